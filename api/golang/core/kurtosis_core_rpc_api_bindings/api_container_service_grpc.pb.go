@@ -24,6 +24,8 @@ const (
 	ApiContainerService_UploadStarlarkPackage_FullMethodName                      = "/api_container_api.ApiContainerService/UploadStarlarkPackage"
 	ApiContainerService_RunStarlarkPackage_FullMethodName                         = "/api_container_api.ApiContainerService/RunStarlarkPackage"
 	ApiContainerService_GetServices_FullMethodName                                = "/api_container_api.ApiContainerService/GetServices"
+	ApiContainerService_GetServiceStats_FullMethodName                            = "/api_container_api.ApiContainerService/GetServiceStats"
+	ApiContainerService_StreamServiceHealthEvents_FullMethodName                  = "/api_container_api.ApiContainerService/StreamServiceHealthEvents"
 	ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_FullMethodName = "/api_container_api.ApiContainerService/GetExistingAndHistoricalServiceIdentifiers"
 	ApiContainerService_ExecCommand_FullMethodName                                = "/api_container_api.ApiContainerService/ExecCommand"
 	ApiContainerService_WaitForHttpGetEndpointAvailability_FullMethodName         = "/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability"
@@ -38,6 +40,16 @@ const (
 	ApiContainerService_GetStarlarkRun_FullMethodName                             = "/api_container_api.ApiContainerService/GetStarlarkRun"
 	ApiContainerService_GetStarlarkScriptPlanYaml_FullMethodName                  = "/api_container_api.ApiContainerService/GetStarlarkScriptPlanYaml"
 	ApiContainerService_GetStarlarkPackagePlanYaml_FullMethodName                 = "/api_container_api.ApiContainerService/GetStarlarkPackagePlanYaml"
+	ApiContainerService_GetEnclaveSnapshotInfo_FullMethodName                     = "/api_container_api.ApiContainerService/GetEnclaveSnapshotInfo"
+	ApiContainerService_RestoreEnclaveSnapshot_FullMethodName                     = "/api_container_api.ApiContainerService/RestoreEnclaveSnapshot"
+	ApiContainerService_SetSecret_FullMethodName                                  = "/api_container_api.ApiContainerService/SetSecret"
+	ApiContainerService_ListSecrets_FullMethodName                                = "/api_container_api.ApiContainerService/ListSecrets"
+	ApiContainerService_RemoveSecret_FullMethodName                               = "/api_container_api.ApiContainerService/RemoveSecret"
+	ApiContainerService_GetEnclaveActivity_FullMethodName                         = "/api_container_api.ApiContainerService/GetEnclaveActivity"
+	ApiContainerService_GetEnclaveResourceUsage_FullMethodName                    = "/api_container_api.ApiContainerService/GetEnclaveResourceUsage"
+	ApiContainerService_SetEnclaveResourceQuotaBudget_FullMethodName              = "/api_container_api.ApiContainerService/SetEnclaveResourceQuotaBudget"
+	ApiContainerService_GetEnclaveEvents_FullMethodName                           = "/api_container_api.ApiContainerService/GetEnclaveEvents"
+	ApiContainerService_GetStarlarkRunTrace_FullMethodName                        = "/api_container_api.ApiContainerService/GetStarlarkRunTrace"
 )
 
 // ApiContainerServiceClient is the client API for ApiContainerService service.
@@ -52,6 +64,10 @@ type ApiContainerServiceClient interface {
 	RunStarlarkPackage(ctx context.Context, in *RunStarlarkPackageArgs, opts ...grpc.CallOption) (ApiContainerService_RunStarlarkPackageClient, error)
 	// Returns the IDs of the current services in the enclave
 	GetServices(ctx context.Context, in *GetServicesArgs, opts ...grpc.CallOption) (*GetServicesResponse, error)
+	// Samples the CPU, memory, network and disk I/O usage of services, along with their peak usage over the last Starlark run
+	GetServiceStats(ctx context.Context, in *GetServiceStatsArgs, opts ...grpc.CallOption) (*GetServiceStatsResponse, error)
+	// Streams the current health of every service, then an event every time the health of a service changes
+	StreamServiceHealthEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiContainerService_StreamServiceHealthEventsClient, error)
 	// Returns information about all existing & historical services
 	GetExistingAndHistoricalServiceIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalServiceIdentifiersResponse, error)
 	// Executes the given command inside a running container
@@ -78,6 +94,28 @@ type ApiContainerServiceClient interface {
 	GetStarlarkScriptPlanYaml(ctx context.Context, in *StarlarkScriptPlanYamlArgs, opts ...grpc.CallOption) (*PlanYaml, error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(ctx context.Context, in *StarlarkPackagePlanYamlArgs, opts ...grpc.CallOption) (*PlanYaml, error)
+	// Gets the enclave plan and the persistent directories of the enclave, which are needed to snapshot the enclave
+	GetEnclaveSnapshotInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclaveSnapshotInfoResponse, error)
+	// Recreates the services of an enclave snapshot by re-executing the snapshot enclave plan inside this (empty) enclave
+	RestoreEnclaveSnapshot(ctx context.Context, in *RestoreEnclaveSnapshotArgs, opts ...grpc.CallOption) (ApiContainerService_RestoreEnclaveSnapshotClient, error)
+	// Creates the secret, or replaces its value if it already exists
+	SetSecret(ctx context.Context, in *SetSecretArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the names of the secrets of the enclave; secret values are never returned
+	ListSecrets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSecretsResponse, error)
+	// Removes a secret; services already using it keep the value they were started with
+	RemoveSecret(ctx context.Context, in *RemoveSecretArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Gets when the API container last served a request, which the engine uses to find the idle enclaves
+	GetEnclaveActivity(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclaveActivityResponse, error)
+	// Gets what the services and files artifacts of the enclave take out of its resource quota
+	GetEnclaveResourceUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclaveResourceUsageResponse, error)
+	// Sets what the services of the enclave can reserve in total given what the other enclaves of its resource quotas
+	// reserve, which the engine keeps up to date; only the engine is allowed to call it
+	SetEnclaveResourceQuotaBudget(ctx context.Context, in *EnclaveResourceQuotaBudget, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Gets the events which happened in the enclave after the given one, which the engine polls to publish them on its
+	// event bus
+	GetEnclaveEvents(ctx context.Context, in *GetEnclaveEventsArgs, opts ...grpc.CallOption) (*GetEnclaveEventsResponse, error)
+	// Gets the spans of the trace of the latest Starlark run of the enclave
+	GetStarlarkRunTrace(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStarlarkRunTraceResponse, error)
 }
 
 type apiContainerServiceClient struct {
@@ -195,6 +233,47 @@ func (c *apiContainerServiceClient) GetServices(ctx context.Context, in *GetServ
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) GetServiceStats(ctx context.Context, in *GetServiceStatsArgs, opts ...grpc.CallOption) (*GetServiceStatsResponse, error) {
+	out := new(GetServiceStatsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetServiceStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) StreamServiceHealthEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ApiContainerService_StreamServiceHealthEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[3], ApiContainerService_StreamServiceHealthEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceStreamServiceHealthEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_StreamServiceHealthEventsClient interface {
+	Recv() (*ServiceHealthEvent, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceStreamServiceHealthEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceStreamServiceHealthEventsClient) Recv() (*ServiceHealthEvent, error) {
+	m := new(ServiceHealthEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *apiContainerServiceClient) GetExistingAndHistoricalServiceIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalServiceIdentifiersResponse, error) {
 	out := new(GetExistingAndHistoricalServiceIdentifiersResponse)
 	err := c.cc.Invoke(ctx, ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_FullMethodName, in, out, opts...)
@@ -232,7 +311,7 @@ func (c *apiContainerServiceClient) WaitForHttpPostEndpointAvailability(ctx cont
 }
 
 func (c *apiContainerServiceClient) UploadFilesArtifact(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_UploadFilesArtifactClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[3], ApiContainerService_UploadFilesArtifact_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[4], ApiContainerService_UploadFilesArtifact_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -266,7 +345,7 @@ func (x *apiContainerServiceUploadFilesArtifactClient) CloseAndRecv() (*UploadFi
 }
 
 func (c *apiContainerServiceClient) DownloadFilesArtifact(ctx context.Context, in *DownloadFilesArtifactArgs, opts ...grpc.CallOption) (ApiContainerService_DownloadFilesArtifactClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[4], ApiContainerService_DownloadFilesArtifact_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[5], ApiContainerService_DownloadFilesArtifact_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -369,6 +448,119 @@ func (c *apiContainerServiceClient) GetStarlarkPackagePlanYaml(ctx context.Conte
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) GetEnclaveSnapshotInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclaveSnapshotInfoResponse, error) {
+	out := new(GetEnclaveSnapshotInfoResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetEnclaveSnapshotInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) RestoreEnclaveSnapshot(ctx context.Context, in *RestoreEnclaveSnapshotArgs, opts ...grpc.CallOption) (ApiContainerService_RestoreEnclaveSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[6], ApiContainerService_RestoreEnclaveSnapshot_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceRestoreEnclaveSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_RestoreEnclaveSnapshotClient interface {
+	Recv() (*StarlarkRunResponseLine, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceRestoreEnclaveSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceRestoreEnclaveSnapshotClient) Recv() (*StarlarkRunResponseLine, error) {
+	m := new(StarlarkRunResponseLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) SetSecret(ctx context.Context, in *SetSecretArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_SetSecret_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) ListSecrets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
+	out := new(ListSecretsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_ListSecrets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) RemoveSecret(ctx context.Context, in *RemoveSecretArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_RemoveSecret_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetEnclaveActivity(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclaveActivityResponse, error) {
+	out := new(GetEnclaveActivityResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetEnclaveActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetEnclaveResourceUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclaveResourceUsageResponse, error) {
+	out := new(GetEnclaveResourceUsageResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetEnclaveResourceUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) SetEnclaveResourceQuotaBudget(ctx context.Context, in *EnclaveResourceQuotaBudget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_SetEnclaveResourceQuotaBudget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetEnclaveEvents(ctx context.Context, in *GetEnclaveEventsArgs, opts ...grpc.CallOption) (*GetEnclaveEventsResponse, error) {
+	out := new(GetEnclaveEventsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetEnclaveEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetStarlarkRunTrace(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStarlarkRunTraceResponse, error) {
+	out := new(GetStarlarkRunTraceResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetStarlarkRunTrace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiContainerServiceServer is the server API for ApiContainerService service.
 // All implementations should embed UnimplementedApiContainerServiceServer
 // for forward compatibility
@@ -381,6 +573,10 @@ type ApiContainerServiceServer interface {
 	RunStarlarkPackage(*RunStarlarkPackageArgs, ApiContainerService_RunStarlarkPackageServer) error
 	// Returns the IDs of the current services in the enclave
 	GetServices(context.Context, *GetServicesArgs) (*GetServicesResponse, error)
+	// Samples the CPU, memory, network and disk I/O usage of services, along with their peak usage over the last Starlark run
+	GetServiceStats(context.Context, *GetServiceStatsArgs) (*GetServiceStatsResponse, error)
+	// Streams the current health of every service, then an event every time the health of a service changes
+	StreamServiceHealthEvents(*emptypb.Empty, ApiContainerService_StreamServiceHealthEventsServer) error
 	// Returns information about all existing & historical services
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalServiceIdentifiersResponse, error)
 	// Executes the given command inside a running container
@@ -407,6 +603,28 @@ type ApiContainerServiceServer interface {
 	GetStarlarkScriptPlanYaml(context.Context, *StarlarkScriptPlanYamlArgs) (*PlanYaml, error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *StarlarkPackagePlanYamlArgs) (*PlanYaml, error)
+	// Gets the enclave plan and the persistent directories of the enclave, which are needed to snapshot the enclave
+	GetEnclaveSnapshotInfo(context.Context, *emptypb.Empty) (*GetEnclaveSnapshotInfoResponse, error)
+	// Recreates the services of an enclave snapshot by re-executing the snapshot enclave plan inside this (empty) enclave
+	RestoreEnclaveSnapshot(*RestoreEnclaveSnapshotArgs, ApiContainerService_RestoreEnclaveSnapshotServer) error
+	// Creates the secret, or replaces its value if it already exists
+	SetSecret(context.Context, *SetSecretArgs) (*emptypb.Empty, error)
+	// Lists the names of the secrets of the enclave; secret values are never returned
+	ListSecrets(context.Context, *emptypb.Empty) (*ListSecretsResponse, error)
+	// Removes a secret; services already using it keep the value they were started with
+	RemoveSecret(context.Context, *RemoveSecretArgs) (*emptypb.Empty, error)
+	// Gets when the API container last served a request, which the engine uses to find the idle enclaves
+	GetEnclaveActivity(context.Context, *emptypb.Empty) (*GetEnclaveActivityResponse, error)
+	// Gets what the services and files artifacts of the enclave take out of its resource quota
+	GetEnclaveResourceUsage(context.Context, *emptypb.Empty) (*GetEnclaveResourceUsageResponse, error)
+	// Sets what the services of the enclave can reserve in total given what the other enclaves of its resource quotas
+	// reserve, which the engine keeps up to date; only the engine is allowed to call it
+	SetEnclaveResourceQuotaBudget(context.Context, *EnclaveResourceQuotaBudget) (*emptypb.Empty, error)
+	// Gets the events which happened in the enclave after the given one, which the engine polls to publish them on its
+	// event bus
+	GetEnclaveEvents(context.Context, *GetEnclaveEventsArgs) (*GetEnclaveEventsResponse, error)
+	// Gets the spans of the trace of the latest Starlark run of the enclave
+	GetStarlarkRunTrace(context.Context, *emptypb.Empty) (*GetStarlarkRunTraceResponse, error)
 }
 
 // UnimplementedApiContainerServiceServer should be embedded to have forward compatible implementations.
@@ -425,6 +643,12 @@ func (UnimplementedApiContainerServiceServer) RunStarlarkPackage(*RunStarlarkPac
 func (UnimplementedApiContainerServiceServer) GetServices(context.Context, *GetServicesArgs) (*GetServicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServices not implemented")
 }
+func (UnimplementedApiContainerServiceServer) GetServiceStats(context.Context, *GetServiceStatsArgs) (*GetServiceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceStats not implemented")
+}
+func (UnimplementedApiContainerServiceServer) StreamServiceHealthEvents(*emptypb.Empty, ApiContainerService_StreamServiceHealthEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamServiceHealthEvents not implemented")
+}
 func (UnimplementedApiContainerServiceServer) GetExistingAndHistoricalServiceIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalServiceIdentifiersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExistingAndHistoricalServiceIdentifiers not implemented")
 }
@@ -467,6 +691,36 @@ func (UnimplementedApiContainerServiceServer) GetStarlarkScriptPlanYaml(context.
 func (UnimplementedApiContainerServiceServer) GetStarlarkPackagePlanYaml(context.Context, *StarlarkPackagePlanYamlArgs) (*PlanYaml, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkPackagePlanYaml not implemented")
 }
+func (UnimplementedApiContainerServiceServer) GetEnclaveSnapshotInfo(context.Context, *emptypb.Empty) (*GetEnclaveSnapshotInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaveSnapshotInfo not implemented")
+}
+func (UnimplementedApiContainerServiceServer) RestoreEnclaveSnapshot(*RestoreEnclaveSnapshotArgs, ApiContainerService_RestoreEnclaveSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreEnclaveSnapshot not implemented")
+}
+func (UnimplementedApiContainerServiceServer) SetSecret(context.Context, *SetSecretArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSecret not implemented")
+}
+func (UnimplementedApiContainerServiceServer) ListSecrets(context.Context, *emptypb.Empty) (*ListSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (UnimplementedApiContainerServiceServer) RemoveSecret(context.Context, *RemoveSecretArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSecret not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetEnclaveActivity(context.Context, *emptypb.Empty) (*GetEnclaveActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaveActivity not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetEnclaveResourceUsage(context.Context, *emptypb.Empty) (*GetEnclaveResourceUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaveResourceUsage not implemented")
+}
+func (UnimplementedApiContainerServiceServer) SetEnclaveResourceQuotaBudget(context.Context, *EnclaveResourceQuotaBudget) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEnclaveResourceQuotaBudget not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetEnclaveEvents(context.Context, *GetEnclaveEventsArgs) (*GetEnclaveEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaveEvents not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetStarlarkRunTrace(context.Context, *emptypb.Empty) (*GetStarlarkRunTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkRunTrace not implemented")
+}
 
 // UnsafeApiContainerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApiContainerServiceServer will
@@ -565,6 +819,45 @@ func _ApiContainerService_GetServices_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_GetServiceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceStatsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetServiceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetServiceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetServiceStats(ctx, req.(*GetServiceStatsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_StreamServiceHealthEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).StreamServiceHealthEvents(m, &apiContainerServiceStreamServiceHealthEventsServer{stream})
+}
+
+type ApiContainerService_StreamServiceHealthEventsServer interface {
+	Send(*ServiceHealthEvent) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceStreamServiceHealthEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceStreamServiceHealthEventsServer) Send(m *ServiceHealthEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -828,6 +1121,189 @@ func _ApiContainerService_GetStarlarkPackagePlanYaml_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_GetEnclaveSnapshotInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetEnclaveSnapshotInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetEnclaveSnapshotInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetEnclaveSnapshotInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_RestoreEnclaveSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RestoreEnclaveSnapshotArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).RestoreEnclaveSnapshot(m, &apiContainerServiceRestoreEnclaveSnapshotServer{stream})
+}
+
+type ApiContainerService_RestoreEnclaveSnapshotServer interface {
+	Send(*StarlarkRunResponseLine) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceRestoreEnclaveSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceRestoreEnclaveSnapshotServer) Send(m *StarlarkRunResponseLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_SetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSecretArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).SetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_SetSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).SetSecret(ctx, req.(*SetSecretArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).ListSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_ListSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).ListSecrets(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_RemoveSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSecretArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).RemoveSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_RemoveSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RemoveSecret(ctx, req.(*RemoveSecretArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetEnclaveActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetEnclaveActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetEnclaveActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetEnclaveActivity(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetEnclaveResourceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetEnclaveResourceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetEnclaveResourceUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetEnclaveResourceUsage(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_SetEnclaveResourceQuotaBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnclaveResourceQuotaBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).SetEnclaveResourceQuotaBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_SetEnclaveResourceQuotaBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).SetEnclaveResourceQuotaBudget(ctx, req.(*EnclaveResourceQuotaBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetEnclaveEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnclaveEventsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetEnclaveEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetEnclaveEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetEnclaveEvents(ctx, req.(*GetEnclaveEventsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetStarlarkRunTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetStarlarkRunTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetStarlarkRunTrace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetStarlarkRunTrace(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiContainerService_ServiceDesc is the grpc.ServiceDesc for ApiContainerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -839,6 +1315,10 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetServices",
 			Handler:    _ApiContainerService_GetServices_Handler,
 		},
+		{
+			MethodName: "GetServiceStats",
+			Handler:    _ApiContainerService_GetServiceStats_Handler,
+		},
 		{
 			MethodName: "GetExistingAndHistoricalServiceIdentifiers",
 			Handler:    _ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_Handler,
@@ -887,6 +1367,42 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStarlarkPackagePlanYaml",
 			Handler:    _ApiContainerService_GetStarlarkPackagePlanYaml_Handler,
 		},
+		{
+			MethodName: "GetEnclaveSnapshotInfo",
+			Handler:    _ApiContainerService_GetEnclaveSnapshotInfo_Handler,
+		},
+		{
+			MethodName: "SetSecret",
+			Handler:    _ApiContainerService_SetSecret_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _ApiContainerService_ListSecrets_Handler,
+		},
+		{
+			MethodName: "RemoveSecret",
+			Handler:    _ApiContainerService_RemoveSecret_Handler,
+		},
+		{
+			MethodName: "GetEnclaveActivity",
+			Handler:    _ApiContainerService_GetEnclaveActivity_Handler,
+		},
+		{
+			MethodName: "GetEnclaveResourceUsage",
+			Handler:    _ApiContainerService_GetEnclaveResourceUsage_Handler,
+		},
+		{
+			MethodName: "SetEnclaveResourceQuotaBudget",
+			Handler:    _ApiContainerService_SetEnclaveResourceQuotaBudget_Handler,
+		},
+		{
+			MethodName: "GetEnclaveEvents",
+			Handler:    _ApiContainerService_GetEnclaveEvents_Handler,
+		},
+		{
+			MethodName: "GetStarlarkRunTrace",
+			Handler:    _ApiContainerService_GetStarlarkRunTrace_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -904,6 +1420,11 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ApiContainerService_RunStarlarkPackage_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamServiceHealthEvents",
+			Handler:       _ApiContainerService_StreamServiceHealthEvents_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "UploadFilesArtifact",
 			Handler:       _ApiContainerService_UploadFilesArtifact_Handler,
@@ -914,6 +1435,11 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ApiContainerService_DownloadFilesArtifact_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RestoreEnclaveSnapshot",
+			Handler:       _ApiContainerService_RestoreEnclaveSnapshot_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api_container_service.proto",
 }
@@ -46,6 +46,12 @@ const (
 	// ApiContainerServiceGetServicesProcedure is the fully-qualified name of the ApiContainerService's
 	// GetServices RPC.
 	ApiContainerServiceGetServicesProcedure = "/api_container_api.ApiContainerService/GetServices"
+	// ApiContainerServiceGetServiceStatsProcedure is the fully-qualified name of the
+	// ApiContainerService's GetServiceStats RPC.
+	ApiContainerServiceGetServiceStatsProcedure = "/api_container_api.ApiContainerService/GetServiceStats"
+	// ApiContainerServiceStreamServiceHealthEventsProcedure is the fully-qualified name of the
+	// ApiContainerService's StreamServiceHealthEvents RPC.
+	ApiContainerServiceStreamServiceHealthEventsProcedure = "/api_container_api.ApiContainerService/StreamServiceHealthEvents"
 	// ApiContainerServiceGetExistingAndHistoricalServiceIdentifiersProcedure is the fully-qualified
 	// name of the ApiContainerService's GetExistingAndHistoricalServiceIdentifiers RPC.
 	ApiContainerServiceGetExistingAndHistoricalServiceIdentifiersProcedure = "/api_container_api.ApiContainerService/GetExistingAndHistoricalServiceIdentifiers"
@@ -88,6 +94,36 @@ const (
 	// ApiContainerServiceGetStarlarkPackagePlanYamlProcedure is the fully-qualified name of the
 	// ApiContainerService's GetStarlarkPackagePlanYaml RPC.
 	ApiContainerServiceGetStarlarkPackagePlanYamlProcedure = "/api_container_api.ApiContainerService/GetStarlarkPackagePlanYaml"
+	// ApiContainerServiceGetEnclaveSnapshotInfoProcedure is the fully-qualified name of the
+	// ApiContainerService's GetEnclaveSnapshotInfo RPC.
+	ApiContainerServiceGetEnclaveSnapshotInfoProcedure = "/api_container_api.ApiContainerService/GetEnclaveSnapshotInfo"
+	// ApiContainerServiceRestoreEnclaveSnapshotProcedure is the fully-qualified name of the
+	// ApiContainerService's RestoreEnclaveSnapshot RPC.
+	ApiContainerServiceRestoreEnclaveSnapshotProcedure = "/api_container_api.ApiContainerService/RestoreEnclaveSnapshot"
+	// ApiContainerServiceSetSecretProcedure is the fully-qualified name of the ApiContainerService's
+	// SetSecret RPC.
+	ApiContainerServiceSetSecretProcedure = "/api_container_api.ApiContainerService/SetSecret"
+	// ApiContainerServiceListSecretsProcedure is the fully-qualified name of the ApiContainerService's
+	// ListSecrets RPC.
+	ApiContainerServiceListSecretsProcedure = "/api_container_api.ApiContainerService/ListSecrets"
+	// ApiContainerServiceRemoveSecretProcedure is the fully-qualified name of the ApiContainerService's
+	// RemoveSecret RPC.
+	ApiContainerServiceRemoveSecretProcedure = "/api_container_api.ApiContainerService/RemoveSecret"
+	// ApiContainerServiceGetEnclaveActivityProcedure is the fully-qualified name of the
+	// ApiContainerService's GetEnclaveActivity RPC.
+	ApiContainerServiceGetEnclaveActivityProcedure = "/api_container_api.ApiContainerService/GetEnclaveActivity"
+	// ApiContainerServiceGetEnclaveResourceUsageProcedure is the fully-qualified name of the
+	// ApiContainerService's GetEnclaveResourceUsage RPC.
+	ApiContainerServiceGetEnclaveResourceUsageProcedure = "/api_container_api.ApiContainerService/GetEnclaveResourceUsage"
+	// ApiContainerServiceSetEnclaveResourceQuotaBudgetProcedure is the fully-qualified name of the
+	// ApiContainerService's SetEnclaveResourceQuotaBudget RPC.
+	ApiContainerServiceSetEnclaveResourceQuotaBudgetProcedure = "/api_container_api.ApiContainerService/SetEnclaveResourceQuotaBudget"
+	// ApiContainerServiceGetEnclaveEventsProcedure is the fully-qualified name of the
+	// ApiContainerService's GetEnclaveEvents RPC.
+	ApiContainerServiceGetEnclaveEventsProcedure = "/api_container_api.ApiContainerService/GetEnclaveEvents"
+	// ApiContainerServiceGetStarlarkRunTraceProcedure is the fully-qualified name of the
+	// ApiContainerService's GetStarlarkRunTrace RPC.
+	ApiContainerServiceGetStarlarkRunTraceProcedure = "/api_container_api.ApiContainerService/GetStarlarkRunTrace"
 )
 
 // ApiContainerServiceClient is a client for the api_container_api.ApiContainerService service.
@@ -100,6 +136,10 @@ type ApiContainerServiceClient interface {
 	RunStarlarkPackage(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error)
 	// Returns the IDs of the current services in the enclave
 	GetServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServicesResponse], error)
+	// Samples the CPU, memory, network and disk I/O usage of services, along with their peak usage over the last Starlark run
+	GetServiceStats(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServiceStatsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServiceStatsResponse], error)
+	// Streams the current health of every service, then an event every time the health of a service changes
+	StreamServiceHealthEvents(context.Context, *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.ServiceHealthEvent], error)
 	// Returns information about all existing & historical services
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse], error)
 	// Executes the given command inside a running container
@@ -126,6 +166,28 @@ type ApiContainerServiceClient interface {
 	GetStarlarkScriptPlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
+	// Gets the enclave plan and the persistent directories of the enclave, which are needed to snapshot the enclave
+	GetEnclaveSnapshotInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotInfoResponse], error)
+	// Recreates the services of an enclave snapshot by re-executing the snapshot enclave plan inside this (empty) enclave
+	RestoreEnclaveSnapshot(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error)
+	// Creates the secret, or replaces its value if it already exists
+	SetSecret(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.SetSecretArgs]) (*connect.Response[emptypb.Empty], error)
+	// Lists the names of the secrets of the enclave; secret values are never returned
+	ListSecrets(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListSecretsResponse], error)
+	// Removes a secret; services already using it keep the value they were started with
+	RemoveSecret(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RemoveSecretArgs]) (*connect.Response[emptypb.Empty], error)
+	// Gets when the API container last served a request, which the engine uses to find the idle enclaves
+	GetEnclaveActivity(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveActivityResponse], error)
+	// Gets what the services and files artifacts of the enclave take out of its resource quota
+	GetEnclaveResourceUsage(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveResourceUsageResponse], error)
+	// Sets what the services of the enclave can reserve in total given what the other enclaves of its resource quotas
+	// reserve, which the engine keeps up to date; only the engine is allowed to call it
+	SetEnclaveResourceQuotaBudget(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.EnclaveResourceQuotaBudget]) (*connect.Response[emptypb.Empty], error)
+	// Gets the events which happened in the enclave after the given one, which the engine polls to publish them on its
+	// event bus
+	GetEnclaveEvents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetEnclaveEventsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveEventsResponse], error)
+	// Gets the spans of the trace of the latest Starlark run of the enclave
+	GetStarlarkRunTrace(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunTraceResponse], error)
 }
 
 // NewApiContainerServiceClient constructs a client for the api_container_api.ApiContainerService
@@ -158,6 +220,16 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceGetServicesProcedure,
 			opts...,
 		),
+		getServiceStats: connect.NewClient[kurtosis_core_rpc_api_bindings.GetServiceStatsArgs, kurtosis_core_rpc_api_bindings.GetServiceStatsResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetServiceStatsProcedure,
+			opts...,
+		),
+		streamServiceHealthEvents: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.ServiceHealthEvent](
+			httpClient,
+			baseURL+ApiContainerServiceStreamServiceHealthEventsProcedure,
+			opts...,
+		),
 		getExistingAndHistoricalServiceIdentifiers: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse](
 			httpClient,
 			baseURL+ApiContainerServiceGetExistingAndHistoricalServiceIdentifiersProcedure,
@@ -228,6 +300,56 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceGetStarlarkPackagePlanYamlProcedure,
 			opts...,
 		),
+		getEnclaveSnapshotInfo: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotInfoResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetEnclaveSnapshotInfoProcedure,
+			opts...,
+		),
+		restoreEnclaveSnapshot: connect.NewClient[kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotArgs, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](
+			httpClient,
+			baseURL+ApiContainerServiceRestoreEnclaveSnapshotProcedure,
+			opts...,
+		),
+		setSecret: connect.NewClient[kurtosis_core_rpc_api_bindings.SetSecretArgs, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceSetSecretProcedure,
+			opts...,
+		),
+		listSecrets: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListSecretsResponse](
+			httpClient,
+			baseURL+ApiContainerServiceListSecretsProcedure,
+			opts...,
+		),
+		removeSecret: connect.NewClient[kurtosis_core_rpc_api_bindings.RemoveSecretArgs, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceRemoveSecretProcedure,
+			opts...,
+		),
+		getEnclaveActivity: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetEnclaveActivityResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetEnclaveActivityProcedure,
+			opts...,
+		),
+		getEnclaveResourceUsage: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetEnclaveResourceUsageResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetEnclaveResourceUsageProcedure,
+			opts...,
+		),
+		setEnclaveResourceQuotaBudget: connect.NewClient[kurtosis_core_rpc_api_bindings.EnclaveResourceQuotaBudget, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceSetEnclaveResourceQuotaBudgetProcedure,
+			opts...,
+		),
+		getEnclaveEvents: connect.NewClient[kurtosis_core_rpc_api_bindings.GetEnclaveEventsArgs, kurtosis_core_rpc_api_bindings.GetEnclaveEventsResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetEnclaveEventsProcedure,
+			opts...,
+		),
+		getStarlarkRunTrace: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetStarlarkRunTraceResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetStarlarkRunTraceProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -237,6 +359,8 @@ type apiContainerServiceClient struct {
 	uploadStarlarkPackage                      *connect.Client[kurtosis_core_rpc_api_bindings.StreamedDataChunk, emptypb.Empty]
 	runStarlarkPackage                         *connect.Client[kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]
 	getServices                                *connect.Client[kurtosis_core_rpc_api_bindings.GetServicesArgs, kurtosis_core_rpc_api_bindings.GetServicesResponse]
+	getServiceStats                            *connect.Client[kurtosis_core_rpc_api_bindings.GetServiceStatsArgs, kurtosis_core_rpc_api_bindings.GetServiceStatsResponse]
+	streamServiceHealthEvents                  *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ServiceHealthEvent]
 	getExistingAndHistoricalServiceIdentifiers *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse]
 	execCommand                                *connect.Client[kurtosis_core_rpc_api_bindings.ExecCommandArgs, kurtosis_core_rpc_api_bindings.ExecCommandResponse]
 	waitForHttpGetEndpointAvailability         *connect.Client[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs, emptypb.Empty]
@@ -251,6 +375,16 @@ type apiContainerServiceClient struct {
 	getStarlarkRun                             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse]
 	getStarlarkScriptPlanYaml                  *connect.Client[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs, kurtosis_core_rpc_api_bindings.PlanYaml]
 	getStarlarkPackagePlanYaml                 *connect.Client[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs, kurtosis_core_rpc_api_bindings.PlanYaml]
+	getEnclaveSnapshotInfo                     *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotInfoResponse]
+	restoreEnclaveSnapshot                     *connect.Client[kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotArgs, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]
+	setSecret                                  *connect.Client[kurtosis_core_rpc_api_bindings.SetSecretArgs, emptypb.Empty]
+	listSecrets                                *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListSecretsResponse]
+	removeSecret                               *connect.Client[kurtosis_core_rpc_api_bindings.RemoveSecretArgs, emptypb.Empty]
+	getEnclaveActivity                         *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetEnclaveActivityResponse]
+	getEnclaveResourceUsage                    *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetEnclaveResourceUsageResponse]
+	setEnclaveResourceQuotaBudget              *connect.Client[kurtosis_core_rpc_api_bindings.EnclaveResourceQuotaBudget, emptypb.Empty]
+	getEnclaveEvents                           *connect.Client[kurtosis_core_rpc_api_bindings.GetEnclaveEventsArgs, kurtosis_core_rpc_api_bindings.GetEnclaveEventsResponse]
+	getStarlarkRunTrace                        *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetStarlarkRunTraceResponse]
 }
 
 // RunStarlarkScript calls api_container_api.ApiContainerService.RunStarlarkScript.
@@ -273,6 +407,16 @@ func (c *apiContainerServiceClient) GetServices(ctx context.Context, req *connec
 	return c.getServices.CallUnary(ctx, req)
 }
 
+// GetServiceStats calls api_container_api.ApiContainerService.GetServiceStats.
+func (c *apiContainerServiceClient) GetServiceStats(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.GetServiceStatsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServiceStatsResponse], error) {
+	return c.getServiceStats.CallUnary(ctx, req)
+}
+
+// StreamServiceHealthEvents calls api_container_api.ApiContainerService.StreamServiceHealthEvents.
+func (c *apiContainerServiceClient) StreamServiceHealthEvents(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.ServiceHealthEvent], error) {
+	return c.streamServiceHealthEvents.CallServerStream(ctx, req)
+}
+
 // GetExistingAndHistoricalServiceIdentifiers calls
 // api_container_api.ApiContainerService.GetExistingAndHistoricalServiceIdentifiers.
 func (c *apiContainerServiceClient) GetExistingAndHistoricalServiceIdentifiers(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse], error) {
@@ -350,6 +494,57 @@ func (c *apiContainerServiceClient) GetStarlarkPackagePlanYaml(ctx context.Conte
 	return c.getStarlarkPackagePlanYaml.CallUnary(ctx, req)
 }
 
+// GetEnclaveSnapshotInfo calls api_container_api.ApiContainerService.GetEnclaveSnapshotInfo.
+func (c *apiContainerServiceClient) GetEnclaveSnapshotInfo(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotInfoResponse], error) {
+	return c.getEnclaveSnapshotInfo.CallUnary(ctx, req)
+}
+
+// RestoreEnclaveSnapshot calls api_container_api.ApiContainerService.RestoreEnclaveSnapshot.
+func (c *apiContainerServiceClient) RestoreEnclaveSnapshot(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error) {
+	return c.restoreEnclaveSnapshot.CallServerStream(ctx, req)
+}
+
+// SetSecret calls api_container_api.ApiContainerService.SetSecret.
+func (c *apiContainerServiceClient) SetSecret(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.SetSecretArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.setSecret.CallUnary(ctx, req)
+}
+
+// ListSecrets calls api_container_api.ApiContainerService.ListSecrets.
+func (c *apiContainerServiceClient) ListSecrets(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListSecretsResponse], error) {
+	return c.listSecrets.CallUnary(ctx, req)
+}
+
+// RemoveSecret calls api_container_api.ApiContainerService.RemoveSecret.
+func (c *apiContainerServiceClient) RemoveSecret(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.RemoveSecretArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeSecret.CallUnary(ctx, req)
+}
+
+// GetEnclaveActivity calls api_container_api.ApiContainerService.GetEnclaveActivity.
+func (c *apiContainerServiceClient) GetEnclaveActivity(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveActivityResponse], error) {
+	return c.getEnclaveActivity.CallUnary(ctx, req)
+}
+
+// GetEnclaveResourceUsage calls api_container_api.ApiContainerService.GetEnclaveResourceUsage.
+func (c *apiContainerServiceClient) GetEnclaveResourceUsage(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveResourceUsageResponse], error) {
+	return c.getEnclaveResourceUsage.CallUnary(ctx, req)
+}
+
+// SetEnclaveResourceQuotaBudget calls
+// api_container_api.ApiContainerService.SetEnclaveResourceQuotaBudget.
+func (c *apiContainerServiceClient) SetEnclaveResourceQuotaBudget(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.EnclaveResourceQuotaBudget]) (*connect.Response[emptypb.Empty], error) {
+	return c.setEnclaveResourceQuotaBudget.CallUnary(ctx, req)
+}
+
+// GetEnclaveEvents calls api_container_api.ApiContainerService.GetEnclaveEvents.
+func (c *apiContainerServiceClient) GetEnclaveEvents(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.GetEnclaveEventsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveEventsResponse], error) {
+	return c.getEnclaveEvents.CallUnary(ctx, req)
+}
+
+// GetStarlarkRunTrace calls api_container_api.ApiContainerService.GetStarlarkRunTrace.
+func (c *apiContainerServiceClient) GetStarlarkRunTrace(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunTraceResponse], error) {
+	return c.getStarlarkRunTrace.CallUnary(ctx, req)
+}
+
 // ApiContainerServiceHandler is an implementation of the api_container_api.ApiContainerService
 // service.
 type ApiContainerServiceHandler interface {
@@ -361,6 +556,10 @@ type ApiContainerServiceHandler interface {
 	RunStarlarkPackage(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error
 	// Returns the IDs of the current services in the enclave
 	GetServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServicesResponse], error)
+	// Samples the CPU, memory, network and disk I/O usage of services, along with their peak usage over the last Starlark run
+	GetServiceStats(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServiceStatsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServiceStatsResponse], error)
+	// Streams the current health of every service, then an event every time the health of a service changes
+	StreamServiceHealthEvents(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[kurtosis_core_rpc_api_bindings.ServiceHealthEvent]) error
 	// Returns information about all existing & historical services
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse], error)
 	// Executes the given command inside a running container
@@ -387,6 +586,28 @@ type ApiContainerServiceHandler interface {
 	GetStarlarkScriptPlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
+	// Gets the enclave plan and the persistent directories of the enclave, which are needed to snapshot the enclave
+	GetEnclaveSnapshotInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotInfoResponse], error)
+	// Recreates the services of an enclave snapshot by re-executing the snapshot enclave plan inside this (empty) enclave
+	RestoreEnclaveSnapshot(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error
+	// Creates the secret, or replaces its value if it already exists
+	SetSecret(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.SetSecretArgs]) (*connect.Response[emptypb.Empty], error)
+	// Lists the names of the secrets of the enclave; secret values are never returned
+	ListSecrets(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListSecretsResponse], error)
+	// Removes a secret; services already using it keep the value they were started with
+	RemoveSecret(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RemoveSecretArgs]) (*connect.Response[emptypb.Empty], error)
+	// Gets when the API container last served a request, which the engine uses to find the idle enclaves
+	GetEnclaveActivity(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveActivityResponse], error)
+	// Gets what the services and files artifacts of the enclave take out of its resource quota
+	GetEnclaveResourceUsage(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveResourceUsageResponse], error)
+	// Sets what the services of the enclave can reserve in total given what the other enclaves of its resource quotas
+	// reserve, which the engine keeps up to date; only the engine is allowed to call it
+	SetEnclaveResourceQuotaBudget(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.EnclaveResourceQuotaBudget]) (*connect.Response[emptypb.Empty], error)
+	// Gets the events which happened in the enclave after the given one, which the engine polls to publish them on its
+	// event bus
+	GetEnclaveEvents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetEnclaveEventsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveEventsResponse], error)
+	// Gets the spans of the trace of the latest Starlark run of the enclave
+	GetStarlarkRunTrace(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunTraceResponse], error)
 }
 
 // NewApiContainerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -415,6 +636,16 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.GetServices,
 		opts...,
 	)
+	apiContainerServiceGetServiceStatsHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetServiceStatsProcedure,
+		svc.GetServiceStats,
+		opts...,
+	)
+	apiContainerServiceStreamServiceHealthEventsHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceStreamServiceHealthEventsProcedure,
+		svc.StreamServiceHealthEvents,
+		opts...,
+	)
 	apiContainerServiceGetExistingAndHistoricalServiceIdentifiersHandler := connect.NewUnaryHandler(
 		ApiContainerServiceGetExistingAndHistoricalServiceIdentifiersProcedure,
 		svc.GetExistingAndHistoricalServiceIdentifiers,
@@ -485,6 +716,56 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.GetStarlarkPackagePlanYaml,
 		opts...,
 	)
+	apiContainerServiceGetEnclaveSnapshotInfoHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetEnclaveSnapshotInfoProcedure,
+		svc.GetEnclaveSnapshotInfo,
+		opts...,
+	)
+	apiContainerServiceRestoreEnclaveSnapshotHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceRestoreEnclaveSnapshotProcedure,
+		svc.RestoreEnclaveSnapshot,
+		opts...,
+	)
+	apiContainerServiceSetSecretHandler := connect.NewUnaryHandler(
+		ApiContainerServiceSetSecretProcedure,
+		svc.SetSecret,
+		opts...,
+	)
+	apiContainerServiceListSecretsHandler := connect.NewUnaryHandler(
+		ApiContainerServiceListSecretsProcedure,
+		svc.ListSecrets,
+		opts...,
+	)
+	apiContainerServiceRemoveSecretHandler := connect.NewUnaryHandler(
+		ApiContainerServiceRemoveSecretProcedure,
+		svc.RemoveSecret,
+		opts...,
+	)
+	apiContainerServiceGetEnclaveActivityHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetEnclaveActivityProcedure,
+		svc.GetEnclaveActivity,
+		opts...,
+	)
+	apiContainerServiceGetEnclaveResourceUsageHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetEnclaveResourceUsageProcedure,
+		svc.GetEnclaveResourceUsage,
+		opts...,
+	)
+	apiContainerServiceSetEnclaveResourceQuotaBudgetHandler := connect.NewUnaryHandler(
+		ApiContainerServiceSetEnclaveResourceQuotaBudgetProcedure,
+		svc.SetEnclaveResourceQuotaBudget,
+		opts...,
+	)
+	apiContainerServiceGetEnclaveEventsHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetEnclaveEventsProcedure,
+		svc.GetEnclaveEvents,
+		opts...,
+	)
+	apiContainerServiceGetStarlarkRunTraceHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetStarlarkRunTraceProcedure,
+		svc.GetStarlarkRunTrace,
+		opts...,
+	)
 	return "/api_container_api.ApiContainerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ApiContainerServiceRunStarlarkScriptProcedure:
@@ -495,6 +776,10 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceRunStarlarkPackageHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetServicesProcedure:
 			apiContainerServiceGetServicesHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetServiceStatsProcedure:
+			apiContainerServiceGetServiceStatsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceStreamServiceHealthEventsProcedure:
+			apiContainerServiceStreamServiceHealthEventsHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetExistingAndHistoricalServiceIdentifiersProcedure:
 			apiContainerServiceGetExistingAndHistoricalServiceIdentifiersHandler.ServeHTTP(w, r)
 		case ApiContainerServiceExecCommandProcedure:
@@ -523,6 +808,26 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceGetStarlarkScriptPlanYamlHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetStarlarkPackagePlanYamlProcedure:
 			apiContainerServiceGetStarlarkPackagePlanYamlHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetEnclaveSnapshotInfoProcedure:
+			apiContainerServiceGetEnclaveSnapshotInfoHandler.ServeHTTP(w, r)
+		case ApiContainerServiceRestoreEnclaveSnapshotProcedure:
+			apiContainerServiceRestoreEnclaveSnapshotHandler.ServeHTTP(w, r)
+		case ApiContainerServiceSetSecretProcedure:
+			apiContainerServiceSetSecretHandler.ServeHTTP(w, r)
+		case ApiContainerServiceListSecretsProcedure:
+			apiContainerServiceListSecretsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceRemoveSecretProcedure:
+			apiContainerServiceRemoveSecretHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetEnclaveActivityProcedure:
+			apiContainerServiceGetEnclaveActivityHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetEnclaveResourceUsageProcedure:
+			apiContainerServiceGetEnclaveResourceUsageHandler.ServeHTTP(w, r)
+		case ApiContainerServiceSetEnclaveResourceQuotaBudgetProcedure:
+			apiContainerServiceSetEnclaveResourceQuotaBudgetHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetEnclaveEventsProcedure:
+			apiContainerServiceGetEnclaveEventsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetStarlarkRunTraceProcedure:
+			apiContainerServiceGetStarlarkRunTraceHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -548,6 +853,14 @@ func (UnimplementedApiContainerServiceHandler) GetServices(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetServices is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) GetServiceStats(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetServiceStatsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServiceStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetServiceStats is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) StreamServiceHealthEvents(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[kurtosis_core_rpc_api_bindings.ServiceHealthEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.StreamServiceHealthEvents is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) GetExistingAndHistoricalServiceIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetExistingAndHistoricalServiceIdentifiers is not implemented"))
 }
@@ -603,3 +916,43 @@ func (UnimplementedApiContainerServiceHandler) GetStarlarkScriptPlanYaml(context
 func (UnimplementedApiContainerServiceHandler) GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkPackagePlanYaml is not implemented"))
 }
+
+func (UnimplementedApiContainerServiceHandler) GetEnclaveSnapshotInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotInfoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetEnclaveSnapshotInfo is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) RestoreEnclaveSnapshot(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.RestoreEnclaveSnapshot is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) SetSecret(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.SetSecretArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.SetSecret is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) ListSecrets(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListSecretsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ListSecrets is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) RemoveSecret(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RemoveSecretArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.RemoveSecret is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetEnclaveActivity(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveActivityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetEnclaveActivity is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetEnclaveResourceUsage(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveResourceUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetEnclaveResourceUsage is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) SetEnclaveResourceQuotaBudget(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.EnclaveResourceQuotaBudget]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.SetEnclaveResourceQuotaBudget is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetEnclaveEvents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetEnclaveEventsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetEnclaveEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetEnclaveEvents is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetStarlarkRunTrace(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunTraceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkRunTrace is not implemented"))
+}
@@ -2,6 +2,7 @@ package binding_constructors
 
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // The generated bindings don't come with constructors (leaving it up to the user to initialize all the fields), so we
@@ -357,11 +358,13 @@ func NewServiceInfo(
 	name string,
 	shortenedUuid string,
 	privateIpAddr string,
+	privateIpv6Addr string,
 	privatePorts map[string]*kurtosis_core_rpc_api_bindings.Port,
 	maybePublicIpAddr string,
 	maybePublicPorts map[string]*kurtosis_core_rpc_api_bindings.Port,
 	serviceStatus kurtosis_core_rpc_api_bindings.ServiceStatus,
 	container *kurtosis_core_rpc_api_bindings.Container,
+	health *kurtosis_core_rpc_api_bindings.ServiceHealth,
 ) *kurtosis_core_rpc_api_bindings.ServiceInfo {
 	return &kurtosis_core_rpc_api_bindings.ServiceInfo{
 		ServiceUuid:       uuid,
@@ -373,6 +376,100 @@ func NewServiceInfo(
 		MaybePublicPorts:  maybePublicPorts,
 		ServiceStatus:     serviceStatus,
 		Container:         container,
+		Health:            health,
+		PrivateIpv6Addr:   privateIpv6Addr,
+	}
+}
+
+func NewServiceHealth(
+	status kurtosis_core_rpc_api_bindings.ServiceHealth_Status,
+	maybeExitCode *int32,
+	restartCount uint32,
+	lastExitReason string,
+	message string,
+	since *timestamppb.Timestamp,
+) *kurtosis_core_rpc_api_bindings.ServiceHealth {
+	return &kurtosis_core_rpc_api_bindings.ServiceHealth{
+		Status:         status,
+		ExitCode:       maybeExitCode,
+		RestartCount:   restartCount,
+		Message:        message,
+		Since:          since,
+		LastExitReason: lastExitReason,
+	}
+}
+
+// ==============================================================================================
+//
+//	Get Service Stats
+//
+// ==============================================================================================
+
+func NewGetServiceStatsArgs(serviceIdentifiers map[string]bool) *kurtosis_core_rpc_api_bindings.GetServiceStatsArgs {
+	return &kurtosis_core_rpc_api_bindings.GetServiceStatsArgs{
+		ServiceIdentifiers: serviceIdentifiers,
+	}
+}
+
+func NewGetServiceStatsResponse(
+	serviceStats map[string]*kurtosis_core_rpc_api_bindings.ServiceStats,
+	serviceErrors map[string]string,
+	lastRunPeakUsage map[string]*kurtosis_core_rpc_api_bindings.ServicePeakUsage,
+) *kurtosis_core_rpc_api_bindings.GetServiceStatsResponse {
+	return &kurtosis_core_rpc_api_bindings.GetServiceStatsResponse{
+		ServiceStats:     serviceStats,
+		ServiceErrors:    serviceErrors,
+		LastRunPeakUsage: lastRunPeakUsage,
+	}
+}
+
+func NewServiceStats(
+	cpuUsageMillicores uint64,
+	memoryUsageBytes uint64,
+	memoryLimitBytes uint64,
+	maybeIoStats *kurtosis_core_rpc_api_bindings.ServiceIoStats,
+	sampleTime *timestamppb.Timestamp,
+) *kurtosis_core_rpc_api_bindings.ServiceStats {
+	return &kurtosis_core_rpc_api_bindings.ServiceStats{
+		CpuUsageMillicores: cpuUsageMillicores,
+		MemoryUsageBytes:   memoryUsageBytes,
+		MemoryLimitBytes:   memoryLimitBytes,
+		IoStats:            maybeIoStats,
+		SampleTime:         sampleTime,
+	}
+}
+
+func NewServiceIoStats(
+	networkReceivedBytes uint64,
+	networkSentBytes uint64,
+	diskReadBytes uint64,
+	diskWrittenBytes uint64,
+) *kurtosis_core_rpc_api_bindings.ServiceIoStats {
+	return &kurtosis_core_rpc_api_bindings.ServiceIoStats{
+		NetworkReceivedBytes: networkReceivedBytes,
+		NetworkSentBytes:     networkSentBytes,
+		DiskReadBytes:        diskReadBytes,
+		DiskWrittenBytes:     diskWrittenBytes,
+	}
+}
+
+func NewServicePeakUsage(peakCpuUsageMillicores uint64, peakMemoryUsageBytes uint64) *kurtosis_core_rpc_api_bindings.ServicePeakUsage {
+	return &kurtosis_core_rpc_api_bindings.ServicePeakUsage{
+		PeakCpuUsageMillicores: peakCpuUsageMillicores,
+		PeakMemoryUsageBytes:   peakMemoryUsageBytes,
+	}
+}
+
+// ==============================================================================================
+//
+//	Stream Service Health Events
+//
+// ==============================================================================================
+
+func NewServiceHealthEvent(serviceName string, health *kurtosis_core_rpc_api_bindings.ServiceHealth) *kurtosis_core_rpc_api_bindings.ServiceHealthEvent {
+	return &kurtosis_core_rpc_api_bindings.ServiceHealthEvent{
+		ServiceName: serviceName,
+		Health:      health,
 	}
 }
 
@@ -446,3 +543,116 @@ func NewConnectServicesArgs(connect kurtosis_core_rpc_api_bindings.Connect) *kur
 func NewConnectServicesResponse() *kurtosis_core_rpc_api_bindings.ConnectServicesResponse {
 	return &kurtosis_core_rpc_api_bindings.ConnectServicesResponse{}
 }
+
+// ==============================================================================================
+//
+//	Secrets
+//
+// ==============================================================================================
+
+func NewSetSecretArgs(name string, value string) *kurtosis_core_rpc_api_bindings.SetSecretArgs {
+	return &kurtosis_core_rpc_api_bindings.SetSecretArgs{
+		Name:  name,
+		Value: value,
+	}
+}
+
+func NewListSecretsResponse(secretNames []string) *kurtosis_core_rpc_api_bindings.ListSecretsResponse {
+	return &kurtosis_core_rpc_api_bindings.ListSecretsResponse{
+		SecretNames: secretNames,
+	}
+}
+
+func NewRemoveSecretArgs(name string) *kurtosis_core_rpc_api_bindings.RemoveSecretArgs {
+	return &kurtosis_core_rpc_api_bindings.RemoveSecretArgs{
+		Name: name,
+	}
+}
+
+// ==============================================================================================
+//
+//	Enclave Activity
+//
+// ==============================================================================================
+
+func NewGetEnclaveActivityResponse(lastActivityTime *timestamppb.Timestamp) *kurtosis_core_rpc_api_bindings.GetEnclaveActivityResponse {
+	return &kurtosis_core_rpc_api_bindings.GetEnclaveActivityResponse{
+		LastActivityTime: lastActivityTime,
+	}
+}
+
+// ==============================================================================================
+//
+//	Enclave Resource Usage
+//
+// ==============================================================================================
+
+func NewGetEnclaveResourceUsageResponse(
+	serviceCount uint32,
+	reservedCpuMillicores uint64,
+	reservedMemoryMegabytes uint64,
+	filesArtifactsStorageBytes uint64,
+) *kurtosis_core_rpc_api_bindings.GetEnclaveResourceUsageResponse {
+	return &kurtosis_core_rpc_api_bindings.GetEnclaveResourceUsageResponse{
+		ServiceCount:               serviceCount,
+		ReservedCpuMillicores:      reservedCpuMillicores,
+		ReservedMemoryMegabytes:    reservedMemoryMegabytes,
+		FilesArtifactsStorageBytes: filesArtifactsStorageBytes,
+	}
+}
+
+// ==============================================================================================
+//
+//	Enclave Events
+//
+// ==============================================================================================
+
+func NewGetEnclaveEventsArgs(afterSequenceNumber uint64) *kurtosis_core_rpc_api_bindings.GetEnclaveEventsArgs {
+	return &kurtosis_core_rpc_api_bindings.GetEnclaveEventsArgs{
+		AfterSequenceNumber: afterSequenceNumber,
+	}
+}
+
+func NewGetEnclaveEventsResponse(
+	events []*kurtosis_core_rpc_api_bindings.EnclaveEvent,
+	eventLogUuid string,
+	latestSequenceNumber uint64,
+) *kurtosis_core_rpc_api_bindings.GetEnclaveEventsResponse {
+	return &kurtosis_core_rpc_api_bindings.GetEnclaveEventsResponse{
+		Events:               events,
+		EventLogUuid:         eventLogUuid,
+		LatestSequenceNumber: latestSequenceNumber,
+	}
+}
+
+// ==============================================================================================
+//
+//	Starlark Run Trace
+//
+// ==============================================================================================
+
+func NewGetStarlarkRunTraceResponse(spans []*kurtosis_core_rpc_api_bindings.StarlarkRunSpan) *kurtosis_core_rpc_api_bindings.GetStarlarkRunTraceResponse {
+	return &kurtosis_core_rpc_api_bindings.GetStarlarkRunTraceResponse{
+		Spans: spans,
+	}
+}
+
+func NewStarlarkRunSpan(
+	spanId string,
+	parentSpanId string,
+	name string,
+	startTime *timestamppb.Timestamp,
+	endTime *timestamppb.Timestamp,
+	attributes map[string]string,
+	errorMsg string,
+) *kurtosis_core_rpc_api_bindings.StarlarkRunSpan {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunSpan{
+		SpanId:       spanId,
+		ParentSpanId: parentSpanId,
+		Name:         name,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		Attributes:   attributes,
+		Error:        errorMsg,
+	}
+}
@@ -413,6 +413,47 @@ func (enclaveCtx *EnclaveContext) GetServices() (map[services.ServiceName]servic
 	return serviceInfos, nil
 }
 
+// GetServiceStats samples the resource usage of the services with the given identifiers, or of all the services of the
+// enclave if there are none, and returns it along with their peak usage over the last Starlark run
+func (enclaveCtx *EnclaveContext) GetServiceStats(ctx context.Context, serviceIdentifiers map[string]bool) (*kurtosis_core_rpc_api_bindings.GetServiceStatsResponse, error) {
+	getServiceStatsArgs := binding_constructors.NewGetServiceStatsArgs(serviceIdentifiers)
+	response, err := enclaveCtx.client.GetServiceStats(ctx, getServiceStatsArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the stats of services '%v'", serviceIdentifiers)
+	}
+	return response, nil
+}
+
+// StreamServiceHealthEvents returns a channel receiving the current health of every service of the enclave, followed
+// by an event every time the health of a service changes. The channel is closed once the context is cancelled.
+func (enclaveCtx *EnclaveContext) StreamServiceHealthEvents(ctx context.Context) (chan *kurtosis_core_rpc_api_bindings.ServiceHealthEvent, error) {
+	stream, err := enclaveCtx.client.StreamServiceHealthEvents(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred streaming the health events of the services")
+	}
+	serviceHealthEventChan := make(chan *kurtosis_core_rpc_api_bindings.ServiceHealthEvent)
+	go func() {
+		defer close(serviceHealthEventChan)
+		for {
+			serviceHealthEvent, err := stream.Recv()
+			if err == io.EOF {
+				logrus.Debugf("Successfully reached the end of the service health events stream. Closing.")
+				return
+			}
+			if err != nil {
+				logrus.Debugf("The service health events stream ended, the client might have cancelled it\n%v", err.Error())
+				return
+			}
+			select {
+			case serviceHealthEventChan <- serviceHealthEvent:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return serviceHealthEventChan, nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk#uploadfilesstring-pathtoupload-string-artifactname
 func (enclaveCtx *EnclaveContext) UploadFiles(pathToUpload string, artifactName string) (services.FilesArtifactUUID, services.FileArtifactName, error) {
 	content, contentSize, _, err := path_compression.CompressPath(pathToUpload, enforceMaxFileSizeLimit)
@@ -531,6 +572,84 @@ func (enclaveCtx *EnclaveContext) GetStarlarkRun(ctx context.Context) (*kurtosis
 	return response, nil
 }
 
+// GetStarlarkRunTrace returns the spans of the trace of the latest Starlark run, with the time taken by every
+// instruction and the image pulls, container creations and ready conditions they did
+func (enclaveCtx *EnclaveContext) GetStarlarkRunTrace(ctx context.Context) ([]*kurtosis_core_rpc_api_bindings.StarlarkRunSpan, error) {
+	response, err := enclaveCtx.client.GetStarlarkRunTrace(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the trace of the last starlark run")
+	}
+	return response.GetSpans(), nil
+}
+
+// GetEnclaveSnapshotInfo returns what the API container knows about the enclave that is needed to snapshot it: the
+// serialized plan of the instructions executed so far and the persistent directories of its services
+func (enclaveCtx *EnclaveContext) GetEnclaveSnapshotInfo(ctx context.Context) (*kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotInfoResponse, error) {
+	response, err := enclaveCtx.client.GetEnclaveSnapshotInfo(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the enclave snapshot info")
+	}
+	return response, nil
+}
+
+// RestoreEnclaveSnapshot replays the serialized enclave plan of a snapshot in this enclave, which must be empty. The
+// files artifacts of the snapshot must have been uploaded beforehand, and persistentDirectoryFilesArtifacts maps each
+// persistent key to the name of the files artifact holding the content to seed the persistent directory with.
+// serviceImages maps service names to the digest-pinned images they run instead of the images referenced in the plan
+func (enclaveCtx *EnclaveContext) RestoreEnclaveSnapshot(
+	ctx context.Context,
+	serializedEnclavePlan string,
+	persistentDirectoryFilesArtifacts map[string]string,
+	serviceImages map[string]string,
+	parallelism int32,
+) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
+	restoreEnclaveSnapshotArgs := &kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotArgs{
+		SerializedEnclavePlan:             serializedEnclavePlan,
+		PersistentDirectoryFilesArtifacts: persistentDirectoryFilesArtifacts,
+		Parallelism:                       &parallelism,
+		ServiceImages:                     serviceImages,
+	}
+	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+
+	stream, err := enclaveCtx.client.RestoreEnclaveSnapshot(ctxWithCancel, restoreEnclaveSnapshotArgs)
+	if err != nil {
+		cancelCtxFunc() // manually call the cancel function as something went wrong
+		return nil, nil, stacktrace.Propagate(err, "Unexpected error happened restoring the enclave snapshot.")
+	}
+
+	go runReceiveStarlarkResponseLineRoutine(cancelCtxFunc, stream, starlarkResponseLineChan)
+	return starlarkResponseLineChan, cancelCtxFunc, nil
+}
+
+// SetSecret creates the secret, or replaces its value if it already exists. Services reference the secret through its
+// name, so its value never ends up in the enclave plan or in the service info
+func (enclaveCtx *EnclaveContext) SetSecret(ctx context.Context, name string, value string) error {
+	args := binding_constructors.NewSetSecretArgs(name, value)
+	if _, err := enclaveCtx.client.SetSecret(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting secret '%v'", name)
+	}
+	return nil
+}
+
+// GetSecretNames returns the sorted names of the secrets of the enclave; secret values can't be read back
+func (enclaveCtx *EnclaveContext) GetSecretNames(ctx context.Context) ([]string, error) {
+	response, err := enclaveCtx.client.ListSecrets(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the secrets")
+	}
+	return response.GetSecretNames(), nil
+}
+
+// RemoveSecret removes the secret; services already using it keep the value they were started with
+func (enclaveCtx *EnclaveContext) RemoveSecret(ctx context.Context, name string) error {
+	args := binding_constructors.NewRemoveSecretArgs(name)
+	if _, err := enclaveCtx.client.RemoveSecret(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing secret '%v'", name)
+	}
+	return nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -686,6 +805,7 @@ func (enclaveCtx *EnclaveContext) convertServiceInfoToServiceContext(serviceInfo
 		services.ServiceName(serviceInfo.Name),
 		services.ServiceUUID(serviceInfo.ServiceUuid),
 		serviceInfo.GetPrivateIpAddr(),
+		serviceInfo.GetPrivateIpv6Addr(),
 		serviceCtxPrivatePorts,
 		serviceInfo.GetMaybePublicIpAddr(),
 		serviceCtxPublicPorts,
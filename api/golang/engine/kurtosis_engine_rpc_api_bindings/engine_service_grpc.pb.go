@@ -21,13 +21,17 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	EngineService_GetEngineInfo_FullMethodName                              = "/engine_api.EngineService/GetEngineInfo"
+	EngineService_GetResourceQuotaUsage_FullMethodName                      = "/engine_api.EngineService/GetResourceQuotaUsage"
 	EngineService_CreateEnclave_FullMethodName                              = "/engine_api.EngineService/CreateEnclave"
 	EngineService_GetEnclaves_FullMethodName                                = "/engine_api.EngineService/GetEnclaves"
 	EngineService_GetExistingAndHistoricalEnclaveIdentifiers_FullMethodName = "/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers"
 	EngineService_StopEnclave_FullMethodName                                = "/engine_api.EngineService/StopEnclave"
+	EngineService_StartEnclave_FullMethodName                               = "/engine_api.EngineService/StartEnclave"
+	EngineService_ExtendEnclave_FullMethodName                              = "/engine_api.EngineService/ExtendEnclave"
 	EngineService_DestroyEnclave_FullMethodName                             = "/engine_api.EngineService/DestroyEnclave"
 	EngineService_Clean_FullMethodName                                      = "/engine_api.EngineService/Clean"
 	EngineService_GetServiceLogs_FullMethodName                             = "/engine_api.EngineService/GetServiceLogs"
+	EngineService_StreamEngineEvents_FullMethodName                         = "/engine_api.EngineService/StreamEngineEvents"
 )
 
 // EngineServiceClient is the client API for EngineService service.
@@ -36,6 +40,8 @@ const (
 type EngineServiceClient interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
 	GetEngineInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEngineInfoResponse, error)
+	// Gets the resource quotas set on the engine, and how much of them the enclaves they apply to take
+	GetResourceQuotaUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetResourceQuotaUsageResponse, error)
 	// ==============================================================================================
 	//
 	//	Enclave Management
@@ -44,17 +50,28 @@ type EngineServiceClient interface {
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(ctx context.Context, in *CreateEnclaveArgs, opts ...grpc.CallOption) (*CreateEnclaveResponse, error)
 	// Returns information about the existing enclaves
-	GetEnclaves(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclavesResponse, error)
+	GetEnclaves(ctx context.Context, in *GetEnclavesArgs, opts ...grpc.CallOption) (*GetEnclavesResponse, error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(ctx context.Context, in *StopEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Starts a stopped enclave again, with the user services which were running when it got stopped
+	StartEnclave(ctx context.Context, in *StartEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Pushes back the expiry of an enclave created with a TTL or an idle timeout
+	ExtendEnclave(ctx context.Context, in *ExtendEnclaveArgs, opts ...grpc.CallOption) (*ExtendEnclaveResponse, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Gets rid of old enclaves
 	Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(ctx context.Context, in *GetServiceLogsArgs, opts ...grpc.CallOption) (EngineService_GetServiceLogsClient, error)
+	// ==============================================================================================
+	//
+	//	Engine Events
+	//
+	// ==============================================================================================
+	// Streams the events matching the filter as they happen in the engine and its enclaves, until the client closes the stream
+	StreamEngineEvents(ctx context.Context, in *StreamEngineEventsArgs, opts ...grpc.CallOption) (EngineService_StreamEngineEventsClient, error)
 }
 
 type engineServiceClient struct {
@@ -74,6 +91,15 @@ func (c *engineServiceClient) GetEngineInfo(ctx context.Context, in *emptypb.Emp
 	return out, nil
 }
 
+func (c *engineServiceClient) GetResourceQuotaUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetResourceQuotaUsageResponse, error) {
+	out := new(GetResourceQuotaUsageResponse)
+	err := c.cc.Invoke(ctx, EngineService_GetResourceQuotaUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *engineServiceClient) CreateEnclave(ctx context.Context, in *CreateEnclaveArgs, opts ...grpc.CallOption) (*CreateEnclaveResponse, error) {
 	out := new(CreateEnclaveResponse)
 	err := c.cc.Invoke(ctx, EngineService_CreateEnclave_FullMethodName, in, out, opts...)
@@ -83,7 +109,7 @@ func (c *engineServiceClient) CreateEnclave(ctx context.Context, in *CreateEncla
 	return out, nil
 }
 
-func (c *engineServiceClient) GetEnclaves(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclavesResponse, error) {
+func (c *engineServiceClient) GetEnclaves(ctx context.Context, in *GetEnclavesArgs, opts ...grpc.CallOption) (*GetEnclavesResponse, error) {
 	out := new(GetEnclavesResponse)
 	err := c.cc.Invoke(ctx, EngineService_GetEnclaves_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -110,6 +136,24 @@ func (c *engineServiceClient) StopEnclave(ctx context.Context, in *StopEnclaveAr
 	return out, nil
 }
 
+func (c *engineServiceClient) StartEnclave(ctx context.Context, in *StartEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_StartEnclave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) ExtendEnclave(ctx context.Context, in *ExtendEnclaveArgs, opts ...grpc.CallOption) (*ExtendEnclaveResponse, error) {
+	out := new(ExtendEnclaveResponse)
+	err := c.cc.Invoke(ctx, EngineService_ExtendEnclave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *engineServiceClient) DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, EngineService_DestroyEnclave_FullMethodName, in, out, opts...)
@@ -160,12 +204,46 @@ func (x *engineServiceGetServiceLogsClient) Recv() (*GetServiceLogsResponse, err
 	return m, nil
 }
 
+func (c *engineServiceClient) StreamEngineEvents(ctx context.Context, in *StreamEngineEventsArgs, opts ...grpc.CallOption) (EngineService_StreamEngineEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[1], EngineService_StreamEngineEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &engineServiceStreamEngineEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EngineService_StreamEngineEventsClient interface {
+	Recv() (*EngineEvent, error)
+	grpc.ClientStream
+}
+
+type engineServiceStreamEngineEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *engineServiceStreamEngineEventsClient) Recv() (*EngineEvent, error) {
+	m := new(EngineEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // EngineServiceServer is the server API for EngineService service.
 // All implementations should embed UnimplementedEngineServiceServer
 // for forward compatibility
 type EngineServiceServer interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
 	GetEngineInfo(context.Context, *emptypb.Empty) (*GetEngineInfoResponse, error)
+	// Gets the resource quotas set on the engine, and how much of them the enclaves they apply to take
+	GetResourceQuotaUsage(context.Context, *emptypb.Empty) (*GetResourceQuotaUsageResponse, error)
 	// ==============================================================================================
 	//
 	//	Enclave Management
@@ -174,17 +252,28 @@ type EngineServiceServer interface {
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(context.Context, *CreateEnclaveArgs) (*CreateEnclaveResponse, error)
 	// Returns information about the existing enclaves
-	GetEnclaves(context.Context, *emptypb.Empty) (*GetEnclavesResponse, error)
+	GetEnclaves(context.Context, *GetEnclavesArgs) (*GetEnclavesResponse, error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error)
+	// Starts a stopped enclave again, with the user services which were running when it got stopped
+	StartEnclave(context.Context, *StartEnclaveArgs) (*emptypb.Empty, error)
+	// Pushes back the expiry of an enclave created with a TTL or an idle timeout
+	ExtendEnclave(context.Context, *ExtendEnclaveArgs) (*ExtendEnclaveResponse, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *CleanArgs) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error
+	// ==============================================================================================
+	//
+	//	Engine Events
+	//
+	// ==============================================================================================
+	// Streams the events matching the filter as they happen in the engine and its enclaves, until the client closes the stream
+	StreamEngineEvents(*StreamEngineEventsArgs, EngineService_StreamEngineEventsServer) error
 }
 
 // UnimplementedEngineServiceServer should be embedded to have forward compatible implementations.
@@ -194,10 +283,13 @@ type UnimplementedEngineServiceServer struct {
 func (UnimplementedEngineServiceServer) GetEngineInfo(context.Context, *emptypb.Empty) (*GetEngineInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEngineInfo not implemented")
 }
+func (UnimplementedEngineServiceServer) GetResourceQuotaUsage(context.Context, *emptypb.Empty) (*GetResourceQuotaUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceQuotaUsage not implemented")
+}
 func (UnimplementedEngineServiceServer) CreateEnclave(context.Context, *CreateEnclaveArgs) (*CreateEnclaveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateEnclave not implemented")
 }
-func (UnimplementedEngineServiceServer) GetEnclaves(context.Context, *emptypb.Empty) (*GetEnclavesResponse, error) {
+func (UnimplementedEngineServiceServer) GetEnclaves(context.Context, *GetEnclavesArgs) (*GetEnclavesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaves not implemented")
 }
 func (UnimplementedEngineServiceServer) GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error) {
@@ -206,6 +298,12 @@ func (UnimplementedEngineServiceServer) GetExistingAndHistoricalEnclaveIdentifie
 func (UnimplementedEngineServiceServer) StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopEnclave not implemented")
 }
+func (UnimplementedEngineServiceServer) StartEnclave(context.Context, *StartEnclaveArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartEnclave not implemented")
+}
+func (UnimplementedEngineServiceServer) ExtendEnclave(context.Context, *ExtendEnclaveArgs) (*ExtendEnclaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendEnclave not implemented")
+}
 func (UnimplementedEngineServiceServer) DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyEnclave not implemented")
 }
@@ -215,6 +313,9 @@ func (UnimplementedEngineServiceServer) Clean(context.Context, *CleanArgs) (*Cle
 func (UnimplementedEngineServiceServer) GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetServiceLogs not implemented")
 }
+func (UnimplementedEngineServiceServer) StreamEngineEvents(*StreamEngineEventsArgs, EngineService_StreamEngineEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEngineEvents not implemented")
+}
 
 // UnsafeEngineServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EngineServiceServer will
@@ -245,6 +346,24 @@ func _EngineService_GetEngineInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_GetResourceQuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetResourceQuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetResourceQuotaUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetResourceQuotaUsage(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EngineService_CreateEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateEnclaveArgs)
 	if err := dec(in); err != nil {
@@ -264,7 +383,7 @@ func _EngineService_CreateEnclave_Handler(srv interface{}, ctx context.Context,
 }
 
 func _EngineService_GetEnclaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(GetEnclavesArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -276,7 +395,7 @@ func _EngineService_GetEnclaves_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: EngineService_GetEnclaves_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EngineServiceServer).GetEnclaves(ctx, req.(*emptypb.Empty))
+		return srv.(EngineServiceServer).GetEnclaves(ctx, req.(*GetEnclavesArgs))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -317,6 +436,42 @@ func _EngineService_StopEnclave_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_StartEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).StartEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_StartEnclave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).StartEnclave(ctx, req.(*StartEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_ExtendEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ExtendEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ExtendEnclave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ExtendEnclave(ctx, req.(*ExtendEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EngineService_DestroyEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DestroyEnclaveArgs)
 	if err := dec(in); err != nil {
@@ -374,6 +529,27 @@ func (x *engineServiceGetServiceLogsServer) Send(m *GetServiceLogsResponse) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _EngineService_StreamEngineEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEngineEventsArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EngineServiceServer).StreamEngineEvents(m, &engineServiceStreamEngineEventsServer{stream})
+}
+
+type EngineService_StreamEngineEventsServer interface {
+	Send(*EngineEvent) error
+	grpc.ServerStream
+}
+
+type engineServiceStreamEngineEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *engineServiceStreamEngineEventsServer) Send(m *EngineEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // EngineService_ServiceDesc is the grpc.ServiceDesc for EngineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -385,6 +561,10 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEngineInfo",
 			Handler:    _EngineService_GetEngineInfo_Handler,
 		},
+		{
+			MethodName: "GetResourceQuotaUsage",
+			Handler:    _EngineService_GetResourceQuotaUsage_Handler,
+		},
 		{
 			MethodName: "CreateEnclave",
 			Handler:    _EngineService_CreateEnclave_Handler,
@@ -401,6 +581,14 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopEnclave",
 			Handler:    _EngineService_StopEnclave_Handler,
 		},
+		{
+			MethodName: "StartEnclave",
+			Handler:    _EngineService_StartEnclave_Handler,
+		},
+		{
+			MethodName: "ExtendEnclave",
+			Handler:    _EngineService_ExtendEnclave_Handler,
+		},
 		{
 			MethodName: "DestroyEnclave",
 			Handler:    _EngineService_DestroyEnclave_Handler,
@@ -416,6 +604,11 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _EngineService_GetServiceLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamEngineEvents",
+			Handler:       _EngineService_StreamEngineEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "engine_service.proto",
 }
@@ -37,6 +37,9 @@ const (
 	// EngineServiceGetEngineInfoProcedure is the fully-qualified name of the EngineService's
 	// GetEngineInfo RPC.
 	EngineServiceGetEngineInfoProcedure = "/engine_api.EngineService/GetEngineInfo"
+	// EngineServiceGetResourceQuotaUsageProcedure is the fully-qualified name of the EngineService's
+	// GetResourceQuotaUsage RPC.
+	EngineServiceGetResourceQuotaUsageProcedure = "/engine_api.EngineService/GetResourceQuotaUsage"
 	// EngineServiceCreateEnclaveProcedure is the fully-qualified name of the EngineService's
 	// CreateEnclave RPC.
 	EngineServiceCreateEnclaveProcedure = "/engine_api.EngineService/CreateEnclave"
@@ -49,6 +52,12 @@ const (
 	// EngineServiceStopEnclaveProcedure is the fully-qualified name of the EngineService's StopEnclave
 	// RPC.
 	EngineServiceStopEnclaveProcedure = "/engine_api.EngineService/StopEnclave"
+	// EngineServiceStartEnclaveProcedure is the fully-qualified name of the EngineService's
+	// StartEnclave RPC.
+	EngineServiceStartEnclaveProcedure = "/engine_api.EngineService/StartEnclave"
+	// EngineServiceExtendEnclaveProcedure is the fully-qualified name of the EngineService's
+	// ExtendEnclave RPC.
+	EngineServiceExtendEnclaveProcedure = "/engine_api.EngineService/ExtendEnclave"
 	// EngineServiceDestroyEnclaveProcedure is the fully-qualified name of the EngineService's
 	// DestroyEnclave RPC.
 	EngineServiceDestroyEnclaveProcedure = "/engine_api.EngineService/DestroyEnclave"
@@ -57,12 +66,17 @@ const (
 	// EngineServiceGetServiceLogsProcedure is the fully-qualified name of the EngineService's
 	// GetServiceLogs RPC.
 	EngineServiceGetServiceLogsProcedure = "/engine_api.EngineService/GetServiceLogs"
+	// EngineServiceStreamEngineEventsProcedure is the fully-qualified name of the EngineService's
+	// StreamEngineEvents RPC.
+	EngineServiceStreamEngineEventsProcedure = "/engine_api.EngineService/StreamEngineEvents"
 )
 
 // EngineServiceClient is a client for the engine_api.EngineService service.
 type EngineServiceClient interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
 	GetEngineInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse], error)
+	// Gets the resource quotas set on the engine, and how much of them the enclaves they apply to take
+	GetResourceQuotaUsage(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetResourceQuotaUsageResponse], error)
 	// ==============================================================================================
 	//
 	//	Enclave Management
@@ -71,17 +85,28 @@ type EngineServiceClient interface {
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error)
 	// Returns information about the existing enclaves
-	GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
+	GetEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Starts a stopped enclave again, with the user services which were running when it got stopped
+	StartEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Pushes back the expiry of an enclave created with a TTL or an idle timeout
+	ExtendEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse], error)
+	// ==============================================================================================
+	//
+	//	Engine Events
+	//
+	// ==============================================================================================
+	// Streams the events matching the filter as they happen in the engine and its enclaves, until the client closes the stream
+	StreamEngineEvents(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StreamEngineEventsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.EngineEvent], error)
 }
 
 // NewEngineServiceClient constructs a client for the engine_api.EngineService service. By default,
@@ -99,12 +124,17 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceGetEngineInfoProcedure,
 			opts...,
 		),
+		getResourceQuotaUsage: connect.NewClient[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetResourceQuotaUsageResponse](
+			httpClient,
+			baseURL+EngineServiceGetResourceQuotaUsageProcedure,
+			opts...,
+		),
 		createEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs, kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse](
 			httpClient,
 			baseURL+EngineServiceCreateEnclaveProcedure,
 			opts...,
 		),
-		getEnclaves: connect.NewClient[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse](
+		getEnclaves: connect.NewClient[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse](
 			httpClient,
 			baseURL+EngineServiceGetEnclavesProcedure,
 			opts...,
@@ -119,6 +149,16 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceStopEnclaveProcedure,
 			opts...,
 		),
+		startEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs, emptypb.Empty](
+			httpClient,
+			baseURL+EngineServiceStartEnclaveProcedure,
+			opts...,
+		),
+		extendEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs, kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse](
+			httpClient,
+			baseURL+EngineServiceExtendEnclaveProcedure,
+			opts...,
+		),
 		destroyEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs, emptypb.Empty](
 			httpClient,
 			baseURL+EngineServiceDestroyEnclaveProcedure,
@@ -134,19 +174,28 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceGetServiceLogsProcedure,
 			opts...,
 		),
+		streamEngineEvents: connect.NewClient[kurtosis_engine_rpc_api_bindings.StreamEngineEventsArgs, kurtosis_engine_rpc_api_bindings.EngineEvent](
+			httpClient,
+			baseURL+EngineServiceStreamEngineEventsProcedure,
+			opts...,
+		),
 	}
 }
 
 // engineServiceClient implements EngineServiceClient.
 type engineServiceClient struct {
 	getEngineInfo                              *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse]
+	getResourceQuotaUsage                      *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetResourceQuotaUsageResponse]
 	createEnclave                              *connect.Client[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs, kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse]
-	getEnclaves                                *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
+	getEnclaves                                *connect.Client[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
 	getExistingAndHistoricalEnclaveIdentifiers *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse]
 	stopEnclave                                *connect.Client[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs, emptypb.Empty]
+	startEnclave                               *connect.Client[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs, emptypb.Empty]
+	extendEnclave                              *connect.Client[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs, kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse]
 	destroyEnclave                             *connect.Client[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs, emptypb.Empty]
 	clean                                      *connect.Client[kurtosis_engine_rpc_api_bindings.CleanArgs, kurtosis_engine_rpc_api_bindings.CleanResponse]
 	getServiceLogs                             *connect.Client[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]
+	streamEngineEvents                         *connect.Client[kurtosis_engine_rpc_api_bindings.StreamEngineEventsArgs, kurtosis_engine_rpc_api_bindings.EngineEvent]
 }
 
 // GetEngineInfo calls engine_api.EngineService.GetEngineInfo.
@@ -154,13 +203,18 @@ func (c *engineServiceClient) GetEngineInfo(ctx context.Context, req *connect.Re
 	return c.getEngineInfo.CallUnary(ctx, req)
 }
 
+// GetResourceQuotaUsage calls engine_api.EngineService.GetResourceQuotaUsage.
+func (c *engineServiceClient) GetResourceQuotaUsage(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetResourceQuotaUsageResponse], error) {
+	return c.getResourceQuotaUsage.CallUnary(ctx, req)
+}
+
 // CreateEnclave calls engine_api.EngineService.CreateEnclave.
 func (c *engineServiceClient) CreateEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error) {
 	return c.createEnclave.CallUnary(ctx, req)
 }
 
 // GetEnclaves calls engine_api.EngineService.GetEnclaves.
-func (c *engineServiceClient) GetEnclaves(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
+func (c *engineServiceClient) GetEnclaves(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
 	return c.getEnclaves.CallUnary(ctx, req)
 }
 
@@ -175,6 +229,16 @@ func (c *engineServiceClient) StopEnclave(ctx context.Context, req *connect.Requ
 	return c.stopEnclave.CallUnary(ctx, req)
 }
 
+// StartEnclave calls engine_api.EngineService.StartEnclave.
+func (c *engineServiceClient) StartEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.startEnclave.CallUnary(ctx, req)
+}
+
+// ExtendEnclave calls engine_api.EngineService.ExtendEnclave.
+func (c *engineServiceClient) ExtendEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error) {
+	return c.extendEnclave.CallUnary(ctx, req)
+}
+
 // DestroyEnclave calls engine_api.EngineService.DestroyEnclave.
 func (c *engineServiceClient) DestroyEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
 	return c.destroyEnclave.CallUnary(ctx, req)
@@ -190,10 +254,17 @@ func (c *engineServiceClient) GetServiceLogs(ctx context.Context, req *connect.R
 	return c.getServiceLogs.CallServerStream(ctx, req)
 }
 
+// StreamEngineEvents calls engine_api.EngineService.StreamEngineEvents.
+func (c *engineServiceClient) StreamEngineEvents(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.StreamEngineEventsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.EngineEvent], error) {
+	return c.streamEngineEvents.CallServerStream(ctx, req)
+}
+
 // EngineServiceHandler is an implementation of the engine_api.EngineService service.
 type EngineServiceHandler interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
 	GetEngineInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse], error)
+	// Gets the resource quotas set on the engine, and how much of them the enclaves they apply to take
+	GetResourceQuotaUsage(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetResourceQuotaUsageResponse], error)
 	// ==============================================================================================
 	//
 	//	Enclave Management
@@ -202,17 +273,28 @@ type EngineServiceHandler interface {
 	// Creates a new Kurtosis Enclave
 	CreateEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error)
 	// Returns information about the existing enclaves
-	GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
+	GetEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error)
 	// Returns information about all existing & historical enclaves
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Starts a stopped enclave again, with the user services which were running when it got stopped
+	StartEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Pushes back the expiry of an enclave created with a TTL or an idle timeout
+	ExtendEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error
+	// ==============================================================================================
+	//
+	//	Engine Events
+	//
+	// ==============================================================================================
+	// Streams the events matching the filter as they happen in the engine and its enclaves, until the client closes the stream
+	StreamEngineEvents(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StreamEngineEventsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EngineEvent]) error
 }
 
 // NewEngineServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -226,6 +308,11 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.GetEngineInfo,
 		opts...,
 	)
+	engineServiceGetResourceQuotaUsageHandler := connect.NewUnaryHandler(
+		EngineServiceGetResourceQuotaUsageProcedure,
+		svc.GetResourceQuotaUsage,
+		opts...,
+	)
 	engineServiceCreateEnclaveHandler := connect.NewUnaryHandler(
 		EngineServiceCreateEnclaveProcedure,
 		svc.CreateEnclave,
@@ -246,6 +333,16 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.StopEnclave,
 		opts...,
 	)
+	engineServiceStartEnclaveHandler := connect.NewUnaryHandler(
+		EngineServiceStartEnclaveProcedure,
+		svc.StartEnclave,
+		opts...,
+	)
+	engineServiceExtendEnclaveHandler := connect.NewUnaryHandler(
+		EngineServiceExtendEnclaveProcedure,
+		svc.ExtendEnclave,
+		opts...,
+	)
 	engineServiceDestroyEnclaveHandler := connect.NewUnaryHandler(
 		EngineServiceDestroyEnclaveProcedure,
 		svc.DestroyEnclave,
@@ -261,10 +358,17 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.GetServiceLogs,
 		opts...,
 	)
+	engineServiceStreamEngineEventsHandler := connect.NewServerStreamHandler(
+		EngineServiceStreamEngineEventsProcedure,
+		svc.StreamEngineEvents,
+		opts...,
+	)
 	return "/engine_api.EngineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EngineServiceGetEngineInfoProcedure:
 			engineServiceGetEngineInfoHandler.ServeHTTP(w, r)
+		case EngineServiceGetResourceQuotaUsageProcedure:
+			engineServiceGetResourceQuotaUsageHandler.ServeHTTP(w, r)
 		case EngineServiceCreateEnclaveProcedure:
 			engineServiceCreateEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceGetEnclavesProcedure:
@@ -273,12 +377,18 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 			engineServiceGetExistingAndHistoricalEnclaveIdentifiersHandler.ServeHTTP(w, r)
 		case EngineServiceStopEnclaveProcedure:
 			engineServiceStopEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceStartEnclaveProcedure:
+			engineServiceStartEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceExtendEnclaveProcedure:
+			engineServiceExtendEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceDestroyEnclaveProcedure:
 			engineServiceDestroyEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceCleanProcedure:
 			engineServiceCleanHandler.ServeHTTP(w, r)
 		case EngineServiceGetServiceLogsProcedure:
 			engineServiceGetServiceLogsHandler.ServeHTTP(w, r)
+		case EngineServiceStreamEngineEventsProcedure:
+			engineServiceStreamEngineEventsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -292,11 +402,15 @@ func (UnimplementedEngineServiceHandler) GetEngineInfo(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetEngineInfo is not implemented"))
 }
 
+func (UnimplementedEngineServiceHandler) GetResourceQuotaUsage(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetResourceQuotaUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetResourceQuotaUsage is not implemented"))
+}
+
 func (UnimplementedEngineServiceHandler) CreateEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.CreateEnclave is not implemented"))
 }
 
-func (UnimplementedEngineServiceHandler) GetEnclaves(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
+func (UnimplementedEngineServiceHandler) GetEnclaves(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetEnclaves is not implemented"))
 }
 
@@ -308,6 +422,14 @@ func (UnimplementedEngineServiceHandler) StopEnclave(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.StopEnclave is not implemented"))
 }
 
+func (UnimplementedEngineServiceHandler) StartEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.StartEnclave is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) ExtendEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.ExtendEnclave is not implemented"))
+}
+
 func (UnimplementedEngineServiceHandler) DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.DestroyEnclave is not implemented"))
 }
@@ -319,3 +441,7 @@ func (UnimplementedEngineServiceHandler) Clean(context.Context, *connect.Request
 func (UnimplementedEngineServiceHandler) GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetServiceLogs is not implemented"))
 }
+
+func (UnimplementedEngineServiceHandler) StreamEngineEvents(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StreamEngineEventsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EngineEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.StreamEngineEvents is not implemented"))
+}
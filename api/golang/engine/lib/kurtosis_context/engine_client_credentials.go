@@ -0,0 +1,118 @@
+package kurtosis_context
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// API token sent to the engine as a bearer token; can also be an OIDC JWT
+	EngineApiTokenEnvVar = "KURTOSIS_ENGINE_API_TOKEN"
+
+	// PEM files used to talk to an engine serving over TLS; the client certificate and key are only needed for mutual TLS
+	EngineTlsCaCertFilepathEnvVar     = "KURTOSIS_ENGINE_TLS_CA_CERT_FILEPATH"
+	EngineTlsClientCertFilepathEnvVar = "KURTOSIS_ENGINE_TLS_CLIENT_CERT_FILEPATH"
+	EngineTlsClientKeyFilepathEnvVar  = "KURTOSIS_ENGINE_TLS_CLIENT_KEY_FILEPATH"
+
+	authorizationMetadataKey = "authorization"
+	bearerTokenPrefix        = "Bearer "
+)
+
+// GetEngineClientDialOptions returns the gRPC dial options to connect to the engine with the credentials set in the
+// environment, falling back to an insecure connection with no credentials when none are set
+func GetEngineClientDialOptions() ([]grpc.DialOption, error) {
+	transportCredentials, err := getEngineTransportCredentials()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building the engine transport credentials")
+	}
+	dialOptions := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCredentials),
+	}
+	if apiToken := os.Getenv(EngineApiTokenEnvVar); apiToken != "" {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(&bearerTokenCredentials{token: apiToken}))
+	}
+	return dialOptions, nil
+}
+
+// GetApiContainerClientDialOptions returns the gRPC dial options to connect to the API container of the enclave, sending
+// the access token the engine issued with the enclave info when the engine checks its callers
+func GetApiContainerClientDialOptions(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) []grpc.DialOption {
+	// TODO SECURITY: use HTTPS!
+	dialOptions := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	if accessToken := enclaveInfo.GetApiContainerAccessToken(); accessToken != "" {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(&bearerTokenCredentials{token: accessToken}))
+	}
+	return dialOptions
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func getEngineTransportCredentials() (credentials.TransportCredentials, error) {
+	caCertFilepath := os.Getenv(EngineTlsCaCertFilepathEnvVar)
+	clientCertFilepath := os.Getenv(EngineTlsClientCertFilepathEnvVar)
+	clientKeyFilepath := os.Getenv(EngineTlsClientKeyFilepathEnvVar)
+	if caCertFilepath == "" && clientCertFilepath == "" && clientKeyFilepath == "" {
+		return insecure.NewCredentials(), nil
+	}
+	if caCertFilepath == "" {
+		return nil, stacktrace.NewError("Connecting to the engine over TLS requires '%v' to be set", EngineTlsCaCertFilepathEnvVar)
+	}
+	if (clientCertFilepath == "") != (clientKeyFilepath == "") {
+		return nil, stacktrace.NewError("'%v' and '%v' must be set together", EngineTlsClientCertFilepathEnvVar, EngineTlsClientKeyFilepathEnvVar)
+	}
+
+	caCertPem, err := os.ReadFile(caCertFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the engine CA certificate at '%v'", caCertFilepath)
+	}
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCertPem) {
+		return nil, stacktrace.NewError("No valid PEM certificate was found in the engine CA certificate at '%v'", caCertFilepath)
+	}
+	// The client certificate is optional, as the clients authenticating with a token only need to trust the engine
+	var clientCerts []tls.Certificate
+	if clientCertFilepath != "" {
+		clientCert, err := tls.LoadX509KeyPair(clientCertFilepath, clientKeyFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred loading the engine client certificate '%v' and key '%v'", clientCertFilepath, clientKeyFilepath)
+		}
+		clientCerts = append(clientCerts, clientCert)
+	}
+	// nolint:exhaustruct
+	tlsConfig := &tls.Config{
+		RootCAs:      caCertPool,
+		Certificates: clientCerts,
+		MinVersion:   tls.VersionTLS12,
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// bearerTokenCredentials sends the API token as a bearer token on every RPC
+type bearerTokenCredentials struct {
+	token string
+}
+
+func (creds *bearerTokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{
+		authorizationMetadataKey: fmt.Sprintf("%v%v", bearerTokenPrefix, creds.token),
+	}, nil
+}
+
+// The engine listens on the loopback interface by default, so the token is allowed over plaintext connections
+func (creds *bearerTokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	portal_constructors "github.com/kurtosis-tech/kurtosis-portal/api/golang/constructors"
@@ -18,7 +19,6 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -34,6 +34,8 @@ const (
 
 	serviceLogsStreamContentChanBufferSize = 5
 
+	engineEventsChanBufferSize = 20
+
 	grpcStreamCancelContextErrorMessage = "rpc error: code = Canceled desc = context canceled"
 
 	validUuidMatchesAllowed = 1
@@ -71,8 +73,11 @@ func NewKurtosisContextFromLocalEngine() (*KurtosisContext, error) {
 	ctx := context.Background()
 	kurtosisEngineSocketStr := fmt.Sprintf("%v:%v", localHostIPAddressStr, DefaultGrpcEngineServerPortNum)
 
-	// TODO SECURITY: Use HTTPS to ensure we're connecting to the real Kurtosis API servers
-	conn, err := grpc.Dial(kurtosisEngineSocketStr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	engineDialOptions, err := GetEngineClientDialOptions()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the dial options to connect to the Kurtosis Engine Server")
+	}
+	conn, err := grpc.Dial(kurtosisEngineSocketStr, engineDialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
@@ -125,6 +130,44 @@ func (kurtosisCtx *KurtosisContext) CreateEnclave(ctx context.Context, enclaveNa
 	return enclaveContext, nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk#createenclavewithlabelsstring-enclavename-mapstring-string-labels---enclavecontextenclavecontext-enclavecontext
+func (kurtosisCtx *KurtosisContext) CreateEnclaveWithLabels(ctx context.Context, enclaveName string, labels map[string]string) (*enclaves.EnclaveContext, error) {
+
+	createEnclaveArgs := newCreateEnclaveArgsWithDefaultValues(enclaveName)
+	createEnclaveArgs.Labels = labels
+
+	response, err := kurtosisCtx.engineClient.CreateEnclave(ctx, createEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v' and labels '%+v'", enclaveName, labels)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
+	}
+
+	return enclaveContext, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#createenclavefromtemplatestring-enclavename-string-templatename---enclavecontextenclavecontext-enclavecontext
+func (kurtosisCtx *KurtosisContext) CreateEnclaveFromTemplate(ctx context.Context, enclaveName string, templateName string) (*enclaves.EnclaveContext, error) {
+
+	createEnclaveArgs := newCreateEnclaveArgsWithDefaultValues(enclaveName)
+	createEnclaveArgs.FromTemplate = &templateName
+
+	response, err := kurtosisCtx.engineClient.CreateEnclave(ctx, createEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v' from template '%v'", enclaveName, templateName)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
+	}
+
+	return enclaveContext, nil
+}
+
 func (kurtosisCtx *KurtosisContext) CreateEnclaveWithDebugEnabled(
 	ctx context.Context,
 	enclaveName string,
@@ -197,7 +240,24 @@ func (kurtosisCtx *KurtosisContext) GetEnclaveContext(ctx context.Context, encla
 
 // Docs available at https://docs.kurtosis.com/sdk#getenclaves---enclaves-enclaves
 func (kurtosisCtx *KurtosisContext) GetEnclaves(ctx context.Context) (*Enclaves, error) {
-	response, err := kurtosisCtx.engineClient.GetEnclaves(ctx, &emptypb.Empty{})
+	enclaves, err := kurtosisCtx.getEnclaves(ctx, &kurtosis_engine_rpc_api_bindings.GetEnclavesArgs{LabelSelector: nil})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclaves")
+	}
+	return enclaves, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#getenclavesmatchinglabelselectorstring-labelselector---enclaves-enclaves
+func (kurtosisCtx *KurtosisContext) GetEnclavesMatchingLabelSelector(ctx context.Context, labelSelector string) (*Enclaves, error) {
+	enclaves, err := kurtosisCtx.getEnclaves(ctx, &kurtosis_engine_rpc_api_bindings.GetEnclavesArgs{LabelSelector: &labelSelector})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclaves matching label selector '%v'", labelSelector)
+	}
+	return enclaves, nil
+}
+
+func (kurtosisCtx *KurtosisContext) getEnclaves(ctx context.Context, getEnclavesArgs *kurtosis_engine_rpc_api_bindings.GetEnclavesArgs) (*Enclaves, error) {
+	response, err := kurtosisCtx.engineClient.GetEnclaves(ctx, getEnclavesArgs)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
@@ -268,6 +328,34 @@ func (kurtosisCtx *KurtosisContext) StopEnclave(ctx context.Context, enclaveIden
 	return nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk/#startenclavestring-enclaveidentifier
+func (kurtosisCtx *KurtosisContext) StartEnclave(ctx context.Context, enclaveIdentifier string) error {
+	startEnclaveArgs := &kurtosis_engine_rpc_api_bindings.StartEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+	}
+
+	if _, err := kurtosisCtx.engineClient.StartEnclave(ctx, startEnclaveArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting enclave with identifier '%v'", enclaveIdentifier)
+	}
+
+	return nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#extendenclavestring-enclaveidentifier-duration-extension
+func (kurtosisCtx *KurtosisContext) ExtendEnclave(ctx context.Context, enclaveIdentifier string, extension time.Duration) (time.Time, error) {
+	extendEnclaveArgs := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		ExtensionSeconds:  int64(extension.Seconds()),
+	}
+
+	response, err := kurtosisCtx.engineClient.ExtendEnclave(ctx, extendEnclaveArgs)
+	if err != nil {
+		return time.Time{}, stacktrace.Propagate(err, "An error occurred extending enclave with identifier '%v'", enclaveIdentifier)
+	}
+
+	return response.GetExpiryTime().AsTime(), nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk/#destroyenclavestring-enclaveidentifier
 func (kurtosisCtx *KurtosisContext) DestroyEnclave(ctx context.Context, enclaveIdentifier string) error {
 	destroyEnclaveArgs := &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{
@@ -294,6 +382,16 @@ func (kurtosisCtx *KurtosisContext) Clean(ctx context.Context, shouldCleanAll bo
 	return cleanResponse.RemovedEnclaveNameAndUuids, nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk#getresourcequotausage---resourcequotausage-resourcequotausages
+func (kurtosisCtx *KurtosisContext) GetResourceQuotaUsage(ctx context.Context) ([]*kurtosis_engine_rpc_api_bindings.ResourceQuotaUsage, error) {
+	response, err := kurtosisCtx.engineClient.GetResourceQuotaUsage(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the usage of the engine resource quotas")
+	}
+
+	return response.GetResourceQuotaUsages(), nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk#getservicelogsstring-enclaveidentifier-setserviceuuid-serviceuuids-boolean-shouldfollowlogs-loglinefilter-loglinefilter---servicelogsstreamcontent-servicelogsstreamcontent
 func (kurtosisCtx *KurtosisContext) GetServiceLogs(
 	ctx context.Context,
@@ -351,6 +449,37 @@ func (kurtosisCtx *KurtosisContext) GetServiceLogs(
 	return serviceLogsStreamContentChan, cancelCtxFunc, nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk#streamengineeventsstreamengineeventsargs-args---streamengineevent-engineevents
+func (kurtosisCtx *KurtosisContext) StreamEngineEvents(
+	ctx context.Context,
+	streamEngineEventsArgs *kurtosis_engine_rpc_api_bindings.StreamEngineEventsArgs,
+) (
+	chan *kurtosis_engine_rpc_api_bindings.EngineEvent,
+	func(),
+	error,
+) {
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
+	shouldCancelCtx := true
+	defer func() {
+		if shouldCancelCtx {
+			cancelCtxFunc()
+		}
+	}()
+
+	engineEventsChan := make(chan *kurtosis_engine_rpc_api_bindings.EngineEvent, engineEventsChanBufferSize)
+
+	stream, err := kurtosisCtx.engineClient.StreamEngineEvents(ctxWithCancel, streamEngineEventsArgs)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred streaming the engine events using args '%+v'", streamEngineEventsArgs)
+	}
+
+	go runReceiveEngineEventsFromTheServerRoutine(cancelCtxFunc, engineEventsChan, stream)
+
+	//This is an async operation, so we don't want to cancel the context if the connection is established and data is flowing
+	shouldCancelCtx = false
+	return engineEventsChan, cancelCtxFunc, nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk#getexistingandhistoricalenclaveidentifiers---enclaveidentifiers-enclaveidentifiers
 func (kurtosisCtx *KurtosisContext) GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context) (*EnclaveIdentifiers, error) {
 	historicalEnclaveIdentifiers, err := kurtosisCtx.engineClient.GetExistingAndHistoricalEnclaveIdentifiers(ctx, &emptypb.Empty{})
@@ -406,6 +535,34 @@ func runReceiveStreamLogsFromTheServerRoutine(
 	}
 }
 
+func runReceiveEngineEventsFromTheServerRoutine(
+	cancelCtxFunc context.CancelFunc,
+	engineEventsChan chan *kurtosis_engine_rpc_api_bindings.EngineEvent,
+	stream kurtosis_engine_rpc_api_bindings.EngineService_StreamEngineEventsClient,
+) {
+	defer func() {
+		cancelCtxFunc()
+		close(engineEventsChan)
+	}()
+
+	for {
+		engineEvent, errReceivingStream := stream.Recv()
+		if errReceivingStream == io.EOF {
+			logrus.Debug("Received an 'EOF' error from the engine events GRPC stream")
+			return
+		}
+		if errReceivingStream != nil {
+			if errReceivingStream.Error() == grpcStreamCancelContextErrorMessage {
+				logrus.Debug("Received a 'context canceled' error from the engine events GRPC stream")
+				return
+			}
+			logrus.Errorf("An error occurred receiving the engine events stream. Error:\n%v", errReceivingStream)
+			return
+		}
+		engineEventsChan <- engineEvent
+	}
+}
+
 func newEnclaveContextFromEnclaveInfo(
 	ctx context.Context,
 	portalClient portal_api.KurtosisPortalClientClient,
@@ -456,8 +613,7 @@ func newEnclaveContextFromEnclaveInfo(
 		apiContainerHostMachineInfo.IpOnHostMachine,
 		apiContainerHostMachineInfo.GrpcPortOnHostMachine,
 	)
-	// TODO SECURITY: use HTTPS!
-	apiContainerConn, err := grpc.Dial(apiContainerHostMachineUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	apiContainerConn, err := grpc.Dial(apiContainerHostMachineUrl, GetApiContainerClientDialOptions(enclaveInfo)...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container on host machine URL '%v'", apiContainerHostMachineUrl)
 	}
@@ -52,7 +52,22 @@ const (
 
 // Defines values for EnclaveTargetStatus.
 const (
-	STOP EnclaveTargetStatus = "STOP"
+	START EnclaveTargetStatus = "START"
+	STOP  EnclaveTargetStatus = "STOP"
+)
+
+// Defines values for EngineEventType.
+const (
+	ENCLAVECREATED       EngineEventType = "ENCLAVE_CREATED"
+	ENCLAVEDESTROYED     EngineEventType = "ENCLAVE_DESTROYED"
+	ENCLAVESTARTED       EngineEventType = "ENCLAVE_STARTED"
+	ENCLAVESTOPPED       EngineEventType = "ENCLAVE_STOPPED"
+	FILESARTIFACTCREATED EngineEventType = "FILES_ARTIFACT_CREATED"
+	SERVICECRASHED       EngineEventType = "SERVICE_CRASHED"
+	SERVICESTARTED       EngineEventType = "SERVICE_STARTED"
+	SERVICESTOPPED       EngineEventType = "SERVICE_STOPPED"
+	STARLARKRUNFINISHED  EngineEventType = "STARLARK_RUN_FINISHED"
+	STARLARKRUNSTARTED   EngineEventType = "STARLARK_RUN_STARTED"
 )
 
 // Defines values for HttpMethodAvailability.
@@ -93,6 +108,14 @@ const (
 	RestartPolicyNEVER  RestartPolicy = "NEVER"
 )
 
+// Defines values for ServiceHealthStatus.
+const (
+	ServiceHealthStatusCRASHED   ServiceHealthStatus = "CRASHED"
+	ServiceHealthStatusHEALTHY   ServiceHealthStatus = "HEALTHY"
+	ServiceHealthStatusUNHEALTHY ServiceHealthStatus = "UNHEALTHY"
+	ServiceHealthStatusUNKNOWN   ServiceHealthStatus = "UNKNOWN"
+)
+
 // Defines values for ServiceStatus.
 const (
 	ServiceStatusRUNNING ServiceStatus = "RUNNING"
@@ -146,11 +169,26 @@ type ContainerStatus string
 // CreateEnclave defines model for CreateEnclave.
 type CreateEnclave struct {
 	// ApiContainerLogLevel Enclave log level, defaults to INFO
-	ApiContainerLogLevel     *string                `json:"api_container_log_level,omitempty"`
-	ApiContainerVersionTag   string                 `json:"api_container_version_tag"`
-	EnclaveName              string                 `json:"enclave_name"`
+	ApiContainerLogLevel   *string `json:"api_container_log_level,omitempty"`
+	ApiContainerVersionTag string  `json:"api_container_version_tag"`
+	EnclaveName            string  `json:"enclave_name"`
+
+	// FromTemplate The enclave pool template to create the enclave from: a warm enclave of the template is handed out if the pool has one, otherwise the enclave is created and the packages of the template run in it
+	FromTemplate *string `json:"from_template,omitempty"`
+
+	// IdleTimeoutSeconds The enclave gets destroyed once its API container hasn't served any request for this many seconds; it never expires if unset
+	IdleTimeoutSeconds *int64 `json:"idle_timeout_seconds,omitempty"`
+
+	// IsIpv6Enabled Whether the enclave network should be a dual-stack network, giving every service an IPv6 address as well; defaults to false
+	IsIpv6Enabled *bool `json:"is_ipv6_enabled,omitempty"`
+
+	// Labels Arbitrary key/value labels to attach to the enclave
+	Labels                   *map[string]string     `json:"labels,omitempty"`
 	Mode                     *EnclaveMode           `json:"mode,omitempty"`
 	ShouldApicRunInDebugMode *ApiContainerDebugMode `json:"should_apic_run_in_debug_mode,omitempty"`
+
+	// TtlSeconds The enclave gets destroyed this many seconds after its creation; it never expires if unset
+	TtlSeconds *int64 `json:"ttl_seconds,omitempty"`
 }
 
 // DeletionSummary defines model for DeletionSummary.
@@ -187,6 +225,8 @@ type EnclaveInfo struct {
 	ContainersStatus            EnclaveStatus                       `json:"containers_status"`
 	CreationTime                Timestamp                           `json:"creation_time"`
 	EnclaveUuid                 string                              `json:"enclave_uuid"`
+	ExpiryTime                  *Timestamp                          `json:"expiry_time,omitempty"`
+	Labels                      *map[string]string                  `json:"labels,omitempty"`
 	Mode                        EnclaveMode                         `json:"mode"`
 	Name                        string                              `json:"name"`
 	ShortenedUuid               string                              `json:"shortened_uuid"`
@@ -207,6 +247,37 @@ type EnclaveStatus string
 // EnclaveTargetStatus defines model for EnclaveTargetStatus.
 type EnclaveTargetStatus string
 
+// EngineEvent Something which happened to an enclave or inside of it. The webhooks receive the same object
+type EngineEvent struct {
+	EnclaveLabels *map[string]string `json:"enclave_labels,omitempty"`
+	EnclaveName   string             `json:"enclave_name"`
+	EnclaveUuid   string             `json:"enclave_uuid"`
+	EventUuid     string             `json:"event_uuid"`
+
+	// FilesArtifactName Set on the files artifact events only
+	FilesArtifactName *string `json:"files_artifact_name,omitempty"`
+
+	// IsStarlarkRunSuccessful Set on the Starlark run finished events only
+	IsStarlarkRunSuccessful *bool `json:"is_starlark_run_successful,omitempty"`
+
+	// Message Details of the event, e.g. the error of a failed Starlark run or why a service crashed
+	Message *string `json:"message,omitempty"`
+
+	// ServiceName Set on the service events only
+	ServiceName *string `json:"service_name,omitempty"`
+
+	// StarlarkPackageId Set on the Starlark run events only; empty for the scripts which aren't part of a package
+	StarlarkPackageId *string `json:"starlark_package_id,omitempty"`
+
+	// Text Human-readable summary of the event
+	Text      string          `json:"text"`
+	Timestamp Timestamp       `json:"timestamp"`
+	Type      EngineEventType `json:"type"`
+}
+
+// EngineEventType defines model for EngineEventType.
+type EngineEventType string
+
 // EngineInfo defines model for EngineInfo.
 type EngineInfo struct {
 	EngineVersion string `json:"engine_version"`
@@ -380,6 +451,36 @@ type RunStarlarkScript struct {
 	SerializedScript string                  `json:"serialized_script"`
 }
 
+// ServiceHealth defines model for ServiceHealth.
+type ServiceHealth struct {
+	// ExitCode Exit code of the container of the service, only set when the service crashed
+	ExitCode *int32 `json:"exit_code,omitempty"`
+
+	// LastExitReason Why the container of the service last exited (e.g. OOMKilled), empty if it never exited
+	LastExitReason string `json:"last_exit_reason"`
+
+	// Message Why the service is in its health status, e.g. the last error of its health check
+	Message string `json:"message"`
+
+	// RestartCount Number of times the container of the service was restarted
+	RestartCount int64 `json:"restart_count"`
+
+	// Since When the service got into its health status
+	Since time.Time `json:"since"`
+
+	// Status 0 - UNKNOWN
+	// 1 - HEALTHY
+	// 2 - UNHEALTHY
+	// 3 - CRASHED
+	Status ServiceHealthStatus `json:"status"`
+}
+
+// ServiceHealthStatus 0 - UNKNOWN
+// 1 - HEALTHY
+// 2 - UNHEALTHY
+// 3 - CRASHED
+type ServiceHealthStatus string
+
 // ServiceIdentifiers An service identifier is a collection of uuid, name and shortened uuid
 type ServiceIdentifiers struct {
 	// Name Name of the service
@@ -394,14 +495,19 @@ type ServiceIdentifiers struct {
 
 // ServiceInfo defines model for ServiceInfo.
 type ServiceInfo struct {
-	Container Container `json:"container"`
+	Container Container      `json:"container"`
+	Health    *ServiceHealth `json:"health,omitempty"`
 
 	// Name Name of the service
 	Name string `json:"name"`
 
 	// PrivateIpAddr The IP address of the service inside the enclave
-	PrivateIpAddr string          `json:"private_ip_addr"`
-	PrivatePorts  map[string]Port `json:"private_ports"`
+	PrivateIpAddr string `json:"private_ip_addr"`
+
+	// PrivateIpv6Addr The IPv6 address of the service inside the enclave
+	// NOTE: Will be empty if the enclave doesn't have IPv6 enabled
+	PrivateIpv6Addr *string         `json:"private_ipv6_addr,omitempty"`
+	PrivatePorts    map[string]Port `json:"private_ports"`
 
 	// PublicIpAddr Public IP address *outside* the enclave where the service is reachable
 	// NOTE: Will be empty if the service isn't running, the service didn't define any ports, or the backend doesn't support reporting public service info
@@ -420,12 +526,51 @@ type ServiceInfo struct {
 	ShortenedUuid string `json:"shortened_uuid"`
 }
 
+// ServiceIoStats Bytes transferred by the service since it started
+type ServiceIoStats struct {
+	DiskReadBytes        int64 `json:"disk_read_bytes"`
+	DiskWrittenBytes     int64 `json:"disk_written_bytes"`
+	NetworkReceivedBytes int64 `json:"network_received_bytes"`
+	NetworkSentBytes     int64 `json:"network_sent_bytes"`
+}
+
 // ServiceLogs defines model for ServiceLogs.
 type ServiceLogs struct {
 	NotFoundServiceUuidSet   *[]string           `json:"not_found_service_uuid_set,omitempty"`
 	ServiceLogsByServiceUuid *map[string]LogLine `json:"service_logs_by_service_uuid,omitempty"`
 }
 
+// ServicePeakUsage defines model for ServicePeakUsage.
+type ServicePeakUsage struct {
+	PeakCpuUsageMillicores int64 `json:"peak_cpu_usage_millicores"`
+	PeakMemoryUsageBytes   int64 `json:"peak_memory_usage_bytes"`
+}
+
+// ServiceStats defines model for ServiceStats.
+type ServiceStats struct {
+	CpuUsageMillicores int64 `json:"cpu_usage_millicores"`
+
+	// IoStats Bytes transferred by the service since it started
+	IoStats *ServiceIoStats `json:"io_stats,omitempty"`
+
+	// MemoryLimitBytes Zero if the memory of the service isn't limited
+	MemoryLimitBytes int64     `json:"memory_limit_bytes"`
+	MemoryUsageBytes int64     `json:"memory_usage_bytes"`
+	SampleTime       Timestamp `json:"sample_time"`
+}
+
+// ServiceStatsReport defines model for ServiceStatsReport.
+type ServiceStatsReport struct {
+	// LastRunPeakUsage Service name -> peak usage of the service over the last Starlark run
+	LastRunPeakUsage map[string]ServicePeakUsage `json:"last_run_peak_usage"`
+
+	// ServiceErrors Service name -> why the service couldn't be sampled, e.g. because it's stopped
+	ServiceErrors map[string]string `json:"service_errors"`
+
+	// ServiceStats Service name -> stats of the service
+	ServiceStats map[string]ServiceStats `json:"service_stats"`
+}
+
 // ServiceStatus 0 - STOPPED
 // 1 - RUNNING
 // 2 - UNKNOWN
@@ -593,6 +738,12 @@ type ConjunctiveFilters = []LogLineFilter
 // EnclaveIdentifier defines model for enclave_identifier.
 type EnclaveIdentifier = string
 
+// EventEnclaveIdentifiers defines model for event_enclave_identifiers.
+type EventEnclaveIdentifiers = []string
+
+// EventTypes defines model for event_types.
+type EventTypes = []EngineEventType
+
 // ExpectedResponse defines model for expected_response.
 type ExpectedResponse = string
 
@@ -605,9 +756,15 @@ type HttpMethod = HttpMethodAvailability
 // InitialDelayMilliseconds defines model for initial_delay_milliseconds.
 type InitialDelayMilliseconds = int32
 
+// LabelSelector defines model for label_selector.
+type LabelSelector = string
+
 // NumLogLines defines model for num_log_lines.
 type NumLogLines = int
 
+// OnlyFailedStarlarkRuns defines model for only_failed_starlark_runs.
+type OnlyFailedStarlarkRuns = bool
+
 // PackageId defines model for package_id.
 type PackageId = string
 
@@ -651,6 +808,15 @@ type NotOk = ResponseInfo
 type DeleteEnclavesParams struct {
 	// RemoveAll If true, remove all enclaves. Otherwise only remove stopped enclaves. Default is false
 	RemoveAll *RemoveAll `form:"remove_all,omitempty" json:"remove_all,omitempty"`
+
+	// LabelSelector Only consider the enclaves whose labels match this comma-separated selector, e.g. `team=infra,branch!=main`. Default is every enclave
+	LabelSelector *LabelSelector `form:"label_selector,omitempty" json:"label_selector,omitempty"`
+}
+
+// GetEnclavesParams defines parameters for GetEnclaves.
+type GetEnclavesParams struct {
+	// LabelSelector Only consider the enclaves whose labels match this comma-separated selector, e.g. `team=infra,branch!=main`. Default is every enclave
+	LabelSelector *LabelSelector `form:"label_selector,omitempty" json:"label_selector,omitempty"`
 }
 
 // PostEnclavesEnclaveIdentifierArtifactsLocalFileMultipartBody defines parameters for PostEnclavesEnclaveIdentifierArtifactsLocalFile.
@@ -671,6 +837,12 @@ type GetEnclavesEnclaveIdentifierServicesParams struct {
 	Services *[]string `form:"services,omitempty" json:"services,omitempty"`
 }
 
+// GetEnclavesEnclaveIdentifierServicesStatsParams defines parameters for GetEnclavesEnclaveIdentifierServicesStats.
+type GetEnclavesEnclaveIdentifierServicesStatsParams struct {
+	// Services Select services to sample, all services if empty
+	Services *[]string `form:"services,omitempty" json:"services,omitempty"`
+}
+
 // GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailabilityParams defines parameters for GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailability.
 type GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailabilityParams struct {
 	// HttpMethod The HTTP method used to check availability. Default is GET.
@@ -718,6 +890,21 @@ type PostEnclavesEnclaveIdentifierStarlarkScriptsParams struct {
 	RetrieveLogsAsync *RetrieveLogsAsync `form:"retrieve_logs_async,omitempty" json:"retrieve_logs_async,omitempty"`
 }
 
+// GetEngineEventsParams defines parameters for GetEngineEvents.
+type GetEngineEventsParams struct {
+	// EventTypes Only stream the events of these types. Default is every type
+	EventTypes *EventTypes `form:"event_types,omitempty" json:"event_types,omitempty"`
+
+	// EnclaveIdentifiers Only stream the events of the enclaves with these UUIDs, shortened UUIDs or names. Default is every enclave
+	EnclaveIdentifiers *EventEnclaveIdentifiers `form:"enclave_identifiers,omitempty" json:"enclave_identifiers,omitempty"`
+
+	// LabelSelector Only consider the enclaves whose labels match this comma-separated selector, e.g. `team=infra,branch!=main`. Default is every enclave
+	LabelSelector *LabelSelector `form:"label_selector,omitempty" json:"label_selector,omitempty"`
+
+	// OnlyFailedStarlarkRuns If true, the only Starlark run events streamed are the failed runs. Default is false
+	OnlyFailedStarlarkRuns *OnlyFailedStarlarkRuns `form:"only_failed_starlark_runs,omitempty" json:"only_failed_starlark_runs,omitempty"`
+}
+
 // PostEnclavesJSONRequestBody defines body for PostEnclaves for application/json ContentType.
 type PostEnclavesJSONRequestBody = CreateEnclave
 
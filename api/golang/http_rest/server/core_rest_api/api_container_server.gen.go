@@ -53,6 +53,9 @@ type ServerInterface interface {
 	// Returns information about all existing & historical services
 	// (GET /enclaves/{enclave_identifier}/services/history)
 	GetEnclavesEnclaveIdentifierServicesHistory(ctx echo.Context, enclaveIdentifier EnclaveIdentifier) error
+	// Samples the resource usage of services within the enclave, along with their peak usage over the last Starlark run
+	// (GET /enclaves/{enclave_identifier}/services/stats)
+	GetEnclavesEnclaveIdentifierServicesStats(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, params GetEnclavesEnclaveIdentifierServicesStatsParams) error
 	// Returns detailed information about a specific service
 	// (GET /enclaves/{enclave_identifier}/services/{service_identifier})
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifier(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier) error
@@ -258,6 +261,31 @@ func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierServicesHistory(ctx
 	return err
 }
 
+// GetEnclavesEnclaveIdentifierServicesStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierServicesStats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "enclave_identifier" -------------
+	var enclaveIdentifier EnclaveIdentifier
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enclave_identifier", runtime.ParamLocationPath, ctx.Param("enclave_identifier"), &enclaveIdentifier)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enclave_identifier: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnclavesEnclaveIdentifierServicesStatsParams
+	// ------------- Optional query parameter "services" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "services", ctx.QueryParams(), &params.Services)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter services: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEnclavesEnclaveIdentifierServicesStats(ctx, enclaveIdentifier, params)
+	return err
+}
+
 // GetEnclavesEnclaveIdentifierServicesServiceIdentifier converts echo context to params.
 func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierServicesServiceIdentifier(ctx echo.Context) error {
 	var err error
@@ -516,6 +544,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services", wrapper.GetEnclavesEnclaveIdentifierServices)
 	router.POST(baseURL+"/enclaves/:enclave_identifier/services/connection", wrapper.PostEnclavesEnclaveIdentifierServicesConnection)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services/history", wrapper.GetEnclavesEnclaveIdentifierServicesHistory)
+	router.GET(baseURL+"/enclaves/:enclave_identifier/services/stats", wrapper.GetEnclavesEnclaveIdentifierServicesStats)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services/:service_identifier", wrapper.GetEnclavesEnclaveIdentifierServicesServiceIdentifier)
 	router.POST(baseURL+"/enclaves/:enclave_identifier/services/:service_identifier/command", wrapper.PostEnclavesEnclaveIdentifierServicesServiceIdentifierCommand)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services/:service_identifier/endpoints/:port_number/availability", wrapper.GetEnclavesEnclaveIdentifierServicesServiceIdentifierEndpointsPortNumberAvailability)
@@ -806,6 +835,36 @@ func (response GetEnclavesEnclaveIdentifierServicesHistorydefaultJSONResponse) V
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
+type GetEnclavesEnclaveIdentifierServicesStatsRequestObject struct {
+	EnclaveIdentifier EnclaveIdentifier `json:"enclave_identifier"`
+	Params            GetEnclavesEnclaveIdentifierServicesStatsParams
+}
+
+type GetEnclavesEnclaveIdentifierServicesStatsResponseObject interface {
+	VisitGetEnclavesEnclaveIdentifierServicesStatsResponse(w http.ResponseWriter) error
+}
+
+type GetEnclavesEnclaveIdentifierServicesStats200JSONResponse ServiceStatsReport
+
+func (response GetEnclavesEnclaveIdentifierServicesStats200JSONResponse) VisitGetEnclavesEnclaveIdentifierServicesStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEnclavesEnclaveIdentifierServicesStatsdefaultJSONResponse struct {
+	Body       ResponseInfo
+	StatusCode int
+}
+
+func (response GetEnclavesEnclaveIdentifierServicesStatsdefaultJSONResponse) VisitGetEnclavesEnclaveIdentifierServicesStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
 type GetEnclavesEnclaveIdentifierServicesServiceIdentifierRequestObject struct {
 	EnclaveIdentifier EnclaveIdentifier `json:"enclave_identifier"`
 	ServiceIdentifier ServiceIdentifier `json:"service_identifier"`
@@ -1048,6 +1107,9 @@ type StrictServerInterface interface {
 	// Returns information about all existing & historical services
 	// (GET /enclaves/{enclave_identifier}/services/history)
 	GetEnclavesEnclaveIdentifierServicesHistory(ctx context.Context, request GetEnclavesEnclaveIdentifierServicesHistoryRequestObject) (GetEnclavesEnclaveIdentifierServicesHistoryResponseObject, error)
+	// Samples the resource usage of services within the enclave, along with their peak usage over the last Starlark run
+	// (GET /enclaves/{enclave_identifier}/services/stats)
+	GetEnclavesEnclaveIdentifierServicesStats(ctx context.Context, request GetEnclavesEnclaveIdentifierServicesStatsRequestObject) (GetEnclavesEnclaveIdentifierServicesStatsResponseObject, error)
 	// Returns detailed information about a specific service
 	// (GET /enclaves/{enclave_identifier}/services/{service_identifier})
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifier(ctx context.Context, request GetEnclavesEnclaveIdentifierServicesServiceIdentifierRequestObject) (GetEnclavesEnclaveIdentifierServicesServiceIdentifierResponseObject, error)
@@ -1336,6 +1398,32 @@ func (sh *strictHandler) GetEnclavesEnclaveIdentifierServicesHistory(ctx echo.Co
 	return nil
 }
 
+// GetEnclavesEnclaveIdentifierServicesStats operation middleware
+func (sh *strictHandler) GetEnclavesEnclaveIdentifierServicesStats(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, params GetEnclavesEnclaveIdentifierServicesStatsParams) error {
+	var request GetEnclavesEnclaveIdentifierServicesStatsRequestObject
+
+	request.EnclaveIdentifier = enclaveIdentifier
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEnclavesEnclaveIdentifierServicesStats(ctx.Request().Context(), request.(GetEnclavesEnclaveIdentifierServicesStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEnclavesEnclaveIdentifierServicesStats")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetEnclavesEnclaveIdentifierServicesStatsResponseObject); ok {
+		return validResponse.VisitGetEnclavesEnclaveIdentifierServicesStatsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetEnclavesEnclaveIdentifierServicesServiceIdentifier operation middleware
 func (sh *strictHandler) GetEnclavesEnclaveIdentifierServicesServiceIdentifier(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier) error {
 	var request GetEnclavesEnclaveIdentifierServicesServiceIdentifierRequestObject
@@ -1546,83 +1634,95 @@ func (sh *strictHandler) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx echo.C
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9a3MbuZF/BTV3Vd5NjUlnk0ou+ibLss2KV2JJdHRXK9cYnGmSiEBgAmAoc13671d4",
-	"zYODGQ71srPZ+3BecfDoN7objc7XKOXrnDNgSkZHX6McC7wGBcL8hYUiC5yqhGTAFFkQEPrnDGQqSK4I",
-	"Z9FRNFsB8gMRw2tAXKCiIFkUR0QPyLFaRXGkP0VHwTXjSMC/CiIgi46UKCCOZLqCNdabqW2up0klCFtG",
-	"d3dxBCyleAO9QH38OHkTI7niQgGDDNm/uXAALpBaAXILheEM7HIgmF9ySBVkiQCZcyahDeXEw5HlnDCF",
-	"BKhCMInUiki0wbSA2AyQIDYkBXRLKEVzQGssbiBDWCK8wYTiOQX0A4yWI/QeKOXoigua/TjyiP2rALGt",
-	"YdYCrB+RlVJ5sga14lmY++9nsymyA1AhIUOKo3QF6Y0Hj1CitiP0Bha4oAoRid6dzrrAq29XB+y/BSyi",
-	"o+i/xpXEju1XOX6vVP6zmXJc29FATxhRBNMkA4q3yZpQSiSknGUyjAwr1nMQWkTqYzVKt5goVDBFKIIv",
-	"kBaKsKVhz4IIqSwVUkxpB149gNTRXHCxxsqMV3/6KYo9QwhTsARhcMpxeoOXWjbDOLjvqJJdpFZYlfJj",
-	"wYcODa2tfpjEm2U6AFIrr3VemL2QjNBEoXUhFXuhkFRYaDjVqkZZSbFcjdBbLhBhUmGWAvrslhmvAFO1",
-	"+txBdIdZL9RcqMRyvQN4LpQXi6Bkd5Cxtm4fHYcwXM8GqZI5z7adZqSmOFrFJCgN7vT8chbXLEopBBKY",
-	"MSF6ql7X86eOGXIbd+lqA65+MgtQgkBA6X7GX2pKV2oRwkrBOlfSiq5BwIDuhNdp4pJstBoWOcIscwZU",
-	"/4AZAiG46ATcQnM4I8y8QdbkrN+SzEHdAjBUgdID6GNYDbvUBhLKlzLBcsvSoCwtMJUQoznl6Y0RKuQP",
-	"CkdzzR29BsICqhOoYd+1kPdi1AQjIDpzzilgZiB3ur7XDfGmpWb3nFCnnClMmDeE9qf1WsuMXPGCZnWz",
-	"iAgLq3QAjkMsZKXIr3nmdGFBKHzMKcfZa6fbGlRgSv/nuqCK5Fiosebvywwrs26A7XPCsCFya0+7q+Wf",
-	"2fGMq/ObnY1wnlOSYk3K8T+lpufXgSfvhVt6whbcorjjiDHvbjh9NPy0k/Xax5r/lwoLisXNqT1WOfvA",
-	"lwGF+igBEWPUjNSsBGe8kHSLvEhZb8ovYmV0QzC6grnk6Q0oqV1AI9JSCcBrTaM4ygXPQSjHEbN2Ih1I",
-	"Sbmckdc2UCXMxsc8DLjW3oFdjSfdYVG94P3SOfFTKRF8/k9IVWtiP7bt6XF0whnT/9mixCv0Ep2cn52d",
-	"nszQeIxeg1QIFgt9epojdMHFLRYZYctr9kf0Ep2dJ7Xh0+YQlBGprYr2QYAVaw2rGx3FUTW1BqInjQHR",
-	"arsJaRoUTtdZgoVlJFGwlgHalitiIfA2MiGHElvjo99r8ibZYBdRZRnR5MJ02gCra5GK7GStfTJriALj",
-	"pcKqkPu0tSTMpR0eECP9c2O3NvZxRcUadh2y0tgvKDOXs/Pp9PQNslJx8fHsbHL2Dl2zn9BL9PHs72fn",
-	"V2c1IXCjozhyI6M48qNCsqD188Sa+rDyIv91VxndAXEwx3do2lgmRKQahBcgC6raUgtfiEpSnsGgsz6O",
-	"KF8mvFB5EVDTYymLNUj0cfb25f/oGJhn1gz2W5gKhMbyIYTeEgrHLsx/U997F61wwDDVwYIAihXZmDDB",
-	"RgK0SjNEAT5L8msgwr4kv5bhvl4iRoSh+VYZf6tOyL/8OUhIBV9UkgvYELgNkBLNiTLLwxeF3HkaI7Ko",
-	"gUwpv5XoB0nWhGITQHw8m/zvC4lerABnL37cS3gfwmj89lH7AhYggKUBSuhhEvmBqOHCNLnibUw7o1Kn",
-	"pCy5EWuzrQN/dLsy/qyFQRtxojS9zZRCFQJCjPMH3LNst0Nbl6YyGO+jrfXSujRUw5iUua3CjE1EOZgz",
-	"OF9ER7/0m+cwK+/iQ1ywT3eh06MjQaJNi7Or7071waqjxaAZnegD4Q2/ZRqxn50latvy4w9Xx/936Uz5",
-	"z5PLS2ug/Sb2cxRH/lNoq78XQnFJ5FvAmolvKV6GNzs7TyZnl7OLjyezyfnZZXJyfPK+uV/XiNC22gEJ",
-	"GJAVFpChc0NJiX74KCFDr7foZ+OaU0CnLnknf2x7kpVXneSCK55yGjw/quzDANuuBGbSJBfqa/YJyMzP",
-	"mPoJd3GkY9BEkTXw0CGhoykTpboRKCuEQUQrmAN8n3KVuY8AxCFla8hx23HbPf2KbhKtQUq8hJ6zephG",
-	"zfTYXbTMAtUesYWsD6GZ29JL5enFxflFFEeTs7fnURxdHV+cdQnlBZiM2JRTkm47tOD0H6cXTuNK/SoV",
-	"QH+MYq94wS0K5uOvqc38BYhPOYMkrz43wbhagVqZ2LrKPVZhtZmcmeQ7V6NrVqUYlDbg9Uk+N5UXlEKG",
-	"FoKvzffj6eQEUZ5iWq2vuIARmiwQUS8kwjufzdJEmsTiNVvhDaA5AEPWMkOGCqlPDGvUd/BHuSDc5vow",
-	"pXpYm0YWDxPxd6PhMDdovCPqfTFHc1hwUY8DDX9lJcZl3iPWVC+yxKc8g9lel3IxWSVY52obOmHtOoUE",
-	"cf81MrFNRMH6Zxu2BlGBLzkIsgamME0W1rA3neo+fQydCIFAa0nUqpgnuFCrRPEbYPfE1QY/mTvskrWz",
-	"PH0Qto9HbYYwYcmiYKk5AcJulblyqN1J6TnIz7G3P5lLq9n0LZHoOhIFu45CoDPOEpO4I2xZAn4fhuVY",
-	"YEqBErnuX+LPO450h0k2F4s9IbDNne0GAv4yEmVYYeP3aYJ4VSz1rUG0KGCJfTSRaGc6UTyxrCG0gyNl",
-	"9GFX1o68/n96dgdP9I/XhuMjrdHXkZ3l3FHBuYkTsAc5eHi2D5DK7FwaCIOW+XcbcYCN+F23f2u6LUEQ",
-	"TMmvkCWyVJI92dLWlJD7dmmz/ZMyUg7kr45Z6NKBaH8k5ZSCgVrzX0easZUGd+ngKhNcCDokBD+ryZLb",
-	"NZgJcbcU+wPrvkU8gB3LmOuWBhJ7F20zoQLTxeCtffv40hEm1FK/g1KhRrUeQu9ckA1WkJA8wVnWcTU1",
-	"mSL9EaTcvQcnTJIMdgpSOjfRYVRvJrkPZxPihg6avJhTknZjMDXf60j8gRdKw/2HOuDodgUCmthJJACn",
-	"KzyncM3OzmenR+jKlyHog8Rny6oJ7IVComCMsGWz/CUjmf6WwYIwrUZbc68gTV2PucXG6Q2wDGUczCKy",
-	"yM3FgwD9j/bjLZ410i94kNiWHE9Day/3wzL2Tth9vv6ZtPvyMTV7Vz92hblL91ukimva3WMYvt1dgz+8",
-	"epPez+LmhN2TtqA3CokCnxuOwgBXoN/Rbm0gbHojycv8xp60TC0Z0jz6Kx8kdB4e6iA0SqDa80M7N6nV",
-	"S4kge7r83xaRgpLvL9DNFXtb5PzPw9LQfrUJUyByAcpk/uza+5LRfu4/MCXZPeaV1+lu2qfWPZT5vZcI",
-	"zSUCCujvuKGHXEl3EjEEUTl874377vZ9qIR9HNLxq1SiSMNGR08ZjlFj9F6E6hsPGLzge1DuQQOLZbH2",
-	"tcqD7GVg2WO3SPCu3jBHOyvJDj3bWaLqe7eBJTKRNyTPIQuVNsVRziXxOxyIxtRP7eGHNy0V3TpRbMA6",
-	"kEElJUOM6iWKgFyA1KZO28gwbWo2Vi9nQuSDYrtqVmjPgThOaxzaDTVosWYDD0Zt+DsJQgmDQ0pBPa7l",
-	"om6J2MM0ELeuy8y6BImOMTU6h4cPZlNg+iE2p3tOHef2SRbAuj7o2xwOQRj6MLso2FvCiFxBdroJqqIo",
-	"tNthhyQQHqO1o2CJLNIUpFwUdK9GVtUle46S1sp7aRAAeA8FwuWCZSqpKtH7YIvuDjo5LgrmL/I+aCUL",
-	"nBq1oVPBlzpADhS7uC9J+PhOCyGAqUQqyMshw4vMGtMPu0/m2t/U8+R9bFAb7uaSYdD2CkGTWnv4f1F7",
-	"YbO3oNKXcQ7zhXvqU4e6tF5AO0ozuuRsiDxfVC94DnXtq5N/sGt+kCNf14Whc9pH0wG7Nc3g0IlX2LwX",
-	"OABEW2RT8W030mnJ4KYc8G3OlNb+fdrk6dGC8XbPh+Hg707Yi4DfOgw3F2Bq23zh1FvB1y4T1AZ22EVN",
-	"s+AsmEDjhUhtbN/xInIuOS0UIDvSvoCqpUftr3ajslyA58SVC+zPsdUA6ClgM+S5gnmDQiHTwgWgK5ij",
-	"ZpngwIuJw+lXCBpIXl58QIojfzlnXyP5gsVBVNHL9lCjXQgVTBLOTqYuQXh5Mpv67OCbaS0zODvRf+nP",
-	"URzpT5/i0HMPf44roqj+5rN36OL0crYoKDqeTqI42oCQbvvRH0evNKg8B4ZzEh1Ffxq9Gr2K7Os6w4Ox",
-	"y7brP+7i6s/xikjFxXb356/tp6x3Q8aMPe3NrkuwhYw52EKwSRYdRe9Anbol3L/VbdlxOTtuPCvuOKGq",
-	"IePA09u7TzuvWH569eqgNyyDXL6u+svdAu/WK5fL0r31L+bMfbi9Su3atcRnbJ/kmJcxxXqNNQujD0Qq",
-	"89KqqUymUghrD+YX/0Q5Mn7FUF6OTZ3US5+SzbkM8HXK5QDGftArvbUZzUfisH8dte0mWu0B1Xjn9dTd",
-	"A4XkPvc8nSXCbV/vWeTGwiBdOVyjbN7X0pdWSMOOLrdSwfrBYiVgzRW05GrnoABKpa/oqz/Kq1l9vCPx",
-	"VRXgLcz3oHAfKb4wgD+hGD/KO7vwQR4Sqe6jvPlS8aHKcg9D+iwKcJxlyAqjlX7Fn0bg3eWkHH9tPwu9",
-	"u5cKpDzfdog/rj+bf2QFcM6ybNW7PIo+xHtnBV7VPqkWdUYLd0138reuJI7uL+TT6snXQMeXu4d5lP4/",
-	"nl1UQ81rnt81rZcXfBvndMJkDqkqn9YDc+WtrWd6jy86Y+8pPLYM+RLPfx9Z4qkC9dI+ZT+4McAziImn",
-	"qOxx6h7R5rjM7v6B/ui+lwT54/KxxGSHC0C1YnkItVVegjKVapqj7qY20FZDVmAFTMu+J8ufvkHkVK/j",
-	"/EbB0oVruJWBwoSazh8lnRGe88KE4LJixy1RK1fTXyvWvIeslt5jatsp+Bvmw8NxL48n1ULfcRzju0cM",
-	"97WePmKWICoO77SqeCh7q5zc/S3Ne7fGv00eLVA5/238FK/fQbVG8IVIUxZ8Xbx69dNfkOUVSTFFNWv6",
-	"IPZ3xYb3loXvLkh7ouCocTh8N2cB0m4vWVQl5E8hH+O0alfygPOgJSm1Pie/rai+3uIlICs7TV6eL8Bv",
-	"N3Z5Hkm29QFgs0xLsgFWNjtzj02wf2BRSwJUtfVPIdO+x6kcf601Rrwb4512FM4wNmn02rSis83nKpxM",
-	"q8BW79SyH11cvt4mOlAVvFiuELazPKXjx7DAZQeIKRfKNv07bnaH/Fb6tn9WvUnlgOH13qxDVsdqNWRc",
-	"T2vUAbN9A8XhQ++5Ubtx7qAtay0yu47MoEl4sB04MZ1KF7z0bne7lj6+mg8PwV29yf2cIT/5e/CIh1QN",
-	"NTJ2z3IGvAOFKJaqelArCnZflrslxu5ZjOy+3fC3j7j1kHeEZisikVSQ20eB9hT2DTHq7TNSzBrtOE07",
-	"4ECbkoOuO3Ymy+/8zvppLEI3ex5LNMZfq7dTfbdg9bbnpv5JCbJcuh4yVXcU7bNkkFO+XZc55rZkvd76",
-	"h/QxIuoFpdfMugMIM9uO0/YCFeDqrmzzl88dXTM/2yaxTgx9R/NGH0+93DXbEFw1Eh2hiWlEQyVHOZeS",
-	"zO2liu2jazLlpq0xy2xnJf/ivULWXAGucwrKQ6hWcM1Mog+Vkog+Bzrofh49TBvcv5Pnig5qD+wGH9vN",
-	"lsFPFUwE7Mwz3wuGCpufOXBoKxniNutZSBAvJJrDCtPFQ42GRUc+sZnQBvd3G3F/G3HpuPQ8luEb6brr",
-	"r/Mfrep2y0fWdPsU3w5dEgZjVxOrfykNQSnfOqwIK1wVW7gmCF4Km6QyNYkI2IYIzszbRFdsbP53RY7G",
-	"DoqRKY9bcamOTD7iboxzEsXRBguC59S7uL4jpCN09Le//vVvtSpg8+cnzbBWwwzBM/uQAZ1QXmSdEMkS",
-	"pJdf7b8W25FpyDS6cbejo5SvQyDWpjQhfVX7Py1Sn+7+PwAA//9fRf2362cAAA==",
+	"H4sIAAAAAAAC/+w9a3PbuLV/BcN7Z9Lt0Fa629ne+pvjOIlnE1vjR3N71xkGIo8k1CTAAqAcrcf//Q5e",
+	"fIIUJb/SdvfDxiKBg/M+BwcP3gUxy3JGgUoRHNwFOeY4Awlc/8JckjmOZUQSoJLMCXD1OAERc5JLwmhw",
+	"EFwuAbmGiOIMEOOoKEgShAFRDXIsl0EYqFfBgRdmGHD4Z0E4JMGB5AWEgYiXkGE1mFznqpuQnNBFcH8f",
+	"BkDjFK9gEKmrq5O3IRJLxiVQSJD5zbhFcI7kEpAF5MfTM8qWaH7LIZaQRBxEzqiALpYnDo8kZ4RKxEEW",
+	"nAokl0SgFU4LCHUDAXxFYkC3JE3RDFCG+Q0kCAuEV5ikeJYC+gPsL/bRB0hThj4zniY/7DvC/lkAX9co",
+	"6yA2TMhSyjzKQC5Z4pf+h8vLKTINUCEgQZKheAnxjUOPpESu99FbmOMilYgI9P74sg+9+nB1xP6bwzw4",
+	"CP5rUmnsxLwVkw9S5p90l8PaiBp7QokkOI0SSPE6ykiaEgExo4nwE0OLbAZcqUi9rSLpFhOJCipJiuAb",
+	"xIUkdKHFMydcSMOFGKdpD10DiNTJnDOeYanby59+DEInEEIlLIBrmnIc3+CF0k0/DfY9qnQXySWWpf4Y",
+	"9KHHQmvQt9N4DaYHIbl0VueU2SnJPjqRKCuEpK8kEhJzhadc1jgrUiyW++gd44hQITGNAX21YCZLwKlc",
+	"fu1huqVsEGvGZWSk3oM849KphVeze9hYgzvExzECV71ByGjGknWvG6kZjjIxAVKhOz27uAxrHqVUAgFU",
+	"uxDVVcF18qlThuzAfbbawGuYzRwkJ+Axuk/4W83oSitCWErIcimM6moCNOpWea0lLshKmWGRI0wT60DV",
+	"A0wRcM54L+IGm+0FofuN8ianw55kBvIWgKIKlQFEH8NrGFAriFK2EBEWaxp7dWmOUwEhmqUsvtFKhVyg",
+	"sDxX0lEwEOZQRaCGf1dKPkhREw2P6swYSwFTjbm19Y1piG1X93tWqWNGJSbUOULzKMuUzoglK9Kk7hYR",
+	"oX6T9uCxjYesDPkNS6wtzEkKV3nKcPLG2rZCFahUf2ZFKkmOuZwo+e4lWGq4HrHPCMWayZ0xzahGfnrE",
+	"UybPbloD4TxPSYwVKyf/EIw2RxmKvOcW9AmdM0NiKxGjLt2w9qjlaTor2IdK/hcS8xTzm2MTVhn9yBYe",
+	"g7oSgIh2alprlpxRVoh0jZxKabmCA2J0dEUw+gwzweIbkEKlgFqlheSAM8WjMMg5y4FLKxENOxIWpagE",
+	"p/W1i1SJs84xt0OuM7ZnVJ1J93hUp3i/9nb8UmoEm/0DYtnpOExtt3sYHDFK1Z8dTrxGe+jo7PT0+OgS",
+	"TSboDQiJYD5X0VOH0Dnjt5gnhC6u6Z/QHjo9i2rNp80mKCFCeRWVgwAtMoWrbR2EQdW1hqJjjUbRWLue",
+	"0jQ4HGdJhLkRJJGQCQ9vS4iYc7wO9JRD8rXO0XfqvIpW2M6okoQoduF02kCrD0jFdpKpnMw4Ik97IbEs",
+	"xCZrLRlzYZp71Eg9bozWpT6suFijrkdXGuN5debi8mw6PX6LjFacX52enpy+R9f0R7SHrk5/OT37fFpT",
+	"Ats6CAPbMggD18qnC8o+j4yr9xsvcm/bxmgDxNYSb/G0AcbHpBqG5yCKVHa1Fr4RGcUsgVGxPgxStohY",
+	"IfPCY6aHQhQZCHR1+W7vfxDQmCXGDQ57mAqFBngfQe9ICod2mv+2PnabLP+EYaomCxxSLMlKTxPMTCCt",
+	"ygyBR86C/OaZYV+Q38rpvgIRIkLRbC11vlVn5M9/9jJSwjcZ5RxWBG49rEQzIjV4+CaRjachIvMaymnK",
+	"bgX6gyAZSbGeQFydnvzvK4FeLQEnr37YyHg3hVH0beL2OcyBA409nFDNBHINUSOFaUrF+ZhuRaXOSVFK",
+	"I1RuW0380e1S57MGB+XEiVT81l0KWXDwCc4FuGcZrsVbW6bSFG/ircnS+ixU4RiVta1Ct4142ZhROJsH",
+	"B78Ou2e/KO/DbVKwL/e+6NFTIDm4K/3q+2MVWNVs0etGT1RAeMtuqSLsk/VEXV9++PHz4d8vrCv/dHJx",
+	"YRy0G8S8DsLAvfIN9UvBJRNEvAOshPguxQv/YKdn0cnpxeX51dHlydnpRXR0ePShOV5fC9+wKgHxOJAl",
+	"5pCgM81Jgf5wJSBBb9bok07NU0DHtngnfuhmklVWHeWcSRaz1Bs/qurDCN8uOaZCFxfqMIcU5NL1mLoO",
+	"92Gg5qCRJBmwQvpnU6oFsi1QUnBNiDIwi/gm4yprHx6MfcbW0ONu4taOfkU/izIQAi9gIFaPs6hL1bZN",
+	"lgZQjREazIYIurRDOq08Pj8/Ow/C4OT03VkQBp8Pz0/7lPIcdEVsylISr3us4Phvx+fW4kr7Kg1AvQxC",
+	"Z3jeIQrq5l9TU/nzMD9lFKK8et1E4/MS5FLPravaYzWt1p0TXXxncv+aViUGqRx4vZOrTeVFmkKC5pxl",
+	"+v3h9OQIpSzGaQVfMg776GSOiHwlEG691qCJ0IXFa7rEK0AzAIqMZwZVqFYRwzj1Fv0o54SZWh9OU9Ws",
+	"yyNDh57x95NhKddkvCfyQzFDM5gzXp8HavmKIOzUPULF9SKJXMnTW+21JRddVYIsl2tfhDVwCgF8dxgJ",
+	"X0e8oMO9tVi9pKgiACcZUInTaG4cezOpHrJHX0TwTLQWRC6LWYQLuYwkuwG6I61m8pPYYBdl1vMMYdgN",
+	"j8oNYUKjeUFjHQH8aZVecqitSak+yPUxqz+JwdmWb4lA1wEv6HXgQ50yGunCHaGLEvFdBKYWAtMUUiKy",
+	"YRB/biXSPS5ZwcsGpsDakjoTAbcYiVTpS+d9iiHOFEt7azAt8HhiN5uIVDIdSRYZ0ZC0RyKuvYWsEnn1",
+	"P9W7Rybq4bWW+L6y6OvA9LLpKGdMzxOwQ9kbPLsBpHI7FxpDr2f+3Uds4SN+t+1/N9sWwAlOyW+QRKI0",
+	"kg3V0k4XX/p2Yar9H/QC44aaTLusRFQ5IIHu8kNzETREjKZrvVR365II+wrFHIslJONEkGIhI40RB2wL",
+	"+O0cbT2IClIgkAIBid1McHb26ReiErEfQmP0qrJBJKKwAm6b+hS1ln77cXBDEqEcJJECmVVcZOqPIdLD",
+	"y6VDinOm0a211KuwvsG5yZijmBVUDi3KSZKBGGbJLRbIwoNkXMFIEG/15XNbugsmEaGSdamvj5NgCXsK",
+	"UR+l40q+DS3eVPZtMs+jVvV5jyF1o+UMlX5t4dbOXj4cH368/PD3svRb/f5JLS2cH158OH5bm9xUxWHb",
+	"UpeCq79dj86sJwy+7SkgeyvMlYMWurDcRboawPOyGsfbc/B1iVnFrJOyIOfh1SH1rW0SNe2JWZqCdo5K",
+	"e1VBKzRBx65t2g1QttI1ptJ3WgtZdlSv+plX0eb63RAQh2APGL2q2yBiI9Cur6/QtKW+zrgDStxXjait",
+	"MI1acVEgl2UoGW2xwb3FeVcx5ZyssISI5BFOkp6F85MpUi9BiLYLJFSQBFrb5QYGWf08OMzq5/EDXdPT",
+	"s8vjA/TZ7VpyIajWBiUMhNo4pGf4Gj5Qt27Yi2bOuBxcjhsSj64T+rL1vJilJO5n9FS/r/P6j6yQiuo/",
+	"Nki6XQKHdpzkgOOlImyQK1UHxRNeUEroormHMCGJepfAnFDlJNZ6cVbozZGq3QzHN0CTkrGiyFUDxEH9",
+	"o4ohhs6a4ObMy2zDjqfhtbPqrWKgi37P5LsuHtNvtc24rcx9nq3DqrDmu4bcHlPc8oSiN2sJAumS7hw4",
+	"hwTNmkmdTgtUmljlTU3fmRBxo/KJJDILcQd3YxIr3euWEymBbtWRgrxlXI0YA1lBslNnAVRu0bElyx4U",
+	"vODDDn+8tA+Ibgr45kp467g54JsozouoUO/NdrKY8dHs0P0zyBhfWxA786Qflf5hBogutbUVp3enlTBt",
+	"MmOdizMYPf/RmKckIzWlaZrR/wFnzmub9p2AqN2vBjJ28rGjZMJA4CxPQS8HbVxMIhkIibO8I9EeYXqQ",
+	"8rKoicUmUZ/rcNQVuJ6x8IJGWocKZwa7BJ+OOXU2t9kWJt/euy5ev/4JkBoY6YHbEmUru0aiZ7VlpYMX",
+	"fXUN7bf17He7zUMjsLxtTcVjtVqjFE7tDNZySOw0fAYxLvTOu1cCCcnyHJIhfEujeQDPS1MaQYkerze6",
+	"9mx7ayLbYXbo1aMNOvky+5ucGg1utHmW0qq/JNrNCxuHFzyvG8XJEbWv4eJ+b4EoL9dUNywF1xZgm+XG",
+	"qu7pmxxvW5RsHLvo9veN3OTWICe84umruXeY5NV8t2lXmYxH5dzjcVtfHLQTKoHnHKTebWBg34fj+v4N",
+	"pyTZoV+5hdd2+9IWjaFlkAlNEB4DtO8jGGBX1L9xwYdR2XzjLt/28EOk+AsepOepkLyI/U5HdRlPUaP1",
+	"RoLqA49oPGcbSB4gA/NFkbnzkaP8pQfsoQXi85tGOGpuH7X42V2Zrt73O1giInFDdJj2HKcIg5wJ4kbY",
+	"koyp6zogD+daKr71ktjAdaSASk76BDXIFA45BwFUah/p503Nxypwelluq/WkqpdvzJE0TmsSatcd0yKj",
+	"IwOjcvy9DEkJhW2OnzlaS6AWROhwGklb3wbKugbxnjY1PvubjxaTp/s2Pqe/TxgMRTIP1fVGLxMcvDgM",
+	"UXZe0HeEErU6ebzymqJKnOe2SQT+Nso6ChqJIo5BiHmRbrTIakf7hlDSgbyRBx6EN3DAf0TJNUDVsaCP",
+	"5qDPVpHjvKBu8+BHQsEXNWpNp5wtOAhP+SO3byJ/+I4LzoHKSEjIyybjD7Y0um+3h5WpfFP1E7v4oC7e",
+	"TZB+1DYqQZNbG+R/XjvVv/EQlzs6Ni4XHjgTNzaldQrasx28T8/G6PN5dWvAtql9FflHp+ZbJfJ1W7gP",
+	"t8bKhqYtRmu6wbEdP2N9RnkLFM3G/jDom+l0dHBVNniZmNIZf8iaHD86ON5ueDEe/XaHjQS4of14Mw76",
+	"PI07rPGOs8xWgrrIjtsc1jzk4l1vYgWPzdzeDw7PBEsLCci0NLcu1FYTzVMzULlFmeXEblHevCRVQ2Dg",
+	"0Ixmz2eYNTjkcy2Mgzqai5pHk0buUtiefwVPPWt95x+RZMhtCNSgHJBxXFFgB7hR1csP7kZu7Ome1/DW",
+	"FS+PprameHF0OXUFxbfTWjHx8kj9Uq9VFfHt1FNBNHekmNAviUzVO1fwQ+fHF5fzIlX774MwWAEXdvj9",
+	"P+2/VqiyHCjOSXAQ/LT/ev91YC4B0WKb2PVs9eM+rH5OlkRIxtftx3fdG3fux7SZOHHpURdgzlvlYM6r",
+	"nCTBQfAe5LEFYf+tdtsclr3Dxu1HPUGtajLp4hLcf2kdtv/x9eutjtqPyhL7jom1z6F26+dlRuwu9jA1",
+	"dr3js2/Ukp6JuTlAgRVFlmElwuAjEVJfCNG0P8VNiVXS86u7SSnQqchYWU70cY49V8XNmfDIdcrECMF+",
+	"VJDemSLoI0nYXeKw7mda7Z6HSeuSh/sHKskuCyu9Jxm76eGz6I3BQdhTO43Tve7Ib+mFFO7oYi0kZA9W",
+	"Kw4Zk9DRq1ZsgTQV7uBR/e6QWqDALY2vDivdwmwDCbto8blG/AnV+FGuA/HHfp9K9Uf/5oUqDzWWHRzp",
+	"sxjAYZIgo4xG+yV7GoW3K5ticte9veZ+JxOIWb7uUX9cv93rkQ3A5teis1/2Uewh3Niry76ntaLeCcb9",
+	"/f1/kpFYvr8ST2snd56LKe8fllG6P55dVT2kvEBqWt+R8DLJ6QkVOcSyPOQB1J7C69wm8viqM3GZwmPr",
+	"kDuJ9q+jSyyWIPfMjVtb31/2DGriOCoGkrpH9Dm2GLy5oQvdO2mQC5ePpSYtKUCqDMthqLzyAqTeC64k",
+	"ahd3Pbf/iQotj2vZdLPSlxeYOdXPgbzQZOnc3gucgMQkhaTOZ4RnrNBTcFGJQ12gao8e105t7KCrZfYY",
+	"m1vf3KL09tNxp49HFaDveB5jsdwi13r6GbMAXkm4daPeQ8Vb1eR29zQfLIx/mTqa5+Tdy+Qpzr69Zq0O",
+	"2gp98EZteP3xZ2RkRVTdpOZNHyT+cs/uzsK/sBtpnyfWmB3KoWZP+ZzMy6sJvr/IM3bTs93X/jx6d6HZ",
+	"aKb5HOz6ULl1fSCaKM4zuigv6ia8se19cJ/7Q/S0r4axu9p+b8WEp1Wxvlt6XyhnQSKHmMyrw4RPoR+T",
+	"uLr98wF5S0dTateG/ntVn+o3pnp0pXVn6vMVorr3pD6PJputL9ZNLsgKaHl3uD20jN1R21qxqjpl+RQ6",
+	"7T4ZIiZ3te8M3E9w63ZH6xhbRzj1ze7mLveKJn3zfudTJOX17mF5GRpRBRXOisUSYdPLcTp8DA9cXqio",
+	"Dv+a6zoOmx9beCl729yrJosxzeufOhkDHcvlmHYDXxoZ0dt9j2B80x0H6n6HZtSQtS9O9IVMr0t4sB84",
+	"0h/+mLNyFtb+CMjjm/n4UpFNsnZLhlzn72HmNmZDXKOy/Cwx4D3Ix8tmLYiJPfEl+lfh3Co57tyLtY8u",
+	"l0QgISE310OYKOzul6zfRhlj2vi6hU7aPbd+brUs1+osvvO9FU/jEfrF81iqMbmrjgUOrdbWvyKmt/ZJ",
+	"ThYLOw2rLhtVOUsCecrWWbkW0tWsN2t3L12oTv2m6TU16QDC1HzdwnxaozZlVHnB156PUHw131yxaug+",
+	"ENb4LIYCd03VpzrK73Koz0Kpe11TwVDOhCAzs/hnPkujOpmvBNHEXFTsLpCriNVL1WqCKx2GcgnXVJcF",
+	"UKmJ6KvngzRf9x9mDfbfk+eaHVRKMj5sNwh+ssmEx8888/q1b8/+M08cukaGmKmnFAL4K4FmsMTp/KFO",
+	"w5AjnthNKIf7u4/Y3UdcWCk9j2d4IVs3RP5nm7oZ8pEt3dwyYZouCIWJPqzWemb3c6snpXModV5NNfxG",
+	"WM037HUeTjOb7NP7aRHQFeGMKgcR2L31+tOdBxOLxb7e2rlkQh7oGsX9BOckCIMV5kQVFKyfch9dsMwP",
+	"/vqXv/y1toNd//yihNi5To2zxJzbQUfq8uJejESJ0t6d+ddQu6/vPN6/sSv7+zHLfCjWujQxfV37T6nZ",
+	"l/v/HwAoq/ycTncAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
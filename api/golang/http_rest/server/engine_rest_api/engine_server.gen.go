@@ -29,7 +29,7 @@ type ServerInterface interface {
 	DeleteEnclaves(ctx echo.Context, params DeleteEnclavesParams) error
 	// List enclaves
 	// (GET /enclaves)
-	GetEnclaves(ctx echo.Context) error
+	GetEnclaves(ctx echo.Context, params GetEnclavesParams) error
 	// Create enclave
 	// (POST /enclaves)
 	PostEnclaves(ctx echo.Context) error
@@ -71,6 +71,13 @@ func (w *ServerInterfaceWrapper) DeleteEnclaves(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter remove_all: %s", err))
 	}
 
+	// ------------- Optional query parameter "label_selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label_selector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter label_selector: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.DeleteEnclaves(ctx, params)
 	return err
@@ -80,8 +87,17 @@ func (w *ServerInterfaceWrapper) DeleteEnclaves(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetEnclaves(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnclavesParams
+	// ------------- Optional query parameter "label_selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label_selector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter label_selector: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetEnclaves(ctx)
+	err = w.Handler.GetEnclaves(ctx, params)
 	return err
 }
 
@@ -248,6 +264,7 @@ func (response DeleteEnclavesdefaultJSONResponse) VisitDeleteEnclavesResponse(w
 }
 
 type GetEnclavesRequestObject struct {
+	Params GetEnclavesParams
 }
 
 type GetEnclavesResponseObject interface {
@@ -544,9 +561,11 @@ func (sh *strictHandler) DeleteEnclaves(ctx echo.Context, params DeleteEnclavesP
 }
 
 // GetEnclaves operation middleware
-func (sh *strictHandler) GetEnclaves(ctx echo.Context) error {
+func (sh *strictHandler) GetEnclaves(ctx echo.Context, params GetEnclavesParams) error {
 	var request GetEnclavesRequestObject
 
+	request.Params = params
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetEnclaves(ctx.Request().Context(), request.(GetEnclavesRequestObject))
 	}
@@ -750,34 +769,41 @@ func (sh *strictHandler) GetEngineInfo(ctx echo.Context) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RZW2/bNhT+KwK3R9XK2oeifssSrzXW2obtYBuKQGXEY5uNRKok5TYw/N8HUtTNohU5",
-	"TQw0T5Z0rt+58BxmhyKepJwBUxINdyjFAiegQJgnYFGMtxBSAkzRFQWh3xKQkaCpopyhIbq5GV/7ntxw",
-	"oYAB8fJnLjyGE/D4ylMb8Kwg5COqeVKsNshHmgINXVp8JOBbRgUQNFQiAx/JaAMJ1urVQ6q5pBKUrdF+",
-	"r2kTvoUQx3HbvPHKMwK8nMjDcVxYIwfeVG1AfKcSPM7ih4JGKp6mQGp017DCWaw8Kr0VjmXpyLcMxEPl",
-	"Sc0Qh8V3nMeAGdrnNsuUMwkG5wlX03v9I+JMAVP6J07TmEZYuxF8ldqXXU3k7wJWaIh+C6rwBflXGcyt",
-	"6DFb8VzZQcQY/EghUtpDIbgwGFpmLfsypVecKUwZiGu4y9afOIEcWYMCGhoMfAQsS9Dwc/6kUb71W776",
-	"DXELhVVmU8vwovnNZDKevEc+Wiyns9noGvloMp2Eo3/Hi+VoskSVzCLiProSgBWMbFLptBU8BaFoDidO",
-	"aRgVKsOYr8MYtuBIDivBi/naMyS+Z32UnuLeePLXFDnUN+VvQUjKWajw2pGefpneeY44CBILb1dMraUm",
-	"EjpaG57FJMQpjUKRsZCykOhIhX1kueObJ2VRdJ+bZnf5XAWI332FSGn7riEGjfEiSxIsHtohyiuFhHUt",
-	"IWYkzDJKDAVVkMiesExwApeM3GSUaO3WHCwEfjB+teyzfJezcYnEBy7VJxxtKMsLp2XyWqRRmHKhQs7C",
-	"DZcqTHLyWlApU7AGoVXQtIOu0bwqzB08fofe236eud25E5SsIaRpiAkRIKUzN6uQU+IkqKyjTFICRUSP",
-	"gnKU7AgmDQtcEjps8B1edoA2Lg8g2caryFSdoE4kjtZ3eTYe4z1SeYbaym1J6XLDGe9mAdfzKKSWoUel",
-	"dVVMqzM+Ua5bmCyPjr69zR429SyWPcVYq2oS9IljOh5NHm2wS5qAVDhJ6+3/aN48of2fN9Vc+B2JziFQ",
-	"1ruOZC1mi2IeWI4WS+Sj2Xx6fXO1HE8nzgHA0fdbCX8UpH7QWCweK7Z+E83o02z5X5cnSyzWoNrCtIgj",
-	"fOujJxWYb8Uh3ScLGvQubxszZUthZIO44iLBCg1RRpl687qanWpHQAJS4rU7MvmLftPtUtMeemIEVDr8",
-	"3LIuh5ZWZQH4aD6fzpGP7Oz3z+XcBNMVgqrM664TrOCVTf5D2PUJaAFUVMX629+ZUFxS6c1Hi+Uqi73L",
-	"2Rj5qIwduhj8MbjQ6ngKDKcUDdGbwcXgAvlmjTL4B8Wmko+4MShoD7tmJnMtN8upl/OY5ai2rkkvk2Be",
-	"mD3HK5dD70u16XzxkLFNmLIfk1LTqLDJb2yVn93hrUiC2ha1vz3YlF5fXDzbnnQ4pDpWpUUWRSCljkth",
-	"BjJEdhFyKygtDvLFzuxXxSRcxAEqeBRey6oO0a0eq8BIb+L6HlQN1J/CBRNC9ScczxqV3OMQKo7ng5I6",
-	"D3gfqVSPQJdy6cBuxmUTvG8ZSPUnJw/Plk/NrXTf7E16Pd6/YDI3gnOeWOT+1u52WsHY+1VrCjZUKp7v",
-	"go9l9wdL+pN4nbJC1mf/9gp5vtyu3U95tGbTY+ju2rdo++Zp0NWjWyCc3LQdl3jHmvc5WqxUgj90pebj",
-	"LfasmPyqPeA9lO3YI6AwjYF4ZsZ5SsIGWCi6wpG5iz6BOoh5hONXK6pHqpMY9ayh4CmcEsSWRprO/jqo",
-	"vFNk7Yqfzy0jIPw7izkmvYTFfN0P+ML3k4iDiDMGUZ6Mp/BVB8cJTE+OSqcEXRgJZuQZJAEjKae6wnbm",
-	"6oplyZ2J6BbTGN/RmKpncPmEmCosYizuTyIOUhzd4zXIp3EFO/srpGR/moi8sfXWa9fqk3v+orjX+CU6",
-	"f3Ffdf7eX97/PGkgf3HYn3/Yd93b9B/5Xz44i17ByWtHPwTFxcTxEikvnF40kUstZ81irbVzbikbD/yA",
-	"KNP26IZrX4blS3NjWnVdrQnEtkjfpjcf9dDiAdtSwVmiofNRJmI0RBul0mFgIzMww82GSzU0J8U+wClF",
-	"PtpiQfFdnMdAf2j8ixa9e/v2HSr/R5s/3mpMD82YCU4ycyx7VzHPyFGLZGnSq529MjTeDiLNNri3N1mD",
-	"iCcuE2ssTUsvan866rf7/wMAAP//IQrWlZYgAAA=",
+	"H4sIAAAAAAAC/9RaX2/bOBL/KjzeAfeiWrndQxfrxT7kmlzXuK1tJC72DkWg0uLY5oYiVXLk1Aj83Q+k",
+	"KFmyZMfOpgG2L7Vlzr/f/OUojzTVWa4VKLR0+EhzZlgGCMZ/A5VKtoZEcFAoFgKMe8rBpkbkKLSiQ/rx",
+	"4+gqInalDYICTsrv2hDFMiB6QXAFJDCiERWOJme4ohF1J+iwT0pEDXwphAFOh2gKiKhNV5AxJx43uaOy",
+	"aIRa0u02opLNQSYWJKSoe1ScKLkhqVZWcDBNfSx5WGkLxDOwJGOYrgiuhCWpzjL2xoLDA4GTinlEYLAc",
+	"kM8ILPtZqIVh0dwwla7+8nPGhPo8IFewYIVEIiyBNZjNvu1fCjCbnfF7uh831ECm15AwKbtGjhbEI0XK",
+	"Q4RJWZs5IBNcgXkQFoh2YIQzFnWeA2+ca2i/YNIe0rqhSI/Gc60lMEW3pc4218qCD6ixxsm9+5BqhaDQ",
+	"fWR5LkXKnBnx79bZ8thg+TcDCzqkf413cRqXv9r4JrAeqYUuhe2FpoKvOaTOf2CMNh7DQOx4X+binVbI",
+	"hAJzBfNi+UFzKJH1KNChxyCioIqMDj+V3xzKd1HH1qjF7hYZFiGHPC29+Tgej8bvaURvZ5Pp9PqKRnQ8",
+	"GSfX/x3dzq7HM3oX7Xs8ou8MMITrEEEuP43OwaAo4WS5SNJKZCL1MpGwhp7gCByI1Evij0Qk2GgJajIa",
+	"/3tCe8S3+a/BWKFVgmzZE55RncdljPQcWBidJQhZLhlCV8nZLjFJrrUk1VGnYuqRaCYvceyGhJEHZrL6",
+	"YSg4NamwZMUUB050gUSUv3ruK2aJVhARXedGk7uwQSYnTPGSjKX3bAm2I8QUighFBPaBKLiEBEUGusDE",
+	"QqoVt8eNXwJawsGi0RunuEqBCLTkcjoitTuc/urvSCyYtdfRZfWXAiyShTZlFcvc0yDyJyKQKFeSCHzN",
+	"hQHr0CiUBaf1QpuMIR1SofDtP3dmCIWwBOPtsInI128TUGwugXdN+G0FDskWigrwQZt71x8KyckcCCO8",
+	"YPKNRZbeVz9HZCnWQi1DxXQmiRQIU2Q0Xb8ljHMD1hJmyQNI+VMrdqsy1c3Hsqr7POFcOCWZnLbyp+Or",
+	"tkGXZi7QMLMh97CJ10wWdatATRgic+1C73W4wFTPf4cUHdMsVJVjpSwkqC9Arkh5uBKWizQxhUqESrgr",
+	"UMkpvPrL2jaiiPJZAdiJJcIWCMbHpM8RodUfDK9ts+N/apeSY3XorgfvK5DgVLotsoyZTbdslt2LJ00p",
+	"CVM8KQpRQiMQMnuiz8Ysg0vFPxaC022tDjOGbeh292CnX6C7nI5qN/2iLX5g6Uqospl1VF6aPE1ybTDR",
+	"Kllpi0lWHm+EcTNX8yPnWgPFDvMemuiI3LvTLOs3Z24EX0Ii8iTkdm827lwueO+BnXbCD3eVRw+CcvDY",
+	"AUxaGvRxOKJD1GPlEdBG9fRru3hVkeoCtBeJgz23HswP0R7IPH868O1wOWZGr7/bCdyMo0QEghMy7VjG",
+	"dKaVZ/LtZ2brce7UwhsGwGYU2xPZBK0aHEKN9VPEU9QzkYFFluXNkexg3PhavTmf8TN660v0xdcN8z7f",
+	"HYiMfScF644kSnXXqO4Hs+vbGY3o9GZy9fHdbDQZ914IenpOJ9kOgnQaNAGLpxL9tBvO9Yfp7H/HLJkx",
+	"swTsMnMsPKfLm9kB+uXBbgn+t2pQOCUaWuf7rG7dNTsC0+DMes4phMLvv+udozOwli3hSI6cduudubP7",
+	"lngGOxlRqdkxg2ZBZAX89c3N5IZGNNwJf7u88U7tc8GuIjRN5wzhTUiCfdhdFw4AokDpfvtPYVBbYcnN",
+	"9e1sUUh3y6ERrX1HLwb/GFw4cToHxXJBh/T7wcXggkZ+j+Txj6sNRjnXSui7X/q5sG/pMZuQksYvTVr7",
+	"oSLcCv3+g9TbMfJ5twH5TKjXzfj0H/Fa0nWlU9Raq33qd+/uSLzjTbfRk6f3Nkjbu72dy3cXFy+2cdkf",
+	"rXuWLrdFmoK1zpOVGuXNKqxU+gXUGsflisjxtUFI7TnYAYpsaXeZS+/cMAiee9sT7wGf7YaXBvZQpzyh",
+	"/1VTyV4Wvw76vwqLT2Cfa9sD/lTbJvphPfEvzTcvFpDtBdm2XQ7RFLD9htnQcs7r+KK0t7lt2HfGNtpV",
+	"w3glLOryCvxUevwSjv5BvM65OTevPN2b8+vFdmNVTkRDp6fQfey+udi2G9CxttAB4ewC1RV/sEi9Ro32",
+	"66Jjofl0jX5VTP6sNeA91OWYcEAmJHDix6rnBGzMDIoFS/37vzNOx1KnTL5ZCAlnErrxBuE5lGExbOPH",
+	"8Gkv887h9Vh9fGkeMdcPSmrGT2Im9fI04Cvbzzocp1opSMtgPIdu1zjOILLI8Ez9nu3Ioxxi//pW8Rfg",
+	"BIrnWrikfPRLPlVkc/ecrZmQbC6kwM0LiDk9DJAZycz9WYfj6s3V86jix/ApEXx7HouyFp4sNywBzm4T",
+	"t9UW5k/RLKrN3uu3i3pb9awZ/pvD/vL3g74t0+m3hG/vnNuTnFPmjvsSwzr8qU7jWbVSOZw29arsmwZ3",
+	"LeVVI9tJPTr+1MUIvkJaOH1cEQ4Pk/qh3/nuKrGTBGZdhXTbml/d7ENArYXRKgOFNKKFkXRIV4j5MA6e",
+	"GfgZyb3uGPrusY1ZLmhE18wI9xK9fNOjDbb+6IT++MMPP9L6r07Kr3cO0301pkbzwnd38k7qgh/UyNYq",
+	"vXks/y+tHaSObHAfdnCDVGd9KjZI2ppeNP45r99t/z8AVps4uFEmAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -27,6 +27,9 @@ type ServerInterface interface {
 	// Get service logs
 	// (GET /enclaves/{enclave_identifier}/services/{service_identifier}/logs)
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsParams) error
+	// Get the engine events
+	// (GET /engine/events)
+	GetEngineEvents(ctx echo.Context, params GetEngineEventsParams) error
 	// Get Starlark execution logs
 	// (GET /starlark/executions/{starlark_execution_uuid}/logs)
 	GetStarlarkExecutionsStarlarkExecutionUuidLogs(ctx echo.Context, starlarkExecutionUuid StarlarkExecutionUuid) error
@@ -144,6 +147,45 @@ func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierServicesServiceIden
 	return err
 }
 
+// GetEngineEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEngineEvents(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEngineEventsParams
+	// ------------- Optional query parameter "event_types" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "event_types", ctx.QueryParams(), &params.EventTypes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event_types: %s", err))
+	}
+
+	// ------------- Optional query parameter "enclave_identifiers" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "enclave_identifiers", ctx.QueryParams(), &params.EnclaveIdentifiers)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enclave_identifiers: %s", err))
+	}
+
+	// ------------- Optional query parameter "label_selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label_selector", ctx.QueryParams(), &params.LabelSelector)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter label_selector: %s", err))
+	}
+
+	// ------------- Optional query parameter "only_failed_starlark_runs" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "only_failed_starlark_runs", ctx.QueryParams(), &params.OnlyFailedStarlarkRuns)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter only_failed_starlark_runs: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEngineEvents(ctx, params)
+	return err
+}
+
 // GetStarlarkExecutionsStarlarkExecutionUuidLogs converts echo context to params.
 func (w *ServerInterfaceWrapper) GetStarlarkExecutionsStarlarkExecutionUuidLogs(ctx echo.Context) error {
 	var err error
@@ -190,6 +232,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/enclaves/:enclave_identifier/logs", wrapper.GetEnclavesEnclaveIdentifierLogs)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services/:service_identifier/logs", wrapper.GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs)
+	router.GET(baseURL+"/engine/events", wrapper.GetEngineEvents)
 	router.GET(baseURL+"/starlark/executions/:starlark_execution_uuid/logs", wrapper.GetStarlarkExecutionsStarlarkExecutionUuidLogs)
 
 }
@@ -197,40 +240,52 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xa3W/buhX/VwhuwDZAtbLeh4vrt6LX7Q2WJUHirhdoA11aOrLZUKTKD7de4P99IEXJ",
-	"+qBlOQtQDFheYlPnm+f8eHToJ5yKohQcuFZ4/oRLIkkBGqT7lgr+xfBU0y0kOWX1MuV4jr8akDscYU4K",
-	"wPMgaYRVuoGCOB4NhWP+s4Qcz/Gf4oPiuCJT8ZVYX1EO7xw/3kdY70ornEhJdni/jzDwlJEtJDQDrmlO",
-	"QVqZGahU0lJTYS378OHy1wipjZAaOGSo+i4ksqYikSO9AeQF4ajypiR6c3AmoCXCEr4aKiHDcy0NtH3z",
-	"ViotKV87M3PBmPiWMLE+GrA2SUDYSggGhDtp3BSWLmGUw1F5XaKARMo1rG1Y99YXbSRPCGOjNvbJTtip",
-	"QG5pOr45yw0gT4cOdPWupIJrQjlIpDdE+6WiIDyz+2lYhlaA4DukRkOGKA9vX8CO87avFmAMzRIF+lh8",
-	"BnRjapoK6OkLZLnSRDIiH5PKVSq4UxGOpuH0q+kEUwukJUkfq0SvRdgYE3TvRaNKjC2LkqSPZH2kFI6Z",
-	"ck5AXcKpUnBVpe+10DePHl80cBdeUpaMpsQqiL8o69xTS+IYaNx50Zc8F5WyHhxw+F5CahMGpBRVAXhm",
-	"K9uDjkM/KUqQmlZmMr86deMirGkBSpOiPGXzsiGsglNH8lOltC3poVEjVl8g1VZPFycHhtvPRAs5EW5v",
-	"anLrAXzXSUm0BsnDtdE2tlHUYxyx+aZlG3BTWDG/3izuk7c318s3l9fJcvH7EkfV2vXNMrher/3zzfLt",
-	"b8nd4v3i9xBL+/HBpMP2dTJnEMVUZG77cyELovEcG8r1T69xNADUCBeglC2h40kyLYeXlrYfZSfgoCOq",
-	"LAvFuCOmFeDF3d3NHY7w5fW7Gxzhj2/uri+v3wdjcl9B2pU/Fboh4UInuTDcgl0AISfXSc1tD5VktetI",
-	"c2CQZdRWL2G3Hf0Tkrmlro7LPhCpGgYXDhEGjkK9LDjc5Hj+aVx3Le2Sa5ClBO1wrJK9j6bx/oswmj2D",
-	"b1Hjsmd76KdP5cvDWBC6IobRaKAfRsKVHC+CkEUN+UNowzrkPfVjroRLmR5ZVVqatDomQizTPepQn3So",
-	"rXgCcS5OuDziBpFrU9TN/aQePCD2jRcSKuVqc8iKQdKL5wAFWs+TqrsIEalEPdKyhCzUZEa4FIrWGs50",
-	"47ZmHdmPyrCoFbejLnZsnbhBTSRDGzUaFAmlBAXcQssWwrFRIClh9N+QJVbcljAzIXeDXCGdE328be1Q",
-	"/0RlpuCdM/X4kZpTBkcDUrdmJ+X0fG2ERnWj5W2a6NsdKMP0KJQk8ghNK85h8snbFGA/B3OO87R9Hp5k",
-	"Aa/bRD/mcAjaMObZneHvKKdqA9liGyxFaXiSe5IEwjS2OgxPlElTUCo37GRFCqNLM2Gfh5JPxiBg8IkI",
-	"3EqxlqACLV7pnyThMzM1UgLXidJQNiTTG78OOzfFqnp9mQAHWmjCHJ96TuEP7e6KDJt2MvLdaJ0Iet2d",
-	"12+b3dfU5sW86cZQzYCjs/vQwzE1uY88q+ts59BUniGOnqGtW7NTGT8SyatUnGpiLmwLfdi3fls+qIht",
-	"Q/BjAHCgfywL63gMbPx24sF08/sMJx2oVYfsXrYHKk3NZ0TDK03dMR6YNdWwpKlm9tk/jNRCUYXuFvfL",
-	"3DD05vYSR3gLUlWldzH7++zCqhMlcFJSPMc/zS5mFzhywzAXh9jPhO2XfXT4Gm+o0kLu+stPwxnyfgpN",
-	"TKSmOUm1Oo86ZiIl7JVtcM5klFAIDc/h9K/tKn4aTlzPdDZ+qj++tIw4E984EySbJKyehq+rkUYXot+D",
-	"RoVhmpasuUCoh9kKWVaUCu4PEraboeWGKgQ8KwXlGqWEI6UlkMINZh39aoeA6g1IpLS1nq8/c4I+wkqJ",
-	"9BG0lcfBQSb6q4RUFAXwDLK/ISERgzVJd+i35fLWy6V8PcORH8tRwS+zyuqF99n/v2wcvqqm+u1bnyNH",
-	"zIEkDtyOHMPXFtdgYjSBp31HMoE8dAc1ga1/yzGBpXvZsn/oDbhfX1y82Hi7PZALTLfvmz4R1SZgR5QT",
-	"/1oREt5YG1ezeDcSN0VBLJC5PPeb/BfVTXCLuMSWyCfcpBx2I6cThVVLmVSFDbAc0v88vjNA+QVQbFRC",
-	"7C+wXkBSjSQqfiqF1L5L3cdkSygjK8qofgGXT2NgfX9nCf8HQM5XkPL/fyj6ncf1f/z7YfjXzvHng55v",
-	"u6cVpSeO/X2seh5X/OQ/JTTbnyeiiutkvdocSNeUQ+wbb7vSyGyG9xZswnfJJxDnvocmIkeEH+6xD/fb",
-	"7icD30ACopxqSjRkyCjK1+gPCVpS2PobJ6J2PP1j9pkvN4DcF9+8EY5W7kcIyhSQIcHZDgmeAiI8Q/C9",
-	"pBIQyTVIT1NW9+oSvUYbYaSqH0pwme40nA+OaCI2fubTwXFw1aMGKx8MzZ6FiMd+IvBfw8JZ9xX9Acvw",
-	"dxXj2PHVgNIvAh2B1BxDEX8fWge7a+KVfatDwLdUCu5uDiJsJMNzvNG6nMe+9Gbu7W8jlJ671mAf29fY",
-	"CG+JpGTF/GhPSF9e3kH8y88//4Kj5prYfXUW9c24lSKrJjfoLRMmO2qRakx69VT9r0p8llq22aN/FZ+l",
-	"ogiZ2GLpWnrR+rNb+bD/TwAAAP//53BKX7omAAA=",
+	"H4sIAAAAAAAC/+w6bW8budF/hQ+fAtcCG8m9+3A4FffBcJSLcK5tyMrdFRdjQ+2OJMa75IbkylEN/feC",
+	"b6t94UorN9egQPMlFnfeOTMccuYZJzwvOAOmJJ4844IIkoMCYX4lnH0sWaLoFuIVzfwyZXiCP5UgdjjC",
+	"jOSAJ0HQCMtkAzkxOApyg/wnASs8wf8/PjAeWzA5vubra8rgjcHH+wirXaGJEyHIDu/3EQaWZGQLMU2B",
+	"KbqiIDTNFGQiaKEo15K9ezd7HSG54UIBgxTZ31wgLSriK6Q2gBwhHFltCqI2B2UCXCIs4FNJBaR4okQJ",
+	"dd2clFIJytZWzC0wFXfJyK60tyzbIakEkNwKplFlS0yJnqja6BUJRh/ZVlB6BeUIvYYVKTOFqNTUxK6t",
+	"bGvrQmIGt66lZ2h7jN56+VxNJSCDFpDecOkRvcbvbG+bsjVlMNUkFppFSKEVzzL+FGd83ev5dZCAVyw5",
+	"z4AwQy0jS8hiCRkkioseCyWcSZqCaO3/hktAhoBEOVGJ9gYqUcLznLySoANXQYo88QjBaD1CHxSQ/EfK",
+	"VoJES0FYsvm/H3NC2YezvaQl+3H/Z2WuDRJnlEGv4ZpAAYKUKViDMBQ5y3bxitAM0lgqIjIiHmNRsoCf",
+	"zVbIRKgxoMZD9w4BiZJ5t7OOCCkiAgykJa5Bmk64IpnsM0q/VCc8QYAqBYtJlh31rTbYCaoSxJYmx7Pj",
+	"YgPIwaEDnM83CWeKUGa8jyi3lOeEpTrflFmKloDgMySldjbKwvkzIMd5+dMTKEuaxhJUn306cMfYnJHH",
+	"qr20qlLODIuwNUtGP5UNYyqOlCDJow1hT0LbmBx80ZLRabsgySNZ95xFfaKcY1DjcLLgTNpovOHq9tEd",
+	"8AqYMS8piowmRDMYf5RauecaxWN5dO5Iz9iKW2at85jB5wIS7TAgBLfx7JA17Voe7hr4nuegNpSt0dOG",
+	"Jhu0IUVhDj3FEWE+Z2krUpM2tZGpGiG9MU+w3HD+KJGABOjWhrk0RcDyIyTaWwrBCxCKWrv4k9CmWWOV",
+	"NKVaEpLdNSD7XMgRrlUqdhsDGB7AO1YXwBxuvZ9XNAMZE6HoiiSq4tMyHyjEmc1vGh55+Or0ZZmOpw51",
+	"KhsJLZZlkoCUqzI7yqSRaVeUUbmBNMyrSlwRzkFKHQAdyq9BEZodqiFNx51s5rd2JxtWLns3+HOBnjY7",
+	"RKp8lwii5Qnp6zPJSTN6WifsVxnPBXdM06NkQ0eUpv03BHmhdmjFbUFg0aWLBiKAfaNQQYSyZjikkq6H",
+	"wudAfL0tc8JeCSApWWaAZJnnROwaBg8SozlIRfLiVHJYVIBVkJxZle3rme73elQ4gnVpWmHVCkNnhIdA",
+	"uLbZmmxQ5prj9Obq+vKXaXw1n14upq9xVK3cLy7n7ZXbu7vGyuvp/WJ++w+zpuGvL+c/x/N3NzXkxvKb",
+	"2c3s/q1dn85/mV3V2RxWPBu/cjW/dFhvZtfT+/hyvpi9ubxaVFI/BHbR3bfMxa+R3jK3OvTIfJlDtHbW",
+	"MK1TCu1T84rYEVz/TVxtPeCmeevBXXzEBVEKBAtXJXVhK0YtxCMy39Zk8771+nZ6H1/d3iwuZzfxYvrb",
+	"Akd27eZ2EVz3a3+/XFy9jefTn6a/hVDqn0M73zizO1ZMeGq2f8VFThSe4JIy9d23OOpU5o3c3eMkw6qH",
+	"YLC7+PY8IitZyMYNMvXgnc9v5zjCs5s3tzjCv17Ob2Y3PwVtcm8z+7Wrx5smYVzFK14yXWYGatPBceKx",
+	"dTkfL3cNascKjgHOHChDQoWJP2mmphbrKAp+mTO4XeHJ78d5e2ozpkAUApSpIC3tfTQM9xeS0fQFeFNf",
+	"ETu0h85ZYdYfjhmhSaJrDf89hiPmivuDICRRBf4Q2rAGeIv9MVXCoUx7VqUSZWJrgBDKcI0a0CcVqjMe",
+	"ALziJ1Q+ogYR6zL375qDHoQCZC8dkVAo283RZVPcsme3mj58778Q6JL7kRYFpKHrfYQLLqnncKYadx71",
+	"yH74Eulgt14VG7IO3KDKkqGNOmoUAYUACUynli2EbSNBUJLRf0Iaa3JbkpUDfDeIFeI5UMe72g61T9Ss",
+	"zFnjTO0/UvVNrdcgvjQ7Saela0U08oWWk2mgbnOQZaaOppJY9MDU7BwGH7xNAfRzck4/ToSPnWQBretA",
+	"X+dwCMpwTLN5yd64K3n12tIUWN/0/a09hjAMlYEHgaMRyUtVlAP2uUv5pA0CAp+wwJ3gawEyUOIV7ksc",
+	"PjOTUghgKpYKigpkeOHXQGdlvgQxKIwjrLgimcGTLwn8rtxNkmHRTlq+aa0TRvfVub9ttp5BHCCqqjHk",
+	"EXB0dh16OKYG15FnVZ11H9pHZ0vl8ugZ3JoxOxTxVyKYdcWhIurX24favrXL8k5EbCuAr5MAO/yPeaG3",
+	"R0fGpxMfhovfRjipgGcdkntRf1CpYj4lCl4pmgde+PamzrRpSVGV6W8/l0JxSSWaT+8XqzJDl3czHOEt",
+	"CGlD72L019GFZscLYKSgeIK/G12MLnBk2hDGDmPfhMST5310+DneUKm42LWXn7sN5f0QmLF/m5bnQY8z",
+	"npDslS5wzkQUkHMFL8F013Y5fu72us5Udvzs//zSNMYpf2IZJ+kgYr4PuYbAM/FPoFBeZooWWdWU9k/h",
+	"EmlU3Tp0B0m20/0XKhGwtOCUKZQQVu/8G/jlDgFVGxBIKi09W79nBP0KS8mTR1CaHgOTMtGfBeguJLAU",
+	"0r/oZ/0M1iTZobeLxZ2jS9l6hCP3LEc5m6VW6qnT2f0/qxS+tv3U+sBLzxFzABl37dabX2tYnRejATj1",
+	"qYIB4KHxmwFo7f7yAJRm137/0Gotfntx8cUai/UHuUBf8b6qE5EXARsg07rvI15JO7ZdUE3XdT2cn7tN",
+	"/kY2HRxHWBEdIr/jyuWweXI6EVieyqAorBLLwf3PwzsjKR+QpCLqTPlenPiOUhi7aYMvQMknHzl+LrhQ",
+	"rrDdj8mW0IwsaUbV7guwOZk2HZLxof+CvOiCTrr/v2rCPA/rfynzq6XMuo+/PE+6Sn1YUDrgset4y5dh",
+	"jZ8P/fn9eSSsXQfzVeUBdE0ZjG2Tvzd13Nu08KTHsOzQizRzRfWhQD2WdRh7URvII0QkohXG5ACbCCAK",
+	"0shmFfsH1w+4msp7loJUgu/0en0UQXpww8u/tESHkzFEzc9ZRA6pPnci3zMnyYlc6GYgutkQDUyG79k5",
+	"2bDq/r8gx9WGUPfRQPDQ0O0A5NYU5gCM/hHFPzQR1Sz6n0xENjw0a+dAR7ORiUN3Z9YrVWxXfTd96IcH",
+	"8E6c/PetU11P57BDaFW07JzlEwhAlFFFzRBvKbWffxCgBIWtaxYTuWPJh9F7pkfbzA9LWcfN0kxuylLP",
+	"s5qJV84SMNEHnwsqAJGVAuFgjIi6WvgWbXgppP+ow5JyZjicX6T8EWHZ6dLKzsq7kqYvqkx6tvXfj4qz",
+	"Wo3tt9HuMOrx0PlUglRfJHICrnnsNHejDN7YTRGv9YMMAralgrPcjpCVIsMTvFGqmIxd6I3Mw82GSzUx",
+	"Jfp+TAqKI7wlguqeo32V58KFl1MQ//D99z/gqJrwMD+NRG0x7gRP7aMrusp4mfZKJCuRXj3b/22IjxKN",
+	"Nnp0r2ijhOchEWsoTUkvav/0Vj7s/zUADSU1inAzAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
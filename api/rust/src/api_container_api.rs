@@ -124,6 +124,76 @@ pub mod container {
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ServiceHealth {
+    #[prost(enumeration = "service_health::Status", tag = "1")]
+    pub status: i32,
+    /// Only set if the service crashed
+    #[prost(int32, optional, tag = "2")]
+    pub exit_code: ::core::option::Option<i32>,
+    /// Number of times the container runtime restarted the container of the service after it exited
+    #[prost(uint32, tag = "3")]
+    pub restart_count: u32,
+    /// Why the service is in this status, e.g. the error of the last failed health check
+    #[prost(string, tag = "4")]
+    pub message: ::prost::alloc::string::String,
+    /// When the service got into this status
+    #[prost(message, optional, tag = "5")]
+    pub since: ::core::option::Option<::prost_types::Timestamp>,
+    /// Why the container of the service last exited (e.g. OOMKilled), empty if it never exited
+    #[prost(string, tag = "6")]
+    pub last_exit_reason: ::prost::alloc::string::String,
+}
+/// Nested message and enum types in `ServiceHealth`.
+pub mod service_health {
+    #[derive(
+        Clone,
+        Copy,
+        Debug,
+        PartialEq,
+        Eq,
+        Hash,
+        PartialOrd,
+        Ord,
+        ::prost::Enumeration
+    )]
+    #[repr(i32)]
+    pub enum Status {
+        /// e.g. the service was stopped on purpose, or its health wasn't checked yet
+        Unknown = 0,
+        /// The container of the service runs and, if the service has a health check, the last one passed
+        Healthy = 1,
+        /// The container of the service runs but its health check failed as many times in a row as its failure threshold
+        Unhealthy = 2,
+        /// The container of the service exited although the service wasn't stopped
+        Crashed = 3,
+    }
+    impl Status {
+        /// String value of the enum field names used in the ProtoBuf definition.
+        ///
+        /// The values are not transformed in any way and thus are considered stable
+        /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+        pub fn as_str_name(&self) -> &'static str {
+            match self {
+                Status::Unknown => "UNKNOWN",
+                Status::Healthy => "HEALTHY",
+                Status::Unhealthy => "UNHEALTHY",
+                Status::Crashed => "CRASHED",
+            }
+        }
+        /// Creates an enum from field names used in the ProtoBuf definition.
+        pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+            match value {
+                "UNKNOWN" => Some(Self::Unknown),
+                "HEALTHY" => Some(Self::Healthy),
+                "UNHEALTHY" => Some(Self::Unhealthy),
+                "CRASHED" => Some(Self::Crashed),
+                _ => None,
+            }
+        }
+    }
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
 pub struct ServiceInfo {
     /// UUID of the service
     #[prost(string, tag = "1")]
@@ -159,6 +229,13 @@ pub struct ServiceInfo {
     /// Docker container or Kubernetes pod container
     #[prost(message, optional, tag = "9")]
     pub container: ::core::option::Option<Container>,
+    /// Whether the service is healthy, unhealthy or crashed
+    #[prost(message, optional, tag = "10")]
+    pub health: ::core::option::Option<ServiceHealth>,
+    /// The IPv6 address of the service inside the enclave
+    /// NOTE: Will be empty if the enclave doesn't have IPv6 enabled
+    #[prost(string, tag = "11")]
+    pub private_ipv6_addr: ::prost::alloc::string::String,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -431,6 +508,91 @@ pub struct GetServicesResponse {
         ServiceInfo,
     >,
 }
+/// ==============================================================================================
+///                                             Get Service Stats
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetServiceStatsArgs {
+    /// "Set" of identifiers of the services to sample
+    /// If empty, will sample all services
+    #[prost(map = "string, bool", tag = "1")]
+    pub service_identifiers: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        bool,
+    >,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ServiceIoStats {
+    /// Since the service started
+    #[prost(uint64, tag = "1")]
+    pub network_received_bytes: u64,
+    #[prost(uint64, tag = "2")]
+    pub network_sent_bytes: u64,
+    #[prost(uint64, tag = "3")]
+    pub disk_read_bytes: u64,
+    #[prost(uint64, tag = "4")]
+    pub disk_written_bytes: u64,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ServiceStats {
+    #[prost(uint64, tag = "1")]
+    pub cpu_usage_millicores: u64,
+    #[prost(uint64, tag = "2")]
+    pub memory_usage_bytes: u64,
+    /// Zero if the memory of the service isn't limited
+    #[prost(uint64, tag = "3")]
+    pub memory_limit_bytes: u64,
+    /// Not set on Kubernetes, whose metrics API only reports CPU and memory
+    #[prost(message, optional, tag = "4")]
+    pub io_stats: ::core::option::Option<ServiceIoStats>,
+    #[prost(message, optional, tag = "5")]
+    pub sample_time: ::core::option::Option<::prost_types::Timestamp>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ServicePeakUsage {
+    #[prost(uint64, tag = "1")]
+    pub peak_cpu_usage_millicores: u64,
+    #[prost(uint64, tag = "2")]
+    pub peak_memory_usage_bytes: u64,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetServiceStatsResponse {
+    /// Service name -> stats of the service
+    #[prost(map = "string, message", tag = "1")]
+    pub service_stats: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ServiceStats,
+    >,
+    /// Service name -> why the service couldn't be sampled, e.g. because it's stopped
+    #[prost(map = "string, string", tag = "2")]
+    pub service_errors: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// Service name -> peak usage of the service over the last Starlark run, sampled periodically during the run
+    /// Empty if no Starlark run happened since the API container started
+    #[prost(map = "string, message", tag = "3")]
+    pub last_run_peak_usage: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ServicePeakUsage,
+    >,
+}
+/// ==============================================================================================
+///                                        Stream Service Health Events
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ServiceHealthEvent {
+    #[prost(string, tag = "1")]
+    pub service_name: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "2")]
+    pub health: ::core::option::Option<ServiceHealth>,
+}
 /// An service identifier is a collection of uuid, name and shortened uuid
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -687,6 +849,10 @@ pub struct GetStarlarkRunResponse {
     pub experimental_features: ::prost::alloc::vec::Vec<i32>,
     #[prost(enumeration = "RestartPolicy", tag = "8")]
     pub restart_policy: i32,
+    /// Package ID of the Starlark run being executed in the enclave right now, if any. The fields above always describe
+    /// the last run that was executed
+    #[prost(string, optional, tag = "9")]
+    pub running_package_id: ::core::option::Option<::prost::alloc::string::String>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -723,6 +889,254 @@ pub struct StarlarkPackagePlanYamlArgs {
     #[prost(string, optional, tag = "4")]
     pub main_function_name: ::core::option::Option<::prost::alloc::string::String>,
 }
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EnclaveSnapshotPersistentDirectory {
+    /// Name of the service mounting the persistent directory
+    #[prost(string, tag = "1")]
+    pub service_name: ::prost::alloc::string::String,
+    /// Path where the persistent directory is mounted inside the service
+    #[prost(string, tag = "2")]
+    pub service_dirpath: ::prost::alloc::string::String,
+    /// Key identifying the persistent directory inside the enclave
+    #[prost(string, tag = "3")]
+    pub persistent_key: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclaveSnapshotInfoResponse {
+    /// The enclave plan persisted by the API container, serialized as JSON
+    #[prost(string, tag = "1")]
+    pub serialized_enclave_plan: ::prost::alloc::string::String,
+    /// One entry per persistent key, even if the persistent directory is mounted by several services
+    #[prost(message, repeated, tag = "2")]
+    pub persistent_directories: ::prost::alloc::vec::Vec<
+        EnclaveSnapshotPersistentDirectory,
+    >,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RestoreEnclaveSnapshotArgs {
+    /// The enclave plan of the snapshot, as returned by GetEnclaveSnapshotInfo
+    #[prost(string, tag = "1")]
+    pub serialized_enclave_plan: ::prost::alloc::string::String,
+    /// Mapping of persistent key -> name of a files artifact, already uploaded to the enclave, holding the content the
+    /// persistent directory should be seeded with before the services get started
+    #[prost(map = "string, string", tag = "2")]
+    pub persistent_directory_files_artifacts: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// Defaults to 4
+    #[prost(int32, optional, tag = "3")]
+    pub parallelism: ::core::option::Option<i32>,
+    /// Mapping of service name -> digest-pinned image (e.g. postgres@sha256:...) the service was running when the snapshot
+    /// was taken, which the restored service runs instead of the image referenced in the enclave plan
+    #[prost(map = "string, string", tag = "4")]
+    pub service_images: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+}
+/// ==============================================================================================
+///                                            Secrets
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SetSecretArgs {
+    #[prost(string, tag = "1")]
+    pub name: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub value: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ListSecretsResponse {
+    /// Sorted alphabetically
+    #[prost(string, repeated, tag = "1")]
+    pub secret_names: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct RemoveSecretArgs {
+    #[prost(string, tag = "1")]
+    pub name: ::prost::alloc::string::String,
+}
+/// ==============================================================================================
+///                                        Enclave Activity
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclaveActivityResponse {
+    /// The last time a request (other than GetEnclaveActivity itself) was served; the API container start time if none was
+    #[prost(message, optional, tag = "1")]
+    pub last_activity_time: ::core::option::Option<::prost_types::Timestamp>,
+}
+/// ==============================================================================================
+///                                     Enclave Resource Usage
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclaveResourceUsageResponse {
+    #[prost(uint32, tag = "1")]
+    pub service_count: u32,
+    /// Sums of the min_cpu and min_memory reservations of the services
+    #[prost(uint64, tag = "2")]
+    pub reserved_cpu_millicores: u64,
+    #[prost(uint64, tag = "3")]
+    pub reserved_memory_megabytes: u64,
+    /// Disk space taken by the files artifacts
+    #[prost(uint64, tag = "4")]
+    pub files_artifacts_storage_bytes: u64,
+}
+/// What is left for the enclave out of the totals of its resource quotas; an unset limit is unlimited
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EnclaveResourceQuotaBudget {
+    #[prost(uint32, optional, tag = "1")]
+    pub max_services: ::core::option::Option<u32>,
+    /// Sums of the min_cpu and min_memory reservations of the services
+    #[prost(uint64, optional, tag = "2")]
+    pub max_cpu_millicores: ::core::option::Option<u64>,
+    #[prost(uint64, optional, tag = "3")]
+    pub max_memory_megabytes: ::core::option::Option<u64>,
+}
+/// ==============================================================================================
+///                                         Enclave Events
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclaveEventsArgs {
+    /// Only the events with a greater sequence number are returned; 0 returns all the events still kept
+    #[prost(uint64, tag = "1")]
+    pub after_sequence_number: u64,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclaveEventsResponse {
+    /// Ordered by sequence number
+    #[prost(message, repeated, tag = "1")]
+    pub events: ::prost::alloc::vec::Vec<EnclaveEvent>,
+    /// Identifies the event log of this API container, which starts over when the API container restarts
+    #[prost(string, tag = "2")]
+    pub event_log_uuid: ::prost::alloc::string::String,
+    /// Sequence number of the last event which happened in the enclave, 0 if none did
+    #[prost(uint64, tag = "3")]
+    pub latest_sequence_number: u64,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EnclaveEvent {
+    /// Starts at 1 and increases by 1 with every event
+    #[prost(uint64, tag = "1")]
+    pub sequence_number: u64,
+    #[prost(enumeration = "enclave_event::Type", tag = "2")]
+    pub r#type: i32,
+    #[prost(message, optional, tag = "3")]
+    pub timestamp: ::core::option::Option<::prost_types::Timestamp>,
+    /// Only set for the service events
+    #[prost(string, optional, tag = "4")]
+    pub service_name: ::core::option::Option<::prost::alloc::string::String>,
+    /// Only set for the files artifact events
+    #[prost(string, optional, tag = "5")]
+    pub files_artifact_name: ::core::option::Option<::prost::alloc::string::String>,
+    /// Only set for the Starlark run events, empty for scripts
+    #[prost(string, optional, tag = "6")]
+    pub starlark_package_id: ::core::option::Option<::prost::alloc::string::String>,
+    /// Only set for the finished Starlark runs
+    #[prost(bool, optional, tag = "7")]
+    pub is_starlark_run_successful: ::core::option::Option<bool>,
+    /// Details about the event, e.g. the error of a failed Starlark run or why a service crashed
+    #[prost(string, tag = "8")]
+    pub message: ::prost::alloc::string::String,
+}
+/// Nested message and enum types in `EnclaveEvent`.
+pub mod enclave_event {
+    #[derive(
+        Clone,
+        Copy,
+        Debug,
+        PartialEq,
+        Eq,
+        Hash,
+        PartialOrd,
+        Ord,
+        ::prost::Enumeration
+    )]
+    #[repr(i32)]
+    pub enum Type {
+        StarlarkRunStarted = 0,
+        StarlarkRunFinished = 1,
+        ServiceStarted = 2,
+        ServiceStopped = 3,
+        /// The container of the service exited although the service wasn't stopped
+        ServiceCrashed = 4,
+        FilesArtifactCreated = 5,
+    }
+    impl Type {
+        /// String value of the enum field names used in the ProtoBuf definition.
+        ///
+        /// The values are not transformed in any way and thus are considered stable
+        /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+        pub fn as_str_name(&self) -> &'static str {
+            match self {
+                Type::StarlarkRunStarted => "STARLARK_RUN_STARTED",
+                Type::StarlarkRunFinished => "STARLARK_RUN_FINISHED",
+                Type::ServiceStarted => "SERVICE_STARTED",
+                Type::ServiceStopped => "SERVICE_STOPPED",
+                Type::ServiceCrashed => "SERVICE_CRASHED",
+                Type::FilesArtifactCreated => "FILES_ARTIFACT_CREATED",
+            }
+        }
+        /// Creates an enum from field names used in the ProtoBuf definition.
+        pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+            match value {
+                "STARLARK_RUN_STARTED" => Some(Self::StarlarkRunStarted),
+                "STARLARK_RUN_FINISHED" => Some(Self::StarlarkRunFinished),
+                "SERVICE_STARTED" => Some(Self::ServiceStarted),
+                "SERVICE_STOPPED" => Some(Self::ServiceStopped),
+                "SERVICE_CRASHED" => Some(Self::ServiceCrashed),
+                "FILES_ARTIFACT_CREATED" => Some(Self::FilesArtifactCreated),
+                _ => None,
+            }
+        }
+    }
+}
+/// ==============================================================================================
+///                                         Starlark Run Trace
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetStarlarkRunTraceResponse {
+    /// Ordered by start time; empty if no Starlark run happened since the API container started
+    #[prost(message, repeated, tag = "1")]
+    pub spans: ::prost::alloc::vec::Vec<StarlarkRunSpan>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StarlarkRunSpan {
+    #[prost(string, tag = "1")]
+    pub span_id: ::prost::alloc::string::String,
+    /// Empty for the root span of the run
+    #[prost(string, tag = "2")]
+    pub parent_span_id: ::prost::alloc::string::String,
+    /// E.g. 'execute add_service' or 'pull_image'
+    #[prost(string, tag = "3")]
+    pub name: ::prost::alloc::string::String,
+    #[prost(message, optional, tag = "4")]
+    pub start_time: ::core::option::Option<::prost_types::Timestamp>,
+    #[prost(message, optional, tag = "5")]
+    pub end_time: ::core::option::Option<::prost_types::Timestamp>,
+    /// E.g. the instruction, service or image the span is about
+    #[prost(map = "string, string", tag = "6")]
+    pub attributes: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// Empty if the operation the span covers succeeded
+    #[prost(string, tag = "7")]
+    pub error: ::prost::alloc::string::String,
+}
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum ServiceStatus {
@@ -1065,6 +1479,68 @@ pub mod api_container_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
+        /// Samples the CPU, memory, network and disk I/O usage of services, along with their peak usage over the last Starlark run
+        pub async fn get_service_stats(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetServiceStatsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetServiceStatsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetServiceStats",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetServiceStats",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Streams the current health of every service, then an event every time the health of a service changes
+        pub async fn stream_service_health_events(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
+        ) -> std::result::Result<
+            tonic::Response<tonic::codec::Streaming<super::ServiceHealthEvent>>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/StreamServiceHealthEvents",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "StreamServiceHealthEvents",
+                    ),
+                );
+            self.inner.server_streaming(req, path, codec).await
+        }
         /// Returns information about all existing & historical services
         pub async fn get_existing_and_historical_service_identifiers(
             &mut self,
@@ -1489,11 +1965,311 @@ pub mod api_container_service_client {
                 );
             self.inner.unary(req, path, codec).await
         }
-    }
-}
-/// Generated server implementations.
-pub mod api_container_service_server {
-    #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
+        /// Gets the enclave plan and the persistent directories of the enclave, which are needed to snapshot the enclave
+        pub async fn get_enclave_snapshot_info(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclaveSnapshotInfoResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetEnclaveSnapshotInfo",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetEnclaveSnapshotInfo",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Recreates the services of an enclave snapshot by re-executing the snapshot enclave plan inside this (empty) enclave
+        pub async fn restore_enclave_snapshot(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RestoreEnclaveSnapshotArgs>,
+        ) -> std::result::Result<
+            tonic::Response<tonic::codec::Streaming<super::StarlarkRunResponseLine>>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/RestoreEnclaveSnapshot",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "RestoreEnclaveSnapshot",
+                    ),
+                );
+            self.inner.server_streaming(req, path, codec).await
+        }
+        /// Creates the secret, or replaces its value if it already exists
+        pub async fn set_secret(
+            &mut self,
+            request: impl tonic::IntoRequest<super::SetSecretArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/SetSecret",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("api_container_api.ApiContainerService", "SetSecret"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Lists the names of the secrets of the enclave; secret values are never returned
+        pub async fn list_secrets(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListSecretsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/ListSecrets",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "ListSecrets",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Removes a secret; services already using it keep the value they were started with
+        pub async fn remove_secret(
+            &mut self,
+            request: impl tonic::IntoRequest<super::RemoveSecretArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/RemoveSecret",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "RemoveSecret",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Gets when the API container last served a request, which the engine uses to find the idle enclaves
+        pub async fn get_enclave_activity(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclaveActivityResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetEnclaveActivity",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetEnclaveActivity",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Gets what the services and files artifacts of the enclave take out of its resource quota
+        pub async fn get_enclave_resource_usage(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclaveResourceUsageResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetEnclaveResourceUsage",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetEnclaveResourceUsage",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Sets what the services of the enclave can reserve in total given what the other enclaves of its resource quotas
+        /// reserve, which the engine keeps up to date; only the engine is allowed to call it
+        pub async fn set_enclave_resource_quota_budget(
+            &mut self,
+            request: impl tonic::IntoRequest<super::EnclaveResourceQuotaBudget>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/SetEnclaveResourceQuotaBudget",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "SetEnclaveResourceQuotaBudget",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Gets the events which happened in the enclave after the given one, which the engine polls to publish them on its
+        /// event bus
+        pub async fn get_enclave_events(
+            &mut self,
+            request: impl tonic::IntoRequest<super::GetEnclaveEventsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclaveEventsResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetEnclaveEvents",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetEnclaveEvents",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+        /// Gets the spans of the trace of the latest Starlark run of the enclave
+        pub async fn get_starlark_run_trace(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetStarlarkRunTraceResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/api_container_api.ApiContainerService/GetStarlarkRunTrace",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new(
+                        "api_container_api.ApiContainerService",
+                        "GetStarlarkRunTrace",
+                    ),
+                );
+            self.inner.unary(req, path, codec).await
+        }
+    }
+}
+/// Generated server implementations.
+pub mod api_container_service_server {
+    #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
     use tonic::codegen::*;
     /// Generated trait containing gRPC methods that should be implemented for use with ApiContainerServiceServer.
     #[async_trait]
@@ -1539,12 +2315,34 @@ pub mod api_container_service_server {
             tonic::Response<super::GetServicesResponse>,
             tonic::Status,
         >;
-        /// Returns information about all existing & historical services
-        async fn get_existing_and_historical_service_identifiers(
+        /// Samples the CPU, memory, network and disk I/O usage of services, along with their peak usage over the last Starlark run
+        async fn get_service_stats(
             &self,
-            request: tonic::Request<()>,
+            request: tonic::Request<super::GetServiceStatsArgs>,
         ) -> std::result::Result<
-            tonic::Response<super::GetExistingAndHistoricalServiceIdentifiersResponse>,
+            tonic::Response<super::GetServiceStatsResponse>,
+            tonic::Status,
+        >;
+        /// Server streaming response type for the StreamServiceHealthEvents method.
+        type StreamServiceHealthEventsStream: futures_core::Stream<
+                Item = std::result::Result<super::ServiceHealthEvent, tonic::Status>,
+            >
+            + Send
+            + 'static;
+        /// Streams the current health of every service, then an event every time the health of a service changes
+        async fn stream_service_health_events(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<Self::StreamServiceHealthEventsStream>,
+            tonic::Status,
+        >;
+        /// Returns information about all existing & historical services
+        async fn get_existing_and_historical_service_identifiers(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetExistingAndHistoricalServiceIdentifiersResponse>,
             tonic::Status,
         >;
         /// Executes the given command inside a running container
@@ -1643,6 +2441,85 @@ pub mod api_container_service_server {
             &self,
             request: tonic::Request<super::StarlarkPackagePlanYamlArgs>,
         ) -> std::result::Result<tonic::Response<super::PlanYaml>, tonic::Status>;
+        /// Gets the enclave plan and the persistent directories of the enclave, which are needed to snapshot the enclave
+        async fn get_enclave_snapshot_info(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclaveSnapshotInfoResponse>,
+            tonic::Status,
+        >;
+        /// Server streaming response type for the RestoreEnclaveSnapshot method.
+        type RestoreEnclaveSnapshotStream: futures_core::Stream<
+                Item = std::result::Result<super::StarlarkRunResponseLine, tonic::Status>,
+            >
+            + Send
+            + 'static;
+        /// Recreates the services of an enclave snapshot by re-executing the snapshot enclave plan inside this (empty) enclave
+        async fn restore_enclave_snapshot(
+            &self,
+            request: tonic::Request<super::RestoreEnclaveSnapshotArgs>,
+        ) -> std::result::Result<
+            tonic::Response<Self::RestoreEnclaveSnapshotStream>,
+            tonic::Status,
+        >;
+        /// Creates the secret, or replaces its value if it already exists
+        async fn set_secret(
+            &self,
+            request: tonic::Request<super::SetSecretArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Lists the names of the secrets of the enclave; secret values are never returned
+        async fn list_secrets(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::ListSecretsResponse>,
+            tonic::Status,
+        >;
+        /// Removes a secret; services already using it keep the value they were started with
+        async fn remove_secret(
+            &self,
+            request: tonic::Request<super::RemoveSecretArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Gets when the API container last served a request, which the engine uses to find the idle enclaves
+        async fn get_enclave_activity(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclaveActivityResponse>,
+            tonic::Status,
+        >;
+        /// Gets what the services and files artifacts of the enclave take out of its resource quota
+        async fn get_enclave_resource_usage(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclaveResourceUsageResponse>,
+            tonic::Status,
+        >;
+        /// Sets what the services of the enclave can reserve in total given what the other enclaves of its resource quotas
+        /// reserve, which the engine keeps up to date; only the engine is allowed to call it
+        async fn set_enclave_resource_quota_budget(
+            &self,
+            request: tonic::Request<super::EnclaveResourceQuotaBudget>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Gets the events which happened in the enclave after the given one, which the engine polls to publish them on its
+        /// event bus
+        async fn get_enclave_events(
+            &self,
+            request: tonic::Request<super::GetEnclaveEventsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetEnclaveEventsResponse>,
+            tonic::Status,
+        >;
+        /// Gets the spans of the trace of the latest Starlark run of the enclave
+        async fn get_starlark_run_trace(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetStarlarkRunTraceResponse>,
+            tonic::Status,
+        >;
     }
     #[derive(Debug)]
     pub struct ApiContainerServiceServer<T: ApiContainerService> {
@@ -1725,24 +2602,461 @@ pub mod api_container_service_server {
             match req.uri().path() {
                 "/api_container_api.ApiContainerService/RunStarlarkScript" => {
                     #[allow(non_camel_case_types)]
-                    struct RunStarlarkScriptSvc<T: ApiContainerService>(pub Arc<T>);
+                    struct RunStarlarkScriptSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::ServerStreamingService<super::RunStarlarkScriptArgs>
+                    for RunStarlarkScriptSvc<T> {
+                        type Response = super::StarlarkRunResponseLine;
+                        type ResponseStream = T::RunStarlarkScriptStream;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::ResponseStream>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::RunStarlarkScriptArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).run_starlark_script(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = RunStarlarkScriptSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/UploadStarlarkPackage" => {
+                    #[allow(non_camel_case_types)]
+                    struct UploadStarlarkPackageSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::ClientStreamingService<super::StreamedDataChunk>
+                    for UploadStarlarkPackageSvc<T> {
+                        type Response = ();
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                tonic::Streaming<super::StreamedDataChunk>,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).upload_starlark_package(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = UploadStarlarkPackageSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.client_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/RunStarlarkPackage" => {
+                    #[allow(non_camel_case_types)]
+                    struct RunStarlarkPackageSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::ServerStreamingService<
+                        super::RunStarlarkPackageArgs,
+                    > for RunStarlarkPackageSvc<T> {
+                        type Response = super::StarlarkRunResponseLine;
+                        type ResponseStream = T::RunStarlarkPackageStream;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::ResponseStream>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::RunStarlarkPackageArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).run_starlark_package(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = RunStarlarkPackageSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/GetServices" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetServicesSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::GetServicesArgs>
+                    for GetServicesSvc<T> {
+                        type Response = super::GetServicesResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetServicesArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_services(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetServicesSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/GetServiceStats" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetServiceStatsSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::GetServiceStatsArgs>
+                    for GetServiceStatsSvc<T> {
+                        type Response = super::GetServiceStatsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetServiceStatsArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_service_stats(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetServiceStatsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/StreamServiceHealthEvents" => {
+                    #[allow(non_camel_case_types)]
+                    struct StreamServiceHealthEventsSvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::ServerStreamingService<()>
+                    for StreamServiceHealthEventsSvc<T> {
+                        type Response = super::ServiceHealthEvent;
+                        type ResponseStream = T::StreamServiceHealthEventsStream;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::ResponseStream>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).stream_service_health_events(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = StreamServiceHealthEventsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/GetExistingAndHistoricalServiceIdentifiers" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetExistingAndHistoricalServiceIdentifiersSvc<
+                        T: ApiContainerService,
+                    >(
+                        pub Arc<T>,
+                    );
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for GetExistingAndHistoricalServiceIdentifiersSvc<T> {
+                        type Response = super::GetExistingAndHistoricalServiceIdentifiersResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner)
+                                    .get_existing_and_historical_service_identifiers(request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetExistingAndHistoricalServiceIdentifiersSvc(
+                            inner,
+                        );
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/ExecCommand" => {
+                    #[allow(non_camel_case_types)]
+                    struct ExecCommandSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::ExecCommandArgs>
+                    for ExecCommandSvc<T> {
+                        type Response = super::ExecCommandResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ExecCommandArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).exec_command(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = ExecCommandSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability" => {
+                    #[allow(non_camel_case_types)]
+                    struct WaitForHttpGetEndpointAvailabilitySvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<
+                        super::WaitForHttpGetEndpointAvailabilityArgs,
+                    > for WaitForHttpGetEndpointAvailabilitySvc<T> {
+                        type Response = ();
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::WaitForHttpGetEndpointAvailabilityArgs,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner)
+                                    .wait_for_http_get_endpoint_availability(request)
+                                    .await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = WaitForHttpGetEndpointAvailabilitySvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability" => {
+                    #[allow(non_camel_case_types)]
+                    struct WaitForHttpPostEndpointAvailabilitySvc<
+                        T: ApiContainerService,
+                    >(
+                        pub Arc<T>,
+                    );
                     impl<
                         T: ApiContainerService,
-                    > tonic::server::ServerStreamingService<super::RunStarlarkScriptArgs>
-                    for RunStarlarkScriptSvc<T> {
-                        type Response = super::StarlarkRunResponseLine;
-                        type ResponseStream = T::RunStarlarkScriptStream;
+                    > tonic::server::UnaryService<
+                        super::WaitForHttpPostEndpointAvailabilityArgs,
+                    > for WaitForHttpPostEndpointAvailabilitySvc<T> {
+                        type Response = ();
                         type Future = BoxFuture<
-                            tonic::Response<Self::ResponseStream>,
+                            tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::RunStarlarkScriptArgs>,
+                            request: tonic::Request<
+                                super::WaitForHttpPostEndpointAvailabilityArgs,
+                            >,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).run_starlark_script(request).await
+                                (*inner)
+                                    .wait_for_http_post_endpoint_availability(request)
+                                    .await
                             };
                             Box::pin(fut)
                         }
@@ -1754,7 +3068,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = RunStarlarkScriptSvc(inner);
+                        let method = WaitForHttpPostEndpointAvailabilitySvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1765,19 +3079,19 @@ pub mod api_container_service_server {
                                 max_decoding_message_size,
                                 max_encoding_message_size,
                             );
-                        let res = grpc.server_streaming(method, req).await;
+                        let res = grpc.unary(method, req).await;
                         Ok(res)
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/UploadStarlarkPackage" => {
+                "/api_container_api.ApiContainerService/UploadFilesArtifact" => {
                     #[allow(non_camel_case_types)]
-                    struct UploadStarlarkPackageSvc<T: ApiContainerService>(pub Arc<T>);
+                    struct UploadFilesArtifactSvc<T: ApiContainerService>(pub Arc<T>);
                     impl<
                         T: ApiContainerService,
                     > tonic::server::ClientStreamingService<super::StreamedDataChunk>
-                    for UploadStarlarkPackageSvc<T> {
-                        type Response = ();
+                    for UploadFilesArtifactSvc<T> {
+                        type Response = super::UploadFilesArtifactResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
@@ -1790,7 +3104,7 @@ pub mod api_container_service_server {
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).upload_starlark_package(request).await
+                                (*inner).upload_files_artifact(request).await
                             };
                             Box::pin(fut)
                         }
@@ -1802,7 +3116,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = UploadStarlarkPackageSvc(inner);
+                        let method = UploadFilesArtifactSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1818,27 +3132,27 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/RunStarlarkPackage" => {
+                "/api_container_api.ApiContainerService/DownloadFilesArtifact" => {
                     #[allow(non_camel_case_types)]
-                    struct RunStarlarkPackageSvc<T: ApiContainerService>(pub Arc<T>);
+                    struct DownloadFilesArtifactSvc<T: ApiContainerService>(pub Arc<T>);
                     impl<
                         T: ApiContainerService,
                     > tonic::server::ServerStreamingService<
-                        super::RunStarlarkPackageArgs,
-                    > for RunStarlarkPackageSvc<T> {
-                        type Response = super::StarlarkRunResponseLine;
-                        type ResponseStream = T::RunStarlarkPackageStream;
+                        super::DownloadFilesArtifactArgs,
+                    > for DownloadFilesArtifactSvc<T> {
+                        type Response = super::StreamedDataChunk;
+                        type ResponseStream = T::DownloadFilesArtifactStream;
                         type Future = BoxFuture<
                             tonic::Response<Self::ResponseStream>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::RunStarlarkPackageArgs>,
+                            request: tonic::Request<super::DownloadFilesArtifactArgs>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).run_starlark_package(request).await
+                                (*inner).download_files_artifact(request).await
                             };
                             Box::pin(fut)
                         }
@@ -1850,7 +3164,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = RunStarlarkPackageSvc(inner);
+                        let method = DownloadFilesArtifactSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1866,25 +3180,170 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/GetServices" => {
+                "/api_container_api.ApiContainerService/StoreWebFilesArtifact" => {
                     #[allow(non_camel_case_types)]
-                    struct GetServicesSvc<T: ApiContainerService>(pub Arc<T>);
+                    struct StoreWebFilesArtifactSvc<T: ApiContainerService>(pub Arc<T>);
                     impl<
                         T: ApiContainerService,
-                    > tonic::server::UnaryService<super::GetServicesArgs>
-                    for GetServicesSvc<T> {
-                        type Response = super::GetServicesResponse;
+                    > tonic::server::UnaryService<super::StoreWebFilesArtifactArgs>
+                    for StoreWebFilesArtifactSvc<T> {
+                        type Response = super::StoreWebFilesArtifactResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::StoreWebFilesArtifactArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).store_web_files_artifact(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = StoreWebFilesArtifactSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/StoreFilesArtifactFromService" => {
+                    #[allow(non_camel_case_types)]
+                    struct StoreFilesArtifactFromServiceSvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<
+                        super::StoreFilesArtifactFromServiceArgs,
+                    > for StoreFilesArtifactFromServiceSvc<T> {
+                        type Response = super::StoreFilesArtifactFromServiceResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<
+                                super::StoreFilesArtifactFromServiceArgs,
+                            >,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).store_files_artifact_from_service(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = StoreFilesArtifactFromServiceSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids" => {
+                    #[allow(non_camel_case_types)]
+                    struct ListFilesArtifactNamesAndUuidsSvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for ListFilesArtifactNamesAndUuidsSvc<T> {
+                        type Response = super::ListFilesArtifactNamesAndUuidsResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).list_files_artifact_names_and_uuids(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = ListFilesArtifactNamesAndUuidsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/api_container_api.ApiContainerService/InspectFilesArtifactContents" => {
+                    #[allow(non_camel_case_types)]
+                    struct InspectFilesArtifactContentsSvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<
+                        super::InspectFilesArtifactContentsRequest,
+                    > for InspectFilesArtifactContentsSvc<T> {
+                        type Response = super::InspectFilesArtifactContentsResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::GetServicesArgs>,
+                            request: tonic::Request<
+                                super::InspectFilesArtifactContentsRequest,
+                            >,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).get_services(request).await
+                                (*inner).inspect_files_artifact_contents(request).await
                             };
                             Box::pin(fut)
                         }
@@ -1896,7 +3355,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = GetServicesSvc(inner);
+                        let method = InspectFilesArtifactContentsSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1912,26 +3371,25 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/GetExistingAndHistoricalServiceIdentifiers" => {
+                "/api_container_api.ApiContainerService/ConnectServices" => {
                     #[allow(non_camel_case_types)]
-                    struct GetExistingAndHistoricalServiceIdentifiersSvc<
+                    struct ConnectServicesSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
                         T: ApiContainerService,
-                    >(
-                        pub Arc<T>,
-                    );
-                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
-                    for GetExistingAndHistoricalServiceIdentifiersSvc<T> {
-                        type Response = super::GetExistingAndHistoricalServiceIdentifiersResponse;
+                    > tonic::server::UnaryService<super::ConnectServicesArgs>
+                    for ConnectServicesSvc<T> {
+                        type Response = super::ConnectServicesResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ConnectServicesArgs>,
+                        ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner)
-                                    .get_existing_and_historical_service_identifiers(request)
-                                    .await
+                                (*inner).connect_services(request).await
                             };
                             Box::pin(fut)
                         }
@@ -1943,9 +3401,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = GetExistingAndHistoricalServiceIdentifiersSvc(
-                            inner,
-                        );
+                        let method = ConnectServicesSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -1961,25 +3417,20 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/ExecCommand" => {
+                "/api_container_api.ApiContainerService/GetStarlarkRun" => {
                     #[allow(non_camel_case_types)]
-                    struct ExecCommandSvc<T: ApiContainerService>(pub Arc<T>);
-                    impl<
-                        T: ApiContainerService,
-                    > tonic::server::UnaryService<super::ExecCommandArgs>
-                    for ExecCommandSvc<T> {
-                        type Response = super::ExecCommandResponse;
+                    struct GetStarlarkRunSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for GetStarlarkRunSvc<T> {
+                        type Response = super::GetStarlarkRunResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(
-                            &mut self,
-                            request: tonic::Request<super::ExecCommandArgs>,
-                        ) -> Self::Future {
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).exec_command(request).await
+                                (*inner).get_starlark_run(request).await
                             };
                             Box::pin(fut)
                         }
@@ -1991,7 +3442,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = ExecCommandSvc(inner);
+                        let method = GetStarlarkRunSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2007,32 +3458,27 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability" => {
+                "/api_container_api.ApiContainerService/GetStarlarkScriptPlanYaml" => {
                     #[allow(non_camel_case_types)]
-                    struct WaitForHttpGetEndpointAvailabilitySvc<T: ApiContainerService>(
+                    struct GetStarlarkScriptPlanYamlSvc<T: ApiContainerService>(
                         pub Arc<T>,
                     );
                     impl<
                         T: ApiContainerService,
-                    > tonic::server::UnaryService<
-                        super::WaitForHttpGetEndpointAvailabilityArgs,
-                    > for WaitForHttpGetEndpointAvailabilitySvc<T> {
-                        type Response = ();
+                    > tonic::server::UnaryService<super::StarlarkScriptPlanYamlArgs>
+                    for GetStarlarkScriptPlanYamlSvc<T> {
+                        type Response = super::PlanYaml;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<
-                                super::WaitForHttpGetEndpointAvailabilityArgs,
-                            >,
+                            request: tonic::Request<super::StarlarkScriptPlanYamlArgs>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner)
-                                    .wait_for_http_get_endpoint_availability(request)
-                                    .await
+                                (*inner).get_starlark_script_plan_yaml(request).await
                             };
                             Box::pin(fut)
                         }
@@ -2044,7 +3490,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = WaitForHttpGetEndpointAvailabilitySvc(inner);
+                        let method = GetStarlarkScriptPlanYamlSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2060,34 +3506,27 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability" => {
+                "/api_container_api.ApiContainerService/GetStarlarkPackagePlanYaml" => {
                     #[allow(non_camel_case_types)]
-                    struct WaitForHttpPostEndpointAvailabilitySvc<
-                        T: ApiContainerService,
-                    >(
+                    struct GetStarlarkPackagePlanYamlSvc<T: ApiContainerService>(
                         pub Arc<T>,
                     );
                     impl<
                         T: ApiContainerService,
-                    > tonic::server::UnaryService<
-                        super::WaitForHttpPostEndpointAvailabilityArgs,
-                    > for WaitForHttpPostEndpointAvailabilitySvc<T> {
-                        type Response = ();
+                    > tonic::server::UnaryService<super::StarlarkPackagePlanYamlArgs>
+                    for GetStarlarkPackagePlanYamlSvc<T> {
+                        type Response = super::PlanYaml;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<
-                                super::WaitForHttpPostEndpointAvailabilityArgs,
-                            >,
+                            request: tonic::Request<super::StarlarkPackagePlanYamlArgs>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner)
-                                    .wait_for_http_post_endpoint_availability(request)
-                                    .await
+                                (*inner).get_starlark_package_plan_yaml(request).await
                             };
                             Box::pin(fut)
                         }
@@ -2099,7 +3538,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = WaitForHttpPostEndpointAvailabilitySvc(inner);
+                        let method = GetStarlarkPackagePlanYamlSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2115,27 +3554,20 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/UploadFilesArtifact" => {
+                "/api_container_api.ApiContainerService/GetEnclaveSnapshotInfo" => {
                     #[allow(non_camel_case_types)]
-                    struct UploadFilesArtifactSvc<T: ApiContainerService>(pub Arc<T>);
-                    impl<
-                        T: ApiContainerService,
-                    > tonic::server::ClientStreamingService<super::StreamedDataChunk>
-                    for UploadFilesArtifactSvc<T> {
-                        type Response = super::UploadFilesArtifactResponse;
+                    struct GetEnclaveSnapshotInfoSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for GetEnclaveSnapshotInfoSvc<T> {
+                        type Response = super::GetEnclaveSnapshotInfoResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(
-                            &mut self,
-                            request: tonic::Request<
-                                tonic::Streaming<super::StreamedDataChunk>,
-                            >,
-                        ) -> Self::Future {
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).upload_files_artifact(request).await
+                                (*inner).get_enclave_snapshot_info(request).await
                             };
                             Box::pin(fut)
                         }
@@ -2147,7 +3579,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = UploadFilesArtifactSvc(inner);
+                        let method = GetEnclaveSnapshotInfoSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2158,32 +3590,32 @@ pub mod api_container_service_server {
                                 max_decoding_message_size,
                                 max_encoding_message_size,
                             );
-                        let res = grpc.client_streaming(method, req).await;
+                        let res = grpc.unary(method, req).await;
                         Ok(res)
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/DownloadFilesArtifact" => {
+                "/api_container_api.ApiContainerService/RestoreEnclaveSnapshot" => {
                     #[allow(non_camel_case_types)]
-                    struct DownloadFilesArtifactSvc<T: ApiContainerService>(pub Arc<T>);
+                    struct RestoreEnclaveSnapshotSvc<T: ApiContainerService>(pub Arc<T>);
                     impl<
                         T: ApiContainerService,
                     > tonic::server::ServerStreamingService<
-                        super::DownloadFilesArtifactArgs,
-                    > for DownloadFilesArtifactSvc<T> {
-                        type Response = super::StreamedDataChunk;
-                        type ResponseStream = T::DownloadFilesArtifactStream;
+                        super::RestoreEnclaveSnapshotArgs,
+                    > for RestoreEnclaveSnapshotSvc<T> {
+                        type Response = super::StarlarkRunResponseLine;
+                        type ResponseStream = T::RestoreEnclaveSnapshotStream;
                         type Future = BoxFuture<
                             tonic::Response<Self::ResponseStream>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::DownloadFilesArtifactArgs>,
+                            request: tonic::Request<super::RestoreEnclaveSnapshotArgs>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).download_files_artifact(request).await
+                                (*inner).restore_enclave_snapshot(request).await
                             };
                             Box::pin(fut)
                         }
@@ -2195,7 +3627,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = DownloadFilesArtifactSvc(inner);
+                        let method = RestoreEnclaveSnapshotSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2211,26 +3643,24 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/StoreWebFilesArtifact" => {
+                "/api_container_api.ApiContainerService/SetSecret" => {
                     #[allow(non_camel_case_types)]
-                    struct StoreWebFilesArtifactSvc<T: ApiContainerService>(pub Arc<T>);
+                    struct SetSecretSvc<T: ApiContainerService>(pub Arc<T>);
                     impl<
                         T: ApiContainerService,
-                    > tonic::server::UnaryService<super::StoreWebFilesArtifactArgs>
-                    for StoreWebFilesArtifactSvc<T> {
-                        type Response = super::StoreWebFilesArtifactResponse;
+                    > tonic::server::UnaryService<super::SetSecretArgs>
+                    for SetSecretSvc<T> {
+                        type Response = ();
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::StoreWebFilesArtifactArgs>,
+                            request: tonic::Request<super::SetSecretArgs>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
-                            let fut = async move {
-                                (*inner).store_web_files_artifact(request).await
-                            };
+                            let fut = async move { (*inner).set_secret(request).await };
                             Box::pin(fut)
                         }
                     }
@@ -2241,7 +3671,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = StoreWebFilesArtifactSvc(inner);
+                        let method = SetSecretSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2257,30 +3687,20 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/StoreFilesArtifactFromService" => {
+                "/api_container_api.ApiContainerService/ListSecrets" => {
                     #[allow(non_camel_case_types)]
-                    struct StoreFilesArtifactFromServiceSvc<T: ApiContainerService>(
-                        pub Arc<T>,
-                    );
-                    impl<
-                        T: ApiContainerService,
-                    > tonic::server::UnaryService<
-                        super::StoreFilesArtifactFromServiceArgs,
-                    > for StoreFilesArtifactFromServiceSvc<T> {
-                        type Response = super::StoreFilesArtifactFromServiceResponse;
+                    struct ListSecretsSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for ListSecretsSvc<T> {
+                        type Response = super::ListSecretsResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(
-                            &mut self,
-                            request: tonic::Request<
-                                super::StoreFilesArtifactFromServiceArgs,
-                            >,
-                        ) -> Self::Future {
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).store_files_artifact_from_service(request).await
+                                (*inner).list_secrets(request).await
                             };
                             Box::pin(fut)
                         }
@@ -2292,7 +3712,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = StoreFilesArtifactFromServiceSvc(inner);
+                        let method = ListSecretsSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2308,22 +3728,25 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids" => {
+                "/api_container_api.ApiContainerService/RemoveSecret" => {
                     #[allow(non_camel_case_types)]
-                    struct ListFilesArtifactNamesAndUuidsSvc<T: ApiContainerService>(
-                        pub Arc<T>,
-                    );
-                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
-                    for ListFilesArtifactNamesAndUuidsSvc<T> {
-                        type Response = super::ListFilesArtifactNamesAndUuidsResponse;
+                    struct RemoveSecretSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::RemoveSecretArgs>
+                    for RemoveSecretSvc<T> {
+                        type Response = ();
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::RemoveSecretArgs>,
+                        ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).list_files_artifact_names_and_uuids(request).await
+                                (*inner).remove_secret(request).await
                             };
                             Box::pin(fut)
                         }
@@ -2335,7 +3758,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = ListFilesArtifactNamesAndUuidsSvc(inner);
+                        let method = RemoveSecretSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2351,30 +3774,20 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/InspectFilesArtifactContents" => {
+                "/api_container_api.ApiContainerService/GetEnclaveActivity" => {
                     #[allow(non_camel_case_types)]
-                    struct InspectFilesArtifactContentsSvc<T: ApiContainerService>(
-                        pub Arc<T>,
-                    );
-                    impl<
-                        T: ApiContainerService,
-                    > tonic::server::UnaryService<
-                        super::InspectFilesArtifactContentsRequest,
-                    > for InspectFilesArtifactContentsSvc<T> {
-                        type Response = super::InspectFilesArtifactContentsResponse;
+                    struct GetEnclaveActivitySvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for GetEnclaveActivitySvc<T> {
+                        type Response = super::GetEnclaveActivityResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(
-                            &mut self,
-                            request: tonic::Request<
-                                super::InspectFilesArtifactContentsRequest,
-                            >,
-                        ) -> Self::Future {
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).inspect_files_artifact_contents(request).await
+                                (*inner).get_enclave_activity(request).await
                             };
                             Box::pin(fut)
                         }
@@ -2386,7 +3799,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = InspectFilesArtifactContentsSvc(inner);
+                        let method = GetEnclaveActivitySvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2402,25 +3815,22 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/ConnectServices" => {
+                "/api_container_api.ApiContainerService/GetEnclaveResourceUsage" => {
                     #[allow(non_camel_case_types)]
-                    struct ConnectServicesSvc<T: ApiContainerService>(pub Arc<T>);
-                    impl<
-                        T: ApiContainerService,
-                    > tonic::server::UnaryService<super::ConnectServicesArgs>
-                    for ConnectServicesSvc<T> {
-                        type Response = super::ConnectServicesResponse;
+                    struct GetEnclaveResourceUsageSvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for GetEnclaveResourceUsageSvc<T> {
+                        type Response = super::GetEnclaveResourceUsageResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(
-                            &mut self,
-                            request: tonic::Request<super::ConnectServicesArgs>,
-                        ) -> Self::Future {
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).connect_services(request).await
+                                (*inner).get_enclave_resource_usage(request).await
                             };
                             Box::pin(fut)
                         }
@@ -2432,7 +3842,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = ConnectServicesSvc(inner);
+                        let method = GetEnclaveResourceUsageSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2448,20 +3858,27 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/GetStarlarkRun" => {
+                "/api_container_api.ApiContainerService/SetEnclaveResourceQuotaBudget" => {
                     #[allow(non_camel_case_types)]
-                    struct GetStarlarkRunSvc<T: ApiContainerService>(pub Arc<T>);
-                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
-                    for GetStarlarkRunSvc<T> {
-                        type Response = super::GetStarlarkRunResponse;
+                    struct SetEnclaveResourceQuotaBudgetSvc<T: ApiContainerService>(
+                        pub Arc<T>,
+                    );
+                    impl<
+                        T: ApiContainerService,
+                    > tonic::server::UnaryService<super::EnclaveResourceQuotaBudget>
+                    for SetEnclaveResourceQuotaBudgetSvc<T> {
+                        type Response = ();
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::EnclaveResourceQuotaBudget>,
+                        ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).get_starlark_run(request).await
+                                (*inner).set_enclave_resource_quota_budget(request).await
                             };
                             Box::pin(fut)
                         }
@@ -2473,7 +3890,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = GetStarlarkRunSvc(inner);
+                        let method = SetEnclaveResourceQuotaBudgetSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2489,27 +3906,25 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/GetStarlarkScriptPlanYaml" => {
+                "/api_container_api.ApiContainerService/GetEnclaveEvents" => {
                     #[allow(non_camel_case_types)]
-                    struct GetStarlarkScriptPlanYamlSvc<T: ApiContainerService>(
-                        pub Arc<T>,
-                    );
+                    struct GetEnclaveEventsSvc<T: ApiContainerService>(pub Arc<T>);
                     impl<
                         T: ApiContainerService,
-                    > tonic::server::UnaryService<super::StarlarkScriptPlanYamlArgs>
-                    for GetStarlarkScriptPlanYamlSvc<T> {
-                        type Response = super::PlanYaml;
+                    > tonic::server::UnaryService<super::GetEnclaveEventsArgs>
+                    for GetEnclaveEventsSvc<T> {
+                        type Response = super::GetEnclaveEventsResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
                         fn call(
                             &mut self,
-                            request: tonic::Request<super::StarlarkScriptPlanYamlArgs>,
+                            request: tonic::Request<super::GetEnclaveEventsArgs>,
                         ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).get_starlark_script_plan_yaml(request).await
+                                (*inner).get_enclave_events(request).await
                             };
                             Box::pin(fut)
                         }
@@ -2521,7 +3936,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = GetStarlarkScriptPlanYamlSvc(inner);
+                        let method = GetEnclaveEventsSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
@@ -2537,27 +3952,20 @@ pub mod api_container_service_server {
                     };
                     Box::pin(fut)
                 }
-                "/api_container_api.ApiContainerService/GetStarlarkPackagePlanYaml" => {
+                "/api_container_api.ApiContainerService/GetStarlarkRunTrace" => {
                     #[allow(non_camel_case_types)]
-                    struct GetStarlarkPackagePlanYamlSvc<T: ApiContainerService>(
-                        pub Arc<T>,
-                    );
-                    impl<
-                        T: ApiContainerService,
-                    > tonic::server::UnaryService<super::StarlarkPackagePlanYamlArgs>
-                    for GetStarlarkPackagePlanYamlSvc<T> {
-                        type Response = super::PlanYaml;
+                    struct GetStarlarkRunTraceSvc<T: ApiContainerService>(pub Arc<T>);
+                    impl<T: ApiContainerService> tonic::server::UnaryService<()>
+                    for GetStarlarkRunTraceSvc<T> {
+                        type Response = super::GetStarlarkRunTraceResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(
-                            &mut self,
-                            request: tonic::Request<super::StarlarkPackagePlanYamlArgs>,
-                        ) -> Self::Future {
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
-                                (*inner).get_starlark_package_plan_yaml(request).await
+                                (*inner).get_starlark_run_trace(request).await
                             };
                             Box::pin(fut)
                         }
@@ -2569,7 +3977,7 @@ pub mod api_container_service_server {
                     let inner = self.inner.clone();
                     let fut = async move {
                         let inner = inner.0;
-                        let method = GetStarlarkPackagePlanYamlSvc(inner);
+                        let method = GetStarlarkRunTraceSvc(inner);
                         let codec = tonic::codec::ProstCodec::default();
                         let mut grpc = tonic::server::Grpc::new(codec)
                             .apply_compression_config(
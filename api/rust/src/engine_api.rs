@@ -9,6 +9,68 @@ pub struct GetEngineInfoResponse {
     pub engine_version: ::prost::alloc::string::String,
 }
 /// ==============================================================================================
+///                                    Get Resource Quota Usage
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetResourceQuotaUsageResponse {
+    #[prost(message, repeated, tag = "1")]
+    pub resource_quota_usages: ::prost::alloc::vec::Vec<ResourceQuotaUsage>,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ResourceQuotaUsage {
+    #[prost(string, tag = "1")]
+    pub name: ::prost::alloc::string::String,
+    /// Only one of the user and the label selector is set
+    /// The quota applies to the enclaves created by this user
+    #[prost(string, tag = "2")]
+    pub user: ::prost::alloc::string::String,
+    /// The quota applies to the enclaves whose labels match this selector
+    #[prost(string, tag = "3")]
+    pub label_selector: ::prost::alloc::string::String,
+    /// The limits of the quota; zero means unlimited
+    #[prost(uint32, tag = "4")]
+    pub max_enclaves: u32,
+    #[prost(uint32, tag = "5")]
+    pub max_services_per_enclave: u32,
+    #[prost(uint64, tag = "6")]
+    pub max_cpu_millicores_per_enclave: u64,
+    #[prost(uint64, tag = "7")]
+    pub max_memory_megabytes_per_enclave: u64,
+    #[prost(uint64, tag = "8")]
+    pub max_files_artifacts_megabytes_per_enclave: u64,
+    /// Usage of the enclaves the quota applies to, which are counted against the max enclaves whether they're running or not
+    #[prost(message, repeated, tag = "9")]
+    pub enclave_usages: ::prost::alloc::vec::Vec<EnclaveResourceUsage>,
+    /// The limits on what the running enclaves of the quota take together; zero means unlimited
+    #[prost(uint32, tag = "10")]
+    pub max_services: u32,
+    #[prost(uint64, tag = "11")]
+    pub max_cpu_millicores: u64,
+    #[prost(uint64, tag = "12")]
+    pub max_memory_megabytes: u64,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EnclaveResourceUsage {
+    #[prost(string, tag = "1")]
+    pub enclave_uuid: ::prost::alloc::string::String,
+    #[prost(string, tag = "2")]
+    pub enclave_name: ::prost::alloc::string::String,
+    /// False if the enclave is stopped or its API container couldn't be reached, in which case the usage is unknown
+    #[prost(bool, tag = "3")]
+    pub is_usage_known: bool,
+    #[prost(uint32, tag = "4")]
+    pub service_count: u32,
+    #[prost(uint64, tag = "5")]
+    pub reserved_cpu_millicores: u64,
+    #[prost(uint64, tag = "6")]
+    pub reserved_memory_megabytes: u64,
+    #[prost(uint64, tag = "7")]
+    pub files_artifacts_storage_bytes: u64,
+}
+/// ==============================================================================================
 ///                                         Create Enclave
 /// ==============================================================================================
 #[allow(clippy::derive_partial_eq_without_eq)]
@@ -32,6 +94,28 @@ pub struct CreateEnclaveArgs {
     /// This is not an EnclaveMode because we will need to debug both current Modes (Test and Prod)
     #[prost(bool, optional, tag = "5")]
     pub should_apic_run_in_debug_mode: ::core::option::Option<bool>,
+    /// Whether the enclave network should be a dual-stack network, giving every service an IPv6 address as well
+    #[prost(bool, optional, tag = "6")]
+    pub is_ipv6_enabled: ::core::option::Option<bool>,
+    /// The enclave expires this many seconds after its creation; it never expires if unset
+    #[prost(int64, optional, tag = "7")]
+    pub ttl_seconds: ::core::option::Option<i64>,
+    /// The enclave expires once its API container hasn't served any request for this many seconds; it never expires if unset
+    #[prost(int64, optional, tag = "8")]
+    pub idle_timeout_seconds: ::core::option::Option<i64>,
+    /// What the engine does with the enclave once it expires; defaults to destroying it
+    #[prost(enumeration = "EnclaveExpiryAction", optional, tag = "9")]
+    pub expiry_action: ::core::option::Option<i32>,
+    /// Key/value labels to tell which pipeline, branch or developer the enclave belongs to
+    #[prost(map = "string, string", tag = "10")]
+    pub labels: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// The enclave pool template to create the enclave from: a warm enclave of the template gets handed out if the
+    /// pool has one, otherwise the enclave gets created and the packages of the template run in it
+    #[prost(string, optional, tag = "11")]
+    pub from_template: ::core::option::Option<::prost::alloc::string::String>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -99,6 +183,26 @@ pub struct EnclaveInfo {
     pub creation_time: ::core::option::Option<::prost_types::Timestamp>,
     #[prost(enumeration = "EnclaveMode", tag = "9")]
     pub mode: i32,
+    /// When the engine will stop or destroy the enclave; not present if the enclave never expires
+    #[prost(message, optional, tag = "10")]
+    pub expiry_time: ::core::option::Option<::prost_types::Timestamp>,
+    /// The labels given to the enclave when it was created
+    #[prost(map = "string, string", tag = "11")]
+    pub labels: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// Sent as a bearer token on the calls to the API container when the engine requires authentication; it allows the
+    /// caller to inspect the enclave, and to change it if they created it or are an admin. Empty otherwise
+    #[prost(string, tag = "12")]
+    pub api_container_access_token: ::prost::alloc::string::String,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GetEnclavesArgs {
+    /// Only the enclaves whose labels match this selector are returned, e.g. 'team=infra,branch!=main'; all of them if unset
+    #[prost(string, optional, tag = "1")]
+    pub label_selector: ::core::option::Option<::prost::alloc::string::String>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -141,6 +245,36 @@ pub struct StopEnclaveArgs {
     pub enclave_identifier: ::prost::alloc::string::String,
 }
 /// ==============================================================================================
+///                                        Start Enclave
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StartEnclaveArgs {
+    /// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to start
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+}
+/// ==============================================================================================
+///                                        Extend Enclave
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ExtendEnclaveArgs {
+    /// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to extend
+    #[prost(string, tag = "1")]
+    pub enclave_identifier: ::prost::alloc::string::String,
+    /// How many seconds to add to the expiry, counting from now if the enclave was about to expire already
+    #[prost(int64, tag = "2")]
+    pub extension_seconds: i64,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ExtendEnclaveResponse {
+    /// The new expiry time of the enclave
+    #[prost(message, optional, tag = "1")]
+    pub expiry_time: ::core::option::Option<::prost_types::Timestamp>,
+}
+/// ==============================================================================================
 ///                                        Destroy Enclave
 /// ==============================================================================================
 #[allow(clippy::derive_partial_eq_without_eq)]
@@ -159,6 +293,9 @@ pub struct CleanArgs {
     /// If true, It will clean even the running enclaves
     #[prost(bool, optional, tag = "1")]
     pub should_clean_all: ::core::option::Option<bool>,
+    /// Only the enclaves whose labels match this selector are cleaned; all of them if unset
+    #[prost(string, optional, tag = "2")]
+    pub label_selector: ::core::option::Option<::prost::alloc::string::String>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -236,6 +373,61 @@ pub struct LogLineFilter {
     #[prost(string, tag = "2")]
     pub text_pattern: ::prost::alloc::string::String,
 }
+/// ==============================================================================================
+///                                         Engine Events
+/// ==============================================================================================
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StreamEngineEventsArgs {
+    /// Only the events of these types are streamed; all of them if empty
+    #[prost(enumeration = "EngineEventType", repeated, tag = "1")]
+    pub event_types: ::prost::alloc::vec::Vec<i32>,
+    /// Only the events of these enclaves, given by UUID, shortened UUID or name, are streamed; the events of every enclave if empty
+    #[prost(string, repeated, tag = "2")]
+    pub enclave_identifiers: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// Only the events of the enclaves whose labels match this selector are streamed, e.g. 'team=infra,branch!=main'
+    #[prost(string, optional, tag = "3")]
+    pub enclave_label_selector: ::core::option::Option<::prost::alloc::string::String>,
+    /// If true, the finished Starlark runs are only streamed when they failed
+    #[prost(bool, tag = "4")]
+    pub only_failed_starlark_runs: bool,
+}
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EngineEvent {
+    /// Unique across all the events, so that the receivers can ignore the events they already got
+    #[prost(string, tag = "1")]
+    pub event_uuid: ::prost::alloc::string::String,
+    #[prost(enumeration = "EngineEventType", tag = "2")]
+    pub r#type: i32,
+    #[prost(message, optional, tag = "3")]
+    pub timestamp: ::core::option::Option<::prost_types::Timestamp>,
+    #[prost(string, tag = "4")]
+    pub enclave_uuid: ::prost::alloc::string::String,
+    #[prost(string, tag = "5")]
+    pub enclave_name: ::prost::alloc::string::String,
+    /// The labels of the enclave when the event happened
+    #[prost(map = "string, string", tag = "6")]
+    pub enclave_labels: ::std::collections::HashMap<
+        ::prost::alloc::string::String,
+        ::prost::alloc::string::String,
+    >,
+    /// Only set for the service events
+    #[prost(string, optional, tag = "7")]
+    pub service_name: ::core::option::Option<::prost::alloc::string::String>,
+    /// Only set for the files artifact events
+    #[prost(string, optional, tag = "8")]
+    pub files_artifact_name: ::core::option::Option<::prost::alloc::string::String>,
+    /// Only set for the Starlark run events, empty for scripts
+    #[prost(string, optional, tag = "9")]
+    pub starlark_package_id: ::core::option::Option<::prost::alloc::string::String>,
+    /// Only set for the finished Starlark runs
+    #[prost(bool, optional, tag = "10")]
+    pub is_starlark_run_successful: ::core::option::Option<bool>,
+    /// Details about the event, e.g. the error of a failed Starlark run or why a service crashed
+    #[prost(string, tag = "11")]
+    pub message: ::prost::alloc::string::String,
+}
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum EnclaveMode {
@@ -262,6 +454,33 @@ impl EnclaveMode {
         }
     }
 }
+/// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum EnclaveExpiryAction {
+    Destroy = 0,
+    Stop = 1,
+}
+impl EnclaveExpiryAction {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            EnclaveExpiryAction::Destroy => "EnclaveExpiryAction_DESTROY",
+            EnclaveExpiryAction::Stop => "EnclaveExpiryAction_STOP",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "EnclaveExpiryAction_DESTROY" => Some(Self::Destroy),
+            "EnclaveExpiryAction_STOP" => Some(Self::Stop),
+            _ => None,
+        }
+    }
+}
 /// ==============================================================================================
 ///                                             Get Enclaves
 /// ==============================================================================================
@@ -381,6 +600,62 @@ impl LogLineOperator {
         }
     }
 }
+/// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum EngineEventType {
+    EnclaveCreated = 0,
+    EnclaveStarted = 1,
+    EnclaveStopped = 2,
+    EnclaveDestroyed = 3,
+    StarlarkRunStarted = 4,
+    StarlarkRunFinished = 5,
+    ServiceStarted = 6,
+    ServiceStopped = 7,
+    /// The container of the service exited although the service wasn't stopped
+    ServiceCrashed = 8,
+    FilesArtifactCreated = 9,
+}
+impl EngineEventType {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            EngineEventType::EnclaveCreated => "EngineEventType_ENCLAVE_CREATED",
+            EngineEventType::EnclaveStarted => "EngineEventType_ENCLAVE_STARTED",
+            EngineEventType::EnclaveStopped => "EngineEventType_ENCLAVE_STOPPED",
+            EngineEventType::EnclaveDestroyed => "EngineEventType_ENCLAVE_DESTROYED",
+            EngineEventType::StarlarkRunStarted => "EngineEventType_STARLARK_RUN_STARTED",
+            EngineEventType::StarlarkRunFinished => {
+                "EngineEventType_STARLARK_RUN_FINISHED"
+            }
+            EngineEventType::ServiceStarted => "EngineEventType_SERVICE_STARTED",
+            EngineEventType::ServiceStopped => "EngineEventType_SERVICE_STOPPED",
+            EngineEventType::ServiceCrashed => "EngineEventType_SERVICE_CRASHED",
+            EngineEventType::FilesArtifactCreated => {
+                "EngineEventType_FILES_ARTIFACT_CREATED"
+            }
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "EngineEventType_ENCLAVE_CREATED" => Some(Self::EnclaveCreated),
+            "EngineEventType_ENCLAVE_STARTED" => Some(Self::EnclaveStarted),
+            "EngineEventType_ENCLAVE_STOPPED" => Some(Self::EnclaveStopped),
+            "EngineEventType_ENCLAVE_DESTROYED" => Some(Self::EnclaveDestroyed),
+            "EngineEventType_STARLARK_RUN_STARTED" => Some(Self::StarlarkRunStarted),
+            "EngineEventType_STARLARK_RUN_FINISHED" => Some(Self::StarlarkRunFinished),
+            "EngineEventType_SERVICE_STARTED" => Some(Self::ServiceStarted),
+            "EngineEventType_SERVICE_STOPPED" => Some(Self::ServiceStopped),
+            "EngineEventType_SERVICE_CRASHED" => Some(Self::ServiceCrashed),
+            "EngineEventType_FILES_ARTIFACT_CREATED" => Some(Self::FilesArtifactCreated),
+            _ => None,
+        }
+    }
+}
 /// Generated client implementations.
 pub mod engine_service_client {
     #![allow(unused_variables, dead_code, missing_docs, clippy::let_unit_value)]
@@ -492,6 +767,34 @@ pub mod engine_service_client {
                 .insert(GrpcMethod::new("engine_api.EngineService", "GetEngineInfo"));
             self.inner.unary(req, path, codec).await
         }
+        /// Gets the resource quotas set on the engine, and how much of them the enclaves they apply to take
+        pub async fn get_resource_quota_usage(
+            &mut self,
+            request: impl tonic::IntoRequest<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetResourceQuotaUsageResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/GetResourceQuotaUsage",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("engine_api.EngineService", "GetResourceQuotaUsage"),
+                );
+            self.inner.unary(req, path, codec).await
+        }
         /// ==============================================================================================
         ///                                   Enclave Management
         /// ==============================================================================================
@@ -524,7 +827,7 @@ pub mod engine_service_client {
         /// Returns information about the existing enclaves
         pub async fn get_enclaves(
             &mut self,
-            request: impl tonic::IntoRequest<()>,
+            request: impl tonic::IntoRequest<super::GetEnclavesArgs>,
         ) -> std::result::Result<
             tonic::Response<super::GetEnclavesResponse>,
             tonic::Status,
@@ -601,6 +904,55 @@ pub mod engine_service_client {
                 .insert(GrpcMethod::new("engine_api.EngineService", "StopEnclave"));
             self.inner.unary(req, path, codec).await
         }
+        /// Starts a stopped enclave again, with the user services which were running when it got stopped
+        pub async fn start_enclave(
+            &mut self,
+            request: impl tonic::IntoRequest<super::StartEnclaveArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status> {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/StartEnclave",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "StartEnclave"));
+            self.inner.unary(req, path, codec).await
+        }
+        /// Pushes back the expiry of an enclave created with a TTL or an idle timeout
+        pub async fn extend_enclave(
+            &mut self,
+            request: impl tonic::IntoRequest<super::ExtendEnclaveArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::ExtendEnclaveResponse>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/ExtendEnclave",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(GrpcMethod::new("engine_api.EngineService", "ExtendEnclave"));
+            self.inner.unary(req, path, codec).await
+        }
         /// Destroys an enclave, removing all artifacts associated with it
         pub async fn destroy_enclave(
             &mut self,
@@ -673,6 +1025,37 @@ pub mod engine_service_client {
                 .insert(GrpcMethod::new("engine_api.EngineService", "GetServiceLogs"));
             self.inner.server_streaming(req, path, codec).await
         }
+        /// ==============================================================================================
+        ///                                        Engine Events
+        /// ==============================================================================================
+        /// Streams the events matching the filter as they happen in the engine and its enclaves, until the client closes the stream
+        pub async fn stream_engine_events(
+            &mut self,
+            request: impl tonic::IntoRequest<super::StreamEngineEventsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<tonic::codec::Streaming<super::EngineEvent>>,
+            tonic::Status,
+        > {
+            self.inner
+                .ready()
+                .await
+                .map_err(|e| {
+                    tonic::Status::new(
+                        tonic::Code::Unknown,
+                        format!("Service was not ready: {}", e.into()),
+                    )
+                })?;
+            let codec = tonic::codec::ProstCodec::default();
+            let path = http::uri::PathAndQuery::from_static(
+                "/engine_api.EngineService/StreamEngineEvents",
+            );
+            let mut req = request.into_request();
+            req.extensions_mut()
+                .insert(
+                    GrpcMethod::new("engine_api.EngineService", "StreamEngineEvents"),
+                );
+            self.inner.server_streaming(req, path, codec).await
+        }
     }
 }
 /// Generated server implementations.
@@ -690,6 +1073,14 @@ pub mod engine_service_server {
             tonic::Response<super::GetEngineInfoResponse>,
             tonic::Status,
         >;
+        /// Gets the resource quotas set on the engine, and how much of them the enclaves they apply to take
+        async fn get_resource_quota_usage(
+            &self,
+            request: tonic::Request<()>,
+        ) -> std::result::Result<
+            tonic::Response<super::GetResourceQuotaUsageResponse>,
+            tonic::Status,
+        >;
         /// ==============================================================================================
         ///                                   Enclave Management
         /// ==============================================================================================
@@ -704,7 +1095,7 @@ pub mod engine_service_server {
         /// Returns information about the existing enclaves
         async fn get_enclaves(
             &self,
-            request: tonic::Request<()>,
+            request: tonic::Request<super::GetEnclavesArgs>,
         ) -> std::result::Result<
             tonic::Response<super::GetEnclavesResponse>,
             tonic::Status,
@@ -722,6 +1113,19 @@ pub mod engine_service_server {
             &self,
             request: tonic::Request<super::StopEnclaveArgs>,
         ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Starts a stopped enclave again, with the user services which were running when it got stopped
+        async fn start_enclave(
+            &self,
+            request: tonic::Request<super::StartEnclaveArgs>,
+        ) -> std::result::Result<tonic::Response<()>, tonic::Status>;
+        /// Pushes back the expiry of an enclave created with a TTL or an idle timeout
+        async fn extend_enclave(
+            &self,
+            request: tonic::Request<super::ExtendEnclaveArgs>,
+        ) -> std::result::Result<
+            tonic::Response<super::ExtendEnclaveResponse>,
+            tonic::Status,
+        >;
         /// Destroys an enclave, removing all artifacts associated with it
         async fn destroy_enclave(
             &self,
@@ -746,6 +1150,23 @@ pub mod engine_service_server {
             tonic::Response<Self::GetServiceLogsStream>,
             tonic::Status,
         >;
+        /// Server streaming response type for the StreamEngineEvents method.
+        type StreamEngineEventsStream: futures_core::Stream<
+                Item = std::result::Result<super::EngineEvent, tonic::Status>,
+            >
+            + Send
+            + 'static;
+        /// ==============================================================================================
+        ///                                        Engine Events
+        /// ==============================================================================================
+        /// Streams the events matching the filter as they happen in the engine and its enclaves, until the client closes the stream
+        async fn stream_engine_events(
+            &self,
+            request: tonic::Request<super::StreamEngineEventsArgs>,
+        ) -> std::result::Result<
+            tonic::Response<Self::StreamEngineEventsStream>,
+            tonic::Status,
+        >;
     }
     #[derive(Debug)]
     pub struct EngineServiceServer<T: EngineService> {
@@ -867,6 +1288,47 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/engine_api.EngineService/GetResourceQuotaUsage" => {
+                    #[allow(non_camel_case_types)]
+                    struct GetResourceQuotaUsageSvc<T: EngineService>(pub Arc<T>);
+                    impl<T: EngineService> tonic::server::UnaryService<()>
+                    for GetResourceQuotaUsageSvc<T> {
+                        type Response = super::GetResourceQuotaUsageResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).get_resource_quota_usage(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = GetResourceQuotaUsageSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/engine_api.EngineService/CreateEnclave" => {
                     #[allow(non_camel_case_types)]
                     struct CreateEnclaveSvc<T: EngineService>(pub Arc<T>);
@@ -916,14 +1378,19 @@ pub mod engine_service_server {
                 "/engine_api.EngineService/GetEnclaves" => {
                     #[allow(non_camel_case_types)]
                     struct GetEnclavesSvc<T: EngineService>(pub Arc<T>);
-                    impl<T: EngineService> tonic::server::UnaryService<()>
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::GetEnclavesArgs>
                     for GetEnclavesSvc<T> {
                         type Response = super::GetEnclavesResponse;
                         type Future = BoxFuture<
                             tonic::Response<Self::Response>,
                             tonic::Status,
                         >;
-                        fn call(&mut self, request: tonic::Request<()>) -> Self::Future {
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::GetEnclavesArgs>,
+                        ) -> Self::Future {
                             let inner = Arc::clone(&self.0);
                             let fut = async move {
                                 (*inner).get_enclaves(request).await
@@ -1049,6 +1516,98 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/engine_api.EngineService/StartEnclave" => {
+                    #[allow(non_camel_case_types)]
+                    struct StartEnclaveSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::StartEnclaveArgs>
+                    for StartEnclaveSvc<T> {
+                        type Response = ();
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::StartEnclaveArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).start_enclave(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = StartEnclaveSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
+                "/engine_api.EngineService/ExtendEnclave" => {
+                    #[allow(non_camel_case_types)]
+                    struct ExtendEnclaveSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::UnaryService<super::ExtendEnclaveArgs>
+                    for ExtendEnclaveSvc<T> {
+                        type Response = super::ExtendEnclaveResponse;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::Response>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::ExtendEnclaveArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).extend_enclave(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = ExtendEnclaveSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.unary(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 "/engine_api.EngineService/DestroyEnclave" => {
                     #[allow(non_camel_case_types)]
                     struct DestroyEnclaveSvc<T: EngineService>(pub Arc<T>);
@@ -1184,6 +1743,54 @@ pub mod engine_service_server {
                     };
                     Box::pin(fut)
                 }
+                "/engine_api.EngineService/StreamEngineEvents" => {
+                    #[allow(non_camel_case_types)]
+                    struct StreamEngineEventsSvc<T: EngineService>(pub Arc<T>);
+                    impl<
+                        T: EngineService,
+                    > tonic::server::ServerStreamingService<
+                        super::StreamEngineEventsArgs,
+                    > for StreamEngineEventsSvc<T> {
+                        type Response = super::EngineEvent;
+                        type ResponseStream = T::StreamEngineEventsStream;
+                        type Future = BoxFuture<
+                            tonic::Response<Self::ResponseStream>,
+                            tonic::Status,
+                        >;
+                        fn call(
+                            &mut self,
+                            request: tonic::Request<super::StreamEngineEventsArgs>,
+                        ) -> Self::Future {
+                            let inner = Arc::clone(&self.0);
+                            let fut = async move {
+                                (*inner).stream_engine_events(request).await
+                            };
+                            Box::pin(fut)
+                        }
+                    }
+                    let accept_compression_encodings = self.accept_compression_encodings;
+                    let send_compression_encodings = self.send_compression_encodings;
+                    let max_decoding_message_size = self.max_decoding_message_size;
+                    let max_encoding_message_size = self.max_encoding_message_size;
+                    let inner = self.inner.clone();
+                    let fut = async move {
+                        let inner = inner.0;
+                        let method = StreamEngineEventsSvc(inner);
+                        let codec = tonic::codec::ProstCodec::default();
+                        let mut grpc = tonic::server::Grpc::new(codec)
+                            .apply_compression_config(
+                                accept_compression_encodings,
+                                send_compression_encodings,
+                            )
+                            .apply_max_message_size_config(
+                                max_decoding_message_size,
+                                max_encoding_message_size,
+                            );
+                        let res = grpc.server_streaming(method, req).await;
+                        Ok(res)
+                    };
+                    Box::pin(fut)
+                }
                 _ => {
                     Box::pin(async move {
                         Ok(
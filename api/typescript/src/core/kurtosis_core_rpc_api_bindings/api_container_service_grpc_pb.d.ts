@@ -12,6 +12,8 @@ interface IApiContainerServiceService extends grpc.ServiceDefinition<grpc.Untype
   uploadStarlarkPackage: grpc.MethodDefinition<api_container_service_pb.StreamedDataChunk, google_protobuf_empty_pb.Empty>;
   runStarlarkPackage: grpc.MethodDefinition<api_container_service_pb.RunStarlarkPackageArgs, api_container_service_pb.StarlarkRunResponseLine>;
   getServices: grpc.MethodDefinition<api_container_service_pb.GetServicesArgs, api_container_service_pb.GetServicesResponse>;
+  getServiceStats: grpc.MethodDefinition<api_container_service_pb.GetServiceStatsArgs, api_container_service_pb.GetServiceStatsResponse>;
+  streamServiceHealthEvents: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.ServiceHealthEvent>;
   getExistingAndHistoricalServiceIdentifiers: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>;
   execCommand: grpc.MethodDefinition<api_container_service_pb.ExecCommandArgs, api_container_service_pb.ExecCommandResponse>;
   waitForHttpGetEndpointAvailability: grpc.MethodDefinition<api_container_service_pb.WaitForHttpGetEndpointAvailabilityArgs, google_protobuf_empty_pb.Empty>;
@@ -26,6 +28,16 @@ interface IApiContainerServiceService extends grpc.ServiceDefinition<grpc.Untype
   getStarlarkRun: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetStarlarkRunResponse>;
   getStarlarkScriptPlanYaml: grpc.MethodDefinition<api_container_service_pb.StarlarkScriptPlanYamlArgs, api_container_service_pb.PlanYaml>;
   getStarlarkPackagePlanYaml: grpc.MethodDefinition<api_container_service_pb.StarlarkPackagePlanYamlArgs, api_container_service_pb.PlanYaml>;
+  getEnclaveSnapshotInfo: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetEnclaveSnapshotInfoResponse>;
+  restoreEnclaveSnapshot: grpc.MethodDefinition<api_container_service_pb.RestoreEnclaveSnapshotArgs, api_container_service_pb.StarlarkRunResponseLine>;
+  setSecret: grpc.MethodDefinition<api_container_service_pb.SetSecretArgs, google_protobuf_empty_pb.Empty>;
+  listSecrets: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.ListSecretsResponse>;
+  removeSecret: grpc.MethodDefinition<api_container_service_pb.RemoveSecretArgs, google_protobuf_empty_pb.Empty>;
+  getEnclaveActivity: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetEnclaveActivityResponse>;
+  getEnclaveResourceUsage: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetEnclaveResourceUsageResponse>;
+  setEnclaveResourceQuotaBudget: grpc.MethodDefinition<api_container_service_pb.EnclaveResourceQuotaBudget, google_protobuf_empty_pb.Empty>;
+  getEnclaveEvents: grpc.MethodDefinition<api_container_service_pb.GetEnclaveEventsArgs, api_container_service_pb.GetEnclaveEventsResponse>;
+  getStarlarkRunTrace: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetStarlarkRunTraceResponse>;
 }
 
 export const ApiContainerServiceService: IApiContainerServiceService;
@@ -35,6 +47,8 @@ export interface IApiContainerServiceServer extends grpc.UntypedServiceImplement
   uploadStarlarkPackage: grpc.handleClientStreamingCall<api_container_service_pb.StreamedDataChunk, google_protobuf_empty_pb.Empty>;
   runStarlarkPackage: grpc.handleServerStreamingCall<api_container_service_pb.RunStarlarkPackageArgs, api_container_service_pb.StarlarkRunResponseLine>;
   getServices: grpc.handleUnaryCall<api_container_service_pb.GetServicesArgs, api_container_service_pb.GetServicesResponse>;
+  getServiceStats: grpc.handleUnaryCall<api_container_service_pb.GetServiceStatsArgs, api_container_service_pb.GetServiceStatsResponse>;
+  streamServiceHealthEvents: grpc.handleServerStreamingCall<google_protobuf_empty_pb.Empty, api_container_service_pb.ServiceHealthEvent>;
   getExistingAndHistoricalServiceIdentifiers: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>;
   execCommand: grpc.handleUnaryCall<api_container_service_pb.ExecCommandArgs, api_container_service_pb.ExecCommandResponse>;
   waitForHttpGetEndpointAvailability: grpc.handleUnaryCall<api_container_service_pb.WaitForHttpGetEndpointAvailabilityArgs, google_protobuf_empty_pb.Empty>;
@@ -49,6 +63,16 @@ export interface IApiContainerServiceServer extends grpc.UntypedServiceImplement
   getStarlarkRun: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetStarlarkRunResponse>;
   getStarlarkScriptPlanYaml: grpc.handleUnaryCall<api_container_service_pb.StarlarkScriptPlanYamlArgs, api_container_service_pb.PlanYaml>;
   getStarlarkPackagePlanYaml: grpc.handleUnaryCall<api_container_service_pb.StarlarkPackagePlanYamlArgs, api_container_service_pb.PlanYaml>;
+  getEnclaveSnapshotInfo: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetEnclaveSnapshotInfoResponse>;
+  restoreEnclaveSnapshot: grpc.handleServerStreamingCall<api_container_service_pb.RestoreEnclaveSnapshotArgs, api_container_service_pb.StarlarkRunResponseLine>;
+  setSecret: grpc.handleUnaryCall<api_container_service_pb.SetSecretArgs, google_protobuf_empty_pb.Empty>;
+  listSecrets: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.ListSecretsResponse>;
+  removeSecret: grpc.handleUnaryCall<api_container_service_pb.RemoveSecretArgs, google_protobuf_empty_pb.Empty>;
+  getEnclaveActivity: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetEnclaveActivityResponse>;
+  getEnclaveResourceUsage: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetEnclaveResourceUsageResponse>;
+  setEnclaveResourceQuotaBudget: grpc.handleUnaryCall<api_container_service_pb.EnclaveResourceQuotaBudget, google_protobuf_empty_pb.Empty>;
+  getEnclaveEvents: grpc.handleUnaryCall<api_container_service_pb.GetEnclaveEventsArgs, api_container_service_pb.GetEnclaveEventsResponse>;
+  getStarlarkRunTrace: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetStarlarkRunTraceResponse>;
 }
 
 export class ApiContainerServiceClient extends grpc.Client {
@@ -63,6 +87,11 @@ export class ApiContainerServiceClient extends grpc.Client {
   getServices(argument: api_container_service_pb.GetServicesArgs, callback: grpc.requestCallback<api_container_service_pb.GetServicesResponse>): grpc.ClientUnaryCall;
   getServices(argument: api_container_service_pb.GetServicesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetServicesResponse>): grpc.ClientUnaryCall;
   getServices(argument: api_container_service_pb.GetServicesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetServicesResponse>): grpc.ClientUnaryCall;
+  getServiceStats(argument: api_container_service_pb.GetServiceStatsArgs, callback: grpc.requestCallback<api_container_service_pb.GetServiceStatsResponse>): grpc.ClientUnaryCall;
+  getServiceStats(argument: api_container_service_pb.GetServiceStatsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetServiceStatsResponse>): grpc.ClientUnaryCall;
+  getServiceStats(argument: api_container_service_pb.GetServiceStatsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetServiceStatsResponse>): grpc.ClientUnaryCall;
+  streamServiceHealthEvents(argument: google_protobuf_empty_pb.Empty, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.ServiceHealthEvent>;
+  streamServiceHealthEvents(argument: google_protobuf_empty_pb.Empty, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.ServiceHealthEvent>;
   getExistingAndHistoricalServiceIdentifiers(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>): grpc.ClientUnaryCall;
   getExistingAndHistoricalServiceIdentifiers(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>): grpc.ClientUnaryCall;
   getExistingAndHistoricalServiceIdentifiers(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>): grpc.ClientUnaryCall;
@@ -104,4 +133,33 @@ export class ApiContainerServiceClient extends grpc.Client {
   getStarlarkPackagePlanYaml(argument: api_container_service_pb.StarlarkPackagePlanYamlArgs, callback: grpc.requestCallback<api_container_service_pb.PlanYaml>): grpc.ClientUnaryCall;
   getStarlarkPackagePlanYaml(argument: api_container_service_pb.StarlarkPackagePlanYamlArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.PlanYaml>): grpc.ClientUnaryCall;
   getStarlarkPackagePlanYaml(argument: api_container_service_pb.StarlarkPackagePlanYamlArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.PlanYaml>): grpc.ClientUnaryCall;
+  getEnclaveSnapshotInfo(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveSnapshotInfoResponse>): grpc.ClientUnaryCall;
+  getEnclaveSnapshotInfo(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveSnapshotInfoResponse>): grpc.ClientUnaryCall;
+  getEnclaveSnapshotInfo(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveSnapshotInfoResponse>): grpc.ClientUnaryCall;
+  restoreEnclaveSnapshot(argument: api_container_service_pb.RestoreEnclaveSnapshotArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
+  restoreEnclaveSnapshot(argument: api_container_service_pb.RestoreEnclaveSnapshotArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
+  setSecret(argument: api_container_service_pb.SetSecretArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  setSecret(argument: api_container_service_pb.SetSecretArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  setSecret(argument: api_container_service_pb.SetSecretArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  listSecrets(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.ListSecretsResponse>): grpc.ClientUnaryCall;
+  listSecrets(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ListSecretsResponse>): grpc.ClientUnaryCall;
+  listSecrets(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ListSecretsResponse>): grpc.ClientUnaryCall;
+  removeSecret(argument: api_container_service_pb.RemoveSecretArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  removeSecret(argument: api_container_service_pb.RemoveSecretArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  removeSecret(argument: api_container_service_pb.RemoveSecretArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  getEnclaveActivity(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveActivityResponse>): grpc.ClientUnaryCall;
+  getEnclaveActivity(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveActivityResponse>): grpc.ClientUnaryCall;
+  getEnclaveActivity(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveActivityResponse>): grpc.ClientUnaryCall;
+  getEnclaveResourceUsage(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveResourceUsageResponse>): grpc.ClientUnaryCall;
+  getEnclaveResourceUsage(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveResourceUsageResponse>): grpc.ClientUnaryCall;
+  getEnclaveResourceUsage(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveResourceUsageResponse>): grpc.ClientUnaryCall;
+  setEnclaveResourceQuotaBudget(argument: api_container_service_pb.EnclaveResourceQuotaBudget, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  setEnclaveResourceQuotaBudget(argument: api_container_service_pb.EnclaveResourceQuotaBudget, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  setEnclaveResourceQuotaBudget(argument: api_container_service_pb.EnclaveResourceQuotaBudget, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  getEnclaveEvents(argument: api_container_service_pb.GetEnclaveEventsArgs, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveEventsResponse>): grpc.ClientUnaryCall;
+  getEnclaveEvents(argument: api_container_service_pb.GetEnclaveEventsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveEventsResponse>): grpc.ClientUnaryCall;
+  getEnclaveEvents(argument: api_container_service_pb.GetEnclaveEventsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetEnclaveEventsResponse>): grpc.ClientUnaryCall;
+  getStarlarkRunTrace(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetStarlarkRunTraceResponse>): grpc.ClientUnaryCall;
+  getStarlarkRunTrace(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetStarlarkRunTraceResponse>): grpc.ClientUnaryCall;
+  getStarlarkRunTrace(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetStarlarkRunTraceResponse>): grpc.ClientUnaryCall;
 }
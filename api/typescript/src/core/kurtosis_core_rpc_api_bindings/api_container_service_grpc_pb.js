@@ -4,6 +4,7 @@
 var grpc = require('@grpc/grpc-js');
 var api_container_service_pb = require('./api_container_service_pb.js');
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 
 function serialize_api_container_api_ConnectServicesArgs(arg) {
   if (!(arg instanceof api_container_service_pb.ConnectServicesArgs)) {
@@ -38,6 +39,17 @@ function deserialize_api_container_api_DownloadFilesArtifactArgs(buffer_arg) {
   return api_container_service_pb.DownloadFilesArtifactArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_EnclaveResourceQuotaBudget(arg) {
+  if (!(arg instanceof api_container_service_pb.EnclaveResourceQuotaBudget)) {
+    throw new Error('Expected argument of type api_container_api.EnclaveResourceQuotaBudget');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_EnclaveResourceQuotaBudget(buffer_arg) {
+  return api_container_service_pb.EnclaveResourceQuotaBudget.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_ExecCommandArgs(arg) {
   if (!(arg instanceof api_container_service_pb.ExecCommandArgs)) {
     throw new Error('Expected argument of type api_container_api.ExecCommandArgs');
@@ -60,6 +72,61 @@ function deserialize_api_container_api_ExecCommandResponse(buffer_arg) {
   return api_container_service_pb.ExecCommandResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetEnclaveActivityResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetEnclaveActivityResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetEnclaveActivityResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetEnclaveActivityResponse(buffer_arg) {
+  return api_container_service_pb.GetEnclaveActivityResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetEnclaveEventsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.GetEnclaveEventsArgs)) {
+    throw new Error('Expected argument of type api_container_api.GetEnclaveEventsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetEnclaveEventsArgs(buffer_arg) {
+  return api_container_service_pb.GetEnclaveEventsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetEnclaveEventsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetEnclaveEventsResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetEnclaveEventsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetEnclaveEventsResponse(buffer_arg) {
+  return api_container_service_pb.GetEnclaveEventsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetEnclaveResourceUsageResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetEnclaveResourceUsageResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetEnclaveResourceUsageResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetEnclaveResourceUsageResponse(buffer_arg) {
+  return api_container_service_pb.GetEnclaveResourceUsageResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetEnclaveSnapshotInfoResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetEnclaveSnapshotInfoResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetEnclaveSnapshotInfoResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetEnclaveSnapshotInfoResponse(buffer_arg) {
+  return api_container_service_pb.GetEnclaveSnapshotInfoResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_GetExistingAndHistoricalServiceIdentifiersResponse(arg) {
   if (!(arg instanceof api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse)) {
     throw new Error('Expected argument of type api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse');
@@ -71,6 +138,28 @@ function deserialize_api_container_api_GetExistingAndHistoricalServiceIdentifier
   return api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetServiceStatsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.GetServiceStatsArgs)) {
+    throw new Error('Expected argument of type api_container_api.GetServiceStatsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetServiceStatsArgs(buffer_arg) {
+  return api_container_service_pb.GetServiceStatsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetServiceStatsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetServiceStatsResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetServiceStatsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetServiceStatsResponse(buffer_arg) {
+  return api_container_service_pb.GetServiceStatsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_GetServicesArgs(arg) {
   if (!(arg instanceof api_container_service_pb.GetServicesArgs)) {
     throw new Error('Expected argument of type api_container_api.GetServicesArgs');
@@ -104,6 +193,17 @@ function deserialize_api_container_api_GetStarlarkRunResponse(buffer_arg) {
   return api_container_service_pb.GetStarlarkRunResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetStarlarkRunTraceResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetStarlarkRunTraceResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetStarlarkRunTraceResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetStarlarkRunTraceResponse(buffer_arg) {
+  return api_container_service_pb.GetStarlarkRunTraceResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_InspectFilesArtifactContentsRequest(arg) {
   if (!(arg instanceof api_container_service_pb.InspectFilesArtifactContentsRequest)) {
     throw new Error('Expected argument of type api_container_api.InspectFilesArtifactContentsRequest');
@@ -137,6 +237,17 @@ function deserialize_api_container_api_ListFilesArtifactNamesAndUuidsResponse(bu
   return api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_ListSecretsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.ListSecretsResponse)) {
+    throw new Error('Expected argument of type api_container_api.ListSecretsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ListSecretsResponse(buffer_arg) {
+  return api_container_service_pb.ListSecretsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_PlanYaml(arg) {
   if (!(arg instanceof api_container_service_pb.PlanYaml)) {
     throw new Error('Expected argument of type api_container_api.PlanYaml');
@@ -148,6 +259,28 @@ function deserialize_api_container_api_PlanYaml(buffer_arg) {
   return api_container_service_pb.PlanYaml.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_RemoveSecretArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.RemoveSecretArgs)) {
+    throw new Error('Expected argument of type api_container_api.RemoveSecretArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RemoveSecretArgs(buffer_arg) {
+  return api_container_service_pb.RemoveSecretArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_RestoreEnclaveSnapshotArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.RestoreEnclaveSnapshotArgs)) {
+    throw new Error('Expected argument of type api_container_api.RestoreEnclaveSnapshotArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RestoreEnclaveSnapshotArgs(buffer_arg) {
+  return api_container_service_pb.RestoreEnclaveSnapshotArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_RunStarlarkPackageArgs(arg) {
   if (!(arg instanceof api_container_service_pb.RunStarlarkPackageArgs)) {
     throw new Error('Expected argument of type api_container_api.RunStarlarkPackageArgs');
@@ -170,6 +303,28 @@ function deserialize_api_container_api_RunStarlarkScriptArgs(buffer_arg) {
   return api_container_service_pb.RunStarlarkScriptArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_ServiceHealthEvent(arg) {
+  if (!(arg instanceof api_container_service_pb.ServiceHealthEvent)) {
+    throw new Error('Expected argument of type api_container_api.ServiceHealthEvent');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ServiceHealthEvent(buffer_arg) {
+  return api_container_service_pb.ServiceHealthEvent.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_SetSecretArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.SetSecretArgs)) {
+    throw new Error('Expected argument of type api_container_api.SetSecretArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_SetSecretArgs(buffer_arg) {
+  return api_container_service_pb.SetSecretArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_StarlarkPackagePlanYamlArgs(arg) {
   if (!(arg instanceof api_container_service_pb.StarlarkPackagePlanYamlArgs)) {
     throw new Error('Expected argument of type api_container_api.StarlarkPackagePlanYamlArgs');
@@ -352,6 +507,30 @@ getServices: {
     responseSerialize: serialize_api_container_api_GetServicesResponse,
     responseDeserialize: deserialize_api_container_api_GetServicesResponse,
   },
+  // Samples the CPU, memory, network and disk I/O usage of services, along with their peak usage over the last Starlark run
+getServiceStats: {
+    path: '/api_container_api.ApiContainerService/GetServiceStats',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.GetServiceStatsArgs,
+    responseType: api_container_service_pb.GetServiceStatsResponse,
+    requestSerialize: serialize_api_container_api_GetServiceStatsArgs,
+    requestDeserialize: deserialize_api_container_api_GetServiceStatsArgs,
+    responseSerialize: serialize_api_container_api_GetServiceStatsResponse,
+    responseDeserialize: deserialize_api_container_api_GetServiceStatsResponse,
+  },
+  // Streams the current health of every service, then an event every time the health of a service changes
+streamServiceHealthEvents: {
+    path: '/api_container_api.ApiContainerService/StreamServiceHealthEvents',
+    requestStream: false,
+    responseStream: true,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.ServiceHealthEvent,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_ServiceHealthEvent,
+    responseDeserialize: deserialize_api_container_api_ServiceHealthEvent,
+  },
   // Returns information about all existing & historical services
 getExistingAndHistoricalServiceIdentifiers: {
     path: '/api_container_api.ApiContainerService/GetExistingAndHistoricalServiceIdentifiers',
@@ -518,6 +697,128 @@ getStarlarkPackagePlanYaml: {
     responseSerialize: serialize_api_container_api_PlanYaml,
     responseDeserialize: deserialize_api_container_api_PlanYaml,
   },
+  // Gets the enclave plan and the persistent directories of the enclave, which are needed to snapshot the enclave
+getEnclaveSnapshotInfo: {
+    path: '/api_container_api.ApiContainerService/GetEnclaveSnapshotInfo',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetEnclaveSnapshotInfoResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetEnclaveSnapshotInfoResponse,
+    responseDeserialize: deserialize_api_container_api_GetEnclaveSnapshotInfoResponse,
+  },
+  // Recreates the services of an enclave snapshot by re-executing the snapshot enclave plan inside this (empty) enclave
+restoreEnclaveSnapshot: {
+    path: '/api_container_api.ApiContainerService/RestoreEnclaveSnapshot',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.RestoreEnclaveSnapshotArgs,
+    responseType: api_container_service_pb.StarlarkRunResponseLine,
+    requestSerialize: serialize_api_container_api_RestoreEnclaveSnapshotArgs,
+    requestDeserialize: deserialize_api_container_api_RestoreEnclaveSnapshotArgs,
+    responseSerialize: serialize_api_container_api_StarlarkRunResponseLine,
+    responseDeserialize: deserialize_api_container_api_StarlarkRunResponseLine,
+  },
+  // Creates the secret, or replaces its value if it already exists
+setSecret: {
+    path: '/api_container_api.ApiContainerService/SetSecret',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.SetSecretArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_SetSecretArgs,
+    requestDeserialize: deserialize_api_container_api_SetSecretArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Lists the names of the secrets of the enclave; secret values are never returned
+listSecrets: {
+    path: '/api_container_api.ApiContainerService/ListSecrets',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.ListSecretsResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_ListSecretsResponse,
+    responseDeserialize: deserialize_api_container_api_ListSecretsResponse,
+  },
+  // Removes a secret; services already using it keep the value they were started with
+removeSecret: {
+    path: '/api_container_api.ApiContainerService/RemoveSecret',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.RemoveSecretArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_RemoveSecretArgs,
+    requestDeserialize: deserialize_api_container_api_RemoveSecretArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Gets when the API container last served a request, which the engine uses to find the idle enclaves
+getEnclaveActivity: {
+    path: '/api_container_api.ApiContainerService/GetEnclaveActivity',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetEnclaveActivityResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetEnclaveActivityResponse,
+    responseDeserialize: deserialize_api_container_api_GetEnclaveActivityResponse,
+  },
+  // Gets what the services and files artifacts of the enclave take out of its resource quota
+getEnclaveResourceUsage: {
+    path: '/api_container_api.ApiContainerService/GetEnclaveResourceUsage',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetEnclaveResourceUsageResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetEnclaveResourceUsageResponse,
+    responseDeserialize: deserialize_api_container_api_GetEnclaveResourceUsageResponse,
+  },
+  // Sets what the services of the enclave can reserve in total given what the other enclaves of its resource quotas
+// reserve, which the engine keeps up to date; only the engine is allowed to call it
+setEnclaveResourceQuotaBudget: {
+    path: '/api_container_api.ApiContainerService/SetEnclaveResourceQuotaBudget',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.EnclaveResourceQuotaBudget,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_EnclaveResourceQuotaBudget,
+    requestDeserialize: deserialize_api_container_api_EnclaveResourceQuotaBudget,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Gets the events which happened in the enclave after the given one, which the engine polls to publish them on its
+// event bus
+getEnclaveEvents: {
+    path: '/api_container_api.ApiContainerService/GetEnclaveEvents',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.GetEnclaveEventsArgs,
+    responseType: api_container_service_pb.GetEnclaveEventsResponse,
+    requestSerialize: serialize_api_container_api_GetEnclaveEventsArgs,
+    requestDeserialize: deserialize_api_container_api_GetEnclaveEventsArgs,
+    responseSerialize: serialize_api_container_api_GetEnclaveEventsResponse,
+    responseDeserialize: deserialize_api_container_api_GetEnclaveEventsResponse,
+  },
+  // Gets the spans of the trace of the latest Starlark run of the enclave
+getStarlarkRunTrace: {
+    path: '/api_container_api.ApiContainerService/GetStarlarkRunTrace',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetStarlarkRunTraceResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetStarlarkRunTraceResponse,
+    responseDeserialize: deserialize_api_container_api_GetStarlarkRunTraceResponse,
+  },
 };
 
 exports.ApiContainerServiceClient = grpc.makeGenericClientConstructor(ApiContainerServiceService);
@@ -26,6 +26,18 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.GetServicesResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.GetServicesResponse>;
 
+  getServiceStats(
+    request: api_container_service_pb.GetServiceStatsArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetServiceStatsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetServiceStatsResponse>;
+
+  streamServiceHealthEvents(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ServiceHealthEvent>;
+
   getExistingAndHistoricalServiceIdentifiers(
     request: google_protobuf_empty_pb.Empty,
     metadata: grpcWeb.Metadata | undefined,
@@ -115,6 +127,74 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.PlanYaml) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.PlanYaml>;
 
+  getEnclaveSnapshotInfo(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetEnclaveSnapshotInfoResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetEnclaveSnapshotInfoResponse>;
+
+  restoreEnclaveSnapshot(
+    request: api_container_service_pb.RestoreEnclaveSnapshotArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
+
+  setSecret(
+    request: api_container_service_pb.SetSecretArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  listSecrets(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.ListSecretsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ListSecretsResponse>;
+
+  removeSecret(
+    request: api_container_service_pb.RemoveSecretArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  getEnclaveActivity(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetEnclaveActivityResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetEnclaveActivityResponse>;
+
+  getEnclaveResourceUsage(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetEnclaveResourceUsageResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetEnclaveResourceUsageResponse>;
+
+  setEnclaveResourceQuotaBudget(
+    request: api_container_service_pb.EnclaveResourceQuotaBudget,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  getEnclaveEvents(
+    request: api_container_service_pb.GetEnclaveEventsArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetEnclaveEventsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetEnclaveEventsResponse>;
+
+  getStarlarkRunTrace(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetStarlarkRunTraceResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetStarlarkRunTraceResponse>;
+
 }
 
 export class ApiContainerServicePromiseClient {
@@ -137,6 +217,16 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.GetServicesResponse>;
 
+  getServiceStats(
+    request: api_container_service_pb.GetServiceStatsArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetServiceStatsResponse>;
+
+  streamServiceHealthEvents(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ServiceHealthEvent>;
+
   getExistingAndHistoricalServiceIdentifiers(
     request: google_protobuf_empty_pb.Empty,
     metadata?: grpcWeb.Metadata
@@ -202,5 +292,55 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.PlanYaml>;
 
+  getEnclaveSnapshotInfo(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetEnclaveSnapshotInfoResponse>;
+
+  restoreEnclaveSnapshot(
+    request: api_container_service_pb.RestoreEnclaveSnapshotArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
+
+  setSecret(
+    request: api_container_service_pb.SetSecretArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  listSecrets(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.ListSecretsResponse>;
+
+  removeSecret(
+    request: api_container_service_pb.RemoveSecretArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  getEnclaveActivity(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetEnclaveActivityResponse>;
+
+  getEnclaveResourceUsage(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetEnclaveResourceUsageResponse>;
+
+  setEnclaveResourceQuotaBudget(
+    request: api_container_service_pb.EnclaveResourceQuotaBudget,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  getEnclaveEvents(
+    request: api_container_service_pb.GetEnclaveEventsArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetEnclaveEventsResponse>;
+
+  getStarlarkRunTrace(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetStarlarkRunTraceResponse>;
+
 }
 
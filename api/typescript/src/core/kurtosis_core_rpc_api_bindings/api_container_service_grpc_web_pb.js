@@ -21,6 +21,8 @@ grpc.web = require('grpc-web');
 
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js')
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
 const proto = {};
 proto.api_container_api = require('./api_container_service_pb.js');
 
@@ -249,6 +251,123 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.getServices =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.GetServiceStatsArgs,
+ *   !proto.api_container_api.GetServiceStatsResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetServiceStats = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetServiceStats',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.GetServiceStatsArgs,
+  proto.api_container_api.GetServiceStatsResponse,
+  /**
+   * @param {!proto.api_container_api.GetServiceStatsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetServiceStatsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.GetServiceStatsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetServiceStatsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetServiceStatsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getServiceStats =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetServiceStats',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetServiceStats,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GetServiceStatsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetServiceStatsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getServiceStats =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetServiceStats',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetServiceStats);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.ServiceHealthEvent>}
+ */
+const methodDescriptor_ApiContainerService_StreamServiceHealthEvents = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/StreamServiceHealthEvents',
+  grpc.web.MethodType.SERVER_STREAMING,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.ServiceHealthEvent,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.ServiceHealthEvent.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ServiceHealthEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.streamServiceHealthEvents =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/StreamServiceHealthEvents',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StreamServiceHealthEvents);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ServiceHealthEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.streamServiceHealthEvents =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/StreamServiceHealthEvents',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StreamServiceHealthEvents);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -1037,5 +1156,610 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.getStarlarkPa
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetEnclaveSnapshotInfoResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetEnclaveSnapshotInfo = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetEnclaveSnapshotInfo',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetEnclaveSnapshotInfoResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetEnclaveSnapshotInfoResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetEnclaveSnapshotInfoResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetEnclaveSnapshotInfoResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getEnclaveSnapshotInfo =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveSnapshotInfo',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveSnapshotInfo,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetEnclaveSnapshotInfoResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getEnclaveSnapshotInfo =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveSnapshotInfo',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveSnapshotInfo);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.RestoreEnclaveSnapshotArgs,
+ *   !proto.api_container_api.StarlarkRunResponseLine>}
+ */
+const methodDescriptor_ApiContainerService_RestoreEnclaveSnapshot = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RestoreEnclaveSnapshot',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.RestoreEnclaveSnapshotArgs,
+  proto.api_container_api.StarlarkRunResponseLine,
+  /**
+   * @param {!proto.api_container_api.RestoreEnclaveSnapshotArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.StarlarkRunResponseLine.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.RestoreEnclaveSnapshotArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StarlarkRunResponseLine>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.restoreEnclaveSnapshot =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/RestoreEnclaveSnapshot',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RestoreEnclaveSnapshot);
+};
+
+
+/**
+ * @param {!proto.api_container_api.RestoreEnclaveSnapshotArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StarlarkRunResponseLine>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.restoreEnclaveSnapshot =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/RestoreEnclaveSnapshot',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RestoreEnclaveSnapshot);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.SetSecretArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_SetSecret = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/SetSecret',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.SetSecretArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.SetSecretArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.SetSecretArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.setSecret =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/SetSecret',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_SetSecret,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.SetSecretArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.setSecret =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/SetSecret',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_SetSecret);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.ListSecretsResponse>}
+ */
+const methodDescriptor_ApiContainerService_ListSecrets = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/ListSecrets',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.ListSecretsResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.ListSecretsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.ListSecretsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ListSecretsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.listSecrets =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ListSecrets',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ListSecrets,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.ListSecretsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.listSecrets =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ListSecrets',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ListSecrets);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.RemoveSecretArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_RemoveSecret = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RemoveSecret',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.RemoveSecretArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.RemoveSecretArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.RemoveSecretArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.removeSecret =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RemoveSecret',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RemoveSecret,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.RemoveSecretArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.removeSecret =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RemoveSecret',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RemoveSecret);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetEnclaveActivityResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetEnclaveActivity = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetEnclaveActivity',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetEnclaveActivityResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetEnclaveActivityResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetEnclaveActivityResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetEnclaveActivityResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getEnclaveActivity =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveActivity',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveActivity,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetEnclaveActivityResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getEnclaveActivity =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveActivity',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveActivity);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetEnclaveResourceUsageResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetEnclaveResourceUsage = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetEnclaveResourceUsage',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetEnclaveResourceUsageResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetEnclaveResourceUsageResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetEnclaveResourceUsageResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetEnclaveResourceUsageResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getEnclaveResourceUsage =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveResourceUsage',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveResourceUsage,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetEnclaveResourceUsageResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getEnclaveResourceUsage =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveResourceUsage',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveResourceUsage);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.EnclaveResourceQuotaBudget,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_SetEnclaveResourceQuotaBudget = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/SetEnclaveResourceQuotaBudget',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.EnclaveResourceQuotaBudget,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.EnclaveResourceQuotaBudget} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.EnclaveResourceQuotaBudget} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.setEnclaveResourceQuotaBudget =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/SetEnclaveResourceQuotaBudget',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_SetEnclaveResourceQuotaBudget,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.EnclaveResourceQuotaBudget} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.setEnclaveResourceQuotaBudget =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/SetEnclaveResourceQuotaBudget',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_SetEnclaveResourceQuotaBudget);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.GetEnclaveEventsArgs,
+ *   !proto.api_container_api.GetEnclaveEventsResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetEnclaveEvents = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetEnclaveEvents',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.GetEnclaveEventsArgs,
+  proto.api_container_api.GetEnclaveEventsResponse,
+  /**
+   * @param {!proto.api_container_api.GetEnclaveEventsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetEnclaveEventsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.GetEnclaveEventsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetEnclaveEventsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetEnclaveEventsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getEnclaveEvents =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveEvents',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveEvents,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GetEnclaveEventsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetEnclaveEventsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getEnclaveEvents =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveEvents',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveEvents);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetStarlarkRunTraceResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetStarlarkRunTrace = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetStarlarkRunTrace',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetStarlarkRunTraceResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetStarlarkRunTraceResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetStarlarkRunTraceResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetStarlarkRunTraceResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getStarlarkRunTrace =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetStarlarkRunTrace',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetStarlarkRunTrace,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetStarlarkRunTraceResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getStarlarkRunTrace =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetStarlarkRunTrace',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetStarlarkRunTrace);
+};
+
+
 module.exports = proto.api_container_api;
 
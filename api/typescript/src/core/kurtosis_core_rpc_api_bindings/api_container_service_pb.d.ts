@@ -1,6 +1,7 @@
 import * as jspb from 'google-protobuf'
 
 import * as google_protobuf_empty_pb from 'google-protobuf/google/protobuf/empty_pb'; // proto import: "google/protobuf/empty.proto"
+import * as google_protobuf_timestamp_pb from 'google-protobuf/google/protobuf/timestamp_pb'; // proto import: "google/protobuf/timestamp.proto"
 
 
 export class Port extends jspb.Message {
@@ -105,6 +106,60 @@ export namespace Container {
   }
 }
 
+export class ServiceHealth extends jspb.Message {
+  getStatus(): ServiceHealth.Status;
+  setStatus(value: ServiceHealth.Status): ServiceHealth;
+
+  getExitCode(): number;
+  setExitCode(value: number): ServiceHealth;
+  hasExitCode(): boolean;
+  clearExitCode(): ServiceHealth;
+
+  getRestartCount(): number;
+  setRestartCount(value: number): ServiceHealth;
+
+  getMessage(): string;
+  setMessage(value: string): ServiceHealth;
+
+  getSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setSince(value?: google_protobuf_timestamp_pb.Timestamp): ServiceHealth;
+  hasSince(): boolean;
+  clearSince(): ServiceHealth;
+
+  getLastExitReason(): string;
+  setLastExitReason(value: string): ServiceHealth;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceHealth.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceHealth): ServiceHealth.AsObject;
+  static serializeBinaryToWriter(message: ServiceHealth, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceHealth;
+  static deserializeBinaryFromReader(message: ServiceHealth, reader: jspb.BinaryReader): ServiceHealth;
+}
+
+export namespace ServiceHealth {
+  export type AsObject = {
+    status: ServiceHealth.Status,
+    exitCode?: number,
+    restartCount: number,
+    message: string,
+    since?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    lastExitReason: string,
+  }
+
+  export enum Status { 
+    UNKNOWN = 0,
+    HEALTHY = 1,
+    UNHEALTHY = 2,
+    CRASHED = 3,
+  }
+
+  export enum ExitCodeCase { 
+    _EXIT_CODE_NOT_SET = 0,
+    EXIT_CODE = 2,
+  }
+}
+
 export class ServiceInfo extends jspb.Message {
   getServiceUuid(): string;
   setServiceUuid(value: string): ServiceInfo;
@@ -135,6 +190,14 @@ export class ServiceInfo extends jspb.Message {
   hasContainer(): boolean;
   clearContainer(): ServiceInfo;
 
+  getHealth(): ServiceHealth | undefined;
+  setHealth(value?: ServiceHealth): ServiceInfo;
+  hasHealth(): boolean;
+  clearHealth(): ServiceInfo;
+
+  getPrivateIpv6Addr(): string;
+  setPrivateIpv6Addr(value: string): ServiceInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ServiceInfo.AsObject;
   static toObject(includeInstance: boolean, msg: ServiceInfo): ServiceInfo.AsObject;
@@ -154,6 +217,8 @@ export namespace ServiceInfo {
     shortenedUuid: string,
     serviceStatus: ServiceStatus,
     container?: Container.AsObject,
+    health?: ServiceHealth.AsObject,
+    privateIpv6Addr: string,
   }
 }
 
@@ -844,6 +909,164 @@ export namespace GetServicesResponse {
   }
 }
 
+export class GetServiceStatsArgs extends jspb.Message {
+  getServiceIdentifiersMap(): jspb.Map<string, boolean>;
+  clearServiceIdentifiersMap(): GetServiceStatsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetServiceStatsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetServiceStatsArgs): GetServiceStatsArgs.AsObject;
+  static serializeBinaryToWriter(message: GetServiceStatsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetServiceStatsArgs;
+  static deserializeBinaryFromReader(message: GetServiceStatsArgs, reader: jspb.BinaryReader): GetServiceStatsArgs;
+}
+
+export namespace GetServiceStatsArgs {
+  export type AsObject = {
+    serviceIdentifiersMap: Array<[string, boolean]>,
+  }
+}
+
+export class ServiceIoStats extends jspb.Message {
+  getNetworkReceivedBytes(): number;
+  setNetworkReceivedBytes(value: number): ServiceIoStats;
+
+  getNetworkSentBytes(): number;
+  setNetworkSentBytes(value: number): ServiceIoStats;
+
+  getDiskReadBytes(): number;
+  setDiskReadBytes(value: number): ServiceIoStats;
+
+  getDiskWrittenBytes(): number;
+  setDiskWrittenBytes(value: number): ServiceIoStats;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceIoStats.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceIoStats): ServiceIoStats.AsObject;
+  static serializeBinaryToWriter(message: ServiceIoStats, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceIoStats;
+  static deserializeBinaryFromReader(message: ServiceIoStats, reader: jspb.BinaryReader): ServiceIoStats;
+}
+
+export namespace ServiceIoStats {
+  export type AsObject = {
+    networkReceivedBytes: number,
+    networkSentBytes: number,
+    diskReadBytes: number,
+    diskWrittenBytes: number,
+  }
+}
+
+export class ServiceStats extends jspb.Message {
+  getCpuUsageMillicores(): number;
+  setCpuUsageMillicores(value: number): ServiceStats;
+
+  getMemoryUsageBytes(): number;
+  setMemoryUsageBytes(value: number): ServiceStats;
+
+  getMemoryLimitBytes(): number;
+  setMemoryLimitBytes(value: number): ServiceStats;
+
+  getIoStats(): ServiceIoStats | undefined;
+  setIoStats(value?: ServiceIoStats): ServiceStats;
+  hasIoStats(): boolean;
+  clearIoStats(): ServiceStats;
+
+  getSampleTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setSampleTime(value?: google_protobuf_timestamp_pb.Timestamp): ServiceStats;
+  hasSampleTime(): boolean;
+  clearSampleTime(): ServiceStats;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceStats.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceStats): ServiceStats.AsObject;
+  static serializeBinaryToWriter(message: ServiceStats, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceStats;
+  static deserializeBinaryFromReader(message: ServiceStats, reader: jspb.BinaryReader): ServiceStats;
+}
+
+export namespace ServiceStats {
+  export type AsObject = {
+    cpuUsageMillicores: number,
+    memoryUsageBytes: number,
+    memoryLimitBytes: number,
+    ioStats?: ServiceIoStats.AsObject,
+    sampleTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class ServicePeakUsage extends jspb.Message {
+  getPeakCpuUsageMillicores(): number;
+  setPeakCpuUsageMillicores(value: number): ServicePeakUsage;
+
+  getPeakMemoryUsageBytes(): number;
+  setPeakMemoryUsageBytes(value: number): ServicePeakUsage;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServicePeakUsage.AsObject;
+  static toObject(includeInstance: boolean, msg: ServicePeakUsage): ServicePeakUsage.AsObject;
+  static serializeBinaryToWriter(message: ServicePeakUsage, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServicePeakUsage;
+  static deserializeBinaryFromReader(message: ServicePeakUsage, reader: jspb.BinaryReader): ServicePeakUsage;
+}
+
+export namespace ServicePeakUsage {
+  export type AsObject = {
+    peakCpuUsageMillicores: number,
+    peakMemoryUsageBytes: number,
+  }
+}
+
+export class GetServiceStatsResponse extends jspb.Message {
+  getServiceStatsMap(): jspb.Map<string, ServiceStats>;
+  clearServiceStatsMap(): GetServiceStatsResponse;
+
+  getServiceErrorsMap(): jspb.Map<string, string>;
+  clearServiceErrorsMap(): GetServiceStatsResponse;
+
+  getLastRunPeakUsageMap(): jspb.Map<string, ServicePeakUsage>;
+  clearLastRunPeakUsageMap(): GetServiceStatsResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetServiceStatsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetServiceStatsResponse): GetServiceStatsResponse.AsObject;
+  static serializeBinaryToWriter(message: GetServiceStatsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetServiceStatsResponse;
+  static deserializeBinaryFromReader(message: GetServiceStatsResponse, reader: jspb.BinaryReader): GetServiceStatsResponse;
+}
+
+export namespace GetServiceStatsResponse {
+  export type AsObject = {
+    serviceStatsMap: Array<[string, ServiceStats.AsObject]>,
+    serviceErrorsMap: Array<[string, string]>,
+    lastRunPeakUsageMap: Array<[string, ServicePeakUsage.AsObject]>,
+  }
+}
+
+export class ServiceHealthEvent extends jspb.Message {
+  getServiceName(): string;
+  setServiceName(value: string): ServiceHealthEvent;
+
+  getHealth(): ServiceHealth | undefined;
+  setHealth(value?: ServiceHealth): ServiceHealthEvent;
+  hasHealth(): boolean;
+  clearHealth(): ServiceHealthEvent;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceHealthEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceHealthEvent): ServiceHealthEvent.AsObject;
+  static serializeBinaryToWriter(message: ServiceHealthEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceHealthEvent;
+  static deserializeBinaryFromReader(message: ServiceHealthEvent, reader: jspb.BinaryReader): ServiceHealthEvent;
+}
+
+export namespace ServiceHealthEvent {
+  export type AsObject = {
+    serviceName: string,
+    health?: ServiceHealth.AsObject,
+  }
+}
+
 export class ServiceIdentifiers extends jspb.Message {
   getServiceUuid(): string;
   setServiceUuid(value: string): ServiceIdentifiers;
@@ -1447,6 +1670,11 @@ export class GetStarlarkRunResponse extends jspb.Message {
   getRestartPolicy(): RestartPolicy;
   setRestartPolicy(value: RestartPolicy): GetStarlarkRunResponse;
 
+  getRunningPackageId(): string;
+  setRunningPackageId(value: string): GetStarlarkRunResponse;
+  hasRunningPackageId(): boolean;
+  clearRunningPackageId(): GetStarlarkRunResponse;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetStarlarkRunResponse.AsObject;
   static toObject(includeInstance: boolean, msg: GetStarlarkRunResponse): GetStarlarkRunResponse.AsObject;
@@ -1465,6 +1693,12 @@ export namespace GetStarlarkRunResponse {
     mainFunctionName: string,
     experimentalFeaturesList: Array<KurtosisFeatureFlag>,
     restartPolicy: RestartPolicy,
+    runningPackageId?: string,
+  }
+
+  export enum RunningPackageIdCase { 
+    _RUNNING_PACKAGE_ID_NOT_SET = 0,
+    RUNNING_PACKAGE_ID = 9,
   }
 }
 
@@ -1577,6 +1811,447 @@ export namespace StarlarkPackagePlanYamlArgs {
   }
 }
 
+export class EnclaveSnapshotPersistentDirectory extends jspb.Message {
+  getServiceName(): string;
+  setServiceName(value: string): EnclaveSnapshotPersistentDirectory;
+
+  getServiceDirpath(): string;
+  setServiceDirpath(value: string): EnclaveSnapshotPersistentDirectory;
+
+  getPersistentKey(): string;
+  setPersistentKey(value: string): EnclaveSnapshotPersistentDirectory;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveSnapshotPersistentDirectory.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveSnapshotPersistentDirectory): EnclaveSnapshotPersistentDirectory.AsObject;
+  static serializeBinaryToWriter(message: EnclaveSnapshotPersistentDirectory, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveSnapshotPersistentDirectory;
+  static deserializeBinaryFromReader(message: EnclaveSnapshotPersistentDirectory, reader: jspb.BinaryReader): EnclaveSnapshotPersistentDirectory;
+}
+
+export namespace EnclaveSnapshotPersistentDirectory {
+  export type AsObject = {
+    serviceName: string,
+    serviceDirpath: string,
+    persistentKey: string,
+  }
+}
+
+export class GetEnclaveSnapshotInfoResponse extends jspb.Message {
+  getSerializedEnclavePlan(): string;
+  setSerializedEnclavePlan(value: string): GetEnclaveSnapshotInfoResponse;
+
+  getPersistentDirectoriesList(): Array<EnclaveSnapshotPersistentDirectory>;
+  setPersistentDirectoriesList(value: Array<EnclaveSnapshotPersistentDirectory>): GetEnclaveSnapshotInfoResponse;
+  clearPersistentDirectoriesList(): GetEnclaveSnapshotInfoResponse;
+  addPersistentDirectories(value?: EnclaveSnapshotPersistentDirectory, index?: number): EnclaveSnapshotPersistentDirectory;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclaveSnapshotInfoResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclaveSnapshotInfoResponse): GetEnclaveSnapshotInfoResponse.AsObject;
+  static serializeBinaryToWriter(message: GetEnclaveSnapshotInfoResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclaveSnapshotInfoResponse;
+  static deserializeBinaryFromReader(message: GetEnclaveSnapshotInfoResponse, reader: jspb.BinaryReader): GetEnclaveSnapshotInfoResponse;
+}
+
+export namespace GetEnclaveSnapshotInfoResponse {
+  export type AsObject = {
+    serializedEnclavePlan: string,
+    persistentDirectoriesList: Array<EnclaveSnapshotPersistentDirectory.AsObject>,
+  }
+}
+
+export class RestoreEnclaveSnapshotArgs extends jspb.Message {
+  getSerializedEnclavePlan(): string;
+  setSerializedEnclavePlan(value: string): RestoreEnclaveSnapshotArgs;
+
+  getPersistentDirectoryFilesArtifactsMap(): jspb.Map<string, string>;
+  clearPersistentDirectoryFilesArtifactsMap(): RestoreEnclaveSnapshotArgs;
+
+  getParallelism(): number;
+  setParallelism(value: number): RestoreEnclaveSnapshotArgs;
+  hasParallelism(): boolean;
+  clearParallelism(): RestoreEnclaveSnapshotArgs;
+
+  getServiceImagesMap(): jspb.Map<string, string>;
+  clearServiceImagesMap(): RestoreEnclaveSnapshotArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RestoreEnclaveSnapshotArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RestoreEnclaveSnapshotArgs): RestoreEnclaveSnapshotArgs.AsObject;
+  static serializeBinaryToWriter(message: RestoreEnclaveSnapshotArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RestoreEnclaveSnapshotArgs;
+  static deserializeBinaryFromReader(message: RestoreEnclaveSnapshotArgs, reader: jspb.BinaryReader): RestoreEnclaveSnapshotArgs;
+}
+
+export namespace RestoreEnclaveSnapshotArgs {
+  export type AsObject = {
+    serializedEnclavePlan: string,
+    persistentDirectoryFilesArtifactsMap: Array<[string, string]>,
+    parallelism?: number,
+    serviceImagesMap: Array<[string, string]>,
+  }
+
+  export enum ParallelismCase { 
+    _PARALLELISM_NOT_SET = 0,
+    PARALLELISM = 3,
+  }
+}
+
+export class SetSecretArgs extends jspb.Message {
+  getName(): string;
+  setName(value: string): SetSecretArgs;
+
+  getValue(): string;
+  setValue(value: string): SetSecretArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetSecretArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: SetSecretArgs): SetSecretArgs.AsObject;
+  static serializeBinaryToWriter(message: SetSecretArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetSecretArgs;
+  static deserializeBinaryFromReader(message: SetSecretArgs, reader: jspb.BinaryReader): SetSecretArgs;
+}
+
+export namespace SetSecretArgs {
+  export type AsObject = {
+    name: string,
+    value: string,
+  }
+}
+
+export class ListSecretsResponse extends jspb.Message {
+  getSecretNamesList(): Array<string>;
+  setSecretNamesList(value: Array<string>): ListSecretsResponse;
+  clearSecretNamesList(): ListSecretsResponse;
+  addSecretNames(value: string, index?: number): ListSecretsResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ListSecretsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ListSecretsResponse): ListSecretsResponse.AsObject;
+  static serializeBinaryToWriter(message: ListSecretsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ListSecretsResponse;
+  static deserializeBinaryFromReader(message: ListSecretsResponse, reader: jspb.BinaryReader): ListSecretsResponse;
+}
+
+export namespace ListSecretsResponse {
+  export type AsObject = {
+    secretNamesList: Array<string>,
+  }
+}
+
+export class RemoveSecretArgs extends jspb.Message {
+  getName(): string;
+  setName(value: string): RemoveSecretArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemoveSecretArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveSecretArgs): RemoveSecretArgs.AsObject;
+  static serializeBinaryToWriter(message: RemoveSecretArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveSecretArgs;
+  static deserializeBinaryFromReader(message: RemoveSecretArgs, reader: jspb.BinaryReader): RemoveSecretArgs;
+}
+
+export namespace RemoveSecretArgs {
+  export type AsObject = {
+    name: string,
+  }
+}
+
+export class GetEnclaveActivityResponse extends jspb.Message {
+  getLastActivityTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setLastActivityTime(value?: google_protobuf_timestamp_pb.Timestamp): GetEnclaveActivityResponse;
+  hasLastActivityTime(): boolean;
+  clearLastActivityTime(): GetEnclaveActivityResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclaveActivityResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclaveActivityResponse): GetEnclaveActivityResponse.AsObject;
+  static serializeBinaryToWriter(message: GetEnclaveActivityResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclaveActivityResponse;
+  static deserializeBinaryFromReader(message: GetEnclaveActivityResponse, reader: jspb.BinaryReader): GetEnclaveActivityResponse;
+}
+
+export namespace GetEnclaveActivityResponse {
+  export type AsObject = {
+    lastActivityTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
+export class GetEnclaveResourceUsageResponse extends jspb.Message {
+  getServiceCount(): number;
+  setServiceCount(value: number): GetEnclaveResourceUsageResponse;
+
+  getReservedCpuMillicores(): number;
+  setReservedCpuMillicores(value: number): GetEnclaveResourceUsageResponse;
+
+  getReservedMemoryMegabytes(): number;
+  setReservedMemoryMegabytes(value: number): GetEnclaveResourceUsageResponse;
+
+  getFilesArtifactsStorageBytes(): number;
+  setFilesArtifactsStorageBytes(value: number): GetEnclaveResourceUsageResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclaveResourceUsageResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclaveResourceUsageResponse): GetEnclaveResourceUsageResponse.AsObject;
+  static serializeBinaryToWriter(message: GetEnclaveResourceUsageResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclaveResourceUsageResponse;
+  static deserializeBinaryFromReader(message: GetEnclaveResourceUsageResponse, reader: jspb.BinaryReader): GetEnclaveResourceUsageResponse;
+}
+
+export namespace GetEnclaveResourceUsageResponse {
+  export type AsObject = {
+    serviceCount: number,
+    reservedCpuMillicores: number,
+    reservedMemoryMegabytes: number,
+    filesArtifactsStorageBytes: number,
+  }
+}
+
+export class EnclaveResourceQuotaBudget extends jspb.Message {
+  getMaxServices(): number;
+  setMaxServices(value: number): EnclaveResourceQuotaBudget;
+  hasMaxServices(): boolean;
+  clearMaxServices(): EnclaveResourceQuotaBudget;
+
+  getMaxCpuMillicores(): number;
+  setMaxCpuMillicores(value: number): EnclaveResourceQuotaBudget;
+  hasMaxCpuMillicores(): boolean;
+  clearMaxCpuMillicores(): EnclaveResourceQuotaBudget;
+
+  getMaxMemoryMegabytes(): number;
+  setMaxMemoryMegabytes(value: number): EnclaveResourceQuotaBudget;
+  hasMaxMemoryMegabytes(): boolean;
+  clearMaxMemoryMegabytes(): EnclaveResourceQuotaBudget;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveResourceQuotaBudget.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveResourceQuotaBudget): EnclaveResourceQuotaBudget.AsObject;
+  static serializeBinaryToWriter(message: EnclaveResourceQuotaBudget, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveResourceQuotaBudget;
+  static deserializeBinaryFromReader(message: EnclaveResourceQuotaBudget, reader: jspb.BinaryReader): EnclaveResourceQuotaBudget;
+}
+
+export namespace EnclaveResourceQuotaBudget {
+  export type AsObject = {
+    maxServices?: number,
+    maxCpuMillicores?: number,
+    maxMemoryMegabytes?: number,
+  }
+
+  export enum MaxServicesCase { 
+    _MAX_SERVICES_NOT_SET = 0,
+    MAX_SERVICES = 1,
+  }
+
+  export enum MaxCpuMillicoresCase { 
+    _MAX_CPU_MILLICORES_NOT_SET = 0,
+    MAX_CPU_MILLICORES = 2,
+  }
+
+  export enum MaxMemoryMegabytesCase { 
+    _MAX_MEMORY_MEGABYTES_NOT_SET = 0,
+    MAX_MEMORY_MEGABYTES = 3,
+  }
+}
+
+export class GetEnclaveEventsArgs extends jspb.Message {
+  getAfterSequenceNumber(): number;
+  setAfterSequenceNumber(value: number): GetEnclaveEventsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclaveEventsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclaveEventsArgs): GetEnclaveEventsArgs.AsObject;
+  static serializeBinaryToWriter(message: GetEnclaveEventsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclaveEventsArgs;
+  static deserializeBinaryFromReader(message: GetEnclaveEventsArgs, reader: jspb.BinaryReader): GetEnclaveEventsArgs;
+}
+
+export namespace GetEnclaveEventsArgs {
+  export type AsObject = {
+    afterSequenceNumber: number,
+  }
+}
+
+export class GetEnclaveEventsResponse extends jspb.Message {
+  getEventsList(): Array<EnclaveEvent>;
+  setEventsList(value: Array<EnclaveEvent>): GetEnclaveEventsResponse;
+  clearEventsList(): GetEnclaveEventsResponse;
+  addEvents(value?: EnclaveEvent, index?: number): EnclaveEvent;
+
+  getEventLogUuid(): string;
+  setEventLogUuid(value: string): GetEnclaveEventsResponse;
+
+  getLatestSequenceNumber(): number;
+  setLatestSequenceNumber(value: number): GetEnclaveEventsResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclaveEventsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclaveEventsResponse): GetEnclaveEventsResponse.AsObject;
+  static serializeBinaryToWriter(message: GetEnclaveEventsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclaveEventsResponse;
+  static deserializeBinaryFromReader(message: GetEnclaveEventsResponse, reader: jspb.BinaryReader): GetEnclaveEventsResponse;
+}
+
+export namespace GetEnclaveEventsResponse {
+  export type AsObject = {
+    eventsList: Array<EnclaveEvent.AsObject>,
+    eventLogUuid: string,
+    latestSequenceNumber: number,
+  }
+}
+
+export class EnclaveEvent extends jspb.Message {
+  getSequenceNumber(): number;
+  setSequenceNumber(value: number): EnclaveEvent;
+
+  getType(): EnclaveEvent.Type;
+  setType(value: EnclaveEvent.Type): EnclaveEvent;
+
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): EnclaveEvent;
+  hasTimestamp(): boolean;
+  clearTimestamp(): EnclaveEvent;
+
+  getServiceName(): string;
+  setServiceName(value: string): EnclaveEvent;
+  hasServiceName(): boolean;
+  clearServiceName(): EnclaveEvent;
+
+  getFilesArtifactName(): string;
+  setFilesArtifactName(value: string): EnclaveEvent;
+  hasFilesArtifactName(): boolean;
+  clearFilesArtifactName(): EnclaveEvent;
+
+  getStarlarkPackageId(): string;
+  setStarlarkPackageId(value: string): EnclaveEvent;
+  hasStarlarkPackageId(): boolean;
+  clearStarlarkPackageId(): EnclaveEvent;
+
+  getIsStarlarkRunSuccessful(): boolean;
+  setIsStarlarkRunSuccessful(value: boolean): EnclaveEvent;
+  hasIsStarlarkRunSuccessful(): boolean;
+  clearIsStarlarkRunSuccessful(): EnclaveEvent;
+
+  getMessage(): string;
+  setMessage(value: string): EnclaveEvent;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveEvent): EnclaveEvent.AsObject;
+  static serializeBinaryToWriter(message: EnclaveEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveEvent;
+  static deserializeBinaryFromReader(message: EnclaveEvent, reader: jspb.BinaryReader): EnclaveEvent;
+}
+
+export namespace EnclaveEvent {
+  export type AsObject = {
+    sequenceNumber: number,
+    type: EnclaveEvent.Type,
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    serviceName?: string,
+    filesArtifactName?: string,
+    starlarkPackageId?: string,
+    isStarlarkRunSuccessful?: boolean,
+    message: string,
+  }
+
+  export enum Type { 
+    STARLARK_RUN_STARTED = 0,
+    STARLARK_RUN_FINISHED = 1,
+    SERVICE_STARTED = 2,
+    SERVICE_STOPPED = 3,
+    SERVICE_CRASHED = 4,
+    FILES_ARTIFACT_CREATED = 5,
+  }
+
+  export enum ServiceNameCase { 
+    _SERVICE_NAME_NOT_SET = 0,
+    SERVICE_NAME = 4,
+  }
+
+  export enum FilesArtifactNameCase { 
+    _FILES_ARTIFACT_NAME_NOT_SET = 0,
+    FILES_ARTIFACT_NAME = 5,
+  }
+
+  export enum StarlarkPackageIdCase { 
+    _STARLARK_PACKAGE_ID_NOT_SET = 0,
+    STARLARK_PACKAGE_ID = 6,
+  }
+
+  export enum IsStarlarkRunSuccessfulCase { 
+    _IS_STARLARK_RUN_SUCCESSFUL_NOT_SET = 0,
+    IS_STARLARK_RUN_SUCCESSFUL = 7,
+  }
+}
+
+export class GetStarlarkRunTraceResponse extends jspb.Message {
+  getSpansList(): Array<StarlarkRunSpan>;
+  setSpansList(value: Array<StarlarkRunSpan>): GetStarlarkRunTraceResponse;
+  clearSpansList(): GetStarlarkRunTraceResponse;
+  addSpans(value?: StarlarkRunSpan, index?: number): StarlarkRunSpan;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetStarlarkRunTraceResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetStarlarkRunTraceResponse): GetStarlarkRunTraceResponse.AsObject;
+  static serializeBinaryToWriter(message: GetStarlarkRunTraceResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetStarlarkRunTraceResponse;
+  static deserializeBinaryFromReader(message: GetStarlarkRunTraceResponse, reader: jspb.BinaryReader): GetStarlarkRunTraceResponse;
+}
+
+export namespace GetStarlarkRunTraceResponse {
+  export type AsObject = {
+    spansList: Array<StarlarkRunSpan.AsObject>,
+  }
+}
+
+export class StarlarkRunSpan extends jspb.Message {
+  getSpanId(): string;
+  setSpanId(value: string): StarlarkRunSpan;
+
+  getParentSpanId(): string;
+  setParentSpanId(value: string): StarlarkRunSpan;
+
+  getName(): string;
+  setName(value: string): StarlarkRunSpan;
+
+  getStartTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setStartTime(value?: google_protobuf_timestamp_pb.Timestamp): StarlarkRunSpan;
+  hasStartTime(): boolean;
+  clearStartTime(): StarlarkRunSpan;
+
+  getEndTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setEndTime(value?: google_protobuf_timestamp_pb.Timestamp): StarlarkRunSpan;
+  hasEndTime(): boolean;
+  clearEndTime(): StarlarkRunSpan;
+
+  getAttributesMap(): jspb.Map<string, string>;
+  clearAttributesMap(): StarlarkRunSpan;
+
+  getError(): string;
+  setError(value: string): StarlarkRunSpan;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkRunSpan.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkRunSpan): StarlarkRunSpan.AsObject;
+  static serializeBinaryToWriter(message: StarlarkRunSpan, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkRunSpan;
+  static deserializeBinaryFromReader(message: StarlarkRunSpan, reader: jspb.BinaryReader): StarlarkRunSpan;
+}
+
+export namespace StarlarkRunSpan {
+  export type AsObject = {
+    spanId: string,
+    parentSpanId: string,
+    name: string,
+    startTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    endTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    attributesMap: Array<[string, string]>,
+    error: string,
+  }
+}
+
 export enum ServiceStatus { 
   STOPPED = 0,
   RUNNING = 1,
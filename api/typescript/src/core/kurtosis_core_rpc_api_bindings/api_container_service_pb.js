@@ -23,6 +23,8 @@ var global = (function() {
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 goog.object.extend(proto, google_protobuf_empty_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
 goog.exportSymbol('proto.api_container_api.Connect', null, global);
 goog.exportSymbol('proto.api_container_api.ConnectServicesArgs', null, global);
 goog.exportSymbol('proto.api_container_api.ConnectServicesResponse', null, global);
@@ -30,29 +32,51 @@ goog.exportSymbol('proto.api_container_api.Container', null, global);
 goog.exportSymbol('proto.api_container_api.Container.Status', null, global);
 goog.exportSymbol('proto.api_container_api.DataChunkMetadata', null, global);
 goog.exportSymbol('proto.api_container_api.DownloadFilesArtifactArgs', null, global);
+goog.exportSymbol('proto.api_container_api.EnclaveEvent', null, global);
+goog.exportSymbol('proto.api_container_api.EnclaveEvent.Type', null, global);
+goog.exportSymbol('proto.api_container_api.EnclaveResourceQuotaBudget', null, global);
+goog.exportSymbol('proto.api_container_api.EnclaveSnapshotPersistentDirectory', null, global);
 goog.exportSymbol('proto.api_container_api.ExecCommandArgs', null, global);
 goog.exportSymbol('proto.api_container_api.ExecCommandResponse', null, global);
 goog.exportSymbol('proto.api_container_api.FileArtifactContentsFileDescription', null, global);
 goog.exportSymbol('proto.api_container_api.FilesArtifactNameAndUuid', null, global);
+goog.exportSymbol('proto.api_container_api.GetEnclaveActivityResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetEnclaveEventsArgs', null, global);
+goog.exportSymbol('proto.api_container_api.GetEnclaveEventsResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetEnclaveResourceUsageResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetEnclaveSnapshotInfoResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetServiceStatsArgs', null, global);
+goog.exportSymbol('proto.api_container_api.GetServiceStatsResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetServicesArgs', null, global);
 goog.exportSymbol('proto.api_container_api.GetServicesResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetStarlarkRunResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetStarlarkRunTraceResponse', null, global);
 goog.exportSymbol('proto.api_container_api.ImageDownloadMode', null, global);
 goog.exportSymbol('proto.api_container_api.InspectFilesArtifactContentsRequest', null, global);
 goog.exportSymbol('proto.api_container_api.InspectFilesArtifactContentsResponse', null, global);
 goog.exportSymbol('proto.api_container_api.KurtosisFeatureFlag', null, global);
 goog.exportSymbol('proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse', null, global);
+goog.exportSymbol('proto.api_container_api.ListSecretsResponse', null, global);
 goog.exportSymbol('proto.api_container_api.PlanYaml', null, global);
 goog.exportSymbol('proto.api_container_api.Port', null, global);
 goog.exportSymbol('proto.api_container_api.Port.TransportProtocol', null, global);
+goog.exportSymbol('proto.api_container_api.RemoveSecretArgs', null, global);
 goog.exportSymbol('proto.api_container_api.RestartPolicy', null, global);
+goog.exportSymbol('proto.api_container_api.RestoreEnclaveSnapshotArgs', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkPackageArgs', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkScriptArgs', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceHealth', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceHealth.Status', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceHealthEvent', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceIdentifiers', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceInfo', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceIoStats', null, global);
+goog.exportSymbol('proto.api_container_api.ServicePeakUsage', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceStats', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceStatus', null, global);
+goog.exportSymbol('proto.api_container_api.SetSecretArgs', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkError', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkError.ErrorCase', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkExecutionError', null, global);
@@ -67,6 +91,7 @@ goog.exportSymbol('proto.api_container_api.StarlarkRunFinishedEvent', null, glob
 goog.exportSymbol('proto.api_container_api.StarlarkRunProgress', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunResponseLine', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkRunSpan', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkScriptPlanYamlArgs', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkValidationError', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkWarning', null, global);
@@ -120,6 +145,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.Container.displayName = 'proto.api_container_api.Container';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServiceHealth = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServiceHealth, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceHealth.displayName = 'proto.api_container_api.ServiceHealth';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -498,6 +544,132 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.GetServicesResponse.displayName = 'proto.api_container_api.GetServicesResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetServiceStatsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetServiceStatsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetServiceStatsArgs.displayName = 'proto.api_container_api.GetServiceStatsArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServiceIoStats = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServiceIoStats, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceIoStats.displayName = 'proto.api_container_api.ServiceIoStats';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServiceStats = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServiceStats, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceStats.displayName = 'proto.api_container_api.ServiceStats';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServicePeakUsage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServicePeakUsage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServicePeakUsage.displayName = 'proto.api_container_api.ServicePeakUsage';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetServiceStatsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetServiceStatsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetServiceStatsResponse.displayName = 'proto.api_container_api.GetServiceStatsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServiceHealthEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServiceHealthEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceHealthEvent.displayName = 'proto.api_container_api.ServiceHealthEvent';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -1023,103 +1195,4464 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.StarlarkPackagePlanYamlArgs.displayName = 'proto.api_container_api.StarlarkPackagePlanYamlArgs';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.Port.toObject(opt_includeInstance, this);
+proto.api_container_api.EnclaveSnapshotPersistentDirectory = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.api_container_api.EnclaveSnapshotPersistentDirectory, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.EnclaveSnapshotPersistentDirectory.displayName = 'proto.api_container_api.EnclaveSnapshotPersistentDirectory';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.Port} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    number: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    transportProtocol: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    maybeApplicationProtocol: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    maybeWaitTimeout: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    locked: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    alias: jspb.Message.getFieldWithDefault(msg, 6, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.GetEnclaveSnapshotInfoResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetEnclaveSnapshotInfoResponse.repeatedFields_, null);
 };
+goog.inherits(proto.api_container_api.GetEnclaveSnapshotInfoResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetEnclaveSnapshotInfoResponse.displayName = 'proto.api_container_api.GetEnclaveSnapshotInfoResponse';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.Port}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.Port;
-  return proto.api_container_api.Port.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.RestoreEnclaveSnapshotArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.api_container_api.RestoreEnclaveSnapshotArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RestoreEnclaveSnapshotArgs.displayName = 'proto.api_container_api.RestoreEnclaveSnapshotArgs';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.Port} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.Port}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setNumber(value);
-      break;
-    case 2:
-      var value = /** @type {!proto.api_container_api.Port.TransportProtocol} */ (reader.readEnum());
-      msg.setTransportProtocol(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMaybeApplicationProtocol(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMaybeWaitTimeout(value);
-      break;
-    case 5:
+proto.api_container_api.SetSecretArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.SetSecretArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.SetSecretArgs.displayName = 'proto.api_container_api.SetSecretArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ListSecretsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.ListSecretsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.ListSecretsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ListSecretsResponse.displayName = 'proto.api_container_api.ListSecretsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.RemoveSecretArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.RemoveSecretArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RemoveSecretArgs.displayName = 'proto.api_container_api.RemoveSecretArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetEnclaveActivityResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetEnclaveActivityResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetEnclaveActivityResponse.displayName = 'proto.api_container_api.GetEnclaveActivityResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetEnclaveResourceUsageResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetEnclaveResourceUsageResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetEnclaveResourceUsageResponse.displayName = 'proto.api_container_api.GetEnclaveResourceUsageResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.EnclaveResourceQuotaBudget = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.EnclaveResourceQuotaBudget, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.EnclaveResourceQuotaBudget.displayName = 'proto.api_container_api.EnclaveResourceQuotaBudget';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetEnclaveEventsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetEnclaveEventsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetEnclaveEventsArgs.displayName = 'proto.api_container_api.GetEnclaveEventsArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetEnclaveEventsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetEnclaveEventsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.GetEnclaveEventsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetEnclaveEventsResponse.displayName = 'proto.api_container_api.GetEnclaveEventsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.EnclaveEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.EnclaveEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.EnclaveEvent.displayName = 'proto.api_container_api.EnclaveEvent';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetStarlarkRunTraceResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetStarlarkRunTraceResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.GetStarlarkRunTraceResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetStarlarkRunTraceResponse.displayName = 'proto.api_container_api.GetStarlarkRunTraceResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkRunSpan = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkRunSpan, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkRunSpan.displayName = 'proto.api_container_api.StarlarkRunSpan';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.Port.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.Port.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.Port} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.Port.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    number: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    transportProtocol: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    maybeApplicationProtocol: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    maybeWaitTimeout: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    locked: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    alias: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.Port}
+ */
+proto.api_container_api.Port.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.Port;
+  return proto.api_container_api.Port.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.Port} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.Port}
+ */
+proto.api_container_api.Port.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumber(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.api_container_api.Port.TransportProtocol} */ (reader.readEnum());
+      msg.setTransportProtocol(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMaybeApplicationProtocol(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMaybeWaitTimeout(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setLocked(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAlias(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.Port.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.Port.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.Port} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.Port.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getTransportProtocol();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getMaybeApplicationProtocol();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getMaybeWaitTimeout();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.Port.TransportProtocol = {
+  TCP: 0,
+  SCTP: 1,
+  UDP: 2
+};
+
+/**
+ * optional uint32 number = 1;
+ * @return {number}
+ */
+proto.api_container_api.Port.prototype.getNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional TransportProtocol transport_protocol = 2;
+ * @return {!proto.api_container_api.Port.TransportProtocol}
+ */
+proto.api_container_api.Port.prototype.getTransportProtocol = function() {
+  return /** @type {!proto.api_container_api.Port.TransportProtocol} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.Port.TransportProtocol} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setTransportProtocol = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string maybe_application_protocol = 3;
+ * @return {string}
+ */
+proto.api_container_api.Port.prototype.getMaybeApplicationProtocol = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setMaybeApplicationProtocol = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string maybe_wait_timeout = 4;
+ * @return {string}
+ */
+proto.api_container_api.Port.prototype.getMaybeWaitTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setMaybeWaitTimeout = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional bool locked = 5;
+ * @return {boolean}
+ */
+proto.api_container_api.Port.prototype.getLocked = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setLocked = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.clearLocked = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.Port.prototype.hasLocked = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional string alias = 6;
+ * @return {string}
+ */
+proto.api_container_api.Port.prototype.getAlias = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setAlias = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.clearAlias = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.Port.prototype.hasAlias = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.Container.repeatedFields_ = [3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.Container.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.Container.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.Container} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.Container.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    imageName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    entrypointArgsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    cmdArgsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    envVarsMap: (f = msg.getEnvVarsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.Container}
+ */
+proto.api_container_api.Container.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.Container;
+  return proto.api_container_api.Container.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.Container} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.Container}
+ */
+proto.api_container_api.Container.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.api_container_api.Container.Status} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setImageName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addEntrypointArgs(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCmdArgs(value);
+      break;
+    case 5:
+      var value = msg.getEnvVarsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.Container.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.Container.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.Container} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.Container.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getImageName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getEntrypointArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getCmdArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getEnvVarsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.Container.Status = {
+  STOPPED: 0,
+  RUNNING: 1,
+  UNKNOWN: 2
+};
+
+/**
+ * optional Status status = 1;
+ * @return {!proto.api_container_api.Container.Status}
+ */
+proto.api_container_api.Container.prototype.getStatus = function() {
+  return /** @type {!proto.api_container_api.Container.Status} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.Container.Status} value
+ * @return {!proto.api_container_api.Container} returns this
+ */
+proto.api_container_api.Container.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string image_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.Container.prototype.getImageName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.Container} returns this
+ */
+proto.api_container_api.Container.prototype.setImageName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated string entrypoint_args = 3;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.Container.prototype.getEntrypointArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.Container} returns this
+ */
+proto.api_container_api.Container.prototype.setEntrypointArgsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.Container} returns this
+ */
+proto.api_container_api.Container.prototype.addEntrypointArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.Container} returns this
+ */
+proto.api_container_api.Container.prototype.clearEntrypointArgsList = function() {
+  return this.setEntrypointArgsList([]);
+};
+
+
+/**
+ * repeated string cmd_args = 4;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.Container.prototype.getCmdArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.Container} returns this
+ */
+proto.api_container_api.Container.prototype.setCmdArgsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.Container} returns this
+ */
+proto.api_container_api.Container.prototype.addCmdArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.Container} returns this
+ */
+proto.api_container_api.Container.prototype.clearCmdArgsList = function() {
+  return this.setCmdArgsList([]);
+};
+
+
+/**
+ * map<string, string> env_vars = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.Container.prototype.getEnvVarsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.Container} returns this
+ */
+proto.api_container_api.Container.prototype.clearEnvVarsMap = function() {
+  this.getEnvVarsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ServiceHealth.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceHealth.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceHealth} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceHealth.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    exitCode: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    restartCount: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    message: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    since: (f = msg.getSince()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    lastExitReason: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceHealth}
+ */
+proto.api_container_api.ServiceHealth.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceHealth;
+  return proto.api_container_api.ServiceHealth.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceHealth} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceHealth}
+ */
+proto.api_container_api.ServiceHealth.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.api_container_api.ServiceHealth.Status} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRestartCount(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setSince(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLastExitReason(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ServiceHealth.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceHealth.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceHealth} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceHealth.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getRestartCount();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getSince();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getLastExitReason();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.ServiceHealth.Status = {
+  UNKNOWN: 0,
+  HEALTHY: 1,
+  UNHEALTHY: 2,
+  CRASHED: 3
+};
+
+/**
+ * optional Status status = 1;
+ * @return {!proto.api_container_api.ServiceHealth.Status}
+ */
+proto.api_container_api.ServiceHealth.prototype.getStatus = function() {
+  return /** @type {!proto.api_container_api.ServiceHealth.Status} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.ServiceHealth.Status} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
+ */
+proto.api_container_api.ServiceHealth.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional int32 exit_code = 2;
+ * @return {number}
+ */
+proto.api_container_api.ServiceHealth.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
+ */
+proto.api_container_api.ServiceHealth.prototype.setExitCode = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceHealth} returns this
+ */
+proto.api_container_api.ServiceHealth.prototype.clearExitCode = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceHealth.prototype.hasExitCode = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional uint32 restart_count = 3;
+ * @return {number}
+ */
+proto.api_container_api.ServiceHealth.prototype.getRestartCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
+ */
+proto.api_container_api.ServiceHealth.prototype.setRestartCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string message = 4;
+ * @return {string}
+ */
+proto.api_container_api.ServiceHealth.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
+ */
+proto.api_container_api.ServiceHealth.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp since = 5;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.api_container_api.ServiceHealth.prototype.getSince = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
+*/
+proto.api_container_api.ServiceHealth.prototype.setSince = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceHealth} returns this
+ */
+proto.api_container_api.ServiceHealth.prototype.clearSince = function() {
+  return this.setSince(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceHealth.prototype.hasSince = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional string last_exit_reason = 6;
+ * @return {string}
+ */
+proto.api_container_api.ServiceHealth.prototype.getLastExitReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
+ */
+proto.api_container_api.ServiceHealth.prototype.setLastExitReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ServiceInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    privateIpAddr: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    privatePortsMap: (f = msg.getPrivatePortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
+    maybePublicIpAddr: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    maybePublicPortsMap: (f = msg.getMaybePublicPortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
+    name: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    serviceStatus: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    container: (f = msg.getContainer()) && proto.api_container_api.Container.toObject(includeInstance, f),
+    health: (f = msg.getHealth()) && proto.api_container_api.ServiceHealth.toObject(includeInstance, f),
+    privateIpv6Addr: jspb.Message.getFieldWithDefault(msg, 11, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceInfo}
+ */
+proto.api_container_api.ServiceInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceInfo;
+  return proto.api_container_api.ServiceInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceInfo}
+ */
+proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPrivateIpAddr(value);
+      break;
+    case 3:
+      var value = msg.getPrivatePortsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
+         });
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMaybePublicIpAddr(value);
+      break;
+    case 5:
+      var value = msg.getMaybePublicPortsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
+         });
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.api_container_api.ServiceStatus} */ (reader.readEnum());
+      msg.setServiceStatus(value);
+      break;
+    case 9:
+      var value = new proto.api_container_api.Container;
+      reader.readMessage(value,proto.api_container_api.Container.deserializeBinaryFromReader);
+      msg.setContainer(value);
+      break;
+    case 10:
+      var value = new proto.api_container_api.ServiceHealth;
+      reader.readMessage(value,proto.api_container_api.ServiceHealth.deserializeBinaryFromReader);
+      msg.setHealth(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPrivateIpv6Addr(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ServiceInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPrivateIpAddr();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPrivatePortsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
+  }
+  f = message.getMaybePublicIpAddr();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getMaybePublicPortsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getServiceStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getContainer();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.api_container_api.Container.serializeBinaryToWriter
+    );
+  }
+  f = message.getHealth();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      proto.api_container_api.ServiceHealth.serializeBinaryToWriter
+    );
+  }
+  f = message.getPrivateIpv6Addr();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_uuid = 1;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string private_ip_addr = 2;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getPrivateIpAddr = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setPrivateIpAddr = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * map<string, Port> private_ports = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ */
+proto.api_container_api.ServiceInfo.prototype.getPrivatePortsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      proto.api_container_api.Port));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearPrivatePortsMap = function() {
+  this.getPrivatePortsMap().clear();
+  return this;};
+
+
+/**
+ * optional string maybe_public_ip_addr = 4;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getMaybePublicIpAddr = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setMaybePublicIpAddr = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * map<string, Port> maybe_public_ports = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ */
+proto.api_container_api.ServiceInfo.prototype.getMaybePublicPortsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      proto.api_container_api.Port));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearMaybePublicPortsMap = function() {
+  this.getMaybePublicPortsMap().clear();
+  return this;};
+
+
+/**
+ * optional string name = 6;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 7;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional ServiceStatus service_status = 8;
+ * @return {!proto.api_container_api.ServiceStatus}
+ */
+proto.api_container_api.ServiceInfo.prototype.getServiceStatus = function() {
+  return /** @type {!proto.api_container_api.ServiceStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.ServiceStatus} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setServiceStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
+};
+
+
+/**
+ * optional Container container = 9;
+ * @return {?proto.api_container_api.Container}
+ */
+proto.api_container_api.ServiceInfo.prototype.getContainer = function() {
+  return /** @type{?proto.api_container_api.Container} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.Container, 9));
+};
+
+
+/**
+ * @param {?proto.api_container_api.Container|undefined} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+*/
+proto.api_container_api.ServiceInfo.prototype.setContainer = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearContainer = function() {
+  return this.setContainer(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceInfo.prototype.hasContainer = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional ServiceHealth health = 10;
+ * @return {?proto.api_container_api.ServiceHealth}
+ */
+proto.api_container_api.ServiceInfo.prototype.getHealth = function() {
+  return /** @type{?proto.api_container_api.ServiceHealth} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.ServiceHealth, 10));
+};
+
+
+/**
+ * @param {?proto.api_container_api.ServiceHealth|undefined} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+*/
+proto.api_container_api.ServiceInfo.prototype.setHealth = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearHealth = function() {
+  return this.setHealth(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceInfo.prototype.hasHealth = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional string private_ipv6_addr = 11;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getPrivateIpv6Addr = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setPrivateIpv6Addr = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.RunStarlarkScriptArgs.repeatedFields_ = [6];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkScriptArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    cloudInstanceId: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    cloudUserId: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    imageDownloadMode: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    nonBlockingMode: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RunStarlarkScriptArgs;
+  return proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedScript(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryRun(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallelism(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
+      break;
+    case 6:
+      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addExperimentalFeatures(values[i]);
+      }
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCloudInstanceId(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCloudUserId(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.api_container_api.ImageDownloadMode} */ (reader.readEnum());
+      msg.setImageDownloadMode(value);
+      break;
+    case 10:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setNonBlockingMode(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedScript();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getExperimentalFeaturesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      6,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getField(message, 9));
+  if (f != null) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 10));
+  if (f != null) {
+    writer.writeBool(
+      10,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_script = 1;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedScript = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedScript = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string serialized_params = 2;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearSerializedParams = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasSerializedParams = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bool dry_run = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getDryRun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setDryRun = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearDryRun = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasDryRun = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional int32 parallelism = 4;
+ * @return {number}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setParallelism = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearParallelism = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasParallelism = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional string main_function_name = 5;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearMainFunctionName = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasMainFunctionName = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * repeated KurtosisFeatureFlag experimental_features = 6;
+ * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getExperimentalFeaturesList = function() {
+  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setExperimentalFeaturesList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {!proto.api_container_api.KurtosisFeatureFlag} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.addExperimentalFeatures = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearExperimentalFeaturesList = function() {
+  return this.setExperimentalFeaturesList([]);
+};
+
+
+/**
+ * optional string cloud_instance_id = 7;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getCloudInstanceId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setCloudInstanceId = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearCloudInstanceId = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasCloudInstanceId = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional string cloud_user_id = 8;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getCloudUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setCloudUserId = function(value) {
+  return jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearCloudUserId = function() {
+  return jspb.Message.setField(this, 8, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasCloudUserId = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional ImageDownloadMode image_download_mode = 9;
+ * @return {!proto.api_container_api.ImageDownloadMode}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getImageDownloadMode = function() {
+  return /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.ImageDownloadMode} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setImageDownloadMode = function(value) {
+  return jspb.Message.setField(this, 9, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearImageDownloadMode = function() {
+  return jspb.Message.setField(this, 9, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasImageDownloadMode = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional bool non_blocking_mode = 10;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getNonBlockingMode = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setNonBlockingMode = function(value) {
+  return jspb.Message.setField(this, 10, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearNonBlockingMode = function() {
+  return jspb.Message.setField(this, 10, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasNonBlockingMode = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.RunStarlarkPackageArgs.repeatedFields_ = [11];
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_ = [[3,4]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase = {
+  STARLARK_PACKAGE_CONTENT_NOT_SET: 0,
+  LOCAL: 3,
+  REMOTE: 4
+};
+
+/**
+ * @return {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getStarlarkPackageContentCase = function() {
+  return /** @type {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkPackageArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    local: msg.getLocal_asB64(),
+    remote: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    clonePackage: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f,
+    cloudInstanceId: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    cloudUserId: jspb.Message.getFieldWithDefault(msg, 13, ""),
+    imageDownloadMode: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    nonBlockingMode: jspb.Message.getBooleanFieldWithDefault(msg, 15, false),
+    githubAuthToken: jspb.Message.getFieldWithDefault(msg, 16, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RunStarlarkPackageArgs;
+  return proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPackageId(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setLocal(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRemote(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryRun(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallelism(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setClonePackage(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRelativePathToMainFile(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
+      break;
+    case 11:
+      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addExperimentalFeatures(values[i]);
+      }
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCloudInstanceId(value);
+      break;
+    case 13:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCloudUserId(value);
+      break;
+    case 14:
+      var value = /** @type {!proto.api_container_api.ImageDownloadMode} */ (reader.readEnum());
+      msg.setImageDownloadMode(value);
+      break;
+    case 15:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setNonBlockingMode(value);
+      break;
+    case 16:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGithubAuthToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPackageId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 9));
+  if (f != null) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 10));
+  if (f != null) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getExperimentalFeaturesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      11,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 12));
+  if (f != null) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 13));
+  if (f != null) {
+    writer.writeString(
+      13,
+      f
+    );
+  }
+  f = /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getField(message, 14));
+  if (f != null) {
+    writer.writeEnum(
+      14,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 15));
+  if (f != null) {
+    writer.writeBool(
+      15,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 16));
+  if (f != null) {
+    writer.writeString(
+      16,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string package_id = 1;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setPackageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bytes local = 3;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes local = 3;
+ * This is a type-conversion wrapper around `getLocal()`
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getLocal()));
+};
+
+
+/**
+ * optional bytes local = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getLocal()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getLocal()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setLocal = function(value) {
+  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocal = function() {
+  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasLocal = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional bool remote = 4;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getRemote = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setRemote = function(value) {
+  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRemote = function() {
+  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRemote = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional string serialized_params = 5;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearSerializedParams = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasSerializedParams = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional bool dry_run = 6;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getDryRun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setDryRun = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearDryRun = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasDryRun = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional int32 parallelism = 7;
+ * @return {number}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setParallelism = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearParallelism = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasParallelism = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional bool clone_package = 8;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getClonePackage = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setClonePackage = function(value) {
+  return jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearClonePackage = function() {
+  return jspb.Message.setField(this, 8, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasClonePackage = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional string relative_path_to_main_file = 9;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getRelativePathToMainFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setRelativePathToMainFile = function(value) {
+  return jspb.Message.setField(this, 9, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRelativePathToMainFile = function() {
+  return jspb.Message.setField(this, 9, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRelativePathToMainFile = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional string main_function_name = 10;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setField(this, 10, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearMainFunctionName = function() {
+  return jspb.Message.setField(this, 10, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasMainFunctionName = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * repeated KurtosisFeatureFlag experimental_features = 11;
+ * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getExperimentalFeaturesList = function() {
+  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 11));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setExperimentalFeaturesList = function(value) {
+  return jspb.Message.setField(this, 11, value || []);
+};
+
+
+/**
+ * @param {!proto.api_container_api.KurtosisFeatureFlag} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.addExperimentalFeatures = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 11, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearExperimentalFeaturesList = function() {
+  return this.setExperimentalFeaturesList([]);
+};
+
+
+/**
+ * optional string cloud_instance_id = 12;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getCloudInstanceId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setCloudInstanceId = function(value) {
+  return jspb.Message.setField(this, 12, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearCloudInstanceId = function() {
+  return jspb.Message.setField(this, 12, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasCloudInstanceId = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+/**
+ * optional string cloud_user_id = 13;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getCloudUserId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setCloudUserId = function(value) {
+  return jspb.Message.setField(this, 13, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearCloudUserId = function() {
+  return jspb.Message.setField(this, 13, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasCloudUserId = function() {
+  return jspb.Message.getField(this, 13) != null;
+};
+
+
+/**
+ * optional ImageDownloadMode image_download_mode = 14;
+ * @return {!proto.api_container_api.ImageDownloadMode}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getImageDownloadMode = function() {
+  return /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.ImageDownloadMode} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setImageDownloadMode = function(value) {
+  return jspb.Message.setField(this, 14, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearImageDownloadMode = function() {
+  return jspb.Message.setField(this, 14, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasImageDownloadMode = function() {
+  return jspb.Message.getField(this, 14) != null;
+};
+
+
+/**
+ * optional bool non_blocking_mode = 15;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getNonBlockingMode = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 15, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setNonBlockingMode = function(value) {
+  return jspb.Message.setField(this, 15, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearNonBlockingMode = function() {
+  return jspb.Message.setField(this, 15, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasNonBlockingMode = function() {
+  return jspb.Message.getField(this, 15) != null;
+};
+
+
+/**
+ * optional string github_auth_token = 16;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getGithubAuthToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setGithubAuthToken = function(value) {
+  return jspb.Message.setField(this, 16, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearGithubAuthToken = function() {
+  return jspb.Message.setField(this, 16, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasGithubAuthToken = function() {
+  return jspb.Message.getField(this, 16) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunResponseLine.oneofGroups_ = [[1,2,3,4,5,6,7]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase = {
+  RUN_RESPONSE_LINE_NOT_SET: 0,
+  INSTRUCTION: 1,
+  ERROR: 2,
+  PROGRESS_INFO: 3,
+  INSTRUCTION_RESULT: 4,
+  RUN_FINISHED_EVENT: 5,
+  WARNING: 6,
+  INFO: 7
+};
+
+/**
+ * @return {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunResponseLineCase = function() {
+  return /** @type {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunResponseLine.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
+    error: (f = msg.getError()) && proto.api_container_api.StarlarkError.toObject(includeInstance, f),
+    progressInfo: (f = msg.getProgressInfo()) && proto.api_container_api.StarlarkRunProgress.toObject(includeInstance, f),
+    instructionResult: (f = msg.getInstructionResult()) && proto.api_container_api.StarlarkInstructionResult.toObject(includeInstance, f),
+    runFinishedEvent: (f = msg.getRunFinishedEvent()) && proto.api_container_api.StarlarkRunFinishedEvent.toObject(includeInstance, f),
+    warning: (f = msg.getWarning()) && proto.api_container_api.StarlarkWarning.toObject(includeInstance, f),
+    info: (f = msg.getInfo()) && proto.api_container_api.StarlarkInfo.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ */
+proto.api_container_api.StarlarkRunResponseLine.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunResponseLine;
+  return proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ */
+proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInstruction;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
+      msg.setInstruction(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.StarlarkError;
+      reader.readMessage(value,proto.api_container_api.StarlarkError.deserializeBinaryFromReader);
+      msg.setError(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkRunProgress;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader);
+      msg.setProgressInfo(value);
+      break;
+    case 4:
+      var value = new proto.api_container_api.StarlarkInstructionResult;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader);
+      msg.setInstructionResult(value);
+      break;
+    case 5:
+      var value = new proto.api_container_api.StarlarkRunFinishedEvent;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader);
+      msg.setRunFinishedEvent(value);
+      break;
+    case 6:
+      var value = new proto.api_container_api.StarlarkWarning;
+      reader.readMessage(value,proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader);
+      msg.setWarning(value);
+      break;
+    case 7:
+      var value = new proto.api_container_api.StarlarkInfo;
+      reader.readMessage(value,proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader);
+      msg.setInfo(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInstruction();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
+    );
+  }
+  f = message.getError();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.api_container_api.StarlarkError.serializeBinaryToWriter
+    );
+  }
+  f = message.getProgressInfo();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionResult();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getRunFinishedEvent();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter
+    );
+  }
+  f = message.getWarning();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.api_container_api.StarlarkWarning.serializeBinaryToWriter
+    );
+  }
+  f = message.getInfo();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.api_container_api.StarlarkInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInstruction instruction = 1;
+ * @return {?proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstruction = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstruction = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = function() {
+  return this.setInstruction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstruction = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional StarlarkError error = 2;
+ * @return {?proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getError = function() {
+  return /** @type{?proto.api_container_api.StarlarkError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkError, 2));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkError|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function() {
+  return this.setError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasError = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional StarlarkRunProgress progress_info = 3;
+ * @return {?proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getProgressInfo = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunProgress} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunProgress, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunProgress|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setProgressInfo = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = function() {
+  return this.setProgressInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasProgressInfo = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional StarlarkInstructionResult instruction_result = 4;
+ * @return {?proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionResult = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionResult} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionResult, 4));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionResult|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionResult = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 4, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult = function() {
+  return this.setInstructionResult(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionResult = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional StarlarkRunFinishedEvent run_finished_event = 5;
+ * @return {?proto.api_container_api.StarlarkRunFinishedEvent}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunFinishedEvent = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunFinishedEvent} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunFinishedEvent, 5));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunFinishedEvent|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setRunFinishedEvent = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 5, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunFinishedEvent = function() {
+  return this.setRunFinishedEvent(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunFinishedEvent = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional StarlarkWarning warning = 6;
+ * @return {?proto.api_container_api.StarlarkWarning}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getWarning = function() {
+  return /** @type{?proto.api_container_api.StarlarkWarning} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkWarning, 6));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkWarning|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setWarning = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 6, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearWarning = function() {
+  return this.setWarning(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasWarning = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional StarlarkInfo info = 7;
+ * @return {?proto.api_container_api.StarlarkInfo}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInfo = function() {
+  return /** @type{?proto.api_container_api.StarlarkInfo} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInfo, 7));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInfo|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInfo = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 7, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInfo = function() {
+  return this.setInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInfo = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    infoMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInfo}
+ */
+proto.api_container_api.StarlarkInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInfo;
+  return proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInfo}
+ */
+proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setInfoMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInfoMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string info_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInfo.prototype.getInfoMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInfo} returns this
+ */
+proto.api_container_api.StarlarkInfo.prototype.setInfoMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkWarning.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkWarning.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkWarning} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkWarning.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    warningMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkWarning}
+ */
+proto.api_container_api.StarlarkWarning.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkWarning;
+  return proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkWarning} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkWarning}
+ */
+proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWarningMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkWarning.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkWarning.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkWarning} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkWarning.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWarningMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string warning_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkWarning.prototype.getWarningMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkWarning} returns this
+ */
+proto.api_container_api.StarlarkWarning.prototype.setWarningMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkInstruction.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstruction.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstruction} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
+    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    argumentsList: jspb.Message.toObjectList(msg.getArgumentsList(),
+    proto.api_container_api.StarlarkInstructionArg.toObject, includeInstance),
+    executableInstruction: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    isSkipped: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    description: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkInstruction.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstruction;
+  return proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstruction} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInstructionPosition;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
+      msg.setPosition(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setInstructionName(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkInstructionArg;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader);
+      msg.addArguments(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutableInstruction(value);
+      break;
+    case 5:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setLocked(value);
+      msg.setIsSkipped(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstruction} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPosition();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getArgumentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter
+    );
+  }
+  f = message.getExecutableInstruction();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getIsSkipped();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInstructionPosition position = 1;
+ * @return {?proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getPosition = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+*/
+proto.api_container_api.StarlarkInstruction.prototype.setPosition = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function() {
+  return this.setPosition(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.hasPosition = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string instruction_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getInstructionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setInstructionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated StarlarkInstructionArg arguments = 3;
+ * @return {!Array<!proto.api_container_api.StarlarkInstructionArg>}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getArgumentsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionArg>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionArg, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.StarlarkInstructionArg>} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+*/
+proto.api_container_api.StarlarkInstruction.prototype.setArgumentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkInstructionArg=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.addArguments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstructionArg, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.clearArgumentsList = function() {
+  return this.setArgumentsList([]);
+};
+
+
+/**
+ * optional string executable_instruction = 4;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getExecutableInstruction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setExecutableInstruction = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional bool is_skipped = 5;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getIsSkipped = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setIsSkipped = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+/**
+ * optional string description = 6;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedInstructionResult: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkInstructionResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionResult;
+  return proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedInstructionResult(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedInstructionResult();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_instruction_result = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.getSerializedInstructionResult = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.setSerializedInstructionResult = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionArg.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionArg} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedArgValue: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    argName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    isRepresentative: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstructionArg.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionArg;
+  return proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionArg} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
       break;
-    case 6:
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setAlias(value);
+      msg.setSerializedArgValue(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setArgName(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsRepresentative(value);
       break;
     default:
       reader.skipField();
@@ -1134,9 +5667,9 @@ proto.api_container_api.Port.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.Port.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.Port.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1144,51 +5677,30 @@ proto.api_container_api.Port.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.Port} message
+ * @param {!proto.api_container_api.StarlarkInstructionArg} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.Port.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getNumber();
-  if (f !== 0) {
-    writer.writeUint32(
-      1,
-      f
-    );
-  }
-  f = message.getTransportProtocol();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
-    );
-  }
-  f = message.getMaybeApplicationProtocol();
+  f = message.getSerializedArgValue();
   if (f.length > 0) {
     writer.writeString(
-      3,
+      1,
       f
     );
   }
-  f = message.getMaybeWaitTimeout();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
     writer.writeString(
-      4,
+      2,
       f
     );
   }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
+  f = message.getIsRepresentative();
+  if (f) {
     writer.writeBool(
-      5,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeString(
-      6,
+      3,
       f
     );
   }
@@ -1196,165 +5708,294 @@ proto.api_container_api.Port.serializeBinaryToWriter = function(message, writer)
 
 
 /**
- * @enum {number}
+ * optional string serialized_arg_value = 1;
+ * @return {string}
  */
-proto.api_container_api.Port.TransportProtocol = {
-  TCP: 0,
-  SCTP: 1,
-  UDP: 2
+proto.api_container_api.StarlarkInstructionArg.prototype.getSerializedArgValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
+
 /**
- * optional uint32 number = 1;
- * @return {number}
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
  */
-proto.api_container_api.Port.prototype.getNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.api_container_api.StarlarkInstructionArg.prototype.setSerializedArgValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.Port} returns this
+ * optional string arg_name = 2;
+ * @return {string}
  */
-proto.api_container_api.Port.prototype.setNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.api_container_api.StarlarkInstructionArg.prototype.getArgName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional TransportProtocol transport_protocol = 2;
- * @return {!proto.api_container_api.Port.TransportProtocol}
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setArgName = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.hasArgName = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bool is_representative = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getIsRepresentative = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setIsRepresentative = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.Port.prototype.getTransportProtocol = function() {
-  return /** @type {!proto.api_container_api.Port.TransportProtocol} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionPosition.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!proto.api_container_api.Port.TransportProtocol} value
- * @return {!proto.api_container_api.Port} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.Port.prototype.setTransportProtocol = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string maybe_application_protocol = 3;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionPosition}
  */
-proto.api_container_api.Port.prototype.getMaybeApplicationProtocol = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.StarlarkInstructionPosition.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionPosition;
+  return proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.Port} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionPosition}
  */
-proto.api_container_api.Port.prototype.setMaybeApplicationProtocol = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLine(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setColumn(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional string maybe_wait_timeout = 4;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.Port.prototype.getMaybeWaitTimeout = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.Port} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.Port.prototype.setMaybeWaitTimeout = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLine();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getColumn();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional bool locked = 5;
- * @return {boolean}
+ * optional string filename = 1;
+ * @return {string}
  */
-proto.api_container_api.Port.prototype.getLocked = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.api_container_api.StarlarkInstructionPosition.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.Port} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
  */
-proto.api_container_api.Port.prototype.setLocked = function(value) {
-  return jspb.Message.setField(this, 5, value);
+proto.api_container_api.StarlarkInstructionPosition.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.Port} returns this
+ * optional int32 line = 2;
+ * @return {number}
  */
-proto.api_container_api.Port.prototype.clearLocked = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.api_container_api.StarlarkInstructionPosition.prototype.getLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
  */
-proto.api_container_api.Port.prototype.hasLocked = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.api_container_api.StarlarkInstructionPosition.prototype.setLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string alias = 6;
- * @return {string}
+ * optional int32 column = 3;
+ * @return {number}
  */
-proto.api_container_api.Port.prototype.getAlias = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.api_container_api.StarlarkInstructionPosition.prototype.getColumn = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.Port} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
  */
-proto.api_container_api.Port.prototype.setAlias = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.api_container_api.StarlarkInstructionPosition.prototype.setColumn = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
+
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.Port} returns this
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
  */
-proto.api_container_api.Port.prototype.clearAlias = function() {
-  return jspb.Message.setField(this, 6, undefined);
-};
-
+proto.api_container_api.StarlarkError.oneofGroups_ = [[1,2,3]];
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @enum {number}
  */
-proto.api_container_api.Port.prototype.hasAlias = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.api_container_api.StarlarkError.ErrorCase = {
+  ERROR_NOT_SET: 0,
+  INTERPRETATION_ERROR: 1,
+  VALIDATION_ERROR: 2,
+  EXECUTION_ERROR: 3
 };
 
-
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @return {proto.api_container_api.StarlarkError.ErrorCase}
  */
-proto.api_container_api.Container.repeatedFields_ = [3,4];
+proto.api_container_api.StarlarkError.prototype.getErrorCase = function() {
+  return /** @type {proto.api_container_api.StarlarkError.ErrorCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkError.oneofGroups_[0]));
+};
 
 
 
@@ -1371,8 +6012,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.Container.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.Container.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkError.toObject(opt_includeInstance, this);
 };
 
 
@@ -1381,17 +6022,15 @@ proto.api_container_api.Container.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.Container} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkError} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.Container.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg) {
   var f, obj = {
-    status: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    imageName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    entrypointArgsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
-    cmdArgsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-    envVarsMap: (f = msg.getEnvVarsMap()) ? f.toObject(includeInstance, undefined) : []
+    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
+    validationError: (f = msg.getValidationError()) && proto.api_container_api.StarlarkValidationError.toObject(includeInstance, f),
+    executionError: (f = msg.getExecutionError()) && proto.api_container_api.StarlarkExecutionError.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1405,23 +6044,23 @@ proto.api_container_api.Container.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.Container}
+ * @return {!proto.api_container_api.StarlarkError}
  */
-proto.api_container_api.Container.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkError.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.Container;
-  return proto.api_container_api.Container.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkError;
+  return proto.api_container_api.StarlarkError.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.Container} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkError} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.Container}
+ * @return {!proto.api_container_api.StarlarkError}
  */
-proto.api_container_api.Container.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1429,26 +6068,19 @@ proto.api_container_api.Container.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.api_container_api.Container.Status} */ (reader.readEnum());
-      msg.setStatus(value);
+      var value = new proto.api_container_api.StarlarkInterpretationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
+      msg.setInterpretationError(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setImageName(value);
+      var value = new proto.api_container_api.StarlarkValidationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader);
+      msg.setValidationError(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addEntrypointArgs(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addCmdArgs(value);
-      break;
-    case 5:
-      var value = msg.getEnvVarsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = new proto.api_container_api.StarlarkExecutionError;
+      reader.readMessage(value,proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader);
+      msg.setExecutionError(value);
       break;
     default:
       reader.skipField();
@@ -1463,9 +6095,9 @@ proto.api_container_api.Container.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.Container.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.Container.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkError.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1473,188 +6105,150 @@ proto.api_container_api.Container.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.Container} message
+ * @param {!proto.api_container_api.StarlarkError} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.Container.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkError.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getInterpretationError();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
     );
   }
-  f = message.getImageName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getValidationError();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter
     );
   }
-  f = message.getEntrypointArgsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getExecutionError();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
-    );
-  }
-  f = message.getCmdArgsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      4,
-      f
+      f,
+      proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter
     );
   }
-  f = message.getEnvVarsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-};
-
-
-/**
- * @enum {number}
- */
-proto.api_container_api.Container.Status = {
-  STOPPED: 0,
-  RUNNING: 1,
-  UNKNOWN: 2
-};
-
-/**
- * optional Status status = 1;
- * @return {!proto.api_container_api.Container.Status}
- */
-proto.api_container_api.Container.prototype.getStatus = function() {
-  return /** @type {!proto.api_container_api.Container.Status} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!proto.api_container_api.Container.Status} value
- * @return {!proto.api_container_api.Container} returns this
+ * optional StarlarkInterpretationError interpretation_error = 1;
+ * @return {?proto.api_container_api.StarlarkInterpretationError}
  */
-proto.api_container_api.Container.prototype.setStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.api_container_api.StarlarkError.prototype.getInterpretationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
 };
 
 
 /**
- * optional string image_name = 2;
- * @return {string}
- */
-proto.api_container_api.Container.prototype.getImageName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+ * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setInterpretationError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.Container} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
  */
-proto.api_container_api.Container.prototype.setImageName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.StarlarkError.prototype.clearInterpretationError = function() {
+  return this.setInterpretationError(undefined);
 };
 
 
 /**
- * repeated string entrypoint_args = 3;
- * @return {!Array<string>}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.Container.prototype.getEntrypointArgsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+proto.api_container_api.StarlarkError.prototype.hasInterpretationError = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.Container} returns this
+ * optional StarlarkValidationError validation_error = 2;
+ * @return {?proto.api_container_api.StarlarkValidationError}
  */
-proto.api_container_api.Container.prototype.setEntrypointArgsList = function(value) {
-  return jspb.Message.setField(this, 3, value || []);
+proto.api_container_api.StarlarkError.prototype.getValidationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkValidationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkValidationError, 2));
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.Container} returns this
- */
-proto.api_container_api.Container.prototype.addEntrypointArgs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+ * @param {?proto.api_container_api.StarlarkValidationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setValidationError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.Container} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
  */
-proto.api_container_api.Container.prototype.clearEntrypointArgsList = function() {
-  return this.setEntrypointArgsList([]);
+proto.api_container_api.StarlarkError.prototype.clearValidationError = function() {
+  return this.setValidationError(undefined);
 };
 
 
 /**
- * repeated string cmd_args = 4;
- * @return {!Array<string>}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.Container.prototype.getCmdArgsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.api_container_api.StarlarkError.prototype.hasValidationError = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.Container} returns this
+ * optional StarlarkExecutionError execution_error = 3;
+ * @return {?proto.api_container_api.StarlarkExecutionError}
  */
-proto.api_container_api.Container.prototype.setCmdArgsList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.api_container_api.StarlarkError.prototype.getExecutionError = function() {
+  return /** @type{?proto.api_container_api.StarlarkExecutionError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkExecutionError, 3));
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.Container} returns this
- */
-proto.api_container_api.Container.prototype.addCmdArgs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+ * @param {?proto.api_container_api.StarlarkExecutionError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setExecutionError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.Container} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
  */
-proto.api_container_api.Container.prototype.clearCmdArgsList = function() {
-  return this.setCmdArgsList([]);
+proto.api_container_api.StarlarkError.prototype.clearExecutionError = function() {
+  return this.setExecutionError(undefined);
 };
 
 
 /**
- * map<string, string> env_vars = 5;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.Container.prototype.getEnvVarsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
-      null));
+proto.api_container_api.StarlarkError.prototype.hasExecutionError = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.Container} returns this
- */
-proto.api_container_api.Container.prototype.clearEnvVarsMap = function() {
-  this.getEnvVarsMap().clear();
-  return this;};
-
-
 
 
 
@@ -1671,8 +6265,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ServiceInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ServiceInfo.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkInterpretationError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInterpretationError.toObject(opt_includeInstance, this);
 };
 
 
@@ -1681,21 +6275,13 @@ proto.api_container_api.ServiceInfo.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ServiceInfo} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkInterpretationError} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceInfo.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkInterpretationError.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    privateIpAddr: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    privatePortsMap: (f = msg.getPrivatePortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
-    maybePublicIpAddr: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    maybePublicPortsMap: (f = msg.getMaybePublicPortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
-    name: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    serviceStatus: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    container: (f = msg.getContainer()) && proto.api_container_api.Container.toObject(includeInstance, f)
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -1709,69 +6295,32 @@ proto.api_container_api.ServiceInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ServiceInfo}
+ * @return {!proto.api_container_api.StarlarkInterpretationError}
  */
-proto.api_container_api.ServiceInfo.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkInterpretationError.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ServiceInfo;
-  return proto.api_container_api.ServiceInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkInterpretationError;
+  return proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ServiceInfo} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkInterpretationError} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ServiceInfo}
+ * @return {!proto.api_container_api.StarlarkInterpretationError}
  */
-proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceUuid(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPrivateIpAddr(value);
-      break;
-    case 3:
-      var value = msg.getPrivatePortsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
-         });
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMaybePublicIpAddr(value);
-      break;
-    case 5:
-      var value = msg.getMaybePublicPortsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
-         });
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
-      break;
-    case 8:
-      var value = /** @type {!proto.api_container_api.ServiceStatus} */ (reader.readEnum());
-      msg.setServiceStatus(value);
-      break;
-    case 9:
-      var value = new proto.api_container_api.Container;
-      reader.readMessage(value,proto.api_container_api.Container.deserializeBinaryFromReader);
-      msg.setContainer(value);
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
       break;
     default:
       reader.skipField();
@@ -1786,9 +6335,9 @@ proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceInfo.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ServiceInfo.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1796,259 +6345,297 @@ proto.api_container_api.ServiceInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ServiceInfo} message
+ * @param {!proto.api_container_api.StarlarkInterpretationError} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceInfo.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceUuid();
+  f = message.getErrorMessage();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPrivateIpAddr();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getPrivatePortsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
-  }
-  f = message.getMaybePublicIpAddr();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getMaybePublicPortsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getServiceStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      8,
-      f
-    );
-  }
-  f = message.getContainer();
-  if (f != null) {
-    writer.writeMessage(
-      9,
-      f,
-      proto.api_container_api.Container.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional string service_uuid = 1;
+ * optional string error_message = 1;
  * @return {string}
  */
-proto.api_container_api.ServiceInfo.prototype.getServiceUuid = function() {
+proto.api_container_api.StarlarkInterpretationError.prototype.getErrorMessage = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * @return {!proto.api_container_api.StarlarkInterpretationError} returns this
  */
-proto.api_container_api.ServiceInfo.prototype.setServiceUuid = function(value) {
+proto.api_container_api.StarlarkInterpretationError.prototype.setErrorMessage = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string private_ip_addr = 2;
- * @return {string}
- */
-proto.api_container_api.ServiceInfo.prototype.getPrivateIpAddr = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
 
-/**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
- */
-proto.api_container_api.ServiceInfo.prototype.setPrivateIpAddr = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * map<string, Port> private_ports = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.ServiceInfo.prototype.getPrivatePortsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      proto.api_container_api.Port));
+proto.api_container_api.StarlarkValidationError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkValidationError.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkValidationError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceInfo.prototype.clearPrivatePortsMap = function() {
-  this.getPrivatePortsMap().clear();
-  return this;};
+proto.api_container_api.StarlarkValidationError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
 
 
 /**
- * optional string maybe_public_ip_addr = 4;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkValidationError}
  */
-proto.api_container_api.ServiceInfo.prototype.getMaybePublicIpAddr = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.StarlarkValidationError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkValidationError;
+  return proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkValidationError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkValidationError}
  */
-proto.api_container_api.ServiceInfo.prototype.setMaybePublicIpAddr = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * map<string, Port> maybe_public_ports = 5;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceInfo.prototype.getMaybePublicPortsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
-      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
-      proto.api_container_api.Port));
+proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkValidationError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceInfo.prototype.clearMaybePublicPortsMap = function() {
-  this.getMaybePublicPortsMap().clear();
-  return this;};
+proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
 
 
 /**
- * optional string name = 6;
+ * optional string error_message = 1;
  * @return {string}
  */
-proto.api_container_api.ServiceInfo.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.api_container_api.StarlarkValidationError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * @return {!proto.api_container_api.StarlarkValidationError} returns this
  */
-proto.api_container_api.ServiceInfo.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.api_container_api.StarlarkValidationError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional string shortened_uuid = 7;
- * @return {string}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.ServiceInfo.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkExecutionError.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkExecutionError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceInfo.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional ServiceStatus service_status = 8;
- * @return {!proto.api_container_api.ServiceStatus}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkExecutionError}
  */
-proto.api_container_api.ServiceInfo.prototype.getServiceStatus = function() {
-  return /** @type {!proto.api_container_api.ServiceStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.api_container_api.StarlarkExecutionError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkExecutionError;
+  return proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!proto.api_container_api.ServiceStatus} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkExecutionError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkExecutionError}
  */
-proto.api_container_api.ServiceInfo.prototype.setServiceStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 8, value);
+proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional Container container = 9;
- * @return {?proto.api_container_api.Container}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceInfo.prototype.getContainer = function() {
-  return /** @type{?proto.api_container_api.Container} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.Container, 9));
+proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.api_container_api.Container|undefined} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
-*/
-proto.api_container_api.ServiceInfo.prototype.setContainer = function(value) {
-  return jspb.Message.setWrapperField(this, 9, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkExecutionError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * optional string error_message = 1;
+ * @return {string}
  */
-proto.api_container_api.ServiceInfo.prototype.clearContainer = function() {
-  return this.setContainer(undefined);
+proto.api_container_api.StarlarkExecutionError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkExecutionError} returns this
  */
-proto.api_container_api.ServiceInfo.prototype.hasContainer = function() {
-  return jspb.Message.getField(this, 9) != null;
+proto.api_container_api.StarlarkExecutionError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -2058,7 +6645,7 @@ proto.api_container_api.ServiceInfo.prototype.hasContainer = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.api_container_api.RunStarlarkScriptArgs.repeatedFields_ = [6];
+proto.api_container_api.StarlarkRunProgress.repeatedFields_ = [1];
 
 
 
@@ -2075,8 +6662,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RunStarlarkScriptArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunProgress.toObject(opt_includeInstance, this);
 };
 
 
@@ -2085,22 +6672,15 @@ proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkRunProgress} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
-    cloudInstanceId: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    cloudUserId: jspb.Message.getFieldWithDefault(msg, 8, ""),
-    imageDownloadMode: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    nonBlockingMode: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
+    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -2114,23 +6694,23 @@ proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ * @return {!proto.api_container_api.StarlarkRunProgress}
  */
-proto.api_container_api.RunStarlarkScriptArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkRunProgress.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RunStarlarkScriptArgs;
-  return proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkRunProgress;
+  return proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkRunProgress} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ * @return {!proto.api_container_api.StarlarkRunProgress}
  */
-proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2139,45 +6719,15 @@ proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = func
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedScript(value);
+      msg.addCurrentStepInfo(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTotalSteps(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDryRun(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallelism(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMainFunctionName(value);
-      break;
-    case 6:
-      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addExperimentalFeatures(values[i]);
-      }
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCloudInstanceId(value);
-      break;
-    case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCloudUserId(value);
-      break;
-    case 9:
-      var value = /** @type {!proto.api_container_api.ImageDownloadMode} */ (reader.readEnum());
-      msg.setImageDownloadMode(value);
-      break;
-    case 10:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setNonBlockingMode(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCurrentStepNumber(value);
       break;
     default:
       reader.skipField();
@@ -2192,9 +6742,9 @@ proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2202,461 +6752,553 @@ proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} message
+ * @param {!proto.api_container_api.StarlarkRunProgress} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedScript();
+  f = message.getCurrentStepInfoList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeString(
+  f = message.getTotalSteps();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
       f
     );
   }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeBool(
+  f = message.getCurrentStepNumber();
+  if (f !== 0) {
+    writer.writeUint32(
       3,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeInt32(
-      4,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getExperimentalFeaturesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      6,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 7));
-  if (f != null) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 8));
-  if (f != null) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
-  f = /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getField(message, 9));
-  if (f != null) {
-    writer.writeEnum(
-      9,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 10));
-  if (f != null) {
-    writer.writeBool(
-      10,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string serialized_script = 1;
- * @return {string}
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedScript = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedScript = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string serialized_params = 2;
- * @return {string}
+ * repeated string current_step_info = 1;
+ * @return {!Array<string>}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepInfoList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepInfoList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearSerializedParams = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.api_container_api.StarlarkRunProgress.prototype.addCurrentStepInfo = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasSerializedParams = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.StarlarkRunProgress.prototype.clearCurrentStepInfoList = function() {
+  return this.setCurrentStepInfoList([]);
 };
 
 
 /**
- * optional bool dry_run = 3;
- * @return {boolean}
+ * optional uint32 total_steps = 2;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getDryRun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.api_container_api.StarlarkRunProgress.prototype.getTotalSteps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setDryRun = function(value) {
-  return jspb.Message.setField(this, 3, value);
+proto.api_container_api.StarlarkRunProgress.prototype.setTotalSteps = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * optional uint32 current_step_number = 3;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearDryRun = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasDryRun = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
-/**
- * optional int32 parallelism = 4;
- * @return {number}
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getParallelism = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {number} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setParallelism = function(value) {
-  return jspb.Message.setField(this, 4, value);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunFinishedEvent.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearParallelism = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    isRunSuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    serializedOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasParallelism = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunFinishedEvent;
+  return proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional string main_function_name = 5;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getMainFunctionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsRunSuccessful(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedOutput(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setMainFunctionName = function(value) {
-  return jspb.Message.setField(this, 5, value);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearMainFunctionName = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIsRunSuccessful();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * Returns whether this field is set.
+ * optional bool is_run_successful = 1;
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasMainFunctionName = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getIsRunSuccessful = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * repeated KurtosisFeatureFlag experimental_features = 6;
- * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getExperimentalFeaturesList = function() {
-  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 6));
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setIsRunSuccessful = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * optional string serialized_output = 2;
+ * @return {string}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setExperimentalFeaturesList = function(value) {
-  return jspb.Message.setField(this, 6, value || []);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getSerializedOutput = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!proto.api_container_api.KurtosisFeatureFlag} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.addExperimentalFeatures = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setSerializedOutput = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearExperimentalFeaturesList = function() {
-  return this.setExperimentalFeaturesList([]);
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
 /**
- * optional string cloud_instance_id = 7;
- * @return {string}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getCloudInstanceId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasSerializedOutput = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
-/**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setCloudInstanceId = function(value) {
-  return jspb.Message.setField(this, 7, value);
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearCloudInstanceId = function() {
-  return jspb.Message.setField(this, 7, undefined);
+proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetServicesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasCloudInstanceId = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string cloud_user_id = 8;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetServicesArgs}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getCloudUserId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.api_container_api.GetServicesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetServicesArgs;
+  return proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetServicesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetServicesArgs}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setCloudUserId = function(value) {
-  return jspb.Message.setField(this, 8, value);
+proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceIdentifiersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearCloudUserId = function() {
-  return jspb.Message.setField(this, 8, undefined);
+proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetServicesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetServicesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasCloudUserId = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.api_container_api.GetServicesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifiersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
 };
 
 
 /**
- * optional ImageDownloadMode image_download_mode = 9;
- * @return {!proto.api_container_api.ImageDownloadMode}
+ * map<string, bool> service_identifiers = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getImageDownloadMode = function() {
-  return /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.api_container_api.GetServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {!proto.api_container_api.ImageDownloadMode} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setImageDownloadMode = function(value) {
-  return jspb.Message.setField(this, 9, value);
-};
+proto.api_container_api.GetServicesArgs.prototype.clearServiceIdentifiersMap = function() {
+  this.getServiceIdentifiersMap().clear();
+  return this;};
 
 
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearImageDownloadMode = function() {
-  return jspb.Message.setField(this, 9, undefined);
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasImageDownloadMode = function() {
-  return jspb.Message.getField(this, 9) != null;
+proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional bool non_blocking_mode = 10;
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetServicesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getNonBlockingMode = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
+proto.api_container_api.GetServicesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceInfoMap: (f = msg.getServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetServicesResponse}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setNonBlockingMode = function(value) {
-  return jspb.Message.setField(this, 10, value);
+proto.api_container_api.GetServicesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetServicesResponse;
+  return proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetServicesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetServicesResponse}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearNonBlockingMode = function() {
-  return jspb.Message.setField(this, 10, undefined);
+proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasNonBlockingMode = function() {
-  return jspb.Message.getField(this, 10) != null;
+proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetServicesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetServicesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.repeatedFields_ = [11];
+proto.api_container_api.GetServicesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
+  }
+};
 
-/**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
- */
-proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_ = [[3,4]];
 
 /**
- * @enum {number}
+ * map<string, ServiceInfo> service_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
  */
-proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase = {
-  STARLARK_PACKAGE_CONTENT_NOT_SET: 0,
-  LOCAL: 3,
-  REMOTE: 4
+proto.api_container_api.GetServicesResponse.prototype.getServiceInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceInfo));
 };
 
+
 /**
- * @return {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesResponse} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getStarlarkPackageContentCase = function() {
-  return /** @type {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0]));
-};
+proto.api_container_api.GetServicesResponse.prototype.clearServiceInfoMap = function() {
+  this.getServiceInfoMap().clear();
+  return this;};
+
+
 
 
 
@@ -2673,8 +7315,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RunStarlarkPackageArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.GetServiceStatsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServiceStatsArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2683,27 +7325,13 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetServiceStatsArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetServiceStatsArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    local: msg.getLocal_asB64(),
-    remote: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    clonePackage: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
-    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 10, ""),
-    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f,
-    cloudInstanceId: jspb.Message.getFieldWithDefault(msg, 12, ""),
-    cloudUserId: jspb.Message.getFieldWithDefault(msg, 13, ""),
-    imageDownloadMode: jspb.Message.getFieldWithDefault(msg, 14, 0),
-    nonBlockingMode: jspb.Message.getBooleanFieldWithDefault(msg, 15, false),
-    githubAuthToken: jspb.Message.getFieldWithDefault(msg, 16, "")
+    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -2717,23 +7345,23 @@ proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ * @return {!proto.api_container_api.GetServiceStatsArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.GetServiceStatsArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RunStarlarkPackageArgs;
-  return proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetServiceStatsArgs;
+  return proto.api_container_api.GetServiceStatsArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetServiceStatsArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ * @return {!proto.api_container_api.GetServiceStatsArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetServiceStatsArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2741,66 +7369,10 @@ proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPackageId(value);
-      break;
-    case 3:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setLocal(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setRemote(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
-      break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDryRun(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallelism(value);
-      break;
-    case 8:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setClonePackage(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRelativePathToMainFile(value);
-      break;
-    case 10:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMainFunctionName(value);
-      break;
-    case 11:
-      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addExperimentalFeatures(values[i]);
-      }
-      break;
-    case 12:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCloudInstanceId(value);
-      break;
-    case 13:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setCloudUserId(value);
-      break;
-    case 14:
-      var value = /** @type {!proto.api_container_api.ImageDownloadMode} */ (reader.readEnum());
-      msg.setImageDownloadMode(value);
-      break;
-    case 15:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setNonBlockingMode(value);
-      break;
-    case 16:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setGithubAuthToken(value);
+      var value = msg.getServiceIdentifiersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
       break;
     default:
       reader.skipField();
@@ -2815,9 +7387,9 @@ proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = function() {
+proto.api_container_api.GetServiceStatsArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetServiceStatsArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2825,402 +7397,504 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} message
+ * @param {!proto.api_container_api.GetServiceStatsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetServiceStatsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPackageId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeBytes(
-      3,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeBool(
-      6,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 7));
-  if (f != null) {
-    writer.writeInt32(
-      7,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 8));
-  if (f != null) {
-    writer.writeBool(
-      8,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 9));
-  if (f != null) {
-    writer.writeString(
-      9,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 10));
-  if (f != null) {
-    writer.writeString(
-      10,
-      f
-    );
-  }
-  f = message.getExperimentalFeaturesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      11,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 12));
-  if (f != null) {
-    writer.writeString(
-      12,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 13));
-  if (f != null) {
-    writer.writeString(
-      13,
-      f
-    );
-  }
-  f = /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getField(message, 14));
-  if (f != null) {
-    writer.writeEnum(
-      14,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 15));
-  if (f != null) {
-    writer.writeBool(
-      15,
-      f
-    );
+  f = message.getServiceIdentifiersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 16));
-  if (f != null) {
-    writer.writeString(
-      16,
-      f
-    );
+};
+
+
+/**
+ * map<string, bool> service_identifiers = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.api_container_api.GetServiceStatsArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServiceStatsArgs} returns this
+ */
+proto.api_container_api.GetServiceStatsArgs.prototype.clearServiceIdentifiersMap = function() {
+  this.getServiceIdentifiersMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ServiceIoStats.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceIoStats.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceIoStats} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceIoStats.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    networkReceivedBytes: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    networkSentBytes: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    diskReadBytes: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    diskWrittenBytes: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
   }
+  return obj;
 };
+}
 
 
 /**
- * optional string package_id = 1;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceIoStats}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getPackageId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.ServiceIoStats.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceIoStats;
+  return proto.api_container_api.ServiceIoStats.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceIoStats} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceIoStats}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setPackageId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.ServiceIoStats.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNetworkReceivedBytes(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNetworkSentBytes(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setDiskReadBytes(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setDiskWrittenBytes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional bytes local = 3;
- * @return {string}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.ServiceIoStats.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceIoStats.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional bytes local = 3;
- * This is a type-conversion wrapper around `getLocal()`
- * @return {string}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceIoStats} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getLocal()));
+proto.api_container_api.ServiceIoStats.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNetworkReceivedBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getNetworkSentBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getDiskReadBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getDiskWrittenBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional bytes local = 3;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getLocal()`
- * @return {!Uint8Array}
+ * optional uint64 network_received_bytes = 1;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getLocal()));
+proto.api_container_api.ServiceIoStats.prototype.getNetworkReceivedBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceIoStats} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setLocal = function(value) {
-  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+proto.api_container_api.ServiceIoStats.prototype.setNetworkReceivedBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * optional uint64 network_sent_bytes = 2;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocal = function() {
-  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+proto.api_container_api.ServiceIoStats.prototype.getNetworkSentBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceIoStats} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasLocal = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.ServiceIoStats.prototype.setNetworkSentBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional bool remote = 4;
- * @return {boolean}
+ * optional uint64 disk_read_bytes = 3;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getRemote = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.api_container_api.ServiceIoStats.prototype.getDiskReadBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceIoStats} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setRemote = function(value) {
-  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+proto.api_container_api.ServiceIoStats.prototype.setDiskReadBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * optional uint64 disk_written_bytes = 4;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRemote = function() {
-  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+proto.api_container_api.ServiceIoStats.prototype.getDiskWrittenBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceIoStats} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRemote = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.ServiceIoStats.prototype.setDiskWrittenBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
-/**
- * optional string serialized_params = 5;
- * @return {string}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
-};
-
 
-/**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setField(this, 5, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearSerializedParams = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.api_container_api.ServiceStats.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceStats.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceStats} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasSerializedParams = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.api_container_api.ServiceStats.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    cpuUsageMillicores: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    memoryUsageBytes: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    memoryLimitBytes: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    ioStats: (f = msg.getIoStats()) && proto.api_container_api.ServiceIoStats.toObject(includeInstance, f),
+    sampleTime: (f = msg.getSampleTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional bool dry_run = 6;
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceStats}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getDryRun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+proto.api_container_api.ServiceStats.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceStats;
+  return proto.api_container_api.ServiceStats.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceStats} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceStats}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setDryRun = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.api_container_api.ServiceStats.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCpuUsageMillicores(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemoryUsageBytes(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemoryLimitBytes(value);
+      break;
+    case 4:
+      var value = new proto.api_container_api.ServiceIoStats;
+      reader.readMessage(value,proto.api_container_api.ServiceIoStats.deserializeBinaryFromReader);
+      msg.setIoStats(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setSampleTime(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearDryRun = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.api_container_api.ServiceStats.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceStats.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceStats} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasDryRun = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.api_container_api.ServiceStats.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCpuUsageMillicores();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getMemoryUsageBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getMemoryLimitBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getIoStats();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.api_container_api.ServiceIoStats.serializeBinaryToWriter
+    );
+  }
+  f = message.getSampleTime();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional int32 parallelism = 7;
+ * optional uint64 cpu_usage_millicores = 1;
  * @return {number}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getParallelism = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.api_container_api.ServiceStats.prototype.getCpuUsageMillicores = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setParallelism = function(value) {
-  return jspb.Message.setField(this, 7, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearParallelism = function() {
-  return jspb.Message.setField(this, 7, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.api_container_api.ServiceStats} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasParallelism = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.api_container_api.ServiceStats.prototype.setCpuUsageMillicores = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional bool clone_package = 8;
- * @return {boolean}
+ * optional uint64 memory_usage_bytes = 2;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getClonePackage = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+proto.api_container_api.ServiceStats.prototype.getMemoryUsageBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceStats} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setClonePackage = function(value) {
-  return jspb.Message.setField(this, 8, value);
+proto.api_container_api.ServiceStats.prototype.setMemoryUsageBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * optional uint64 memory_limit_bytes = 3;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearClonePackage = function() {
-  return jspb.Message.setField(this, 8, undefined);
+proto.api_container_api.ServiceStats.prototype.getMemoryLimitBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceStats} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasClonePackage = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.api_container_api.ServiceStats.prototype.setMemoryLimitBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional string relative_path_to_main_file = 9;
- * @return {string}
+ * optional ServiceIoStats io_stats = 4;
+ * @return {?proto.api_container_api.ServiceIoStats}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getRelativePathToMainFile = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.api_container_api.ServiceStats.prototype.getIoStats = function() {
+  return /** @type{?proto.api_container_api.ServiceIoStats} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.ServiceIoStats, 4));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setRelativePathToMainFile = function(value) {
-  return jspb.Message.setField(this, 9, value);
+ * @param {?proto.api_container_api.ServiceIoStats|undefined} value
+ * @return {!proto.api_container_api.ServiceStats} returns this
+*/
+proto.api_container_api.ServiceStats.prototype.setIoStats = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceStats} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRelativePathToMainFile = function() {
-  return jspb.Message.setField(this, 9, undefined);
+proto.api_container_api.ServiceStats.prototype.clearIoStats = function() {
+  return this.setIoStats(undefined);
 };
 
 
@@ -3228,35 +7902,36 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRelativePathToMain
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRelativePathToMainFile = function() {
-  return jspb.Message.getField(this, 9) != null;
+proto.api_container_api.ServiceStats.prototype.hasIoStats = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional string main_function_name = 10;
- * @return {string}
+ * optional google.protobuf.Timestamp sample_time = 5;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getMainFunctionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+proto.api_container_api.ServiceStats.prototype.getSampleTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setMainFunctionName = function(value) {
-  return jspb.Message.setField(this, 10, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.ServiceStats} returns this
+*/
+proto.api_container_api.ServiceStats.prototype.setSampleTime = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceStats} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearMainFunctionName = function() {
-  return jspb.Message.setField(this, 10, undefined);
+proto.api_container_api.ServiceStats.prototype.clearSampleTime = function() {
+  return this.setSampleTime(undefined);
 };
 
 
@@ -3264,259 +7939,370 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.clearMainFunctionName =
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasMainFunctionName = function() {
-  return jspb.Message.getField(this, 10) != null;
+proto.api_container_api.ServiceStats.prototype.hasSampleTime = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
-/**
- * repeated KurtosisFeatureFlag experimental_features = 11;
- * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getExperimentalFeaturesList = function() {
-  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 11));
-};
-
 
-/**
- * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setExperimentalFeaturesList = function(value) {
-  return jspb.Message.setField(this, 11, value || []);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {!proto.api_container_api.KurtosisFeatureFlag} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.addExperimentalFeatures = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 11, value, opt_index);
+proto.api_container_api.ServicePeakUsage.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServicePeakUsage.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServicePeakUsage} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearExperimentalFeaturesList = function() {
-  return this.setExperimentalFeaturesList([]);
+proto.api_container_api.ServicePeakUsage.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    peakCpuUsageMillicores: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    peakMemoryUsageBytes: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string cloud_instance_id = 12;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServicePeakUsage}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getCloudInstanceId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+proto.api_container_api.ServicePeakUsage.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServicePeakUsage;
+  return proto.api_container_api.ServicePeakUsage.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServicePeakUsage} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServicePeakUsage}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setCloudInstanceId = function(value) {
-  return jspb.Message.setField(this, 12, value);
+proto.api_container_api.ServicePeakUsage.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setPeakCpuUsageMillicores(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setPeakMemoryUsageBytes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearCloudInstanceId = function() {
-  return jspb.Message.setField(this, 12, undefined);
+proto.api_container_api.ServicePeakUsage.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServicePeakUsage.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServicePeakUsage} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasCloudInstanceId = function() {
-  return jspb.Message.getField(this, 12) != null;
+proto.api_container_api.ServicePeakUsage.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPeakCpuUsageMillicores();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getPeakMemoryUsageBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string cloud_user_id = 13;
- * @return {string}
+ * optional uint64 peak_cpu_usage_millicores = 1;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getCloudUserId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+proto.api_container_api.ServicePeakUsage.prototype.getPeakCpuUsageMillicores = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.ServicePeakUsage} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setCloudUserId = function(value) {
-  return jspb.Message.setField(this, 13, value);
+proto.api_container_api.ServicePeakUsage.prototype.setPeakCpuUsageMillicores = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * optional uint64 peak_memory_usage_bytes = 2;
+ * @return {number}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearCloudUserId = function() {
-  return jspb.Message.setField(this, 13, undefined);
+proto.api_container_api.ServicePeakUsage.prototype.getPeakMemoryUsageBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.api_container_api.ServicePeakUsage} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasCloudUserId = function() {
-  return jspb.Message.getField(this, 13) != null;
+proto.api_container_api.ServicePeakUsage.prototype.setPeakMemoryUsageBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
-/**
- * optional ImageDownloadMode image_download_mode = 14;
- * @return {!proto.api_container_api.ImageDownloadMode}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getImageDownloadMode = function() {
-  return /** @type {!proto.api_container_api.ImageDownloadMode} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {!proto.api_container_api.ImageDownloadMode} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setImageDownloadMode = function(value) {
-  return jspb.Message.setField(this, 14, value);
+proto.api_container_api.GetServiceStatsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServiceStatsResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetServiceStatsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearImageDownloadMode = function() {
-  return jspb.Message.setField(this, 14, undefined);
+proto.api_container_api.GetServiceStatsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceStatsMap: (f = msg.getServiceStatsMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceStats.toObject) : [],
+    serviceErrorsMap: (f = msg.getServiceErrorsMap()) ? f.toObject(includeInstance, undefined) : [],
+    lastRunPeakUsageMap: (f = msg.getLastRunPeakUsageMap()) ? f.toObject(includeInstance, proto.api_container_api.ServicePeakUsage.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetServiceStatsResponse}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasImageDownloadMode = function() {
-  return jspb.Message.getField(this, 14) != null;
+proto.api_container_api.GetServiceStatsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetServiceStatsResponse;
+  return proto.api_container_api.GetServiceStatsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional bool non_blocking_mode = 15;
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetServiceStatsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetServiceStatsResponse}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getNonBlockingMode = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 15, false));
+proto.api_container_api.GetServiceStatsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceStatsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceStats.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceStats());
+         });
+      break;
+    case 2:
+      var value = msg.getServiceErrorsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 3:
+      var value = msg.getLastRunPeakUsageMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServicePeakUsage.deserializeBinaryFromReader, "", new proto.api_container_api.ServicePeakUsage());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setNonBlockingMode = function(value) {
-  return jspb.Message.setField(this, 15, value);
+proto.api_container_api.GetServiceStatsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetServiceStatsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetServiceStatsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearNonBlockingMode = function() {
-  return jspb.Message.setField(this, 15, undefined);
+proto.api_container_api.GetServiceStatsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceStatsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceStats.serializeBinaryToWriter);
+  }
+  f = message.getServiceErrorsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getLastRunPeakUsageMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServicePeakUsage.serializeBinaryToWriter);
+  }
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * map<string, ServiceStats> service_stats = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceStats>}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasNonBlockingMode = function() {
-  return jspb.Message.getField(this, 15) != null;
+proto.api_container_api.GetServiceStatsResponse.prototype.getServiceStatsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceStats>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceStats));
 };
 
 
 /**
- * optional string github_auth_token = 16;
- * @return {string}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServiceStatsResponse} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getGithubAuthToken = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
-};
+proto.api_container_api.GetServiceStatsResponse.prototype.clearServiceStatsMap = function() {
+  this.getServiceStatsMap().clear();
+  return this;};
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * map<string, string> service_errors = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setGithubAuthToken = function(value) {
-  return jspb.Message.setField(this, 16, value);
+proto.api_container_api.GetServiceStatsResponse.prototype.getServiceErrorsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServiceStatsResponse} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearGithubAuthToken = function() {
-  return jspb.Message.setField(this, 16, undefined);
-};
+proto.api_container_api.GetServiceStatsResponse.prototype.clearServiceErrorsMap = function() {
+  this.getServiceErrorsMap().clear();
+  return this;};
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * map<string, ServicePeakUsage> last_run_peak_usage = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServicePeakUsage>}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasGithubAuthToken = function() {
-  return jspb.Message.getField(this, 16) != null;
+proto.api_container_api.GetServiceStatsResponse.prototype.getLastRunPeakUsageMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServicePeakUsage>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      proto.api_container_api.ServicePeakUsage));
 };
 
 
-
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServiceStatsResponse} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.oneofGroups_ = [[1,2,3,4,5,6,7]];
+proto.api_container_api.GetServiceStatsResponse.prototype.clearLastRunPeakUsageMap = function() {
+  this.getLastRunPeakUsageMap().clear();
+  return this;};
 
-/**
- * @enum {number}
- */
-proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase = {
-  RUN_RESPONSE_LINE_NOT_SET: 0,
-  INSTRUCTION: 1,
-  ERROR: 2,
-  PROGRESS_INFO: 3,
-  INSTRUCTION_RESULT: 4,
-  RUN_FINISHED_EVENT: 5,
-  WARNING: 6,
-  INFO: 7
-};
 
-/**
- * @return {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase}
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getRunResponseLineCase = function() {
-  return /** @type {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0]));
-};
 
 
 
@@ -3533,8 +8319,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunResponseLine.toObject(opt_includeInstance, this);
+proto.api_container_api.ServiceHealthEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceHealthEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -3543,19 +8329,14 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ServiceHealthEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInstance, msg) {
+proto.api_container_api.ServiceHealthEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
-    error: (f = msg.getError()) && proto.api_container_api.StarlarkError.toObject(includeInstance, f),
-    progressInfo: (f = msg.getProgressInfo()) && proto.api_container_api.StarlarkRunProgress.toObject(includeInstance, f),
-    instructionResult: (f = msg.getInstructionResult()) && proto.api_container_api.StarlarkInstructionResult.toObject(includeInstance, f),
-    runFinishedEvent: (f = msg.getRunFinishedEvent()) && proto.api_container_api.StarlarkRunFinishedEvent.toObject(includeInstance, f),
-    warning: (f = msg.getWarning()) && proto.api_container_api.StarlarkWarning.toObject(includeInstance, f),
-    info: (f = msg.getInfo()) && proto.api_container_api.StarlarkInfo.toObject(includeInstance, f)
+    serviceName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    health: (f = msg.getHealth()) && proto.api_container_api.ServiceHealth.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3569,23 +8350,23 @@ proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ * @return {!proto.api_container_api.ServiceHealthEvent}
  */
-proto.api_container_api.StarlarkRunResponseLine.deserializeBinary = function(bytes) {
+proto.api_container_api.ServiceHealthEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunResponseLine;
-  return proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ServiceHealthEvent;
+  return proto.api_container_api.ServiceHealthEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ServiceHealthEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ * @return {!proto.api_container_api.ServiceHealthEvent}
  */
-proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ServiceHealthEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3593,39 +8374,13 @@ proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInstruction;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
-      msg.setInstruction(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceName(value);
       break;
     case 2:
-      var value = new proto.api_container_api.StarlarkError;
-      reader.readMessage(value,proto.api_container_api.StarlarkError.deserializeBinaryFromReader);
-      msg.setError(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.StarlarkRunProgress;
-      reader.readMessage(value,proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader);
-      msg.setProgressInfo(value);
-      break;
-    case 4:
-      var value = new proto.api_container_api.StarlarkInstructionResult;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader);
-      msg.setInstructionResult(value);
-      break;
-    case 5:
-      var value = new proto.api_container_api.StarlarkRunFinishedEvent;
-      reader.readMessage(value,proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader);
-      msg.setRunFinishedEvent(value);
-      break;
-    case 6:
-      var value = new proto.api_container_api.StarlarkWarning;
-      reader.readMessage(value,proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader);
-      msg.setWarning(value);
-      break;
-    case 7:
-      var value = new proto.api_container_api.StarlarkInfo;
-      reader.readMessage(value,proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader);
-      msg.setInfo(value);
+      var value = new proto.api_container_api.ServiceHealth;
+      reader.readMessage(value,proto.api_container_api.ServiceHealth.deserializeBinaryFromReader);
+      msg.setHealth(value);
       break;
     default:
       reader.skipField();
@@ -3640,9 +8395,9 @@ proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = function() {
+proto.api_container_api.ServiceHealthEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ServiceHealthEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3650,96 +8405,73 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunResponseLine} message
+ * @param {!proto.api_container_api.ServiceHealthEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ServiceHealthEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInstruction();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getServiceName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getError();
+  f = message.getHealth();
   if (f != null) {
     writer.writeMessage(
       2,
       f,
-      proto.api_container_api.StarlarkError.serializeBinaryToWriter
-    );
-  }
-  f = message.getProgressInfo();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter
-    );
-  }
-  f = message.getInstructionResult();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter
-    );
-  }
-  f = message.getRunFinishedEvent();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter
-    );
-  }
-  f = message.getWarning();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      proto.api_container_api.StarlarkWarning.serializeBinaryToWriter
-    );
-  }
-  f = message.getInfo();
-  if (f != null) {
-    writer.writeMessage(
-      7,
-      f,
-      proto.api_container_api.StarlarkInfo.serializeBinaryToWriter
+      proto.api_container_api.ServiceHealth.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional StarlarkInstruction instruction = 1;
- * @return {?proto.api_container_api.StarlarkInstruction}
+ * optional string service_name = 1;
+ * @return {string}
+ */
+proto.api_container_api.ServiceHealthEvent.prototype.getServiceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceHealthEvent} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInstruction = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
+proto.api_container_api.ServiceHealthEvent.prototype.setServiceName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * optional ServiceHealth health = 2;
+ * @return {?proto.api_container_api.ServiceHealth}
+ */
+proto.api_container_api.ServiceHealthEvent.prototype.getHealth = function() {
+  return /** @type{?proto.api_container_api.ServiceHealth} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.ServiceHealth, 2));
+};
+
+
+/**
+ * @param {?proto.api_container_api.ServiceHealth|undefined} value
+ * @return {!proto.api_container_api.ServiceHealthEvent} returns this
 */
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInstruction = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+proto.api_container_api.ServiceHealthEvent.prototype.setHealth = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @return {!proto.api_container_api.ServiceHealthEvent} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = function() {
-  return this.setInstruction(undefined);
+proto.api_container_api.ServiceHealthEvent.prototype.clearHealth = function() {
+  return this.setHealth(undefined);
 };
 
 
@@ -3747,234 +8479,369 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = fun
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstruction = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.ServiceHealthEvent.prototype.hasHealth = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional StarlarkError error = 2;
- * @return {?proto.api_container_api.StarlarkError}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getError = function() {
-  return /** @type{?proto.api_container_api.StarlarkError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkError, 2));
+proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceIdentifiers.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkError|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceIdentifiers} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceIdentifiers}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function() {
-  return this.setError(undefined);
+proto.api_container_api.ServiceIdentifiers.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceIdentifiers;
+  return proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceIdentifiers} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceIdentifiers}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasError = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional StarlarkRunProgress progress_info = 3;
- * @return {?proto.api_container_api.StarlarkRunProgress}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getProgressInfo = function() {
-  return /** @type{?proto.api_container_api.StarlarkRunProgress} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunProgress, 3));
+proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkRunProgress|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setProgressInfo = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceIdentifiers} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * optional string service_uuid = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = function() {
-  return this.setProgressInfo(undefined);
+proto.api_container_api.ServiceIdentifiers.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasProgressInfo = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.ServiceIdentifiers.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional StarlarkInstructionResult instruction_result = 4;
- * @return {?proto.api_container_api.StarlarkInstructionResult}
+ * optional string name = 2;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionResult = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstructionResult} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionResult, 4));
+proto.api_container_api.ServiceIdentifiers.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInstructionResult|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionResult = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 4, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * optional string shortened_uuid = 3;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult = function() {
-  return this.setInstructionResult(undefined);
+proto.api_container_api.ServiceIdentifiers.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionResult = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
+
 /**
- * optional StarlarkRunFinishedEvent run_finished_event = 5;
- * @return {?proto.api_container_api.StarlarkRunFinishedEvent}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getRunFinishedEvent = function() {
-  return /** @type{?proto.api_container_api.StarlarkRunFinishedEvent} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunFinishedEvent, 5));
-};
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.repeatedFields_ = [1];
+
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {?proto.api_container_api.StarlarkRunFinishedEvent|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setRunFinishedEvent = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 5, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject(opt_includeInstance, this);
 };
 
 
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunFinishedEvent = function() {
-  return this.setRunFinishedEvent(undefined);
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
+    proto.api_container_api.ServiceIdentifiers.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunFinishedEvent = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse;
+  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional StarlarkWarning warning = 6;
- * @return {?proto.api_container_api.StarlarkWarning}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getWarning = function() {
-  return /** @type{?proto.api_container_api.StarlarkWarning} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkWarning, 6));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkWarning|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setWarning = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 6, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.ServiceIdentifiers;
+      reader.readMessage(value,proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader);
+      msg.addAllidentifiers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearWarning = function() {
-  return this.setWarning(undefined);
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasWarning = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAllidentifiersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional StarlarkInfo info = 7;
- * @return {?proto.api_container_api.StarlarkInfo}
+ * repeated ServiceIdentifiers allIdentifiers = 1;
+ * @return {!Array<!proto.api_container_api.ServiceIdentifiers>}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInfo = function() {
-  return /** @type{?proto.api_container_api.StarlarkInfo} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInfo, 7));
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.getAllidentifiersList = function() {
+  return /** @type{!Array<!proto.api_container_api.ServiceIdentifiers>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceIdentifiers, 1));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInfo|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @param {!Array<!proto.api_container_api.ServiceIdentifiers>} value
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
 */
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInfo = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 7, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @param {!proto.api_container_api.ServiceIdentifiers=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceIdentifiers}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInfo = function() {
-  return this.setInfo(undefined);
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceIdentifiers, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInfo = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.clearAllidentifiersList = function() {
+  return this.setAllidentifiersList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ExecCommandArgs.repeatedFields_ = [2];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3990,8 +8857,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInfo.toObject(opt_includeInstance, this);
+proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -4000,13 +8867,14 @@ proto.api_container_api.StarlarkInfo.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInfo} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ExecCommandArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInfo.toObject = function(includeInstance, msg) {
+proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    infoMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    commandArgsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -4020,23 +8888,23 @@ proto.api_container_api.StarlarkInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInfo}
+ * @return {!proto.api_container_api.ExecCommandArgs}
  */
-proto.api_container_api.StarlarkInfo.deserializeBinary = function(bytes) {
+proto.api_container_api.ExecCommandArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInfo;
-  return proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ExecCommandArgs;
+  return proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInfo} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ExecCommandArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInfo}
+ * @return {!proto.api_container_api.ExecCommandArgs}
  */
-proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4045,7 +8913,11 @@ proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setInfoMessage(value);
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCommandArgs(value);
       break;
     default:
       reader.skipField();
@@ -4060,9 +8932,9 @@ proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInfo.prototype.serializeBinary = function() {
+proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInfo.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4070,40 +8942,84 @@ proto.api_container_api.StarlarkInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInfo} message
+ * @param {!proto.api_container_api.ExecCommandArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInfo.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInfoMessage();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getCommandArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string info_message = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInfo.prototype.getInfoMessage = function() {
+proto.api_container_api.ExecCommandArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInfo} returns this
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
  */
-proto.api_container_api.StarlarkInfo.prototype.setInfoMessage = function(value) {
+proto.api_container_api.ExecCommandArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * repeated string command_args = 2;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.ExecCommandArgs.prototype.getCommandArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.setCommandArgsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.addCommandArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.clearCommandArgsList = function() {
+  return this.setCommandArgsList([]);
+};
+
+
 
 
 
@@ -4120,8 +9036,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkWarning.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkWarning.toObject(opt_includeInstance, this);
+proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4130,13 +9046,14 @@ proto.api_container_api.StarlarkWarning.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkWarning} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ExecCommandResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkWarning.toObject = function(includeInstance, msg) {
+proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    warningMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    logOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -4150,23 +9067,23 @@ proto.api_container_api.StarlarkWarning.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkWarning}
+ * @return {!proto.api_container_api.ExecCommandResponse}
  */
-proto.api_container_api.StarlarkWarning.deserializeBinary = function(bytes) {
+proto.api_container_api.ExecCommandResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkWarning;
-  return proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ExecCommandResponse;
+  return proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkWarning} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ExecCommandResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkWarning}
+ * @return {!proto.api_container_api.ExecCommandResponse}
  */
-proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4174,8 +9091,12 @@ proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setWarningMessage(value);
+      msg.setLogOutput(value);
       break;
     default:
       reader.skipField();
@@ -4190,9 +9111,9 @@ proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkWarning.prototype.serializeBinary = function() {
+proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkWarning.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4200,16 +9121,23 @@ proto.api_container_api.StarlarkWarning.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkWarning} message
+ * @param {!proto.api_container_api.ExecCommandResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkWarning.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getWarningMessage();
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getLogOutput();
   if (f.length > 0) {
     writer.writeString(
-      1,
+      2,
       f
     );
   }
@@ -4217,30 +9145,41 @@ proto.api_container_api.StarlarkWarning.serializeBinaryToWriter = function(messa
 
 
 /**
- * optional string warning_message = 1;
- * @return {string}
+ * optional int32 exit_code = 1;
+ * @return {number}
  */
-proto.api_container_api.StarlarkWarning.prototype.getWarningMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.ExecCommandResponse.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkWarning} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.ExecCommandResponse} returns this
  */
-proto.api_container_api.StarlarkWarning.prototype.setWarningMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.ExecCommandResponse.prototype.setExitCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
+/**
+ * optional string log_output = 2;
+ * @return {string}
+ */
+proto.api_container_api.ExecCommandResponse.prototype.getLogOutput = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {string} value
+ * @return {!proto.api_container_api.ExecCommandResponse} returns this
  */
-proto.api_container_api.StarlarkInstruction.repeatedFields_ = [3];
+proto.api_container_api.ExecCommandResponse.prototype.setLogOutput = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -4257,8 +9196,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstruction.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -4267,19 +9206,19 @@ proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstruction} msg The msg instance to transform.
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance, msg) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
-    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    argumentsList: jspb.Message.toObjectList(msg.getArgumentsList(),
-    proto.api_container_api.StarlarkInstructionArg.toObject, includeInstance),
-    executableInstruction: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    isSkipped: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    description: jspb.Message.getFieldWithDefault(msg, 6, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    retries: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    bodyText: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -4293,23 +9232,23 @@ proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstruction}
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
  */
-proto.api_container_api.StarlarkInstruction.deserializeBinary = function(bytes) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstruction;
-  return proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs;
+  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstruction} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstruction}
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
  */
-proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4317,30 +9256,32 @@ proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInstructionPosition;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
-      msg.setPosition(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setInstructionName(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
       break;
     case 3:
-      var value = new proto.api_container_api.StarlarkInstructionArg;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader);
-      msg.addArguments(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setExecutableInstruction(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInitialDelayMilliseconds(value);
       break;
     case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsSkipped(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
       break;
     case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetriesDelayMilliseconds(value);
+      break;
+    case 7:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDescription(value);
+      msg.setBodyText(value);
       break;
     default:
       reader.skipField();
@@ -4355,9 +9296,9 @@ proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function() {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4365,333 +9306,277 @@ proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstruction} message
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPosition();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getInstructionName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
       f
     );
   }
-  f = message.getArgumentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
       3,
-      f,
-      proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getExecutableInstruction();
-  if (f.length > 0) {
-    writer.writeString(
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeUint32(
       4,
       f
     );
   }
-  f = message.getIsSkipped();
-  if (f) {
-    writer.writeBool(
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeUint32(
       5,
       f
     );
   }
-  f = message.getDescription();
-  if (f.length > 0) {
-    writer.writeString(
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeUint32(
       6,
       f
     );
   }
+  f = /** @type {string} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
 };
 
 
 /**
- * optional StarlarkInstructionPosition position = 1;
- * @return {?proto.api_container_api.StarlarkInstructionPosition}
+ * optional string service_identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getPosition = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
-*/
-proto.api_container_api.StarlarkInstruction.prototype.setPosition = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * optional uint32 port = 2;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function() {
-  return this.setPosition(undefined);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.hasPosition = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string instruction_name = 2;
+ * optional string path = 3;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getInstructionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.setInstructionName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPath = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * repeated StarlarkInstructionArg arguments = 3;
- * @return {!Array<!proto.api_container_api.StarlarkInstructionArg>}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.getArgumentsList = function() {
-  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionArg>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionArg, 3));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearPath = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.StarlarkInstructionArg>} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
-*/
-proto.api_container_api.StarlarkInstruction.prototype.setArgumentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasPath = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * @param {!proto.api_container_api.StarlarkInstructionArg=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * optional uint32 initial_delay_milliseconds = 4;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInstruction.prototype.addArguments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstructionArg, opt_index);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.clearArgumentsList = function() {
-  return this.setArgumentsList([]);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * optional string executable_instruction = 4;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.getExecutableInstruction = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearInitialDelayMilliseconds = function() {
+  return jspb.Message.setField(this, 4, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstruction.prototype.setExecutableInstruction = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasInitialDelayMilliseconds = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional bool is_skipped = 5;
- * @return {boolean}
+ * optional uint32 retries = 5;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getIsSkipped = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.setIsSkipped = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetries = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * optional string description = 6;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.getDescription = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearRetries = function() {
+  return jspb.Message.setField(this, 5, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstruction.prototype.setDescription = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasRetries = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional uint32 retries_delay_milliseconds = 6;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionResult.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionResult} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionResult.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    serializedInstructionResult: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
+  return jspb.Message.setField(this, 6, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionResult}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionResult.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionResult;
-  return proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearRetriesDelayMilliseconds = function() {
+  return jspb.Message.setField(this, 6, undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionResult} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionResult}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedInstructionResult(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasRetriesDelayMilliseconds = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string body_text = 7;
+ * @return {string}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getBodyText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionResult} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getSerializedInstructionResult();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
+  return jspb.Message.setField(this, 7, value);
 };
 
 
 /**
- * optional string serialized_instruction_result = 1;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.getSerializedInstructionResult = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearBodyText = function() {
+  return jspb.Message.setField(this, 7, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.setSerializedInstructionResult = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasBodyText = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
@@ -4711,8 +9596,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionArg.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -4721,15 +9606,20 @@ proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionArg} msg The msg instance to transform.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstance, msg) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedArgValue: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    argName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    isRepresentative: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    requestBody: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retries: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    bodyText: jspb.Message.getFieldWithDefault(msg, 8, "")
   };
 
   if (includeInstance) {
@@ -4743,23 +9633,23 @@ proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
  */
-proto.api_container_api.StarlarkInstructionArg.deserializeBinary = function(bytes) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionArg;
-  return proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs;
+  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionArg} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
  */
-proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4768,15 +9658,35 @@ proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = fun
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedArgValue(value);
+      msg.setServiceIdentifier(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setArgName(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsRepresentative(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRequestBody(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInitialDelayMilliseconds(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetriesDelayMilliseconds(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBodyText(value);
       break;
     default:
       reader.skipField();
@@ -4791,9 +9701,9 @@ proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = function() {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4801,30 +9711,65 @@ proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionArg} message
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedArgValue();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 4));
   if (f != null) {
     writer.writeString(
-      2,
+      4,
       f
     );
   }
-  f = message.getIsRepresentative();
-  if (f) {
-    writer.writeBool(
-      3,
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeString(
+      8,
       f
     );
   }
@@ -4832,47 +9777,65 @@ proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = functio
 
 
 /**
- * optional string serialized_arg_value = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getSerializedArgValue = function() {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setSerializedArgValue = function(value) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string arg_name = 2;
+ * optional uint32 port = 2;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string path = 3;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getArgName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setArgName = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPath = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearPath = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
 
@@ -4880,249 +9843,194 @@ proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.hasArgName = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasPath = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional bool is_representative = 3;
- * @return {boolean}
+ * optional string request_body = 4;
+ * @return {string}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getIsRepresentative = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRequestBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setIsRepresentative = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRequestBody = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearRequestBody = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionPosition.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasRequestBody = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional uint32 initial_delay_milliseconds = 5;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
-  };
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionPosition;
-  return proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearInitialDelayMilliseconds = function() {
+  return jspb.Message.setField(this, 5, undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFilename(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLine(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setColumn(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasInitialDelayMilliseconds = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional uint32 retries = 6;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionPosition} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getFilename();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getLine();
-  if (f !== 0) {
-    writer.writeInt32(
-      2,
-      f
-    );
-  }
-  f = message.getColumn();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
-      f
-    );
-  }
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetries = function(value) {
+  return jspb.Message.setField(this, 6, value);
 };
 
 
 /**
- * optional string filename = 1;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getFilename = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearRetries = function() {
+  return jspb.Message.setField(this, 6, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setFilename = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasRetries = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 /**
- * optional int32 line = 2;
+ * optional uint32 retries_delay_milliseconds = 7;
  * @return {number}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getLine = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setLine = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
+  return jspb.Message.setField(this, 7, value);
 };
 
 
 /**
- * optional int32 column = 3;
- * @return {number}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getColumn = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearRetriesDelayMilliseconds = function() {
+  return jspb.Message.setField(this, 7, undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setColumn = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasRetriesDelayMilliseconds = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
+/**
+ * optional string body_text = 8;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getBodyText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
 
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkError.oneofGroups_ = [[1,2,3]];
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
+  return jspb.Message.setField(this, 8, value);
+};
+
 
 /**
- * @enum {number}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkError.ErrorCase = {
-  ERROR_NOT_SET: 0,
-  INTERPRETATION_ERROR: 1,
-  VALIDATION_ERROR: 2,
-  EXECUTION_ERROR: 3
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearBodyText = function() {
+  return jspb.Message.setField(this, 8, undefined);
 };
 
+
 /**
- * @return {proto.api_container_api.StarlarkError.ErrorCase}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkError.prototype.getErrorCase = function() {
-  return /** @type {proto.api_container_api.StarlarkError.ErrorCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkError.oneofGroups_[0]));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasBodyText = function() {
+  return jspb.Message.getField(this, 8) != null;
 };
 
 
 
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -5136,8 +10044,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkError.toObject(opt_includeInstance, this);
+proto.api_container_api.StreamedDataChunk.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StreamedDataChunk.toObject(opt_includeInstance, this);
 };
 
 
@@ -5146,15 +10054,15 @@ proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StreamedDataChunk} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg) {
+proto.api_container_api.StreamedDataChunk.toObject = function(includeInstance, msg) {
   var f, obj = {
-    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
-    validationError: (f = msg.getValidationError()) && proto.api_container_api.StarlarkValidationError.toObject(includeInstance, f),
-    executionError: (f = msg.getExecutionError()) && proto.api_container_api.StarlarkExecutionError.toObject(includeInstance, f)
+    data: msg.getData_asB64(),
+    previousChunkHash: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    metadata: (f = msg.getMetadata()) && proto.api_container_api.DataChunkMetadata.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -5168,23 +10076,23 @@ proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkError}
+ * @return {!proto.api_container_api.StreamedDataChunk}
  */
-proto.api_container_api.StarlarkError.deserializeBinary = function(bytes) {
+proto.api_container_api.StreamedDataChunk.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkError;
-  return proto.api_container_api.StarlarkError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StreamedDataChunk;
+  return proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StreamedDataChunk} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkError}
+ * @return {!proto.api_container_api.StreamedDataChunk}
  */
-proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5192,19 +10100,17 @@ proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInterpretationError;
-      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
-      msg.setInterpretationError(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
       break;
     case 2:
-      var value = new proto.api_container_api.StarlarkValidationError;
-      reader.readMessage(value,proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader);
-      msg.setValidationError(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPreviousChunkHash(value);
       break;
     case 3:
-      var value = new proto.api_container_api.StarlarkExecutionError;
-      reader.readMessage(value,proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader);
-      msg.setExecutionError(value);
+      var value = new proto.api_container_api.DataChunkMetadata;
+      reader.readMessage(value,proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
       break;
     default:
       reader.skipField();
@@ -5219,9 +10125,9 @@ proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
+proto.api_container_api.StreamedDataChunk.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5229,138 +10135,122 @@ proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkError} message
+ * @param {!proto.api_container_api.StreamedDataChunk} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInterpretationError();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
       1,
-      f,
-      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getValidationError();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getPreviousChunkHash();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getExecutionError();
+  f = message.getMetadata();
   if (f != null) {
     writer.writeMessage(
       3,
       f,
-      proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter
+      proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional StarlarkInterpretationError interpretation_error = 1;
- * @return {?proto.api_container_api.StarlarkInterpretationError}
+ * optional bytes data = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkError.prototype.getInterpretationError = function() {
-  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setInterpretationError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+proto.api_container_api.StreamedDataChunk.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.api_container_api.StarlarkError.prototype.clearInterpretationError = function() {
-  return this.setInterpretationError(undefined);
+proto.api_container_api.StreamedDataChunk.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkError.prototype.hasInterpretationError = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.StreamedDataChunk.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
 };
 
 
 /**
- * optional StarlarkValidationError validation_error = 2;
- * @return {?proto.api_container_api.StarlarkValidationError}
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
  */
-proto.api_container_api.StarlarkError.prototype.getValidationError = function() {
-  return /** @type{?proto.api_container_api.StarlarkValidationError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkValidationError, 2));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkValidationError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setValidationError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+proto.api_container_api.StreamedDataChunk.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * optional string previous_chunk_hash = 2;
+ * @return {string}
  */
-proto.api_container_api.StarlarkError.prototype.clearValidationError = function() {
-  return this.setValidationError(undefined);
+proto.api_container_api.StreamedDataChunk.prototype.getPreviousChunkHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
  */
-proto.api_container_api.StarlarkError.prototype.hasValidationError = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.StreamedDataChunk.prototype.setPreviousChunkHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional StarlarkExecutionError execution_error = 3;
- * @return {?proto.api_container_api.StarlarkExecutionError}
+ * optional DataChunkMetadata metadata = 3;
+ * @return {?proto.api_container_api.DataChunkMetadata}
  */
-proto.api_container_api.StarlarkError.prototype.getExecutionError = function() {
-  return /** @type{?proto.api_container_api.StarlarkExecutionError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkExecutionError, 3));
+proto.api_container_api.StreamedDataChunk.prototype.getMetadata = function() {
+  return /** @type{?proto.api_container_api.DataChunkMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.DataChunkMetadata, 3));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkExecutionError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * @param {?proto.api_container_api.DataChunkMetadata|undefined} value
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
 */
-proto.api_container_api.StarlarkError.prototype.setExecutionError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+proto.api_container_api.StreamedDataChunk.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
  */
-proto.api_container_api.StarlarkError.prototype.clearExecutionError = function() {
-  return this.setExecutionError(undefined);
+proto.api_container_api.StreamedDataChunk.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
 };
 
 
@@ -5368,7 +10258,7 @@ proto.api_container_api.StarlarkError.prototype.clearExecutionError = function()
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkError.prototype.hasExecutionError = function() {
+proto.api_container_api.StreamedDataChunk.prototype.hasMetadata = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
@@ -5389,8 +10279,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInterpretationError.toObject(opt_includeInstance, this);
+proto.api_container_api.DataChunkMetadata.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DataChunkMetadata.toObject(opt_includeInstance, this);
 };
 
 
@@ -5399,13 +10289,13 @@ proto.api_container_api.StarlarkInterpretationError.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInterpretationError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DataChunkMetadata} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInterpretationError.toObject = function(includeInstance, msg) {
+proto.api_container_api.DataChunkMetadata.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5419,23 +10309,23 @@ proto.api_container_api.StarlarkInterpretationError.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInterpretationError}
+ * @return {!proto.api_container_api.DataChunkMetadata}
  */
-proto.api_container_api.StarlarkInterpretationError.deserializeBinary = function(bytes) {
+proto.api_container_api.DataChunkMetadata.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInterpretationError;
-  return proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DataChunkMetadata;
+  return proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInterpretationError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DataChunkMetadata} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInterpretationError}
+ * @return {!proto.api_container_api.DataChunkMetadata}
  */
-proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5444,7 +10334,7 @@ proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -5459,9 +10349,9 @@ proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary = function() {
+proto.api_container_api.DataChunkMetadata.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5469,13 +10359,13 @@ proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInterpretationError} message
+ * @param {!proto.api_container_api.DataChunkMetadata} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -5486,19 +10376,19 @@ proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = fu
 
 
 /**
- * optional string error_message = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.getErrorMessage = function() {
+proto.api_container_api.DataChunkMetadata.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInterpretationError} returns this
+ * @return {!proto.api_container_api.DataChunkMetadata} returns this
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.setErrorMessage = function(value) {
+proto.api_container_api.DataChunkMetadata.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -5519,8 +10409,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkValidationError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkValidationError.toObject(opt_includeInstance, this);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UploadFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5529,13 +10419,14 @@ proto.api_container_api.StarlarkValidationError.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkValidationError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkValidationError.toObject = function(includeInstance, msg) {
+proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5549,23 +10440,23 @@ proto.api_container_api.StarlarkValidationError.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkValidationError}
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse}
  */
-proto.api_container_api.StarlarkValidationError.deserializeBinary = function(bytes) {
+proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkValidationError;
-  return proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.UploadFilesArtifactResponse;
+  return proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkValidationError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkValidationError}
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse}
  */
-proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5574,7 +10465,11 @@ proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = fu
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      msg.setUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -5589,9 +10484,9 @@ proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = function() {
+proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5599,40 +10494,65 @@ proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkValidationError} message
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getUuid();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string error_message = 1;
+ * optional string uuid = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkValidationError.prototype.getErrorMessage = function() {
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkValidationError} returns this
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
  */
-proto.api_container_api.StarlarkValidationError.prototype.setErrorMessage = function(value) {
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
+ */
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -5649,8 +10569,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkExecutionError.toObject(opt_includeInstance, this);
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DownloadFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -5659,13 +10579,13 @@ proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkExecutionError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstance, msg) {
+proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5679,23 +10599,23 @@ proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkExecutionError}
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
  */
-proto.api_container_api.StarlarkExecutionError.deserializeBinary = function(bytes) {
+proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkExecutionError;
-  return proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DownloadFilesArtifactArgs;
+  return proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkExecutionError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkExecutionError}
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
  */
-proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5704,7 +10624,7 @@ proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = fun
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      msg.setIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -5719,9 +10639,9 @@ proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = function() {
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5729,13 +10649,13 @@ proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkExecutionError} message
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -5746,31 +10666,24 @@ proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = functio
 
 
 /**
- * optional string error_message = 1;
+ * optional string identifier = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.getErrorMessage = function() {
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.getIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkExecutionError} returns this
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
  */
-proto.api_container_api.StarlarkExecutionError.prototype.setErrorMessage = function(value) {
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.setIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.StarlarkRunProgress.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5786,8 +10699,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunProgress.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreWebFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -5796,15 +10709,14 @@ proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunProgress} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5818,23 +10730,23 @@ proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunProgress}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
  */
-proto.api_container_api.StarlarkRunProgress.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunProgress;
-  return proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreWebFilesArtifactArgs;
+  return proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunProgress} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunProgress}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
  */
-proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5843,15 +10755,11 @@ proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = functi
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addCurrentStepInfo(value);
+      msg.setUrl(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setTotalSteps(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setCurrentStepNumber(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -5866,9 +10774,9 @@ proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function() {
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5876,106 +10784,62 @@ proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunProgress} message
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCurrentStepInfoList();
+  f = message.getUrl();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getTotalSteps();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
-  }
-  f = message.getCurrentStepNumber();
-  if (f !== 0) {
-    writer.writeUint32(
-      3,
-      f
-    );
-  }
-};
-
-
-/**
- * repeated string current_step_info = 1;
- * @return {!Array<string>}
- */
-proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepInfoList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
- */
-proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepInfoList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
-};
-
-
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
- */
-proto.api_container_api.StarlarkRunProgress.prototype.addCurrentStepInfo = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
- */
-proto.api_container_api.StarlarkRunProgress.prototype.clearCurrentStepInfoList = function() {
-  return this.setCurrentStepInfoList([]);
+  }
 };
 
 
 /**
- * optional uint32 total_steps = 2;
- * @return {number}
+ * optional string url = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.getTotalSteps = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setTotalSteps = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 current_step_number = 3;
- * @return {number}
+ * optional string name = 2;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -5995,8 +10859,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunFinishedEvent.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreWebFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6005,14 +10869,13 @@ proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    isRunSuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    serializedOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -6026,23 +10889,23 @@ proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunFinishedEvent;
-  return proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreWebFilesArtifactResponse;
+  return proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6050,12 +10913,8 @@ proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = f
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsRunSuccessful(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedOutput(value);
+      msg.setUuid(value);
       break;
     default:
       reader.skipField();
@@ -6070,9 +10929,9 @@ proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = function() {
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6080,23 +10939,16 @@ proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} message
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIsRunSuccessful();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
+  f = message.getUuid();
+  if (f.length > 0) {
     writer.writeString(
-      2,
+      1,
       f
     );
   }
@@ -6104,56 +10956,20 @@ proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = funct
 
 
 /**
- * optional bool is_run_successful = 1;
- * @return {boolean}
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.getIsRunSuccessful = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.setIsRunSuccessful = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
-};
-
-
-/**
- * optional string serialized_output = 2;
+ * optional string uuid = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.getSerializedOutput = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.setSerializedOutput = function(value) {
-  return jspb.Message.setField(this, 2, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput = function() {
-  return jspb.Message.setField(this, 2, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse} returns this
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasSerializedOutput = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -6173,8 +10989,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetServicesArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -6183,13 +10999,15 @@ proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetServicesArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : []
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -6203,23 +11021,23 @@ proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetServicesArgs}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
  */
-proto.api_container_api.GetServicesArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetServicesArgs;
-  return proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceArgs;
+  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetServicesArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetServicesArgs}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
  */
-proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6227,10 +11045,16 @@ proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceIdentifiersMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSourcePath(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -6245,9 +11069,9 @@ proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetServicesArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6255,39 +11079,88 @@ proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetServicesArgs} message
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifiersMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSourcePath();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, bool> service_identifiers = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * optional string service_identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.GetServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.GetServicesArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string source_path = 2;
+ * @return {string}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getSourcePath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setSourcePath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
  */
-proto.api_container_api.GetServicesArgs.prototype.clearServiceIdentifiersMap = function() {
-  this.getServiceIdentifiersMap().clear();
-  return this;};
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
 
 
 
@@ -6306,8 +11179,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetServicesResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6316,13 +11189,13 @@ proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetServicesResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceInfoMap: (f = msg.getServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : []
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -6336,23 +11209,23 @@ proto.api_container_api.GetServicesResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetServicesResponse}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
  */
-proto.api_container_api.GetServicesResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetServicesResponse;
-  return proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceResponse;
+  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetServicesResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetServicesResponse}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
  */
-proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6360,10 +11233,8 @@ proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
       break;
     default:
       reader.skipField();
@@ -6378,9 +11249,9 @@ proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function() {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetServicesResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6388,39 +11259,38 @@ proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetServicesResponse} message
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, ServiceInfo> service_info = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
+ * optional string uuid = 1;
+ * @return {string}
  */
-proto.api_container_api.GetServicesResponse.prototype.getServiceInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.ServiceInfo));
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.GetServicesResponse} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} returns this
  */
-proto.api_container_api.GetServicesResponse.prototype.clearServiceInfoMap = function() {
-  this.getServiceInfoMap().clear();
-  return this;};
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
 
@@ -6439,8 +11309,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ServiceIdentifiers.toObject(opt_includeInstance, this);
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FilesArtifactNameAndUuid.toObject(opt_includeInstance, this);
 };
 
 
@@ -6449,15 +11319,14 @@ proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ServiceIdentifiers} msg The msg instance to transform.
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance, msg) {
+proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    fileuuid: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -6471,23 +11340,23 @@ proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.ServiceIdentifiers.deserializeBinary = function(bytes) {
+proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ServiceIdentifiers;
-  return proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.FilesArtifactNameAndUuid;
+  return proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ServiceIdentifiers} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6496,15 +11365,11 @@ proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = functio
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceUuid(value);
+      msg.setFilename(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
+      msg.setFileuuid(value);
       break;
     default:
       reader.skipField();
@@ -6519,9 +11384,9 @@ proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function() {
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6529,97 +11394,72 @@ proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ServiceIdentifiers} message
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceUuid();
+  f = message.getFilename();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getFileuuid();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string service_uuid = 1;
+ * optional string fileName = 1;
  * @return {string}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getServiceUuid = function() {
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFilename = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setServiceUuid = function(value) {
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFilename = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string fileUuid = 2;
  * @return {string}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getName = function() {
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFileuuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setName = function(value) {
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * optional string shortened_uuid = 3;
- * @return {string}
- */
-proto.api_container_api.ServiceIdentifiers.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
- */
-proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.repeatedFields_ = [1];
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.repeatedFields_ = [1];
 
 
 
@@ -6636,8 +11476,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6646,14 +11486,14 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.proto
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
-    proto.api_container_api.ServiceIdentifiers.toObject, includeInstance)
+    fileNamesAndUuidsList: jspb.Message.toObjectList(msg.getFileNamesAndUuidsList(),
+    proto.api_container_api.FilesArtifactNameAndUuid.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -6667,23 +11507,23 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObj
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse;
-  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse;
+  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6691,9 +11531,9 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deser
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.ServiceIdentifiers;
-      reader.readMessage(value,proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader);
-      msg.addAllidentifiers(value);
+      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
+      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
+      msg.addFileNamesAndUuids(value);
       break;
     default:
       reader.skipField();
@@ -6708,9 +11548,9 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deser
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.serializeBinary = function() {
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6718,69 +11558,62 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.proto
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} message
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAllidentifiersList();
+  f = message.getFileNamesAndUuidsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter
+      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated ServiceIdentifiers allIdentifiers = 1;
- * @return {!Array<!proto.api_container_api.ServiceIdentifiers>}
+ * repeated FilesArtifactNameAndUuid file_names_and_uuids = 1;
+ * @return {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.getAllidentifiersList = function() {
-  return /** @type{!Array<!proto.api_container_api.ServiceIdentifiers>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceIdentifiers, 1));
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.getFileNamesAndUuidsList = function() {
+  return /** @type{!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.ServiceIdentifiers>} value
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+ * @param {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} value
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
 */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.setFileNamesAndUuidsList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.ServiceIdentifiers=} opt_value
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid=} opt_value
  * @param {number=} opt_index
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceIdentifiers, opt_index);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.addFileNamesAndUuids = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FilesArtifactNameAndUuid, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.clearAllidentifiersList = function() {
-  return this.setAllidentifiersList([]);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.clearFileNamesAndUuidsList = function() {
+  return this.setFileNamesAndUuidsList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.ExecCommandArgs.repeatedFields_ = [2];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6796,8 +11629,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ExecCommandArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.InspectFilesArtifactContentsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -6806,14 +11639,13 @@ proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ExecCommandArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.InspectFilesArtifactContentsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    commandArgsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    fileNamesAndUuid: (f = msg.getFileNamesAndUuid()) && proto.api_container_api.FilesArtifactNameAndUuid.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -6827,23 +11659,23 @@ proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ExecCommandArgs}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
  */
-proto.api_container_api.ExecCommandArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ExecCommandArgs;
-  return proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.InspectFilesArtifactContentsRequest;
+  return proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ExecCommandArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ExecCommandArgs}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
  */
-proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6851,12 +11683,9 @@ proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addCommandArgs(value);
+      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
+      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
+      msg.setFileNamesAndUuid(value);
       break;
     default:
       reader.skipField();
@@ -6871,9 +11700,9 @@ proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6881,84 +11710,67 @@ proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ExecCommandArgs} message
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getFileNamesAndUuid();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
-    );
-  }
-  f = message.getCommandArgsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      2,
-      f
+      f,
+      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string service_identifier = 1;
- * @return {string}
+ * optional FilesArtifactNameAndUuid file_names_and_uuid = 1;
+ * @return {?proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.ExecCommandArgs.prototype.getServiceIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.getFileNamesAndUuid = function() {
+  return /** @type{?proto.api_container_api.FilesArtifactNameAndUuid} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
- */
-proto.api_container_api.ExecCommandArgs.prototype.setServiceIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {?proto.api_container_api.FilesArtifactNameAndUuid|undefined} value
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
+*/
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.setFileNamesAndUuid = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * repeated string command_args = 2;
- * @return {!Array<string>}
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
  */
-proto.api_container_api.ExecCommandArgs.prototype.getCommandArgsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.clearFileNamesAndUuid = function() {
+  return this.setFileNamesAndUuid(undefined);
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.ExecCommandArgs.prototype.setCommandArgsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.hasFileNamesAndUuid = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
- */
-proto.api_container_api.ExecCommandArgs.prototype.addCommandArgs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
-};
-
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.ExecCommandArgs.prototype.clearCommandArgsList = function() {
-  return this.setCommandArgsList([]);
-};
-
-
+proto.api_container_api.InspectFilesArtifactContentsResponse.repeatedFields_ = [1];
 
 
 
@@ -6975,8 +11787,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ExecCommandResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.InspectFilesArtifactContentsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6985,14 +11797,14 @@ proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ExecCommandResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    logOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+    fileDescriptionsList: jspb.Message.toObjectList(msg.getFileDescriptionsList(),
+    proto.api_container_api.FileArtifactContentsFileDescription.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -7006,23 +11818,23 @@ proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ExecCommandResponse}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
  */
-proto.api_container_api.ExecCommandResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ExecCommandResponse;
-  return proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.InspectFilesArtifactContentsResponse;
+  return proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ExecCommandResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ExecCommandResponse}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
  */
-proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7030,12 +11842,9 @@ proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setExitCode(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLogOutput(value);
+      var value = new proto.api_container_api.FileArtifactContentsFileDescription;
+      reader.readMessage(value,proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader);
+      msg.addFileDescriptions(value);
       break;
     default:
       reader.skipField();
@@ -7050,9 +11859,9 @@ proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function() {
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7060,62 +11869,58 @@ proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ExecCommandResponse} message
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getExitCode();
-  if (f !== 0) {
-    writer.writeInt32(
-      1,
-      f
-    );
-  }
-  f = message.getLogOutput();
+  f = message.getFileDescriptionsList();
   if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional int32 exit_code = 1;
- * @return {number}
+ * repeated FileArtifactContentsFileDescription file_descriptions = 1;
+ * @return {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>}
  */
-proto.api_container_api.ExecCommandResponse.prototype.getExitCode = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.getFileDescriptionsList = function() {
+  return /** @type{!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FileArtifactContentsFileDescription, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.ExecCommandResponse} returns this
- */
-proto.api_container_api.ExecCommandResponse.prototype.setExitCode = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+ * @param {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} value
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
+*/
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.setFileDescriptionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string log_output = 2;
- * @return {string}
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
  */
-proto.api_container_api.ExecCommandResponse.prototype.getLogOutput = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.addFileDescriptions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FileArtifactContentsFileDescription, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
  */
-proto.api_container_api.ExecCommandResponse.prototype.setLogOutput = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.clearFileDescriptionsList = function() {
+  return this.setFileDescriptionsList([]);
 };
 
 
@@ -7135,8 +11940,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FileArtifactContentsFileDescription.toObject(opt_includeInstance, this);
 };
 
 
@@ -7145,19 +11950,15 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.FileArtifactContentsFileDescription.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    retries: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    bodyText: jspb.Message.getFieldWithDefault(msg, 7, "")
+    path: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    size: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    textPreview: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -7171,23 +11972,23 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs;
-  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.FileArtifactContentsFileDescription;
+  return proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7196,31 +11997,15 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setPath(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSize(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setInitialDelayMilliseconds(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetries(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetriesDelayMilliseconds(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBodyText(value);
+      msg.setTextPreview(value);
       break;
     default:
       reader.skipField();
@@ -7235,9 +12020,9 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7245,22 +12030,22 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} message
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getPath();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPort();
+  f = message.getSize();
   if (f !== 0) {
-    writer.writeUint32(
+    writer.writeUint64(
       2,
       f
     );
@@ -7272,96 +12057,68 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryTo
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeUint32(
-      4,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeUint32(
-      6,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 7));
-  if (f != null) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string path = 1;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.getPath = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.setPath = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 port = 2;
+ * optional uint64 size = 2;
  * @return {number}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPort = function() {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.getSize = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPort = function(value) {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.setSize = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional string path = 3;
+ * optional string text_preview = 3;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPath = function() {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.getTextPreview = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPath = function(value) {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.setTextPreview = function(value) {
   return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearPath = function() {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.clearTextPreview = function() {
   return jspb.Message.setField(this, 3, undefined);
 };
 
@@ -7370,155 +12127,249 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearPa
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasPath = function() {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.hasTextPreview = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
 
-/**
- * optional uint32 initial_delay_milliseconds = 4;
- * @return {number}
- */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
-  return jspb.Message.setField(this, 4, value);
+proto.api_container_api.ConnectServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ConnectServicesArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ConnectServicesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearInitialDelayMilliseconds = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.api_container_api.ConnectServicesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    connect: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ConnectServicesArgs}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasInitialDelayMilliseconds = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.ConnectServicesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ConnectServicesArgs;
+  return proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional uint32 retries = 5;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ConnectServicesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ConnectServicesArgs}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetries = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.api_container_api.Connect} */ (reader.readEnum());
+      msg.setConnect(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetries = function(value) {
-  return jspb.Message.setField(this, 5, value);
+proto.api_container_api.ConnectServicesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ConnectServicesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearRetries = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getConnect();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional Connect connect = 1;
+ * @return {!proto.api_container_api.Connect}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasRetries = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.api_container_api.ConnectServicesArgs.prototype.getConnect = function() {
+  return /** @type {!proto.api_container_api.Connect} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional uint32 retries_delay_milliseconds = 6;
- * @return {number}
+ * @param {!proto.api_container_api.Connect} value
+ * @return {!proto.api_container_api.ConnectServicesArgs} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.api_container_api.ConnectServicesArgs.prototype.setConnect = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.api_container_api.ConnectServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ConnectServicesResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ConnectServicesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearRetriesDelayMilliseconds = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.api_container_api.ConnectServicesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ConnectServicesResponse}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasRetriesDelayMilliseconds = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.api_container_api.ConnectServicesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ConnectServicesResponse;
+  return proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional string body_text = 7;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ConnectServicesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ConnectServicesResponse}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getBodyText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
-  return jspb.Message.setField(this, 7, value);
+proto.api_container_api.ConnectServicesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ConnectServicesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.clearBodyText = function() {
-  return jspb.Message.setField(this, 7, undefined);
+proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
+
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.hasBodyText = function() {
-  return jspb.Message.getField(this, 7) != null;
-};
-
-
+proto.api_container_api.GetStarlarkRunResponse.repeatedFields_ = [7];
 
 
 
@@ -7535,8 +12386,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.GetStarlarkRunResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetStarlarkRunResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7545,20 +12396,21 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObje
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetStarlarkRunResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetStarlarkRunResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    requestBody: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    retries: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    bodyText: jspb.Message.getFieldWithDefault(msg, 8, "")
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedScript: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
+    restartPolicy: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    runningPackageId: jspb.Message.getFieldWithDefault(msg, 9, "")
   };
 
   if (includeInstance) {
@@ -7572,23 +12424,23 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = funct
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.GetStarlarkRunResponse}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.GetStarlarkRunResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs;
-  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetStarlarkRunResponse;
+  return proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetStarlarkRunResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.GetStarlarkRunResponse}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7597,35 +12449,41 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinar
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setPackageId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedScript(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
+      msg.setSerializedParams(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRequestBody(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallelism(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setInitialDelayMilliseconds(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRelativePathToMainFile(value);
       break;
     case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetries(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
       break;
     case 7:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetriesDelayMilliseconds(value);
+      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addExperimentalFeatures(values[i]);
+      }
       break;
     case 8:
+      var value = /** @type {!proto.api_container_api.RestartPolicy} */ (reader.readEnum());
+      msg.setRestartPolicy(value);
+      break;
+    case 9:
       var value = /** @type {string} */ (reader.readString());
-      msg.setBodyText(value);
+      msg.setRunningPackageId(value);
       break;
     default:
       reader.skipField();
@@ -7640,9 +12498,9 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinar
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+proto.api_container_api.GetStarlarkRunResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7650,65 +12508,72 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serial
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} message
+ * @param {!proto.api_container_api.GetStarlarkRunResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getPackageId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getSerializedScript();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
+  f = message.getSerializedParams();
+  if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeString(
+  f = message.getParallelism();
+  if (f !== 0) {
+    writer.writeInt32(
       4,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeUint32(
+  f = message.getRelativePathToMainFile();
+  if (f.length > 0) {
+    writer.writeString(
       5,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeUint32(
+  f = message.getMainFunctionName();
+  if (f.length > 0) {
+    writer.writeString(
       6,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 7));
-  if (f != null) {
-    writer.writeUint32(
+  f = message.getExperimentalFeaturesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
       7,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 8));
+  f = message.getRestartPolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 9));
   if (f != null) {
     writer.writeString(
-      8,
+      9,
       f
     );
   }
@@ -7716,245 +12581,192 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryT
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string package_id = 1;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.GetStarlarkRunResponse.prototype.getPackageId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.GetStarlarkRunResponse.prototype.setPackageId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 port = 2;
- * @return {number}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional string path = 3;
+ * optional string serialized_script = 2;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.GetStarlarkRunResponse.prototype.getSerializedScript = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPath = function(value) {
-  return jspb.Message.setField(this, 3, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearPath = function() {
-  return jspb.Message.setField(this, 3, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasPath = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.GetStarlarkRunResponse.prototype.setSerializedScript = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string request_body = 4;
+ * optional string serialized_params = 3;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRequestBody = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.GetStarlarkRunResponse.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRequestBody = function(value) {
-  return jspb.Message.setField(this, 4, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearRequestBody = function() {
-  return jspb.Message.setField(this, 4, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasRequestBody = function() {
-  return jspb.Message.getField(this, 4) != null;
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ */
+proto.api_container_api.GetStarlarkRunResponse.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional uint32 initial_delay_milliseconds = 5;
+ * optional int32 parallelism = 4;
  * @return {number}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.api_container_api.GetStarlarkRunResponse.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
-  return jspb.Message.setField(this, 5, value);
+proto.api_container_api.GetStarlarkRunResponse.prototype.setParallelism = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * optional string relative_path_to_main_file = 5;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearInitialDelayMilliseconds = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.api_container_api.GetStarlarkRunResponse.prototype.getRelativePathToMainFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasInitialDelayMilliseconds = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.api_container_api.GetStarlarkRunResponse.prototype.setRelativePathToMainFile = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * optional uint32 retries = 6;
- * @return {number}
+ * optional string main_function_name = 6;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetries = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.api_container_api.GetStarlarkRunResponse.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetries = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.api_container_api.GetStarlarkRunResponse.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * repeated KurtosisFeatureFlag experimental_features = 7;
+ * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearRetries = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.api_container_api.GetStarlarkRunResponse.prototype.getExperimentalFeaturesList = function() {
+  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 7));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasRetries = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.api_container_api.GetStarlarkRunResponse.prototype.setExperimentalFeaturesList = function(value) {
+  return jspb.Message.setField(this, 7, value || []);
 };
 
 
 /**
- * optional uint32 retries_delay_milliseconds = 7;
- * @return {number}
+ * @param {!proto.api_container_api.KurtosisFeatureFlag} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.api_container_api.GetStarlarkRunResponse.prototype.addExperimentalFeatures = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
-  return jspb.Message.setField(this, 7, value);
+proto.api_container_api.GetStarlarkRunResponse.prototype.clearExperimentalFeaturesList = function() {
+  return this.setExperimentalFeaturesList([]);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * optional RestartPolicy restart_policy = 8;
+ * @return {!proto.api_container_api.RestartPolicy}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearRetriesDelayMilliseconds = function() {
-  return jspb.Message.setField(this, 7, undefined);
+proto.api_container_api.GetStarlarkRunResponse.prototype.getRestartPolicy = function() {
+  return /** @type {!proto.api_container_api.RestartPolicy} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!proto.api_container_api.RestartPolicy} value
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasRetriesDelayMilliseconds = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.api_container_api.GetStarlarkRunResponse.prototype.setRestartPolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
 };
 
 
 /**
- * optional string body_text = 8;
+ * optional string running_package_id = 9;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getBodyText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.api_container_api.GetStarlarkRunResponse.prototype.getRunningPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
-  return jspb.Message.setField(this, 8, value);
+proto.api_container_api.GetStarlarkRunResponse.prototype.setRunningPackageId = function(value) {
+  return jspb.Message.setField(this, 9, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearBodyText = function() {
-  return jspb.Message.setField(this, 8, undefined);
+proto.api_container_api.GetStarlarkRunResponse.prototype.clearRunningPackageId = function() {
+  return jspb.Message.setField(this, 9, undefined);
 };
 
 
@@ -7962,8 +12774,8 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.clearB
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.hasBodyText = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.api_container_api.GetStarlarkRunResponse.prototype.hasRunningPackageId = function() {
+  return jspb.Message.getField(this, 9) != null;
 };
 
 
@@ -7983,8 +12795,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StreamedDataChunk.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StreamedDataChunk.toObject(opt_includeInstance, this);
+proto.api_container_api.PlanYaml.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PlanYaml.toObject(opt_includeInstance, this);
 };
 
 
@@ -7993,15 +12805,13 @@ proto.api_container_api.StreamedDataChunk.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StreamedDataChunk} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PlanYaml} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StreamedDataChunk.toObject = function(includeInstance, msg) {
+proto.api_container_api.PlanYaml.toObject = function(includeInstance, msg) {
   var f, obj = {
-    data: msg.getData_asB64(),
-    previousChunkHash: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    metadata: (f = msg.getMetadata()) && proto.api_container_api.DataChunkMetadata.toObject(includeInstance, f)
+    planYaml: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -8015,23 +12825,23 @@ proto.api_container_api.StreamedDataChunk.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StreamedDataChunk}
+ * @return {!proto.api_container_api.PlanYaml}
  */
-proto.api_container_api.StreamedDataChunk.deserializeBinary = function(bytes) {
+proto.api_container_api.PlanYaml.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StreamedDataChunk;
-  return proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PlanYaml;
+  return proto.api_container_api.PlanYaml.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StreamedDataChunk} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PlanYaml} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StreamedDataChunk}
+ * @return {!proto.api_container_api.PlanYaml}
  */
-proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PlanYaml.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8039,17 +12849,8 @@ proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setData(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPreviousChunkHash(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.DataChunkMetadata;
-      reader.readMessage(value,proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader);
-      msg.setMetadata(value);
+      msg.setPlanYaml(value);
       break;
     default:
       reader.skipField();
@@ -8062,143 +12863,49 @@ proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function
 
 /**
  * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.api_container_api.StreamedDataChunk.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
-/**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StreamedDataChunk} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getData_asU8();
-  if (f.length > 0) {
-    writer.writeBytes(
-      1,
-      f
-    );
-  }
-  f = message.getPreviousChunkHash();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getMetadata();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional bytes data = 1;
- * @return {string}
- */
-proto.api_container_api.StreamedDataChunk.prototype.getData = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * optional bytes data = 1;
- * This is a type-conversion wrapper around `getData()`
- * @return {string}
- */
-proto.api_container_api.StreamedDataChunk.prototype.getData_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getData()));
-};
-
-
-/**
- * optional bytes data = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getData()`
- * @return {!Uint8Array}
- */
-proto.api_container_api.StreamedDataChunk.prototype.getData_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getData()));
-};
-
-
-/**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
- */
-proto.api_container_api.StreamedDataChunk.prototype.setData = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
-};
-
-
-/**
- * optional string previous_chunk_hash = 2;
- * @return {string}
- */
-proto.api_container_api.StreamedDataChunk.prototype.getPreviousChunkHash = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
- */
-proto.api_container_api.StreamedDataChunk.prototype.setPreviousChunkHash = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * optional DataChunkMetadata metadata = 3;
- * @return {?proto.api_container_api.DataChunkMetadata}
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StreamedDataChunk.prototype.getMetadata = function() {
-  return /** @type{?proto.api_container_api.DataChunkMetadata} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.DataChunkMetadata, 3));
+proto.api_container_api.PlanYaml.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.PlanYaml.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.api_container_api.DataChunkMetadata|undefined} value
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
-*/
-proto.api_container_api.StreamedDataChunk.prototype.setMetadata = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.PlanYaml} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.PlanYaml.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPlanYaml();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
+ * optional string plan_yaml = 1;
+ * @return {string}
  */
-proto.api_container_api.StreamedDataChunk.prototype.clearMetadata = function() {
-  return this.setMetadata(undefined);
+proto.api_container_api.PlanYaml.prototype.getPlanYaml = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.PlanYaml} returns this
  */
-proto.api_container_api.StreamedDataChunk.prototype.hasMetadata = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.PlanYaml.prototype.setPlanYaml = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -8218,8 +12925,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.DataChunkMetadata.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.DataChunkMetadata.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkScriptPlanYamlArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -8228,13 +12935,15 @@ proto.api_container_api.DataChunkMetadata.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.DataChunkMetadata} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkScriptPlanYamlArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DataChunkMetadata.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 5, "")
   };
 
   if (includeInstance) {
@@ -8248,23 +12957,23 @@ proto.api_container_api.DataChunkMetadata.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.DataChunkMetadata}
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs}
  */
-proto.api_container_api.DataChunkMetadata.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.DataChunkMetadata;
-  return proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkScriptPlanYamlArgs;
+  return proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.DataChunkMetadata} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkScriptPlanYamlArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.DataChunkMetadata}
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs}
  */
-proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8273,7 +12982,15 @@ proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setSerializedScript(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
       break;
     default:
       reader.skipField();
@@ -8288,9 +13005,9 @@ proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.DataChunkMetadata.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkScriptPlanYamlArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8298,40 +13015,126 @@ proto.api_container_api.DataChunkMetadata.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.DataChunkMetadata} message
+ * @param {!proto.api_container_api.StarlarkScriptPlanYamlArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getSerializedScript();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string name = 1;
+ * optional string serialized_script = 1;
  * @return {string}
  */
-proto.api_container_api.DataChunkMetadata.prototype.getName = function() {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.getSerializedScript = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.DataChunkMetadata} returns this
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
  */
-proto.api_container_api.DataChunkMetadata.prototype.setName = function(value) {
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.setSerializedScript = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string serialized_params = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
+ */
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
+ */
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.clearSerializedParams = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.hasSerializedParams = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string main_function_name = 5;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
+ */
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
+ */
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.clearMainFunctionName = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.hasMainFunctionName = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
 
 
 
@@ -8348,8 +13151,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.UploadFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkPackagePlanYamlArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -8358,14 +13161,16 @@ proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkPackagePlanYamlArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -8379,23 +13184,23 @@ proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.UploadFilesArtifactResponse}
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs}
  */
-proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.UploadFilesArtifactResponse;
-  return proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkPackagePlanYamlArgs;
+  return proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkPackagePlanYamlArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.UploadFilesArtifactResponse}
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs}
  */
-proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8404,11 +13209,19 @@ proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      msg.setPackageId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setSerializedParams(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRelativePathToMainFile(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
       break;
     default:
       reader.skipField();
@@ -8423,9 +13236,9 @@ proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkPackagePlanYamlArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8433,192 +13246,166 @@ proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} message
+ * @param {!proto.api_container_api.StarlarkPackagePlanYamlArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getPackageId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
     writer.writeString(
       2,
       f
     );
   }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string uuid = 1;
+ * optional string package_id = 1;
  * @return {string}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.getUuid = function() {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getPackageId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.setUuid = function(value) {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setPackageId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string serialized_params = 2;
  * @return {string}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.getName = function() {
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getSerializedParams = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ */
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearSerializedParams = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.DownloadFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.hasSerializedParams = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string relative_path_to_main_file = 3;
+ * @return {string}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getRelativePathToMainFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ */
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setRelativePathToMainFile = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.DownloadFilesArtifactArgs;
-  return proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearRelativePathToMainFile = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setIdentifier(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.hasRelativePathToMainFile = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string main_function_name = 4;
+ * @return {string}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * optional string identifier = 1;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.getIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearMainFunctionName = function() {
+  return jspb.Message.setField(this, 4, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.setIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.hasMainFunctionName = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
@@ -8638,8 +13425,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreWebFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.EnclaveSnapshotPersistentDirectory.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.EnclaveSnapshotPersistentDirectory.toObject(opt_includeInstance, this);
 };
 
 
@@ -8648,14 +13435,15 @@ proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.EnclaveSnapshotPersistentDirectory} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.EnclaveSnapshotPersistentDirectory.toObject = function(includeInstance, msg) {
   var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    serviceName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceDirpath: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    persistentKey: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -8669,23 +13457,23 @@ proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ * @return {!proto.api_container_api.EnclaveSnapshotPersistentDirectory}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.EnclaveSnapshotPersistentDirectory.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreWebFilesArtifactArgs;
-  return proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.EnclaveSnapshotPersistentDirectory;
+  return proto.api_container_api.EnclaveSnapshotPersistentDirectory.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.EnclaveSnapshotPersistentDirectory} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ * @return {!proto.api_container_api.EnclaveSnapshotPersistentDirectory}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.EnclaveSnapshotPersistentDirectory.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8694,11 +13482,15 @@ proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
+      msg.setServiceName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setServiceDirpath(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPersistentKey(value);
       break;
     default:
       reader.skipField();
@@ -8713,9 +13505,9 @@ proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.EnclaveSnapshotPersistentDirectory.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.EnclaveSnapshotPersistentDirectory.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8723,65 +13515,97 @@ proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} message
+ * @param {!proto.api_container_api.EnclaveSnapshotPersistentDirectory} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.EnclaveSnapshotPersistentDirectory.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrl();
+  f = message.getServiceName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getServiceDirpath();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getPersistentKey();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string url = 1;
+ * optional string service_name = 1;
  * @return {string}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getUrl = function() {
+proto.api_container_api.EnclaveSnapshotPersistentDirectory.prototype.getServiceName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.EnclaveSnapshotPersistentDirectory} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setUrl = function(value) {
+proto.api_container_api.EnclaveSnapshotPersistentDirectory.prototype.setServiceName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string service_dirpath = 2;
  * @return {string}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getName = function() {
+proto.api_container_api.EnclaveSnapshotPersistentDirectory.prototype.getServiceDirpath = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.EnclaveSnapshotPersistentDirectory} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setName = function(value) {
+proto.api_container_api.EnclaveSnapshotPersistentDirectory.prototype.setServiceDirpath = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional string persistent_key = 3;
+ * @return {string}
+ */
+proto.api_container_api.EnclaveSnapshotPersistentDirectory.prototype.getPersistentKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.EnclaveSnapshotPersistentDirectory} returns this
+ */
+proto.api_container_api.EnclaveSnapshotPersistentDirectory.prototype.setPersistentKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.repeatedFields_ = [2];
 
 
 
@@ -8798,8 +13622,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreWebFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetEnclaveSnapshotInfoResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8808,13 +13632,15 @@ proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetEnclaveSnapshotInfoResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serializedEnclavePlan: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    persistentDirectoriesList: jspb.Message.toObjectList(msg.getPersistentDirectoriesList(),
+    proto.api_container_api.EnclaveSnapshotPersistentDirectory.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -8828,23 +13654,23 @@ proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ * @return {!proto.api_container_api.GetEnclaveSnapshotInfoResponse}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreWebFilesArtifactResponse;
-  return proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetEnclaveSnapshotInfoResponse;
+  return proto.api_container_api.GetEnclaveSnapshotInfoResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetEnclaveSnapshotInfoResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ * @return {!proto.api_container_api.GetEnclaveSnapshotInfoResponse}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8853,7 +13679,12 @@ proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReade
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      msg.setSerializedEnclavePlan(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.EnclaveSnapshotPersistentDirectory;
+      reader.readMessage(value,proto.api_container_api.EnclaveSnapshotPersistentDirectory.deserializeBinaryFromReader);
+      msg.addPersistentDirectories(value);
       break;
     default:
       reader.skipField();
@@ -8868,9 +13699,9 @@ proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetEnclaveSnapshotInfoResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8878,37 +13709,83 @@ proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} message
+ * @param {!proto.api_container_api.GetEnclaveSnapshotInfoResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedEnclavePlan();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPersistentDirectoriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.api_container_api.EnclaveSnapshotPersistentDirectory.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_enclave_plan = 1;
+ * @return {string}
+ */
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.prototype.getSerializedEnclavePlan = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GetEnclaveSnapshotInfoResponse} returns this
+ */
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.prototype.setSerializedEnclavePlan = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated EnclaveSnapshotPersistentDirectory persistent_directories = 2;
+ * @return {!Array<!proto.api_container_api.EnclaveSnapshotPersistentDirectory>}
+ */
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.prototype.getPersistentDirectoriesList = function() {
+  return /** @type{!Array<!proto.api_container_api.EnclaveSnapshotPersistentDirectory>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.EnclaveSnapshotPersistentDirectory, 2));
 };
 
 
 /**
- * optional string uuid = 1;
- * @return {string}
+ * @param {!Array<!proto.api_container_api.EnclaveSnapshotPersistentDirectory>} value
+ * @return {!proto.api_container_api.GetEnclaveSnapshotInfoResponse} returns this
+*/
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.prototype.setPersistentDirectoriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.EnclaveSnapshotPersistentDirectory=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.EnclaveSnapshotPersistentDirectory}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.prototype.addPersistentDirectories = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.api_container_api.EnclaveSnapshotPersistentDirectory, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetEnclaveSnapshotInfoResponse} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.GetEnclaveSnapshotInfoResponse.prototype.clearPersistentDirectoriesList = function() {
+  return this.setPersistentDirectoriesList([]);
 };
 
 
@@ -8928,8 +13805,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.RestoreEnclaveSnapshotArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RestoreEnclaveSnapshotArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -8938,15 +13815,16 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = f
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RestoreEnclaveSnapshotArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.RestoreEnclaveSnapshotArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 3, "")
+    serializedEnclavePlan: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    persistentDirectoryFilesArtifactsMap: (f = msg.getPersistentDirectoryFilesArtifactsMap()) ? f.toObject(includeInstance, undefined) : [],
+    parallelism: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    serviceImagesMap: (f = msg.getServiceImagesMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -8960,23 +13838,23 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(in
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ * @return {!proto.api_container_api.RestoreEnclaveSnapshotArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.RestoreEnclaveSnapshotArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceArgs;
-  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RestoreEnclaveSnapshotArgs;
+  return proto.api_container_api.RestoreEnclaveSnapshotArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RestoreEnclaveSnapshotArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ * @return {!proto.api_container_api.RestoreEnclaveSnapshotArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RestoreEnclaveSnapshotArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8985,15 +13863,23 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromR
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setSerializedEnclavePlan(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSourcePath(value);
+      var value = msg.getPersistentDirectoryFilesArtifactsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallelism(value);
+      break;
+    case 4:
+      var value = msg.getServiceImagesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -9008,9 +13894,9 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromR
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBinary = function() {
+proto.api_container_api.RestoreEnclaveSnapshotArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RestoreEnclaveSnapshotArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9018,90 +13904,135 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBin
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} message
+ * @param {!proto.api_container_api.RestoreEnclaveSnapshotArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RestoreEnclaveSnapshotArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getSerializedEnclavePlan();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getSourcePath();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
+  f = message.getPersistentDirectoryFilesArtifactsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeInt32(
       3,
       f
     );
   }
+  f = message.getServiceImagesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
 };
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string serialized_enclave_plan = 1;
  * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.RestoreEnclaveSnapshotArgs.prototype.getSerializedEnclavePlan = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @return {!proto.api_container_api.RestoreEnclaveSnapshotArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.RestoreEnclaveSnapshotArgs.prototype.setSerializedEnclavePlan = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string source_path = 2;
- * @return {string}
+ * map<string, string> persistent_directory_files_artifacts = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getSourcePath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.RestoreEnclaveSnapshotArgs.prototype.getPersistentDirectoryFilesArtifactsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.RestoreEnclaveSnapshotArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setSourcePath = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.RestoreEnclaveSnapshotArgs.prototype.clearPersistentDirectoryFilesArtifactsMap = function() {
+  this.getPersistentDirectoryFilesArtifactsMap().clear();
+  return this;};
+
+
+/**
+ * optional int32 parallelism = 3;
+ * @return {number}
+ */
+proto.api_container_api.RestoreEnclaveSnapshotArgs.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * optional string name = 3;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.api_container_api.RestoreEnclaveSnapshotArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.RestoreEnclaveSnapshotArgs.prototype.setParallelism = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RestoreEnclaveSnapshotArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.RestoreEnclaveSnapshotArgs.prototype.clearParallelism = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RestoreEnclaveSnapshotArgs.prototype.hasParallelism = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * map<string, string> service_images = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.RestoreEnclaveSnapshotArgs.prototype.getServiceImagesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      null));
 };
 
 
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.RestoreEnclaveSnapshotArgs} returns this
+ */
+proto.api_container_api.RestoreEnclaveSnapshotArgs.prototype.clearServiceImagesMap = function() {
+  this.getServiceImagesMap().clear();
+  return this;};
+
+
 
 
 
@@ -9118,8 +14049,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.SetSecretArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.SetSecretArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -9128,13 +14059,14 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.SetSecretArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.SetSecretArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -9148,23 +14080,23 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = functio
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ * @return {!proto.api_container_api.SetSecretArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.SetSecretArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceResponse;
-  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.SetSecretArgs;
+  return proto.api_container_api.SetSecretArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.SetSecretArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ * @return {!proto.api_container_api.SetSecretArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.SetSecretArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9173,7 +14105,11 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryF
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValue(value);
       break;
     default:
       reader.skipField();
@@ -9188,9 +14124,9 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryF
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializeBinary = function() {
+proto.api_container_api.SetSecretArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.SetSecretArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9198,40 +14134,72 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializ
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} message
+ * @param {!proto.api_container_api.SetSecretArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.SetSecretArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getValue();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string uuid = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.getUuid = function() {
+proto.api_container_api.SetSecretArgs.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} returns this
+ * @return {!proto.api_container_api.SetSecretArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid = function(value) {
+proto.api_container_api.SetSecretArgs.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string value = 2;
+ * @return {string}
+ */
+proto.api_container_api.SetSecretArgs.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.SetSecretArgs} returns this
+ */
+proto.api_container_api.SetSecretArgs.prototype.setValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ListSecretsResponse.repeatedFields_ = [1];
 
 
 
@@ -9248,8 +14216,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.FilesArtifactNameAndUuid.toObject(opt_includeInstance, this);
+proto.api_container_api.ListSecretsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ListSecretsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9258,14 +14226,13 @@ proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ListSecretsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInstance, msg) {
+proto.api_container_api.ListSecretsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    fileuuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+    secretNamesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -9279,23 +14246,23 @@ proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * @return {!proto.api_container_api.ListSecretsResponse}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinary = function(bytes) {
+proto.api_container_api.ListSecretsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.FilesArtifactNameAndUuid;
-  return proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ListSecretsResponse;
+  return proto.api_container_api.ListSecretsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ListSecretsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * @return {!proto.api_container_api.ListSecretsResponse}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ListSecretsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9304,11 +14271,7 @@ proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = f
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setFilename(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setFileuuid(value);
+      msg.addSecretNames(value);
       break;
     default:
       reader.skipField();
@@ -9323,9 +14286,9 @@ proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = function() {
+proto.api_container_api.ListSecretsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ListSecretsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9333,73 +14296,60 @@ proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} message
+ * @param {!proto.api_container_api.ListSecretsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ListSecretsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFilename();
+  f = message.getSecretNamesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = message.getFileuuid();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string fileName = 1;
- * @return {string}
+ * repeated string secret_names = 1;
+ * @return {!Array<string>}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFilename = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.ListSecretsResponse.prototype.getSecretNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ListSecretsResponse} returns this
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFilename = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.ListSecretsResponse.prototype.setSecretNamesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * optional string fileUuid = 2;
- * @return {string}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ListSecretsResponse} returns this
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFileuuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.ListSecretsResponse.prototype.addSecretNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ListSecretsResponse} returns this
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.ListSecretsResponse.prototype.clearSecretNamesList = function() {
+  return this.setSecretNamesList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9415,8 +14365,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.RemoveSecretArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RemoveSecretArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -9425,14 +14375,13 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RemoveSecretArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.RemoveSecretArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fileNamesAndUuidsList: jspb.Message.toObjectList(msg.getFileNamesAndUuidsList(),
-    proto.api_container_api.FilesArtifactNameAndUuid.toObject, includeInstance)
+    name: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -9446,23 +14395,23 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ * @return {!proto.api_container_api.RemoveSecretArgs}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.RemoveSecretArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse;
-  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RemoveSecretArgs;
+  return proto.api_container_api.RemoveSecretArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RemoveSecretArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ * @return {!proto.api_container_api.RemoveSecretArgs}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RemoveSecretArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9470,9 +14419,8 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
-      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
-      msg.addFileNamesAndUuids(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -9487,9 +14435,9 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.serializeBinary = function() {
+proto.api_container_api.RemoveSecretArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RemoveSecretArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9497,58 +14445,37 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} message
+ * @param {!proto.api_container_api.RemoveSecretArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RemoveSecretArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFileNamesAndUuidsList();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated FilesArtifactNameAndUuid file_names_and_uuids = 1;
- * @return {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>}
- */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.getFileNamesAndUuidsList = function() {
-  return /** @type{!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
-};
-
-
-/**
- * @param {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} value
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
-*/
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.setFileNamesAndUuidsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.addFileNamesAndUuids = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FilesArtifactNameAndUuid, opt_index);
+proto.api_container_api.RemoveSecretArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.RemoveSecretArgs} returns this
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.clearFileNamesAndUuidsList = function() {
-  return this.setFileNamesAndUuidsList([]);
+proto.api_container_api.RemoveSecretArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -9568,8 +14495,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.InspectFilesArtifactContentsRequest.toObject(opt_includeInstance, this);
+proto.api_container_api.GetEnclaveActivityResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetEnclaveActivityResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9578,13 +14505,13 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetEnclaveActivityResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetEnclaveActivityResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fileNamesAndUuid: (f = msg.getFileNamesAndUuid()) && proto.api_container_api.FilesArtifactNameAndUuid.toObject(includeInstance, f)
+    lastActivityTime: (f = msg.getLastActivityTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -9598,23 +14525,23 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.toObject = function(
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
+ * @return {!proto.api_container_api.GetEnclaveActivityResponse}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinary = function(bytes) {
+proto.api_container_api.GetEnclaveActivityResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.InspectFilesArtifactContentsRequest;
-  return proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetEnclaveActivityResponse;
+  return proto.api_container_api.GetEnclaveActivityResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetEnclaveActivityResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
+ * @return {!proto.api_container_api.GetEnclaveActivityResponse}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetEnclaveActivityResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9622,9 +14549,9 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFro
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
-      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
-      msg.setFileNamesAndUuid(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastActivityTime(value);
       break;
     default:
       reader.skipField();
@@ -9639,9 +14566,9 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFro
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.serializeBinary = function() {
+proto.api_container_api.GetEnclaveActivityResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetEnclaveActivityResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9649,48 +14576,48 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.serializeB
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} message
+ * @param {!proto.api_container_api.GetEnclaveActivityResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetEnclaveActivityResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFileNamesAndUuid();
+  f = message.getLastActivityTime();
   if (f != null) {
     writer.writeMessage(
       1,
       f,
-      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional FilesArtifactNameAndUuid file_names_and_uuid = 1;
- * @return {?proto.api_container_api.FilesArtifactNameAndUuid}
+ * optional google.protobuf.Timestamp last_activity_time = 1;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.getFileNamesAndUuid = function() {
-  return /** @type{?proto.api_container_api.FilesArtifactNameAndUuid} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
+proto.api_container_api.GetEnclaveActivityResponse.prototype.getLastActivityTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
 };
 
 
 /**
- * @param {?proto.api_container_api.FilesArtifactNameAndUuid|undefined} value
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.GetEnclaveActivityResponse} returns this
 */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.setFileNamesAndUuid = function(value) {
+proto.api_container_api.GetEnclaveActivityResponse.prototype.setLastActivityTime = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
+ * @return {!proto.api_container_api.GetEnclaveActivityResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.clearFileNamesAndUuid = function() {
-  return this.setFileNamesAndUuid(undefined);
+proto.api_container_api.GetEnclaveActivityResponse.prototype.clearLastActivityTime = function() {
+  return this.setLastActivityTime(undefined);
 };
 
 
@@ -9698,19 +14625,12 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.clearFileN
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.hasFileNamesAndUuid = function() {
+proto.api_container_api.GetEnclaveActivityResponse.prototype.hasLastActivityTime = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.InspectFilesArtifactContentsResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9726,8 +14646,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.InspectFilesArtifactContentsResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.GetEnclaveResourceUsageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetEnclaveResourceUsageResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9736,14 +14656,16 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetEnclaveResourceUsageResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetEnclaveResourceUsageResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fileDescriptionsList: jspb.Message.toObjectList(msg.getFileDescriptionsList(),
-    proto.api_container_api.FileArtifactContentsFileDescription.toObject, includeInstance)
+    serviceCount: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    reservedCpuMillicores: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    reservedMemoryMegabytes: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    filesArtifactsStorageBytes: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -9757,23 +14679,23 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.toObject = function
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
+ * @return {!proto.api_container_api.GetEnclaveResourceUsageResponse}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.GetEnclaveResourceUsageResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.InspectFilesArtifactContentsResponse;
-  return proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetEnclaveResourceUsageResponse;
+  return proto.api_container_api.GetEnclaveResourceUsageResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetEnclaveResourceUsageResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
+ * @return {!proto.api_container_api.GetEnclaveResourceUsageResponse}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetEnclaveResourceUsageResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9781,9 +14703,20 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFr
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.FileArtifactContentsFileDescription;
-      reader.readMessage(value,proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader);
-      msg.addFileDescriptions(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setServiceCount(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setReservedCpuMillicores(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setReservedMemoryMegabytes(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setFilesArtifactsStorageBytes(value);
       break;
     default:
       reader.skipField();
@@ -9798,9 +14731,9 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFr
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.serializeBinary = function() {
+proto.api_container_api.GetEnclaveResourceUsageResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetEnclaveResourceUsageResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9808,58 +14741,112 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.serialize
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} message
+ * @param {!proto.api_container_api.GetEnclaveResourceUsageResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetEnclaveResourceUsageResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFileDescriptionsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getServiceCount();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
-      f,
-      proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getReservedCpuMillicores();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getReservedMemoryMegabytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getFilesArtifactsStorageBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * repeated FileArtifactContentsFileDescription file_descriptions = 1;
- * @return {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>}
+ * optional uint32 service_count = 1;
+ * @return {number}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.getFileDescriptionsList = function() {
-  return /** @type{!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FileArtifactContentsFileDescription, 1));
+proto.api_container_api.GetEnclaveResourceUsageResponse.prototype.getServiceCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} value
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
-*/
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.setFileDescriptionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {number} value
+ * @return {!proto.api_container_api.GetEnclaveResourceUsageResponse} returns this
+ */
+proto.api_container_api.GetEnclaveResourceUsageResponse.prototype.setServiceCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ * optional uint64 reserved_cpu_millicores = 2;
+ * @return {number}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.addFileDescriptions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FileArtifactContentsFileDescription, opt_index);
+proto.api_container_api.GetEnclaveResourceUsageResponse.prototype.getReservedCpuMillicores = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.GetEnclaveResourceUsageResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.clearFileDescriptionsList = function() {
-  return this.setFileDescriptionsList([]);
+proto.api_container_api.GetEnclaveResourceUsageResponse.prototype.setReservedCpuMillicores = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 reserved_memory_megabytes = 3;
+ * @return {number}
+ */
+proto.api_container_api.GetEnclaveResourceUsageResponse.prototype.getReservedMemoryMegabytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.GetEnclaveResourceUsageResponse} returns this
+ */
+proto.api_container_api.GetEnclaveResourceUsageResponse.prototype.setReservedMemoryMegabytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 files_artifacts_storage_bytes = 4;
+ * @return {number}
+ */
+proto.api_container_api.GetEnclaveResourceUsageResponse.prototype.getFilesArtifactsStorageBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.GetEnclaveResourceUsageResponse} returns this
+ */
+proto.api_container_api.GetEnclaveResourceUsageResponse.prototype.setFilesArtifactsStorageBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
@@ -9879,8 +14866,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.FileArtifactContentsFileDescription.toObject(opt_includeInstance, this);
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.EnclaveResourceQuotaBudget.toObject(opt_includeInstance, this);
 };
 
 
@@ -9889,15 +14876,15 @@ proto.api_container_api.FileArtifactContentsFileDescription.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The msg instance to transform.
+ * @param {!proto.api_container_api.EnclaveResourceQuotaBudget} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FileArtifactContentsFileDescription.toObject = function(includeInstance, msg) {
+proto.api_container_api.EnclaveResourceQuotaBudget.toObject = function(includeInstance, msg) {
   var f, obj = {
-    path: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    size: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    textPreview: jspb.Message.getFieldWithDefault(msg, 3, "")
+    maxServices: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    maxCpuMillicores: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    maxMemoryMegabytes: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -9911,23 +14898,23 @@ proto.api_container_api.FileArtifactContentsFileDescription.toObject = function(
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ * @return {!proto.api_container_api.EnclaveResourceQuotaBudget}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinary = function(bytes) {
+proto.api_container_api.EnclaveResourceQuotaBudget.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.FileArtifactContentsFileDescription;
-  return proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.EnclaveResourceQuotaBudget;
+  return proto.api_container_api.EnclaveResourceQuotaBudget.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.EnclaveResourceQuotaBudget} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ * @return {!proto.api_container_api.EnclaveResourceQuotaBudget}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.EnclaveResourceQuotaBudget.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9935,16 +14922,16 @@ proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFro
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxServices(value);
       break;
     case 2:
       var value = /** @type {number} */ (reader.readUint64());
-      msg.setSize(value);
+      msg.setMaxCpuMillicores(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTextPreview(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMaxMemoryMegabytes(value);
       break;
     default:
       reader.skipField();
@@ -9959,9 +14946,9 @@ proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFro
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.serializeBinary = function() {
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.EnclaveResourceQuotaBudget.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9969,29 +14956,29 @@ proto.api_container_api.FileArtifactContentsFileDescription.prototype.serializeB
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription} message
+ * @param {!proto.api_container_api.EnclaveResourceQuotaBudget} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.EnclaveResourceQuotaBudget.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPath();
-  if (f.length > 0) {
-    writer.writeString(
+  f = /** @type {number} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeUint32(
       1,
       f
     );
   }
-  f = message.getSize();
-  if (f !== 0) {
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
     writer.writeUint64(
       2,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
   if (f != null) {
-    writer.writeString(
+    writer.writeUint64(
       3,
       f
     );
@@ -10000,64 +14987,100 @@ proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWri
 
 
 /**
- * optional string path = 1;
- * @return {string}
+ * optional uint32 max_services = 1;
+ * @return {number}
+ */
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.getMaxServices = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.EnclaveResourceQuotaBudget} returns this
+ */
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.setMaxServices = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.EnclaveResourceQuotaBudget} returns this
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.clearMaxServices = function() {
+  return jspb.Message.setField(this, 1, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.hasMaxServices = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional uint64 size = 2;
+ * optional uint64 max_cpu_millicores = 2;
  * @return {number}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.getSize = function() {
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.getMaxCpuMillicores = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ * @return {!proto.api_container_api.EnclaveResourceQuotaBudget} returns this
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.setSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.setMaxCpuMillicores = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional string text_preview = 3;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.EnclaveResourceQuotaBudget} returns this
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.getTextPreview = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.clearMaxCpuMillicores = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.setTextPreview = function(value) {
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.hasMaxCpuMillicores = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional uint64 max_memory_megabytes = 3;
+ * @return {number}
+ */
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.getMaxMemoryMegabytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.EnclaveResourceQuotaBudget} returns this
+ */
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.setMaxMemoryMegabytes = function(value) {
   return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ * @return {!proto.api_container_api.EnclaveResourceQuotaBudget} returns this
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.clearTextPreview = function() {
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.clearMaxMemoryMegabytes = function() {
   return jspb.Message.setField(this, 3, undefined);
 };
 
@@ -10066,7 +15089,7 @@ proto.api_container_api.FileArtifactContentsFileDescription.prototype.clearTextP
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.hasTextPreview = function() {
+proto.api_container_api.EnclaveResourceQuotaBudget.prototype.hasMaxMemoryMegabytes = function() {
   return jspb.Message.getField(this, 3) != null;
 };
 
@@ -10087,8 +15110,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ConnectServicesArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ConnectServicesArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.GetEnclaveEventsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetEnclaveEventsArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -10097,13 +15120,13 @@ proto.api_container_api.ConnectServicesArgs.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ConnectServicesArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetEnclaveEventsArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetEnclaveEventsArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    connect: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    afterSequenceNumber: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -10117,23 +15140,23 @@ proto.api_container_api.ConnectServicesArgs.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ConnectServicesArgs}
+ * @return {!proto.api_container_api.GetEnclaveEventsArgs}
  */
-proto.api_container_api.ConnectServicesArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.GetEnclaveEventsArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ConnectServicesArgs;
-  return proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetEnclaveEventsArgs;
+  return proto.api_container_api.GetEnclaveEventsArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ConnectServicesArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetEnclaveEventsArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ConnectServicesArgs}
+ * @return {!proto.api_container_api.GetEnclaveEventsArgs}
  */
-proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetEnclaveEventsArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10141,8 +15164,8 @@ proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.api_container_api.Connect} */ (reader.readEnum());
-      msg.setConnect(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setAfterSequenceNumber(value);
       break;
     default:
       reader.skipField();
@@ -10157,9 +15180,9 @@ proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ConnectServicesArgs.prototype.serializeBinary = function() {
+proto.api_container_api.GetEnclaveEventsArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetEnclaveEventsArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10167,15 +15190,15 @@ proto.api_container_api.ConnectServicesArgs.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ConnectServicesArgs} message
+ * @param {!proto.api_container_api.GetEnclaveEventsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetEnclaveEventsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getConnect();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getAfterSequenceNumber();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
       f
     );
@@ -10184,24 +15207,31 @@ proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter = function(m
 
 
 /**
- * optional Connect connect = 1;
- * @return {!proto.api_container_api.Connect}
+ * optional uint64 after_sequence_number = 1;
+ * @return {number}
  */
-proto.api_container_api.ConnectServicesArgs.prototype.getConnect = function() {
-  return /** @type {!proto.api_container_api.Connect} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.api_container_api.GetEnclaveEventsArgs.prototype.getAfterSequenceNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!proto.api_container_api.Connect} value
- * @return {!proto.api_container_api.ConnectServicesArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.GetEnclaveEventsArgs} returns this
  */
-proto.api_container_api.ConnectServicesArgs.prototype.setConnect = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.api_container_api.GetEnclaveEventsArgs.prototype.setAfterSequenceNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetEnclaveEventsResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -10217,8 +15247,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ConnectServicesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ConnectServicesResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.GetEnclaveEventsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetEnclaveEventsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -10227,13 +15257,16 @@ proto.api_container_api.ConnectServicesResponse.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ConnectServicesResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetEnclaveEventsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetEnclaveEventsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    eventsList: jspb.Message.toObjectList(msg.getEventsList(),
+    proto.api_container_api.EnclaveEvent.toObject, includeInstance),
+    eventLogUuid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    latestSequenceNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -10247,29 +15280,42 @@ proto.api_container_api.ConnectServicesResponse.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ConnectServicesResponse}
+ * @return {!proto.api_container_api.GetEnclaveEventsResponse}
  */
-proto.api_container_api.ConnectServicesResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.GetEnclaveEventsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ConnectServicesResponse;
-  return proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetEnclaveEventsResponse;
+  return proto.api_container_api.GetEnclaveEventsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ConnectServicesResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetEnclaveEventsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ConnectServicesResponse}
+ * @return {!proto.api_container_api.GetEnclaveEventsResponse}
  */
-proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetEnclaveEventsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = new proto.api_container_api.EnclaveEvent;
+      reader.readMessage(value,proto.api_container_api.EnclaveEvent.deserializeBinaryFromReader);
+      msg.addEvents(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEventLogUuid(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setLatestSequenceNumber(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -10283,9 +15329,9 @@ proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ConnectServicesResponse.prototype.serializeBinary = function() {
+proto.api_container_api.GetEnclaveEventsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetEnclaveEventsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10293,22 +15339,111 @@ proto.api_container_api.ConnectServicesResponse.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ConnectServicesResponse} message
+ * @param {!proto.api_container_api.GetEnclaveEventsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetEnclaveEventsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getEventsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.EnclaveEvent.serializeBinaryToWriter
+    );
+  }
+  f = message.getEventLogUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getLatestSequenceNumber();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
 };
 
 
+/**
+ * repeated EnclaveEvent events = 1;
+ * @return {!Array<!proto.api_container_api.EnclaveEvent>}
+ */
+proto.api_container_api.GetEnclaveEventsResponse.prototype.getEventsList = function() {
+  return /** @type{!Array<!proto.api_container_api.EnclaveEvent>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.EnclaveEvent, 1));
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {!Array<!proto.api_container_api.EnclaveEvent>} value
+ * @return {!proto.api_container_api.GetEnclaveEventsResponse} returns this
+*/
+proto.api_container_api.GetEnclaveEventsResponse.prototype.setEventsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.EnclaveEvent=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.EnclaveEvent}
  */
-proto.api_container_api.GetStarlarkRunResponse.repeatedFields_ = [7];
+proto.api_container_api.GetEnclaveEventsResponse.prototype.addEvents = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.EnclaveEvent, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetEnclaveEventsResponse} returns this
+ */
+proto.api_container_api.GetEnclaveEventsResponse.prototype.clearEventsList = function() {
+  return this.setEventsList([]);
+};
+
+
+/**
+ * optional string event_log_uuid = 2;
+ * @return {string}
+ */
+proto.api_container_api.GetEnclaveEventsResponse.prototype.getEventLogUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GetEnclaveEventsResponse} returns this
+ */
+proto.api_container_api.GetEnclaveEventsResponse.prototype.setEventLogUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 latest_sequence_number = 3;
+ * @return {number}
+ */
+proto.api_container_api.GetEnclaveEventsResponse.prototype.getLatestSequenceNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.GetEnclaveEventsResponse} returns this
+ */
+proto.api_container_api.GetEnclaveEventsResponse.prototype.setLatestSequenceNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
 
 
 
@@ -10325,8 +15460,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetStarlarkRunResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.EnclaveEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.EnclaveEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -10335,20 +15470,20 @@ proto.api_container_api.GetStarlarkRunResponse.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetStarlarkRunResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.EnclaveEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetStarlarkRunResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.EnclaveEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serializedScript: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 7)) == null ? undefined : f,
-    restartPolicy: jspb.Message.getFieldWithDefault(msg, 8, 0)
+    sequenceNumber: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    type: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    serviceName: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    filesArtifactName: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    starlarkPackageId: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    isStarlarkRunSuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
+    message: jspb.Message.getFieldWithDefault(msg, 8, "")
   };
 
   if (includeInstance) {
@@ -10362,23 +15497,23 @@ proto.api_container_api.GetStarlarkRunResponse.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetStarlarkRunResponse}
+ * @return {!proto.api_container_api.EnclaveEvent}
  */
-proto.api_container_api.GetStarlarkRunResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.EnclaveEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetStarlarkRunResponse;
-  return proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.EnclaveEvent;
+  return proto.api_container_api.EnclaveEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetStarlarkRunResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.EnclaveEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetStarlarkRunResponse}
+ * @return {!proto.api_container_api.EnclaveEvent}
  */
-proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.EnclaveEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10386,38 +15521,37 @@ proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPackageId(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSequenceNumber(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedScript(value);
+      var value = /** @type {!proto.api_container_api.EnclaveEvent.Type} */ (reader.readEnum());
+      msg.setType(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallelism(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceName(value);
       break;
     case 5:
       var value = /** @type {string} */ (reader.readString());
-      msg.setRelativePathToMainFile(value);
+      msg.setFilesArtifactName(value);
       break;
     case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.setMainFunctionName(value);
+      msg.setStarlarkPackageId(value);
       break;
     case 7:
-      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addExperimentalFeatures(values[i]);
-      }
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsStarlarkRunSuccessful(value);
       break;
     case 8:
-      var value = /** @type {!proto.api_container_api.RestartPolicy} */ (reader.readEnum());
-      msg.setRestartPolicy(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
       break;
     default:
       reader.skipField();
@@ -10432,9 +15566,9 @@ proto.api_container_api.GetStarlarkRunResponse.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.serializeBinary = function() {
+proto.api_container_api.EnclaveEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.EnclaveEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10442,64 +15576,65 @@ proto.api_container_api.GetStarlarkRunResponse.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetStarlarkRunResponse} message
+ * @param {!proto.api_container_api.EnclaveEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.EnclaveEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPackageId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSequenceNumber();
+  if (f !== 0) {
+    writer.writeUint64(
       1,
       f
     );
   }
-  f = message.getSerializedScript();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
       f
     );
   }
-  f = message.getSerializedParams();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getParallelism();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = /** @type {string} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeString(
       4,
       f
     );
   }
-  f = message.getRelativePathToMainFile();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
     writer.writeString(
       5,
       f
     );
-  }
-  f = message.getMainFunctionName();
-  if (f.length > 0) {
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
     writer.writeString(
       6,
       f
     );
   }
-  f = message.getExperimentalFeaturesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeBool(
       7,
       f
     );
   }
-  f = message.getRestartPolicy();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
       8,
       f
     );
@@ -10508,299 +15643,260 @@ proto.api_container_api.GetStarlarkRunResponse.serializeBinaryToWriter = functio
 
 
 /**
- * optional string package_id = 1;
- * @return {string}
+ * @enum {number}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getPackageId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.EnclaveEvent.Type = {
+  STARLARK_RUN_STARTED: 0,
+  STARLARK_RUN_FINISHED: 1,
+  SERVICE_STARTED: 2,
+  SERVICE_STOPPED: 3,
+  SERVICE_CRASHED: 4,
+  FILES_ARTIFACT_CREATED: 5
 };
 
-
 /**
- * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * optional uint64 sequence_number = 1;
+ * @return {number}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setPackageId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.EnclaveEvent.prototype.getSequenceNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional string serialized_script = 2;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getSerializedScript = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.EnclaveEvent.prototype.setSequenceNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * optional Type type = 2;
+ * @return {!proto.api_container_api.EnclaveEvent.Type}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setSerializedScript = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.EnclaveEvent.prototype.getType = function() {
+  return /** @type {!proto.api_container_api.EnclaveEvent.Type} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * optional string serialized_params = 3;
- * @return {string}
+ * @param {!proto.api_container_api.EnclaveEvent.Type} value
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.EnclaveEvent.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * optional google.protobuf.Timestamp timestamp = 3;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.EnclaveEvent.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
 };
 
 
 /**
- * optional int32 parallelism = 4;
- * @return {number}
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
+*/
+proto.api_container_api.EnclaveEvent.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getParallelism = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.api_container_api.EnclaveEvent.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setParallelism = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.api_container_api.EnclaveEvent.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional string relative_path_to_main_file = 5;
+ * optional string service_name = 4;
  * @return {string}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getRelativePathToMainFile = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.api_container_api.EnclaveEvent.prototype.getServiceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setRelativePathToMainFile = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.api_container_api.EnclaveEvent.prototype.setServiceName = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * optional string main_function_name = 6;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getMainFunctionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.api_container_api.EnclaveEvent.prototype.clearServiceName = function() {
+  return jspb.Message.setField(this, 4, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setMainFunctionName = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.api_container_api.EnclaveEvent.prototype.hasServiceName = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * repeated KurtosisFeatureFlag experimental_features = 7;
- * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ * optional string files_artifact_name = 5;
+ * @return {string}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getExperimentalFeaturesList = function() {
-  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 7));
+proto.api_container_api.EnclaveEvent.prototype.getFilesArtifactName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setExperimentalFeaturesList = function(value) {
-  return jspb.Message.setField(this, 7, value || []);
+proto.api_container_api.EnclaveEvent.prototype.setFilesArtifactName = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.KurtosisFeatureFlag} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.addExperimentalFeatures = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 7, value, opt_index);
+proto.api_container_api.EnclaveEvent.prototype.clearFilesArtifactName = function() {
+  return jspb.Message.setField(this, 5, undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.clearExperimentalFeaturesList = function() {
-  return this.setExperimentalFeaturesList([]);
+proto.api_container_api.EnclaveEvent.prototype.hasFilesArtifactName = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * optional RestartPolicy restart_policy = 8;
- * @return {!proto.api_container_api.RestartPolicy}
+ * optional string starlark_package_id = 6;
+ * @return {string}
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.getRestartPolicy = function() {
-  return /** @type {!proto.api_container_api.RestartPolicy} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.api_container_api.EnclaveEvent.prototype.getStarlarkPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * @param {!proto.api_container_api.RestartPolicy} value
- * @return {!proto.api_container_api.GetStarlarkRunResponse} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
  */
-proto.api_container_api.GetStarlarkRunResponse.prototype.setRestartPolicy = function(value) {
-  return jspb.Message.setProto3EnumField(this, 8, value);
+proto.api_container_api.EnclaveEvent.prototype.setStarlarkPackageId = function(value) {
+  return jspb.Message.setField(this, 6, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
  */
-proto.api_container_api.PlanYaml.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.PlanYaml.toObject(opt_includeInstance, this);
+proto.api_container_api.EnclaveEvent.prototype.clearStarlarkPackageId = function() {
+  return jspb.Message.setField(this, 6, undefined);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.PlanYaml} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.PlanYaml.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    planYaml: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.EnclaveEvent.prototype.hasStarlarkPackageId = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.PlanYaml}
+ * optional bool is_starlark_run_successful = 7;
+ * @return {boolean}
  */
-proto.api_container_api.PlanYaml.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.PlanYaml;
-  return proto.api_container_api.PlanYaml.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.EnclaveEvent.prototype.getIsStarlarkRunSuccessful = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.PlanYaml} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.PlanYaml}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
  */
-proto.api_container_api.PlanYaml.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPlanYaml(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.EnclaveEvent.prototype.setIsStarlarkRunSuccessful = function(value) {
+  return jspb.Message.setField(this, 7, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
  */
-proto.api_container_api.PlanYaml.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.PlanYaml.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.EnclaveEvent.prototype.clearIsStarlarkRunSuccessful = function() {
+  return jspb.Message.setField(this, 7, undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.PlanYaml} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.PlanYaml.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getPlanYaml();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.api_container_api.EnclaveEvent.prototype.hasIsStarlarkRunSuccessful = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * optional string plan_yaml = 1;
+ * optional string message = 8;
  * @return {string}
  */
-proto.api_container_api.PlanYaml.prototype.getPlanYaml = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.EnclaveEvent.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.PlanYaml} returns this
+ * @return {!proto.api_container_api.EnclaveEvent} returns this
  */
-proto.api_container_api.PlanYaml.prototype.setPlanYaml = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.EnclaveEvent.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetStarlarkRunTraceResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -10816,8 +15912,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkScriptPlanYamlArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.GetStarlarkRunTraceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetStarlarkRunTraceResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -10826,15 +15922,14 @@ proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkScriptPlanYamlArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetStarlarkRunTraceResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetStarlarkRunTraceResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 5, "")
+    spansList: jspb.Message.toObjectList(msg.getSpansList(),
+    proto.api_container_api.StarlarkRunSpan.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -10848,40 +15943,33 @@ proto.api_container_api.StarlarkScriptPlanYamlArgs.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs}
+ * @return {!proto.api_container_api.GetStarlarkRunTraceResponse}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.GetStarlarkRunTraceResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkScriptPlanYamlArgs;
-  return proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetStarlarkRunTraceResponse;
+  return proto.api_container_api.GetStarlarkRunTraceResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkScriptPlanYamlArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetStarlarkRunTraceResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs}
+ * @return {!proto.api_container_api.GetStarlarkRunTraceResponse}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedScript(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
+proto.api_container_api.GetStarlarkRunTraceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
       break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMainFunctionName(value);
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkRunSpan;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunSpan.deserializeBinaryFromReader);
+      msg.addSpans(value);
       break;
     default:
       reader.skipField();
@@ -10896,9 +15984,9 @@ proto.api_container_api.StarlarkScriptPlanYamlArgs.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.serializeBinary = function() {
+proto.api_container_api.GetStarlarkRunTraceResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkScriptPlanYamlArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetStarlarkRunTraceResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10906,123 +15994,58 @@ proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkScriptPlanYamlArgs} message
+ * @param {!proto.api_container_api.GetStarlarkRunTraceResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetStarlarkRunTraceResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedScript();
+  f = message.getSpansList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeString(
-      5,
-      f
+      f,
+      proto.api_container_api.StarlarkRunSpan.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string serialized_script = 1;
- * @return {string}
- */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.getSerializedScript = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
- */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.setSerializedScript = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string serialized_params = 2;
- * @return {string}
- */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.getSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
- */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setField(this, 2, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
- */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.clearSerializedParams = function() {
-  return jspb.Message.setField(this, 2, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.hasSerializedParams = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * optional string main_function_name = 5;
- * @return {string}
+ * repeated StarlarkRunSpan spans = 1;
+ * @return {!Array<!proto.api_container_api.StarlarkRunSpan>}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.getMainFunctionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.api_container_api.GetStarlarkRunTraceResponse.prototype.getSpansList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkRunSpan>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkRunSpan, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
- */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.setMainFunctionName = function(value) {
-  return jspb.Message.setField(this, 5, value);
+ * @param {!Array<!proto.api_container_api.StarlarkRunSpan>} value
+ * @return {!proto.api_container_api.GetStarlarkRunTraceResponse} returns this
+*/
+proto.api_container_api.GetStarlarkRunTraceResponse.prototype.setSpansList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkScriptPlanYamlArgs} returns this
+ * @param {!proto.api_container_api.StarlarkRunSpan=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkRunSpan}
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.clearMainFunctionName = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.api_container_api.GetStarlarkRunTraceResponse.prototype.addSpans = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.StarlarkRunSpan, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetStarlarkRunTraceResponse} returns this
  */
-proto.api_container_api.StarlarkScriptPlanYamlArgs.prototype.hasMainFunctionName = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.api_container_api.GetStarlarkRunTraceResponse.prototype.clearSpansList = function() {
+  return this.setSpansList([]);
 };
 
 
@@ -11042,8 +16065,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkPackagePlanYamlArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkRunSpan.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunSpan.toObject(opt_includeInstance, this);
 };
 
 
@@ -11052,16 +16075,19 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkPackagePlanYamlArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkRunSpan} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkRunSpan.toObject = function(includeInstance, msg) {
   var f, obj = {
-    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 4, "")
+    spanId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    parentSpanId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    startTime: (f = msg.getStartTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    endTime: (f = msg.getEndTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    attributesMap: (f = msg.getAttributesMap()) ? f.toObject(includeInstance, undefined) : [],
+    error: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -11075,23 +16101,23 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs}
+ * @return {!proto.api_container_api.StarlarkRunSpan}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkRunSpan.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkPackagePlanYamlArgs;
-  return proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkRunSpan;
+  return proto.api_container_api.StarlarkRunSpan.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkPackagePlanYamlArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkRunSpan} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs}
+ * @return {!proto.api_container_api.StarlarkRunSpan}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkRunSpan.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -11100,19 +16126,35 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPackageId(value);
+      msg.setSpanId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
+      msg.setParentSpanId(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setRelativePathToMainFile(value);
+      msg.setName(value);
       break;
     case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartTime(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setEndTime(value);
+      break;
+    case 6:
+      var value = msg.getAttributesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 7:
       var value = /** @type {string} */ (reader.readString());
-      msg.setMainFunctionName(value);
+      msg.setError(value);
       break;
     default:
       reader.skipField();
@@ -11127,9 +16169,9 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkRunSpan.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkPackagePlanYamlArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkRunSpan.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -11137,37 +16179,57 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkPackagePlanYamlArgs} message
+ * @param {!proto.api_container_api.StarlarkRunSpan} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkRunSpan.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPackageId();
+  f = message.getSpanId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
+  f = message.getParentSpanId();
+  if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
+  f = message.getName();
+  if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 4));
+  f = message.getStartTime();
   if (f != null) {
-    writer.writeString(
+    writer.writeMessage(
       4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getEndTime();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getAttributesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
       f
     );
   }
@@ -11175,83 +16237,84 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.serializeBinaryToWriter = fu
 
 
 /**
- * optional string package_id = 1;
+ * optional string span_id = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getPackageId = function() {
+proto.api_container_api.StarlarkRunSpan.prototype.getSpanId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * @return {!proto.api_container_api.StarlarkRunSpan} returns this
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setPackageId = function(value) {
+proto.api_container_api.StarlarkRunSpan.prototype.setSpanId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string serialized_params = 2;
+ * optional string parent_span_id = 2;
  * @return {string}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getSerializedParams = function() {
+proto.api_container_api.StarlarkRunSpan.prototype.getParentSpanId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * @return {!proto.api_container_api.StarlarkRunSpan} returns this
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.api_container_api.StarlarkRunSpan.prototype.setParentSpanId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * optional string name = 3;
+ * @return {string}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearSerializedParams = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.api_container_api.StarlarkRunSpan.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkRunSpan} returns this
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.hasSerializedParams = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.StarlarkRunSpan.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string relative_path_to_main_file = 3;
- * @return {string}
+ * optional google.protobuf.Timestamp start_time = 4;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getRelativePathToMainFile = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.StarlarkRunSpan.prototype.getStartTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
- */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setRelativePathToMainFile = function(value) {
-  return jspb.Message.setField(this, 3, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunSpan} returns this
+*/
+proto.api_container_api.StarlarkRunSpan.prototype.setStartTime = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunSpan} returns this
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearRelativePathToMainFile = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.api_container_api.StarlarkRunSpan.prototype.clearStartTime = function() {
+  return this.setStartTime(undefined);
 };
 
 
@@ -11259,35 +16322,36 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearRelativePathT
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.hasRelativePathToMainFile = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.StarlarkRunSpan.prototype.hasStartTime = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional string main_function_name = 4;
- * @return {string}
+ * optional google.protobuf.Timestamp end_time = 5;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.getMainFunctionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.StarlarkRunSpan.prototype.getEndTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
- */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.setMainFunctionName = function(value) {
-  return jspb.Message.setField(this, 4, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunSpan} returns this
+*/
+proto.api_container_api.StarlarkRunSpan.prototype.setEndTime = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkPackagePlanYamlArgs} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunSpan} returns this
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearMainFunctionName = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.api_container_api.StarlarkRunSpan.prototype.clearEndTime = function() {
+  return this.setEndTime(undefined);
 };
 
 
@@ -11295,8 +16359,48 @@ proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.clearMainFunctionN
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkPackagePlanYamlArgs.prototype.hasMainFunctionName = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.StarlarkRunSpan.prototype.hasEndTime = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * map<string, string> attributes = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.StarlarkRunSpan.prototype.getAttributesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.StarlarkRunSpan} returns this
+ */
+proto.api_container_api.StarlarkRunSpan.prototype.clearAttributesMap = function() {
+  this.getAttributesMap().clear();
+  return this;};
+
+
+/**
+ * optional string error = 7;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkRunSpan.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkRunSpan} returns this
+ */
+proto.api_container_api.StarlarkRunSpan.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
 
 
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkPackagePlanYamlArgs, StarlarkRunResponseLine, StarlarkScriptPlanYamlArgs, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
+import { ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, EnclaveResourceQuotaBudget, ExecCommandArgs, ExecCommandResponse, GetEnclaveActivityResponse, GetEnclaveEventsArgs, GetEnclaveEventsResponse, GetEnclaveResourceUsageResponse, GetEnclaveSnapshotInfoResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetServiceStatsArgs, GetServiceStatsResponse, GetStarlarkRunResponse, GetStarlarkRunTraceResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, ListSecretsResponse, PlanYaml, RemoveSecretArgs, RestoreEnclaveSnapshotArgs, RunStarlarkPackageArgs, RunStarlarkScriptArgs, ServiceHealthEvent, SetSecretArgs, StarlarkPackagePlanYamlArgs, StarlarkRunResponseLine, StarlarkScriptPlanYamlArgs, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -56,6 +56,28 @@ export const ApiContainerService = {
       O: GetServicesResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Samples the CPU, memory, network and disk I/O usage of services, along with their peak usage over the last Starlark run
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetServiceStats
+     */
+    getServiceStats: {
+      name: "GetServiceStats",
+      I: GetServiceStatsArgs,
+      O: GetServiceStatsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Streams the current health of every service, then an event every time the health of a service changes
+     *
+     * @generated from rpc api_container_api.ApiContainerService.StreamServiceHealthEvents
+     */
+    streamServiceHealthEvents: {
+      name: "StreamServiceHealthEvents",
+      I: Empty,
+      O: ServiceHealthEvent,
+      kind: MethodKind.ServerStreaming,
+    },
     /**
      * Returns information about all existing & historical services
      *
@@ -206,6 +228,118 @@ export const ApiContainerService = {
       O: PlanYaml,
       kind: MethodKind.Unary,
     },
+    /**
+     * Gets the enclave plan and the persistent directories of the enclave, which are needed to snapshot the enclave
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetEnclaveSnapshotInfo
+     */
+    getEnclaveSnapshotInfo: {
+      name: "GetEnclaveSnapshotInfo",
+      I: Empty,
+      O: GetEnclaveSnapshotInfoResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Recreates the services of an enclave snapshot by re-executing the snapshot enclave plan inside this (empty) enclave
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RestoreEnclaveSnapshot
+     */
+    restoreEnclaveSnapshot: {
+      name: "RestoreEnclaveSnapshot",
+      I: RestoreEnclaveSnapshotArgs,
+      O: StarlarkRunResponseLine,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Creates the secret, or replaces its value if it already exists
+     *
+     * @generated from rpc api_container_api.ApiContainerService.SetSecret
+     */
+    setSecret: {
+      name: "SetSecret",
+      I: SetSecretArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Lists the names of the secrets of the enclave; secret values are never returned
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ListSecrets
+     */
+    listSecrets: {
+      name: "ListSecrets",
+      I: Empty,
+      O: ListSecretsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Removes a secret; services already using it keep the value they were started with
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RemoveSecret
+     */
+    removeSecret: {
+      name: "RemoveSecret",
+      I: RemoveSecretArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Gets when the API container last served a request, which the engine uses to find the idle enclaves
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetEnclaveActivity
+     */
+    getEnclaveActivity: {
+      name: "GetEnclaveActivity",
+      I: Empty,
+      O: GetEnclaveActivityResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Gets what the services and files artifacts of the enclave take out of its resource quota
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetEnclaveResourceUsage
+     */
+    getEnclaveResourceUsage: {
+      name: "GetEnclaveResourceUsage",
+      I: Empty,
+      O: GetEnclaveResourceUsageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Sets what the services of the enclave can reserve in total given what the other enclaves of its resource quotas
+     * reserve, which the engine keeps up to date; only the engine is allowed to call it
+     *
+     * @generated from rpc api_container_api.ApiContainerService.SetEnclaveResourceQuotaBudget
+     */
+    setEnclaveResourceQuotaBudget: {
+      name: "SetEnclaveResourceQuotaBudget",
+      I: EnclaveResourceQuotaBudget,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Gets the events which happened in the enclave after the given one, which the engine polls to publish them on its
+     * event bus
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetEnclaveEvents
+     */
+    getEnclaveEvents: {
+      name: "GetEnclaveEvents",
+      I: GetEnclaveEventsArgs,
+      O: GetEnclaveEventsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Gets the spans of the trace of the latest Starlark run of the enclave
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetStarlarkRunTrace
+     */
+    getStarlarkRunTrace: {
+      name: "GetStarlarkRunTrace",
+      I: Empty,
+      O: GetStarlarkRunTraceResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 
 /**
@@ -235,6 +235,98 @@ export declare enum Container_Status {
   UNKNOWN = 2,
 }
 
+/**
+ * @generated from message api_container_api.ServiceHealth
+ */
+export declare class ServiceHealth extends Message<ServiceHealth> {
+  /**
+   * @generated from field: api_container_api.ServiceHealth.Status status = 1;
+   */
+  status: ServiceHealth_Status;
+
+  /**
+   * Only set if the service crashed
+   *
+   * @generated from field: optional int32 exit_code = 2;
+   */
+  exitCode?: number;
+
+  /**
+   * Number of times the container runtime restarted the container of the service after it exited
+   *
+   * @generated from field: uint32 restart_count = 3;
+   */
+  restartCount: number;
+
+  /**
+   * Why the service is in this status, e.g. the error of the last failed health check
+   *
+   * @generated from field: string message = 4;
+   */
+  message: string;
+
+  /**
+   * When the service got into this status
+   *
+   * @generated from field: google.protobuf.Timestamp since = 5;
+   */
+  since?: Timestamp;
+
+  /**
+   * Why the container of the service last exited (e.g. OOMKilled), empty if it never exited
+   *
+   * @generated from field: string last_exit_reason = 6;
+   */
+  lastExitReason: string;
+
+  constructor(data?: PartialMessage<ServiceHealth>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceHealth";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceHealth;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceHealth;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceHealth;
+
+  static equals(a: ServiceHealth | PlainMessage<ServiceHealth> | undefined, b: ServiceHealth | PlainMessage<ServiceHealth> | undefined): boolean;
+}
+
+/**
+ * @generated from enum api_container_api.ServiceHealth.Status
+ */
+export declare enum ServiceHealth_Status {
+  /**
+   * e.g. the service was stopped on purpose, or its health wasn't checked yet
+   *
+   * @generated from enum value: UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * The container of the service runs and, if the service has a health check, the last one passed
+   *
+   * @generated from enum value: HEALTHY = 1;
+   */
+  HEALTHY = 1,
+
+  /**
+   * The container of the service runs but its health check failed as many times in a row as its failure threshold
+   *
+   * @generated from enum value: UNHEALTHY = 2;
+   */
+  UNHEALTHY = 2,
+
+  /**
+   * The container of the service exited although the service wasn't stopped
+   *
+   * @generated from enum value: CRASHED = 3;
+   */
+  CRASHED = 3,
+}
+
 /**
  * @generated from message api_container_api.ServiceInfo
  */
@@ -306,6 +398,21 @@ export declare class ServiceInfo extends Message<ServiceInfo> {
    */
   container?: Container;
 
+  /**
+   * Whether the service is healthy, unhealthy or crashed
+   *
+   * @generated from field: api_container_api.ServiceHealth health = 10;
+   */
+  health?: ServiceHealth;
+
+  /**
+   * The IPv6 address of the service inside the enclave
+   * NOTE: Will be empty if the enclave doesn't have IPv6 enabled
+   *
+   * @generated from field: string private_ipv6_addr = 11;
+   */
+  privateIpv6Addr: string;
+
   constructor(data?: PartialMessage<ServiceInfo>);
 
   static readonly runtime: typeof proto3;
@@ -1034,6 +1141,229 @@ export declare class GetServicesResponse extends Message<GetServicesResponse> {
   static equals(a: GetServicesResponse | PlainMessage<GetServicesResponse> | undefined, b: GetServicesResponse | PlainMessage<GetServicesResponse> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                            Get Service Stats
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetServiceStatsArgs
+ */
+export declare class GetServiceStatsArgs extends Message<GetServiceStatsArgs> {
+  /**
+   * "Set" of identifiers of the services to sample
+   * If empty, will sample all services
+   *
+   * @generated from field: map<string, bool> service_identifiers = 1;
+   */
+  serviceIdentifiers: { [key: string]: boolean };
+
+  constructor(data?: PartialMessage<GetServiceStatsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetServiceStatsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetServiceStatsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetServiceStatsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetServiceStatsArgs;
+
+  static equals(a: GetServiceStatsArgs | PlainMessage<GetServiceStatsArgs> | undefined, b: GetServiceStatsArgs | PlainMessage<GetServiceStatsArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ServiceIoStats
+ */
+export declare class ServiceIoStats extends Message<ServiceIoStats> {
+  /**
+   * Since the service started
+   *
+   * @generated from field: uint64 network_received_bytes = 1;
+   */
+  networkReceivedBytes: bigint;
+
+  /**
+   * @generated from field: uint64 network_sent_bytes = 2;
+   */
+  networkSentBytes: bigint;
+
+  /**
+   * @generated from field: uint64 disk_read_bytes = 3;
+   */
+  diskReadBytes: bigint;
+
+  /**
+   * @generated from field: uint64 disk_written_bytes = 4;
+   */
+  diskWrittenBytes: bigint;
+
+  constructor(data?: PartialMessage<ServiceIoStats>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceIoStats";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceIoStats;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceIoStats;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceIoStats;
+
+  static equals(a: ServiceIoStats | PlainMessage<ServiceIoStats> | undefined, b: ServiceIoStats | PlainMessage<ServiceIoStats> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ServiceStats
+ */
+export declare class ServiceStats extends Message<ServiceStats> {
+  /**
+   * @generated from field: uint64 cpu_usage_millicores = 1;
+   */
+  cpuUsageMillicores: bigint;
+
+  /**
+   * @generated from field: uint64 memory_usage_bytes = 2;
+   */
+  memoryUsageBytes: bigint;
+
+  /**
+   * Zero if the memory of the service isn't limited
+   *
+   * @generated from field: uint64 memory_limit_bytes = 3;
+   */
+  memoryLimitBytes: bigint;
+
+  /**
+   * Not set on Kubernetes, whose metrics API only reports CPU and memory
+   *
+   * @generated from field: api_container_api.ServiceIoStats io_stats = 4;
+   */
+  ioStats?: ServiceIoStats;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp sample_time = 5;
+   */
+  sampleTime?: Timestamp;
+
+  constructor(data?: PartialMessage<ServiceStats>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceStats";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceStats;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceStats;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceStats;
+
+  static equals(a: ServiceStats | PlainMessage<ServiceStats> | undefined, b: ServiceStats | PlainMessage<ServiceStats> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ServicePeakUsage
+ */
+export declare class ServicePeakUsage extends Message<ServicePeakUsage> {
+  /**
+   * @generated from field: uint64 peak_cpu_usage_millicores = 1;
+   */
+  peakCpuUsageMillicores: bigint;
+
+  /**
+   * @generated from field: uint64 peak_memory_usage_bytes = 2;
+   */
+  peakMemoryUsageBytes: bigint;
+
+  constructor(data?: PartialMessage<ServicePeakUsage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServicePeakUsage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServicePeakUsage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServicePeakUsage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServicePeakUsage;
+
+  static equals(a: ServicePeakUsage | PlainMessage<ServicePeakUsage> | undefined, b: ServicePeakUsage | PlainMessage<ServicePeakUsage> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetServiceStatsResponse
+ */
+export declare class GetServiceStatsResponse extends Message<GetServiceStatsResponse> {
+  /**
+   * Service name -> stats of the service
+   *
+   * @generated from field: map<string, api_container_api.ServiceStats> service_stats = 1;
+   */
+  serviceStats: { [key: string]: ServiceStats };
+
+  /**
+   * Service name -> why the service couldn't be sampled, e.g. because it's stopped
+   *
+   * @generated from field: map<string, string> service_errors = 2;
+   */
+  serviceErrors: { [key: string]: string };
+
+  /**
+   * Service name -> peak usage of the service over the last Starlark run, sampled periodically during the run
+   * Empty if no Starlark run happened since the API container started
+   *
+   * @generated from field: map<string, api_container_api.ServicePeakUsage> last_run_peak_usage = 3;
+   */
+  lastRunPeakUsage: { [key: string]: ServicePeakUsage };
+
+  constructor(data?: PartialMessage<GetServiceStatsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetServiceStatsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetServiceStatsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetServiceStatsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetServiceStatsResponse;
+
+  static equals(a: GetServiceStatsResponse | PlainMessage<GetServiceStatsResponse> | undefined, b: GetServiceStatsResponse | PlainMessage<GetServiceStatsResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                       Stream Service Health Events
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.ServiceHealthEvent
+ */
+export declare class ServiceHealthEvent extends Message<ServiceHealthEvent> {
+  /**
+   * @generated from field: string service_name = 1;
+   */
+  serviceName: string;
+
+  /**
+   * @generated from field: api_container_api.ServiceHealth health = 2;
+   */
+  health?: ServiceHealth;
+
+  constructor(data?: PartialMessage<ServiceHealthEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceHealthEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceHealthEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceHealthEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceHealthEvent;
+
+  static equals(a: ServiceHealthEvent | PlainMessage<ServiceHealthEvent> | undefined, b: ServiceHealthEvent | PlainMessage<ServiceHealthEvent> | undefined): boolean;
+}
+
 /**
  * An service identifier is a collection of uuid, name and shortened uuid
  *
@@ -1815,6 +2145,14 @@ export declare class GetStarlarkRunResponse extends Message<GetStarlarkRunRespon
    */
   restartPolicy: RestartPolicy;
 
+  /**
+   * Package ID of the Starlark run being executed in the enclave right now, if any. The fields above always describe
+   * the last run that was executed
+   *
+   * @generated from field: optional string running_package_id = 9;
+   */
+  runningPackageId?: string;
+
   constructor(data?: PartialMessage<GetStarlarkRunResponse>);
 
   static readonly runtime: typeof proto3;
@@ -1936,3 +2274,593 @@ export declare class StarlarkPackagePlanYamlArgs extends Message<StarlarkPackage
   static equals(a: StarlarkPackagePlanYamlArgs | PlainMessage<StarlarkPackagePlanYamlArgs> | undefined, b: StarlarkPackagePlanYamlArgs | PlainMessage<StarlarkPackagePlanYamlArgs> | undefined): boolean;
 }
 
+/**
+ * @generated from message api_container_api.EnclaveSnapshotPersistentDirectory
+ */
+export declare class EnclaveSnapshotPersistentDirectory extends Message<EnclaveSnapshotPersistentDirectory> {
+  /**
+   * Name of the service mounting the persistent directory
+   *
+   * @generated from field: string service_name = 1;
+   */
+  serviceName: string;
+
+  /**
+   * Path where the persistent directory is mounted inside the service
+   *
+   * @generated from field: string service_dirpath = 2;
+   */
+  serviceDirpath: string;
+
+  /**
+   * Key identifying the persistent directory inside the enclave
+   *
+   * @generated from field: string persistent_key = 3;
+   */
+  persistentKey: string;
+
+  constructor(data?: PartialMessage<EnclaveSnapshotPersistentDirectory>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.EnclaveSnapshotPersistentDirectory";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclaveSnapshotPersistentDirectory;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclaveSnapshotPersistentDirectory;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclaveSnapshotPersistentDirectory;
+
+  static equals(a: EnclaveSnapshotPersistentDirectory | PlainMessage<EnclaveSnapshotPersistentDirectory> | undefined, b: EnclaveSnapshotPersistentDirectory | PlainMessage<EnclaveSnapshotPersistentDirectory> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetEnclaveSnapshotInfoResponse
+ */
+export declare class GetEnclaveSnapshotInfoResponse extends Message<GetEnclaveSnapshotInfoResponse> {
+  /**
+   * The enclave plan persisted by the API container, serialized as JSON
+   *
+   * @generated from field: string serialized_enclave_plan = 1;
+   */
+  serializedEnclavePlan: string;
+
+  /**
+   * One entry per persistent key, even if the persistent directory is mounted by several services
+   *
+   * @generated from field: repeated api_container_api.EnclaveSnapshotPersistentDirectory persistent_directories = 2;
+   */
+  persistentDirectories: EnclaveSnapshotPersistentDirectory[];
+
+  constructor(data?: PartialMessage<GetEnclaveSnapshotInfoResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetEnclaveSnapshotInfoResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclaveSnapshotInfoResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclaveSnapshotInfoResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclaveSnapshotInfoResponse;
+
+  static equals(a: GetEnclaveSnapshotInfoResponse | PlainMessage<GetEnclaveSnapshotInfoResponse> | undefined, b: GetEnclaveSnapshotInfoResponse | PlainMessage<GetEnclaveSnapshotInfoResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.RestoreEnclaveSnapshotArgs
+ */
+export declare class RestoreEnclaveSnapshotArgs extends Message<RestoreEnclaveSnapshotArgs> {
+  /**
+   * The enclave plan of the snapshot, as returned by GetEnclaveSnapshotInfo
+   *
+   * @generated from field: string serialized_enclave_plan = 1;
+   */
+  serializedEnclavePlan: string;
+
+  /**
+   * Mapping of persistent key -> name of a files artifact, already uploaded to the enclave, holding the content the
+   * persistent directory should be seeded with before the services get started
+   *
+   * @generated from field: map<string, string> persistent_directory_files_artifacts = 2;
+   */
+  persistentDirectoryFilesArtifacts: { [key: string]: string };
+
+  /**
+   * Defaults to 4
+   *
+   * @generated from field: optional int32 parallelism = 3;
+   */
+  parallelism?: number;
+
+  /**
+   * Mapping of service name -> digest-pinned image (e.g. postgres@sha256:...) the service was running when the snapshot
+   * was taken, which the restored service runs instead of the image referenced in the enclave plan
+   *
+   * @generated from field: map<string, string> service_images = 4;
+   */
+  serviceImages: { [key: string]: string };
+
+  constructor(data?: PartialMessage<RestoreEnclaveSnapshotArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RestoreEnclaveSnapshotArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreEnclaveSnapshotArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreEnclaveSnapshotArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreEnclaveSnapshotArgs;
+
+  static equals(a: RestoreEnclaveSnapshotArgs | PlainMessage<RestoreEnclaveSnapshotArgs> | undefined, b: RestoreEnclaveSnapshotArgs | PlainMessage<RestoreEnclaveSnapshotArgs> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                           Secrets
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.SetSecretArgs
+ */
+export declare class SetSecretArgs extends Message<SetSecretArgs> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value: string;
+
+  constructor(data?: PartialMessage<SetSecretArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.SetSecretArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSecretArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSecretArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSecretArgs;
+
+  static equals(a: SetSecretArgs | PlainMessage<SetSecretArgs> | undefined, b: SetSecretArgs | PlainMessage<SetSecretArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ListSecretsResponse
+ */
+export declare class ListSecretsResponse extends Message<ListSecretsResponse> {
+  /**
+   * Sorted alphabetically
+   *
+   * @generated from field: repeated string secret_names = 1;
+   */
+  secretNames: string[];
+
+  constructor(data?: PartialMessage<ListSecretsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ListSecretsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSecretsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSecretsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSecretsResponse;
+
+  static equals(a: ListSecretsResponse | PlainMessage<ListSecretsResponse> | undefined, b: ListSecretsResponse | PlainMessage<ListSecretsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.RemoveSecretArgs
+ */
+export declare class RemoveSecretArgs extends Message<RemoveSecretArgs> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  constructor(data?: PartialMessage<RemoveSecretArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RemoveSecretArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveSecretArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveSecretArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveSecretArgs;
+
+  static equals(a: RemoveSecretArgs | PlainMessage<RemoveSecretArgs> | undefined, b: RemoveSecretArgs | PlainMessage<RemoveSecretArgs> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                       Enclave Activity
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetEnclaveActivityResponse
+ */
+export declare class GetEnclaveActivityResponse extends Message<GetEnclaveActivityResponse> {
+  /**
+   * The last time a request (other than GetEnclaveActivity itself) was served; the API container start time if none was
+   *
+   * @generated from field: google.protobuf.Timestamp last_activity_time = 1;
+   */
+  lastActivityTime?: Timestamp;
+
+  constructor(data?: PartialMessage<GetEnclaveActivityResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetEnclaveActivityResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclaveActivityResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclaveActivityResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclaveActivityResponse;
+
+  static equals(a: GetEnclaveActivityResponse | PlainMessage<GetEnclaveActivityResponse> | undefined, b: GetEnclaveActivityResponse | PlainMessage<GetEnclaveActivityResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                    Enclave Resource Usage
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetEnclaveResourceUsageResponse
+ */
+export declare class GetEnclaveResourceUsageResponse extends Message<GetEnclaveResourceUsageResponse> {
+  /**
+   * @generated from field: uint32 service_count = 1;
+   */
+  serviceCount: number;
+
+  /**
+   * Sums of the min_cpu and min_memory reservations of the services
+   *
+   * @generated from field: uint64 reserved_cpu_millicores = 2;
+   */
+  reservedCpuMillicores: bigint;
+
+  /**
+   * @generated from field: uint64 reserved_memory_megabytes = 3;
+   */
+  reservedMemoryMegabytes: bigint;
+
+  /**
+   * Disk space taken by the files artifacts
+   *
+   * @generated from field: uint64 files_artifacts_storage_bytes = 4;
+   */
+  filesArtifactsStorageBytes: bigint;
+
+  constructor(data?: PartialMessage<GetEnclaveResourceUsageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetEnclaveResourceUsageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclaveResourceUsageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclaveResourceUsageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclaveResourceUsageResponse;
+
+  static equals(a: GetEnclaveResourceUsageResponse | PlainMessage<GetEnclaveResourceUsageResponse> | undefined, b: GetEnclaveResourceUsageResponse | PlainMessage<GetEnclaveResourceUsageResponse> | undefined): boolean;
+}
+
+/**
+ * What is left for the enclave out of the totals of its resource quotas; an unset limit is unlimited
+ *
+ * @generated from message api_container_api.EnclaveResourceQuotaBudget
+ */
+export declare class EnclaveResourceQuotaBudget extends Message<EnclaveResourceQuotaBudget> {
+  /**
+   * @generated from field: optional uint32 max_services = 1;
+   */
+  maxServices?: number;
+
+  /**
+   * Sums of the min_cpu and min_memory reservations of the services
+   *
+   * @generated from field: optional uint64 max_cpu_millicores = 2;
+   */
+  maxCpuMillicores?: bigint;
+
+  /**
+   * @generated from field: optional uint64 max_memory_megabytes = 3;
+   */
+  maxMemoryMegabytes?: bigint;
+
+  constructor(data?: PartialMessage<EnclaveResourceQuotaBudget>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.EnclaveResourceQuotaBudget";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclaveResourceQuotaBudget;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclaveResourceQuotaBudget;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclaveResourceQuotaBudget;
+
+  static equals(a: EnclaveResourceQuotaBudget | PlainMessage<EnclaveResourceQuotaBudget> | undefined, b: EnclaveResourceQuotaBudget | PlainMessage<EnclaveResourceQuotaBudget> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                        Enclave Events
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetEnclaveEventsArgs
+ */
+export declare class GetEnclaveEventsArgs extends Message<GetEnclaveEventsArgs> {
+  /**
+   * Only the events with a greater sequence number are returned; 0 returns all the events still kept
+   *
+   * @generated from field: uint64 after_sequence_number = 1;
+   */
+  afterSequenceNumber: bigint;
+
+  constructor(data?: PartialMessage<GetEnclaveEventsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetEnclaveEventsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclaveEventsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclaveEventsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclaveEventsArgs;
+
+  static equals(a: GetEnclaveEventsArgs | PlainMessage<GetEnclaveEventsArgs> | undefined, b: GetEnclaveEventsArgs | PlainMessage<GetEnclaveEventsArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetEnclaveEventsResponse
+ */
+export declare class GetEnclaveEventsResponse extends Message<GetEnclaveEventsResponse> {
+  /**
+   * Ordered by sequence number
+   *
+   * @generated from field: repeated api_container_api.EnclaveEvent events = 1;
+   */
+  events: EnclaveEvent[];
+
+  /**
+   * Identifies the event log of this API container, which starts over when the API container restarts
+   *
+   * @generated from field: string event_log_uuid = 2;
+   */
+  eventLogUuid: string;
+
+  /**
+   * Sequence number of the last event which happened in the enclave, 0 if none did
+   *
+   * @generated from field: uint64 latest_sequence_number = 3;
+   */
+  latestSequenceNumber: bigint;
+
+  constructor(data?: PartialMessage<GetEnclaveEventsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetEnclaveEventsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclaveEventsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclaveEventsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclaveEventsResponse;
+
+  static equals(a: GetEnclaveEventsResponse | PlainMessage<GetEnclaveEventsResponse> | undefined, b: GetEnclaveEventsResponse | PlainMessage<GetEnclaveEventsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.EnclaveEvent
+ */
+export declare class EnclaveEvent extends Message<EnclaveEvent> {
+  /**
+   * Starts at 1 and increases by 1 with every event
+   *
+   * @generated from field: uint64 sequence_number = 1;
+   */
+  sequenceNumber: bigint;
+
+  /**
+   * @generated from field: api_container_api.EnclaveEvent.Type type = 2;
+   */
+  type: EnclaveEvent_Type;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 3;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * Only set for the service events
+   *
+   * @generated from field: optional string service_name = 4;
+   */
+  serviceName?: string;
+
+  /**
+   * Only set for the files artifact events
+   *
+   * @generated from field: optional string files_artifact_name = 5;
+   */
+  filesArtifactName?: string;
+
+  /**
+   * Only set for the Starlark run events, empty for scripts
+   *
+   * @generated from field: optional string starlark_package_id = 6;
+   */
+  starlarkPackageId?: string;
+
+  /**
+   * Only set for the finished Starlark runs
+   *
+   * @generated from field: optional bool is_starlark_run_successful = 7;
+   */
+  isStarlarkRunSuccessful?: boolean;
+
+  /**
+   * Details about the event, e.g. the error of a failed Starlark run or why a service crashed
+   *
+   * @generated from field: string message = 8;
+   */
+  message: string;
+
+  constructor(data?: PartialMessage<EnclaveEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.EnclaveEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclaveEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclaveEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclaveEvent;
+
+  static equals(a: EnclaveEvent | PlainMessage<EnclaveEvent> | undefined, b: EnclaveEvent | PlainMessage<EnclaveEvent> | undefined): boolean;
+}
+
+/**
+ * @generated from enum api_container_api.EnclaveEvent.Type
+ */
+export declare enum EnclaveEvent_Type {
+  /**
+   * @generated from enum value: STARLARK_RUN_STARTED = 0;
+   */
+  STARLARK_RUN_STARTED = 0,
+
+  /**
+   * @generated from enum value: STARLARK_RUN_FINISHED = 1;
+   */
+  STARLARK_RUN_FINISHED = 1,
+
+  /**
+   * @generated from enum value: SERVICE_STARTED = 2;
+   */
+  SERVICE_STARTED = 2,
+
+  /**
+   * @generated from enum value: SERVICE_STOPPED = 3;
+   */
+  SERVICE_STOPPED = 3,
+
+  /**
+   * The container of the service exited although the service wasn't stopped
+   *
+   * @generated from enum value: SERVICE_CRASHED = 4;
+   */
+  SERVICE_CRASHED = 4,
+
+  /**
+   * @generated from enum value: FILES_ARTIFACT_CREATED = 5;
+   */
+  FILES_ARTIFACT_CREATED = 5,
+}
+
+/**
+ * ==============================================================================================
+ *                                        Starlark Run Trace
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetStarlarkRunTraceResponse
+ */
+export declare class GetStarlarkRunTraceResponse extends Message<GetStarlarkRunTraceResponse> {
+  /**
+   * Ordered by start time; empty if no Starlark run happened since the API container started
+   *
+   * @generated from field: repeated api_container_api.StarlarkRunSpan spans = 1;
+   */
+  spans: StarlarkRunSpan[];
+
+  constructor(data?: PartialMessage<GetStarlarkRunTraceResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetStarlarkRunTraceResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStarlarkRunTraceResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStarlarkRunTraceResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStarlarkRunTraceResponse;
+
+  static equals(a: GetStarlarkRunTraceResponse | PlainMessage<GetStarlarkRunTraceResponse> | undefined, b: GetStarlarkRunTraceResponse | PlainMessage<GetStarlarkRunTraceResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.StarlarkRunSpan
+ */
+export declare class StarlarkRunSpan extends Message<StarlarkRunSpan> {
+  /**
+   * @generated from field: string span_id = 1;
+   */
+  spanId: string;
+
+  /**
+   * Empty for the root span of the run
+   *
+   * @generated from field: string parent_span_id = 2;
+   */
+  parentSpanId: string;
+
+  /**
+   * E.g. 'execute add_service' or 'pull_image'
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 4;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 5;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * E.g. the instruction, service or image the span is about
+   *
+   * @generated from field: map<string, string> attributes = 6;
+   */
+  attributes: { [key: string]: string };
+
+  /**
+   * Empty if the operation the span covers succeeded
+   *
+   * @generated from field: string error = 7;
+   */
+  error: string;
+
+  constructor(data?: PartialMessage<StarlarkRunSpan>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkRunSpan";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkRunSpan;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkRunSpan;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkRunSpan;
+
+  static equals(a: StarlarkRunSpan | PlainMessage<StarlarkRunSpan> | undefined, b: StarlarkRunSpan | PlainMessage<StarlarkRunSpan> | undefined): boolean;
+}
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { proto3 } from "@bufbuild/protobuf";
+import { proto3, Timestamp } from "@bufbuild/protobuf";
 
 /**
  * @generated from enum api_container_api.ServiceStatus
@@ -119,6 +119,34 @@ export const Container_Status = /*@__PURE__*/ proto3.makeEnum(
   ],
 );
 
+/**
+ * @generated from message api_container_api.ServiceHealth
+ */
+export const ServiceHealth = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.ServiceHealth",
+  () => [
+    { no: 1, name: "status", kind: "enum", T: proto3.getEnumType(ServiceHealth_Status) },
+    { no: 2, name: "exit_code", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 3, name: "restart_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "since", kind: "message", T: Timestamp },
+    { no: 6, name: "last_exit_reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from enum api_container_api.ServiceHealth.Status
+ */
+export const ServiceHealth_Status = /*@__PURE__*/ proto3.makeEnum(
+  "api_container_api.ServiceHealth.Status",
+  [
+    {no: 0, name: "UNKNOWN"},
+    {no: 1, name: "HEALTHY"},
+    {no: 2, name: "UNHEALTHY"},
+    {no: 3, name: "CRASHED"},
+  ],
+);
+
 /**
  * @generated from message api_container_api.ServiceInfo
  */
@@ -134,6 +162,8 @@ export const ServiceInfo = /*@__PURE__*/ proto3.makeMessageType(
     { no: 7, name: "shortened_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "service_status", kind: "enum", T: proto3.getEnumType(ServiceStatus) },
     { no: 9, name: "container", kind: "message", T: Container },
+    { no: 10, name: "health", kind: "message", T: ServiceHealth },
+    { no: 11, name: "private_ipv6_addr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -358,6 +388,85 @@ export const GetServicesResponse = /*@__PURE__*/ proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                            Get Service Stats
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetServiceStatsArgs
+ */
+export const GetServiceStatsArgs = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.GetServiceStatsArgs",
+  () => [
+    { no: 1, name: "service_identifiers", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 8 /* ScalarType.BOOL */} },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ServiceIoStats
+ */
+export const ServiceIoStats = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.ServiceIoStats",
+  () => [
+    { no: 1, name: "network_received_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "network_sent_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "disk_read_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "disk_written_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ServiceStats
+ */
+export const ServiceStats = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.ServiceStats",
+  () => [
+    { no: 1, name: "cpu_usage_millicores", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "memory_usage_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "memory_limit_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "io_stats", kind: "message", T: ServiceIoStats },
+    { no: 5, name: "sample_time", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ServicePeakUsage
+ */
+export const ServicePeakUsage = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.ServicePeakUsage",
+  () => [
+    { no: 1, name: "peak_cpu_usage_millicores", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "peak_memory_usage_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetServiceStatsResponse
+ */
+export const GetServiceStatsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.GetServiceStatsResponse",
+  () => [
+    { no: 1, name: "service_stats", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: ServiceStats} },
+    { no: 2, name: "service_errors", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 3, name: "last_run_peak_usage", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: ServicePeakUsage} },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                       Stream Service Health Events
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.ServiceHealthEvent
+ */
+export const ServiceHealthEvent = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.ServiceHealthEvent",
+  () => [
+    { no: 1, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "health", kind: "message", T: ServiceHealth },
+  ],
+);
+
 /**
  * An service identifier is a collection of uuid, name and shortened uuid
  *
@@ -636,6 +745,7 @@ export const GetStarlarkRunResponse = /*@__PURE__*/ proto3.makeMessageType(
     { no: 6, name: "main_function_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "experimental_features", kind: "enum", T: proto3.getEnumType(KurtosisFeatureFlag), repeated: true },
     { no: 8, name: "restart_policy", kind: "enum", T: proto3.getEnumType(RestartPolicy) },
+    { no: 9, name: "running_package_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ],
 );
 
@@ -674,3 +784,207 @@ export const StarlarkPackagePlanYamlArgs = /*@__PURE__*/ proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message api_container_api.EnclaveSnapshotPersistentDirectory
+ */
+export const EnclaveSnapshotPersistentDirectory = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.EnclaveSnapshotPersistentDirectory",
+  () => [
+    { no: 1, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "service_dirpath", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "persistent_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetEnclaveSnapshotInfoResponse
+ */
+export const GetEnclaveSnapshotInfoResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.GetEnclaveSnapshotInfoResponse",
+  () => [
+    { no: 1, name: "serialized_enclave_plan", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "persistent_directories", kind: "message", T: EnclaveSnapshotPersistentDirectory, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.RestoreEnclaveSnapshotArgs
+ */
+export const RestoreEnclaveSnapshotArgs = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.RestoreEnclaveSnapshotArgs",
+  () => [
+    { no: 1, name: "serialized_enclave_plan", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "persistent_directory_files_artifacts", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 3, name: "parallelism", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 4, name: "service_images", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                           Secrets
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.SetSecretArgs
+ */
+export const SetSecretArgs = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.SetSecretArgs",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ListSecretsResponse
+ */
+export const ListSecretsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.ListSecretsResponse",
+  () => [
+    { no: 1, name: "secret_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.RemoveSecretArgs
+ */
+export const RemoveSecretArgs = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.RemoveSecretArgs",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                       Enclave Activity
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetEnclaveActivityResponse
+ */
+export const GetEnclaveActivityResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.GetEnclaveActivityResponse",
+  () => [
+    { no: 1, name: "last_activity_time", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                    Enclave Resource Usage
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetEnclaveResourceUsageResponse
+ */
+export const GetEnclaveResourceUsageResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.GetEnclaveResourceUsageResponse",
+  () => [
+    { no: 1, name: "service_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "reserved_cpu_millicores", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "reserved_memory_megabytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "files_artifacts_storage_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ],
+);
+
+/**
+ * What is left for the enclave out of the totals of its resource quotas; an unset limit is unlimited
+ *
+ * @generated from message api_container_api.EnclaveResourceQuotaBudget
+ */
+export const EnclaveResourceQuotaBudget = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.EnclaveResourceQuotaBudget",
+  () => [
+    { no: 1, name: "max_services", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 2, name: "max_cpu_millicores", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+    { no: 3, name: "max_memory_megabytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */, opt: true },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                        Enclave Events
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetEnclaveEventsArgs
+ */
+export const GetEnclaveEventsArgs = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.GetEnclaveEventsArgs",
+  () => [
+    { no: 1, name: "after_sequence_number", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetEnclaveEventsResponse
+ */
+export const GetEnclaveEventsResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.GetEnclaveEventsResponse",
+  () => [
+    { no: 1, name: "events", kind: "message", T: EnclaveEvent, repeated: true },
+    { no: 2, name: "event_log_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "latest_sequence_number", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.EnclaveEvent
+ */
+export const EnclaveEvent = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.EnclaveEvent",
+  () => [
+    { no: 1, name: "sequence_number", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(EnclaveEvent_Type) },
+    { no: 3, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 4, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "files_artifact_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "starlark_package_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "is_starlark_run_successful", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 8, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from enum api_container_api.EnclaveEvent.Type
+ */
+export const EnclaveEvent_Type = /*@__PURE__*/ proto3.makeEnum(
+  "api_container_api.EnclaveEvent.Type",
+  [
+    {no: 0, name: "STARLARK_RUN_STARTED"},
+    {no: 1, name: "STARLARK_RUN_FINISHED"},
+    {no: 2, name: "SERVICE_STARTED"},
+    {no: 3, name: "SERVICE_STOPPED"},
+    {no: 4, name: "SERVICE_CRASHED"},
+    {no: 5, name: "FILES_ARTIFACT_CREATED"},
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                        Starlark Run Trace
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.GetStarlarkRunTraceResponse
+ */
+export const GetStarlarkRunTraceResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.GetStarlarkRunTraceResponse",
+  () => [
+    { no: 1, name: "spans", kind: "message", T: StarlarkRunSpan, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.StarlarkRunSpan
+ */
+export const StarlarkRunSpan = /*@__PURE__*/ proto3.makeMessageType(
+  "api_container_api.StarlarkRunSpan",
+  () => [
+    { no: 1, name: "span_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "parent_span_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "start_time", kind: "message", T: Timestamp },
+    { no: 5, name: "end_time", kind: "message", T: Timestamp },
+    { no: 6, name: "attributes", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
+import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, EngineEvent, ExtendEnclaveArgs, ExtendEnclaveResponse, GetEnclavesArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetResourceQuotaUsageResponse, GetServiceLogsArgs, GetServiceLogsResponse, StartEnclaveArgs, StopEnclaveArgs, StreamEngineEventsArgs } from "./engine_service_pb.js";
 
 /**
  * @generated from service engine_api.EngineService
@@ -23,6 +23,17 @@ export declare const EngineService: {
       readonly O: typeof GetEngineInfoResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Gets the resource quotas set on the engine, and how much of them the enclaves they apply to take
+     *
+     * @generated from rpc engine_api.EngineService.GetResourceQuotaUsage
+     */
+    readonly getResourceQuotaUsage: {
+      readonly name: "GetResourceQuotaUsage",
+      readonly I: typeof Empty,
+      readonly O: typeof GetResourceQuotaUsageResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * ==============================================================================================
      *                                   Enclave Management
@@ -44,7 +55,7 @@ export declare const EngineService: {
      */
     readonly getEnclaves: {
       readonly name: "GetEnclaves",
-      readonly I: typeof Empty,
+      readonly I: typeof GetEnclavesArgs,
       readonly O: typeof GetEnclavesResponse,
       readonly kind: MethodKind.Unary,
     },
@@ -70,6 +81,28 @@ export declare const EngineService: {
       readonly O: typeof Empty,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Starts a stopped enclave again, with the user services which were running when it got stopped
+     *
+     * @generated from rpc engine_api.EngineService.StartEnclave
+     */
+    readonly startEnclave: {
+      readonly name: "StartEnclave",
+      readonly I: typeof StartEnclaveArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Pushes back the expiry of an enclave created with a TTL or an idle timeout
+     *
+     * @generated from rpc engine_api.EngineService.ExtendEnclave
+     */
+    readonly extendEnclave: {
+      readonly name: "ExtendEnclave",
+      readonly I: typeof ExtendEnclaveArgs,
+      readonly O: typeof ExtendEnclaveResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * Destroys an enclave, removing all artifacts associated with it
      *
@@ -103,6 +136,20 @@ export declare const EngineService: {
       readonly O: typeof GetServiceLogsResponse,
       readonly kind: MethodKind.ServerStreaming,
     },
+    /**
+     * ==============================================================================================
+     *                                        Engine Events
+     * ==============================================================================================
+     * Streams the events matching the filter as they happen in the engine and its enclaves, until the client closes the stream
+     *
+     * @generated from rpc engine_api.EngineService.StreamEngineEvents
+     */
+    readonly streamEngineEvents: {
+      readonly name: "StreamEngineEvents",
+      readonly I: typeof StreamEngineEventsArgs,
+      readonly O: typeof EngineEvent,
+      readonly kind: MethodKind.ServerStreaming,
+    },
   }
 };
 
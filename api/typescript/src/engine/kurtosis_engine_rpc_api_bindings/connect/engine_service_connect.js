@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
+import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, EngineEvent, ExtendEnclaveArgs, ExtendEnclaveResponse, GetEnclavesArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetResourceQuotaUsageResponse, GetServiceLogsArgs, GetServiceLogsResponse, StartEnclaveArgs, StopEnclaveArgs, StreamEngineEventsArgs } from "./engine_service_pb.js";
 
 /**
  * @generated from service engine_api.EngineService
@@ -23,6 +23,17 @@ export const EngineService = {
       O: GetEngineInfoResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Gets the resource quotas set on the engine, and how much of them the enclaves they apply to take
+     *
+     * @generated from rpc engine_api.EngineService.GetResourceQuotaUsage
+     */
+    getResourceQuotaUsage: {
+      name: "GetResourceQuotaUsage",
+      I: Empty,
+      O: GetResourceQuotaUsageResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * ==============================================================================================
      *                                   Enclave Management
@@ -44,7 +55,7 @@ export const EngineService = {
      */
     getEnclaves: {
       name: "GetEnclaves",
-      I: Empty,
+      I: GetEnclavesArgs,
       O: GetEnclavesResponse,
       kind: MethodKind.Unary,
     },
@@ -70,6 +81,28 @@ export const EngineService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * Starts a stopped enclave again, with the user services which were running when it got stopped
+     *
+     * @generated from rpc engine_api.EngineService.StartEnclave
+     */
+    startEnclave: {
+      name: "StartEnclave",
+      I: StartEnclaveArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Pushes back the expiry of an enclave created with a TTL or an idle timeout
+     *
+     * @generated from rpc engine_api.EngineService.ExtendEnclave
+     */
+    extendEnclave: {
+      name: "ExtendEnclave",
+      I: ExtendEnclaveArgs,
+      O: ExtendEnclaveResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Destroys an enclave, removing all artifacts associated with it
      *
@@ -103,6 +136,20 @@ export const EngineService = {
       O: GetServiceLogsResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * ==============================================================================================
+     *                                        Engine Events
+     * ==============================================================================================
+     * Streams the events matching the filter as they happen in the engine and its enclaves, until the client closes the stream
+     *
+     * @generated from rpc engine_api.EngineService.StreamEngineEvents
+     */
+    streamEngineEvents: {
+      name: "StreamEngineEvents",
+      I: StreamEngineEventsArgs,
+      O: EngineEvent,
+      kind: MethodKind.ServerStreaming,
+    },
   }
 };
 
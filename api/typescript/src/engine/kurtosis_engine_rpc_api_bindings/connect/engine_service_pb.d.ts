@@ -21,6 +21,23 @@ export declare enum EnclaveMode {
   PRODUCTION = 1,
 }
 
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.EnclaveExpiryAction
+ */
+export declare enum EnclaveExpiryAction {
+  /**
+   * @generated from enum value: EnclaveExpiryAction_DESTROY = 0;
+   */
+  EnclaveExpiryAction_DESTROY = 0,
+
+  /**
+   * @generated from enum value: EnclaveExpiryAction_STOP = 1;
+   */
+  EnclaveExpiryAction_STOP = 1,
+}
+
 /**
  * ==============================================================================================
  *                                            Get Enclaves
@@ -112,6 +129,65 @@ export declare enum LogLineOperator {
   LogLineOperator_DOES_NOT_CONTAIN_MATCH_REGEX = 3,
 }
 
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.EngineEventType
+ */
+export declare enum EngineEventType {
+  /**
+   * @generated from enum value: EngineEventType_ENCLAVE_CREATED = 0;
+   */
+  EngineEventType_ENCLAVE_CREATED = 0,
+
+  /**
+   * @generated from enum value: EngineEventType_ENCLAVE_STARTED = 1;
+   */
+  EngineEventType_ENCLAVE_STARTED = 1,
+
+  /**
+   * @generated from enum value: EngineEventType_ENCLAVE_STOPPED = 2;
+   */
+  EngineEventType_ENCLAVE_STOPPED = 2,
+
+  /**
+   * @generated from enum value: EngineEventType_ENCLAVE_DESTROYED = 3;
+   */
+  EngineEventType_ENCLAVE_DESTROYED = 3,
+
+  /**
+   * @generated from enum value: EngineEventType_STARLARK_RUN_STARTED = 4;
+   */
+  EngineEventType_STARLARK_RUN_STARTED = 4,
+
+  /**
+   * @generated from enum value: EngineEventType_STARLARK_RUN_FINISHED = 5;
+   */
+  EngineEventType_STARLARK_RUN_FINISHED = 5,
+
+  /**
+   * @generated from enum value: EngineEventType_SERVICE_STARTED = 6;
+   */
+  EngineEventType_SERVICE_STARTED = 6,
+
+  /**
+   * @generated from enum value: EngineEventType_SERVICE_STOPPED = 7;
+   */
+  EngineEventType_SERVICE_STOPPED = 7,
+
+  /**
+   * The container of the service exited although the service wasn't stopped
+   *
+   * @generated from enum value: EngineEventType_SERVICE_CRASHED = 8;
+   */
+  EngineEventType_SERVICE_CRASHED = 8,
+
+  /**
+   * @generated from enum value: EngineEventType_FILES_ARTIFACT_CREATED = 9;
+   */
+  EngineEventType_FILES_ARTIFACT_CREATED = 9,
+}
+
 /**
  * ==============================================================================================
  *                                        Get Engine Info
@@ -142,6 +218,180 @@ export declare class GetEngineInfoResponse extends Message<GetEngineInfoResponse
   static equals(a: GetEngineInfoResponse | PlainMessage<GetEngineInfoResponse> | undefined, b: GetEngineInfoResponse | PlainMessage<GetEngineInfoResponse> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                   Get Resource Quota Usage
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetResourceQuotaUsageResponse
+ */
+export declare class GetResourceQuotaUsageResponse extends Message<GetResourceQuotaUsageResponse> {
+  /**
+   * @generated from field: repeated engine_api.ResourceQuotaUsage resource_quota_usages = 1;
+   */
+  resourceQuotaUsages: ResourceQuotaUsage[];
+
+  constructor(data?: PartialMessage<GetResourceQuotaUsageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetResourceQuotaUsageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetResourceQuotaUsageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetResourceQuotaUsageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetResourceQuotaUsageResponse;
+
+  static equals(a: GetResourceQuotaUsageResponse | PlainMessage<GetResourceQuotaUsageResponse> | undefined, b: GetResourceQuotaUsageResponse | PlainMessage<GetResourceQuotaUsageResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.ResourceQuotaUsage
+ */
+export declare class ResourceQuotaUsage extends Message<ResourceQuotaUsage> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Only one of the user and the label selector is set
+   * The quota applies to the enclaves created by this user
+   *
+   * @generated from field: string user = 2;
+   */
+  user: string;
+
+  /**
+   * The quota applies to the enclaves whose labels match this selector
+   *
+   * @generated from field: string label_selector = 3;
+   */
+  labelSelector: string;
+
+  /**
+   * The limits of the quota; zero means unlimited
+   *
+   * @generated from field: uint32 max_enclaves = 4;
+   */
+  maxEnclaves: number;
+
+  /**
+   * @generated from field: uint32 max_services_per_enclave = 5;
+   */
+  maxServicesPerEnclave: number;
+
+  /**
+   * @generated from field: uint64 max_cpu_millicores_per_enclave = 6;
+   */
+  maxCpuMillicoresPerEnclave: bigint;
+
+  /**
+   * @generated from field: uint64 max_memory_megabytes_per_enclave = 7;
+   */
+  maxMemoryMegabytesPerEnclave: bigint;
+
+  /**
+   * @generated from field: uint64 max_files_artifacts_megabytes_per_enclave = 8;
+   */
+  maxFilesArtifactsMegabytesPerEnclave: bigint;
+
+  /**
+   * Usage of the enclaves the quota applies to, which are counted against the max enclaves whether they're running or not
+   *
+   * @generated from field: repeated engine_api.EnclaveResourceUsage enclave_usages = 9;
+   */
+  enclaveUsages: EnclaveResourceUsage[];
+
+  /**
+   * The limits on what the running enclaves of the quota take together; zero means unlimited
+   *
+   * @generated from field: uint32 max_services = 10;
+   */
+  maxServices: number;
+
+  /**
+   * @generated from field: uint64 max_cpu_millicores = 11;
+   */
+  maxCpuMillicores: bigint;
+
+  /**
+   * @generated from field: uint64 max_memory_megabytes = 12;
+   */
+  maxMemoryMegabytes: bigint;
+
+  constructor(data?: PartialMessage<ResourceQuotaUsage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ResourceQuotaUsage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceQuotaUsage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceQuotaUsage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceQuotaUsage;
+
+  static equals(a: ResourceQuotaUsage | PlainMessage<ResourceQuotaUsage> | undefined, b: ResourceQuotaUsage | PlainMessage<ResourceQuotaUsage> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.EnclaveResourceUsage
+ */
+export declare class EnclaveResourceUsage extends Message<EnclaveResourceUsage> {
+  /**
+   * @generated from field: string enclave_uuid = 1;
+   */
+  enclaveUuid: string;
+
+  /**
+   * @generated from field: string enclave_name = 2;
+   */
+  enclaveName: string;
+
+  /**
+   * False if the enclave is stopped or its API container couldn't be reached, in which case the usage is unknown
+   *
+   * @generated from field: bool is_usage_known = 3;
+   */
+  isUsageKnown: boolean;
+
+  /**
+   * @generated from field: uint32 service_count = 4;
+   */
+  serviceCount: number;
+
+  /**
+   * @generated from field: uint64 reserved_cpu_millicores = 5;
+   */
+  reservedCpuMillicores: bigint;
+
+  /**
+   * @generated from field: uint64 reserved_memory_megabytes = 6;
+   */
+  reservedMemoryMegabytes: bigint;
+
+  /**
+   * @generated from field: uint64 files_artifacts_storage_bytes = 7;
+   */
+  filesArtifactsStorageBytes: bigint;
+
+  constructor(data?: PartialMessage<EnclaveResourceUsage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.EnclaveResourceUsage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclaveResourceUsage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclaveResourceUsage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclaveResourceUsage;
+
+  static equals(a: EnclaveResourceUsage | PlainMessage<EnclaveResourceUsage> | undefined, b: EnclaveResourceUsage | PlainMessage<EnclaveResourceUsage> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                                        Create Enclave
@@ -185,6 +435,49 @@ export declare class CreateEnclaveArgs extends Message<CreateEnclaveArgs> {
    */
   shouldApicRunInDebugMode?: boolean;
 
+  /**
+   * Whether the enclave network should be a dual-stack network, giving every service an IPv6 address as well
+   *
+   * @generated from field: optional bool is_ipv6_enabled = 6;
+   */
+  isIpv6Enabled?: boolean;
+
+  /**
+   * The enclave expires this many seconds after its creation; it never expires if unset
+   *
+   * @generated from field: optional int64 ttl_seconds = 7;
+   */
+  ttlSeconds?: bigint;
+
+  /**
+   * The enclave expires once its API container hasn't served any request for this many seconds; it never expires if unset
+   *
+   * @generated from field: optional int64 idle_timeout_seconds = 8;
+   */
+  idleTimeoutSeconds?: bigint;
+
+  /**
+   * What the engine does with the enclave once it expires; defaults to destroying it
+   *
+   * @generated from field: optional engine_api.EnclaveExpiryAction expiry_action = 9;
+   */
+  expiryAction?: EnclaveExpiryAction;
+
+  /**
+   * Key/value labels to tell which pipeline, branch or developer the enclave belongs to
+   *
+   * @generated from field: map<string, string> labels = 10;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * The enclave pool template to create the enclave from: a warm enclave of the template gets handed out if the
+   * pool has one, otherwise the enclave gets created and the packages of the template run in it
+   *
+   * @generated from field: optional string from_template = 11;
+   */
+  fromTemplate?: string;
+
   constructor(data?: PartialMessage<CreateEnclaveArgs>);
 
   static readonly runtime: typeof proto3;
@@ -375,6 +668,28 @@ export declare class EnclaveInfo extends Message<EnclaveInfo> {
    */
   mode: EnclaveMode;
 
+  /**
+   * When the engine will stop or destroy the enclave; not present if the enclave never expires
+   *
+   * @generated from field: google.protobuf.Timestamp expiry_time = 10;
+   */
+  expiryTime?: Timestamp;
+
+  /**
+   * The labels given to the enclave when it was created
+   *
+   * @generated from field: map<string, string> labels = 11;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * Sent as a bearer token on the calls to the API container when the engine requires authentication; it allows the
+   * caller to inspect the enclave, and to change it if they created it or are an admin. Empty otherwise
+   *
+   * @generated from field: string api_container_access_token = 12;
+   */
+  apiContainerAccessToken: string;
+
   constructor(data?: PartialMessage<EnclaveInfo>);
 
   static readonly runtime: typeof proto3;
@@ -390,6 +705,32 @@ export declare class EnclaveInfo extends Message<EnclaveInfo> {
   static equals(a: EnclaveInfo | PlainMessage<EnclaveInfo> | undefined, b: EnclaveInfo | PlainMessage<EnclaveInfo> | undefined): boolean;
 }
 
+/**
+ * @generated from message engine_api.GetEnclavesArgs
+ */
+export declare class GetEnclavesArgs extends Message<GetEnclavesArgs> {
+  /**
+   * Only the enclaves whose labels match this selector are returned, e.g. 'team=infra,branch!=main'; all of them if unset
+   *
+   * @generated from field: optional string label_selector = 1;
+   */
+  labelSelector?: string;
+
+  constructor(data?: PartialMessage<GetEnclavesArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetEnclavesArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclavesArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclavesArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclavesArgs;
+
+  static equals(a: GetEnclavesArgs | PlainMessage<GetEnclavesArgs> | undefined, b: GetEnclavesArgs | PlainMessage<GetEnclavesArgs> | undefined): boolean;
+}
+
 /**
  * @generated from message engine_api.GetEnclavesResponse
  */
@@ -512,6 +853,99 @@ export declare class StopEnclaveArgs extends Message<StopEnclaveArgs> {
   static equals(a: StopEnclaveArgs | PlainMessage<StopEnclaveArgs> | undefined, b: StopEnclaveArgs | PlainMessage<StopEnclaveArgs> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                       Start Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.StartEnclaveArgs
+ */
+export declare class StartEnclaveArgs extends Message<StartEnclaveArgs> {
+  /**
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to start
+   *
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  constructor(data?: PartialMessage<StartEnclaveArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.StartEnclaveArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartEnclaveArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartEnclaveArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartEnclaveArgs;
+
+  static equals(a: StartEnclaveArgs | PlainMessage<StartEnclaveArgs> | undefined, b: StartEnclaveArgs | PlainMessage<StartEnclaveArgs> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                       Extend Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.ExtendEnclaveArgs
+ */
+export declare class ExtendEnclaveArgs extends Message<ExtendEnclaveArgs> {
+  /**
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to extend
+   *
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  /**
+   * How many seconds to add to the expiry, counting from now if the enclave was about to expire already
+   *
+   * @generated from field: int64 extension_seconds = 2;
+   */
+  extensionSeconds: bigint;
+
+  constructor(data?: PartialMessage<ExtendEnclaveArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ExtendEnclaveArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExtendEnclaveArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExtendEnclaveArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExtendEnclaveArgs;
+
+  static equals(a: ExtendEnclaveArgs | PlainMessage<ExtendEnclaveArgs> | undefined, b: ExtendEnclaveArgs | PlainMessage<ExtendEnclaveArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.ExtendEnclaveResponse
+ */
+export declare class ExtendEnclaveResponse extends Message<ExtendEnclaveResponse> {
+  /**
+   * The new expiry time of the enclave
+   *
+   * @generated from field: google.protobuf.Timestamp expiry_time = 1;
+   */
+  expiryTime?: Timestamp;
+
+  constructor(data?: PartialMessage<ExtendEnclaveResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ExtendEnclaveResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExtendEnclaveResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExtendEnclaveResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExtendEnclaveResponse;
+
+  static equals(a: ExtendEnclaveResponse | PlainMessage<ExtendEnclaveResponse> | undefined, b: ExtendEnclaveResponse | PlainMessage<ExtendEnclaveResponse> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                                       Destroy Enclave
@@ -557,6 +991,13 @@ export declare class CleanArgs extends Message<CleanArgs> {
    */
   shouldCleanAll?: boolean;
 
+  /**
+   * Only the enclaves whose labels match this selector are cleaned; all of them if unset
+   *
+   * @generated from field: optional string label_selector = 2;
+   */
+  labelSelector?: string;
+
   constructor(data?: PartialMessage<CleanArgs>);
 
   static readonly runtime: typeof proto3;
@@ -784,3 +1225,142 @@ export declare class LogLineFilter extends Message<LogLineFilter> {
   static equals(a: LogLineFilter | PlainMessage<LogLineFilter> | undefined, b: LogLineFilter | PlainMessage<LogLineFilter> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                        Engine Events
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.StreamEngineEventsArgs
+ */
+export declare class StreamEngineEventsArgs extends Message<StreamEngineEventsArgs> {
+  /**
+   * Only the events of these types are streamed; all of them if empty
+   *
+   * @generated from field: repeated engine_api.EngineEventType event_types = 1;
+   */
+  eventTypes: EngineEventType[];
+
+  /**
+   * Only the events of these enclaves, given by UUID, shortened UUID or name, are streamed; the events of every enclave if empty
+   *
+   * @generated from field: repeated string enclave_identifiers = 2;
+   */
+  enclaveIdentifiers: string[];
+
+  /**
+   * Only the events of the enclaves whose labels match this selector are streamed, e.g. 'team=infra,branch!=main'
+   *
+   * @generated from field: optional string enclave_label_selector = 3;
+   */
+  enclaveLabelSelector?: string;
+
+  /**
+   * If true, the finished Starlark runs are only streamed when they failed
+   *
+   * @generated from field: bool only_failed_starlark_runs = 4;
+   */
+  onlyFailedStarlarkRuns: boolean;
+
+  constructor(data?: PartialMessage<StreamEngineEventsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.StreamEngineEventsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamEngineEventsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamEngineEventsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamEngineEventsArgs;
+
+  static equals(a: StreamEngineEventsArgs | PlainMessage<StreamEngineEventsArgs> | undefined, b: StreamEngineEventsArgs | PlainMessage<StreamEngineEventsArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.EngineEvent
+ */
+export declare class EngineEvent extends Message<EngineEvent> {
+  /**
+   * Unique across all the events, so that the receivers can ignore the events they already got
+   *
+   * @generated from field: string event_uuid = 1;
+   */
+  eventUuid: string;
+
+  /**
+   * @generated from field: engine_api.EngineEventType type = 2;
+   */
+  type: EngineEventType;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 3;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * @generated from field: string enclave_uuid = 4;
+   */
+  enclaveUuid: string;
+
+  /**
+   * @generated from field: string enclave_name = 5;
+   */
+  enclaveName: string;
+
+  /**
+   * The labels of the enclave when the event happened
+   *
+   * @generated from field: map<string, string> enclave_labels = 6;
+   */
+  enclaveLabels: { [key: string]: string };
+
+  /**
+   * Only set for the service events
+   *
+   * @generated from field: optional string service_name = 7;
+   */
+  serviceName?: string;
+
+  /**
+   * Only set for the files artifact events
+   *
+   * @generated from field: optional string files_artifact_name = 8;
+   */
+  filesArtifactName?: string;
+
+  /**
+   * Only set for the Starlark run events, empty for scripts
+   *
+   * @generated from field: optional string starlark_package_id = 9;
+   */
+  starlarkPackageId?: string;
+
+  /**
+   * Only set for the finished Starlark runs
+   *
+   * @generated from field: optional bool is_starlark_run_successful = 10;
+   */
+  isStarlarkRunSuccessful?: boolean;
+
+  /**
+   * Details about the event, e.g. the error of a failed Starlark run or why a service crashed
+   *
+   * @generated from field: string message = 11;
+   */
+  message: string;
+
+  constructor(data?: PartialMessage<EngineEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.EngineEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EngineEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EngineEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EngineEvent;
+
+  static equals(a: EngineEvent | PlainMessage<EngineEvent> | undefined, b: EngineEvent | PlainMessage<EngineEvent> | undefined): boolean;
+}
+
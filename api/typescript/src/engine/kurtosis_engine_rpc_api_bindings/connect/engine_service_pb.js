@@ -16,6 +16,19 @@ export const EnclaveMode = /*@__PURE__*/ proto3.makeEnum(
   ],
 );
 
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.EnclaveExpiryAction
+ */
+export const EnclaveExpiryAction = /*@__PURE__*/ proto3.makeEnum(
+  "engine_api.EnclaveExpiryAction",
+  [
+    {no: 0, name: "EnclaveExpiryAction_DESTROY"},
+    {no: 1, name: "EnclaveExpiryAction_STOP"},
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                            Get Enclaves
@@ -64,6 +77,27 @@ export const LogLineOperator = /*@__PURE__*/ proto3.makeEnum(
   ],
 );
 
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.EngineEventType
+ */
+export const EngineEventType = /*@__PURE__*/ proto3.makeEnum(
+  "engine_api.EngineEventType",
+  [
+    {no: 0, name: "EngineEventType_ENCLAVE_CREATED"},
+    {no: 1, name: "EngineEventType_ENCLAVE_STARTED"},
+    {no: 2, name: "EngineEventType_ENCLAVE_STOPPED"},
+    {no: 3, name: "EngineEventType_ENCLAVE_DESTROYED"},
+    {no: 4, name: "EngineEventType_STARLARK_RUN_STARTED"},
+    {no: 5, name: "EngineEventType_STARLARK_RUN_FINISHED"},
+    {no: 6, name: "EngineEventType_SERVICE_STARTED"},
+    {no: 7, name: "EngineEventType_SERVICE_STOPPED"},
+    {no: 8, name: "EngineEventType_SERVICE_CRASHED"},
+    {no: 9, name: "EngineEventType_FILES_ARTIFACT_CREATED"},
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                        Get Engine Info
@@ -78,6 +112,57 @@ export const GetEngineInfoResponse = /*@__PURE__*/ proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                   Get Resource Quota Usage
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetResourceQuotaUsageResponse
+ */
+export const GetResourceQuotaUsageResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "engine_api.GetResourceQuotaUsageResponse",
+  () => [
+    { no: 1, name: "resource_quota_usages", kind: "message", T: ResourceQuotaUsage, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.ResourceQuotaUsage
+ */
+export const ResourceQuotaUsage = /*@__PURE__*/ proto3.makeMessageType(
+  "engine_api.ResourceQuotaUsage",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "label_selector", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "max_enclaves", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "max_services_per_enclave", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 6, name: "max_cpu_millicores_per_enclave", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 7, name: "max_memory_megabytes_per_enclave", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 8, name: "max_files_artifacts_megabytes_per_enclave", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 9, name: "enclave_usages", kind: "message", T: EnclaveResourceUsage, repeated: true },
+    { no: 10, name: "max_services", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 11, name: "max_cpu_millicores", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 12, name: "max_memory_megabytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.EnclaveResourceUsage
+ */
+export const EnclaveResourceUsage = /*@__PURE__*/ proto3.makeMessageType(
+  "engine_api.EnclaveResourceUsage",
+  () => [
+    { no: 1, name: "enclave_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "enclave_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "is_usage_known", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "service_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "reserved_cpu_millicores", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "reserved_memory_megabytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 7, name: "files_artifacts_storage_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                        Create Enclave
@@ -93,6 +178,12 @@ export const CreateEnclaveArgs = /*@__PURE__*/ proto3.makeMessageType(
     { no: 3, name: "api_container_log_level", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "mode", kind: "enum", T: proto3.getEnumType(EnclaveMode), opt: true },
     { no: 5, name: "should_apic_run_in_debug_mode", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 6, name: "is_ipv6_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 7, name: "ttl_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 8, name: "idle_timeout_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 9, name: "expiry_action", kind: "enum", T: proto3.getEnumType(EnclaveExpiryAction), opt: true },
+    { no: 10, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 11, name: "from_template", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ],
 );
 
@@ -149,6 +240,19 @@ export const EnclaveInfo = /*@__PURE__*/ proto3.makeMessageType(
     { no: 7, name: "api_container_host_machine_info", kind: "message", T: EnclaveAPIContainerHostMachineInfo },
     { no: 8, name: "creation_time", kind: "message", T: Timestamp },
     { no: 9, name: "mode", kind: "enum", T: proto3.getEnumType(EnclaveMode) },
+    { no: 10, name: "expiry_time", kind: "message", T: Timestamp },
+    { no: 11, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 12, name: "api_container_access_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.GetEnclavesArgs
+ */
+export const GetEnclavesArgs = /*@__PURE__*/ proto3.makeMessageType(
+  "engine_api.GetEnclavesArgs",
+  () => [
+    { no: 1, name: "label_selector", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ],
 );
 
@@ -200,6 +304,45 @@ export const StopEnclaveArgs = /*@__PURE__*/ proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                       Start Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.StartEnclaveArgs
+ */
+export const StartEnclaveArgs = /*@__PURE__*/ proto3.makeMessageType(
+  "engine_api.StartEnclaveArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * ==============================================================================================
+ *                                       Extend Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.ExtendEnclaveArgs
+ */
+export const ExtendEnclaveArgs = /*@__PURE__*/ proto3.makeMessageType(
+  "engine_api.ExtendEnclaveArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "extension_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.ExtendEnclaveResponse
+ */
+export const ExtendEnclaveResponse = /*@__PURE__*/ proto3.makeMessageType(
+  "engine_api.ExtendEnclaveResponse",
+  () => [
+    { no: 1, name: "expiry_time", kind: "message", T: Timestamp },
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                       Destroy Enclave
@@ -225,6 +368,7 @@ export const CleanArgs = /*@__PURE__*/ proto3.makeMessageType(
   "engine_api.CleanArgs",
   () => [
     { no: 1, name: "should_clean_all", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 2, name: "label_selector", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ],
 );
 
@@ -301,3 +445,40 @@ export const LogLineFilter = /*@__PURE__*/ proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                        Engine Events
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.StreamEngineEventsArgs
+ */
+export const StreamEngineEventsArgs = /*@__PURE__*/ proto3.makeMessageType(
+  "engine_api.StreamEngineEventsArgs",
+  () => [
+    { no: 1, name: "event_types", kind: "enum", T: proto3.getEnumType(EngineEventType), repeated: true },
+    { no: 2, name: "enclave_identifiers", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "enclave_label_selector", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "only_failed_starlark_runs", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.EngineEvent
+ */
+export const EngineEvent = /*@__PURE__*/ proto3.makeMessageType(
+  "engine_api.EngineEvent",
+  () => [
+    { no: 1, name: "event_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(EngineEventType) },
+    { no: 3, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 4, name: "enclave_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "enclave_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "enclave_labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 7, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "files_artifact_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 9, name: "starlark_package_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 10, name: "is_starlark_run_successful", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 11, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
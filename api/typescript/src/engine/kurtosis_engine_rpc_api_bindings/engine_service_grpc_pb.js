@@ -61,6 +61,50 @@ function deserialize_engine_api_DestroyEnclaveArgs(buffer_arg) {
   return engine_service_pb.DestroyEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_EngineEvent(arg) {
+  if (!(arg instanceof engine_service_pb.EngineEvent)) {
+    throw new Error('Expected argument of type engine_api.EngineEvent');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_EngineEvent(buffer_arg) {
+  return engine_service_pb.EngineEvent.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_ExtendEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.ExtendEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.ExtendEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ExtendEnclaveArgs(buffer_arg) {
+  return engine_service_pb.ExtendEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_ExtendEnclaveResponse(arg) {
+  if (!(arg instanceof engine_service_pb.ExtendEnclaveResponse)) {
+    throw new Error('Expected argument of type engine_api.ExtendEnclaveResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_ExtendEnclaveResponse(buffer_arg) {
+  return engine_service_pb.ExtendEnclaveResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetEnclavesArgs(arg) {
+  if (!(arg instanceof engine_service_pb.GetEnclavesArgs)) {
+    throw new Error('Expected argument of type engine_api.GetEnclavesArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetEnclavesArgs(buffer_arg) {
+  return engine_service_pb.GetEnclavesArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_GetEnclavesResponse(arg) {
   if (!(arg instanceof engine_service_pb.GetEnclavesResponse)) {
     throw new Error('Expected argument of type engine_api.GetEnclavesResponse');
@@ -94,6 +138,17 @@ function deserialize_engine_api_GetExistingAndHistoricalEnclaveIdentifiersRespon
   return engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_GetResourceQuotaUsageResponse(arg) {
+  if (!(arg instanceof engine_service_pb.GetResourceQuotaUsageResponse)) {
+    throw new Error('Expected argument of type engine_api.GetResourceQuotaUsageResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetResourceQuotaUsageResponse(buffer_arg) {
+  return engine_service_pb.GetResourceQuotaUsageResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_GetServiceLogsArgs(arg) {
   if (!(arg instanceof engine_service_pb.GetServiceLogsArgs)) {
     throw new Error('Expected argument of type engine_api.GetServiceLogsArgs');
@@ -116,6 +171,17 @@ function deserialize_engine_api_GetServiceLogsResponse(buffer_arg) {
   return engine_service_pb.GetServiceLogsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_StartEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.StartEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.StartEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_StartEnclaveArgs(buffer_arg) {
+  return engine_service_pb.StartEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_StopEnclaveArgs(arg) {
   if (!(arg instanceof engine_service_pb.StopEnclaveArgs)) {
     throw new Error('Expected argument of type engine_api.StopEnclaveArgs');
@@ -127,6 +193,17 @@ function deserialize_engine_api_StopEnclaveArgs(buffer_arg) {
   return engine_service_pb.StopEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_StreamEngineEventsArgs(arg) {
+  if (!(arg instanceof engine_service_pb.StreamEngineEventsArgs)) {
+    throw new Error('Expected argument of type engine_api.StreamEngineEventsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_StreamEngineEventsArgs(buffer_arg) {
+  return engine_service_pb.StreamEngineEventsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_google_protobuf_Empty(arg) {
   if (!(arg instanceof google_protobuf_empty_pb.Empty)) {
     throw new Error('Expected argument of type google.protobuf.Empty');
@@ -152,6 +229,18 @@ getEngineInfo: {
     responseSerialize: serialize_engine_api_GetEngineInfoResponse,
     responseDeserialize: deserialize_engine_api_GetEngineInfoResponse,
   },
+  // Gets the resource quotas set on the engine, and how much of them the enclaves they apply to take
+getResourceQuotaUsage: {
+    path: '/engine_api.EngineService/GetResourceQuotaUsage',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: engine_service_pb.GetResourceQuotaUsageResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_engine_api_GetResourceQuotaUsageResponse,
+    responseDeserialize: deserialize_engine_api_GetResourceQuotaUsageResponse,
+  },
   // ==============================================================================================
 //                                   Enclave Management
 // ==============================================================================================
@@ -172,10 +261,10 @@ getEnclaves: {
     path: '/engine_api.EngineService/GetEnclaves',
     requestStream: false,
     responseStream: false,
-    requestType: google_protobuf_empty_pb.Empty,
+    requestType: engine_service_pb.GetEnclavesArgs,
     responseType: engine_service_pb.GetEnclavesResponse,
-    requestSerialize: serialize_google_protobuf_Empty,
-    requestDeserialize: deserialize_google_protobuf_Empty,
+    requestSerialize: serialize_engine_api_GetEnclavesArgs,
+    requestDeserialize: deserialize_engine_api_GetEnclavesArgs,
     responseSerialize: serialize_engine_api_GetEnclavesResponse,
     responseDeserialize: deserialize_engine_api_GetEnclavesResponse,
   },
@@ -203,6 +292,30 @@ stopEnclave: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Starts a stopped enclave again, with the user services which were running when it got stopped
+startEnclave: {
+    path: '/engine_api.EngineService/StartEnclave',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.StartEnclaveArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_engine_api_StartEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_StartEnclaveArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Pushes back the expiry of an enclave created with a TTL or an idle timeout
+extendEnclave: {
+    path: '/engine_api.EngineService/ExtendEnclave',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.ExtendEnclaveArgs,
+    responseType: engine_service_pb.ExtendEnclaveResponse,
+    requestSerialize: serialize_engine_api_ExtendEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_ExtendEnclaveArgs,
+    responseSerialize: serialize_engine_api_ExtendEnclaveResponse,
+    responseDeserialize: deserialize_engine_api_ExtendEnclaveResponse,
+  },
   // Destroys an enclave, removing all artifacts associated with it
 destroyEnclave: {
     path: '/engine_api.EngineService/DestroyEnclave',
@@ -239,6 +352,21 @@ getServiceLogs: {
     responseSerialize: serialize_engine_api_GetServiceLogsResponse,
     responseDeserialize: deserialize_engine_api_GetServiceLogsResponse,
   },
+  // ==============================================================================================
+//                                        Engine Events
+// ==============================================================================================
+// Streams the events matching the filter as they happen in the engine and its enclaves, until the client closes the stream
+streamEngineEvents: {
+    path: '/engine_api.EngineService/StreamEngineEvents',
+    requestStream: false,
+    responseStream: true,
+    requestType: engine_service_pb.StreamEngineEventsArgs,
+    responseType: engine_service_pb.EngineEvent,
+    requestSerialize: serialize_engine_api_StreamEngineEventsArgs,
+    requestDeserialize: deserialize_engine_api_StreamEngineEventsArgs,
+    responseSerialize: serialize_engine_api_EngineEvent,
+    responseDeserialize: deserialize_engine_api_EngineEvent,
+  },
 };
 
 exports.EngineServiceClient = grpc.makeGenericClientConstructor(EngineServiceService);
@@ -16,6 +16,13 @@ export class EngineServiceClient {
                response: engine_service_pb.GetEngineInfoResponse) => void
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetEngineInfoResponse>;
 
+  getResourceQuotaUsage(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.GetResourceQuotaUsageResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.GetResourceQuotaUsageResponse>;
+
   createEnclave(
     request: engine_service_pb.CreateEnclaveArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -24,7 +31,7 @@ export class EngineServiceClient {
   ): grpcWeb.ClientReadableStream<engine_service_pb.CreateEnclaveResponse>;
 
   getEnclaves(
-    request: google_protobuf_empty_pb.Empty,
+    request: engine_service_pb.GetEnclavesArgs,
     metadata: grpcWeb.Metadata | undefined,
     callback: (err: grpcWeb.RpcError,
                response: engine_service_pb.GetEnclavesResponse) => void
@@ -44,6 +51,20 @@ export class EngineServiceClient {
                response: google_protobuf_empty_pb.Empty) => void
   ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
 
+  startEnclave(
+    request: engine_service_pb.StartEnclaveArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  extendEnclave(
+    request: engine_service_pb.ExtendEnclaveArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.ExtendEnclaveResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.ExtendEnclaveResponse>;
+
   destroyEnclave(
     request: engine_service_pb.DestroyEnclaveArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -63,6 +84,11 @@ export class EngineServiceClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  streamEngineEvents(
+    request: engine_service_pb.StreamEngineEventsArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EngineEvent>;
+
 }
 
 export class EngineServicePromiseClient {
@@ -75,13 +101,18 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<engine_service_pb.GetEngineInfoResponse>;
 
+  getResourceQuotaUsage(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.GetResourceQuotaUsageResponse>;
+
   createEnclave(
     request: engine_service_pb.CreateEnclaveArgs,
     metadata?: grpcWeb.Metadata
   ): Promise<engine_service_pb.CreateEnclaveResponse>;
 
   getEnclaves(
-    request: google_protobuf_empty_pb.Empty,
+    request: engine_service_pb.GetEnclavesArgs,
     metadata?: grpcWeb.Metadata
   ): Promise<engine_service_pb.GetEnclavesResponse>;
 
@@ -95,6 +126,16 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<google_protobuf_empty_pb.Empty>;
 
+  startEnclave(
+    request: engine_service_pb.StartEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  extendEnclave(
+    request: engine_service_pb.ExtendEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.ExtendEnclaveResponse>;
+
   destroyEnclave(
     request: engine_service_pb.DestroyEnclaveArgs,
     metadata?: grpcWeb.Metadata
@@ -110,5 +151,10 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  streamEngineEvents(
+    request: engine_service_pb.StreamEngineEventsArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EngineEvent>;
+
 }
 
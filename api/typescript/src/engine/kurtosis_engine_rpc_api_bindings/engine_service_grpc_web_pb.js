@@ -139,6 +139,67 @@ proto.engine_api.EngineServicePromiseClient.prototype.getEngineInfo =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.engine_api.GetResourceQuotaUsageResponse>}
+ */
+const methodDescriptor_EngineService_GetResourceQuotaUsage = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetResourceQuotaUsage',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.engine_api.GetResourceQuotaUsageResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.GetResourceQuotaUsageResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.GetResourceQuotaUsageResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.GetResourceQuotaUsageResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getResourceQuotaUsage =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/GetResourceQuotaUsage',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetResourceQuotaUsage,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.GetResourceQuotaUsageResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getResourceQuotaUsage =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/GetResourceQuotaUsage',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetResourceQuotaUsage);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -203,16 +264,16 @@ proto.engine_api.EngineServicePromiseClient.prototype.createEnclave =
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.google.protobuf.Empty,
+ *   !proto.engine_api.GetEnclavesArgs,
  *   !proto.engine_api.GetEnclavesResponse>}
  */
 const methodDescriptor_EngineService_GetEnclaves = new grpc.web.MethodDescriptor(
   '/engine_api.EngineService/GetEnclaves',
   grpc.web.MethodType.UNARY,
-  google_protobuf_empty_pb.Empty,
+  proto.engine_api.GetEnclavesArgs,
   proto.engine_api.GetEnclavesResponse,
   /**
-   * @param {!proto.google.protobuf.Empty} request
+   * @param {!proto.engine_api.GetEnclavesArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
@@ -223,7 +284,7 @@ const methodDescriptor_EngineService_GetEnclaves = new grpc.web.MethodDescriptor
 
 
 /**
- * @param {!proto.google.protobuf.Empty} request The
+ * @param {!proto.engine_api.GetEnclavesArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
@@ -244,7 +305,7 @@ proto.engine_api.EngineServiceClient.prototype.getEnclaves =
 
 
 /**
- * @param {!proto.google.protobuf.Empty} request The
+ * @param {!proto.engine_api.GetEnclavesArgs} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
@@ -383,6 +444,128 @@ proto.engine_api.EngineServicePromiseClient.prototype.stopEnclave =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.StartEnclaveArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_EngineService_StartEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/StartEnclave',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.StartEnclaveArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.engine_api.StartEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.StartEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.startEnclave =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/StartEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_StartEnclave,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.StartEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.startEnclave =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/StartEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_StartEnclave);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.ExtendEnclaveArgs,
+ *   !proto.engine_api.ExtendEnclaveResponse>}
+ */
+const methodDescriptor_EngineService_ExtendEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/ExtendEnclave',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.ExtendEnclaveArgs,
+  proto.engine_api.ExtendEnclaveResponse,
+  /**
+   * @param {!proto.engine_api.ExtendEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.ExtendEnclaveResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.ExtendEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.ExtendEnclaveResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.ExtendEnclaveResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.extendEnclave =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/ExtendEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ExtendEnclave,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.ExtendEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.ExtendEnclaveResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.extendEnclave =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/ExtendEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_ExtendEnclave);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -561,5 +744,61 @@ proto.engine_api.EngineServicePromiseClient.prototype.getServiceLogs =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.StreamEngineEventsArgs,
+ *   !proto.engine_api.EngineEvent>}
+ */
+const methodDescriptor_EngineService_StreamEngineEvents = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/StreamEngineEvents',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.engine_api.StreamEngineEventsArgs,
+  proto.engine_api.EngineEvent,
+  /**
+   * @param {!proto.engine_api.StreamEngineEventsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.EngineEvent.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.StreamEngineEventsArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EngineEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.streamEngineEvents =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/StreamEngineEvents',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_StreamEngineEvents);
+};
+
+
+/**
+ * @param {!proto.engine_api.StreamEngineEventsArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EngineEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.streamEngineEvents =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/StreamEngineEvents',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_StreamEngineEvents);
+};
+
+
 module.exports = proto.engine_api;
 
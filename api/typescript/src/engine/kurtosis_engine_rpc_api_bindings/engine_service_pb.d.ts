@@ -22,6 +22,132 @@ export namespace GetEngineInfoResponse {
   }
 }
 
+export class GetResourceQuotaUsageResponse extends jspb.Message {
+  getResourceQuotaUsagesList(): Array<ResourceQuotaUsage>;
+  setResourceQuotaUsagesList(value: Array<ResourceQuotaUsage>): GetResourceQuotaUsageResponse;
+  clearResourceQuotaUsagesList(): GetResourceQuotaUsageResponse;
+  addResourceQuotaUsages(value?: ResourceQuotaUsage, index?: number): ResourceQuotaUsage;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetResourceQuotaUsageResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetResourceQuotaUsageResponse): GetResourceQuotaUsageResponse.AsObject;
+  static serializeBinaryToWriter(message: GetResourceQuotaUsageResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetResourceQuotaUsageResponse;
+  static deserializeBinaryFromReader(message: GetResourceQuotaUsageResponse, reader: jspb.BinaryReader): GetResourceQuotaUsageResponse;
+}
+
+export namespace GetResourceQuotaUsageResponse {
+  export type AsObject = {
+    resourceQuotaUsagesList: Array<ResourceQuotaUsage.AsObject>,
+  }
+}
+
+export class ResourceQuotaUsage extends jspb.Message {
+  getName(): string;
+  setName(value: string): ResourceQuotaUsage;
+
+  getUser(): string;
+  setUser(value: string): ResourceQuotaUsage;
+
+  getLabelSelector(): string;
+  setLabelSelector(value: string): ResourceQuotaUsage;
+
+  getMaxEnclaves(): number;
+  setMaxEnclaves(value: number): ResourceQuotaUsage;
+
+  getMaxServicesPerEnclave(): number;
+  setMaxServicesPerEnclave(value: number): ResourceQuotaUsage;
+
+  getMaxCpuMillicoresPerEnclave(): number;
+  setMaxCpuMillicoresPerEnclave(value: number): ResourceQuotaUsage;
+
+  getMaxMemoryMegabytesPerEnclave(): number;
+  setMaxMemoryMegabytesPerEnclave(value: number): ResourceQuotaUsage;
+
+  getMaxFilesArtifactsMegabytesPerEnclave(): number;
+  setMaxFilesArtifactsMegabytesPerEnclave(value: number): ResourceQuotaUsage;
+
+  getEnclaveUsagesList(): Array<EnclaveResourceUsage>;
+  setEnclaveUsagesList(value: Array<EnclaveResourceUsage>): ResourceQuotaUsage;
+  clearEnclaveUsagesList(): ResourceQuotaUsage;
+  addEnclaveUsages(value?: EnclaveResourceUsage, index?: number): EnclaveResourceUsage;
+
+  getMaxServices(): number;
+  setMaxServices(value: number): ResourceQuotaUsage;
+
+  getMaxCpuMillicores(): number;
+  setMaxCpuMillicores(value: number): ResourceQuotaUsage;
+
+  getMaxMemoryMegabytes(): number;
+  setMaxMemoryMegabytes(value: number): ResourceQuotaUsage;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResourceQuotaUsage.AsObject;
+  static toObject(includeInstance: boolean, msg: ResourceQuotaUsage): ResourceQuotaUsage.AsObject;
+  static serializeBinaryToWriter(message: ResourceQuotaUsage, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResourceQuotaUsage;
+  static deserializeBinaryFromReader(message: ResourceQuotaUsage, reader: jspb.BinaryReader): ResourceQuotaUsage;
+}
+
+export namespace ResourceQuotaUsage {
+  export type AsObject = {
+    name: string,
+    user: string,
+    labelSelector: string,
+    maxEnclaves: number,
+    maxServicesPerEnclave: number,
+    maxCpuMillicoresPerEnclave: number,
+    maxMemoryMegabytesPerEnclave: number,
+    maxFilesArtifactsMegabytesPerEnclave: number,
+    enclaveUsagesList: Array<EnclaveResourceUsage.AsObject>,
+    maxServices: number,
+    maxCpuMillicores: number,
+    maxMemoryMegabytes: number,
+  }
+}
+
+export class EnclaveResourceUsage extends jspb.Message {
+  getEnclaveUuid(): string;
+  setEnclaveUuid(value: string): EnclaveResourceUsage;
+
+  getEnclaveName(): string;
+  setEnclaveName(value: string): EnclaveResourceUsage;
+
+  getIsUsageKnown(): boolean;
+  setIsUsageKnown(value: boolean): EnclaveResourceUsage;
+
+  getServiceCount(): number;
+  setServiceCount(value: number): EnclaveResourceUsage;
+
+  getReservedCpuMillicores(): number;
+  setReservedCpuMillicores(value: number): EnclaveResourceUsage;
+
+  getReservedMemoryMegabytes(): number;
+  setReservedMemoryMegabytes(value: number): EnclaveResourceUsage;
+
+  getFilesArtifactsStorageBytes(): number;
+  setFilesArtifactsStorageBytes(value: number): EnclaveResourceUsage;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveResourceUsage.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveResourceUsage): EnclaveResourceUsage.AsObject;
+  static serializeBinaryToWriter(message: EnclaveResourceUsage, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveResourceUsage;
+  static deserializeBinaryFromReader(message: EnclaveResourceUsage, reader: jspb.BinaryReader): EnclaveResourceUsage;
+}
+
+export namespace EnclaveResourceUsage {
+  export type AsObject = {
+    enclaveUuid: string,
+    enclaveName: string,
+    isUsageKnown: boolean,
+    serviceCount: number,
+    reservedCpuMillicores: number,
+    reservedMemoryMegabytes: number,
+    filesArtifactsStorageBytes: number,
+  }
+}
+
 export class CreateEnclaveArgs extends jspb.Message {
   getEnclaveName(): string;
   setEnclaveName(value: string): CreateEnclaveArgs;
@@ -48,6 +174,34 @@ export class CreateEnclaveArgs extends jspb.Message {
   hasShouldApicRunInDebugMode(): boolean;
   clearShouldApicRunInDebugMode(): CreateEnclaveArgs;
 
+  getIsIpv6Enabled(): boolean;
+  setIsIpv6Enabled(value: boolean): CreateEnclaveArgs;
+  hasIsIpv6Enabled(): boolean;
+  clearIsIpv6Enabled(): CreateEnclaveArgs;
+
+  getTtlSeconds(): number;
+  setTtlSeconds(value: number): CreateEnclaveArgs;
+  hasTtlSeconds(): boolean;
+  clearTtlSeconds(): CreateEnclaveArgs;
+
+  getIdleTimeoutSeconds(): number;
+  setIdleTimeoutSeconds(value: number): CreateEnclaveArgs;
+  hasIdleTimeoutSeconds(): boolean;
+  clearIdleTimeoutSeconds(): CreateEnclaveArgs;
+
+  getExpiryAction(): EnclaveExpiryAction;
+  setExpiryAction(value: EnclaveExpiryAction): CreateEnclaveArgs;
+  hasExpiryAction(): boolean;
+  clearExpiryAction(): CreateEnclaveArgs;
+
+  getLabelsMap(): jspb.Map<string, string>;
+  clearLabelsMap(): CreateEnclaveArgs;
+
+  getFromTemplate(): string;
+  setFromTemplate(value: string): CreateEnclaveArgs;
+  hasFromTemplate(): boolean;
+  clearFromTemplate(): CreateEnclaveArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): CreateEnclaveArgs.AsObject;
   static toObject(includeInstance: boolean, msg: CreateEnclaveArgs): CreateEnclaveArgs.AsObject;
@@ -63,6 +217,12 @@ export namespace CreateEnclaveArgs {
     apiContainerLogLevel?: string,
     mode?: EnclaveMode,
     shouldApicRunInDebugMode?: boolean,
+    isIpv6Enabled?: boolean,
+    ttlSeconds?: number,
+    idleTimeoutSeconds?: number,
+    expiryAction?: EnclaveExpiryAction,
+    labelsMap: Array<[string, string]>,
+    fromTemplate?: string,
   }
 
   export enum EnclaveNameCase { 
@@ -89,6 +249,31 @@ export namespace CreateEnclaveArgs {
     _SHOULD_APIC_RUN_IN_DEBUG_MODE_NOT_SET = 0,
     SHOULD_APIC_RUN_IN_DEBUG_MODE = 5,
   }
+
+  export enum IsIpv6EnabledCase { 
+    _IS_IPV6_ENABLED_NOT_SET = 0,
+    IS_IPV6_ENABLED = 6,
+  }
+
+  export enum TtlSecondsCase { 
+    _TTL_SECONDS_NOT_SET = 0,
+    TTL_SECONDS = 7,
+  }
+
+  export enum IdleTimeoutSecondsCase { 
+    _IDLE_TIMEOUT_SECONDS_NOT_SET = 0,
+    IDLE_TIMEOUT_SECONDS = 8,
+  }
+
+  export enum ExpiryActionCase { 
+    _EXPIRY_ACTION_NOT_SET = 0,
+    EXPIRY_ACTION = 9,
+  }
+
+  export enum FromTemplateCase { 
+    _FROM_TEMPLATE_NOT_SET = 0,
+    FROM_TEMPLATE = 11,
+  }
 }
 
 export class CreateEnclaveResponse extends jspb.Message {
@@ -197,6 +382,17 @@ export class EnclaveInfo extends jspb.Message {
   getMode(): EnclaveMode;
   setMode(value: EnclaveMode): EnclaveInfo;
 
+  getExpiryTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setExpiryTime(value?: google_protobuf_timestamp_pb.Timestamp): EnclaveInfo;
+  hasExpiryTime(): boolean;
+  clearExpiryTime(): EnclaveInfo;
+
+  getLabelsMap(): jspb.Map<string, string>;
+  clearLabelsMap(): EnclaveInfo;
+
+  getApiContainerAccessToken(): string;
+  setApiContainerAccessToken(value: string): EnclaveInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): EnclaveInfo.AsObject;
   static toObject(includeInstance: boolean, msg: EnclaveInfo): EnclaveInfo.AsObject;
@@ -216,6 +412,34 @@ export namespace EnclaveInfo {
     apiContainerHostMachineInfo?: EnclaveAPIContainerHostMachineInfo.AsObject,
     creationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     mode: EnclaveMode,
+    expiryTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    labelsMap: Array<[string, string]>,
+    apiContainerAccessToken: string,
+  }
+}
+
+export class GetEnclavesArgs extends jspb.Message {
+  getLabelSelector(): string;
+  setLabelSelector(value: string): GetEnclavesArgs;
+  hasLabelSelector(): boolean;
+  clearLabelSelector(): GetEnclavesArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclavesArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclavesArgs): GetEnclavesArgs.AsObject;
+  static serializeBinaryToWriter(message: GetEnclavesArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclavesArgs;
+  static deserializeBinaryFromReader(message: GetEnclavesArgs, reader: jspb.BinaryReader): GetEnclavesArgs;
+}
+
+export namespace GetEnclavesArgs {
+  export type AsObject = {
+    labelSelector?: string,
+  }
+
+  export enum LabelSelectorCase { 
+    _LABEL_SELECTOR_NOT_SET = 0,
+    LABEL_SELECTOR = 1,
   }
 }
 
@@ -301,6 +525,66 @@ export namespace StopEnclaveArgs {
   }
 }
 
+export class StartEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): StartEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StartEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: StartEnclaveArgs): StartEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: StartEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StartEnclaveArgs;
+  static deserializeBinaryFromReader(message: StartEnclaveArgs, reader: jspb.BinaryReader): StartEnclaveArgs;
+}
+
+export namespace StartEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+  }
+}
+
+export class ExtendEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): ExtendEnclaveArgs;
+
+  getExtensionSeconds(): number;
+  setExtensionSeconds(value: number): ExtendEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExtendEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: ExtendEnclaveArgs): ExtendEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: ExtendEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExtendEnclaveArgs;
+  static deserializeBinaryFromReader(message: ExtendEnclaveArgs, reader: jspb.BinaryReader): ExtendEnclaveArgs;
+}
+
+export namespace ExtendEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+    extensionSeconds: number,
+  }
+}
+
+export class ExtendEnclaveResponse extends jspb.Message {
+  getExpiryTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setExpiryTime(value?: google_protobuf_timestamp_pb.Timestamp): ExtendEnclaveResponse;
+  hasExpiryTime(): boolean;
+  clearExpiryTime(): ExtendEnclaveResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExtendEnclaveResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ExtendEnclaveResponse): ExtendEnclaveResponse.AsObject;
+  static serializeBinaryToWriter(message: ExtendEnclaveResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExtendEnclaveResponse;
+  static deserializeBinaryFromReader(message: ExtendEnclaveResponse, reader: jspb.BinaryReader): ExtendEnclaveResponse;
+}
+
+export namespace ExtendEnclaveResponse {
+  export type AsObject = {
+    expiryTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+}
+
 export class DestroyEnclaveArgs extends jspb.Message {
   getEnclaveIdentifier(): string;
   setEnclaveIdentifier(value: string): DestroyEnclaveArgs;
@@ -325,6 +609,11 @@ export class CleanArgs extends jspb.Message {
   hasShouldCleanAll(): boolean;
   clearShouldCleanAll(): CleanArgs;
 
+  getLabelSelector(): string;
+  setLabelSelector(value: string): CleanArgs;
+  hasLabelSelector(): boolean;
+  clearLabelSelector(): CleanArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): CleanArgs.AsObject;
   static toObject(includeInstance: boolean, msg: CleanArgs): CleanArgs.AsObject;
@@ -336,12 +625,18 @@ export class CleanArgs extends jspb.Message {
 export namespace CleanArgs {
   export type AsObject = {
     shouldCleanAll?: boolean,
+    labelSelector?: string,
   }
 
   export enum ShouldCleanAllCase { 
     _SHOULD_CLEAN_ALL_NOT_SET = 0,
     SHOULD_CLEAN_ALL = 1,
   }
+
+  export enum LabelSelectorCase { 
+    _LABEL_SELECTOR_NOT_SET = 0,
+    LABEL_SELECTOR = 2,
+  }
 }
 
 export class EnclaveNameAndUuid extends jspb.Message {
@@ -517,10 +812,143 @@ export namespace LogLineFilter {
   }
 }
 
+export class StreamEngineEventsArgs extends jspb.Message {
+  getEventTypesList(): Array<EngineEventType>;
+  setEventTypesList(value: Array<EngineEventType>): StreamEngineEventsArgs;
+  clearEventTypesList(): StreamEngineEventsArgs;
+  addEventTypes(value: EngineEventType, index?: number): StreamEngineEventsArgs;
+
+  getEnclaveIdentifiersList(): Array<string>;
+  setEnclaveIdentifiersList(value: Array<string>): StreamEngineEventsArgs;
+  clearEnclaveIdentifiersList(): StreamEngineEventsArgs;
+  addEnclaveIdentifiers(value: string, index?: number): StreamEngineEventsArgs;
+
+  getEnclaveLabelSelector(): string;
+  setEnclaveLabelSelector(value: string): StreamEngineEventsArgs;
+  hasEnclaveLabelSelector(): boolean;
+  clearEnclaveLabelSelector(): StreamEngineEventsArgs;
+
+  getOnlyFailedStarlarkRuns(): boolean;
+  setOnlyFailedStarlarkRuns(value: boolean): StreamEngineEventsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StreamEngineEventsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: StreamEngineEventsArgs): StreamEngineEventsArgs.AsObject;
+  static serializeBinaryToWriter(message: StreamEngineEventsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StreamEngineEventsArgs;
+  static deserializeBinaryFromReader(message: StreamEngineEventsArgs, reader: jspb.BinaryReader): StreamEngineEventsArgs;
+}
+
+export namespace StreamEngineEventsArgs {
+  export type AsObject = {
+    eventTypesList: Array<EngineEventType>,
+    enclaveIdentifiersList: Array<string>,
+    enclaveLabelSelector?: string,
+    onlyFailedStarlarkRuns: boolean,
+  }
+
+  export enum EnclaveLabelSelectorCase { 
+    _ENCLAVE_LABEL_SELECTOR_NOT_SET = 0,
+    ENCLAVE_LABEL_SELECTOR = 3,
+  }
+}
+
+export class EngineEvent extends jspb.Message {
+  getEventUuid(): string;
+  setEventUuid(value: string): EngineEvent;
+
+  getType(): EngineEventType;
+  setType(value: EngineEventType): EngineEvent;
+
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): EngineEvent;
+  hasTimestamp(): boolean;
+  clearTimestamp(): EngineEvent;
+
+  getEnclaveUuid(): string;
+  setEnclaveUuid(value: string): EngineEvent;
+
+  getEnclaveName(): string;
+  setEnclaveName(value: string): EngineEvent;
+
+  getEnclaveLabelsMap(): jspb.Map<string, string>;
+  clearEnclaveLabelsMap(): EngineEvent;
+
+  getServiceName(): string;
+  setServiceName(value: string): EngineEvent;
+  hasServiceName(): boolean;
+  clearServiceName(): EngineEvent;
+
+  getFilesArtifactName(): string;
+  setFilesArtifactName(value: string): EngineEvent;
+  hasFilesArtifactName(): boolean;
+  clearFilesArtifactName(): EngineEvent;
+
+  getStarlarkPackageId(): string;
+  setStarlarkPackageId(value: string): EngineEvent;
+  hasStarlarkPackageId(): boolean;
+  clearStarlarkPackageId(): EngineEvent;
+
+  getIsStarlarkRunSuccessful(): boolean;
+  setIsStarlarkRunSuccessful(value: boolean): EngineEvent;
+  hasIsStarlarkRunSuccessful(): boolean;
+  clearIsStarlarkRunSuccessful(): EngineEvent;
+
+  getMessage(): string;
+  setMessage(value: string): EngineEvent;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EngineEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: EngineEvent): EngineEvent.AsObject;
+  static serializeBinaryToWriter(message: EngineEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EngineEvent;
+  static deserializeBinaryFromReader(message: EngineEvent, reader: jspb.BinaryReader): EngineEvent;
+}
+
+export namespace EngineEvent {
+  export type AsObject = {
+    eventUuid: string,
+    type: EngineEventType,
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    enclaveUuid: string,
+    enclaveName: string,
+    enclaveLabelsMap: Array<[string, string]>,
+    serviceName?: string,
+    filesArtifactName?: string,
+    starlarkPackageId?: string,
+    isStarlarkRunSuccessful?: boolean,
+    message: string,
+  }
+
+  export enum ServiceNameCase { 
+    _SERVICE_NAME_NOT_SET = 0,
+    SERVICE_NAME = 7,
+  }
+
+  export enum FilesArtifactNameCase { 
+    _FILES_ARTIFACT_NAME_NOT_SET = 0,
+    FILES_ARTIFACT_NAME = 8,
+  }
+
+  export enum StarlarkPackageIdCase { 
+    _STARLARK_PACKAGE_ID_NOT_SET = 0,
+    STARLARK_PACKAGE_ID = 9,
+  }
+
+  export enum IsStarlarkRunSuccessfulCase { 
+    _IS_STARLARK_RUN_SUCCESSFUL_NOT_SET = 0,
+    IS_STARLARK_RUN_SUCCESSFUL = 10,
+  }
+}
+
 export enum EnclaveMode { 
   TEST = 0,
   PRODUCTION = 1,
 }
+export enum EnclaveExpiryAction { 
+  ENCLAVEEXPIRYACTION_DESTROY = 0,
+  ENCLAVEEXPIRYACTION_STOP = 1,
+}
 export enum EnclaveContainersStatus { 
   ENCLAVECONTAINERSSTATUS_EMPTY = 0,
   ENCLAVECONTAINERSSTATUS_RUNNING = 1,
@@ -537,3 +965,15 @@ export enum LogLineOperator {
   LOGLINEOPERATOR_DOES_CONTAIN_MATCH_REGEX = 2,
   LOGLINEOPERATOR_DOES_NOT_CONTAIN_MATCH_REGEX = 3,
 }
+export enum EngineEventType { 
+  ENGINEEVENTTYPE_ENCLAVE_CREATED = 0,
+  ENGINEEVENTTYPE_ENCLAVE_STARTED = 1,
+  ENGINEEVENTTYPE_ENCLAVE_STOPPED = 2,
+  ENGINEEVENTTYPE_ENCLAVE_DESTROYED = 3,
+  ENGINEEVENTTYPE_STARLARK_RUN_STARTED = 4,
+  ENGINEEVENTTYPE_STARLARK_RUN_FINISHED = 5,
+  ENGINEEVENTTYPE_SERVICE_STARTED = 6,
+  ENGINEEVENTTYPE_SERVICE_STOPPED = 7,
+  ENGINEEVENTTYPE_SERVICE_CRASHED = 8,
+  ENGINEEVENTTYPE_FILES_ARTIFACT_CREATED = 9,
+}
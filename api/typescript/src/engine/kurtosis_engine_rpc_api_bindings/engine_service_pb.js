@@ -34,19 +34,30 @@ goog.exportSymbol('proto.engine_api.EnclaveAPIContainerHostMachineInfo', null, g
 goog.exportSymbol('proto.engine_api.EnclaveAPIContainerInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveAPIContainerStatus', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveContainersStatus', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveExpiryAction', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveIdentifiers', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveMode', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveNameAndUuid', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveResourceUsage', null, global);
+goog.exportSymbol('proto.engine_api.EngineEvent', null, global);
+goog.exportSymbol('proto.engine_api.EngineEventType', null, global);
+goog.exportSymbol('proto.engine_api.ExtendEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.ExtendEnclaveResponse', null, global);
+goog.exportSymbol('proto.engine_api.GetEnclavesArgs', null, global);
 goog.exportSymbol('proto.engine_api.GetEnclavesResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetEngineInfoResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse', null, global);
+goog.exportSymbol('proto.engine_api.GetResourceQuotaUsageResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsArgs', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsResponse', null, global);
 goog.exportSymbol('proto.engine_api.LogLine', null, global);
 goog.exportSymbol('proto.engine_api.LogLineFilter', null, global);
 goog.exportSymbol('proto.engine_api.LogLineOperator', null, global);
+goog.exportSymbol('proto.engine_api.ResourceQuotaUsage', null, global);
+goog.exportSymbol('proto.engine_api.StartEnclaveArgs', null, global);
 goog.exportSymbol('proto.engine_api.StopEnclaveArgs', null, global);
+goog.exportSymbol('proto.engine_api.StreamEngineEventsArgs', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -68,6 +79,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.GetEngineInfoResponse.displayName = 'proto.engine_api.GetEngineInfoResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetResourceQuotaUsageResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.GetResourceQuotaUsageResponse.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.GetResourceQuotaUsageResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetResourceQuotaUsageResponse.displayName = 'proto.engine_api.GetResourceQuotaUsageResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ResourceQuotaUsage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.ResourceQuotaUsage.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.ResourceQuotaUsage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ResourceQuotaUsage.displayName = 'proto.engine_api.ResourceQuotaUsage';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.EnclaveResourceUsage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.EnclaveResourceUsage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclaveResourceUsage.displayName = 'proto.engine_api.EnclaveResourceUsage';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -173,6 +247,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.EnclaveInfo.displayName = 'proto.engine_api.EnclaveInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetEnclavesArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetEnclavesArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetEnclavesArgs.displayName = 'proto.engine_api.GetEnclavesArgs';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -257,6 +352,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.StopEnclaveArgs.displayName = 'proto.engine_api.StopEnclaveArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.StartEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.StartEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.StartEnclaveArgs.displayName = 'proto.engine_api.StartEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ExtendEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.ExtendEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ExtendEnclaveArgs.displayName = 'proto.engine_api.ExtendEnclaveArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.ExtendEnclaveResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.ExtendEnclaveResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.ExtendEnclaveResponse.displayName = 'proto.engine_api.ExtendEnclaveResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -425,6 +583,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.LogLineFilter.displayName = 'proto.engine_api.LogLineFilter';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.StreamEngineEventsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.StreamEngineEventsArgs.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.StreamEngineEventsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.StreamEngineEventsArgs.displayName = 'proto.engine_api.StreamEngineEventsArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.EngineEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.EngineEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EngineEvent.displayName = 'proto.engine_api.EngineEvent';
+}
 
 
 
@@ -556,6 +756,13 @@ proto.engine_api.GetEngineInfoResponse.prototype.setEngineVersion = function(val
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetResourceQuotaUsageResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -571,8 +778,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CreateEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.GetResourceQuotaUsageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetResourceQuotaUsageResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -581,17 +788,14 @@ proto.engine_api.CreateEnclaveArgs.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CreateEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetResourceQuotaUsageResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.GetResourceQuotaUsageResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    mode: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    shouldApicRunInDebugMode: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+    resourceQuotaUsagesList: jspb.Message.toObjectList(msg.getResourceQuotaUsagesList(),
+    proto.engine_api.ResourceQuotaUsage.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -605,23 +809,23 @@ proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CreateEnclaveArgs}
+ * @return {!proto.engine_api.GetResourceQuotaUsageResponse}
  */
-proto.engine_api.CreateEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.GetResourceQuotaUsageResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CreateEnclaveArgs;
-  return proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetResourceQuotaUsageResponse;
+  return proto.engine_api.GetResourceQuotaUsageResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CreateEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetResourceQuotaUsageResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CreateEnclaveArgs}
+ * @return {!proto.engine_api.GetResourceQuotaUsageResponse}
  */
-proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetResourceQuotaUsageResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -629,24 +833,9 @@ proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, r
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApiContainerVersionTag(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApiContainerLogLevel(value);
-      break;
-    case 4:
-      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
-      msg.setMode(value);
-      break;
-    case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setShouldApicRunInDebugMode(value);
+      var value = new proto.engine_api.ResourceQuotaUsage;
+      reader.readMessage(value,proto.engine_api.ResourceQuotaUsage.deserializeBinaryFromReader);
+      msg.addResourceQuotaUsages(value);
       break;
     default:
       reader.skipField();
@@ -661,9 +850,9 @@ proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.serializeBinary = function() {
+proto.engine_api.GetResourceQuotaUsageResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetResourceQuotaUsageResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -671,230 +860,68 @@ proto.engine_api.CreateEnclaveArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CreateEnclaveArgs} message
+ * @param {!proto.engine_api.GetResourceQuotaUsageResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetResourceQuotaUsageResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = /** @type {string} */ (jspb.Message.getField(message, 1));
-  if (f != null) {
-    writer.writeString(
+  f = message.getResourceQuotaUsagesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       1,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeEnum(
-      4,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
-  if (f != null) {
-    writer.writeBool(
-      5,
-      f
+      f,
+      proto.engine_api.ResourceQuotaUsage.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string enclave_name = 1;
- * @return {string}
+ * repeated ResourceQuotaUsage resource_quota_usages = 1;
+ * @return {!Array<!proto.engine_api.ResourceQuotaUsage>}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getEnclaveName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.GetResourceQuotaUsageResponse.prototype.getResourceQuotaUsagesList = function() {
+  return /** @type{!Array<!proto.engine_api.ResourceQuotaUsage>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.ResourceQuotaUsage, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.setEnclaveName = function(value) {
-  return jspb.Message.setField(this, 1, value);
+ * @param {!Array<!proto.engine_api.ResourceQuotaUsage>} value
+ * @return {!proto.engine_api.GetResourceQuotaUsageResponse} returns this
+*/
+proto.engine_api.GetResourceQuotaUsageResponse.prototype.setResourceQuotaUsagesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * @param {!proto.engine_api.ResourceQuotaUsage=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.ResourceQuotaUsage}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.clearEnclaveName = function() {
-  return jspb.Message.setField(this, 1, undefined);
+proto.engine_api.GetResourceQuotaUsageResponse.prototype.addResourceQuotaUsages = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.ResourceQuotaUsage, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetResourceQuotaUsageResponse} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.hasEnclaveName = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-/**
- * optional string api_container_version_tag = 2;
- * @return {string}
- */
-proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerVersionTag = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerVersionTag = function(value) {
-  return jspb.Message.setField(this, 2, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.clearApiContainerVersionTag = function() {
-  return jspb.Message.setField(this, 2, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.engine_api.CreateEnclaveArgs.prototype.hasApiContainerVersionTag = function() {
-  return jspb.Message.getField(this, 2) != null;
-};
-
-
-/**
- * optional string api_container_log_level = 3;
- * @return {string}
- */
-proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerLogLevel = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerLogLevel = function(value) {
-  return jspb.Message.setField(this, 3, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.clearApiContainerLogLevel = function() {
-  return jspb.Message.setField(this, 3, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.engine_api.CreateEnclaveArgs.prototype.hasApiContainerLogLevel = function() {
-  return jspb.Message.getField(this, 3) != null;
-};
-
-
-/**
- * optional EnclaveMode mode = 4;
- * @return {!proto.engine_api.EnclaveMode}
- */
-proto.engine_api.CreateEnclaveArgs.prototype.getMode = function() {
-  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
-};
-
-
-/**
- * @param {!proto.engine_api.EnclaveMode} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.setMode = function(value) {
-  return jspb.Message.setField(this, 4, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.clearMode = function() {
-  return jspb.Message.setField(this, 4, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.engine_api.CreateEnclaveArgs.prototype.hasMode = function() {
-  return jspb.Message.getField(this, 4) != null;
-};
-
-
-/**
- * optional bool should_apic_run_in_debug_mode = 5;
- * @return {boolean}
- */
-proto.engine_api.CreateEnclaveArgs.prototype.getShouldApicRunInDebugMode = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.setShouldApicRunInDebugMode = function(value) {
-  return jspb.Message.setField(this, 5, value);
+proto.engine_api.GetResourceQuotaUsageResponse.prototype.clearResourceQuotaUsagesList = function() {
+  return this.setResourceQuotaUsagesList([]);
 };
 
 
-/**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
- */
-proto.engine_api.CreateEnclaveArgs.prototype.clearShouldApicRunInDebugMode = function() {
-  return jspb.Message.setField(this, 5, undefined);
-};
-
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.engine_api.CreateEnclaveArgs.prototype.hasShouldApicRunInDebugMode = function() {
-  return jspb.Message.getField(this, 5) != null;
-};
-
-
+proto.engine_api.ResourceQuotaUsage.repeatedFields_ = [9];
 
 
 
@@ -911,8 +938,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
+proto.engine_api.ResourceQuotaUsage.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ResourceQuotaUsage.toObject(opt_includeInstance, this);
 };
 
 
@@ -921,13 +948,25 @@ proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CreateEnclaveResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.ResourceQuotaUsage} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.ResourceQuotaUsage.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    user: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    labelSelector: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    maxEnclaves: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    maxServicesPerEnclave: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    maxCpuMillicoresPerEnclave: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    maxMemoryMegabytesPerEnclave: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    maxFilesArtifactsMegabytesPerEnclave: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    enclaveUsagesList: jspb.Message.toObjectList(msg.getEnclaveUsagesList(),
+    proto.engine_api.EnclaveResourceUsage.toObject, includeInstance),
+    maxServices: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    maxCpuMillicores: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    maxMemoryMegabytes: jspb.Message.getFieldWithDefault(msg, 12, 0)
   };
 
   if (includeInstance) {
@@ -941,23 +980,23 @@ proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CreateEnclaveResponse}
+ * @return {!proto.engine_api.ResourceQuotaUsage}
  */
-proto.engine_api.CreateEnclaveResponse.deserializeBinary = function(bytes) {
+proto.engine_api.ResourceQuotaUsage.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CreateEnclaveResponse;
-  return proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.ResourceQuotaUsage;
+  return proto.engine_api.ResourceQuotaUsage.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CreateEnclaveResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.ResourceQuotaUsage} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CreateEnclaveResponse}
+ * @return {!proto.engine_api.ResourceQuotaUsage}
  */
-proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.ResourceQuotaUsage.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -965,9 +1004,53 @@ proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveInfo;
-      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
-      msg.setEnclaveInfo(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUser(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLabelSelector(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxEnclaves(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxServicesPerEnclave(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMaxCpuMillicoresPerEnclave(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMaxMemoryMegabytesPerEnclave(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMaxFilesArtifactsMegabytesPerEnclave(value);
+      break;
+    case 9:
+      var value = new proto.engine_api.EnclaveResourceUsage;
+      reader.readMessage(value,proto.engine_api.EnclaveResourceUsage.deserializeBinaryFromReader);
+      msg.addEnclaveUsages(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxServices(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMaxCpuMillicores(value);
+      break;
+    case 12:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMaxMemoryMegabytes(value);
       break;
     default:
       reader.skipField();
@@ -982,9 +1065,9 @@ proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
+proto.engine_api.ResourceQuotaUsage.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.ResourceQuotaUsage.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -992,57 +1075,333 @@ proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CreateEnclaveResponse} message
+ * @param {!proto.engine_api.ResourceQuotaUsage} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.ResourceQuotaUsage.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveInfo();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
+      f
+    );
+  }
+  f = message.getUser();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getLabelSelector();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getMaxEnclaves();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getMaxServicesPerEnclave();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getMaxCpuMillicoresPerEnclave();
+  if (f !== 0) {
+    writer.writeUint64(
+      6,
+      f
+    );
+  }
+  f = message.getMaxMemoryMegabytesPerEnclave();
+  if (f !== 0) {
+    writer.writeUint64(
+      7,
+      f
+    );
+  }
+  f = message.getMaxFilesArtifactsMegabytesPerEnclave();
+  if (f !== 0) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+  f = message.getEnclaveUsagesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
       f,
-      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
+      proto.engine_api.EnclaveResourceUsage.serializeBinaryToWriter
     );
   }
-};
-
-
-/**
- * optional EnclaveInfo enclave_info = 1;
- * @return {?proto.engine_api.EnclaveInfo}
- */
-proto.engine_api.CreateEnclaveResponse.prototype.getEnclaveInfo = function() {
-  return /** @type{?proto.engine_api.EnclaveInfo} */ (
-    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveInfo, 1));
-};
-
+  f = message.getMaxServices();
+  if (f !== 0) {
+    writer.writeUint32(
+      10,
+      f
+    );
+  }
+  f = message.getMaxCpuMillicores();
+  if (f !== 0) {
+    writer.writeUint64(
+      11,
+      f
+    );
+  }
+  f = message.getMaxMemoryMegabytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      12,
+      f
+    );
+  }
+};
+
 
 /**
- * @param {?proto.engine_api.EnclaveInfo|undefined} value
- * @return {!proto.engine_api.CreateEnclaveResponse} returns this
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string user = 2;
+ * @return {string}
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.getUser = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.setUser = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string label_selector = 3;
+ * @return {string}
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.getLabelSelector = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.setLabelSelector = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 max_enclaves = 4;
+ * @return {number}
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.getMaxEnclaves = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.setMaxEnclaves = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 max_services_per_enclave = 5;
+ * @return {number}
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.getMaxServicesPerEnclave = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.setMaxServicesPerEnclave = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional uint64 max_cpu_millicores_per_enclave = 6;
+ * @return {number}
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.getMaxCpuMillicoresPerEnclave = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.setMaxCpuMillicoresPerEnclave = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional uint64 max_memory_megabytes_per_enclave = 7;
+ * @return {number}
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.getMaxMemoryMegabytesPerEnclave = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.setMaxMemoryMegabytesPerEnclave = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional uint64 max_files_artifacts_megabytes_per_enclave = 8;
+ * @return {number}
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.getMaxFilesArtifactsMegabytesPerEnclave = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.setMaxFilesArtifactsMegabytesPerEnclave = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * repeated EnclaveResourceUsage enclave_usages = 9;
+ * @return {!Array<!proto.engine_api.EnclaveResourceUsage>}
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.getEnclaveUsagesList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveResourceUsage>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveResourceUsage, 9));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.EnclaveResourceUsage>} value
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
 */
-proto.engine_api.CreateEnclaveResponse.prototype.setEnclaveInfo = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+proto.engine_api.ResourceQuotaUsage.prototype.setEnclaveUsagesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.CreateEnclaveResponse} returns this
+ * @param {!proto.engine_api.EnclaveResourceUsage=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveResourceUsage}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.clearEnclaveInfo = function() {
-  return this.setEnclaveInfo(undefined);
+proto.engine_api.ResourceQuotaUsage.prototype.addEnclaveUsages = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.engine_api.EnclaveResourceUsage, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
  */
-proto.engine_api.CreateEnclaveResponse.prototype.hasEnclaveInfo = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.engine_api.ResourceQuotaUsage.prototype.clearEnclaveUsagesList = function() {
+  return this.setEnclaveUsagesList([]);
+};
+
+
+/**
+ * optional uint32 max_services = 10;
+ * @return {number}
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.getMaxServices = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.setMaxServices = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional uint64 max_cpu_millicores = 11;
+ * @return {number}
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.getMaxCpuMillicores = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.setMaxCpuMillicores = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+/**
+ * optional uint64 max_memory_megabytes = 12;
+ * @return {number}
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.getMaxMemoryMegabytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 12, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ResourceQuotaUsage} returns this
+ */
+proto.engine_api.ResourceQuotaUsage.prototype.setMaxMemoryMegabytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 12, value);
 };
 
 
@@ -1062,8 +1421,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveAPIContainerInfo.toObject(opt_includeInstance, this);
+proto.engine_api.EnclaveResourceUsage.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveResourceUsage.toObject(opt_includeInstance, this);
 };
 
 
@@ -1072,16 +1431,19 @@ proto.engine_api.EnclaveAPIContainerInfo.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveAPIContainerInfo} msg The msg instance to transform.
+ * @param {!proto.engine_api.EnclaveResourceUsage} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveAPIContainerInfo.toObject = function(includeInstance, msg) {
+proto.engine_api.EnclaveResourceUsage.toObject = function(includeInstance, msg) {
   var f, obj = {
-    containerId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    ipInsideEnclave: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    grpcPortInsideEnclave: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    bridgeIpAddress: jspb.Message.getFieldWithDefault(msg, 6, "")
+    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    isUsageKnown: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    serviceCount: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    reservedCpuMillicores: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    reservedMemoryMegabytes: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    filesArtifactsStorageBytes: jspb.Message.getFieldWithDefault(msg, 7, 0)
   };
 
   if (includeInstance) {
@@ -1095,23 +1457,23 @@ proto.engine_api.EnclaveAPIContainerInfo.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveAPIContainerInfo}
+ * @return {!proto.engine_api.EnclaveResourceUsage}
  */
-proto.engine_api.EnclaveAPIContainerInfo.deserializeBinary = function(bytes) {
+proto.engine_api.EnclaveResourceUsage.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveAPIContainerInfo;
-  return proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EnclaveResourceUsage;
+  return proto.engine_api.EnclaveResourceUsage.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveAPIContainerInfo} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EnclaveResourceUsage} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveAPIContainerInfo}
+ * @return {!proto.engine_api.EnclaveResourceUsage}
  */
-proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EnclaveResourceUsage.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1120,19 +1482,31 @@ proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader = function(
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setContainerId(value);
+      msg.setEnclaveUuid(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setIpInsideEnclave(value);
+      msg.setEnclaveName(value);
       break;
     case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsUsageKnown(value);
+      break;
+    case 4:
       var value = /** @type {number} */ (reader.readUint32());
-      msg.setGrpcPortInsideEnclave(value);
+      msg.setServiceCount(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setReservedCpuMillicores(value);
       break;
     case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBridgeIpAddress(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setReservedMemoryMegabytes(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setFilesArtifactsStorageBytes(value);
       break;
     default:
       reader.skipField();
@@ -1147,9 +1521,9 @@ proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.serializeBinary = function() {
+proto.engine_api.EnclaveResourceUsage.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter(this, writer);
+  proto.engine_api.EnclaveResourceUsage.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1157,272 +1531,187 @@ proto.engine_api.EnclaveAPIContainerInfo.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveAPIContainerInfo} message
+ * @param {!proto.engine_api.EnclaveResourceUsage} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.EnclaveResourceUsage.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getContainerId();
+  f = message.getEnclaveUuid();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getIpInsideEnclave();
+  f = message.getEnclaveName();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getGrpcPortInsideEnclave();
+  f = message.getIsUsageKnown();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getServiceCount();
   if (f !== 0) {
     writer.writeUint32(
-      3,
+      4,
       f
     );
   }
-  f = message.getBridgeIpAddress();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getReservedCpuMillicores();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = message.getReservedMemoryMegabytes();
+  if (f !== 0) {
+    writer.writeUint64(
       6,
       f
     );
   }
+  f = message.getFilesArtifactsStorageBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      7,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string container_id = 1;
+ * optional string enclave_uuid = 1;
  * @return {string}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.getContainerId = function() {
+proto.engine_api.EnclaveResourceUsage.prototype.getEnclaveUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ * @return {!proto.engine_api.EnclaveResourceUsage} returns this
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.setContainerId = function(value) {
+proto.engine_api.EnclaveResourceUsage.prototype.setEnclaveUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string ip_inside_enclave = 2;
+ * optional string enclave_name = 2;
  * @return {string}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.getIpInsideEnclave = function() {
+proto.engine_api.EnclaveResourceUsage.prototype.getEnclaveName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ * @return {!proto.engine_api.EnclaveResourceUsage} returns this
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.setIpInsideEnclave = function(value) {
+proto.engine_api.EnclaveResourceUsage.prototype.setEnclaveName = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional uint32 grpc_port_inside_enclave = 3;
- * @return {number}
+ * optional bool is_usage_known = 3;
+ * @return {boolean}
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.getGrpcPortInsideEnclave = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.engine_api.EnclaveResourceUsage.prototype.getIsUsageKnown = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ * @param {boolean} value
+ * @return {!proto.engine_api.EnclaveResourceUsage} returns this
  */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.setGrpcPortInsideEnclave = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.engine_api.EnclaveResourceUsage.prototype.setIsUsageKnown = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * optional string bridge_ip_address = 6;
- * @return {string}
- */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.getBridgeIpAddress = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
- */
-proto.engine_api.EnclaveAPIContainerInfo.prototype.setBridgeIpAddress = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(opt_includeInstance, this);
-};
-
-
-/**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    ipOnHostMachine: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    grpcPortOnHostMachine: jspb.Message.getFieldWithDefault(msg, 5, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
-};
-}
-
-
-/**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ * optional uint32 service_count = 4;
+ * @return {number}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveAPIContainerHostMachineInfo;
-  return proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader(msg, reader);
+proto.engine_api.EnclaveResourceUsage.prototype.getServiceCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveResourceUsage} returns this
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setIpOnHostMachine(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setGrpcPortOnHostMachine(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.engine_api.EnclaveResourceUsage.prototype.setServiceCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional uint64 reserved_cpu_millicores = 5;
+ * @return {number}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.engine_api.EnclaveResourceUsage.prototype.getReservedCpuMillicores = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveResourceUsage} returns this
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getIpOnHostMachine();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getGrpcPortOnHostMachine();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
+proto.engine_api.EnclaveResourceUsage.prototype.setReservedCpuMillicores = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional string ip_on_host_machine = 4;
- * @return {string}
+ * optional uint64 reserved_memory_megabytes = 6;
+ * @return {number}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.getIpOnHostMachine = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.engine_api.EnclaveResourceUsage.prototype.getReservedMemoryMegabytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} returns this
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveResourceUsage} returns this
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.setIpOnHostMachine = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.engine_api.EnclaveResourceUsage.prototype.setReservedMemoryMegabytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * optional uint32 grpc_port_on_host_machine = 5;
+ * optional uint64 files_artifacts_storage_bytes = 7;
  * @return {number}
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.getGrpcPortOnHostMachine = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.engine_api.EnclaveResourceUsage.prototype.getFilesArtifactsStorageBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} returns this
+ * @return {!proto.engine_api.EnclaveResourceUsage} returns this
  */
-proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.setGrpcPortOnHostMachine = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.engine_api.EnclaveResourceUsage.prototype.setFilesArtifactsStorageBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
@@ -1442,8 +1731,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveInfo.toObject(opt_includeInstance, this);
+proto.engine_api.CreateEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateEnclaveArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -1452,21 +1741,23 @@ proto.engine_api.EnclaveInfo.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveInfo} msg The msg instance to transform.
+ * @param {!proto.engine_api.CreateEnclaveArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveInfo.toObject = function(includeInstance, msg) {
+proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    containersStatus: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    apiContainerStatus: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    apiContainerInfo: (f = msg.getApiContainerInfo()) && proto.engine_api.EnclaveAPIContainerInfo.toObject(includeInstance, f),
-    apiContainerHostMachineInfo: (f = msg.getApiContainerHostMachineInfo()) && proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(includeInstance, f),
-    creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    mode: jspb.Message.getFieldWithDefault(msg, 9, 0)
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    mode: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    shouldApicRunInDebugMode: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    isIpv6Enabled: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    ttlSeconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    idleTimeoutSeconds: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    expiryAction: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
+    fromTemplate: jspb.Message.getFieldWithDefault(msg, 11, "")
   };
 
   if (includeInstance) {
@@ -1480,23 +1771,23 @@ proto.engine_api.EnclaveInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveInfo}
+ * @return {!proto.engine_api.CreateEnclaveArgs}
  */
-proto.engine_api.EnclaveInfo.deserializeBinary = function(bytes) {
+proto.engine_api.CreateEnclaveArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveInfo;
-  return proto.engine_api.EnclaveInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.CreateEnclaveArgs;
+  return proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveInfo} msg The message object to deserialize into.
+ * @param {!proto.engine_api.CreateEnclaveArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveInfo}
+ * @return {!proto.engine_api.CreateEnclaveArgs}
  */
-proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1505,42 +1796,49 @@ proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveUuid(value);
+      msg.setEnclaveName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setApiContainerVersionTag(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
+      msg.setApiContainerLogLevel(value);
       break;
     case 4:
-      var value = /** @type {!proto.engine_api.EnclaveContainersStatus} */ (reader.readEnum());
-      msg.setContainersStatus(value);
+      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
+      msg.setMode(value);
       break;
     case 5:
-      var value = /** @type {!proto.engine_api.EnclaveAPIContainerStatus} */ (reader.readEnum());
-      msg.setApiContainerStatus(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldApicRunInDebugMode(value);
       break;
     case 6:
-      var value = new proto.engine_api.EnclaveAPIContainerInfo;
-      reader.readMessage(value,proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader);
-      msg.setApiContainerInfo(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsIpv6Enabled(value);
       break;
     case 7:
-      var value = new proto.engine_api.EnclaveAPIContainerHostMachineInfo;
-      reader.readMessage(value,proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader);
-      msg.setApiContainerHostMachineInfo(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTtlSeconds(value);
       break;
     case 8:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setCreationTime(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setIdleTimeoutSeconds(value);
       break;
     case 9:
-      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
-      msg.setMode(value);
+      var value = /** @type {!proto.engine_api.EnclaveExpiryAction} */ (reader.readEnum());
+      msg.setExpiryAction(value);
+      break;
+    case 10:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFromTemplate(value);
       break;
     default:
       reader.skipField();
@@ -1555,9 +1853,9 @@ proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveInfo.prototype.serializeBinary = function() {
+proto.engine_api.CreateEnclaveArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveInfo.serializeBinaryToWriter(this, writer);
+  proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1565,196 +1863,221 @@ proto.engine_api.EnclaveInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveInfo} message
+ * @param {!proto.engine_api.CreateEnclaveArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveInfo.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveUuid();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getContainersStatus();
-  if (f !== 0.0) {
+  f = /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
     writer.writeEnum(
       4,
       f
     );
   }
-  f = message.getApiContainerStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeBool(
       5,
       f
     );
   }
-  f = message.getApiContainerInfo();
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 6));
   if (f != null) {
-    writer.writeMessage(
+    writer.writeBool(
       6,
-      f,
-      proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getApiContainerHostMachineInfo();
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
   if (f != null) {
-    writer.writeMessage(
+    writer.writeInt64(
       7,
-      f,
-      proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getCreationTime();
+  f = /** @type {number} */ (jspb.Message.getField(message, 8));
   if (f != null) {
-    writer.writeMessage(
+    writer.writeInt64(
       8,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getMode();
-  if (f !== 0.0) {
+  f = /** @type {!proto.engine_api.EnclaveExpiryAction} */ (jspb.Message.getField(message, 9));
+  if (f != null) {
     writer.writeEnum(
       9,
       f
     );
   }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(10, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 11));
+  if (f != null) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string enclave_uuid = 1;
+ * optional string enclave_name = 1;
  * @return {string}
  */
-proto.engine_api.EnclaveInfo.prototype.getEnclaveUuid = function() {
+proto.engine_api.CreateEnclaveArgs.prototype.getEnclaveName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.setEnclaveUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.engine_api.CreateEnclaveArgs.prototype.setEnclaveName = function(value) {
+  return jspb.Message.setField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearEnclaveName = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasEnclaveName = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string api_container_version_tag = 2;
  * @return {string}
  */
-proto.engine_api.EnclaveInfo.prototype.getName = function() {
+proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerVersionTag = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerVersionTag = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional string shortened_uuid = 3;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.engine_api.CreateEnclaveArgs.prototype.clearApiContainerVersionTag = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.engine_api.CreateEnclaveArgs.prototype.hasApiContainerVersionTag = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional EnclaveContainersStatus containers_status = 4;
- * @return {!proto.engine_api.EnclaveContainersStatus}
+ * optional string api_container_log_level = 3;
+ * @return {string}
  */
-proto.engine_api.EnclaveInfo.prototype.getContainersStatus = function() {
-  return /** @type {!proto.engine_api.EnclaveContainersStatus} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerLogLevel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveContainersStatus} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.setContainersStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerLogLevel = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * optional EnclaveAPIContainerStatus api_container_status = 5;
- * @return {!proto.engine_api.EnclaveAPIContainerStatus}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.getApiContainerStatus = function() {
-  return /** @type {!proto.engine_api.EnclaveAPIContainerStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.engine_api.CreateEnclaveArgs.prototype.clearApiContainerLogLevel = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveAPIContainerStatus} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.setApiContainerStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.engine_api.CreateEnclaveArgs.prototype.hasApiContainerLogLevel = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * optional EnclaveAPIContainerInfo api_container_info = 6;
- * @return {?proto.engine_api.EnclaveAPIContainerInfo}
+ * optional EnclaveMode mode = 4;
+ * @return {!proto.engine_api.EnclaveMode}
  */
-proto.engine_api.EnclaveInfo.prototype.getApiContainerInfo = function() {
-  return /** @type{?proto.engine_api.EnclaveAPIContainerInfo} */ (
-    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveAPIContainerInfo, 6));
+proto.engine_api.CreateEnclaveArgs.prototype.getMode = function() {
+  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {?proto.engine_api.EnclaveAPIContainerInfo|undefined} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
-*/
-proto.engine_api.EnclaveInfo.prototype.setApiContainerInfo = function(value) {
-  return jspb.Message.setWrapperField(this, 6, value);
+ * @param {!proto.engine_api.EnclaveMode} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setMode = function(value) {
+  return jspb.Message.setField(this, 4, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.clearApiContainerInfo = function() {
-  return this.setApiContainerInfo(undefined);
+proto.engine_api.CreateEnclaveArgs.prototype.clearMode = function() {
+  return jspb.Message.setField(this, 4, undefined);
 };
 
 
@@ -1762,36 +2085,35 @@ proto.engine_api.EnclaveInfo.prototype.clearApiContainerInfo = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.hasApiContainerInfo = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.engine_api.CreateEnclaveArgs.prototype.hasMode = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
 /**
- * optional EnclaveAPIContainerHostMachineInfo api_container_host_machine_info = 7;
- * @return {?proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ * optional bool should_apic_run_in_debug_mode = 5;
+ * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.getApiContainerHostMachineInfo = function() {
-  return /** @type{?proto.engine_api.EnclaveAPIContainerHostMachineInfo} */ (
-    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveAPIContainerHostMachineInfo, 7));
+proto.engine_api.CreateEnclaveArgs.prototype.getShouldApicRunInDebugMode = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * @param {?proto.engine_api.EnclaveAPIContainerHostMachineInfo|undefined} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
-*/
-proto.engine_api.EnclaveInfo.prototype.setApiContainerHostMachineInfo = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
+ * @param {boolean} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setShouldApicRunInDebugMode = function(value) {
+  return jspb.Message.setField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.clearApiContainerHostMachineInfo = function() {
-  return this.setApiContainerHostMachineInfo(undefined);
+proto.engine_api.CreateEnclaveArgs.prototype.clearShouldApicRunInDebugMode = function() {
+  return jspb.Message.setField(this, 5, undefined);
 };
 
 
@@ -1799,36 +2121,35 @@ proto.engine_api.EnclaveInfo.prototype.clearApiContainerHostMachineInfo = functi
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.hasApiContainerHostMachineInfo = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.engine_api.CreateEnclaveArgs.prototype.hasShouldApicRunInDebugMode = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * optional google.protobuf.Timestamp creation_time = 8;
- * @return {?proto.google.protobuf.Timestamp}
+ * optional bool is_ipv6_enabled = 6;
+ * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.getCreationTime = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 8));
+proto.engine_api.CreateEnclaveArgs.prototype.getIsIpv6Enabled = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
-*/
-proto.engine_api.EnclaveInfo.prototype.setCreationTime = function(value) {
-  return jspb.Message.setWrapperField(this, 8, value);
+ * @param {boolean} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setIsIpv6Enabled = function(value) {
+  return jspb.Message.setField(this, 6, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.clearCreationTime = function() {
-  return this.setCreationTime(undefined);
+proto.engine_api.CreateEnclaveArgs.prototype.clearIsIpv6Enabled = function() {
+  return jspb.Message.setField(this, 6, undefined);
 };
 
 
@@ -1836,162 +2157,177 @@ proto.engine_api.EnclaveInfo.prototype.clearCreationTime = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.engine_api.EnclaveInfo.prototype.hasCreationTime = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.engine_api.CreateEnclaveArgs.prototype.hasIsIpv6Enabled = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
 /**
- * optional EnclaveMode mode = 9;
- * @return {!proto.engine_api.EnclaveMode}
+ * optional int64 ttl_seconds = 7;
+ * @return {number}
  */
-proto.engine_api.EnclaveInfo.prototype.getMode = function() {
-  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.engine_api.CreateEnclaveArgs.prototype.getTtlSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveMode} value
- * @return {!proto.engine_api.EnclaveInfo} returns this
+ * @param {number} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.EnclaveInfo.prototype.setMode = function(value) {
-  return jspb.Message.setProto3EnumField(this, 9, value);
+proto.engine_api.CreateEnclaveArgs.prototype.setTtlSeconds = function(value) {
+  return jspb.Message.setField(this, 7, value);
 };
 
 
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearTtlSeconds = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.GetEnclavesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetEnclavesResponse.toObject(opt_includeInstance, this);
+proto.engine_api.CreateEnclaveArgs.prototype.hasTtlSeconds = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetEnclavesResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional int64 idle_timeout_seconds = 8;
+ * @return {number}
  */
-proto.engine_api.GetEnclavesResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    enclaveInfoMap: (f = msg.getEnclaveInfoMap()) ? f.toObject(includeInstance, proto.engine_api.EnclaveInfo.toObject) : []
-  };
+proto.engine_api.CreateEnclaveArgs.prototype.getIdleTimeoutSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setIdleTimeoutSeconds = function(value) {
+  return jspb.Message.setField(this, 8, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetEnclavesResponse}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.GetEnclavesResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetEnclavesResponse;
-  return proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader(msg, reader);
+proto.engine_api.CreateEnclaveArgs.prototype.clearIdleTimeoutSeconds = function() {
+  return jspb.Message.setField(this, 8, undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.engine_api.GetEnclavesResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetEnclavesResponse}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = msg.getEnclaveInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.EnclaveInfo.deserializeBinaryFromReader, "", new proto.engine_api.EnclaveInfo());
-         });
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.engine_api.CreateEnclaveArgs.prototype.hasIdleTimeoutSeconds = function() {
+  return jspb.Message.getField(this, 8) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional EnclaveExpiryAction expiry_action = 9;
+ * @return {!proto.engine_api.EnclaveExpiryAction}
  */
-proto.engine_api.GetEnclavesResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetEnclavesResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.engine_api.CreateEnclaveArgs.prototype.getExpiryAction = function() {
+  return /** @type {!proto.engine_api.EnclaveExpiryAction} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetEnclavesResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!proto.engine_api.EnclaveExpiryAction} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.GetEnclavesResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getEnclaveInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.EnclaveInfo.serializeBinaryToWriter);
-  }
+proto.engine_api.CreateEnclaveArgs.prototype.setExpiryAction = function(value) {
+  return jspb.Message.setField(this, 9, value);
 };
 
 
 /**
- * map<string, EnclaveInfo> enclave_info = 1;
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearExpiryAction = function() {
+  return jspb.Message.setField(this, 9, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasExpiryAction = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * map<string, string> labels = 10;
  * @param {boolean=} opt_noLazyCreate Do not create the map if
  * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.engine_api.EnclaveInfo>}
+ * @return {!jspb.Map<string,string>}
  */
-proto.engine_api.GetEnclavesResponse.prototype.getEnclaveInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.engine_api.EnclaveInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.engine_api.EnclaveInfo));
+proto.engine_api.CreateEnclaveArgs.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 10, opt_noLazyCreate,
+      null));
 };
 
 
 /**
  * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetEnclavesResponse} returns this
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
  */
-proto.engine_api.GetEnclavesResponse.prototype.clearEnclaveInfoMap = function() {
-  this.getEnclaveInfoMap().clear();
+proto.engine_api.CreateEnclaveArgs.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
   return this;};
 
 
+/**
+ * optional string from_template = 11;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getFromTemplate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setFromTemplate = function(value) {
+  return jspb.Message.setField(this, 11, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearFromTemplate = function() {
+  return jspb.Message.setField(this, 11, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasFromTemplate = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
 
 
 
@@ -2008,8 +2344,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveIdentifiers.toObject(opt_includeInstance, this);
+proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2018,15 +2354,13 @@ proto.engine_api.EnclaveIdentifiers.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveIdentifiers} msg The msg instance to transform.
+ * @param {!proto.engine_api.CreateEnclaveResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveIdentifiers.toObject = function(includeInstance, msg) {
+proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2040,23 +2374,23 @@ proto.engine_api.EnclaveIdentifiers.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveIdentifiers}
+ * @return {!proto.engine_api.CreateEnclaveResponse}
  */
-proto.engine_api.EnclaveIdentifiers.deserializeBinary = function(bytes) {
+proto.engine_api.CreateEnclaveResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveIdentifiers;
-  return proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.CreateEnclaveResponse;
+  return proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveIdentifiers} msg The message object to deserialize into.
+ * @param {!proto.engine_api.CreateEnclaveResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveIdentifiers}
+ * @return {!proto.engine_api.CreateEnclaveResponse}
  */
-proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2064,16 +2398,9 @@ proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveUuid(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
+      var value = new proto.engine_api.EnclaveInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
+      msg.setEnclaveInfo(value);
       break;
     default:
       reader.skipField();
@@ -2088,9 +2415,9 @@ proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.serializeBinary = function() {
+proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter(this, writer);
+  proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2098,98 +2425,61 @@ proto.engine_api.EnclaveIdentifiers.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveIdentifiers} message
+ * @param {!proto.engine_api.CreateEnclaveResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveUuid();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getEnclaveInfo();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
+      f,
+      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string enclave_uuid = 1;
- * @return {string}
- */
-proto.engine_api.EnclaveIdentifiers.prototype.getEnclaveUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveIdentifiers} returns this
- */
-proto.engine_api.EnclaveIdentifiers.prototype.setEnclaveUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string name = 2;
- * @return {string}
+ * optional EnclaveInfo enclave_info = 1;
+ * @return {?proto.engine_api.EnclaveInfo}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.CreateEnclaveResponse.prototype.getEnclaveInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveInfo, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveIdentifiers} returns this
- */
-proto.engine_api.EnclaveIdentifiers.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+ * @param {?proto.engine_api.EnclaveInfo|undefined} value
+ * @return {!proto.engine_api.CreateEnclaveResponse} returns this
+*/
+proto.engine_api.CreateEnclaveResponse.prototype.setEnclaveInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string shortened_uuid = 3;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveResponse} returns this
  */
-proto.engine_api.EnclaveIdentifiers.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.engine_api.CreateEnclaveResponse.prototype.clearEnclaveInfo = function() {
+  return this.setEnclaveInfo(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.EnclaveIdentifiers.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.engine_api.CreateEnclaveResponse.prototype.hasEnclaveInfo = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2205,8 +2495,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject(opt_includeInstance, this);
+proto.engine_api.EnclaveAPIContainerInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveAPIContainerInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -2215,14 +2505,16 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.to
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.EnclaveAPIContainerInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.EnclaveAPIContainerInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
-    proto.engine_api.EnclaveIdentifiers.toObject, includeInstance)
+    containerId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    ipInsideEnclave: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    grpcPortInsideEnclave: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    bridgeIpAddress: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -2236,23 +2528,23 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject = f
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinary = function(bytes) {
+proto.engine_api.EnclaveAPIContainerInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse;
-  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EnclaveAPIContainerInfo;
+  return proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EnclaveAPIContainerInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2260,12 +2552,23 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeB
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveIdentifiers;
-      reader.readMessage(value,proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader);
-      msg.addAllidentifiers(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContainerId(value);
       break;
-    default:
-      reader.skipField();
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIpInsideEnclave(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setGrpcPortInsideEnclave(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBridgeIpAddress(value);
+      break;
+    default:
+      reader.skipField();
       break;
     }
   }
@@ -2277,9 +2580,9 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeB
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.serializeBinary = function() {
+proto.engine_api.EnclaveAPIContainerInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2287,58 +2590,112 @@ proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.se
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} message
+ * @param {!proto.engine_api.EnclaveAPIContainerInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAllidentifiersList();
+  f = message.getContainerId();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getIpInsideEnclave();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getGrpcPortInsideEnclave();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getBridgeIpAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
     );
   }
 };
 
 
 /**
- * repeated EnclaveIdentifiers allIdentifiers = 1;
- * @return {!Array<!proto.engine_api.EnclaveIdentifiers>}
+ * optional string container_id = 1;
+ * @return {string}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.getAllidentifiersList = function() {
-  return /** @type{!Array<!proto.engine_api.EnclaveIdentifiers>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveIdentifiers, 1));
+proto.engine_api.EnclaveAPIContainerInfo.prototype.getContainerId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.EnclaveIdentifiers>} value
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
-*/
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.setContainerId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveIdentifiers=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.EnclaveIdentifiers}
+ * optional string ip_inside_enclave = 2;
+ * @return {string}
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveIdentifiers, opt_index);
+proto.engine_api.EnclaveAPIContainerInfo.prototype.getIpInsideEnclave = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
  */
-proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.clearAllidentifiersList = function() {
-  return this.setAllidentifiersList([]);
+proto.engine_api.EnclaveAPIContainerInfo.prototype.setIpInsideEnclave = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 grpc_port_inside_enclave = 3;
+ * @return {number}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.getGrpcPortInsideEnclave = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.setGrpcPortInsideEnclave = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string bridge_ip_address = 6;
+ * @return {string}
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.getBridgeIpAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveAPIContainerInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerInfo.prototype.setBridgeIpAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
@@ -2358,8 +2715,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.StopEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.StopEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -2368,13 +2725,14 @@ proto.engine_api.StopEnclaveArgs.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.StopEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.StopEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    ipOnHostMachine: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    grpcPortOnHostMachine: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -2388,32 +2746,36 @@ proto.engine_api.StopEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.StopEnclaveArgs}
+ * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo}
  */
-proto.engine_api.StopEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.StopEnclaveArgs;
-  return proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EnclaveAPIContainerHostMachineInfo;
+  return proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.StopEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.StopEnclaveArgs}
+ * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo}
  */
-proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
-    case 1:
+    case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveIdentifier(value);
+      msg.setIpOnHostMachine(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setGrpcPortOnHostMachine(value);
       break;
     default:
       reader.skipField();
@@ -2428,9 +2790,9 @@ proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.StopEnclaveArgs.prototype.serializeBinary = function() {
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2438,16 +2800,23 @@ proto.engine_api.StopEnclaveArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.StopEnclaveArgs} message
+ * @param {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveIdentifier();
+  f = message.getIpOnHostMachine();
   if (f.length > 0) {
     writer.writeString(
-      1,
+      4,
+      f
+    );
+  }
+  f = message.getGrpcPortOnHostMachine();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
       f
     );
   }
@@ -2455,20 +2824,38 @@ proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter = function(message, wri
 
 
 /**
- * optional string enclave_identifier = 1;
+ * optional string ip_on_host_machine = 4;
  * @return {string}
  */
-proto.engine_api.StopEnclaveArgs.prototype.getEnclaveIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.getIpOnHostMachine = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.StopEnclaveArgs} returns this
+ * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} returns this
  */
-proto.engine_api.StopEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.setIpOnHostMachine = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 grpc_port_on_host_machine = 5;
+ * @return {number}
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.getGrpcPortOnHostMachine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveAPIContainerHostMachineInfo} returns this
+ */
+proto.engine_api.EnclaveAPIContainerHostMachineInfo.prototype.setGrpcPortOnHostMachine = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
@@ -2488,8 +2875,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.DestroyEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.EnclaveInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -2498,13 +2885,24 @@ proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.DestroyEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.EnclaveInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.EnclaveInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    containersStatus: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    apiContainerStatus: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    apiContainerInfo: (f = msg.getApiContainerInfo()) && proto.engine_api.EnclaveAPIContainerInfo.toObject(includeInstance, f),
+    apiContainerHostMachineInfo: (f = msg.getApiContainerHostMachineInfo()) && proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(includeInstance, f),
+    creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    mode: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    expiryTime: (f = msg.getExpiryTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
+    apiContainerAccessToken: jspb.Message.getFieldWithDefault(msg, 12, "")
   };
 
   if (includeInstance) {
@@ -2518,23 +2916,23 @@ proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.DestroyEnclaveArgs}
+ * @return {!proto.engine_api.EnclaveInfo}
  */
-proto.engine_api.DestroyEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.EnclaveInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.DestroyEnclaveArgs;
-  return proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EnclaveInfo;
+  return proto.engine_api.EnclaveInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.DestroyEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EnclaveInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.DestroyEnclaveArgs}
+ * @return {!proto.engine_api.EnclaveInfo}
  */
-proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2543,36 +2941,2456 @@ proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveIdentifier(value);
+      msg.setEnclaveUuid(value);
       break;
-    default:
-      reader.skipField();
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
-    }
-  }
-  return msg;
-};
-
-
-/**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
-
-
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.engine_api.EnclaveContainersStatus} */ (reader.readEnum());
+      msg.setContainersStatus(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.engine_api.EnclaveAPIContainerStatus} */ (reader.readEnum());
+      msg.setApiContainerStatus(value);
+      break;
+    case 6:
+      var value = new proto.engine_api.EnclaveAPIContainerInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveAPIContainerInfo.deserializeBinaryFromReader);
+      msg.setApiContainerInfo(value);
+      break;
+    case 7:
+      var value = new proto.engine_api.EnclaveAPIContainerHostMachineInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveAPIContainerHostMachineInfo.deserializeBinaryFromReader);
+      msg.setApiContainerHostMachineInfo(value);
+      break;
+    case 8:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreationTime(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
+      msg.setMode(value);
+      break;
+    case 10:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpiryTime(value);
+      break;
+    case 11:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApiContainerAccessToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getContainersStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = message.getApiContainerStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getApiContainerInfo();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.engine_api.EnclaveAPIContainerInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getApiContainerHostMachineInfo();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.engine_api.EnclaveAPIContainerHostMachineInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getCreationTime();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
+  f = message.getExpiryTime();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(11, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getApiContainerAccessToken();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_uuid = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getEnclaveUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setEnclaveUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 3;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional EnclaveContainersStatus containers_status = 4;
+ * @return {!proto.engine_api.EnclaveContainersStatus}
+ */
+proto.engine_api.EnclaveInfo.prototype.getContainersStatus = function() {
+  return /** @type {!proto.engine_api.EnclaveContainersStatus} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveContainersStatus} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setContainersStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+/**
+ * optional EnclaveAPIContainerStatus api_container_status = 5;
+ * @return {!proto.engine_api.EnclaveAPIContainerStatus}
+ */
+proto.engine_api.EnclaveInfo.prototype.getApiContainerStatus = function() {
+  return /** @type {!proto.engine_api.EnclaveAPIContainerStatus} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveAPIContainerStatus} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setApiContainerStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional EnclaveAPIContainerInfo api_container_info = 6;
+ * @return {?proto.engine_api.EnclaveAPIContainerInfo}
+ */
+proto.engine_api.EnclaveInfo.prototype.getApiContainerInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveAPIContainerInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveAPIContainerInfo, 6));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveAPIContainerInfo|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setApiContainerInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearApiContainerInfo = function() {
+  return this.setApiContainerInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasApiContainerInfo = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional EnclaveAPIContainerHostMachineInfo api_container_host_machine_info = 7;
+ * @return {?proto.engine_api.EnclaveAPIContainerHostMachineInfo}
+ */
+proto.engine_api.EnclaveInfo.prototype.getApiContainerHostMachineInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveAPIContainerHostMachineInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveAPIContainerHostMachineInfo, 7));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveAPIContainerHostMachineInfo|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setApiContainerHostMachineInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearApiContainerHostMachineInfo = function() {
+  return this.setApiContainerHostMachineInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasApiContainerHostMachineInfo = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp creation_time = 8;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.EnclaveInfo.prototype.getCreationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 8));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setCreationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearCreationTime = function() {
+  return this.setCreationTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasCreationTime = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional EnclaveMode mode = 9;
+ * @return {!proto.engine_api.EnclaveMode}
+ */
+proto.engine_api.EnclaveInfo.prototype.getMode = function() {
+  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveMode} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp expiry_time = 10;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.EnclaveInfo.prototype.getExpiryTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 10));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+*/
+proto.engine_api.EnclaveInfo.prototype.setExpiryTime = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearExpiryTime = function() {
+  return this.setExpiryTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveInfo.prototype.hasExpiryTime = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * map<string, string> labels = 11;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.EnclaveInfo.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 11, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;};
+
+
+/**
+ * optional string api_container_access_token = 12;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getApiContainerAccessToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setApiContainerAccessToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclavesArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetEnclavesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    labelSelector: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetEnclavesArgs}
+ */
+proto.engine_api.GetEnclavesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetEnclavesArgs;
+  return proto.engine_api.GetEnclavesArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetEnclavesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetEnclavesArgs}
+ */
+proto.engine_api.GetEnclavesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLabelSelector(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetEnclavesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetEnclavesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string label_selector = 1;
+ * @return {string}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.getLabelSelector = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.GetEnclavesArgs} returns this
+ */
+proto.engine_api.GetEnclavesArgs.prototype.setLabelSelector = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetEnclavesArgs} returns this
+ */
+proto.engine_api.GetEnclavesArgs.prototype.clearLabelSelector = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetEnclavesArgs.prototype.hasLabelSelector = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetEnclavesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclavesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetEnclavesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfoMap: (f = msg.getEnclaveInfoMap()) ? f.toObject(includeInstance, proto.engine_api.EnclaveInfo.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetEnclavesResponse}
+ */
+proto.engine_api.GetEnclavesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetEnclavesResponse;
+  return proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetEnclavesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetEnclavesResponse}
+ */
+proto.engine_api.GetEnclavesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getEnclaveInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.EnclaveInfo.deserializeBinaryFromReader, "", new proto.engine_api.EnclaveInfo());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetEnclavesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetEnclavesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetEnclavesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEnclavesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.EnclaveInfo.serializeBinaryToWriter);
+  }
+};
+
+
+/**
+ * map<string, EnclaveInfo> enclave_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.engine_api.EnclaveInfo>}
+ */
+proto.engine_api.GetEnclavesResponse.prototype.getEnclaveInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.engine_api.EnclaveInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.engine_api.EnclaveInfo));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetEnclavesResponse} returns this
+ */
+proto.engine_api.GetEnclavesResponse.prototype.clearEnclaveInfoMap = function() {
+  this.getEnclaveInfoMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveIdentifiers.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveIdentifiers} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveIdentifiers.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveIdentifiers}
+ */
+proto.engine_api.EnclaveIdentifiers.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveIdentifiers;
+  return proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveIdentifiers} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveIdentifiers}
+ */
+proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveIdentifiers} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_uuid = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.getEnclaveUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.setEnclaveUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 3;
+ * @return {string}
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveIdentifiers} returns this
+ */
+proto.engine_api.EnclaveIdentifiers.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
+    proto.engine_api.EnclaveIdentifiers.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse;
+  return proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveIdentifiers;
+      reader.readMessage(value,proto.engine_api.EnclaveIdentifiers.deserializeBinaryFromReader);
+      msg.addAllidentifiers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAllidentifiersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveIdentifiers.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated EnclaveIdentifiers allIdentifiers = 1;
+ * @return {!Array<!proto.engine_api.EnclaveIdentifiers>}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.getAllidentifiersList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveIdentifiers>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveIdentifiers, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.EnclaveIdentifiers>} value
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
+*/
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveIdentifiers=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveIdentifiers}
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveIdentifiers, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse} returns this
+ */
+proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.prototype.clearAllidentifiersList = function() {
+  return this.setAllidentifiersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.StopEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.StopEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.StopEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.StopEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.StopEnclaveArgs}
+ */
+proto.engine_api.StopEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.StopEnclaveArgs;
+  return proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.StopEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.StopEnclaveArgs}
+ */
+proto.engine_api.StopEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.StopEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.StopEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.StopEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.StopEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.StopEnclaveArgs} returns this
+ */
+proto.engine_api.StopEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.StartEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.StartEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.StartEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.StartEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.StartEnclaveArgs}
+ */
+proto.engine_api.StartEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.StartEnclaveArgs;
+  return proto.engine_api.StartEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.StartEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.StartEnclaveArgs}
+ */
+proto.engine_api.StartEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.StartEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.StartEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.StartEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.StartEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.StartEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.StartEnclaveArgs} returns this
+ */
+proto.engine_api.StartEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.ExtendEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ExtendEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.ExtendEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ExtendEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    extensionSeconds: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.ExtendEnclaveArgs}
+ */
+proto.engine_api.ExtendEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.ExtendEnclaveArgs;
+  return proto.engine_api.ExtendEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.ExtendEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.ExtendEnclaveArgs}
+ */
+proto.engine_api.ExtendEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setExtensionSeconds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.ExtendEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.ExtendEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.ExtendEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ExtendEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExtensionSeconds();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.ExtendEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.ExtendEnclaveArgs} returns this
+ */
+proto.engine_api.ExtendEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 extension_seconds = 2;
+ * @return {number}
+ */
+proto.engine_api.ExtendEnclaveArgs.prototype.getExtensionSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.ExtendEnclaveArgs} returns this
+ */
+proto.engine_api.ExtendEnclaveArgs.prototype.setExtensionSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.ExtendEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.ExtendEnclaveResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.ExtendEnclaveResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ExtendEnclaveResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    expiryTime: (f = msg.getExpiryTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.ExtendEnclaveResponse}
+ */
+proto.engine_api.ExtendEnclaveResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.ExtendEnclaveResponse;
+  return proto.engine_api.ExtendEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.ExtendEnclaveResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.ExtendEnclaveResponse}
+ */
+proto.engine_api.ExtendEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setExpiryTime(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.ExtendEnclaveResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.ExtendEnclaveResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.ExtendEnclaveResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.ExtendEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getExpiryTime();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.Timestamp expiry_time = 1;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.ExtendEnclaveResponse.prototype.getExpiryTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.ExtendEnclaveResponse} returns this
+*/
+proto.engine_api.ExtendEnclaveResponse.prototype.setExpiryTime = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.ExtendEnclaveResponse} returns this
+ */
+proto.engine_api.ExtendEnclaveResponse.prototype.clearExpiryTime = function() {
+  return this.setExpiryTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.ExtendEnclaveResponse.prototype.hasExpiryTime = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.DestroyEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.DestroyEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.DestroyEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.DestroyEnclaveArgs}
+ */
+proto.engine_api.DestroyEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.DestroyEnclaveArgs;
+  return proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.DestroyEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.DestroyEnclaveArgs}
+ */
+proto.engine_api.DestroyEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.DestroyEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
+ */
+proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CleanArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CleanArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    shouldCleanAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    labelSelector: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CleanArgs}
+ */
+proto.engine_api.CleanArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CleanArgs;
+  return proto.engine_api.CleanArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CleanArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CleanArgs}
+ */
+proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldCleanAll(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLabelSelector(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CleanArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CleanArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool should_clean_all = 1;
+ * @return {boolean}
+ */
+proto.engine_api.CleanArgs.prototype.getShouldCleanAll = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CleanArgs} returns this
+ */
+proto.engine_api.CleanArgs.prototype.setShouldCleanAll = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CleanArgs} returns this
+ */
+proto.engine_api.CleanArgs.prototype.clearShouldCleanAll = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CleanArgs.prototype.hasShouldCleanAll = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string label_selector = 2;
+ * @return {string}
+ */
+proto.engine_api.CleanArgs.prototype.getLabelSelector = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CleanArgs} returns this
+ */
+proto.engine_api.CleanArgs.prototype.setLabelSelector = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.CleanArgs} returns this
+ */
+proto.engine_api.CleanArgs.prototype.clearLabelSelector = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CleanArgs.prototype.hasLabelSelector = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveNameAndUuid.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveNameAndUuid} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    uuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
+ */
+proto.engine_api.EnclaveNameAndUuid.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveNameAndUuid;
+  return proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveNameAndUuid} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
+ */
+proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveNameAndUuid} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string uuid = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.CleanResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CleanResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CleanResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    removedEnclaveNameAndUuidsList: jspb.Message.toObjectList(msg.getRemovedEnclaveNameAndUuidsList(),
+    proto.engine_api.EnclaveNameAndUuid.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CleanResponse}
+ */
+proto.engine_api.CleanResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CleanResponse;
+  return proto.engine_api.CleanResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CleanResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CleanResponse}
+ */
+proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveNameAndUuid;
+      reader.readMessage(value,proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader);
+      msg.addRemovedEnclaveNameAndUuids(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CleanResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CleanResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRemovedEnclaveNameAndUuidsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
+ * @return {!Array<!proto.engine_api.EnclaveNameAndUuid>}
+ */
+proto.engine_api.CleanResponse.prototype.getRemovedEnclaveNameAndUuidsList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveNameAndUuid>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveNameAndUuid, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.EnclaveNameAndUuid>} value
+ * @return {!proto.engine_api.CleanResponse} returns this
+*/
+proto.engine_api.CleanResponse.prototype.setRemovedEnclaveNameAndUuidsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveNameAndUuid=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
+ */
+proto.engine_api.CleanResponse.prototype.addRemovedEnclaveNameAndUuids = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveNameAndUuid, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.CleanResponse} returns this
+ */
+proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = function() {
+  return this.setRemovedEnclaveNameAndUuidsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetServiceLogsArgs.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceLogsArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetServiceLogsArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
+    followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
+    proto.engine_api.LogLineFilter.toObject, includeInstance),
+    returnAllLogs: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
+    numLogLines: jspb.Message.getFieldWithDefault(msg, 6, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetServiceLogsArgs}
+ */
+proto.engine_api.GetServiceLogsArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetServiceLogsArgs;
+  return proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetServiceLogsArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetServiceLogsArgs}
+ */
+proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = msg.getServiceUuidSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFollowLogs(value);
+      break;
+    case 4:
+      var value = new proto.engine_api.LogLineFilter;
+      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
+      msg.addConjunctiveFilters(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnAllLogs(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumLogLines(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.DestroyEnclaveArgs} message
+ * @param {!proto.engine_api.GetServiceLogsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getEnclaveIdentifier();
   if (f.length > 0) {
@@ -2581,24 +5399,225 @@ proto.engine_api.DestroyEnclaveArgs.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getServiceUuidSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getConjunctiveFiltersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.engine_api.LogLineFilter.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * map<string, bool> service_uuid_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearServiceUuidSetMap = function() {
+  this.getServiceUuidSetMap().clear();
+  return this;};
+
+
+/**
+ * optional bool follow_logs = 3;
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getFollowLogs = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setFollowLogs = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearFollowLogs = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.hasFollowLogs = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * repeated LogLineFilter conjunctive_filters = 4;
+ * @return {!Array<!proto.engine_api.LogLineFilter>}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFiltersList = function() {
+  return /** @type{!Array<!proto.engine_api.LogLineFilter>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LogLineFilter, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.LogLineFilter>} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFiltersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.LogLineFilter=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLineFilter}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFilters = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.engine_api.LogLineFilter, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = function() {
+  return this.setConjunctiveFiltersList([]);
+};
+
+
+/**
+ * optional bool return_all_logs = 5;
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getReturnAllLogs = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setReturnAllLogs = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearReturnAllLogs = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.hasReturnAllLogs = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional uint32 num_log_lines = 6;
+ * @return {number}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getNumLogLines = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setNumLogLines = function(value) {
+  return jspb.Message.setField(this, 6, value);
 };
 
 
 /**
- * optional string enclave_identifier = 1;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.getEnclaveIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.GetServiceLogsArgs.prototype.clearNumLogLines = function() {
+  return jspb.Message.setField(this, 6, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.DestroyEnclaveArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.engine_api.GetServiceLogsArgs.prototype.hasNumLogLines = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
@@ -2618,8 +5637,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CleanArgs.toObject(opt_includeInstance, this);
+proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceLogsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2628,13 +5647,14 @@ proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CleanArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetServiceLogsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    shouldCleanAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    serviceLogsByServiceUuidMap: (f = msg.getServiceLogsByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.LogLine.toObject) : [],
+    notFoundServiceUuidSetMap: (f = msg.getNotFoundServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -2648,23 +5668,23 @@ proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CleanArgs}
+ * @return {!proto.engine_api.GetServiceLogsResponse}
  */
-proto.engine_api.CleanArgs.deserializeBinary = function(bytes) {
+proto.engine_api.GetServiceLogsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CleanArgs;
-  return proto.engine_api.CleanArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetServiceLogsResponse;
+  return proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CleanArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetServiceLogsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CleanArgs}
+ * @return {!proto.engine_api.GetServiceLogsResponse}
  */
-proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2672,8 +5692,16 @@ proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setShouldCleanAll(value);
+      var value = msg.getServiceLogsByServiceUuidMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.LogLine.deserializeBinaryFromReader, "", new proto.engine_api.LogLine());
+         });
+      break;
+    case 2:
+      var value = msg.getNotFoundServiceUuidSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
       break;
     default:
       reader.skipField();
@@ -2688,9 +5716,9 @@ proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
+proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CleanArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2698,58 +5726,74 @@ proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CleanArgs} message
+ * @param {!proto.engine_api.GetServiceLogsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 1));
-  if (f != null) {
-    writer.writeBool(
-      1,
-      f
-    );
+  f = message.getServiceLogsByServiceUuidMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.LogLine.serializeBinaryToWriter);
+  }
+  f = message.getNotFoundServiceUuidSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
   }
 };
 
 
 /**
- * optional bool should_clean_all = 1;
- * @return {boolean}
+ * map<string, LogLine> service_logs_by_service_uuid = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.engine_api.LogLine>}
  */
-proto.engine_api.CleanArgs.prototype.getShouldCleanAll = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.engine_api.GetServiceLogsResponse.prototype.getServiceLogsByServiceUuidMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.engine_api.LogLine>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.engine_api.LogLine));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.CleanArgs} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
  */
-proto.engine_api.CleanArgs.prototype.setShouldCleanAll = function(value) {
-  return jspb.Message.setField(this, 1, value);
-};
+proto.engine_api.GetServiceLogsResponse.prototype.clearServiceLogsByServiceUuidMap = function() {
+  this.getServiceLogsByServiceUuidMap().clear();
+  return this;};
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.CleanArgs} returns this
+ * map<string, bool> not_found_service_uuid_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.engine_api.CleanArgs.prototype.clearShouldCleanAll = function() {
-  return jspb.Message.setField(this, 1, undefined);
+proto.engine_api.GetServiceLogsResponse.prototype.getNotFoundServiceUuidSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
  */
-proto.engine_api.CleanArgs.prototype.hasShouldCleanAll = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
+proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap = function() {
+  this.getNotFoundServiceUuidSetMap().clear();
+  return this;};
+
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.LogLine.repeatedFields_ = [1];
 
 
 
@@ -2766,8 +5810,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveNameAndUuid.toObject(opt_includeInstance, this);
+proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLine.toObject(opt_includeInstance, this);
 };
 
 
@@ -2776,14 +5820,14 @@ proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveNameAndUuid} msg The msg instance to transform.
+ * @param {!proto.engine_api.LogLine} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
+proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    uuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2797,23 +5841,23 @@ proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.LogLine}
  */
-proto.engine_api.EnclaveNameAndUuid.deserializeBinary = function(bytes) {
+proto.engine_api.LogLine.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveNameAndUuid;
-  return proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.LogLine;
+  return proto.engine_api.LogLine.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveNameAndUuid} msg The message object to deserialize into.
+ * @param {!proto.engine_api.LogLine} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.LogLine}
  */
-proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2822,11 +5866,12 @@ proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.addLine(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
       break;
     default:
       reader.skipField();
@@ -2841,9 +5886,9 @@ proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
+proto.engine_api.LogLine.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter(this, writer);
+  proto.engine_api.LogLine.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2851,72 +5896,104 @@ proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveNameAndUuid} message
+ * @param {!proto.engine_api.LogLine} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getLineList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * repeated string line = 1;
+ * @return {!Array<string>}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.LogLine.prototype.getLineList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.setLineList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLine} returns this
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.engine_api.LogLine.prototype.addLine = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * optional string uuid = 2;
- * @return {string}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.LogLine} returns this
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.LogLine.prototype.clearLineList = function() {
+  return this.setLineList([]);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ * optional google.protobuf.Timestamp timestamp = 2;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.LogLine.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
 };
 
 
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.LogLine} returns this
+*/
+proto.engine_api.LogLine.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.LogLine} returns this
  */
-proto.engine_api.CleanResponse.repeatedFields_ = [1];
+proto.engine_api.LogLine.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.LogLine.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
 
 
 
@@ -2932,9 +6009,9 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
  * @return {!Object}
- */
-proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CleanResponse.toObject(opt_includeInstance, this);
+ */
+proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLineFilter.toObject(opt_includeInstance, this);
 };
 
 
@@ -2943,14 +6020,14 @@ proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CleanResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.LogLineFilter} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
   var f, obj = {
-    removedEnclaveNameAndUuidsList: jspb.Message.toObjectList(msg.getRemovedEnclaveNameAndUuidsList(),
-    proto.engine_api.EnclaveNameAndUuid.toObject, includeInstance)
+    operator: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    textPattern: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2964,23 +6041,23 @@ proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CleanResponse}
+ * @return {!proto.engine_api.LogLineFilter}
  */
-proto.engine_api.CleanResponse.deserializeBinary = function(bytes) {
+proto.engine_api.LogLineFilter.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CleanResponse;
-  return proto.engine_api.CleanResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.LogLineFilter;
+  return proto.engine_api.LogLineFilter.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CleanResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.LogLineFilter} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CleanResponse}
+ * @return {!proto.engine_api.LogLineFilter}
  */
-proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2988,9 +6065,12 @@ proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveNameAndUuid;
-      reader.readMessage(value,proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader);
-      msg.addRemovedEnclaveNameAndUuids(value);
+      var value = /** @type {!proto.engine_api.LogLineOperator} */ (reader.readEnum());
+      msg.setOperator(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTextPattern(value);
       break;
     default:
       reader.skipField();
@@ -3005,9 +6085,9 @@ proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
+proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CleanResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.LogLineFilter.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3015,58 +6095,62 @@ proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CleanResponse} message
+ * @param {!proto.engine_api.LogLineFilter} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRemovedEnclaveNameAndUuidsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
+  f = message.getOperator();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
-      f,
-      proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getTextPattern();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
- * @return {!Array<!proto.engine_api.EnclaveNameAndUuid>}
+ * optional LogLineOperator operator = 1;
+ * @return {!proto.engine_api.LogLineOperator}
  */
-proto.engine_api.CleanResponse.prototype.getRemovedEnclaveNameAndUuidsList = function() {
-  return /** @type{!Array<!proto.engine_api.EnclaveNameAndUuid>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveNameAndUuid, 1));
+proto.engine_api.LogLineFilter.prototype.getOperator = function() {
+  return /** @type {!proto.engine_api.LogLineOperator} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.EnclaveNameAndUuid>} value
- * @return {!proto.engine_api.CleanResponse} returns this
-*/
-proto.engine_api.CleanResponse.prototype.setRemovedEnclaveNameAndUuidsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {!proto.engine_api.LogLineOperator} value
+ * @return {!proto.engine_api.LogLineFilter} returns this
+ */
+proto.engine_api.LogLineFilter.prototype.setOperator = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveNameAndUuid=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * optional string text_pattern = 2;
+ * @return {string}
  */
-proto.engine_api.CleanResponse.prototype.addRemovedEnclaveNameAndUuids = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveNameAndUuid, opt_index);
+proto.engine_api.LogLineFilter.prototype.getTextPattern = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.CleanResponse} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.LogLineFilter} returns this
  */
-proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = function() {
-  return this.setRemovedEnclaveNameAndUuidsList([]);
+proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -3076,7 +6160,7 @@ proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = f
  * @private {!Array<number>}
  * @const
  */
-proto.engine_api.GetServiceLogsArgs.repeatedFields_ = [4];
+proto.engine_api.StreamEngineEventsArgs.repeatedFields_ = [1,2];
 
 
 
@@ -3093,8 +6177,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetServiceLogsArgs.toObject(opt_includeInstance, this);
+proto.engine_api.StreamEngineEventsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.StreamEngineEventsArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -3103,19 +6187,16 @@ proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetServiceLogsArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.StreamEngineEventsArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.StreamEngineEventsArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
-    followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
-    proto.engine_api.LogLineFilter.toObject, includeInstance),
-    returnAllLogs: jspb.Message.getBooleanFieldWithDefault(msg, 5, false),
-    numLogLines: jspb.Message.getFieldWithDefault(msg, 6, 0)
+    eventTypesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    enclaveIdentifiersList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    enclaveLabelSelector: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    onlyFailedStarlarkRuns: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -3129,23 +6210,23 @@ proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetServiceLogsArgs}
+ * @return {!proto.engine_api.StreamEngineEventsArgs}
  */
-proto.engine_api.GetServiceLogsArgs.deserializeBinary = function(bytes) {
+proto.engine_api.StreamEngineEventsArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetServiceLogsArgs;
-  return proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.StreamEngineEventsArgs;
+  return proto.engine_api.StreamEngineEventsArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetServiceLogsArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.StreamEngineEventsArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetServiceLogsArgs}
+ * @return {!proto.engine_api.StreamEngineEventsArgs}
  */
-proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.StreamEngineEventsArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3153,31 +6234,22 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveIdentifier(value);
+      var values = /** @type {!Array<!proto.engine_api.EngineEventType>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addEventTypes(values[i]);
+      }
       break;
     case 2:
-      var value = msg.getServiceUuidSetMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.addEnclaveIdentifiers(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setFollowLogs(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveLabelSelector(value);
       break;
     case 4:
-      var value = new proto.engine_api.LogLineFilter;
-      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
-      msg.addConjunctiveFilters(value);
-      break;
-    case 5:
       var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReturnAllLogs(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setNumLogLines(value);
+      msg.setOnlyFailedStarlarkRuns(value);
       break;
     default:
       reader.skipField();
@@ -3192,9 +6264,9 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
+proto.engine_api.StreamEngineEventsArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.StreamEngineEventsArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3202,49 +6274,37 @@ proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetServiceLogsArgs} message
+ * @param {!proto.engine_api.StreamEngineEventsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.StreamEngineEventsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveIdentifier();
+  f = message.getEventTypesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writePackedEnum(
       1,
       f
     );
   }
-  f = message.getServiceUuidSetMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = message.getConjunctiveFiltersList();
+  f = message.getEnclaveIdentifiersList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      4,
-      f,
-      proto.engine_api.LogLineFilter.serializeBinaryToWriter
+    writer.writeRepeatedString(
+      2,
+      f
     );
   }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
   if (f != null) {
-    writer.writeBool(
-      5,
+    writer.writeString(
+      3,
       f
     );
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeUint32(
-      6,
+  f = message.getOnlyFailedStarlarkRuns();
+  if (f) {
+    writer.writeBool(
+      4,
       f
     );
   }
@@ -3252,179 +6312,103 @@ proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message,
 
 
 /**
- * optional string enclave_identifier = 1;
- * @return {string}
- */
-proto.engine_api.GetServiceLogsArgs.prototype.getEnclaveIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
- */
-proto.engine_api.GetServiceLogsArgs.prototype.setEnclaveIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * map<string, bool> service_uuid_set = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
- */
-proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
-};
-
-
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
- */
-proto.engine_api.GetServiceLogsArgs.prototype.clearServiceUuidSetMap = function() {
-  this.getServiceUuidSetMap().clear();
-  return this;};
-
-
-/**
- * optional bool follow_logs = 3;
- * @return {boolean}
- */
-proto.engine_api.GetServiceLogsArgs.prototype.getFollowLogs = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
- */
-proto.engine_api.GetServiceLogsArgs.prototype.setFollowLogs = function(value) {
-  return jspb.Message.setField(this, 3, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
- */
-proto.engine_api.GetServiceLogsArgs.prototype.clearFollowLogs = function() {
-  return jspb.Message.setField(this, 3, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * repeated EngineEventType event_types = 1;
+ * @return {!Array<!proto.engine_api.EngineEventType>}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.hasFollowLogs = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.engine_api.StreamEngineEventsArgs.prototype.getEventTypesList = function() {
+  return /** @type {!Array<!proto.engine_api.EngineEventType>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * repeated LogLineFilter conjunctive_filters = 4;
- * @return {!Array<!proto.engine_api.LogLineFilter>}
+ * @param {!Array<!proto.engine_api.EngineEventType>} value
+ * @return {!proto.engine_api.StreamEngineEventsArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFiltersList = function() {
-  return /** @type{!Array<!proto.engine_api.LogLineFilter>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LogLineFilter, 4));
-};
-
-
-/**
- * @param {!Array<!proto.engine_api.LogLineFilter>} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
-*/
-proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFiltersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+proto.engine_api.StreamEngineEventsArgs.prototype.setEventTypesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * @param {!proto.engine_api.LogLineFilter=} opt_value
+ * @param {!proto.engine_api.EngineEventType} value
  * @param {number=} opt_index
- * @return {!proto.engine_api.LogLineFilter}
+ * @return {!proto.engine_api.StreamEngineEventsArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFilters = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.engine_api.LogLineFilter, opt_index);
+proto.engine_api.StreamEngineEventsArgs.prototype.addEventTypes = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @return {!proto.engine_api.StreamEngineEventsArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = function() {
-  return this.setConjunctiveFiltersList([]);
+proto.engine_api.StreamEngineEventsArgs.prototype.clearEventTypesList = function() {
+  return this.setEventTypesList([]);
 };
 
 
 /**
- * optional bool return_all_logs = 5;
- * @return {boolean}
+ * repeated string enclave_identifiers = 2;
+ * @return {!Array<string>}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getReturnAllLogs = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.engine_api.StreamEngineEventsArgs.prototype.getEnclaveIdentifiersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.StreamEngineEventsArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setReturnAllLogs = function(value) {
-  return jspb.Message.setField(this, 5, value);
+proto.engine_api.StreamEngineEventsArgs.prototype.setEnclaveIdentifiersList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.StreamEngineEventsArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearReturnAllLogs = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.engine_api.StreamEngineEventsArgs.prototype.addEnclaveIdentifiers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.StreamEngineEventsArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.hasReturnAllLogs = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.engine_api.StreamEngineEventsArgs.prototype.clearEnclaveIdentifiersList = function() {
+  return this.setEnclaveIdentifiersList([]);
 };
 
 
 /**
- * optional uint32 num_log_lines = 6;
- * @return {number}
+ * optional string enclave_label_selector = 3;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getNumLogLines = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.engine_api.StreamEngineEventsArgs.prototype.getEnclaveLabelSelector = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.StreamEngineEventsArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setNumLogLines = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.engine_api.StreamEngineEventsArgs.prototype.setEnclaveLabelSelector = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
  * Clears the field making it undefined.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @return {!proto.engine_api.StreamEngineEventsArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearNumLogLines = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.engine_api.StreamEngineEventsArgs.prototype.clearEnclaveLabelSelector = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
 
@@ -3432,8 +6416,26 @@ proto.engine_api.GetServiceLogsArgs.prototype.clearNumLogLines = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.hasNumLogLines = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.engine_api.StreamEngineEventsArgs.prototype.hasEnclaveLabelSelector = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional bool only_failed_starlark_runs = 4;
+ * @return {boolean}
+ */
+proto.engine_api.StreamEngineEventsArgs.prototype.getOnlyFailedStarlarkRuns = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.StreamEngineEventsArgs} returns this
+ */
+proto.engine_api.StreamEngineEventsArgs.prototype.setOnlyFailedStarlarkRuns = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
@@ -3453,8 +6455,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetServiceLogsResponse.toObject(opt_includeInstance, this);
+proto.engine_api.EngineEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EngineEvent.toObject(opt_includeInstance, this);
 };
 
 
@@ -3463,14 +6465,23 @@ proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetServiceLogsResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.EngineEvent} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.EngineEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceLogsByServiceUuidMap: (f = msg.getServiceLogsByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.LogLine.toObject) : [],
-    notFoundServiceUuidSetMap: (f = msg.getNotFoundServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : []
+    eventUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    type: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    enclaveLabelsMap: (f = msg.getEnclaveLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
+    serviceName: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    filesArtifactName: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    starlarkPackageId: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    isStarlarkRunSuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 10, false),
+    message: jspb.Message.getFieldWithDefault(msg, 11, "")
   };
 
   if (includeInstance) {
@@ -3484,23 +6495,23 @@ proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetServiceLogsResponse}
+ * @return {!proto.engine_api.EngineEvent}
  */
-proto.engine_api.GetServiceLogsResponse.deserializeBinary = function(bytes) {
+proto.engine_api.EngineEvent.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetServiceLogsResponse;
-  return proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EngineEvent;
+  return proto.engine_api.EngineEvent.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetServiceLogsResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EngineEvent} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetServiceLogsResponse}
+ * @return {!proto.engine_api.EngineEvent}
  */
-proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EngineEvent.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3508,17 +6519,52 @@ proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceLogsByServiceUuidMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.LogLine.deserializeBinaryFromReader, "", new proto.engine_api.LogLine());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEventUuid(value);
       break;
     case 2:
-      var value = msg.getNotFoundServiceUuidSetMap();
+      var value = /** @type {!proto.engine_api.EngineEventType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveUuid(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveName(value);
+      break;
+    case 6:
+      var value = msg.getEnclaveLabelsMap();
       reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
          });
       break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceName(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilesArtifactName(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStarlarkPackageId(value);
+      break;
+    case 10:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsStarlarkRunSuccessful(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3532,9 +6578,9 @@ proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
+proto.engine_api.EngineEvent.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.EngineEvent.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3542,431 +6588,380 @@ proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetServiceLogsResponse} message
+ * @param {!proto.engine_api.EngineEvent} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.EngineEvent.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceLogsByServiceUuidMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.LogLine.serializeBinaryToWriter);
+  f = message.getEventUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
   }
-  f = message.getNotFoundServiceUuidSetMap(true);
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getEnclaveUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getEnclaveName();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getEnclaveLabelsMap(true);
   if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 9));
+  if (f != null) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 10));
+  if (f != null) {
+    writer.writeBool(
+      10,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, LogLine> service_logs_by_service_uuid = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.engine_api.LogLine>}
+ * optional string event_uuid = 1;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.getServiceLogsByServiceUuidMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.engine_api.LogLine>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.engine_api.LogLine));
+proto.engine_api.EngineEvent.prototype.getEventUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.GetServiceLogsResponse.prototype.clearServiceLogsByServiceUuidMap = function() {
-  this.getServiceLogsByServiceUuidMap().clear();
-  return this;};
+proto.engine_api.EngineEvent.prototype.setEventUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
 /**
- * map<string, bool> not_found_service_uuid_set = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * optional EngineEventType type = 2;
+ * @return {!proto.engine_api.EngineEventType}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.getNotFoundServiceUuidSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+proto.engine_api.EngineEvent.prototype.getType = function() {
+  return /** @type {!proto.engine_api.EngineEventType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ * @param {!proto.engine_api.EngineEventType} value
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap = function() {
-  this.getNotFoundServiceUuidSetMap().clear();
-  return this;};
-
+proto.engine_api.EngineEvent.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
 
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * optional google.protobuf.Timestamp timestamp = 3;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.engine_api.LogLine.repeatedFields_ = [1];
-
+proto.engine_api.EngineEvent.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.LogLine.toObject(opt_includeInstance, this);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EngineEvent} returns this
+*/
+proto.engine_api.EngineEvent.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.engine_api.LogLine} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
-  };
+proto.engine_api.EngineEvent.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EngineEvent.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.LogLine}
+ * optional string enclave_uuid = 4;
+ * @return {string}
  */
-proto.engine_api.LogLine.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.LogLine;
-  return proto.engine_api.LogLine.deserializeBinaryFromReader(msg, reader);
+proto.engine_api.EngineEvent.prototype.getEnclaveUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.engine_api.LogLine} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.LogLine}
+ * @param {string} value
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addLine(value);
-      break;
-    case 2:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setTimestamp(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.engine_api.EngineEvent.prototype.setEnclaveUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string enclave_name = 5;
+ * @return {string}
  */
-proto.engine_api.LogLine.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.engine_api.LogLine.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.engine_api.EngineEvent.prototype.getEnclaveName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.LogLine} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getLineList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      1,
-      f
-    );
-  }
-  f = message.getTimestamp();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
+proto.engine_api.EngineEvent.prototype.setEnclaveName = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * repeated string line = 1;
- * @return {!Array<string>}
+ * map<string, string> enclave_labels = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.engine_api.LogLine.prototype.getLineList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.engine_api.EngineEvent.prototype.getEnclaveLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.engine_api.LogLine} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLine.prototype.setLineList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.engine_api.EngineEvent.prototype.clearEnclaveLabelsMap = function() {
+  this.getEnclaveLabelsMap().clear();
+  return this;};
+
+
+/**
+ * optional string service_name = 7;
+ * @return {string}
+ */
+proto.engine_api.EngineEvent.prototype.getServiceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.engine_api.LogLine} returns this
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLine.prototype.addLine = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.engine_api.EngineEvent.prototype.setServiceName = function(value) {
+  return jspb.Message.setField(this, 7, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.LogLine} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLine.prototype.clearLineList = function() {
-  return this.setLineList([]);
+proto.engine_api.EngineEvent.prototype.clearServiceName = function() {
+  return jspb.Message.setField(this, 7, undefined);
 };
 
 
 /**
- * optional google.protobuf.Timestamp timestamp = 2;
- * @return {?proto.google.protobuf.Timestamp}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.LogLine.prototype.getTimestamp = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+proto.engine_api.EngineEvent.prototype.hasServiceName = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.engine_api.LogLine} returns this
-*/
-proto.engine_api.LogLine.prototype.setTimestamp = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * optional string files_artifact_name = 8;
+ * @return {string}
+ */
+proto.engine_api.EngineEvent.prototype.getFilesArtifactName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.engine_api.LogLine} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLine.prototype.clearTimestamp = function() {
-  return this.setTimestamp(undefined);
+proto.engine_api.EngineEvent.prototype.setFilesArtifactName = function(value) {
+  return jspb.Message.setField(this, 8, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLine.prototype.hasTimestamp = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.engine_api.EngineEvent.prototype.clearFilesArtifactName = function() {
+  return jspb.Message.setField(this, 8, undefined);
 };
 
 
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EngineEvent.prototype.hasFilesArtifactName = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional string starlark_package_id = 9;
+ * @return {string}
  */
-proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.LogLineFilter.toObject(opt_includeInstance, this);
+proto.engine_api.EngineEvent.prototype.getStarlarkPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.engine_api.LogLineFilter} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    operator: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    textPattern: jspb.Message.getFieldWithDefault(msg, 2, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.engine_api.EngineEvent.prototype.setStarlarkPackageId = function(value) {
+  return jspb.Message.setField(this, 9, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.LogLineFilter}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLineFilter.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.LogLineFilter;
-  return proto.engine_api.LogLineFilter.deserializeBinaryFromReader(msg, reader);
+proto.engine_api.EngineEvent.prototype.clearStarlarkPackageId = function() {
+  return jspb.Message.setField(this, 9, undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.engine_api.LogLineFilter} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.LogLineFilter}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {!proto.engine_api.LogLineOperator} */ (reader.readEnum());
-      msg.setOperator(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTextPattern(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.engine_api.EngineEvent.prototype.hasStarlarkPackageId = function() {
+  return jspb.Message.getField(this, 9) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional bool is_starlark_run_successful = 10;
+ * @return {boolean}
  */
-proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.engine_api.LogLineFilter.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.engine_api.EngineEvent.prototype.getIsStarlarkRunSuccessful = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.LogLineFilter} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {boolean} value
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getOperator();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      1,
-      f
-    );
-  }
-  f = message.getTextPattern();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
+proto.engine_api.EngineEvent.prototype.setIsStarlarkRunSuccessful = function(value) {
+  return jspb.Message.setField(this, 10, value);
 };
 
 
 /**
- * optional LogLineOperator operator = 1;
- * @return {!proto.engine_api.LogLineOperator}
+ * Clears the field making it undefined.
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLineFilter.prototype.getOperator = function() {
-  return /** @type {!proto.engine_api.LogLineOperator} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.engine_api.EngineEvent.prototype.clearIsStarlarkRunSuccessful = function() {
+  return jspb.Message.setField(this, 10, undefined);
 };
 
 
 /**
- * @param {!proto.engine_api.LogLineOperator} value
- * @return {!proto.engine_api.LogLineFilter} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.engine_api.LogLineFilter.prototype.setOperator = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.engine_api.EngineEvent.prototype.hasIsStarlarkRunSuccessful = function() {
+  return jspb.Message.getField(this, 10) != null;
 };
 
 
 /**
- * optional string text_pattern = 2;
+ * optional string message = 11;
  * @return {string}
  */
-proto.engine_api.LogLineFilter.prototype.getTextPattern = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.EngineEvent.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.LogLineFilter} returns this
+ * @return {!proto.engine_api.EngineEvent} returns this
  */
-proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.EngineEvent.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
 };
 
 
@@ -3978,6 +6973,14 @@ proto.engine_api.EnclaveMode = {
   PRODUCTION: 1
 };
 
+/**
+ * @enum {number}
+ */
+proto.engine_api.EnclaveExpiryAction = {
+  ENCLAVEEXPIRYACTION_DESTROY: 0,
+  ENCLAVEEXPIRYACTION_STOP: 1
+};
+
 /**
  * @enum {number}
  */
@@ -4006,4 +7009,20 @@ proto.engine_api.LogLineOperator = {
   LOGLINEOPERATOR_DOES_NOT_CONTAIN_MATCH_REGEX: 3
 };
 
+/**
+ * @enum {number}
+ */
+proto.engine_api.EngineEventType = {
+  ENGINEEVENTTYPE_ENCLAVE_CREATED: 0,
+  ENGINEEVENTTYPE_ENCLAVE_STARTED: 1,
+  ENGINEEVENTTYPE_ENCLAVE_STOPPED: 2,
+  ENGINEEVENTTYPE_ENCLAVE_DESTROYED: 3,
+  ENGINEEVENTTYPE_STARLARK_RUN_STARTED: 4,
+  ENGINEEVENTTYPE_STARLARK_RUN_FINISHED: 5,
+  ENGINEEVENTTYPE_SERVICE_STARTED: 6,
+  ENGINEEVENTTYPE_SERVICE_STOPPED: 7,
+  ENGINEEVENTTYPE_SERVICE_CRASHED: 8,
+  ENGINEEVENTTYPE_FILES_ARTIFACT_CREATED: 9
+};
+
 goog.object.extend(exports, proto.engine_api);
@@ -30,10 +30,16 @@ func NewEnclaveIdentifierArg(
 
 	validate := getValidationFunc(argKey, engineClientCtxKey, isGreedy)
 
+	// Greedy args default to a list, so an optional greedy arg that isn't provided results in no identifiers
+	var defaultValue interface{} = ""
+	if isGreedy {
+		defaultValue = []string{}
+	}
+
 	return &args.ArgConfig{
 		Key:                   argKey,
 		IsOptional:            isOptional,
-		DefaultValue:          "",
+		DefaultValue:          defaultValue,
 		IsGreedy:              isGreedy,
 		ValidationFunc:        validate,
 		ArgCompletionProvider: args.NewManualCompletionsProvider(getCompletions),
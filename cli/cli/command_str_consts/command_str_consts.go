@@ -32,9 +32,14 @@ const (
 	EnclaveLsCmdStr         = "ls"
 	EnclaveAddCmdStr        = "add"
 	EnclaveStopCmdStr       = "stop"
+	EnclaveStartCmdStr      = "start"
+	EnclaveExtendCmdStr     = "extend"
 	EnclaveRmCmdStr         = "rm"
 	EnclaveDumpCmdStr       = "dump"
 	EnclaveConnectCmdStr    = "connect"
+	EnclaveSnapshotCmdStr   = "snapshot"
+	EnclaveRestoreCmdStr    = "restore"
+	EnclaveWatchCmdStr      = "watch"
 	EngineCmdStr            = "engine"
 	EngineLogsCmdStr        = "logs"
 	EngineStartCmdStr       = "start"
@@ -55,6 +60,10 @@ const (
 	PortalStartCmdStr       = "start"
 	PortalStatusCmdStr      = "status"
 	PortalStopCmdStr        = "stop"
+	SecretCmdStr            = "secret"
+	SecretSetCmdStr         = "set"
+	SecretLsCmdStr          = "ls"
+	SecretRmCmdStr          = "rm"
 	ServiceCmdStr           = "service"
 	ServiceAddCmdStr        = "add"
 	ServiceExecCmdStr       = "exec"
@@ -63,6 +72,7 @@ const (
 	ServiceShellCmdStr      = "shell"
 	ServiceStartCmdStr      = "start"
 	ServiceStopCmdStr       = "stop"
+	ServiceStatsCmdStr      = "stats"
 	ServiceInspectCmdStr    = "inspect"
 	StarlarkRunCmdStr       = "run"
 	TwitterCmdStr           = "twitter"
@@ -24,6 +24,10 @@ const (
 	shouldCleanRunningEnclavesFlagKey = "all"
 	defaultShouldCleanRunningEnclaves = "false"
 
+	labelSelectorFlagKey = "selector"
+	// Cleans every enclave
+	defaultLabelSelector = ""
+
 	// Titles of the cleaning phases
 	// Should be lowercased as they'll go into a string like "Cleaning XXXXX...."
 	oldEngineCleaningPhaseTitle = "old Kurtosis engine containers"
@@ -55,6 +59,12 @@ var CleanCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand
 			Type:      flags.FlagType_Bool,
 			Default:   defaultShouldCleanRunningEnclaves,
 		},
+		{
+			Key:     labelSelectorFlagKey,
+			Usage:   "If set, only removes the enclaves whose labels match this comma-separated selector (e.g. 'team=infra,branch!=main')",
+			Type:    flags.FlagType_String,
+			Default: defaultLabelSelector,
+		},
 	},
 	Args:    nil,
 	RunFunc: run,
@@ -73,6 +83,11 @@ func run(
 		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", shouldCleanAll)
 	}
 
+	labelSelector, err := flags.GetString(labelSelectorFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a string flag with key '%v' but none was found; this is an error in Kurtosis!", labelSelectorFlagKey)
+	}
+
 	// Map of cleaning_phase_title -> (successfully_destroyed_object_id, object_destruction_errors, clean_error)
 	cleaningPhaseFunctions := map[string]func() ([]string, []error, error){
 		oldEngineCleaningPhaseTitle: func() ([]string, []error, error) {
@@ -81,7 +96,7 @@ func run(
 		},
 		enclavesCleaningPhaseTitle: func() ([]string, []error, error) {
 			// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
-			return cleanEnclaves(ctx, engineClient, shouldCleanAll)
+			return cleanEnclaves(ctx, engineClient, shouldCleanAll, labelSelector)
 		},
 		unusedImagesPhaseTitle: func() ([]string, []error, error) {
 			// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
@@ -163,8 +178,8 @@ func cleanStoppedEngineContainers(ctx context.Context, kurtosisBackend backend_i
 	return successfulEngineContainerNames, removeEngineErrors, nil
 }
 
-func cleanEnclaves(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, shouldCleanAll bool) ([]string, []error, error) {
-	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{ShouldCleanAll: &shouldCleanAll}
+func cleanEnclaves(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, shouldCleanAll bool, labelSelector string) ([]string, []error, error) {
+	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{ShouldCleanAll: &shouldCleanAll, LabelSelector: &labelSelector}
 	cleanResp, err := engineClient.Clean(ctx, cleanArgs)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while calling clean")
@@ -19,6 +19,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"strings"
+	"time"
 )
 
 const (
@@ -26,6 +27,27 @@ const (
 	apiContainerLogLevelFlagKey  = "api-container-log-level"
 	enclaveNameFlagKey           = "name"
 	enclaveProductionModeFlagKey = "production"
+	enclaveIpv6FlagKey           = "ipv6"
+	enclaveTtlFlagKey            = "ttl"
+	enclaveIdleTimeoutFlagKey    = "idle-timeout"
+	enclaveOnExpiryFlagKey       = "on-expiry"
+	enclaveLabelsFlagKey         = "labels"
+	enclaveFromTemplateFlagKey   = "from-template"
+
+	labelKeyValueDelimiter     = "="
+	labelDeclarationsDelimiter = ","
+
+	// Each label should be KEY1=VALUE1, which means we should have two components to each label declaration
+	expectedNumberKeyValueComponentsInLabelDeclaration = 2
+
+	// Signifies that the enclave never expires
+	noEnclaveExpiryTimeout = ""
+
+	stopOnExpiryFlagValue    = "stop"
+	destroyOnExpiryFlagValue = "destroy"
+
+	// Signifies that the enclave is created empty
+	noEnclavePoolTemplate = ""
 
 	// Signifies that an enclave name should be auto-generated
 	autogenerateEnclaveNameKeyword = ""
@@ -77,6 +99,54 @@ var EnclaveAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCo
 			Type:      flags.FlagType_Bool,
 			Default:   "false",
 		},
+		{
+			Key:     enclaveIpv6FlagKey,
+			Usage:   "If enabled, the enclave network is dual-stack and every service gets an IPv6 address next to its IPv4 one (Docker only)",
+			Type:    flags.FlagType_Bool,
+			Default: "false",
+		},
+		{
+			Key:     enclaveTtlFlagKey,
+			Usage:   "How long the enclave lives before it gets stopped or destroyed by the engine, as a duration like '2h' or '30m' (empty means the enclave never expires)",
+			Type:    flags.FlagType_String,
+			Default: noEnclaveExpiryTimeout,
+		},
+		{
+			Key:     enclaveIdleTimeoutFlagKey,
+			Usage:   "How long the enclave can go without any request to its API container before it gets stopped or destroyed by the engine, as a duration like '30m' (empty means the enclave never expires)",
+			Type:    flags.FlagType_String,
+			Default: noEnclaveExpiryTimeout,
+		},
+		{
+			Key: enclaveOnExpiryFlagKey,
+			Usage: fmt.Sprintf(
+				"What the engine does with the enclave once its TTL or idle timeout is reached (%v|%v)",
+				destroyOnExpiryFlagValue,
+				stopOnExpiryFlagValue,
+			),
+			Type:    flags.FlagType_String,
+			Default: destroyOnExpiryFlagValue,
+		},
+		{
+			Key: enclaveLabelsFlagKey,
+			Usage: fmt.Sprintf(
+				"String containing labels to attach to the enclave, in the form \"KEY1%vVALUE1%vKEY2%vVALUE2\"; "+
+					"the enclaves can then be selected by label in commands like '%v %v'",
+				labelKeyValueDelimiter,
+				labelDeclarationsDelimiter,
+				labelKeyValueDelimiter,
+				command_str_consts.EnclaveCmdStr,
+				command_str_consts.EnclaveLsCmdStr,
+			),
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
+		{
+			Key:     enclaveFromTemplateFlagKey,
+			Usage:   "The enclave pool template, set on the engine, to create the enclave from; the enclave comes with the packages of the template already run in it, and is a warm one from the enclave pool if the pool has one ready",
+			Type:    flags.FlagType_String,
+			Default: noEnclavePoolTemplate,
+		},
 	},
 }
 
@@ -119,7 +189,7 @@ func run(
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating an engine manager.")
 	}
-	engineClient, closeClientFunc, err := engineManager.StartEngineIdempotentlyWithDefaultVersion(ctx, defaults.DefaultEngineLogLevel, defaults.DefaultEngineEnclavePoolSize, defaults.DefaultGitHubAuthTokenOverride)
+	engineClient, closeClientFunc, err := engineManager.StartEngineIdempotentlyWithDefaultVersion(ctx, defaults.DefaultEngineLogLevel, defaults.DefaultEngineEnclavePoolSize, defaults.DefaultEngineHostPortRanges, defaults.DefaultGitHubAuthTokenOverride)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating a new Kurtosis engine client")
 	}
@@ -136,17 +206,61 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred while getting the enclave mode using flag with key '%v'; this is a bug in Kurtosis", enclaveProductionModeFlagKey)
 	}
 
+	isIpv6Enabled, err := flags.GetBool(enclaveIpv6FlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting whether IPv6 is enabled using flag with key '%v'; this is a bug in Kurtosis", enclaveIpv6FlagKey)
+	}
+
 	mode := kurtosis_engine_rpc_api_bindings.EnclaveMode_TEST
 	if isProduction {
 		mode = kurtosis_engine_rpc_api_bindings.EnclaveMode_PRODUCTION
 	}
 
+	ttlSeconds, err := getExpiryTimeoutSeconds(flags, enclaveTtlFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave TTL")
+	}
+
+	idleTimeoutSeconds, err := getExpiryTimeoutSeconds(flags, enclaveIdleTimeoutFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave idle timeout")
+	}
+
+	expiryAction, err := getExpiryAction(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave expiry action")
+	}
+
+	labelsStr, err := flags.GetString(enclaveLabelsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave labels using flag with key '%v'; this is a bug in Kurtosis", enclaveLabelsFlagKey)
+	}
+	labels, err := parseLabelsStr(labelsStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the enclave labels string '%v'", labelsStr)
+	}
+
+	fromTemplate, err := flags.GetString(enclaveFromTemplateFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave pool template using flag with key '%v'; this is a bug in Kurtosis", enclaveFromTemplateFlagKey)
+	}
+	var maybeFromTemplate *string
+	if fromTemplate != noEnclavePoolTemplate {
+		maybeFromTemplate = &fromTemplate
+	}
+
 	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
 		EnclaveName:              &enclaveName,
 		ApiContainerVersionTag:   &apiContainerVersion,
 		ApiContainerLogLevel:     &kurtosisLogLevelStr,
 		Mode:                     &mode,
 		ShouldApicRunInDebugMode: &shouldApicRunInDebugMode,
+		IsIpv6Enabled:            &isIpv6Enabled,
+		TtlSeconds:               ttlSeconds,
+		IdleTimeoutSeconds:       idleTimeoutSeconds,
+		ExpiryAction:             &expiryAction,
+		Labels:                   labels,
+		FromTemplate:             maybeFromTemplate,
 	}
 	createdEnclaveResponse, err := engineClient.CreateEnclave(ctx, createEnclaveArgs)
 	if err != nil {
@@ -160,3 +274,68 @@ func run(
 
 	return nil
 }
+
+// getExpiryTimeoutSeconds returns nil if the flag wasn't set
+func getExpiryTimeoutSeconds(flags *flags.ParsedFlags, flagKey string) (*int64, error) {
+	timeoutStr, err := flags.GetString(flagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the timeout using flag with key '%v'; this is a bug in Kurtosis", flagKey)
+	}
+	if timeoutStr == noEnclaveExpiryTimeout {
+		return nil, nil
+	}
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "The value '%v' of flag '%v' isn't a valid duration", timeoutStr, flagKey)
+	}
+	if timeout < time.Second {
+		return nil, stacktrace.NewError("The value '%v' of flag '%v' must be at least one second", timeoutStr, flagKey)
+	}
+	timeoutSeconds := int64(timeout.Seconds())
+	return &timeoutSeconds, nil
+}
+
+func getExpiryAction(flags *flags.ParsedFlags) (kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction, error) {
+	expiryActionStr, err := flags.GetString(enclaveOnExpiryFlagKey)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred while getting the expiry action using flag with key '%v'; this is a bug in Kurtosis", enclaveOnExpiryFlagKey)
+	}
+	switch expiryActionStr {
+	case destroyOnExpiryFlagValue:
+		return kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY, nil
+	case stopOnExpiryFlagValue:
+		return kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_STOP, nil
+	default:
+		return 0, stacktrace.NewError("Unrecognized value '%v' for flag '%v'; valid values are '%v' and '%v'", expiryActionStr, enclaveOnExpiryFlagKey, destroyOnExpiryFlagValue, stopOnExpiryFlagValue)
+	}
+}
+
+// Parses a string in the form KEY1=VALUE1,KEY2=VALUE2 into a map of labels
+// Empty declarations are skipped, so an empty string results in an empty map
+func parseLabelsStr(labelsStr string) (map[string]string, error) {
+	result := map[string]string{}
+	for _, labelDeclarationStr := range strings.Split(labelsStr, labelDeclarationsDelimiter) {
+		labelDeclarationStr = strings.TrimSpace(labelDeclarationStr)
+		if labelDeclarationStr == "" {
+			continue
+		}
+
+		labelKeyValueComponents := strings.SplitN(labelDeclarationStr, labelKeyValueDelimiter, expectedNumberKeyValueComponentsInLabelDeclaration)
+		if len(labelKeyValueComponents) < expectedNumberKeyValueComponentsInLabelDeclaration {
+			return nil, stacktrace.NewError("Label declaration string '%v' must be of the form KEY1%vVALUE1", labelDeclarationStr, labelKeyValueDelimiter)
+		}
+		key := labelKeyValueComponents[0]
+		value := labelKeyValueComponents[1]
+
+		if preexistingValue, found := result[key]; found {
+			return nil, stacktrace.NewError(
+				"Cannot assign label '%v' to value '%v' because the key has previously been assigned to value '%v'",
+				key,
+				value,
+				preexistingValue,
+			)
+		}
+		result[key] = value
+	}
+	return result, nil
+}
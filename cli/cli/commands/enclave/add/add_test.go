@@ -0,0 +1,28 @@
+package add
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseLabelsStr(t *testing.T) {
+	labels, err := parseLabelsStr("team=infra, branch=feature/x=y")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"team": "infra", "branch": "feature/x=y"}, labels)
+}
+
+func TestParseLabelsStr_EmptyDeclarationsAreSkipped(t *testing.T) {
+	labels, err := parseLabelsStr(",, ,")
+	require.NoError(t, err)
+	require.Empty(t, labels)
+}
+
+func TestParseLabelsStr_DuplicateKeyFails(t *testing.T) {
+	_, err := parseLabelsStr("team=infra,team=core")
+	require.Error(t, err)
+}
+
+func TestParseLabelsStr_MissingValueFails(t *testing.T) {
+	_, err := parseLabelsStr("team")
+	require.Error(t, err)
+}
@@ -0,0 +1,94 @@
+package extend
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"time"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	durationFlagKey     = "duration"
+	defaultDurationFlag = "1h"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveExtendCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveExtendCmdStr,
+	ShortDescription: "Extends the expiry of an enclave",
+	LongDescription: "Pushes back the time at which an enclave created with a TTL or an idle timeout gets stopped or " +
+		"destroyed by the engine. The extension counts from the current expiry time, or from now if the enclave is " +
+		"about to be reaped",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     durationFlagKey,
+			Usage:   "How much longer the enclave lives, as a duration like '2h' or '30m'",
+			Type:    flags.FlagType_String,
+			Default: defaultDurationFlag,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier arg using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	durationStr, err := flags.GetString(durationFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", durationFlagKey)
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "The value '%v' of flag '%v' isn't a valid duration", durationStr, durationFlagKey)
+	}
+	if duration < time.Second {
+		return stacktrace.NewError("The value '%v' of flag '%v' must be at least one second", durationStr, durationFlagKey)
+	}
+
+	extendArgs := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		ExtensionSeconds:  int64(duration.Seconds()),
+	}
+	response, err := engineClient.ExtendEnclave(ctx, extendArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred extending enclave '%v'", enclaveIdentifier)
+	}
+
+	out.PrintOutLn(fmt.Sprintf("Enclave '%v' now expires at %v", enclaveIdentifier, response.GetExpiryTime().AsTime().Local().Format(time.RFC1123)))
+	return nil
+}
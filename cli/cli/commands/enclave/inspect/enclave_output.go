@@ -0,0 +1,96 @@
+package inspect
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"strings"
+	"time"
+)
+
+const (
+	enclaveInspectOutputKind = "EnclaveInspect"
+
+	apiContainerStatusPrefix = "EnclaveAPIContainerStatus_"
+)
+
+// enclaveInspectOutput is how an enclave is rendered in the JSON and YAML output formats. Services and files artifacts
+// are only filled in when the API container of the enclave is running
+type enclaveInspectOutput struct {
+	Uuid               string                             `json:"uuid" yaml:"uuid"`
+	ShortenedUuid      string                             `json:"shortened_uuid" yaml:"shortened_uuid"`
+	Name               string                             `json:"name" yaml:"name"`
+	Status             string                             `json:"status" yaml:"status"`
+	ApiContainerStatus string                             `json:"api_container_status" yaml:"api_container_status"`
+	CreationTime       string                             `json:"creation_time" yaml:"creation_time"`
+	Mode               string                             `json:"mode" yaml:"mode"`
+	Services           []*user_services.UserServiceOutput `json:"services" yaml:"services"`
+	FilesArtifacts     []*filesArtifactOutput             `json:"files_artifacts" yaml:"files_artifacts"`
+}
+
+type filesArtifactOutput struct {
+	Uuid          string `json:"uuid" yaml:"uuid"`
+	ShortenedUuid string `json:"shortened_uuid" yaml:"shortened_uuid"`
+	Name          string `json:"name" yaml:"name"`
+}
+
+func printEnclaveInspectStructuredOutput(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveIdentifier string, outputFormat output_printers.OutputFormat) error {
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", enclaveIdentifier)
+	}
+	enclaveStatus, err := enclave_status_stringifier.EnclaveContainersStatusPlainStringifier(enclaveInfo.GetContainersStatus())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status")
+	}
+	enclaveCreationTime := ""
+	if enclaveInfo.GetCreationTime() != nil {
+		enclaveCreationTime = enclaveInfo.GetCreationTime().AsTime().Format(time.RFC3339)
+	}
+	output := &enclaveInspectOutput{
+		Uuid:               enclaveInfo.GetEnclaveUuid(),
+		ShortenedUuid:      enclaveInfo.GetShortenedUuid(),
+		Name:               enclaveInfo.GetName(),
+		Status:             enclaveStatus,
+		ApiContainerStatus: strings.TrimPrefix(enclaveInfo.GetApiContainerStatus().String(), apiContainerStatusPrefix),
+		CreationTime:       enclaveCreationTime,
+		Mode:               strings.ToLower(enclaveInfo.GetMode().String()),
+		Services:           []*user_services.UserServiceOutput{},
+		FilesArtifacts:     []*filesArtifactOutput{},
+	}
+
+	isApiContainerRunning := enclaveInfo.GetApiContainerStatus() == kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING
+	if isApiContainerRunning {
+		allServicesMap := map[string]bool{}
+		userServices, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, allServicesMap)
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+		}
+		for _, userService := range user_services.GetSortedUserServiceSliceFromUserServiceMap(userServices) {
+			output.Services = append(output.Services, user_services.NewUserServiceOutput(userService))
+		}
+
+		enclaveContext, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveInfo.GetName())
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while fetching enclave with name '%v'", enclaveInfo.GetName())
+		}
+		filesArtifactsNamesAndUuids, err := enclaveContext.GetAllFilesArtifactNamesAndUuids(ctx)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while fetching files artifacts name and uuids for enclave '%v'", enclaveContext.GetEnclaveName())
+		}
+		for _, filesArtifactNameAndUuid := range sortFileNamesAndUuids(filesArtifactsNamesAndUuids) {
+			output.FilesArtifacts = append(output.FilesArtifacts, &filesArtifactOutput{
+				Uuid:          filesArtifactNameAndUuid.GetFileUuid(),
+				ShortenedUuid: uuid_generator.ShortenedUUIDString(filesArtifactNameAndUuid.GetFileUuid()),
+				Name:          filesArtifactNameAndUuid.GetFileName(),
+			})
+		}
+	}
+
+	return output_printers.PrintStructuredOutput(outputFormat, enclaveInspectOutputKind, output)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/container_status_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/service_health_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
 	"github.com/kurtosis-tech/stacktrace"
 )
@@ -16,6 +17,7 @@ const (
 	userServiceNameColHeader   = "Name"
 	userServicePortsColHeader  = "Ports"
 	userServiceStatusColHeader = "Status"
+	userServiceHealthColHeader = "Health"
 )
 
 func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, showFullUuids bool, isAPIContainerRunning bool) error {
@@ -34,6 +36,7 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		userServiceNameColHeader,
 		userServicePortsColHeader,
 		userServiceStatusColHeader,
+		userServiceHealthColHeader,
 	)
 	sortedUserServices := user_services.GetSortedUserServiceSliceFromUserServiceMap(userServices)
 	for _, userService := range sortedUserServices {
@@ -46,6 +49,7 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 
 		containerStatus := userService.GetContainer().GetStatus()
 		containerStatusStr := container_status_stringifier.ContainerStatusStringifier(containerStatus)
+		serviceHealthStr := service_health_stringifier.ServiceHealthStringifier(userService.GetHealth())
 
 		portBindingLines, err := user_services.GetUserServicePortBindingStrings(userService)
 		if err != nil {
@@ -54,7 +58,7 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		firstPortBindingLine := portBindingLines[0]
 		additionalPortBindingLines := portBindingLines[1:]
 
-		if err := tablePrinter.AddRow(uuidToPrint, serviceIdStr, firstPortBindingLine, containerStatusStr); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, serviceIdStr, firstPortBindingLine, containerStatusStr, serviceHealthStr); err != nil {
 			return stacktrace.Propagate(
 				err,
 				"An error occurred adding row for user service with UUID '%v' to the table printer",
@@ -63,7 +67,7 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		}
 
 		for _, additionalPortBindingLine := range additionalPortBindingLines {
-			if err := tablePrinter.AddRow("", "", additionalPortBindingLine, ""); err != nil {
+			if err := tablePrinter.AddRow("", "", additionalPortBindingLine, "", ""); err != nil {
 				return stacktrace.Propagate(
 					err,
 					"An error occurred adding additional port binding row '%v' for user service with UUID '%v' to the table printer",
@@ -28,6 +28,8 @@ const (
 	enclaveStatusColumnHeader       = "Status"
 	enclaveNameColumnHeader         = "Name"
 	enclaveCreationTimeColumnHeader = "Creation Time"
+	enclaveExpiryTimeColumnHeader   = "Expires"
+	enclaveLabelsColumnHeader       = "Labels"
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
@@ -35,9 +37,33 @@ const (
 	fullUuidsFlagKey       = "full-uuids"
 	fullUuidFlagKeyDefault = "false"
 
+	labelSelectorFlagKey = "selector"
+	// Lists every enclave
+	defaultLabelSelector = ""
+
+	labelKeyValueDelimiter     = "="
+	labelDeclarationsDelimiter = ","
+
 	emptyTimeForOldEnclaves = ""
+
+	// Shown for the enclaves created without a TTL nor an idle timeout
+	noExpiryTime = ""
+
+	enclaveListOutputKind = "EnclaveList"
 )
 
+// enclaveOutput is how each enclave is rendered in the JSON and YAML output formats
+type enclaveOutput struct {
+	Uuid          string            `json:"uuid" yaml:"uuid"`
+	ShortenedUuid string            `json:"shortened_uuid" yaml:"shortened_uuid"`
+	Name          string            `json:"name" yaml:"name"`
+	Status        string            `json:"status" yaml:"status"`
+	CreationTime  string            `json:"creation_time" yaml:"creation_time"`
+	Mode          string            `json:"mode" yaml:"mode"`
+	ExpiryTime    string            `json:"expiry_time,omitempty" yaml:"expiry_time,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
 var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.EnclaveLsCmdStr,
 	ShortDescription:          "Lists enclaves",
@@ -51,6 +77,13 @@ var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			Type:    flags.FlagType_Bool,
 			Default: fullUuidFlagKeyDefault,
 		},
+		{
+			Key:       labelSelectorFlagKey,
+			Shorthand: "l",
+			Usage:     "Only list the enclaves whose labels match this comma-separated selector, supporting 'key=value', 'key!=value', 'key' and '!key' requirements (e.g. 'team=infra,branch!=main')",
+			Type:      flags.FlagType_String,
+			Default:   defaultLabelSelector,
+		},
 	},
 	Args:    nil,
 	RunFunc: run,
@@ -69,9 +102,14 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
 	}
 
-	enclaves, err := kurtosisCtx.GetEnclaves(ctx)
+	labelSelector, err := flags.GetString(labelSelectorFlagKey)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting enclaves")
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", labelSelectorFlagKey)
+	}
+
+	enclaves, err := kurtosisCtx.GetEnclavesMatchingLabelSelector(ctx, labelSelector)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves matching label selector '%v'", labelSelector)
 	}
 
 	showFullUuids, err := flags.GetBool(fullUuidsFlagKey)
@@ -79,9 +117,22 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
 	}
 
-	tablePrinter := output_printers.NewTablePrinter(enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader)
+	outputFormat, err := output_printers.GetOutputFormat(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader, enclaveExpiryTimeColumnHeader, enclaveLabelsColumnHeader)
 	orderedEnclaveInfoMaps, enclaveWithoutCreationTimeInfoMap := getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(enclaves.GetEnclavesByUuid())
 
+	if outputFormat.IsStructured() {
+		enclavesOutput, err := getEnclavesOutput(enclaveWithoutCreationTimeInfoMap, orderedEnclaveInfoMaps)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred building the list of enclaves to print")
+		}
+		return output_printers.PrintStructuredOutput(outputFormat, enclaveListOutputKind, enclavesOutput)
+	}
+
 	//TODO remove this iteration after 2023-01-01 when we are sure that there is not any old enclave created without the creation time label
 	//This is for retro-compatibility, for those old enclave did not track enclave's creation time
 	for _, enclaveInfo := range enclaveWithoutCreationTimeInfoMap {
@@ -96,7 +147,7 @@ func run(
 			return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
 		}
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveInfo.Name, enclaveStatus, emptyTimeForOldEnclaves); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, enclaveInfo.Name, enclaveStatus, emptyTimeForOldEnclaves, getEnclaveExpiryTimeStr(enclaveInfo), getEnclaveLabelsStr(enclaveInfo)); err != nil {
 			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
 		}
 	}
@@ -120,7 +171,7 @@ func run(
 
 		enclaveName := enclaveInfo.GetName()
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveName, enclaveStatus, enclaveCreationTime); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, enclaveName, enclaveStatus, enclaveCreationTime, getEnclaveExpiryTimeStr(enclaveInfo), getEnclaveLabelsStr(enclaveInfo)); err != nil {
 			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
 		}
 	}
@@ -130,6 +181,62 @@ func run(
 	return nil
 }
 
+func getEnclavesOutput(
+	enclaveWithoutCreationTimeInfoMap map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo,
+	orderedEnclaveInfoMaps []*kurtosis_engine_rpc_api_bindings.EnclaveInfo,
+) ([]*enclaveOutput, error) {
+	enclaveInfos := []*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	for _, enclaveInfo := range enclaveWithoutCreationTimeInfoMap {
+		enclaveInfos = append(enclaveInfos, enclaveInfo)
+	}
+	enclaveInfos = append(enclaveInfos, orderedEnclaveInfoMaps...)
+
+	enclavesOutput := []*enclaveOutput{}
+	for _, enclaveInfo := range enclaveInfos {
+		enclaveStatus, err := enclave_status_stringifier.EnclaveContainersStatusPlainStringifier(enclaveInfo.GetContainersStatus())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
+		}
+		enclaveCreationTime := emptyTimeForOldEnclaves
+		if enclaveInfo.GetCreationTime() != nil {
+			enclaveCreationTime = enclaveInfo.GetCreationTime().AsTime().Format(time.RFC3339)
+		}
+		enclaveExpiryTime := noExpiryTime
+		if enclaveInfo.GetExpiryTime() != nil {
+			enclaveExpiryTime = enclaveInfo.GetExpiryTime().AsTime().Format(time.RFC3339)
+		}
+		enclavesOutput = append(enclavesOutput, &enclaveOutput{
+			Uuid:          enclaveInfo.GetEnclaveUuid(),
+			ShortenedUuid: enclaveInfo.GetShortenedUuid(),
+			Name:          enclaveInfo.GetName(),
+			Status:        enclaveStatus,
+			CreationTime:  enclaveCreationTime,
+			Mode:          strings.ToLower(enclaveInfo.GetMode().String()),
+			ExpiryTime:    enclaveExpiryTime,
+			Labels:        enclaveInfo.GetLabels(),
+		})
+	}
+	return enclavesOutput, nil
+}
+
+func getEnclaveExpiryTimeStr(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) string {
+	if enclaveInfo.GetExpiryTime() == nil {
+		return noExpiryTime
+	}
+	// The extra space is a hack till we figure out the table printer color + formatting story
+	return " " + enclaveInfo.GetExpiryTime().AsTime().Local().Format(time.RFC1123)
+}
+
+// getEnclaveLabelsStr renders the labels sorted by key, so the column is stable across runs
+func getEnclaveLabelsStr(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) string {
+	labelDeclarations := []string{}
+	for key, value := range enclaveInfo.GetLabels() {
+		labelDeclarations = append(labelDeclarations, key+labelKeyValueDelimiter+value)
+	}
+	sort.Strings(labelDeclarations)
+	return strings.Join(labelDeclarations, labelDeclarationsDelimiter)
+}
+
 func getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(
 	enclaveInfoMap map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo,
 ) (
@@ -0,0 +1,195 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	enclave_consts "github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/enclave"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	command_args_run "github.com/kurtosis-tech/kurtosis/cli/cli/command_args/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_snapshot"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/mholt/archiver"
+	"github.com/sirupsen/logrus"
+	"os"
+	"path"
+)
+
+const (
+	snapshotFilepathArgKey = "snapshot-filepath"
+	isSnapshotArgOptional  = false
+	defaultSnapshotArg     = ""
+
+	enclaveNameFlagKey = "name"
+	// Signifies that the enclave gets the name of the enclave the snapshot was taken from
+	useSnapshotEnclaveNameKeyword = ""
+
+	parallelismFlagKey = "parallelism"
+	defaultParallelism = "4"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	defaultTmpDir = ""
+	tmpDirPattern = "kurtosis-enclave-restore-*"
+
+	isNotDryRun        = false
+	doNotShowFullUuids = false
+	restoreVerbosity   = command_args_run.Description
+)
+
+// EnclaveRestoreCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var EnclaveRestoreCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveRestoreCmdStr,
+	ShortDescription: "Restores an enclave from a snapshot",
+	LongDescription: "Creates a new enclave from an archive produced by '" + command_str_consts.EnclaveCmdStr + " " +
+		command_str_consts.EnclaveSnapshotCmdStr + "', with the same files artifacts, persistent directories content " +
+		"and services, which are started again with the same names and configurations",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       enclaveNameFlagKey,
+			Shorthand: "n",
+			Default:   useSnapshotEnclaveNameKeyword,
+			Usage: fmt.Sprintf(
+				"The name to give the restored enclave, which must match regex '%v' "+
+					"(emptystring will reuse the name of the enclave the snapshot was taken from)",
+				enclave_consts.AllowedEnclaveNameCharsRegexStr,
+			),
+			Type: flags.FlagType_String,
+		},
+		{
+			Key:     parallelismFlagKey,
+			Usage:   "The number of instructions which can be run in parallel while restoring the enclave",
+			Type:    flags.FlagType_Uint32,
+			Default: defaultParallelism,
+		},
+	},
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewFilepathArg(
+			snapshotFilepathArgKey,
+			isSnapshotArgOptional,
+			defaultSnapshotArg,
+			file_system_path_arg.DefaultValidationFunc,
+		),
+	},
+	RunFunc: runRestore,
+}
+
+func runRestore(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	snapshotFilepath, err := args.GetNonGreedyArg(snapshotFilepathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the snapshot filepath using arg key '%v'", snapshotFilepathArgKey)
+	}
+	enclaveName, err := flags.GetString(enclaveNameFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave name using flag with key '%v'; this is a bug in Kurtosis", enclaveNameFlagKey)
+	}
+	parallelism, err := flags.GetUint32(parallelismFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the parallelism using flag with key '%v'; this is a bug in Kurtosis", parallelismFlagKey)
+	}
+
+	tmpDirpath, err := os.MkdirTemp(defaultTmpDir, tmpDirPattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary directory to extract snapshot '%v' to", snapshotFilepath)
+	}
+	defer os.RemoveAll(tmpDirpath)
+	if err = archiver.Unarchive(snapshotFilepath, tmpDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred extracting snapshot '%v'", snapshotFilepath)
+	}
+	snapshotRootDirpath := path.Join(tmpDirpath, enclave_snapshot.SnapshotRootDirname)
+
+	manifest, err := enclave_snapshot.ReadManifest(snapshotRootDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the manifest of snapshot '%v'", snapshotFilepath)
+	}
+	serializedEnclavePlan, err := enclave_snapshot.ReadEnclavePlan(snapshotRootDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the enclave plan of snapshot '%v'", snapshotFilepath)
+	}
+	if manifest.KurtosisVersion != kurtosis_version.KurtosisVersion {
+		logrus.Warnf("Snapshot '%v' was taken with Kurtosis '%v' but is being restored with Kurtosis '%v'; if the restore fails, try again with the version the snapshot was taken with", snapshotFilepath, manifest.KurtosisVersion, kurtosis_version.KurtosisVersion)
+	}
+	if enclaveName == useSnapshotEnclaveNameKeyword {
+		enclaveName = manifest.EnclaveName
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+	logrus.Infof("Creating enclave '%v' to restore snapshot '%v' in...", enclaveName, snapshotFilepath)
+	enclaveCtx, err := kurtosisCtx.CreateEnclave(ctx, enclaveName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating enclave '%v'", enclaveName)
+	}
+
+	persistentDirectoryFilesArtifacts, err := uploadSnapshotFiles(enclaveCtx, snapshotRootDirpath, manifest)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred uploading the files of snapshot '%v' to enclave '%v'", snapshotFilepath, enclaveName)
+	}
+
+	responseLineChan, cancelFunc, err := enclaveCtx.RestoreEnclaveSnapshot(ctx, serializedEnclavePlan, persistentDirectoryFilesArtifacts, manifest.ServiceImages, int32(parallelism))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the restoration of snapshot '%v' in enclave '%v'", snapshotFilepath, enclaveName)
+	}
+	if err = run.ReadAndPrintResponseLinesUntilClosed(responseLineChan, cancelFunc, restoreVerbosity, isNotDryRun); err != nil {
+		return err
+	}
+
+	if err = inspect.PrintEnclaveInspect(ctx, kurtosisCtx, enclaveName, doNotShowFullUuids); err != nil {
+		logrus.Errorf("An error occurred while printing enclave status and contents:\n%s", err)
+	}
+	return nil
+}
+
+// uploadSnapshotFiles uploads the files artifacts of the snapshot under their original names, as well as the content
+// of the persistent directories, returning the name of the files artifact each persistent directory got uploaded to
+func uploadSnapshotFiles(enclaveCtx *enclaves.EnclaveContext, snapshotRootDirpath string, manifest *enclave_snapshot.Manifest) (map[string]string, error) {
+	for _, filesArtifactName := range manifest.FilesArtifactNames {
+		filesArtifactDirpath := path.Join(snapshotRootDirpath, enclave_snapshot.FilesArtifactsDirname, filesArtifactName)
+		if _, _, err := enclaveCtx.UploadFiles(filesArtifactDirpath, filesArtifactName); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred uploading files artifact '%v'", filesArtifactName)
+		}
+	}
+
+	persistentDirectoryFilesArtifacts := map[string]string{}
+	for _, persistentKey := range manifest.PersistentDirectoryKeys {
+		persistentDirectoryDirpath := path.Join(snapshotRootDirpath, enclave_snapshot.PersistentDirectoriesDirname, persistentKey)
+		persistentDirectoryContent, err := os.ReadDir(persistentDirectoryDirpath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the content of persistent directory '%v'", persistentKey)
+		}
+		if len(persistentDirectoryContent) == 0 {
+			logrus.Debugf("Persistent directory '%v' is empty, it doesn't need to be seeded", persistentKey)
+			continue
+		}
+		filesArtifactName := enclave_snapshot.GetPersistentDirectoryFilesArtifactName(persistentKey)
+		if _, _, err = enclaveCtx.UploadFiles(persistentDirectoryDirpath, filesArtifactName); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred uploading the content of persistent directory '%v'", persistentKey)
+		}
+		persistentDirectoryFilesArtifacts[persistentKey] = filesArtifactName
+	}
+	return persistentDirectoryFilesArtifacts, nil
+}
@@ -21,12 +21,17 @@ import (
 
 const (
 	enclaveIdentifiersArgKey = "enclaves"
-	isEnclaveIdArgOptional   = false
-	isEnclaveIdArgGreedy     = true
+	// The enclaves can be selected with a label selector instead
+	isEnclaveIdArgOptional = true
+	isEnclaveIdArgGreedy   = true
 
 	shouldForceRemoveFlagKey = "force"
 	defaultShouldForceRemove = "false"
 
+	labelSelectorFlagKey = "selector"
+	// Signifies that the enclaves are selected by identifier
+	noLabelSelector = ""
+
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
 )
@@ -34,7 +39,7 @@ const (
 var EnclaveRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.EnclaveRmCmdStr,
 	ShortDescription:          "Destroys the specified enclaves",
-	LongDescription:           "Destroys the specified enclaves, removing all resources associated with them",
+	LongDescription:           "Destroys the specified enclaves, or the ones matching a label selector, removing all resources associated with them",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -45,6 +50,12 @@ var EnclaveRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			Type:      flags.FlagType_Bool,
 			Default:   defaultShouldForceRemove,
 		},
+		{
+			Key:     labelSelectorFlagKey,
+			Usage:   "Destroys the enclaves whose labels match this comma-separated selector (e.g. 'team=infra,branch!=main') instead of the specified ones",
+			Type:    flags.FlagType_String,
+			Default: noLabelSelector,
+		},
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
@@ -75,11 +86,37 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the force-removal flag value using key '%v'; this is a bug in Kurtosis!", shouldForceRemoveFlagKey)
 	}
 
+	labelSelector, err := flags.GetString(labelSelectorFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the label selector flag value using key '%v'; this is a bug in Kurtosis!", labelSelectorFlagKey)
+	}
+
+	if len(enclaveIdentifiers) == 0 && labelSelector == noLabelSelector {
+		return stacktrace.NewError("No enclaves to destroy were specified; pass the enclave identifiers or select the enclaves with the '%v' flag", labelSelectorFlagKey)
+	}
+	if len(enclaveIdentifiers) > 0 && labelSelector != noLabelSelector {
+		return stacktrace.NewError("The enclaves to destroy can be specified either by identifier or with the '%v' flag, but not both", labelSelectorFlagKey)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
 	}
 
+	if labelSelector != noLabelSelector {
+		matchingEnclaves, err := kurtosisCtx.GetEnclavesMatchingLabelSelector(ctx, labelSelector)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the enclaves matching label selector '%v'", labelSelector)
+		}
+		for enclaveUuid := range matchingEnclaves.GetEnclavesByUuid() {
+			enclaveIdentifiers = append(enclaveIdentifiers, enclaveUuid)
+		}
+		if len(enclaveIdentifiers) == 0 {
+			logrus.Infof("No enclaves match label selector '%v'; nothing to destroy", labelSelector)
+			return nil
+		}
+	}
+
 	logrus.Debugf("inputted enclave UUIDs: %+v", enclaveIdentifiers)
 
 	// Condense the enclave UUIDs down into a unique set, so we don't try to double-destroy an enclave
@@ -0,0 +1,278 @@
+package snapshot
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_snapshot"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/files"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/mholt/archiver"
+	"github.com/sirupsen/logrus"
+	"os"
+	"path"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	outputFilepathArg = "output-filepath"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	defaultSnapshotFilepath = "kurtosis-snapshot.tgz"
+	snapshotFilenameSuffix  = "--snapshot.tgz"
+	outputFileIsOptional    = true
+
+	snapshotDirPermission = 0o755
+
+	defaultTmpDir        = ""
+	tmpDirPattern        = "kurtosis-enclave-snapshot-*"
+	persistentDirTarName = "persistent-directory.tar"
+)
+
+// EnclaveSnapshotCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var EnclaveSnapshotCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveSnapshotCmdStr,
+	ShortDescription: "Snapshots an enclave to a portable archive",
+	LongDescription: "Bundles the plan of the instructions executed in an enclave, all its files artifacts, the content of " +
+		"the persistent directories of its services and the images they use into a single archive, which can be " +
+		"restored on any backend with '" + command_str_consts.EnclaveCmdStr + " " + command_str_consts.EnclaveRestoreCmdStr + "'",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     nil,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		file_system_path_arg.NewFilepathArg(
+			outputFilepathArg,
+			outputFileIsOptional,
+			defaultSnapshotFilepath,
+			file_system_path_arg.BypassDefaultValidationFunc,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+	snapshotFilepath, err := args.GetNonGreedyArg(outputFilepathArg)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting output filepath using arg key '%v'", outputFilepathArg)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting enclave for identifier '%v'", enclaveIdentifier)
+	}
+	if enclaveInfo.ApiContainerStatus != kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+		return stacktrace.NewError("Enclave '%v' can't be snapshotted as it is not running", enclaveIdentifier)
+	}
+	enclaveName := enclaveInfo.GetName()
+	if snapshotFilepath == defaultSnapshotFilepath {
+		snapshotFilepath = enclaveName + snapshotFilenameSuffix
+	}
+	if _, err = os.Stat(snapshotFilepath); err == nil {
+		return stacktrace.NewError("Can't write the snapshot of enclave '%v' to '%v' as the file already exists", enclaveIdentifier, snapshotFilepath)
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while retrieving enclave context for enclave with identifier '%v'", enclaveIdentifier)
+	}
+
+	tmpDirpath, err := os.MkdirTemp(defaultTmpDir, tmpDirPattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary directory to assemble the snapshot of enclave '%v'", enclaveIdentifier)
+	}
+	defer os.RemoveAll(tmpDirpath)
+	snapshotRootDirpath := path.Join(tmpDirpath, enclave_snapshot.SnapshotRootDirname)
+	if err = os.Mkdir(snapshotRootDirpath, snapshotDirPermission); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating directory '%v'", snapshotRootDirpath)
+	}
+
+	snapshotInfo, err := enclaveCtx.GetEnclaveSnapshotInfo(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the snapshot info of enclave '%v'", enclaveIdentifier)
+	}
+	if err = enclave_snapshot.WriteEnclavePlan(snapshotRootDirpath, snapshotInfo.GetSerializedEnclavePlan()); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the plan of enclave '%v' to the snapshot", enclaveIdentifier)
+	}
+
+	filesArtifactNames, err := snapshotFilesArtifacts(ctx, enclaveCtx, snapshotRootDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred adding the files artifacts of enclave '%v' to the snapshot", enclaveIdentifier)
+	}
+
+	serviceInfos, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, map[string]bool{})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v'", enclaveIdentifier)
+	}
+	serviceImages, err := getServiceImageDigests(ctx, kurtosisBackend, enclaveInfo.GetEnclaveUuid(), serviceInfos)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the image digests of the services of enclave '%v'", enclaveIdentifier)
+	}
+
+	persistentDirectoryKeys := []string{}
+	persistentDirectoriesDirpath := path.Join(snapshotRootDirpath, enclave_snapshot.PersistentDirectoriesDirname)
+	if err = os.Mkdir(persistentDirectoriesDirpath, snapshotDirPermission); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating directory '%v'", persistentDirectoriesDirpath)
+	}
+	for _, persistentDirectory := range snapshotInfo.GetPersistentDirectories() {
+		serviceInfo, found := serviceInfos[persistentDirectory.GetServiceName()]
+		if !found {
+			return stacktrace.NewError("Service '%v' mounting persistent directory '%v' wasn't found in enclave '%v'", persistentDirectory.GetServiceName(), persistentDirectory.GetPersistentKey(), enclaveIdentifier)
+		}
+		persistentDirectoryDestDirpath := path.Join(persistentDirectoriesDirpath, persistentDirectory.GetPersistentKey())
+		if err = copyPersistentDirectory(ctx, kurtosisBackend, enclaveInfo.GetEnclaveUuid(), serviceInfo.GetServiceUuid(), persistentDirectory.GetServiceDirpath(), tmpDirpath, persistentDirectoryDestDirpath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying persistent directory '%v' out of service '%v'", persistentDirectory.GetPersistentKey(), persistentDirectory.GetServiceName())
+		}
+		persistentDirectoryKeys = append(persistentDirectoryKeys, persistentDirectory.GetPersistentKey())
+	}
+
+	manifest := &enclave_snapshot.Manifest{
+		SchemaVersion:           enclave_snapshot.CurrentSchemaVersion,
+		KurtosisVersion:         kurtosis_version.KurtosisVersion,
+		EnclaveName:             enclaveName,
+		ServiceImages:           serviceImages,
+		FilesArtifactNames:      filesArtifactNames,
+		PersistentDirectoryKeys: persistentDirectoryKeys,
+	}
+	if err = enclave_snapshot.WriteManifest(snapshotRootDirpath, manifest); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the manifest of the snapshot of enclave '%v'", enclaveIdentifier)
+	}
+
+	if err = archiver.Archive([]string{snapshotRootDirpath}, snapshotFilepath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred compressing the snapshot of enclave '%v' to '%v'", enclaveIdentifier, snapshotFilepath)
+	}
+	logrus.Infof("Snapshotted enclave '%v' to '%v'", enclaveIdentifier, snapshotFilepath)
+	return nil
+}
+
+func snapshotFilesArtifacts(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, snapshotRootDirpath string) ([]string, error) {
+	filesInEnclave, err := enclaveCtx.GetAllFilesArtifactNamesAndUuids(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching the files artifacts in the enclave")
+	}
+	filesArtifactsDirpath := path.Join(snapshotRootDirpath, enclave_snapshot.FilesArtifactsDirname)
+	if err = os.Mkdir(filesArtifactsDirpath, snapshotDirPermission); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating directory '%v'", filesArtifactsDirpath)
+	}
+	filesArtifactNames := []string{}
+	for _, fileNameAndUuid := range filesInEnclave {
+		fileDownloadPath := path.Join(filesArtifactsDirpath, fileNameAndUuid.GetFileName())
+		if err = os.Mkdir(fileDownloadPath, snapshotDirPermission); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while creating directory '%v' to write files artifact '%v'", fileDownloadPath, fileNameAndUuid.GetFileName())
+		}
+		if err = files.DownloadAndExtractFilesArtifact(ctx, enclaveCtx, fileNameAndUuid.GetFileName(), fileDownloadPath); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while downloading and extracting files artifact '%v'", fileNameAndUuid.GetFileName())
+		}
+		filesArtifactNames = append(filesArtifactNames, fileNameAndUuid.GetFileName())
+	}
+	return filesArtifactNames, nil
+}
+
+// getServiceImageDigests returns the digest-pinned image of each service, keyed by service name, so that restoring the
+// snapshot runs the exact same images even if their tags got moved since. Services running an image without digest,
+// such as one built locally, are restored with the image referenced in the enclave plan
+func getServiceImageDigests(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid string,
+	serviceInfos map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo,
+) (map[string]string, error) {
+	imageDigestsByUuid, erroredServiceUuids, err := kurtosisBackend.GetUserServiceImageDigests(ctx, enclave.EnclaveUUID(enclaveUuid), &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    nil,
+		Statuses: nil,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the image digests of the services")
+	}
+	serviceImages := map[string]string{}
+	for serviceName, serviceInfo := range serviceInfos {
+		serviceUuid := service.ServiceUUID(serviceInfo.GetServiceUuid())
+		if imageDigest, found := imageDigestsByUuid[serviceUuid]; found {
+			serviceImages[serviceName] = imageDigest
+			continue
+		}
+		if digestErr, found := erroredServiceUuids[serviceUuid]; found {
+			logrus.Warnf("Service '%v' will be restored with image '%v' as it can't be pinned to a digest:\n%v", serviceName, serviceInfo.GetContainer().GetImageName(), digestErr)
+		}
+	}
+	return serviceImages, nil
+}
+
+// copyPersistentDirectory writes the content of the directory mounted at serviceDirpath in the service to destDirpath
+func copyPersistentDirectory(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid string,
+	serviceUuid string,
+	serviceDirpath string,
+	tmpDirpath string,
+	destDirpath string,
+) error {
+	tarFilepath := path.Join(tmpDirpath, persistentDirTarName)
+	tarFile, err := os.Create(tarFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating file '%v' to copy the persistent directory to", tarFilepath)
+	}
+	defer os.Remove(tarFilepath)
+	if err = kurtosisBackend.CopyFilesFromUserService(ctx, enclave.EnclaveUUID(enclaveUuid), service.ServiceUUID(serviceUuid), serviceDirpath, tarFile); err != nil {
+		tarFile.Close()
+		return stacktrace.Propagate(err, "An error occurred copying '%v' out of the service", serviceDirpath)
+	}
+	if err = tarFile.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing file '%v'", tarFilepath)
+	}
+
+	// The archive contains the directory itself, so it's extracted next to the destination and renamed
+	extractionDirpath := destDirpath + "-extraction"
+	if err = archiver.Unarchive(tarFilepath, extractionDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred extracting '%v' to '%v'", tarFilepath, extractionDirpath)
+	}
+	defer os.RemoveAll(extractionDirpath)
+	extractedDirpath := path.Join(extractionDirpath, path.Base(serviceDirpath))
+	if err = os.Rename(extractedDirpath, destDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving '%v' to '%v'", extractedDirpath, destDirpath)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package start
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"strings"
+)
+
+const (
+	enclaveIdentifiersArgKey = "enclaves"
+	isEnclaveIdArgOptional   = false
+	isEnclaveIdArgGreedy     = true // The user can specify multiple enclaves to start
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveStartCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveStartCmdStr,
+	ShortDescription: "Starts stopped enclaves",
+	LongDescription: "Starts the stopped enclaves with the given UUIDs again, along with the services which were running " +
+		"when they got stopped. Files artifacts, persistent directories and the enclave plan are kept, so running the " +
+		"same package again only runs what changed",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     nil,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifiersArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifiers, err := args.GetGreedyArg(enclaveIdentifiersArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifiers arg using key '%v'", enclaveIdentifiersArgKey)
+	}
+
+	logrus.Info("Starting enclaves...")
+	startEnclaveErrorStrs := []string{}
+	for _, enclaveIdentifier := range enclaveIdentifiers {
+		startArgs := &kurtosis_engine_rpc_api_bindings.StartEnclaveArgs{EnclaveIdentifier: enclaveIdentifier}
+		if _, err := engineClient.StartEnclave(ctx, startArgs); err != nil {
+			wrappedErr := stacktrace.Propagate(err, "An error occurred starting enclave '%v'", enclaveIdentifier)
+			startEnclaveErrorStrs = append(startEnclaveErrorStrs, wrappedErr.Error())
+		}
+	}
+
+	if len(startEnclaveErrorStrs) > 0 {
+		joinedErrorsStr := strings.Join(
+			startEnclaveErrorStrs,
+			"\n\n",
+		)
+		// We use this rather than stacktrace because stacktrace gets messy
+		return fmt.Errorf(
+			"One or more errors occurred when starting enclaves:\n%v",
+			joinedErrorsStr,
+		)
+	}
+
+	logrus.Info("Enclaves started successfully")
+
+	return nil
+}
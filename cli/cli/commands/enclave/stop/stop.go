@@ -4,13 +4,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
-	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
-	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/shared_starlark_calls"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -30,7 +28,7 @@ const (
 var EnclaveStopCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.EnclaveStopCmdStr,
 	ShortDescription:          "Stops enclaves",
-	LongDescription:           "Stops the enclaves with the given UUIDs",
+	LongDescription:           "Stops the enclaves with the given UUIDs; stopped enclaves can be started again with 'enclave start'",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags:                     nil,
@@ -65,9 +63,8 @@ func run(
 	stopEnclaveErrorStrs := []string{}
 	for _, enclaveIdentifier := range enclaveIdentifiers {
 		stopArgs := &kurtosis_engine_rpc_api_bindings.StopEnclaveArgs{EnclaveIdentifier: enclaveIdentifier}
-		if err = stopAllEnclaveServices(ctx, enclaveIdentifier); err != nil {
-			return stacktrace.Propagate(err, "An error occurred stopping all enclave services")
-		}
+		// The services aren't stopped one by one, so that they're still registered as started and get started again
+		// with the enclave
 		if _, err := engineClient.StopEnclave(ctx, stopArgs); err != nil {
 			wrappedErr := stacktrace.Propagate(err, "An error occurred stopping enclave '%v'", enclaveIdentifier)
 			stopEnclaveErrorStrs = append(stopEnclaveErrorStrs, wrappedErr.Error())
@@ -90,27 +87,3 @@ func run(
 
 	return nil
 }
-
-func stopAllEnclaveServices(ctx context.Context, enclaveIdentifier string) error {
-	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
-	}
-
-	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
-	}
-
-	allEnclaveServices, err := enclaveCtx.GetServices()
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting all enclave services")
-	}
-
-	for serviceName := range allEnclaveServices {
-		if err := shared_starlark_calls.StopServiceStarlarkCommand(ctx, enclaveCtx, serviceName); err != nil {
-			return stacktrace.Propagate(err, "An error occurred stopping service '%s'", serviceName)
-		}
-	}
-	return nil
-}
@@ -0,0 +1,299 @@
+package watch
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"sort"
+	"strings"
+)
+
+const (
+	// Package ID the API container reports for runs of standalone scripts, rather than packages
+	standaloneScriptPackageId = "DEFAULT_PACKAGE_ID_FOR_SCRIPT"
+
+	apiContainerStatusPrefix = "EnclaveAPIContainerStatus_"
+
+	maxLogLines = 500
+	// The logs section always gets at least this many lines, even if it means cutting the sections above it
+	minLogSectionLines = 3
+
+	selectedServiceMarker    = ">"
+	notSelectedServiceMarker = " "
+	columnSeparator          = "   "
+
+	keyBindingsHelp = "up/down: select service   e: exec into service   r: restart service   o: open service port   q: quit"
+)
+
+// dashboardData is the state of the enclave shown by the dashboard, fetched again on every refresh
+type dashboardData struct {
+	enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo
+
+	// Empty when the API container isn't running
+	services []*kurtosis_core_rpc_api_bindings.ServiceInfo
+
+	// Empty when the API container isn't running
+	filesArtifacts []*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid
+
+	// Nil when the API container isn't running
+	starlarkRun *kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse
+}
+
+// dashboard holds everything shown on the screen; it doesn't do any I/O so that it can be rendered from anywhere
+type dashboard struct {
+	data *dashboardData
+
+	// The selection follows the service by name across refreshes; empty if the enclave has no services
+	selectedServiceName string
+
+	// Tail of the logs of the selected service
+	logLines []string
+
+	// Result of the last action, or last error, shown under the logs
+	statusMessage string
+}
+
+func newDashboard(data *dashboardData) *dashboard {
+	dash := &dashboard{
+		data:                nil,
+		selectedServiceName: "",
+		logLines:            []string{},
+		statusMessage:       "",
+	}
+	dash.setData(data)
+	return dash
+}
+
+func (dash *dashboard) setData(data *dashboardData) {
+	dash.data = data
+	if dash.getSelectedService() == nil {
+		dash.selectedServiceName = ""
+		if len(data.services) > 0 {
+			dash.selectedServiceName = data.services[0].GetName()
+		}
+	}
+}
+
+// getSelectedService returns nil if the enclave has no services
+func (dash *dashboard) getSelectedService() *kurtosis_core_rpc_api_bindings.ServiceInfo {
+	for _, service := range dash.data.services {
+		if service.GetName() == dash.selectedServiceName {
+			return service
+		}
+	}
+	return nil
+}
+
+// moveSelection moves the selection by the given number of services, stopping at the first and last ones
+func (dash *dashboard) moveSelection(delta int) {
+	services := dash.data.services
+	if len(services) == 0 {
+		return
+	}
+	selectedIdx := 0
+	for idx, service := range services {
+		if service.GetName() == dash.selectedServiceName {
+			selectedIdx = idx
+		}
+	}
+	selectedIdx += delta
+	if selectedIdx < 0 {
+		selectedIdx = 0
+	}
+	if selectedIdx >= len(services) {
+		selectedIdx = len(services) - 1
+	}
+	dash.selectedServiceName = services[selectedIdx].GetName()
+}
+
+func (dash *dashboard) addLogLines(logLines []string) {
+	dash.logLines = append(dash.logLines, logLines...)
+	if len(dash.logLines) > maxLogLines {
+		dash.logLines = dash.logLines[len(dash.logLines)-maxLogLines:]
+	}
+}
+
+func (dash *dashboard) clearLogLines() {
+	dash.logLines = []string{}
+}
+
+func (dash *dashboard) setStatusMessage(statusMessage string) {
+	dash.statusMessage = statusMessage
+}
+
+// render returns the lines of the screen, none longer than width and no more than height of them
+func (dash *dashboard) render(width int, height int) []string {
+	footer := []string{
+		dash.statusMessage,
+		keyBindingsHelp,
+	}
+
+	body := []string{}
+	body = append(body, dash.renderHeader()...)
+	body = append(body, "")
+	body = append(body, dash.renderServices()...)
+	body = append(body, "")
+	body = append(body, dash.renderFilesArtifacts()...)
+	body = append(body, "")
+
+	logSectionTitle := "Logs"
+	if selectedService := dash.getSelectedService(); selectedService != nil {
+		logSectionTitle = fmt.Sprintf("Logs of '%s'", selectedService.GetName())
+	}
+	bodyHeight := height - len(footer)
+	maxNonLogLines := bodyHeight - 1 - minLogSectionLines
+	if maxNonLogLines < 0 {
+		maxNonLogLines = 0
+	}
+	if len(body) > maxNonLogLines {
+		body = body[:maxNonLogLines]
+	}
+	body = append(body, renderSectionTitle(logSectionTitle))
+	numLogLinesShown := bodyHeight - len(body)
+	logLines := dash.logLines
+	if numLogLinesShown < 0 {
+		numLogLinesShown = 0
+	}
+	if len(logLines) > numLogLinesShown {
+		logLines = logLines[len(logLines)-numLogLinesShown:]
+	}
+	body = append(body, logLines...)
+	for len(body) < bodyHeight {
+		body = append(body, "")
+	}
+
+	screen := append(body, footer...)
+	if len(screen) > height {
+		screen = screen[len(screen)-height:]
+	}
+	for idx, line := range screen {
+		screen[idx] = truncateLine(line, width)
+	}
+	return screen
+}
+
+func (dash *dashboard) renderHeader() []string {
+	enclaveInfo := dash.data.enclaveInfo
+	enclaveStatus, err := enclave_status_stringifier.EnclaveContainersStatusPlainStringifier(enclaveInfo.GetContainersStatus())
+	if err != nil {
+		enclaveStatus = enclaveInfo.GetContainersStatus().String()
+	}
+	apiContainerStatus := strings.TrimPrefix(enclaveInfo.GetApiContainerStatus().String(), apiContainerStatusPrefix)
+	return []string{
+		fmt.Sprintf("Enclave: %s (%s)%sStatus: %s%sAPI container: %s", enclaveInfo.GetName(), enclaveInfo.GetShortenedUuid(), columnSeparator, enclaveStatus, columnSeparator, apiContainerStatus),
+		fmt.Sprintf("Starlark: %s", describeStarlarkRun(dash.data.starlarkRun)),
+	}
+}
+
+func (dash *dashboard) renderServices() []string {
+	lines := []string{renderSectionTitle("User Services")}
+	if len(dash.data.services) == 0 {
+		return append(lines, "  No services")
+	}
+
+	nameColWidth := 0
+	statusColWidth := 0
+	for _, service := range dash.data.services {
+		if len(service.GetName()) > nameColWidth {
+			nameColWidth = len(service.GetName())
+		}
+		if len(getServiceStatusStr(service)) > statusColWidth {
+			statusColWidth = len(getServiceStatusStr(service))
+		}
+	}
+	for _, service := range dash.data.services {
+		marker := notSelectedServiceMarker
+		if service.GetName() == dash.selectedServiceName {
+			marker = selectedServiceMarker
+		}
+		portBindingLines, err := user_services.GetUserServicePortBindingStrings(service)
+		if err != nil {
+			portBindingLines = []string{err.Error()}
+		}
+		lines = append(lines, fmt.Sprintf("%s %-*s%s%-*s%s%s", marker, nameColWidth, service.GetName(), columnSeparator, statusColWidth, getServiceStatusStr(service), columnSeparator, portBindingLines[0]))
+		for _, additionalPortBindingLine := range portBindingLines[1:] {
+			lines = append(lines, fmt.Sprintf("  %-*s%s%-*s%s%s", nameColWidth, "", columnSeparator, statusColWidth, "", columnSeparator, additionalPortBindingLine))
+		}
+	}
+	return lines
+}
+
+func (dash *dashboard) renderFilesArtifacts() []string {
+	lines := []string{renderSectionTitle("Files Artifacts")}
+	if len(dash.data.filesArtifacts) == 0 {
+		return append(lines, "  No files artifacts")
+	}
+	filesArtifactNames := []string{}
+	for _, filesArtifact := range dash.data.filesArtifacts {
+		filesArtifactNames = append(filesArtifactNames, filesArtifact.GetFileName())
+	}
+	sort.Strings(filesArtifactNames)
+	return append(lines, "  "+strings.Join(filesArtifactNames, ", "))
+}
+
+// getServiceUrl returns the URL of the first port, by ID, of the service which has an application protocol and is
+// bound to the host machine, or false if there's no such port
+func getServiceUrl(service *kurtosis_core_rpc_api_bindings.ServiceInfo) (string, bool) {
+	publicIpAddr := service.GetMaybePublicIpAddr()
+	if publicIpAddr == "" {
+		return "", false
+	}
+	portIds := []string{}
+	for portId := range service.GetPrivatePorts() {
+		portIds = append(portIds, portId)
+	}
+	sort.Strings(portIds)
+	for _, portId := range portIds {
+		applicationProtocol := service.GetPrivatePorts()[portId].GetMaybeApplicationProtocol()
+		publicPort, found := service.GetMaybePublicPorts()[portId]
+		if !found || applicationProtocol == "" {
+			continue
+		}
+		return fmt.Sprintf("%s://%s:%d", applicationProtocol, publicIpAddr, publicPort.GetNumber()), true
+	}
+	return "", false
+}
+
+func getServiceStatusStr(service *kurtosis_core_rpc_api_bindings.ServiceInfo) string {
+	return kurtosis_core_rpc_api_bindings.ServiceStatus_name[int32(service.GetServiceStatus())]
+}
+
+func describeStarlarkRun(starlarkRun *kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse) string {
+	if starlarkRun == nil {
+		return "unknown, the API container isn't running"
+	}
+	if starlarkRun.RunningPackageId != nil {
+		return "running " + describeStarlarkPackage(starlarkRun.GetRunningPackageId())
+	}
+	if starlarkRun.GetSerializedScript() == "" {
+		return "idle, nothing was run in this enclave yet"
+	}
+	return "idle, last run was " + describeStarlarkPackage(starlarkRun.GetPackageId())
+}
+
+func describeStarlarkPackage(packageId string) string {
+	if packageId == standaloneScriptPackageId {
+		return "a standalone script"
+	}
+	return fmt.Sprintf("package '%s'", packageId)
+}
+
+func renderSectionTitle(title string) string {
+	return fmt.Sprintf("== %s ==", title)
+}
+
+func truncateLine(line string, width int) string {
+	// Tabs and carriage returns coming from the logs would mess up the screen
+	line = strings.ReplaceAll(line, "\t", "    ")
+	line = strings.ReplaceAll(line, "\r", "")
+	runes := []rune(line)
+	if width < 0 {
+		width = 0
+	}
+	if len(runes) > width {
+		runes = runes[:width]
+	}
+	return string(runes)
+}
@@ -0,0 +1,153 @@
+package watch
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+const (
+	testWidth  = 120
+	testHeight = 20
+)
+
+func TestRender(t *testing.T) {
+	dash := newDashboard(newDashboardDataForTest())
+	dash.addLogLines([]string{"first line", "second line"})
+	dash.setStatusMessage("Restarted service 'node'")
+
+	screen := dash.render(testWidth, testHeight)
+	require.Len(t, screen, testHeight)
+	require.Equal(t, "Enclave: test-enclave (abc123)   Status: RUNNING   API container: RUNNING", screen[0])
+	require.Equal(t, "Starlark: running package 'github.com/kurtosis-tech/test-package'", screen[1])
+	require.Equal(t, "== User Services ==", screen[3])
+	require.Equal(t, "> explorer   RUNNING   http: 80/tcp -> http://127.0.0.1:49152", screen[4])
+	require.Equal(t, "  node       STOPPED   <none>", screen[5])
+	require.Equal(t, "== Files Artifacts ==", screen[7])
+	require.Equal(t, "  config, genesis", screen[8])
+	require.Equal(t, "== Logs of 'explorer' ==", screen[10])
+	require.Equal(t, "first line", screen[11])
+	require.Equal(t, "second line", screen[12])
+	require.Equal(t, "Restarted service 'node'", screen[testHeight-2])
+	require.Equal(t, keyBindingsHelp, screen[testHeight-1])
+}
+
+func TestRender_SmallTerminalKeepsLogsAndFooter(t *testing.T) {
+	dash := newDashboard(newDashboardDataForTest())
+	dash.addLogLines([]string{"first line", "second line", "third line", "fourth line"})
+
+	width := 30
+	height := 8
+	screen := dash.render(width, height)
+	require.Len(t, screen, height)
+	for _, line := range screen {
+		require.LessOrEqual(t, len(line), width)
+	}
+	require.Equal(t, "== Logs of 'explorer' ==", screen[2])
+	require.Equal(t, []string{"second line", "third line", "fourth line"}, screen[3:6])
+	require.True(t, strings.HasPrefix(keyBindingsHelp, screen[height-1]))
+}
+
+func TestMoveSelection(t *testing.T) {
+	dash := newDashboard(newDashboardDataForTest())
+	require.Equal(t, "explorer", dash.getSelectedService().GetName())
+
+	dash.moveSelection(-1)
+	require.Equal(t, "explorer", dash.getSelectedService().GetName())
+	dash.moveSelection(1)
+	require.Equal(t, "node", dash.getSelectedService().GetName())
+	dash.moveSelection(1)
+	require.Equal(t, "node", dash.getSelectedService().GetName())
+
+	// the selection follows the service across refreshes, and falls back to the first one once it's gone
+	data := newDashboardDataForTest()
+	dash.setData(data)
+	require.Equal(t, "node", dash.getSelectedService().GetName())
+	data.services = data.services[:1]
+	dash.setData(data)
+	require.Equal(t, "explorer", dash.getSelectedService().GetName())
+	data.services = []*kurtosis_core_rpc_api_bindings.ServiceInfo{}
+	dash.setData(data)
+	require.Nil(t, dash.getSelectedService())
+}
+
+func TestAddLogLines_KeepsTail(t *testing.T) {
+	dash := newDashboard(newDashboardDataForTest())
+	for i := 0; i < maxLogLines; i++ {
+		dash.addLogLines([]string{"old line"})
+	}
+	dash.addLogLines([]string{"new line"})
+	require.Len(t, dash.logLines, maxLogLines)
+	require.Equal(t, "new line", dash.logLines[maxLogLines-1])
+}
+
+func TestGetServiceUrl(t *testing.T) {
+	data := newDashboardDataForTest()
+	url, found := getServiceUrl(data.services[0])
+	require.True(t, found)
+	require.Equal(t, "http://127.0.0.1:49152", url)
+
+	_, found = getServiceUrl(data.services[1])
+	require.False(t, found)
+}
+
+func TestDescribeStarlarkRun(t *testing.T) {
+	require.Equal(t, "unknown, the API container isn't running", describeStarlarkRun(nil))
+	noRunYet := &kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse{} //nolint:exhaustruct
+	require.Equal(t, "idle, nothing was run in this enclave yet", describeStarlarkRun(noRunYet))
+
+	lastRun := &kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse{ //nolint:exhaustruct
+		PackageId:        standaloneScriptPackageId,
+		SerializedScript: "def run(plan): pass",
+	}
+	require.Equal(t, "idle, last run was a standalone script", describeStarlarkRun(lastRun))
+}
+
+func TestParseKeys(t *testing.T) {
+	require.Equal(t, []dashboardKey{dashboardKey_Up, dashboardKey_Down, dashboardKey_Exec}, parseKeys([]byte("\x1b[A\x1b[Be")))
+	require.Equal(t, []dashboardKey{dashboardKey_Down, dashboardKey_Up, dashboardKey_Restart, dashboardKey_OpenPort}, parseKeys([]byte("jkro")))
+	require.Equal(t, []dashboardKey{dashboardKey_Quit, dashboardKey_Quit}, parseKeys([]byte{'q', ctrlCByte}))
+	require.Empty(t, parseKeys([]byte("x\x1b")))
+}
+
+func newDashboardDataForTest() *dashboardData {
+	runningPackageId := "github.com/kurtosis-tech/test-package"
+	return &dashboardData{
+		enclaveInfo: &kurtosis_engine_rpc_api_bindings.EnclaveInfo{ //nolint:exhaustruct
+			Name:               "test-enclave",
+			ShortenedUuid:      "abc123",
+			ContainersStatus:   kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING,
+			ApiContainerStatus: kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING,
+		},
+		services: []*kurtosis_core_rpc_api_bindings.ServiceInfo{
+			{ //nolint:exhaustruct
+				Name:          "explorer",
+				ServiceUuid:   "explorer-uuid",
+				ServiceStatus: kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING,
+				PrivatePorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+					"http": {Number: 80, MaybeApplicationProtocol: "http"}, //nolint:exhaustruct
+				},
+				MaybePublicIpAddr: "127.0.0.1",
+				MaybePublicPorts: map[string]*kurtosis_core_rpc_api_bindings.Port{
+					"http": {Number: 49152}, //nolint:exhaustruct
+				},
+			},
+			{ //nolint:exhaustruct
+				Name:          "node",
+				ServiceUuid:   "node-uuid",
+				ServiceStatus: kurtosis_core_rpc_api_bindings.ServiceStatus_STOPPED,
+			},
+		},
+		filesArtifacts: []*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{
+			{FileName: "genesis", FileUuid: "genesis-uuid"}, //nolint:exhaustruct
+			{FileName: "config", FileUuid: "config-uuid"},   //nolint:exhaustruct
+		},
+		starlarkRun: &kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse{ //nolint:exhaustruct
+			PackageId:        standaloneScriptPackageId,
+			SerializedScript: "def run(plan): pass",
+			RunningPackageId: &runningPackageId,
+		},
+	}
+}
@@ -0,0 +1,150 @@
+package watch
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"golang.org/x/term"
+	"os"
+	"strings"
+)
+
+const (
+	enterAlternateScreenSeq = "\x1b[?1049h"
+	exitAlternateScreenSeq  = "\x1b[?1049l"
+	hideCursorSeq           = "\x1b[?25l"
+	showCursorSeq           = "\x1b[?25h"
+	clearScreenSeq          = "\x1b[H\x1b[2J"
+	// In raw mode a line feed doesn't move the cursor back to the start of the line
+	rawModeNewline = "\r\n"
+
+	arrowUpSeq   = "\x1b[A"
+	arrowDownSeq = "\x1b[B"
+	ctrlCByte    = 0x03
+
+	keyReadBufferSize = 64
+)
+
+type dashboardKey int
+
+const (
+	dashboardKey_Up dashboardKey = iota
+	dashboardKey_Down
+	dashboardKey_Exec
+	dashboardKey_Restart
+	dashboardKey_OpenPort
+	dashboardKey_Quit
+)
+
+var dashboardKeysByByte = map[byte]dashboardKey{
+	'k':       dashboardKey_Up,
+	'j':       dashboardKey_Down,
+	'e':       dashboardKey_Exec,
+	'r':       dashboardKey_Restart,
+	'o':       dashboardKey_OpenPort,
+	'q':       dashboardKey_Quit,
+	ctrlCByte: dashboardKey_Quit,
+}
+
+// dashboardTerminal is the terminal the dashboard is drawn in, which is put in raw mode and switched to its alternate
+// screen so that the content of the terminal is back as it was once the dashboard exits
+type dashboardTerminal struct {
+	stdinFd  int
+	stdoutFd int
+
+	// Nil when the terminal isn't in raw mode
+	originalState *term.State
+}
+
+func newDashboardTerminal() (*dashboardTerminal, error) {
+	stdinFd := int(os.Stdin.Fd())
+	stdoutFd := int(os.Stdout.Fd())
+	if !term.IsTerminal(stdinFd) || !term.IsTerminal(stdoutFd) {
+		return nil, stacktrace.NewError("The dashboard needs an interactive terminal, but the standard input or output isn't one")
+	}
+	return &dashboardTerminal{
+		stdinFd:       stdinFd,
+		stdoutFd:      stdoutFd,
+		originalState: nil,
+	}, nil
+}
+
+func (terminal *dashboardTerminal) enter() error {
+	originalState, err := term.MakeRaw(terminal.stdinFd)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred putting the terminal in raw mode")
+	}
+	terminal.originalState = originalState
+	if _, err := os.Stdout.WriteString(enterAlternateScreenSeq + hideCursorSeq); err != nil {
+		return stacktrace.Propagate(err, "An error occurred switching the terminal to its alternate screen")
+	}
+	return nil
+}
+
+// leave is a no-op if the terminal isn't in raw mode
+func (terminal *dashboardTerminal) leave() error {
+	if terminal.originalState == nil {
+		return nil
+	}
+	if _, err := os.Stdout.WriteString(showCursorSeq + exitAlternateScreenSeq); err != nil {
+		return stacktrace.Propagate(err, "An error occurred switching the terminal back to its main screen")
+	}
+	if err := term.Restore(terminal.stdinFd, terminal.originalState); err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring the terminal out of raw mode")
+	}
+	terminal.originalState = nil
+	return nil
+}
+
+func (terminal *dashboardTerminal) getSize() (int, int, error) {
+	width, height, err := term.GetSize(terminal.stdoutFd)
+	if err != nil {
+		return 0, 0, stacktrace.Propagate(err, "An error occurred getting the size of the terminal")
+	}
+	return width, height, nil
+}
+
+func (terminal *dashboardTerminal) draw(lines []string) error {
+	if _, err := os.Stdout.WriteString(clearScreenSeq + strings.Join(lines, rawModeNewline)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred drawing the dashboard")
+	}
+	return nil
+}
+
+// readKeys sends the keys pressed by the user, one read of the standard input at a time. It doesn't read again until
+// it receives on keysHandledChan, so that the standard input can be handed over while the keys are handled, e.g. to
+// a shell on a service, and returns once keysHandledChan is closed
+func readKeys(keysChan chan<- []dashboardKey, keysHandledChan <-chan struct{}) {
+	defer close(keysChan)
+	buffer := make([]byte, keyReadBufferSize)
+	for {
+		numBytesRead, err := os.Stdin.Read(buffer)
+		if err != nil {
+			return
+		}
+		keysChan <- parseKeys(buffer[:numBytesRead])
+		if _, isChanOpen := <-keysHandledChan; !isChanOpen {
+			return
+		}
+	}
+}
+
+// parseKeys ignores the bytes which aren't bound to any key
+func parseKeys(input []byte) []dashboardKey {
+	keys := []dashboardKey{}
+	for len(input) > 0 {
+		if strings.HasPrefix(string(input), arrowUpSeq) {
+			keys = append(keys, dashboardKey_Up)
+			input = input[len(arrowUpSeq):]
+			continue
+		}
+		if strings.HasPrefix(string(input), arrowDownSeq) {
+			keys = append(keys, dashboardKey_Down)
+			input = input[len(arrowDownSeq):]
+			continue
+		}
+		if key, found := dashboardKeysByByte[input[0]]; found {
+			keys = append(keys, key)
+		}
+		input = input[1:]
+	}
+	return keys
+}
@@ -0,0 +1,363 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/multi_os_command_executor"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/shared_starlark_calls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	refreshInterval = 2 * time.Second
+
+	// Number of log lines of the selected service fetched when it gets selected, before following new ones
+	numInitialLogLines = 100
+	shouldFollowLogs   = true
+	returnAllLogs      = false
+
+	interruptChanBufferSize = 1
+	// Only one action runs at a time, so its result never blocks even if the dashboard exited in the meantime
+	actionResultChanBufferSize = 1
+)
+
+var doNotFilterLogLines *kurtosis_context.LogLineFilter = nil
+
+var EnclaveWatchCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveWatchCmdStr,
+	ShortDescription: "Watch an enclave in a live dashboard",
+	LongDescription: "Opens a dashboard of the enclave in the terminal, showing its services with their status and " +
+		"ports, its files artifacts, the Starlark run being executed and the logs of the selected service. The " +
+		"dashboard refreshes as the enclave changes, and allows to exec into, restart or open the port of a service",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+// serviceLogLines are log lines of a service received from the logs stream
+type serviceLogLines struct {
+	serviceUuid services.ServiceUUID
+	lines       []string
+}
+
+// enclaveWatcher fetches the data of the enclave and runs the actions triggered from the dashboard
+type enclaveWatcher struct {
+	kurtosisBackend   backend_interface.KurtosisBackend
+	kurtosisCtx       *kurtosis_context.KurtosisContext
+	enclaveIdentifier string
+
+	// Only available once the API container has been seen running
+	maybeEnclaveCtx *enclaves.EnclaveContext
+}
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	terminal, err := newDashboardTerminal()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting up the terminal to watch enclave '%v' in", enclaveIdentifier)
+	}
+
+	watcher := &enclaveWatcher{
+		kurtosisBackend:   kurtosisBackend,
+		kurtosisCtx:       kurtosisCtx,
+		enclaveIdentifier: enclaveIdentifier,
+		maybeEnclaveCtx:   nil,
+	}
+	data, err := watcher.fetchData(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred fetching the data of enclave '%v'", enclaveIdentifier)
+	}
+
+	if err = terminal.enter(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred entering the dashboard terminal")
+	}
+	defer func() {
+		if err := terminal.leave(); err != nil {
+			logrus.Errorf("An error occurred restoring the terminal; you may need to reset it:\n%v", err)
+		}
+	}()
+
+	return watcher.runDashboard(ctx, terminal, newDashboard(data))
+}
+
+func (watcher *enclaveWatcher) runDashboard(ctx context.Context, terminal *dashboardTerminal, dash *dashboard) error {
+	keysChan := make(chan []dashboardKey)
+	keysHandledChan := make(chan struct{})
+	defer close(keysHandledChan)
+	go readKeys(keysChan, keysHandledChan)
+
+	// In raw mode Ctrl+C comes as a key, but the process can still get signaled
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interruptChan)
+
+	refreshTicker := time.NewTicker(refreshInterval)
+	defer refreshTicker.Stop()
+
+	logLinesChan := make(chan *serviceLogLines)
+	var logsServiceUuid services.ServiceUUID
+	cancelLogsStream := func() {}
+	defer func() {
+		cancelLogsStream()
+	}()
+
+	// Actions which take a while, like restarting a service, run in the background and send back a status message
+	actionResultChan := make(chan string, actionResultChanBufferSize)
+	isActionRunning := false
+
+	lastFrame := ""
+	for {
+		if selectedService := dash.getSelectedService(); services.ServiceUUID(selectedService.GetServiceUuid()) != logsServiceUuid {
+			cancelLogsStream()
+			cancelLogsStream = func() {}
+			dash.clearLogLines()
+			logsServiceUuid = services.ServiceUUID(selectedService.GetServiceUuid())
+			if selectedService != nil {
+				cancelFunc, err := watcher.startLogsStream(ctx, logsServiceUuid, logLinesChan)
+				if err != nil {
+					dash.setStatusMessage(fmt.Sprintf("Failed to stream the logs of service '%s': %v", selectedService.GetName(), err))
+				} else {
+					cancelLogsStream = cancelFunc
+				}
+			}
+		}
+
+		width, height, err := terminal.getSize()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the size of the dashboard")
+		}
+		frame := dash.render(width, height)
+		if frameStr := fmt.Sprint(frame); frameStr != lastFrame {
+			if err := terminal.draw(frame); err != nil {
+				return stacktrace.Propagate(err, "An error occurred drawing the dashboard")
+			}
+			lastFrame = frameStr
+		}
+
+		select {
+		case <-refreshTicker.C:
+			data, err := watcher.fetchData(ctx)
+			if err != nil {
+				dash.setStatusMessage(fmt.Sprintf("Failed to refresh the dashboard: %v", err))
+				continue
+			}
+			dash.setData(data)
+		case logLines := <-logLinesChan:
+			if logLines.serviceUuid == logsServiceUuid {
+				dash.addLogLines(logLines.lines)
+			}
+		case statusMessage := <-actionResultChan:
+			isActionRunning = false
+			dash.setStatusMessage(statusMessage)
+		case keys, isChanOpen := <-keysChan:
+			if !isChanOpen {
+				return stacktrace.NewError("The standard input got closed while watching the enclave")
+			}
+			for _, key := range keys {
+				switch key {
+				case dashboardKey_Quit:
+					return nil
+				case dashboardKey_Up:
+					dash.moveSelection(-1)
+				case dashboardKey_Down:
+					dash.moveSelection(1)
+				case dashboardKey_Exec:
+					enclaveUuid := enclave.EnclaveUUID(dash.data.enclaveInfo.GetEnclaveUuid())
+					dash.setStatusMessage(watcher.execIntoService(ctx, terminal, enclaveUuid, dash.getSelectedService()))
+					// The shell drew over the dashboard
+					lastFrame = ""
+				case dashboardKey_OpenPort:
+					dash.setStatusMessage(openServicePort(dash.getSelectedService()))
+				case dashboardKey_Restart:
+					selectedService := dash.getSelectedService()
+					if isActionRunning || selectedService == nil {
+						continue
+					}
+					isActionRunning = true
+					dash.setStatusMessage(fmt.Sprintf("Restarting service '%s'...", selectedService.GetName()))
+					go func() {
+						actionResultChan <- watcher.restartService(ctx, services.ServiceName(selectedService.GetName()))
+					}()
+				}
+			}
+			keysHandledChan <- struct{}{}
+		case <-interruptChan:
+			return nil
+		}
+	}
+}
+
+func (watcher *enclaveWatcher) fetchData(ctx context.Context) (*dashboardData, error) {
+	enclaveInfo, err := watcher.kurtosisCtx.GetEnclave(ctx, watcher.enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", watcher.enclaveIdentifier)
+	}
+	data := &dashboardData{
+		enclaveInfo:    enclaveInfo,
+		services:       []*kurtosis_core_rpc_api_bindings.ServiceInfo{},
+		filesArtifacts: []*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{},
+		starlarkRun:    nil,
+	}
+	if enclaveInfo.GetApiContainerStatus() != kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+		return data, nil
+	}
+
+	allServices := map[string]bool{}
+	serviceInfos, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, allServices)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v'", watcher.enclaveIdentifier)
+	}
+	data.services = user_services.GetSortedUserServiceSliceFromUserServiceMap(serviceInfos)
+
+	if watcher.maybeEnclaveCtx == nil {
+		enclaveCtx, err := watcher.kurtosisCtx.GetEnclaveContext(ctx, watcher.enclaveIdentifier)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", watcher.enclaveIdentifier)
+		}
+		watcher.maybeEnclaveCtx = enclaveCtx
+	}
+	data.filesArtifacts, err = watcher.maybeEnclaveCtx.GetAllFilesArtifactNamesAndUuids(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the files artifacts of enclave '%v'", watcher.enclaveIdentifier)
+	}
+	data.starlarkRun, err = watcher.maybeEnclaveCtx.GetStarlarkRun(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Starlark run of enclave '%v'", watcher.enclaveIdentifier)
+	}
+	return data, nil
+}
+
+// startLogsStream follows the logs of the service, sending them to logLinesChan until the returned function is called
+func (watcher *enclaveWatcher) startLogsStream(ctx context.Context, serviceUuid services.ServiceUUID, logLinesChan chan<- *serviceLogLines) (func(), error) {
+	streamCtx, cancelStreamCtx := context.WithCancel(ctx)
+	serviceUuids := map[services.ServiceUUID]bool{
+		serviceUuid: true,
+	}
+	logsStreamContentChan, cancelLogsStream, err := watcher.kurtosisCtx.GetServiceLogs(streamCtx, watcher.enclaveIdentifier, serviceUuids, shouldFollowLogs, returnAllLogs, numInitialLogLines, doNotFilterLogLines)
+	if err != nil {
+		cancelStreamCtx()
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs of service '%v'", serviceUuid)
+	}
+	go func() {
+		for logsStreamContent := range logsStreamContentChan {
+			lines := []string{}
+			for _, serviceLog := range logsStreamContent.GetServiceLogsByServiceUuids()[serviceUuid] {
+				lines = append(lines, serviceLog.GetContent())
+			}
+			select {
+			case logLinesChan <- &serviceLogLines{serviceUuid: serviceUuid, lines: lines}:
+			case <-streamCtx.Done():
+				return
+			}
+		}
+	}()
+	return func() {
+		cancelLogsStream()
+		cancelStreamCtx()
+	}, nil
+}
+
+// execIntoService hands the terminal over to a shell on the service, and returns the status message to show once the
+// shell exits
+func (watcher *enclaveWatcher) execIntoService(ctx context.Context, terminal *dashboardTerminal, enclaveUuid enclave.EnclaveUUID, selectedService *kurtosis_core_rpc_api_bindings.ServiceInfo) string {
+	if selectedService == nil {
+		return "No service to exec into"
+	}
+	if selectedService.GetContainer().GetStatus() != kurtosis_core_rpc_api_bindings.Container_RUNNING {
+		return fmt.Sprintf("Service '%s' isn't running, it can't be exec'd into", selectedService.GetName())
+	}
+	if err := terminal.leave(); err != nil {
+		return fmt.Sprintf("Failed to hand the terminal over to the shell: %v", err)
+	}
+	out.PrintOutLn(fmt.Sprintf("Opening a shell on service '%s'; exit it to get back to the dashboard", selectedService.GetName()))
+	shellErr := watcher.kurtosisBackend.GetShellOnUserService(ctx, enclaveUuid, service.ServiceUUID(selectedService.GetServiceUuid()))
+	if err := terminal.enter(); err != nil {
+		return fmt.Sprintf("Failed to get the terminal back from the shell: %v", err)
+	}
+	if shellErr != nil {
+		return fmt.Sprintf("Failed to open a shell on service '%s': %v", selectedService.GetName(), shellErr)
+	}
+	return fmt.Sprintf("Exited the shell on service '%s'", selectedService.GetName())
+}
+
+// restartService returns the status message to show once the service is restarted, or failed to be
+func (watcher *enclaveWatcher) restartService(ctx context.Context, serviceName services.ServiceName) string {
+	if watcher.maybeEnclaveCtx == nil {
+		return fmt.Sprintf("Service '%s' can't be restarted as the API container isn't running", serviceName)
+	}
+	if err := shared_starlark_calls.StopServiceStarlarkCommand(ctx, watcher.maybeEnclaveCtx, serviceName); err != nil {
+		return fmt.Sprintf("Failed to stop service '%s': %v", serviceName, err)
+	}
+	if err := shared_starlark_calls.StartServiceStarlarkCommand(ctx, watcher.maybeEnclaveCtx, serviceName); err != nil {
+		return fmt.Sprintf("Failed to start service '%s' again: %v", serviceName, err)
+	}
+	return fmt.Sprintf("Restarted service '%s'", serviceName)
+}
+
+// openServicePort returns the status message to show once the port is opened, or failed to be
+func openServicePort(selectedService *kurtosis_core_rpc_api_bindings.ServiceInfo) string {
+	if selectedService == nil {
+		return "No service to open the port of"
+	}
+	url, found := getServiceUrl(selectedService)
+	if !found {
+		return fmt.Sprintf("Service '%s' has no port with an application protocol bound to the host machine", selectedService.GetName())
+	}
+	if err := multi_os_command_executor.OpenFile(url); err != nil {
+		return fmt.Sprintf("Failed to open '%s': %v", url, err)
+	}
+	return fmt.Sprintf("Opened '%s'", url)
+}
@@ -3,6 +3,7 @@ package common
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -19,6 +20,15 @@ func ValidateEnclavePoolSizeFlag(enclavePoolSize uint8) error {
 	return nil
 }
 
+// ValidateHostPortRangesFlag checks the ranges are well-formed here, so a typo fails fast instead of making the engine
+// crash on start
+func ValidateHostPortRangesFlag(hostPortRanges string) error {
+	if _, err := port_publish_spec.ParseHostPortRanges(hostPortRanges); err != nil {
+		return stacktrace.Propagate(err, "The host port ranges '%v' are invalid", hostPortRanges)
+	}
+	return nil
+}
+
 func isEnclavePoolAvailableForCurrentClusterType() (bool, error) {
 	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
 	if err != nil {
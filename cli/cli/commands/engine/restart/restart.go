@@ -22,6 +22,7 @@ const (
 	engineVersionFlagKey           = "version"
 	logLevelFlagKey                = "log-level"
 	enclavePoolSizeFlagKey         = "enclave-pool-size"
+	hostPortRangesFlagKey          = "host-port-ranges"
 	githubAuthTokenOverrideFlagKey = "github-auth-token"
 
 	defaultEngineVersion                   = ""
@@ -64,6 +65,13 @@ var RestartCmd = &lowlevel.LowlevelKurtosisCommand{
 			Type:      flags.FlagType_Uint8,
 			Default:   strconv.Itoa(int(defaults.DefaultEngineEnclavePoolSize)),
 		},
+		{
+			Key:       hostPortRangesFlagKey,
+			Usage:     "Named ranges of host ports, in the 'name=start-end,other-name=start-end' format, that the services of every enclave can publish their ports on with 'PortPublishing(range=\"name\")'. Each service gets a host port of the range that no other service reserved.",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaults.DefaultEngineHostPortRanges,
+		},
 		{
 			Key:       githubAuthTokenOverrideFlagKey,
 			Usage:     "The GitHub auth token that should be used to authorize git operations such as accessing packages in private repositories. Overrides existing GitHub auth config if a user is logged in.",
@@ -89,6 +97,15 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 		return stacktrace.Propagate(err, "An error occurred validating the '%v' flag", enclavePoolSizeFlagKey)
 	}
 
+	hostPortRanges, err := flags.GetString(hostPortRangesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the host port ranges using flag with key '%v'; this is a bug in Kurtosis", hostPortRangesFlagKey)
+	}
+
+	if err := common.ValidateHostPortRangesFlag(hostPortRanges); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the '%v' flag", hostPortRangesFlagKey)
+	}
+
 	logrus.Infof("Restarting Kurtosis engine...")
 
 	logLevelStr, err := flags.GetString(logLevelFlagKey)
@@ -129,7 +146,7 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 
 	var engineClientCloseFunc func() error
 	var restartEngineErr error
-	_, engineClientCloseFunc, restartEngineErr = engineManager.RestartEngineIdempotently(ctx, logLevel, engineVersion, restartEngineOnSameVersionIfAnyRunning, enclavePoolSize, shouldStartInDebugMode, githubAuthTokenOverride)
+	_, engineClientCloseFunc, restartEngineErr = engineManager.RestartEngineIdempotently(ctx, logLevel, engineVersion, restartEngineOnSameVersionIfAnyRunning, enclavePoolSize, hostPortRanges, shouldStartInDebugMode, githubAuthTokenOverride)
 	if restartEngineErr != nil {
 		return stacktrace.Propagate(restartEngineErr, "An error occurred restarting the Kurtosis engine")
 	}
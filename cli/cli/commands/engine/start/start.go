@@ -22,6 +22,7 @@ const (
 	engineVersionFlagKey           = "version"
 	logLevelFlagKey                = "log-level"
 	enclavePoolSizeFlagKey         = "enclave-pool-size"
+	hostPortRangesFlagKey          = "host-port-ranges"
 	githubAuthTokenOverrideFlagKey = "github-auth-token"
 
 	defaultEngineVersion          = ""
@@ -65,6 +66,13 @@ var StartCmd = &lowlevel.LowlevelKurtosisCommand{
 			Type:      flags.FlagType_Uint8,
 			Default:   strconv.Itoa(int(defaults.DefaultEngineEnclavePoolSize)),
 		},
+		{
+			Key:       hostPortRangesFlagKey,
+			Usage:     "Named ranges of host ports, in the 'name=start-end,other-name=start-end' format, that the services of every enclave can publish their ports on with 'PortPublishing(range=\"name\")'. Each service gets a host port of the range that no other service reserved.",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaults.DefaultEngineHostPortRanges,
+		},
 		{
 			Key:       githubAuthTokenOverrideFlagKey,
 			Usage:     "The github auth token that should be used to authorize git operations such as accessing packages in private repositories. Overrides existing github auth config if a user is logged in.",
@@ -90,6 +98,15 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 		return stacktrace.Propagate(err, "An error occurred validating the '%v' flag", enclavePoolSizeFlagKey)
 	}
 
+	hostPortRanges, err := flags.GetString(hostPortRangesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the host port ranges using flag with key '%v'; this is a bug in Kurtosis", hostPortRangesFlagKey)
+	}
+
+	if err := common.ValidateHostPortRangesFlag(hostPortRanges); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the '%v' flag", hostPortRangesFlagKey)
+	}
+
 	logLevelStr, err := flags.GetString(logLevelFlagKey)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while getting the Kurtosis engine log level using flag with key '%v'; this is a bug in Kurtosis", logLevelFlagKey)
@@ -126,13 +143,13 @@ func run(_ context.Context, flags *flags.ParsedFlags, _ *args.ParsedArgs) error
 	if engineVersion == defaultEngineVersion && isDebugMode {
 		engineDebugVersion := fmt.Sprintf("%s-%s", kurtosis_version.KurtosisVersion, defaults.DefaultKurtosisContainerDebugImageNameSuffix)
 		logrus.Infof("Starting Kurtosis engine in debug mode from image '%v%v%v'...", kurtosisTechEngineImagePrefix, imageVersionDelimiter, engineDebugVersion)
-		_, engineClientCloseFunc, startEngineErr = engineManager.StartEngineIdempotentlyWithCustomVersion(ctx, engineDebugVersion, logLevel, enclavePoolSize, true, githubAuthTokenOverride)
+		_, engineClientCloseFunc, startEngineErr = engineManager.StartEngineIdempotentlyWithCustomVersion(ctx, engineDebugVersion, logLevel, enclavePoolSize, hostPortRanges, true, githubAuthTokenOverride)
 	} else if engineVersion == defaultEngineVersion {
 		logrus.Infof("Starting Kurtosis engine from image '%v%v%v'...", kurtosisTechEngineImagePrefix, imageVersionDelimiter, kurtosis_version.KurtosisVersion)
-		_, engineClientCloseFunc, startEngineErr = engineManager.StartEngineIdempotentlyWithDefaultVersion(ctx, logLevel, enclavePoolSize, githubAuthTokenOverride)
+		_, engineClientCloseFunc, startEngineErr = engineManager.StartEngineIdempotentlyWithDefaultVersion(ctx, logLevel, enclavePoolSize, hostPortRanges, githubAuthTokenOverride)
 	} else {
 		logrus.Infof("Starting Kurtosis engine from image '%v%v%v'...", kurtosisTechEngineImagePrefix, imageVersionDelimiter, engineVersion)
-		_, engineClientCloseFunc, startEngineErr = engineManager.StartEngineIdempotentlyWithCustomVersion(ctx, engineVersion, logLevel, enclavePoolSize, defaults.DefaultEnableDebugMode, githubAuthTokenOverride)
+		_, engineClientCloseFunc, startEngineErr = engineManager.StartEngineIdempotentlyWithCustomVersion(ctx, engineVersion, logLevel, enclavePoolSize, hostPortRanges, defaults.DefaultEnableDebugMode, githubAuthTokenOverride)
 	}
 	if startEngineErr != nil {
 		return stacktrace.Propagate(startEngineErr, "An error occurred starting the Kurtosis engine")
@@ -2,9 +2,11 @@ package status
 
 import (
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
 )
 
 const (
@@ -15,10 +17,13 @@ const (
 type prettyPrintingEngineStatusVisitor struct {
 	// Will only be filled in if the engine is running
 	maybeApiVersion string
+
+	// Will only be filled in if the engine is running and has resource quotas
+	resourceQuotaUsages []*kurtosis_engine_rpc_api_bindings.ResourceQuotaUsage
 }
 
-func newPrettyPrintingEngineStatusVisitor(maybeApiVersion string) *prettyPrintingEngineStatusVisitor {
-	return &prettyPrintingEngineStatusVisitor{maybeApiVersion: maybeApiVersion}
+func newPrettyPrintingEngineStatusVisitor(maybeApiVersion string, resourceQuotaUsages []*kurtosis_engine_rpc_api_bindings.ResourceQuotaUsage) *prettyPrintingEngineStatusVisitor {
+	return &prettyPrintingEngineStatusVisitor{maybeApiVersion: maybeApiVersion, resourceQuotaUsages: resourceQuotaUsages}
 }
 
 func (p *prettyPrintingEngineStatusVisitor) VisitStopped() error {
@@ -39,5 +44,11 @@ func (p *prettyPrintingEngineStatusVisitor) VisitRunning() error {
 
 	out.PrintOutLn("A Kurtosis engine is running with the following info:")
 	keyValuePrinter.Print()
+
+	if len(p.resourceQuotaUsages) > 0 {
+		if err := printResourceQuotaUsages(p.resourceQuotaUsages); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the usage of the engine resource quotas")
+		}
+	}
 	return nil
 }
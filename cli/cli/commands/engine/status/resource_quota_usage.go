@@ -0,0 +1,207 @@
+package status
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	quotaColumnHeader          = "Quota"
+	appliesToColumnHeader      = "Applies To"
+	enclavesColumnHeader       = "Enclaves"
+	totalServicesColumnHeader  = "Total Services"
+	totalCpuColumnHeader       = "Total CPU (millicores)"
+	totalMemoryColumnHeader    = "Total Memory (MB)"
+	enclaveColumnHeader        = "Enclave"
+	servicesColumnHeader       = "Services"
+	cpuColumnHeader            = "CPU (millicores)"
+	memoryColumnHeader         = "Memory (MB)"
+	filesArtifactsColumnHeader = "Files Artifacts (MB)"
+	unlimitedQuotaStr          = "unlimited"
+	unknownUsageStr            = "unknown"
+	unlimitedQuota             = 0
+	bytesInMegabyte            = 1024 * 1024
+	usageOverLimitFormat       = "%v/%v"
+	// The total of a quota with enclaves whose usage is unknown only counts the others
+	partialTotalUsageFormat      = ">=%v"
+	filesArtifactsUsageFormat    = "%.1f"
+	userAppliesToFormat          = "user '%v'"
+	labelSelectorAppliesToFormat = "labels '%v'"
+)
+
+type resourceQuotaUsageOutput struct {
+	Name string `json:"name" yaml:"name"`
+	// Only one of the user and the label selector is set
+	User                                 string                        `json:"user,omitempty" yaml:"user,omitempty"`
+	LabelSelector                        string                        `json:"labelSelector,omitempty" yaml:"labelSelector,omitempty"`
+	MaxEnclaves                          uint32                        `json:"maxEnclaves" yaml:"maxEnclaves"`
+	MaxServices                          uint32                        `json:"maxServices" yaml:"maxServices"`
+	MaxCpuMillicores                     uint64                        `json:"maxCpuMillicores" yaml:"maxCpuMillicores"`
+	MaxMemoryMegabytes                   uint64                        `json:"maxMemoryMegabytes" yaml:"maxMemoryMegabytes"`
+	MaxServicesPerEnclave                uint32                        `json:"maxServicesPerEnclave" yaml:"maxServicesPerEnclave"`
+	MaxCpuMillicoresPerEnclave           uint64                        `json:"maxCpuMillicoresPerEnclave" yaml:"maxCpuMillicoresPerEnclave"`
+	MaxMemoryMegabytesPerEnclave         uint64                        `json:"maxMemoryMegabytesPerEnclave" yaml:"maxMemoryMegabytesPerEnclave"`
+	MaxFilesArtifactsMegabytesPerEnclave uint64                        `json:"maxFilesArtifactsMegabytesPerEnclave" yaml:"maxFilesArtifactsMegabytesPerEnclave"`
+	Enclaves                             []*enclaveResourceUsageOutput `json:"enclaves" yaml:"enclaves"`
+}
+
+type enclaveResourceUsageOutput struct {
+	Name string `json:"name" yaml:"name"`
+	// False if the enclave is stopped or its API container couldn't be reached, in which case the usage is left empty
+	IsUsageKnown               bool   `json:"isUsageKnown" yaml:"isUsageKnown"`
+	ServiceCount               uint32 `json:"serviceCount" yaml:"serviceCount"`
+	ReservedCpuMillicores      uint64 `json:"reservedCpuMillicores" yaml:"reservedCpuMillicores"`
+	ReservedMemoryMegabytes    uint64 `json:"reservedMemoryMegabytes" yaml:"reservedMemoryMegabytes"`
+	FilesArtifactsStorageBytes uint64 `json:"filesArtifactsStorageBytes" yaml:"filesArtifactsStorageBytes"`
+}
+
+// getResourceQuotaUsages returns the usage of the resource quotas set on the running engine. The engine status is
+// still reported if it can't be gotten, e.g. because the engine predates the resource quotas
+func getResourceQuotaUsages(ctx context.Context) []*kurtosis_engine_rpc_api_bindings.ResourceQuotaUsage {
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		logrus.Warnf("An error occurred connecting to the engine to get the usage of its resource quotas:\n%v", err)
+		return nil
+	}
+	resourceQuotaUsages, err := kurtosisCtx.GetResourceQuotaUsage(ctx)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the usage of the engine resource quotas:\n%v", err)
+		return nil
+	}
+	return resourceQuotaUsages
+}
+
+func newResourceQuotaUsageOutputs(resourceQuotaUsages []*kurtosis_engine_rpc_api_bindings.ResourceQuotaUsage) []*resourceQuotaUsageOutput {
+	outputs := []*resourceQuotaUsageOutput{}
+	for _, quotaUsage := range resourceQuotaUsages {
+		enclaveOutputs := []*enclaveResourceUsageOutput{}
+		for _, enclaveUsage := range quotaUsage.GetEnclaveUsages() {
+			enclaveOutputs = append(enclaveOutputs, &enclaveResourceUsageOutput{
+				Name:                       enclaveUsage.GetEnclaveName(),
+				IsUsageKnown:               enclaveUsage.GetIsUsageKnown(),
+				ServiceCount:               enclaveUsage.GetServiceCount(),
+				ReservedCpuMillicores:      enclaveUsage.GetReservedCpuMillicores(),
+				ReservedMemoryMegabytes:    enclaveUsage.GetReservedMemoryMegabytes(),
+				FilesArtifactsStorageBytes: enclaveUsage.GetFilesArtifactsStorageBytes(),
+			})
+		}
+		outputs = append(outputs, &resourceQuotaUsageOutput{
+			Name:                                 quotaUsage.GetName(),
+			User:                                 quotaUsage.GetUser(),
+			LabelSelector:                        quotaUsage.GetLabelSelector(),
+			MaxEnclaves:                          quotaUsage.GetMaxEnclaves(),
+			MaxServices:                          quotaUsage.GetMaxServices(),
+			MaxCpuMillicores:                     quotaUsage.GetMaxCpuMillicores(),
+			MaxMemoryMegabytes:                   quotaUsage.GetMaxMemoryMegabytes(),
+			MaxServicesPerEnclave:                quotaUsage.GetMaxServicesPerEnclave(),
+			MaxCpuMillicoresPerEnclave:           quotaUsage.GetMaxCpuMillicoresPerEnclave(),
+			MaxMemoryMegabytesPerEnclave:         quotaUsage.GetMaxMemoryMegabytesPerEnclave(),
+			MaxFilesArtifactsMegabytesPerEnclave: quotaUsage.GetMaxFilesArtifactsMegabytesPerEnclave(),
+			Enclaves:                             enclaveOutputs,
+		})
+	}
+	return outputs
+}
+
+func printResourceQuotaUsages(resourceQuotaUsages []*kurtosis_engine_rpc_api_bindings.ResourceQuotaUsage) error {
+	quotasTablePrinter := output_printers.NewTablePrinter(quotaColumnHeader, appliesToColumnHeader, enclavesColumnHeader, totalServicesColumnHeader, totalCpuColumnHeader, totalMemoryColumnHeader)
+	enclavesTablePrinter := output_printers.NewTablePrinter(enclaveColumnHeader, quotaColumnHeader, servicesColumnHeader, cpuColumnHeader, memoryColumnHeader, filesArtifactsColumnHeader)
+	hasEnclaves := false
+	for _, quotaUsage := range resourceQuotaUsages {
+		appliesTo := fmt.Sprintf(labelSelectorAppliesToFormat, quotaUsage.GetLabelSelector())
+		if quotaUsage.GetUser() != "" {
+			appliesTo = fmt.Sprintf(userAppliesToFormat, quotaUsage.GetUser())
+		}
+		numEnclaves := len(quotaUsage.GetEnclaveUsages())
+		totalServiceCount, totalCpuMillicores, totalMemoryMegabytes := getTotalReservations(quotaUsage)
+		if err := quotasTablePrinter.AddRow(
+			quotaUsage.GetName(),
+			appliesTo,
+			formatUsageOverLimit(fmt.Sprint(numEnclaves), uint64(quotaUsage.GetMaxEnclaves())),
+			formatUsageOverLimit(totalServiceCount, getTotalLimit(uint64(quotaUsage.GetMaxServices()), uint64(quotaUsage.GetMaxServicesPerEnclave()), quotaUsage.GetMaxEnclaves())),
+			formatUsageOverLimit(totalCpuMillicores, getTotalLimit(quotaUsage.GetMaxCpuMillicores(), quotaUsage.GetMaxCpuMillicoresPerEnclave(), quotaUsage.GetMaxEnclaves())),
+			formatUsageOverLimit(totalMemoryMegabytes, getTotalLimit(quotaUsage.GetMaxMemoryMegabytes(), quotaUsage.GetMaxMemoryMegabytesPerEnclave(), quotaUsage.GetMaxEnclaves())),
+		); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding resource quota '%v' to the table", quotaUsage.GetName())
+		}
+
+		for _, enclaveUsage := range quotaUsage.GetEnclaveUsages() {
+			hasEnclaves = true
+			serviceCount, cpuMillicores, memoryMegabytes, filesArtifactsMegabytes := unknownUsageStr, unknownUsageStr, unknownUsageStr, unknownUsageStr
+			if enclaveUsage.GetIsUsageKnown() {
+				serviceCount = fmt.Sprint(enclaveUsage.GetServiceCount())
+				cpuMillicores = fmt.Sprint(enclaveUsage.GetReservedCpuMillicores())
+				memoryMegabytes = fmt.Sprint(enclaveUsage.GetReservedMemoryMegabytes())
+				filesArtifactsMegabytes = fmt.Sprintf(filesArtifactsUsageFormat, float64(enclaveUsage.GetFilesArtifactsStorageBytes())/bytesInMegabyte)
+			}
+			if err := enclavesTablePrinter.AddRow(
+				enclaveUsage.GetEnclaveName(),
+				quotaUsage.GetName(),
+				formatUsageOverLimit(serviceCount, uint64(quotaUsage.GetMaxServicesPerEnclave())),
+				formatUsageOverLimit(cpuMillicores, quotaUsage.GetMaxCpuMillicoresPerEnclave()),
+				formatUsageOverLimit(memoryMegabytes, quotaUsage.GetMaxMemoryMegabytesPerEnclave()),
+				formatUsageOverLimit(filesArtifactsMegabytes, quotaUsage.GetMaxFilesArtifactsMegabytesPerEnclave()),
+			); err != nil {
+				return stacktrace.Propagate(err, "An error occurred adding the usage of enclave '%v' to the table", enclaveUsage.GetEnclaveName())
+			}
+		}
+	}
+
+	out.PrintOutLn("")
+	out.PrintOutLn("Resource quotas:")
+	quotasTablePrinter.Print()
+	if hasEnclaves {
+		out.PrintOutLn("")
+		enclavesTablePrinter.Print()
+	}
+	return nil
+}
+
+// getTotalReservations sums the services and the CPU and memory reservations of the enclaves of the quota whose usage
+// is known
+func getTotalReservations(quotaUsage *kurtosis_engine_rpc_api_bindings.ResourceQuotaUsage) (string, string, string) {
+	var totalServiceCount uint32
+	var totalCpuMillicores, totalMemoryMegabytes uint64
+	isTotalPartial := false
+	for _, enclaveUsage := range quotaUsage.GetEnclaveUsages() {
+		if !enclaveUsage.GetIsUsageKnown() {
+			isTotalPartial = true
+			continue
+		}
+		totalServiceCount += enclaveUsage.GetServiceCount()
+		totalCpuMillicores += enclaveUsage.GetReservedCpuMillicores()
+		totalMemoryMegabytes += enclaveUsage.GetReservedMemoryMegabytes()
+	}
+	if isTotalPartial {
+		return fmt.Sprintf(partialTotalUsageFormat, totalServiceCount), fmt.Sprintf(partialTotalUsageFormat, totalCpuMillicores), fmt.Sprintf(partialTotalUsageFormat, totalMemoryMegabytes)
+	}
+	return fmt.Sprint(totalServiceCount), fmt.Sprint(totalCpuMillicores), fmt.Sprint(totalMemoryMegabytes)
+}
+
+// getTotalLimit returns what the enclaves of a quota can take together: the most restrictive of the total limit of the
+// quota and of the limit of each enclave times the number of enclaves, the latter being only bounded when both are
+func getTotalLimit(totalLimit uint64, perEnclaveLimit uint64, maxEnclaves uint32) uint64 {
+	if perEnclaveLimit == unlimitedQuota || maxEnclaves == unlimitedQuota {
+		return totalLimit
+	}
+	boundByEnclaves := perEnclaveLimit * uint64(maxEnclaves)
+	if totalLimit != unlimitedQuota && totalLimit < boundByEnclaves {
+		return totalLimit
+	}
+	return boundByEnclaves
+}
+
+func formatUsageOverLimit(usage string, limit uint64) string {
+	limitStr := unlimitedQuotaStr
+	if limit != unlimitedQuota {
+		limitStr = fmt.Sprint(limit)
+	}
+	return fmt.Sprintf(usageOverLimitFormat, usage, limitStr)
+}
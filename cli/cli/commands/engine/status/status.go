@@ -2,8 +2,11 @@ package status
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/spf13/cobra"
 )
@@ -16,9 +19,30 @@ var StatusCmd = &cobra.Command{
 	RunE:  run,
 }
 
+const (
+	engineStatusOutputKind = "EngineStatus"
+)
+
+type engineStatusOutput struct {
+	Status string `json:"status" yaml:"status"`
+	// Empty when the engine isn't running
+	Version string `json:"version" yaml:"version"`
+	// Empty when the engine isn't running or has no resource quotas
+	ResourceQuotas []*resourceQuotaUsageOutput `json:"resourceQuotas,omitempty" yaml:"resourceQuotas,omitempty"`
+}
+
 func run(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
+	outputFormatStr, err := cmd.Flags().GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of the '%v' flag", defaults.OutputFormatFlagKey)
+	}
+	outputFormat, err := output_printers.ParseOutputFormat(outputFormatStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the output format")
+	}
+
 	engineManager, err := engine_manager.NewEngineManager(ctx)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating an engine manager")
@@ -28,7 +52,19 @@ func run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis engine status")
 	}
-	prettyPrintingStatusVisitor := newPrettyPrintingEngineStatusVisitor(maybeApiVersion)
+	resourceQuotaUsages := []*kurtosis_engine_rpc_api_bindings.ResourceQuotaUsage{}
+	if status == engine_manager.EngineStatus_Running {
+		resourceQuotaUsages = getResourceQuotaUsages(ctx)
+	}
+	if outputFormat.IsStructured() {
+		output := &engineStatusOutput{
+			Status:         string(status),
+			Version:        maybeApiVersion,
+			ResourceQuotas: newResourceQuotaUsageOutputs(resourceQuotaUsages),
+		}
+		return output_printers.PrintStructuredOutput(outputFormat, engineStatusOutputKind, output)
+	}
+	prettyPrintingStatusVisitor := newPrettyPrintingEngineStatusVisitor(maybeApiVersion, resourceQuotaUsages)
 	if err := status.Accept(prettyPrintingStatusVisitor); err != nil {
 		return stacktrace.Propagate(err, "An error occurred printing the engine status")
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -43,8 +44,21 @@ const (
 	emptyFileStr          = ""
 	rootLevelFileStr      = ""
 	byteGroup             = 1024
+
+	filesArtifactInspectOutputKind = "FilesArtifactInspect"
 )
 
+type filesArtifactInspectOutput struct {
+	Name  string        `json:"name" yaml:"name"`
+	Files []*fileOutput `json:"files" yaml:"files"`
+}
+
+type fileOutput struct {
+	Path        string `json:"path" yaml:"path"`
+	Size        uint64 `json:"size" yaml:"size"`
+	TextPreview string `json:"text_preview" yaml:"text_preview"`
+}
+
 var sizeSuffix = []byte{'K', 'M', 'G', 'T', 'P'}
 
 var FilesInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
@@ -91,6 +105,11 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the enclave ID using key '%v'", enclaveIdentifierArgKey)
 	}
 
+	outputFormat, err := output_printers.GetOutputFormat(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
@@ -117,6 +136,14 @@ func run(
 	}
 	fileDescriptions := filesInspectResponse.GetFileDescriptions()
 
+	if outputFormat.IsStructured() {
+		output, err := getFilesArtifactInspectOutput(artifactIdentifierName, fileDescriptions, filePath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred building the output for artifact identifier '%v', from '%v'", artifactIdentifierName, enclaveIdentifier)
+		}
+		return output_printers.PrintStructuredOutput(outputFormat, filesArtifactInspectOutputKind, output)
+	}
+
 	if filePath == "" {
 		logrus.Infof("Artifact '%v' contents:%v", artifactIdentifierName, buildTree(fileDescriptions))
 		return nil
@@ -235,3 +262,25 @@ func getCompletionFunc(enclaveArgKey string, artifactArgKey string) func(ctx con
 		return fileArtifactContentPaths, nil
 	}
 }
+
+// getFilesArtifactInspectOutput only includes the file at filePath, unless it's empty
+func getFilesArtifactInspectOutput(artifactName string, fileDescriptions []*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription, filePath string) (*filesArtifactInspectOutput, error) {
+	files := []*fileOutput{}
+	for _, fileDescription := range fileDescriptions {
+		if filePath != emptyFilePath && fileDescription.GetPath() != filePath {
+			continue
+		}
+		files = append(files, &fileOutput{
+			Path:        fileDescription.GetPath(),
+			Size:        fileDescription.GetSize(),
+			TextPreview: fileDescription.GetTextPreview(),
+		})
+	}
+	if filePath != emptyFilePath && len(files) == 0 {
+		return nil, stacktrace.NewError("File '%v' couldn't be found in artifact '%v'", filePath, artifactName)
+	}
+	return &filesArtifactInspectOutput{
+		Name:  artifactName,
+		Files: files,
+	}, nil
+}
@@ -3,7 +3,6 @@ package rendertemplate
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
 	"os"
 	"path"
@@ -40,6 +39,9 @@ const (
 	nameFlagKey = "name"
 	defaultName = ""
 
+	formatFlagKey = "format"
+	defaultFormat = "text"
+
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
 
@@ -51,6 +53,7 @@ def run(plan, args):
 			args["file_name"]: struct(
 				template = args["template"],
 				data = args["template_data"],
+				format = args["format"],
 			),
 		}
 	)
@@ -64,6 +67,7 @@ def run(plan, args):
 			args["file_name"]: struct(
 				template = args["template"],
 				data = args["template_data"],
+				format = args["format"],
 			),
 		}
 	)
@@ -71,9 +75,11 @@ def run(plan, args):
 )
 
 var RenderTemplateCommand = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
-	CommandStr:                command_str_consts.FilesRenderTemplate,
-	ShortDescription:          "Renders a template to an enclave.",
-	LongDescription:           "Renders a Golang text/template to an enclave so that the output can be accessed by services inside the enclave.",
+	CommandStr:       command_str_consts.FilesRenderTemplate,
+	ShortDescription: "Renders a template to an enclave.",
+	LongDescription: "Renders a Golang text/template to an enclave so that the output can be accessed by services inside the enclave. " +
+		"The same template functions as the 'render_templates' Starlark instruction are available (e.g. 'default', 'toYaml', 'b64enc', 'add' or 'artifactFile'), " +
+		"and the rendered output is validated against the format passed with the '" + formatFlagKey + "' flag.",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -83,6 +89,12 @@ var RenderTemplateCommand = &engine_consuming_kurtosis_command.EngineConsumingKu
 			Type:    flags.FlagType_String,
 			Default: defaultName,
 		},
+		{
+			Key:     formatFlagKey,
+			Usage:   "The format of the rendered file, which gets validated after rendering. One of 'text', 'json', 'yaml' or 'toml'",
+			Type:    flags.FlagType_String,
+			Default: defaultFormat,
+		},
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
@@ -146,6 +158,11 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the name to be given to the produced artifact")
 	}
 
+	format, err := flags.GetString(formatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the format of the rendered file")
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
@@ -177,7 +194,7 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred while decoding the JSON file '%v'", dataJSONFilepath)
 	}
 
-	filesArtifactOutputMessage, err := renderTemplateStarlarkCommand(ctx, enclaveCtx, destRelFilepath, templateFileContents, templateData, artifactName)
+	filesArtifactOutputMessage, err := renderTemplateStarlarkCommand(ctx, enclaveCtx, destRelFilepath, templateFileContents, templateData, artifactName, format)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred rendering the template file at path '%v' with data in the file at path '%v' to enclave '%v'", templateFilepath, dataJSONFilepath, enclaveIdentifier)
 	}
@@ -206,18 +223,24 @@ func validateDestRelFilePathArg(destRelFilepath string) (error, bool) {
 	return nil, file_system_path_arg.DoNotContinueWithDefaultValidation
 }
 
-func renderTemplateStarlarkCommand(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, destRelFilepath string, templateFileContents string, templateData interface{}, artifactName string) (string, error) {
+func renderTemplateStarlarkCommand(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, destRelFilepath string, templateFileContents string, templateData interface{}, artifactName string, format string) (string, error) {
 	template := starlarkTemplateWithArtifactName
 	if artifactName == defaultName {
 		template = starlarkTemplateWithoutArtifactName
 	}
 
-	templateDataBytes, err := json.Marshal(templateData)
+	// the params are marshalled rather than formatted into a string, as templates using functions are full of quotes
+	paramsBytes, err := json.Marshal(map[string]interface{}{
+		"file_name":     destRelFilepath,
+		"template":      templateFileContents,
+		"template_data": templateData,
+		"name":          artifactName,
+		"format":        format,
+	})
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error has occurred when parsing input params to render template Starlark command")
 	}
-	params := fmt.Sprintf(`{"file_name": "%s", "template": "%s", "template_data": %s, "name": "%s"}`, destRelFilepath, templateFileContents, string(templateDataBytes), artifactName)
-	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, template, starlark_run_config.NewRunStarlarkConfig(starlark_run_config.WithSerializedParams(params)))
+	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, template, starlark_run_config.NewRunStarlarkConfig(starlark_run_config.WithSerializedParams(string(paramsBytes))))
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred during Starlark script execution for rendering template. This is a bug in Kurtosis")
 	}
@@ -3,6 +3,7 @@ package print
 import (
 	"context"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
@@ -12,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -42,8 +44,22 @@ const (
 	numberStr     = "number"
 
 	ipAddress = "127.0.0.1"
+
+	portPrintOutputKind = "PortPrint"
 )
 
+type portOutput struct {
+	Enclave             string `json:"enclave" yaml:"enclave"`
+	Service             string `json:"service" yaml:"service"`
+	Id                  string `json:"id" yaml:"id"`
+	IpAddress           string `json:"ip_address" yaml:"ip_address"`
+	Number              uint16 `json:"number" yaml:"number"`
+	TransportProtocol   string `json:"transport_protocol" yaml:"transport_protocol"`
+	ApplicationProtocol string `json:"application_protocol" yaml:"application_protocol"`
+	// Empty when the port has no application protocol
+	Url string `json:"url" yaml:"url"`
+}
+
 var expectedRelativeOrder = map[string]int{
 	protocolStr: 0,
 	ipStr:       1,
@@ -69,7 +85,7 @@ var PortPrintCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 		{
 			Key: formatFlagKey,
 			Usage: fmt.Sprintf(
-				"Allows selecting what pieces of port are printed, using comma separated values (examples: %s). Default '%s'.",
+				"Allows selecting what pieces of port are printed, using comma separated values (examples: %s). Default '%s'. Ignored when the output format is JSON or YAML.",
 				strings.Join(formatFlagKeyExamples, ", "), formatFlagKeyDefault),
 			Type:    flags.FlagType_String,
 			Default: formatFlagKeyDefault,
@@ -126,6 +142,11 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the output flag key '%v'", formatFlagKey)
 	}
 
+	outputFormat, err := output_printers.GetOutputFormat(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
@@ -150,6 +171,11 @@ func run(
 		)
 	}
 
+	if outputFormat.IsStructured() {
+		output := newPortOutput(enclaveIdentifier, serviceIdentifier, portIdentifier, publicPort)
+		return output_printers.PrintStructuredOutput(outputFormat, portPrintOutputKind, output)
+	}
+
 	fullUrl, err := formatPortOutput(format, ipAddress, publicPort)
 	if err != nil {
 		return stacktrace.Propagate(err, "Couldn't format the output according to formatting string '%v'", format)
@@ -158,6 +184,24 @@ func run(
 	return nil
 }
 
+func newPortOutput(enclaveIdentifier string, serviceIdentifier string, portIdentifier string, spec *services.PortSpec) *portOutput {
+	url := ""
+	if spec.GetMaybeApplicationProtocol() != "" {
+		url = fmt.Sprintf("%s://%s:%d", spec.GetMaybeApplicationProtocol(), ipAddress, spec.GetNumber())
+	}
+	transportProtocol := kurtosis_core_rpc_api_bindings.Port_TransportProtocol(spec.GetTransportProtocol())
+	return &portOutput{
+		Enclave:             enclaveIdentifier,
+		Service:             serviceIdentifier,
+		Id:                  portIdentifier,
+		IpAddress:           ipAddress,
+		Number:              spec.GetNumber(),
+		TransportProtocol:   strings.ToLower(transportProtocol.String()),
+		ApplicationProtocol: spec.GetMaybeApplicationProtocol(),
+		Url:                 url,
+	}
+}
+
 func formatPortOutput(format string, ipAddress string, spec *services.PortSpec) (string, error) {
 	parts := strings.Split(format, ",")
 	var resultParts []string
@@ -32,6 +32,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/portal"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/secret"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/twitter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/version"
@@ -39,6 +40,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/metrics_client_factory"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_send_metrics_election"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/user_support_constants"
@@ -113,6 +116,11 @@ func init() {
 		defaults.DefaultEnableDebugMode,
 		"Whether should enable Kurtosis in debug mode. The debug mode will use the Kurtosis container debug images version (only enabled for the engine server so far)",
 	)
+	RootCmd.PersistentFlags().String(
+		defaults.OutputFormatFlagKey,
+		string(output_printers.DefaultOutputFormat),
+		"Sets how inspection commands render their data ("+strings.Join(output_printers.AllOutputFormatStrs(), "|")+"). JSON and YAML follow a versioned schema meant for scripts",
+	)
 
 	RootCmd.AddCommand(analytics.AnalyticsCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(clean.CleanCmd.MustGetCobraCommand())
@@ -133,6 +141,7 @@ func init() {
 	RootCmd.AddCommand(port.PortCmd)
 	RootCmd.AddCommand(portal.PortalCmd)
 	RootCmd.AddCommand(run.StarlarkRunCmd.MustGetCobraCommand())
+	RootCmd.AddCommand(secret.SecretCmd)
 	RootCmd.AddCommand(service.ServiceCmd)
 	RootCmd.AddCommand(_import.ImportCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(twitter.TwitterCmd.MustGetCobraCommand())
@@ -151,9 +160,17 @@ func globalSetup(cmd *cobra.Command, args []string) error {
 	if err := setupCLILogs(cmd); err != nil {
 		return stacktrace.Propagate(err, "An error occurred setting up CLI logs")
 	}
+	if err := validateOutputFormat(cmd); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the '%v' flag", defaults.OutputFormatFlagKey)
+	}
 	checkCLIVersion(cmd)
 	//It is necessary to try track this metric on every execution to have at least one successful deliver
-	if err := user_send_metrics_election.SendAnyBackloggedUserMetricsElectionEvent(); err != nil {
+	metricsSinkConfig, err := metrics_client_factory.GetConfiguredMetricsSinkConfig()
+	if err != nil {
+		//We don't want to interrupt users flow if something fails when tracking metrics
+		logrus.Debugf("An error occurred getting the configured metrics sink\n%v", err)
+	}
+	if err := user_send_metrics_election.SendAnyBackloggedUserMetricsElectionEvent(metricsSinkConfig); err != nil {
 		//We don't want to interrupt users flow if something fails when tracking metrics
 		logrus.Debugf("An error occurred tracking user consent to send metrics election\n%v", err)
 	}
@@ -191,6 +208,17 @@ func setupCLILogs(cmd *cobra.Command) error {
 	return nil
 }
 
+func validateOutputFormat(cmd *cobra.Command) error {
+	outputFormatStr, err := cmd.Flags().GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
+	}
+	if _, err = output_printers.ParseOutputFormat(outputFormatStr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing output format '%v'", outputFormatStr)
+	}
+	return nil
+}
+
 func checkCLIVersion(cmd *cobra.Command) {
 	// We temporarily set the logrus output to STDERR so that only these version warning messages get sent there
 	// This is so that if you're running a command that actually prints output (e.g. 'completion', to generate completions)
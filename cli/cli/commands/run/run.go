@@ -31,6 +31,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/chrome_trace"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
@@ -106,6 +107,9 @@ const (
 	nonBlockingModeFlagKey = "non-blocking-tasks"
 	defaultBlockingMode    = "false"
 
+	traceFileFlagKey      = "trace-file"
+	traceFileDefaultValue = ""
+
 	httpProtocolRegexStr = "^(http|https)://"
 )
 
@@ -213,6 +217,12 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Type:    flags.FlagType_Bool,
 			Default: defaultBlockingMode,
 		},
+		{
+			Key:     traceFileFlagKey,
+			Usage:   "If set, Kurtosis will write the trace of the run to this file, in the Chrome trace JSON format that chrome://tracing, Perfetto (ui.perfetto.dev) and speedscope open. It shows how long each instruction took, including the image pulls, container creations and readiness checks.",
+			Type:    flags.FlagType_String,
+			Default: traceFileDefaultValue,
+		},
 	},
 	Args: []*args.ArgConfig{
 		// TODO add a `Usage` description here when ArgConfig supports it
@@ -323,6 +333,11 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", nonBlockingModeFlagKey)
 	}
 
+	traceFilepath, err := flags.GetString(traceFileFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", traceFileFlagKey)
+	}
+
 	if packageArgs == inputArgsAreEmptyBracesByDefault && packageArgsFile != packageArgsFileDefaultValue {
 		logrus.Debugf("'%v' is empty but '%v' is provided so we will go with the '%v' value", inputArgsArgKey, packageArgsFileFlagKey, packageArgsFileFlagKey)
 		packageArgs, err = getArgsFromFilepathOrURL(packageArgsFile)
@@ -408,6 +423,15 @@ func run(
 
 	errRunningKurtosis = ReadAndPrintResponseLinesUntilClosed(responseLineChan, cancelFunc, verbosity, dryRun)
 
+	// The trace gets written for the failed runs too, as that's often when it's needed the most
+	if traceFilepath != traceFileDefaultValue {
+		if err = writeStarlarkRunTraceFile(ctx, enclaveCtx, traceFilepath); err != nil {
+			logrus.Warnf("An error occurred writing the trace of the run to '%v'\nError was: %v", traceFilepath, err)
+		} else {
+			logrus.Infof("Trace of the run written to '%v'", traceFilepath)
+		}
+	}
+
 	if err = enclaveCtx.ConnectServices(ctx, connect); err != nil {
 		logrus.Warnf("An error occurred configuring the user services port forwarding\nError was: %v", err)
 	}
@@ -514,6 +538,17 @@ func executeRemotePackage(
 	return enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, runConfig)
 }
 
+func writeStarlarkRunTraceFile(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, traceFilepath string) error {
+	spans, err := enclaveCtx.GetStarlarkRunTrace(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the trace of the run from the enclave")
+	}
+	if err = chrome_trace.WriteChromeTraceFile(traceFilepath, spans); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the trace of the run")
+	}
+	return nil
+}
+
 // ReadAndPrintResponseLinesUntilClosed TODO(victor.colombo): Extract this to somewhere reasonable
 func ReadAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, cancelFunc context.CancelFunc, verbosity command_args_run.Verbosity, dryRun bool) error {
 	defer cancelFunc()
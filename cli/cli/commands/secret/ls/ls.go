@@ -0,0 +1,103 @@
+package ls
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	secretNameColumnHeader = "Name"
+
+	secretListOutputKind = "SecretList"
+)
+
+// secretOutput is how each secret is rendered in the JSON and YAML output formats
+type secretOutput struct {
+	Name string `json:"name" yaml:"name"`
+}
+
+var SecretLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.SecretLsCmdStr,
+	ShortDescription:          "Lists secrets",
+	LongDescription:           "Lists the names of the secrets of an enclave; secret values can't be read back",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     nil,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	outputFormat, err := output_printers.GetOutputFormat(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	secretNames, err := enclaveCtx.GetSecretNames(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the secrets of enclave '%v'", enclaveIdentifier)
+	}
+
+	if outputFormat.IsStructured() {
+		secretsOutput := []*secretOutput{}
+		for _, secretName := range secretNames {
+			secretsOutput = append(secretsOutput, &secretOutput{Name: secretName})
+		}
+		return output_printers.PrintStructuredOutput(outputFormat, secretListOutputKind, secretsOutput)
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(secretNameColumnHeader)
+	for _, secretName := range secretNames {
+		if err := tablePrinter.AddRow(secretName); err != nil {
+			return stacktrace.NewError("An error occurred adding row for secret '%v' to the table printer", secretName)
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
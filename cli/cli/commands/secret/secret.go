@@ -0,0 +1,24 @@
+package secret
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/secret/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/secret/rm"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/secret/set"
+	"github.com/spf13/cobra"
+)
+
+// SecretCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var SecretCmd = &cobra.Command{
+	Use:   command_str_consts.SecretCmdStr,
+	Short: "Manage secrets for an enclave",
+	Long:  "Contains actions for managing the secrets of an enclave, which services reference with 'Secret(\"name\")' in their env vars and files",
+	RunE:  nil,
+}
+
+func init() {
+	SecretCmd.AddCommand(set.SecretSetCmd.MustGetCobraCommand())
+	SecretCmd.AddCommand(ls.SecretLsCmd.MustGetCobraCommand())
+	SecretCmd.AddCommand(rm.SecretRmCmd.MustGetCobraCommand())
+}
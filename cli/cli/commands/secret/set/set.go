@@ -0,0 +1,152 @@
+package set
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/term"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	secretNameArgKey = "name"
+
+	fromFileFlagKey     = "from-file"
+	fromFileFlagDefault = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	valuePromptFmtStr = "Value of secret '%v': "
+)
+
+// The value is never taken as an argument, so that it doesn't end up in the shell history or in the process list
+var SecretSetCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.SecretSetCmdStr,
+	ShortDescription: "Sets a secret in an enclave",
+	LongDescription: fmt.Sprintf(
+		"Creates the secret, or replaces its value if it already exists. The value is prompted for when stdin is a "+
+			"terminal, and read from stdin otherwise (e.g. 'cat password.txt | %v %v %v my-enclave db-password'), "+
+			"unless the '--%v' flag is set. Services already using the secret keep the value they were started with.",
+		command_str_consts.KurtosisCmdStr,
+		command_str_consts.SecretCmdStr,
+		command_str_consts.SecretSetCmdStr,
+		fromFileFlagKey,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     fromFileFlagKey,
+			Usage:   "Path to a file holding the value of the secret, used as-is",
+			Type:    flags.FlagType_String,
+			Default: fromFileFlagDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key: secretNameArgKey,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	secretName, err := args.GetNonGreedyArg(secretNameArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the secret name using key '%v'", secretNameArgKey)
+	}
+
+	fromFilepath, err := flags.GetString(fromFileFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fromFileFlagKey)
+	}
+
+	secretValue, err := getSecretValue(secretName, fromFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of secret '%v'", secretName)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	if err := enclaveCtx.SetSecret(ctx, secretName, secretValue); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting secret '%v' in enclave '%v'", secretName, enclaveIdentifier)
+	}
+	logrus.Infof("Secret '%v' set in enclave '%v'", secretName, enclaveIdentifier)
+	return nil
+}
+
+func getSecretValue(secretName string, fromFilepath string) (string, error) {
+	if fromFilepath != fromFileFlagDefault {
+		secretValueBytes, err := os.ReadFile(fromFilepath)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred reading file '%v'", fromFilepath)
+		}
+		return string(secretValueBytes), nil
+	}
+
+	stdinFd := int(os.Stdin.Fd())
+	if term.IsTerminal(stdinFd) {
+		// The prompt goes to stderr so it doesn't mix with the output of the command
+		fmt.Fprintf(os.Stderr, valuePromptFmtStr, secretName)
+		secretValueBytes, err := term.ReadPassword(stdinFd)
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred reading the secret value from the terminal")
+		}
+		return string(secretValueBytes), nil
+	}
+	return readSecretValue(os.Stdin)
+}
+
+// readSecretValue reads a piped secret value, dropping the trailing newline that 'echo' and most files end with
+func readSecretValue(reader io.Reader) (string, error) {
+	secretValueBytes, err := io.ReadAll(reader)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading the secret value from stdin")
+	}
+	secretValue := strings.TrimSuffix(string(secretValueBytes), "\n")
+	return strings.TrimSuffix(secretValue, "\r"), nil
+}
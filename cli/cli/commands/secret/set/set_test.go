@@ -0,0 +1,23 @@
+package set
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSecretValue(t *testing.T) {
+	secretValue, err := readSecretValue(strings.NewReader("hunter2\n"))
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", secretValue)
+
+	secretValue, err = readSecretValue(strings.NewReader("hunter2\r\n"))
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", secretValue)
+
+	// Only the trailing newline is dropped
+	secretValue, err = readSecretValue(strings.NewReader("line1\nline2\n\n"))
+	require.NoError(t, err)
+	require.Equal(t, "line1\nline2\n", secretValue)
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/service_health_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/service_status_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
@@ -41,6 +43,9 @@ const (
 	serviceNameTitleName           = "Name"
 	serviceUUIDTitleName           = "UUID"
 	serviceStatusTitleName         = "Status"
+	serviceHealthTitleName         = "Health"
+	serviceHealthMessageTitleName  = "Health message"
+	serviceLastExitReasonTitleName = "Last exit reason"
 	serviceImageTitleName          = "Image"
 	servicePortsTitleName          = "Ports"
 	serviceEntrypointArgsTitleName = "ENTRYPOINT"
@@ -49,6 +54,8 @@ const (
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
+
+	serviceInspectOutputKind = "ServiceInspect"
 )
 
 var ServiceInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
@@ -105,11 +112,20 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidFlagKey)
 	}
 
+	outputFormat, err := output_printers.GetOutputFormat(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
 	}
 
+	if outputFormat.IsStructured() {
+		return printServiceInspectStructuredOutput(ctx, kurtosisCtx, enclaveIdentifier, serviceIdentifier, outputFormat)
+	}
+
 	if err = PrintServiceInspect(ctx, kurtosisBackend, kurtosisCtx, enclaveIdentifier, serviceIdentifier, showFullUuid); err != nil {
 		// this is already wrapped up
 		return err
@@ -118,30 +134,9 @@ func run(
 }
 
 func PrintServiceInspect(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveIdentifier string, serviceIdentifier string, showFullUuid bool) error {
-	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	userService, err := getUserServiceInfo(ctx, kurtosisCtx, enclaveIdentifier, serviceIdentifier)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", enclaveIdentifier)
-	}
-
-	enclaveApiContainerStatus := enclaveInfo.ApiContainerStatus
-	isApiContainerRunning := enclaveApiContainerStatus == kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING
-
-	userServices := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{}
-	if isApiContainerRunning {
-		var err error
-		serviceMap := map[string]bool{
-			serviceIdentifier: true,
-		}
-		userServices, err = user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, serviceMap)
-		if err != nil {
-			return stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
-		}
-	}
-
-	var userService *kurtosis_core_rpc_api_bindings.ServiceInfo
-	for _, userServiceInfo := range userServices {
-		userService = userServiceInfo
-		break
+		return stacktrace.Propagate(err, "An error occurred getting service '%v' in enclave '%v'", serviceIdentifier, enclaveIdentifier)
 	}
 	out.PrintOutLn(fmt.Sprintf("%s: %s", serviceNameTitleName, userService.GetName()))
 
@@ -155,6 +150,15 @@ func PrintServiceInspect(ctx context.Context, kurtosisBackend backend_interface.
 	serviceStatusStr := service_status_stringifier.ServiceStatusStringifier(serviceStatus)
 	out.PrintOutLn(fmt.Sprintf("%s: %s", serviceStatusTitleName, serviceStatusStr))
 
+	serviceHealthStr := service_health_stringifier.ServiceHealthStringifier(userService.GetHealth())
+	out.PrintOutLn(fmt.Sprintf("%s: %s", serviceHealthTitleName, serviceHealthStr))
+	if healthMessage := userService.GetHealth().GetMessage(); healthMessage != "" {
+		out.PrintOutLn(fmt.Sprintf("%s: %s", serviceHealthMessageTitleName, healthMessage))
+	}
+	if lastExitReason := userService.GetHealth().GetLastExitReason(); lastExitReason != "" {
+		out.PrintOutLn(fmt.Sprintf("%s: %s", serviceLastExitReasonTitleName, lastExitReason))
+	}
+
 	out.PrintOutLn(fmt.Sprintf("%s: %s", serviceImageTitleName, userService.GetContainer().GetImageName()))
 
 	out.PrintOutLn(fmt.Sprintf("%s:", servicePortsTitleName))
@@ -183,3 +187,44 @@ func PrintServiceInspect(ctx context.Context, kurtosisBackend backend_interface.
 
 	return nil
 }
+
+func printServiceInspectStructuredOutput(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveIdentifier string, serviceIdentifier string, outputFormat output_printers.OutputFormat) error {
+	userService, err := getUserServiceInfo(ctx, kurtosisCtx, enclaveIdentifier, serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting service '%v' in enclave '%v'", serviceIdentifier, enclaveIdentifier)
+	}
+	if userService == nil {
+		return stacktrace.NewError("Service '%v' couldn't be found in enclave '%v'; its API container needs to be running for it to be inspected", serviceIdentifier, enclaveIdentifier)
+	}
+	return output_printers.PrintStructuredOutput(outputFormat, serviceInspectOutputKind, user_services.NewUserServiceOutput(userService))
+}
+
+// getUserServiceInfo returns nil if the API container of the enclave isn't running, as services can't be listed then
+func getUserServiceInfo(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveIdentifier string, serviceIdentifier string) (*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", enclaveIdentifier)
+	}
+
+	enclaveApiContainerStatus := enclaveInfo.ApiContainerStatus
+	isApiContainerRunning := enclaveApiContainerStatus == kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING
+
+	userServices := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{}
+	if isApiContainerRunning {
+		var err error
+		serviceMap := map[string]bool{
+			serviceIdentifier: true,
+		}
+		userServices, err = user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, serviceMap)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+		}
+	}
+
+	var userService *kurtosis_core_rpc_api_bindings.ServiceInfo
+	for _, userServiceInfo := range userServices {
+		userService = userServiceInfo
+		break
+	}
+	return userService, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/rm"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/shell"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/start"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/stats"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/stop"
 	"github.com/spf13/cobra"
 )
@@ -34,5 +35,6 @@ func init() {
 	ServiceCmd.AddCommand(shell.ServiceShellCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(start.ServiceStartCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(stop.ServiceStopCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(stats.ServiceStatsCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(inspect.ServiceInspectCmd.MustGetCobraCommand())
 }
@@ -0,0 +1,310 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/go-units"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifierArgKey        = "service"
+	isServiceIdentifierArgOptional = true
+	isServiceIdentifierArgGreedy   = true
+
+	shouldFollowStatsFlagKey = "follow"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	interruptChanBufferSize = 5
+
+	// Sampling takes about a second per service on Docker already, so there's no need to wait long between samples
+	followRefreshInterval = 2 * time.Second
+
+	clearScreenSeq = "\x1b[H\x1b[2J"
+
+	serviceStatsOutputKind = "ServiceStats"
+
+	nameColHeader       = "Name"
+	cpuColHeader        = "CPU"
+	memoryColHeader     = "Memory"
+	networkIoColHeader  = "Net I/O (rx / tx)"
+	diskIoColHeader     = "Disk I/O (read / write)"
+	peakCpuColHeader    = "Peak CPU (last run)"
+	peakMemoryColHeader = "Peak Memory (last run)"
+
+	notAvailableStr   = "-"
+	unlimitedValueStr = "unlimited"
+)
+
+var defaultShouldFollowStats = strconv.FormatBool(false)
+
+var ServiceStatsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ServiceStatsCmdStr,
+	ShortDescription: "Show service resource usage",
+	LongDescription: "Show the CPU, memory, network and disk I/O usage of the given services in an enclave, or of all its services if " +
+		"none are given, along with their peak CPU and memory usage over the last run. The peak usage is a good starting " +
+		"point for the 'min_cpu' and 'max_memory' values of the services. Network and disk I/O aren't available on Kubernetes",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isServiceIdentifierArgOptional,
+			isServiceIdentifierArgGreedy,
+		),
+	},
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       shouldFollowStatsFlagKey,
+			Usage:     "Keeps sampling the services and refreshing their usage until stopped",
+			Shorthand: "f",
+			Type:      flags.FlagType_Bool,
+			Default:   defaultShouldFollowStats,
+		},
+	},
+	RunFunc: run,
+}
+
+// serviceStatsOutput is the data model of the structured output of the command
+type serviceStatsOutput struct {
+	Services []*serviceStatsOutputEntry `json:"services" yaml:"services"`
+}
+
+// Usage fields are nil when they aren't available, e.g. I/O on Kubernetes, or peaks if nothing was run yet
+type serviceStatsOutputEntry struct {
+	Name                   string  `json:"name" yaml:"name"`
+	Error                  string  `json:"error,omitempty" yaml:"error,omitempty"`
+	CpuUsageMillicores     *uint64 `json:"cpu_usage_millicores,omitempty" yaml:"cpu_usage_millicores,omitempty"`
+	MemoryUsageBytes       *uint64 `json:"memory_usage_bytes,omitempty" yaml:"memory_usage_bytes,omitempty"`
+	MemoryLimitBytes       *uint64 `json:"memory_limit_bytes,omitempty" yaml:"memory_limit_bytes,omitempty"`
+	NetworkReceivedBytes   *uint64 `json:"network_received_bytes,omitempty" yaml:"network_received_bytes,omitempty"`
+	NetworkSentBytes       *uint64 `json:"network_sent_bytes,omitempty" yaml:"network_sent_bytes,omitempty"`
+	DiskReadBytes          *uint64 `json:"disk_read_bytes,omitempty" yaml:"disk_read_bytes,omitempty"`
+	DiskWrittenBytes       *uint64 `json:"disk_written_bytes,omitempty" yaml:"disk_written_bytes,omitempty"`
+	PeakCpuUsageMillicores *uint64 `json:"peak_cpu_usage_millicores,omitempty" yaml:"peak_cpu_usage_millicores,omitempty"`
+	PeakMemoryUsageBytes   *uint64 `json:"peak_memory_usage_bytes,omitempty" yaml:"peak_memory_usage_bytes,omitempty"`
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifiers, err := args.GetGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifiers using arg key '%v'", serviceIdentifierArgKey)
+	}
+
+	shouldFollowStats, err := flags.GetBool(shouldFollowStatsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the follow flag using key '%v'", shouldFollowStatsFlagKey)
+	}
+
+	outputFormat, err := output_printers.GetOutputFormat(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output format")
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
+	}
+
+	serviceIdentifiersSet := map[string]bool{}
+	for _, serviceIdentifier := range serviceIdentifiers {
+		serviceIdentifiersSet[serviceIdentifier] = true
+	}
+
+	// This channel will receive a signal when the user presses an interrupt
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt)
+
+	for {
+		serviceStats, err := enclaveCtx.GetServiceStats(ctx, serviceIdentifiersSet)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the stats of the services in enclave '%v'", enclaveIdentifier)
+		}
+		output := newServiceStatsOutput(serviceStats)
+		if outputFormat.IsStructured() {
+			if err = output_printers.PrintStructuredOutput(outputFormat, serviceStatsOutputKind, output); err != nil {
+				return stacktrace.Propagate(err, "An error occurred printing the stats of the services")
+			}
+		} else {
+			if shouldFollowStats {
+				fmt.Fprint(out.GetOut(), clearScreenSeq)
+			}
+			if err = printServiceStatsTable(output); err != nil {
+				return stacktrace.Propagate(err, "An error occurred printing the stats of the services")
+			}
+		}
+
+		if !shouldFollowStats {
+			return nil
+		}
+		select {
+		case <-time.After(followRefreshInterval):
+		case <-interruptChan:
+			logrus.Debugf("Received signal interruption in service stats Kurtosis CLI command")
+			return nil
+		}
+	}
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+// newServiceStatsOutput returns the services sorted by name, with the ones which were only in the last run too
+func newServiceStatsOutput(response *kurtosis_core_rpc_api_bindings.GetServiceStatsResponse) *serviceStatsOutput {
+	entriesByName := map[string]*serviceStatsOutputEntry{}
+	getEntry := func(serviceName string) *serviceStatsOutputEntry {
+		entry, found := entriesByName[serviceName]
+		if !found {
+			entry = &serviceStatsOutputEntry{Name: serviceName} //nolint:exhaustruct
+			entriesByName[serviceName] = entry
+		}
+		return entry
+	}
+
+	for serviceName, stats := range response.GetServiceStats() {
+		entry := getEntry(serviceName)
+		entry.CpuUsageMillicores = uint64Ptr(stats.GetCpuUsageMillicores())
+		entry.MemoryUsageBytes = uint64Ptr(stats.GetMemoryUsageBytes())
+		entry.MemoryLimitBytes = uint64Ptr(stats.GetMemoryLimitBytes())
+		if ioStats := stats.GetIoStats(); ioStats != nil {
+			entry.NetworkReceivedBytes = uint64Ptr(ioStats.GetNetworkReceivedBytes())
+			entry.NetworkSentBytes = uint64Ptr(ioStats.GetNetworkSentBytes())
+			entry.DiskReadBytes = uint64Ptr(ioStats.GetDiskReadBytes())
+			entry.DiskWrittenBytes = uint64Ptr(ioStats.GetDiskWrittenBytes())
+		}
+	}
+	for serviceName, serviceErr := range response.GetServiceErrors() {
+		getEntry(serviceName).Error = serviceErr
+	}
+	for serviceName, peakUsage := range response.GetLastRunPeakUsage() {
+		entry := getEntry(serviceName)
+		entry.PeakCpuUsageMillicores = uint64Ptr(peakUsage.GetPeakCpuUsageMillicores())
+		entry.PeakMemoryUsageBytes = uint64Ptr(peakUsage.GetPeakMemoryUsageBytes())
+	}
+
+	serviceNames := []string{}
+	for serviceName := range entriesByName {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+	output := &serviceStatsOutput{Services: []*serviceStatsOutputEntry{}}
+	for _, serviceName := range serviceNames {
+		output.Services = append(output.Services, entriesByName[serviceName])
+	}
+	return output
+}
+
+func printServiceStatsTable(output *serviceStatsOutput) error {
+	tablePrinter := output_printers.NewTablePrinter(nameColHeader, cpuColHeader, memoryColHeader, networkIoColHeader, diskIoColHeader, peakCpuColHeader, peakMemoryColHeader)
+	for _, entry := range output.Services {
+		if err := tablePrinter.AddRow(getServiceStatsTableRow(entry)...); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding the stats of service '%v' to the table", entry.Name)
+		}
+	}
+	tablePrinter.Print()
+	for _, entry := range output.Services {
+		if entry.Error != "" {
+			logrus.Warnf("Couldn't get the stats of service '%v': %v", entry.Name, entry.Error)
+		}
+	}
+	return nil
+}
+
+func getServiceStatsTableRow(entry *serviceStatsOutputEntry) []string {
+	memoryStr := notAvailableStr
+	if entry.MemoryUsageBytes != nil {
+		memoryLimitStr := unlimitedValueStr
+		if entry.MemoryLimitBytes != nil && *entry.MemoryLimitBytes > 0 {
+			memoryLimitStr = formatBytes(entry.MemoryLimitBytes)
+		}
+		memoryStr = fmt.Sprintf("%s / %s", formatBytes(entry.MemoryUsageBytes), memoryLimitStr)
+	}
+	networkIoStr := notAvailableStr
+	if entry.NetworkReceivedBytes != nil {
+		networkIoStr = fmt.Sprintf("%s / %s", formatBytes(entry.NetworkReceivedBytes), formatBytes(entry.NetworkSentBytes))
+	}
+	diskIoStr := notAvailableStr
+	if entry.DiskReadBytes != nil {
+		diskIoStr = fmt.Sprintf("%s / %s", formatBytes(entry.DiskReadBytes), formatBytes(entry.DiskWrittenBytes))
+	}
+	return []string{
+		entry.Name,
+		formatMilliCores(entry.CpuUsageMillicores),
+		memoryStr,
+		networkIoStr,
+		diskIoStr,
+		formatMilliCores(entry.PeakCpuUsageMillicores),
+		formatBytes(entry.PeakMemoryUsageBytes),
+	}
+}
+
+// formatMilliCores uses the same unit as the 'min_cpu' and 'max_cpu' values of the services
+func formatMilliCores(maybeMilliCores *uint64) string {
+	if maybeMilliCores == nil {
+		return notAvailableStr
+	}
+	return fmt.Sprintf("%dm", *maybeMilliCores)
+}
+
+// formatBytes uses decimal units, like the 'min_memory' and 'max_memory' values of the services which are in megabytes
+func formatBytes(maybeBytes *uint64) string {
+	if maybeBytes == nil {
+		return notAvailableStr
+	}
+	return units.HumanSize(float64(*maybeBytes))
+}
+
+func uint64Ptr(value uint64) *uint64 {
+	return &value
+}
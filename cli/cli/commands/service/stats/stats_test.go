@@ -0,0 +1,53 @@
+package stats
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNewServiceStatsOutput(t *testing.T) {
+	response := &kurtosis_core_rpc_api_bindings.GetServiceStatsResponse{
+		ServiceStats: map[string]*kurtosis_core_rpc_api_bindings.ServiceStats{
+			"node": { //nolint:exhaustruct
+				CpuUsageMillicores: 250,
+				MemoryUsageBytes:   100_000_000,
+				MemoryLimitBytes:   512_000_000,
+				IoStats: &kurtosis_core_rpc_api_bindings.ServiceIoStats{
+					NetworkReceivedBytes: 2_000,
+					NetworkSentBytes:     1_000,
+					DiskReadBytes:        0,
+					DiskWrittenBytes:     3_000_000,
+				},
+			},
+		},
+		ServiceErrors: map[string]string{
+			"explorer": "Cannot get stats for service 'explorer' as its container isn't running",
+		},
+		LastRunPeakUsage: map[string]*kurtosis_core_rpc_api_bindings.ServicePeakUsage{
+			"node":     {PeakCpuUsageMillicores: 900, PeakMemoryUsageBytes: 200_000_000}, //nolint:exhaustruct
+			"explorer": {PeakCpuUsageMillicores: 10, PeakMemoryUsageBytes: 1_000_000},    //nolint:exhaustruct
+		},
+	}
+
+	output := newServiceStatsOutput(response)
+	require.Len(t, output.Services, 2)
+	explorer := output.Services[0]
+	node := output.Services[1]
+	require.Equal(t, "explorer", explorer.Name)
+	require.Equal(t, "node", node.Name)
+
+	require.Equal(t, []string{"explorer", "-", "-", "-", "-", "10m", "1MB"}, getServiceStatsTableRow(explorer))
+	require.NotEmpty(t, explorer.Error)
+	require.Equal(t, []string{"node", "250m", "100MB / 512MB", "2kB / 1kB", "0B / 3MB", "900m", "200MB"}, getServiceStatsTableRow(node))
+}
+
+func TestGetServiceStatsTableRow_UnlimitedMemoryWithoutIo(t *testing.T) {
+	response := &kurtosis_core_rpc_api_bindings.GetServiceStatsResponse{ //nolint:exhaustruct
+		ServiceStats: map[string]*kurtosis_core_rpc_api_bindings.ServiceStats{
+			"node": {CpuUsageMillicores: 5, MemoryUsageBytes: 1_500_000}, //nolint:exhaustruct
+		},
+	}
+	output := newServiceStatsOutput(response)
+	require.Equal(t, []string{"node", "5m", "1.5MB / unlimited", "-", "-", "-", "-"}, getServiceStatsTableRow(output.Services[0]))
+}
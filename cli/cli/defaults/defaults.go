@@ -18,12 +18,18 @@ const (
 	// engine-enclave-pool-size = 0 means that enclave pool feat will be disabled
 	DefaultEngineEnclavePoolSize uint8 = 0
 
+	// No host port ranges means the services can only publish their ports on static or ephemeral host ports
+	DefaultEngineHostPortRanges = ""
+
 	// This is the persistent flag key used, accroos all the CLI commands, to determine wheter to run in debug mode
 	DebugModeFlagKey                             = "debug-mode"
 	DefaultEnableDebugMode                       = false
 	DefaultKurtosisContainerDebugImageNameSuffix = "debug"
 
 	DefaultGitHubAuthTokenOverride = ""
+
+	// This is the persistent flag key used, across all the CLI commands, to select how the commands render their data
+	OutputFormatFlagKey = "output"
 )
 
 var DefaultApiContainerLogLevel = logrus.DebugLevel
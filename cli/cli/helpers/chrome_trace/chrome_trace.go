@@ -0,0 +1,128 @@
+package chrome_trace
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// The Chrome trace event format, that chrome://tracing, Perfetto and speedscope open
+// See https://docs.google.com/document/d/1CvAClvFfyA5R-PhYUmn5OOQtYMH4h6I0nSsKchNAySU
+const (
+	completeEventPhase = "X"
+	eventCategory      = "kurtosis"
+
+	// There's a single process, the Starlark run; the threads are the lanes the spans get laid out on
+	starlarkRunPid = 1
+
+	errorArgKey = "error"
+
+	traceFilePermission = 0o644
+)
+
+type ChromeTrace struct {
+	TraceEvents []*ChromeTraceEvent `json:"traceEvents"`
+}
+
+type ChromeTraceEvent struct {
+	Name     string `json:"name"`
+	Category string `json:"cat"`
+	Phase    string `json:"ph"`
+
+	// Microseconds since the start of the Starlark run
+	Timestamp int64 `json:"ts"`
+
+	// Microseconds
+	Duration int64 `json:"dur"`
+
+	ProcessId int `json:"pid"`
+	ThreadId  int `json:"tid"`
+
+	Args map[string]string `json:"args"`
+}
+
+// NewChromeTrace converts the spans of a Starlark run into a Chrome trace
+// The spans get laid out on as few threads as possible, a span going on the same thread as another only if it's
+// nested in it or doesn't overlap it, as the viewers expect the events of a thread to be nested. This way the
+// instructions running in parallel show side by side rather than on top of each other.
+func NewChromeTrace(spans []*kurtosis_core_rpc_api_bindings.StarlarkRunSpan) *ChromeTrace {
+	sortedSpans := make([]*kurtosis_core_rpc_api_bindings.StarlarkRunSpan, len(spans))
+	copy(sortedSpans, spans)
+	// The parents go before their children when they start at the same time, as they're the longest
+	sort.SliceStable(sortedSpans, func(i, j int) bool {
+		startI, startJ := sortedSpans[i].GetStartTime().AsTime(), sortedSpans[j].GetStartTime().AsTime()
+		if !startI.Equal(startJ) {
+			return startI.Before(startJ)
+		}
+		return sortedSpans[i].GetEndTime().AsTime().After(sortedSpans[j].GetEndTime().AsTime())
+	})
+
+	traceEvents := []*ChromeTraceEvent{}
+	if len(sortedSpans) == 0 {
+		return &ChromeTrace{TraceEvents: traceEvents}
+	}
+	traceStartTime := sortedSpans[0].GetStartTime().AsTime()
+
+	// The spans still open on each thread, from the outermost to the innermost
+	openSpansByThread := [][]*kurtosis_core_rpc_api_bindings.StarlarkRunSpan{}
+	for _, span := range sortedSpans {
+		threadIdx := getThreadToLayOutSpanOn(openSpansByThread, span)
+		if threadIdx == len(openSpansByThread) {
+			openSpansByThread = append(openSpansByThread, []*kurtosis_core_rpc_api_bindings.StarlarkRunSpan{})
+		}
+		openSpansByThread[threadIdx] = append(openSpansByThread[threadIdx], span)
+
+		args := map[string]string{}
+		for key, value := range span.GetAttributes() {
+			args[key] = value
+		}
+		if span.GetError() != "" {
+			args[errorArgKey] = span.GetError()
+		}
+		startTime := span.GetStartTime().AsTime()
+		traceEvents = append(traceEvents, &ChromeTraceEvent{
+			Name:      span.GetName(),
+			Category:  eventCategory,
+			Phase:     completeEventPhase,
+			Timestamp: startTime.Sub(traceStartTime).Microseconds(),
+			Duration:  span.GetEndTime().AsTime().Sub(startTime).Microseconds(),
+			ProcessId: starlarkRunPid,
+			// The thread IDs start at 1 as some viewers treat 0 as no thread
+			ThreadId: threadIdx + 1,
+			Args:     args,
+		})
+	}
+	return &ChromeTrace{TraceEvents: traceEvents}
+}
+
+// WriteChromeTraceFile writes the spans of a Starlark run to a Chrome trace JSON file
+func WriteChromeTraceFile(traceFilepath string, spans []*kurtosis_core_rpc_api_bindings.StarlarkRunSpan) error {
+	serializedTrace, err := json.Marshal(NewChromeTrace(spans))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the Chrome trace")
+	}
+	if err = os.WriteFile(traceFilepath, serializedTrace, traceFilePermission); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the Chrome trace to '%v'", traceFilepath)
+	}
+	return nil
+}
+
+// Returns the first thread where the span is nested in the innermost span still open, or a new thread if there's none
+// Mutates the open spans, closing the ones that ended before the span starts
+func getThreadToLayOutSpanOn(openSpansByThread [][]*kurtosis_core_rpc_api_bindings.StarlarkRunSpan, span *kurtosis_core_rpc_api_bindings.StarlarkRunSpan) int {
+	spanStartTime := span.GetStartTime().AsTime()
+	spanEndTime := span.GetEndTime().AsTime()
+	for threadIdx, openSpans := range openSpansByThread {
+		for len(openSpans) > 0 && !openSpans[len(openSpans)-1].GetEndTime().AsTime().After(spanStartTime) {
+			openSpans = openSpans[:len(openSpans)-1]
+		}
+		openSpansByThread[threadIdx] = openSpans
+		if len(openSpans) == 0 || !spanEndTime.After(openSpans[len(openSpans)-1].GetEndTime().AsTime()) {
+			return threadIdx
+		}
+	}
+	return len(openSpansByThread)
+}
@@ -0,0 +1,81 @@
+package chrome_trace
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var runStartTime = time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func TestNewChromeTrace(t *testing.T) {
+	spans := []*kurtosis_core_rpc_api_bindings.StarlarkRunSpan{
+		// Two instructions executed in parallel, out of order as they can come in any order
+		newTestSpan("execute add_service", 200*time.Millisecond, 450*time.Millisecond, map[string]string{"kurtosis.starlark.instruction_number": "2"}, ""),
+		newTestSpan("starlark_run", 0, 500*time.Millisecond, map[string]string{"kurtosis.starlark.package_id": "github.com/test/test"}, ""),
+		newTestSpan("execute add_service", 100*time.Millisecond, 400*time.Millisecond, map[string]string{"kurtosis.starlark.instruction_number": "1"}, "image not found"),
+		newTestSpan("pull_image", 120*time.Millisecond, 180*time.Millisecond, nil, ""),
+	}
+
+	trace := NewChromeTrace(spans)
+	require.Equal(t, []*ChromeTraceEvent{
+		newTestEvent("starlark_run", 0, 500_000, 1, map[string]string{"kurtosis.starlark.package_id": "github.com/test/test"}),
+		newTestEvent("execute add_service", 100_000, 300_000, 1, map[string]string{"kurtosis.starlark.instruction_number": "1", "error": "image not found"}),
+		newTestEvent("pull_image", 120_000, 60_000, 1, map[string]string{}),
+		// Overlaps the other instruction without being nested in it, so it goes to another thread
+		newTestEvent("execute add_service", 200_000, 250_000, 2, map[string]string{"kurtosis.starlark.instruction_number": "2"}),
+	}, trace.TraceEvents)
+}
+
+func TestNewChromeTrace_NoSpans(t *testing.T) {
+	trace := NewChromeTrace(nil)
+	require.Empty(t, trace.TraceEvents)
+}
+
+func TestWriteChromeTraceFile(t *testing.T) {
+	traceFilepath := path.Join(t.TempDir(), "trace.json")
+	spans := []*kurtosis_core_rpc_api_bindings.StarlarkRunSpan{
+		newTestSpan("starlark_run", 0, time.Second, nil, ""),
+	}
+	require.NoError(t, WriteChromeTraceFile(traceFilepath, spans))
+
+	serializedTrace, err := os.ReadFile(traceFilepath)
+	require.NoError(t, err)
+	var trace map[string][]map[string]interface{}
+	require.NoError(t, json.Unmarshal(serializedTrace, &trace))
+	require.Len(t, trace["traceEvents"], 1)
+	require.Equal(t, "X", trace["traceEvents"][0]["ph"])
+	require.Equal(t, float64(1_000_000), trace["traceEvents"][0]["dur"])
+}
+
+func newTestSpan(name string, start time.Duration, end time.Duration, attributes map[string]string, errorMsg string) *kurtosis_core_rpc_api_bindings.StarlarkRunSpan {
+	return binding_constructors.NewStarlarkRunSpan(
+		"",
+		"",
+		name,
+		timestamppb.New(runStartTime.Add(start)),
+		timestamppb.New(runStartTime.Add(end)),
+		attributes,
+		errorMsg,
+	)
+}
+
+func newTestEvent(name string, timestamp int64, duration int64, threadId int, args map[string]string) *ChromeTraceEvent {
+	return &ChromeTraceEvent{
+		Name:      name,
+		Category:  eventCategory,
+		Phase:     completeEventPhase,
+		Timestamp: timestamp,
+		Duration:  duration,
+		ProcessId: starlarkRunPid,
+		ThreadId:  threadId,
+		Args:      args,
+	}
+}
@@ -0,0 +1,97 @@
+package enclave_snapshot
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+	"path"
+)
+
+// Layout of an enclave snapshot once extracted:
+//
+//	kurtosis-enclave-snapshot/
+//	  manifest.json
+//	  enclave_plan.json
+//	  files_artifacts/<files artifact name>/...
+//	  persistent_directories/<persistent key>/...
+const (
+	SnapshotRootDirname          = "kurtosis-enclave-snapshot"
+	ManifestFilename             = "manifest.json"
+	EnclavePlanFilename          = "enclave_plan.json"
+	FilesArtifactsDirname        = "files_artifacts"
+	PersistentDirectoriesDirname = "persistent_directories"
+
+	// Bump this whenever the layout above or the manifest changes in a non backward compatible way
+	CurrentSchemaVersion = 1
+
+	// Name of the files artifact the content of a persistent directory is uploaded to when restoring a snapshot
+	persistentDirectoryFilesArtifactPrefix = "kurtosis-snapshot-"
+
+	snapshotFilesPermission = 0o644
+)
+
+type Manifest struct {
+	SchemaVersion int `json:"schema_version"`
+
+	// Version of the CLI that took the snapshot
+	KurtosisVersion string `json:"kurtosis_version"`
+
+	EnclaveName string `json:"enclave_name"`
+
+	// Digest-pinned image each service was running when the snapshot was taken, keyed by service name; restored services
+	// run these instead of the images referenced in the enclave plan, whose tags may have moved since
+	ServiceImages map[string]string `json:"service_images"`
+
+	FilesArtifactNames []string `json:"files_artifact_names"`
+
+	PersistentDirectoryKeys []string `json:"persistent_directory_keys"`
+}
+
+func GetPersistentDirectoryFilesArtifactName(persistentKey string) string {
+	return persistentDirectoryFilesArtifactPrefix + persistentKey
+}
+
+func WriteManifest(snapshotRootDirpath string, manifest *Manifest) error {
+	serializedManifest, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the enclave snapshot manifest")
+	}
+	manifestFilepath := path.Join(snapshotRootDirpath, ManifestFilename)
+	if err = os.WriteFile(manifestFilepath, serializedManifest, snapshotFilesPermission); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the enclave snapshot manifest to '%s'", manifestFilepath)
+	}
+	return nil
+}
+
+func ReadManifest(snapshotRootDirpath string) (*Manifest, error) {
+	manifestFilepath := path.Join(snapshotRootDirpath, ManifestFilename)
+	serializedManifest, err := os.ReadFile(manifestFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave snapshot manifest at '%s'", manifestFilepath)
+	}
+	manifest := new(Manifest)
+	if err = json.Unmarshal(serializedManifest, manifest); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave snapshot manifest at '%s'", manifestFilepath)
+	}
+	if manifest.SchemaVersion != CurrentSchemaVersion {
+		return nil, stacktrace.NewError("The enclave snapshot has schema version '%d' but this version of Kurtosis can only restore snapshots with schema version '%d'", manifest.SchemaVersion, CurrentSchemaVersion)
+	}
+	return manifest, nil
+}
+
+func WriteEnclavePlan(snapshotRootDirpath string, serializedEnclavePlan string) error {
+	enclavePlanFilepath := path.Join(snapshotRootDirpath, EnclavePlanFilename)
+	if err := os.WriteFile(enclavePlanFilepath, []byte(serializedEnclavePlan), snapshotFilesPermission); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the enclave plan to '%s'", enclavePlanFilepath)
+	}
+	return nil
+}
+
+func ReadEnclavePlan(snapshotRootDirpath string) (string, error) {
+	enclavePlanFilepath := path.Join(snapshotRootDirpath, EnclavePlanFilename)
+	serializedEnclavePlan, err := os.ReadFile(enclavePlanFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading the enclave plan at '%s'", enclavePlanFilepath)
+	}
+	return string(serializedEnclavePlan), nil
+}
@@ -6,6 +6,12 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 )
 
+const (
+	emptyStatusStr   = "EMPTY"
+	runningStatusStr = "RUNNING"
+	stoppedStatusStr = "STOPPED"
+)
+
 var (
 	colorizeRunning = color.New(color.FgGreen).SprintFunc()
 	colorizeStopped = color.New(color.FgYellow).SprintFunc()
@@ -13,13 +19,30 @@ var (
 )
 
 func EnclaveContainersStatusStringifier(enclaveStatus kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus) (string, error) {
+	enclaveStatusStr, err := EnclaveContainersStatusPlainStringifier(enclaveStatus)
+	if err != nil {
+		return "", err
+	}
+	switch enclaveStatusStr {
+	case emptyStatusStr:
+		return colorizeEmpty(enclaveStatusStr), nil
+	case runningStatusStr:
+		return colorizeRunning(enclaveStatusStr), nil
+	default:
+		return colorizeStopped(enclaveStatusStr), nil
+	}
+}
+
+// EnclaveContainersStatusPlainStringifier is the uncolored version of EnclaveContainersStatusStringifier, for outputs
+// meant to be read by scripts
+func EnclaveContainersStatusPlainStringifier(enclaveStatus kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus) (string, error) {
 	switch enclaveStatus {
 	case kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_EMPTY:
-		return colorizeEmpty("EMPTY"), nil
+		return emptyStatusStr, nil
 	case kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING:
-		return colorizeRunning("RUNNING"), nil
+		return runningStatusStr, nil
 	case kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_STOPPED:
-		return colorizeStopped("STOPPED"), nil
+		return stoppedStatusStr, nil
 	default:
 		return "", stacktrace.NewError("Unrecognized enclave status '%v'; this is a bug in Kurtosis", enclaveStatus)
 	}
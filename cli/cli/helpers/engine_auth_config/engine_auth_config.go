@@ -0,0 +1,160 @@
+package engine_auth_config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// The engine auth config YAML file, as written by the user. The certificates, keys and JWKS are referenced by their
+// path on the host, and get inlined in the engine server args because the engine can't read the host files
+type engineAuthConfigYaml struct {
+	ApiTokens []*apiTokenYaml `yaml:"api_tokens,omitempty"`
+
+	MutualTls *mutualTlsYaml `yaml:"mutual_tls,omitempty"`
+
+	Oidc *oidcYaml `yaml:"oidc,omitempty"`
+}
+
+type apiTokenYaml struct {
+	Name string `yaml:"name"`
+
+	TokenSha256 string `yaml:"token_sha256"`
+
+	Role string `yaml:"role"`
+}
+
+type mutualTlsYaml struct {
+	ServerCertFilepath string `yaml:"server_cert_file"`
+
+	ServerKeyFilepath string `yaml:"server_key_file"`
+
+	ClientCaCertFilepath string `yaml:"client_ca_cert_file"`
+
+	ClientRoles map[string]string `yaml:"client_roles,omitempty"`
+
+	DefaultRole string `yaml:"default_role,omitempty"`
+}
+
+type oidcYaml struct {
+	Issuer string `yaml:"issuer"`
+
+	Audience string `yaml:"audience"`
+
+	JwksFilepath string `yaml:"jwks_file"`
+
+	UsernameClaim string `yaml:"username_claim,omitempty"`
+
+	RolesClaim string `yaml:"roles_claim,omitempty"`
+
+	DefaultRole string `yaml:"default_role,omitempty"`
+}
+
+// GetEngineAuthConfig reads the engine auth config YAML file from the Kurtosis config directory, returning nil if the
+// user didn't write one, in which case the engine APIs are left open
+func GetEngineAuthConfig() (*args.EngineAuthConfig, error) {
+	engineAuthConfigFilepath, err := host_machine_directories.GetEngineAuthConfigYAMLFilepath()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine auth config filepath")
+	}
+	if _, err := os.Stat(engineAuthConfigFilepath); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred checking if the engine auth config file '%v' exists", engineAuthConfigFilepath)
+	}
+	engineAuthConfig, err := readEngineAuthConfig(engineAuthConfigFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the engine auth config file '%v'", engineAuthConfigFilepath)
+	}
+	return engineAuthConfig, nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func readEngineAuthConfig(engineAuthConfigFilepath string) (*args.EngineAuthConfig, error) {
+	fileContent, err := os.ReadFile(engineAuthConfigFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading file '%v'", engineAuthConfigFilepath)
+	}
+	parsedConfig := &engineAuthConfigYaml{
+		ApiTokens: nil,
+		MutualTls: nil,
+		Oidc:      nil,
+	}
+	if err := yaml.UnmarshalStrict(fileContent, parsedConfig); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the YAML in file '%v'", engineAuthConfigFilepath)
+	}
+	// The relative paths in the file are relative to the file itself
+	configDirpath := filepath.Dir(engineAuthConfigFilepath)
+
+	result := &args.EngineAuthConfig{
+		ApiTokens: nil,
+		MutualTls: nil,
+		Oidc:      nil,
+	}
+	for _, apiToken := range parsedConfig.ApiTokens {
+		result.ApiTokens = append(result.ApiTokens, &args.ApiTokenAuthConfig{
+			Name:        apiToken.Name,
+			TokenSha256: apiToken.TokenSha256,
+			Role:        apiToken.Role,
+		})
+	}
+	if parsedConfig.MutualTls != nil {
+		serverCertPem, err := readReferencedFile(configDirpath, parsedConfig.MutualTls.ServerCertFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the mTLS server certificate")
+		}
+		serverKeyPem, err := readReferencedFile(configDirpath, parsedConfig.MutualTls.ServerKeyFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the mTLS server key")
+		}
+		clientCaCertPem, err := readReferencedFile(configDirpath, parsedConfig.MutualTls.ClientCaCertFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the mTLS client CA certificate")
+		}
+		result.MutualTls = &args.MutualTlsAuthConfig{
+			ServerCertPem:   serverCertPem,
+			ServerKeyPem:    serverKeyPem,
+			ClientCaCertPem: clientCaCertPem,
+			ClientRoles:     parsedConfig.MutualTls.ClientRoles,
+			DefaultRole:     parsedConfig.MutualTls.DefaultRole,
+		}
+	}
+	if parsedConfig.Oidc != nil {
+		jwksJson, err := readReferencedFile(configDirpath, parsedConfig.Oidc.JwksFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the OIDC JWKS")
+		}
+		result.Oidc = &args.OidcAuthConfig{
+			Issuer:        parsedConfig.Oidc.Issuer,
+			Audience:      parsedConfig.Oidc.Audience,
+			JwksJson:      jwksJson,
+			UsernameClaim: parsedConfig.Oidc.UsernameClaim,
+			RolesClaim:    parsedConfig.Oidc.RolesClaim,
+			DefaultRole:   parsedConfig.Oidc.DefaultRole,
+		}
+	}
+	return result, nil
+}
+
+func readReferencedFile(configDirpath string, referencedFilepath string) (string, error) {
+	if referencedFilepath == "" {
+		return "", stacktrace.NewError("Expected a filepath but none was set")
+	}
+	if !filepath.IsAbs(referencedFilepath) {
+		referencedFilepath = filepath.Join(configDirpath, referencedFilepath)
+	}
+	fileContent, err := os.ReadFile(referencedFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading file '%v'", referencedFilepath)
+	}
+	return string(fileContent), nil
+}
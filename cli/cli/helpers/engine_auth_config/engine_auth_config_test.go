@@ -0,0 +1,58 @@
+package engine_auth_config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testJwksJson = `{"keys":[]}`
+
+	testEngineAuthConfigYaml = `
+api_tokens:
+  - name: ci
+    token_sha256: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+    role: enclave-owner
+oidc:
+  issuer: https://issuer.example.com
+  audience: kurtosis-engine
+  jwks_file: jwks.json
+  default_role: read-only
+`
+)
+
+func TestReadEngineAuthConfig(t *testing.T) {
+	configDirpath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(configDirpath, "jwks.json"), []byte(testJwksJson), 0644))
+	configFilepath := filepath.Join(configDirpath, "engine-auth-config.yml")
+	require.NoError(t, os.WriteFile(configFilepath, []byte(testEngineAuthConfigYaml), 0644))
+
+	engineAuthConfig, err := readEngineAuthConfig(configFilepath)
+	require.NoError(t, err)
+	require.Len(t, engineAuthConfig.ApiTokens, 1)
+	require.Equal(t, "ci", engineAuthConfig.ApiTokens[0].Name)
+	require.Equal(t, "enclave-owner", engineAuthConfig.ApiTokens[0].Role)
+	require.Nil(t, engineAuthConfig.MutualTls)
+	require.NotNil(t, engineAuthConfig.Oidc)
+	require.Equal(t, testJwksJson, engineAuthConfig.Oidc.JwksJson)
+	require.Equal(t, "read-only", engineAuthConfig.Oidc.DefaultRole)
+}
+
+func TestReadEngineAuthConfig_UnknownFieldIsRejected(t *testing.T) {
+	configFilepath := filepath.Join(t.TempDir(), "engine-auth-config.yml")
+	require.NoError(t, os.WriteFile(configFilepath, []byte("api_token:\n  - name: ci\n"), 0644))
+
+	_, err := readEngineAuthConfig(configFilepath)
+	require.Error(t, err)
+}
+
+func TestReadEngineAuthConfig_MissingReferencedFile(t *testing.T) {
+	configFilepath := filepath.Join(t.TempDir(), "engine-auth-config.yml")
+	require.NoError(t, os.WriteFile(configFilepath, []byte(testEngineAuthConfigYaml), 0644))
+
+	_, err := readEngineAuthConfig(configFilepath)
+	require.Error(t, err)
+}
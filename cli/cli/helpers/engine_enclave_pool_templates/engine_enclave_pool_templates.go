@@ -0,0 +1,95 @@
+package engine_enclave_pool_templates
+
+import (
+	"os"
+
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// The engine enclave pool templates YAML file, as written by the user. The templates are validated by the engine when
+// it starts
+type engineEnclavePoolTemplatesYaml struct {
+	Templates []*enclavePoolTemplateYaml `yaml:"templates"`
+}
+
+type enclavePoolTemplateYaml struct {
+	Name string `yaml:"name"`
+
+	Size uint8 `yaml:"size"`
+
+	Packages []*enclavePoolTemplatePackageYaml `yaml:"packages"`
+
+	Parallelism int32 `yaml:"parallelism,omitempty"`
+
+	ImageDownloadMode string `yaml:"image_download_mode,omitempty"`
+
+	MaxConsecutiveFailures uint32 `yaml:"max_consecutive_failures,omitempty"`
+}
+
+type enclavePoolTemplatePackageYaml struct {
+	PackageId string `yaml:"package_id"`
+
+	// Serialized as JSON, like the args of 'kurtosis run'
+	Args string `yaml:"args,omitempty"`
+}
+
+// GetEngineEnclavePoolTemplates reads the engine enclave pool templates YAML file from the Kurtosis config directory,
+// returning nil if the user didn't write one, in which case the enclave pool only keeps empty enclaves
+func GetEngineEnclavePoolTemplates() ([]*args.EnclavePoolTemplateConfig, error) {
+	engineEnclavePoolTemplatesFilepath, err := host_machine_directories.GetEngineEnclavePoolTemplatesYAMLFilepath()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine enclave pool templates filepath")
+	}
+	if _, err := os.Stat(engineEnclavePoolTemplatesFilepath); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred checking if the engine enclave pool templates file '%v' exists", engineEnclavePoolTemplatesFilepath)
+	}
+	enclavePoolTemplates, err := readEngineEnclavePoolTemplates(engineEnclavePoolTemplatesFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the engine enclave pool templates file '%v'", engineEnclavePoolTemplatesFilepath)
+	}
+	return enclavePoolTemplates, nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func readEngineEnclavePoolTemplates(engineEnclavePoolTemplatesFilepath string) ([]*args.EnclavePoolTemplateConfig, error) {
+	fileContent, err := os.ReadFile(engineEnclavePoolTemplatesFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading file '%v'", engineEnclavePoolTemplatesFilepath)
+	}
+	parsedEnclavePoolTemplates := &engineEnclavePoolTemplatesYaml{
+		Templates: nil,
+	}
+	if err := yaml.UnmarshalStrict(fileContent, parsedEnclavePoolTemplates); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the YAML in file '%v'", engineEnclavePoolTemplatesFilepath)
+	}
+
+	result := []*args.EnclavePoolTemplateConfig{}
+	for _, template := range parsedEnclavePoolTemplates.Templates {
+		packages := []*args.EnclavePoolTemplatePackageConfig{}
+		for _, templatePackage := range template.Packages {
+			packages = append(packages, &args.EnclavePoolTemplatePackageConfig{
+				PackageId: templatePackage.PackageId,
+				Args:      templatePackage.Args,
+			})
+		}
+		result = append(result, &args.EnclavePoolTemplateConfig{
+			Name:                   template.Name,
+			Size:                   template.Size,
+			Packages:               packages,
+			Parallelism:            template.Parallelism,
+			ImageDownloadMode:      template.ImageDownloadMode,
+			MaxConsecutiveFailures: template.MaxConsecutiveFailures,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,59 @@
+package engine_enclave_pool_templates
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEngineEnclavePoolTemplatesYaml = `
+templates:
+  - name: postgres-and-redis
+    size: 2
+    packages:
+      - package_id: github.com/kurtosis-tech/postgres-package
+        args: '{"max_connections": 200}'
+      - package_id: github.com/kurtosis-tech/redis-package
+  - name: ethereum
+    size: 1
+    parallelism: 8
+    image_download_mode: always
+    max_consecutive_failures: 3
+    packages:
+      - package_id: github.com/ethpandaops/ethereum-package
+`
+)
+
+func TestReadEngineEnclavePoolTemplates(t *testing.T) {
+	templatesFilepath := filepath.Join(t.TempDir(), "engine-enclave-pool-templates.yml")
+	require.NoError(t, os.WriteFile(templatesFilepath, []byte(testEngineEnclavePoolTemplatesYaml), 0644))
+
+	templates, err := readEngineEnclavePoolTemplates(templatesFilepath)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	require.Equal(t, "postgres-and-redis", templates[0].Name)
+	require.Equal(t, uint8(2), templates[0].Size)
+	require.Len(t, templates[0].Packages, 2)
+	require.Equal(t, "github.com/kurtosis-tech/postgres-package", templates[0].Packages[0].PackageId)
+	require.Equal(t, `{"max_connections": 200}`, templates[0].Packages[0].Args)
+	require.Empty(t, templates[0].Packages[1].Args)
+	require.Zero(t, templates[0].Parallelism)
+	require.Empty(t, templates[0].ImageDownloadMode)
+	require.Zero(t, templates[0].MaxConsecutiveFailures)
+	require.Equal(t, "ethereum", templates[1].Name)
+	require.Len(t, templates[1].Packages, 1)
+	require.Equal(t, int32(8), templates[1].Parallelism)
+	require.Equal(t, "always", templates[1].ImageDownloadMode)
+	require.Equal(t, uint32(3), templates[1].MaxConsecutiveFailures)
+}
+
+func TestReadEngineEnclavePoolTemplates_UnknownFieldIsRejected(t *testing.T) {
+	templatesFilepath := filepath.Join(t.TempDir(), "engine-enclave-pool-templates.yml")
+	require.NoError(t, os.WriteFile(templatesFilepath, []byte("templates:\n  - name: ethereum\n    packages:\n      - package: github.com/ethpandaops/ethereum-package\n"), 0644))
+
+	_, err := readEngineEnclavePoolTemplates(templatesFilepath)
+	require.Error(t, err)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/user_support_constants"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -72,10 +73,31 @@ type engineExistenceGuarantor struct {
 
 	poolSize uint8
 
+	// Named host port ranges the services of the enclaves can publish their ports on
+	hostPortRanges string
+
 	enclaveEnvVars string
 
 	allowedCORSOrigins *[]string
 
+	// How the engine APIs authenticate and authorize their callers; nil leaves them open
+	authConfig *args.EngineAuthConfig
+
+	// Quotas on the enclaves created by the engine; empty means no quota applies
+	resourceQuotas []*args.ResourceQuotaConfig
+
+	// URLs the engine events get posted to; empty means they aren't posted anywhere
+	webhooks []*args.WebhookConfig
+
+	// OTLP endpoint the API containers export the traces of the Starlark runs to; empty means they aren't exported
+	otlpTracesEndpoint string
+
+	// Sink the engine and the API containers send the metrics to; nil means they send them to Segment
+	metricsSinkConfig *metrics_client.SinkConfig
+
+	// Enclaves the enclave pool keeps warm with packages already run in them; empty means it only keeps empty enclaves
+	enclavePoolTemplates []*args.EnclavePoolTemplateConfig
+
 	// Whether the engine's should run with the debug server to receive a remote debug connection
 	shouldRunInDebugMode bool
 
@@ -94,8 +116,15 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 	kurtosisClusterType resolved_config.KurtosisClusterType,
 	onBastionHost bool,
 	poolSize uint8,
+	hostPortRanges string,
 	enclaveEnvVars string,
 	allowedCORSOrigins *[]string,
+	authConfig *args.EngineAuthConfig,
+	resourceQuotas []*args.ResourceQuotaConfig,
+	webhooks []*args.WebhookConfig,
+	otlpTracesEndpoint string,
+	metricsSinkConfig *metrics_client.SinkConfig,
+	enclavePoolTemplates []*args.EnclavePoolTemplateConfig,
 	shouldRunInDebugMode bool,
 	githubAuthTokenOverride string,
 
@@ -112,8 +141,15 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 		kurtosisClusterType,
 		onBastionHost,
 		poolSize,
+		hostPortRanges,
 		enclaveEnvVars,
 		allowedCORSOrigins,
+		authConfig,
+		resourceQuotas,
+		webhooks,
+		otlpTracesEndpoint,
+		metricsSinkConfig,
+		enclavePoolTemplates,
 		shouldRunInDebugMode,
 		githubAuthTokenOverride,
 	)
@@ -131,8 +167,15 @@ func newEngineExistenceGuarantorWithCustomVersion(
 	kurtosisClusterType resolved_config.KurtosisClusterType,
 	onBastionHost bool,
 	poolSize uint8,
+	hostPortRanges string,
 	enclaveEnvVars string,
 	allowedCORSOrigins *[]string,
+	authConfig *args.EngineAuthConfig,
+	resourceQuotas []*args.ResourceQuotaConfig,
+	webhooks []*args.WebhookConfig,
+	otlpTracesEndpoint string,
+	metricsSinkConfig *metrics_client.SinkConfig,
+	enclavePoolTemplates []*args.EnclavePoolTemplateConfig,
 	shouldRunInDebugMode bool,
 	githubAuthTokenOverride string,
 ) *engineExistenceGuarantor {
@@ -150,8 +193,15 @@ func newEngineExistenceGuarantorWithCustomVersion(
 		kurtosisClusterType:                       kurtosisClusterType,
 		onBastionHost:                             onBastionHost,
 		poolSize:                                  poolSize,
+		hostPortRanges:                            hostPortRanges,
 		enclaveEnvVars:                            enclaveEnvVars,
 		allowedCORSOrigins:                        allowedCORSOrigins,
+		authConfig:                                authConfig,
+		resourceQuotas:                            resourceQuotas,
+		webhooks:                                  webhooks,
+		otlpTracesEndpoint:                        otlpTracesEndpoint,
+		metricsSinkConfig:                         metricsSinkConfig,
+		enclavePoolTemplates:                      enclavePoolTemplates,
 		shouldRunInDebugMode:                      shouldRunInDebugMode,
 		githubAuthTokenOverride:                   githubAuthTokenOverride,
 	}
@@ -212,6 +262,13 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.allowedCORSOrigins,
 			guarantor.shouldRunInDebugMode,
 			githubAuthToken,
+			guarantor.hostPortRanges,
+			guarantor.authConfig,
+			guarantor.resourceQuotas,
+			guarantor.webhooks,
+			guarantor.otlpTracesEndpoint,
+			guarantor.metricsSinkConfig,
+			guarantor.enclavePoolTemplates,
 		)
 	} else {
 		_, _, engineLaunchErr = guarantor.engineServerLauncher.LaunchWithCustomVersion(
@@ -231,6 +288,13 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.allowedCORSOrigins,
 			guarantor.shouldRunInDebugMode,
 			githubAuthToken,
+			guarantor.hostPortRanges,
+			guarantor.authConfig,
+			guarantor.resourceQuotas,
+			guarantor.webhooks,
+			guarantor.otlpTracesEndpoint,
+			guarantor.metricsSinkConfig,
+			guarantor.enclavePoolTemplates,
 		)
 	}
 	if engineLaunchErr != nil {
@@ -9,6 +9,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_auth_config"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_enclave_pool_templates"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_resource_quotas"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_webhooks"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_cluster_setting"
@@ -18,11 +22,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -45,6 +50,18 @@ type EngineManager struct {
 	onBastionHost                             bool
 	enclaveEnvVars                            string
 	allowedCORSOrigins                        *[]string
+	// Read from the optional engine auth config file, so every engine start and restart applies it
+	authConfig *args.EngineAuthConfig
+	// Read from the optional engine resource quotas file, so every engine start and restart applies them
+	resourceQuotas []*args.ResourceQuotaConfig
+	// Read from the optional engine webhooks file, so every engine start and restart posts its events to them
+	webhooks []*args.WebhookConfig
+	// From the tracing section of the Kurtosis config, so the API containers export the traces of the Starlark runs
+	otlpTracesEndpoint string
+	// From the metrics sink section of the Kurtosis config, so the engine and the API containers send the metrics there too
+	metricsSinkConfig *metrics_client.SinkConfig
+	// Read from the optional engine enclave pool templates file, so every engine start and restart keeps them warm
+	enclavePoolTemplates []*args.EnclavePoolTemplateConfig
 	// Make engine IP, port, and protocol configurable in the future
 }
 
@@ -97,14 +114,40 @@ func NewEngineManager(ctx context.Context) (*EngineManager, error) {
 		}
 	}
 
+	authConfig, err := engine_auth_config.GetEngineAuthConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine auth config")
+	}
+
+	resourceQuotas, err := engine_resource_quotas.GetEngineResourceQuotas()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine resource quotas")
+	}
+
+	webhooks, err := engine_webhooks.GetEngineWebhooks()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine webhooks")
+	}
+
+	enclavePoolTemplates, err := engine_enclave_pool_templates.GetEngineEnclavePoolTemplates()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine enclave pool templates")
+	}
+
 	return &EngineManager{
 		kurtosisBackend:   kurtosisBackend,
 		shouldSendMetrics: kurtosisConfig.GetShouldSendMetrics(),
 		engineServerKurtosisBackendConfigSupplier: engineBackendConfigSupplier,
-		clusterConfig:      clusterConfig,
-		onBastionHost:      onBastionHost,
-		enclaveEnvVars:     enclaveEnvVars,
-		allowedCORSOrigins: nil,
+		clusterConfig:        clusterConfig,
+		onBastionHost:        onBastionHost,
+		enclaveEnvVars:       enclaveEnvVars,
+		allowedCORSOrigins:   nil,
+		authConfig:           authConfig,
+		resourceQuotas:       resourceQuotas,
+		webhooks:             webhooks,
+		otlpTracesEndpoint:   kurtosisConfig.GetOtlpTracesEndpoint(),
+		metricsSinkConfig:    kurtosisConfig.GetMetricsSinkConfig(),
+		enclavePoolTemplates: enclavePoolTemplates,
 	}, nil
 }
 
@@ -180,6 +223,7 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(
 	ctx context.Context,
 	logLevel logrus.Level,
 	poolSize uint8,
+	hostPortRanges string,
 	githubAuthTokenOverride string) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
 	status, maybeHostMachinePortBinding, engineVersion, err := manager.GetEngineStatus(ctx)
 	if err != nil {
@@ -198,8 +242,15 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(
 		clusterType,
 		manager.onBastionHost,
 		poolSize,
+		hostPortRanges,
 		manager.enclaveEnvVars,
 		manager.allowedCORSOrigins,
+		manager.authConfig,
+		manager.resourceQuotas,
+		manager.webhooks,
+		manager.otlpTracesEndpoint,
+		manager.metricsSinkConfig,
+		manager.enclavePoolTemplates,
 		doNotStartTheEngineInDebugModeForDefaultVersion,
 		githubAuthTokenOverride,
 	)
@@ -218,6 +269,7 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(
 	engineImageVersionTag string,
 	logLevel logrus.Level,
 	poolSize uint8,
+	hostPortRanges string,
 	shouldStartInDebugMode bool,
 	githubAuthTokenOverride string) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
 	status, maybeHostMachinePortBinding, engineVersion, err := manager.GetEngineStatus(ctx)
@@ -238,8 +290,15 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(
 		clusterType,
 		manager.onBastionHost,
 		poolSize,
+		hostPortRanges,
 		manager.enclaveEnvVars,
 		manager.allowedCORSOrigins,
+		manager.authConfig,
+		manager.resourceQuotas,
+		manager.webhooks,
+		manager.otlpTracesEndpoint,
+		manager.metricsSinkConfig,
+		manager.enclavePoolTemplates,
 		shouldStartInDebugMode,
 		githubAuthTokenOverride,
 	)
@@ -339,6 +398,7 @@ func (manager *EngineManager) RestartEngineIdempotently(
 	optionalVersionToUse string,
 	restartEngineOnSameVersionIfAnyRunning bool,
 	poolSize uint8,
+	hostPortRanges string,
 	shouldStartInDebugMode bool,
 	githubAuthTokenOverride string) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
 	var versionOfNewEngine string
@@ -365,9 +425,9 @@ func (manager *EngineManager) RestartEngineIdempotently(
 	var engineClientCloseFunc func() error
 	var restartEngineErr error
 	if versionOfNewEngine != defaultEngineVersion {
-		_, engineClientCloseFunc, restartEngineErr = manager.StartEngineIdempotentlyWithCustomVersion(ctx, versionOfNewEngine, logLevel, poolSize, shouldStartInDebugMode, githubAuthTokenOverride)
+		_, engineClientCloseFunc, restartEngineErr = manager.StartEngineIdempotentlyWithCustomVersion(ctx, versionOfNewEngine, logLevel, poolSize, hostPortRanges, shouldStartInDebugMode, githubAuthTokenOverride)
 	} else {
-		_, engineClientCloseFunc, restartEngineErr = manager.StartEngineIdempotentlyWithDefaultVersion(ctx, logLevel, poolSize, githubAuthTokenOverride)
+		_, engineClientCloseFunc, restartEngineErr = manager.StartEngineIdempotentlyWithDefaultVersion(ctx, logLevel, poolSize, hostPortRanges, githubAuthTokenOverride)
 	}
 	if restartEngineErr != nil {
 		return nil, nil, stacktrace.Propagate(restartEngineErr, "An error occurred starting a new engine")
@@ -427,7 +487,11 @@ func (manager *EngineManager) startEngineWithGuarantor(ctx context.Context, curr
 
 func getEngineClientFromHostMachineIpAndPort(hostMachineIpAndPort *hostMachineIpAndPort) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
 	url := hostMachineIpAndPort.GetURL()
-	conn, err := grpc.Dial(url, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	engineDialOptions, err := kurtosis_context.GetEngineClientDialOptions()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the dial options to connect to the Kurtosis engine")
+	}
+	conn, err := grpc.Dial(url, engineDialOptions...)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred dialling Kurtosis engine at URL '%v'", url)
 	}
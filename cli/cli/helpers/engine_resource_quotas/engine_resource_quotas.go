@@ -0,0 +1,97 @@
+package engine_resource_quotas
+
+import (
+	"os"
+
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// The engine resource quotas YAML file, as written by the user. The quotas are validated by the engine when it starts
+type engineResourceQuotasYaml struct {
+	Quotas []*resourceQuotaYaml `yaml:"quotas"`
+}
+
+type resourceQuotaYaml struct {
+	Name string `yaml:"name"`
+
+	User string `yaml:"user,omitempty"`
+
+	LabelSelector string `yaml:"label_selector,omitempty"`
+
+	MaxEnclaves uint32 `yaml:"max_enclaves,omitempty"`
+
+	// The limits below apply to all the running enclaves of the quota together
+	MaxServices uint32 `yaml:"max_services,omitempty"`
+
+	MaxCpuMillicores uint64 `yaml:"max_cpu_millicores,omitempty"`
+
+	MaxMemoryMegabytes uint64 `yaml:"max_memory_megabytes,omitempty"`
+
+	// The limits below apply to each enclave on its own, not to all the enclaves of the quota together
+	MaxServicesPerEnclave uint32 `yaml:"max_services_per_enclave,omitempty"`
+
+	MaxCpuMillicoresPerEnclave uint64 `yaml:"max_cpu_millicores_per_enclave,omitempty"`
+
+	MaxMemoryMegabytesPerEnclave uint64 `yaml:"max_memory_megabytes_per_enclave,omitempty"`
+
+	MaxFilesArtifactsMegabytesPerEnclave uint64 `yaml:"max_files_artifacts_megabytes_per_enclave,omitempty"`
+}
+
+// GetEngineResourceQuotas reads the engine resource quotas YAML file from the Kurtosis config directory, returning
+// nil if the user didn't write one, in which case no quota applies to the enclaves
+func GetEngineResourceQuotas() ([]*args.ResourceQuotaConfig, error) {
+	engineResourceQuotasFilepath, err := host_machine_directories.GetEngineResourceQuotasYAMLFilepath()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine resource quotas filepath")
+	}
+	if _, err := os.Stat(engineResourceQuotasFilepath); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred checking if the engine resource quotas file '%v' exists", engineResourceQuotasFilepath)
+	}
+	resourceQuotas, err := readEngineResourceQuotas(engineResourceQuotasFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the engine resource quotas file '%v'", engineResourceQuotasFilepath)
+	}
+	return resourceQuotas, nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func readEngineResourceQuotas(engineResourceQuotasFilepath string) ([]*args.ResourceQuotaConfig, error) {
+	fileContent, err := os.ReadFile(engineResourceQuotasFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading file '%v'", engineResourceQuotasFilepath)
+	}
+	parsedQuotas := &engineResourceQuotasYaml{
+		Quotas: nil,
+	}
+	if err := yaml.UnmarshalStrict(fileContent, parsedQuotas); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the YAML in file '%v'", engineResourceQuotasFilepath)
+	}
+
+	result := []*args.ResourceQuotaConfig{}
+	for _, quota := range parsedQuotas.Quotas {
+		result = append(result, &args.ResourceQuotaConfig{
+			Name:                                 quota.Name,
+			User:                                 quota.User,
+			LabelSelector:                        quota.LabelSelector,
+			MaxEnclaves:                          quota.MaxEnclaves,
+			MaxServices:                          quota.MaxServices,
+			MaxCpuMillicores:                     quota.MaxCpuMillicores,
+			MaxMemoryMegabytes:                   quota.MaxMemoryMegabytes,
+			MaxServicesPerEnclave:                quota.MaxServicesPerEnclave,
+			MaxCpuMillicoresPerEnclave:           quota.MaxCpuMillicoresPerEnclave,
+			MaxMemoryMegabytesPerEnclave:         quota.MaxMemoryMegabytesPerEnclave,
+			MaxFilesArtifactsMegabytesPerEnclave: quota.MaxFilesArtifactsMegabytesPerEnclave,
+		})
+	}
+	return result, nil
+}
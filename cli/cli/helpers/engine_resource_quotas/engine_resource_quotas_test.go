@@ -0,0 +1,49 @@
+package engine_resource_quotas
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEngineResourceQuotasYaml = `
+quotas:
+  - name: ci
+    user: ci
+    max_enclaves: 3
+    max_cpu_millicores: 6000
+    max_cpu_millicores_per_enclave: 4000
+  - name: infra-team
+    label_selector: team=infra
+    max_memory_megabytes_per_enclave: 8192
+    max_files_artifacts_megabytes_per_enclave: 512
+`
+)
+
+func TestReadEngineResourceQuotas(t *testing.T) {
+	quotasFilepath := filepath.Join(t.TempDir(), "engine-resource-quotas.yml")
+	require.NoError(t, os.WriteFile(quotasFilepath, []byte(testEngineResourceQuotasYaml), 0644))
+
+	resourceQuotas, err := readEngineResourceQuotas(quotasFilepath)
+	require.NoError(t, err)
+	require.Len(t, resourceQuotas, 2)
+	require.Equal(t, "ci", resourceQuotas[0].User)
+	require.Equal(t, uint32(3), resourceQuotas[0].MaxEnclaves)
+	require.Equal(t, uint64(6000), resourceQuotas[0].MaxCpuMillicores)
+	require.Equal(t, uint64(4000), resourceQuotas[0].MaxCpuMillicoresPerEnclave)
+	require.Zero(t, resourceQuotas[0].MaxServicesPerEnclave)
+	require.Equal(t, "team=infra", resourceQuotas[1].LabelSelector)
+	require.Equal(t, uint64(8192), resourceQuotas[1].MaxMemoryMegabytesPerEnclave)
+	require.Equal(t, uint64(512), resourceQuotas[1].MaxFilesArtifactsMegabytesPerEnclave)
+}
+
+func TestReadEngineResourceQuotas_UnknownFieldIsRejected(t *testing.T) {
+	quotasFilepath := filepath.Join(t.TempDir(), "engine-resource-quotas.yml")
+	require.NoError(t, os.WriteFile(quotasFilepath, []byte("quotas:\n  - name: ci\n    max_cpu: 4000\n"), 0644))
+
+	_, err := readEngineResourceQuotas(quotasFilepath)
+	require.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package engine_webhooks
+
+import (
+	"os"
+
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// The engine webhooks YAML file, as written by the user. The webhooks are validated by the engine when it starts
+type engineWebhooksYaml struct {
+	Webhooks []*webhookYaml `yaml:"webhooks"`
+}
+
+type webhookYaml struct {
+	Name string `yaml:"name"`
+
+	Url string `yaml:"url"`
+
+	Secret string `yaml:"secret,omitempty"`
+
+	EventTypes []string `yaml:"event_types,omitempty"`
+
+	EnclaveIdentifiers []string `yaml:"enclave_identifiers,omitempty"`
+
+	EnclaveLabelSelector string `yaml:"enclave_label_selector,omitempty"`
+
+	OnlyFailedStarlarkRuns bool `yaml:"only_failed_starlark_runs,omitempty"`
+
+	MaxRetries uint32 `yaml:"max_retries,omitempty"`
+}
+
+// GetEngineWebhooks reads the engine webhooks YAML file from the Kurtosis config directory, returning nil if the user
+// didn't write one, in which case the engine events aren't posted anywhere
+func GetEngineWebhooks() ([]*args.WebhookConfig, error) {
+	engineWebhooksFilepath, err := host_machine_directories.GetEngineWebhooksYAMLFilepath()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine webhooks filepath")
+	}
+	if _, err := os.Stat(engineWebhooksFilepath); err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred checking if the engine webhooks file '%v' exists", engineWebhooksFilepath)
+	}
+	webhooks, err := readEngineWebhooks(engineWebhooksFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the engine webhooks file '%v'", engineWebhooksFilepath)
+	}
+	return webhooks, nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func readEngineWebhooks(engineWebhooksFilepath string) ([]*args.WebhookConfig, error) {
+	fileContent, err := os.ReadFile(engineWebhooksFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading file '%v'", engineWebhooksFilepath)
+	}
+	parsedWebhooks := &engineWebhooksYaml{
+		Webhooks: nil,
+	}
+	if err := yaml.UnmarshalStrict(fileContent, parsedWebhooks); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the YAML in file '%v'", engineWebhooksFilepath)
+	}
+
+	result := []*args.WebhookConfig{}
+	for _, webhook := range parsedWebhooks.Webhooks {
+		result = append(result, &args.WebhookConfig{
+			Name:                   webhook.Name,
+			Url:                    webhook.Url,
+			Secret:                 webhook.Secret,
+			EventTypes:             webhook.EventTypes,
+			EnclaveIdentifiers:     webhook.EnclaveIdentifiers,
+			EnclaveLabelSelector:   webhook.EnclaveLabelSelector,
+			OnlyFailedStarlarkRuns: webhook.OnlyFailedStarlarkRuns,
+			MaxRetries:             webhook.MaxRetries,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,50 @@
+package engine_webhooks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEngineWebhooksYaml = `
+webhooks:
+  - name: nightly-devnets
+    url: https://hooks.example.com/services/nightly
+    secret: my-secret
+    event_types:
+      - STARLARK_RUN_FINISHED
+    enclave_label_selector: purpose=nightly
+    only_failed_starlark_runs: true
+    max_retries: 3
+  - name: audit
+    url: https://audit.example.com/kurtosis
+`
+)
+
+func TestReadEngineWebhooks(t *testing.T) {
+	webhooksFilepath := filepath.Join(t.TempDir(), "engine-webhooks.yml")
+	require.NoError(t, os.WriteFile(webhooksFilepath, []byte(testEngineWebhooksYaml), 0644))
+
+	webhooks, err := readEngineWebhooks(webhooksFilepath)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 2)
+	require.Equal(t, "https://hooks.example.com/services/nightly", webhooks[0].Url)
+	require.Equal(t, "my-secret", webhooks[0].Secret)
+	require.Equal(t, []string{"STARLARK_RUN_FINISHED"}, webhooks[0].EventTypes)
+	require.Equal(t, "purpose=nightly", webhooks[0].EnclaveLabelSelector)
+	require.True(t, webhooks[0].OnlyFailedStarlarkRuns)
+	require.Equal(t, uint32(3), webhooks[0].MaxRetries)
+	require.Empty(t, webhooks[1].Secret)
+	require.Empty(t, webhooks[1].EventTypes)
+}
+
+func TestReadEngineWebhooks_UnknownFieldIsRejected(t *testing.T) {
+	webhooksFilepath := filepath.Join(t.TempDir(), "engine-webhooks.yml")
+	require.NoError(t, os.WriteFile(webhooksFilepath, []byte("webhooks:\n  - name: audit\n    uri: https://audit.example.com\n"), 0644))
+
+	_, err := readEngineWebhooks(webhooksFilepath)
+	require.Error(t, err)
+}
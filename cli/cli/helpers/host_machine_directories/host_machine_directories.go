@@ -11,6 +11,14 @@ const (
 
 	kurtosisConfigYAMLFilename = "kurtosis-config.yml"
 
+	engineAuthConfigYAMLFilename = "engine-auth-config.yml"
+
+	engineResourceQuotasYAMLFilename = "engine-resource-quotas.yml"
+
+	engineWebhooksYAMLFilename = "engine-webhooks.yml"
+
+	engineEnclavePoolTemplatesYAMLFilename = "engine-enclave-pool-templates.yml"
+
 	kurtosisClusterSettingFilename = "cluster-setting"
 
 	latestCLIReleaseVersionCacheFilename = "latest-cli-release-version-cache"
@@ -59,6 +67,46 @@ func GetKurtosisConfigYAMLFilepath() (string, error) {
 	return kurtosisConfigYAMLFilepath, nil
 }
 
+// Get the yaml filepath of the optional config of how the engine APIs authenticate and authorize their callers
+func GetEngineAuthConfigYAMLFilepath() (string, error) {
+	xdgRelFilepath := getRelativeFilepathForXDG(engineAuthConfigYAMLFilename)
+	engineAuthConfigYAMLFilepath, err := xdg.ConfigFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the engine auth config YAML filepath from relative path '%v'", xdgRelFilepath)
+	}
+	return engineAuthConfigYAMLFilepath, nil
+}
+
+// Get the yaml filepath of the optional resource quotas on the enclaves created by the engine
+func GetEngineResourceQuotasYAMLFilepath() (string, error) {
+	xdgRelFilepath := getRelativeFilepathForXDG(engineResourceQuotasYAMLFilename)
+	engineResourceQuotasYAMLFilepath, err := xdg.ConfigFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the engine resource quotas YAML filepath from relative path '%v'", xdgRelFilepath)
+	}
+	return engineResourceQuotasYAMLFilepath, nil
+}
+
+// Get the yaml filepath of the optional webhooks the engine posts its events to
+func GetEngineWebhooksYAMLFilepath() (string, error) {
+	xdgRelFilepath := getRelativeFilepathForXDG(engineWebhooksYAMLFilename)
+	engineWebhooksYAMLFilepath, err := xdg.ConfigFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the engine webhooks YAML filepath from relative path '%v'", xdgRelFilepath)
+	}
+	return engineWebhooksYAMLFilepath, nil
+}
+
+// Get the yaml filepath of the optional templates of the enclaves the engine keeps warm in its enclave pool
+func GetEngineEnclavePoolTemplatesYAMLFilepath() (string, error) {
+	xdgRelFilepath := getRelativeFilepathForXDG(engineEnclavePoolTemplatesYAMLFilename)
+	engineEnclavePoolTemplatesYAMLFilepath, err := xdg.ConfigFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the engine enclave pool templates YAML filepath from relative path '%v'", xdgRelFilepath)
+	}
+	return engineEnclavePoolTemplatesYAMLFilepath, nil
+}
+
 // Get the cluster setting filepath where the users' cluster selection setting is saved
 func GetKurtosisClusterSettingFilepath() (string, error) {
 	xdgRelFilepath := getRelativeFilepathForXDG(kurtosisClusterSettingFilename)
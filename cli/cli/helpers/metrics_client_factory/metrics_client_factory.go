@@ -34,12 +34,14 @@ func GetMetricsClient() (metrics_client.MetricsClient, func() error, error) {
 	maybeCloudUserId, maybeCloudInstanceId := metrics_cloud_user_instance_id_helper.GetMaybeCloudUserAndInstanceID()
 
 	var sendUserMetrics bool
+	var metricsSinkConfig *metrics_client.SinkConfig
 	if hasConfig {
 		kurtosisConfig, err := kurtosisConfigStore.GetConfig()
 		if err != nil {
 			return nil, nil, stacktrace.NewError("An error occurred while fetching stored configuration")
 		}
 		sendUserMetrics = kurtosisConfig.GetShouldSendMetrics()
+		metricsSinkConfig = kurtosisConfig.GetMetricsSinkConfig()
 	} else {
 		sendUserMetrics = defaults.SendMetricsByDefault
 	}
@@ -55,7 +57,7 @@ func GetMetricsClient() (metrics_client.MetricsClient, func() error, error) {
 			shouldFlushMetricsClientQueueOnEachEvent,
 			metrics_client.DoNothingMetricsClientCallback{},
 			analytics_logger.ConvertLogrusLoggerToAnalyticsLogger(logger),
-			metrics_client.IsCI(), maybeCloudUserId, maybeCloudInstanceId),
+			metrics_client.IsCI(), maybeCloudUserId, maybeCloudInstanceId, metricsSinkConfig),
 	)
 
 	if err != nil {
@@ -66,11 +68,17 @@ func GetMetricsClient() (metrics_client.MetricsClient, func() error, error) {
 }
 
 // GetSegmentClient use this method only if you are sure that you want to send metrics otherwise use GetMetricsClient
+// The events go to the configured metrics sink rather than to Segment if there's one
 func GetSegmentClient() (metrics_client.MetricsClient, func() error, error) {
 	metricsUserId, clusterType, err := getMetricsUserIdAndClusterType()
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "an error occurred while getting metrics user id and cluster type")
 	}
+
+	metricsSinkConfig, err := GetConfiguredMetricsSinkConfig()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred while getting the configured metrics sink")
+	}
 	// this is force set to true in order to get the segment client
 	sendUserMetrics := true
 
@@ -86,7 +94,7 @@ func GetSegmentClient() (metrics_client.MetricsClient, func() error, error) {
 			shouldFlushMetricsClientQueueOnEachEvent,
 			metrics_client.DoNothingMetricsClientCallback{},
 			analytics_logger.ConvertLogrusLoggerToAnalyticsLogger(logger),
-			metrics_client.IsCI(), maybeCloudUserId, maybeCloudInstanceId),
+			metrics_client.IsCI(), maybeCloudUserId, maybeCloudInstanceId, metricsSinkConfig),
 	)
 
 	if err != nil {
@@ -96,6 +104,23 @@ func GetSegmentClient() (metrics_client.MetricsClient, func() error, error) {
 	return metricsClient, metricsClientCloseFunc, nil
 }
 
+// GetConfiguredMetricsSinkConfig returns the metrics sink of the Kurtosis config, or nil if there's no config or sink
+func GetConfiguredMetricsSinkConfig() (*metrics_client.SinkConfig, error) {
+	kurtosisConfigStore := kurtosis_config.GetKurtosisConfigStore()
+	hasConfig, err := kurtosisConfigStore.HasConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while determining whether configuration already exists")
+	}
+	if !hasConfig {
+		return nil, nil
+	}
+	kurtosisConfig, err := kurtosisConfigStore.GetConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching stored configuration")
+	}
+	return kurtosisConfig.GetMetricsSinkConfig(), nil
+}
+
 func getMetricsUserIdAndClusterType() (string, string, error) {
 	clusterSettingStore := kurtosis_cluster_setting.GetKurtosisClusterSettingStore()
 	isClusterSet, err := clusterSettingStore.HasClusterSetting()
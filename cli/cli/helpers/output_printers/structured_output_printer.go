@@ -0,0 +1,91 @@
+package output_printers
+
+import (
+	"encoding/json"
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+	"strings"
+)
+
+// OutputFormat is how inspection commands render their data, selected with the global '--output' flag
+type OutputFormat string
+
+const (
+	OutputFormat_Table OutputFormat = "table"
+	OutputFormat_Json  OutputFormat = "json"
+	OutputFormat_Yaml  OutputFormat = "yaml"
+
+	DefaultOutputFormat = OutputFormat_Table
+
+	// Version of the schema of the JSON and YAML outputs. Within a version fields only ever get added; renaming or
+	// removing a field, or changing its type, requires bumping it
+	StructuredOutputSchemaVersion = "v1"
+
+	jsonIndent = "  "
+)
+
+func AllOutputFormatStrs() []string {
+	return []string{
+		string(OutputFormat_Table),
+		string(OutputFormat_Json),
+		string(OutputFormat_Yaml),
+	}
+}
+
+func ParseOutputFormat(outputFormatStr string) (OutputFormat, error) {
+	for _, validOutputFormatStr := range AllOutputFormatStrs() {
+		if strings.ToLower(outputFormatStr) == validOutputFormatStr {
+			return OutputFormat(validOutputFormatStr), nil
+		}
+	}
+	return "", stacktrace.NewError("Invalid output format '%s'; valid output formats are: %s", outputFormatStr, strings.Join(AllOutputFormatStrs(), ", "))
+}
+
+// GetOutputFormat returns the output format selected with the global '--output' flag
+func GetOutputFormat(parsedFlags *flags.ParsedFlags) (OutputFormat, error) {
+	outputFormatStr, err := parsedFlags.GetString(defaults.OutputFormatFlagKey)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", defaults.OutputFormatFlagKey)
+	}
+	return ParseOutputFormat(outputFormatStr)
+}
+
+func (format OutputFormat) IsStructured() bool {
+	return format != OutputFormat_Table
+}
+
+// structuredOutput is the envelope around the data of every command printed as JSON or YAML, so that consumers can
+// tell what they are reading and which version of its schema
+type structuredOutput struct {
+	SchemaVersion string      `json:"schema_version" yaml:"schema_version"`
+	Kind          string      `json:"kind" yaml:"kind"`
+	Data          interface{} `json:"data" yaml:"data"`
+}
+
+// PrintStructuredOutput prints the data of a command in the given structured output format. Kind names the data
+// model, e.g. 'EnclaveList', and data must have both json and yaml tags on its fields
+func PrintStructuredOutput(format OutputFormat, kind string, data interface{}) error {
+	output := &structuredOutput{
+		SchemaVersion: StructuredOutputSchemaVersion,
+		Kind:          kind,
+		Data:          data,
+	}
+	var serializedOutput []byte
+	var err error
+	switch format {
+	case OutputFormat_Json:
+		serializedOutput, err = json.MarshalIndent(output, "", jsonIndent)
+	case OutputFormat_Yaml:
+		serializedOutput, err = yaml.Marshal(output)
+	default:
+		return stacktrace.NewError("Output format '%s' is not a structured output format; this is a bug in Kurtosis", format)
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing '%s' to '%s'", kind, format)
+	}
+	out.PrintOutLn(strings.TrimSuffix(string(serializedOutput), "\n"))
+	return nil
+}
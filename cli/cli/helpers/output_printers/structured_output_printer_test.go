@@ -0,0 +1,86 @@
+package output_printers
+
+import (
+	"bytes"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+)
+
+const (
+	testOutputKind = "TestKind"
+)
+
+type testOutputData struct {
+	Name  string   `json:"name" yaml:"name"`
+	Ports []uint16 `json:"ports" yaml:"ports"`
+}
+
+func TestParseOutputFormat(t *testing.T) {
+	outputFormat, err := ParseOutputFormat("table")
+	require.NoError(t, err)
+	require.Equal(t, OutputFormat_Table, outputFormat)
+	require.False(t, outputFormat.IsStructured())
+
+	outputFormat, err = ParseOutputFormat("JSON")
+	require.NoError(t, err)
+	require.Equal(t, OutputFormat_Json, outputFormat)
+	require.True(t, outputFormat.IsStructured())
+
+	outputFormat, err = ParseOutputFormat("yaml")
+	require.NoError(t, err)
+	require.Equal(t, OutputFormat_Yaml, outputFormat)
+	require.True(t, outputFormat.IsStructured())
+
+	_, err = ParseOutputFormat("xml")
+	require.Error(t, err)
+}
+
+func TestPrintStructuredOutput_Json(t *testing.T) {
+	output := captureStructuredOutput(t, OutputFormat_Json)
+	expectedOutput := `{
+  "schema_version": "v1",
+  "kind": "TestKind",
+  "data": {
+    "name": "node",
+    "ports": [
+      8545,
+      30303
+    ]
+  }
+}
+`
+	require.Equal(t, expectedOutput, output)
+}
+
+func TestPrintStructuredOutput_Yaml(t *testing.T) {
+	output := captureStructuredOutput(t, OutputFormat_Yaml)
+	expectedOutput := `schema_version: v1
+kind: TestKind
+data:
+  name: node
+  ports:
+  - 8545
+  - 30303
+`
+	require.Equal(t, expectedOutput, output)
+}
+
+func TestPrintStructuredOutput_TableIsNotStructured(t *testing.T) {
+	err := PrintStructuredOutput(OutputFormat_Table, testOutputKind, &testOutputData{Name: "node", Ports: nil})
+	require.Error(t, err)
+}
+
+func captureStructuredOutput(t *testing.T, outputFormat OutputFormat) string {
+	buffer := new(bytes.Buffer)
+	out.SetOut(buffer)
+	defer out.SetOut(os.Stdout)
+
+	data := &testOutputData{
+		Name:  "node",
+		Ports: []uint16{8545, 30303},
+	}
+	require.NoError(t, PrintStructuredOutput(outputFormat, testOutputKind, data))
+	return buffer.String()
+}
@@ -0,0 +1,36 @@
+package service_health_stringifier
+
+import (
+	"fmt"
+	"github.com/fatih/color"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+)
+
+var (
+	colorizeHealthy   = color.New(color.FgGreen).SprintFunc()
+	colorizeUnhealthy = color.New(color.FgYellow).SprintFunc()
+	colorizeCrashed   = color.New(color.FgRed).SprintFunc()
+)
+
+// ServiceHealthStringifier renders the health status of a service, along with the exit code and the restart count
+// of its container when it crashed, or only the restart count when it keeps crashing
+func ServiceHealthStringifier(serviceHealth *kurtosis_core_rpc_api_bindings.ServiceHealth) string {
+	serviceHealthStr := kurtosis_core_rpc_api_bindings.ServiceHealth_Status_name[int32(serviceHealth.GetStatus())]
+	switch serviceHealth.GetStatus() {
+	case kurtosis_core_rpc_api_bindings.ServiceHealth_HEALTHY:
+		return colorizeHealthy(serviceHealthStr)
+	case kurtosis_core_rpc_api_bindings.ServiceHealth_UNHEALTHY:
+		return colorizeUnhealthy(serviceHealthStr)
+	case kurtosis_core_rpc_api_bindings.ServiceHealth_CRASHED:
+		if serviceHealth.ExitCode != nil {
+			serviceHealthStr = fmt.Sprintf("%s (exit code %d, %d restart(s))", serviceHealthStr, serviceHealth.GetExitCode(), serviceHealth.GetRestartCount())
+		} else if serviceHealth.GetRestartCount() > 0 {
+			serviceHealthStr = fmt.Sprintf("%s (%d restart(s))", serviceHealthStr, serviceHealth.GetRestartCount())
+		}
+		return colorizeCrashed(serviceHealthStr)
+	case kurtosis_core_rpc_api_bindings.ServiceHealth_UNKNOWN:
+		return serviceHealthStr
+	default:
+		return serviceHealthStr
+	}
+}
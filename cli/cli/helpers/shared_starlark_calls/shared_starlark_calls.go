@@ -14,6 +14,11 @@ const (
 def run(plan, args):
 	plan.stop_service(name=args["service_name"])
 `
+
+	startServiceStarlarkScript = `
+def run(plan, args):
+	plan.start_service(name=args["service_name"])
+`
 )
 
 func StopServiceStarlarkCommand(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, serviceName services.ServiceName) error {
@@ -33,3 +38,21 @@ func StopServiceStarlarkCommand(ctx context.Context, enclaveCtx *enclaves.Enclav
 	}
 	return nil
 }
+
+func StartServiceStarlarkCommand(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, serviceName services.ServiceName) error {
+	params := fmt.Sprintf(`{"service_name": "%s"}`, serviceName)
+	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, startServiceStarlarkScript, starlark_run_config.NewRunStarlarkConfig(starlark_run_config.WithSerializedParams(params)))
+	if err != nil {
+		return stacktrace.Propagate(err, "An unexpected error occurred on Starlark for starting service")
+	}
+	if runResult.ExecutionError != nil {
+		return stacktrace.NewError("An error occurred during Starlark script execution for starting service: %s", runResult.ExecutionError.GetErrorMessage())
+	}
+	if runResult.InterpretationError != nil {
+		return stacktrace.NewError("An error occurred during Starlark script interpretation for starting service: %s", runResult.InterpretationError.GetErrorMessage())
+	}
+	if len(runResult.ValidationErrors) > 0 {
+		return stacktrace.NewError("An error occurred during Starlark script validation for starting service: %v", runResult.ValidationErrors)
+	}
+	return nil
+}
@@ -19,7 +19,9 @@ const (
 	didUserAcceptSendingMetricsValueForMetricsClientCreation = true
 )
 
-func SendAnyBackloggedUserMetricsElectionEvent() error {
+// SendAnyBackloggedUserMetricsElectionEvent sends the election to the given metrics sink, or to Segment if it's nil
+// The sink gets passed in rather than read from the Kurtosis config, as this is also called while the config gets initialized
+func SendAnyBackloggedUserMetricsElectionEvent(metricsSinkConfig *metrics_client.SinkConfig) error {
 
 	userMetricsElectionEventBacklog := user_metrics_election_event_backlog.GetUserMetricsElectionEventBacklog()
 	shouldSendMetrics, hasBackloggedEvent, err := userMetricsElectionEventBacklog.Get()
@@ -69,6 +71,7 @@ func SendAnyBackloggedUserMetricsElectionEvent() error {
 				metrics_client.IsCI(),
 				maybeCloudUserID,
 				maybeCloudInstanceID,
+				metricsSinkConfig,
 			),
 		)
 		if err != nil {
@@ -0,0 +1,108 @@
+package user_services
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"sort"
+	"strings"
+	"time"
+)
+
+// UserServiceOutput is how a service is rendered in the JSON and YAML output formats, by every command printing services
+type UserServiceOutput struct {
+	Name               string            `json:"name" yaml:"name"`
+	Uuid               string            `json:"uuid" yaml:"uuid"`
+	ShortenedUuid      string            `json:"shortened_uuid" yaml:"shortened_uuid"`
+	Status             string            `json:"status" yaml:"status"`
+	ContainerStatus    string            `json:"container_status" yaml:"container_status"`
+	Image              string            `json:"image" yaml:"image"`
+	PrivateIpAddress   string            `json:"private_ip_address" yaml:"private_ip_address"`
+	PrivateIpv6Address string            `json:"private_ipv6_address,omitempty" yaml:"private_ipv6_address,omitempty"`
+	Ports              []*PortOutput     `json:"ports" yaml:"ports"`
+	Entrypoint         []string          `json:"entrypoint" yaml:"entrypoint"`
+	Cmd                []string          `json:"cmd" yaml:"cmd"`
+	EnvVars            map[string]string `json:"env_vars" yaml:"env_vars"`
+	Health             *HealthOutput     `json:"health" yaml:"health"`
+}
+
+// HealthOutput is the health of a service; the exit code is only set when the service crashed
+type HealthOutput struct {
+	Status         string `json:"status" yaml:"status"`
+	ExitCode       *int32 `json:"exit_code,omitempty" yaml:"exit_code,omitempty"`
+	RestartCount   uint32 `json:"restart_count" yaml:"restart_count"`
+	LastExitReason string `json:"last_exit_reason" yaml:"last_exit_reason"`
+	Message        string `json:"message" yaml:"message"`
+	Since          string `json:"since" yaml:"since"`
+}
+
+// PortOutput is a port of a service; the public fields are empty when the port isn't bound to the host machine
+type PortOutput struct {
+	Id                  string `json:"id" yaml:"id"`
+	Number              uint32 `json:"number" yaml:"number"`
+	TransportProtocol   string `json:"transport_protocol" yaml:"transport_protocol"`
+	ApplicationProtocol string `json:"application_protocol" yaml:"application_protocol"`
+	PublicIpAddress     string `json:"public_ip_address" yaml:"public_ip_address"`
+	PublicNumber        uint32 `json:"public_number" yaml:"public_number"`
+}
+
+func NewUserServiceOutput(userService *kurtosis_core_rpc_api_bindings.ServiceInfo) *UserServiceOutput {
+	privatePorts := userService.GetPrivatePorts()
+	portIds := []string{}
+	for portId := range privatePorts {
+		portIds = append(portIds, portId)
+	}
+	sort.Strings(portIds)
+
+	ports := []*PortOutput{}
+	for _, portId := range portIds {
+		privatePortSpec := privatePorts[portId]
+		port := &PortOutput{
+			Id:                  portId,
+			Number:              privatePortSpec.GetNumber(),
+			TransportProtocol:   strings.ToLower(privatePortSpec.GetTransportProtocol().String()),
+			ApplicationProtocol: privatePortSpec.GetMaybeApplicationProtocol(),
+			PublicIpAddress:     defaultEmptyIPAddrForServices,
+			PublicNumber:        0,
+		}
+		if publicPortSpec, found := userService.GetMaybePublicPorts()[portId]; found && userService.GetMaybePublicIpAddr() != defaultEmptyIPAddrForServices {
+			port.PublicIpAddress = userService.GetMaybePublicIpAddr()
+			port.PublicNumber = publicPortSpec.GetNumber()
+		}
+		ports = append(ports, port)
+	}
+
+	container := userService.GetContainer()
+	envVars := map[string]string{}
+	for envVarKey, envVarVal := range container.GetEnvVars() {
+		envVars[envVarKey] = envVarVal
+	}
+	return &UserServiceOutput{
+		Name:               userService.GetName(),
+		Uuid:               userService.GetServiceUuid(),
+		ShortenedUuid:      userService.GetShortenedUuid(),
+		Status:             kurtosis_core_rpc_api_bindings.ServiceStatus_name[int32(userService.GetServiceStatus())],
+		ContainerStatus:    kurtosis_core_rpc_api_bindings.Container_Status_name[int32(container.GetStatus())],
+		Image:              container.GetImageName(),
+		PrivateIpAddress:   userService.GetPrivateIpAddr(),
+		PrivateIpv6Address: userService.GetPrivateIpv6Addr(),
+		Ports:              ports,
+		Entrypoint:         append([]string{}, container.GetEntrypointArgs()...),
+		Cmd:                append([]string{}, container.GetCmdArgs()...),
+		EnvVars:            envVars,
+		Health:             newHealthOutput(userService.GetHealth()),
+	}
+}
+
+func newHealthOutput(serviceHealth *kurtosis_core_rpc_api_bindings.ServiceHealth) *HealthOutput {
+	since := ""
+	if serviceHealth.GetSince() != nil {
+		since = serviceHealth.GetSince().AsTime().Format(time.RFC3339)
+	}
+	return &HealthOutput{
+		Status:         kurtosis_core_rpc_api_bindings.ServiceHealth_Status_name[int32(serviceHealth.GetStatus())],
+		ExitCode:       serviceHealth.ExitCode,
+		RestartCount:   serviceHealth.GetRestartCount(),
+		LastExitReason: serviceHealth.GetLastExitReason(),
+		Message:        serviceHealth.GetMessage(),
+		Since:          since,
+	}
+}
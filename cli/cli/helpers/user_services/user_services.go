@@ -6,10 +6,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_liveness_validator"
 	"github.com/kurtosis-tech/stacktrace"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"sort"
 	"strings"
 )
@@ -105,7 +105,7 @@ func GetUserServiceInfoMapFromAPIContainer(ctx context.Context, enclaveInfo *kur
 		apicHostMachineIp,
 		apicHostMachineGrpcPort,
 	)
-	conn, err := grpc.Dial(apiContainerHostGrpcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(apiContainerHostGrpcUrl, kurtosis_context.GetApiContainerClientDialOptions(enclaveInfo)...)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
@@ -57,7 +57,9 @@ func logUserEmailAddressAsMetric(userEmail string) {
 			analytics_logger.ConvertLogrusLoggerToAnalyticsLogger(logger),
 			metrics_client.IsCI(),
 			maybeCloudUserID,
-			maybeCloudInstanceID),
+			maybeCloudInstanceID,
+			// There's no config with a metrics sink yet at first install
+			nil),
 	)
 	if err != nil {
 		logrus.Debugf("tried creating a metrics client but failed with error:\n%v", err)
@@ -20,7 +20,8 @@ func initConfig() (*resolved_config.KurtosisConfig, error) {
 		logrus.Debugf("An error occurred creating user-consent-to-send-metrics election file\n%v", err)
 	}
 	//Here we are trying to send this metric for first time, but if it fails we'll continue to retry every time the CLI runs
-	if err := user_send_metrics_election.SendAnyBackloggedUserMetricsElectionEvent(); err != nil {
+	//There's no config with a metrics sink yet as we're initializing it
+	if err := user_send_metrics_election.SendAnyBackloggedUserMetricsElectionEvent(nil); err != nil {
 		//We don't want to interrupt users flow if something fails when tracking metrics
 		logrus.Debugf("An error occurred tracking user-consent-to-send-metrics election\n%v", err)
 	}
@@ -0,0 +1,17 @@
+package v3
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type MetricsSinkConfigV3 struct {
+	Type     *string           `yaml:"type,omitempty"`
+	FilePath *string           `yaml:"file-path,omitempty"`
+	Url      *string           `yaml:"url,omitempty"`
+	Headers  map[string]string `yaml:"headers,omitempty"`
+}
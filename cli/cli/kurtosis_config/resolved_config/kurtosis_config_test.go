@@ -3,7 +3,8 @@ package resolved_config
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects"
-	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v3 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v3"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/stretchr/testify/require"
 	"sort"
 	"testing"
@@ -50,11 +51,13 @@ func TestNewKurtosisConfigFromRequiredFields_MetricsElectionIsSent(t *testing.T)
 }
 
 func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
-	_, err := NewKurtosisConfigFromOverrides(&v2.KurtosisConfigV2{
+	_, err := NewKurtosisConfigFromOverrides(&v3.KurtosisConfigV3{
 		ConfigVersion:     0,
 		ShouldSendMetrics: nil,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		Tracing:           nil,
+		MetricsSink:       nil,
 	})
 	// You can not initialize a Kurtosis config with empty overrides - it needs at least `ShouldSendMetrics`
 	require.Error(t, err)
@@ -63,11 +66,13 @@ func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
 func TestNewKurtosisConfigJustMetrics(t *testing.T) {
 	version := config_version.ConfigVersion_v0
 	shouldSendMetrics := true
-	originalOverrides := v2.KurtosisConfigV2{
+	originalOverrides := v3.KurtosisConfigV3{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		Tracing:           nil,
+		MetricsSink:       nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	// You can not initialize a Kurtosis config with empty originalOverrides - it needs at least `ShouldSendMetrics`
@@ -94,15 +99,17 @@ func TestCloudConfigOverridesApiUrl(t *testing.T) {
 	version := config_version.ConfigVersion_v0
 	shouldSendMetrics := true
 	apiUrl := "test.com"
-	originalOverrides := v2.KurtosisConfigV2{
+	originalOverrides := v3.KurtosisConfigV3{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
-		CloudConfig: &v2.KurtosisCloudConfigV2{
+		CloudConfig: &v3.KurtosisCloudConfigV3{
 			ApiUrl:           &apiUrl,
 			Port:             nil,
 			CertificateChain: nil,
 		},
+		Tracing:     nil,
+		MetricsSink: nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	require.NoError(t, err)
@@ -112,3 +119,100 @@ func TestCloudConfigOverridesApiUrl(t *testing.T) {
 	require.Nil(t, overrides.CloudConfig.Port)
 	require.Nil(t, overrides.CloudConfig.CertificateChain)
 }
+
+func TestTracingOverridesOtlpEndpoint(t *testing.T) {
+	shouldSendMetrics := true
+	otlpEndpoint := "http://otel-collector:4317"
+	originalOverrides := v3.KurtosisConfigV3{
+		ConfigVersion:     config_version.ConfigVersion_v0,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		Tracing: &v3.TracingConfigV3{
+			OtlpEndpoint: &otlpEndpoint,
+		},
+		MetricsSink: nil,
+	}
+	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
+	require.NoError(t, err)
+	require.Equal(t, otlpEndpoint, config.GetOtlpTracesEndpoint())
+}
+
+func TestTracingOverridesInvalidOtlpEndpoint(t *testing.T) {
+	shouldSendMetrics := true
+	for _, otlpEndpoint := range []string{"otel-collector:4317", "grpc://otel-collector:4317", "http://"} {
+		endpoint := otlpEndpoint
+		originalOverrides := v3.KurtosisConfigV3{
+			ConfigVersion:     config_version.ConfigVersion_v0,
+			ShouldSendMetrics: &shouldSendMetrics,
+			KurtosisClusters:  nil,
+			CloudConfig:       nil,
+			Tracing: &v3.TracingConfigV3{
+				OtlpEndpoint: &endpoint,
+			},
+			MetricsSink: nil,
+		}
+		_, err := NewKurtosisConfigFromOverrides(&originalOverrides)
+		require.Error(t, err, "Expected OTLP endpoint '%v' to be rejected", otlpEndpoint)
+	}
+}
+
+func TestNoTracingOverridesDoesNotExportTraces(t *testing.T) {
+	config, err := NewKurtosisConfigFromRequiredFields(false)
+	require.NoError(t, err)
+	require.Empty(t, config.GetOtlpTracesEndpoint())
+}
+
+func TestMetricsSinkOverrides(t *testing.T) {
+	shouldSendMetrics := true
+	sinkType := "otlp-logs"
+	sinkUrl := "http://otel-collector:4318"
+	originalOverrides := v3.KurtosisConfigV3{
+		ConfigVersion:     config_version.ConfigVersion_v0,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		Tracing:           nil,
+		MetricsSink: &v3.MetricsSinkConfigV3{
+			Type:     &sinkType,
+			FilePath: nil,
+			Url:      &sinkUrl,
+			Headers:  map[string]string{"Authorization": "Bearer token"},
+		},
+	}
+	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
+	require.NoError(t, err)
+	require.Equal(t, &metrics_client.SinkConfig{
+		Type:     metrics_client.OtlpLogs,
+		FilePath: "",
+		Url:      sinkUrl,
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	}, config.GetMetricsSinkConfig())
+}
+
+func TestMetricsSinkOverridesInvalidSink(t *testing.T) {
+	shouldSendMetrics := true
+	sinkType := "jsonl-file"
+	originalOverrides := v3.KurtosisConfigV3{
+		ConfigVersion:     config_version.ConfigVersion_v0,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		Tracing:           nil,
+		// The JSONL file sink requires a file path
+		MetricsSink: &v3.MetricsSinkConfigV3{
+			Type:     &sinkType,
+			FilePath: nil,
+			Url:      nil,
+			Headers:  nil,
+		},
+	}
+	_, err := NewKurtosisConfigFromOverrides(&originalOverrides)
+	require.Error(t, err)
+}
+
+func TestNoMetricsSinkOverridesSendsToSegment(t *testing.T) {
+	config, err := NewKurtosisConfigFromRequiredFields(true)
+	require.NoError(t, err)
+	require.Nil(t, config.GetMetricsSinkConfig())
+}
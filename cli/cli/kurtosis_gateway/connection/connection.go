@@ -29,7 +29,7 @@ const (
 type GatewayConnectionToKurtosis interface {
 	// GetLocalPorts returns a map keyed with an identifier string describing local ports being forwarded
 	GetLocalPorts() map[string]*port_spec.PortSpec
-	GetGrpcClientConn() (*grpc.ClientConn, error)
+	GetGrpcClientConn(extraDialOptions ...grpc.DialOption) (*grpc.ClientConn, error)
 	Stop()
 }
 
@@ -201,9 +201,9 @@ func (connection *gatewayConnectionToKurtosisImpl) GetLocalPorts() map[string]*p
 	return connection.localPorts
 }
 
-// GetGrpcClientConn returns a client conn dialed in to the local port
+// GetGrpcClientConn returns a client conn dialed in to the local port, with the extra dial options on top of the defaults
 // It is the caller's responsibility to call resultClientConn.close()
-func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn() (resultClientConn *grpc.ClientConn, resultErr error) {
+func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn(extraDialOptions ...grpc.DialOption) (resultClientConn *grpc.ClientConn, resultErr error) {
 	localPorts := connection.GetLocalPorts()
 	localGrpcPort, isFound := localPorts[grpcPortId]
 	if !isFound {
@@ -211,7 +211,8 @@ func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn() (resultCl
 	}
 	localGrpcPortNum := localPorts[grpcPortId].GetNumber()
 	localGrpcServerAddress := fmt.Sprintf("%v:%v", localHostIpStr, localGrpcPortNum)
-	grpcConnection, err := grpc.Dial(localGrpcServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	dialOptions := append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, extraDialOptions...)
+	grpcConnection, err := grpc.Dial(localGrpcServerAddress, dialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to create a GRPC client connection on address '%v', but a non-nil error was returned", localGrpcServerAddress)
 	}
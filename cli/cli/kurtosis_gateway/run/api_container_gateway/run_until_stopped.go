@@ -3,6 +3,7 @@ package api_container_gateway
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/server/api_container_gateway"
 	minimal_grpc_server "github.com/kurtosis-tech/minimal-grpc-server/golang/server"
@@ -22,8 +23,8 @@ func RunApiContainerGatewayUntilStopped(connectionProvider *connection.GatewayCo
 	}
 	defer apiContainerConnection.Stop()
 
-	// Dial in to our locally forwarded port
-	apiContainerGrpcClientConn, err := apiContainerConnection.GetGrpcClientConn()
+	// Dial in to our locally forwarded port, authenticating to the API container with the token the engine issued with the enclave info
+	apiContainerGrpcClientConn, err := apiContainerConnection.GetGrpcClientConn(kurtosis_context.GetApiContainerClientDialOptions(enclaveInfo)...)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to create a grpc client connection to the forwarded API container port, instead a non nil error was returned")
 	}
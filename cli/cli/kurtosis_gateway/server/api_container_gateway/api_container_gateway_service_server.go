@@ -116,6 +116,26 @@ func (service *ApiContainerGatewayServiceServer) GetServices(ctx context.Context
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) GetServiceStats(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetServiceStatsArgs) (*kurtosis_core_rpc_api_bindings.GetServiceStatsResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetServiceStats(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) StreamServiceHealthEvents(args *emptypb.Empty, streamToWriteTo kurtosis_core_rpc_api_bindings.ApiContainerService_StreamServiceHealthEventsServer) error {
+	logrus.Debug("Streaming the health events of the services")
+	streamToReadFrom, err := service.remoteApiContainerClient.StreamServiceHealthEvents(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting to stream the health events of the services")
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_core_rpc_api_bindings.ServiceHealthEvent](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding the health events of the services from Kurtosis core back to the user")
+	}
+	return nil
+}
+
 func (service *ApiContainerGatewayServiceServer) ConnectServices(ctx context.Context, args *kurtosis_core_rpc_api_bindings.ConnectServicesArgs) (*kurtosis_core_rpc_api_bindings.ConnectServicesResponse, error) {
 	service.mutex.Lock()
 	defer service.mutex.Unlock()
@@ -230,6 +250,90 @@ func (service *ApiContainerGatewayServiceServer) GetStarlarkRun(ctx context.Cont
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) GetEnclaveSnapshotInfo(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotInfoResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetEnclaveSnapshotInfo(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) RestoreEnclaveSnapshot(args *kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotArgs, streamToWriteTo kurtosis_core_rpc_api_bindings.ApiContainerService_RestoreEnclaveSnapshotServer) error {
+	logrus.Debug("Restoring enclave snapshot")
+	streamToReadFrom, err := service.remoteApiContainerClient.RestoreEnclaveSnapshot(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the restoration of the enclave snapshot")
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from Kurtosis core back to the user while restoring the enclave snapshot")
+	}
+	return nil
+}
+
+func (service *ApiContainerGatewayServiceServer) SetSecret(ctx context.Context, args *kurtosis_core_rpc_api_bindings.SetSecretArgs) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.SetSecret(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) ListSecrets(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ListSecretsResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.ListSecrets(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) RemoveSecret(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RemoveSecretArgs) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.RemoveSecret(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetEnclaveActivity(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetEnclaveActivityResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetEnclaveActivity(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetEnclaveResourceUsage(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetEnclaveResourceUsageResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetEnclaveResourceUsage(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) SetEnclaveResourceQuotaBudget(ctx context.Context, args *kurtosis_core_rpc_api_bindings.EnclaveResourceQuotaBudget) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.SetEnclaveResourceQuotaBudget(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetEnclaveEvents(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetEnclaveEventsArgs) (*kurtosis_core_rpc_api_bindings.GetEnclaveEventsResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetEnclaveEvents(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetStarlarkRunTrace(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunTraceResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetStarlarkRunTrace(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
 func (service *ApiContainerGatewayServiceServer) GetStarlarkScriptPlanYaml(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs) (*kurtosis_core_rpc_api_bindings.PlanYaml, error) {
 	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetStarlarkScriptPlanYaml(ctx, args)
 	if err != nil {
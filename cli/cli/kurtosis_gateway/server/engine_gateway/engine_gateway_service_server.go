@@ -119,7 +119,7 @@ func (service *EngineGatewayServiceServer) CreateEnclave(ctx context.Context, ar
 	return remoteEngineResponse, nil
 }
 
-func (service *EngineGatewayServiceServer) GetEnclaves(ctx context.Context, in *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetEnclavesResponse, error) {
+func (service *EngineGatewayServiceServer) GetEnclaves(ctx context.Context, in *kurtosis_engine_rpc_api_bindings.GetEnclavesArgs) (*kurtosis_engine_rpc_api_bindings.GetEnclavesResponse, error) {
 	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
@@ -178,6 +178,31 @@ func (service *EngineGatewayServiceServer) StopEnclave(ctx context.Context, args
 	return &emptypb.Empty{}, nil
 }
 
+func (service *EngineGatewayServiceServer) StartEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.StartEnclaveArgs) (*emptypb.Empty, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	if _, err := remoteEngineClient.StartEnclave(ctx, args); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to start enclave '%v'", args.EnclaveIdentifier)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (service *EngineGatewayServiceServer) ExtendEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs) (*kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	response, err := remoteEngineClient.ExtendEnclave(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to extend enclave '%v'", args.EnclaveIdentifier)
+	}
+
+	return response, nil
+}
+
 func (service *EngineGatewayServiceServer) DestroyEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
 	if err != nil {
@@ -221,6 +246,18 @@ func (service *EngineGatewayServiceServer) GetEngineInfo(ctx context.Context, em
 	return remoteEngineResponse, nil
 }
 
+func (service *EngineGatewayServiceServer) GetResourceQuotaUsage(ctx context.Context, emptyArgs *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetResourceQuotaUsageResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.GetResourceQuotaUsage(ctx, emptyArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the resource quota usage through the remote engine")
+	}
+	return remoteEngineResponse, nil
+}
+
 func (service *EngineGatewayServiceServer) GetServiceLogs(
 	args *kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs,
 	streamToWriteTo kurtosis_engine_rpc_api_bindings.EngineService_GetServiceLogsServer,
@@ -239,6 +276,24 @@ func (service *EngineGatewayServiceServer) GetServiceLogs(
 	return nil
 }
 
+func (service *EngineGatewayServiceServer) StreamEngineEvents(
+	args *kurtosis_engine_rpc_api_bindings.StreamEngineEventsArgs,
+	streamToWriteTo kurtosis_engine_rpc_api_bindings.EngineService_StreamEngineEventsServer,
+) error {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	streamToReadFrom, err := remoteEngineClient.StreamEngineEvents(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred streaming the engine events")
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_engine_rpc_api_bindings.EngineEvent](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from Kurtosis engine back to the user")
+	}
+	return nil
+}
+
 // Private functions for managing our running enclave api container gateways
 func (service *EngineGatewayServiceServer) startRunningGatewayForEnclave(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (*runningApiContainerGateway, error) {
 	service.mutex.Lock()
@@ -2,6 +2,7 @@ package connect_server
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net/http"
@@ -26,14 +27,22 @@ type ConnectServer struct {
 	path            string
 	handler         http.Handler
 	stopGracePeriod time.Duration // How long we'll give the server to stop after asking nicely before we kill it
+	tlsConfig       *tls.Config   // If nil, the server serves plaintext HTTP/2 (h2c)
 }
 
 func NewConnectServer(listenPort uint16, stopGracePeriod time.Duration, handler http.Handler, path string) *ConnectServer {
+	return NewConnectServerWithTls(listenPort, stopGracePeriod, handler, path, nil)
+}
+
+// NewConnectServerWithTls creates a server that serves over TLS with the given config, which must hold the server
+// certificate (and the client CAs if the clients need to be authenticated)
+func NewConnectServerWithTls(listenPort uint16, stopGracePeriod time.Duration, handler http.Handler, path string, tlsConfig *tls.Config) *ConnectServer {
 	return &ConnectServer{
 		listenPort:      listenPort,
 		stopGracePeriod: stopGracePeriod,
 		handler:         handler,
 		path:            path,
+		tlsConfig:       tlsConfig,
 	}
 }
 func (server *ConnectServer) RunServerUntilInterrupted() error {
@@ -72,7 +81,15 @@ func (server *ConnectServer) RunServerUntilStopped(
 	}
 
 	go func() {
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if server.tlsConfig != nil {
+			httpServer.TLSConfig = server.tlsConfig
+			// The certificate is already in the TLS config
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			logrus.Infof("Error occurred while starting the server, error: %+v", err)
 		}
 	}()
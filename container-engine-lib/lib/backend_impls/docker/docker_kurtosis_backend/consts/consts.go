@@ -34,6 +34,9 @@ const (
 
 	GitHubAuthStorageDirPath = "/kurtosis-data/github-auth/"
 
+	// The directory where the engine keeps the state it needs to survive a restart (e.g. the enclave expiries)
+	EngineDataDirPath = "/kurtosis-data/engine/"
+
 	EmptyApplicationURL = ""
 )
 
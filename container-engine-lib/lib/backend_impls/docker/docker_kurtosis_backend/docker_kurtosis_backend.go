@@ -8,6 +8,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 
 	"github.com/sirupsen/logrus"
 
@@ -249,6 +250,7 @@ func (backend *DockerKurtosisBackend) StartRegisteredUserServices(ctx context.Co
 		backend.objAttrsProvider,
 		freeIpAddrProviderForEnclave,
 		backend.dockerManager,
+		backend.dockerNetworkAllocator,
 		restartPolicy)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Unexpected error while starting user service")
@@ -293,6 +295,30 @@ func (backend *DockerKurtosisBackend) GetUserServiceLogs(
 	return user_service_functions.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) GetUserServiceImageDigests(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]string,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return user_service_functions.GetUserServiceImageDigests(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) GetUserServiceStats(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service.ServiceStats,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return user_service_functions.GetUserServiceStats(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
 // NOTE: This function will block while the exec is ongoing; if we need more perf we can make it async
 func (backend *DockerKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
@@ -542,6 +568,10 @@ func (backend *DockerKurtosisBackend) GetAvailableCPUAndMemory(ctx context.Conte
 	return availableMemory, availableCpu, isResourceInformationComplete, nil
 }
 
+func (backend *DockerKurtosisBackend) GetHostPortReservations(ctx context.Context) ([]*port_publish_spec.HostPortReservation, error) {
+	return user_service_functions.GetHostPortReservations(ctx, backend.dockerManager)
+}
+
 func (backend *DockerKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (string, error) {
 	return backend.dockerManager.BuildImage(ctx, imageName, imageBuildSpec)
 }
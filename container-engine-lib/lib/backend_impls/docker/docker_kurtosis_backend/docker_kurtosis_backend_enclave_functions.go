@@ -3,6 +3,7 @@ package docker_kurtosis_backend
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -25,6 +26,11 @@ const (
 	shouldFetchStoppedContainersWhenDisconnectingFromEnclaveNetworks = false
 
 	serializedArgs = "SERIALIZED_ARGS"
+
+	// Starting an enclave back up takes longer than creating its API container, as the API container restarts the user
+	// services of the enclave before serving requests
+	maxWaitForStartedApiContainerAvailabilityRetries         = 300
+	timeBetweenWaitForStartedApiContainerAvailabilityRetries = 1 * time.Second
 )
 
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
@@ -36,7 +42,7 @@ type matchingNetworkInformation struct {
 	containers    []*types.Container
 }
 
-func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
+func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, labels map[string]string, isIpv6Enabled bool) (*enclave.Enclave, error) {
 	teardownCtx := context.Background() // Separate context for tearing stuff down in case the input context is cancelled
 
 	searchNetworkLabels := map[string]string{
@@ -72,7 +78,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 
 	creationTime := time.Now()
 
-	enclaveNetworkAttrs, err := enclaveObjAttrsProvider.ForEnclaveNetwork(enclaveName, creationTime)
+	enclaveNetworkAttrs, err := enclaveObjAttrsProvider.ForEnclaveNetwork(enclaveName, creationTime, labels)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while trying to get the enclave network attributes for the enclave with ID '%v'", enclaveUuid)
 	}
@@ -97,6 +103,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 		ctx,
 		enclaveNetworkName.GetString(),
 		enclaveNetworkLabels,
+		isIpv6Enabled,
 	)
 	if err != nil {
 		// TODO If the user Ctrl-C's while the CreateNetwork call is ongoing then the CreateNetwork will error saying
@@ -145,7 +152,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 	}()
 
 	// TODO: return production mode for create enclave request as well
-	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, false)
+	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, false, labels)
 
 	if err := backend.ConnectReverseProxyToNetwork(ctx, networkId); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred connecting the reverse proxy to the enclave network with ID '%v'", networkId)
@@ -189,6 +196,7 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 		}
 
 		enclaveName := getEnclaveNameFromNetwork(matchingNetworkInfo.dockerNetwork)
+		enclaveLabels := getEnclaveLabelsFromNetwork(matchingNetworkInfo.dockerNetwork)
 
 		// This method just looks for api-container ( which only can be one) for an enclave
 		// and extracts out whether enclave is running on production mode
@@ -218,6 +226,7 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 			matchingNetworkInfo.enclaveStatus,
 			creationTime,
 			productionMode,
+			enclaveLabels,
 		)
 	}
 
@@ -300,6 +309,33 @@ func (backend *DockerKurtosisBackend) StopEnclaves(
 	return successfulEnclaveUuids, erroredEnclaveUuids, nil
 }
 
+// Starts the stopped enclaves matching the given filters, by starting their logs collector and API container again
+// The API container then starts the user services that were running when the enclave got stopped
+func (backend *DockerKurtosisBackend) StartEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	resultSuccessfulEnclaveUuids map[enclave.EnclaveUUID]bool,
+	resultErroredEnclaveUuids map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+	matchingNetworkInfo, err := backend.getMatchingEnclaveNetworkInfo(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting enclave network info using filters '%+v'", filters)
+	}
+
+	erroredEnclaveUuids := map[enclave.EnclaveUUID]error{}
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	for enclaveUuid, networkInfo := range matchingNetworkInfo {
+		if err := backend.startEnclaveKurtosisContainers(ctx, enclaveUuid, networkInfo.containers); err != nil {
+			erroredEnclaveUuids[enclaveUuid] = stacktrace.Propagate(err, "An error occurred starting the containers of enclave '%v'", enclaveUuid)
+			continue
+		}
+		successfulEnclaveUuids[enclaveUuid] = true
+	}
+	return successfulEnclaveUuids, erroredEnclaveUuids, nil
+}
+
 func (backend *DockerKurtosisBackend) DumpEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -372,9 +408,17 @@ func (backend *DockerKurtosisBackend) DestroyEnclaves(
 		erroredEnclaveUuids[enclaveUuid] = volumeRemovalErr
 	}
 
+	successfulNamedNetworkRemovalEnclaveUuids, erroredNamedNetworkRemovalEnclaveUuids, err := destroyNamedNetworksInEnclaves(ctx, backend.dockerManager, successfulVolumeRemovalEnclaveUuids)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying the named networks of enclaves for which volumes were successfully destroyed: %+v", successfulVolumeRemovalEnclaveUuids)
+	}
+	for enclaveUuid, namedNetworkRemovalErr := range erroredNamedNetworkRemovalEnclaveUuids {
+		erroredEnclaveUuids[enclaveUuid] = namedNetworkRemovalErr
+	}
+
 	// Disconnect the external containers from the enclave networks being removed
 	networksToDisconnect := map[enclave.EnclaveUUID]string{}
-	for enclaveUuid := range successfulVolumeRemovalEnclaveUuids {
+	for enclaveUuid := range successfulNamedNetworkRemovalEnclaveUuids {
 		networkInfo, found := matchingNetworkInfo[enclaveUuid]
 		if !found {
 			return nil, nil, stacktrace.NewError("Attempt was made to disconnect enclave '%v' that did not match filters. This is likely a bug in Kurtosis.", enclaveUuid)
@@ -415,6 +459,7 @@ func (backend *DockerKurtosisBackend) UpdateEnclave(
 	enclaveUuid enclave.EnclaveUUID,
 	newName string,
 	newCreationTime *time.Time,
+	newLabels map[string]string,
 ) error {
 	return stacktrace.NewError("UpdateEnclave isn't implemented for Docker yet")
 }
@@ -519,6 +564,62 @@ func (backend *DockerKurtosisBackend) getEnclaveStatusAndContainers(
 	return resultEnclaveStatus, allEnclaveContainers, nil
 }
 
+// Starts the logs collector first, as the API container waits for it to be available before starting user services
+func (backend *DockerKurtosisBackend) startEnclaveKurtosisContainers(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveContainers []*types.Container,
+) error {
+	var logsCollectorContainer *types.Container
+	var apiContainer *types.Container
+	for _, enclaveContainer := range enclaveContainers {
+		switch enclaveContainer.GetLabels()[docker_label_key.ContainerTypeDockerLabelKey.GetString()] {
+		case label_value_consts.LogsCollectorTypeDockerLabelValue.GetString():
+			logsCollectorContainer = enclaveContainer
+		case label_value_consts.APIContainerContainerTypeDockerLabelValue.GetString():
+			apiContainer = enclaveContainer
+		}
+	}
+	if apiContainer == nil {
+		return stacktrace.NewError("No API container was found in enclave '%v', so the enclave can't be started", enclaveUuid)
+	}
+
+	for _, kurtosisContainer := range []*types.Container{logsCollectorContainer, apiContainer} {
+		if kurtosisContainer == nil {
+			continue
+		}
+		isContainerRunning, found := consts.IsContainerRunningDeterminer[kurtosisContainer.GetStatus()]
+		if !found {
+			// This should never happen because we enforce completeness in a unit test
+			return stacktrace.NewError("No is-running designation found for enclave container status '%v'; this is a bug in Kurtosis!", kurtosisContainer.GetStatus().String())
+		}
+		if isContainerRunning {
+			continue
+		}
+		if err := backend.dockerManager.StartContainer(ctx, kurtosisContainer.GetId()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred starting container '%v' of enclave '%v'", kurtosisContainer.GetName(), enclaveUuid)
+		}
+	}
+
+	privateGrpcPortSpec, err := getPrivateApiContainerPorts(apiContainer.GetLabels())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the private port specs of the API container of enclave '%v'", enclaveUuid)
+	}
+	logrus.Debugf("Waiting for the APIC of enclave '%v' to start its services and become available...", enclaveUuid)
+	if err := shared_helpers.WaitForPortAvailabilityUsingNetstat(
+		ctx,
+		backend.dockerManager,
+		apiContainer.GetId(),
+		privateGrpcPortSpec,
+		maxWaitForStartedApiContainerAvailabilityRetries,
+		timeBetweenWaitForStartedApiContainerAvailabilityRetries,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for the API container's grpc port to become available")
+	}
+	logrus.Debugf("...APIC is available in enclave '%v'", enclaveUuid)
+	return nil
+}
+
 func (backend *DockerKurtosisBackend) getAllEnclaveContainers(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -761,6 +862,61 @@ func destroyVolumesInEnclaves(
 	return successfulEnclaveUuids, erroredEnclaveUuids, nil
 }
 
+// Removes the named networks services got attached to, which all the enclave containers were attached to, so they're
+// unused by now
+func destroyNamedNetworksInEnclaves(
+	ctx context.Context,
+	dockerManager *docker_manager.DockerManager,
+	enclaveUuids map[enclave.EnclaveUUID]bool,
+) (
+	map[enclave.EnclaveUUID]bool,
+	map[enclave.EnclaveUUID]error,
+	error,
+) {
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	erroredEnclaveUuids := map[enclave.EnclaveUUID]error{}
+	for enclaveUuid := range enclaveUuids {
+		// Named networks don't have the app ID label, so they never get mistaken for the enclave network
+		searchLabels := map[string]string{
+			docker_label_key.EnclaveUUIDDockerLabelKey.GetString(): string(enclaveUuid),
+		}
+		enclaveNetworks, err := dockerManager.GetNetworksByLabels(ctx, searchLabels)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred getting the networks of enclave '%v'", enclaveUuid)
+		}
+
+		namedNetworkIds := map[string]bool{}
+		for _, network := range enclaveNetworks {
+			if _, found := network.GetLabels()[docker_label_key.NamedNetworkDockerLabelKey.GetString()]; found {
+				namedNetworkIds[network.GetId()] = true
+			}
+		}
+
+		var removeNetworkOperation docker_operation_parallelizer.DockerOperation = func(ctx context.Context, dockerManager *docker_manager.DockerManager, dockerObjectId string) error {
+			if err := dockerManager.RemoveNetwork(ctx, dockerObjectId); err != nil {
+				return stacktrace.Propagate(err, "An error occurred removing named network with ID '%v'", dockerObjectId)
+			}
+			return nil
+		}
+		_, erroredNetworkIds := docker_operation_parallelizer.RunDockerOperationInParallel(
+			ctx,
+			namedNetworkIds,
+			dockerManager,
+			removeNetworkOperation,
+		)
+		if len(erroredNetworkIds) > 0 {
+			networkRemovalErrStrs := []string{}
+			for networkId, networkRemovalErr := range erroredNetworkIds {
+				networkRemovalErrStrs = append(networkRemovalErrStrs, fmt.Sprintf("Named network '%v':\n%v", networkId, networkRemovalErr))
+			}
+			erroredEnclaveUuids[enclaveUuid] = stacktrace.NewError("An error occurred removing the named networks of enclave '%v':\n%v", enclaveUuid, strings.Join(networkRemovalErrStrs, "\n\n"))
+			continue
+		}
+		successfulEnclaveUuids[enclaveUuid] = true
+	}
+	return successfulEnclaveUuids, erroredEnclaveUuids, nil
+}
+
 func destroyEnclaveNetworks(
 	ctx context.Context,
 	dockerManager *docker_manager.DockerManager,
@@ -853,6 +1009,20 @@ func getEnclaveNameFromNetwork(network *types.Network) string {
 	return enclaveNameStr
 }
 
+// getEnclaveLabelsFromNetwork returns the labels given by the user to the enclave, stored as user custom labels on the
+// enclave network
+func getEnclaveLabelsFromNetwork(network *types.Network) map[string]string {
+	enclaveLabels := map[string]string{}
+	for labelKey, labelValue := range network.GetLabels() {
+		userLabelKey, isUserCustomLabel := docker_label_key.GetUserCustomLabelKey(labelKey)
+		if !isUserCustomLabel {
+			continue
+		}
+		enclaveLabels[userLabelKey] = labelValue
+	}
+	return enclaveLabels
+}
+
 func getProductionModeFromEnvVars(serializedParamsStr string) (bool, error) {
 	type Args struct {
 		IsProductionEnclave bool `json:"isProductionEnclave"`
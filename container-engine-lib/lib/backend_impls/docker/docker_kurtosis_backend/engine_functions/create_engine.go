@@ -249,6 +249,20 @@ func CreateEngine(
 		return nil, stacktrace.Propagate(err, "An error occurred creating GitHub auth storage.")
 	}
 
+	engineDataVolObjAttrs, err := objAttrsProvider.ForEngineDataVolume()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retrieving object attributes for the engine data volume.")
+	}
+	engineDataVolNameStr := engineDataVolObjAttrs.GetName().GetString()
+	engineDataVolLabelStrs := map[string]string{}
+	for labelKey, labelValue := range engineDataVolObjAttrs.GetLabels() {
+		engineDataVolLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	// This volume is created idempotently too, as the data the previous engine left in it must survive engine restarts
+	if err = dockerManager.CreateVolume(ctx, engineDataVolNameStr, engineDataVolLabelStrs); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the engine data volume.")
+	}
+
 	bindMounts := map[string]string{
 		// Necessary so that the engine server can interact with the Docker engine
 		consts.DockerSocketFilepath: consts.DockerSocketFilepath,
@@ -257,6 +271,7 @@ func CreateEngine(
 	volumeMounts := map[string]string{
 		logsStorageVolNameStr:       logsStorageDirPath,
 		githubAuthStorageVolNameStr: consts.GitHubAuthStorageDirPath,
+		engineDataVolNameStr:        consts.EngineDataDirPath,
 	}
 
 	if serverArgs.OnBastionHost {
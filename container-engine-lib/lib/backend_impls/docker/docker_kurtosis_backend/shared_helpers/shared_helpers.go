@@ -50,6 +50,10 @@ const (
 	numContainersToDumpAtOnce            = 20
 
 	emptyUrl = ""
+
+	secretEnvVarNamesSeparator = ","
+	envVarKeyValueSeparator    = "="
+	envVarKeyValueNumParts     = 2
 )
 
 // !!!WARNING!!!
@@ -75,6 +79,9 @@ type UserServiceDockerResources struct {
 
 	// Will never be nil but may be empty if no expander volumes exist
 	ExpanderVolumeNames []string
+
+	// Containers joined to the network namespace of the service container; may be empty
+	SidecarContainers []*types.Container
 }
 
 func GetEnclaveNetworkByEnclaveUuid(ctx context.Context, enclaveUuid enclave.EnclaveUUID, dockerManager *docker_manager.DockerManager) (*types.Network, error) {
@@ -220,6 +227,20 @@ func TransformPortSpecToDockerPort(portSpec *port_spec.PortSpec) (nat.Port, erro
 	return dockerPort, nil
 }
 
+// GetPrivateIpv6FromContainer returns the private IPv6 address stored in the labels of a user service container, or nil
+// if the container isn't on a dual-stack network
+func GetPrivateIpv6FromContainer(containerName string, labels map[string]string) (net.IP, error) {
+	privateIpv6AddrStr, found := labels[docker_label_key.PrivateIPv6DockerLabelKey.GetString()]
+	if !found {
+		return nil, nil
+	}
+	privateIpv6 := net.ParseIP(privateIpv6AddrStr)
+	if privateIpv6 == nil {
+		return nil, stacktrace.NewError("Couldn't parse private IPv6 string '%v' on container '%v' to an IP address", privateIpv6AddrStr, containerName)
+	}
+	return privateIpv6, nil
+}
+
 // TODO Extract this to DockerKurtosisBackend and use it everywhere, for Engines and API containers?
 func GetIpAndPortInfoFromContainer(
 	containerName string,
@@ -505,6 +526,39 @@ func DumpContainers(ctx context.Context, dockerManager *docker_manager.DockerMan
 //	Private Helper Functions
 //
 // ====================================================================================================
+// SerializeSecretEnvVarNames returns the value of the label listing the env vars of a container which hold secrets
+func SerializeSecretEnvVarNames(secretEnvVarNames []string) string {
+	return strings.Join(secretEnvVarNames, secretEnvVarNamesSeparator)
+}
+
+// GetSecretEnvVarNamesFromLabels returns the set of env vars which hold secrets, according to the labels of a container
+func GetSecretEnvVarNamesFromLabels(containerLabels map[string]string) map[string]bool {
+	secretEnvVarNames := map[string]bool{}
+	serializedSecretEnvVarNames, found := containerLabels[docker_label_key.SecretEnvVarNamesDockerLabelKey.GetString()]
+	if !found || serializedSecretEnvVarNames == "" {
+		return secretEnvVarNames
+	}
+	for _, envVarName := range strings.Split(serializedSecretEnvVarNames, secretEnvVarNamesSeparator) {
+		secretEnvVarNames[envVarName] = true
+	}
+	return secretEnvVarNames
+}
+
+// RedactSecretEnvVars returns a copy of the env vars where the values of the env vars holding secrets got redacted
+func RedactSecretEnvVars(envVars map[string]string, secretEnvVarNames map[string]bool) map[string]string {
+	if len(secretEnvVarNames) == 0 {
+		return envVars
+	}
+	redactedEnvVars := map[string]string{}
+	for envVarName, envVarValue := range envVars {
+		if secretEnvVarNames[envVarName] {
+			envVarValue = container.RedactedSecretValue
+		}
+		redactedEnvVars[envVarName] = envVarValue
+	}
+	return redactedEnvVars
+}
+
 func getMatchingUserServiceDockerResources(
 	ctx context.Context,
 	enclaveId enclave.EnclaveUUID,
@@ -542,12 +596,49 @@ func getMatchingUserServiceDockerResources(
 			resourceObj = &UserServiceDockerResources{
 				ServiceContainer:    nil,
 				ExpanderVolumeNames: nil,
+				SidecarContainers:   nil,
 			}
 		}
 		resourceObj.ServiceContainer = container
 		result[serviceUuid] = resourceObj
 	}
 
+	// Grab sidecars, keyed by the UUID of the service they run next to
+	sidecarContainerSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString():   string(enclaveId),
+		docker_label_key.ContainerTypeDockerLabelKey.GetString(): label_value_consts.UserServiceSidecarContainerTypeDockerLabelValue.GetString(),
+	}
+	sidecarContainers, err := dockerManager.GetContainersByLabels(ctx, sidecarContainerSearchLabels, shouldGetStoppedContainersWhenGettingServiceInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting user service sidecar containers in enclave '%v' by labels: %+v", enclaveId, sidecarContainerSearchLabels)
+	}
+
+	for _, container := range sidecarContainers {
+		serviceUuidStr, found := container.GetLabels()[docker_label_key.UserServiceGUIDDockerLabelKey.GetString()]
+		if !found {
+			return nil, stacktrace.NewError("Found user service sidecar container '%v' that didn't have expected service GUID label '%v'", container.GetId(), docker_label_key.UserServiceGUIDDockerLabelKey.GetString())
+		}
+		serviceUuid := service.ServiceUUID(serviceUuidStr)
+
+		if len(maybeUuidsToMatch) > 0 {
+			if _, found := maybeUuidsToMatch[serviceUuid]; !found {
+				continue
+			}
+		}
+
+		resourceObj, found := result[serviceUuid]
+		if !found {
+			resourceObj = &UserServiceDockerResources{
+				ServiceContainer:    nil,
+				ExpanderVolumeNames: nil,
+				SidecarContainers:   nil,
+			}
+		}
+		resourceObj.SidecarContainers = append(resourceObj.SidecarContainers, container)
+		result[serviceUuid] = resourceObj
+	}
+
 	// Grab volumes, INDEPENDENT OF whether there are any containers
 	filesArtifactExpansionVolumeSearchLabels := map[string]string{
 		docker_label_key.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
@@ -577,6 +668,7 @@ func getMatchingUserServiceDockerResources(
 			resourceObj = &UserServiceDockerResources{
 				ServiceContainer:    nil,
 				ExpanderVolumeNames: nil,
+				SidecarContainers:   nil,
 			}
 		}
 		resourceObj.ExpanderVolumeNames = append(resourceObj.ExpanderVolumeNames, volume.Name)
@@ -622,6 +714,10 @@ func getUserServiceObjsFromDockerResources(
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred getting IP & port info from container '%v'", serviceContainer.GetName())
 		}
+		privateIpv6, err := GetPrivateIpv6FromContainer(containerName, containerLabels)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the private IPv6 address from container '%v'", containerName)
+		}
 
 		registration := service.NewServiceRegistration(
 			serviceName,
@@ -630,6 +726,7 @@ func getUserServiceObjsFromDockerResources(
 			privateIp,
 			string(serviceName), // in Docker, hostname = serviceName because we're setting the "alias" of the container to serviceName
 		)
+		registration.SetPrivateIPv6(privateIpv6)
 
 		containerStatus := serviceContainer.GetStatus()
 		isContainerRunning, found := consts.IsContainerRunningDeterminer[containerStatus]
@@ -637,8 +734,12 @@ func getUserServiceObjsFromDockerResources(
 			return nil, stacktrace.NewError("No is-running determination found for status '%v' for container '%v'", containerStatus.String(), containerName)
 		}
 		serviceContainerStatus := container.ContainerStatus_Stopped
+		var maybeExitCode *int32
 		if isContainerRunning {
 			serviceContainerStatus = container.ContainerStatus_Running
+		} else {
+			exitCode := int32(serviceContainer.GetExitCode())
+			maybeExitCode = &exitCode
 		}
 
 		result[serviceUuid] = service.NewService(
@@ -646,12 +747,16 @@ func getUserServiceObjsFromDockerResources(
 			privatePorts,
 			maybePublicIp,
 			maybePublicPorts,
-			container.NewContainer(
+			container.NewContainerWithExitInfo(
 				serviceContainerStatus,
 				serviceContainer.GetImageName(),
 				serviceContainer.GetEntrypointArgs(),
 				serviceContainer.GetCmdArgs(),
-				serviceContainer.GetEnvVars(),
+				RedactSecretEnvVars(serviceContainer.GetEnvVars(), GetSecretEnvVarNamesFromLabels(containerLabels)),
+				maybeExitCode,
+				uint32(serviceContainer.GetRestartCount()),
+				serviceContainer.GetLastExitReason(),
+				serviceContainer.IsCrashLooping(),
 			),
 		)
 	}
@@ -705,6 +810,9 @@ func dumpContainerInfo(
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred inspecting container with ID '%v'", containerId)
 	}
+	if inspectResult.Config != nil {
+		inspectResult.Config.Env = redactSecretEnvVarStrs(inspectResult.Config.Env, GetSecretEnvVarNamesFromLabels(inspectResult.Config.Labels))
+	}
 	jsonSerializedInspectResultBytes, err := json.MarshalIndent(inspectResult, containerSpecJsonSerializationPrefix, containerSpecJsonSerializationIndent)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred serializing the results of inspecting container with ID '%v' to JSON", containerId)
@@ -768,3 +876,19 @@ func dumpContainerInfo(
 
 	return nil
 }
+
+// Same as RedactSecretEnvVars, for env vars in the KEY=VALUE form of the Docker container specs
+func redactSecretEnvVarStrs(envVarStrs []string, secretEnvVarNames map[string]bool) []string {
+	if len(secretEnvVarNames) == 0 {
+		return envVarStrs
+	}
+	redactedEnvVarStrs := []string{}
+	for _, envVarStr := range envVarStrs {
+		envVarName := strings.SplitN(envVarStr, envVarKeyValueSeparator, envVarKeyValueNumParts)[0]
+		if secretEnvVarNames[envVarName] {
+			envVarStr = envVarName + envVarKeyValueSeparator + container.RedactedSecretValue
+		}
+		redactedEnvVarStrs = append(redactedEnvVarStrs, envVarStr)
+	}
+	return redactedEnvVarStrs
+}
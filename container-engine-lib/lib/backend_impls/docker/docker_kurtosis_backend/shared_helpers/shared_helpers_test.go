@@ -0,0 +1,35 @@
+package shared_helpers
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactSecretEnvVars(t *testing.T) {
+	containerLabels := map[string]string{
+		docker_label_key.SecretEnvVarNamesDockerLabelKey.GetString(): SerializeSecretEnvVarNames([]string{"DB_PASSWORD", "API_TOKEN"}),
+	}
+	secretEnvVarNames := GetSecretEnvVarNamesFromLabels(containerLabels)
+
+	envVars := map[string]string{
+		"DB_PASSWORD": "hunter2",
+		"API_TOKEN":   "abcdef",
+		"LOG_LEVEL":   "debug",
+	}
+	require.Equal(t, map[string]string{
+		"DB_PASSWORD": container.RedactedSecretValue,
+		"API_TOKEN":   container.RedactedSecretValue,
+		"LOG_LEVEL":   "debug",
+	}, RedactSecretEnvVars(envVars, secretEnvVarNames))
+	require.Equal(t, "hunter2", envVars["DB_PASSWORD"])
+
+	envVarStrs := []string{"DB_PASSWORD=hunter2=", "LOG_LEVEL=debug"}
+	require.Equal(t, []string{"DB_PASSWORD=" + container.RedactedSecretValue, "LOG_LEVEL=debug"}, redactSecretEnvVarStrs(envVarStrs, secretEnvVarNames))
+}
+
+func TestGetSecretEnvVarNamesFromLabelsWithoutLabel(t *testing.T) {
+	require.Empty(t, GetSecretEnvVarNamesFromLabels(map[string]string{}))
+}
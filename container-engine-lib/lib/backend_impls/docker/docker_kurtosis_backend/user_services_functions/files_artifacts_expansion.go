@@ -12,6 +12,9 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"strings"
 )
 
@@ -21,6 +24,11 @@ const (
 	expanderContainerSuccessExitCode = 0
 
 	skipAddingToBridgeNetwork = true
+
+	// The API container traces the Starlark runs, which this span is part of when the context carries one
+	tracerName                   = "github.com/kurtosis-tech/kurtosis/container-engine-lib"
+	expandFilesArtifactsSpanName = "expand_files_artifacts"
+	serviceUuidSpanAttributeKey  = attribute.Key("kurtosis.service.uuid")
 )
 
 // Functions required to do files artifacts expansion
@@ -66,8 +74,10 @@ func doFilesArtifactExpansionAndGetUserServiceVolumes(
 		}
 	}()
 
-	if err := runFilesArtifactsExpander(
-		ctx,
+	expansionCtx, span := otel.Tracer(tracerName).Start(ctx, expandFilesArtifactsSpanName)
+	span.SetAttributes(serviceUuidSpanAttributeKey.String(string(serviceUuid)))
+	expansionErr := runFilesArtifactsExpander(
+		expansionCtx,
 		serviceUuid,
 		objAttrsProvider,
 		freeIpAddrProvider,
@@ -76,7 +86,12 @@ func doFilesArtifactExpansionAndGetUserServiceVolumes(
 		enclaveNetworkId,
 		expanderMountpointsToVolumeNames,
 		dockerManager,
-	); err != nil {
+	)
+	if expansionErr != nil {
+		span.SetStatus(codes.Error, expansionErr.Error())
+	}
+	span.End()
+	if err := expansionErr; err != nil {
 		return nil, stacktrace.Propagate(
 			err,
 			"An error occurred running files artifacts expander for service '%v'",
@@ -0,0 +1,94 @@
+package user_service_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"strings"
+)
+
+const (
+	imageDigestSeparator = "@"
+	imageTagSeparator    = ":"
+	imagePathSeparator   = "/"
+)
+
+func GetUserServiceImageDigests(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	dockerManager *docker_manager.DockerManager,
+) (
+	map[service.ServiceUUID]string,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	_, allDockerResources, err := shared_helpers.GetMatchingUserServiceObjsAndDockerResourcesNoMutex(ctx, enclaveId, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
+	}
+
+	successfulUserServiceImageDigests := map[service.ServiceUUID]string{}
+	erroredUserServices := map[service.ServiceUUID]error{}
+	for serviceUuid, resourcesForService := range allDockerResources {
+		container := resourcesForService.ServiceContainer
+		if container == nil {
+			erroredUserServices[serviceUuid] = stacktrace.NewError("Cannot get the image digest of service '%v' as it has no container", serviceUuid)
+			continue
+		}
+		containerInfo, err := dockerManager.InspectContainer(ctx, container.GetId())
+		if err != nil {
+			erroredUserServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred inspecting container '%v' for user service with UUID '%v'", container.GetName(), serviceUuid)
+			continue
+		}
+		// The container references the ID of the image it was created from, so the digest is the one of the image the
+		// service is actually running even if the tag got moved to another image since
+		repoDigests, err := dockerManager.GetImageRepoDigests(ctx, containerInfo.Image)
+		if err != nil {
+			erroredUserServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred getting the digests of image '%v' for user service with UUID '%v'", container.GetImageName(), serviceUuid)
+			continue
+		}
+		imageDigest, found := getRepoDigestForImage(container.GetImageName(), repoDigests)
+		if !found {
+			erroredUserServices[serviceUuid] = stacktrace.NewError("Image '%v' of user service with UUID '%v' has no digest, which is the case of images built locally that were never pushed to a registry", container.GetImageName(), serviceUuid)
+			continue
+		}
+		successfulUserServiceImageDigests[serviceUuid] = imageDigest
+	}
+	return successfulUserServiceImageDigests, erroredUserServices, nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+// getRepoDigestForImage picks the digest of the repository the image was referenced from, as an image pulled from
+// several repositories has one digest per repository
+func getRepoDigestForImage(imageName string, repoDigests []string) (string, bool) {
+	if len(repoDigests) == 0 {
+		return "", false
+	}
+	imageRepository := getImageRepository(imageName)
+	for _, repoDigest := range repoDigests {
+		digestRepository, _, found := strings.Cut(repoDigest, imageDigestSeparator)
+		if found && digestRepository == imageRepository {
+			return repoDigest, true
+		}
+	}
+	return repoDigests[0], true
+}
+
+// getImageRepository strips the tag or the digest off the image name; a colon before the last slash separates the
+// port of the registry rather than the tag
+func getImageRepository(imageName string) string {
+	imageRepository, _, _ := strings.Cut(imageName, imageDigestSeparator)
+	lastPathSeparatorIndex := strings.LastIndex(imageRepository, imagePathSeparator)
+	if tagSeparatorIndex := strings.LastIndex(imageRepository, imageTagSeparator); tagSeparatorIndex > lastPathSeparatorIndex {
+		imageRepository = imageRepository[:tagSeparatorIndex]
+	}
+	return imageRepository
+}
@@ -0,0 +1,29 @@
+package user_service_functions
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetRepoDigestForImage(t *testing.T) {
+	repoDigests := []string{
+		"mirror.example.com:5000/library/postgres@sha256:aaaa",
+		"postgres@sha256:bbbb",
+	}
+
+	imageDigest, found := getRepoDigestForImage("postgres:16", repoDigests)
+	require.True(t, found)
+	require.Equal(t, "postgres@sha256:bbbb", imageDigest)
+
+	imageDigest, found = getRepoDigestForImage("mirror.example.com:5000/library/postgres", repoDigests)
+	require.True(t, found)
+	require.Equal(t, "mirror.example.com:5000/library/postgres@sha256:aaaa", imageDigest)
+
+	// Falls back to the first digest when the image was re-tagged locally
+	imageDigest, found = getRepoDigestForImage("my-postgres:latest", repoDigests)
+	require.True(t, found)
+	require.Equal(t, "mirror.example.com:5000/library/postgres@sha256:aaaa", imageDigest)
+
+	_, found = getRepoDigestForImage("locally-built:latest", nil)
+	require.False(t, found)
+}
@@ -0,0 +1,137 @@
+package user_service_functions
+
+import (
+	"context"
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
+	"github.com/kurtosis-tech/stacktrace"
+	"reflect"
+	"strings"
+)
+
+const (
+	milliCoresInCore = 1000
+
+	// Docker reports block I/O operations in lowercase on cgroups v2 hosts, and capitalized on cgroups v1 ones
+	blkioReadOp  = "read"
+	blkioWriteOp = "write"
+
+	// Page cache which the kernel can reclaim, and which the Docker CLI doesn't count in the memory usage either
+	// The first key is the cgroups v1 one, the second the cgroups v2 one
+	cgroupsV1InactiveFileMemoryStatKey = "total_inactive_file"
+	cgroupsV2InactiveFileMemoryStatKey = "inactive_file"
+)
+
+func GetUserServiceStats(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	dockerManager *docker_manager.DockerManager,
+) (
+	map[service.ServiceUUID]*service.ServiceStats,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	_, allDockerResources, err := shared_helpers.GetMatchingUserServiceObjsAndDockerResourcesNoMutex(ctx, enclaveId, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
+	}
+
+	// Each sample takes about a second as Docker waits for a second CPU sample, so the services are sampled in parallel
+	successfulUserServicesStats := map[service.ServiceUUID]*service.ServiceStats{}
+	erroredUserServices := map[service.ServiceUUID]error{}
+	statsOperations := map[operation_parallelizer.OperationID]operation_parallelizer.Operation{}
+	for serviceUuid, resourcesForService := range allDockerResources {
+		container := resourcesForService.ServiceContainer
+		if container == nil {
+			erroredUserServices[serviceUuid] = stacktrace.NewError("Cannot get stats for service '%v' as it has no container", serviceUuid)
+			continue
+		}
+		if container.GetStatus() != types.ContainerStatus_Running {
+			erroredUserServices[serviceUuid] = stacktrace.NewError("Cannot get stats for service '%v' as its container isn't running", serviceUuid)
+			continue
+		}
+
+		statsOperations[operation_parallelizer.OperationID(serviceUuid)] = createStatsOperation(ctx, serviceUuid, container, dockerManager)
+	}
+
+	successfulOperations, failedOperations := operation_parallelizer.RunOperationsInParallel(statsOperations)
+	for operationId, operationResult := range successfulOperations {
+		serviceUuid := service.ServiceUUID(operationId)
+		serviceStats, ok := operationResult.(*service.ServiceStats)
+		if !ok {
+			return nil, nil, stacktrace.NewError("An error occurred processing the stats of service '%v'. It seems the result object is of an unexpected type ('%v'). This is a Kurtosis internal bug.", serviceUuid, reflect.TypeOf(operationResult))
+		}
+		successfulUserServicesStats[serviceUuid] = serviceStats
+	}
+	for operationId, err := range failedOperations {
+		erroredUserServices[service.ServiceUUID(operationId)] = err
+	}
+	return successfulUserServicesStats, erroredUserServices, nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func createStatsOperation(
+	ctx context.Context,
+	serviceUuid service.ServiceUUID,
+	container *types.Container,
+	dockerManager *docker_manager.DockerManager,
+) operation_parallelizer.Operation {
+	return func() (interface{}, error) {
+		containerStats, err := dockerManager.GetContainerStats(ctx, container.GetId())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting stats for container '%v' for user service with UUID '%v'", container.GetName(), serviceUuid)
+		}
+		return newServiceStatsFromDockerStats(containerStats), nil
+	}
+}
+
+// newServiceStatsFromDockerStats computes the stats the same way the 'docker stats' command does
+func newServiceStatsFromDockerStats(containerStats *dockerTypes.StatsJSON) *service.ServiceStats {
+	var cpuUsageMilliCores uint64
+	cpuDelta := float64(containerStats.CPUStats.CPUUsage.TotalUsage) - float64(containerStats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(containerStats.CPUStats.SystemUsage) - float64(containerStats.PreCPUStats.SystemUsage)
+	numCpus := uint64(containerStats.CPUStats.OnlineCPUs)
+	if numCpus == 0 {
+		numCpus = uint64(len(containerStats.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if cpuDelta > 0 && systemDelta > 0 {
+		cpuUsageMilliCores = uint64(cpuDelta / systemDelta * float64(numCpus) * milliCoresInCore)
+	}
+
+	memoryUsageBytes := containerStats.MemoryStats.Usage
+	inactiveFileBytes, found := containerStats.MemoryStats.Stats[cgroupsV1InactiveFileMemoryStatKey]
+	if !found {
+		inactiveFileBytes = containerStats.MemoryStats.Stats[cgroupsV2InactiveFileMemoryStatKey]
+	}
+	if inactiveFileBytes < memoryUsageBytes {
+		memoryUsageBytes -= inactiveFileBytes
+	}
+
+	var networkReceivedBytes, networkSentBytes uint64
+	for _, networkStats := range containerStats.Networks {
+		networkReceivedBytes += networkStats.RxBytes
+		networkSentBytes += networkStats.TxBytes
+	}
+	var diskReadBytes, diskWrittenBytes uint64
+	for _, blkioEntry := range containerStats.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(blkioEntry.Op) {
+		case blkioReadOp:
+			diskReadBytes += blkioEntry.Value
+		case blkioWriteOp:
+			diskWrittenBytes += blkioEntry.Value
+		}
+	}
+
+	ioStats := service.NewServiceIoStats(networkReceivedBytes, networkSentBytes, diskReadBytes, diskWrittenBytes)
+	return service.NewServiceStats(cpuUsageMilliCores, memoryUsageBytes, containerStats.MemoryStats.Limit, ioStats, containerStats.Read)
+}
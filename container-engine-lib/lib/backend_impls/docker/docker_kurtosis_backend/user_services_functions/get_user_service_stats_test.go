@@ -0,0 +1,44 @@
+package user_service_functions
+
+import (
+	dockerTypes "github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewServiceStatsFromDockerStats(t *testing.T) {
+	sampleTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	containerStats := &dockerTypes.StatsJSON{} //nolint:exhaustruct
+	containerStats.Read = sampleTime
+	containerStats.PreCPUStats.CPUUsage.TotalUsage = 1_000
+	containerStats.PreCPUStats.SystemUsage = 10_000
+	// The container used a quarter of the CPU time of the 2 CPUs of the host
+	containerStats.CPUStats.CPUUsage.TotalUsage = 3_500
+	containerStats.CPUStats.SystemUsage = 20_000
+	containerStats.CPUStats.OnlineCPUs = 2
+	containerStats.MemoryStats.Usage = 300
+	containerStats.MemoryStats.Limit = 1_000
+	containerStats.MemoryStats.Stats = map[string]uint64{cgroupsV2InactiveFileMemoryStatKey: 100}
+	containerStats.Networks = map[string]dockerTypes.NetworkStats{
+		"eth0": {RxBytes: 10, TxBytes: 20}, //nolint:exhaustruct
+		"eth1": {RxBytes: 1, TxBytes: 2},   //nolint:exhaustruct
+	}
+	containerStats.BlkioStats.IoServiceBytesRecursive = []dockerTypes.BlkioStatEntry{
+		{Op: "Read", Value: 5},   //nolint:exhaustruct
+		{Op: "write", Value: 7},  //nolint:exhaustruct
+		{Op: "total", Value: 12}, //nolint:exhaustruct
+	}
+
+	serviceStats := newServiceStatsFromDockerStats(containerStats)
+	require.Equal(t, uint64(500), serviceStats.GetCpuUsageMilliCores())
+	require.Equal(t, uint64(200), serviceStats.GetMemoryUsageBytes())
+	require.Equal(t, uint64(1_000), serviceStats.GetMemoryLimitBytes())
+	require.Equal(t, sampleTime, serviceStats.GetSampleTime())
+	ioStats := serviceStats.GetMaybeIoStats()
+	require.NotNil(t, ioStats)
+	require.Equal(t, uint64(11), ioStats.GetNetworkReceivedBytes())
+	require.Equal(t, uint64(22), ioStats.GetNetworkSentBytes())
+	require.Equal(t, uint64(5), ioStats.GetDiskReadBytes())
+	require.Equal(t, uint64(7), ioStats.GetDiskWrittenBytes())
+}
@@ -0,0 +1,221 @@
+package user_service_functions
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Stopped services keep their host ports, so they get them back when they're restarted
+	shouldFetchStoppedContainersWhenGettingHostPortReservations = true
+)
+
+var (
+	// The host ports picked for the services being started, which don't show up in the reservations until the
+	// containers of the services get created
+	// This only covers the services started by this process; if the API container of another enclave picks the same
+	// host port at the same time, Docker fails to start one of the two services
+	reservedHostPorts      = map[uint16]bool{}
+	reservedHostPortsMutex = &sync.Mutex{}
+)
+
+// GetHostPortReservations returns the host ports reserved by the user services of every enclave, which are read off
+// the labels of their containers
+func GetHostPortReservations(ctx context.Context, dockerManager *docker_manager.DockerManager) ([]*port_publish_spec.HostPortReservation, error) {
+	userServiceContainerSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.ContainerTypeDockerLabelKey.GetString(): label_value_consts.UserServiceContainerTypeDockerLabelValue.GetString(),
+	}
+	userServiceContainers, err := dockerManager.GetContainersByLabels(ctx, userServiceContainerSearchLabels, shouldFetchStoppedContainersWhenGettingHostPortReservations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the user service containers of all enclaves")
+	}
+
+	reservations := []*port_publish_spec.HostPortReservation{}
+	for _, userServiceContainer := range userServiceContainers {
+		containerLabels := userServiceContainer.GetLabels()
+		serializedHostPorts, found := containerLabels[docker_label_key.HostPortsDockerLabelKey.GetString()]
+		if !found {
+			continue
+		}
+		hostPortNumbers, err := deserializeHostPortNumbers(serializedHostPorts)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing the host ports of user service container '%v'", userServiceContainer.GetName())
+		}
+		enclaveUuidStr := containerLabels[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()]
+		serviceNameStr := containerLabels[docker_label_key.IDDockerLabelKey.GetString()]
+		for privatePortId, hostPortNumber := range hostPortNumbers {
+			reservations = append(reservations, port_publish_spec.NewHostPortReservation(hostPortNumber, enclaveUuidStr, serviceNameStr, privatePortId))
+		}
+	}
+	return reservations, nil
+}
+
+// Picks the host ports of the private ports published on static or range host ports, keyed by service and by private
+// port ID. Services whose ports can't be published as requested fail, rather than the whole start. The returned
+// function releases the picked host ports, which must be done once the containers of the services exist.
+func reserveHostPorts(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceConfigs map[service.ServiceUUID]*service.ServiceConfig,
+	serviceRegistrations map[service.ServiceUUID]*service.ServiceRegistration,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID]map[string]uint16, map[service.ServiceUUID]error, func(), error) {
+	hostPortNumbersByService := map[service.ServiceUUID]map[string]uint16{}
+	failedServices := map[service.ServiceUUID]error{}
+	pickedHostPorts := map[uint16]bool{}
+	releasePickedHostPorts := func() {
+		reservedHostPortsMutex.Lock()
+		defer reservedHostPortsMutex.Unlock()
+		for hostPortNumber := range pickedHostPorts {
+			delete(reservedHostPorts, hostPortNumber)
+		}
+	}
+
+	isPublishingOnReservedHostPorts := false
+	for _, serviceConfig := range serviceConfigs {
+		for _, portPublishSpec := range serviceConfig.GetPortPublishSpecs() {
+			if portPublishSpec.GetMode() != port_publish_spec.PortPublishMode_Ephemeral {
+				isPublishingOnReservedHostPorts = true
+			}
+		}
+	}
+	if !isPublishingOnReservedHostPorts {
+		return hostPortNumbersByService, failedServices, releasePickedHostPorts, nil
+	}
+
+	reservedHostPortsMutex.Lock()
+	defer reservedHostPortsMutex.Unlock()
+
+	reservations, err := GetHostPortReservations(ctx, dockerManager)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting the host port reservations")
+	}
+	reservationsByHostPort := map[uint16]*port_publish_spec.HostPortReservation{}
+	for _, reservation := range reservations {
+		reservationsByHostPort[reservation.GetHostPortNumber()] = reservation
+	}
+
+	for serviceUuid, serviceConfig := range serviceConfigs {
+		serviceRegistration, found := serviceRegistrations[serviceUuid]
+		if !found {
+			return nil, nil, nil, stacktrace.NewError("Expected to find a registration for service '%v' but none was found; this is a bug in Kurtosis", serviceUuid)
+		}
+		serviceName := string(serviceRegistration.GetName())
+		isHostPortTaken := func(hostPortNumber uint16) bool {
+			if reservedHostPorts[hostPortNumber] {
+				return true
+			}
+			reservation, found := reservationsByHostPort[hostPortNumber]
+			return found && !reservation.IsHeldBy(string(enclaveUuid), serviceName)
+		}
+
+		hostPortNumbers := map[string]uint16{}
+		var serviceErr error
+		for privatePortId, portPublishSpec := range serviceConfig.GetPortPublishSpecs() {
+			if _, found := serviceConfig.GetPrivatePorts()[privatePortId]; !found {
+				serviceErr = stacktrace.NewError("Service '%v' publishes port '%v' on the host, but it has no private port with that ID", serviceName, privatePortId)
+				break
+			}
+			switch portPublishSpec.GetMode() {
+			case port_publish_spec.PortPublishMode_Ephemeral:
+				continue
+			case port_publish_spec.PortPublishMode_Static:
+				hostPortNumber := portPublishSpec.GetHostPortNumber()
+				if isHostPortTaken(hostPortNumber) {
+					serviceErr = getHostPortTakenError(serviceName, privatePortId, hostPortNumber, reservationsByHostPort[hostPortNumber])
+					break
+				}
+				hostPortNumbers[privatePortId] = hostPortNumber
+			case port_publish_spec.PortPublishMode_Range:
+				hostPortRange := portPublishSpec.GetResolvedRange()
+				if hostPortRange == nil {
+					serviceErr = stacktrace.NewError("Port '%v' of service '%v' is published on host port range '%v', which wasn't resolved; this is a bug in Kurtosis", privatePortId, serviceName, portPublishSpec.GetRangeName())
+					break
+				}
+				hostPortNumber, found := getFreeHostPortInRange(hostPortRange, isHostPortTaken, reservations, string(enclaveUuid), serviceName, privatePortId)
+				if !found {
+					serviceErr = stacktrace.NewError("Port '%v' of service '%v' can't be published on host port range '%v' (%v), as all its host ports are reserved", privatePortId, serviceName, portPublishSpec.GetRangeName(), hostPortRange.String())
+					break
+				}
+				hostPortNumbers[privatePortId] = hostPortNumber
+			default:
+				serviceErr = stacktrace.NewError("Port '%v' of service '%v' has unrecognized port publish mode '%v'", privatePortId, serviceName, portPublishSpec.GetMode())
+			}
+			if serviceErr != nil {
+				break
+			}
+			reservedHostPorts[hostPortNumbers[privatePortId]] = true
+			pickedHostPorts[hostPortNumbers[privatePortId]] = true
+		}
+		if serviceErr != nil {
+			failedServices[serviceUuid] = serviceErr
+			continue
+		}
+		hostPortNumbersByService[serviceUuid] = hostPortNumbers
+	}
+	return hostPortNumbersByService, failedServices, releasePickedHostPorts, nil
+}
+
+// The host port the service already holds, if it's in the range, comes first so a restarted service keeps its host port
+func getFreeHostPortInRange(
+	hostPortRange *port_publish_spec.HostPortRange,
+	isHostPortTaken func(uint16) bool,
+	reservations []*port_publish_spec.HostPortReservation,
+	enclaveUuid string,
+	serviceName string,
+	privatePortId string,
+) (uint16, bool) {
+	for _, reservation := range reservations {
+		if reservation.IsHeldBy(enclaveUuid, serviceName) && reservation.GetPrivatePortId() == privatePortId &&
+			hostPortRange.Contains(reservation.GetHostPortNumber()) && !isHostPortTaken(reservation.GetHostPortNumber()) {
+			return reservation.GetHostPortNumber(), true
+		}
+	}
+	for hostPortNumber := uint32(hostPortRange.GetStart()); hostPortNumber <= uint32(hostPortRange.GetEnd()); hostPortNumber++ {
+		if !isHostPortTaken(uint16(hostPortNumber)) {
+			return uint16(hostPortNumber), true
+		}
+	}
+	return 0, false
+}
+
+func getHostPortTakenError(serviceName string, privatePortId string, hostPortNumber uint16, maybeReservation *port_publish_spec.HostPortReservation) error {
+	if maybeReservation == nil {
+		return stacktrace.NewError("Port '%v' of service '%v' can't be published on host port '%v', as another service is being started on it", privatePortId, serviceName, hostPortNumber)
+	}
+	return stacktrace.NewError(
+		"Port '%v' of service '%v' can't be published on host port '%v', as it's reserved by port '%v' of service '%v' in enclave '%v'",
+		privatePortId,
+		serviceName,
+		hostPortNumber,
+		maybeReservation.GetPrivatePortId(),
+		maybeReservation.GetServiceName(),
+		maybeReservation.GetEnclaveUuid(),
+	)
+}
+
+func serializeHostPortNumbers(hostPortNumbers map[string]uint16) (string, error) {
+	serializedHostPortNumbers, err := json.Marshal(hostPortNumbers)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing host ports '%+v'", hostPortNumbers)
+	}
+	return string(serializedHostPortNumbers), nil
+}
+
+func deserializeHostPortNumbers(serializedHostPortNumbers string) (map[string]uint16, error) {
+	hostPortNumbers := map[string]uint16{}
+	if err := json.Unmarshal([]byte(serializedHostPortNumbers), &hostPortNumbers); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing host ports '%v'", serializedHostPortNumbers)
+	}
+	return hostPortNumbers, nil
+}
@@ -0,0 +1,251 @@
+package user_service_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	initContainerSuccessExitCode = 0
+)
+
+// Runs the init containers of a service one after the other, each to completion, before the service container starts
+// NOTE: Docker can't join a container to the network namespace of a container that isn't running yet, so init
+// containers get their own IP on the enclave network rather than sharing the one of the service
+func runUserServiceInitContainers(
+	ctx context.Context,
+	serviceName service.ServiceName,
+	serviceUuid service.ServiceUUID,
+	initContainers []*container_spec.ContainerSpec,
+	enclaveNetworkId string,
+	objAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	freeIpAddrProvider *free_ip_addr_tracker.FreeIpAddrTracker,
+	serviceVolumeMounts map[string]string,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	for _, initContainer := range initContainers {
+		if err := runUserServiceInitContainer(
+			ctx,
+			serviceName,
+			serviceUuid,
+			initContainer,
+			enclaveNetworkId,
+			objAttrsProvider,
+			freeIpAddrProvider,
+			serviceVolumeMounts,
+			dockerManager,
+		); err != nil {
+			return stacktrace.Propagate(err, "An error occurred running init container '%v' of service '%v'", initContainer.GetName(), serviceName)
+		}
+	}
+	return nil
+}
+
+// Starts the sidecars of a service in the network namespace of its container, returning the IDs of the sidecar
+// containers that got started
+// NOTE: It is the caller's responsibility to remove the sidecars if the service fails to start afterwards
+func startUserServiceSidecars(
+	ctx context.Context,
+	serviceName service.ServiceName,
+	serviceUuid service.ServiceUUID,
+	sidecars []*container_spec.ContainerSpec,
+	serviceContainerId string,
+	objAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	serviceVolumeMounts map[string]string,
+	restartPolicy docker_manager.RestartPolicy,
+	restartPolicyMaxRetryCount int,
+	dockerManager *docker_manager.DockerManager,
+) ([]string, error) {
+	sidecarContainerIds := []string{}
+	shouldRemoveSidecars := true
+	defer func() {
+		if !shouldRemoveSidecars {
+			return
+		}
+		for _, sidecarContainerId := range sidecarContainerIds {
+			// Use background context so we remove these even if the input context was cancelled
+			if err := dockerManager.RemoveContainer(context.Background(), sidecarContainerId); err != nil {
+				logrus.Errorf("Starting the sidecars of service '%v' failed so we tried to remove sidecar container with ID '%v' that we started, but doing so threw an error:\n%v", serviceName, sidecarContainerId, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove sidecar container with ID '%v'!", sidecarContainerId)
+			}
+		}
+	}()
+
+	for _, sidecar := range sidecars {
+		containerAttrs, err := objAttrsProvider.ForUserServiceSidecarContainer(serviceName, serviceUuid, sidecar.GetName())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the attributes of sidecar '%v' of service '%v'", sidecar.GetName(), serviceName)
+		}
+		volumeMounts, err := getCompanionContainerVolumeMounts(sidecar, serviceVolumeMounts)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the volumes to mount on sidecar '%v' of service '%v'", sidecar.GetName(), serviceName)
+		}
+
+		createAndStartArgsBuilder := docker_manager.NewCreateAndStartContainerArgsBuilder(
+			sidecar.GetImageName(),
+			containerAttrs.GetName().GetString(),
+			// Unused as the sidecar joins the network namespace of the service container
+			"",
+		).WithNetworkMode(
+			docker_manager.NewContainerNetworkMode(serviceContainerId),
+		).WithEnvironmentVariables(
+			sidecar.GetEnvVars(),
+		).WithLabels(
+			getLabelStrs(containerAttrs),
+		).WithVolumeMounts(
+			volumeMounts,
+		).WithRestartPolicy(
+			restartPolicy,
+		).WithRestartPolicyMaxRetryCount(
+			restartPolicyMaxRetryCount,
+		)
+		if sidecar.GetEntrypointArgs() != nil {
+			createAndStartArgsBuilder.WithEntrypointArgs(sidecar.GetEntrypointArgs())
+		}
+		if sidecar.GetCmdArgs() != nil {
+			createAndStartArgsBuilder.WithCmdArgs(sidecar.GetCmdArgs())
+		}
+
+		sidecarContainerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgsBuilder.Build())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred starting sidecar '%v' of service '%v'", sidecar.GetName(), serviceName)
+		}
+		sidecarContainerIds = append(sidecarContainerIds, sidecarContainerId)
+	}
+
+	shouldRemoveSidecars = false
+	return sidecarContainerIds, nil
+}
+
+func runUserServiceInitContainer(
+	ctx context.Context,
+	serviceName service.ServiceName,
+	serviceUuid service.ServiceUUID,
+	initContainer *container_spec.ContainerSpec,
+	enclaveNetworkId string,
+	objAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	freeIpAddrProvider *free_ip_addr_tracker.FreeIpAddrTracker,
+	serviceVolumeMounts map[string]string,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	containerAttrs, err := objAttrsProvider.ForUserServiceInitContainer(serviceName, serviceUuid, initContainer.GetName())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the attributes of init container '%v'", initContainer.GetName())
+	}
+	containerName := containerAttrs.GetName().GetString()
+
+	volumeMounts, err := getCompanionContainerVolumeMounts(initContainer, serviceVolumeMounts)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the volumes to mount on init container '%v'", containerName)
+	}
+
+	ipAddr, err := freeIpAddrProvider.GetFreeIpAddr()
+	if err != nil {
+		return stacktrace.Propagate(err, "Couldn't get a free IP to give the init container '%v'", containerName)
+	}
+	defer func() {
+		if err := freeIpAddrProvider.ReleaseIpAddr(ipAddr); err != nil {
+			logrus.Errorf("Error releasing IP address '%v'", ipAddr)
+		}
+	}()
+
+	createAndStartArgsBuilder := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		initContainer.GetImageName(),
+		containerName,
+		enclaveNetworkId,
+	).WithStaticIP(
+		ipAddr,
+	).WithEnvironmentVariables(
+		initContainer.GetEnvVars(),
+	).WithVolumeMounts(
+		volumeMounts,
+	).WithLabels(
+		getLabelStrs(containerAttrs),
+	).WithSkipAddingToBridgeNetworkIfStaticIpIsSet(
+		skipAddingToBridgeNetwork,
+	)
+	if initContainer.GetEntrypointArgs() != nil {
+		createAndStartArgsBuilder.WithEntrypointArgs(initContainer.GetEntrypointArgs())
+	}
+	if initContainer.GetCmdArgs() != nil {
+		createAndStartArgsBuilder.WithCmdArgs(initContainer.GetCmdArgs())
+	}
+
+	containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgsBuilder.Build())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating init container '%v'", containerName)
+	}
+	defer func() {
+		// We can remove the init container even if it failed because its logs end up in the error we return
+		if err := dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+			logrus.Errorf("We tried to remove the init container '%v' with ID '%v' that we started, but doing so threw an error:\n%v", containerName, containerId, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to remove init container '%v' manually", containerName)
+		}
+	}()
+
+	exitCode, err := dockerManager.WaitForExit(ctx, containerId)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for init container '%v' to exit", containerName)
+	}
+	if exitCode != initContainerSuccessExitCode {
+		containerLogsBlockStr, err := getFilesArtifactsExpanderContainerLogsBlockStr(ctx, containerId, dockerManager)
+		if err != nil {
+			return stacktrace.NewError(
+				"Init container '%v' finished with non-%v exit code '%v' so we tried to get the logs, but doing so failed with an error:\n%v",
+				containerName,
+				initContainerSuccessExitCode,
+				exitCode,
+				err,
+			)
+		}
+		return stacktrace.NewError(
+			"Init container '%v' finished with non-%v exit code '%v' and logs:\n%v",
+			containerName,
+			initContainerSuccessExitCode,
+			exitCode,
+			containerLogsBlockStr,
+		)
+	}
+	return nil
+}
+
+// Translates the directories an init container or sidecar shares with the service container into the
+// volume_name -> mountpoint map Docker expects, reusing the volumes mounted on the service container
+func getCompanionContainerVolumeMounts(
+	containerSpec *container_spec.ContainerSpec,
+	serviceVolumeMounts map[string]string,
+) (map[string]string, error) {
+	serviceDirpathsToVolumeNames := map[string]string{}
+	for volumeName, serviceDirpath := range serviceVolumeMounts {
+		serviceDirpathsToVolumeNames[serviceDirpath] = volumeName
+	}
+
+	result := map[string]string{}
+	for dirpath, serviceDirpath := range containerSpec.GetDirpathsToServiceDirpaths() {
+		volumeName, found := serviceDirpathsToVolumeNames[serviceDirpath]
+		if !found {
+			return nil, stacktrace.NewError(
+				"Container '%v' wants to mount service directory '%v' on '%v', but no files artifact or persistent directory is mounted on that directory of the service",
+				containerSpec.GetName(),
+				serviceDirpath,
+				dirpath,
+			)
+		}
+		result[volumeName] = dirpath
+	}
+	return result, nil
+}
+
+func getLabelStrs(objAttrs object_attributes_provider.DockerObjectAttributes) map[string]string {
+	labelStrs := map[string]string{}
+	for labelKey, labelValue := range objAttrs.GetLabels() {
+		labelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	return labelStrs
+}
@@ -0,0 +1,25 @@
+package user_service_functions
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_spec"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetCompanionContainerVolumeMounts(t *testing.T) {
+	serviceVolumeMounts := map[string]string{
+		"files-artifact-expansion--1234": "/config",
+		"data-volume":                    "/data",
+	}
+
+	sidecar, err := container_spec.NewContainerSpec("exporter", "alpine:3.19", nil, nil, nil, map[string]string{"/var/lib/data": "/data"})
+	require.NoError(t, err)
+	volumeMounts, err := getCompanionContainerVolumeMounts(sidecar, serviceVolumeMounts)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"data-volume": "/var/lib/data"}, volumeMounts)
+
+	sidecarWithUnknownDirpath, err := container_spec.NewContainerSpec("exporter", "alpine:3.19", nil, nil, nil, map[string]string{"/logs": "/var/log"})
+	require.NoError(t, err)
+	_, err = getCompanionContainerVolumeMounts(sidecarWithUnknownDirpath, serviceVolumeMounts)
+	require.Error(t, err)
+}
@@ -0,0 +1,355 @@
+package user_service_functions
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_network_allocator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/network_helpers"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// The Docker network of a named network is called after the enclave network, e.g. 'kt-my-enclave--backend'
+	namedNetworkDockerNameSeparator = "--"
+
+	shouldFetchStoppedContainersWhenLookingForApiContainer = false
+
+	// Named networks are IPv4 only, even in an enclave with IPv6 enabled
+	doNotEnableIpv6OnNamedNetworks = false
+)
+
+var (
+	// Guards the creation of the named networks, so services started in parallel share them
+	namedNetworkCreationMutex = &sync.Mutex{}
+
+	// The IPs of the services being started on their named network, which Docker doesn't know about until the
+	// containers get connected to it
+	// Key is the network ID
+	reservedNamedNetworkIps      = map[string]map[string]bool{}
+	reservedNamedNetworkIpsMutex = &sync.Mutex{}
+)
+
+// Services with named networks are attached to those networks only, and not to the default enclave network, so they
+// can only reach the services sharing one of their named networks. The API container is attached to every named
+// network, as it needs to reach all the services
+func getOrCreateNamedNetworks(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveNetwork *types.Network,
+	serviceConfigs map[service.ServiceUUID]*service.ServiceConfig,
+	dockerManager *docker_manager.DockerManager,
+	networkAllocator *docker_network_allocator.DockerNetworkAllocator,
+) (map[string]*types.Network, error) {
+	namedNetworks := map[string]*types.Network{}
+	for _, serviceConfig := range serviceConfigs {
+		for _, networkName := range serviceConfig.GetNetworks() {
+			namedNetworks[networkName] = nil
+		}
+	}
+	if len(namedNetworks) == 0 {
+		return namedNetworks, nil
+	}
+
+	namedNetworkCreationMutex.Lock()
+	defer namedNetworkCreationMutex.Unlock()
+
+	existingNetworks, err := getExistingNamedNetworks(ctx, enclaveUuid, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the named networks of enclave '%v'", enclaveUuid)
+	}
+
+	var apiContainerId string
+	for networkName := range namedNetworks {
+		if existingNetwork, found := existingNetworks[networkName]; found {
+			namedNetworks[networkName] = existingNetwork
+			continue
+		}
+
+		if apiContainerId == "" {
+			apiContainerId, err = getApiContainerId(ctx, enclaveUuid, dockerManager)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v', which needs to be attached to the named networks", enclaveUuid)
+			}
+		}
+
+		dockerNetworkName := enclaveNetwork.GetName() + namedNetworkDockerNameSeparator + networkName
+		networkLabels := map[string]string{
+			docker_label_key.EnclaveUUIDDockerLabelKey.GetString():  string(enclaveUuid),
+			docker_label_key.NamedNetworkDockerLabelKey.GetString(): networkName,
+		}
+		networkId, err := networkAllocator.CreateNewNetwork(ctx, dockerNetworkName, networkLabels, doNotEnableIpv6OnNamedNetworks)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating Docker network '%v' for named network '%v'", dockerNetworkName, networkName)
+		}
+		// The API container gets a dynamic IP and no alias, as nothing needs to know its address on the named networks
+		if err := dockerManager.ConnectContainerToNetworkWithAliases(ctx, networkId, apiContainerId, nil, nil); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred connecting the API container to named network '%v'", networkName)
+		}
+		logrus.Debugf("Created Docker network '%v' for named network '%v' of enclave '%v'", dockerNetworkName, networkName, enclaveUuid)
+
+		createdNetworks, err := getExistingNamedNetworks(ctx, enclaveUuid, dockerManager)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the named networks of enclave '%v'", enclaveUuid)
+		}
+		createdNetwork, found := createdNetworks[networkName]
+		if !found {
+			return nil, stacktrace.NewError("Named network '%v' was created with ID '%v' but couldn't be found afterwards; this is a bug in Kurtosis", networkName, networkId)
+		}
+		namedNetworks[networkName] = createdNetwork
+	}
+	return namedNetworks, nil
+}
+
+// Gets the preexisting Docker networks the services are attached to on top of their own networks
+// Kurtosis never creates nor removes these networks
+func getExternalNetworks(
+	ctx context.Context,
+	serviceConfigs map[service.ServiceUUID]*service.ServiceConfig,
+	dockerManager *docker_manager.DockerManager,
+) (map[string]*types.Network, error) {
+	externalNetworks := map[string]*types.Network{}
+	for _, serviceConfig := range serviceConfigs {
+		for _, networkName := range serviceConfig.GetExternalNetworks() {
+			if _, found := externalNetworks[networkName]; found {
+				continue
+			}
+			// Docker matches network names by substring, so we look for the exact one
+			matchingNetworks, err := dockerManager.GetNetworksByName(ctx, networkName)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting external network '%v'", networkName)
+			}
+			for _, matchingNetwork := range matchingNetworks {
+				if matchingNetwork.GetName() == networkName {
+					externalNetworks[networkName] = matchingNetwork
+					break
+				}
+			}
+			if _, found := externalNetworks[networkName]; !found {
+				return nil, stacktrace.NewError("External network '%v' doesn't exist; it needs to be created before starting the services attached to it", networkName)
+			}
+		}
+	}
+	return externalNetworks, nil
+}
+
+// Reserves an IP on the first named network of each service with named networks, as that's the IP the service gets
+// known by
+// The returned function releases the reservations, and should be called once the services are started
+func reserveNamedNetworkIps(
+	ctx context.Context,
+	serviceConfigs map[service.ServiceUUID]*service.ServiceConfig,
+	namedNetworks map[string]*types.Network,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID]net.IP, func(), error) {
+	reservedIps := map[service.ServiceUUID]net.IP{}
+	reservedIpNetworkIds := map[string]string{}
+	releaseReservedIps := func() {
+		reservedNamedNetworkIpsMutex.Lock()
+		defer reservedNamedNetworkIpsMutex.Unlock()
+		for ipAddr, networkId := range reservedIpNetworkIds {
+			delete(reservedNamedNetworkIps[networkId], ipAddr)
+		}
+	}
+
+	reservedNamedNetworkIpsMutex.Lock()
+	defer reservedNamedNetworkIpsMutex.Unlock()
+	shouldReleaseReservedIps := true
+	defer func() {
+		if shouldReleaseReservedIps {
+			for ipAddr, networkId := range reservedIpNetworkIds {
+				delete(reservedNamedNetworkIps[networkId], ipAddr)
+			}
+		}
+	}()
+
+	takenIpsByNetworkId := map[string]map[string]bool{}
+	for serviceUuid, serviceConfig := range serviceConfigs {
+		if len(serviceConfig.GetNetworks()) == 0 {
+			continue
+		}
+		primaryNetworkName := serviceConfig.GetNetworks()[0]
+		primaryNetwork, found := namedNetworks[primaryNetworkName]
+		if !found {
+			return nil, nil, stacktrace.NewError("Named network '%v' of service '%v' wasn't created; this is a bug in Kurtosis", primaryNetworkName, serviceUuid)
+		}
+		networkId := primaryNetwork.GetId()
+
+		takenIps, found := takenIpsByNetworkId[networkId]
+		if !found {
+			connectedIps, err := dockerManager.GetIpAddrsTakenOnNetwork(ctx, networkId)
+			if err != nil {
+				return nil, nil, stacktrace.Propagate(err, "An error occurred getting the IPs taken on named network '%v'", primaryNetworkName)
+			}
+			takenIps = connectedIps
+			takenIps[primaryNetwork.GetIpAndMask().IP.String()] = true
+			takenIps[primaryNetwork.GetGatewayIp()] = true
+			for ipAddr := range reservedNamedNetworkIps[networkId] {
+				takenIps[ipAddr] = true
+			}
+			takenIpsByNetworkId[networkId] = takenIps
+		}
+
+		// This marks the IP as taken too
+		ipAddr, err := network_helpers.GetFreeIpAddrFromSubnet(takenIps, primaryNetwork.GetIpAndMask())
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred getting a free IP on named network '%v' for service '%v'", primaryNetworkName, serviceUuid)
+		}
+		if _, found := reservedNamedNetworkIps[networkId]; !found {
+			reservedNamedNetworkIps[networkId] = map[string]bool{}
+		}
+		reservedNamedNetworkIps[networkId][ipAddr.String()] = true
+		reservedIpNetworkIds[ipAddr.String()] = networkId
+		reservedIps[serviceUuid] = ipAddr
+	}
+	shouldReleaseReservedIps = false
+	return reservedIps, releaseReservedIps, nil
+}
+
+// Attaches the service container to its named networks other than the first one, which the container got created on,
+// and to its external networks
+func connectToSecondaryNetworks(
+	ctx context.Context,
+	containerId string,
+	serviceConfig *service.ServiceConfig,
+	aliases []string,
+	namedNetworks map[string]*types.Network,
+	externalNetworks map[string]*types.Network,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	secondaryNetworks := map[string]*types.Network{}
+	if serviceNetworks := serviceConfig.GetNetworks(); len(serviceNetworks) > 1 {
+		for _, networkName := range serviceNetworks[1:] {
+			secondaryNetworks[networkName] = namedNetworks[networkName]
+		}
+	}
+	for _, networkName := range serviceConfig.GetExternalNetworks() {
+		secondaryNetworks[networkName] = externalNetworks[networkName]
+	}
+
+	for networkName, secondaryNetwork := range secondaryNetworks {
+		if secondaryNetwork == nil {
+			return stacktrace.NewError("Network '%v' wasn't looked up before starting the service; this is a bug in Kurtosis", networkName)
+		}
+		if err := dockerManager.ConnectContainerToNetworkWithAliases(ctx, secondaryNetwork.GetId(), containerId, nil, aliases); err != nil {
+			return stacktrace.Propagate(err, "An error occurred connecting the service container to network '%v'", networkName)
+		}
+	}
+	return nil
+}
+
+// Services with named networks can't reach the API container on the enclave network, so the nameservers set to the
+// address of the API container on the enclave network, i.e. the enclave DNS server, get replaced with its address on
+// the first named network of the service, which the API container is attached to as well
+// Only the configs of the services with such nameservers get copied, the others are returned as they are
+func getServiceConfigsWithNamedNetworkDnsServers(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveNetworkId string,
+	serviceConfigs map[service.ServiceUUID]*service.ServiceConfig,
+	namedNetworks map[string]*types.Network,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID]*service.ServiceConfig, error) {
+	isAnyServiceUsingDnsServersOnNamedNetworks := false
+	for _, serviceConfig := range serviceConfigs {
+		if len(serviceConfig.GetNetworks()) > 0 && len(serviceConfig.GetDnsServers()) > 0 {
+			isAnyServiceUsingDnsServersOnNamedNetworks = true
+		}
+	}
+	if !isAnyServiceUsingDnsServersOnNamedNetworks {
+		return serviceConfigs, nil
+	}
+
+	apiContainerId, err := getApiContainerId(ctx, enclaveUuid, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v'", enclaveUuid)
+	}
+	// Keyed by network ID
+	apiContainerIps, err := dockerManager.GetContainerIps(ctx, apiContainerId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the IPs of the API container of enclave '%v'", enclaveUuid)
+	}
+	apiContainerEnclaveNetworkIp := apiContainerIps[enclaveNetworkId]
+
+	result := map[service.ServiceUUID]*service.ServiceConfig{}
+	for serviceUuid, serviceConfig := range serviceConfigs {
+		result[serviceUuid] = serviceConfig
+		if len(serviceConfig.GetNetworks()) == 0 || len(serviceConfig.GetDnsServers()) == 0 {
+			continue
+		}
+		primaryNetworkName := serviceConfig.GetNetworks()[0]
+		primaryNetwork, found := namedNetworks[primaryNetworkName]
+		if !found {
+			return nil, stacktrace.NewError("Named network '%v' of service '%v' wasn't created; this is a bug in Kurtosis", primaryNetworkName, serviceUuid)
+		}
+		apiContainerNamedNetworkIp, found := apiContainerIps[primaryNetwork.GetId()]
+		if !found {
+			return nil, stacktrace.NewError("The API container of enclave '%v' isn't attached to named network '%v', so service '%v' can't reach the enclave DNS server", enclaveUuid, primaryNetworkName, serviceUuid)
+		}
+		dnsServers := replaceDnsServer(serviceConfig.GetDnsServers(), apiContainerEnclaveNetworkIp, apiContainerNamedNetworkIp)
+		serviceConfigWithDnsServers, err := serviceConfig.Copy()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred copying the config of service '%v'", serviceUuid)
+		}
+		serviceConfigWithDnsServers.SetDnsServers(dnsServers)
+		result[serviceUuid] = serviceConfigWithDnsServers
+	}
+	return result, nil
+}
+
+func replaceDnsServer(dnsServers []string, dnsServerToReplace string, replacement string) []string {
+	result := []string{}
+	for _, dnsServer := range dnsServers {
+		if dnsServer == dnsServerToReplace {
+			result = append(result, replacement)
+			continue
+		}
+		result = append(result, dnsServer)
+	}
+	return result
+}
+
+// Gets the named networks of the enclave, keyed by name
+func getExistingNamedNetworks(ctx context.Context, enclaveUuid enclave.EnclaveUUID, dockerManager *docker_manager.DockerManager) (map[string]*types.Network, error) {
+	// The named networks don't have the app ID label on purpose, so they never get mistaken for the enclave network
+	searchLabels := map[string]string{
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString(): string(enclaveUuid),
+	}
+	networks, err := dockerManager.GetNetworksByLabels(ctx, searchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the networks of enclave '%v'", enclaveUuid)
+	}
+	result := map[string]*types.Network{}
+	for _, network := range networks {
+		networkName, found := network.GetLabels()[docker_label_key.NamedNetworkDockerLabelKey.GetString()]
+		if !found {
+			continue
+		}
+		result[networkName] = network
+	}
+	return result, nil
+}
+
+func getApiContainerId(ctx context.Context, enclaveUuid enclave.EnclaveUUID, dockerManager *docker_manager.DockerManager) (string, error) {
+	searchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString():   string(enclaveUuid),
+		docker_label_key.ContainerTypeDockerLabelKey.GetString(): label_value_consts.APIContainerContainerTypeDockerLabelValue.GetString(),
+	}
+	containers, err := dockerManager.GetContainersByLabels(ctx, searchLabels, shouldFetchStoppedContainersWhenLookingForApiContainer)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v'", enclaveUuid)
+	}
+	if len(containers) != 1 {
+		return "", stacktrace.NewError("Expected exactly one running API container in enclave '%v' but found %d", enclaveUuid, len(containers))
+	}
+	return containers[0].GetId(), nil
+}
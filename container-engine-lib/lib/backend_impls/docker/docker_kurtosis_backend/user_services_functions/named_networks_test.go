@@ -0,0 +1,12 @@
+package user_service_functions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplaceDnsServer(t *testing.T) {
+	require.Equal(t, []string{"172.20.0.2", "1.1.1.1"}, replaceDnsServer([]string{"172.16.0.3", "1.1.1.1"}, "172.16.0.3", "172.20.0.2"))
+	require.Equal(t, []string{"1.1.1.1"}, replaceDnsServer([]string{"1.1.1.1"}, "172.16.0.3", "172.20.0.2"))
+}
@@ -197,12 +197,25 @@ func removeUserServiceDockerResources(
 	}
 
 	kurtosisObjectsToRemoveByContainerId := map[string]*service.Service{}
+	sidecarContainerIdsByContainerId := map[string][]string{}
 	for serviceUuid, resources := range resourcesToRemove {
 		// Safe to skip the is-found check because we verified the map keys are identical earlier
 		serviceObj := serviceObjectsToRemove[serviceUuid]
 
 		containerId := resources.ServiceContainer.GetId()
 		kurtosisObjectsToRemoveByContainerId[containerId] = serviceObj
+		sidecarContainerIdsByContainerId[containerId] = getSidecarContainerIds(resources)
+	}
+
+	var removeContainerOperation docker_operation_parallelizer.DockerOperation = func(
+		ctx context.Context,
+		dockerManager *docker_manager.DockerManager,
+		dockerObjectId string,
+	) error {
+		if err := dockerManager.RemoveContainer(ctx, dockerObjectId); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing container with ID '%v'", dockerObjectId)
+		}
+		return nil
 	}
 
 	// TODO Simplify this with Go generics
@@ -211,6 +224,9 @@ func removeUserServiceDockerResources(
 		dockerManager *docker_manager.DockerManager,
 		dockerObjectId string,
 	) error {
+		if err := runDockerOperationOnSidecarContainers(ctx, dockerManager, sidecarContainerIdsByContainerId[dockerObjectId], removeContainerOperation); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing the sidecars of user service container with ID '%v'", dockerObjectId)
+		}
 		if err := dockerManager.RemoveContainer(ctx, dockerObjectId); err != nil {
 			return stacktrace.Propagate(err, "An error occurred removing user service container with ID '%v'", dockerObjectId)
 		}
@@ -294,3 +310,27 @@ func removeUserServiceDockerResources(
 func extractServiceUUIDFromService(service *service.Service) string {
 	return string(service.GetRegistration().GetUUID())
 }
+
+func getSidecarContainerIds(resources *shared_helpers.UserServiceDockerResources) []string {
+	sidecarContainerIds := []string{}
+	for _, sidecarContainer := range resources.SidecarContainers {
+		sidecarContainerIds = append(sidecarContainerIds, sidecarContainer.GetId())
+	}
+	return sidecarContainerIds
+}
+
+// Sidecars live in the network namespace of the service container, so they get stopped and removed before it and
+// started after it
+func runDockerOperationOnSidecarContainers(
+	ctx context.Context,
+	dockerManager *docker_manager.DockerManager,
+	sidecarContainerIds []string,
+	dockerOperation docker_operation_parallelizer.DockerOperation,
+) error {
+	for _, sidecarContainerId := range sidecarContainerIds {
+		if err := dockerOperation(ctx, dockerManager, sidecarContainerId); err != nil {
+			return stacktrace.Propagate(err, "An error occurred on sidecar container with ID '%v'", sidecarContainerId)
+		}
+	}
+	return nil
+}
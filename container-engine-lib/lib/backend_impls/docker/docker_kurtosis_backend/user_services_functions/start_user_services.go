@@ -3,24 +3,31 @@ package user_service_functions
 import (
 	"context"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_collector_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_settings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
 
 	"github.com/docker/go-connections/nat"
+	"github.com/docker/go-units"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_network_allocator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_operation_parallelizer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
-	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_restart_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/network_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
@@ -31,6 +38,7 @@ const (
 	unlimitedReplacements                = -1
 	skipAddingUserServiceToBridgeNetwork = true
 	emptyImageName                       = ""
+	unlimitedRestartPolicyMaxRetryCount  = 0
 )
 
 func RegisterUserServices(
@@ -124,6 +132,7 @@ func StartRegisteredUserServices(
 	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
 	freeIpProviderForEnclave *free_ip_addr_tracker.FreeIpAddrTracker,
 	dockerManager *docker_manager.DockerManager,
+	networkAllocator *docker_network_allocator.DockerNetworkAllocator,
 	restartPolicy docker_manager.RestartPolicy,
 ) (
 	map[service.ServiceUUID]*service.Service,
@@ -173,26 +182,43 @@ func StartRegisteredUserServices(
 		}
 	}
 
-	//TODO this is a huge hack to temporarily enable static ports for NEAR until we have a more productized solution
-	// Sanity check for port bindings on all services
-	for serviceUuid, serviceConfig := range serviceConfigsToStart {
-		publicPorts := serviceConfig.GetPublicPorts()
-		if len(publicPorts) > 0 {
-			privatePorts := serviceConfig.GetPrivatePorts()
-			err := checkPrivateAndPublicPortsAreOneToOne(privatePorts, publicPorts)
-			if err != nil {
-				failedServicesPool[serviceUuid] = stacktrace.Propagate(err, "Private and public ports for service with UUID '%v' are not one to one.", serviceUuid)
-				delete(serviceConfigsToStart, serviceUuid)
-			}
-		}
+	hostPortNumbers, failedHostPortReservations, releaseHostPorts, err := reserveHostPorts(ctx, enclaveUuid, serviceConfigsToStart, serviceRegistrationsToStart, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred reserving the host ports of the services to start in enclave '%v'", enclaveUuid)
+	}
+	// Once the services are started their host ports show up in the reservations, and the failed ones don't need theirs anymore
+	defer releaseHostPorts()
+	for serviceUuid, reservationErr := range failedHostPortReservations {
+		failedServicesPool[serviceUuid] = stacktrace.Propagate(reservationErr, "An error occurred reserving the host ports of service with UUID '%v'", serviceUuid)
+		delete(serviceConfigsToStart, serviceUuid)
 	}
-	//TODO END huge hack to temporarily enable static ports for NEAR
 
 	enclaveNetwork, err := shared_helpers.GetEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid, dockerManager)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred getting enclave network by enclave ID '%v'", enclaveUuid)
 	}
 	enclaveNetworkID := enclaveNetwork.GetId()
+	// Nil unless the enclave has IPv6 enabled
+	enclaveNetworkIpv6AndMask := enclaveNetwork.GetIpv6AndMask()
+
+	namedNetworks, err := getOrCreateNamedNetworks(ctx, enclaveUuid, enclaveNetwork, serviceConfigsToStart, dockerManager, networkAllocator)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting or creating the named networks of the services to start in enclave '%v'", enclaveUuid)
+	}
+	externalNetworks, err := getExternalNetworks(ctx, serviceConfigsToStart, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the external networks of the services to start in enclave '%v'", enclaveUuid)
+	}
+	namedNetworkIps, releaseNamedNetworkIps, err := reserveNamedNetworkIps(ctx, serviceConfigsToStart, namedNetworks, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred reserving IPs on the named networks of the services to start in enclave '%v'", enclaveUuid)
+	}
+	// Once the services are started their IPs show up on the networks, and the failed ones don't need theirs anymore
+	defer releaseNamedNetworkIps()
+	serviceConfigsToStart, err = getServiceConfigsWithNamedNetworkDnsServers(ctx, enclaveUuid, enclaveNetworkID, serviceConfigsToStart, namedNetworks, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the nameservers of the services to start on named networks in enclave '%v'", enclaveUuid)
+	}
 
 	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
 	if err != nil {
@@ -218,6 +244,11 @@ func StartRegisteredUserServices(
 	successfulStarts, failedStarts, err := runStartServiceOperationsInParallel(
 		ctx,
 		enclaveNetworkID,
+		enclaveNetworkIpv6AndMask,
+		namedNetworks,
+		externalNetworks,
+		namedNetworkIps,
+		hostPortNumbers,
 		serviceConfigsToStart,
 		serviceRegistrations,
 		enclaveObjAttrsProvider,
@@ -293,6 +324,7 @@ func RemoveRegisteredUserServiceProcesses(
 	}
 
 	servicesToStartByContainerId := map[string]*service.Service{}
+	sidecarContainerIdsByContainerId := map[string][]string{}
 	for uuid, serviceResources := range allDockerResources {
 		serviceObj, found := allServiceObjs[uuid]
 		if !found {
@@ -300,6 +332,18 @@ func RemoveRegisteredUserServiceProcesses(
 			return nil, nil, stacktrace.NewError("No service object found for service '%v' that had Docker resources; this is a bug in Kurtosis", uuid)
 		}
 		servicesToStartByContainerId[serviceResources.ServiceContainer.GetId()] = serviceObj
+		sidecarContainerIdsByContainerId[serviceResources.ServiceContainer.GetId()] = getSidecarContainerIds(serviceResources)
+	}
+
+	var removeContainerOperation docker_operation_parallelizer.DockerOperation = func(
+		ctx context.Context,
+		dockerManager *docker_manager.DockerManager,
+		dockerObjectId string,
+	) error {
+		if err := dockerManager.RemoveContainer(ctx, dockerObjectId); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing container with ID '%v'", dockerObjectId)
+		}
+		return nil
 	}
 
 	var dockerOperation docker_operation_parallelizer.DockerOperation = func(
@@ -307,6 +351,9 @@ func RemoveRegisteredUserServiceProcesses(
 		dockerManager *docker_manager.DockerManager,
 		dockerObjectId string,
 	) error {
+		if err := runDockerOperationOnSidecarContainers(ctx, dockerManager, sidecarContainerIdsByContainerId[dockerObjectId], removeContainerOperation); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing the sidecars of user service processes container with ID '%v'", dockerObjectId)
+		}
 		if err := dockerManager.RemoveContainer(ctx, dockerObjectId); err != nil {
 			return stacktrace.Propagate(err, "An error occurred removing user service processes container with ID '%v'", dockerObjectId)
 		}
@@ -376,6 +423,7 @@ func restartUserServices(
 	}
 
 	servicesToStartByContainerId := map[string]*service.Service{}
+	sidecarContainerIdsByContainerId := map[string][]string{}
 	for uuid, serviceResources := range allDockerResources {
 		serviceObj, found := allServiceObjs[uuid]
 		if !found {
@@ -383,6 +431,18 @@ func restartUserServices(
 			return nil, nil, stacktrace.NewError("No service object found for service '%v' that had Docker resources", uuid)
 		}
 		servicesToStartByContainerId[serviceResources.ServiceContainer.GetId()] = serviceObj
+		sidecarContainerIdsByContainerId[serviceResources.ServiceContainer.GetId()] = getSidecarContainerIds(serviceResources)
+	}
+
+	var startContainerOperation docker_operation_parallelizer.DockerOperation = func(
+		ctx context.Context,
+		dockerManager *docker_manager.DockerManager,
+		dockerObjectId string,
+	) error {
+		if err := dockerManager.StartContainer(ctx, dockerObjectId); err != nil {
+			return stacktrace.Propagate(err, "An error occurred starting container with ID '%v'", dockerObjectId)
+		}
+		return nil
 	}
 
 	// TODO PLEAAASE GO GENERICS... but we can't use 1.18 yet because it'll break all Kurtosis clients :(
@@ -394,6 +454,9 @@ func restartUserServices(
 		if err := dockerManager.StartContainer(ctx, dockerObjectId); err != nil {
 			return stacktrace.Propagate(err, "An error occurred starting user service container with ID '%v'", dockerObjectId)
 		}
+		if err := runDockerOperationOnSidecarContainers(ctx, dockerManager, sidecarContainerIdsByContainerId[dockerObjectId], startContainerOperation); err != nil {
+			return stacktrace.Propagate(err, "An error occurred starting the sidecars of user service container with ID '%v'", dockerObjectId)
+		}
 		return nil
 	}
 
@@ -453,6 +516,11 @@ func restartUserServices(
 func runStartServiceOperationsInParallel(
 	ctx context.Context,
 	enclaveNetworkId string,
+	enclaveNetworkIpv6AndMask *net.IPNet,
+	namedNetworks map[string]*types.Network,
+	externalNetworks map[string]*types.Network,
+	namedNetworkIps map[service.ServiceUUID]net.IP,
+	hostPortNumbers map[service.ServiceUUID]map[string]uint16,
 	serviceConfigs map[service.ServiceUUID]*service.ServiceConfig,
 	serviceRegistrations map[service.ServiceUUID]*service.ServiceRegistration,
 	enclaveObjAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
@@ -482,6 +550,11 @@ func runStartServiceOperationsInParallel(
 			config,
 			serviceRegistration,
 			enclaveNetworkId,
+			enclaveNetworkIpv6AndMask,
+			namedNetworks,
+			externalNetworks,
+			namedNetworkIps[serviceUuid],
+			hostPortNumbers[serviceUuid],
 			enclaveObjAttrsProvider,
 			freeIpAddrProvider,
 			dockerManager,
@@ -518,6 +591,12 @@ func createStartServiceOperation(
 	serviceConfig *service.ServiceConfig,
 	serviceRegistration *service.ServiceRegistration,
 	enclaveNetworkId string,
+	enclaveNetworkIpv6AndMask *net.IPNet,
+	namedNetworks map[string]*types.Network,
+	externalNetworks map[string]*types.Network,
+	namedNetworkIp net.IP,
+	// Host ports of the private ports published on static or range host ports, keyed by private port ID
+	hostPortNumbers map[string]uint16,
 	enclaveObjAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
 	freeIpAddrProvider *free_ip_addr_tracker.FreeIpAddrTracker,
 	dockerManager *docker_manager.DockerManager,
@@ -527,22 +606,39 @@ func createStartServiceOperation(
 ) operation_parallelizer.Operation {
 	id := serviceRegistration.GetName()
 	privateIpAddr := serviceRegistration.GetPrivateIP()
+	serviceNetworkId := enclaveNetworkId
+	// Services with named networks are created on the first of them, and are known by their IP there
+	if namedNetworkIp != nil {
+		privateIpAddr = namedNetworkIp
+		serviceNetworkId = namedNetworks[serviceConfig.GetNetworks()[0]].GetId()
+	}
 
 	return func() (interface{}, error) {
 		filesArtifactsExpansion := serviceConfig.GetFilesArtifactsExpansion()
 		persistentDirectories := serviceConfig.GetPersistentDirectories()
 		containerImageName := serviceConfig.GetContainerImageName()
 		privatePorts := serviceConfig.GetPrivatePorts()
-		publicPorts := serviceConfig.GetPublicPorts()
 		entrypointArgs := serviceConfig.GetEntrypointArgs()
 		cmdArgs := serviceConfig.GetCmdArgs()
 		envVars := serviceConfig.GetEnvVars()
 		cpuAllocationMillicpus := serviceConfig.GetCPUAllocationMillicpus()
+		serviceRestartPolicy, restartPolicyMaxRetryCount := getDockerRestartPolicy(serviceConfig.GetRestartPolicy(), restartPolicy)
 		memoryAllocationMegabytes := serviceConfig.GetMemoryAllocationMegabytes()
 		privateIPAddrPlaceholder := serviceConfig.GetPrivateIPAddrPlaceholder()
+		privateIPv6AddrPlaceholder := serviceConfig.GetPrivateIPv6AddrPlaceholder()
 		user := serviceConfig.GetUser()
 		filesToBeMoved := serviceConfig.GetFilesToBeMoved()
 
+		// Services get an IPv6 address on the enclave network if it's a dual-stack one; named networks are IPv4 only
+		var privateIpv6Addr net.IP
+		if enclaveNetworkIpv6AndMask != nil && namedNetworkIp == nil {
+			var err error
+			privateIpv6Addr, err = network_helpers.GetIpv6AddrForIpv4Addr(privateIpAddr, enclaveNetworkIpv6AndMask)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the IPv6 address of user service with UUID '%v'", serviceUUID)
+			}
+		}
+
 		// We replace the placeholder value with the actual private IP address
 		privateIPAddrStr := privateIpAddr.String()
 		for index := range entrypointArgs {
@@ -555,6 +651,24 @@ func createStartServiceOperation(
 			envVars[key] = strings.Replace(envVars[key], privateIPAddrPlaceholder, privateIPAddrStr, unlimitedReplacements)
 		}
 
+		// The IPv6 placeholder gets replaced with an empty string if the service has no IPv6 address, so the
+		// service can tell whether IPv6 is available
+		if privateIPv6AddrPlaceholder != "" {
+			privateIPv6AddrStr := ""
+			if privateIpv6Addr != nil {
+				privateIPv6AddrStr = privateIpv6Addr.String()
+			}
+			for index := range entrypointArgs {
+				entrypointArgs[index] = strings.Replace(entrypointArgs[index], privateIPv6AddrPlaceholder, privateIPv6AddrStr, unlimitedReplacements)
+			}
+			for index := range cmdArgs {
+				cmdArgs[index] = strings.Replace(cmdArgs[index], privateIPv6AddrPlaceholder, privateIPv6AddrStr, unlimitedReplacements)
+			}
+			for key := range envVars {
+				envVars[key] = strings.Replace(envVars[key], privateIPv6AddrPlaceholder, privateIPv6AddrStr, unlimitedReplacements)
+			}
+		}
+
 		// TODO clean this hack up
 		// this path will only be hit if `files_to_be_moved` is set in Starlark; which is a hidden property
 		// used by compose transpilation
@@ -633,6 +747,20 @@ func createStartServiceOperation(
 			}
 		}
 
+		if err := runUserServiceInitContainers(
+			ctx,
+			id,
+			serviceUUID,
+			serviceConfig.GetInitContainers(),
+			enclaveNetworkId,
+			enclaveObjAttrsProvider,
+			freeIpAddrProvider,
+			volumeMounts,
+			dockerManager,
+		); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred running the init containers of user service with UUID '%v'", serviceUUID)
+		}
+
 		containerAttrs, err := enclaveObjAttrsProvider.ForUserServiceContainer(
 			id,
 			serviceUUID,
@@ -649,6 +777,21 @@ func createStartServiceOperation(
 		for labelKey, labelValue := range containerAttrs.GetLabels() {
 			labelStrs[labelKey.GetString()] = labelValue.GetString()
 		}
+		// Lets the backend hide the values of these env vars from the service info and the dumps
+		if secretEnvVarNames := serviceConfig.GetSecretEnvVarNames(); len(secretEnvVarNames) > 0 {
+			labelStrs[docker_label_key.SecretEnvVarNamesDockerLabelKey.GetString()] = shared_helpers.SerializeSecretEnvVarNames(secretEnvVarNames)
+		}
+		if privateIpv6Addr != nil {
+			labelStrs[docker_label_key.PrivateIPv6DockerLabelKey.GetString()] = privateIpv6Addr.String()
+		}
+		// Reserves the host ports for as long as the container exists, across all enclaves
+		if len(hostPortNumbers) > 0 {
+			serializedHostPortNumbers, err := serializeHostPortNumbers(hostPortNumbers)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred serializing the host ports of service '%v'", serviceUUID)
+			}
+			labelStrs[docker_label_key.HostPortsDockerLabelKey.GetString()] = serializedHostPortNumbers
+		}
 
 		dockerUsedPorts := map[nat.Port]docker_manager.PortPublishSpec{}
 		for portId, privatePortSpec := range privatePorts {
@@ -656,13 +799,8 @@ func createStartServiceOperation(
 			if err != nil {
 				return nil, stacktrace.Propagate(err, "An error occurred converting private port spec '%v' to a Docker port", portId)
 			}
-			//TODO this is a huge hack to temporarily enable static ports for NEAR until we have a more productized solution
-			if len(publicPorts) > 0 {
-				publicPortSpec, found := publicPorts[portId]
-				if !found {
-					return nil, stacktrace.NewError("Expected to receive public port with ID '%v' bound to private port number '%v', but it was not found", portId, privatePortSpec.GetNumber())
-				}
-				dockerUsedPorts[dockerPort] = docker_manager.NewManualPublishingSpec(publicPortSpec.GetNumber())
+			if hostPortNumber, found := hostPortNumbers[portId]; found {
+				dockerUsedPorts[dockerPort] = docker_manager.NewManualPublishingSpec(hostPortNumber)
 			} else {
 				dockerUsedPorts[dockerPort] = docker_manager.NewAutomaticPublishingSpec()
 			}
@@ -681,9 +819,11 @@ func createStartServiceOperation(
 		createAndStartArgsBuilder := docker_manager.NewCreateAndStartContainerArgsBuilder(
 			containerImageName,
 			containerName.GetString(),
-			enclaveNetworkId,
+			serviceNetworkId,
 		).WithStaticIP(
 			privateIpAddr,
+		).WithStaticIPv6(
+			privateIpv6Addr,
 		).WithUsedPorts(
 			dockerUsedPorts,
 		).WithEnvironmentVariables(
@@ -705,8 +845,25 @@ func createStartServiceOperation(
 		).WithLoggingDriver(
 			fluentdLoggingDriverCnfg,
 		).WithRestartPolicy(
-			restartPolicy,
-		).WithUser(user)
+			serviceRestartPolicy,
+		).WithRestartPolicyMaxRetryCount(
+			restartPolicyMaxRetryCount,
+		).WithUser(
+			user,
+		).WithFilesToCopyBeforeStart(
+			serviceConfig.GetSecretFiles(),
+		)
+		applyContainerSettings(createAndStartArgsBuilder, serviceConfig.GetContainerSettings())
+
+		aliases := []string{string(id)}
+		// Every replica of a group shares the group name as an alias, so Docker DNS round-robins it across replicas
+		if replicaGroup := serviceConfig.GetReplicaGroup(); replicaGroup != "" {
+			createAndStartArgsBuilder.WithAdditionalAliases([]string{replicaGroup})
+			aliases = append(aliases, replicaGroup)
+		}
+		if dnsServers := serviceConfig.GetDnsServers(); len(dnsServers) > 0 {
+			createAndStartArgsBuilder.WithDnsServers(dnsServers)
+		}
 
 		if entrypointArgs != nil {
 			createAndStartArgsBuilder.WithEntrypointArgs(entrypointArgs)
@@ -739,6 +896,36 @@ func createStartServiceOperation(
 			}
 		}()
 
+		if err := connectToSecondaryNetworks(ctx, containerId, serviceConfig, aliases, namedNetworks, externalNetworks, dockerManager); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred attaching user service with UUID '%v' to its networks", serviceUUID)
+		}
+
+		sidecarContainerIds, err := startUserServiceSidecars(
+			ctx,
+			id,
+			serviceUUID,
+			serviceConfig.GetSidecars(),
+			containerId,
+			enclaveObjAttrsProvider,
+			volumeMounts,
+			serviceRestartPolicy,
+			restartPolicyMaxRetryCount,
+			dockerManager,
+		)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred starting the sidecars of user service with UUID '%v'", serviceUUID)
+		}
+		defer func() {
+			if shouldKillContainer {
+				for _, sidecarContainerId := range sidecarContainerIds {
+					if err := dockerManager.RemoveContainer(context.Background(), sidecarContainerId); err != nil {
+						logrus.Errorf("Launching user service container '%v' didn't complete successfully so we tried to remove sidecar container with ID '%v', but doing so threw an error:\n%v", containerName.GetString(), sidecarContainerId, err)
+						logrus.Errorf("ACTION REQUIRED: You'll need to manually remove sidecar container with ID '%v'!", sidecarContainerId)
+					}
+				}
+			}
+		}()
+
 		_, _, maybePublicIp, maybePublicPortSpecs, err := shared_helpers.GetIpAndPortInfoFromContainer(
 			containerName.GetString(),
 			labelStrs,
@@ -748,8 +935,22 @@ func createStartServiceOperation(
 			return nil, stacktrace.Propagate(err, "An error occurred getting the public IP and ports from container '%v'", containerName)
 		}
 
+		startedServiceRegistration := serviceRegistration
+		if namedNetworkIp != nil || privateIpv6Addr != nil {
+			startedServiceRegistration = service.NewServiceRegistration(
+				serviceRegistration.GetName(),
+				serviceRegistration.GetUUID(),
+				serviceRegistration.GetEnclaveID(),
+				privateIpAddr,
+				serviceRegistration.GetHostname(),
+			)
+			startedServiceRegistration.SetStatus(serviceRegistration.GetStatus())
+			startedServiceRegistration.SetConfig(serviceRegistration.GetConfig())
+			startedServiceRegistration.SetPrivateIPv6(privateIpv6Addr)
+		}
+
 		serviceObjectPtr := service.NewService(
-			serviceRegistration,
+			startedServiceRegistration,
 			privatePorts,
 			maybePublicIp,
 			maybePublicPortSpecs,
@@ -758,7 +959,7 @@ func createStartServiceOperation(
 				containerImageName,
 				entrypointArgs,
 				cmdArgs,
-				envVars),
+				shared_helpers.RedactSecretEnvVars(envVars, shared_helpers.GetSecretEnvVarNamesFromLabels(labelStrs))),
 		)
 
 		shouldDeleteVolumes = false
@@ -811,21 +1012,71 @@ func getUpdatedEntrypointAndCmdFromFilesToBeMoved(ctx context.Context, dockerMan
 	return cmdArgs, entrypointArgs, nil
 }
 
-// Ensure that provided [privatePorts] and [publicPorts] are one to one by checking:
-// - There is a matching publicPort for every portID in privatePorts
-// - There are the same amount of private and public ports
-// If error is nil, the public and private ports are one to one.
-func checkPrivateAndPublicPortsAreOneToOne(privatePorts map[string]*port_spec.PortSpec, publicPorts map[string]*port_spec.PortSpec) error {
-	if len(privatePorts) != len(publicPorts) {
-		return stacktrace.NewError("The received private ports length and the public ports length are not equal. Received '%v' private ports and '%v' public ports", len(privatePorts), len(publicPorts))
+// getDockerRestartPolicy returns the Docker restart policy for the restart policy of the service, and the default one if
+// the service has none
+func getDockerRestartPolicy(serviceRestartPolicy *service_restart_policy.ServiceRestartPolicy, defaultRestartPolicy docker_manager.RestartPolicy) (docker_manager.RestartPolicy, int) {
+	if serviceRestartPolicy == nil {
+		return defaultRestartPolicy, unlimitedRestartPolicyMaxRetryCount
 	}
-
-	for portID, privatePortSpec := range privatePorts {
-		if _, found := publicPorts[portID]; !found {
-			return stacktrace.NewError("Expected to receive public port with ID '%v' bound to private port number '%v', but it was not found", portID, privatePortSpec.GetNumber())
+	switch serviceRestartPolicy.GetMode() {
+	case service_restart_policy.ServiceRestartPolicyMode_Never:
+		return docker_manager.NoRestart, unlimitedRestartPolicyMaxRetryCount
+	case service_restart_policy.ServiceRestartPolicyMode_Always:
+		return docker_manager.RestartAlways, unlimitedRestartPolicyMaxRetryCount
+	case service_restart_policy.ServiceRestartPolicyMode_OnFailure:
+		maxRetries, hasMaxRetries := serviceRestartPolicy.GetMaxRetries()
+		if !hasMaxRetries {
+			return docker_manager.RestartOnFailure, unlimitedRestartPolicyMaxRetryCount
 		}
+		return docker_manager.RestartOnFailure, int(maxRetries)
+	}
+	// Can't happen because the restart policy is validated when it's created
+	return defaultRestartPolicy, unlimitedRestartPolicyMaxRetryCount
+}
+
+// Translates the privileges, capabilities, kernel parameters and special mounts of a service into the matching Docker flags
+func applyContainerSettings(createAndStartArgsBuilder *docker_manager.CreateAndStartContainerArgsBuilder, containerSettings *container_settings.ContainerSettings) {
+	if containerSettings == nil {
+		return
+	}
+
+	addedCapabilities := map[docker_manager.ContainerCapability]bool{}
+	for _, capability := range containerSettings.GetAddedCapabilities() {
+		addedCapabilities[docker_manager.ContainerCapability(capability)] = true
+	}
+	droppedCapabilities := map[docker_manager.ContainerCapability]bool{}
+	for _, capability := range containerSettings.GetDroppedCapabilities() {
+		droppedCapabilities[docker_manager.ContainerCapability(capability)] = true
+	}
+
+	var ulimits []*units.Ulimit
+	for name, ulimit := range containerSettings.GetUlimits() {
+		ulimits = append(ulimits, &units.Ulimit{
+			Name: name,
+			Hard: ulimit.Hard,
+			Soft: ulimit.Soft,
+		})
 	}
-	return nil
+
+	createAndStartArgsBuilder.WithPrivileged(
+		containerSettings.IsPrivileged(),
+	).WithAddedCapabilities(
+		addedCapabilities,
+	).WithDroppedCapabilities(
+		droppedCapabilities,
+	).WithSysctls(
+		containerSettings.GetSysctls(),
+	).WithUlimits(
+		ulimits,
+	).WithShmSizeMegabytes(
+		containerSettings.GetShmSizeMegabytes(),
+	).WithReadOnlyRootFilesystem(
+		containerSettings.IsReadOnlyRootFilesystem(),
+	).WithTmpfs(
+		containerSettings.GetTmpfsDirpathsToSizeMegabytes(),
+	).WithExtraHosts(
+		containerSettings.GetExtraHosts(),
+	)
 }
 
 // Registers a user service for each given serviceName, allocating each an IP and ServiceUUID
@@ -28,6 +28,7 @@ func StopUserServices(
 	}
 
 	servicesToStopByContainerId := map[string]*service.Service{}
+	sidecarContainerIdsByContainerId := map[string][]string{}
 	for uuid, serviceResources := range allDockerResources {
 		serviceObj, found := allServiceObjs[uuid]
 		if !found {
@@ -35,6 +36,18 @@ func StopUserServices(
 			return nil, nil, stacktrace.NewError("No service object found for service '%v' that had Docker resources", uuid)
 		}
 		servicesToStopByContainerId[serviceResources.ServiceContainer.GetId()] = serviceObj
+		sidecarContainerIdsByContainerId[serviceResources.ServiceContainer.GetId()] = getSidecarContainerIds(serviceResources)
+	}
+
+	var killContainerOperation docker_operation_parallelizer.DockerOperation = func(
+		ctx context.Context,
+		dockerManager *docker_manager.DockerManager,
+		dockerObjectId string,
+	) error {
+		if err := dockerManager.KillContainer(ctx, dockerObjectId); err != nil {
+			return stacktrace.Propagate(err, "An error occurred killing container with ID '%v'", dockerObjectId)
+		}
+		return nil
 	}
 
 	// TODO PLEAAASE GO GENERICS... but we can't use 1.18 yet because it'll break all Kurtosis clients :(
@@ -43,6 +56,9 @@ func StopUserServices(
 		dockerManager *docker_manager.DockerManager,
 		dockerObjectId string,
 	) error {
+		if err := runDockerOperationOnSidecarContainers(ctx, dockerManager, sidecarContainerIdsByContainerId[dockerObjectId], killContainerOperation); err != nil {
+			return stacktrace.Propagate(err, "An error occurred killing the sidecars of user service container with ID '%v'", dockerObjectId)
+		}
 		if err := dockerManager.KillContainer(ctx, dockerObjectId); err != nil {
 			return stacktrace.Propagate(err, "An error occurred killing user service container with ID '%v'", dockerObjectId)
 		}
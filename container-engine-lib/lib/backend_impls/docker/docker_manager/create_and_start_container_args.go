@@ -6,6 +6,7 @@ import (
 	"net"
 
 	"github.com/docker/go-connections/nat"
+	"github.com/docker/go-units"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 )
 
@@ -14,11 +15,23 @@ type CreateAndStartContainerArgs struct {
 	dockerImage                              string
 	name                                     string
 	alias                                    string
+	additionalAliases                        []string
 	interactiveModeTtySize                   *InteractiveModeTtySize // If nil interactive mode will be disabled; if non-nil then interactive mode will be enabled
 	networkId                                string
 	staticIp                                 net.IP
+	staticIpv6                               net.IP
 	addedCapabilities                        map[ContainerCapability]bool
 	securityOpts                             map[ContainerSecurityOpt]bool
+	droppedCapabilities                      map[ContainerCapability]bool
+	privileged                               bool
+	sysctls                                  map[string]string
+	ulimits                                  []*units.Ulimit
+	shmSizeMegabytes                         uint64
+	readOnlyRootFilesystem                   bool
+	tmpfsDirpathsToSizeMegabytes             map[string]uint64
+	extraHosts                               map[string]string
+	dnsServers                               []string
+	filesToCopyBeforeStart                   map[string]string
 	networkMode                              DockerManagerNetworkMode
 	usedPorts                                map[nat.Port]PortPublishSpec
 	entrypointArgs                           []string
@@ -34,6 +47,7 @@ type CreateAndStartContainerArgs struct {
 	skipAddingToBridgeNetworkIfStaticIpIsSet bool
 	containerInitEnabled                     bool
 	restartPolicy                            RestartPolicy
+	restartPolicyMaxRetryCount               int
 	imageDownloadMode                        image_download_mode.ImageDownloadMode
 	user                                     *service_user.ServiceUser
 	imageRegistrySpec                        *image_registry_spec.ImageRegistrySpec
@@ -44,11 +58,23 @@ type CreateAndStartContainerArgsBuilder struct {
 	dockerImage                              string
 	name                                     string
 	alias                                    string
+	additionalAliases                        []string
 	interactiveModeTtySize                   *InteractiveModeTtySize // If nil interactive mode will be disabled; if non-nil then interactive mode will be enabled
 	networkId                                string
 	staticIp                                 net.IP
+	staticIpv6                               net.IP
 	addedCapabilities                        map[ContainerCapability]bool
 	securityOpts                             map[ContainerSecurityOpt]bool
+	droppedCapabilities                      map[ContainerCapability]bool
+	privileged                               bool
+	sysctls                                  map[string]string
+	ulimits                                  []*units.Ulimit
+	shmSizeMegabytes                         uint64
+	readOnlyRootFilesystem                   bool
+	tmpfsDirpathsToSizeMegabytes             map[string]uint64
+	extraHosts                               map[string]string
+	dnsServers                               []string
+	filesToCopyBeforeStart                   map[string]string
 	networkMode                              DockerManagerNetworkMode
 	usedPorts                                map[nat.Port]PortPublishSpec
 	entrypointArgs                           []string
@@ -64,6 +90,7 @@ type CreateAndStartContainerArgsBuilder struct {
 	skipAddingToBridgeNetworkIfStaticIpIsSet bool
 	containerInitEnabled                     bool
 	restartPolicy                            RestartPolicy
+	restartPolicyMaxRetryCount               int
 	imageDownloadMode                        image_download_mode.ImageDownloadMode
 	user                                     *service_user.ServiceUser
 	imageRegistrySpec                        *image_registry_spec.ImageRegistrySpec
@@ -81,11 +108,23 @@ func NewCreateAndStartContainerArgsBuilder(dockerImage string, name string, netw
 		dockerImage:                              dockerImage,
 		name:                                     name,
 		alias:                                    "",
+		additionalAliases:                        nil,
 		interactiveModeTtySize:                   nil,
 		networkId:                                networkId,
 		staticIp:                                 nil,
+		staticIpv6:                               nil,
 		addedCapabilities:                        map[ContainerCapability]bool{},
 		securityOpts:                             map[ContainerSecurityOpt]bool{},
+		droppedCapabilities:                      map[ContainerCapability]bool{},
+		privileged:                               false,
+		sysctls:                                  map[string]string{},
+		ulimits:                                  nil,
+		shmSizeMegabytes:                         0,
+		readOnlyRootFilesystem:                   false,
+		tmpfsDirpathsToSizeMegabytes:             map[string]uint64{},
+		extraHosts:                               map[string]string{},
+		dnsServers:                               nil,
+		filesToCopyBeforeStart:                   map[string]string{},
 		networkMode:                              DefaultNetworkMode,
 		usedPorts:                                map[nat.Port]PortPublishSpec{},
 		entrypointArgs:                           nil,
@@ -101,6 +140,7 @@ func NewCreateAndStartContainerArgsBuilder(dockerImage string, name string, netw
 		skipAddingToBridgeNetworkIfStaticIpIsSet: false,
 		containerInitEnabled:                     false,
 		restartPolicy:                            NoRestart,
+		restartPolicyMaxRetryCount:               0,
 		imageDownloadMode:                        image_download_mode.ImageDownloadMode_Missing,
 		user:                                     nil,
 		imageRegistrySpec:                        nil,
@@ -113,11 +153,23 @@ func (builder *CreateAndStartContainerArgsBuilder) Build() *CreateAndStartContai
 		name:                                     builder.name,
 		labels:                                   builder.labels,
 		alias:                                    builder.alias,
+		additionalAliases:                        builder.additionalAliases,
 		interactiveModeTtySize:                   builder.interactiveModeTtySize,
 		networkId:                                builder.networkId,
 		staticIp:                                 builder.staticIp,
+		staticIpv6:                               builder.staticIpv6,
 		addedCapabilities:                        builder.addedCapabilities,
 		securityOpts:                             builder.securityOpts,
+		droppedCapabilities:                      builder.droppedCapabilities,
+		privileged:                               builder.privileged,
+		sysctls:                                  builder.sysctls,
+		ulimits:                                  builder.ulimits,
+		shmSizeMegabytes:                         builder.shmSizeMegabytes,
+		readOnlyRootFilesystem:                   builder.readOnlyRootFilesystem,
+		tmpfsDirpathsToSizeMegabytes:             builder.tmpfsDirpathsToSizeMegabytes,
+		extraHosts:                               builder.extraHosts,
+		dnsServers:                               builder.dnsServers,
+		filesToCopyBeforeStart:                   builder.filesToCopyBeforeStart,
 		networkMode:                              builder.networkMode,
 		usedPorts:                                builder.usedPorts,
 		entrypointArgs:                           builder.entrypointArgs,
@@ -132,6 +184,7 @@ func (builder *CreateAndStartContainerArgsBuilder) Build() *CreateAndStartContai
 		skipAddingToBridgeNetworkIfStaticIpIsSet: builder.skipAddingToBridgeNetworkIfStaticIpIsSet,
 		containerInitEnabled:                     builder.containerInitEnabled,
 		restartPolicy:                            builder.restartPolicy,
+		restartPolicyMaxRetryCount:               builder.restartPolicyMaxRetryCount,
 		imageDownloadMode:                        builder.imageDownloadMode,
 		user:                                     builder.user,
 		imageRegistrySpec:                        builder.imageRegistrySpec,
@@ -144,6 +197,13 @@ func (builder *CreateAndStartContainerArgsBuilder) WithAlias(alias string) *Crea
 	return builder
 }
 
+// Extra aliases to give the container on its network; several containers can share an alias, in which case Docker
+// resolves it to all of them in round-robin order
+func (builder *CreateAndStartContainerArgsBuilder) WithAdditionalAliases(additionalAliases []string) *CreateAndStartContainerArgsBuilder {
+	builder.additionalAliases = additionalAliases
+	return builder
+}
+
 // If non-nil, the container will be started in interactive mode, with a container TTY
 // set to the specified dimensions
 func (builder *CreateAndStartContainerArgsBuilder) WithInteractiveModeTtySize(size *InteractiveModeTtySize) *CreateAndStartContainerArgsBuilder {
@@ -157,6 +217,13 @@ func (builder *CreateAndStartContainerArgsBuilder) WithStaticIP(ip net.IP) *Crea
 	return builder
 }
 
+// IPv6 IP the container will be assigned on a dual-stack network; only used together with a static IP (leave nil to let
+// Docker pick one)
+func (builder *CreateAndStartContainerArgsBuilder) WithStaticIPv6(ip net.IP) *CreateAndStartContainerArgsBuilder {
+	builder.staticIpv6 = ip
+	return builder
+}
+
 // A "set" of capabilities to add to the container, corresponding to the --cap-add Docker flag
 // For more info, see the --cap-add section of https://docs.docker.com/engine/reference/run/
 func (builder *CreateAndStartContainerArgsBuilder) WithAddedCapabilities(capabilities map[ContainerCapability]bool) *CreateAndStartContainerArgsBuilder {
@@ -171,6 +238,70 @@ func (builder *CreateAndStartContainerArgsBuilder) WithSecurityOpts(securityOpts
 	return builder
 }
 
+// A "set" of capabilities to drop from the container, corresponding to the --cap-drop Docker flag
+// For more info, see the --cap-drop section of https://docs.docker.com/engine/reference/run/
+func (builder *CreateAndStartContainerArgsBuilder) WithDroppedCapabilities(capabilities map[ContainerCapability]bool) *CreateAndStartContainerArgsBuilder {
+	builder.droppedCapabilities = capabilities
+	return builder
+}
+
+// Gives the container all the capabilities and access to all the devices of the host, corresponding to the --privileged Docker flag
+func (builder *CreateAndStartContainerArgsBuilder) WithPrivileged(privileged bool) *CreateAndStartContainerArgsBuilder {
+	builder.privileged = privileged
+	return builder
+}
+
+// Namespaced kernel parameters to set in the container, corresponding to the --sysctl Docker flag
+func (builder *CreateAndStartContainerArgsBuilder) WithSysctls(sysctls map[string]string) *CreateAndStartContainerArgsBuilder {
+	builder.sysctls = sysctls
+	return builder
+}
+
+// Limits on the resources of the processes of the container, corresponding to the --ulimit Docker flag
+func (builder *CreateAndStartContainerArgsBuilder) WithUlimits(ulimits []*units.Ulimit) *CreateAndStartContainerArgsBuilder {
+	builder.ulimits = ulimits
+	return builder
+}
+
+// Size of /dev/shm in megabytes, corresponding to the --shm-size Docker flag
+// 0 is the empty value, meaning if the value is 0, Docker's default size is used
+func (builder *CreateAndStartContainerArgsBuilder) WithShmSizeMegabytes(shmSizeMegabytes uint64) *CreateAndStartContainerArgsBuilder {
+	builder.shmSizeMegabytes = shmSizeMegabytes
+	return builder
+}
+
+// Mounts the root filesystem of the container as read only, corresponding to the --read-only Docker flag
+func (builder *CreateAndStartContainerArgsBuilder) WithReadOnlyRootFilesystem(readOnlyRootFilesystem bool) *CreateAndStartContainerArgsBuilder {
+	builder.readOnlyRootFilesystem = readOnlyRootFilesystem
+	return builder
+}
+
+// Mapping of (mountpoint on container) -> (max size in megabytes) of the in-memory filesystems to mount, corresponding
+// to the --tmpfs Docker flag. A size of 0 means Docker's default size
+func (builder *CreateAndStartContainerArgsBuilder) WithTmpfs(tmpfsDirpathsToSizeMegabytes map[string]uint64) *CreateAndStartContainerArgsBuilder {
+	builder.tmpfsDirpathsToSizeMegabytes = tmpfsDirpathsToSizeMegabytes
+	return builder
+}
+
+// Mapping of (hostname) -> (IP address) added to the /etc/hosts of the container, corresponding to the --add-host Docker flag
+func (builder *CreateAndStartContainerArgsBuilder) WithExtraHosts(extraHosts map[string]string) *CreateAndStartContainerArgsBuilder {
+	builder.extraHosts = extraHosts
+	return builder
+}
+
+// IP addresses of the nameservers the container resolves hostnames with, instead of the ones of the Docker host
+func (builder *CreateAndStartContainerArgsBuilder) WithDnsServers(dnsServers []string) *CreateAndStartContainerArgsBuilder {
+	builder.dnsServers = dnsServers
+	return builder
+}
+
+// Mapping of (filepath on container) -> (file content) of the files written to the container after it gets created and
+// before it starts, so their content never shows up in the container spec
+func (builder *CreateAndStartContainerArgsBuilder) WithFilesToCopyBeforeStart(filesToCopyBeforeStart map[string]string) *CreateAndStartContainerArgsBuilder {
+	builder.filesToCopyBeforeStart = filesToCopyBeforeStart
+	return builder
+}
+
 // When a non-empty string, sets the Docker --network flag to be this given string
 func (builder *CreateAndStartContainerArgsBuilder) WithNetworkMode(mode DockerManagerNetworkMode) *CreateAndStartContainerArgsBuilder {
 	builder.networkMode = mode
@@ -255,6 +386,12 @@ func (builder *CreateAndStartContainerArgsBuilder) WithRestartPolicy(restartPoli
 	return builder
 }
 
+// Will configure how many times at most the container gets restarted, only with the restart on failure policy (0 means no limit)
+func (builder *CreateAndStartContainerArgsBuilder) WithRestartPolicyMaxRetryCount(restartPolicyMaxRetryCount int) *CreateAndStartContainerArgsBuilder {
+	builder.restartPolicyMaxRetryCount = restartPolicyMaxRetryCount
+	return builder
+}
+
 // WithSkipAddingToBridgeNetworkIfStaticIpIsSet Allows you to skip adding the container to the bridge network assuming the static ip address is set
 // With this option set to false
 // 1. We connect a container to the bridge network by default when it starts
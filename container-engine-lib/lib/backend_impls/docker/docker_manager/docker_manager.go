@@ -6,6 +6,7 @@
 package docker_manager
 
 import (
+	"archive/tar"
 	"bufio"
 	"bytes"
 	"context"
@@ -80,6 +81,15 @@ const (
 	//  bound in Docker for Linux
 	hostMachineDomainInsideContainer = "host.docker.internal"
 
+	// Name of the Docker tmpfs mount option limiting its size in bytes
+	tmpfsSizeOptionName = "size"
+
+	// Files copied to a container before it starts are extracted from a TAR archive at the root of its filesystem, and
+	// are readable by any user as the container may not run as root
+	containerRootDirpath  = "/"
+	copiedFilePerms       = 0444
+	shouldCopyFilesUidGid = false
+
 	// hostGatewayName is the string value that Docker will replace by
 	// the value of HostGatewayIP daemon config value
 	hostGatewayName = "host-gateway"
@@ -157,6 +167,14 @@ const (
 	buildkitSessionSharedKey = ""
 
 	nixCmdPath = "/nix/var/nix/profiles/default/bin/nix"
+
+	// Same reason as Kubernetes gives, so that it reads the same on both backends
+	oomKilledExitReason = "OOMKilled"
+
+	// Docker doubles the delay before restarting a container every time it restarts it, and only resets that delay
+	// once the container ran for that long. The first delays being shorter than the interval the containers get
+	// looked at, a container restarted within that window is still crash looping even if it's running
+	restartBackoffResetWindow = 10 * time.Second
 )
 
 type RestartPolicy string
@@ -228,25 +246,37 @@ Args:
 	name: The name to give the new Docker network
 	subnetMask: The subnet mask defining allowed IPs for the Docker network
 	gatewayIP: The IP to give the network gateway
+	ipv6SubnetMask: The IPv6 subnet mask defining allowed IPv6 IPs for the Docker network, turning it into a dual-stack
+		network; empty if the network is IPv4 only
 	labels: Labels to give the network object
 
 Returns:
 
 	id: The Docker-managed ID of the network
 */
-func (manager *DockerManager) CreateNetwork(context context.Context, name string, subnetMask string, gatewayIP net.IP, labels map[string]string) (id string, err error) {
+func (manager *DockerManager) CreateNetwork(context context.Context, name string, subnetMask string, gatewayIP net.IP, ipv6SubnetMask string, labels map[string]string) (id string, err error) {
 	ipamConfig := []network.IPAMConfig{{
 		Subnet:     subnetMask,
 		IPRange:    "",
 		Gateway:    gatewayIP.String(),
 		AuxAddress: nil,
 	}}
+	isIpv6Enabled := ipv6SubnetMask != ""
+	if isIpv6Enabled {
+		// We let Docker pick the IPv6 gateway, which is the first IP of the subnet
+		ipamConfig = append(ipamConfig, network.IPAMConfig{
+			Subnet:     ipv6SubnetMask,
+			IPRange:    "",
+			Gateway:    "",
+			AuxAddress: nil,
+		})
+	}
 
 	resp, err := manager.dockerClient.NetworkCreate(context, name, types.NetworkCreate{
 		CheckDuplicate: false,
 		Driver:         dockerNetworkDriver,
 		Scope:          "",
-		EnableIPv6:     false,
+		EnableIPv6:     isIpv6Enabled,
 		IPAM: &network.IPAM{
 			Driver:  "",
 			Options: nil,
@@ -393,6 +423,29 @@ func (manager *DockerManager) GetContainerIdsConnectedToNetwork(context context.
 	return result, nil
 }
 
+// GetIpAddrsTakenOnNetwork returns the IPv4 addresses of the containers connected to the network
+func (manager *DockerManager) GetIpAddrsTakenOnNetwork(ctx context.Context, networkId string) (map[string]bool, error) {
+	inspectResponse, err := manager.dockerClient.NetworkInspect(ctx, networkId, types.NetworkInspectOptions{
+		Scope:   "",
+		Verbose: false,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get network information for network with ID '%v'", networkId)
+	}
+	result := map[string]bool{}
+	for containerId, endpoint := range inspectResponse.Containers {
+		if endpoint.IPv4Address == "" {
+			continue
+		}
+		ipAddr, _, err := net.ParseCIDR(endpoint.IPv4Address)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing IP address '%v' of container '%v' on network '%v'", endpoint.IPv4Address, containerId, networkId)
+		}
+		result[ipAddr.String()] = true
+	}
+	return result, nil
+}
+
 /*
 RemoveNetwork
 Removes the Docker network with the given id
@@ -584,6 +637,15 @@ func (manager *DockerManager) CreateAndStartContainer(
 	containerHostConfigPtr, err := manager.getContainerHostConfig(
 		args.addedCapabilities,
 		args.securityOpts,
+		args.droppedCapabilities,
+		args.privileged,
+		args.sysctls,
+		args.ulimits,
+		args.shmSizeMegabytes,
+		args.readOnlyRootFilesystem,
+		args.tmpfsDirpathsToSizeMegabytes,
+		args.extraHosts,
+		args.dnsServers,
 		args.networkMode,
 		args.bindMounts,
 		args.volumeMounts,
@@ -593,7 +655,8 @@ func (manager *DockerManager) CreateAndStartContainer(
 		args.memoryAllocationMegabytes,
 		args.loggingDriverConfig,
 		args.containerInitEnabled,
-		args.restartPolicy)
+		args.restartPolicy,
+		args.restartPolicyMaxRetryCount)
 	if err != nil {
 		return "", nil, stacktrace.Propagate(err, "Failed to configure host to container mappings from service.")
 	}
@@ -601,7 +664,11 @@ func (manager *DockerManager) CreateAndStartContainer(
 	// note a nil network config would connect to bridge network by default
 	var networkConfig *network.NetworkingConfig
 	if args.staticIp != nil && args.skipAddingToBridgeNetworkIfStaticIpIsSet {
-		targetNetworkEndPointSettings := getEndpointSettingsForIpAddress(args.staticIp.String(), args.alias)
+		staticIpv6AddressStr := ""
+		if args.staticIpv6 != nil {
+			staticIpv6AddressStr = args.staticIpv6.String()
+		}
+		targetNetworkEndPointSettings := getEndpointSettingsForIpAddress(args.staticIp.String(), staticIpv6AddressStr, append([]string{args.alias}, args.additionalAliases...))
 		endpointSettingsByNetworkId := map[string]*network.EndpointSettings{}
 		endpointSettingsByNetworkId[args.networkId] = targetNetworkEndPointSettings
 		networkConfig = &network.NetworkingConfig{
@@ -633,12 +700,18 @@ func (manager *DockerManager) CreateAndStartContainer(
 	// static ip is provided and the user wants the connection to bridge network to happen
 	// in the container start the bridge network got connected and now we connect to target network
 	if args.staticIp != nil && !args.skipAddingToBridgeNetworkIfStaticIpIsSet {
-		if err = manager.ConnectContainerToNetwork(ctx, args.networkId, containerId, args.staticIp, args.alias); err != nil {
+		if err = manager.connectContainerToNetwork(ctx, args.networkId, containerId, args.staticIp, args.staticIpv6, append([]string{args.alias}, args.additionalAliases...)); err != nil {
 			return "", nil, stacktrace.Propagate(err, "Failed to connect container %s to network.", containerId)
 		}
 	}
 	// TODO defer a disconnct-from-network if this function doesn't succeed??
 
+	if len(args.filesToCopyBeforeStart) > 0 {
+		if err = manager.copyFilesToContainer(ctx, containerId, args.filesToCopyBeforeStart); err != nil {
+			return "", nil, stacktrace.Propagate(err, "An error occurred copying files to container '%v' before starting it", containerId)
+		}
+	}
+
 	err = manager.StartContainer(ctx, containerId)
 	if err != nil {
 		return "", nil, stacktrace.Propagate(err, "Could not start Docker container from image '%v'.", dockerImage)
@@ -989,6 +1062,22 @@ func (manager *DockerManager) GetContainerLogs(
 	return readCloser, nil
 }
 
+// GetContainerStats samples the resource usage of the container, waiting for Docker to take two samples so that the
+// CPU usage can be computed from the difference between them
+func (manager *DockerManager) GetContainerStats(ctx context.Context, containerId string) (*types.StatsJSON, error) {
+	containerStatsResponse, err := manager.dockerClient.ContainerStats(ctx, containerId, dontStreamStats)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the stats of container with ID '%v'", containerId)
+	}
+	defer containerStatsResponse.Body.Close()
+
+	containerStats := new(types.StatsJSON)
+	if err = json.NewDecoder(containerStatsResponse.Body).Decode(containerStats); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the stats of container with ID '%v'", containerId)
+	}
+	return containerStats, nil
+}
+
 /*
 RunExecCommand
 Executes the given command inside the container with the given ID, blocking until the command completes
@@ -1159,6 +1248,14 @@ Connects the container with the given container ID to the network with the given
 If the IP address passed is nil then we get a random ip address
 */
 func (manager *DockerManager) ConnectContainerToNetwork(ctx context.Context, networkId string, containerId string, staticIpAddr net.IP, alias string) (err error) {
+	return manager.connectContainerToNetwork(ctx, networkId, containerId, staticIpAddr, nil, []string{alias})
+}
+
+func (manager *DockerManager) ConnectContainerToNetworkWithAliases(ctx context.Context, networkId string, containerId string, staticIpAddr net.IP, aliases []string) error {
+	return manager.connectContainerToNetwork(ctx, networkId, containerId, staticIpAddr, nil, aliases)
+}
+
+func (manager *DockerManager) connectContainerToNetwork(ctx context.Context, networkId string, containerId string, staticIpAddr net.IP, staticIpv6Addr net.IP, aliases []string) error {
 	logrus.Tracef(
 		"Connecting container ID %v to network ID %v using static IP %v",
 		containerId,
@@ -1169,10 +1266,14 @@ func (manager *DockerManager) ConnectContainerToNetwork(ctx context.Context, net
 	if staticIpAddr != nil {
 		staticIpAddressStr = staticIpAddr.String()
 	}
+	staticIpv6AddressStr := ""
+	if staticIpv6Addr != nil {
+		staticIpv6AddressStr = staticIpv6Addr.String()
+	}
 
-	config := getEndpointSettingsForIpAddress(staticIpAddressStr, alias)
+	config := getEndpointSettingsForIpAddress(staticIpAddressStr, staticIpv6AddressStr, aliases)
 
-	err = manager.dockerClient.NetworkConnect(
+	err := manager.dockerClient.NetworkConnect(
 		ctx,
 		networkId,
 		containerId,
@@ -1565,6 +1666,38 @@ func (manager *DockerManager) GetAvailableCPUAndMemory(ctx context.Context) (com
 //	INSTANCE HELPER FUNCTIONS
 //
 // =================================================================================================================
+// The parent directories of the files get created when Docker extracts the archive
+func (manager *DockerManager) copyFilesToContainer(ctx context.Context, containerId string, filepathsToContent map[string]string) error {
+	tarBuffer := &bytes.Buffer{}
+	tarWriter := tar.NewWriter(tarBuffer)
+	for filepath, content := range filepathsToContent {
+		// nolint: exhaustruct
+		header := &tar.Header{
+			Name: strings.TrimPrefix(filepath, containerRootDirpath),
+			Mode: copiedFilePerms,
+			Size: int64(len(content)),
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the TAR header of file '%v'", filepath)
+		}
+		if _, err := tarWriter.Write([]byte(content)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the content of file '%v' to the TAR archive", filepath)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the TAR archive of the files to copy")
+	}
+
+	copyOptions := types.CopyToContainerOptions{
+		AllowOverwriteDirWithFile: false,
+		CopyUIDGID:                shouldCopyFilesUidGid,
+	}
+	if err := manager.dockerClient.CopyToContainer(ctx, containerId, containerRootDirpath, tarBuffer, copyOptions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the files to container '%v'", containerId)
+	}
+	return nil
+}
+
 func (manager *DockerManager) createPersistentVolumeInternal(context context.Context, volumeConfig volume.CreateOptions) error {
 	/*
 		We don't use the return value of VolumeCreate because there's not much useful information on there - Docker doesn't
@@ -1660,6 +1793,16 @@ func (manager *DockerManager) getImagePlatform(ctx context.Context, imageName st
 	return imageInspect.Architecture, nil
 }
 
+// GetImageRepoDigests returns the digest-pinned references of the image, which is empty for images which were built
+// locally and never pushed to or pulled from a registry
+func (manager *DockerManager) GetImageRepoDigests(ctx context.Context, imageId string) ([]string, error) {
+	imageInspect, _, err := manager.dockerClient.ImageInspectWithRaw(ctx, imageId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "an error occurred while running image inspect on image '%v'", imageId)
+	}
+	return imageInspect.RepoDigests, nil
+}
+
 func (manager *DockerManager) GetEntryPointAndCommand(ctx context.Context, imageName string) ([]string, []string, error) {
 	imageInspect, _, err := manager.dockerClient.ImageInspectWithRaw(ctx, imageName)
 	if err != nil {
@@ -1686,10 +1829,24 @@ Args:
 		host machine (if at all)
 	needsToAccessDockerHostMachine: If true, adds a "host.docker.internal:host-gateway" extra host binding, which is necessary
 		for machines that will need to access the machine hosting Docker itself.
+	tmpfsDirpathsToSizeMegabytes: Mapping of (mountpoint on container) -> (max size in megabytes) of in-memory filesystems to
+		mount; a size of 0 means Docker's default size
+	extraHosts: Mapping of (hostname) -> (IP address) added to the /etc/hosts of the container
+	dnsServers: IP addresses of the nameservers of the container; Docker's embedded DNS server still resolves the names
+		of the containers of the network and forwards every other query to them
 */
 func (manager *DockerManager) getContainerHostConfig(
 	addedCapabilities map[ContainerCapability]bool,
 	securityOpts map[ContainerSecurityOpt]bool,
+	droppedCapabilities map[ContainerCapability]bool,
+	privileged bool,
+	sysctls map[string]string,
+	ulimits []*units.Ulimit,
+	shmSizeMegabytes uint64,
+	readOnlyRootFilesystem bool,
+	tmpfsDirpathsToSizeMegabytes map[string]uint64,
+	extraHostsToIpAddrs map[string]string,
+	dnsServers []string,
 	networkMode DockerManagerNetworkMode,
 	bindMounts map[string]string,
 	volumeMounts map[string]string,
@@ -1700,6 +1857,7 @@ func (manager *DockerManager) getContainerHostConfig(
 	loggingDriverConfig LoggingDriver,
 	useInit bool,
 	restartPolicy RestartPolicy,
+	restartPolicyMaxRetryCount int,
 ) (hostConfig *container.HostConfig, err error) {
 
 	bindsList := make([]string, 0, len(bindMounts))
@@ -1754,6 +1912,12 @@ func (manager *DockerManager) getContainerHostConfig(
 		addedCapabilitiesSlice = append(addedCapabilitiesSlice, capabilityStr)
 	}
 
+	droppedCapabilitiesSlice := []string{}
+	for capability := range droppedCapabilities {
+		capabilityStr := string(capability)
+		droppedCapabilitiesSlice = append(droppedCapabilitiesSlice, capabilityStr)
+	}
+
 	securityOptsSlice := []string{}
 	for securityOpt := range securityOpts {
 		securityOptStr := string(securityOpt)
@@ -1769,6 +1933,21 @@ func (manager *DockerManager) getContainerHostConfig(
 			fmt.Sprintf("%v:%v", hostMachineDomainInsideContainer, hostGatewayName),
 		)
 	}
+	for hostname, ipAddr := range extraHostsToIpAddrs {
+		extraHosts = append(extraHosts, fmt.Sprintf("%v:%v", hostname, ipAddr))
+	}
+
+	var tmpfs map[string]string
+	if len(tmpfsDirpathsToSizeMegabytes) > 0 {
+		tmpfs = map[string]string{}
+		for dirpath, sizeMegabytes := range tmpfsDirpathsToSizeMegabytes {
+			tmpfsOptions := ""
+			if sizeMegabytes != 0 {
+				tmpfsOptions = fmt.Sprintf("%v=%v", tmpfsSizeOptionName, convertMegabytesToBytes(sizeMegabytes))
+			}
+			tmpfs[dirpath] = tmpfsOptions
+		}
+	}
 
 	resources := container.Resources{
 		CPUShares:            0,
@@ -1797,7 +1976,7 @@ func (manager *DockerManager) getContainerHostConfig(
 		MemorySwappiness:     nil,
 		OomKillDisable:       nil,
 		PidsLimit:            nil,
-		Ulimits:              nil,
+		Ulimits:              ulimits,
 		CPUCount:             0,
 		CPUPercent:           0,
 		IOMaximumIOps:        0,
@@ -1839,16 +2018,16 @@ func (manager *DockerManager) getContainerHostConfig(
 		PortBindings:    portMap,
 		RestartPolicy: container.RestartPolicy{
 			Name:              string(restartPolicy),
-			MaximumRetryCount: 0,
+			MaximumRetryCount: restartPolicyMaxRetryCount,
 		},
 		AutoRemove:      false,
 		VolumeDriver:    "",
 		VolumesFrom:     nil,
 		Annotations:     map[string]string{},
 		CapAdd:          addedCapabilitiesSlice,
-		CapDrop:         nil,
+		CapDrop:         droppedCapabilitiesSlice,
 		CgroupnsMode:    "",
-		DNS:             nil,
+		DNS:             dnsServers,
 		DNSOptions:      nil,
 		DNSSearch:       nil,
 		ExtraHosts:      extraHosts,
@@ -1858,16 +2037,16 @@ func (manager *DockerManager) getContainerHostConfig(
 		Links:           nil,
 		OomScoreAdj:     0,
 		PidMode:         "",
-		Privileged:      false,
+		Privileged:      privileged,
 		PublishAllPorts: false,
-		ReadonlyRootfs:  false,
+		ReadonlyRootfs:  readOnlyRootFilesystem,
 		SecurityOpt:     securityOptsSlice,
 		StorageOpt:      nil,
-		Tmpfs:           nil,
+		Tmpfs:           tmpfs,
 		UTSMode:         "",
 		UsernsMode:      "",
-		ShmSize:         0,
-		Sysctls:         nil,
+		ShmSize:         int64(convertMegabytesToBytes(shmSizeMegabytes)),
+		Sysctls:         sysctls,
 		Runtime:         "",
 		ConsoleSize:     [2]uint{},
 		Isolation:       "",
@@ -2114,11 +2293,50 @@ func newContainerFromDockerContainer(dockerContainer types.ContainerJSON) (*dock
 		dockerContainer.Config.Entrypoint,
 		dockerContainer.Config.Cmd,
 		containerEnvArgs,
+		dockerContainer.State.ExitCode,
+		dockerContainer.RestartCount,
+		getLastExitReasonFromDockerContainerState(dockerContainer.State),
+		isDockerContainerCrashLooping(dockerContainer, time.Now()),
 	)
 
 	return newContainer, nil
 }
 
+func isDockerContainerCrashLooping(dockerContainer types.ContainerJSON, now time.Time) bool {
+	if dockerContainer.State == nil {
+		return false
+	}
+	if dockerContainer.State.Restarting {
+		return true
+	}
+	if dockerContainer.RestartCount == 0 || !dockerContainer.State.Running {
+		return false
+	}
+	startedAt, err := time.Parse(time.RFC3339Nano, dockerContainer.State.StartedAt)
+	if err != nil {
+		return false
+	}
+	return now.Sub(startedAt) < restartBackoffResetWindow
+}
+
+func getLastExitReasonFromDockerContainerState(dockerContainerState *types.ContainerState) string {
+	if dockerContainerState == nil {
+		return ""
+	}
+	finishedAt, err := time.Parse(time.RFC3339Nano, dockerContainerState.FinishedAt)
+	if err != nil || finishedAt.IsZero() {
+		// The container never exited
+		return ""
+	}
+	if dockerContainerState.OOMKilled {
+		return oomKilledExitReason
+	}
+	if dockerContainerState.Error != "" {
+		return dockerContainerState.Error
+	}
+	return fmt.Sprintf("Exited with code %d", dockerContainerState.ExitCode)
+}
+
 func getContainerStatusByDockerContainerState(dockerContainerState string) (docker_manager_types.ContainerStatus, error) {
 	containerStatus, err := docker_manager_types.ContainerStatusString(dockerContainerState)
 	if err != nil {
@@ -2157,23 +2375,38 @@ func newNetworkFromDockerNetwork(dockerNetwork types.NetworkResource) (*docker_m
 	if len(dockerNetwork.IPAM.Config) == 0 {
 		return nil, stacktrace.NewError("Kurtosis Docker network with ID %v does not contains any IPAM config.", dockerNetwork.ID)
 	}
-	if len(dockerNetwork.IPAM.Config) > 1 {
-		return nil, stacktrace.NewError("This is an unexpected error Docker network with ID '%v' shouldn't have more than one IPAM config; this is a bug in Kurtosis itself", dockerNetwork.ID)
+	// A network has a single IPv4 IPAM config, plus a single IPv6 one if it's a dual-stack network
+	var ipAndMask *net.IPNet
+	var ipv6AndMask *net.IPNet
+	gatewayIp := ""
+	for _, ipamConfig := range dockerNetwork.IPAM.Config {
+		_, subnet, err := net.ParseCIDR(ipamConfig.Subnet)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing CIDR '%v'", ipamConfig.Subnet)
+		}
+		if subnet.IP.To4() == nil {
+			if ipv6AndMask != nil {
+				return nil, stacktrace.NewError("This is an unexpected error Docker network with ID '%v' shouldn't have more than one IPv6 IPAM config; this is a bug in Kurtosis itself", dockerNetwork.ID)
+			}
+			ipv6AndMask = subnet
+			continue
+		}
+		if ipAndMask != nil {
+			return nil, stacktrace.NewError("This is an unexpected error Docker network with ID '%v' shouldn't have more than one IPv4 IPAM config; this is a bug in Kurtosis itself", dockerNetwork.ID)
+		}
+		ipAndMask = subnet
+		gatewayIp = ipamConfig.Gateway
 	}
-	firstIpamConfig := dockerNetwork.IPAM.Config[0]
-
-	_, ipAndMask, err := net.ParseCIDR(firstIpamConfig.Subnet)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred parsing CIDR '%v'", firstIpamConfig.Subnet)
+	if ipAndMask == nil {
+		return nil, stacktrace.NewError("Kurtosis Docker network with ID %v does not contain any IPv4 IPAM config.", dockerNetwork.ID)
 	}
 
-	gatewayIp := firstIpamConfig.Gateway
-
 	networkWrapper := docker_manager_types.NewNetwork(
 		dockerNetwork.Name,
 		dockerNetwork.ID,
 		ipAndMask,
 		gatewayIp,
+		ipv6AndMask,
 		dockerNetwork.Labels,
 	)
 
@@ -2223,10 +2456,10 @@ func convertMillicpusToNanoCPUs(value uint64) uint64 {
 	return value * millicpusToNanoCPUsFactor
 }
 
-func getEndpointSettingsForIpAddress(ipAddress string, alias string) *network.EndpointSettings {
+func getEndpointSettingsForIpAddress(ipAddress string, ipv6Address string, aliases []string) *network.EndpointSettings {
 	ipamConfig := &network.EndpointIPAMConfig{
 		IPv4Address:  ipAddress,
-		IPv6Address:  "",
+		IPv6Address:  ipv6Address,
 		LinkLocalIPs: nil,
 	}
 
@@ -2246,9 +2479,11 @@ func getEndpointSettingsForIpAddress(ipAddress string, alias string) *network.En
 		DriverOpts:          nil,
 	}
 
-	if alias != emptyNetworkAlias {
+	for _, alias := range aliases {
 		// docker treats [""] differently from []
-		config.Aliases = []string{alias}
+		if alias != emptyNetworkAlias {
+			config.Aliases = append(config.Aliases, alias)
+		}
 	}
 
 	return config
@@ -2,6 +2,7 @@ package docker_manager
 
 import (
 	"testing"
+	"time"
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
@@ -182,3 +183,25 @@ func TestBuildImage(t *testing.T) {
 	//_, err = dockerManager.BuildImage(ctx, "foobar", imageBuildSpec)
 	//require.NoError(t, err)
 }
+
+func TestIsDockerContainerCrashLooping(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newDockerContainer := func(restartCount int, isRunning bool, isRestarting bool, startedAt time.Time) types.ContainerJSON {
+		return types.ContainerJSON{ //nolint:exhaustruct
+			ContainerJSONBase: &types.ContainerJSONBase{ //nolint:exhaustruct
+				RestartCount: restartCount,
+				State: &types.ContainerState{ //nolint:exhaustruct
+					Running:    isRunning,
+					Restarting: isRestarting,
+					StartedAt:  startedAt.Format(time.RFC3339Nano),
+				},
+			},
+		}
+	}
+
+	require.True(t, isDockerContainerCrashLooping(newDockerContainer(3, false, true, now.Add(-time.Minute)), now))
+	// Restarted right before being looked at, Docker would still double the delay if it exited again
+	require.True(t, isDockerContainerCrashLooping(newDockerContainer(3, true, false, now.Add(-2*time.Second)), now))
+	require.False(t, isDockerContainerCrashLooping(newDockerContainer(3, true, false, now.Add(-time.Minute)), now))
+	require.False(t, isDockerContainerCrashLooping(newDockerContainer(0, true, false, now.Add(-2*time.Second)), now))
+}
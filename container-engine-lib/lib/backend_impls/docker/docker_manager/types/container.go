@@ -14,6 +14,10 @@ type Container struct {
 	entrypointArgs   []string
 	cmdArgs          []string
 	envVars          map[string]string
+	exitCode         int
+	restartCount     int
+	lastExitReason   string
+	isCrashLooping   bool
 }
 
 func NewContainer(
@@ -26,6 +30,10 @@ func NewContainer(
 	entrypointArgs []string,
 	cmdArgs []string,
 	envVars map[string]string,
+	exitCode int,
+	restartCount int,
+	lastExitReason string,
+	isCrashLooping bool,
 ) *Container {
 	return &Container{
 		id:               id,
@@ -37,6 +45,10 @@ func NewContainer(
 		entrypointArgs:   entrypointArgs,
 		cmdArgs:          cmdArgs,
 		envVars:          envVars,
+		exitCode:         exitCode,
+		restartCount:     restartCount,
+		lastExitReason:   lastExitReason,
+		isCrashLooping:   isCrashLooping,
 	}
 }
 
@@ -75,3 +87,23 @@ func (c *Container) GetCmdArgs() []string {
 func (c *Container) GetEnvVars() map[string]string {
 	return c.envVars
 }
+
+// GetExitCode is only meaningful once the container has exited
+func (c *Container) GetExitCode() int {
+	return c.exitCode
+}
+
+func (c *Container) GetRestartCount() int {
+	return c.restartCount
+}
+
+// GetLastExitReason returns why the container last exited, or an empty string if it never exited
+func (c *Container) GetLastExitReason() string {
+	return c.lastExitReason
+}
+
+// IsCrashLooping is true while Docker waits before restarting the container, per its restart policy, and while the
+// restarted container didn't run long enough yet for Docker to reset the delay between two restarts
+func (c *Container) IsCrashLooping() bool {
+	return c.isCrashLooping
+}
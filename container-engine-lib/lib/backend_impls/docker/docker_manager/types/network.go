@@ -7,11 +7,13 @@ type Network struct {
 	id           string
 	ipAndMask    *net.IPNet
 	gatewayIpStr string
-	labels       map[string]string
+	// Nil if the network isn't a dual-stack network
+	ipv6AndMask *net.IPNet
+	labels      map[string]string
 }
 
-func NewNetwork(name string, id string, ipAndMask *net.IPNet, gatewayIpStr string, labels map[string]string) *Network {
-	return &Network{name: name, id: id, ipAndMask: ipAndMask, gatewayIpStr: gatewayIpStr, labels: labels}
+func NewNetwork(name string, id string, ipAndMask *net.IPNet, gatewayIpStr string, ipv6AndMask *net.IPNet, labels map[string]string) *Network {
+	return &Network{name: name, id: id, ipAndMask: ipAndMask, gatewayIpStr: gatewayIpStr, ipv6AndMask: ipv6AndMask, labels: labels}
 }
 
 func (network Network) GetName() string {
@@ -30,6 +32,10 @@ func (network Network) GetGatewayIp() string {
 	return network.gatewayIpStr
 }
 
+func (network Network) GetIpv6AndMask() *net.IPNet {
+	return network.ipv6AndMask
+}
+
 func (network Network) GetLabels() map[string]string {
 	return network.labels
 }
@@ -60,6 +60,7 @@ func (provider *DockerNetworkAllocator) CreateNewNetwork(
 	ctx context.Context,
 	networkName string,
 	labels map[string]string,
+	isIpv6Enabled bool,
 ) (resultNetworkId string, resultErr error) {
 	if !provider.isConstructedViaConstructor {
 		return "", stacktrace.NewError("This instance of Docker network allocator was constructed without the constructor, which means that the rand.Seed won't have been initialized!")
@@ -99,7 +100,17 @@ func (provider *DockerNetworkAllocator) CreateNewNetwork(
 			return "", stacktrace.Propagate(err, "An error occurred getting a free IP for the network gateway")
 		}
 
-		networkId, err := provider.dockerManager.CreateNetwork(ctx, networkName, freeNetworkIpAndMask.String(), gatewayIp, labels)
+		// The IPv6 subnet of a dual-stack network is derived from its IPv4 subnet, so it's free as long as the IPv4 one is
+		ipv6NetworkMaskStr := ""
+		if isIpv6Enabled {
+			ipv6NetworkIpAndMask, err := network_helpers.GetIpv6SubnetForIpv4Subnet(freeNetworkIpAndMask)
+			if err != nil {
+				return "", stacktrace.Propagate(err, "An error occurred getting the IPv6 subnet for network '%v'", freeNetworkIpAndMask.String())
+			}
+			ipv6NetworkMaskStr = ipv6NetworkIpAndMask.String()
+		}
+
+		networkId, err := provider.dockerManager.CreateNetwork(ctx, networkName, freeNetworkIpAndMask.String(), gatewayIp, ipv6NetworkMaskStr, labels)
 		if err == nil {
 			return networkId, nil
 		}
@@ -14,7 +14,7 @@ func TestErrorOnInstantiationWithoutConstructor(t *testing.T) {
 		isConstructedViaConstructor: false,
 		dockerManager:               nil,
 	}
-	_, err := allocator.CreateNewNetwork(context.Background(), "", map[string]string{})
+	_, err := allocator.CreateNewNetwork(context.Background(), "", map[string]string{}, false)
 	assert.Error(t, err)
 }
 
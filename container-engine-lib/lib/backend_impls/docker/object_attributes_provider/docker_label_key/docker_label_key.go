@@ -50,6 +50,12 @@ func ValidateUserCustomLabelKey(str string) error {
 	return nil
 }
 
+// GetUserCustomLabelKey returns the user custom label key stored in the given Docker label key, or false if the Docker
+// label key isn't a user custom one
+func GetUserCustomLabelKey(dockerLabelKeyStr string) (string, bool) {
+	return strings.CutPrefix(dockerLabelKeyStr, customUserLabelsKeyPrefixStr)
+}
+
 // CreateNewDockerUserCustomLabelKey creates a Traefik Docker label with the Traefik label key prefix
 func CreateNewDockerTraefikLabelKey(str string) (*DockerLabelKey, error) {
 	labelKeyStr := traefikLabelKeyPrefixStr + str
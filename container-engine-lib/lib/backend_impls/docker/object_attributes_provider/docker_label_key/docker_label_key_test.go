@@ -59,3 +59,15 @@ func TestMaxAllowedLabel(t *testing.T) {
 	_, err = CreateNewDockerUserCustomLabelKey(overUserCustomValidMaxLabel)
 	require.Error(t, err)
 }
+
+func TestGetUserCustomLabelKey(t *testing.T) {
+	userCustomLabelKey, err := CreateNewDockerUserCustomLabelKey("team")
+	require.NoError(t, err)
+
+	userLabelKey, isUserCustomLabel := GetUserCustomLabelKey(userCustomLabelKey.GetString())
+	require.True(t, isUserCustomLabel)
+	require.Equal(t, "team", userLabelKey)
+
+	_, isUserCustomLabel = GetUserCustomLabelKey(EnclaveNameDockerLabelKey.GetString())
+	require.False(t, isUserCustomLabel)
+}
@@ -34,6 +34,18 @@ const (
 
 	privateIpAddrLabelKeyStr = labelNamespaceStr + "private-ip"
 
+	privateIpv6AddrLabelKeyStr = labelNamespaceStr + "private-ipv6"
+
+	// Name of a named network of an enclave, as given by the services attached to it
+	namedNetworkLabelKeyStr = labelNamespaceStr + "named-network"
+
+	// Comma-separated names of the env vars of a user service container which hold secrets
+	secretEnvVarNamesLabelKeyStr = labelNamespaceStr + "secret-env-vars"
+
+	// JSON map of the IDs of the private ports of a user service container, published on static or range host ports,
+	// to those host ports; the host ports stay reserved for as long as the container exists
+	hostPortsLabelKeyStr = labelNamespaceStr + "host-ports"
+
 	// We create a duplicate of the enclave uuid and service uuid label key because:
 	// the logs aggregator (vector) needs the enclave uuid and service uuid label keys to create the filepath where logs are stored in persistent volume
 	// but vectors template syntax can't interpret the "com.kurtosistech." prefix, so we can't use the existing label keys
@@ -65,6 +77,10 @@ var EnclaveUUIDDockerLabelKey = MustCreateNewDockerLabelKey(enclaveIdLabelKeyStr
 var EnclaveNameDockerLabelKey = MustCreateNewDockerLabelKey(enclaveNameLabelKeyStr)
 var EnclaveCreationTimeLabelKey = MustCreateNewDockerLabelKey(enclaveCreationTime)
 var PrivateIPDockerLabelKey = MustCreateNewDockerLabelKey(privateIpAddrLabelKeyStr)
+var PrivateIPv6DockerLabelKey = MustCreateNewDockerLabelKey(privateIpv6AddrLabelKeyStr)
+var NamedNetworkDockerLabelKey = MustCreateNewDockerLabelKey(namedNetworkLabelKeyStr)
+var SecretEnvVarNamesDockerLabelKey = MustCreateNewDockerLabelKey(secretEnvVarNamesLabelKeyStr)
+var HostPortsDockerLabelKey = MustCreateNewDockerLabelKey(hostPortsLabelKeyStr)
 var UserServiceGUIDDockerLabelKey = MustCreateNewDockerLabelKey(userServiceGuidDockerLabelKeyStr)
 var LogsEnclaveUUIDDockerLabelKey = MustCreateNewDockerLabelKey(logsEnclaveUuidLabelKeyStr)
 var LogsServiceUUIDDockerLabelKey = MustCreateNewDockerLabelKey(logsServiceUuidDockerLabelKey)
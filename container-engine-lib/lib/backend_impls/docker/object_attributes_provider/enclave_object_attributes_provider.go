@@ -35,7 +35,7 @@ const (
 )
 
 type DockerEnclaveObjectAttributesProvider interface {
-	ForEnclaveNetwork(enclaveName string, creationTime time.Time) (DockerObjectAttributes, error)
+	ForEnclaveNetwork(enclaveName string, creationTime time.Time, userLabels map[string]string) (DockerObjectAttributes, error)
 	ForEnclaveDataVolume() (DockerObjectAttributes, error)
 	ForApiContainer(
 		ipAddr net.IP,
@@ -52,6 +52,16 @@ type DockerEnclaveObjectAttributesProvider interface {
 	ForFilesArtifactsExpanderContainer(
 		serviceUUID service.ServiceUUID,
 	) (DockerObjectAttributes, error)
+	ForUserServiceInitContainer(
+		serviceName service.ServiceName,
+		serviceUuid service.ServiceUUID,
+		containerName string,
+	) (DockerObjectAttributes, error)
+	ForUserServiceSidecarContainer(
+		serviceName service.ServiceName,
+		serviceUuid service.ServiceUUID,
+		containerName string,
+	) (DockerObjectAttributes, error)
 	ForSingleFilesArtifactExpansionVolume(
 		serviceUUID service.ServiceUUID,
 	) (DockerObjectAttributes, error)
@@ -75,7 +85,7 @@ func newDockerEnclaveObjectAttributesProviderImpl(
 	}
 }
 
-func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNetwork(enclaveName string, creationTime time.Time) (DockerObjectAttributes, error) {
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNetwork(enclaveName string, creationTime time.Time, userLabels map[string]string) (DockerObjectAttributes, error) {
 	// TODO: might need to revert this if we have multiple users on the same cluster (what if two people create enclaves with name test?)
 	enclaveNetworkNameStr := networkPrefix + enclaveName
 	name, err := docker_object_name.CreateNewDockerObjectName(enclaveNetworkNameStr)
@@ -115,6 +125,19 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNetwork(enc
 	labels[docker_label_key.EnclaveCreationTimeLabelKey] = creationTimeLabelValue
 	labels[docker_label_key.EnclaveNameDockerLabelKey] = enclaveNameLabelValue
 
+	// add user custom label
+	for userLabelKey, userLabelValue := range userLabels {
+		dockerLabelKey, err := docker_label_key.CreateNewDockerUserCustomLabelKey(userLabelKey)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Docker label key '%s'", userLabelKey)
+		}
+		dockerLabelValue, err := docker_label_value.CreateNewDockerLabelValue(userLabelValue)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a new user custom Docker label value '%s'", userLabelValue)
+		}
+		labels[dockerLabelKey] = dockerLabelValue
+	}
+
 	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -394,6 +417,38 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForFilesArtifactsExpa
 	return objectAttributes, nil
 }
 
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserServiceInitContainer(
+	serviceName service.ServiceName,
+	serviceUuid service.ServiceUUID,
+	containerName string,
+) (
+	DockerObjectAttributes,
+	error,
+) {
+	return provider.getAttributesForUserServiceCompanionContainer(
+		serviceName,
+		serviceUuid,
+		containerName,
+		label_value_consts.UserServiceInitContainerTypeDockerLabelValue,
+	)
+}
+
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserServiceSidecarContainer(
+	serviceName service.ServiceName,
+	serviceUuid service.ServiceUUID,
+	containerName string,
+) (
+	DockerObjectAttributes,
+	error,
+) {
+	return provider.getAttributesForUserServiceCompanionContainer(
+		serviceName,
+		serviceUuid,
+		containerName,
+		label_value_consts.UserServiceSidecarContainerTypeDockerLabelValue,
+	)
+}
+
 func (provider *dockerEnclaveObjectAttributesProviderImpl) ForLogsCollector(tcpPortId string, tcpPortSpec *port_spec.PortSpec, httpPortId string, httpPortSpec *port_spec.PortSpec) (DockerObjectAttributes, error) {
 	name, err := provider.getNameForEnclaveObject([]string{logsCollectorFragment})
 	if err != nil {
@@ -459,6 +514,52 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) getNameForEnclaveObje
 	return name, nil
 }
 
+// Gets the attributes of a container running along with the main container of a service (init container or sidecar)
+// Its name is service_name--container_name--guid so that it sorts next to the service container
+func (provider *dockerEnclaveObjectAttributesProviderImpl) getAttributesForUserServiceCompanionContainer(
+	serviceName service.ServiceName,
+	serviceUuid service.ServiceUUID,
+	containerName string,
+	containerType *docker_label_value.DockerLabelValue,
+) (DockerObjectAttributes, error) {
+	serviceUuidStr := string(serviceUuid)
+
+	guidStr, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating a UUID for container '%v' of service '%v'", containerName, serviceUuidStr)
+	}
+
+	nameStr := strings.Join(
+		[]string{
+			string(serviceName), containerName, guidStr,
+		},
+		objectNameElementSeparator,
+	)
+	name, err := docker_object_name.CreateNewDockerObjectName(nameStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating Docker object name from string '%v'", nameStr)
+	}
+
+	labels, err := provider.getLabelsForEnclaveObjectWithGUID(guidStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting labels for container '%v' of service '%v' with UUID '%v'", containerName, serviceUuidStr, guidStr)
+	}
+
+	serviceUuidLabelValue, err := docker_label_value.CreateNewDockerLabelValue(serviceUuidStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value from service GUID string '%v'", serviceUuidStr)
+	}
+	labels[docker_label_key.UserServiceGUIDDockerLabelKey] = serviceUuidLabelValue
+	labels[docker_label_key.ContainerTypeDockerLabelKey] = containerType
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
+
 // Gets the name of the service container (service_name--service_uuid)
 func (provider *dockerEnclaveObjectAttributesProviderImpl) getNameForUserServiceContainer(serviceName service.ServiceName, serviceUuid service.ServiceUUID) (*docker_object_name.DockerObjectName, error) {
 	nameStr := strings.Join(
@@ -78,3 +78,19 @@ func TestForUserServiceContainer(t *testing.T) {
 		}
 	}
 }
+
+func TestForUserServiceSidecarContainer(t *testing.T) {
+	objAttrsProvider := GetDockerObjectAttributesProvider()
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	require.NoError(t, err, "An unexpected error occurred getting the enclave object attributes provider")
+
+	serviceName := service.ServiceName("nginx")
+	serviceUuid := service.ServiceUUID("3771c85af16a40a18201acf4b4b5ad28")
+	containerAttrs, err := enclaveObjAttrsProvider.ForUserServiceSidecarContainer(serviceName, serviceUuid, "metrics-exporter")
+	require.NoError(t, err, "An unexpected error occurred getting the container attributes")
+	require.Regexp(t, "^nginx--metrics-exporter--[0-9a-f]{32}$", containerAttrs.GetName().GetString())
+	objLabels := containerAttrs.GetLabels()
+	require.Equal(t, "user-service-sidecar", objLabels[docker_label_key.ContainerTypeDockerLabelKey].GetString())
+	require.Equal(t, string(serviceUuid), objLabels[docker_label_key.UserServiceGUIDDockerLabelKey].GetString())
+	require.NotEqual(t, string(serviceUuid), objLabels[docker_label_key.GUIDDockerLabelKey].GetString())
+}
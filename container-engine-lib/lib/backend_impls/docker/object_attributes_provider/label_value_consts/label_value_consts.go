@@ -21,6 +21,8 @@ const (
 	apiContainerContainerTypeLabelValueStr           = "api-container"
 	userServiceContainerTypeLabelValueStr            = "user-service"
 	filesArtifactsExpanderContainerTypeLabelValueStr = "files-artifacts-expander"
+	userServiceInitContainerTypeLabelValueStr        = "user-service-init-container"
+	userServiceSidecarContainerTypeLabelValueStr     = "user-service-sidecar"
 
 	enclaveDataVolumeTypeLabelValueStr            = "enclave-data"
 	filesArtifactExpansionVolumeTypeLabelValueStr = "files-artifacts-expansion"
@@ -28,6 +30,7 @@ const (
 	logsStorageVolumeTypeLabelValueStr            = "kurtosis-logs-storage"
 	logsCollectorVolumeTypeLabelValueStr          = "logs-collector-data"
 	githubAuthStorageVolumeTypeLabelValueStr      = "github-auth-storage"
+	engineDataVolumeTypeLabelValueStr             = "engine-data"
 )
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -47,6 +50,8 @@ var ReverseProxyTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLab
 var APIContainerContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(apiContainerContainerTypeLabelValueStr)
 var UserServiceContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(userServiceContainerTypeLabelValueStr)
 var FilesArtifactExpanderContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactsExpanderContainerTypeLabelValueStr)
+var UserServiceInitContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(userServiceInitContainerTypeLabelValueStr)
+var UserServiceSidecarContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(userServiceSidecarContainerTypeLabelValueStr)
 
 var EnclaveDataVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveDataVolumeTypeLabelValueStr)
 var FilesArtifactExpansionVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactExpansionVolumeTypeLabelValueStr)
@@ -54,3 +59,4 @@ var PersistentDirectoryVolumeTypeDockerLabelValue = docker_label_value.MustCreat
 var LogsStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsStorageVolumeTypeLabelValueStr)
 var LogsCollectorVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsCollectorVolumeTypeLabelValueStr)
 var GitHubAuthStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(githubAuthStorageVolumeTypeLabelValueStr)
+var EngineDataVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(engineDataVolumeTypeLabelValueStr)
@@ -21,6 +21,7 @@ const (
 	logsAggregatorName          = "kurtosis-logs-aggregator"
 	logsStorageVolumeName       = "kurtosis-logs-storage"
 	githubAuthStorageVolumeName = "kurtosis-github-auth-storage"
+	engineDataVolumeName        = "kurtosis-engine-data"
 	engineRESTAPIPortStr        = "engine-rest-api"
 	reverseProxyNamePrefix      = "kurtosis-reverse-proxy"
 )
@@ -38,6 +39,7 @@ type DockerObjectAttributesProvider interface {
 	ForLogsStorageVolume() (DockerObjectAttributes, error)
 	ForReverseProxy(engineGuid engine.EngineGUID) (DockerObjectAttributes, error)
 	ForGitHubAuthStorageVolume() (DockerObjectAttributes, error)
+	ForEngineDataVolume() (DockerObjectAttributes, error)
 }
 
 func GetDockerObjectAttributesProvider() DockerObjectAttributesProvider {
@@ -172,6 +174,23 @@ func (provider *dockerObjectAttributesProviderImpl) ForGitHubAuthStorageVolume()
 	return objectAttributes, nil
 }
 
+func (provider *dockerObjectAttributesProviderImpl) ForEngineDataVolume() (DockerObjectAttributes, error) {
+	name, err := docker_object_name.CreateNewDockerObjectName(engineDataVolumeName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker object name object from string '%v'", engineDataVolumeName)
+	}
+
+	labels := map[*docker_label_key.DockerLabelKey]*docker_label_value.DockerLabelValue{
+		docker_label_key.VolumeTypeDockerLabelKey: label_value_consts.EngineDataVolumeTypeDockerLabelValue,
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+	return objectAttributes, nil
+}
+
 func (provider *dockerObjectAttributesProviderImpl) ForReverseProxy(engineGuid engine.EngineGUID) (DockerObjectAttributes, error) {
 
 	nameStr := strings.Join(
@@ -18,6 +18,13 @@ const (
 	// The ID of the REST API port
 	KurtosisInternalContainerRESTAPIPortSpecId = "rest-api"
 
+	// The IDs of the ports of the enclave DNS server of the API container
+	KurtosisInternalContainerDnsUdpPortSpecId = "dns-udp"
+	KurtosisInternalContainerDnsTcpPortSpecId = "dns-tcp"
+
+	// The ID of the port the API container serves its Prometheus metrics on
+	KurtosisInternalContainerMetricsPortSpecId = "metrics"
+
 	HttpApplicationProtocol = "http"
 
 	IngressRulePathAllPaths = "/"
@@ -35,6 +35,9 @@ var noWait *port_spec.Wait = nil
 // TODO add support for passing toleration to Engine
 var noToleration []apiv1.Toleration = nil
 var noSelectors map[string]string = nil
+var noPodSecurityContext *apiv1.PodSecurityContext = nil
+var noHostAliases []apiv1.HostAlias = nil
+var noPodDnsConfig *apiv1.PodDNSConfig = nil
 
 func CreateEngine(
 	ctx context.Context,
@@ -482,6 +485,9 @@ func createEnginePod(
 		apiv1.RestartPolicyNever,
 		noToleration,
 		noSelectors,
+		noPodSecurityContext,
+		noHostAliases,
+		noPodDnsConfig,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while creating the pod with name '%s' in namespace '%s' with image '%s'", enginePodName, namespace, containerImageAndTag)
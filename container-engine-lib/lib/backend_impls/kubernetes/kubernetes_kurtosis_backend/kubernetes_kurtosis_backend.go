@@ -7,6 +7,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	apiv1 "k8s.io/api/core/v1"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/engine_functions"
@@ -344,6 +345,36 @@ func (backend *KubernetesKurtosisBackend) GetUserServiceLogs(
 		backend.kubernetesManager)
 }
 
+func (backend *KubernetesKurtosisBackend) GetUserServiceImageDigests(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (successfulUserServiceImageDigests map[service.ServiceUUID]string, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) {
+	return user_services_functions.GetUserServiceImageDigests(
+		ctx,
+		enclaveUuid,
+		filters,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) GetUserServiceStats(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (successfulUserServiceStats map[service.ServiceUUID]*service.ServiceStats, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) {
+	return user_services_functions.GetUserServiceStats(
+		ctx,
+		enclaveUuid,
+		filters,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
 func (backend *KubernetesKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -435,6 +466,11 @@ func (backend *KubernetesKurtosisBackend) GetAvailableCPUAndMemory(ctx context.C
 	return 0, 0, isResourceInformationComplete, nil
 }
 
+// GetHostPortReservations returns no reservations, as the ports of Kubernetes services aren't published on the host
+func (backend *KubernetesKurtosisBackend) GetHostPortReservations(ctx context.Context) ([]*port_publish_spec.HostPortReservation, error) {
+	return []*port_publish_spec.HostPortReservation{}, nil
+}
+
 func (backend *KubernetesKurtosisBackend) GetLogsAggregator(
 	ctx context.Context,
 ) (*logs_aggregator.LogsAggregator, error) {
@@ -47,6 +47,9 @@ var noWait *port_spec.Wait = nil
 // TODO add support for passing toleration to APIC
 var noTolerations []apiv1.Toleration = nil
 var noSelectors map[string]string = nil
+var noPodSecurityContext *apiv1.PodSecurityContext = nil
+var noHostAliases []apiv1.HostAlias = nil
+var noPodDnsConfig *apiv1.PodDNSConfig = nil
 
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
 
@@ -112,6 +115,24 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 	privatePortSpecs := map[string]*port_spec.PortSpec{
 		consts.KurtosisInternalContainerGrpcPortSpecId: privateGrpcPortSpec,
 	}
+	// The Kubernetes service of the API container only forwards the ports it declares, so the enclave DNS server
+	// needs its own ports for the services of the enclave to reach it on the cluster IP
+	dnsPortSpecIdsToTransportProtocols := map[string]port_spec.TransportProtocol{
+		consts.KurtosisInternalContainerDnsUdpPortSpecId: port_spec.TransportProtocol_UDP,
+		consts.KurtosisInternalContainerDnsTcpPortSpecId: port_spec.TransportProtocol_TCP,
+	}
+	for portSpecId, transportProtocol := range dnsPortSpecIdsToTransportProtocols {
+		dnsPortSpec, err := port_spec.NewPortSpec(api_container.EnclaveDnsPortNum, transportProtocol, "", noWait, "")
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the API container's enclave DNS port spec with protocol '%v'", transportProtocol.String())
+		}
+		privatePortSpecs[portSpecId] = dnsPortSpec
+	}
+	metricsPortSpec, err := port_spec.NewPortSpec(api_container.MetricsPortNum, consts.KurtosisServersTransportProtocol, consts.HttpApplicationProtocol, noWait, "")
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the API container's metrics port spec using number '%v'", api_container.MetricsPortNum)
+	}
+	privatePortSpecs[consts.KurtosisInternalContainerMetricsPortSpecId] = metricsPortSpec
 
 	enclaveAttributesProvider := backend.objAttrsProvider.ForEnclave(enclaveId)
 	apiContainerAttributesProvider := enclaveAttributesProvider.ForApiContainer()
@@ -489,6 +510,9 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 		apiContainerRestartPolicy,
 		noTolerations,
 		noSelectors,
+		noPodSecurityContext,
+		noHostAliases,
+		noPodDnsConfig,
 	)
 	if err != nil {
 		errMsg := fmt.Sprintf("An error occurred while creating the pod with name '%s' in namespace '%s' with image '%s'", apiContainerPodName, enclaveNamespaceName, image)
@@ -50,10 +50,16 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
 	enclaveName string,
+	labels map[string]string,
+	isIpv6Enabled bool,
 ) (
 	*enclave.Enclave,
 	error,
 ) {
+	if isIpv6Enabled {
+		return nil, stacktrace.NewError("IPv6 enclaves aren't supported by Kubernetes; you can only create them when running on Docker")
+	}
+
 	teardownContext := context.Background()
 
 	searchNamespaceLabels := map[string]string{
@@ -72,7 +78,7 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 
 	// Make Enclave attributes provider
 	enclaveObjAttrsProvider := backend.objAttrsProvider.ForEnclave(enclaveUuid)
-	enclaveNamespaceAttrs, err := enclaveObjAttrsProvider.ForEnclaveNamespace(creationTime, enclaveName)
+	enclaveNamespaceAttrs, err := enclaveObjAttrsProvider.ForEnclaveNamespace(creationTime, enclaveName, labels)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while trying to get the enclave network attributes for the enclave with ID '%v'", enclaveUuid)
 	}
@@ -136,6 +142,7 @@ func (backend *KubernetesKurtosisBackend) UpdateEnclave(
 	enclaveUuid enclave.EnclaveUUID,
 	newName string,
 	newCreationTime *time.Time,
+	newLabels map[string]string,
 ) error {
 
 	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
@@ -156,8 +163,18 @@ func (backend *KubernetesKurtosisBackend) UpdateEnclave(
 		updatedAnnotations[kubernetes_annotation_key_consts.EnclaveCreationTimeAnnotationKey.GetString()] = newCreationTimeStr
 	}
 
+	updatedLabels := map[string]string{}
+	for userLabelKey, userLabelValue := range newLabels {
+		kubernetesLabelKey, err := kubernetes_label_key.CreateNewKubernetesUserCustomLabelKey(userLabelKey)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating a new user custom Kubernetes label key '%s'", userLabelKey)
+		}
+		updatedLabels[kubernetesLabelKey.GetString()] = userLabelValue
+	}
+
 	namespaceApplyConfigurator := func(namespaceApplyConfig *applyconfigurationsv1.NamespaceApplyConfiguration) {
 		namespaceApplyConfig.WithAnnotations(updatedAnnotations)
+		namespaceApplyConfig.WithLabels(updatedLabels)
 	}
 
 	if _, err := backend.kubernetesManager.UpdateNamespace(ctx, namespace.GetName(), namespaceApplyConfigurator); err != nil {
@@ -244,6 +261,18 @@ func (backend *KubernetesKurtosisBackend) StopEnclaves(
 	return successfulEnclaveIds, erroredEnclaveIds, nil
 }
 
+func (backend *KubernetesKurtosisBackend) StartEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	map[enclave.EnclaveUUID]bool,
+	map[enclave.EnclaveUUID]error,
+	error,
+) {
+	// Stopping an enclave removes its pods, so there is nothing left to start back up
+	return nil, nil, stacktrace.NewError("Starting stopped enclaves isn't yet implemented on Kubernetes")
+}
+
 func (backend *KubernetesKurtosisBackend) DumpEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, outputDirpath string) error {
 	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
 	if err != nil {
@@ -559,6 +588,7 @@ func getEnclaveObjectsFromKubernetesResources(
 		}
 
 		enclaveName := getEnclaveNameFromEnclaveNamespace(resourcesForEnclaveId.namespace)
+		enclaveLabels := getEnclaveLabelsFromEnclaveNamespace(resourcesForEnclaveId.namespace)
 
 		enclaveObj := enclave.NewEnclave(
 			enclaveId,
@@ -566,6 +596,7 @@ func getEnclaveObjectsFromKubernetesResources(
 			enclaveStatus,
 			enclaveCreationTime,
 			false,
+			enclaveLabels,
 		)
 
 		result[enclaveId] = enclaveObj
@@ -612,6 +643,20 @@ func getEnclaveCreationTimeFromEnclaveNamespace(namespace *apiv1.Namespace) (*ti
 	return &enclaveCreationTime, nil
 }
 
+// getEnclaveLabelsFromEnclaveNamespace returns the labels given by the user to the enclave, stored as user custom
+// labels on the enclave namespace
+func getEnclaveLabelsFromEnclaveNamespace(namespace *apiv1.Namespace) map[string]string {
+	enclaveLabels := map[string]string{}
+	for labelKey, labelValue := range namespace.Labels {
+		userLabelKey, isUserCustomLabel := kubernetes_label_key.GetUserCustomLabelKey(labelKey)
+		if !isUserCustomLabel {
+			continue
+		}
+		enclaveLabels[userLabelKey] = labelValue
+	}
+	return enclaveLabels
+}
+
 func getEnclaveNameFromEnclaveNamespace(namespace *apiv1.Namespace) string {
 	namespaceAnnotations := namespace.Annotations
 
@@ -66,6 +66,11 @@ const (
 	dumpPodErrorTitle = "Pod"
 
 	emptyImageName = ""
+
+	// Reason of a container which Kubernetes waits on before restarting it, as it keeps exiting
+	crashLoopBackOffWaitingReason = "CrashLoopBackOff"
+	// Reason of a container which exited with a non-zero code, which says less than the code itself
+	genericErrorTerminationReason = "Error"
 )
 
 // Kubernetes doesn't provide public IP or port information; this is instead handled by the Kurtosis gateway that the user uses
@@ -356,8 +361,9 @@ func GetUserServiceKubernetesResourcesMatchingGuids(
 		} else if numPodsForGuid == 1 {
 			kubernetesPod := kubernetesPodsForGuid[0]
 
+			// The service container comes first, followed by the sidecars of the service if it has any
 			numContainersForPod := len(kubernetesPod.Spec.Containers)
-			if numContainersForPod != 1 {
+			if numContainersForPod < 1 {
 				return nil, stacktrace.NewError("Found %v containers associated with service GUID '%v'; this is a bug in Kurtosis", numContainersForPod, serviceUuid)
 			}
 
@@ -498,23 +504,35 @@ func GetUserServiceObjectsFromKubernetesResources(
 			return nil, stacktrace.Propagate(err, "An error occurred getting container status from Kubernetes pod '%+v'", resourcesToParse.Pod)
 		}
 
+		// The service container always comes first; the sidecars follow it
 		podContainer := resourcesToParse.Pod.Spec.Containers[0]
 		podContainerEnvVars := map[string]string{}
 		for _, env := range podContainer.Env {
+			// The values of the env vars holding secrets live in a Kubernetes secret
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				podContainerEnvVars[env.Name] = container.RedactedSecretValue
+				continue
+			}
 			podContainerEnvVars[env.Name] = env.Value
 		}
 
+		maybeExitCode, restartCount, lastExitReason, isCrashLooping := getContainerExitInfoFromPod(resourcesToParse.Pod, podContainer.Name)
+
 		resultObj.Service = service.NewService(
 			serviceRegistrationObj,
 			privatePorts,
 			servicePublicIp,
 			servicePublicPorts,
-			container.NewContainer(
+			container.NewContainerWithExitInfo(
 				containerStatus,
 				podContainer.Image,
 				podContainer.Command,
 				podContainer.Args,
 				podContainerEnvVars,
+				maybeExitCode,
+				restartCount,
+				lastExitReason,
+				isCrashLooping,
 			),
 		)
 	}
@@ -846,6 +864,37 @@ func BuildCombinedError(errorsById map[string]error, titleStr string) error {
 //	Private Helper Methods
 //
 // ====================================================================================================
+// getContainerExitInfoFromPod returns the exit code of the container if it's terminated, the number of times
+// Kubernetes restarted it, why it last exited, and whether Kubernetes is backing off before restarting it again
+func getContainerExitInfoFromPod(pod *apiv1.Pod, containerName string) (*int32, uint32, string, bool) {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.Name != containerName {
+			continue
+		}
+		// Safe to cast because the restart count is never negative
+		restartCount := uint32(containerStatus.RestartCount)
+		isCrashLooping := containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason == crashLoopBackOffWaitingReason
+		lastExitReason := ""
+		if lastTermination := containerStatus.LastTerminationState.Terminated; lastTermination != nil {
+			lastExitReason = getExitReasonFromTerminatedState(lastTermination)
+		}
+		terminated := containerStatus.State.Terminated
+		if terminated == nil {
+			return nil, restartCount, lastExitReason, isCrashLooping
+		}
+		exitCode := terminated.ExitCode
+		return &exitCode, restartCount, getExitReasonFromTerminatedState(terminated), isCrashLooping
+	}
+	return nil, 0, "", false
+}
+
+func getExitReasonFromTerminatedState(terminated *apiv1.ContainerStateTerminated) string {
+	if terminated.Reason != "" && terminated.Reason != genericErrorTerminationReason {
+		return terminated.Reason
+	}
+	return fmt.Sprintf("Exited with code %d", terminated.ExitCode)
+}
+
 func getEnclaveMatchLabels() map[string]string {
 	matchLabels := map[string]string{
 		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():                label_value_consts.AppIDKubernetesLabelValue.GetString(),
@@ -0,0 +1,126 @@
+package user_services_functions
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_settings"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	shmVolumeName         = "dev-shm"
+	shmDirpath            = "/dev/shm"
+	tmpfsVolumeNameFmtStr = "tmpfs-%d"
+)
+
+// Applies the privileges, capabilities, kernel parameters and special mounts of a service to its container, returning
+// the extra pod volumes backing the /dev/shm and tmpfs mounts, the pod security context holding the sysctls, and the
+// host aliases of the pod
+// NOTE: Kubernetes has no equivalent of ulimits, so these get rejected
+func applyContainerSettings(
+	userServiceContainer *apiv1.Container,
+	containerSettings *container_settings.ContainerSettings,
+) ([]apiv1.Volume, *apiv1.PodSecurityContext, []apiv1.HostAlias, error) {
+	if containerSettings == nil {
+		return nil, nil, nil, nil
+	}
+	if len(containerSettings.GetUlimits()) > 0 {
+		return nil, nil, nil, stacktrace.NewError("Ulimits aren't supported by Kubernetes; you can only set them when running on Docker")
+	}
+
+	if userServiceContainer.SecurityContext == nil {
+		// nolint: exhaustruct
+		userServiceContainer.SecurityContext = &apiv1.SecurityContext{}
+	}
+	securityContext := userServiceContainer.SecurityContext
+	if containerSettings.IsPrivileged() {
+		isPrivileged := true
+		securityContext.Privileged = &isPrivileged
+	}
+	if containerSettings.IsReadOnlyRootFilesystem() {
+		isReadOnlyRootFilesystem := true
+		securityContext.ReadOnlyRootFilesystem = &isReadOnlyRootFilesystem
+	}
+	if len(containerSettings.GetAddedCapabilities()) > 0 || len(containerSettings.GetDroppedCapabilities()) > 0 {
+		capabilities := &apiv1.Capabilities{
+			Add:  nil,
+			Drop: nil,
+		}
+		for _, capability := range containerSettings.GetAddedCapabilities() {
+			capabilities.Add = append(capabilities.Add, apiv1.Capability(capability))
+		}
+		for _, capability := range containerSettings.GetDroppedCapabilities() {
+			capabilities.Drop = append(capabilities.Drop, apiv1.Capability(capability))
+		}
+		securityContext.Capabilities = capabilities
+	}
+
+	// Kubernetes only supports in-memory filesystems through emptyDir volumes backed by memory
+	var podVolumes []apiv1.Volume
+	if shmSizeMegabytes := containerSettings.GetShmSizeMegabytes(); shmSizeMegabytes != 0 {
+		podVolumes = append(podVolumes, getInMemoryVolume(shmVolumeName, shmSizeMegabytes))
+		userServiceContainer.VolumeMounts = append(userServiceContainer.VolumeMounts, getInMemoryVolumeMount(shmVolumeName, shmDirpath))
+	}
+	// Sorted so the volume names are stable between runs
+	tmpfsDirpaths := []string{}
+	for dirpath := range containerSettings.GetTmpfsDirpathsToSizeMegabytes() {
+		tmpfsDirpaths = append(tmpfsDirpaths, dirpath)
+	}
+	sort.Strings(tmpfsDirpaths)
+	for idx, dirpath := range tmpfsDirpaths {
+		volumeName := fmt.Sprintf(tmpfsVolumeNameFmtStr, idx)
+		podVolumes = append(podVolumes, getInMemoryVolume(volumeName, containerSettings.GetTmpfsDirpathsToSizeMegabytes()[dirpath]))
+		userServiceContainer.VolumeMounts = append(userServiceContainer.VolumeMounts, getInMemoryVolumeMount(volumeName, dirpath))
+	}
+
+	var podSecurityContext *apiv1.PodSecurityContext
+	if len(containerSettings.GetSysctls()) > 0 {
+		// nolint: exhaustruct
+		podSecurityContext = &apiv1.PodSecurityContext{}
+		for name, value := range containerSettings.GetSysctls() {
+			podSecurityContext.Sysctls = append(podSecurityContext.Sysctls, apiv1.Sysctl{
+				Name:  name,
+				Value: value,
+			})
+		}
+	}
+
+	var hostAliases []apiv1.HostAlias
+	for hostname, ipAddr := range containerSettings.GetExtraHosts() {
+		hostAliases = append(hostAliases, apiv1.HostAlias{
+			IP:        ipAddr,
+			Hostnames: []string{hostname},
+		})
+	}
+
+	return podVolumes, podSecurityContext, hostAliases, nil
+}
+
+// A size of 0 means no limit other than the memory of the node
+func getInMemoryVolume(volumeName string, sizeMegabytes uint64) apiv1.Volume {
+	var sizeLimit *resource.Quantity
+	if sizeMegabytes != 0 {
+		sizeLimit = resource.NewQuantity(int64(convertMegabytesToBytes(sizeMegabytes)), resource.DecimalSI)
+	}
+	// nolint: exhaustruct
+	return apiv1.Volume{
+		Name: volumeName,
+		VolumeSource: apiv1.VolumeSource{
+			EmptyDir: &apiv1.EmptyDirVolumeSource{
+				Medium:    apiv1.StorageMediumMemory,
+				SizeLimit: sizeLimit,
+			},
+		},
+	}
+}
+
+func getInMemoryVolumeMount(volumeName string, dirpath string) apiv1.VolumeMount {
+	// nolint: exhaustruct
+	return apiv1.VolumeMount{
+		Name:      volumeName,
+		MountPath: dirpath,
+	}
+}
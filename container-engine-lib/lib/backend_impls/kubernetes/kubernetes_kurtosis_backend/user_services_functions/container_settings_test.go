@@ -0,0 +1,57 @@
+package user_services_functions
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_settings"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func TestApplyContainerSettings(t *testing.T) {
+	containerSettings, err := container_settings.NewContainerSettings(
+		true,
+		[]string{"cap_bpf"},
+		[]string{"MKNOD"},
+		map[string]string{"net.core.somaxconn": "1024"},
+		nil,
+		256,
+		true,
+		map[string]uint64{"/run": 0, "/tmp": 64},
+		map[string]string{"db.local": "10.0.0.5"},
+	)
+	require.NoError(t, err)
+
+	// nolint: exhaustruct
+	userServiceContainer := &apiv1.Container{Name: userServiceContainerName}
+	podVolumes, podSecurityContext, hostAliases, err := applyContainerSettings(userServiceContainer, containerSettings)
+	require.NoError(t, err)
+
+	securityContext := userServiceContainer.SecurityContext
+	require.True(t, *securityContext.Privileged)
+	require.True(t, *securityContext.ReadOnlyRootFilesystem)
+	require.Equal(t, []apiv1.Capability{"BPF"}, securityContext.Capabilities.Add)
+	require.Equal(t, []apiv1.Capability{"MKNOD"}, securityContext.Capabilities.Drop)
+
+	require.Len(t, podVolumes, 3)
+	require.Equal(t, apiv1.StorageMediumMemory, podVolumes[0].EmptyDir.Medium)
+	require.Equal(t, int64(256_000_000), podVolumes[0].EmptyDir.SizeLimit.Value())
+	require.Nil(t, podVolumes[1].EmptyDir.SizeLimit)
+	require.Equal(t, shmDirpath, userServiceContainer.VolumeMounts[0].MountPath)
+	require.Equal(t, "/run", userServiceContainer.VolumeMounts[1].MountPath)
+	require.Equal(t, "/tmp", userServiceContainer.VolumeMounts[2].MountPath)
+
+	require.Equal(t, []apiv1.Sysctl{{Name: "net.core.somaxconn", Value: "1024"}}, podSecurityContext.Sysctls)
+	require.Equal(t, []apiv1.HostAlias{{IP: "10.0.0.5", Hostnames: []string{"db.local"}}}, hostAliases)
+}
+
+func TestApplyContainerSettingsRejectsUlimits(t *testing.T) {
+	nofileUlimit, err := container_settings.NewUlimit(1024, 65536)
+	require.NoError(t, err)
+	containerSettings, err := container_settings.NewContainerSettings(false, nil, nil, nil, map[string]*container_settings.Ulimit{"nofile": nofileUlimit}, 0, false, nil, nil)
+	require.NoError(t, err)
+
+	// nolint: exhaustruct
+	_, _, _, err = applyContainerSettings(&apiv1.Container{}, containerSettings)
+	require.Error(t, err)
+}
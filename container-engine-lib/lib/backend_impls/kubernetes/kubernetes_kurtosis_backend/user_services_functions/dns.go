@@ -0,0 +1,44 @@
+package user_services_functions
+
+import (
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const (
+	// NOTE: This assumes the cluster uses the default cluster domain
+	clusterDomain = "cluster.local"
+
+	namespaceSearchDomainFmtStr = "%s.svc." + clusterDomain
+	serviceSearchDomain         = "svc." + clusterDomain
+
+	// Same as the one Kubernetes sets on pods using the cluster DNS, so short names keep getting expanded with the
+	// search domains first
+	ndotsOptionName  = "ndots"
+	ndotsOptionValue = "5"
+)
+
+// Gets the DNS config of a pod resolving hostnames with the given nameservers instead of the cluster DNS, keeping the
+// search domains of the cluster DNS so the names of the services of the namespace keep resolving as long as the
+// nameservers forward these domains to the cluster DNS
+func getPodDnsConfig(namespaceName string, dnsServers []string) *apiv1.PodDNSConfig {
+	if len(dnsServers) == 0 {
+		return nil
+	}
+	ndots := ndotsOptionValue
+	return &apiv1.PodDNSConfig{
+		Nameservers: dnsServers,
+		Searches: []string{
+			fmt.Sprintf(namespaceSearchDomainFmtStr, namespaceName),
+			serviceSearchDomain,
+			clusterDomain,
+		},
+		Options: []apiv1.PodDNSConfigOption{
+			{
+				Name:  ndotsOptionName,
+				Value: &ndots,
+			},
+		},
+	}
+}
@@ -0,0 +1,17 @@
+package user_services_functions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPodDnsConfig(t *testing.T) {
+	require.Nil(t, getPodDnsConfig("kt-enclave", nil))
+
+	podDnsConfig := getPodDnsConfig("kt-enclave", []string{"10.1.0.3"})
+	require.Equal(t, []string{"10.1.0.3"}, podDnsConfig.Nameservers)
+	require.Equal(t, []string{"kt-enclave.svc.cluster.local", "svc.cluster.local", "cluster.local"}, podDnsConfig.Searches)
+	require.Len(t, podDnsConfig.Options, 1)
+	require.Equal(t, "5", *podDnsConfig.Options[0].Value)
+}
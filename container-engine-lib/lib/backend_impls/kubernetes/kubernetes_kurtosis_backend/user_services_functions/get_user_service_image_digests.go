@@ -0,0 +1,67 @@
+package user_services_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+	"strings"
+)
+
+const (
+	// Prefix container runtimes based on dockershim add to the image ID reported in the container status
+	dockerPullableImageIdPrefix = "docker-pullable://"
+
+	imageDigestSeparator = "@"
+)
+
+// GetUserServiceImageDigests gets the image digest from the status of the container, as reported by the container
+// runtime of the node the pod runs on
+func GetUserServiceImageDigests(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (successfulUserServiceImageDigests map[service.ServiceUUID]string, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) {
+	serviceObjectsAndResources, err := shared_helpers.GetMatchingUserServiceObjectsAndKubernetesResources(ctx, enclaveId, filters, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Expected to be able to get user services and Kubernetes resources, instead a non nil error was returned")
+	}
+	userServiceImageDigests := map[service.ServiceUUID]string{}
+	erredServiceImageDigests := map[service.ServiceUUID]error{}
+	for _, serviceObjectAndResource := range serviceObjectsAndResources {
+		serviceUuid := serviceObjectAndResource.Service.GetRegistration().GetUUID()
+		servicePod := serviceObjectAndResource.KubernetesResources.Pod
+		if servicePod == nil {
+			erredServiceImageDigests[serviceUuid] = stacktrace.NewError("Expected to find a pod for Kurtosis service with UUID '%v', instead no pod was found", serviceUuid)
+			continue
+		}
+		imageDigest, found := getUserServiceContainerImageDigest(servicePod)
+		if !found {
+			erredServiceImageDigests[serviceUuid] = stacktrace.NewError("Expected the status of the container of service with UUID '%v' to reference its image by digest, instead no digest was found", serviceUuid)
+			continue
+		}
+		userServiceImageDigests[serviceUuid] = imageDigest
+	}
+	return userServiceImageDigests, erredServiceImageDigests, nil
+}
+
+func getUserServiceContainerImageDigest(pod *apiv1.Pod) (string, bool) {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.Name != userServiceContainerName {
+			continue
+		}
+		imageDigest := strings.TrimPrefix(containerStatus.ImageID, dockerPullableImageIdPrefix)
+		if !strings.Contains(imageDigest, imageDigestSeparator) {
+			return "", false
+		}
+		return imageDigest, true
+	}
+	return "", false
+}
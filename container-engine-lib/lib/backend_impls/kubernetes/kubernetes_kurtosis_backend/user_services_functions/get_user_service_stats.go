@@ -0,0 +1,61 @@
+package user_services_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// GetUserServiceStats gets the CPU and memory usage of the services from the metrics server of the cluster, which
+// doesn't report network and disk I/O
+func GetUserServiceStats(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (successfulUserServiceStats map[service.ServiceUUID]*service.ServiceStats, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) {
+	serviceObjectsAndResources, err := shared_helpers.GetMatchingUserServiceObjectsAndKubernetesResources(ctx, enclaveId, filters, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Expected to be able to get user services and Kubernetes resources, instead a non nil error was returned")
+	}
+	userServiceStats := map[service.ServiceUUID]*service.ServiceStats{}
+	erredServiceStats := map[service.ServiceUUID]error{}
+	for _, serviceObjectAndResource := range serviceObjectsAndResources {
+		serviceUuid := serviceObjectAndResource.Service.GetRegistration().GetUUID()
+		servicePod := serviceObjectAndResource.KubernetesResources.Pod
+		if servicePod == nil {
+			erredServiceStats[serviceUuid] = stacktrace.NewError("Expected to find a pod for Kurtosis service with UUID '%v', instead no pod was found", serviceUuid)
+			continue
+		}
+		cpuUsageMilliCores, memoryUsageBytes, sampleTime, err := kubernetesManager.GetContainerUsage(ctx, servicePod.Namespace, servicePod.Name, userServiceContainerName)
+		if err != nil {
+			erredServiceStats[serviceUuid] = stacktrace.Propagate(err, "Expected to be able to call Kubernetes to get the resource usage of service with UUID '%v', instead a non-nil error was returned", serviceUuid)
+			continue
+		}
+		memoryLimitBytes := getUserServiceContainerMemoryLimitBytes(servicePod)
+		userServiceStats[serviceUuid] = service.NewServiceStats(cpuUsageMilliCores, memoryUsageBytes, memoryLimitBytes, nil, sampleTime)
+	}
+	return userServiceStats, erredServiceStats, nil
+}
+
+// getUserServiceContainerMemoryLimitBytes returns zero if the memory of the container isn't limited
+func getUserServiceContainerMemoryLimitBytes(pod *apiv1.Pod) uint64 {
+	for _, container := range pod.Spec.Containers {
+		if container.Name != userServiceContainerName {
+			continue
+		}
+		memoryLimit, found := container.Resources.Limits[apiv1.ResourceMemory]
+		if !found {
+			return 0
+		}
+		return uint64(memoryLimit.Value())
+	}
+	return 0
+}
@@ -0,0 +1,78 @@
+package user_services_functions
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// Names which Kurtosis already uses for the containers of a user service pod
+var reservedPodContainerNames = map[string]bool{
+	userServiceContainerName:               true,
+	filesArtifactExpanderInitContainerName: true,
+}
+
+// Gets the specs of the init containers or sidecars of a user service pod; they share the network namespace of the
+// pod and mount the same volumes as the service container on the directories they request
+func getCompanionPodContainerSpecs(
+	containerSpecs []*container_spec.ContainerSpec,
+	userServiceContainerMounts []apiv1.VolumeMount,
+	imageDownloadMode image_download_mode.ImageDownloadMode,
+) ([]apiv1.Container, error) {
+	userServiceContainerMountsByMountPath := map[string]apiv1.VolumeMount{}
+	for _, volumeMount := range userServiceContainerMounts {
+		userServiceContainerMountsByMountPath[volumeMount.MountPath] = volumeMount
+	}
+
+	imagePullPolicy := apiv1.PullIfNotPresent
+	if imageDownloadMode == image_download_mode.ImageDownloadMode_Always {
+		imagePullPolicy = apiv1.PullAlways
+	}
+
+	containers := []apiv1.Container{}
+	for _, containerSpec := range containerSpecs {
+		containerName := containerSpec.GetName()
+		if _, found := reservedPodContainerNames[containerName]; found {
+			return nil, stacktrace.NewError("Container name '%v' is reserved by Kurtosis; please use another name", containerName)
+		}
+
+		var containerEnvVars []apiv1.EnvVar
+		for varName, varValue := range containerSpec.GetEnvVars() {
+			envVar := apiv1.EnvVar{
+				Name:      varName,
+				Value:     varValue,
+				ValueFrom: nil,
+			}
+			containerEnvVars = append(containerEnvVars, envVar)
+		}
+
+		var containerMounts []apiv1.VolumeMount
+		for dirpath, serviceDirpath := range containerSpec.GetDirpathsToServiceDirpaths() {
+			volumeMount, found := userServiceContainerMountsByMountPath[serviceDirpath]
+			if !found {
+				return nil, stacktrace.NewError(
+					"Container '%v' wants to mount service directory '%v' on '%v', but no files artifact or persistent directory is mounted on that directory of the service",
+					containerName,
+					serviceDirpath,
+					dirpath,
+				)
+			}
+			volumeMount.MountPath = dirpath
+			containerMounts = append(containerMounts, volumeMount)
+		}
+
+		// nolint: exhaustruct
+		containers = append(containers, apiv1.Container{
+			Name:  containerName,
+			Image: containerSpec.GetImageName(),
+			// Yes, even though this is called "command" it actually corresponds to the Docker ENTRYPOINT
+			Command:         containerSpec.GetEntrypointArgs(),
+			Args:            containerSpec.GetCmdArgs(),
+			Env:             containerEnvVars,
+			VolumeMounts:    containerMounts,
+			ImagePullPolicy: imagePullPolicy,
+		})
+	}
+	return containers, nil
+}
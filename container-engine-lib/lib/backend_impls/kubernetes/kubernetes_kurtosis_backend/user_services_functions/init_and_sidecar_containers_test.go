@@ -0,0 +1,31 @@
+package user_services_functions
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"testing"
+)
+
+func TestGetCompanionPodContainerSpecs(t *testing.T) {
+	// nolint: exhaustruct
+	userServiceContainerMounts := []apiv1.VolumeMount{
+		{Name: "data-volume", MountPath: "/data"},
+	}
+
+	sidecar, err := container_spec.NewContainerSpec("exporter", "alpine:3.19", nil, []string{"run"}, map[string]string{"KEY": "value"}, map[string]string{"/var/lib/data": "/data"})
+	require.NoError(t, err)
+	containers, err := getCompanionPodContainerSpecs([]*container_spec.ContainerSpec{sidecar}, userServiceContainerMounts, image_download_mode.ImageDownloadMode_Missing)
+	require.NoError(t, err)
+	require.Len(t, containers, 1)
+	require.Equal(t, "exporter", containers[0].Name)
+	require.Equal(t, []string{"run"}, containers[0].Args)
+	require.Equal(t, "data-volume", containers[0].VolumeMounts[0].Name)
+	require.Equal(t, "/var/lib/data", containers[0].VolumeMounts[0].MountPath)
+
+	reservedNameSidecar, err := container_spec.NewContainerSpec(userServiceContainerName, "alpine:3.19", nil, nil, nil, nil)
+	require.NoError(t, err)
+	_, err = getCompanionPodContainerSpecs([]*container_spec.ContainerSpec{reservedNameSidecar}, userServiceContainerMounts, image_download_mode.ImageDownloadMode_Missing)
+	require.Error(t, err)
+}
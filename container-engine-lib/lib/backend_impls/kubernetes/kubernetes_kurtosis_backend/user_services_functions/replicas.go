@@ -0,0 +1,53 @@
+package user_services_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_value"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// Adds the replica group label to the labels of the pod of a service replica
+func addReplicaGroupPodLabel(podLabels map[string]string, replicaGroup string) error {
+	replicaGroupLabelValue, err := kubernetes_label_value.CreateNewKubernetesLabelValue(replicaGroup)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a Kubernetes label value for replica group '%s'", replicaGroup)
+	}
+	podLabels[kubernetes_label_key.ReplicaGroupKubernetesLabelKey.GetString()] = replicaGroupLabelValue.GetString()
+	return nil
+}
+
+// Gets the labels selecting the pods of all the replicas of a group in the enclave, out of the labels of one of them
+// NOTE: These don't include the resource type label, so the headless service of the group doesn't get mistaken for
+// the Kubernetes service of a user service
+func getReplicaGroupPodSelector(podLabels map[string]string) map[string]string {
+	selectorLabelKeys := []*kubernetes_label_key.KubernetesLabelKey{
+		kubernetes_label_key.AppIDKubernetesLabelKey,
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey,
+		kubernetes_label_key.ReplicaGroupKubernetesLabelKey,
+	}
+	selector := map[string]string{}
+	for _, labelKey := range selectorLabelKeys {
+		selector[labelKey.GetString()] = podLabels[labelKey.GetString()]
+	}
+	return selector
+}
+
+// Adds the pod of a replica to the headless service named after its group, so that the group name resolves to the
+// IPs of all the replicas. The service goes away along with the last replica
+func addPodToReplicaGroupService(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+	namespaceName string,
+	replicaGroup string,
+	pod *apiv1.Pod,
+) error {
+	selector := getReplicaGroupPodSelector(pod.Labels)
+	if _, err := kubernetesManager.AddPodToHeadlessService(ctx, namespaceName, replicaGroup, selector, selector, pod); err != nil {
+		return stacktrace.Propagate(err, "An error occurred adding pod '%s' to the headless service of replica group '%s'", pod.Name, replicaGroup)
+	}
+	return nil
+}
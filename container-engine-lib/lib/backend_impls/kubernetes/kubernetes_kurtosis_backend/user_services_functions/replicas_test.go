@@ -0,0 +1,24 @@
+package user_services_functions
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReplicaGroupPodSelector(t *testing.T) {
+	podLabels := map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():                "kurtosis",
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString():          "enclave-uuid",
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): "user-service",
+		kubernetes_label_key.GUIDKubernetesLabelKey.GetString():                 "service-uuid",
+	}
+	require.NoError(t, addReplicaGroupPodLabel(podLabels, "api"))
+
+	require.Equal(t, map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():        "kurtosis",
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString():  "enclave-uuid",
+		kubernetes_label_key.ReplicaGroupKubernetesLabelKey.GetString(): "api",
+	}, getReplicaGroupPodSelector(podLabels))
+}
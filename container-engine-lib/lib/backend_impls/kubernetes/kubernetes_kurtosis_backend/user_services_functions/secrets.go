@@ -0,0 +1,100 @@
+package user_services_functions
+
+import (
+	"fmt"
+	"sort"
+
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const (
+	secretsVolumeName      = "kurtosis-secrets"
+	secretEnvVarKeyFmtStr  = "env-%d"
+	secretFileKeyFmtStr    = "file-%d"
+	secretFilePerms        = int32(0444)
+	isSecretFileMountRO    = true
+	isSecretKeyRefOptional = false
+)
+
+// Moves the env vars holding secrets and the secret files of a service to a Kubernetes secret, which the service
+// container references so that the secret values never show up in the pod spec
+// Returns the data of the Kubernetes secret to create and the pod volume holding the secret files, both nil if the
+// service has no secrets
+// NOTE: Env var names and filepaths aren't always valid secret keys, so the secret keys are generated
+func applySecrets(
+	userServiceContainer *apiv1.Container,
+	secretName string,
+	secretEnvVarNames []string,
+	secretFiles map[string]string,
+) (map[string][]byte, *apiv1.Volume) {
+	if len(secretEnvVarNames) == 0 && len(secretFiles) == 0 {
+		return nil, nil
+	}
+	secretData := map[string][]byte{}
+
+	secretEnvVarNamesSet := map[string]bool{}
+	for _, envVarName := range secretEnvVarNames {
+		secretEnvVarNamesSet[envVarName] = true
+	}
+	for idx, envVar := range userServiceContainer.Env {
+		if !secretEnvVarNamesSet[envVar.Name] {
+			continue
+		}
+		secretKey := fmt.Sprintf(secretEnvVarKeyFmtStr, idx)
+		secretData[secretKey] = []byte(envVar.Value)
+		isOptional := isSecretKeyRefOptional
+		// nolint: exhaustruct
+		userServiceContainer.Env[idx] = apiv1.EnvVar{
+			Name: envVar.Name,
+			ValueFrom: &apiv1.EnvVarSource{
+				SecretKeyRef: &apiv1.SecretKeySelector{
+					LocalObjectReference: apiv1.LocalObjectReference{Name: secretName},
+					Key:                  secretKey,
+					Optional:             &isOptional,
+				},
+			},
+		}
+	}
+
+	if len(secretFiles) == 0 {
+		return secretData, nil
+	}
+	// Sorted so the secret keys are stable between runs
+	secretFilepaths := []string{}
+	for filepath := range secretFiles {
+		secretFilepaths = append(secretFilepaths, filepath)
+	}
+	sort.Strings(secretFilepaths)
+
+	var secretVolumeItems []apiv1.KeyToPath
+	for idx, filepath := range secretFilepaths {
+		secretKey := fmt.Sprintf(secretFileKeyFmtStr, idx)
+		secretData[secretKey] = []byte(secretFiles[filepath])
+		// nolint: exhaustruct
+		secretVolumeItems = append(secretVolumeItems, apiv1.KeyToPath{
+			Key:  secretKey,
+			Path: secretKey,
+		})
+		// Each secret file gets mounted on its own, so the rest of its directory stays untouched
+		// nolint: exhaustruct
+		userServiceContainer.VolumeMounts = append(userServiceContainer.VolumeMounts, apiv1.VolumeMount{
+			Name:      secretsVolumeName,
+			ReadOnly:  isSecretFileMountRO,
+			MountPath: filepath,
+			SubPath:   secretKey,
+		})
+	}
+	defaultMode := secretFilePerms
+	// nolint: exhaustruct
+	secretsVolume := &apiv1.Volume{
+		Name: secretsVolumeName,
+		VolumeSource: apiv1.VolumeSource{
+			Secret: &apiv1.SecretVolumeSource{
+				SecretName:  secretName,
+				Items:       secretVolumeItems,
+				DefaultMode: &defaultMode,
+			},
+		},
+	}
+	return secretData, secretsVolume
+}
@@ -0,0 +1,56 @@
+package user_services_functions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const testSecretName = "postgres-pod"
+
+func TestApplySecrets(t *testing.T) {
+	// nolint: exhaustruct
+	userServiceContainer := &apiv1.Container{
+		Name: userServiceContainerName,
+		Env: []apiv1.EnvVar{
+			{Name: "LOG_LEVEL", Value: "debug"},
+			{Name: "DB_PASSWORD", Value: "hunter2"},
+		},
+	}
+	secretData, secretsVolume := applySecrets(
+		userServiceContainer,
+		testSecretName,
+		[]string{"DB_PASSWORD"},
+		map[string]string{"/run/secrets/token": "abcdef", "/etc/ssl/key.pem": "private-key"},
+	)
+
+	require.Equal(t, map[string][]byte{
+		"env-1":  []byte("hunter2"),
+		"file-0": []byte("private-key"),
+		"file-1": []byte("abcdef"),
+	}, secretData)
+
+	require.Equal(t, "debug", userServiceContainer.Env[0].Value)
+	require.Empty(t, userServiceContainer.Env[1].Value)
+	require.Equal(t, testSecretName, userServiceContainer.Env[1].ValueFrom.SecretKeyRef.Name)
+	require.Equal(t, "env-1", userServiceContainer.Env[1].ValueFrom.SecretKeyRef.Key)
+
+	require.Equal(t, testSecretName, secretsVolume.Secret.SecretName)
+	require.Len(t, secretsVolume.Secret.Items, 2)
+	require.Len(t, userServiceContainer.VolumeMounts, 2)
+	require.Equal(t, "/etc/ssl/key.pem", userServiceContainer.VolumeMounts[0].MountPath)
+	require.Equal(t, "file-0", userServiceContainer.VolumeMounts[0].SubPath)
+	require.True(t, userServiceContainer.VolumeMounts[0].ReadOnly)
+}
+
+func TestApplySecretsWithoutSecrets(t *testing.T) {
+	// nolint: exhaustruct
+	userServiceContainer := &apiv1.Container{
+		Env: []apiv1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}},
+	}
+	secretData, secretsVolume := applySecrets(userServiceContainer, testSecretName, nil, nil)
+	require.Nil(t, secretData)
+	require.Nil(t, secretsVolume)
+	require.Equal(t, "debug", userServiceContainer.Env[0].Value)
+}
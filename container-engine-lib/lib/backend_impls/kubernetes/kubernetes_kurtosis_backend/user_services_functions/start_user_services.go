@@ -18,8 +18,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_port_spec_serializer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_restart_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
@@ -46,6 +48,8 @@ const (
 	unboundPortNumber = 1
 
 	unlimitedReplacements = -1
+
+	noPrivateIPv6Addr = ""
 )
 
 // Completeness enforced via unit test
@@ -136,12 +140,13 @@ func StartRegisteredUserServices(
 		return successfulServicesPool, failedServicesPool, nil
 	}
 
-	// Sanity check for port bindings on all services
-	//TODO this is a huge hack to temporarily enable static ports for NEAR until we have a more productized solution
+	// Kubernetes services aren't published on the host, so only ephemeral port publish specs make sense
 	for _, config := range services {
-		publicPorts := config.GetPublicPorts()
-		if len(publicPorts) > 0 {
-			logrus.Warn("The Kubernetes Kurtosis backend doesn't support defining static ports for services; the public ports will be ignored.")
+		for _, portPublishSpec := range config.GetPortPublishSpecs() {
+			if portPublishSpec.GetMode() != port_publish_spec.PortPublishMode_Ephemeral {
+				logrus.Warn("The Kubernetes Kurtosis backend doesn't support publishing the ports of services on static or range host ports; the port publish specs will be ignored.")
+				break
+			}
 		}
 	}
 
@@ -314,6 +319,10 @@ func createStartServiceOperation(
 		nodeSelectors := serviceConfig.GetNodeSelectors()
 		imageDownloadMode := serviceConfig.GetImageDownloadMode()
 
+		if len(serviceConfig.GetNetworks()) > 0 || len(serviceConfig.GetExternalNetworks()) > 0 {
+			return nil, stacktrace.NewError("Service with UUID '%v' is attached to networks %v and external networks %v, but networks aren't supported by Kubernetes; you can only set them when running on Docker", serviceUuid, serviceConfig.GetNetworks(), serviceConfig.GetExternalNetworks())
+		}
+
 		matchingObjectAndResources, found := servicesObjectsAndResources[serviceUuid]
 		if !found {
 			return nil, stacktrace.NewError("Even though we pulled back some Kubernetes resources, no Kubernetes resources were available for requested service UUID '%v'; this is a bug in Kurtosis", serviceUuid)
@@ -331,6 +340,18 @@ func createStartServiceOperation(
 		for key := range envVars {
 			envVars[key] = strings.Replace(envVars[key], privateIPAddrPlaceholder, privateIPAddr, unlimitedReplacements)
 		}
+		// Kubernetes enclaves are IPv4 only, so the IPv6 placeholder gets replaced with an empty string
+		if privateIPv6AddrPlaceholder := serviceConfig.GetPrivateIPv6AddrPlaceholder(); privateIPv6AddrPlaceholder != "" {
+			for index := range entrypointArgs {
+				entrypointArgs[index] = strings.Replace(entrypointArgs[index], privateIPv6AddrPlaceholder, noPrivateIPv6Addr, unlimitedReplacements)
+			}
+			for index := range cmdArgs {
+				cmdArgs[index] = strings.Replace(cmdArgs[index], privateIPv6AddrPlaceholder, noPrivateIPv6Addr, unlimitedReplacements)
+			}
+			for key := range envVars {
+				envVars[key] = strings.Replace(envVars[key], privateIPv6AddrPlaceholder, noPrivateIPv6Addr, unlimitedReplacements)
+			}
+		}
 
 		namespaceName := kubernetesService.GetNamespace()
 		serviceRegistrationObj := matchingObjectAndResources.ServiceRegistration
@@ -390,6 +411,12 @@ func createStartServiceOperation(
 			return nil, stacktrace.Propagate(err, "An error occurred getting attributes for new pod for service with UUID '%v'", serviceUuid)
 		}
 		podLabelsStrs := shared_helpers.GetStringMapFromLabelMap(podAttributes.GetLabels())
+		replicaGroup := serviceConfig.GetReplicaGroup()
+		if replicaGroup != "" {
+			if err := addReplicaGroupPodLabel(podLabelsStrs, replicaGroup); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred labelling the pod of service '%s' as a replica of '%s'", serviceName, replicaGroup)
+			}
+		}
 		podAnnotationsStrs := shared_helpers.GetStringMapFromAnnotationMap(podAttributes.GetAnnotations())
 
 		podContainers, err := getUserServicePodContainerSpecs(
@@ -409,7 +436,51 @@ func createStartServiceOperation(
 			return nil, stacktrace.Propagate(err, "An error occurred creating the container specs for the user service pod with image '%v'", containerImageName)
 		}
 
+		// Init containers run after the files artifacts expansion, so they can see the expanded files
+		initContainers, err := getCompanionPodContainerSpecs(serviceConfig.GetInitContainers(), userServiceContainerVolumeMounts, imageDownloadMode)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the init container specs for the user service pod")
+		}
+		podInitContainers = append(podInitContainers, initContainers...)
+
+		sidecars, err := getCompanionPodContainerSpecs(serviceConfig.GetSidecars(), userServiceContainerVolumeMounts, imageDownloadMode)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the sidecar container specs for the user service pod")
+		}
+		podContainers = append(podContainers, sidecars...)
+
+		// Applied last so these mounts only end up on the service container
+		containerSettingsVolumes, podSecurityContext, hostAliases, err := applyContainerSettings(&podContainers[0], serviceConfig.GetContainerSettings())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred applying the container settings of service '%s'", serviceName)
+		}
+		podVolumes = append(podVolumes, containerSettingsVolumes...)
+
 		podName := podAttributes.GetName().GetString()
+		// The Kubernetes secret is named after the pod, and gets deleted by Kubernetes along with it
+		secretData, secretsVolume := applySecrets(&podContainers[0], podName, serviceConfig.GetSecretEnvVarNames(), serviceConfig.GetSecretFiles())
+		if secretsVolume != nil {
+			podVolumes = append(podVolumes, *secretsVolume)
+		}
+		var createdSecret *apiv1.Secret
+		shouldDestroySecret := false
+		if secretData != nil {
+			createdSecret, err = kubernetesManager.CreateSecret(ctx, namespaceName, podName, podLabelsStrs, secretData)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes secret holding the secrets of service '%s'", serviceName)
+			}
+			shouldDestroySecret = true
+			defer func() {
+				if !shouldDestroySecret {
+					return
+				}
+				if err := kubernetesManager.RemoveSecret(ctx, createdSecret); err != nil {
+					logrus.Errorf("Starting service didn't complete successfully so we tried to remove the secret we created but doing so threw an error:\n%v", err)
+					logrus.Errorf("ACTION REQUIRED: You'll need to remove secret '%v' in '%v' manually!!!", podName, namespaceName)
+				}
+			}()
+		}
+
 		createdPod, err := kubernetesManager.CreatePod(
 			ctx,
 			namespaceName,
@@ -420,9 +491,12 @@ func createStartServiceOperation(
 			podContainers,
 			podVolumes,
 			userServiceServiceAccountName,
-			restartPolicy,
+			getPodRestartPolicy(serviceConfig.GetRestartPolicy(), restartPolicy),
 			tolerations,
 			nodeSelectors,
+			podSecurityContext,
+			hostAliases,
+			getPodDnsConfig(namespaceName, serviceConfig.GetDnsServers()),
 		)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating pod '%v' using image '%v'", podName, containerImageName)
@@ -438,6 +512,20 @@ func createStartServiceOperation(
 			}
 		}()
 
+		if createdSecret != nil {
+			if _, err := kubernetesManager.SetSecretOwner(ctx, createdSecret, createdPod); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred making pod '%v' the owner of the secret holding the secrets of service '%s'", podName, serviceName)
+			}
+			// From now on, Kubernetes deletes the secret along with the pod
+			shouldDestroySecret = false
+		}
+
+		if replicaGroup != "" {
+			if err := addPodToReplicaGroupService(ctx, kubernetesManager, namespaceName, replicaGroup, createdPod); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred making the name of replica group '%s' resolve to pod '%v'", replicaGroup, podName)
+			}
+		}
+
 		// Create the ingress for the reverse proxy
 		ingressAttributes, err := enclaveObjAttributesProvider.ForUserServiceIngress(serviceUuid, serviceName, privatePorts)
 		if err != nil {
@@ -781,6 +869,25 @@ func getKubernetesContainerPortsFromPrivatePortSpecs(privatePorts map[string]*po
 	return result, nil
 }
 
+// getPodRestartPolicy returns the pod restart policy for the restart policy of the service, and the default one if the
+// service has none. Pods can't limit how many times their containers get restarted, so the max retries of the service
+// are enforced by Kurtosis which stops the service once it crash loops past them.
+func getPodRestartPolicy(serviceRestartPolicy *service_restart_policy.ServiceRestartPolicy, defaultRestartPolicy apiv1.RestartPolicy) apiv1.RestartPolicy {
+	if serviceRestartPolicy == nil {
+		return defaultRestartPolicy
+	}
+	switch serviceRestartPolicy.GetMode() {
+	case service_restart_policy.ServiceRestartPolicyMode_Never:
+		return apiv1.RestartPolicyNever
+	case service_restart_policy.ServiceRestartPolicyMode_OnFailure:
+		return apiv1.RestartPolicyOnFailure
+	case service_restart_policy.ServiceRestartPolicyMode_Always:
+		return apiv1.RestartPolicyAlways
+	}
+	// Can't happen because the restart policy is validated when it's created
+	return defaultRestartPolicy
+}
+
 func convertMegabytesToBytes(value uint64) uint64 {
 	return value * megabytesToBytesFactor
 }
@@ -1,7 +1,9 @@
 package user_services_functions
 
 import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_restart_policy"
 	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
 	"testing"
 )
 
@@ -11,3 +13,15 @@ func TestConvertMemoryAllocationToBytesReturnsCorrectValue(t *testing.T) {
 	memoryAllocationBytes := convertMegabytesToBytes(memoryAllocationMegabytes)
 	require.Equal(t, uint64(400000000), memoryAllocationBytes)
 }
+
+func TestGetPodRestartPolicy(t *testing.T) {
+	require.Equal(t, apiv1.RestartPolicyNever, getPodRestartPolicy(nil, apiv1.RestartPolicyNever))
+
+	onFailureRestartPolicy, err := service_restart_policy.NewServiceRestartPolicy(service_restart_policy.ServiceRestartPolicyMode_OnFailure, 3)
+	require.NoError(t, err)
+	require.Equal(t, apiv1.RestartPolicyOnFailure, getPodRestartPolicy(onFailureRestartPolicy, apiv1.RestartPolicyNever))
+
+	alwaysRestartPolicy, err := service_restart_policy.NewServiceRestartPolicy(service_restart_policy.ServiceRestartPolicyMode_Always, 0)
+	require.NoError(t, err)
+	require.Equal(t, apiv1.RestartPolicyAlways, getPodRestartPolicy(alwaysRestartPolicy, apiv1.RestartPolicyNever))
+}
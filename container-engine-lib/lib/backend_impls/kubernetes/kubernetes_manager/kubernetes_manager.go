@@ -27,6 +27,7 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -35,6 +36,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/util/retry"
 )
 
 const (
@@ -55,6 +57,8 @@ const (
 	// Kubernetes unfortunately doesn't have a good way to get the exit code out, so we have to parse it out of a string
 	expectedTerminationMessage = "command terminated with exit code"
 
+	podMetricsPathFormat = "/apis/metrics.k8s.io/v1beta1/namespaces/%s/pods/%s"
+
 	shouldAllocateStdinOnPodExec   = false
 	shouldAllocatedStdoutOnPodExec = true
 	shouldAllocatedStderrOnPodExec = true
@@ -65,6 +69,8 @@ const (
 	// This is the owner string we'll use when updating fields
 	fieldManager = "kurtosis"
 
+	podKind = "Pod"
+
 	shouldFollowContainerLogsWhenPrintingPodInfo = false
 	shouldAddTimestampsWhenPrintingPodInfo       = true
 
@@ -143,6 +149,18 @@ type KubernetesManager struct {
 
 func int64Ptr(i int64) *int64 { return &i }
 
+// podMetricsResponse is the subset of the PodMetrics object of the metrics.k8s.io API that Kurtosis uses
+type podMetricsResponse struct {
+	Timestamp  metav1.Time `json:"timestamp"`
+	Containers []struct {
+		Name  string `json:"name"`
+		Usage struct {
+			Cpu    string `json:"cpu"`
+			Memory string `json:"memory"`
+		} `json:"usage"`
+	} `json:"containers"`
+}
+
 func NewKubernetesManager(kubernetesClientSet *kubernetes.Clientset, kuberneteRestConfig *rest.Config, storageClass string) *KubernetesManager {
 	return &KubernetesManager{
 		kubernetesClientSet: kubernetesClientSet,
@@ -293,6 +311,67 @@ func (manager *KubernetesManager) GetServicesByLabels(ctx context.Context, names
 	return &servicesNotMarkedForDeletionserviceList, nil
 }
 
+// AddPodToHeadlessService makes the pod an owner of the headless service with the given name, creating the service
+// if it doesn't exist yet. The service selects pods by the given labels, so its DNS name resolves to all of them, and
+// Kubernetes deletes it once all the pods owning it are gone
+func (manager *KubernetesManager) AddPodToHeadlessService(ctx context.Context, namespace string, name string, serviceLabels map[string]string, matchPodLabels map[string]string, pod *apiv1.Pod) (*apiv1.Service, error) {
+	servicesClient := manager.kubernetesClientSet.CoreV1().Services(namespace)
+
+	// nolint: exhaustruct
+	podOwnerReference := metav1.OwnerReference{
+		APIVersion: apiv1.SchemeGroupVersion.String(),
+		Kind:       podKind,
+		Name:       pod.Name,
+		UID:        pod.UID,
+	}
+
+	// Several pods can get added at the same time, so we retry when another one created or updated the service first
+	isRetriable := func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}
+	var serviceResult *apiv1.Service
+	err := retry.OnError(retry.DefaultRetry, isRetriable, func() error {
+		existingService, err := servicesClient.Get(ctx, name, metav1.GetOptions{}) //nolint:exhaustruct
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		if err != nil {
+			// nolint: exhaustruct
+			service := &apiv1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            name,
+					Namespace:       namespace,
+					Labels:          serviceLabels,
+					OwnerReferences: []metav1.OwnerReference{podOwnerReference},
+				},
+				Spec: apiv1.ServiceSpec{
+					ClusterIP: apiv1.ClusterIPNone,
+					Selector:  matchPodLabels,
+				},
+			}
+			serviceResult, err = servicesClient.Create(ctx, service, globalCreateOptions)
+			return err
+		}
+		for _, ownerReference := range existingService.OwnerReferences {
+			if ownerReference.UID == pod.UID {
+				serviceResult = existingService
+				return nil
+			}
+		}
+		existingService.OwnerReferences = append(existingService.OwnerReferences, podOwnerReference)
+		// nolint: exhaustruct
+		updateOptions := metav1.UpdateOptions{
+			FieldManager: fieldManager,
+		}
+		serviceResult, err = servicesClient.Update(ctx, existingService, updateOptions)
+		return err
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to add pod '%s' to headless service '%s' in namespace '%s'", pod.Name, name, namespace)
+	}
+	return serviceResult, nil
+}
+
 func (manager *KubernetesManager) GetIngressesByLabels(ctx context.Context, namespace string, ingressLabels map[string]string) (*netv1.IngressList, error) {
 	ingressesClient := manager.kubernetesClientSet.NetworkingV1().Ingresses(namespace)
 
@@ -1035,6 +1114,62 @@ func (manager *KubernetesManager) RemoveClusterRoleBindings(ctx context.Context,
 	return nil
 }
 
+// ---------------------------secrets------------------------------------------------------------------------------------
+
+func (manager *KubernetesManager) CreateSecret(ctx context.Context, namespace string, name string, labels map[string]string, data map[string][]byte) (*apiv1.Secret, error) {
+	client := manager.kubernetesClientSet.CoreV1().Secrets(namespace)
+
+	// nolint: exhaustruct
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Data: data,
+		Type: apiv1.SecretTypeOpaque,
+	}
+
+	secretResult, err := client.Create(ctx, secret, globalCreateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create secret with name '%s' in namespace '%v'", name, namespace)
+	}
+	return secretResult, nil
+}
+
+// SetSecretOwner makes Kubernetes delete the secret along with the pod owning it
+func (manager *KubernetesManager) SetSecretOwner(ctx context.Context, secret *apiv1.Secret, ownerPod *apiv1.Pod) (*apiv1.Secret, error) {
+	client := manager.kubernetesClientSet.CoreV1().Secrets(secret.Namespace)
+
+	// nolint: exhaustruct
+	secret.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion: apiv1.SchemeGroupVersion.String(),
+			Kind:       podKind,
+			Name:       ownerPod.Name,
+			UID:        ownerPod.UID,
+		},
+	}
+	// nolint: exhaustruct
+	updateOptions := metav1.UpdateOptions{
+		FieldManager: fieldManager,
+	}
+	secretResult, err := client.Update(ctx, secret, updateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to set pod '%s' as the owner of secret '%s' in namespace '%v'", ownerPod.Name, secret.Name, secret.Namespace)
+	}
+	return secretResult, nil
+}
+
+func (manager *KubernetesManager) RemoveSecret(ctx context.Context, secret *apiv1.Secret) error {
+	client := manager.kubernetesClientSet.CoreV1().Secrets(secret.Namespace)
+
+	if err := client.Delete(ctx, secret.Name, globalDeleteOptions); err != nil {
+		return stacktrace.Propagate(err, "Failed to delete secret with name '%s' in namespace '%v'", secret.Name, secret.Namespace)
+	}
+	return nil
+}
+
 // ---------------------------pods---------------------------------------------------------------------------------------
 
 func (manager *KubernetesManager) CreatePod(
@@ -1050,6 +1185,9 @@ func (manager *KubernetesManager) CreatePod(
 	restartPolicy apiv1.RestartPolicy,
 	tolerations []apiv1.Toleration,
 	nodeSelectors map[string]string,
+	podSecurityContext *apiv1.PodSecurityContext,
+	hostAliases []apiv1.HostAlias,
+	podDnsConfig *apiv1.PodDNSConfig,
 ) (*apiv1.Pod, error) {
 	podClient := manager.kubernetesClientSet.CoreV1().Pods(namespaceName)
 
@@ -1072,6 +1210,11 @@ func (manager *KubernetesManager) CreatePod(
 		Finalizers:                 nil,
 		ManagedFields:              nil,
 	}
+	// A pod with its own DNS config only uses the nameservers and search domains of that config
+	podDnsPolicy := apiv1.DNSPolicy("")
+	if podDnsConfig != nil {
+		podDnsPolicy = apiv1.DNSNone
+	}
 	podSpec := apiv1.PodSpec{
 		Volumes:                       podVolumes,
 		InitContainers:                initContainers,
@@ -1080,7 +1223,7 @@ func (manager *KubernetesManager) CreatePod(
 		RestartPolicy:                 restartPolicy,
 		TerminationGracePeriodSeconds: nil,
 		ActiveDeadlineSeconds:         nil,
-		DNSPolicy:                     "",
+		DNSPolicy:                     podDnsPolicy,
 		NodeSelector:                  nodeSelectors,
 		ServiceAccountName:            podServiceAccountName,
 		DeprecatedServiceAccount:      "",
@@ -1090,7 +1233,7 @@ func (manager *KubernetesManager) CreatePod(
 		HostPID:                       false,
 		HostIPC:                       false,
 		ShareProcessNamespace:         nil,
-		SecurityContext:               nil,
+		SecurityContext:               podSecurityContext,
 		// TODO add support for ImageRegistrySpec to Kubernetes by adding the right secret here
 		// You will have to first publish the secret using the Kubernetes API
 		ImagePullSecrets:          nil,
@@ -1099,10 +1242,10 @@ func (manager *KubernetesManager) CreatePod(
 		Affinity:                  nil,
 		SchedulerName:             "",
 		Tolerations:               tolerations,
-		HostAliases:               nil,
+		HostAliases:               hostAliases,
 		PriorityClassName:         "",
 		Priority:                  nil,
-		DNSConfig:                 nil,
+		DNSConfig:                 podDnsConfig,
 		ReadinessGates:            nil,
 		RuntimeClassName:          nil,
 		EnableServiceLinks:        nil,
@@ -1198,6 +1341,47 @@ func (manager *KubernetesManager) GetPod(ctx context.Context, namespace string,
 }
 
 // GetContainerLogs gets the logs for a given container running inside the given pod in the give namespace
+// GetContainerUsage returns the CPU usage in millicores and the memory usage in bytes of the container, as last
+// sampled by the metrics server of the cluster, along with the time of that sample. It errors if the cluster doesn't
+// run a metrics server, or if it didn't sample the pod yet
+func (manager *KubernetesManager) GetContainerUsage(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+	containerName string,
+) (
+	uint64,
+	uint64,
+	time.Time,
+	error,
+) {
+	// The metrics API client isn't part of client-go, so the request is made on the raw REST client instead
+	metricsPath := fmt.Sprintf(podMetricsPathFormat, namespaceName, podName)
+	serializedPodMetrics, err := manager.kubernetesClientSet.CoreV1().RESTClient().Get().AbsPath(metricsPath).DoRaw(ctx)
+	if err != nil {
+		return 0, 0, time.Time{}, stacktrace.Propagate(err, "An error occurred getting the metrics of pod '%v' in namespace '%v'; make sure the cluster runs a metrics server", podName, namespaceName)
+	}
+	podMetrics := new(podMetricsResponse)
+	if err = json.Unmarshal(serializedPodMetrics, podMetrics); err != nil {
+		return 0, 0, time.Time{}, stacktrace.Propagate(err, "An error occurred deserializing the metrics of pod '%v' in namespace '%v'", podName, namespaceName)
+	}
+	for _, containerMetrics := range podMetrics.Containers {
+		if containerMetrics.Name != containerName {
+			continue
+		}
+		cpuUsage, err := resource.ParseQuantity(containerMetrics.Usage.Cpu)
+		if err != nil {
+			return 0, 0, time.Time{}, stacktrace.Propagate(err, "An error occurred parsing CPU usage '%v' of container '%v' in pod '%v'", containerMetrics.Usage.Cpu, containerName, podName)
+		}
+		memoryUsage, err := resource.ParseQuantity(containerMetrics.Usage.Memory)
+		if err != nil {
+			return 0, 0, time.Time{}, stacktrace.Propagate(err, "An error occurred parsing memory usage '%v' of container '%v' in pod '%v'", containerMetrics.Usage.Memory, containerName, podName)
+		}
+		return uint64(cpuUsage.MilliValue()), uint64(memoryUsage.Value()), podMetrics.Timestamp.Time, nil
+	}
+	return 0, 0, time.Time{}, stacktrace.NewError("The metrics of pod '%v' in namespace '%v' don't have any container named '%v'", podName, namespaceName, containerName)
+}
+
 // TODO We could upgrade this to get the logs of many containers at once just like kubectl does, see:
 //
 //	https://github.com/kubernetes/kubectl/blob/master/pkg/cmd/logs/logs.go#L345
@@ -41,6 +41,12 @@ func ValidateUserCustomLabelKey(str string) error {
 	return nil
 }
 
+// GetUserCustomLabelKey returns the user custom label key stored in the given Kubernetes label key, or false if the
+// Kubernetes label key isn't a user custom one
+func GetUserCustomLabelKey(kubernetesLabelKeyStr string) (string, bool) {
+	return strings.CutPrefix(kubernetesLabelKeyStr, customUserLabelsKeyPrefixStr)
+}
+
 func createNewKubernetesLabelKey(str string) (*KubernetesLabelKey, error) {
 	if err := validate(str); err != nil {
 		return nil, stacktrace.Propagate(err, "Label value string '%v' doesn't pass validation of being a Kubernetes label key", str)
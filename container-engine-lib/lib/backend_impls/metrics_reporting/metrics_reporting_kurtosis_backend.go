@@ -8,6 +8,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
@@ -119,8 +120,8 @@ func (backend *MetricsReportingKurtosisBackend) DumpKurtosis(ctx context.Context
 	return nil
 }
 
-func (backend *MetricsReportingKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
-	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName)
+func (backend *MetricsReportingKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, labels map[string]string, isIpv6Enabled bool) (*enclave.Enclave, error) {
+	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName, labels, isIpv6Enabled)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with UUID '%v'", enclaveUuid)
 	}
@@ -146,10 +147,11 @@ func (backend *MetricsReportingKurtosisBackend) UpdateEnclave(
 	enclaveUuid enclave.EnclaveUUID,
 	newName string,
 	newCreationTime *time.Time,
+	newLabels map[string]string,
 ) error {
 
-	if err := backend.underlying.UpdateEnclave(ctx, enclaveUuid, newName, newCreationTime); err != nil {
-		return stacktrace.Propagate(err, "An error occurred updating enclave with UUID '%v', updating name to '%s' and creation time to '%v'", enclaveUuid, newName, newCreationTime)
+	if err := backend.underlying.UpdateEnclave(ctx, enclaveUuid, newName, newCreationTime, newLabels); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating enclave with UUID '%v', updating name to '%s', creation time to '%v' and labels to '%+v'", enclaveUuid, newName, newCreationTime, newLabels)
 	}
 
 	return nil
@@ -170,6 +172,21 @@ func (backend *MetricsReportingKurtosisBackend) StopEnclaves(
 	return successes, failures, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) StartEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	successfulEnclaveIds map[enclave.EnclaveUUID]bool,
+	erroredEnclaveIds map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+	successes, failures, err := backend.underlying.StartEnclaves(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred starting enclaves using filters: %+v", filters)
+	}
+	return successes, failures, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) DumpEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -319,6 +336,38 @@ func (backend *MetricsReportingKurtosisBackend) GetUserServiceLogs(
 	return userServiceLogs, erroredUserServices, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetUserServiceImageDigests(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]string,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	userServiceImageDigests, erroredUserServices, err := backend.underlying.GetUserServiceImageDigests(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user service image digests in enclave '%v' using filters '%+v'", enclaveUuid, filters)
+	}
+	return userServiceImageDigests, erroredUserServices, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) GetUserServiceStats(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]*service.ServiceStats,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	userServiceStats, erroredUserServices, err := backend.underlying.GetUserServiceStats(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user service stats in enclave '%v' using filters '%+v'", enclaveUuid, filters)
+	}
+	return userServiceStats, erroredUserServices, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -469,6 +518,10 @@ func (backend *MetricsReportingKurtosisBackend) GetAvailableCPUAndMemory(ctx con
 	return availableMemory, availableCpu, isResourceInformationComplete, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetHostPortReservations(ctx context.Context) ([]*port_publish_spec.HostPortReservation, error) {
+	return backend.underlying.GetHostPortReservations(ctx)
+}
+
 func (backend *MetricsReportingKurtosisBackend) BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (string, error) {
 	return backend.underlying.BuildImage(ctx, imageName, imageBuildSpec)
 }
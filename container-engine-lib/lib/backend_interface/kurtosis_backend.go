@@ -8,6 +8,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
@@ -85,15 +86,18 @@ type KurtosisBackend interface {
 	DumpKurtosis(ctx context.Context, outputDirpath string) error
 
 	// Creates an enclave with the given enclave UUID
-	CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error)
+	// If isIpv6Enabled is true, the enclave network is a dual-stack network and services get an IPv6 address too
+	// The labels are stored on the enclave objects, and returned with the enclave
+	CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, labels map[string]string, isIpv6Enabled bool) (*enclave.Enclave, error)
 
-	// Update an enclave by UUID, it's only possible to udpate the name and creation time so far
-	// The newCreationTime param is optional, it won't be updated if the value is nil
+	// Update an enclave by UUID, it's only possible to udpate the name, creation time and labels so far
+	// The newCreationTime and newLabels params are optional, they won't be updated if the value is nil
 	UpdateEnclave(
 		ctx context.Context,
 		enclaveUuid enclave.EnclaveUUID,
 		newName string,
 		creationTime *time.Time,
+		newLabels map[string]string,
 	) error
 
 	// Gets enclaves matching the given filters
@@ -115,6 +119,17 @@ type KurtosisBackend interface {
 		resultErr error,
 	)
 
+	// Starts the stopped enclaves matching the given filters again; their API container restarts the user services that
+	// were running when the enclave got stopped
+	StartEnclaves(
+		ctx context.Context,
+		filters *enclave.EnclaveFilters,
+	) (
+		successfulEnclaveIds map[enclave.EnclaveUUID]bool,
+		erroredEnclaveIds map[enclave.EnclaveUUID]error,
+		resultErr error,
+	)
+
 	// Dumps the contents of the given enclave to the given directory
 	// TODO add this to K8S
 	DumpEnclave(
@@ -276,6 +291,30 @@ type KurtosisBackend interface {
 		resultError error,
 	)
 
+	// Gets the digest-pinned reference (e.g. postgres@sha256:...) of the image each user service matching the given
+	// filters is running, and an error for the services whose image has no digest
+	GetUserServiceImageDigests(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *service.ServiceFilters,
+	) (
+		successfulUserServiceImageDigests map[service.ServiceUUID]string,
+		erroredUserServiceUuids map[service.ServiceUUID]error,
+		resultError error,
+	)
+
+	// Samples the resource usage of the user services matching the given filters, returning the stats of the services
+	// whose container is running, and an error for each of the others
+	GetUserServiceStats(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *service.ServiceFilters,
+	) (
+		successfulUserServiceStats map[service.ServiceUUID]*service.ServiceStats,
+		erroredUserServiceUuids map[service.ServiceUUID]error,
+		resultError error,
+	)
+
 	// Executes a shell command inside an user service instance indenfified by its ID
 	RunUserServiceExecCommands(
 		ctx context.Context,
@@ -355,6 +394,10 @@ type KurtosisBackend interface {
 	// GetAvailableCPUAndMemory - gets available memory in megabytes and cpu in millicores, the boolean indicates whether the information is complete
 	GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, bool, error)
 
+	// GetHostPortReservations gets the host ports held by the user services of every enclave, which published their
+	// ports on static or range host ports
+	GetHostPortReservations(ctx context.Context) ([]*port_publish_spec.HostPortReservation, error)
+
 	// BuildImage builds a container image based on the [imageBuildSpec] with [imageName]
 	// Returns image architecture and if error occurred
 	BuildImage(ctx context.Context, imageName string, imageBuildSpec *image_build_spec.ImageBuildSpec) (string, error)
@@ -30,6 +30,8 @@ import (
 
 	nix_build_spec "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
 
+	port_publish_spec "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
+
 	reverse_proxy "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
 
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
@@ -211,25 +213,25 @@ func (_c *MockKurtosisBackend_CreateAPIContainer_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// CreateEnclave provides a mock function with given fields: ctx, enclaveUuid, enclaveName
-func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
-	ret := _m.Called(ctx, enclaveUuid, enclaveName)
+// CreateEnclave provides a mock function with given fields: ctx, enclaveUuid, enclaveName, labels, isIpv6Enabled
+func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, labels map[string]string, isIpv6Enabled bool) (*enclave.Enclave, error) {
+	ret := _m.Called(ctx, enclaveUuid, enclaveName, labels, isIpv6Enabled)
 
 	var r0 *enclave.Enclave
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string) (*enclave.Enclave, error)); ok {
-		return rf(ctx, enclaveUuid, enclaveName)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, map[string]string, bool) (*enclave.Enclave, error)); ok {
+		return rf(ctx, enclaveUuid, enclaveName, labels, isIpv6Enabled)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string) *enclave.Enclave); ok {
-		r0 = rf(ctx, enclaveUuid, enclaveName)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, map[string]string, bool) *enclave.Enclave); ok {
+		r0 = rf(ctx, enclaveUuid, enclaveName, labels, isIpv6Enabled)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*enclave.Enclave)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, string) error); ok {
-		r1 = rf(ctx, enclaveUuid, enclaveName)
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, string, map[string]string, bool) error); ok {
+		r1 = rf(ctx, enclaveUuid, enclaveName, labels, isIpv6Enabled)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -246,13 +248,15 @@ type MockKurtosisBackend_CreateEnclave_Call struct {
 //   - ctx context.Context
 //   - enclaveUuid enclave.EnclaveUUID
 //   - enclaveName string
-func (_e *MockKurtosisBackend_Expecter) CreateEnclave(ctx interface{}, enclaveUuid interface{}, enclaveName interface{}) *MockKurtosisBackend_CreateEnclave_Call {
-	return &MockKurtosisBackend_CreateEnclave_Call{Call: _e.mock.On("CreateEnclave", ctx, enclaveUuid, enclaveName)}
+//   - labels map[string]string
+//   - isIpv6Enabled bool
+func (_e *MockKurtosisBackend_Expecter) CreateEnclave(ctx interface{}, enclaveUuid interface{}, enclaveName interface{}, labels interface{}, isIpv6Enabled interface{}) *MockKurtosisBackend_CreateEnclave_Call {
+	return &MockKurtosisBackend_CreateEnclave_Call{Call: _e.mock.On("CreateEnclave", ctx, enclaveUuid, enclaveName, labels, isIpv6Enabled)}
 }
 
-func (_c *MockKurtosisBackend_CreateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string)) *MockKurtosisBackend_CreateEnclave_Call {
+func (_c *MockKurtosisBackend_CreateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, labels map[string]string, isIpv6Enabled bool)) *MockKurtosisBackend_CreateEnclave_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(map[string]string), args[4].(bool))
 	})
 	return _c
 }
@@ -262,7 +266,7 @@ func (_c *MockKurtosisBackend_CreateEnclave_Call) Return(_a0 *enclave.Enclave, _
 	return _c
 }
 
-func (_c *MockKurtosisBackend_CreateEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string) (*enclave.Enclave, error)) *MockKurtosisBackend_CreateEnclave_Call {
+func (_c *MockKurtosisBackend_CreateEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string, map[string]string, bool) (*enclave.Enclave, error)) *MockKurtosisBackend_CreateEnclave_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1300,6 +1304,60 @@ func (_c *MockKurtosisBackend_GetEngines_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetHostPortReservations provides a mock function with given fields: ctx
+func (_m *MockKurtosisBackend) GetHostPortReservations(ctx context.Context) ([]*port_publish_spec.HostPortReservation, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*port_publish_spec.HostPortReservation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*port_publish_spec.HostPortReservation, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*port_publish_spec.HostPortReservation); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*port_publish_spec.HostPortReservation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetHostPortReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHostPortReservations'
+type MockKurtosisBackend_GetHostPortReservations_Call struct {
+	*mock.Call
+}
+
+// GetHostPortReservations is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockKurtosisBackend_Expecter) GetHostPortReservations(ctx interface{}) *MockKurtosisBackend_GetHostPortReservations_Call {
+	return &MockKurtosisBackend_GetHostPortReservations_Call{Call: _e.mock.On("GetHostPortReservations", ctx)}
+}
+
+func (_c *MockKurtosisBackend_GetHostPortReservations_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_GetHostPortReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetHostPortReservations_Call) Return(_a0 []*port_publish_spec.HostPortReservation, _a1 error) *MockKurtosisBackend_GetHostPortReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetHostPortReservations_Call) RunAndReturn(run func(context.Context) ([]*port_publish_spec.HostPortReservation, error)) *MockKurtosisBackend_GetHostPortReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLogsAggregator provides a mock function with given fields: ctx
 func (_m *MockKurtosisBackend) GetLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error) {
 	ret := _m.Called(ctx)
@@ -1507,6 +1565,71 @@ func (_c *MockKurtosisBackend_GetShellOnUserService_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetUserServiceImageDigests provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) GetUserServiceImageDigests(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]string, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[service.ServiceUUID]string
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]string, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]string); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_GetUserServiceImageDigests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserServiceImageDigests'
+type MockKurtosisBackend_GetUserServiceImageDigests_Call struct {
+	*mock.Call
+}
+
+// GetUserServiceImageDigests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *service.ServiceFilters
+func (_e *MockKurtosisBackend_Expecter) GetUserServiceImageDigests(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_GetUserServiceImageDigests_Call {
+	return &MockKurtosisBackend_GetUserServiceImageDigests_Call{Call: _e.mock.On("GetUserServiceImageDigests", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceImageDigests_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_GetUserServiceImageDigests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceImageDigests_Call) Return(successfulUserServiceImageDigests map[service.ServiceUUID]string, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) *MockKurtosisBackend_GetUserServiceImageDigests_Call {
+	_c.Call.Return(successfulUserServiceImageDigests, erroredUserServiceUuids, resultError)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceImageDigests_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]string, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_GetUserServiceImageDigests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserServiceLogs provides a mock function with given fields: ctx, enclaveUuid, filters, shouldFollowLogs
 func (_m *MockKurtosisBackend) GetUserServiceLogs(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters, shouldFollowLogs bool) (map[service.ServiceUUID]io.ReadCloser, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, filters, shouldFollowLogs)
@@ -1573,6 +1696,71 @@ func (_c *MockKurtosisBackend_GetUserServiceLogs_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetUserServiceStats provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) GetUserServiceStats(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.ServiceStats, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[service.ServiceUUID]*service.ServiceStats
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]*service.ServiceStats, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]*service.ServiceStats); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]*service.ServiceStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_GetUserServiceStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserServiceStats'
+type MockKurtosisBackend_GetUserServiceStats_Call struct {
+	*mock.Call
+}
+
+// GetUserServiceStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *service.ServiceFilters
+func (_e *MockKurtosisBackend_Expecter) GetUserServiceStats(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_GetUserServiceStats_Call {
+	return &MockKurtosisBackend_GetUserServiceStats_Call{Call: _e.mock.On("GetUserServiceStats", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceStats_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_GetUserServiceStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceStats_Call) Return(successfulUserServiceStats map[service.ServiceUUID]*service.ServiceStats, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) *MockKurtosisBackend_GetUserServiceStats_Call {
+	_c.Call.Return(successfulUserServiceStats, erroredUserServiceUuids, resultError)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServiceStats_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]*service.ServiceStats, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_GetUserServiceStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserServices provides a mock function with given fields: ctx, enclaveUuid, filters
 func (_m *MockKurtosisBackend) GetUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.Service, error) {
 	ret := _m.Called(ctx, enclaveUuid, filters)
@@ -1997,6 +2185,70 @@ func (_c *MockKurtosisBackend_RunUserServiceExecCommands_Call) RunAndReturn(run
 	return _c
 }
 
+// StartEnclaves provides a mock function with given fields: ctx, filters
+func (_m *MockKurtosisBackend) StartEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	ret := _m.Called(ctx, filters)
+
+	var r0 map[enclave.EnclaveUUID]bool
+	var r1 map[enclave.EnclaveUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *enclave.EnclaveFilters) map[enclave.EnclaveUUID]bool); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[enclave.EnclaveUUID]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *enclave.EnclaveFilters) map[enclave.EnclaveUUID]error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[enclave.EnclaveUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *enclave.EnclaveFilters) error); ok {
+		r2 = rf(ctx, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_StartEnclaves_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartEnclaves'
+type MockKurtosisBackend_StartEnclaves_Call struct {
+	*mock.Call
+}
+
+// StartEnclaves is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters *enclave.EnclaveFilters
+func (_e *MockKurtosisBackend_Expecter) StartEnclaves(ctx interface{}, filters interface{}) *MockKurtosisBackend_StartEnclaves_Call {
+	return &MockKurtosisBackend_StartEnclaves_Call{Call: _e.mock.On("StartEnclaves", ctx, filters)}
+}
+
+func (_c *MockKurtosisBackend_StartEnclaves_Call) Run(run func(ctx context.Context, filters *enclave.EnclaveFilters)) *MockKurtosisBackend_StartEnclaves_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*enclave.EnclaveFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_StartEnclaves_Call) Return(successfulEnclaveIds map[enclave.EnclaveUUID]bool, erroredEnclaveIds map[enclave.EnclaveUUID]error, resultErr error) *MockKurtosisBackend_StartEnclaves_Call {
+	_c.Call.Return(successfulEnclaveIds, erroredEnclaveIds, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_StartEnclaves_Call) RunAndReturn(run func(context.Context, *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error)) *MockKurtosisBackend_StartEnclaves_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartRegisteredUserServices provides a mock function with given fields: ctx, enclaveUuid, services
 func (_m *MockKurtosisBackend) StartRegisteredUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, services)
@@ -2384,13 +2636,13 @@ func (_c *MockKurtosisBackend_UnregisterUserServices_Call) RunAndReturn(run func
 	return _c
 }
 
-// UpdateEnclave provides a mock function with given fields: ctx, enclaveUuid, newName, creationTime
-func (_m *MockKurtosisBackend) UpdateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, newName string, creationTime *time.Time) error {
-	ret := _m.Called(ctx, enclaveUuid, newName, creationTime)
+// UpdateEnclave provides a mock function with given fields: ctx, enclaveUuid, newName, creationTime, newLabels
+func (_m *MockKurtosisBackend) UpdateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, newName string, creationTime *time.Time, newLabels map[string]string) error {
+	ret := _m.Called(ctx, enclaveUuid, newName, creationTime, newLabels)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, *time.Time) error); ok {
-		r0 = rf(ctx, enclaveUuid, newName, creationTime)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, *time.Time, map[string]string) error); ok {
+		r0 = rf(ctx, enclaveUuid, newName, creationTime, newLabels)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2407,14 +2659,14 @@ type MockKurtosisBackend_UpdateEnclave_Call struct {
 //   - ctx context.Context
 //   - enclaveUuid enclave.EnclaveUUID
 //   - newName string
-//   - creationTime *time.Time
-func (_e *MockKurtosisBackend_Expecter) UpdateEnclave(ctx interface{}, enclaveUuid interface{}, newName interface{}, creationTime interface{}) *MockKurtosisBackend_UpdateEnclave_Call {
-	return &MockKurtosisBackend_UpdateEnclave_Call{Call: _e.mock.On("UpdateEnclave", ctx, enclaveUuid, newName, creationTime)}
+//   - creationTime *time.Time, newLabels map[string]string
+func (_e *MockKurtosisBackend_Expecter) UpdateEnclave(ctx interface{}, enclaveUuid interface{}, newName interface{}, creationTime interface{}, newLabels interface{}) *MockKurtosisBackend_UpdateEnclave_Call {
+	return &MockKurtosisBackend_UpdateEnclave_Call{Call: _e.mock.On("UpdateEnclave", ctx, enclaveUuid, newName, creationTime, newLabels)}
 }
 
-func (_c *MockKurtosisBackend_UpdateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, newName string, creationTime *time.Time)) *MockKurtosisBackend_UpdateEnclave_Call {
+func (_c *MockKurtosisBackend_UpdateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, newName string, creationTime *time.Time, newLabels map[string]string)) *MockKurtosisBackend_UpdateEnclave_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(*time.Time))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(*time.Time), args[4].(map[string]string))
 	})
 	return _c
 }
@@ -2424,7 +2676,7 @@ func (_c *MockKurtosisBackend_UpdateEnclave_Call) Return(_a0 error) *MockKurtosi
 	return _c
 }
 
-func (_c *MockKurtosisBackend_UpdateEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string, *time.Time) error) *MockKurtosisBackend_UpdateEnclave_Call {
+func (_c *MockKurtosisBackend_UpdateEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string, *time.Time, map[string]string) error) *MockKurtosisBackend_UpdateEnclave_Call {
 	_c.Call.Return(run)
 	return _c
 }
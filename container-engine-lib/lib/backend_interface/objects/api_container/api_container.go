@@ -8,6 +8,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 )
 
+const (
+	// The port the enclave DNS server of the API container listens on, over both UDP and TCP
+	EnclaveDnsPortNum uint16 = 53
+
+	// The port the API container serves its Prometheus metrics on, over HTTP
+	MetricsPortNum uint16 = 9090
+)
+
 // Represents point-in-time information about an API container
 // WARNING: Store this at your own risk!
 type APIContainer struct {
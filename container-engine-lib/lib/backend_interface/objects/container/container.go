@@ -1,5 +1,8 @@
 package container
 
+// Value shown instead of the value of the env vars holding secrets
+const RedactedSecretValue = "<redacted>"
+
 // Represents a service container
 type Container struct {
 	status         ContainerStatus
@@ -7,10 +10,26 @@ type Container struct {
 	entrypointArgs []string
 	cmdArgs        []string
 	envVars        map[string]string
+
+	// Nil while the container hasn't exited
+	maybeExitCode *int32
+
+	// Number of times the container runtime restarted the container after it exited
+	restartCount uint32
+
+	// Why the container last exited (e.g. OOMKilled), empty if it never exited
+	lastExitReason string
+
+	// True while the container runtime waits before restarting the container, after it exited again
+	isCrashLooping bool
 }
 
 func NewContainer(status ContainerStatus, imageName string, entrypointArgs []string, cmdArgs []string, envVars map[string]string) *Container {
-	return &Container{status: status, imageName: imageName, entrypointArgs: entrypointArgs, cmdArgs: cmdArgs, envVars: envVars}
+	return NewContainerWithExitInfo(status, imageName, entrypointArgs, cmdArgs, envVars, nil, 0, "", false)
+}
+
+func NewContainerWithExitInfo(status ContainerStatus, imageName string, entrypointArgs []string, cmdArgs []string, envVars map[string]string, maybeExitCode *int32, restartCount uint32, lastExitReason string, isCrashLooping bool) *Container {
+	return &Container{status: status, imageName: imageName, entrypointArgs: entrypointArgs, cmdArgs: cmdArgs, envVars: envVars, maybeExitCode: maybeExitCode, restartCount: restartCount, lastExitReason: lastExitReason, isCrashLooping: isCrashLooping}
 }
 
 func (container *Container) GetStatus() ContainerStatus {
@@ -32,3 +51,19 @@ func (container *Container) GetCmdArgs() []string {
 func (container *Container) GetEnvVars() map[string]string {
 	return container.envVars
 }
+
+func (container *Container) GetMaybeExitCode() *int32 {
+	return container.maybeExitCode
+}
+
+func (container *Container) GetRestartCount() uint32 {
+	return container.restartCount
+}
+
+func (container *Container) GetLastExitReason() string {
+	return container.lastExitReason
+}
+
+func (container *Container) IsCrashLooping() bool {
+	return container.isCrashLooping
+}
@@ -0,0 +1,164 @@
+package container_settings
+
+import (
+	"encoding/json"
+	"net"
+	"path"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Same as the Docker --ulimit flag
+	UnlimitedUlimit = -1
+
+	capabilityPrefix = "CAP_"
+)
+
+// Ulimit is the soft and hard limit on a resource (e.g. the number of open files) of the processes of a container
+type Ulimit struct {
+	Soft int64
+	Hard int64
+}
+
+func NewUlimit(soft int64, hard int64) (*Ulimit, error) {
+	isSoftAboveHard := hard != UnlimitedUlimit && (soft == UnlimitedUlimit || soft > hard)
+	if isSoftAboveHard {
+		return nil, stacktrace.NewError("The soft limit '%v' of an ulimit can't be higher than its hard limit '%v'", soft, hard)
+	}
+	return &Ulimit{
+		Soft: soft,
+		Hard: hard,
+	}, nil
+}
+
+// ContainerSettings holds the low level settings of the container of a service: its privileges and capabilities,
+// kernel parameters, resource limits and special mounts
+type ContainerSettings struct {
+	privateContainerSettings *privateContainerSettings
+}
+
+type privateContainerSettings struct {
+	IsPrivileged bool
+
+	// Linux capabilities without the CAP_ prefix, e.g. NET_ADMIN
+	AddedCapabilities   []string
+	DroppedCapabilities []string
+
+	// Namespaced kernel parameters, e.g. net.core.somaxconn
+	Sysctls map[string]string
+
+	// Keyed by the name of the limited resource, e.g. nofile
+	Ulimits map[string]*Ulimit
+
+	// 0 means the default size of the container engine
+	ShmSizeMegabytes uint64
+
+	IsReadOnlyRootFilesystem bool
+
+	// Map of dirpaths on the container on which an in-memory filesystem gets mounted, to its max size in megabytes
+	// 0 means the default size of the container engine
+	TmpfsDirpathsToSizeMegabytes map[string]uint64
+
+	// Map of hostnames to the IP addresses they resolve to inside the container
+	ExtraHosts map[string]string
+}
+
+func NewContainerSettings(
+	isPrivileged bool,
+	addedCapabilities []string,
+	droppedCapabilities []string,
+	sysctls map[string]string,
+	ulimits map[string]*Ulimit,
+	shmSizeMegabytes uint64,
+	isReadOnlyRootFilesystem bool,
+	tmpfsDirpathsToSizeMegabytes map[string]uint64,
+	extraHosts map[string]string,
+) (*ContainerSettings, error) {
+	for dirpath := range tmpfsDirpathsToSizeMegabytes {
+		if !path.IsAbs(dirpath) {
+			return nil, stacktrace.NewError("Tmpfs directory '%v' must be an absolute path", dirpath)
+		}
+	}
+	for hostname, ipAddr := range extraHosts {
+		if net.ParseIP(ipAddr) == nil {
+			return nil, stacktrace.NewError("Extra host '%v' must resolve to a valid IP address, but got '%v'", hostname, ipAddr)
+		}
+	}
+	internalContainerSettings := &privateContainerSettings{
+		IsPrivileged:                 isPrivileged,
+		AddedCapabilities:            normalizeCapabilities(addedCapabilities),
+		DroppedCapabilities:          normalizeCapabilities(droppedCapabilities),
+		Sysctls:                      sysctls,
+		Ulimits:                      ulimits,
+		ShmSizeMegabytes:             shmSizeMegabytes,
+		IsReadOnlyRootFilesystem:     isReadOnlyRootFilesystem,
+		TmpfsDirpathsToSizeMegabytes: tmpfsDirpathsToSizeMegabytes,
+		ExtraHosts:                   extraHosts,
+	}
+	return &ContainerSettings{privateContainerSettings: internalContainerSettings}, nil
+}
+
+func (settings *ContainerSettings) IsPrivileged() bool {
+	return settings.privateContainerSettings.IsPrivileged
+}
+
+func (settings *ContainerSettings) GetAddedCapabilities() []string {
+	return settings.privateContainerSettings.AddedCapabilities
+}
+
+func (settings *ContainerSettings) GetDroppedCapabilities() []string {
+	return settings.privateContainerSettings.DroppedCapabilities
+}
+
+func (settings *ContainerSettings) GetSysctls() map[string]string {
+	return settings.privateContainerSettings.Sysctls
+}
+
+func (settings *ContainerSettings) GetUlimits() map[string]*Ulimit {
+	return settings.privateContainerSettings.Ulimits
+}
+
+func (settings *ContainerSettings) GetShmSizeMegabytes() uint64 {
+	return settings.privateContainerSettings.ShmSizeMegabytes
+}
+
+func (settings *ContainerSettings) IsReadOnlyRootFilesystem() bool {
+	return settings.privateContainerSettings.IsReadOnlyRootFilesystem
+}
+
+func (settings *ContainerSettings) GetTmpfsDirpathsToSizeMegabytes() map[string]uint64 {
+	return settings.privateContainerSettings.TmpfsDirpathsToSizeMegabytes
+}
+
+func (settings *ContainerSettings) GetExtraHosts() map[string]string {
+	return settings.privateContainerSettings.ExtraHosts
+}
+
+func (settings ContainerSettings) MarshalJSON() ([]byte, error) {
+	return json.Marshal(settings.privateContainerSettings)
+}
+
+func (settings *ContainerSettings) UnmarshalJSON(data []byte) error {
+
+	// Suppressing exhaustruct requirement because we want an object with zero values
+	// nolint: exhaustruct
+	unmarshalledPrivateStructPtr := &privateContainerSettings{}
+
+	if err := json.Unmarshal(data, unmarshalledPrivateStructPtr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unmarshalling the private struct")
+	}
+
+	settings.privateContainerSettings = unmarshalledPrivateStructPtr
+	return nil
+}
+
+// Docker and Kubernetes both accept capabilities like NET_ADMIN, so we accept cap_net_admin or CAP_NET_ADMIN too
+func normalizeCapabilities(capabilities []string) []string {
+	var result []string
+	for _, capability := range capabilities {
+		result = append(result, strings.TrimPrefix(strings.ToUpper(capability), capabilityPrefix))
+	}
+	return result
+}
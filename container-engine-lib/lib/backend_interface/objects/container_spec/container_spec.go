@@ -0,0 +1,116 @@
+package container_spec
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/stacktrace"
+	"regexp"
+)
+
+const (
+	// ContainerNameRegex implements RFC-1123 for naming containers, namely:
+	// * contain at most 63 characters
+	// * contain only lowercase alphanumeric characters or '-'
+	// * start with an alphanumeric character
+	// * end with an alphanumeric character
+	// This is what Kubernetes requires for the names of the containers of a pod
+	ContainerNameRegex            = "[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?"
+	WordWrappedContainerNameRegex = "^" + ContainerNameRegex + "$"
+)
+
+var (
+	compiledWordWrappedContainerNameRegex = regexp.MustCompile(WordWrappedContainerNameRegex)
+)
+
+// ContainerSpec describes a container running along with the main container of a service, either before it starts
+// (init container) or next to it (sidecar). It shares the network namespace of the main container, and can mount
+// some of the directories of the main container.
+type ContainerSpec struct {
+	privateContainerSpec *privateContainerSpec
+}
+
+type privateContainerSpec struct {
+	Name string
+
+	ImageName string
+
+	EntrypointArgs []string
+
+	CmdArgs []string
+
+	EnvVars map[string]string
+
+	// Map of dirpaths on this container to the dirpaths on the main container of the service whose volume gets
+	// mounted there too, so that both containers see the same files
+	DirpathsToServiceDirpaths map[string]string
+}
+
+func NewContainerSpec(
+	name string,
+	imageName string,
+	entrypointArgs []string,
+	cmdArgs []string,
+	envVars map[string]string,
+	dirpathsToServiceDirpaths map[string]string,
+) (*ContainerSpec, error) {
+	if !IsContainerNameValid(name) {
+		return nil, stacktrace.NewError("Container name '%v' is invalid; it must match regex '%v'", name, WordWrappedContainerNameRegex)
+	}
+	if imageName == "" {
+		return nil, stacktrace.NewError("Container '%v' needs an image", name)
+	}
+	internalContainerSpec := &privateContainerSpec{
+		Name:                      name,
+		ImageName:                 imageName,
+		EntrypointArgs:            entrypointArgs,
+		CmdArgs:                   cmdArgs,
+		EnvVars:                   envVars,
+		DirpathsToServiceDirpaths: dirpathsToServiceDirpaths,
+	}
+	return &ContainerSpec{privateContainerSpec: internalContainerSpec}, nil
+}
+
+func (spec *ContainerSpec) GetName() string {
+	return spec.privateContainerSpec.Name
+}
+
+func (spec *ContainerSpec) GetImageName() string {
+	return spec.privateContainerSpec.ImageName
+}
+
+func (spec *ContainerSpec) GetEntrypointArgs() []string {
+	return spec.privateContainerSpec.EntrypointArgs
+}
+
+func (spec *ContainerSpec) GetCmdArgs() []string {
+	return spec.privateContainerSpec.CmdArgs
+}
+
+func (spec *ContainerSpec) GetEnvVars() map[string]string {
+	return spec.privateContainerSpec.EnvVars
+}
+
+func (spec *ContainerSpec) GetDirpathsToServiceDirpaths() map[string]string {
+	return spec.privateContainerSpec.DirpathsToServiceDirpaths
+}
+
+func (spec ContainerSpec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(spec.privateContainerSpec)
+}
+
+func (spec *ContainerSpec) UnmarshalJSON(data []byte) error {
+
+	// Suppressing exhaustruct requirement because we want an object with zero values
+	// nolint: exhaustruct
+	unmarshalledPrivateStructPtr := &privateContainerSpec{}
+
+	if err := json.Unmarshal(data, unmarshalledPrivateStructPtr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unmarshalling the private struct")
+	}
+
+	spec.privateContainerSpec = unmarshalledPrivateStructPtr
+	return nil
+}
+
+func IsContainerNameValid(name string) bool {
+	return compiledWordWrappedContainerNameRegex.MatchString(name)
+}
@@ -10,10 +10,12 @@ type Enclave struct {
 	status              EnclaveStatus
 	creationTime        *time.Time
 	isProductionEnclave bool
+	// The key/value labels given by the user when creating the enclave
+	labels map[string]string
 }
 
-func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time, productionMode bool) *Enclave {
-	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime, isProductionEnclave: productionMode}
+func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time, productionMode bool, labels map[string]string) *Enclave {
+	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime, isProductionEnclave: productionMode, labels: labels}
 }
 
 func (enclave *Enclave) GetUUID() EnclaveUUID {
@@ -35,3 +37,7 @@ func (enclave *Enclave) GetName() string {
 func (enclave *Enclave) IsProductionEnclave() bool {
 	return enclave.isProductionEnclave
 }
+
+func (enclave *Enclave) GetLabels() map[string]string {
+	return enclave.labels
+}
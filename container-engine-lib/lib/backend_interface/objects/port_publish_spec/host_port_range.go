@@ -0,0 +1,118 @@
+package port_publish_spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	hostPortRangesSeparator    = ","
+	hostPortRangeNameSeparator = "="
+	hostPortRangeBoundsSep     = "-"
+
+	hostPortRangeFormat = "%d" + hostPortRangeBoundsSep + "%d"
+
+	portNumberBase    = 10
+	portNumberBitSize = 16
+)
+
+// HostPortRange is an inclusive range of host ports that the engine operator sets aside for the services publishing
+// their ports on a named range
+type HostPortRange struct {
+	privateHostPortRange *privateHostPortRange
+}
+
+type privateHostPortRange struct {
+	Start uint16
+
+	End uint16
+}
+
+func NewHostPortRange(start uint16, end uint16) (*HostPortRange, error) {
+	if start == noHostPortNumber {
+		return nil, stacktrace.NewError("A host port range can't start at port '%v'", start)
+	}
+	if start > end {
+		return nil, stacktrace.NewError("A host port range must start before it ends, but it starts at '%v' and ends at '%v'", start, end)
+	}
+	return &HostPortRange{
+		privateHostPortRange: &privateHostPortRange{
+			Start: start,
+			End:   end,
+		},
+	}, nil
+}
+
+// ParseHostPortRanges parses named host port ranges in the 'name=start-end,other-name=start-end' format; an empty
+// string means no ranges
+func ParseHostPortRanges(hostPortRangesStr string) (map[string]*HostPortRange, error) {
+	hostPortRanges := map[string]*HostPortRange{}
+	if strings.TrimSpace(hostPortRangesStr) == "" {
+		return hostPortRanges, nil
+	}
+	for _, hostPortRangeStr := range strings.Split(hostPortRangesStr, hostPortRangesSeparator) {
+		rangeName, boundsStr, found := strings.Cut(strings.TrimSpace(hostPortRangeStr), hostPortRangeNameSeparator)
+		if !found || rangeName == "" {
+			return nil, stacktrace.NewError("Host port range '%v' isn't in the 'name%vstart%vend' format", hostPortRangeStr, hostPortRangeNameSeparator, hostPortRangeBoundsSep)
+		}
+		if _, found := hostPortRanges[rangeName]; found {
+			return nil, stacktrace.NewError("Host port range '%v' is defined more than once", rangeName)
+		}
+		startStr, endStr, found := strings.Cut(boundsStr, hostPortRangeBoundsSep)
+		if !found {
+			return nil, stacktrace.NewError("The bounds '%v' of host port range '%v' aren't in the 'start%vend' format", boundsStr, rangeName, hostPortRangeBoundsSep)
+		}
+		start, err := strconv.ParseUint(startStr, portNumberBase, portNumberBitSize)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the start '%v' of host port range '%v'", startStr, rangeName)
+		}
+		end, err := strconv.ParseUint(endStr, portNumberBase, portNumberBitSize)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the end '%v' of host port range '%v'", endStr, rangeName)
+		}
+		hostPortRange, err := NewHostPortRange(uint16(start), uint16(end))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Host port range '%v' is invalid", rangeName)
+		}
+		hostPortRanges[rangeName] = hostPortRange
+	}
+	return hostPortRanges, nil
+}
+
+func (hostPortRange *HostPortRange) GetStart() uint16 {
+	return hostPortRange.privateHostPortRange.Start
+}
+
+func (hostPortRange *HostPortRange) GetEnd() uint16 {
+	return hostPortRange.privateHostPortRange.End
+}
+
+func (hostPortRange *HostPortRange) Contains(hostPortNumber uint16) bool {
+	return hostPortNumber >= hostPortRange.GetStart() && hostPortNumber <= hostPortRange.GetEnd()
+}
+
+func (hostPortRange *HostPortRange) String() string {
+	return fmt.Sprintf(hostPortRangeFormat, hostPortRange.GetStart(), hostPortRange.GetEnd())
+}
+
+func (hostPortRange *HostPortRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hostPortRange.privateHostPortRange)
+}
+
+func (hostPortRange *HostPortRange) UnmarshalJSON(data []byte) error {
+
+	// Suppressing exhaustruct requirement because we want an object with zero values
+	// nolint: exhaustruct
+	unmarshalledPrivateStructPtr := &privateHostPortRange{}
+
+	if err := json.Unmarshal(data, unmarshalledPrivateStructPtr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unmarshalling the private struct")
+	}
+
+	hostPortRange.privateHostPortRange = unmarshalledPrivateStructPtr
+	return nil
+}
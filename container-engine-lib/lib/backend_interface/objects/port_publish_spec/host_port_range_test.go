@@ -0,0 +1,38 @@
+package port_publish_spec
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseHostPortRanges(t *testing.T) {
+	hostPortRanges, err := ParseHostPortRanges("p2p=30000-30099, rpc=31000-31000")
+	require.NoError(t, err)
+	require.Len(t, hostPortRanges, 2)
+	require.Equal(t, "30000-30099", hostPortRanges["p2p"].String())
+	require.Equal(t, "31000-31000", hostPortRanges["rpc"].String())
+	require.True(t, hostPortRanges["p2p"].Contains(30099))
+	require.False(t, hostPortRanges["p2p"].Contains(30100))
+}
+
+func TestParseHostPortRanges_EmptyMeansNoRanges(t *testing.T) {
+	hostPortRanges, err := ParseHostPortRanges("")
+	require.NoError(t, err)
+	require.Empty(t, hostPortRanges)
+}
+
+func TestParseHostPortRanges_ErrorsOnInvalidRanges(t *testing.T) {
+	invalidHostPortRanges := []string{
+		"p2p",
+		"=30000-30099",
+		"p2p=30000",
+		"p2p=30099-30000",
+		"p2p=0-10",
+		"p2p=30000-70000",
+		"p2p=30000-30099,p2p=31000-31099",
+	}
+	for _, invalidHostPortRangesStr := range invalidHostPortRanges {
+		_, err := ParseHostPortRanges(invalidHostPortRangesStr)
+		require.Error(t, err, "Expected '%v' to be invalid", invalidHostPortRangesStr)
+	}
+}
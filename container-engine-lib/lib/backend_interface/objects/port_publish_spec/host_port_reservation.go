@@ -0,0 +1,45 @@
+package port_publish_spec
+
+// HostPortReservation is a host port held by a service that published one of its ports on a static or a range host
+// port. The reservation lasts for as long as the service exists, even when it's stopped, so restarting it gets the
+// same host port back. Ephemeral host ports aren't reserved.
+type HostPortReservation struct {
+	hostPortNumber uint16
+
+	// UUID of the enclave of the service, as a string as this package can't depend on the enclave package
+	enclaveUuid string
+
+	serviceName string
+
+	privatePortId string
+}
+
+func NewHostPortReservation(hostPortNumber uint16, enclaveUuid string, serviceName string, privatePortId string) *HostPortReservation {
+	return &HostPortReservation{
+		hostPortNumber: hostPortNumber,
+		enclaveUuid:    enclaveUuid,
+		serviceName:    serviceName,
+		privatePortId:  privatePortId,
+	}
+}
+
+func (reservation *HostPortReservation) GetHostPortNumber() uint16 {
+	return reservation.hostPortNumber
+}
+
+func (reservation *HostPortReservation) GetEnclaveUuid() string {
+	return reservation.enclaveUuid
+}
+
+func (reservation *HostPortReservation) GetServiceName() string {
+	return reservation.serviceName
+}
+
+func (reservation *HostPortReservation) GetPrivatePortId() string {
+	return reservation.privatePortId
+}
+
+// IsHeldBy tells whether the reservation belongs to the given service, which can publish its port there again
+func (reservation *HostPortReservation) IsHeldBy(enclaveUuid string, serviceName string) bool {
+	return reservation.enclaveUuid == enclaveUuid && reservation.serviceName == serviceName
+}
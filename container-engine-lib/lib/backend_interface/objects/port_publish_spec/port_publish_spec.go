@@ -0,0 +1,131 @@
+package port_publish_spec
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+type PortPublishMode string
+
+const (
+	// The container engine publishes the port on any free host port
+	PortPublishMode_Ephemeral PortPublishMode = "ephemeral"
+
+	// The port is published on the requested host port
+	PortPublishMode_Static PortPublishMode = "static"
+
+	// The port is published on the first host port of a named host port range that no other service reserved
+	PortPublishMode_Range PortPublishMode = "range"
+
+	noHostPortNumber = uint16(0)
+	noRangeName      = ""
+)
+
+// PortPublishSpec tells the container engine on which host port a private port of a service gets published
+type PortPublishSpec struct {
+	// we do this way in order to have exported fields which can be marshalled
+	// and an unexported type for encapsulation
+	privatePortPublishSpec *privatePortPublishSpec
+}
+
+type privatePortPublishSpec struct {
+	Mode PortPublishMode
+
+	// Only set for static port publish specs
+	HostPortNumber uint16
+
+	// Only set for range port publish specs
+	RangeName string
+
+	// The bounds of the named range; nil until the range name gets resolved, as only the engine knows the ranges
+	ResolvedRange *HostPortRange
+}
+
+func NewEphemeralPortPublishSpec() *PortPublishSpec {
+	return &PortPublishSpec{
+		privatePortPublishSpec: &privatePortPublishSpec{
+			Mode:           PortPublishMode_Ephemeral,
+			HostPortNumber: noHostPortNumber,
+			RangeName:      noRangeName,
+			ResolvedRange:  nil,
+		},
+	}
+}
+
+func NewStaticPortPublishSpec(hostPortNumber uint16) (*PortPublishSpec, error) {
+	if hostPortNumber == noHostPortNumber {
+		return nil, stacktrace.NewError("A static port publish spec needs a host port number, but it was '%v'", hostPortNumber)
+	}
+	return &PortPublishSpec{
+		privatePortPublishSpec: &privatePortPublishSpec{
+			Mode:           PortPublishMode_Static,
+			HostPortNumber: hostPortNumber,
+			RangeName:      noRangeName,
+			ResolvedRange:  nil,
+		},
+	}, nil
+}
+
+func NewRangePortPublishSpec(rangeName string) (*PortPublishSpec, error) {
+	if rangeName == noRangeName {
+		return nil, stacktrace.NewError("A range port publish spec needs the name of a host port range, but it was empty")
+	}
+	return &PortPublishSpec{
+		privatePortPublishSpec: &privatePortPublishSpec{
+			Mode:           PortPublishMode_Range,
+			HostPortNumber: noHostPortNumber,
+			RangeName:      rangeName,
+			ResolvedRange:  nil,
+		},
+	}, nil
+}
+
+func (spec *PortPublishSpec) GetMode() PortPublishMode {
+	return spec.privatePortPublishSpec.Mode
+}
+
+func (spec *PortPublishSpec) GetHostPortNumber() uint16 {
+	return spec.privatePortPublishSpec.HostPortNumber
+}
+
+func (spec *PortPublishSpec) GetRangeName() string {
+	return spec.privatePortPublishSpec.RangeName
+}
+
+// GetResolvedRange returns the bounds of the named range of a range port publish spec, or nil if it wasn't resolved yet
+func (spec *PortPublishSpec) GetResolvedRange() *HostPortRange {
+	return spec.privatePortPublishSpec.ResolvedRange
+}
+
+// WithResolvedRange returns a copy of this range port publish spec carrying the bounds of its named range
+func (spec *PortPublishSpec) WithResolvedRange(hostPortRange *HostPortRange) (*PortPublishSpec, error) {
+	if spec.GetMode() != PortPublishMode_Range {
+		return nil, stacktrace.NewError("Only range port publish specs have a range to resolve, but this port publish spec is a '%v' one", spec.GetMode())
+	}
+	return &PortPublishSpec{
+		privatePortPublishSpec: &privatePortPublishSpec{
+			Mode:           PortPublishMode_Range,
+			HostPortNumber: noHostPortNumber,
+			RangeName:      spec.GetRangeName(),
+			ResolvedRange:  hostPortRange,
+		},
+	}, nil
+}
+
+func (spec *PortPublishSpec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(spec.privatePortPublishSpec)
+}
+
+func (spec *PortPublishSpec) UnmarshalJSON(data []byte) error {
+
+	// Suppressing exhaustruct requirement because we want an object with zero values
+	// nolint: exhaustruct
+	unmarshalledPrivateStructPtr := &privatePortPublishSpec{}
+
+	if err := json.Unmarshal(data, unmarshalledPrivateStructPtr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unmarshalling the private struct")
+	}
+
+	spec.privatePortPublishSpec = unmarshalledPrivateStructPtr
+	return nil
+}
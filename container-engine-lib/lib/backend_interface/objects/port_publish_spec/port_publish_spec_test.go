@@ -0,0 +1,51 @@
+package port_publish_spec
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNewStaticPortPublishSpec_ErrorsOnZeroHostPort(t *testing.T) {
+	_, err := NewStaticPortPublishSpec(0)
+	require.Error(t, err)
+}
+
+func TestNewRangePortPublishSpec_ErrorsOnEmptyRangeName(t *testing.T) {
+	_, err := NewRangePortPublishSpec("")
+	require.Error(t, err)
+}
+
+func TestWithResolvedRange_OnlyWorksForRangeSpecs(t *testing.T) {
+	hostPortRange, err := NewHostPortRange(30000, 30010)
+	require.NoError(t, err)
+
+	_, err = NewEphemeralPortPublishSpec().WithResolvedRange(hostPortRange)
+	require.Error(t, err)
+
+	rangeSpec, err := NewRangePortPublishSpec("p2p")
+	require.NoError(t, err)
+	resolvedSpec, err := rangeSpec.WithResolvedRange(hostPortRange)
+	require.NoError(t, err)
+	require.Nil(t, rangeSpec.GetResolvedRange())
+	require.Equal(t, "p2p", resolvedSpec.GetRangeName())
+	require.Equal(t, hostPortRange, resolvedSpec.GetResolvedRange())
+}
+
+func TestPortPublishSpecMarshallers(t *testing.T) {
+	hostPortRange, err := NewHostPortRange(30000, 30010)
+	require.NoError(t, err)
+	rangeSpec, err := NewRangePortPublishSpec("p2p")
+	require.NoError(t, err)
+	originalSpec, err := rangeSpec.WithResolvedRange(hostPortRange)
+	require.NoError(t, err)
+
+	marshaledSpec, err := json.Marshal(originalSpec)
+	require.NoError(t, err)
+
+	// nolint: exhaustruct
+	newSpec := &PortPublishSpec{}
+	err = json.Unmarshal(marshaledSpec, newSpec)
+	require.NoError(t, err)
+	require.EqualValues(t, originalSpec, newSpec)
+}
@@ -2,12 +2,16 @@ package service
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_settings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_restart_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_user"
 	"github.com/kurtosis-tech/stacktrace"
 	v1 "k8s.io/api/core/v1"
@@ -36,7 +40,9 @@ type privateServiceConfig struct {
 
 	PrivatePorts map[string]*port_spec.PortSpec
 
-	PublicPorts map[string]*port_spec.PortSpec //TODO this is a huge hack to temporarily enable static ports for NEAR until we have a more productized solution
+	// How the private ports get published on the host, keyed by private port ID
+	// Private ports missing from it get published on an ephemeral host port
+	PortPublishSpecs map[string]*port_publish_spec.PortPublishSpec
 
 	EntrypointArgs []string
 
@@ -71,8 +77,46 @@ type privateServiceConfig struct {
 	ImageDownloadMode image_download_mode.ImageDownloadMode
 
 	FilesToBeMoved map[string]string
+
+	// If nil, the container engine applies its default restart policy
+	RestartPolicy *service_restart_policy.ServiceRestartPolicy
+
+	// Containers which run one after the other, each to completion, before the main container starts
+	InitContainers []*container_spec.ContainerSpec
+
+	// Containers which run next to the main container, for as long as it runs
+	Sidecars []*container_spec.ContainerSpec
+
+	// If nil, the container engine applies its default privileges, capabilities, kernel parameters and mounts
+	ContainerSettings *container_settings.ContainerSettings
+
+	// Names of the env vars holding secrets, which container engines keep out of service info and dumps
+	SecretEnvVarNames []string
+
+	// Map of filepaths on the container to the secret content written there before the container starts
+	SecretFiles map[string]string
+
+	// Name of the replicated service this service is a replica of; empty if the service isn't a replica
+	// Container engines make this name resolve to every replica of the group
+	ReplicaGroup string
+
+	// IP addresses of the nameservers the service resolves hostnames with; empty to use the default of the container engine
+	DnsServers []string
+
+	// Names of the named networks of the enclave the service is attached to, instead of the default enclave network
+	// Empty to attach the service to the default enclave network only
+	Networks []string
+
+	// Names of preexisting container engine networks the service gets attached to as well
+	ExternalNetworks []string
+
+	// Placeholder replaced with the private IPv6 address of the service, or with an empty string if the enclave
+	// doesn't have IPv6 enabled; empty to not replace anything
+	PrivateIPv6AddrPlaceholder string
 }
 
+// CreateServiceConfig creates a service config; the public ports are a shorthand to publish the private ports with the
+// same IDs on static host ports, other ways to publish ports are set with SetPortPublishSpecs
 func CreateServiceConfig(
 	containerImageName string,
 	imageBuildSpec *image_build_spec.ImageBuildSpec,
@@ -101,13 +145,18 @@ func CreateServiceConfig(
 		return nil, stacktrace.Propagate(err, "Invalid service config labels '%+v'", labels)
 	}
 
+	portPublishSpecs, err := getStaticPortPublishSpecs(publicPorts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred converting the public ports to port publish specs")
+	}
+
 	internalServiceConfig := &privateServiceConfig{
 		ContainerImageName:        containerImageName,
 		ImageBuildSpec:            imageBuildSpec,
 		ImagerRegistrySpec:        imageRegistrySpec,
 		NixBuildSpec:              nixBuildSpec,
 		PrivatePorts:              privatePorts,
-		PublicPorts:               publicPorts,
+		PortPublishSpecs:          portPublishSpecs,
 		EntrypointArgs:            entrypointArgs,
 		CmdArgs:                   cmdArgs,
 		EnvVars:                   envVars,
@@ -125,6 +174,17 @@ func CreateServiceConfig(
 		NodeSelectors:                nodeSelectors,
 		ImageDownloadMode:            imageDownloadMode,
 		FilesToBeMoved:               map[string]string{},
+		RestartPolicy:                nil,
+		InitContainers:               nil,
+		Sidecars:                     nil,
+		ContainerSettings:            nil,
+		SecretEnvVarNames:            nil,
+		SecretFiles:                  nil,
+		ReplicaGroup:                 "",
+		DnsServers:                   nil,
+		Networks:                     nil,
+		ExternalNetworks:             nil,
+		PrivateIPv6AddrPlaceholder:   "",
 	}
 	return &ServiceConfig{internalServiceConfig}, nil
 }
@@ -149,8 +209,12 @@ func (serviceConfig *ServiceConfig) GetPrivatePorts() map[string]*port_spec.Port
 	return serviceConfig.privateServiceConfig.PrivatePorts
 }
 
-func (serviceConfig *ServiceConfig) GetPublicPorts() map[string]*port_spec.PortSpec {
-	return serviceConfig.privateServiceConfig.PublicPorts
+func (serviceConfig *ServiceConfig) GetPortPublishSpecs() map[string]*port_publish_spec.PortPublishSpec {
+	return serviceConfig.privateServiceConfig.PortPublishSpecs
+}
+
+func (serviceConfig *ServiceConfig) SetPortPublishSpecs(portPublishSpecs map[string]*port_publish_spec.PortPublishSpec) {
+	serviceConfig.privateServiceConfig.PortPublishSpecs = portPublishSpecs
 }
 
 func (serviceConfig *ServiceConfig) GetEntrypointArgs() []string {
@@ -227,6 +291,94 @@ func (serviceConfig *ServiceConfig) GetFilesToBeMoved() map[string]string {
 	return serviceConfig.privateServiceConfig.FilesToBeMoved
 }
 
+func (serviceConfig *ServiceConfig) SetRestartPolicy(restartPolicy *service_restart_policy.ServiceRestartPolicy) {
+	serviceConfig.privateServiceConfig.RestartPolicy = restartPolicy
+}
+
+func (serviceConfig *ServiceConfig) GetRestartPolicy() *service_restart_policy.ServiceRestartPolicy {
+	return serviceConfig.privateServiceConfig.RestartPolicy
+}
+
+func (serviceConfig *ServiceConfig) SetInitContainers(initContainers []*container_spec.ContainerSpec) {
+	serviceConfig.privateServiceConfig.InitContainers = initContainers
+}
+
+func (serviceConfig *ServiceConfig) GetInitContainers() []*container_spec.ContainerSpec {
+	return serviceConfig.privateServiceConfig.InitContainers
+}
+
+func (serviceConfig *ServiceConfig) SetSidecars(sidecars []*container_spec.ContainerSpec) {
+	serviceConfig.privateServiceConfig.Sidecars = sidecars
+}
+
+func (serviceConfig *ServiceConfig) GetSidecars() []*container_spec.ContainerSpec {
+	return serviceConfig.privateServiceConfig.Sidecars
+}
+
+func (serviceConfig *ServiceConfig) SetContainerSettings(containerSettings *container_settings.ContainerSettings) {
+	serviceConfig.privateServiceConfig.ContainerSettings = containerSettings
+}
+
+func (serviceConfig *ServiceConfig) GetContainerSettings() *container_settings.ContainerSettings {
+	return serviceConfig.privateServiceConfig.ContainerSettings
+}
+
+func (serviceConfig *ServiceConfig) SetSecretEnvVarNames(secretEnvVarNames []string) {
+	serviceConfig.privateServiceConfig.SecretEnvVarNames = secretEnvVarNames
+}
+
+func (serviceConfig *ServiceConfig) GetSecretEnvVarNames() []string {
+	return serviceConfig.privateServiceConfig.SecretEnvVarNames
+}
+
+func (serviceConfig *ServiceConfig) SetSecretFiles(secretFiles map[string]string) {
+	serviceConfig.privateServiceConfig.SecretFiles = secretFiles
+}
+
+func (serviceConfig *ServiceConfig) GetSecretFiles() map[string]string {
+	return serviceConfig.privateServiceConfig.SecretFiles
+}
+
+func (serviceConfig *ServiceConfig) SetReplicaGroup(replicaGroup string) {
+	serviceConfig.privateServiceConfig.ReplicaGroup = replicaGroup
+}
+
+func (serviceConfig *ServiceConfig) GetReplicaGroup() string {
+	return serviceConfig.privateServiceConfig.ReplicaGroup
+}
+
+func (serviceConfig *ServiceConfig) SetDnsServers(dnsServers []string) {
+	serviceConfig.privateServiceConfig.DnsServers = dnsServers
+}
+
+func (serviceConfig *ServiceConfig) GetDnsServers() []string {
+	return serviceConfig.privateServiceConfig.DnsServers
+}
+
+func (serviceConfig *ServiceConfig) SetNetworks(networks []string) {
+	serviceConfig.privateServiceConfig.Networks = networks
+}
+
+func (serviceConfig *ServiceConfig) GetNetworks() []string {
+	return serviceConfig.privateServiceConfig.Networks
+}
+
+func (serviceConfig *ServiceConfig) SetExternalNetworks(externalNetworks []string) {
+	serviceConfig.privateServiceConfig.ExternalNetworks = externalNetworks
+}
+
+func (serviceConfig *ServiceConfig) GetExternalNetworks() []string {
+	return serviceConfig.privateServiceConfig.ExternalNetworks
+}
+
+func (serviceConfig *ServiceConfig) SetPrivateIPv6AddrPlaceholder(privateIPv6AddrPlaceholder string) {
+	serviceConfig.privateServiceConfig.PrivateIPv6AddrPlaceholder = privateIPv6AddrPlaceholder
+}
+
+func (serviceConfig *ServiceConfig) GetPrivateIPv6AddrPlaceholder() string {
+	return serviceConfig.privateServiceConfig.PrivateIPv6AddrPlaceholder
+}
+
 func (serviceConfig *ServiceConfig) UnmarshalJSON(data []byte) error {
 
 	// Suppressing exhaustruct requirement because we want an object with zero values
@@ -237,6 +389,53 @@ func (serviceConfig *ServiceConfig) UnmarshalJSON(data []byte) error {
 		return stacktrace.Propagate(err, "An error occurred unmarshalling the private struct")
 	}
 
+	// Service configs persisted before port publish specs existed carry static host ports as public ports
+	if unmarshalledPrivateStructPtr.PortPublishSpecs == nil {
+		// nolint: exhaustruct
+		legacyServiceConfig := &legacyPublicPortsServiceConfig{}
+		if err := json.Unmarshal(data, legacyServiceConfig); err != nil {
+			return stacktrace.Propagate(err, "An error occurred unmarshalling the public ports of the service config")
+		}
+		portPublishSpecs, err := getStaticPortPublishSpecs(legacyServiceConfig.PublicPorts)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred converting the public ports of the service config to port publish specs")
+		}
+		unmarshalledPrivateStructPtr.PortPublishSpecs = portPublishSpecs
+	}
+
 	serviceConfig.privateServiceConfig = unmarshalledPrivateStructPtr
 	return nil
 }
+
+// Copy returns a deep copy of the service config, e.g. to start several services off of the same config
+func (serviceConfig *ServiceConfig) Copy() (*ServiceConfig, error) {
+	serviceConfigBytes, err := json.Marshal(serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred marshalling the service config")
+	}
+	// nolint: exhaustruct
+	serviceConfigCopy := &ServiceConfig{}
+	if err := json.Unmarshal(serviceConfigBytes, serviceConfigCopy); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred unmarshalling the copy of the service config")
+	}
+	return serviceConfigCopy, nil
+}
+
+type legacyPublicPortsServiceConfig struct {
+	PublicPorts map[string]*port_spec.PortSpec
+}
+
+func getStaticPortPublishSpecs(publicPorts map[string]*port_spec.PortSpec) (map[string]*port_publish_spec.PortPublishSpec, error) {
+	if len(publicPorts) == 0 {
+		return nil, nil
+	}
+	portPublishSpecs := make(map[string]*port_publish_spec.PortPublishSpec, len(publicPorts))
+	for portId, publicPortSpec := range publicPorts {
+		portPublishSpec, err := port_publish_spec.NewStaticPortPublishSpec(publicPortSpec.GetNumber())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the port publish spec of public port '%v'", portId)
+		}
+		portPublishSpecs[portId] = portPublishSpec
+	}
+	return portPublishSpecs, nil
+}
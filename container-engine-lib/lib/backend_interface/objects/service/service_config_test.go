@@ -5,12 +5,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_settings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_restart_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_user"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
@@ -41,12 +45,8 @@ func TestServiceConfigMarshallers(t *testing.T) {
 		require.EqualValues(t, privatePortSpec, originalPrivetPortSpec)
 	}
 
-	originalServiceConfigPublicPorts := originalServiceConfig.GetPublicPorts()
-	for privatePortId, publicPortSpec := range newServiceConfig.GetPublicPorts() {
-		originalPublicPortSpec, found := originalServiceConfigPublicPorts[privatePortId]
-		require.True(t, found)
-		require.EqualValues(t, publicPortSpec, originalPublicPortSpec)
-	}
+	require.Len(t, newServiceConfig.GetPortPublishSpecs(), 2)
+	require.EqualValues(t, originalServiceConfig.GetPortPublishSpecs(), newServiceConfig.GetPortPublishSpecs())
 
 	require.Equal(t, originalServiceConfig, newServiceConfig)
 	require.Equal(t, originalServiceConfig.GetEnvVars(), newServiceConfig.GetEnvVars())
@@ -62,6 +62,43 @@ func TestServiceConfigMarshallers(t *testing.T) {
 	require.Equal(t, originalServiceConfig.GetLabels(), newServiceConfig.GetLabels())
 	require.Equal(t, originalServiceConfig.GetImageBuildSpec(), newServiceConfig.GetImageBuildSpec())
 	require.Equal(t, originalServiceConfig.GetNodeSelectors(), newServiceConfig.GetNodeSelectors())
+	require.Equal(t, originalServiceConfig.GetRestartPolicy(), newServiceConfig.GetRestartPolicy())
+	require.Equal(t, originalServiceConfig.GetInitContainers(), newServiceConfig.GetInitContainers())
+	require.Equal(t, originalServiceConfig.GetSidecars(), newServiceConfig.GetSidecars())
+	require.Equal(t, originalServiceConfig.GetContainerSettings(), newServiceConfig.GetContainerSettings())
+	require.Equal(t, originalServiceConfig.GetSecretEnvVarNames(), newServiceConfig.GetSecretEnvVarNames())
+	require.Equal(t, originalServiceConfig.GetSecretFiles(), newServiceConfig.GetSecretFiles())
+	require.Equal(t, originalServiceConfig.GetReplicaGroup(), newServiceConfig.GetReplicaGroup())
+	require.Equal(t, originalServiceConfig.GetDnsServers(), newServiceConfig.GetDnsServers())
+	require.Equal(t, originalServiceConfig.GetNetworks(), newServiceConfig.GetNetworks())
+	require.Equal(t, originalServiceConfig.GetExternalNetworks(), newServiceConfig.GetExternalNetworks())
+	require.Equal(t, originalServiceConfig.GetPrivateIPv6AddrPlaceholder(), newServiceConfig.GetPrivateIPv6AddrPlaceholder())
+}
+
+func TestServiceConfigUnmarshaller_ConvertsLegacyPublicPorts(t *testing.T) {
+	legacyServiceConfigJson := []byte(`{"ContainerImageName":"image:tag","PublicPorts":{"rpc":{"Number":8545,"TransportProtocol":0}}}`)
+
+	// nolint: exhaustruct
+	serviceConfig := &ServiceConfig{}
+	err := json.Unmarshal(legacyServiceConfigJson, serviceConfig)
+	require.NoError(t, err)
+
+	portPublishSpec, found := serviceConfig.GetPortPublishSpecs()["rpc"]
+	require.True(t, found)
+	require.Equal(t, port_publish_spec.PortPublishMode_Static, portPublishSpec.GetMode())
+	require.Equal(t, uint16(8545), portPublishSpec.GetHostPortNumber())
+}
+
+func TestServiceConfigCopy(t *testing.T) {
+	originalServiceConfig := getServiceConfigForTest(t, "image:tag")
+
+	serviceConfigCopy, err := originalServiceConfig.Copy()
+	require.NoError(t, err)
+	require.Equal(t, originalServiceConfig.GetReplicaGroup(), serviceConfigCopy.GetReplicaGroup())
+	require.Equal(t, originalServiceConfig.GetCmdArgs(), serviceConfigCopy.GetCmdArgs())
+
+	serviceConfigCopy.GetCmdArgs()[0] = "--verbose"
+	require.Equal(t, "-l", originalServiceConfig.GetCmdArgs()[0])
 }
 
 func getServiceConfigForTest(t *testing.T, imageName string) *ServiceConfig {
@@ -92,9 +129,57 @@ func getServiceConfigForTest(t *testing.T, imageName string) *ServiceConfig {
 		testImageDownloadMode(),
 	)
 	require.NoError(t, err)
+	serviceConfig.SetRestartPolicy(testRestartPolicy(t))
+	serviceConfig.SetInitContainers([]*container_spec.ContainerSpec{testContainerSpec(t, "migrations")})
+	serviceConfig.SetSidecars([]*container_spec.ContainerSpec{testContainerSpec(t, "metrics-exporter")})
+	serviceConfig.SetContainerSettings(testContainerSettings(t))
+	serviceConfig.SetSecretEnvVarNames([]string{"DB_PASSWORD"})
+	serviceConfig.SetSecretFiles(map[string]string{"/run/secrets/api-token": "{{kurtosis:secret:api-token}}"})
+	serviceConfig.SetReplicaGroup("api")
+	serviceConfig.SetDnsServers([]string{"172.16.0.3"})
+	serviceConfig.SetNetworks([]string{"frontend", "backend"})
+	serviceConfig.SetExternalNetworks([]string{"lab-network"})
+	serviceConfig.SetPrivateIPv6AddrPlaceholder("IPV6-ADDRESS")
 	return serviceConfig
 }
 
+func testContainerSettings(t *testing.T) *container_settings.ContainerSettings {
+	nofileUlimit, err := container_settings.NewUlimit(1024, 65536)
+	require.NoError(t, err)
+	containerSettings, err := container_settings.NewContainerSettings(
+		false,
+		[]string{"NET_ADMIN", "SYS_PTRACE"},
+		[]string{"MKNOD"},
+		map[string]string{"net.core.somaxconn": "1024"},
+		map[string]*container_settings.Ulimit{"nofile": nofileUlimit},
+		256,
+		true,
+		map[string]uint64{"/tmp": 64},
+		map[string]string{"db.local": "10.0.0.5"},
+	)
+	require.NoError(t, err)
+	return containerSettings
+}
+
+func testContainerSpec(t *testing.T, name string) *container_spec.ContainerSpec {
+	containerSpec, err := container_spec.NewContainerSpec(
+		name,
+		"alpine:3.19",
+		[]string{"sh", "-c"},
+		[]string{"echo hello"},
+		map[string]string{"KEY": "value"},
+		map[string]string{"/data": "dirpath1"},
+	)
+	require.NoError(t, err)
+	return containerSpec
+}
+
+func testRestartPolicy(t *testing.T) *service_restart_policy.ServiceRestartPolicy {
+	restartPolicy, err := service_restart_policy.NewServiceRestartPolicy(service_restart_policy.ServiceRestartPolicyMode_OnFailure, 5)
+	require.NoError(t, err)
+	return restartPolicy
+}
+
 func testPersistentDirectory() *service_directory.PersistentDirectories {
 	persistentDirectoriesMap := map[string]service_directory.PersistentDirectory{
 		"dirpath1": {PersistentKey: service_directory.DirectoryPersistentKey("dirpath1_persistent_directory_key"), Size: service_directory.DirectoryPersistentSize(int64(0))},
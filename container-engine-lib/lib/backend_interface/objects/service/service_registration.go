@@ -27,6 +27,9 @@ type privateServiceRegistration struct {
 	// with the service
 	PrivateIp net.IP
 
+	// The private IPv6 address of the service within the enclave; nil if the enclave doesn't have IPv6 enabled
+	PrivateIpv6 net.IP
+
 	// The hostname differs whether Kurtosis is using a Kubernetes backend of a Docker backend.
 	// In Docker, we set the serviceName as the hostname, whereas in Kubernetes the hostname is automatically assigned
 	// to the "Kubernetes Service Name", which is something like user-services-<SERVICE_UUID>
@@ -47,6 +50,7 @@ func NewServiceRegistration(name ServiceName, uuid ServiceUUID, enclaveUuid encl
 		Uuid:        uuid,
 		EnclaveUuid: enclaveUuid,
 		PrivateIp:   privateIp,
+		PrivateIpv6: nil,
 		Hostname:    hostname,
 		Status:      ServiceStatus_Registered,
 		Config:      nil,
@@ -72,6 +76,14 @@ func (registration *ServiceRegistration) GetPrivateIP() net.IP {
 	return registration.privateServiceRegistration.PrivateIp
 }
 
+func (registration *ServiceRegistration) GetPrivateIPv6() net.IP {
+	return registration.privateServiceRegistration.PrivateIpv6
+}
+
+func (registration *ServiceRegistration) SetPrivateIPv6(privateIpv6 net.IP) {
+	registration.privateServiceRegistration.PrivateIpv6 = privateIpv6
+}
+
 func (registration *ServiceRegistration) GetHostname() string {
 	return registration.privateServiceRegistration.Hostname
 }
@@ -21,6 +21,7 @@ func TestServiceRegistrationMarshallers(t *testing.T) {
 	serviceConfig := getServiceConfigForTest(t, "image-name:tag-version")
 
 	originalServiceRegistration.SetStatus(startedServiceStatus)
+	originalServiceRegistration.SetPrivateIPv6(net.ParseIP("fd6b:7572:746f:1000::c633:6479"))
 	originalServiceRegistration.SetConfig(serviceConfig)
 
 	marshaledServiceRegistration, err := json.Marshal(originalServiceRegistration)
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"time"
+)
+
+// ServiceStats is the resource usage of the container of a service, at the time it was sampled
+type ServiceStats struct {
+	// In millicores, the same unit as the min and max CPU of the service config
+	cpuUsageMilliCores uint64
+
+	memoryUsageBytes uint64
+
+	// Zero if the memory of the container isn't limited
+	memoryLimitBytes uint64
+
+	// Nil if the backend doesn't report network and disk I/O, as is the case with Kubernetes
+	maybeIoStats *ServiceIoStats
+
+	sampleTime time.Time
+}
+
+// ServiceIoStats are the bytes the container of a service sent and read since it started
+type ServiceIoStats struct {
+	networkReceivedBytes uint64
+
+	networkSentBytes uint64
+
+	diskReadBytes uint64
+
+	diskWrittenBytes uint64
+}
+
+func NewServiceStats(cpuUsageMilliCores uint64, memoryUsageBytes uint64, memoryLimitBytes uint64, maybeIoStats *ServiceIoStats, sampleTime time.Time) *ServiceStats {
+	return &ServiceStats{cpuUsageMilliCores: cpuUsageMilliCores, memoryUsageBytes: memoryUsageBytes, memoryLimitBytes: memoryLimitBytes, maybeIoStats: maybeIoStats, sampleTime: sampleTime}
+}
+
+func NewServiceIoStats(networkReceivedBytes uint64, networkSentBytes uint64, diskReadBytes uint64, diskWrittenBytes uint64) *ServiceIoStats {
+	return &ServiceIoStats{networkReceivedBytes: networkReceivedBytes, networkSentBytes: networkSentBytes, diskReadBytes: diskReadBytes, diskWrittenBytes: diskWrittenBytes}
+}
+
+func (stats *ServiceStats) GetCpuUsageMilliCores() uint64 {
+	return stats.cpuUsageMilliCores
+}
+
+func (stats *ServiceStats) GetMemoryUsageBytes() uint64 {
+	return stats.memoryUsageBytes
+}
+
+func (stats *ServiceStats) GetMemoryLimitBytes() uint64 {
+	return stats.memoryLimitBytes
+}
+
+func (stats *ServiceStats) GetMaybeIoStats() *ServiceIoStats {
+	return stats.maybeIoStats
+}
+
+func (stats *ServiceStats) GetSampleTime() time.Time {
+	return stats.sampleTime
+}
+
+func (ioStats *ServiceIoStats) GetNetworkReceivedBytes() uint64 {
+	return ioStats.networkReceivedBytes
+}
+
+func (ioStats *ServiceIoStats) GetNetworkSentBytes() uint64 {
+	return ioStats.networkSentBytes
+}
+
+func (ioStats *ServiceIoStats) GetDiskReadBytes() uint64 {
+	return ioStats.diskReadBytes
+}
+
+func (ioStats *ServiceIoStats) GetDiskWrittenBytes() uint64 {
+	return ioStats.diskWrittenBytes
+}
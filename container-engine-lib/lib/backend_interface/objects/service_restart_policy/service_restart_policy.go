@@ -0,0 +1,86 @@
+package service_restart_policy
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+type ServiceRestartPolicyMode string
+
+const (
+	// The container is never restarted
+	ServiceRestartPolicyMode_Never ServiceRestartPolicyMode = "never"
+
+	// The container is restarted when it exits with a non-zero code, up to the max retries if there are some
+	ServiceRestartPolicyMode_OnFailure ServiceRestartPolicyMode = "on-failure"
+
+	// The container is restarted whenever it exits
+	ServiceRestartPolicyMode_Always ServiceRestartPolicyMode = "always"
+
+	// No max retries means the container is restarted for as long as it keeps failing
+	unlimitedMaxRetries = 0
+)
+
+// ServiceRestartPolicy tells the container engine what to do with the container of a service when it exits. Backoff
+// is not supported: the container engine restarts the containers with an exponential backoff between two restarts,
+// which neither engine lets be configured per container: Docker's restart policy only has a name and a max
+// retry count, its delay being hardcoded in the daemon, and the CrashLoopBackOff delay of Kubernetes is a kubelet
+// setting shared by every pod of the node. Kurtosis restarting the containers itself to apply a custom delay would
+// bypass the engines' restart counts, which the max retries and the crash detection of the services rely on.
+type ServiceRestartPolicy struct {
+	privateServiceRestartPolicy *privateServiceRestartPolicy
+}
+
+type privateServiceRestartPolicy struct {
+	Mode ServiceRestartPolicyMode
+
+	MaxRetries uint32
+}
+
+func NewServiceRestartPolicy(mode ServiceRestartPolicyMode, maxRetries uint32) (*ServiceRestartPolicy, error) {
+	switch mode {
+	case ServiceRestartPolicyMode_Never, ServiceRestartPolicyMode_Always:
+		if maxRetries != unlimitedMaxRetries {
+			return nil, stacktrace.NewError("Max retries can only be set with restart policy '%v', but restart policy was '%v'", ServiceRestartPolicyMode_OnFailure, mode)
+		}
+	case ServiceRestartPolicyMode_OnFailure:
+		// Any max retries is valid
+	default:
+		return nil, stacktrace.NewError("Unrecognized restart policy '%v'; valid restart policies are '%v', '%v' and '%v'", mode, ServiceRestartPolicyMode_Never, ServiceRestartPolicyMode_OnFailure, ServiceRestartPolicyMode_Always)
+	}
+	internalServiceRestartPolicy := &privateServiceRestartPolicy{
+		Mode:       mode,
+		MaxRetries: maxRetries,
+	}
+	return &ServiceRestartPolicy{privateServiceRestartPolicy: internalServiceRestartPolicy}, nil
+}
+
+func (policy *ServiceRestartPolicy) GetMode() ServiceRestartPolicyMode {
+	return policy.privateServiceRestartPolicy.Mode
+}
+
+// GetMaxRetries returns how many times the container gets restarted at most, and false if there's no limit
+func (policy *ServiceRestartPolicy) GetMaxRetries() (uint32, bool) {
+	if policy.privateServiceRestartPolicy.MaxRetries == unlimitedMaxRetries {
+		return 0, false
+	}
+	return policy.privateServiceRestartPolicy.MaxRetries, true
+}
+
+func (policy ServiceRestartPolicy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(policy.privateServiceRestartPolicy)
+}
+
+func (policy *ServiceRestartPolicy) UnmarshalJSON(data []byte) error {
+
+	// Suppressing exhaustruct requirement because we want an object with zero values
+	// nolint: exhaustruct
+	unmarshalledPrivateStructPtr := &privateServiceRestartPolicy{}
+
+	if err := json.Unmarshal(data, unmarshalledPrivateStructPtr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unmarshalling the private struct")
+	}
+
+	policy.privateServiceRestartPolicy = unmarshalledPrivateStructPtr
+	return nil
+}
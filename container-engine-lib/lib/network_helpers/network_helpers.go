@@ -9,8 +9,19 @@ import (
 const (
 	allOnesFourBytesMask = 0xffffffff
 	ipV6SuffixStart      = 12
+	numBitsInByte        = 8
+
+	// Every IPv6 subnet we hand out is a /64 inside this unique local address (RFC 4193) prefix; the two bytes after
+	// the prefix are taken from the IPv4 subnet it pairs with, so both subnets of a dual-stack network can be derived
+	// from one another
+	ipv6SubnetPrefixLen       = 6
+	ipv6SubnetMaskBits        = 64
+	ipv6SubnetIpv4OctetsStart = 1
+	ipv6SubnetIpv4OctetsEnd   = 3
 )
 
+var ipv6SubnetPrefix = [ipv6SubnetPrefixLen]byte{0xfd, 0x6b, 0x75, 0x72, 0x74, 0x6f}
+
 /*
 GetFreeIpAddrFromSubnet
 Gets a free IP address from the subnet that the IP tracker was initialized with.
@@ -56,3 +67,46 @@ func GetFreeIpAddrFromSubnet(takenIps map[string]bool, subnet *net.IPNet) (net.I
 	}
 	return nil, stacktrace.NewError("Failed to allocate IpAddr on subnet %v - all taken.", subnet)
 }
+
+/*
+GetIpv6SubnetForIpv4Subnet
+Gets the IPv6 /64 subnet that pairs with the given IPv4 subnet in a dual-stack network. The second and third octets of
+the IPv4 subnet are embedded in the IPv6 subnet, so two different IPv4 subnets never map to the same IPv6 subnet as
+long as they differ in those octets.
+*/
+func GetIpv6SubnetForIpv4Subnet(ipv4Subnet *net.IPNet) (*net.IPNet, error) {
+	ipv4SubnetIp := ipv4Subnet.IP.To4()
+	if ipv4SubnetIp == nil {
+		return nil, stacktrace.NewError("Expected subnet '%v' to be an IPv4 subnet, but it isn't", ipv4Subnet)
+	}
+	ipv6SubnetIp := make(net.IP, net.IPv6len)
+	copy(ipv6SubnetIp, ipv6SubnetPrefix[:])
+	copy(ipv6SubnetIp[ipv6SubnetPrefixLen:], ipv4SubnetIp[ipv6SubnetIpv4OctetsStart:ipv6SubnetIpv4OctetsEnd])
+	return &net.IPNet{
+		IP:   ipv6SubnetIp,
+		Mask: net.CIDRMask(ipv6SubnetMaskBits, net.IPv6len*numBitsInByte),
+	}, nil
+}
+
+/*
+GetIpv6AddrForIpv4Addr
+Gets the IPv6 address that pairs with the given IPv4 address inside the given IPv6 subnet, by placing the IPv4 address in
+the last four bytes of the subnet. As the IPv4 addresses of a network are unique, so are the IPv6 addresses derived
+from them.
+*/
+func GetIpv6AddrForIpv4Addr(ipv4Addr net.IP, ipv6Subnet *net.IPNet) (net.IP, error) {
+	ipv4AddrBytes := ipv4Addr.To4()
+	if ipv4AddrBytes == nil {
+		return nil, stacktrace.NewError("Expected IP '%v' to be an IPv4 address, but it isn't", ipv4Addr)
+	}
+	if len(ipv6Subnet.IP) != net.IPv6len || ipv6Subnet.IP.To4() != nil {
+		return nil, stacktrace.NewError("Expected subnet '%v' to be an IPv6 subnet, but it isn't", ipv6Subnet)
+	}
+	ipv6Addr := make(net.IP, net.IPv6len)
+	copy(ipv6Addr, ipv6Subnet.IP.Mask(ipv6Subnet.Mask))
+	copy(ipv6Addr[ipV6SuffixStart:], ipv4AddrBytes)
+	if !ipv6Subnet.Contains(ipv6Addr) {
+		return nil, stacktrace.NewError("IPv6 subnet '%v' is too small to hold an address derived from IPv4 address '%v'", ipv6Subnet, ipv4Addr)
+	}
+	return ipv6Addr, nil
+}
@@ -0,0 +1,49 @@
+package network_helpers
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIpv6SubnetForIpv4Subnet(t *testing.T) {
+	_, ipv4Subnet, err := net.ParseCIDR("172.16.12.0/22")
+	require.NoError(t, err)
+
+	ipv6Subnet, err := GetIpv6SubnetForIpv4Subnet(ipv4Subnet)
+	require.NoError(t, err)
+	require.Equal(t, "fd6b:7572:746f:100c::/64", ipv6Subnet.String())
+
+	_, otherIpv4Subnet, err := net.ParseCIDR("172.16.16.0/22")
+	require.NoError(t, err)
+	otherIpv6Subnet, err := GetIpv6SubnetForIpv4Subnet(otherIpv4Subnet)
+	require.NoError(t, err)
+	require.NotEqual(t, ipv6Subnet.String(), otherIpv6Subnet.String())
+}
+
+func TestGetIpv6SubnetForIpv4Subnet_RejectsIpv6(t *testing.T) {
+	_, ipv6Subnet, err := net.ParseCIDR("fd00::/64")
+	require.NoError(t, err)
+
+	_, err = GetIpv6SubnetForIpv4Subnet(ipv6Subnet)
+	require.Error(t, err)
+}
+
+func TestGetIpv6AddrForIpv4Addr(t *testing.T) {
+	_, ipv6Subnet, err := net.ParseCIDR("fd6b:7572:746f:100c::/64")
+	require.NoError(t, err)
+
+	ipv6Addr, err := GetIpv6AddrForIpv4Addr(net.ParseIP("172.16.12.3"), ipv6Subnet)
+	require.NoError(t, err)
+	require.Equal(t, "fd6b:7572:746f:100c::ac10:c03", ipv6Addr.String())
+	require.True(t, ipv6Subnet.Contains(ipv6Addr))
+}
+
+func TestGetIpv6AddrForIpv4Addr_RejectsIpv4Subnet(t *testing.T) {
+	_, ipv4Subnet, err := net.ParseCIDR("172.16.12.0/22")
+	require.NoError(t, err)
+
+	_, err = GetIpv6AddrForIpv4Addr(net.ParseIP("172.16.12.3"), ipv4Subnet)
+	require.Error(t, err)
+}
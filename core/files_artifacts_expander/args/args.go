@@ -15,6 +15,8 @@ import (
 // JSON-serialized args that the files artifacts expander container take in
 const (
 	jsonFieldTag = "json"
+
+	optionalAccessTokenFieldName = "ApiContainerAccessToken"
 )
 
 // Fields are public for JSON de/serialization
@@ -22,6 +24,9 @@ type FilesArtifactsExpanderArgs struct {
 	APIContainerIpAddress   string                   `json:"apiContainerIpAddress"`
 	ApiContainerPort        uint16                   `json:"apiContainerPort"`
 	FilesArtifactExpansions []FilesArtifactExpansion `json:"filesArtifactExpansions"`
+
+	// Sent to the API container on every call, empty if the API container doesn't check access tokens
+	ApiContainerAccessToken string `json:"apiContainerAccessToken,omitempty"`
 }
 
 type FilesArtifactExpansion struct {
@@ -31,11 +36,12 @@ type FilesArtifactExpansion struct {
 	DirPathToExpandTo string `json:"dirPathToExpandTo"`
 }
 
-func NewFilesArtifactsExpanderArgs(apiContainerIpAddress string, apiContainerPort uint16, filesArtifactExpansions []FilesArtifactExpansion) (*FilesArtifactsExpanderArgs, error) {
+func NewFilesArtifactsExpanderArgs(apiContainerIpAddress string, apiContainerPort uint16, filesArtifactExpansions []FilesArtifactExpansion, apiContainerAccessToken string) (*FilesArtifactsExpanderArgs, error) {
 	result := &FilesArtifactsExpanderArgs{
 		APIContainerIpAddress:   apiContainerIpAddress,
 		ApiContainerPort:        apiContainerPort,
 		FilesArtifactExpansions: filesArtifactExpansions,
+		ApiContainerAccessToken: apiContainerAccessToken,
 	}
 	logrus.Debugf("Expander args: %+v", result)
 	if err := result.validate(); err != nil {
@@ -53,6 +59,9 @@ func (args FilesArtifactsExpanderArgs) validate() error {
 	for i := 0; i < reflectValType.NumField(); i++ {
 		field := reflectValType.Field(i)
 		jsonFieldName := field.Tag.Get(jsonFieldTag)
+		if field.Name == optionalAccessTokenFieldName {
+			continue
+		}
 
 		// Ensure no empty strings
 		strVal := reflectVal.Field(i).String()
@@ -16,6 +16,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"net"
 	"os"
 	"os/exec"
@@ -32,6 +33,10 @@ const (
 
 	forceColors   = true
 	fullTimestamp = true
+
+	// How the access token issued by the API container gets sent back to it
+	authorizationMetadataKey = "authorization"
+	bearerPrefix             = "Bearer "
 )
 
 func main() {
@@ -84,6 +89,9 @@ func runMain() error {
 
 	apiContainerClient := kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(apiContainerConnection)
 	backgroundContext := context.Background()
+	if filesArtifactExpanderArgs.ApiContainerAccessToken != "" {
+		backgroundContext = metadata.AppendToOutgoingContext(backgroundContext, authorizationMetadataKey, bearerPrefix+filesArtifactExpanderArgs.ApiContainerAccessToken)
+	}
 
 	// Download and extract the file artifacts in the args
 	filesArtifactWorkerPool := workerpool.New(maxWorkers)
@@ -0,0 +1,177 @@
+package api_container_access
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// AccessLevel is what an access token allows on the API container of its enclave
+type AccessLevel string
+
+const (
+	// Inspect the enclave, its services and files artifacts
+	AccessLevel_Read AccessLevel = "read"
+
+	// Anything the API container does, e.g. run Starlark, add, update or remove services and upload files artifacts
+	AccessLevel_Manage AccessLevel = "manage"
+
+	// Everything the manage access level allows, plus what only the engine is trusted with, e.g. setting what the
+	// resource quotas of the enclave leave to it
+	AccessLevel_Engine AccessLevel = "engine"
+)
+
+// Each access level allows everything the lower ones do
+var accessLevelRanks = map[AccessLevel]int{
+	AccessLevel_Read:   0,
+	AccessLevel_Manage: 1,
+	AccessLevel_Engine: 2,
+}
+
+const (
+	// The gRPC metadata key the access token is sent in, as 'Bearer <token>'
+	AuthorizationMetadataKey = "authorization"
+	BearerPrefix             = "Bearer "
+
+	tokenPartsSeparator = "."
+	numTokenParts       = 2
+)
+
+var tokenEncoding = base64.RawURLEncoding
+
+// accessTokenClaims is the signed content of an access token
+type accessTokenClaims struct {
+	EnclaveUuid string `json:"enclaveUuid"`
+
+	// Who the token got issued to, for the logs of the API container
+	Subject string `json:"subject"`
+
+	AccessLevel AccessLevel `json:"accessLevel"`
+
+	// Unix time in seconds
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+// AccessToken is an access token that passed verification
+type AccessToken struct {
+	subject string
+
+	accessLevel AccessLevel
+}
+
+func (token *AccessToken) GetSubject() string {
+	return token.subject
+}
+
+// Allows returns true if the access level allows what the given one does
+func (level AccessLevel) Allows(otherLevel AccessLevel) bool {
+	rank, found := accessLevelRanks[level]
+	otherRank, isOtherFound := accessLevelRanks[otherLevel]
+	return found && isOtherFound && rank >= otherRank
+}
+
+func (token *AccessToken) GetAccessLevel() AccessLevel {
+	return token.accessLevel
+}
+
+// TokenSigner signs and verifies the access tokens of the API container of one enclave. The engine derives the key of
+// each enclave from its own secret, so the key given to the API container of an enclave is worthless for the others
+type TokenSigner struct {
+	enclaveUuid string
+
+	key []byte
+}
+
+// NewTokenSignerFromSecret is used by the engine, which holds the secret the keys of all the enclaves are derived from
+func NewTokenSignerFromSecret(secret []byte, enclaveUuid string) *TokenSigner {
+	keyHash := hmac.New(sha256.New, secret)
+	keyHash.Write([]byte(enclaveUuid))
+	return &TokenSigner{
+		enclaveUuid: enclaveUuid,
+		key:         keyHash.Sum(nil),
+	}
+}
+
+// NewTokenSignerFromKey is used by the API container, with the key the engine launched it with
+func NewTokenSignerFromKey(hexKey string, enclaveUuid string) (*TokenSigner, error) {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding the access token key of enclave '%v'", enclaveUuid)
+	}
+	if len(key) == 0 {
+		return nil, stacktrace.NewError("The access token key of enclave '%v' is empty", enclaveUuid)
+	}
+	return &TokenSigner{
+		enclaveUuid: enclaveUuid,
+		key:         key,
+	}, nil
+}
+
+// GetHexKey returns the key the API container gets launched with
+func (signer *TokenSigner) GetHexKey() string {
+	return hex.EncodeToString(signer.key)
+}
+
+func (signer *TokenSigner) IssueToken(subject string, accessLevel AccessLevel, validity time.Duration) (string, error) {
+	claims := &accessTokenClaims{
+		EnclaveUuid: signer.enclaveUuid,
+		Subject:     subject,
+		AccessLevel: accessLevel,
+		ExpiresAt:   time.Now().Add(validity).Unix(),
+	}
+	claimsBytes, err := json.Marshal(claims)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the claims of the access token of '%v'", subject)
+	}
+	encodedClaims := tokenEncoding.EncodeToString(claimsBytes)
+	return encodedClaims + tokenPartsSeparator + tokenEncoding.EncodeToString(signer.sign(encodedClaims)), nil
+}
+
+// VerifyToken returns an error unless the token got signed for this enclave and hasn't expired
+func (signer *TokenSigner) VerifyToken(token string) (*AccessToken, error) {
+	tokenParts := strings.Split(token, tokenPartsSeparator)
+	if len(tokenParts) != numTokenParts {
+		return nil, stacktrace.NewError("The access token is malformed")
+	}
+	encodedClaims, encodedSignature := tokenParts[0], tokenParts[1]
+	signature, err := tokenEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding the signature of the access token")
+	}
+	if !hmac.Equal(signature, signer.sign(encodedClaims)) {
+		return nil, stacktrace.NewError("The access token wasn't issued for enclave '%v'", signer.enclaveUuid)
+	}
+	claimsBytes, err := tokenEncoding.DecodeString(encodedClaims)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding the claims of the access token")
+	}
+	claims := &accessTokenClaims{} //nolint:exhaustruct
+	if err := json.Unmarshal(claimsBytes, claims); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the claims of the access token")
+	}
+	if claims.EnclaveUuid != signer.enclaveUuid {
+		return nil, stacktrace.NewError("The access token was issued for enclave '%v', not for enclave '%v'", claims.EnclaveUuid, signer.enclaveUuid)
+	}
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, stacktrace.NewError("The access token of '%v' has expired", claims.Subject)
+	}
+	if _, found := accessLevelRanks[claims.AccessLevel]; !found {
+		return nil, stacktrace.NewError("The access token of '%v' has unrecognized access level '%v'", claims.Subject, claims.AccessLevel)
+	}
+	return &AccessToken{
+		subject:     claims.Subject,
+		accessLevel: claims.AccessLevel,
+	}, nil
+}
+
+func (signer *TokenSigner) sign(encodedClaims string) []byte {
+	signatureHash := hmac.New(sha256.New, signer.key)
+	signatureHash.Write([]byte(encodedClaims))
+	return signatureHash.Sum(nil)
+}
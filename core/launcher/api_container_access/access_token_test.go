@@ -0,0 +1,86 @@
+package api_container_access
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid      = "enclave-1"
+	testOtherEnclaveUuid = "enclave-2"
+	testSubject          = "alice"
+)
+
+var testSecret = []byte("engine-secret")
+
+func TestTokenSigner_VerifiesTokensOfItsEnclave(t *testing.T) {
+	engineSigner := NewTokenSignerFromSecret(testSecret, testEnclaveUuid)
+	token, err := engineSigner.IssueToken(testSubject, AccessLevel_Read, time.Hour)
+	require.NoError(t, err)
+
+	apiContainerSigner, err := NewTokenSignerFromKey(engineSigner.GetHexKey(), testEnclaveUuid)
+	require.NoError(t, err)
+	accessToken, err := apiContainerSigner.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, testSubject, accessToken.GetSubject())
+	require.Equal(t, AccessLevel_Read, accessToken.GetAccessLevel())
+}
+
+func TestTokenSigner_RejectsTokensOfOtherEnclaves(t *testing.T) {
+	token, err := NewTokenSignerFromSecret(testSecret, testOtherEnclaveUuid).IssueToken(testSubject, AccessLevel_Manage, time.Hour)
+	require.NoError(t, err)
+
+	_, err = NewTokenSignerFromSecret(testSecret, testEnclaveUuid).VerifyToken(token)
+	require.Error(t, err)
+}
+
+func TestTokenSigner_RejectsExpiredTokens(t *testing.T) {
+	signer := NewTokenSignerFromSecret(testSecret, testEnclaveUuid)
+	token, err := signer.IssueToken(testSubject, AccessLevel_Manage, -time.Minute)
+	require.NoError(t, err)
+
+	_, err = signer.VerifyToken(token)
+	require.ErrorContains(t, err, "expired")
+}
+
+func TestTokenSigner_RejectsTamperedTokens(t *testing.T) {
+	signer := NewTokenSignerFromSecret(testSecret, testEnclaveUuid)
+	readToken, err := signer.IssueToken(testSubject, AccessLevel_Read, time.Hour)
+	require.NoError(t, err)
+	manageToken, err := signer.IssueToken(testSubject, AccessLevel_Manage, time.Hour)
+	require.NoError(t, err)
+
+	// the claims of the manage token with the signature of the read one
+	tamperedToken := strings.Split(manageToken, tokenPartsSeparator)[0] + tokenPartsSeparator + strings.Split(readToken, tokenPartsSeparator)[1]
+	_, err = signer.VerifyToken(tamperedToken)
+	require.Error(t, err)
+
+	_, err = signer.VerifyToken("not-a-token")
+	require.Error(t, err)
+}
+
+func TestTokenCredentials_IssuesBearerToken(t *testing.T) {
+	signer := NewTokenSignerFromSecret(testSecret, testEnclaveUuid)
+	credentials := NewTokenCredentials(signer, testSubject, AccessLevel_Manage, time.Minute)
+
+	metadata, err := credentials.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(metadata[AuthorizationMetadataKey], BearerPrefix))
+
+	accessToken, err := signer.VerifyToken(strings.TrimPrefix(metadata[AuthorizationMetadataKey], BearerPrefix))
+	require.NoError(t, err)
+	require.Equal(t, AccessLevel_Manage, accessToken.GetAccessLevel())
+}
+
+func TestAccessLevel_AllowsLowerAccessLevels(t *testing.T) {
+	require.True(t, AccessLevel_Engine.Allows(AccessLevel_Manage))
+	require.True(t, AccessLevel_Manage.Allows(AccessLevel_Manage))
+	require.True(t, AccessLevel_Manage.Allows(AccessLevel_Read))
+	require.False(t, AccessLevel_Manage.Allows(AccessLevel_Engine))
+	require.False(t, AccessLevel_Read.Allows(AccessLevel_Manage))
+	require.False(t, AccessLevel("admin").Allows(AccessLevel_Read))
+}
@@ -0,0 +1,44 @@
+package api_container_access
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// TokenCredentials implements the PerRPCCredentials of gRPC, issuing a short-lived token for every call; it's used by
+// the engine and the API container itself, which hold the key of the enclave
+type TokenCredentials struct {
+	signer *TokenSigner
+
+	subject string
+
+	accessLevel AccessLevel
+
+	validity time.Duration
+}
+
+func NewTokenCredentials(signer *TokenSigner, subject string, accessLevel AccessLevel, validity time.Duration) *TokenCredentials {
+	return &TokenCredentials{
+		signer:      signer,
+		subject:     subject,
+		accessLevel: accessLevel,
+		validity:    validity,
+	}
+}
+
+func (credentials *TokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	token, err := credentials.signer.IssueToken(credentials.subject, credentials.accessLevel, credentials.validity)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred issuing an access token for '%v'", credentials.subject)
+	}
+	return map[string]string{
+		AuthorizationMetadataKey: BearerPrefix + token,
+	}, nil
+}
+
+// RequireTransportSecurity is false as the API containers are served over plain gRPC
+func (credentials *TokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
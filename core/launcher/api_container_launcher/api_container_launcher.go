@@ -46,6 +46,15 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
 	shouldStartInDebugMode bool,
+	hostPortRanges string,
+	// Nil if no quota applies to the enclave
+	resourceQuota *args.EnclaveResourceQuota,
+	// Empty to not export the traces of the Starlark runs
+	otlpTracesEndpoint string,
+	// Nil to send the metrics to Segment
+	metricsSinkConfig *metrics_client.SinkConfig,
+	// Empty if the API container doesn't check access tokens
+	accessTokenKey string,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -65,6 +74,11 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 		cloudUserID,
 		cloudInstanceID,
 		shouldStartInDebugMode,
+		hostPortRanges,
+		resourceQuota,
+		otlpTracesEndpoint,
+		metricsSinkConfig,
+		accessTokenKey,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred launching the API container with default version tag '%v'", kurtosis_version.KurtosisVersion)
@@ -87,6 +101,15 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
 	shouldStartInDebugMode bool,
+	hostPortRanges string,
+	// Nil if no quota applies to the enclave
+	resourceQuota *args.EnclaveResourceQuota,
+	// Empty to not export the traces of the Starlark runs
+	otlpTracesEndpoint string,
+	// Nil to send the metrics to Segment
+	metricsSinkConfig *metrics_client.SinkConfig,
+	// Empty if the API container doesn't check access tokens
+	accessTokenKey string,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -107,6 +130,11 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 		isCI,
 		cloudUserID,
 		cloudInstanceID,
+		hostPortRanges,
+		resourceQuota,
+		otlpTracesEndpoint,
+		metricsSinkConfig,
+		accessTokenKey,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the API container args")
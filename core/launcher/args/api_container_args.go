@@ -54,11 +54,31 @@ type APIContainerArgs struct {
 
 	// The Cloud Instance ID of the current user if available
 	CloudInstanceID metrics_client.CloudInstanceID `json:"cloud_instance_id"`
+
+	// The named host port ranges the services can publish their ports on, in the 'name=start-end,...' format
+	HostPortRanges string `json:"hostPortRanges"`
+
+	// Nil if no quota applies to the enclave
+	ResourceQuota *EnclaveResourceQuota `json:"resourceQuota"`
+
+	// The URL of the OTLP gRPC endpoint the traces of the Starlark runs get exported to, empty to not export them
+	OtlpTracesEndpoint string `json:"otlpTracesEndpoint"`
+
+	// The sink the metrics get sent to when the user accepted sending metrics, nil to send them to Segment
+	MetricsSink *metrics_client.SinkConfig `json:"metricsSink,omitempty"`
+
+	// The hex key the access tokens issued by the engine are checked with, empty if the API container is open to
+	// anyone who can reach it
+	AccessTokenKey string `json:"accessTokenKey"`
 }
 
 var skipValidation = map[string]bool{
-	"cloud_instance_id": true,
-	"cloud_user_id":     true,
+	"cloud_instance_id":  true,
+	"cloud_user_id":      true,
+	"hostPortRanges":     true,
+	"resourceQuota":      true,
+	"otlpTracesEndpoint": true,
+	"accessTokenKey":     true,
 }
 
 func (args *APIContainerArgs) UnmarshalJSON(data []byte) error {
@@ -110,6 +130,11 @@ func NewAPIContainerArgs(
 	isCI bool,
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
+	hostPortRanges string,
+	resourceQuota *EnclaveResourceQuota,
+	otlpTracesEndpoint string,
+	metricsSink *metrics_client.SinkConfig,
+	accessTokenKey string,
 ) (*APIContainerArgs, error) {
 	result := &APIContainerArgs{
 		Version:                     version,
@@ -126,6 +151,11 @@ func NewAPIContainerArgs(
 		IsCI:                        isCI,
 		CloudUserID:                 cloudUserID,
 		CloudInstanceID:             cloudInstanceID,
+		HostPortRanges:              hostPortRanges,
+		ResourceQuota:               resourceQuota,
+		OtlpTracesEndpoint:          otlpTracesEndpoint,
+		MetricsSink:                 metricsSink,
+		AccessTokenKey:              accessTokenKey,
 	}
 
 	if err := result.validate(); err != nil {
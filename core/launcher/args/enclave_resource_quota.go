@@ -0,0 +1,32 @@
+package args
+
+// EnclaveResourceQuota caps what the services and files artifacts of an enclave can take, as resolved by the engine
+// from the quotas that apply to the enclave; a zero limit means unlimited
+type EnclaveResourceQuota struct {
+	MaxServices uint32 `json:"maxServices"`
+
+	// Sum of the min_cpu reservations of the services of the enclave
+	MaxCpuMillicores uint64 `json:"maxCpuMillicores"`
+
+	// Sum of the min_memory reservations of the services of the enclave
+	MaxMemoryMegabytes uint64 `json:"maxMemoryMegabytes"`
+
+	// Disk space taken by the files artifacts stored in the enclave
+	MaxFilesArtifactsMegabytes uint64 `json:"maxFilesArtifactsMegabytes"`
+
+	// What the totals of the quotas leave to the enclave when it got created, nil if none of them limits a total. The
+	// engine keeps it up to date while the enclave runs
+	Budget *EnclaveResourceQuotaBudget `json:"budget,omitempty"`
+}
+
+// EnclaveResourceQuotaBudget is what the services of an enclave can reserve in total, given what the other running
+// enclaves of its resource quotas reserve; a nil limit means unlimited, while a zero one leaves nothing to the enclave
+type EnclaveResourceQuotaBudget struct {
+	MaxServices *uint32 `json:"maxServices,omitempty"`
+
+	// Sum of the min_cpu reservations of the services of the enclave
+	MaxCpuMillicores *uint64 `json:"maxCpuMillicores,omitempty"`
+
+	// Sum of the min_memory reservations of the services of the enclave
+	MaxMemoryMegabytes *uint64 `json:"maxMemoryMegabytes,omitempty"`
+}
@@ -21,23 +21,32 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_access"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_dns"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/prometheus_metrics"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/tracing"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/analytics_logger"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/source"
 	minimal_grpc_server "github.com/kurtosis-tech/minimal-grpc-server/golang/server"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"go.starlark.net/starlark"
 	"google.golang.org/grpc"
@@ -57,6 +66,9 @@ const (
 	emptyFunctionName         = ""
 
 	shouldFlushMetricsClientQueueOnEachEvent = false
+
+	// The enclave DNS server forwards the queries it can't answer to the resolver of the API container itself
+	resolvConfFilepath = "/etc/resolv.conf"
 )
 
 func main() {
@@ -110,6 +122,19 @@ func runMain() error {
 	}
 	logrus.SetLevel(logLevel)
 
+	// Nil when the API container is open to anyone who can reach it
+	var accessTokenSigner *api_container_access.TokenSigner
+	if serverArgs.AccessTokenKey != "" {
+		accessTokenSigner, err = api_container_access.NewTokenSignerFromKey(serverArgs.AccessTokenKey, serverArgs.EnclaveUUID)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the checker of the access tokens issued by the engine")
+		}
+		logrus.Info("Only the callers with an access token issued by the engine are served")
+	}
+
+	starlarkRunSpanRecorder, shutdownTracingFunc := tracing.SetUp(ctx, serverArgs.OtlpTracesEndpoint, serverArgs.EnclaveUUID)
+	defer shutdownTracingFunc()
+
 	enclaveDataDir := enclave_data_directory.NewEnclaveDataDirectory(serverArgs.EnclaveDataVolumeDirpath)
 
 	clusterConfig := serverArgs.KurtosisBackendConfig
@@ -127,6 +152,21 @@ func runMain() error {
 		return stacktrace.Propagate(err, "An error occurred while getting the enclave db")
 	}
 
+	secretStore, err := secret_store.GetOrCreateSecretStore(enclaveDb, enclaveDataDir.GetSecretStoreKeyFilepath())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the secret store")
+	}
+
+	dnsRecordStore, err := enclave_dns.GetOrCreateDnsRecordStore(enclaveDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the DNS record store")
+	}
+
+	hostPortRanges, err := port_publish_spec.ParseHostPortRanges(serverArgs.HostPortRanges)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the host port ranges '%v'", serverArgs.HostPortRanges)
+	}
+
 	filesArtifactStore, err := enclaveDataDir.GetFilesArtifactStore()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the files artifact store")
@@ -172,10 +212,26 @@ func runMain() error {
 		return stacktrace.NewError("Backend type '%v' was not recognized by API container.", serverArgs.KurtosisBackendType.String())
 	}
 
-	serviceNetwork, err := createServiceNetwork(kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb)
+	serviceNetwork, err := createServiceNetwork(kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb, secretStore, dnsRecordStore, hostPortRanges, accessTokenSigner)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the service network")
 	}
+	go serviceNetwork.MonitorServicesHealth(ctx)
+
+	upstreamResolverAddress, err := enclave_dns.GetUpstreamResolverAddress(resolvConfFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the resolver the enclave DNS server forwards queries to")
+	}
+	enclaveDnsServer := enclave_dns.NewEnclaveDnsServer(dnsRecordStore, upstreamResolverAddress)
+	if err := enclaveDnsServer.Start(ctx, api_container.EnclaveDnsPortNum); err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the enclave DNS server")
+	}
+
+	// The metrics sink file path is a path on the CLI's host, so the API container writes its events to the enclave data directory
+	var metricsSinkConfig *metrics_client.SinkConfig
+	if serverArgs.MetricsSink != nil {
+		metricsSinkConfig = serverArgs.MetricsSink.WithFileInDirectory(serverArgs.EnclaveDataVolumeDirpath)
+	}
 
 	logger := logrus.StandardLogger()
 	metricsClient, closeClientFunc, err := metrics_client.CreateMetricsClient(
@@ -191,6 +247,7 @@ func runMain() error {
 			serverArgs.IsCI,
 			serverArgs.CloudUserID,
 			serverArgs.CloudInstanceID,
+			metricsSinkConfig,
 		),
 	)
 	if err != nil {
@@ -219,12 +276,24 @@ func runMain() error {
 		return stacktrace.Propagate(err, "An error occurred loading stored enclave plan")
 	}
 
+	// Start the services which were running when the enclave got stopped, in case the enclave is being started again
+	if err := serviceNetwork.StartServicesStoppedWithEnclave(ctx, enclavePlan.GetServiceNamesInStartOrder()); err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the services which were running when the enclave got stopped")
+	}
+
+	var initialResourceQuotaBudget *args.EnclaveResourceQuotaBudget
+	if serverArgs.ResourceQuota != nil {
+		initialResourceQuotaBudget = serverArgs.ResourceQuota.Budget
+	}
+	resourceQuotaBudget := startosis_validator.NewResourceQuotaBudget(initialResourceQuotaBudget)
+
 	// TODO: Consolidate Interpreter, Validator and Executor into a single interface
 	startosisInterpreter := startosis_engine.NewStartosisInterpreter(serviceNetwork, gitPackageContentProvider, runtimeValueStore, starlarkValueSerde, serverArgs.EnclaveEnvVars, interpretationTimeValueStore)
 	startosisRunner := startosis_engine.NewStartosisRunner(
 		startosisInterpreter,
-		startosis_engine.NewStartosisValidator(&kurtosisBackend, serviceNetwork, filesArtifactStore),
-		startosis_engine.NewStartosisExecutor(starlarkValueSerde, runtimeValueStore, enclavePlan, enclaveDb))
+		startosis_engine.NewStartosisValidator(&kurtosisBackend, serverArgs.KurtosisBackendType, serviceNetwork, filesArtifactStore, secretStore, hostPortRanges, serverArgs.ResourceQuota, resourceQuotaBudget),
+		startosis_engine.NewStartosisExecutor(starlarkValueSerde, runtimeValueStore, enclavePlan, enclaveDb),
+		startosis_engine.NewServicePeakUsageTracker(serviceNetwork))
 
 	//Creation of ApiContainerService
 	restartPolicy := kurtosis_core_rpc_api_bindings.RestartPolicy_NEVER
@@ -240,13 +309,26 @@ func runMain() error {
 		restartPolicy,
 		metricsClient,
 		githubAuthProvider,
+		runtimeValueStore,
+		starlarkValueSerde,
+		secretStore,
+		starlarkRunSpanRecorder,
+		resourceQuotaBudget,
 	)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the API container service")
 	}
+	go apiContainerService.RecordServiceEvents(ctx)
+
+	prometheus.MustRegister(apiContainerService.NewPrometheusCollector())
+	go func() {
+		if err := prometheus_metrics.RunServer(ctx, api_container.MetricsPortNum); err != nil {
+			logrus.Errorf("The Prometheus metrics server stopped; the metrics of the enclave won't be available. Error:\n%v", err)
+		}
+	}()
 
 	apiContainerServiceRegistrationFunc := func(grpcServer *grpc.Server) {
-		kurtosis_core_rpc_api_bindings.RegisterApiContainerServiceServer(grpcServer, apiContainerService)
+		server.RegisterApiContainerServiceServer(grpcServer, apiContainerService, accessTokenSigner)
 	}
 	apiContainerServer := minimal_grpc_server.NewMinimalGRPCServer(
 		serverArgs.GrpcListenPortNum,
@@ -270,7 +352,11 @@ func createServiceNetwork(
 	args *args.APIContainerArgs,
 	ownIpAddress net.IP,
 	enclaveDb *enclave_db.EnclaveDB,
-) (service_network.ServiceNetwork, error) {
+	secretStore *secret_store.SecretStore,
+	dnsRecordStore *enclave_dns.DnsRecordStore,
+	hostPortRanges map[string]*port_publish_spec.HostPortRange,
+	accessTokenSigner *api_container_access.TokenSigner,
+) (*service_network.DefaultServiceNetwork, error) {
 	enclaveIdStr := args.EnclaveUUID
 	enclaveUuid := enclave.EnclaveUUID(enclaveIdStr)
 
@@ -278,6 +364,7 @@ func createServiceNetwork(
 		ownIpAddress,
 		args.GrpcListenPortNum,
 		args.Version,
+		accessTokenSigner,
 	)
 
 	serviceNetwork, err := service_network.NewDefaultServiceNetwork(
@@ -286,6 +373,9 @@ func createServiceNetwork(
 		kurtosisBackend,
 		enclaveDataDir,
 		enclaveDb,
+		secretStore,
+		dnsRecordStore,
+		hostPortRanges,
 	)
 
 	if err != nil {
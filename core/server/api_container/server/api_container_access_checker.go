@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_access"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// The methods that only inspect the enclave; the ones not listed here need the manage access level
+var readOnlyMethodNames = map[string]bool{
+	"GetServices":                                true,
+	"GetServiceStats":                            true,
+	"StreamServiceHealthEvents":                  true,
+	"GetExistingAndHistoricalServiceIdentifiers": true,
+	"WaitForHttpGetEndpointAvailability":         true,
+	"WaitForHttpPostEndpointAvailability":        true,
+	"DownloadFilesArtifact":                      true,
+	"ListFilesArtifactNamesAndUuids":             true,
+	"InspectFilesArtifactContents":               true,
+	"GetStarlarkRun":                             true,
+	"GetStarlarkScriptPlanYaml":                  true,
+	"GetStarlarkPackagePlanYaml":                 true,
+	"GetEnclaveSnapshotInfo":                     true,
+	"ListSecrets":                                true,
+	getEnclaveActivityMethodName:                 true,
+	getEnclaveResourceUsageMethodName:            true,
+	getEnclaveEventsMethodName:                   true,
+	"GetStarlarkRunTrace":                        true,
+}
+
+// The methods only the engine is allowed to call
+var engineOnlyMethodNames = map[string]bool{
+	setEnclaveResourceQuotaBudgetMethodName: true,
+}
+
+// apiContainerAccessChecker rejects the calls that don't carry an access token issued by the engine for this enclave,
+// or whose token doesn't allow the method, e.g. a read-only caller of the engine running Starlark
+type apiContainerAccessChecker struct {
+	signer *api_container_access.TokenSigner
+}
+
+func newApiContainerAccessChecker(signer *api_container_access.TokenSigner) *apiContainerAccessChecker {
+	return &apiContainerAccessChecker{signer: signer}
+}
+
+// wrapServiceDesc returns a copy of the service description whose handlers check the access token of the call before
+// serving it
+func (checker *apiContainerAccessChecker) wrapServiceDesc(serviceDesc grpc.ServiceDesc) grpc.ServiceDesc {
+	wrappedMethods := []grpc.MethodDesc{}
+	for _, method := range serviceDesc.Methods {
+		method.Handler = checker.wrapMethodHandler(method.MethodName, method.Handler)
+		wrappedMethods = append(wrappedMethods, method)
+	}
+	wrappedStreams := []grpc.StreamDesc{}
+	for _, stream := range serviceDesc.Streams {
+		stream.Handler = checker.wrapStreamHandler(stream.StreamName, stream.Handler)
+		wrappedStreams = append(wrappedStreams, stream)
+	}
+	serviceDesc.Methods = wrappedMethods
+	serviceDesc.Streams = wrappedStreams
+	return serviceDesc
+}
+
+func (checker *apiContainerAccessChecker) wrapMethodHandler(methodName string, handler grpcMethodHandler) grpcMethodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		if err := checker.checkAccess(ctx, methodName); err != nil {
+			return nil, err
+		}
+		return handler(srv, ctx, dec, interceptor)
+	}
+}
+
+func (checker *apiContainerAccessChecker) wrapStreamHandler(methodName string, handler grpc.StreamHandler) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		if err := checker.checkAccess(stream.Context(), methodName); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+func (checker *apiContainerAccessChecker) checkAccess(ctx context.Context, methodName string) error {
+	token, found := getBearerToken(ctx)
+	if !found {
+		return status.Errorf(codes.Unauthenticated, "The call to '%v' carries no access token; the access tokens of the API container are issued by the engine", methodName)
+	}
+	accessToken, err := checker.signer.VerifyToken(token)
+	if err != nil {
+		logrus.Debugf("Rejected the access token of a call to '%v':\n%v", methodName, err)
+		return status.Errorf(codes.Unauthenticated, "The access token of the call to '%v' isn't valid for this enclave: %v", methodName, err)
+	}
+	if !accessToken.GetAccessLevel().Allows(getRequiredAccessLevel(methodName)) {
+		return status.Errorf(codes.PermissionDenied, "'%v' with access level '%v' isn't allowed to call '%v' on this enclave", accessToken.GetSubject(), accessToken.GetAccessLevel(), methodName)
+	}
+	return nil
+}
+
+func getRequiredAccessLevel(methodName string) api_container_access.AccessLevel {
+	if engineOnlyMethodNames[methodName] {
+		return api_container_access.AccessLevel_Engine
+	}
+	if readOnlyMethodNames[methodName] {
+		return api_container_access.AccessLevel_Read
+	}
+	return api_container_access.AccessLevel_Manage
+}
+
+func getBearerToken(ctx context.Context) (string, bool) {
+	callMetadata, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return "", false
+	}
+	for _, authorization := range callMetadata.Get(api_container_access.AuthorizationMetadataKey) {
+		if strings.HasPrefix(authorization, api_container_access.BearerPrefix) {
+			return strings.TrimPrefix(authorization, api_container_access.BearerPrefix), true
+		}
+	}
+	return "", false
+}
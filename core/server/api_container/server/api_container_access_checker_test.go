@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_access"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testAccessEnclaveUuid = "enclave-1"
+	runStarlarkMethodName = "RunStarlarkScript"
+	getServicesMethodName = "GetServices"
+	setBudgetMethodName   = "SetEnclaveResourceQuotaBudget"
+)
+
+func TestApiContainerAccessChecker_OnlyManageTokensCanMutateTheEnclave(t *testing.T) {
+	signer := api_container_access.NewTokenSignerFromSecret([]byte("engine-secret"), testAccessEnclaveUuid)
+	wrappedServiceDesc := newApiContainerAccessChecker(signer).wrapServiceDesc(newTestAccessServiceDesc())
+	getServicesHandler := wrappedServiceDesc.Methods[0].Handler
+	runStarlarkHandler := wrappedServiceDesc.Methods[1].Handler
+
+	readCtx := newTestCtxWithAccessToken(t, signer, api_container_access.AccessLevel_Read)
+	_, err := getServicesHandler(nil, readCtx, nil, nil)
+	require.NoError(t, err)
+	_, err = runStarlarkHandler(nil, readCtx, nil, nil)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	manageCtx := newTestCtxWithAccessToken(t, signer, api_container_access.AccessLevel_Manage)
+	_, err = runStarlarkHandler(nil, manageCtx, nil, nil)
+	require.NoError(t, err)
+}
+
+func TestApiContainerAccessChecker_OnlyTheEngineCanSetTheResourceQuotaBudget(t *testing.T) {
+	signer := api_container_access.NewTokenSignerFromSecret([]byte("engine-secret"), testAccessEnclaveUuid)
+	wrappedServiceDesc := newApiContainerAccessChecker(signer).wrapServiceDesc(newTestAccessServiceDesc())
+	setBudgetHandler := wrappedServiceDesc.Methods[2].Handler
+
+	_, err := setBudgetHandler(nil, newTestCtxWithAccessToken(t, signer, api_container_access.AccessLevel_Manage), nil, nil)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = setBudgetHandler(nil, newTestCtxWithAccessToken(t, signer, api_container_access.AccessLevel_Engine), nil, nil)
+	require.NoError(t, err)
+}
+
+func TestApiContainerAccessChecker_RejectsCallsWithoutValidToken(t *testing.T) {
+	signer := api_container_access.NewTokenSignerFromSecret([]byte("engine-secret"), testAccessEnclaveUuid)
+	wrappedServiceDesc := newApiContainerAccessChecker(signer).wrapServiceDesc(newTestAccessServiceDesc())
+	getServicesHandler := wrappedServiceDesc.Methods[0].Handler
+
+	_, err := getServicesHandler(nil, context.Background(), nil, nil)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	otherEnclaveSigner := api_container_access.NewTokenSignerFromSecret([]byte("engine-secret"), "enclave-2")
+	_, err = getServicesHandler(nil, newTestCtxWithAccessToken(t, otherEnclaveSigner, api_container_access.AccessLevel_Manage), nil, nil)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func newTestAccessServiceDesc() grpc.ServiceDesc {
+	noopHandler := func(_ interface{}, _ context.Context, _ func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+		return nil, nil
+	}
+	return grpc.ServiceDesc{
+		ServiceName: "test",
+		HandlerType: nil,
+		Methods: []grpc.MethodDesc{
+			{MethodName: getServicesMethodName, Handler: noopHandler},
+			{MethodName: runStarlarkMethodName, Handler: noopHandler},
+			{MethodName: setBudgetMethodName, Handler: noopHandler},
+		},
+		Streams:  []grpc.StreamDesc{},
+		Metadata: nil,
+	}
+}
+
+func newTestCtxWithAccessToken(t *testing.T, signer *api_container_access.TokenSigner, accessLevel api_container_access.AccessLevel) context.Context {
+	token, err := signer.IssueToken("alice", accessLevel, time.Hour)
+	require.NoError(t, err)
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(api_container_access.AuthorizationMetadataKey, api_container_access.BearerPrefix+token))
+}
@@ -14,16 +14,23 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/docker_compose_transpiler"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_snapshot"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan/resolver"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
 	"io"
 	"math"
 	"net/http"
 	"os"
 	"path"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
@@ -38,16 +45,23 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/tracing"
 	"github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang/grpc_file_streaming"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -99,9 +113,27 @@ type ApiContainerService struct {
 
 	starlarkRun *kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse
 
+	// Package ID of the Starlark run being executed right now, nil if none is
+	runningStarlarkPackageId      *string
+	runningStarlarkPackageIdMutex *sync.RWMutex
+
 	metricsClient metrics_client.MetricsClient
 
 	githubAuthProvider *git_package_content_provider.GitHubPackageAuthProvider
+
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	starlarkValueSerde *kurtosis_types.StarlarkValueSerde
+
+	secretStore *secret_store.SecretStore
+
+	activityTracker *enclaveActivityTracker
+
+	eventLog *enclaveEventLog
+
+	starlarkRunSpanRecorder *tracing.StarlarkRunSpanRecorder
+
+	resourceQuotaBudget *startosis_validator.ResourceQuotaBudget
 }
 
 func NewApiContainerService(
@@ -113,7 +145,20 @@ func NewApiContainerService(
 	restartPolicy kurtosis_core_rpc_api_bindings.RestartPolicy,
 	metricsClient metrics_client.MetricsClient,
 	githubAuthProvider *git_package_content_provider.GitHubPackageAuthProvider,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	starlarkValueSerde *kurtosis_types.StarlarkValueSerde,
+	secretStore *secret_store.SecretStore,
+	starlarkRunSpanRecorder *tracing.StarlarkRunSpanRecorder,
+	resourceQuotaBudget *startosis_validator.ResourceQuotaBudget,
 ) (*ApiContainerService, error) {
+	eventLog, err := newEnclaveEventLog()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave event log")
+	}
+	filesArtifactStore.AddFilesArtifactStoredHandler(func(artifactName string, _ enclave_data_directory.FilesArtifactUUID) {
+		eventLog.recordFilesArtifactCreated(artifactName)
+	})
+
 	service := &ApiContainerService{
 		filesArtifactStore:     filesArtifactStore,
 		serviceNetwork:         serviceNetwork,
@@ -130,9 +175,19 @@ func NewApiContainerService(
 			MainFunctionName:       "",
 			ExperimentalFeatures:   []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{},
 			RestartPolicy:          kurtosis_core_rpc_api_bindings.RestartPolicy_NEVER,
+			RunningPackageId:       nil,
 		},
-		metricsClient:      metricsClient,
-		githubAuthProvider: githubAuthProvider,
+		runningStarlarkPackageId:      nil,
+		runningStarlarkPackageIdMutex: &sync.RWMutex{},
+		metricsClient:                 metricsClient,
+		githubAuthProvider:            githubAuthProvider,
+		runtimeValueStore:             runtimeValueStore,
+		starlarkValueSerde:            starlarkValueSerde,
+		secretStore:                   secretStore,
+		activityTracker:               newEnclaveActivityTracker(),
+		eventLog:                      eventLog,
+		starlarkRunSpanRecorder:       starlarkRunSpanRecorder,
+		resourceQuotaBudget:           resourceQuotaBudget,
 	}
 
 	return service, nil
@@ -181,6 +236,7 @@ func (apicService *ApiContainerService) RunStarlarkScript(args *kurtosis_core_rp
 		MainFunctionName:       mainFuncName,
 		ExperimentalFeatures:   experimentalFeatures,
 		RestartPolicy:          apicService.restartPolicy,
+		RunningPackageId:       nil,
 	}
 
 	return nil
@@ -324,6 +380,7 @@ func (apicService *ApiContainerService) RunStarlarkPackage(args *kurtosis_core_r
 		MainFunctionName:       mainFuncName,
 		ExperimentalFeatures:   args.ExperimentalFeatures,
 		RestartPolicy:          apicService.restartPolicy,
+		RunningPackageId:       nil,
 	}
 	return nil
 }
@@ -427,7 +484,7 @@ func (apicService *ApiContainerService) GetServices(ctx context.Context, args *k
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "an error occurred while fetching all services from the backend")
 	}
-	serviceInfos, err = getServiceInfosFromServiceObjs(allServices)
+	serviceInfos, err = getServiceInfosFromServiceObjs(allServices, apicService.serviceNetwork)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "an error occurred while converting the service obj into service info")
 	}
@@ -436,6 +493,82 @@ func (apicService *ApiContainerService) GetServices(ctx context.Context, args *k
 	return resp, nil
 }
 
+func (apicService *ApiContainerService) GetServiceStats(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetServiceStatsArgs) (*kurtosis_core_rpc_api_bindings.GetServiceStatsResponse, error) {
+	successfulServiceStats, erroredServices, err := apicService.serviceNetwork.GetServicesStats(ctx, args.GetServiceIdentifiers())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the stats of services '%v'", args.GetServiceIdentifiers())
+	}
+
+	serviceStats := map[string]*kurtosis_core_rpc_api_bindings.ServiceStats{}
+	for serviceName, stats := range successfulServiceStats {
+		var maybeIoStats *kurtosis_core_rpc_api_bindings.ServiceIoStats
+		if ioStats := stats.GetMaybeIoStats(); ioStats != nil {
+			maybeIoStats = binding_constructors.NewServiceIoStats(
+				ioStats.GetNetworkReceivedBytes(),
+				ioStats.GetNetworkSentBytes(),
+				ioStats.GetDiskReadBytes(),
+				ioStats.GetDiskWrittenBytes(),
+			)
+		}
+		serviceStats[string(serviceName)] = binding_constructors.NewServiceStats(
+			stats.GetCpuUsageMilliCores(),
+			stats.GetMemoryUsageBytes(),
+			stats.GetMemoryLimitBytes(),
+			maybeIoStats,
+			timestamppb.New(stats.GetSampleTime()),
+		)
+	}
+	serviceErrors := map[string]string{}
+	for serviceName, serviceErr := range erroredServices {
+		serviceErrors[string(serviceName)] = serviceErr.Error()
+	}
+
+	// When services are requested explicitly, only their peaks are returned
+	lastRunPeakUsage := map[string]*kurtosis_core_rpc_api_bindings.ServicePeakUsage{}
+	for serviceName, peakUsage := range apicService.startosisRunner.GetServicePeakUsages() {
+		_, isSampled := successfulServiceStats[serviceName]
+		_, isErrored := erroredServices[serviceName]
+		if len(args.GetServiceIdentifiers()) > 0 && !isSampled && !isErrored {
+			continue
+		}
+		lastRunPeakUsage[string(serviceName)] = binding_constructors.NewServicePeakUsage(peakUsage.GetCpuUsageMilliCores(), peakUsage.GetMemoryUsageBytes())
+	}
+	return binding_constructors.NewGetServiceStatsResponse(serviceStats, serviceErrors, lastRunPeakUsage), nil
+}
+
+func (apicService *ApiContainerService) StreamServiceHealthEvents(_ *emptypb.Empty, stream kurtosis_core_rpc_api_bindings.ApiContainerService_StreamServiceHealthEventsServer) error {
+	ctx := stream.Context()
+	// Subscribing before sending the current healths, so that no change in between is missed
+	serviceHealthEventsChan, unsubscribe := apicService.serviceNetwork.SubscribeToServiceHealthEvents()
+	defer unsubscribe()
+
+	allServices, err := apicService.serviceNetwork.GetServices(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services to send their current health")
+	}
+	for _, serviceObj := range allServices {
+		serviceName := serviceObj.GetRegistration().GetName()
+		if err := sendServiceHealthEvent(stream, serviceName, apicService.serviceNetwork.GetServiceHealth(serviceObj)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred sending the current health of service '%v'", serviceName)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			logrus.Debug("The client stopped streaming the health events of the services")
+			return nil
+		case serviceHealthEvent, isChanOpen := <-serviceHealthEventsChan:
+			if !isChanOpen {
+				return nil
+			}
+			if err := sendServiceHealthEvent(stream, serviceHealthEvent.GetServiceName(), serviceHealthEvent.GetHealth()); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending the health event of service '%v'", serviceHealthEvent.GetServiceName())
+			}
+		}
+	}
+}
+
 func (apicService *ApiContainerService) ConnectServices(_ context.Context, _ *kurtosis_core_rpc_api_bindings.ConnectServicesArgs) (*kurtosis_core_rpc_api_bindings.ConnectServicesResponse, error) {
 	resp := binding_constructors.NewConnectServicesResponse()
 	return resp, nil
@@ -604,7 +737,133 @@ func (apicService *ApiContainerService) ListFilesArtifactNamesAndUuids(_ context
 }
 
 func (apicService *ApiContainerService) GetStarlarkRun(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse, error) {
-	return apicService.starlarkRun, nil
+	apicService.runningStarlarkPackageIdMutex.RLock()
+	runningPackageId := apicService.runningStarlarkPackageId
+	apicService.runningStarlarkPackageIdMutex.RUnlock()
+
+	lastStarlarkRun := apicService.starlarkRun
+	return &kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse{
+		PackageId:              lastStarlarkRun.GetPackageId(),
+		SerializedScript:       lastStarlarkRun.GetSerializedScript(),
+		SerializedParams:       lastStarlarkRun.GetSerializedParams(),
+		Parallelism:            lastStarlarkRun.GetParallelism(),
+		RelativePathToMainFile: lastStarlarkRun.GetRelativePathToMainFile(),
+		MainFunctionName:       lastStarlarkRun.GetMainFunctionName(),
+		ExperimentalFeatures:   lastStarlarkRun.GetExperimentalFeatures(),
+		RestartPolicy:          lastStarlarkRun.GetRestartPolicy(),
+		RunningPackageId:       runningPackageId,
+	}, nil
+}
+
+func (apicService *ApiContainerService) GetEnclaveSnapshotInfo(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotInfoResponse, error) {
+	serializedEnclavePlan, err := apicService.startosisRunner.GetCurrentEnclavePlan().Serialize()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the current enclave plan")
+	}
+
+	persistentDirectoriesByServiceName, err := apicService.serviceNetwork.GetServicesPersistentDirectories()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persistent directories of the services in the enclave")
+	}
+	serviceNames := []string{}
+	for serviceName := range persistentDirectoriesByServiceName {
+		serviceNames = append(serviceNames, string(serviceName))
+	}
+	sort.Strings(serviceNames)
+
+	// A persistent directory can be mounted by several services, it only needs to be snapshotted once
+	snapshottedPersistentKeys := map[service_directory.DirectoryPersistentKey]bool{}
+	persistentDirectories := []*kurtosis_core_rpc_api_bindings.EnclaveSnapshotPersistentDirectory{}
+	for _, serviceName := range serviceNames {
+		servicePersistentDirectories := persistentDirectoriesByServiceName[service.ServiceName(serviceName)]
+		serviceDirpaths := []string{}
+		for serviceDirpath := range servicePersistentDirectories.ServiceDirpathToPersistentDirectory {
+			serviceDirpaths = append(serviceDirpaths, serviceDirpath)
+		}
+		sort.Strings(serviceDirpaths)
+		for _, serviceDirpath := range serviceDirpaths {
+			persistentKey := servicePersistentDirectories.ServiceDirpathToPersistentDirectory[serviceDirpath].PersistentKey
+			if snapshottedPersistentKeys[persistentKey] {
+				continue
+			}
+			snapshottedPersistentKeys[persistentKey] = true
+			persistentDirectories = append(persistentDirectories, &kurtosis_core_rpc_api_bindings.EnclaveSnapshotPersistentDirectory{
+				ServiceName:    serviceName,
+				ServiceDirpath: serviceDirpath,
+				PersistentKey:  string(persistentKey),
+			})
+		}
+	}
+
+	return &kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotInfoResponse{
+		SerializedEnclavePlan: string(serializedEnclavePlan),
+		PersistentDirectories: persistentDirectories,
+	}, nil
+}
+
+func (apicService *ApiContainerService) RestoreEnclaveSnapshot(args *kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotArgs, stream kurtosis_core_rpc_api_bindings.ApiContainerService_RestoreEnclaveSnapshotServer) error {
+	// Restoring replays the snapshotted plan with the snapshotted runtime values UUIDs, which would collide with
+	// anything that already ran in this enclave
+	if currentEnclavePlanSize := apicService.startosisRunner.GetCurrentEnclavePlan().Size(); currentEnclavePlanSize > 0 {
+		return stacktrace.NewError("Enclave snapshots can only be restored into an empty enclave, but this enclave has already executed '%d' instructions", currentEnclavePlanSize)
+	}
+	parallelism := int(args.GetParallelism())
+	if parallelism == 0 {
+		parallelism = defaultParallelism
+	}
+
+	snapshotEnclavePlan, err := enclave_plan_persistence.Deserialize([]byte(args.GetSerializedEnclavePlan()))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred deserializing the enclave plan of the snapshot")
+	}
+	persistentDirectoryFilesArtifacts := map[service_directory.DirectoryPersistentKey]string{}
+	for persistentKey, filesArtifactName := range args.GetPersistentDirectoryFilesArtifacts() {
+		persistentDirectoryFilesArtifacts[service_directory.DirectoryPersistentKey(persistentKey)] = filesArtifactName
+	}
+	serviceImages := map[service.ServiceName]string{}
+	for serviceName, serviceImage := range args.GetServiceImages() {
+		serviceImages[service.ServiceName(serviceName)] = serviceImage
+	}
+	seederImage := service_config.GetFilesArtifactsExpanderImage(apicService.serviceNetwork.GetApiContainerInfo().GetVersion())
+	enclaveSnapshotRestore, err := enclave_snapshot.NewEnclaveSnapshotRestore(snapshotEnclavePlan, persistentDirectoryFilesArtifacts, serviceImages, seederImage, apicService.starlarkValueSerde)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred building the script restoring the enclave snapshot")
+	}
+	if err = enclaveSnapshotRestore.SeedRuntimeValueStore(apicService.runtimeValueStore); err != nil {
+		return stacktrace.Propagate(err, "An error occurred seeding the runtime values of the enclave snapshot")
+	}
+	defer apicService.runtimeValueStore.SetNextValueUuids(nil)
+
+	noPackageReplaceOptions := map[string]string{}
+	noSerializedParams := ""
+	noMainFuncName := ""
+	noExperimentalFeatures := []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}
+	apicService.runStarlark(
+		parallelism,
+		defaultStartosisDryRun,
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		noPackageReplaceOptions,
+		noMainFuncName,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		enclaveSnapshotRestore.GetScript(),
+		noSerializedParams,
+		convertFromImageDownloadModeAPI(defaultImageDownloadMode),
+		false,
+		noExperimentalFeatures,
+		stream)
+
+	apicService.starlarkRun = &kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse{
+		PackageId:              startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		SerializedScript:       enclaveSnapshotRestore.GetScript(),
+		SerializedParams:       noSerializedParams,
+		Parallelism:            int32(parallelism),
+		RelativePathToMainFile: startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		MainFunctionName:       noMainFuncName,
+		ExperimentalFeatures:   noExperimentalFeatures,
+		RestartPolicy:          apicService.restartPolicy,
+		RunningPackageId:       nil,
+	}
+	return nil
 }
 
 func (apicService *ApiContainerService) GetStarlarkPackagePlanYaml(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs) (*kurtosis_core_rpc_api_bindings.PlanYaml, error) {
@@ -681,6 +940,83 @@ func (apicService *ApiContainerService) GetStarlarkScriptPlanYaml(ctx context.Co
 	return &kurtosis_core_rpc_api_bindings.PlanYaml{PlanYaml: planYamlStr}, nil
 }
 
+func (apicService *ApiContainerService) SetSecret(_ context.Context, args *kurtosis_core_rpc_api_bindings.SetSecretArgs) (*emptypb.Empty, error) {
+	secretName := args.GetName()
+	if err := apicService.secretStore.SetSecret(secretName, args.GetValue()); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred setting secret '%v'", secretName)
+	}
+	// Only the name gets logged, never the value
+	logrus.Infof("Secret '%v' was set", secretName)
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService *ApiContainerService) ListSecrets(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ListSecretsResponse, error) {
+	secretNames, err := apicService.secretStore.GetSecretNames()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the secret names")
+	}
+	return binding_constructors.NewListSecretsResponse(secretNames), nil
+}
+
+func (apicService *ApiContainerService) RemoveSecret(_ context.Context, args *kurtosis_core_rpc_api_bindings.RemoveSecretArgs) (*emptypb.Empty, error) {
+	secretName := args.GetName()
+	if err := apicService.secretStore.RemoveSecret(secretName); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing secret '%v'", secretName)
+	}
+	logrus.Infof("Secret '%v' was removed", secretName)
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService *ApiContainerService) GetEnclaveActivity(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetEnclaveActivityResponse, error) {
+	lastActivityTime := apicService.activityTracker.getLastActivityTime()
+	return binding_constructors.NewGetEnclaveActivityResponse(timestamppb.New(lastActivityTime)), nil
+}
+
+func (apicService *ApiContainerService) GetEnclaveResourceUsage(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetEnclaveResourceUsageResponse, error) {
+	serviceNames, err := apicService.serviceNetwork.GetServiceNames()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service names")
+	}
+	serviceConfigs, err := apicService.serviceNetwork.GetServiceConfigs()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service configs")
+	}
+	reservedCpuMillicores := uint64(0)
+	reservedMemoryMegabytes := uint64(0)
+	for _, serviceConfig := range serviceConfigs {
+		reservedCpuMillicores += serviceConfig.GetMinCPUAllocationMillicpus()
+		reservedMemoryMegabytes += serviceConfig.GetMinMemoryAllocationMegabytes()
+	}
+	filesArtifactsStorageBytes, err := apicService.filesArtifactStore.GetTotalSizeBytes()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the size of the files artifacts")
+	}
+	return binding_constructors.NewGetEnclaveResourceUsageResponse(uint32(len(serviceNames)), reservedCpuMillicores, reservedMemoryMegabytes, filesArtifactsStorageBytes), nil
+}
+
+func (apicService *ApiContainerService) SetEnclaveResourceQuotaBudget(_ context.Context, budget *kurtosis_core_rpc_api_bindings.EnclaveResourceQuotaBudget) (*emptypb.Empty, error) {
+	apicService.resourceQuotaBudget.Set(&args.EnclaveResourceQuotaBudget{
+		MaxServices:        budget.MaxServices,
+		MaxCpuMillicores:   budget.MaxCpuMillicores,
+		MaxMemoryMegabytes: budget.MaxMemoryMegabytes,
+	})
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService *ApiContainerService) GetEnclaveEvents(_ context.Context, args *kurtosis_core_rpc_api_bindings.GetEnclaveEventsArgs) (*kurtosis_core_rpc_api_bindings.GetEnclaveEventsResponse, error) {
+	events, latestSequenceNumber := apicService.eventLog.getEventsAfter(args.GetAfterSequenceNumber())
+	return binding_constructors.NewGetEnclaveEventsResponse(events, apicService.eventLog.uuid, latestSequenceNumber), nil
+}
+
+func (apicService *ApiContainerService) GetStarlarkRunTrace(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunTraceResponse, error) {
+	return binding_constructors.NewGetStarlarkRunTraceResponse(apicService.starlarkRunSpanRecorder.GetLatestStarlarkRunSpans()), nil
+}
+
+// RecordServiceEvents records when the services of the enclave start, stop and crash, until the context gets cancelled
+func (apicService *ApiContainerService) RecordServiceEvents(ctx context.Context) {
+	apicService.eventLog.recordServiceEvents(ctx, apicService.serviceNetwork)
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -834,7 +1170,7 @@ func (apicService *ApiContainerService) getServiceInfoForIdentifier(ctx context.
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting info for service '%v'", serviceIdentifier)
 	}
-	serviceInfo, err := getServiceInfoFromServiceObj(serviceObj)
+	serviceInfo, err := getServiceInfoFromServiceObj(serviceObj, apicService.serviceNetwork.GetServiceHealth(serviceObj))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "an error occurred while converting service obj for service with id '%v' to service info", serviceIdentifier)
 	}
@@ -920,6 +1256,12 @@ func (apicService *ApiContainerService) runStarlarkPackageSetup(
 	return mainScriptToExecute, relativePathToMainFile, packageIdFromArgs, replacesForComposePackage, nil
 }
 
+func (apicService *ApiContainerService) setRunningStarlarkPackageId(maybePackageId *string) {
+	apicService.runningStarlarkPackageIdMutex.Lock()
+	defer apicService.runningStarlarkPackageIdMutex.Unlock()
+	apicService.runningStarlarkPackageId = maybePackageId
+}
+
 func (apicService *ApiContainerService) runStarlark(
 	parallelism int,
 	dryRun bool,
@@ -934,11 +1276,30 @@ func (apicService *ApiContainerService) runStarlark(
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
 	stream grpc.ServerStream,
 ) {
+	apicService.setRunningStarlarkPackageId(&packageId)
+	// NOTE: if the client closes the stream, the execution keeps going after this returns but isn't reported as running anymore
+	defer apicService.setRunningStarlarkPackageId(nil)
+
+	if !dryRun {
+		apicService.eventLog.recordStarlarkRunStarted(packageId)
+	}
+	// The first error of the run is what the finished event reports
+	runErrorMessage := ""
+	enclaveUuid := string(apicService.serviceNetwork.GetEnclaveUuid())
+	// The services added by the run are the ones that aren't here when it finishes
+	serviceNamesBeforeRun, err := apicService.serviceNetwork.GetServiceNames()
+	if err != nil {
+		logrus.Warnf("Couldn't get the services before the run, the services it adds won't be tracked in the metrics. Error was:\n%v", err)
+	}
+	// The instruction an execution error gets reported after, which is the one that failed
+	lastInstructionName := ""
+
 	responseLineStream := apicService.startosisRunner.Run(stream.Context(), dryRun, parallelism, packageId, packageReplaceOptions, mainFunctionName, relativePathToMainFile, serializedStarlark, serializedParams, imageDownloadMode, nonBlockingMode, experimentalFeatures)
 	for {
 		select {
 		case <-stream.Context().Done():
 			// TODO: maybe add the ability to kill the execution
+			// The runner drops the lines of the run from now on, and keeps tracking the peak usage of the services until the execution ends
 			logrus.Infof("Stream was closed by client. The script ouput won't be returned anymore but note that the execution won't be interrupted. There's currently no way to stop a Kurtosis script execution.")
 			return
 		case responseLine, isChanOpen := <-responseLineStream:
@@ -949,13 +1310,30 @@ func (apicService *ApiContainerService) runStarlark(
 				return
 			}
 
+			if instruction := responseLine.GetInstruction(); instruction != nil {
+				lastInstructionName = instruction.GetInstructionName()
+			}
+			if starlarkError := responseLine.GetError(); starlarkError != nil && runErrorMessage == "" {
+				runErrorMessage = getStarlarkErrorMessage(starlarkError)
+			}
+			if responseLine.GetError().GetExecutionError() != nil {
+				if err := apicService.metricsClient.TrackInstructionFailed(enclaveUuid, packageId, lastInstructionName); err != nil {
+					logrus.Warn("An error occurred tracking the instruction-failed event")
+				}
+			}
 			if runFinishedEvent := responseLine.GetRunFinishedEvent(); runFinishedEvent != nil {
 				isSuccessful := runFinishedEvent.GetIsRunSuccessful()
+				if !dryRun {
+					apicService.eventLog.recordStarlarkRunFinished(packageId, isSuccessful, runErrorMessage)
+				}
 				numberOfServicesAfterRunFinished := 0
 				if serviceNames, err := apicService.serviceNetwork.GetServiceNames(); err != nil {
 					logrus.Warn("Couldn't figure out the number of services after run finished, will be logging 0 in the metrics")
 				} else {
 					numberOfServicesAfterRunFinished = len(serviceNames)
+					if !dryRun && serviceNamesBeforeRun != nil {
+						apicService.trackServicesAddedByRun(enclaveUuid, serviceNamesBeforeRun, serviceNames)
+					}
 				}
 				if err := apicService.metricsClient.TrackKurtosisRunFinishedEvent(packageId, numberOfServicesAfterRunFinished, isSuccessful); err != nil {
 					logrus.Warn("An error occurred tracking the run-finished event")
@@ -970,10 +1348,32 @@ func (apicService *ApiContainerService) runStarlark(
 	}
 }
 
-func getServiceInfosFromServiceObjs(services map[service.ServiceUUID]*service.Service) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
+func (apicService *ApiContainerService) trackServicesAddedByRun(enclaveUuid string, serviceNamesBeforeRun map[service.ServiceName]bool, serviceNamesAfterRun map[service.ServiceName]bool) {
+	for serviceName := range serviceNamesAfterRun {
+		if _, found := serviceNamesBeforeRun[serviceName]; found {
+			continue
+		}
+		if err := apicService.metricsClient.TrackServiceAdded(enclaveUuid, string(serviceName)); err != nil {
+			logrus.Warnf("An error occurred tracking the service-added event of service '%v'", serviceName)
+		}
+	}
+}
+
+func sendServiceHealthEvent(stream kurtosis_core_rpc_api_bindings.ApiContainerService_StreamServiceHealthEventsServer, serviceName service.ServiceName, serviceHealth *service_health.ServiceHealth) error {
+	serviceInfoHealth, err := convertServiceHealthToServiceInfoHealth(serviceHealth)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred converting the health of service '%v'", serviceName)
+	}
+	if err := stream.Send(binding_constructors.NewServiceHealthEvent(string(serviceName), serviceInfoHealth)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the health event of service '%v' to the client", serviceName)
+	}
+	return nil
+}
+
+func getServiceInfosFromServiceObjs(services map[service.ServiceUUID]*service.Service, serviceNetwork service_network.ServiceNetwork) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
 	serviceInfos := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{}
 	for uuid, serviceObj := range services {
-		serviceInfo, err := getServiceInfoFromServiceObj(serviceObj)
+		serviceInfo, err := getServiceInfoFromServiceObj(serviceObj, serviceNetwork.GetServiceHealth(serviceObj))
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "there was an error converting the service obj for service with uuid '%v' and name '%v' to service info", uuid, serviceObj.GetRegistration().GetName())
 		}
@@ -982,9 +1382,13 @@ func getServiceInfosFromServiceObjs(services map[service.ServiceUUID]*service.Se
 	return serviceInfos, nil
 }
 
-func getServiceInfoFromServiceObj(serviceObj *service.Service) (*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
+func getServiceInfoFromServiceObj(serviceObj *service.Service, serviceHealth *service_health.ServiceHealth) (*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
 	privatePorts := serviceObj.GetPrivatePorts()
 	privateIp := serviceObj.GetRegistration().GetPrivateIP()
+	privateIpv6Str := ""
+	if privateIpv6 := serviceObj.GetRegistration().GetPrivateIPv6(); privateIpv6 != nil {
+		privateIpv6Str = privateIpv6.String()
+	}
 	maybePublicIp := serviceObj.GetMaybePublicIP()
 	maybePublicPorts := serviceObj.GetMaybePublicPorts()
 	serviceUuidStr := string(serviceObj.GetRegistration().GetUUID())
@@ -1021,17 +1425,23 @@ func getServiceInfoFromServiceObj(serviceObj *service.Service) (*kurtosis_core_r
 		CmdArgs:        serviceContainer.GetCmdArgs(),
 		EnvVars:        serviceContainer.GetEnvVars(),
 	}
+	serviceInfoHealth, err := convertServiceHealthToServiceInfoHealth(serviceHealth)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred converting the health of the service to a service info health")
+	}
 
 	serviceInfoResponse := binding_constructors.NewServiceInfo(
 		serviceUuidStr,
 		serviceNameStr,
 		uuid_generator.ShortenedUUIDString(serviceUuidStr),
 		privateIp.String(),
+		privateIpv6Str,
 		privateApiPorts,
 		publicIpAddrStr,
 		publicApiPorts,
 		serviceStatus,
 		serviceInfoContainer,
+		serviceInfoHealth,
 	)
 	return serviceInfoResponse, nil
 }
@@ -1123,6 +1533,30 @@ func convertContainerStatusToServiceInfoContainerStatus(containerStatus containe
 	}
 }
 
+func convertServiceHealthToServiceInfoHealth(serviceHealth *service_health.ServiceHealth) (*kurtosis_core_rpc_api_bindings.ServiceHealth, error) {
+	var serviceInfoHealthStatus kurtosis_core_rpc_api_bindings.ServiceHealth_Status
+	switch serviceHealth.GetStatus() {
+	case service_health.ServiceHealthStatus_Unknown:
+		serviceInfoHealthStatus = kurtosis_core_rpc_api_bindings.ServiceHealth_UNKNOWN
+	case service_health.ServiceHealthStatus_Healthy:
+		serviceInfoHealthStatus = kurtosis_core_rpc_api_bindings.ServiceHealth_HEALTHY
+	case service_health.ServiceHealthStatus_Unhealthy:
+		serviceInfoHealthStatus = kurtosis_core_rpc_api_bindings.ServiceHealth_UNHEALTHY
+	case service_health.ServiceHealthStatus_Crashed:
+		serviceInfoHealthStatus = kurtosis_core_rpc_api_bindings.ServiceHealth_CRASHED
+	default:
+		return nil, stacktrace.NewError("Failed to convert service health status %v", serviceHealth.GetStatus())
+	}
+	return binding_constructors.NewServiceHealth(
+		serviceInfoHealthStatus,
+		serviceHealth.GetMaybeExitCode(),
+		serviceHealth.GetRestartCount(),
+		serviceHealth.GetLastExitReason(),
+		serviceHealth.GetMessage(),
+		timestamppb.New(serviceHealth.GetSince()),
+	), nil
+}
+
 func convertFromImageDownloadModeAPI(api_mode kurtosis_core_rpc_api_bindings.ImageDownloadMode) image_download_mode.ImageDownloadMode {
 	switch api_mode {
 	case kurtosis_core_rpc_api_bindings.ImageDownloadMode_always:
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_access"
+	"google.golang.org/grpc"
+)
+
+const (
+	getEnclaveActivityMethodName      = "GetEnclaveActivity"
+	getEnclaveResourceUsageMethodName = "GetEnclaveResourceUsage"
+	getEnclaveEventsMethodName        = "GetEnclaveEvents"
+
+	setEnclaveResourceQuotaBudgetMethodName = "SetEnclaveResourceQuotaBudget"
+)
+
+// The methods the engine calls periodically, to find out whether the enclave is idle, to report its resource usage, to
+// publish its events or to update what its resource quotas leave to it, don't count as activity
+var methodNamesNotCountingAsActivity = map[string]bool{
+	getEnclaveActivityMethodName:            true,
+	getEnclaveResourceUsageMethodName:       true,
+	getEnclaveEventsMethodName:              true,
+	setEnclaveResourceQuotaBudgetMethodName: true,
+}
+
+// grpcMethodHandler mirrors the unexported type of the unary handlers of a grpc.MethodDesc
+type grpcMethodHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+// enclaveActivityTracker keeps the last time the API container served a request, so that the engine can reap the
+// enclaves which have been idle for longer than their idle timeout
+type enclaveActivityTracker struct {
+	lastActivityTime time.Time
+
+	mutex *sync.RWMutex
+}
+
+func newEnclaveActivityTracker() *enclaveActivityTracker {
+	return &enclaveActivityTracker{
+		lastActivityTime: time.Now(),
+		mutex:            &sync.RWMutex{},
+	}
+}
+
+func (tracker *enclaveActivityTracker) recordActivity() {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tracker.lastActivityTime = time.Now()
+}
+
+func (tracker *enclaveActivityTracker) getLastActivityTime() time.Time {
+	tracker.mutex.RLock()
+	defer tracker.mutex.RUnlock()
+	return tracker.lastActivityTime
+}
+
+// wrapServiceDesc returns a copy of the service description whose handlers record activity before serving the
+// request; streams record activity again once they're over, so long runs don't look idle as soon as they end
+func (tracker *enclaveActivityTracker) wrapServiceDesc(serviceDesc grpc.ServiceDesc) grpc.ServiceDesc {
+	wrappedMethods := []grpc.MethodDesc{}
+	for _, method := range serviceDesc.Methods {
+		if !methodNamesNotCountingAsActivity[method.MethodName] {
+			method.Handler = tracker.wrapMethodHandler(method.Handler)
+		}
+		wrappedMethods = append(wrappedMethods, method)
+	}
+	wrappedStreams := []grpc.StreamDesc{}
+	for _, stream := range serviceDesc.Streams {
+		stream.Handler = tracker.wrapStreamHandler(stream.Handler)
+		wrappedStreams = append(wrappedStreams, stream)
+	}
+	serviceDesc.Methods = wrappedMethods
+	serviceDesc.Streams = wrappedStreams
+	return serviceDesc
+}
+
+func (tracker *enclaveActivityTracker) wrapMethodHandler(handler grpcMethodHandler) grpcMethodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		tracker.recordActivity()
+		return handler(srv, ctx, dec, interceptor)
+	}
+}
+
+func (tracker *enclaveActivityTracker) wrapStreamHandler(handler grpc.StreamHandler) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		tracker.recordActivity()
+		defer tracker.recordActivity()
+		return handler(srv, stream)
+	}
+}
+
+// RegisterApiContainerServiceServer registers the API container service on the gRPC server, tracking the activity of
+// the enclave on the way. When the signer isn't nil, the calls without a valid access token are rejected before they
+// count as activity
+func RegisterApiContainerServiceServer(grpcServer *grpc.Server, apicService *ApiContainerService, accessTokenSigner *api_container_access.TokenSigner) {
+	serviceDesc := apicService.activityTracker.wrapServiceDesc(kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc)
+	if accessTokenSigner != nil {
+		serviceDesc = newApiContainerAccessChecker(accessTokenSigner).wrapServiceDesc(serviceDesc)
+	}
+	grpcServer.RegisterService(&serviceDesc, apicService)
+}
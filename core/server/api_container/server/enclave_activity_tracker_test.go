@@ -0,0 +1,39 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestEnclaveActivityTracker_WrappedHandlersRecordActivity(t *testing.T) {
+	tracker := newEnclaveActivityTracker()
+	startTime := time.Now().Add(-time.Hour)
+	tracker.lastActivityTime = startTime
+
+	noopHandler := func(_ interface{}, _ context.Context, _ func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+		return nil, nil
+	}
+	serviceDesc := grpc.ServiceDesc{
+		ServiceName: "test",
+		HandlerType: nil,
+		Methods: []grpc.MethodDesc{
+			{MethodName: getEnclaveActivityMethodName, Handler: noopHandler},
+			{MethodName: "GetServices", Handler: noopHandler},
+		},
+		Streams:  []grpc.StreamDesc{},
+		Metadata: nil,
+	}
+	wrappedServiceDesc := tracker.wrapServiceDesc(serviceDesc)
+
+	_, err := wrappedServiceDesc.Methods[0].Handler(nil, context.Background(), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, startTime, tracker.getLastActivityTime())
+
+	_, err = wrappedServiceDesc.Methods[1].Handler(nil, context.Background(), nil, nil)
+	require.NoError(t, err)
+	require.True(t, tracker.getLastActivityTime().After(startTime))
+}
@@ -0,0 +1,199 @@
+package enclave_dns
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+type DnsRecordType string
+
+const (
+	DnsRecordType_A     DnsRecordType = "A"
+	DnsRecordType_AAAA  DnsRecordType = "AAAA"
+	DnsRecordType_CNAME DnsRecordType = "CNAME"
+	DnsRecordType_SRV   DnsRecordType = "SRV"
+	// Not an actual DNS record: the queries for the name and all its subdomains get forwarded to the resolver of the target
+	DnsRecordType_FORWARD DnsRecordType = "FORWARD"
+
+	wildcardLabel       = "*"
+	wildcardPrefix      = wildcardLabel + "."
+	domainNameSeparator = "."
+
+	maxDomainNameLength = 253
+	// Underscores are allowed so SRV records can use service and protocol labels like '_http._tcp'
+	domainNameLabelRegexStr = "^[a-z0-9_]([a-z0-9_-]{0,61}[a-z0-9_])?$"
+
+	defaultDnsPortNum = 53
+)
+
+var (
+	domainNameLabelRegex = regexp.MustCompile(domainNameLabelRegexStr)
+
+	isValidDnsRecordType = map[DnsRecordType]bool{
+		DnsRecordType_A:       true,
+		DnsRecordType_AAAA:    true,
+		DnsRecordType_CNAME:   true,
+		DnsRecordType_SRV:     true,
+		DnsRecordType_FORWARD: true,
+	}
+)
+
+// DnsRecord is a record served by the enclave DNS server
+// Names starting with a '*' label are wildcards matching all the names below them that don't have records of their own
+type DnsRecord struct {
+	Name   string        `json:"name"`
+	Type   DnsRecordType `json:"type"`
+	Target string        `json:"target"`
+
+	// Only used by SRV records
+	Port     uint16 `json:"port"`
+	Priority uint16 `json:"priority"`
+	Weight   uint16 `json:"weight"`
+}
+
+// NewDnsRecord validates and normalizes the record
+// An empty record type gets inferred from the target: A or AAAA for IP addresses, CNAME for hostnames
+// The target of a FORWARD record is the IP address of the resolver, with an optional port defaulting to 53
+func NewDnsRecord(name string, recordType DnsRecordType, target string, port uint16, priority uint16, weight uint16) (*DnsRecord, error) {
+	normalizedName := normalizeDomainName(name)
+	if err := validateDomainName(normalizedName, true); err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid DNS record name '%v'", name)
+	}
+
+	if recordType == "" {
+		recordType = inferDnsRecordType(target)
+	}
+	recordType = DnsRecordType(strings.ToUpper(string(recordType)))
+	if !isValidDnsRecordType[recordType] {
+		return nil, stacktrace.NewError("Invalid type '%v' for DNS record '%v'; valid types are %v", recordType, name, getValidDnsRecordTypes())
+	}
+	if recordType != DnsRecordType_SRV && (port != 0 || priority != 0 || weight != 0) {
+		return nil, stacktrace.NewError("Port, priority and weight can only be set on SRV records, but DNS record '%v' is of type '%v'", name, recordType)
+	}
+
+	var normalizedTarget string
+	switch recordType {
+	case DnsRecordType_A, DnsRecordType_AAAA:
+		ipAddr := net.ParseIP(target)
+		if ipAddr == nil || (ipAddr.To4() != nil) != (recordType == DnsRecordType_A) {
+			return nil, stacktrace.NewError("The target of %v record '%v' should be an IPv%v address but was '%v'", recordType, name, getIpVersion(recordType), target)
+		}
+		normalizedTarget = ipAddr.String()
+	case DnsRecordType_CNAME, DnsRecordType_SRV:
+		normalizedTarget = normalizeDomainName(target)
+		if err := validateDomainName(normalizedTarget, false); err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid target '%v' for %v record '%v'", target, recordType, name)
+		}
+		if recordType == DnsRecordType_CNAME && normalizedTarget == normalizedName {
+			return nil, stacktrace.NewError("CNAME record '%v' can't point to itself", name)
+		}
+		if recordType == DnsRecordType_SRV && port == 0 {
+			return nil, stacktrace.NewError("SRV record '%v' needs a port", name)
+		}
+	case DnsRecordType_FORWARD:
+		if strings.HasPrefix(normalizedName, wildcardPrefix) {
+			return nil, stacktrace.NewError("FORWARD record '%v' can't be a wildcard, as it already covers all the subdomains of the name", name)
+		}
+		resolverAddress, err := getResolverAddress(target)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid resolver '%v' for FORWARD record '%v'", target, name)
+		}
+		normalizedTarget = resolverAddress
+	}
+
+	return &DnsRecord{
+		Name:     normalizedName,
+		Type:     recordType,
+		Target:   normalizedTarget,
+		Port:     port,
+		Priority: priority,
+		Weight:   weight,
+	}, nil
+}
+
+func (record *DnsRecord) String() string {
+	if record.Type == DnsRecordType_SRV {
+		return fmt.Sprintf("%v %v %v %v %v:%v", record.Name, record.Type, record.Priority, record.Weight, record.Target, record.Port)
+	}
+	return fmt.Sprintf("%v %v %v", record.Name, record.Type, record.Target)
+}
+
+// Gets the key identifying the record in the store; adding the same record twice is a no-op
+func (record *DnsRecord) getKey() string {
+	return record.String()
+}
+
+// Domain names are case-insensitive, and the records are stored without the trailing dot of fully qualified names
+func normalizeDomainName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), domainNameSeparator)
+}
+
+func validateDomainName(name string, isWildcardAllowed bool) error {
+	if name == "" {
+		return stacktrace.NewError("Domain name is empty")
+	}
+	if len(name) > maxDomainNameLength {
+		return stacktrace.NewError("Domain name '%v' is longer than %d characters", name, maxDomainNameLength)
+	}
+	for idx, label := range strings.Split(name, domainNameSeparator) {
+		if label == wildcardLabel && idx == 0 && isWildcardAllowed {
+			continue
+		}
+		if !domainNameLabelRegex.MatchString(label) {
+			return stacktrace.NewError("Label '%v' of domain name '%v' doesn't match regex '%v'", label, name, domainNameLabelRegexStr)
+		}
+	}
+	return nil
+}
+
+func inferDnsRecordType(target string) DnsRecordType {
+	ipAddr := net.ParseIP(target)
+	if ipAddr == nil {
+		return DnsRecordType_CNAME
+	}
+	if ipAddr.To4() != nil {
+		return DnsRecordType_A
+	}
+	return DnsRecordType_AAAA
+}
+
+func getResolverAddress(resolver string) (string, error) {
+	if ipAddr := net.ParseIP(resolver); ipAddr != nil {
+		return net.JoinHostPort(ipAddr.String(), strconv.Itoa(defaultDnsPortNum)), nil
+	}
+	host, portStr, err := net.SplitHostPort(resolver)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Expected the resolver to be an IP address with an optional port")
+	}
+	ipAddr := net.ParseIP(host)
+	if ipAddr == nil {
+		return "", stacktrace.NewError("Expected the resolver host '%v' to be an IP address", host)
+	}
+	portNum, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil || portNum == 0 {
+		return "", stacktrace.NewError("Expected the resolver port '%v' to be a number between 1 and 65535", portStr)
+	}
+	return net.JoinHostPort(ipAddr.String(), portStr), nil
+}
+
+func getIpVersion(recordType DnsRecordType) int {
+	if recordType == DnsRecordType_A {
+		return 4
+	}
+	return 6
+}
+
+func getValidDnsRecordTypes() []DnsRecordType {
+	return []DnsRecordType{
+		DnsRecordType_A,
+		DnsRecordType_AAAA,
+		DnsRecordType_CNAME,
+		DnsRecordType_SRV,
+		DnsRecordType_FORWARD,
+	}
+}
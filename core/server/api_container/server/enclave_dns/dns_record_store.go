@@ -0,0 +1,160 @@
+package enclave_dns
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	dnsRecordStoreBucketName = []byte("dns-record-store")
+)
+
+// DnsRecordStore holds the DNS records of the enclave
+// The records are persisted in the enclave database so they survive restarts of the API container, and are kept in
+// memory as well since the DNS server reads them on every query
+type DnsRecordStore struct {
+	enclaveDb *enclave_db.EnclaveDB
+
+	mutex *sync.RWMutex
+	// Key is the record key
+	records map[string]*DnsRecord
+}
+
+func GetOrCreateDnsRecordStore(enclaveDb *enclave_db.EnclaveDB) (*DnsRecordStore, error) {
+	records := map[string]*DnsRecord{}
+	if err := enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(dnsRecordStoreBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the DNS record store database bucket")
+		}
+		logrus.Debugf("DNS record store bucket: '%+v'", bucket)
+		return bucket.ForEach(func(key, recordBytes []byte) error {
+			// nolint: exhaustruct
+			record := &DnsRecord{}
+			if err := json.Unmarshal(recordBytes, record); err != nil {
+				return stacktrace.Propagate(err, "An error occurred unmarshalling DNS record '%v'", string(key))
+			}
+			records[string(key)] = record
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building the DNS record store")
+	}
+
+	return &DnsRecordStore{
+		enclaveDb: enclaveDb,
+		mutex:     &sync.RWMutex{},
+		records:   records,
+	}, nil
+}
+
+// AddDnsRecord adds the record, doing nothing if the exact same record already exists
+// A name with a CNAME record can't have any other record, as per the DNS spec
+func (store *DnsRecordStore) AddDnsRecord(record *DnsRecord) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	recordKey := record.getKey()
+	if _, found := store.records[recordKey]; found {
+		return nil
+	}
+	for _, existingRecord := range store.records {
+		if existingRecord.Name != record.Name || existingRecord.Type == DnsRecordType_FORWARD || record.Type == DnsRecordType_FORWARD {
+			continue
+		}
+		if existingRecord.Type == DnsRecordType_CNAME || record.Type == DnsRecordType_CNAME {
+			return stacktrace.NewError("Can't add DNS record '%v' as it conflicts with existing record '%v'; a name with a CNAME record can't have any other record", record, existingRecord)
+		}
+	}
+
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred marshalling DNS record '%v'", record)
+	}
+	if err := store.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(dnsRecordStoreBucketName)
+		if err := bucket.Put([]byte(recordKey), recordBytes); err != nil {
+			return stacktrace.Propagate(err, "An error occurred saving DNS record '%v' into the enclave db bucket", record)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving DNS record '%v' into the enclave db", record)
+	}
+	store.records[recordKey] = record
+	return nil
+}
+
+// GetDnsRecords returns all the records, sorted by name and type
+func (store *DnsRecordStore) GetDnsRecords() []*DnsRecord {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	records := make([]*DnsRecord, 0, len(store.records))
+	for _, record := range store.records {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].getKey() < records[j].getKey()
+	})
+	return records
+}
+
+// Gets the records of the name, or the records of the closest wildcard matching it if the name has none
+// FORWARD records are never returned, as they aren't records the server answers with
+func (store *DnsRecordStore) getRecordsForName(name string) []*DnsRecord {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	if records := store.getRecordsWithNameUnlocked(name); len(records) > 0 {
+		return records
+	}
+	labels := strings.Split(name, domainNameSeparator)
+	for idx := 1; idx < len(labels); idx++ {
+		wildcardName := wildcardPrefix + strings.Join(labels[idx:], domainNameSeparator)
+		if records := store.getRecordsWithNameUnlocked(wildcardName); len(records) > 0 {
+			return records
+		}
+	}
+	return nil
+}
+
+// Gets the address of the resolver the queries for the name get forwarded to, using the FORWARD record of the longest
+// domain the name belongs to
+func (store *DnsRecordStore) getForwardingResolverAddress(name string) (string, bool) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	resolverAddress := ""
+	longestDomainLength := -1
+	for _, record := range store.records {
+		if record.Type != DnsRecordType_FORWARD || len(record.Name) <= longestDomainLength {
+			continue
+		}
+		if name == record.Name || strings.HasSuffix(name, domainNameSeparator+record.Name) {
+			resolverAddress = record.Target
+			longestDomainLength = len(record.Name)
+		}
+	}
+	return resolverAddress, longestDomainLength >= 0
+}
+
+func (store *DnsRecordStore) getRecordsWithNameUnlocked(name string) []*DnsRecord {
+	records := []*DnsRecord{}
+	for _, record := range store.records {
+		if record.Name == name && record.Type != DnsRecordType_FORWARD {
+			records = append(records, record)
+		}
+	}
+	// Sorted so the answers are stable
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].getKey() < records[j].getKey()
+	})
+	return records
+}
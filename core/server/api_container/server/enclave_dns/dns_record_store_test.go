@@ -0,0 +1,93 @@
+package enclave_dns
+
+import (
+	"path"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestDnsRecordStore_AddAndGet(t *testing.T) {
+	store, enclaveDb := getDnsRecordStoreForTest(t)
+	require.Empty(t, store.GetDnsRecords())
+
+	apiRecord := mustNewDnsRecord(t, "api.internal", "", "10.0.0.5")
+	require.NoError(t, store.AddDnsRecord(apiRecord))
+	// Adding the same record twice is a no-op
+	require.NoError(t, store.AddDnsRecord(mustNewDnsRecord(t, "API.internal.", "", "10.0.0.5")))
+	require.NoError(t, store.AddDnsRecord(mustNewDnsRecord(t, "api.internal", "", "10.0.0.6")))
+	require.Len(t, store.GetDnsRecords(), 2)
+
+	// A name with A records can't get a CNAME record, and the other way around
+	require.Error(t, store.AddDnsRecord(mustNewDnsRecord(t, "api.internal", "", "my-service")))
+	require.NoError(t, store.AddDnsRecord(mustNewDnsRecord(t, "db.internal", "", "postgres")))
+	require.Error(t, store.AddDnsRecord(mustNewDnsRecord(t, "db.internal", "", "10.0.0.7")))
+
+	// The records survive restarts of the API container
+	restartedStore, err := GetOrCreateDnsRecordStore(enclaveDb)
+	require.NoError(t, err)
+	require.Equal(t, store.GetDnsRecords(), restartedStore.GetDnsRecords())
+}
+
+func TestDnsRecordStore_Wildcards(t *testing.T) {
+	store, _ := getDnsRecordStoreForTest(t)
+	require.NoError(t, store.AddDnsRecord(mustNewDnsRecord(t, "*.company.com", "", "10.0.0.1")))
+	require.NoError(t, store.AddDnsRecord(mustNewDnsRecord(t, "*.internal.company.com", "", "10.0.0.2")))
+	require.NoError(t, store.AddDnsRecord(mustNewDnsRecord(t, "db.internal.company.com", "", "10.0.0.3")))
+
+	requireRecordTargets(t, []string{"10.0.0.3"}, store.getRecordsForName("db.internal.company.com"))
+	requireRecordTargets(t, []string{"10.0.0.2"}, store.getRecordsForName("api.internal.company.com"))
+	requireRecordTargets(t, []string{"10.0.0.2"}, store.getRecordsForName("v1.api.internal.company.com"))
+	requireRecordTargets(t, []string{"10.0.0.1"}, store.getRecordsForName("www.company.com"))
+	require.Empty(t, store.getRecordsForName("company.com"))
+	require.Empty(t, store.getRecordsForName("example.com"))
+}
+
+func TestDnsRecordStore_ForwardingResolvers(t *testing.T) {
+	store, _ := getDnsRecordStoreForTest(t)
+	require.NoError(t, store.AddDnsRecord(mustNewDnsRecord(t, "company.com", DnsRecordType_FORWARD, "10.0.0.53")))
+	require.NoError(t, store.AddDnsRecord(mustNewDnsRecord(t, "internal.company.com", DnsRecordType_FORWARD, "10.1.0.53:5353")))
+
+	resolverAddress, found := store.getForwardingResolverAddress("www.company.com")
+	require.True(t, found)
+	require.Equal(t, "10.0.0.53:53", resolverAddress)
+
+	resolverAddress, found = store.getForwardingResolverAddress("api.internal.company.com")
+	require.True(t, found)
+	require.Equal(t, "10.1.0.53:5353", resolverAddress)
+
+	_, found = store.getForwardingResolverAddress("notcompany.com")
+	require.False(t, found)
+
+	// FORWARD records aren't records the server answers with
+	require.Empty(t, store.getRecordsForName("company.com"))
+}
+
+func getDnsRecordStoreForTest(t *testing.T) (*DnsRecordStore, *enclave_db.EnclaveDB) {
+	db, err := bolt.Open(path.Join(t.TempDir(), "enclave.db"), 0666, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	store, err := GetOrCreateDnsRecordStore(enclaveDb)
+	require.NoError(t, err)
+	return store, enclaveDb
+}
+
+func mustNewDnsRecord(t *testing.T, name string, recordType DnsRecordType, target string) *DnsRecord {
+	record, err := NewDnsRecord(name, recordType, target, 0, 0, 0)
+	require.NoError(t, err)
+	return record
+}
+
+func requireRecordTargets(t *testing.T, expectedTargets []string, records []*DnsRecord) {
+	targets := []string{}
+	for _, record := range records {
+		targets = append(targets, record.Target)
+	}
+	require.Equal(t, expectedTargets, targets)
+}
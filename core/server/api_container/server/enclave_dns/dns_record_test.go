@@ -0,0 +1,67 @@
+package enclave_dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDnsRecord_InfersTypeFromTarget(t *testing.T) {
+	aRecord, err := NewDnsRecord("API.Internal.Company.com.", "", "10.0.0.5", 0, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, "api.internal.company.com", aRecord.Name)
+	require.Equal(t, DnsRecordType_A, aRecord.Type)
+
+	aaaaRecord, err := NewDnsRecord("api.internal", "", "fd00::5", 0, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, DnsRecordType_AAAA, aaaaRecord.Type)
+
+	cnameRecord, err := NewDnsRecord("*.internal.company.com", "", "my-service", 0, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, DnsRecordType_CNAME, cnameRecord.Type)
+	require.Equal(t, "my-service", cnameRecord.Target)
+}
+
+func TestNewDnsRecord_SrvAndForward(t *testing.T) {
+	srvRecord, err := NewDnsRecord("_http._tcp.api.internal", "srv", "my-service", 8080, 10, 5)
+	require.NoError(t, err)
+	require.Equal(t, DnsRecordType_SRV, srvRecord.Type)
+	require.Equal(t, "_http._tcp.api.internal SRV 10 5 my-service:8080", srvRecord.String())
+
+	_, err = NewDnsRecord("_http._tcp.api.internal", DnsRecordType_SRV, "my-service", 0, 0, 0)
+	require.Error(t, err)
+
+	forwardRecord, err := NewDnsRecord("corp.example.com", DnsRecordType_FORWARD, "10.0.0.53", 0, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.53:53", forwardRecord.Target)
+
+	forwardRecord, err = NewDnsRecord("corp.example.com", DnsRecordType_FORWARD, "[fd00::53]:5353", 0, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, "[fd00::53]:5353", forwardRecord.Target)
+}
+
+func TestNewDnsRecord_Invalid(t *testing.T) {
+	invalidRecords := []struct {
+		name       string
+		recordType DnsRecordType
+		target     string
+		port       uint16
+	}{
+		{"", "", "10.0.0.5", 0},
+		{"api..internal", "", "10.0.0.5", 0},
+		{"api.*.internal", "", "10.0.0.5", 0},
+		{"api.internal", DnsRecordType_A, "fd00::5", 0},
+		{"api.internal", DnsRecordType_AAAA, "10.0.0.5", 0},
+		{"api.internal", "MX", "mail.internal", 0},
+		{"api.internal", DnsRecordType_CNAME, "api.internal", 0},
+		{"api.internal", DnsRecordType_CNAME, "not a hostname", 0},
+		{"api.internal", DnsRecordType_CNAME, "my-service", 80},
+		{"*.corp.example.com", DnsRecordType_FORWARD, "10.0.0.53", 0},
+		{"corp.example.com", DnsRecordType_FORWARD, "resolver.corp.example.com", 0},
+		{"corp.example.com", DnsRecordType_FORWARD, "10.0.0.53:0", 0},
+	}
+	for _, invalidRecord := range invalidRecords {
+		_, err := NewDnsRecord(invalidRecord.name, invalidRecord.recordType, invalidRecord.target, invalidRecord.port, 0, 0)
+		require.Error(t, err, "Expected record '%+v' to be invalid", invalidRecord)
+	}
+}
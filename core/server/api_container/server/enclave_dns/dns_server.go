@@ -0,0 +1,488 @@
+package enclave_dns
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	udpNetwork = "udp"
+	tcpNetwork = "tcp"
+
+	ipv4LookupNetwork = "ip4"
+	ipv6LookupNetwork = "ip6"
+
+	// Kept short so the records added while services run get picked up quickly
+	dnsRecordTtlSeconds = 5
+
+	// Responses to UDP queries longer than this get truncated, so the client retries over TCP
+	maxUdpMessageSize = 512
+	maxMessageSize    = 65535
+	// Over TCP, every message is prefixed with its length
+	tcpMessageLengthNumBytes = 2
+
+	queryTimeout       = 5 * time.Second
+	tcpConnIdleTimeout = 10 * time.Second
+
+	maxCnameChainLength = 8
+
+	resolvConfNameserverKeyword = "nameserver"
+)
+
+// The subset of net.Resolver used to resolve the targets of CNAME records that aren't records of the enclave
+type hostnameResolver interface {
+	LookupIP(ctx context.Context, network string, host string) ([]net.IP, error)
+}
+
+// EnclaveDnsServer is the DNS server of the enclave, running in the API container
+// It answers the queries for the records of the enclave, forwards the queries for the domains of FORWARD records to
+// their resolver, and forwards all the other queries to the resolver of the API container itself, which knows the
+// names of the services of the enclave
+type EnclaveDnsServer struct {
+	recordStore *DnsRecordStore
+
+	upstreamResolverAddress string
+
+	hostnameResolver hostnameResolver
+}
+
+func NewEnclaveDnsServer(recordStore *DnsRecordStore, upstreamResolverAddress string) *EnclaveDnsServer {
+	return &EnclaveDnsServer{
+		recordStore:             recordStore,
+		upstreamResolverAddress: upstreamResolverAddress,
+		hostnameResolver:        net.DefaultResolver,
+	}
+}
+
+// GetUpstreamResolverAddress gets the address of the first nameserver of the given resolv.conf file
+func GetUpstreamResolverAddress(resolvConfFilepath string) (string, error) {
+	resolvConfContents, err := os.ReadFile(resolvConfFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading resolv.conf file '%v'", resolvConfFilepath)
+	}
+	upstreamResolverAddress, err := getUpstreamResolverAddressFromResolvConf(string(resolvConfContents))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the nameserver of resolv.conf file '%v'", resolvConfFilepath)
+	}
+	return upstreamResolverAddress, nil
+}
+
+// Start listens on the port over both UDP and TCP, and serves the queries until the context gets cancelled
+func (server *EnclaveDnsServer) Start(ctx context.Context, portNum uint16) error {
+	listenAddress := net.JoinHostPort("", strconv.Itoa(int(portNum)))
+	udpConn, err := net.ListenPacket(udpNetwork, listenAddress)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listening for DNS queries over UDP on '%v'", listenAddress)
+	}
+	tcpListener, err := net.Listen(tcpNetwork, listenAddress)
+	if err != nil {
+		if closeErr := udpConn.Close(); closeErr != nil {
+			logrus.Warnf("An error occurred closing the UDP listener of the enclave DNS server:\n%v", closeErr)
+		}
+		return stacktrace.Propagate(err, "An error occurred listening for DNS queries over TCP on '%v'", listenAddress)
+	}
+
+	go server.serveUdp(ctx, udpConn)
+	go server.serveTcp(ctx, tcpListener)
+	go func() {
+		<-ctx.Done()
+		if err := udpConn.Close(); err != nil {
+			logrus.Warnf("An error occurred closing the UDP listener of the enclave DNS server:\n%v", err)
+		}
+		if err := tcpListener.Close(); err != nil {
+			logrus.Warnf("An error occurred closing the TCP listener of the enclave DNS server:\n%v", err)
+		}
+	}()
+	logrus.Infof("Enclave DNS server listening on port %v, forwarding to '%v'", portNum, server.upstreamResolverAddress)
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private helper methods
+//
+// ====================================================================================================
+func (server *EnclaveDnsServer) serveUdp(ctx context.Context, udpConn net.PacketConn) {
+	buffer := make([]byte, maxMessageSize)
+	for {
+		numBytes, clientAddress, err := udpConn.ReadFrom(buffer)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			logrus.Debugf("An error occurred reading a DNS query over UDP:\n%v", err)
+			continue
+		}
+		query := append([]byte{}, buffer[:numBytes]...)
+		go func() {
+			response, err := server.handleQuery(ctx, query, udpNetwork)
+			if err != nil {
+				logrus.Debugf("Dropping invalid DNS query from '%v':\n%v", clientAddress, err)
+				return
+			}
+			if _, err := udpConn.WriteTo(response, clientAddress); err != nil {
+				logrus.Debugf("An error occurred writing the DNS response to '%v':\n%v", clientAddress, err)
+			}
+		}()
+	}
+}
+
+func (server *EnclaveDnsServer) serveTcp(ctx context.Context, tcpListener net.Listener) {
+	for {
+		conn, err := tcpListener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			logrus.Debugf("An error occurred accepting a DNS connection over TCP:\n%v", err)
+			continue
+		}
+		go server.serveTcpConn(ctx, conn)
+	}
+}
+
+// Clients can send several queries over the same TCP connection
+func (server *EnclaveDnsServer) serveTcpConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	for {
+		if err := conn.SetDeadline(time.Now().Add(tcpConnIdleTimeout)); err != nil {
+			return
+		}
+		query, err := readTcpMessage(conn)
+		if err != nil {
+			return
+		}
+		response, err := server.handleQuery(ctx, query, tcpNetwork)
+		if err != nil {
+			logrus.Debugf("Dropping invalid DNS query from '%v':\n%v", conn.RemoteAddr(), err)
+			return
+		}
+		if err := writeTcpMessage(conn, response); err != nil {
+			return
+		}
+	}
+}
+
+// Gets the response to the query, received over the given network; an error means the query should be dropped
+func (server *EnclaveDnsServer) handleQuery(ctx context.Context, query []byte, network string) ([]byte, error) {
+	var parser dnsmessage.Parser
+	queryHeader, err := parser.Start(query)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the header of the DNS query")
+	}
+	question, err := parser.Question()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the question of the DNS query")
+	}
+	name := normalizeDomainName(question.Name.String())
+
+	if records := server.recordStore.getRecordsForName(name); len(records) > 0 {
+		response, err := server.buildAnswer(ctx, queryHeader, question, records, network)
+		if err != nil {
+			logrus.Warnf("An error occurred answering the DNS query for '%v' with the records of the enclave:\n%v", name, err)
+			return buildEmptyResponse(queryHeader, question, dnsmessage.RCodeServerFailure, false)
+		}
+		return response, nil
+	}
+
+	resolverAddress, found := server.recordStore.getForwardingResolverAddress(name)
+	if !found {
+		resolverAddress = server.upstreamResolverAddress
+	}
+	response, err := forwardQuery(network, resolverAddress, query)
+	if err != nil {
+		logrus.Debugf("An error occurred forwarding the DNS query for '%v' to '%v':\n%v", name, resolverAddress, err)
+		return buildEmptyResponse(queryHeader, question, dnsmessage.RCodeServerFailure, false)
+	}
+	return response, nil
+}
+
+func (server *EnclaveDnsServer) buildAnswer(
+	ctx context.Context,
+	queryHeader dnsmessage.Header,
+	question dnsmessage.Question,
+	records []*DnsRecord,
+	network string,
+) ([]byte, error) {
+	builder := dnsmessage.NewBuilder(nil, getResponseHeader(queryHeader, dnsmessage.RCodeSuccess, false))
+	builder.EnableCompression()
+	if err := builder.StartQuestions(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting the questions of the DNS response")
+	}
+	if err := builder.Question(question); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the question to the DNS response")
+	}
+	if err := builder.StartAnswers(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting the answers of the DNS response")
+	}
+	if err := server.addAnswers(ctx, &builder, question.Name, question.Type, records, 0); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the answers to the DNS response")
+	}
+	response, err := builder.Finish()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building the DNS response")
+	}
+	if network == udpNetwork && len(response) > maxUdpMessageSize {
+		return buildEmptyResponse(queryHeader, question, dnsmessage.RCodeSuccess, true)
+	}
+	return response, nil
+}
+
+// Adds the answers of the records to the question type, following CNAME records
+// The answers of wildcard records use the name of the question
+func (server *EnclaveDnsServer) addAnswers(
+	ctx context.Context,
+	builder *dnsmessage.Builder,
+	name dnsmessage.Name,
+	questionType dnsmessage.Type,
+	records []*DnsRecord,
+	cnameChainLength int,
+) error {
+	for _, record := range records {
+		resourceHeader := dnsmessage.ResourceHeader{
+			Name:   name,
+			Type:   0,
+			Class:  dnsmessage.ClassINET,
+			TTL:    dnsRecordTtlSeconds,
+			Length: 0,
+		}
+		switch {
+		case record.Type == DnsRecordType_CNAME:
+			targetName, err := dnsmessage.NewName(record.Target + domainNameSeparator)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred creating the DNS name of target '%v'", record.Target)
+			}
+			if err := builder.CNAMEResource(resourceHeader, dnsmessage.CNAMEResource{CNAME: targetName}); err != nil {
+				return stacktrace.Propagate(err, "An error occurred adding CNAME record '%v' to the DNS response", record)
+			}
+			if questionType == dnsmessage.TypeCNAME {
+				continue
+			}
+			if err := server.addCnameTargetAnswers(ctx, builder, targetName, record.Target, questionType, cnameChainLength+1); err != nil {
+				return stacktrace.Propagate(err, "An error occurred adding the answers of target '%v' of CNAME record '%v'", record.Target, record)
+			}
+		case record.Type == DnsRecordType_A && questionType == dnsmessage.TypeA:
+			if err := addAResource(builder, resourceHeader, net.ParseIP(record.Target)); err != nil {
+				return stacktrace.Propagate(err, "An error occurred adding A record '%v' to the DNS response", record)
+			}
+		case record.Type == DnsRecordType_AAAA && questionType == dnsmessage.TypeAAAA:
+			if err := addAAAAResource(builder, resourceHeader, net.ParseIP(record.Target)); err != nil {
+				return stacktrace.Propagate(err, "An error occurred adding AAAA record '%v' to the DNS response", record)
+			}
+		case record.Type == DnsRecordType_SRV && questionType == dnsmessage.TypeSRV:
+			targetName, err := dnsmessage.NewName(record.Target + domainNameSeparator)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred creating the DNS name of target '%v'", record.Target)
+			}
+			srvResource := dnsmessage.SRVResource{
+				Priority: record.Priority,
+				Weight:   record.Weight,
+				Port:     record.Port,
+				Target:   targetName,
+			}
+			if err := builder.SRVResource(resourceHeader, srvResource); err != nil {
+				return stacktrace.Propagate(err, "An error occurred adding SRV record '%v' to the DNS response", record)
+			}
+		}
+	}
+	return nil
+}
+
+// Stub resolvers don't follow CNAME records by themselves, so the addresses of the target get added to the response
+// The target is resolved with the records of the enclave first, then with the resolver of its FORWARD record if any,
+// and finally with the resolver of the API container, which knows the names of the services of the enclave
+func (server *EnclaveDnsServer) addCnameTargetAnswers(
+	ctx context.Context,
+	builder *dnsmessage.Builder,
+	targetName dnsmessage.Name,
+	target string,
+	questionType dnsmessage.Type,
+	cnameChainLength int,
+) error {
+	if cnameChainLength > maxCnameChainLength {
+		return stacktrace.NewError("Following the CNAME records of the enclave went through more than %d records; there's probably a loop", maxCnameChainLength)
+	}
+	if records := server.recordStore.getRecordsForName(target); len(records) > 0 {
+		return server.addAnswers(ctx, builder, targetName, questionType, records, cnameChainLength)
+	}
+
+	var lookupNetwork string
+	switch questionType {
+	case dnsmessage.TypeA:
+		lookupNetwork = ipv4LookupNetwork
+	case dnsmessage.TypeAAAA:
+		lookupNetwork = ipv6LookupNetwork
+	default:
+		// The client can query the target itself for other types
+		return nil
+	}
+
+	lookupCtx, cancelLookup := context.WithTimeout(ctx, queryTimeout)
+	defer cancelLookup()
+	resolver := server.hostnameResolver
+	if resolverAddress, found := server.recordStore.getForwardingResolverAddress(target); found {
+		resolver = getResolverForAddress(resolverAddress)
+	}
+	ipAddrs, err := resolver.LookupIP(lookupCtx, lookupNetwork, target)
+	if err != nil {
+		// The response keeps the CNAME record, so the client can still try resolving the target itself
+		logrus.Debugf("An error occurred resolving '%v', the target of a CNAME record of the enclave:\n%v", target, err)
+		return nil
+	}
+	resourceHeader := dnsmessage.ResourceHeader{
+		Name:   targetName,
+		Type:   0,
+		Class:  dnsmessage.ClassINET,
+		TTL:    dnsRecordTtlSeconds,
+		Length: 0,
+	}
+	for _, ipAddr := range ipAddrs {
+		if questionType == dnsmessage.TypeA {
+			err = addAResource(builder, resourceHeader, ipAddr)
+		} else {
+			err = addAAAAResource(builder, resourceHeader, ipAddr)
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding address '%v' of '%v' to the DNS response", ipAddr, target)
+		}
+	}
+	return nil
+}
+
+func addAResource(builder *dnsmessage.Builder, resourceHeader dnsmessage.ResourceHeader, ipAddr net.IP) error {
+	ipv4Addr := ipAddr.To4()
+	if ipv4Addr == nil {
+		return stacktrace.NewError("Expected '%v' to be an IPv4 address", ipAddr)
+	}
+	var aResource dnsmessage.AResource
+	copy(aResource.A[:], ipv4Addr)
+	return builder.AResource(resourceHeader, aResource)
+}
+
+func addAAAAResource(builder *dnsmessage.Builder, resourceHeader dnsmessage.ResourceHeader, ipAddr net.IP) error {
+	ipv6Addr := ipAddr.To16()
+	if ipv6Addr == nil || ipAddr.To4() != nil {
+		return stacktrace.NewError("Expected '%v' to be an IPv6 address", ipAddr)
+	}
+	var aaaaResource dnsmessage.AAAAResource
+	copy(aaaaResource.AAAA[:], ipv6Addr)
+	return builder.AAAAResource(resourceHeader, aaaaResource)
+}
+
+func buildEmptyResponse(queryHeader dnsmessage.Header, question dnsmessage.Question, rcode dnsmessage.RCode, isTruncated bool) ([]byte, error) {
+	builder := dnsmessage.NewBuilder(nil, getResponseHeader(queryHeader, rcode, isTruncated))
+	if err := builder.StartQuestions(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting the questions of the DNS response")
+	}
+	if err := builder.Question(question); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the question to the DNS response")
+	}
+	response, err := builder.Finish()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building the DNS response")
+	}
+	return response, nil
+}
+
+func getResponseHeader(queryHeader dnsmessage.Header, rcode dnsmessage.RCode, isTruncated bool) dnsmessage.Header {
+	return dnsmessage.Header{
+		ID:                 queryHeader.ID,
+		Response:           true,
+		OpCode:             queryHeader.OpCode,
+		Authoritative:      true,
+		Truncated:          isTruncated,
+		RecursionDesired:   queryHeader.RecursionDesired,
+		RecursionAvailable: true,
+		AuthenticData:      false,
+		CheckingDisabled:   false,
+		RCode:              rcode,
+	}
+}
+
+// Forwards the query as is, so the response already has the ID of the query
+func forwardQuery(network string, resolverAddress string, query []byte) ([]byte, error) {
+	conn, err := net.DialTimeout(network, resolverAddress, queryTimeout)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to resolver '%v' over %v", resolverAddress, network)
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(queryTimeout)); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred setting the deadline of the connection to resolver '%v'", resolverAddress)
+	}
+
+	if network == tcpNetwork {
+		if err := writeTcpMessage(conn, query); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred sending the query to resolver '%v'", resolverAddress)
+		}
+		response, err := readTcpMessage(conn)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the response of resolver '%v'", resolverAddress)
+		}
+		return response, nil
+	}
+
+	if _, err := conn.Write(query); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred sending the query to resolver '%v'", resolverAddress)
+	}
+	buffer := make([]byte, maxMessageSize)
+	numBytes, err := conn.Read(buffer)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the response of resolver '%v'", resolverAddress)
+	}
+	return buffer[:numBytes], nil
+}
+
+func readTcpMessage(reader io.Reader) ([]byte, error) {
+	lengthBytes := make([]byte, tcpMessageLengthNumBytes)
+	if _, err := io.ReadFull(reader, lengthBytes); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the length of the DNS message")
+	}
+	message := make([]byte, binary.BigEndian.Uint16(lengthBytes))
+	if _, err := io.ReadFull(reader, message); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the DNS message")
+	}
+	return message, nil
+}
+
+func writeTcpMessage(writer io.Writer, message []byte) error {
+	lengthBytes := make([]byte, tcpMessageLengthNumBytes)
+	binary.BigEndian.PutUint16(lengthBytes, uint16(len(message)))
+	if _, err := writer.Write(append(lengthBytes, message...)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the DNS message")
+	}
+	return nil
+}
+
+func getResolverForAddress(resolverAddress string) *net.Resolver {
+	// nolint: exhaustruct
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network string, _ string) (net.Conn, error) {
+			// nolint: exhaustruct
+			dialer := net.Dialer{}
+			return dialer.DialContext(ctx, network, resolverAddress)
+		},
+	}
+}
+
+func getUpstreamResolverAddressFromResolvConf(resolvConfContents string) (string, error) {
+	for _, line := range strings.Split(resolvConfContents, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != resolvConfNameserverKeyword {
+			continue
+		}
+		return getResolverAddress(fields[1])
+	}
+	return "", stacktrace.NewError("No nameserver found in resolv.conf contents:\n%v", resolvConfContents)
+}
@@ -0,0 +1,184 @@
+package enclave_dns
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	testQueryId = 1234
+)
+
+type fakeHostnameResolver struct {
+	ipAddrsByHost map[string][]net.IP
+}
+
+func (resolver *fakeHostnameResolver) LookupIP(_ context.Context, _ string, host string) ([]net.IP, error) {
+	ipAddrs, found := resolver.ipAddrsByHost[host]
+	if !found {
+		return nil, &net.DNSError{Err: "no such host", Name: host, Server: "", IsTimeout: false, IsTemporary: false, IsNotFound: true}
+	}
+	return ipAddrs, nil
+}
+
+func TestHandleQuery_AnswersWithRecords(t *testing.T) {
+	server := getDnsServerForTest(t, "127.0.0.1:1")
+	require.NoError(t, server.recordStore.AddDnsRecord(mustNewDnsRecord(t, "api.internal.company.com", "", "10.0.0.5")))
+	require.NoError(t, server.recordStore.AddDnsRecord(mustNewDnsRecord(t, "*.internal.company.com", "", "fd00::5")))
+
+	header, answers := queryForTest(t, server, "API.internal.company.com.", dnsmessage.TypeA)
+	require.Equal(t, uint16(testQueryId), header.ID)
+	require.Equal(t, dnsmessage.RCodeSuccess, header.RCode)
+	require.Len(t, answers, 1)
+	require.Equal(t, [4]byte{10, 0, 0, 5}, answers[0].Body.(*dnsmessage.AResource).A)
+
+	// Wildcard answers use the name of the question
+	_, answers = queryForTest(t, server, "db.internal.company.com.", dnsmessage.TypeAAAA)
+	require.Len(t, answers, 1)
+	require.Equal(t, "db.internal.company.com.", answers[0].Header.Name.String())
+	require.Equal(t, net.ParseIP("fd00::5").To16(), net.IP(answers[0].Body.(*dnsmessage.AAAAResource).AAAA[:]))
+
+	// The name exists but has no record of the type
+	header, answers = queryForTest(t, server, "api.internal.company.com.", dnsmessage.TypeAAAA)
+	require.Equal(t, dnsmessage.RCodeSuccess, header.RCode)
+	require.Empty(t, answers)
+}
+
+func TestHandleQuery_FollowsCnameRecords(t *testing.T) {
+	server := getDnsServerForTest(t, "127.0.0.1:1")
+	server.hostnameResolver = &fakeHostnameResolver{
+		ipAddrsByHost: map[string][]net.IP{
+			"my-service": {net.ParseIP("172.16.0.7"), net.ParseIP("172.16.0.8")},
+		},
+	}
+	require.NoError(t, server.recordStore.AddDnsRecord(mustNewDnsRecord(t, "www.company.com", "", "api.company.com")))
+	require.NoError(t, server.recordStore.AddDnsRecord(mustNewDnsRecord(t, "api.company.com", "", "my-service")))
+
+	_, answers := queryForTest(t, server, "www.company.com.", dnsmessage.TypeA)
+	require.Len(t, answers, 4)
+	require.Equal(t, "api.company.com.", answers[0].Body.(*dnsmessage.CNAMEResource).CNAME.String())
+	require.Equal(t, "my-service.", answers[1].Body.(*dnsmessage.CNAMEResource).CNAME.String())
+	require.Equal(t, "my-service.", answers[2].Header.Name.String())
+	require.Equal(t, [4]byte{172, 16, 0, 7}, answers[2].Body.(*dnsmessage.AResource).A)
+	require.Equal(t, [4]byte{172, 16, 0, 8}, answers[3].Body.(*dnsmessage.AResource).A)
+
+	// Only the CNAME record gets returned when asking for it
+	_, answers = queryForTest(t, server, "www.company.com.", dnsmessage.TypeCNAME)
+	require.Len(t, answers, 1)
+}
+
+func TestHandleQuery_SrvRecords(t *testing.T) {
+	server := getDnsServerForTest(t, "127.0.0.1:1")
+	srvRecord, err := NewDnsRecord("_http._tcp.api.internal", DnsRecordType_SRV, "my-service", 8080, 10, 5)
+	require.NoError(t, err)
+	require.NoError(t, server.recordStore.AddDnsRecord(srvRecord))
+
+	_, answers := queryForTest(t, server, "_http._tcp.api.internal.", dnsmessage.TypeSRV)
+	require.Len(t, answers, 1)
+	srvResource := answers[0].Body.(*dnsmessage.SRVResource)
+	require.Equal(t, uint16(8080), srvResource.Port)
+	require.Equal(t, uint16(10), srvResource.Priority)
+	require.Equal(t, uint16(5), srvResource.Weight)
+	require.Equal(t, "my-service.", srvResource.Target.String())
+}
+
+func TestHandleQuery_ForwardsOtherQueries(t *testing.T) {
+	upstreamAddress, upstreamQueries := startFakeResolverForTest(t, [4]byte{1, 1, 1, 1})
+	forwardedAddress, forwardedQueries := startFakeResolverForTest(t, [4]byte{10, 0, 0, 10})
+
+	server := getDnsServerForTest(t, upstreamAddress)
+	require.NoError(t, server.recordStore.AddDnsRecord(mustNewDnsRecord(t, "internal.company.com", DnsRecordType_FORWARD, forwardedAddress)))
+
+	_, answers := queryForTest(t, server, "db.internal.company.com.", dnsmessage.TypeA)
+	require.Len(t, answers, 1)
+	require.Equal(t, [4]byte{10, 0, 0, 10}, answers[0].Body.(*dnsmessage.AResource).A)
+	require.Equal(t, "db.internal.company.com.", <-forwardedQueries)
+
+	_, answers = queryForTest(t, server, "example.com.", dnsmessage.TypeA)
+	require.Len(t, answers, 1)
+	require.Equal(t, [4]byte{1, 1, 1, 1}, answers[0].Body.(*dnsmessage.AResource).A)
+	require.Equal(t, "example.com.", <-upstreamQueries)
+}
+
+func TestGetUpstreamResolverAddressFromResolvConf(t *testing.T) {
+	resolvConfContents := "# Generated by Docker\nsearch kt-enclave.svc.cluster.local\nnameserver 127.0.0.11\nnameserver 8.8.8.8\noptions ndots:0\n"
+	upstreamResolverAddress, err := getUpstreamResolverAddressFromResolvConf(resolvConfContents)
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.11:53", upstreamResolverAddress)
+
+	_, err = getUpstreamResolverAddressFromResolvConf("search svc.cluster.local\n")
+	require.Error(t, err)
+}
+
+func getDnsServerForTest(t *testing.T, upstreamResolverAddress string) *EnclaveDnsServer {
+	store, _ := getDnsRecordStoreForTest(t)
+	return NewEnclaveDnsServer(store, upstreamResolverAddress)
+}
+
+func queryForTest(t *testing.T, server *EnclaveDnsServer, name string, questionType dnsmessage.Type) (dnsmessage.Header, []dnsmessage.Resource) {
+	// nolint: exhaustruct
+	query := dnsmessage.Message{
+		Header: dnsmessage.Header{ID: testQueryId, RecursionDesired: true},
+		Questions: []dnsmessage.Question{
+			{
+				Name:  dnsmessage.MustNewName(name),
+				Type:  questionType,
+				Class: dnsmessage.ClassINET,
+			},
+		},
+	}
+	queryBytes, err := query.Pack()
+	require.NoError(t, err)
+
+	responseBytes, err := server.handleQuery(context.Background(), queryBytes, udpNetwork)
+	require.NoError(t, err)
+	// nolint: exhaustruct
+	response := dnsmessage.Message{}
+	require.NoError(t, response.Unpack(responseBytes))
+	return response.Header, response.Answers
+}
+
+// Starts a resolver answering every A query with the IP address, sending the names it gets queried for to the channel
+func startFakeResolverForTest(t *testing.T, ipAddr [4]byte) (string, chan string) {
+	conn, err := net.ListenPacket(udpNetwork, "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+	queriedNames := make(chan string, 1)
+	go func() {
+		buffer := make([]byte, maxMessageSize)
+		for {
+			numBytes, clientAddress, err := conn.ReadFrom(buffer)
+			if err != nil {
+				return
+			}
+			// nolint: exhaustruct
+			message := dnsmessage.Message{}
+			if err := message.Unpack(buffer[:numBytes]); err != nil {
+				return
+			}
+			queriedNames <- message.Questions[0].Name.String()
+			message.Header.Response = true
+			message.Answers = []dnsmessage.Resource{
+				{
+					// nolint: exhaustruct
+					Header: dnsmessage.ResourceHeader{Name: message.Questions[0].Name, Class: dnsmessage.ClassINET, TTL: 60},
+					Body:   &dnsmessage.AResource{A: ipAddr},
+				},
+			}
+			response, err := message.Pack()
+			if err != nil {
+				return
+			}
+			if _, err := conn.WriteTo(response, clientAddress); err != nil {
+				return
+			}
+		}
+	}()
+	return conn.LocalAddr().String(), queriedNames
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// The oldest events get dropped past this many, so the engine has to poll more often than they happen
+	maxEnclaveEventsKept = 1000
+
+	// The errors of the Starlark runs can be long, and the events end up in webhooks and chat messages
+	maxEnclaveEventMessageLength = 4096
+
+	truncatedEnclaveEventMessageSuffix = "..."
+)
+
+// enclaveEventLog keeps the last events which happened in the enclave, numbered in order, so that the engine can get
+// the ones it didn't see yet
+type enclaveEventLog struct {
+	// Changes every time the API container starts, as the events aren't persisted
+	uuid string
+
+	// Oldest first, with consecutive sequence numbers
+	events []*kurtosis_core_rpc_api_bindings.EnclaveEvent
+
+	latestSequenceNumber uint64
+
+	mutex *sync.RWMutex
+}
+
+func newEnclaveEventLog() (*enclaveEventLog, error) {
+	uuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the UUID of the enclave event log")
+	}
+	return &enclaveEventLog{
+		uuid:                 uuid,
+		events:               []*kurtosis_core_rpc_api_bindings.EnclaveEvent{},
+		latestSequenceNumber: 0,
+		mutex:                &sync.RWMutex{},
+	}, nil
+}
+
+func (eventLog *enclaveEventLog) record(event *kurtosis_core_rpc_api_bindings.EnclaveEvent) {
+	eventLog.mutex.Lock()
+	defer eventLog.mutex.Unlock()
+	eventLog.latestSequenceNumber++
+	event.SequenceNumber = eventLog.latestSequenceNumber
+	event.Timestamp = timestamppb.Now()
+	eventLog.events = append(eventLog.events, event)
+	if len(eventLog.events) > maxEnclaveEventsKept {
+		eventLog.events = eventLog.events[len(eventLog.events)-maxEnclaveEventsKept:]
+	}
+}
+
+// getEventsAfter returns the events kept with a greater sequence number, and the latest sequence number
+func (eventLog *enclaveEventLog) getEventsAfter(afterSequenceNumber uint64) ([]*kurtosis_core_rpc_api_bindings.EnclaveEvent, uint64) {
+	eventLog.mutex.RLock()
+	defer eventLog.mutex.RUnlock()
+	events := []*kurtosis_core_rpc_api_bindings.EnclaveEvent{}
+	for _, event := range eventLog.events {
+		if event.GetSequenceNumber() > afterSequenceNumber {
+			events = append(events, event)
+		}
+	}
+	return events, eventLog.latestSequenceNumber
+}
+
+func (eventLog *enclaveEventLog) recordStarlarkRunStarted(packageId string) {
+	eventLog.record(&kurtosis_core_rpc_api_bindings.EnclaveEvent{
+		SequenceNumber:          0,
+		Type:                    kurtosis_core_rpc_api_bindings.EnclaveEvent_STARLARK_RUN_STARTED,
+		Timestamp:               nil,
+		ServiceName:             nil,
+		FilesArtifactName:       nil,
+		StarlarkPackageId:       &packageId,
+		IsStarlarkRunSuccessful: nil,
+		Message:                 "",
+	})
+}
+
+func (eventLog *enclaveEventLog) recordStarlarkRunFinished(packageId string, isSuccessful bool, errorMessage string) {
+	eventLog.record(&kurtosis_core_rpc_api_bindings.EnclaveEvent{
+		SequenceNumber:          0,
+		Type:                    kurtosis_core_rpc_api_bindings.EnclaveEvent_STARLARK_RUN_FINISHED,
+		Timestamp:               nil,
+		ServiceName:             nil,
+		FilesArtifactName:       nil,
+		StarlarkPackageId:       &packageId,
+		IsStarlarkRunSuccessful: &isSuccessful,
+		Message:                 truncateEnclaveEventMessage(errorMessage),
+	})
+}
+
+func (eventLog *enclaveEventLog) recordServiceEvent(eventType kurtosis_core_rpc_api_bindings.EnclaveEvent_Type, serviceName service.ServiceName, message string) {
+	serviceNameStr := string(serviceName)
+	eventLog.record(&kurtosis_core_rpc_api_bindings.EnclaveEvent{
+		SequenceNumber:          0,
+		Type:                    eventType,
+		Timestamp:               nil,
+		ServiceName:             &serviceNameStr,
+		FilesArtifactName:       nil,
+		StarlarkPackageId:       nil,
+		IsStarlarkRunSuccessful: nil,
+		Message:                 truncateEnclaveEventMessage(message),
+	})
+}
+
+func (eventLog *enclaveEventLog) recordFilesArtifactCreated(artifactName string) {
+	eventLog.record(&kurtosis_core_rpc_api_bindings.EnclaveEvent{
+		SequenceNumber:          0,
+		Type:                    kurtosis_core_rpc_api_bindings.EnclaveEvent_FILES_ARTIFACT_CREATED,
+		Timestamp:               nil,
+		ServiceName:             nil,
+		FilesArtifactName:       &artifactName,
+		StarlarkPackageId:       nil,
+		IsStarlarkRunSuccessful: nil,
+		Message:                 "",
+	})
+}
+
+// recordServiceEvents turns the changes of the health of the services into service events, until the context gets
+// cancelled. A service is running when it's healthy or unhealthy, and stopped on purpose when its health is unknown.
+func (eventLog *enclaveEventLog) recordServiceEvents(ctx context.Context, serviceNetwork service_network.ServiceNetwork) {
+	serviceHealthEventsChan, unsubscribe := serviceNetwork.SubscribeToServiceHealthEvents()
+	defer unsubscribe()
+
+	// By UUID rather than name, so that a service removed and added again with the same name is seen as a new one
+	lastHealthStatuses := map[service.ServiceUUID]service_health.ServiceHealthStatus{}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case serviceHealthEvent, isChanOpen := <-serviceHealthEventsChan:
+			if !isChanOpen {
+				return
+			}
+			serviceName := serviceHealthEvent.GetServiceName()
+			serviceObj, err := serviceNetwork.GetService(ctx, string(serviceName))
+			if err != nil {
+				logrus.Debugf("Service '%v' couldn't be found to record the change of its health, it was likely removed:\n%v", serviceName, err)
+				continue
+			}
+			serviceUuid := serviceObj.GetRegistration().GetUUID()
+			health := serviceHealthEvent.GetHealth()
+			previousHealthStatus, found := lastHealthStatuses[serviceUuid]
+			lastHealthStatuses[serviceUuid] = health.GetStatus()
+			if eventType, isEvent := getServiceEventType(previousHealthStatus, found, health.GetStatus()); isEvent {
+				eventLog.recordServiceEvent(eventType, serviceName, health.GetMessage())
+			}
+		}
+	}
+}
+
+func getServiceEventType(
+	previousHealthStatus service_health.ServiceHealthStatus,
+	isPreviousHealthStatusKnown bool,
+	healthStatus service_health.ServiceHealthStatus,
+) (kurtosis_core_rpc_api_bindings.EnclaveEvent_Type, bool) {
+	wasRunning := isPreviousHealthStatusKnown && isServiceRunning(previousHealthStatus)
+	wasCrashed := isPreviousHealthStatusKnown && previousHealthStatus == service_health.ServiceHealthStatus_Crashed
+	switch {
+	case healthStatus == service_health.ServiceHealthStatus_Crashed && !wasCrashed:
+		return kurtosis_core_rpc_api_bindings.EnclaveEvent_SERVICE_CRASHED, true
+	case isServiceRunning(healthStatus) && !wasRunning:
+		// Includes the services restarted by their restart policy after crashing
+		return kurtosis_core_rpc_api_bindings.EnclaveEvent_SERVICE_STARTED, true
+	case healthStatus == service_health.ServiceHealthStatus_Unknown && (wasRunning || wasCrashed):
+		return kurtosis_core_rpc_api_bindings.EnclaveEvent_SERVICE_STOPPED, true
+	}
+	return 0, false
+}
+
+func isServiceRunning(healthStatus service_health.ServiceHealthStatus) bool {
+	return healthStatus == service_health.ServiceHealthStatus_Healthy || healthStatus == service_health.ServiceHealthStatus_Unhealthy
+}
+
+func truncateEnclaveEventMessage(message string) string {
+	if len(message) <= maxEnclaveEventMessageLength {
+		return message
+	}
+	return message[:maxEnclaveEventMessageLength-len(truncatedEnclaveEventMessageSuffix)] + truncatedEnclaveEventMessageSuffix
+}
+
+func getStarlarkErrorMessage(starlarkError *kurtosis_core_rpc_api_bindings.StarlarkError) string {
+	switch {
+	case starlarkError.GetInterpretationError() != nil:
+		return starlarkError.GetInterpretationError().GetErrorMessage()
+	case starlarkError.GetValidationError() != nil:
+		return starlarkError.GetValidationError().GetErrorMessage()
+	case starlarkError.GetExecutionError() != nil:
+		return starlarkError.GetExecutionError().GetErrorMessage()
+	}
+	return ""
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnclaveEventLog_GetEventsAfter(t *testing.T) {
+	eventLog, err := newEnclaveEventLog()
+	require.NoError(t, err)
+
+	events, latestSequenceNumber := eventLog.getEventsAfter(0)
+	require.Empty(t, events)
+	require.Equal(t, uint64(0), latestSequenceNumber)
+
+	eventLog.recordStarlarkRunStarted("github.com/kurtosis-tech/test-package")
+	eventLog.recordFilesArtifactCreated("test-artifact")
+	eventLog.recordStarlarkRunFinished("github.com/kurtosis-tech/test-package", false, "An error occurred")
+
+	events, latestSequenceNumber = eventLog.getEventsAfter(1)
+	require.Equal(t, uint64(3), latestSequenceNumber)
+	require.Len(t, events, 2)
+	require.Equal(t, uint64(2), events[0].GetSequenceNumber())
+	require.Equal(t, "test-artifact", events[0].GetFilesArtifactName())
+	require.Equal(t, kurtosis_core_rpc_api_bindings.EnclaveEvent_STARLARK_RUN_FINISHED, events[1].GetType())
+	require.False(t, events[1].GetIsStarlarkRunSuccessful())
+	require.Equal(t, "An error occurred", events[1].GetMessage())
+
+	events, _ = eventLog.getEventsAfter(3)
+	require.Empty(t, events)
+}
+
+func TestEnclaveEventLog_DropsOldestEvents(t *testing.T) {
+	eventLog, err := newEnclaveEventLog()
+	require.NoError(t, err)
+
+	for i := 0; i < maxEnclaveEventsKept+10; i++ {
+		eventLog.recordFilesArtifactCreated("test-artifact")
+	}
+
+	events, latestSequenceNumber := eventLog.getEventsAfter(0)
+	require.Len(t, events, maxEnclaveEventsKept)
+	require.Equal(t, uint64(11), events[0].GetSequenceNumber())
+	require.Equal(t, uint64(maxEnclaveEventsKept+10), latestSequenceNumber)
+}
+
+func TestEnclaveEventLog_TruncatesLongMessages(t *testing.T) {
+	eventLog, err := newEnclaveEventLog()
+	require.NoError(t, err)
+
+	eventLog.recordStarlarkRunFinished("", false, strings.Repeat("a", maxEnclaveEventMessageLength+1))
+
+	events, _ := eventLog.getEventsAfter(0)
+	require.Len(t, events[0].GetMessage(), maxEnclaveEventMessageLength)
+	require.True(t, strings.HasSuffix(events[0].GetMessage(), truncatedEnclaveEventMessageSuffix))
+}
+
+func TestGetServiceEventType(t *testing.T) {
+	eventType, isEvent := getServiceEventType(0, false, service_health.ServiceHealthStatus_Healthy)
+	require.True(t, isEvent)
+	require.Equal(t, kurtosis_core_rpc_api_bindings.EnclaveEvent_SERVICE_STARTED, eventType)
+
+	// A service registered but not started yet
+	_, isEvent = getServiceEventType(0, false, service_health.ServiceHealthStatus_Unknown)
+	require.False(t, isEvent)
+
+	// A failing health check doesn't start the service again
+	_, isEvent = getServiceEventType(service_health.ServiceHealthStatus_Healthy, true, service_health.ServiceHealthStatus_Unhealthy)
+	require.False(t, isEvent)
+
+	eventType, isEvent = getServiceEventType(service_health.ServiceHealthStatus_Unhealthy, true, service_health.ServiceHealthStatus_Crashed)
+	require.True(t, isEvent)
+	require.Equal(t, kurtosis_core_rpc_api_bindings.EnclaveEvent_SERVICE_CRASHED, eventType)
+
+	// Restarted by its restart policy
+	eventType, isEvent = getServiceEventType(service_health.ServiceHealthStatus_Crashed, true, service_health.ServiceHealthStatus_Healthy)
+	require.True(t, isEvent)
+	require.Equal(t, kurtosis_core_rpc_api_bindings.EnclaveEvent_SERVICE_STARTED, eventType)
+
+	eventType, isEvent = getServiceEventType(service_health.ServiceHealthStatus_Healthy, true, service_health.ServiceHealthStatus_Unknown)
+	require.True(t, isEvent)
+	require.Equal(t, kurtosis_core_rpc_api_bindings.EnclaveEvent_SERVICE_STOPPED, eventType)
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	prometheusNamespace = "kurtosis"
+
+	prometheusStatusLabel = "status"
+	prometheusHealthLabel = "health"
+
+	getServicesForPrometheusTimeout = 10 * time.Second
+)
+
+var (
+	servicesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(prometheusNamespace, "", "services"),
+		"Number of services of the enclave, by status",
+		[]string{prometheusStatusLabel},
+		nil,
+	)
+	servicesByHealthDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(prometheusNamespace, "", "services_by_health"),
+		"Number of services of the enclave, by health",
+		[]string{prometheusHealthLabel},
+		nil,
+	)
+	filesArtifactsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(prometheusNamespace, "", "files_artifacts"),
+		"Number of files artifacts stored in the enclave",
+		nil,
+		nil,
+	)
+	filesArtifactsSizeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(prometheusNamespace, "", "files_artifacts_size_bytes"),
+		"Disk space taken by the files artifacts stored in the enclave",
+		nil,
+		nil,
+	)
+)
+
+// apiContainerPrometheusCollector reports the state of the enclave when Prometheus scrapes the API container, so the
+// numbers are never stale
+type apiContainerPrometheusCollector struct {
+	apicService *ApiContainerService
+}
+
+// NewPrometheusCollector creates the Prometheus collector of the services and files artifacts of the enclave
+func (apicService *ApiContainerService) NewPrometheusCollector() prometheus.Collector {
+	return &apiContainerPrometheusCollector{apicService: apicService}
+}
+
+func (collector *apiContainerPrometheusCollector) Describe(descs chan<- *prometheus.Desc) {
+	descs <- servicesDesc
+	descs <- servicesByHealthDesc
+	descs <- filesArtifactsDesc
+	descs <- filesArtifactsSizeDesc
+}
+
+func (collector *apiContainerPrometheusCollector) Collect(metrics chan<- prometheus.Metric) {
+	collector.collectServices(metrics)
+
+	filesArtifactStore := collector.apicService.filesArtifactStore
+	metrics <- prometheus.MustNewConstMetric(filesArtifactsDesc, prometheus.GaugeValue, float64(len(filesArtifactStore.ListFiles())))
+	filesArtifactsSizeBytes, err := filesArtifactStore.GetTotalSizeBytes()
+	if err != nil {
+		logrus.Warnf("An error occurred getting the size of the files artifacts; it's left out of the Prometheus metrics. Error:\n%v", err)
+	} else {
+		metrics <- prometheus.MustNewConstMetric(filesArtifactsSizeDesc, prometheus.GaugeValue, float64(filesArtifactsSizeBytes))
+	}
+}
+
+func (collector *apiContainerPrometheusCollector) collectServices(metrics chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), getServicesForPrometheusTimeout)
+	defer cancel()
+	serviceNetwork := collector.apicService.serviceNetwork
+	services, err := serviceNetwork.GetServices(ctx)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the services; they're left out of the Prometheus metrics. Error:\n%v", err)
+		return
+	}
+
+	// Every status and health gets reported, even with no service, so that they don't disappear from the graphs
+	numServicesByStatus := map[string]int{}
+	for _, status := range kurtosis_core_rpc_api_bindings.ServiceStatus_name {
+		numServicesByStatus[status] = 0
+	}
+	numServicesByHealth := map[string]int{}
+	for _, healthStatus := range service_health.ServiceHealthStatusValues() {
+		numServicesByHealth[strings.ToUpper(healthStatus.String())] = 0
+	}
+	for _, serviceObj := range services {
+		// The services which are only registered have no status in the API, which reports them as unknown
+		status, _ := convertServiceStatusToServiceInfoStatus(serviceObj.GetRegistration().GetStatus())
+		numServicesByStatus[status.String()]++
+		numServicesByHealth[strings.ToUpper(serviceNetwork.GetServiceHealth(serviceObj).GetStatus().String())]++
+	}
+
+	for status, numServices := range numServicesByStatus {
+		metrics <- prometheus.MustNewConstMetric(servicesDesc, prometheus.GaugeValue, float64(numServices), status)
+	}
+	for healthStatus, numServices := range numServicesByHealth {
+		metrics <- prometheus.MustNewConstMetric(servicesByHealthDesc, prometheus.GaugeValue, float64(numServices), healthStatus)
+	}
+}
@@ -0,0 +1,138 @@
+package secret_store
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	secretNameSubgroupName = "name"
+
+	// Secrets are referenced through placeholders in the service configs, so that only the placeholders end up in the
+	// enclave plan, the printed instructions and the service registrations; they get replaced when the service starts
+	secretPlaceholderFormat = "{{kurtosis:secret:%v}}"
+	secretPlaceholderRegex  = "\\{\\{kurtosis:secret:(?P<" + secretNameSubgroupName + ">[a-zA-Z0-9][a-zA-Z0-9_.-]*)\\}\\}"
+
+	unlimitedMatches = -1
+)
+
+var compiledSecretPlaceholderRegex = regexp.MustCompile(secretPlaceholderRegex)
+
+func GetSecretPlaceholder(name string) string {
+	return fmt.Sprintf(secretPlaceholderFormat, name)
+}
+
+// IsSecretPlaceholder returns true if the string is exactly one secret placeholder
+func IsSecretPlaceholder(str string) bool {
+	match := compiledSecretPlaceholderRegex.FindStringIndex(str)
+	return match != nil && match[0] == 0 && match[1] == len(str)
+}
+
+// GetSecretNamesReferencedByServiceConfig returns the sorted names of the secrets used by the env vars and the secret
+// files of the service
+func GetSecretNamesReferencedByServiceConfig(serviceConfig *service.ServiceConfig) []string {
+	secretNamesSet := map[string]bool{}
+	for _, envVarValue := range serviceConfig.GetEnvVars() {
+		for _, secretName := range getReferencedSecretNames(envVarValue) {
+			secretNamesSet[secretName] = true
+		}
+	}
+	for _, secretFileContent := range serviceConfig.GetSecretFiles() {
+		for _, secretName := range getReferencedSecretNames(secretFileContent) {
+			secretNamesSet[secretName] = true
+		}
+	}
+	secretNames := []string{}
+	for secretName := range secretNamesSet {
+		secretNames = append(secretNames, secretName)
+	}
+	sort.Strings(secretNames)
+	return secretNames
+}
+
+// ResolveSecrets returns a copy of the service config where the secret placeholders got replaced with the secret
+// values, flagging the env vars holding secrets so the container engine keeps them out of the service info and dumps
+// The service config itself is left untouched, so that it can be persisted safely
+// If the service uses no secret, the service config itself is returned
+func (store *SecretStore) ResolveSecrets(serviceConfig *service.ServiceConfig) (*service.ServiceConfig, error) {
+	if len(GetSecretNamesReferencedByServiceConfig(serviceConfig)) == 0 {
+		return serviceConfig, nil
+	}
+
+	serviceConfigBytes, err := json.Marshal(serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred marshalling the service config to copy it")
+	}
+	// nolint: exhaustruct
+	resolvedServiceConfig := &service.ServiceConfig{}
+	if err := json.Unmarshal(serviceConfigBytes, resolvedServiceConfig); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred unmarshalling the copy of the service config")
+	}
+
+	secretEnvVarNames := []string{}
+	resolvedEnvVars := resolvedServiceConfig.GetEnvVars()
+	for envVarName, envVarValue := range resolvedEnvVars {
+		if len(getReferencedSecretNames(envVarValue)) == 0 {
+			continue
+		}
+		resolvedValue, err := store.replaceSecretPlaceholders(envVarValue)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred replacing the secrets of env var '%v'", envVarName)
+		}
+		resolvedEnvVars[envVarName] = resolvedValue
+		secretEnvVarNames = append(secretEnvVarNames, envVarName)
+	}
+	sort.Strings(secretEnvVarNames)
+	resolvedServiceConfig.SetSecretEnvVarNames(secretEnvVarNames)
+
+	resolvedSecretFiles := map[string]string{}
+	for filepath, content := range resolvedServiceConfig.GetSecretFiles() {
+		resolvedContent, err := store.replaceSecretPlaceholders(content)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred replacing the secrets of file '%v'", filepath)
+		}
+		resolvedSecretFiles[filepath] = resolvedContent
+	}
+	resolvedServiceConfig.SetSecretFiles(resolvedSecretFiles)
+
+	return resolvedServiceConfig, nil
+}
+
+func (store *SecretStore) replaceSecretPlaceholders(str string) (string, error) {
+	var replacementErr error
+	result := compiledSecretPlaceholderRegex.ReplaceAllStringFunc(str, func(placeholder string) string {
+		if replacementErr != nil {
+			return placeholder
+		}
+		secretName := getSecretNameFromPlaceholder(placeholder)
+		value, found, err := store.GetSecret(secretName)
+		if err != nil {
+			replacementErr = stacktrace.Propagate(err, "An error occurred getting secret '%v'", secretName)
+		} else if !found {
+			replacementErr = stacktrace.NewError("Secret '%v' doesn't exist; set it with 'kurtosis secret set'", secretName)
+		}
+		return value
+	})
+	if replacementErr != nil {
+		return "", replacementErr
+	}
+	return result, nil
+}
+
+func getReferencedSecretNames(str string) []string {
+	secretNames := []string{}
+	for _, placeholder := range compiledSecretPlaceholderRegex.FindAllString(str, unlimitedMatches) {
+		secretNames = append(secretNames, getSecretNameFromPlaceholder(placeholder))
+	}
+	return secretNames
+}
+
+func getSecretNameFromPlaceholder(placeholder string) string {
+	match := compiledSecretPlaceholderRegex.FindStringSubmatch(placeholder)
+	return match[compiledSecretPlaceholderRegex.SubexpIndex(secretNameSubgroupName)]
+}
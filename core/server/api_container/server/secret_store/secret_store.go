@@ -0,0 +1,190 @@
+package secret_store
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// AES-256
+	encryptionKeyNumBytes  = 32
+	encryptionKeyFilePerms = 0600
+
+	maxSecretNameLength = 253
+	secretNameRegexStr  = "^[a-zA-Z0-9][a-zA-Z0-9_.-]*$"
+)
+
+var (
+	secretStoreBucketName = []byte("secret-store")
+
+	secretNameRegex = regexp.MustCompile(secretNameRegexStr)
+)
+
+// SecretStore holds the secrets of the enclave, which services get through the Secret placeholders of their config
+// The secret values are encrypted before landing in the enclave database, with a key kept in a separate file of the
+// enclave data directory, so they never get persisted in plaintext next to the enclave plan
+type SecretStore struct {
+	enclaveDb *enclave_db.EnclaveDB
+	cipher    cipher.AEAD
+}
+
+// GetOrCreateSecretStore loads the encryption key from the given file, generating it the first time the enclave starts
+func GetOrCreateSecretStore(enclaveDb *enclave_db.EnclaveDB, encryptionKeyFilepath string) (*SecretStore, error) {
+	encryptionKey, err := getOrCreateEncryptionKey(encryptionKeyFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the encryption key of the secret store from '%v'", encryptionKeyFilepath)
+	}
+	blockCipher, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the block cipher of the secret store")
+	}
+	aeadCipher, err := cipher.NewGCM(blockCipher)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the GCM cipher of the secret store")
+	}
+
+	if err := enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(secretStoreBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the secret store database bucket")
+		}
+		logrus.Debugf("Secret store bucket: '%+v'", bucket)
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building the secret store")
+	}
+
+	return &SecretStore{
+		enclaveDb: enclaveDb,
+		cipher:    aeadCipher,
+	}, nil
+}
+
+// SetSecret creates the secret, or replaces its value if it already exists
+func (store *SecretStore) SetSecret(name string, value string) error {
+	if err := ValidateSecretName(name); err != nil {
+		return stacktrace.Propagate(err, "Invalid secret name '%v'", name)
+	}
+
+	// The nonce is stored in front of the encrypted value, as it's needed to decrypt it
+	nonce := make([]byte, store.cipher.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return stacktrace.Propagate(err, "An error occurred generating the nonce to encrypt secret '%v'", name)
+	}
+	encryptedValue := store.cipher.Seal(nonce, nonce, []byte(value), []byte(name))
+
+	if err := store.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(secretStoreBucketName)
+		if err := bucket.Put([]byte(name), encryptedValue); err != nil {
+			return stacktrace.Propagate(err, "An error occurred saving secret '%v' into the enclave db bucket", name)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving secret '%v' into the enclave db", name)
+	}
+	return nil
+}
+
+// GetSecret returns the plaintext value of the secret, and false if the secret doesn't exist
+func (store *SecretStore) GetSecret(name string) (string, bool, error) {
+	var encryptedValue []byte
+	if err := store.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(secretStoreBucketName)
+		// The slice returned by bolt is only valid during the transaction
+		if value := bucket.Get([]byte(name)); value != nil {
+			encryptedValue = append([]byte{}, value...)
+		}
+		return nil
+	}); err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred getting secret '%v' from the enclave db", name)
+	}
+	if encryptedValue == nil {
+		return "", false, nil
+	}
+
+	nonceSize := store.cipher.NonceSize()
+	if len(encryptedValue) < nonceSize {
+		return "", false, stacktrace.NewError("The encrypted value of secret '%v' is shorter than its nonce; this is a bug in Kurtosis", name)
+	}
+	value, err := store.cipher.Open(nil, encryptedValue[:nonceSize], encryptedValue[nonceSize:], []byte(name))
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred decrypting secret '%v'", name)
+	}
+	return string(value), true, nil
+}
+
+// GetSecretNames returns the sorted names of all the secrets, never their values
+func (store *SecretStore) GetSecretNames() ([]string, error) {
+	secretNames := []string{}
+	if err := store.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(secretStoreBucketName)
+		return bucket.ForEach(func(name, _ []byte) error {
+			secretNames = append(secretNames, string(name))
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the secret names from the enclave db")
+	}
+	sort.Strings(secretNames)
+	return secretNames, nil
+}
+
+// RemoveSecret removes the secret; the services using it keep the value they were started with
+func (store *SecretStore) RemoveSecret(name string) error {
+	if err := store.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(secretStoreBucketName)
+		if bucket.Get([]byte(name)) == nil {
+			return stacktrace.NewError("Secret '%v' doesn't exist", name)
+		}
+		if err := bucket.Delete([]byte(name)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred deleting secret '%v' from the enclave db bucket", name)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing secret '%v' from the enclave db", name)
+	}
+	return nil
+}
+
+func ValidateSecretName(name string) error {
+	if len(name) > maxSecretNameLength {
+		return stacktrace.NewError("Secret name '%v' is longer than %d characters", name, maxSecretNameLength)
+	}
+	if !secretNameRegex.MatchString(name) {
+		return stacktrace.NewError("Secret name '%v' doesn't match regex '%v'", name, secretNameRegexStr)
+	}
+	return nil
+}
+
+func getOrCreateEncryptionKey(encryptionKeyFilepath string) ([]byte, error) {
+	encryptionKey, err := os.ReadFile(encryptionKeyFilepath)
+	if err == nil {
+		if len(encryptionKey) != encryptionKeyNumBytes {
+			return nil, stacktrace.NewError("Expected the encryption key to be %d bytes but it was %d bytes", encryptionKeyNumBytes, len(encryptionKey))
+		}
+		return encryptionKey, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the encryption key file")
+	}
+
+	encryptionKey = make([]byte, encryptionKeyNumBytes)
+	if _, err := io.ReadFull(rand.Reader, encryptionKey); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the encryption key")
+	}
+	if err := os.WriteFile(encryptionKeyFilepath, encryptionKey, encryptionKeyFilePerms); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred writing the encryption key file")
+	}
+	return encryptionKey, nil
+}
@@ -0,0 +1,134 @@
+package secret_store
+
+import (
+	"path"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	testSecretName  = "db-password"
+	testSecretValue = "hunter2"
+
+	encryptionKeyFilename = "secret-store.key"
+)
+
+func TestSecretStore_SetGetListRemove(t *testing.T) {
+	store, enclaveDb := getSecretStoreForTest(t)
+
+	require.NoError(t, store.SetSecret(testSecretName, "old-value"))
+	require.NoError(t, store.SetSecret(testSecretName, testSecretValue))
+	require.NoError(t, store.SetSecret("api-token", "abcdef"))
+
+	value, found, err := store.GetSecret(testSecretName)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, testSecretValue, value)
+
+	secretNames, err := store.GetSecretNames()
+	require.NoError(t, err)
+	require.Equal(t, []string{"api-token", testSecretName}, secretNames)
+
+	// The value is never persisted in plaintext
+	require.NoError(t, enclaveDb.View(func(tx *bolt.Tx) error {
+		persistedValue := tx.Bucket(secretStoreBucketName).Get([]byte(testSecretName))
+		require.NotContains(t, string(persistedValue), testSecretValue)
+		return nil
+	}))
+
+	require.NoError(t, store.RemoveSecret(testSecretName))
+	_, found, err = store.GetSecret(testSecretName)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Error(t, store.RemoveSecret(testSecretName))
+}
+
+func TestSecretStore_KeyIsReusedAcrossRestarts(t *testing.T) {
+	store, enclaveDb := getSecretStoreForTest(t)
+	require.NoError(t, store.SetSecret(testSecretName, testSecretValue))
+
+	restartedStore, err := GetOrCreateSecretStore(enclaveDb, path.Join(path.Dir(enclaveDb.Path()), encryptionKeyFilename))
+	require.NoError(t, err)
+	value, found, err := restartedStore.GetSecret(testSecretName)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, testSecretValue, value)
+}
+
+func TestSecretStore_InvalidName(t *testing.T) {
+	store, _ := getSecretStoreForTest(t)
+	require.Error(t, store.SetSecret("-starts-with-dash", testSecretValue))
+	require.Error(t, store.SetSecret("has spaces", testSecretValue))
+}
+
+func TestResolveSecrets(t *testing.T) {
+	store, _ := getSecretStoreForTest(t)
+	require.NoError(t, store.SetSecret(testSecretName, testSecretValue))
+
+	envVars := map[string]string{
+		"DB_URL":    "postgres://admin:" + GetSecretPlaceholder(testSecretName) + "@db:5432",
+		"LOG_LEVEL": "debug",
+	}
+	serviceConfig := getServiceConfigForTest(t, envVars)
+	serviceConfig.SetSecretFiles(map[string]string{"/run/secrets/db-password": GetSecretPlaceholder(testSecretName)})
+	require.Equal(t, []string{testSecretName}, GetSecretNamesReferencedByServiceConfig(serviceConfig))
+
+	resolvedServiceConfig, err := store.ResolveSecrets(serviceConfig)
+	require.NoError(t, err)
+	require.Equal(t, "postgres://admin:hunter2@db:5432", resolvedServiceConfig.GetEnvVars()["DB_URL"])
+	require.Equal(t, "debug", resolvedServiceConfig.GetEnvVars()["LOG_LEVEL"])
+	require.Equal(t, []string{"DB_URL"}, resolvedServiceConfig.GetSecretEnvVarNames())
+	require.Equal(t, map[string]string{"/run/secrets/db-password": testSecretValue}, resolvedServiceConfig.GetSecretFiles())
+
+	// The original config keeps the placeholders, as it's the one getting persisted
+	require.Equal(t, envVars["DB_URL"], serviceConfig.GetEnvVars()["DB_URL"])
+	require.Empty(t, serviceConfig.GetSecretEnvVarNames())
+}
+
+func TestResolveSecrets_NoSecrets(t *testing.T) {
+	store, _ := getSecretStoreForTest(t)
+	serviceConfig := getServiceConfigForTest(t, map[string]string{"LOG_LEVEL": "debug"})
+
+	resolvedServiceConfig, err := store.ResolveSecrets(serviceConfig)
+	require.NoError(t, err)
+	require.Same(t, serviceConfig, resolvedServiceConfig)
+}
+
+func TestResolveSecrets_MissingSecret(t *testing.T) {
+	store, _ := getSecretStoreForTest(t)
+	serviceConfig := getServiceConfigForTest(t, map[string]string{"DB_PASSWORD": GetSecretPlaceholder(testSecretName)})
+
+	_, err := store.ResolveSecrets(serviceConfig)
+	require.Error(t, err)
+}
+
+func TestIsSecretPlaceholder(t *testing.T) {
+	require.True(t, IsSecretPlaceholder(GetSecretPlaceholder(testSecretName)))
+	require.False(t, IsSecretPlaceholder("prefix-"+GetSecretPlaceholder(testSecretName)))
+	require.False(t, IsSecretPlaceholder("files-artifact"))
+}
+
+func getSecretStoreForTest(t *testing.T) (*SecretStore, *enclave_db.EnclaveDB) {
+	tmpDirpath := t.TempDir()
+	db, err := bolt.Open(path.Join(tmpDirpath, "enclave.db"), 0666, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	store, err := GetOrCreateSecretStore(enclaveDb, path.Join(tmpDirpath, encryptionKeyFilename))
+	require.NoError(t, err)
+	return store, enclaveDb
+}
+
+func getServiceConfigForTest(t *testing.T, envVars map[string]string) *service.ServiceConfig {
+	serviceConfig, err := service.CreateServiceConfig("postgres:16", nil, nil, nil, nil, nil, nil, nil, envVars, nil, nil, 0, 0, "", 0, 0, nil, nil, nil, nil, image_download_mode.ImageDownloadMode_Missing)
+	require.NoError(t, err)
+	return serviceConfig
+}
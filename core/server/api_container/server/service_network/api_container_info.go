@@ -1,6 +1,12 @@
 package service_network
 
-import "net"
+import (
+	"net"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_access"
+	"github.com/kurtosis-tech/stacktrace"
+)
 
 type ApiContainerInfo struct {
 	ipAddress net.IP
@@ -8,17 +14,22 @@ type ApiContainerInfo struct {
 	grpcPortNum uint16
 
 	version string
+
+	// Nil if the API container doesn't check access tokens
+	accessTokenSigner *api_container_access.TokenSigner
 }
 
 func NewApiContainerInfo(
 	ipAddress net.IP,
 	grpcPortNum uint16,
 	version string,
+	accessTokenSigner *api_container_access.TokenSigner,
 ) *ApiContainerInfo {
 	return &ApiContainerInfo{
-		ipAddress:   ipAddress,
-		grpcPortNum: grpcPortNum,
-		version:     version,
+		ipAddress:         ipAddress,
+		grpcPortNum:       grpcPortNum,
+		version:           version,
+		accessTokenSigner: accessTokenSigner,
 	}
 }
 
@@ -33,3 +44,16 @@ func (apic *ApiContainerInfo) GetGrpcPortNum() uint16 {
 func (apic *ApiContainerInfo) GetVersion() string {
 	return apic.version
 }
+
+// IssueAccessToken returns a token for the containers of the enclave calling the API container, e.g. the files
+// artifacts expander, or empty if the API container doesn't check access tokens
+func (apic *ApiContainerInfo) IssueAccessToken(subject string, accessLevel api_container_access.AccessLevel, validity time.Duration) (string, error) {
+	if apic.accessTokenSigner == nil {
+		return "", nil
+	}
+	token, err := apic.accessTokenSigner.IssueToken(subject, accessLevel, validity)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred issuing an access token for '%v'", subject)
+	}
+	return token, nil
+}
@@ -6,6 +6,7 @@
 package service_network
 
 import (
+	"archive/tar"
 	"bytes"
 	"compress/gzip"
 	"context"
@@ -13,7 +14,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_dns"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
 	"github.com/kurtosis-tech/kurtosis/path-compression"
 	"io"
@@ -27,10 +31,14 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_restart_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/tracing"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
@@ -41,6 +49,7 @@ const (
 	defaultMemoryAllocMegabytes uint64 = 0
 
 	tempDirForRenderedTemplatesPrefix = "temp-dir-for-rendered-templates-"
+	filesArtifactRootDirpath          = "/"
 
 	enforceMaxFileSizeLimit = false
 
@@ -82,6 +91,17 @@ type DefaultServiceNetwork struct {
 
 	// This contains all service identifiers ever successfully created
 	serviceIdentifiersRepository *service_identifiers.ServiceIdentifiersRepository
+
+	healthMonitor *service_health.ServiceHealthMonitor
+
+	// Resolves the secrets used by the services right before they start, as the persisted configs only hold placeholders
+	secretStore *secret_store.SecretStore
+
+	// The records of the enclave DNS server of the API container; nil if the enclave has no DNS server
+	dnsRecordStore *enclave_dns.DnsRecordStore
+
+	// The named host port ranges set on the engine, by name
+	hostPortRanges map[string]*port_publish_spec.HostPortRange
 }
 
 func NewDefaultServiceNetwork(
@@ -90,6 +110,9 @@ func NewDefaultServiceNetwork(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
 	enclaveDb *enclave_db.EnclaveDB,
+	secretStore *secret_store.SecretStore,
+	dnsRecordStore *enclave_dns.DnsRecordStore,
+	hostPortRanges map[string]*port_publish_spec.HostPortRange,
 ) (*DefaultServiceNetwork, error) {
 	serviceIdentifiersRepository, err := service_identifiers.GetOrCreateNewServiceIdentifiersRepository(enclaveDb)
 	if err != nil {
@@ -100,7 +123,7 @@ func NewDefaultServiceNetwork(
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the service registration repository")
 	}
 
-	return &DefaultServiceNetwork{
+	network := &DefaultServiceNetwork{
 		enclaveUuid:      enclaveUuid,
 		apiContainerInfo: apiContainerInfo,
 		mutex:            &sync.Mutex{},
@@ -110,7 +133,20 @@ func NewDefaultServiceNetwork(
 
 		serviceRegistrationRepository: serviceRegistrationRepository,
 		serviceIdentifiersRepository:  serviceIdentifiersRepository,
-	}, nil
+
+		healthMonitor: nil,
+
+		secretStore:    secretStore,
+		dnsRecordStore: dnsRecordStore,
+		hostPortRanges: hostPortRanges,
+	}
+	network.healthMonitor = service_health.NewServiceHealthMonitor(network.GetServices, network.stopServiceIfPastMaxRetries)
+	return network, nil
+}
+
+// MonitorServicesHealth keeps track of the health of the services until the context is cancelled
+func (network *DefaultServiceNetwork) MonitorServicesHealth(ctx context.Context) {
+	network.healthMonitor.Run(ctx)
 }
 
 // AddService creates and starts the service in their own container
@@ -421,6 +457,7 @@ func (network *DefaultServiceNetwork) RemoveService(
 	if err := network.serviceRegistrationRepository.Delete(serviceName); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred deleting the service registration for service '%v' from the repository", serviceName)
 	}
+	network.healthMonitor.RemoveService(serviceName)
 
 	return serviceUuid, nil
 }
@@ -467,7 +504,12 @@ func (network *DefaultServiceNetwork) StartServices(
 	}
 
 	for serviceUuid, serviceRegistration := range serviceRegistrations {
-		serviceConfigs[serviceUuid] = serviceRegistration.GetConfig()
+		resolvedServiceConfig, err := network.getServiceConfigToStart(serviceRegistration.GetConfig())
+		if err != nil {
+			erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred getting the config to start service '%v' with", serviceRegistration.GetName())
+			continue
+		}
+		serviceConfigs[serviceUuid] = resolvedServiceConfig
 	}
 
 	successfulServices, failedServices, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, serviceConfigs)
@@ -692,6 +734,67 @@ func (network *DefaultServiceNetwork) GetServices(ctx context.Context) (map[serv
 	return filteredServicesToRegisteredServices, nil
 }
 
+func (network *DefaultServiceNetwork) GetServicesStats(
+	ctx context.Context,
+	serviceIdentifiers map[string]bool,
+) (
+	map[service.ServiceName]*service.ServiceStats,
+	map[service.ServiceName]error,
+	error,
+) {
+	serviceNamesByUuid, err := network.getServiceNamesByUuidForIdentifiers(serviceIdentifiers)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the services to sample the resource usage of")
+	}
+	if len(serviceNamesByUuid) == 0 {
+		return map[service.ServiceName]*service.ServiceStats{}, map[service.ServiceName]error{}, nil
+	}
+
+	serviceUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid := range serviceNamesByUuid {
+		serviceUuids[serviceUuid] = true
+	}
+	filters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    serviceUuids,
+		Statuses: nil,
+	}
+	// Sampling can take a while so, unlike most calls to the backend, it isn't done while holding the network mutex
+	successfulServiceStats, erroredServiceUuids, err := network.kurtosisBackend.GetUserServiceStats(ctx, network.enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the stats of services '%v'", serviceUuids)
+	}
+
+	serviceStatsByName := map[service.ServiceName]*service.ServiceStats{}
+	for serviceUuid, serviceStats := range successfulServiceStats {
+		serviceStatsByName[serviceNamesByUuid[serviceUuid]] = serviceStats
+	}
+	serviceErrorsByName := map[service.ServiceName]error{}
+	for serviceUuid, serviceErr := range erroredServiceUuids {
+		serviceErrorsByName[serviceNamesByUuid[serviceUuid]] = serviceErr
+	}
+	for serviceUuid, serviceName := range serviceNamesByUuid {
+		_, isSuccessful := successfulServiceStats[serviceUuid]
+		_, isErrored := erroredServiceUuids[serviceUuid]
+		if !isSuccessful && !isErrored {
+			serviceErrorsByName[serviceName] = stacktrace.NewError("Service '%v' is registered but wasn't started", serviceName)
+		}
+	}
+	return serviceStatsByName, serviceErrorsByName, nil
+}
+
+func (network *DefaultServiceNetwork) SetServiceHealthCheck(serviceName service.ServiceName, healthCheck *service_health.HealthCheck) {
+	network.healthMonitor.SetHealthCheck(serviceName, healthCheck)
+}
+
+func (network *DefaultServiceNetwork) GetServiceHealth(serviceObj *service.Service) *service_health.ServiceHealth {
+	return network.healthMonitor.GetServiceHealth(serviceObj)
+}
+
+func (network *DefaultServiceNetwork) SubscribeToServiceHealthEvents() (<-chan *service_health.ServiceHealthEvent, func()) {
+	return network.healthMonitor.Subscribe()
+}
+
 func (network *DefaultServiceNetwork) GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
@@ -744,6 +847,126 @@ func (network *DefaultServiceNetwork) GetServiceNames() (map[service.ServiceName
 	return serviceNames, nil
 }
 
+// GetServicesPersistentDirectories returns the persistent directories mounted by each registered service, skipping the
+// services that don't mount any
+func (network *DefaultServiceNetwork) GetServicesPersistentDirectories() (map[service.ServiceName]*service_directory.PersistentDirectories, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	registeredServices, err := network.serviceRegistrationRepository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting registered services from the repository")
+	}
+
+	persistentDirectoriesByServiceName := map[service.ServiceName]*service_directory.PersistentDirectories{}
+	for serviceName, registration := range registeredServices {
+		serviceConfig := registration.GetConfig()
+		if serviceConfig == nil || serviceConfig.GetPersistentDirectories() == nil {
+			continue
+		}
+		if len(serviceConfig.GetPersistentDirectories().ServiceDirpathToPersistentDirectory) == 0 {
+			continue
+		}
+		persistentDirectoriesByServiceName[serviceName] = serviceConfig.GetPersistentDirectories()
+	}
+	return persistentDirectoriesByServiceName, nil
+}
+
+func (network *DefaultServiceNetwork) GetServiceConfigs() (map[service.ServiceName]*service.ServiceConfig, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	registeredServices, err := network.serviceRegistrationRepository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting registered services from the repository")
+	}
+
+	serviceConfigsByServiceName := map[service.ServiceName]*service.ServiceConfig{}
+	for serviceName, registration := range registeredServices {
+		// Services that got registered but never started have no config yet
+		if registration.GetConfig() == nil {
+			continue
+		}
+		serviceConfigsByServiceName[serviceName] = registration.GetConfig()
+	}
+	return serviceConfigsByServiceName, nil
+}
+
+func (network *DefaultServiceNetwork) GetServiceReplicaNames(replicaGroup service.ServiceName) ([]service.ServiceName, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	replicaNames, _, err := network.getServiceReplicasUnlocked(replicaGroup)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the replicas of service '%s'", replicaGroup)
+	}
+	return replicaNames, nil
+}
+
+func (network *DefaultServiceNetwork) ScaleService(ctx context.Context, replicaGroup service.ServiceName, replicas int) (map[service.ServiceName]*service.Service, error) {
+	if replicas < 1 {
+		return nil, stacktrace.NewError("Service '%s' can't be scaled to %d replicas; it needs at least one", replicaGroup, replicas)
+	}
+
+	network.mutex.Lock()
+	replicaNames, replicaConfig, err := network.getServiceReplicasUnlocked(replicaGroup)
+	network.mutex.Unlock()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the replicas of service '%s'", replicaGroup)
+	}
+	if len(replicaNames) == 0 {
+		return nil, stacktrace.NewError("Service '%s' has no replicas; only services added with replicas can be scaled", replicaGroup)
+	}
+	healthCheck := network.healthMonitor.GetHealthCheck(replicaNames[0])
+
+	existingReplicaNames := map[service.ServiceName]bool{}
+	for _, replicaName := range replicaNames {
+		replicaIndex, _ := GetReplicaIndex(replicaGroup, replicaName)
+		if replicaIndex < replicas {
+			existingReplicaNames[replicaName] = true
+			continue
+		}
+		if _, err := network.RemoveService(ctx, string(replicaName)); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred removing replica '%s' of service '%s'", replicaName, replicaGroup)
+		}
+	}
+
+	replicaConfigsToAdd := map[service.ServiceName]*service.ServiceConfig{}
+	for replicaIndex := 0; replicaIndex < replicas; replicaIndex++ {
+		replicaName := GetReplicaName(replicaGroup, replicaIndex)
+		if existingReplicaNames[replicaName] {
+			continue
+		}
+		// Each replica gets its own copy, as starting a service can modify its config
+		replicaConfigCopy, err := replicaConfig.Copy()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred copying the config of service '%s' for replica '%s'", replicaGroup, replicaName)
+		}
+		replicaConfigsToAdd[replicaName] = replicaConfigCopy
+	}
+	addedReplicas, failedReplicas, err := network.AddServices(ctx, replicaConfigsToAdd, len(replicaConfigsToAdd))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding replicas to service '%s'", replicaGroup)
+	}
+	if len(failedReplicas) > 0 {
+		return nil, stacktrace.NewError("Some replicas of service '%s' failed to start, so none were added. Errors were:\n%v", replicaGroup, failedReplicas)
+	}
+	for replicaName := range addedReplicas {
+		network.healthMonitor.SetHealthCheck(replicaName, healthCheck)
+	}
+	return addedReplicas, nil
+}
+
+func (network *DefaultServiceNetwork) AddDnsRecord(record *enclave_dns.DnsRecord) error {
+	if network.dnsRecordStore == nil {
+		return stacktrace.NewError("The enclave has no DNS server to add record '%v' to", record)
+	}
+	if err := network.dnsRecordStore.AddDnsRecord(record); err != nil {
+		return stacktrace.Propagate(err, "An error occurred adding DNS record '%v' to the enclave DNS server", record)
+	}
+	return nil
+}
+
 func (network *DefaultServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
 	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
 	if err != nil {
@@ -944,6 +1167,27 @@ func (network *DefaultServiceNetwork) unregisterService(ctx context.Context, ser
 	return nil
 }
 
+// stopServiceIfPastMaxRetries stops the service if its container was restarted as many times as the max retries of
+// its restart policy. Docker stops restarting the container by itself, but Kubernetes pods can't limit the restarts.
+func (network *DefaultServiceNetwork) stopServiceIfPastMaxRetries(ctx context.Context, serviceName service.ServiceName, restartCount uint32) (bool, error) {
+	serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the registration of service '%v'", serviceName)
+	}
+	serviceConfig := serviceRegistration.GetConfig()
+	if serviceConfig == nil || serviceConfig.GetRestartPolicy() == nil || serviceConfig.GetRestartPolicy().GetMode() != service_restart_policy.ServiceRestartPolicyMode_OnFailure {
+		return false, nil
+	}
+	maxRetries, hasMaxRetries := serviceConfig.GetRestartPolicy().GetMaxRetries()
+	if !hasMaxRetries || restartCount < maxRetries {
+		return false, nil
+	}
+	if err := network.StopService(ctx, string(serviceName)); err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred stopping service '%v'", serviceName)
+	}
+	return true, nil
+}
+
 // startRegisteredService handles the logistic of starting a service in the relevant Kurtosis backend:
 func (network *DefaultServiceNetwork) startRegisteredService(
 	ctx context.Context,
@@ -961,17 +1205,26 @@ func (network *DefaultServiceNetwork) startRegisteredService(
 		return nil, stacktrace.NewError("Memory allocation, `%d`, is too low. Kurtosis requires the memory limit to be at least `%d` megabytes for service with UUID '%v'.", serviceConfig.GetMemoryAllocationMegabytes(), minMemoryLimit, serviceUuid)
 	}
 
+	resolvedServiceConfig, err := network.getServiceConfigToStart(serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the config to start service with UUID '%v' with", serviceUuid)
+	}
+
 	// TODO(gb): make the backend also handle starting service sequentially to simplify the logic there as well
 	serviceConfigMap := map[service.ServiceUUID]*service.ServiceConfig{
-		serviceUuid: serviceConfig,
+		serviceUuid: resolvedServiceConfig,
 	}
-	successfulServices, failedServices, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, serviceConfigMap)
+	createContainerCtx, createContainerSpan := tracing.StartSpan(ctx, tracing.CreateContainerSpanName, tracing.ImageKey.String(resolvedServiceConfig.GetContainerImageName()))
+	successfulServices, failedServices, err := network.kurtosisBackend.StartRegisteredUserServices(createContainerCtx, network.enclaveUuid, serviceConfigMap)
 	if err != nil {
+		tracing.EndSpan(createContainerSpan, err)
 		return nil, err
 	}
 	if failedServiceErr, isFailed := failedServices[serviceUuid]; isFailed {
+		tracing.EndSpan(createContainerSpan, failedServiceErr)
 		return nil, failedServiceErr
 	}
+	tracing.EndSpan(createContainerSpan, nil)
 
 	startedService, isSuccessful := successfulServices[serviceUuid]
 	if !isSuccessful {
@@ -1001,10 +1254,13 @@ func (network *DefaultServiceNetwork) startRegisteredService(
 
 	allPrivateAndPublicPorts := mergeAndGetAllPrivateAndPublicServicePorts(startedService)
 
-	if err := waitUntilAllTCPAndUDPPortsAreOpen(
+	_, waitForPortsSpan := tracing.StartSpan(ctx, tracing.WaitForPortsSpanName)
+	waitForPortsErr := waitUntilAllTCPAndUDPPortsAreOpen(
 		startedService.GetRegistration().GetPrivateIP(),
 		allPrivateAndPublicPorts,
-	); err != nil {
+	)
+	tracing.EndSpan(waitForPortsSpan, waitForPortsErr)
+	if err := waitForPortsErr; err != nil {
 		serviceLogs, getServiceLogsErr := network.getServiceLogs(ctx, startedService, shouldFollowLogs)
 		if getServiceLogsErr != nil {
 			serviceLogs = fmt.Sprintf("An error occurred while getting the service logs.\n Error:%v", getServiceLogsErr)
@@ -1028,6 +1284,70 @@ func (network *DefaultServiceNetwork) startRegisteredService(
 	return startedService, nil
 }
 
+// Gets the config the backend starts the service with, out of the config of its registration
+// The backend gets the secret values, while the service registration keeps the config with the placeholders. Every
+// service resolves hostnames with the enclave DNS server, which forwards everything else to the resolver of the API
+// container, so the records added after the service started resolve too
+func (network *DefaultServiceNetwork) getServiceConfigToStart(serviceConfig *service.ServiceConfig) (*service.ServiceConfig, error) {
+	resolvedServiceConfig, err := network.secretStore.ResolveSecrets(serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets of the service")
+	}
+	resolvedServiceConfig, err = network.resolveHostPortRanges(resolvedServiceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving the host port ranges the service publishes its ports on")
+	}
+	if network.dnsRecordStore == nil {
+		return resolvedServiceConfig, nil
+	}
+	// The config with the DNS server never gets persisted, as the API container might get another IP address
+	// Services on named networks can't reach this address, so the backend replaces it with the address of the API
+	// container on their networks
+	serviceConfigWithDnsServer, err := resolvedServiceConfig.Copy()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred copying the config of the service")
+	}
+	serviceConfigWithDnsServer.SetDnsServers([]string{network.apiContainerInfo.GetIpAddress().String()})
+	return serviceConfigWithDnsServer, nil
+}
+
+// Only the engine knows the bounds of the named host port ranges, so the backend gets them along with the range names
+func (network *DefaultServiceNetwork) resolveHostPortRanges(serviceConfig *service.ServiceConfig) (*service.ServiceConfig, error) {
+	isPublishingOnRange := false
+	for _, portPublishSpec := range serviceConfig.GetPortPublishSpecs() {
+		if portPublishSpec.GetMode() == port_publish_spec.PortPublishMode_Range {
+			isPublishingOnRange = true
+		}
+	}
+	if !isPublishingOnRange {
+		return serviceConfig, nil
+	}
+
+	resolvedPortPublishSpecs := map[string]*port_publish_spec.PortPublishSpec{}
+	for portId, portPublishSpec := range serviceConfig.GetPortPublishSpecs() {
+		if portPublishSpec.GetMode() != port_publish_spec.PortPublishMode_Range {
+			resolvedPortPublishSpecs[portId] = portPublishSpec
+			continue
+		}
+		hostPortRange, found := network.hostPortRanges[portPublishSpec.GetRangeName()]
+		if !found {
+			return nil, stacktrace.NewError("Port '%v' is published on host port range '%v', but no such range is set on the engine", portId, portPublishSpec.GetRangeName())
+		}
+		resolvedPortPublishSpec, err := portPublishSpec.WithResolvedRange(hostPortRange)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resolving host port range '%v' of port '%v'", portPublishSpec.GetRangeName(), portId)
+		}
+		resolvedPortPublishSpecs[portId] = resolvedPortPublishSpec
+	}
+	// The persisted config keeps the range names only, so the engine can change the bounds of a range between restarts
+	serviceConfigWithResolvedRanges, err := serviceConfig.Copy()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred copying the config of the service")
+	}
+	serviceConfigWithResolvedRanges.SetPortPublishSpecs(resolvedPortPublishSpecs)
+	return serviceConfigWithResolvedRanges, nil
+}
+
 // destroyService is the opposite of startRegisteredService. It removes a started service from the enclave. Note that it does not
 // take care of unregistering the service. For this, unregisterService should be called
 // Similar to unregisterService, it is expected that the service passed to destroyService has been properly started.
@@ -1110,7 +1430,12 @@ func (network *DefaultServiceNetwork) startRegisteredServices(
 				<-concurrencyControlChan
 			}()
 			logrus.Debugf("Starting service '%s'", serviceToStartUuid)
-			startedService, err := network.startRegisteredService(ctx, serviceToStartUuid, serviceToStartConfig)
+			startServiceCtx, span := tracing.StartSpan(ctx, tracing.StartServiceSpanName, tracing.ServiceUuidKey.String(string(serviceToStartUuid)))
+			startedService, err := network.startRegisteredService(startServiceCtx, serviceToStartUuid, serviceToStartConfig)
+			if err == nil {
+				span.SetAttributes(tracing.ServiceNameKey.String(string(startedService.GetRegistration().GetName())))
+			}
+			tracing.EndSpan(span, err)
 			mapWriteMutex.Lock()
 			defer mapWriteMutex.Unlock()
 			if err != nil {
@@ -1128,6 +1453,30 @@ func (network *DefaultServiceNetwork) startRegisteredServices(
 	return startedServices, failedServices
 }
 
+// Returns the names of the replicas of the replicated service sorted by replica index, and the config of the first
+// one, which all the replicas share
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) getServiceReplicasUnlocked(replicaGroup service.ServiceName) ([]service.ServiceName, *service.ServiceConfig, error) {
+	registeredServices, err := network.serviceRegistrationRepository.GetAll()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting registered services from the repository")
+	}
+
+	replicaNames := []service.ServiceName{}
+	for serviceName, registration := range registeredServices {
+		serviceConfig := registration.GetConfig()
+		if serviceConfig == nil || serviceConfig.GetReplicaGroup() != string(replicaGroup) {
+			continue
+		}
+		replicaNames = append(replicaNames, serviceName)
+	}
+	if len(replicaNames) == 0 {
+		return replicaNames, nil, nil
+	}
+	sortReplicaNames(replicaGroup, replicaNames)
+	return replicaNames, registeredServices[replicaNames[0]].GetConfig(), nil
+}
+
 // This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
 func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.Context, serviceName service.ServiceName, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
 
@@ -1220,7 +1569,7 @@ func (network *DefaultServiceNetwork) renderTemplatesUnlocked(templatesAndDataBy
 
 	for destinationRelFilepath, templateAndData := range templatesAndDataByDestinationRelFilepath {
 		destinationAbsoluteFilePath := path.Join(tempDirForRenderedTemplates, destinationRelFilepath)
-		if err := templateAndData.RenderToFile(destinationAbsoluteFilePath); err != nil {
+		if err := templateAndData.RenderToFile(destinationAbsoluteFilePath, network.readFileFromFilesArtifactUnlocked); err != nil {
 			return "", stacktrace.Propagate(err, "There was an error in rendering template for file '%s'", destinationRelFilepath)
 		}
 	}
@@ -1268,6 +1617,58 @@ func (network *DefaultServiceNetwork) renderTemplatesUnlocked(templatesAndDataBy
 	return filesArtifactUuid, nil
 }
 
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) readFileFromFilesArtifactUnlocked(artifactName string, relativeFilepath string) ([]byte, error) {
+	store, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting files artifact store")
+	}
+	_, filesArtifact, _, found, err := store.GetFile(artifactName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retrieving files artifact '%s' from the store", artifactName)
+	}
+	if !found {
+		return nil, stacktrace.NewError("Files artifact '%s' doesn't exist in this enclave", artifactName)
+	}
+
+	compressedFilesArtifact, err := os.Open(filesArtifact.GetAbsoluteFilepath())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening files artifact '%s'", artifactName)
+	}
+	defer compressedFilesArtifact.Close()
+	gzipReader, err := gzip.NewReader(compressedFilesArtifact)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a gzip reader for files artifact '%s'", artifactName)
+	}
+	defer gzipReader.Close()
+
+	// paths inside the archive are relative to its root, but users often write them with a leading slash
+	cleanedRelativeFilepath := cleanFilepathInFilesArtifact(relativeFilepath)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the content of files artifact '%s'", artifactName)
+		}
+		if header.Typeflag != tar.TypeReg || cleanFilepathInFilesArtifact(header.Name) != cleanedRelativeFilepath {
+			continue
+		}
+		fileContent, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading file '%s' from files artifact '%s'", relativeFilepath, artifactName)
+		}
+		return fileContent, nil
+	}
+	return nil, stacktrace.NewError("File '%s' doesn't exist in files artifact '%s'", relativeFilepath, artifactName)
+}
+
+func cleanFilepathInFilesArtifact(filepath string) string {
+	return strings.TrimPrefix(path.Clean(filesArtifactRootDirpath+filepath), filesArtifactRootDirpath)
+}
+
 // This isn't thread safe and must be called from a thread safe context
 func (network *DefaultServiceNetwork) getServiceNameForIdentifierUnlocked(serviceIdentifier string) (service.ServiceName, error) {
 	maybeServiceUuid := service.ServiceUUID(serviceIdentifier)
@@ -1482,6 +1883,32 @@ func mergeAndGetAllPrivateAndPublicServicePorts(service *service.Service) map[st
 }
 
 // This isn't thread safe and must be called from a thread safe context
+// getServiceNamesByUuidForIdentifiers returns all the registered services if there are no identifiers
+func (network *DefaultServiceNetwork) getServiceNamesByUuidForIdentifiers(serviceIdentifiers map[string]bool) (map[service.ServiceUUID]service.ServiceName, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceNamesByUuid := map[service.ServiceUUID]service.ServiceName{}
+	if len(serviceIdentifiers) == 0 {
+		registeredServices, err := network.serviceRegistrationRepository.GetAll()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting registered services from the repository")
+		}
+		for serviceName, serviceRegistration := range registeredServices {
+			serviceNamesByUuid[serviceRegistration.GetUUID()] = serviceName
+		}
+		return serviceNamesByUuid, nil
+	}
+	for serviceIdentifier := range serviceIdentifiers {
+		serviceRegistration, err := network.getServiceRegistrationForIdentifierUnlocked(serviceIdentifier)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while fetching registration for service identifier '%v'", serviceIdentifier)
+		}
+		serviceNamesByUuid[serviceRegistration.GetUUID()] = serviceRegistration.GetName()
+	}
+	return serviceNamesByUuid, nil
+}
+
 func (network *DefaultServiceNetwork) getServiceRegistrationForIdentifierUnlocked(
 	serviceIdentifier string,
 ) (*service.ServiceRegistration, error) {
@@ -21,8 +21,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_dns"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/pkg/errors"
@@ -52,10 +55,23 @@ var (
 		testIpFromInt(0),
 		uint16(1234),
 		"0.0.0",
+		nil,
 	)
 	unusedEnclaveDataDir *enclave_data_directory.EnclaveDataDirectory
 
+	// None of the test services use secrets, so the secret store never gets called
+	unusedSecretStore *secret_store.SecretStore
+
+	// Without a DNS record store the enclave has no DNS server, so the test services use the default one of the backend
+	unusedDnsRecordStore *enclave_dns.DnsRecordStore
+
+	// None of the test services publish their ports on a named host port range
+	noHostPortRanges map[string]*port_publish_spec.HostPortRange
+
 	portWaitForTest = port_spec.NewWait(5 * time.Second)
+
+	// The services get started with a child context carrying the span of their start, rather than the test context
+	startServiceCtxMatcher = mock.MatchedBy(func(context.Context) bool { return true })
 )
 
 func TestAddService_Successful(t *testing.T) {
@@ -84,6 +100,9 @@ func TestAddService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		unusedSecretStore,
+		unusedDnsRecordStore,
+		noHostPortRanges,
 	)
 	require.Nil(t, err)
 
@@ -104,7 +123,7 @@ func TestAddService_Successful(t *testing.T) {
 
 	// Then the service is started
 	backend.EXPECT().StartRegisteredUserServices(
-		ctx,
+		startServiceCtxMatcher,
 		enclaveName,
 		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
 			// Matcher function returning true iff the services map arg contains exactly the following key:
@@ -165,6 +184,9 @@ func TestAddService_FailedToStart(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		unusedSecretStore,
+		unusedDnsRecordStore,
+		noHostPortRanges,
 	)
 	require.Nil(t, err)
 
@@ -185,7 +207,7 @@ func TestAddService_FailedToStart(t *testing.T) {
 
 	// StartRegisteredUserServices will be called for this service
 	backend.EXPECT().StartRegisteredUserServices(
-		ctx,
+		startServiceCtxMatcher,
 		enclaveName,
 		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
 			// Matcher function returning true iff the services map arg contains exactly the following key:
@@ -259,6 +281,9 @@ func TestAddServices_Success(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		unusedSecretStore,
+		unusedDnsRecordStore,
+		noHostPortRanges,
 	)
 	require.Nil(t, err)
 
@@ -281,7 +306,7 @@ func TestAddServices_Success(t *testing.T) {
 
 	// StartUserService will be called three times, with all the provided services
 	backend.EXPECT().StartRegisteredUserServices(
-		ctx,
+		startServiceCtxMatcher,
 		enclaveName,
 		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
 			// Matcher function returning true iff the services map arg contains exactly the following key:
@@ -352,6 +377,9 @@ func TestAddServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		unusedSecretStore,
+		unusedDnsRecordStore,
+		noHostPortRanges,
 	)
 	require.Nil(t, err)
 
@@ -387,7 +415,7 @@ func TestAddServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 
 	// StartUserService will be called three times, with all the provided services
 	backend.EXPECT().StartRegisteredUserServices(
-		ctx,
+		startServiceCtxMatcher,
 		enclaveName,
 		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
 			// Matcher function returning true iff the services map arg contains exactly the following key:
@@ -401,7 +429,7 @@ func TestAddServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 		map[service.ServiceUUID]error{},
 		nil)
 	backend.EXPECT().StartRegisteredUserServices(
-		ctx,
+		startServiceCtxMatcher,
 		enclaveName,
 		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
 			// Matcher function returning true iff the services map arg contains exactly the following key:
@@ -503,6 +531,9 @@ func TestAddServices_FailedToRegisterService(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		unusedSecretStore,
+		unusedDnsRecordStore,
+		noHostPortRanges,
 	)
 	require.Nil(t, err)
 
@@ -557,6 +588,9 @@ func TestStopService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		unusedSecretStore,
+		unusedDnsRecordStore,
+		noHostPortRanges,
 	)
 	require.Nil(t, err)
 
@@ -615,6 +649,9 @@ func TestStopService_StopUserServicesFailed(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		unusedSecretStore,
+		unusedDnsRecordStore,
+		noHostPortRanges,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -670,6 +707,9 @@ func TestStopService_ServiceAlreadyStopped(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		unusedSecretStore,
+		unusedDnsRecordStore,
+		noHostPortRanges,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -729,6 +769,9 @@ func TestStartService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		unusedSecretStore,
+		unusedDnsRecordStore,
+		noHostPortRanges,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -783,6 +826,9 @@ func TestStartService_StartRegisteredUserServicesFailed(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		unusedSecretStore,
+		unusedDnsRecordStore,
+		noHostPortRanges,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -837,6 +883,9 @@ func TestStartService_ServiceAlreadyStarted(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		unusedSecretStore,
+		unusedDnsRecordStore,
+		noHostPortRanges,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -882,6 +931,9 @@ func TestUpdateService(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		unusedSecretStore,
+		unusedDnsRecordStore,
+		noHostPortRanges,
 	)
 	require.Nil(t, err)
 
@@ -963,7 +1015,7 @@ func TestUpdateService(t *testing.T) {
 	// The services will then be re-created
 	serviceObj := service.NewService(existingServiceRegistration, map[string]*port_spec.PortSpec{}, existingServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, "", nil, nil, nil))
 	backend.EXPECT().StartRegisteredUserServices(
-		ctx,
+		startServiceCtxMatcher,
 		enclaveName,
 		map[service.ServiceUUID]*service.ServiceConfig{
 			existingServiceRegistration.GetUUID(): updatedServiceConfig,
@@ -976,7 +1028,7 @@ func TestUpdateService(t *testing.T) {
 		nil,
 	)
 	backend.EXPECT().StartRegisteredUserServices(
-		ctx,
+		startServiceCtxMatcher,
 		enclaveName,
 		map[service.ServiceUUID]*service.ServiceConfig{
 			failedToBeRecreatedServiceRegistration.GetUUID(): updatedServiceConfig,
@@ -1028,6 +1080,54 @@ func TestUpdateService(t *testing.T) {
 	require.Nil(t, newFailedToBeRecreatedServiceRegistration.GetConfig())
 }
 
+func TestGetServiceConfigToStart_UsesEnclaveDnsServer(t *testing.T) {
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	dnsRecordStore, err := enclave_dns.GetOrCreateDnsRecordStore(enclaveDb)
+	require.Nil(t, err)
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		unusedSecretStore,
+		dnsRecordStore,
+		noHostPortRanges,
+	)
+	require.Nil(t, err)
+
+	serviceConfig := testServiceConfig(t, testContainerImageName)
+	// Services started before the enclave has any record use the enclave DNS server too
+	serviceConfigToStart, err := network.getServiceConfigToStart(serviceConfig)
+	require.Nil(t, err)
+	require.Equal(t, []string{apiContainerInfo.GetIpAddress().String()}, serviceConfigToStart.GetDnsServers())
+	// The config of the registration never gets the DNS server
+	require.Empty(t, serviceConfig.GetDnsServers())
+
+	dnsRecord, err := enclave_dns.NewDnsRecord("api.internal.company.com", "", "10.0.0.5", 0, 0, 0)
+	require.Nil(t, err)
+	require.Nil(t, network.AddDnsRecord(dnsRecord))
+
+	serviceConfigToStart, err = network.getServiceConfigToStart(serviceConfig)
+	require.Nil(t, err)
+	require.Equal(t, []string{apiContainerInfo.GetIpAddress().String()}, serviceConfigToStart.GetDnsServers())
+
+	segmentedServiceConfig := testServiceConfig(t, testContainerImageName)
+	segmentedServiceConfig.SetNetworks([]string{"backend"})
+	serviceConfigToStart, err = network.getServiceConfigToStart(segmentedServiceConfig)
+	require.Nil(t, err)
+	require.Equal(t, []string{apiContainerInfo.GetIpAddress().String()}, serviceConfigToStart.GetDnsServers())
+}
+
 func TestScanPort(t *testing.T) {
 	localhost := net.ParseIP(localhostIPAddrStr)
 
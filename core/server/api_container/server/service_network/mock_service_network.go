@@ -8,6 +8,8 @@ import (
 	enclave "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	enclave_data_directory "github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 
+	enclave_dns "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_dns"
+
 	exec_result "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 
 	http "net/http"
@@ -18,8 +20,12 @@ import (
 
 	render_templates "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
 
+	service_health "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
+
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 
+	service_directory "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+
 	service_identifiers "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
 )
 
@@ -36,6 +42,48 @@ func (_m *MockServiceNetwork) EXPECT() *MockServiceNetwork_Expecter {
 	return &MockServiceNetwork_Expecter{mock: &_m.Mock}
 }
 
+// AddDnsRecord provides a mock function with given fields: record
+func (_m *MockServiceNetwork) AddDnsRecord(record *enclave_dns.DnsRecord) error {
+	ret := _m.Called(record)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*enclave_dns.DnsRecord) error); ok {
+		r0 = rf(record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_AddDnsRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDnsRecord'
+type MockServiceNetwork_AddDnsRecord_Call struct {
+	*mock.Call
+}
+
+// AddDnsRecord is a helper method to define mock.On call
+//   - record *enclave_dns.DnsRecord
+func (_e *MockServiceNetwork_Expecter) AddDnsRecord(record interface{}) *MockServiceNetwork_AddDnsRecord_Call {
+	return &MockServiceNetwork_AddDnsRecord_Call{Call: _e.mock.On("AddDnsRecord", record)}
+}
+
+func (_c *MockServiceNetwork_AddDnsRecord_Call) Run(run func(record *enclave_dns.DnsRecord)) *MockServiceNetwork_AddDnsRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*enclave_dns.DnsRecord))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_AddDnsRecord_Call) Return(_a0 error) *MockServiceNetwork_AddDnsRecord_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_AddDnsRecord_Call) RunAndReturn(run func(*enclave_dns.DnsRecord) error) *MockServiceNetwork_AddDnsRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddService provides a mock function with given fields: ctx, serviceName, serviceConfig
 func (_m *MockServiceNetwork) AddService(ctx context.Context, serviceName service.ServiceName, serviceConfig *service.ServiceConfig) (*service.Service, error) {
 	ret := _m.Called(ctx, serviceName, serviceConfig)
@@ -524,6 +572,50 @@ func (_c *MockServiceNetwork_GetService_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetServiceHealth provides a mock function with given fields: serviceObj
+func (_m *MockServiceNetwork) GetServiceHealth(serviceObj *service.Service) *service_health.ServiceHealth {
+	ret := _m.Called(serviceObj)
+
+	var r0 *service_health.ServiceHealth
+	if rf, ok := ret.Get(0).(func(*service.Service) *service_health.ServiceHealth); ok {
+		r0 = rf(serviceObj)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service_health.ServiceHealth)
+		}
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_GetServiceHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceHealth'
+type MockServiceNetwork_GetServiceHealth_Call struct {
+	*mock.Call
+}
+
+// GetServiceHealth is a helper method to define mock.On call
+//   - serviceObj *service.Service
+func (_e *MockServiceNetwork_Expecter) GetServiceHealth(serviceObj interface{}) *MockServiceNetwork_GetServiceHealth_Call {
+	return &MockServiceNetwork_GetServiceHealth_Call{Call: _e.mock.On("GetServiceHealth", serviceObj)}
+}
+
+func (_c *MockServiceNetwork_GetServiceHealth_Call) Run(run func(serviceObj *service.Service)) *MockServiceNetwork_GetServiceHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*service.Service))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceHealth_Call) Return(_a0 *service_health.ServiceHealth) *MockServiceNetwork_GetServiceHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceHealth_Call) RunAndReturn(run func(*service.Service) *service_health.ServiceHealth) *MockServiceNetwork_GetServiceHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetServiceNames provides a mock function with given fields:
 func (_m *MockServiceNetwork) GetServiceNames() (map[service.ServiceName]bool, error) {
 	ret := _m.Called()
@@ -577,6 +669,166 @@ func (_c *MockServiceNetwork_GetServiceNames_Call) RunAndReturn(run func() (map[
 	return _c
 }
 
+// GetServiceConfigs provides a mock function with given fields:
+func (_m *MockServiceNetwork) GetServiceConfigs() (map[service.ServiceName]*service.ServiceConfig, error) {
+	ret := _m.Called()
+
+	var r0 map[service.ServiceName]*service.ServiceConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[service.ServiceName]*service.ServiceConfig, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[service.ServiceName]*service.ServiceConfig); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]*service.ServiceConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServiceConfigs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceConfigs'
+type MockServiceNetwork_GetServiceConfigs_Call struct {
+	*mock.Call
+}
+
+// GetServiceConfigs is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) GetServiceConfigs() *MockServiceNetwork_GetServiceConfigs_Call {
+	return &MockServiceNetwork_GetServiceConfigs_Call{Call: _e.mock.On("GetServiceConfigs")}
+}
+
+func (_c *MockServiceNetwork_GetServiceConfigs_Call) Run(run func()) *MockServiceNetwork_GetServiceConfigs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceConfigs_Call) Return(_a0 map[service.ServiceName]*service.ServiceConfig, _a1 error) *MockServiceNetwork_GetServiceConfigs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceConfigs_Call) RunAndReturn(run func() (map[service.ServiceName]*service.ServiceConfig, error)) *MockServiceNetwork_GetServiceConfigs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServicesPersistentDirectories provides a mock function with given fields:
+func (_m *MockServiceNetwork) GetServicesPersistentDirectories() (map[service.ServiceName]*service_directory.PersistentDirectories, error) {
+	ret := _m.Called()
+
+	var r0 map[service.ServiceName]*service_directory.PersistentDirectories
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[service.ServiceName]*service_directory.PersistentDirectories, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[service.ServiceName]*service_directory.PersistentDirectories); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]*service_directory.PersistentDirectories)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServicesPersistentDirectories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServicesPersistentDirectories'
+type MockServiceNetwork_GetServicesPersistentDirectories_Call struct {
+	*mock.Call
+}
+
+// GetServicesPersistentDirectories is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) GetServicesPersistentDirectories() *MockServiceNetwork_GetServicesPersistentDirectories_Call {
+	return &MockServiceNetwork_GetServicesPersistentDirectories_Call{Call: _e.mock.On("GetServicesPersistentDirectories")}
+}
+
+func (_c *MockServiceNetwork_GetServicesPersistentDirectories_Call) Run(run func()) *MockServiceNetwork_GetServicesPersistentDirectories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServicesPersistentDirectories_Call) Return(_a0 map[service.ServiceName]*service_directory.PersistentDirectories, _a1 error) *MockServiceNetwork_GetServicesPersistentDirectories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServicesPersistentDirectories_Call) RunAndReturn(run func() (map[service.ServiceName]*service_directory.PersistentDirectories, error)) *MockServiceNetwork_GetServicesPersistentDirectories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServiceReplicaNames provides a mock function with given fields: replicaGroup
+func (_m *MockServiceNetwork) GetServiceReplicaNames(replicaGroup service.ServiceName) ([]service.ServiceName, error) {
+	ret := _m.Called(replicaGroup)
+
+	var r0 []service.ServiceName
+	var r1 error
+	if rf, ok := ret.Get(0).(func(service.ServiceName) ([]service.ServiceName, error)); ok {
+		return rf(replicaGroup)
+	}
+	if rf, ok := ret.Get(0).(func(service.ServiceName) []service.ServiceName); ok {
+		r0 = rf(replicaGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]service.ServiceName)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(service.ServiceName) error); ok {
+		r1 = rf(replicaGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServiceReplicaNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceReplicaNames'
+type MockServiceNetwork_GetServiceReplicaNames_Call struct {
+	*mock.Call
+}
+
+// GetServiceReplicaNames is a helper method to define mock.On call
+//   - replicaGroup service.ServiceName
+func (_e *MockServiceNetwork_Expecter) GetServiceReplicaNames(replicaGroup interface{}) *MockServiceNetwork_GetServiceReplicaNames_Call {
+	return &MockServiceNetwork_GetServiceReplicaNames_Call{Call: _e.mock.On("GetServiceReplicaNames", replicaGroup)}
+}
+
+func (_c *MockServiceNetwork_GetServiceReplicaNames_Call) Run(run func(replicaGroup service.ServiceName)) *MockServiceNetwork_GetServiceReplicaNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceReplicaNames_Call) Return(_a0 []service.ServiceName, _a1 error) *MockServiceNetwork_GetServiceReplicaNames_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceReplicaNames_Call) RunAndReturn(run func(service.ServiceName) ([]service.ServiceName, error)) *MockServiceNetwork_GetServiceReplicaNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetServices provides a mock function with given fields: ctx
 func (_m *MockServiceNetwork) GetServices(ctx context.Context) (map[service.ServiceUUID]*service.Service, error) {
 	ret := _m.Called(ctx)
@@ -631,6 +883,70 @@ func (_c *MockServiceNetwork_GetServices_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetServicesStats provides a mock function with given fields: ctx, serviceIdentifiers
+func (_m *MockServiceNetwork) GetServicesStats(ctx context.Context, serviceIdentifiers map[string]bool) (map[service.ServiceName]*service.ServiceStats, map[service.ServiceName]error, error) {
+	ret := _m.Called(ctx, serviceIdentifiers)
+
+	var r0 map[service.ServiceName]*service.ServiceStats
+	var r1 map[service.ServiceName]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool) (map[service.ServiceName]*service.ServiceStats, map[service.ServiceName]error, error)); ok {
+		return rf(ctx, serviceIdentifiers)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool) map[service.ServiceName]*service.ServiceStats); ok {
+		r0 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]*service.ServiceStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[string]bool) map[service.ServiceName]error); ok {
+		r1 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceName]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, map[string]bool) error); ok {
+		r2 = rf(ctx, serviceIdentifiers)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockServiceNetwork_GetServicesStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServicesStats'
+type MockServiceNetwork_GetServicesStats_Call struct {
+	*mock.Call
+}
+
+// GetServicesStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifiers map[string]bool
+func (_e *MockServiceNetwork_Expecter) GetServicesStats(ctx interface{}, serviceIdentifiers interface{}) *MockServiceNetwork_GetServicesStats_Call {
+	return &MockServiceNetwork_GetServicesStats_Call{Call: _e.mock.On("GetServicesStats", ctx, serviceIdentifiers)}
+}
+
+func (_c *MockServiceNetwork_GetServicesStats_Call) Run(run func(ctx context.Context, serviceIdentifiers map[string]bool)) *MockServiceNetwork_GetServicesStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]bool))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServicesStats_Call) Return(_a0 map[service.ServiceName]*service.ServiceStats, _a1 map[service.ServiceName]error, _a2 error) *MockServiceNetwork_GetServicesStats_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServicesStats_Call) RunAndReturn(run func(context.Context, map[string]bool) (map[service.ServiceName]*service.ServiceStats, map[service.ServiceName]error, error)) *MockServiceNetwork_GetServicesStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUniqueNameForFileArtifact provides a mock function with given fields:
 func (_m *MockServiceNetwork) GetUniqueNameForFileArtifact() (string, error) {
 	ret := _m.Called()
@@ -968,6 +1284,96 @@ func (_c *MockServiceNetwork_RunExecs_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// ScaleService provides a mock function with given fields: ctx, replicaGroup, replicas
+func (_m *MockServiceNetwork) ScaleService(ctx context.Context, replicaGroup service.ServiceName, replicas int) (map[service.ServiceName]*service.Service, error) {
+	ret := _m.Called(ctx, replicaGroup, replicas)
+
+	var r0 map[service.ServiceName]*service.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.ServiceName, int) (map[service.ServiceName]*service.Service, error)); ok {
+		return rf(ctx, replicaGroup, replicas)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, service.ServiceName, int) map[service.ServiceName]*service.Service); ok {
+		r0 = rf(ctx, replicaGroup, replicas)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]*service.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, service.ServiceName, int) error); ok {
+		r1 = rf(ctx, replicaGroup, replicas)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_ScaleService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScaleService'
+type MockServiceNetwork_ScaleService_Call struct {
+	*mock.Call
+}
+
+// ScaleService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - replicaGroup service.ServiceName
+//   - replicas int
+func (_e *MockServiceNetwork_Expecter) ScaleService(ctx interface{}, replicaGroup interface{}, replicas interface{}) *MockServiceNetwork_ScaleService_Call {
+	return &MockServiceNetwork_ScaleService_Call{Call: _e.mock.On("ScaleService", ctx, replicaGroup, replicas)}
+}
+
+func (_c *MockServiceNetwork_ScaleService_Call) Run(run func(ctx context.Context, replicaGroup service.ServiceName, replicas int)) *MockServiceNetwork_ScaleService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.ServiceName), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_ScaleService_Call) Return(_a0 map[service.ServiceName]*service.Service, _a1 error) *MockServiceNetwork_ScaleService_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_ScaleService_Call) RunAndReturn(run func(context.Context, service.ServiceName, int) (map[service.ServiceName]*service.Service, error)) *MockServiceNetwork_ScaleService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetServiceHealthCheck provides a mock function with given fields: serviceName, healthCheck
+func (_m *MockServiceNetwork) SetServiceHealthCheck(serviceName service.ServiceName, healthCheck *service_health.HealthCheck) {
+	_m.Called(serviceName, healthCheck)
+}
+
+// MockServiceNetwork_SetServiceHealthCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetServiceHealthCheck'
+type MockServiceNetwork_SetServiceHealthCheck_Call struct {
+	*mock.Call
+}
+
+// SetServiceHealthCheck is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+//   - healthCheck *service_health.HealthCheck
+func (_e *MockServiceNetwork_Expecter) SetServiceHealthCheck(serviceName interface{}, healthCheck interface{}) *MockServiceNetwork_SetServiceHealthCheck_Call {
+	return &MockServiceNetwork_SetServiceHealthCheck_Call{Call: _e.mock.On("SetServiceHealthCheck", serviceName, healthCheck)}
+}
+
+func (_c *MockServiceNetwork_SetServiceHealthCheck_Call) Run(run func(serviceName service.ServiceName, healthCheck *service_health.HealthCheck)) *MockServiceNetwork_SetServiceHealthCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName), args[1].(*service_health.HealthCheck))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetServiceHealthCheck_Call) Return() *MockServiceNetwork_SetServiceHealthCheck_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetServiceHealthCheck_Call) RunAndReturn(run func(service.ServiceName, *service_health.HealthCheck)) *MockServiceNetwork_SetServiceHealthCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) StartService(ctx context.Context, serviceIdentifier string) error {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -1182,6 +1588,61 @@ func (_c *MockServiceNetwork_StopServices_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// SubscribeToServiceHealthEvents provides a mock function with given fields:
+func (_m *MockServiceNetwork) SubscribeToServiceHealthEvents() (<-chan *service_health.ServiceHealthEvent, func()) {
+	ret := _m.Called()
+
+	var r0 <-chan *service_health.ServiceHealthEvent
+	var r1 func()
+	if rf, ok := ret.Get(0).(func() (<-chan *service_health.ServiceHealthEvent, func())); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() <-chan *service_health.ServiceHealthEvent); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *service_health.ServiceHealthEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() func()); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_SubscribeToServiceHealthEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeToServiceHealthEvents'
+type MockServiceNetwork_SubscribeToServiceHealthEvents_Call struct {
+	*mock.Call
+}
+
+// SubscribeToServiceHealthEvents is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) SubscribeToServiceHealthEvents() *MockServiceNetwork_SubscribeToServiceHealthEvents_Call {
+	return &MockServiceNetwork_SubscribeToServiceHealthEvents_Call{Call: _e.mock.On("SubscribeToServiceHealthEvents")}
+}
+
+func (_c *MockServiceNetwork_SubscribeToServiceHealthEvents_Call) Run(run func()) *MockServiceNetwork_SubscribeToServiceHealthEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_SubscribeToServiceHealthEvents_Call) Return(_a0 <-chan *service_health.ServiceHealthEvent, _a1 func()) *MockServiceNetwork_SubscribeToServiceHealthEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_SubscribeToServiceHealthEvents_Call) RunAndReturn(run func() (<-chan *service_health.ServiceHealthEvent, func())) *MockServiceNetwork_SubscribeToServiceHealthEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateFilesArtifact provides a mock function with given fields: fileArtifactUuid, updatedContent, contentMd5
 func (_m *MockServiceNetwork) UpdateFilesArtifact(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte) error {
 	ret := _m.Called(fileArtifactUuid, updatedContent, contentMd5)
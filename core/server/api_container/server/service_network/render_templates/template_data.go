@@ -16,30 +16,50 @@ import (
 const (
 	templateNamePrefix                   = "kurtosis-template-"
 	folderPermissionForRenderedTemplates = 0755
+	renderedTemplateFilePermission       = 0644
 )
 
 type TemplateData struct {
-	template *template.Template
+	// The template is stored as a string and parsed again at render time, so that the functions reading files
+	// artifacts can be bound to the enclave's files artifact store
+	templateString string
 
 	dataAsSerializedJson string
+
+	format TemplateFormat
 }
 
-func CreateTemplateData(templateString string, dataAsSerializedJson string) (*TemplateData, error) {
-	templateName, err := generateUniqueTemplateName(templateString)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Error generating unique template name")
+func CreateTemplateData(templateString string, dataAsSerializedJson string, format TemplateFormat) (*TemplateData, error) {
+	// we parse the template once here so that invalid templates, or templates calling unknown functions, are caught
+	// as early as possible
+	if _, err := parseTemplate(templateString, noFilesArtifactFileReader); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the template string '%s'", templateString)
 	}
-	parsedTemplate, err := template.New(templateName).Parse(templateString)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred parsing the template string '%s'", templateString)
+	if !format.IsValid() {
+		return nil, stacktrace.NewError("Template format '%s' is not valid. Valid formats are: %v", format, AllTemplateFormats())
 	}
 
 	return &TemplateData{
-		template:             parsedTemplate,
+		templateString:       templateString,
 		dataAsSerializedJson: dataAsSerializedJson,
+		format:               format,
 	}, nil
 }
 
+func (templateData *TemplateData) GetFormat() TemplateFormat {
+	return templateData.format
+}
+
+// GetReferencedFilesArtifactNames returns the names of the files artifacts read by the template through the
+// artifact lookup functions, when those names are passed as string literals
+func (templateData *TemplateData) GetReferencedFilesArtifactNames() ([]string, error) {
+	parsedTemplate, err := parseTemplate(templateData.templateString, noFilesArtifactFileReader)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the template string '%s'", templateData.templateString)
+	}
+	return getReferencedFilesArtifactNames(parsedTemplate), nil
+}
+
 func (templateData *TemplateData) ReplaceRuntimeValues(runtimeValueStore *runtime_value_store.RuntimeValueStore) error {
 	dataAsSerializedJsonWithRuntimeValues, err := magic_string_helper.ReplaceRuntimeValueInString(templateData.dataAsSerializedJson, runtimeValueStore)
 	if err != nil {
@@ -53,30 +73,52 @@ func (templateData *TemplateData) ReplaceRuntimeValues(runtimeValueStore *runtim
 	return nil
 }
 
-func (templateData *TemplateData) RenderToFile(destinationAbsoluteFilePath string) error {
+// RenderToFile renders the template to the destination file, validating the output against the template format.
+// The files artifact file reader is used by the template functions looking up the content of other files artifacts
+func (templateData *TemplateData) RenderToFile(destinationAbsoluteFilePath string, filesArtifactFileReader FilesArtifactFileReader) error {
 	decodedData, err := decodeJsonString(templateData.dataAsSerializedJson)
 	if err != nil {
 		return stacktrace.Propagate(err, "There was an error decoding the data as a JSON string: '%s'", templateData.dataAsSerializedJson)
 	}
 
+	parsedTemplate, err := parseTemplate(templateData.templateString, filesArtifactFileReader)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the template string '%s'", templateData.templateString)
+	}
+
+	renderedTemplate := &bytes.Buffer{}
+	if err = parsedTemplate.Execute(renderedTemplate, decodedData); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while rendering the template for destination '%s'", destinationAbsoluteFilePath)
+	}
+
+	if err = templateData.format.validateRenderedContent(renderedTemplate.Bytes()); err != nil {
+		return stacktrace.Propagate(err, "The template rendered for destination '%s' is not valid '%s'. Rendered content was:\n%s", destinationAbsoluteFilePath, templateData.format, renderedTemplate.String())
+	}
+
 	// Create all parent directories to account for nesting
 	destinationFileDir := path.Dir(destinationAbsoluteFilePath)
 	if err := os.MkdirAll(destinationFileDir, folderPermissionForRenderedTemplates); err != nil {
 		return stacktrace.Propagate(err, "There was an error in creating the parent directory '%s' to write the file '%s' into.", destinationFileDir, destinationAbsoluteFilePath)
 	}
 
-	renderedTemplateFile, err := os.Create(destinationAbsoluteFilePath)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred while creating temporary file to render template into for file '%s'.", destinationAbsoluteFilePath)
-	}
-	defer renderedTemplateFile.Close()
-
-	if err = templateData.template.Execute(renderedTemplateFile, decodedData); err != nil {
+	if err = os.WriteFile(destinationAbsoluteFilePath, renderedTemplate.Bytes(), renderedTemplateFilePermission); err != nil {
 		return stacktrace.Propagate(err, "An error occurred while writing the rendered template to destination '%s'", destinationAbsoluteFilePath)
 	}
 	return nil
 }
 
+func parseTemplate(templateString string, filesArtifactFileReader FilesArtifactFileReader) (*template.Template, error) {
+	templateName, err := generateUniqueTemplateName(templateString)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error generating unique template name")
+	}
+	parsedTemplate, err := template.New(templateName).Funcs(newTemplateFuncMap(filesArtifactFileReader)).Parse(templateString)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the template string '%s'", templateString)
+	}
+	return parsedTemplate, nil
+}
+
 func decodeJsonString(dataAsSerializedJson string) (interface{}, error) {
 	dataAsSerializedJsonBytes := []byte(dataAsSerializedJson)
 	dataJsonReader := bytes.NewReader(dataAsSerializedJsonBytes)
@@ -0,0 +1,102 @@
+package render_templates
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+)
+
+const (
+	testDestinationFilename = "rendered.txt"
+)
+
+func TestRenderToFile_StringAndDefaultFunctions(t *testing.T) {
+	template := `{{ .Name | upper }} {{ default "none" .Missing }} {{ .Name | replace "o" "0" | quote }} {{ split "," .List | join "-" }}`
+	rendered := renderForTest(t, template, `{"Name":"foo","List":"a,b,c"}`, TemplateFormat_Text, noFilesArtifactFileReader)
+	require.Equal(t, `FOO none "f00" a-b-c`, rendered)
+}
+
+func TestRenderToFile_ArithmeticKeepsIntegers(t *testing.T) {
+	template := `{{ add .Port 1 }} {{ mul .Count 2 3 }} {{ div .Big 2 }} {{ add .Ratio 1 }} {{ max 3 .Count 1 }}`
+	rendered := renderForTest(t, template, `{"Port":8545,"Count":4,"Big":9007199254740993,"Ratio":0.5}`, TemplateFormat_Text, noFilesArtifactFileReader)
+	require.Equal(t, `8546 24 4503599627370496 1.5 4`, rendered)
+}
+
+func TestRenderToFile_EncodingFunctions(t *testing.T) {
+	template := `{{ b64enc .Secret }} {{ .Secret | b64enc | b64dec }} {{ sha256sum .Secret }}`
+	rendered := renderForTest(t, template, `{"Secret":"hello"}`, TemplateFormat_Text, noFilesArtifactFileReader)
+	require.Equal(t, `aGVsbG8= hello 2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824`, rendered)
+}
+
+func TestRenderToFile_SerializationFunctions(t *testing.T) {
+	template := "{{ toJson .Peers }}\n{{ toYaml .Config }}\n{{ toToml .Config }}"
+	rendered := renderForTest(t, template, `{"Peers":["a","b"],"Config":{"port":30303,"verbose":true}}`, TemplateFormat_Text, noFilesArtifactFileReader)
+	require.Equal(t, "[\"a\",\"b\"]\nport: 30303\nverbose: true\nport = 30303\nverbose = true", rendered)
+}
+
+func TestRenderToFile_ValidFormatsAreAccepted(t *testing.T) {
+	data := `{"Name":"api","Labels":{"team":"infra"}}`
+	renderForTest(t, `{"name": {{ quote .Name }}, "labels": {{ toJson .Labels }}}`, data, TemplateFormat_Json, noFilesArtifactFileReader)
+	renderForTest(t, "name: {{ .Name }}\nlabels:{{ toYaml .Labels | nindent 2 }}", data, TemplateFormat_Yaml, noFilesArtifactFileReader)
+	renderForTest(t, "name = {{ quote .Name }}\n[labels]\n{{ toToml .Labels }}", data, TemplateFormat_Toml, noFilesArtifactFileReader)
+}
+
+func TestRenderToFile_InvalidFormatFailsRendering(t *testing.T) {
+	data := `{"Name":"api"}`
+	invalidTemplatesByFormat := map[TemplateFormat]string{
+		TemplateFormat_Json: `{"name": {{ .Name }}}`,
+		TemplateFormat_Yaml: "name: {{ .Name }}\n  broken: [",
+		TemplateFormat_Toml: "name = {{ .Name }}",
+	}
+	for format, template := range invalidTemplatesByFormat {
+		templateData, err := CreateTemplateData(template, data, format)
+		require.NoError(t, err)
+		destinationFilepath := path.Join(t.TempDir(), testDestinationFilename)
+		err = templateData.RenderToFile(destinationFilepath, noFilesArtifactFileReader)
+		require.Error(t, err, "Expected format '%s' to fail validation", format)
+		_, statErr := os.Stat(destinationFilepath)
+		require.True(t, os.IsNotExist(statErr), "Expected nothing to be written for format '%s'", format)
+	}
+}
+
+func TestCreateTemplateData_FailsForUnknownFormat(t *testing.T) {
+	_, err := CreateTemplateData("{{ .Name }}", `{"Name":"api"}`, "xml")
+	require.Error(t, err)
+}
+
+func TestCreateTemplateData_FailsForUnknownFunction(t *testing.T) {
+	_, err := CreateTemplateData("{{ doesNotExist .Name }}", `{"Name":"api"}`, TemplateFormat_Text)
+	require.Error(t, err)
+}
+
+func TestRenderToFile_ArtifactFileLookup(t *testing.T) {
+	filesArtifactFileReader := func(filesArtifactName string, relativeFilepath string) ([]byte, error) {
+		if filesArtifactName == "genesis" && relativeFilepath == "chain-id.txt" {
+			return []byte("1337"), nil
+		}
+		return nil, stacktrace.NewError("File '%s' not found in '%s'", relativeFilepath, filesArtifactName)
+	}
+	rendered := renderForTest(t, `chain_id: {{ artifactFile "genesis" "chain-id.txt" | trim }}`, `{}`, TemplateFormat_Yaml, filesArtifactFileReader)
+	require.Equal(t, `chain_id: 1337`, rendered)
+}
+
+func TestGetReferencedFilesArtifactNames(t *testing.T) {
+	template := `{{ artifactFile "genesis" "a.txt" }}{{ if .Enabled }}{{ artifactFile "keys" "b.txt" | b64enc }}{{ end }}{{ artifactFile .Dynamic "c.txt" }}`
+	templateData, err := CreateTemplateData(template, `{}`, TemplateFormat_Text)
+	require.NoError(t, err)
+	referencedFilesArtifactNames, err := templateData.GetReferencedFilesArtifactNames()
+	require.NoError(t, err)
+	require.Equal(t, []string{"genesis", "keys"}, referencedFilesArtifactNames)
+}
+
+func renderForTest(t *testing.T, template string, data string, format TemplateFormat, filesArtifactFileReader FilesArtifactFileReader) string {
+	templateData, err := CreateTemplateData(template, data, format)
+	require.NoError(t, err)
+	destinationFilepath := path.Join(t.TempDir(), testDestinationFilename)
+	require.NoError(t, templateData.RenderToFile(destinationFilepath, filesArtifactFileReader))
+	renderedBytes, err := os.ReadFile(destinationFilepath)
+	require.NoError(t, err)
+	return string(renderedBytes)
+}
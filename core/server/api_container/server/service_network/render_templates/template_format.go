@@ -0,0 +1,65 @@
+package render_templates
+
+import (
+	"encoding/json"
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/pelletier/go-toml/v2"
+)
+
+// TemplateFormat is the format a rendered template is expected to be in. Every format but 'text' gets the rendered
+// output parsed after rendering, so that a broken config file fails the instruction instead of the service using it
+type TemplateFormat string
+
+const (
+	TemplateFormat_Text TemplateFormat = "text"
+	TemplateFormat_Json TemplateFormat = "json"
+	TemplateFormat_Yaml TemplateFormat = "yaml"
+	TemplateFormat_Toml TemplateFormat = "toml"
+
+	DefaultTemplateFormat = TemplateFormat_Text
+)
+
+func AllTemplateFormats() []TemplateFormat {
+	return []TemplateFormat{
+		TemplateFormat_Text,
+		TemplateFormat_Json,
+		TemplateFormat_Yaml,
+		TemplateFormat_Toml,
+	}
+}
+
+func (format TemplateFormat) IsValid() bool {
+	for _, validFormat := range AllTemplateFormats() {
+		if format == validFormat {
+			return true
+		}
+	}
+	return false
+}
+
+func (format TemplateFormat) validateRenderedContent(renderedContent []byte) error {
+	switch format {
+	case TemplateFormat_Text:
+		return nil
+	case TemplateFormat_Json:
+		var parsedContent interface{}
+		if err := json.Unmarshal(renderedContent, &parsedContent); err != nil {
+			return stacktrace.Propagate(err, "Rendered content is not valid JSON")
+		}
+		return nil
+	case TemplateFormat_Yaml:
+		var parsedContent interface{}
+		if err := yaml.Unmarshal(renderedContent, &parsedContent); err != nil {
+			return stacktrace.Propagate(err, "Rendered content is not valid YAML")
+		}
+		return nil
+	case TemplateFormat_Toml:
+		var parsedContent map[string]interface{}
+		if err := toml.Unmarshal(renderedContent, &parsedContent); err != nil {
+			return stacktrace.Propagate(err, "Rendered content is not valid TOML")
+		}
+		return nil
+	}
+	return stacktrace.NewError("Unrecognized template format '%s'", format)
+}
@@ -0,0 +1,527 @@
+package render_templates
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/pelletier/go-toml/v2"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+)
+
+const (
+	// ArtifactFileFuncName is the name of the template function returning the content of a file inside another files
+	// artifact of the enclave, e.g. {{ artifactFile "genesis" "genesis.json" }}
+	ArtifactFileFuncName = "artifactFile"
+
+	indentChar  = " "
+	newlineChar = "\n"
+)
+
+// FilesArtifactFileReader returns the content of the file at the relative filepath inside the files artifact
+type FilesArtifactFileReader func(filesArtifactName string, relativeFilepath string) ([]byte, error)
+
+// noFilesArtifactFileReader is used when the template is only parsed, never executed
+var noFilesArtifactFileReader FilesArtifactFileReader = func(filesArtifactName string, relativeFilepath string) ([]byte, error) {
+	return nil, stacktrace.NewError("Reading file '%s' from files artifact '%s' isn't possible when the template is only being parsed. This is a Kurtosis bug", relativeFilepath, filesArtifactName)
+}
+
+// newTemplateFuncMap returns the curated list of functions available to all templates rendered by Kurtosis.
+// Names and argument orders follow the Sprig library (used by Helm) wherever possible, so that they look familiar, and
+// the last argument is always the one that can be piped in
+func newTemplateFuncMap(filesArtifactFileReader FilesArtifactFileReader) template.FuncMap {
+	return template.FuncMap{
+		// strings
+		"upper":      strings.ToUpper,
+		"lower":      strings.ToLower,
+		"title":      titleFunc,
+		"trim":       strings.TrimSpace,
+		"trimPrefix": func(prefix string, str string) string { return strings.TrimPrefix(str, prefix) },
+		"trimSuffix": func(suffix string, str string) string { return strings.TrimSuffix(str, suffix) },
+		"replace":    func(old string, new string, str string) string { return strings.ReplaceAll(str, old, new) },
+		"contains":   func(substr string, str string) bool { return strings.Contains(str, substr) },
+		"hasPrefix":  func(prefix string, str string) bool { return strings.HasPrefix(str, prefix) },
+		"hasSuffix":  func(suffix string, str string) bool { return strings.HasSuffix(str, suffix) },
+		"split":      func(separator string, str string) []string { return strings.Split(str, separator) },
+		"join":       joinFunc,
+		"repeat":     func(count int, str string) string { return strings.Repeat(str, count) },
+		"quote":      func(value interface{}) string { return strconv.Quote(toStringFunc(value)) },
+		"squote":     func(value interface{}) string { return fmt.Sprintf("'%s'", toStringFunc(value)) },
+		"indent":     indentFunc,
+		"nindent":    func(spaces int, str string) string { return newlineChar + indentFunc(spaces, str) },
+		"toString":   toStringFunc,
+
+		// default values
+		"default":  defaultFunc,
+		"empty":    isEmpty,
+		"coalesce": coalesceFunc,
+		"required": requiredFunc,
+		"ternary":  ternaryFunc,
+
+		// serialization
+		"toJson":       toJsonFunc,
+		"toPrettyJson": toPrettyJsonFunc,
+		"fromJson":     fromJsonFunc,
+		"toYaml":       toYamlFunc,
+		"toToml":       toTomlFunc,
+
+		// encoding and hashing
+		"b64enc":    func(str string) string { return base64.StdEncoding.EncodeToString([]byte(str)) },
+		"b64dec":    b64decFunc,
+		"sha256sum": sha256sumFunc,
+
+		// arithmetic
+		"add": addFunc,
+		"sub": subFunc,
+		"mul": mulFunc,
+		"div": divFunc,
+		"mod": modFunc,
+		"max": maxFunc,
+		"min": minFunc,
+
+		// collections
+		"list":   func(values ...interface{}) []interface{} { return values },
+		"dict":   dictFunc,
+		"keys":   keysFunc,
+		"hasKey": hasKeyFunc,
+
+		// lookups into other files artifacts
+		ArtifactFileFuncName: func(filesArtifactName string, relativeFilepath string) (string, error) {
+			fileContent, err := filesArtifactFileReader(filesArtifactName, relativeFilepath)
+			if err != nil {
+				return "", stacktrace.Propagate(err, "An error occurred reading file '%s' from files artifact '%s'", relativeFilepath, filesArtifactName)
+			}
+			return string(fileContent), nil
+		},
+	}
+}
+
+// getReferencedFilesArtifactNames walks the parsed template looking for calls to the artifact lookup function, and
+// returns the files artifact names that are passed in as string literals
+func getReferencedFilesArtifactNames(parsedTemplate *template.Template) []string {
+	filesArtifactNames := []string{}
+	for _, definedTemplate := range parsedTemplate.Templates() {
+		if definedTemplate.Tree == nil {
+			continue
+		}
+		filesArtifactNames = append(filesArtifactNames, getReferencedFilesArtifactNamesInNode(definedTemplate.Tree.Root)...)
+	}
+	return filesArtifactNames
+}
+
+func getReferencedFilesArtifactNamesInNode(node parse.Node) []string {
+	filesArtifactNames := []string{}
+	switch typedNode := node.(type) {
+	case *parse.ListNode:
+		if typedNode == nil {
+			return filesArtifactNames
+		}
+		for _, childNode := range typedNode.Nodes {
+			filesArtifactNames = append(filesArtifactNames, getReferencedFilesArtifactNamesInNode(childNode)...)
+		}
+	case *parse.ActionNode:
+		filesArtifactNames = append(filesArtifactNames, getReferencedFilesArtifactNamesInNode(typedNode.Pipe)...)
+	case *parse.PipeNode:
+		if typedNode == nil {
+			return filesArtifactNames
+		}
+		for _, command := range typedNode.Cmds {
+			filesArtifactNames = append(filesArtifactNames, getReferencedFilesArtifactNamesInNode(command)...)
+		}
+	case *parse.CommandNode:
+		if len(typedNode.Args) > 1 {
+			if identifier, isIdentifier := typedNode.Args[0].(*parse.IdentifierNode); isIdentifier && identifier.Ident == ArtifactFileFuncName {
+				if filesArtifactName, isString := typedNode.Args[1].(*parse.StringNode); isString {
+					filesArtifactNames = append(filesArtifactNames, filesArtifactName.Text)
+				}
+			}
+		}
+		for _, argument := range typedNode.Args {
+			filesArtifactNames = append(filesArtifactNames, getReferencedFilesArtifactNamesInNode(argument)...)
+		}
+	case *parse.IfNode:
+		filesArtifactNames = append(filesArtifactNames, getReferencedFilesArtifactNamesInBranch(&typedNode.BranchNode)...)
+	case *parse.RangeNode:
+		filesArtifactNames = append(filesArtifactNames, getReferencedFilesArtifactNamesInBranch(&typedNode.BranchNode)...)
+	case *parse.WithNode:
+		filesArtifactNames = append(filesArtifactNames, getReferencedFilesArtifactNamesInBranch(&typedNode.BranchNode)...)
+	case *parse.TemplateNode:
+		filesArtifactNames = append(filesArtifactNames, getReferencedFilesArtifactNamesInNode(typedNode.Pipe)...)
+	}
+	return filesArtifactNames
+}
+
+func getReferencedFilesArtifactNamesInBranch(branchNode *parse.BranchNode) []string {
+	filesArtifactNames := getReferencedFilesArtifactNamesInNode(branchNode.Pipe)
+	filesArtifactNames = append(filesArtifactNames, getReferencedFilesArtifactNamesInNode(branchNode.List)...)
+	if branchNode.ElseList != nil {
+		filesArtifactNames = append(filesArtifactNames, getReferencedFilesArtifactNamesInNode(branchNode.ElseList)...)
+	}
+	return filesArtifactNames
+}
+
+func titleFunc(str string) string {
+	words := strings.Fields(str)
+	for idx, word := range words {
+		wordRunes := []rune(word)
+		words[idx] = strings.ToUpper(string(wordRunes[0])) + string(wordRunes[1:])
+	}
+	return strings.Join(words, " ")
+}
+
+func joinFunc(separator string, values interface{}) (string, error) {
+	valuesAsList, err := toList(values)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Function 'join' expects a list")
+	}
+	valuesAsStrings := []string{}
+	for _, value := range valuesAsList {
+		valuesAsStrings = append(valuesAsStrings, toStringFunc(value))
+	}
+	return strings.Join(valuesAsStrings, separator), nil
+}
+
+func indentFunc(spaces int, str string) string {
+	padding := strings.Repeat(indentChar, spaces)
+	return padding + strings.ReplaceAll(str, newlineChar, newlineChar+padding)
+}
+
+func toStringFunc(value interface{}) string {
+	switch typedValue := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typedValue
+	case []byte:
+		return string(typedValue)
+	case fmt.Stringer:
+		return typedValue.String()
+	default:
+		return fmt.Sprintf("%v", typedValue)
+	}
+}
+
+func defaultFunc(defaultValue interface{}, maybeValue ...interface{}) interface{} {
+	if len(maybeValue) == 0 || isEmpty(maybeValue[0]) {
+		return defaultValue
+	}
+	return maybeValue[0]
+}
+
+func coalesceFunc(values ...interface{}) interface{} {
+	for _, value := range values {
+		if !isEmpty(value) {
+			return value
+		}
+	}
+	return nil
+}
+
+func requiredFunc(errorMsg string, value interface{}) (interface{}, error) {
+	if isEmpty(value) {
+		return nil, stacktrace.NewError(errorMsg)
+	}
+	return value, nil
+}
+
+func ternaryFunc(valueIfTrue interface{}, valueIfFalse interface{}, condition bool) interface{} {
+	if condition {
+		return valueIfTrue
+	}
+	return valueIfFalse
+}
+
+// isEmpty follows the text/template definition of emptiness: false, 0, nil and empty strings, lists and maps
+func isEmpty(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	if number, isNumber := value.(json.Number); isNumber {
+		floatValue, err := number.Float64()
+		return err == nil && floatValue == 0
+	}
+	reflectValue := reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return reflectValue.Len() == 0
+	case reflect.Bool:
+		return !reflectValue.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflectValue.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflectValue.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return reflectValue.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return reflectValue.IsNil()
+	default:
+		return false
+	}
+}
+
+func toJsonFunc(value interface{}) (string, error) {
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing value '%v' to JSON", value)
+	}
+	return string(jsonBytes), nil
+}
+
+func toPrettyJsonFunc(value interface{}) (string, error) {
+	jsonBytes, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing value '%v' to JSON", value)
+	}
+	return string(jsonBytes), nil
+}
+
+func fromJsonFunc(str string) (interface{}, error) {
+	decodedValue, err := decodeJsonString(str)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing '%s' from JSON", str)
+	}
+	return decodedValue, nil
+}
+
+func toYamlFunc(value interface{}) (string, error) {
+	yamlBytes, err := yaml.Marshal(normalizeJsonNumbers(value))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing value '%v' to YAML", value)
+	}
+	return strings.TrimSuffix(string(yamlBytes), newlineChar), nil
+}
+
+func toTomlFunc(value interface{}) (string, error) {
+	tomlBuffer := &bytes.Buffer{}
+	if err := toml.NewEncoder(tomlBuffer).Encode(normalizeJsonNumbers(value)); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing value '%v' to TOML", value)
+	}
+	return strings.TrimSuffix(tomlBuffer.String(), newlineChar), nil
+}
+
+// normalizeJsonNumbers converts the json.Number values produced when decoding the template data into Go numbers, so
+// that they don't get serialized as strings by the YAML and TOML encoders
+func normalizeJsonNumbers(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case json.Number:
+		if intValue, err := typedValue.Int64(); err == nil {
+			return intValue
+		}
+		if floatValue, err := typedValue.Float64(); err == nil {
+			return floatValue
+		}
+		return typedValue.String()
+	case map[string]interface{}:
+		normalizedMap := map[string]interface{}{}
+		for key, mapValue := range typedValue {
+			normalizedMap[key] = normalizeJsonNumbers(mapValue)
+		}
+		return normalizedMap
+	case []interface{}:
+		normalizedList := []interface{}{}
+		for _, listValue := range typedValue {
+			normalizedList = append(normalizedList, normalizeJsonNumbers(listValue))
+		}
+		return normalizedList
+	default:
+		return value
+	}
+}
+
+func b64decFunc(str string) (string, error) {
+	decodedBytes, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred decoding '%s' from base64", str)
+	}
+	return string(decodedBytes), nil
+}
+
+func sha256sumFunc(str string) string {
+	hash := sha256.Sum256([]byte(str))
+	return hex.EncodeToString(hash[:])
+}
+
+func addFunc(values ...interface{}) (interface{}, error) {
+	return reduceNumbers("add", values, func(a int64, b int64) (int64, error) { return a + b, nil }, func(a float64, b float64) float64 { return a + b })
+}
+
+func subFunc(a interface{}, b interface{}) (interface{}, error) {
+	return reduceNumbers("sub", []interface{}{a, b}, func(a int64, b int64) (int64, error) { return a - b, nil }, func(a float64, b float64) float64 { return a - b })
+}
+
+func mulFunc(values ...interface{}) (interface{}, error) {
+	return reduceNumbers("mul", values, func(a int64, b int64) (int64, error) { return a * b, nil }, func(a float64, b float64) float64 { return a * b })
+}
+
+func divFunc(a interface{}, b interface{}) (interface{}, error) {
+	return reduceNumbers(
+		"div",
+		[]interface{}{a, b},
+		func(a int64, b int64) (int64, error) {
+			if b == 0 {
+				return 0, stacktrace.NewError("Division by zero")
+			}
+			return a / b, nil
+		},
+		func(a float64, b float64) float64 { return a / b },
+	)
+}
+
+func modFunc(a interface{}, b interface{}) (interface{}, error) {
+	return reduceNumbers(
+		"mod",
+		[]interface{}{a, b},
+		func(a int64, b int64) (int64, error) {
+			if b == 0 {
+				return 0, stacktrace.NewError("Modulo by zero")
+			}
+			return a % b, nil
+		},
+		math.Mod,
+	)
+}
+
+func maxFunc(values ...interface{}) (interface{}, error) {
+	return reduceNumbers(
+		"max",
+		values,
+		func(a int64, b int64) (int64, error) {
+			if a > b {
+				return a, nil
+			}
+			return b, nil
+		},
+		math.Max,
+	)
+}
+
+func minFunc(values ...interface{}) (interface{}, error) {
+	return reduceNumbers(
+		"min",
+		values,
+		func(a int64, b int64) (int64, error) {
+			if a < b {
+				return a, nil
+			}
+			return b, nil
+		},
+		math.Min,
+	)
+}
+
+// reduceNumbers applies the operation to all values from left to right. The result is an integer if all values are
+// integers, and a float otherwise
+func reduceNumbers(
+	funcName string,
+	values []interface{},
+	intOperation func(int64, int64) (int64, error),
+	floatOperation func(float64, float64) float64,
+) (interface{}, error) {
+	if len(values) == 0 {
+		return nil, stacktrace.NewError("Function '%s' expects at least one argument", funcName)
+	}
+	areAllIntegers := true
+	intValues := []int64{}
+	floatValues := []float64{}
+	for _, value := range values {
+		intValue, floatValue, isInteger, err := toNumber(value)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Function '%s' expects numeric arguments", funcName)
+		}
+		areAllIntegers = areAllIntegers && isInteger
+		intValues = append(intValues, intValue)
+		floatValues = append(floatValues, floatValue)
+	}
+
+	if areAllIntegers {
+		intResult := intValues[0]
+		for _, intValue := range intValues[1:] {
+			var err error
+			intResult, err = intOperation(intResult, intValue)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred evaluating function '%s'", funcName)
+			}
+		}
+		return intResult, nil
+	}
+	floatResult := floatValues[0]
+	for _, floatValue := range floatValues[1:] {
+		floatResult = floatOperation(floatResult, floatValue)
+	}
+	return floatResult, nil
+}
+
+func toNumber(value interface{}) (int64, float64, bool, error) {
+	switch typedValue := value.(type) {
+	case json.Number:
+		if intValue, err := typedValue.Int64(); err == nil {
+			return intValue, float64(intValue), true, nil
+		}
+		floatValue, err := typedValue.Float64()
+		if err != nil {
+			return 0, 0, false, stacktrace.Propagate(err, "Value '%v' is not a number", typedValue)
+		}
+		return 0, floatValue, false, nil
+	case string:
+		return toNumber(json.Number(typedValue))
+	}
+	reflectValue := reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflectValue.Int(), float64(reflectValue.Int()), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(reflectValue.Uint()), float64(reflectValue.Uint()), true, nil
+	case reflect.Float32, reflect.Float64:
+		return 0, reflectValue.Float(), false, nil
+	default:
+		return 0, 0, false, stacktrace.NewError("Value '%v' of type '%T' is not a number", value, value)
+	}
+}
+
+func toList(value interface{}) ([]interface{}, error) {
+	reflectValue := reflect.ValueOf(value)
+	if reflectValue.Kind() != reflect.Slice && reflectValue.Kind() != reflect.Array {
+		return nil, stacktrace.NewError("Value '%v' of type '%T' is not a list", value, value)
+	}
+	list := []interface{}{}
+	for idx := 0; idx < reflectValue.Len(); idx++ {
+		list = append(list, reflectValue.Index(idx).Interface())
+	}
+	return list, nil
+}
+
+func dictFunc(keysAndValues ...interface{}) (map[string]interface{}, error) {
+	if len(keysAndValues)%2 != 0 {
+		return nil, stacktrace.NewError("Function 'dict' expects an even number of arguments, got '%d'", len(keysAndValues))
+	}
+	dict := map[string]interface{}{}
+	for idx := 0; idx < len(keysAndValues); idx += 2 {
+		dict[toStringFunc(keysAndValues[idx])] = keysAndValues[idx+1]
+	}
+	return dict, nil
+}
+
+func keysFunc(dict map[string]interface{}) []string {
+	keys := []string{}
+	for key := range dict {
+		keys = append(keys, key)
+	}
+	// sorting keeps the rendered output deterministic, which matters for the idempotent runs
+	sort.Strings(keys)
+	return keys
+}
+
+func hasKeyFunc(dict map[string]interface{}, key string) bool {
+	_, found := dict[key]
+	return found
+}
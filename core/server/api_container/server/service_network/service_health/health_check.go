@@ -0,0 +1,44 @@
+package service_health
+
+import (
+	"context"
+	"time"
+)
+
+// HealthProbe returns an error if the service isn't healthy
+type HealthProbe func(ctx context.Context) error
+
+// HealthCheck is run against a service for as long as its container is running, once every interval
+type HealthCheck struct {
+	probe HealthProbe
+
+	interval time.Duration
+
+	// How long a single run of the probe can take before it counts as a failure
+	timeout time.Duration
+
+	// Number of failures in a row after which the service is unhealthy
+	failureThreshold uint32
+}
+
+func NewHealthCheck(probe HealthProbe, interval time.Duration, timeout time.Duration, failureThreshold uint32) *HealthCheck {
+	return &HealthCheck{probe: probe, interval: interval, timeout: timeout, failureThreshold: failureThreshold}
+}
+
+func (check *HealthCheck) GetInterval() time.Duration {
+	return check.interval
+}
+
+func (check *HealthCheck) GetTimeout() time.Duration {
+	return check.timeout
+}
+
+func (check *HealthCheck) GetFailureThreshold() uint32 {
+	return check.failureThreshold
+}
+
+func (check *HealthCheck) run(ctx context.Context) error {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, check.timeout)
+	defer cancel()
+	return check.probe(ctxWithTimeout)
+}
@@ -0,0 +1,72 @@
+package service_health
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"time"
+)
+
+type ServiceHealth struct {
+	status ServiceHealthStatus
+
+	// Nil unless the service crashed
+	maybeExitCode *int32
+
+	// Number of times the container runtime restarted the container of the service after it exited
+	restartCount uint32
+
+	// Why the container of the service last exited, empty if it never exited
+	lastExitReason string
+
+	// Why the service is in this status, e.g. the error of the last failed health check
+	message string
+
+	// When the service got into this status
+	since time.Time
+}
+
+func NewServiceHealth(status ServiceHealthStatus, maybeExitCode *int32, restartCount uint32, lastExitReason string, message string, since time.Time) *ServiceHealth {
+	return &ServiceHealth{status: status, maybeExitCode: maybeExitCode, restartCount: restartCount, lastExitReason: lastExitReason, message: message, since: since}
+}
+
+func (health *ServiceHealth) GetStatus() ServiceHealthStatus {
+	return health.status
+}
+
+func (health *ServiceHealth) GetMaybeExitCode() *int32 {
+	return health.maybeExitCode
+}
+
+func (health *ServiceHealth) GetRestartCount() uint32 {
+	return health.restartCount
+}
+
+func (health *ServiceHealth) GetLastExitReason() string {
+	return health.lastExitReason
+}
+
+func (health *ServiceHealth) GetMessage() string {
+	return health.message
+}
+
+func (health *ServiceHealth) GetSince() time.Time {
+	return health.since
+}
+
+// ServiceHealthEvent is sent to the subscribers of the monitor every time the health of a service changes
+type ServiceHealthEvent struct {
+	serviceName service.ServiceName
+
+	health *ServiceHealth
+}
+
+func NewServiceHealthEvent(serviceName service.ServiceName, health *ServiceHealth) *ServiceHealthEvent {
+	return &ServiceHealthEvent{serviceName: serviceName, health: health}
+}
+
+func (event *ServiceHealthEvent) GetServiceName() service.ServiceName {
+	return event.serviceName
+}
+
+func (event *ServiceHealthEvent) GetHealth() *ServiceHealth {
+	return event.health
+}
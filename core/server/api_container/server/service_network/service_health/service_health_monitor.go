@@ -0,0 +1,381 @@
+package service_health
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+const (
+	// How often the monitor looks at the containers of the services and runs the health checks which are due; it's
+	// the finest interval a health check can effectively have
+	monitorTickInterval = 1 * time.Second
+
+	// Events are dropped for subscribers which are this far behind, rather than blocking the monitor
+	subscriberChanBufferSize = 100
+
+	serviceStoppedMessage    = "The service is stopped"
+	serviceNotStartedMessage = "The service is registered but wasn't started yet"
+)
+
+// ServicesGetter returns the services of the enclave, with the current status of their containers
+type ServicesGetter func(ctx context.Context) (map[service.ServiceUUID]*service.Service, error)
+
+// CrashLoopingServiceStopper stops a service whose container keeps crashing if it was restarted as many times as the
+// max retries of its restart policy, and returns whether it did. Not every container engine can limit the restarts.
+type CrashLoopingServiceStopper func(ctx context.Context, serviceName service.ServiceName, restartCount uint32) (bool, error)
+
+// stoppedCrashLoopingService is what the monitor remembers about a service it stopped as it kept crashing
+type stoppedCrashLoopingService struct {
+	restartCount uint32
+
+	lastExitReason string
+}
+
+// probeState is what the monitor remembers about the last runs of the health check of a service
+type probeState struct {
+	lastRunTime time.Time
+
+	consecutiveFailures uint32
+
+	// Nil if the last run passed
+	lastErr error
+
+	isRunning bool
+}
+
+// ServiceHealthMonitor keeps track of the health of the services for as long as the enclave lives. A service whose
+// container exited without it being stopped is crashed, and a service with a health check is unhealthy once the
+// check failed as many times in a row as its failure threshold.
+type ServiceHealthMonitor struct {
+	getServices ServicesGetter
+
+	stopCrashLoopingService CrashLoopingServiceStopper
+
+	healthChecks map[service.ServiceName]*HealthCheck
+
+	probeStates map[service.ServiceName]*probeState
+
+	// Last health sent to the subscribers, by service name
+	healths map[service.ServiceName]*ServiceHealth
+
+	// Services stopped by the monitor, which stay crashed until they're started again
+	stoppedCrashLoopingServices map[service.ServiceName]*stoppedCrashLoopingService
+
+	subscribers map[uint32]chan *ServiceHealthEvent
+
+	nextSubscriberId uint32
+
+	mutex *sync.Mutex
+}
+
+func NewServiceHealthMonitor(getServices ServicesGetter, stopCrashLoopingService CrashLoopingServiceStopper) *ServiceHealthMonitor {
+	return &ServiceHealthMonitor{
+		getServices:                 getServices,
+		stopCrashLoopingService:     stopCrashLoopingService,
+		healthChecks:                map[service.ServiceName]*HealthCheck{},
+		probeStates:                 map[service.ServiceName]*probeState{},
+		healths:                     map[service.ServiceName]*ServiceHealth{},
+		stoppedCrashLoopingServices: map[service.ServiceName]*stoppedCrashLoopingService{},
+		subscribers:                 map[uint32]chan *ServiceHealthEvent{},
+		nextSubscriberId:            0,
+		mutex:                       &sync.Mutex{},
+	}
+}
+
+// SetHealthCheck replaces the health check of the service, if it already had one; a nil health check removes it
+func (monitor *ServiceHealthMonitor) SetHealthCheck(serviceName service.ServiceName, healthCheck *HealthCheck) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	delete(monitor.probeStates, serviceName)
+	if healthCheck == nil {
+		delete(monitor.healthChecks, serviceName)
+		return
+	}
+	monitor.healthChecks[serviceName] = healthCheck
+}
+
+// GetHealthCheck returns the health check of the service, or nil if it doesn't have one
+func (monitor *ServiceHealthMonitor) GetHealthCheck(serviceName service.ServiceName) *HealthCheck {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	return monitor.healthChecks[serviceName]
+}
+
+// RemoveService forgets everything about the service, e.g. because it was removed from the enclave
+func (monitor *ServiceHealthMonitor) RemoveService(serviceName service.ServiceName) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	delete(monitor.healthChecks, serviceName)
+	delete(monitor.probeStates, serviceName)
+	delete(monitor.healths, serviceName)
+	delete(monitor.stoppedCrashLoopingServices, serviceName)
+}
+
+// GetServiceHealth returns the health of the service, based on the current status of its container and the last runs
+// of its health check
+func (monitor *ServiceHealthMonitor) GetServiceHealth(serviceObj *service.Service) *ServiceHealth {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	return monitor.getServiceHealthUnlocked(serviceObj, time.Now())
+}
+
+// Subscribe returns a channel receiving an event every time the health of a service changes, and the function to
+// call to unsubscribe, which closes the channel
+func (monitor *ServiceHealthMonitor) Subscribe() (<-chan *ServiceHealthEvent, func()) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	subscriberId := monitor.nextSubscriberId
+	monitor.nextSubscriberId++
+	eventsChan := make(chan *ServiceHealthEvent, subscriberChanBufferSize)
+	monitor.subscribers[subscriberId] = eventsChan
+
+	unsubscribe := func() {
+		monitor.mutex.Lock()
+		defer monitor.mutex.Unlock()
+		if _, found := monitor.subscribers[subscriberId]; !found {
+			return
+		}
+		delete(monitor.subscribers, subscriberId)
+		close(eventsChan)
+	}
+	return eventsChan, unsubscribe
+}
+
+// Run checks the services until the context is cancelled
+func (monitor *ServiceHealthMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(monitorTickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			monitor.checkServices(ctx, time.Now())
+		}
+	}
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func (monitor *ServiceHealthMonitor) checkServices(ctx context.Context, now time.Time) {
+	services, err := monitor.getServices(ctx)
+	if err != nil {
+		logrus.Debugf("An error occurred getting the services to check their health, the check will be skipped:\n%v", err)
+		return
+	}
+
+	// Stopping the services is done without holding the lock too, as it goes through the container engine
+	justStoppedServices := monitor.stopServicesCrashLoopingPastMaxRetries(ctx, services)
+
+	// The health checks are run without holding the lock, as they can take up to their timeout
+	dueHealthChecks := monitor.getDueHealthChecks(services, now)
+	waitGroup := &sync.WaitGroup{}
+	for serviceName, healthCheck := range dueHealthChecks {
+		waitGroup.Add(1)
+		go func(serviceName service.ServiceName, healthCheck *HealthCheck) {
+			defer waitGroup.Done()
+			monitor.recordHealthCheckResult(serviceName, healthCheck, healthCheck.run(ctx))
+		}(serviceName, healthCheck)
+	}
+	waitGroup.Wait()
+
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	for _, serviceObj := range services {
+		serviceName := serviceObj.GetRegistration().GetName()
+		if _, isJustStopped := justStoppedServices[serviceName]; !isJustStopped && serviceObj.GetRegistration().GetStatus() == service.ServiceStatus_Started {
+			// The service was started again since the monitor stopped it
+			delete(monitor.stoppedCrashLoopingServices, serviceName)
+		}
+		health := monitor.getServiceHealthUnlocked(serviceObj, now)
+		previousHealth, found := monitor.healths[serviceName]
+		if found && !hasHealthChanged(previousHealth, health) {
+			continue
+		}
+		monitor.healths[serviceName] = health
+		monitor.publishUnlocked(NewServiceHealthEvent(serviceName, health))
+	}
+}
+
+// stopServicesCrashLoopingPastMaxRetries returns the services it stopped
+func (monitor *ServiceHealthMonitor) stopServicesCrashLoopingPastMaxRetries(ctx context.Context, services map[service.ServiceUUID]*service.Service) map[service.ServiceName]bool {
+	stoppedServices := map[service.ServiceName]bool{}
+	for _, serviceObj := range services {
+		serviceContainer := serviceObj.GetContainer()
+		if serviceObj.GetRegistration().GetStatus() != service.ServiceStatus_Started || serviceContainer == nil || !serviceContainer.IsCrashLooping() {
+			continue
+		}
+		serviceName := serviceObj.GetRegistration().GetName()
+		isStopped, err := monitor.stopCrashLoopingService(ctx, serviceName, serviceContainer.GetRestartCount())
+		if err != nil {
+			logrus.Warnf("An error occurred stopping service '%v' which keeps crashing, it will be tried again:\n%v", serviceName, err)
+			continue
+		}
+		if !isStopped {
+			continue
+		}
+		logrus.Infof("Stopped service '%v' as it kept crashing after being restarted %d time(s)", serviceName, serviceContainer.GetRestartCount())
+		stoppedServices[serviceName] = true
+		monitor.mutex.Lock()
+		monitor.stoppedCrashLoopingServices[serviceName] = &stoppedCrashLoopingService{
+			restartCount:   serviceContainer.GetRestartCount(),
+			lastExitReason: serviceContainer.GetLastExitReason(),
+		}
+		monitor.mutex.Unlock()
+	}
+	return stoppedServices
+}
+
+func (monitor *ServiceHealthMonitor) getDueHealthChecks(services map[service.ServiceUUID]*service.Service, now time.Time) map[service.ServiceName]*HealthCheck {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	dueHealthChecks := map[service.ServiceName]*HealthCheck{}
+	for _, serviceObj := range services {
+		serviceName := serviceObj.GetRegistration().GetName()
+		healthCheck, found := monitor.healthChecks[serviceName]
+		if !found {
+			continue
+		}
+		if !isServiceContainerRunning(serviceObj) {
+			// The service starts from a clean slate once its container runs again
+			delete(monitor.probeStates, serviceName)
+			continue
+		}
+		state, found := monitor.probeStates[serviceName]
+		if !found {
+			state = &probeState{
+				lastRunTime:         time.Time{},
+				consecutiveFailures: 0,
+				lastErr:             nil,
+				isRunning:           false,
+			}
+			monitor.probeStates[serviceName] = state
+		}
+		if state.isRunning || now.Sub(state.lastRunTime) < healthCheck.GetInterval() {
+			continue
+		}
+		state.isRunning = true
+		state.lastRunTime = now
+		dueHealthChecks[serviceName] = healthCheck
+	}
+	return dueHealthChecks
+}
+
+func (monitor *ServiceHealthMonitor) recordHealthCheckResult(serviceName service.ServiceName, healthCheck *HealthCheck, healthCheckErr error) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	state, found := monitor.probeStates[serviceName]
+	if !found || monitor.healthChecks[serviceName] != healthCheck {
+		// The service was removed, or its health check replaced, while the check was running
+		return
+	}
+	state.isRunning = false
+	state.lastErr = healthCheckErr
+	if healthCheckErr == nil {
+		state.consecutiveFailures = 0
+		return
+	}
+	state.consecutiveFailures++
+	logrus.Debugf("Health check of service '%v' failed %d time(s) in a row:\n%v", serviceName, state.consecutiveFailures, healthCheckErr)
+}
+
+func (monitor *ServiceHealthMonitor) getServiceHealthUnlocked(serviceObj *service.Service, now time.Time) *ServiceHealth {
+	serviceName := serviceObj.GetRegistration().GetName()
+	health := monitor.getServiceHealthWithoutSinceUnlocked(serviceObj)
+
+	// The health keeps the time it first got into its status, as long as it stays in it
+	since := now
+	if previousHealth, found := monitor.healths[serviceName]; found && previousHealth.GetStatus() == health.GetStatus() {
+		since = previousHealth.GetSince()
+	}
+	return NewServiceHealth(health.GetStatus(), health.GetMaybeExitCode(), health.GetRestartCount(), health.GetLastExitReason(), health.GetMessage(), since)
+}
+
+func (monitor *ServiceHealthMonitor) getServiceHealthWithoutSinceUnlocked(serviceObj *service.Service) *ServiceHealth {
+	serviceName := serviceObj.GetRegistration().GetName()
+	serviceContainer := serviceObj.GetContainer()
+	var maybeExitCode *int32
+	var restartCount uint32
+	var lastExitReason string
+	if serviceContainer != nil {
+		maybeExitCode = serviceContainer.GetMaybeExitCode()
+		restartCount = serviceContainer.GetRestartCount()
+		lastExitReason = serviceContainer.GetLastExitReason()
+	}
+
+	switch serviceObj.GetRegistration().GetStatus() {
+	case service.ServiceStatus_Stopped:
+		if stoppedService, found := monitor.stoppedCrashLoopingServices[serviceName]; found {
+			message := fmt.Sprintf("Kurtosis stopped the service as it kept crashing after being restarted %d time(s), the max retries of its restart policy", stoppedService.restartCount)
+			return NewServiceHealth(ServiceHealthStatus_Crashed, nil, stoppedService.restartCount, stoppedService.lastExitReason, message, time.Time{})
+		}
+		return NewServiceHealth(ServiceHealthStatus_Unknown, nil, restartCount, lastExitReason, serviceStoppedMessage, time.Time{})
+	case service.ServiceStatus_Registered:
+		return NewServiceHealth(ServiceHealthStatus_Unknown, nil, restartCount, lastExitReason, serviceNotStartedMessage, time.Time{})
+	case service.ServiceStatus_Started:
+		// Handled below
+	}
+
+	if serviceContainer != nil && serviceContainer.IsCrashLooping() {
+		message := fmt.Sprintf("The container of the service keeps crashing, it was restarted %d time(s)", restartCount)
+		return NewServiceHealth(ServiceHealthStatus_Crashed, maybeExitCode, restartCount, lastExitReason, message, time.Time{})
+	}
+	if !isServiceContainerRunning(serviceObj) {
+		message := "The container of the service exited although the service wasn't stopped"
+		if maybeExitCode != nil {
+			message = fmt.Sprintf("The container of the service exited with code %d although the service wasn't stopped", *maybeExitCode)
+		}
+		return NewServiceHealth(ServiceHealthStatus_Crashed, maybeExitCode, restartCount, lastExitReason, message, time.Time{})
+	}
+
+	healthCheck, found := monitor.healthChecks[serviceName]
+	if !found {
+		return NewServiceHealth(ServiceHealthStatus_Healthy, nil, restartCount, lastExitReason, "", time.Time{})
+	}
+	state, found := monitor.probeStates[serviceName]
+	if found && state.consecutiveFailures >= healthCheck.GetFailureThreshold() {
+		message := fmt.Sprintf("The health check failed %d time(s) in a row", state.consecutiveFailures)
+		if state.lastErr != nil {
+			message = fmt.Sprintf("%s, last error: %v", message, state.lastErr)
+		}
+		return NewServiceHealth(ServiceHealthStatus_Unhealthy, nil, restartCount, lastExitReason, message, time.Time{})
+	}
+	return NewServiceHealth(ServiceHealthStatus_Healthy, nil, restartCount, lastExitReason, "", time.Time{})
+}
+
+// publishUnlocked never blocks; subscribers which aren't keeping up miss events
+func (monitor *ServiceHealthMonitor) publishUnlocked(event *ServiceHealthEvent) {
+	for subscriberId, eventsChan := range monitor.subscribers {
+		select {
+		case eventsChan <- event:
+		default:
+			logrus.Warnf("Subscriber '%d' to the health of the services is too far behind, the health event of service '%v' was dropped", subscriberId, event.GetServiceName())
+		}
+	}
+}
+
+func isServiceContainerRunning(serviceObj *service.Service) bool {
+	serviceContainer := serviceObj.GetContainer()
+	return serviceContainer != nil && serviceContainer.GetStatus() == container.ContainerStatus_Running
+}
+
+// hasHealthChanged ignores the message, so that a failing health check doesn't send an event on every run
+func hasHealthChanged(previousHealth *ServiceHealth, health *ServiceHealth) bool {
+	if previousHealth.GetStatus() != health.GetStatus() || previousHealth.GetRestartCount() != health.GetRestartCount() || previousHealth.GetLastExitReason() != health.GetLastExitReason() {
+		return true
+	}
+	previousExitCode := previousHealth.GetMaybeExitCode()
+	exitCode := health.GetMaybeExitCode()
+	if previousExitCode == nil || exitCode == nil {
+		return previousExitCode != exitCode
+	}
+	return *previousExitCode != *exitCode
+}
@@ -0,0 +1,193 @@
+package service_health
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"net"
+	"testing"
+	"time"
+)
+
+const (
+	testServiceName service.ServiceName = "test-service"
+	testServiceUuid service.ServiceUUID = "cddc2ea3948149d9afa2ef93abb4ec56"
+	testEnclaveUuid enclave.EnclaveUUID = "enclave-uuid"
+
+	testHealthCheckInterval = 10 * time.Second
+	testHealthCheckTimeout  = 1 * time.Second
+	testFailureThreshold    = 2
+
+	testLastExitReason = "OOMKilled"
+	testMaxRetries     = 3
+)
+
+func TestCheckServices_HealthyWithoutHealthCheck(t *testing.T) {
+	services := map[service.ServiceUUID]*service.Service{}
+	monitor := NewServiceHealthMonitor(getServicesForTest(services), neverStopServiceForTest)
+	eventsChan, unsubscribe := monitor.Subscribe()
+	defer unsubscribe()
+
+	services[testServiceUuid] = newServiceForTest(service.ServiceStatus_Started, container.ContainerStatus_Running, nil, 0)
+	monitor.checkServices(context.Background(), time.Now())
+	event := requireEvent(t, eventsChan)
+	require.Equal(t, testServiceName, event.GetServiceName())
+	require.Equal(t, ServiceHealthStatus_Healthy, event.GetHealth().GetStatus())
+
+	// nothing changed, so no new event
+	monitor.checkServices(context.Background(), time.Now())
+	require.Empty(t, eventsChan)
+}
+
+func TestCheckServices_CrashedWithExitCodeAndRestartCount(t *testing.T) {
+	exitCode := int32(137)
+	services := map[service.ServiceUUID]*service.Service{
+		testServiceUuid: newServiceForTest(service.ServiceStatus_Started, container.ContainerStatus_Stopped, &exitCode, 3),
+	}
+	monitor := NewServiceHealthMonitor(getServicesForTest(services), neverStopServiceForTest)
+	eventsChan, unsubscribe := monitor.Subscribe()
+	defer unsubscribe()
+
+	monitor.checkServices(context.Background(), time.Now())
+	health := requireEvent(t, eventsChan).GetHealth()
+	require.Equal(t, ServiceHealthStatus_Crashed, health.GetStatus())
+	require.Equal(t, exitCode, *health.GetMaybeExitCode())
+	require.Equal(t, uint32(3), health.GetRestartCount())
+}
+
+func TestCheckServices_CrashLoopingServiceIsCrashed(t *testing.T) {
+	services := map[service.ServiceUUID]*service.Service{
+		testServiceUuid: newCrashLoopingServiceForTest(2),
+	}
+	monitor := NewServiceHealthMonitor(getServicesForTest(services), neverStopServiceForTest)
+	eventsChan, unsubscribe := monitor.Subscribe()
+	defer unsubscribe()
+
+	monitor.checkServices(context.Background(), time.Now())
+	health := requireEvent(t, eventsChan).GetHealth()
+	require.Equal(t, ServiceHealthStatus_Crashed, health.GetStatus())
+	require.Equal(t, uint32(2), health.GetRestartCount())
+	require.Equal(t, testLastExitReason, health.GetLastExitReason())
+}
+
+func TestCheckServices_StopsServiceCrashLoopingPastMaxRetries(t *testing.T) {
+	services := map[service.ServiceUUID]*service.Service{
+		testServiceUuid: newCrashLoopingServiceForTest(testMaxRetries),
+	}
+	stopServiceForTest := func(ctx context.Context, serviceName service.ServiceName, restartCount uint32) (bool, error) {
+		if restartCount < testMaxRetries {
+			return false, nil
+		}
+		services[testServiceUuid] = newServiceForTest(service.ServiceStatus_Stopped, container.ContainerStatus_Stopped, nil, 0)
+		return true, nil
+	}
+	monitor := NewServiceHealthMonitor(getServicesForTest(services), stopServiceForTest)
+
+	monitor.checkServices(context.Background(), time.Now())
+	// the service stays crashed once it's stopped, rather than being reported as stopped by the user
+	monitor.checkServices(context.Background(), time.Now())
+	health := monitor.GetServiceHealth(services[testServiceUuid])
+	require.Equal(t, ServiceHealthStatus_Crashed, health.GetStatus())
+	require.Equal(t, uint32(testMaxRetries), health.GetRestartCount())
+	require.Equal(t, testLastExitReason, health.GetLastExitReason())
+
+	// until it's started again
+	services[testServiceUuid] = newServiceForTest(service.ServiceStatus_Started, container.ContainerStatus_Running, nil, 0)
+	monitor.checkServices(context.Background(), time.Now())
+	require.Equal(t, ServiceHealthStatus_Healthy, monitor.GetServiceHealth(services[testServiceUuid]).GetStatus())
+}
+
+func TestGetServiceHealth_StoppedServiceIsUnknown(t *testing.T) {
+	exitCode := int32(0)
+	monitor := NewServiceHealthMonitor(getServicesForTest(map[service.ServiceUUID]*service.Service{}), neverStopServiceForTest)
+	health := monitor.GetServiceHealth(newServiceForTest(service.ServiceStatus_Stopped, container.ContainerStatus_Stopped, &exitCode, 0))
+	require.Equal(t, ServiceHealthStatus_Unknown, health.GetStatus())
+	require.Nil(t, health.GetMaybeExitCode())
+}
+
+func TestCheckServices_UnhealthyAfterFailureThreshold(t *testing.T) {
+	services := map[service.ServiceUUID]*service.Service{
+		testServiceUuid: newServiceForTest(service.ServiceStatus_Started, container.ContainerStatus_Running, nil, 0),
+	}
+	monitor := NewServiceHealthMonitor(getServicesForTest(services), neverStopServiceForTest)
+	var probeErr error
+	numProbeRuns := 0
+	monitor.SetHealthCheck(testServiceName, NewHealthCheck(func(ctx context.Context) error {
+		numProbeRuns++
+		return probeErr
+	}, testHealthCheckInterval, testHealthCheckTimeout, testFailureThreshold))
+	eventsChan, unsubscribe := monitor.Subscribe()
+	defer unsubscribe()
+
+	now := time.Now()
+	monitor.checkServices(context.Background(), now)
+	require.Equal(t, ServiceHealthStatus_Healthy, requireEvent(t, eventsChan).GetHealth().GetStatus())
+
+	// the health check isn't due yet
+	probeErr = stacktrace.NewError("Connection refused")
+	monitor.checkServices(context.Background(), now.Add(testHealthCheckInterval/2))
+	require.Equal(t, 1, numProbeRuns)
+
+	// one failure is below the threshold
+	now = now.Add(testHealthCheckInterval)
+	monitor.checkServices(context.Background(), now)
+	require.Equal(t, 2, numProbeRuns)
+	require.Empty(t, eventsChan)
+
+	now = now.Add(testHealthCheckInterval)
+	monitor.checkServices(context.Background(), now)
+	health := requireEvent(t, eventsChan).GetHealth()
+	require.Equal(t, ServiceHealthStatus_Unhealthy, health.GetStatus())
+	require.Contains(t, health.GetMessage(), "Connection refused")
+
+	probeErr = nil
+	now = now.Add(testHealthCheckInterval)
+	monitor.checkServices(context.Background(), now)
+	require.Equal(t, ServiceHealthStatus_Healthy, requireEvent(t, eventsChan).GetHealth().GetStatus())
+}
+
+func TestSubscribe_UnsubscribeClosesChannel(t *testing.T) {
+	monitor := NewServiceHealthMonitor(getServicesForTest(map[service.ServiceUUID]*service.Service{}), neverStopServiceForTest)
+	eventsChan, unsubscribe := monitor.Subscribe()
+	unsubscribe()
+	unsubscribe()
+	_, isChanOpen := <-eventsChan
+	require.False(t, isChanOpen)
+}
+
+func getServicesForTest(services map[service.ServiceUUID]*service.Service) ServicesGetter {
+	return func(ctx context.Context) (map[service.ServiceUUID]*service.Service, error) {
+		return services, nil
+	}
+}
+
+func newServiceForTest(serviceStatus service.ServiceStatus, containerStatus container.ContainerStatus, maybeExitCode *int32, restartCount uint32) *service.Service {
+	registration := service.NewServiceRegistration(testServiceName, testServiceUuid, testEnclaveUuid, net.IP{}, string(testServiceName))
+	registration.SetStatus(serviceStatus)
+	serviceContainer := container.NewContainerWithExitInfo(containerStatus, "", nil, nil, nil, maybeExitCode, restartCount, "", false)
+	return service.NewService(registration, nil, nil, nil, serviceContainer)
+}
+
+func newCrashLoopingServiceForTest(restartCount uint32) *service.Service {
+	registration := service.NewServiceRegistration(testServiceName, testServiceUuid, testEnclaveUuid, net.IP{}, string(testServiceName))
+	registration.SetStatus(service.ServiceStatus_Started)
+	serviceContainer := container.NewContainerWithExitInfo(container.ContainerStatus_Running, "", nil, nil, nil, nil, restartCount, testLastExitReason, true)
+	return service.NewService(registration, nil, nil, nil, serviceContainer)
+}
+
+func neverStopServiceForTest(ctx context.Context, serviceName service.ServiceName, restartCount uint32) (bool, error) {
+	return false, nil
+}
+
+func requireEvent(t *testing.T, eventsChan <-chan *ServiceHealthEvent) *ServiceHealthEvent {
+	select {
+	case event := <-eventsChan:
+		return event
+	default:
+		require.Fail(t, "Expected a health event but there was none")
+		return nil
+	}
+}
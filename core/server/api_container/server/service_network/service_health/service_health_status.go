@@ -0,0 +1,21 @@
+package service_health
+
+//go:generate go run github.com/dmarkham/enumer -trimprefix "ServiceHealthStatus_" -type=ServiceHealthStatus
+type ServiceHealthStatus uint8
+
+const (
+	// ServiceHealthStatus_Unknown means the health of the service isn't known, e.g. because it was stopped on purpose
+	// or it wasn't checked yet
+	ServiceHealthStatus_Unknown ServiceHealthStatus = iota
+
+	// ServiceHealthStatus_Healthy means the container of the service is running and, if the service has a health
+	// check, the last one passed
+	ServiceHealthStatus_Healthy
+
+	// ServiceHealthStatus_Unhealthy means the container of the service is running but its health check failed as many
+	// times in a row as its failure threshold
+	ServiceHealthStatus_Unhealthy
+
+	// ServiceHealthStatus_Crashed means the container of the service exited although the service wasn't stopped
+	ServiceHealthStatus_Crashed
+)
@@ -5,7 +5,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_dns"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"io"
@@ -96,14 +99,55 @@ type ServiceNetwork interface {
 
 	GetServices(ctx context.Context) (map[service.ServiceUUID]*service.Service, error)
 
+	// GetServicesStats samples the resource usage of the services matching the identifiers, or of all the services if
+	// there are none, keyed by service name. Services which can't be sampled, e.g. because they're stopped, are returned
+	// in the second map
+	GetServicesStats(
+		ctx context.Context,
+		serviceIdentifiers map[string]bool,
+	) (
+		map[service.ServiceName]*service.ServiceStats,
+		map[service.ServiceName]error,
+		error,
+	)
+
+	// SetServiceHealthCheck makes the health of the service depend on the health check, which is run for as long as
+	// the container of the service is running
+	SetServiceHealthCheck(serviceName service.ServiceName, healthCheck *service_health.HealthCheck)
+
+	// GetServiceHealth tells whether the service is healthy, unhealthy or crashed, based on the current status of its
+	// container and the last runs of its health check
+	GetServiceHealth(serviceObj *service.Service) *service_health.ServiceHealth
+
+	// SubscribeToServiceHealthEvents returns a channel receiving an event every time the health of a service changes,
+	// and the function to call to unsubscribe
+	SubscribeToServiceHealthEvents() (<-chan *service_health.ServiceHealthEvent, func())
+
 	CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
 
 	GetServiceNames() (map[service.ServiceName]bool, error)
 
+	GetServicesPersistentDirectories() (map[service.ServiceName]*service_directory.PersistentDirectories, error)
+
+	// GetServiceConfigs returns the config each registered service was added with
+	GetServiceConfigs() (map[service.ServiceName]*service.ServiceConfig, error)
+
 	GetExistingAndHistoricalServiceIdentifiers() (service_identifiers.ServiceIdentifiers, error)
 
 	ExistServiceRegistration(serviceName service.ServiceName) (bool, error)
 
+	// GetServiceReplicaNames returns the names of the replicas of the replicated service, sorted by replica index; it's
+	// empty if the service has no replicas
+	GetServiceReplicaNames(replicaGroup service.ServiceName) ([]service.ServiceName, error)
+
+	// ScaleService adds or removes replicas of the replicated service until it has the given number of replicas. New
+	// replicas get the config and health check of the existing ones. Returns the replicas which were added
+	ScaleService(ctx context.Context, replicaGroup service.ServiceName, replicas int) (map[service.ServiceName]*service.Service, error)
+
+	// AddDnsRecord adds the record to the enclave DNS server. Only the services started once the enclave has DNS
+	// records resolve hostnames with it
+	AddDnsRecord(record *enclave_dns.DnsRecord) error
+
 	RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
 
 	UploadFilesArtifact(data io.Reader, contentMd5 []byte, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
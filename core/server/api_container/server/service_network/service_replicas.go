@@ -0,0 +1,44 @@
+package service_network
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+)
+
+const (
+	// Replicas of a service are named after it, suffixed with their index, e.g. 'api-0', 'api-1'
+	replicaNameFormat    = "%s-%d"
+	replicaNameSeparator = "-"
+)
+
+// GetReplicaName returns the name of the replica with the given index of the replicated service
+func GetReplicaName(replicaGroup service.ServiceName, replicaIndex int) service.ServiceName {
+	return service.ServiceName(fmt.Sprintf(replicaNameFormat, replicaGroup, replicaIndex))
+}
+
+// GetReplicaIndex returns the index of the replica out of its name, or false if the name isn't the name of a replica
+// of the replicated service
+func GetReplicaIndex(replicaGroup service.ServiceName, replicaName service.ServiceName) (int, bool) {
+	replicaIndexStr, found := strings.CutPrefix(string(replicaName), string(replicaGroup)+replicaNameSeparator)
+	if !found {
+		return 0, false
+	}
+	replicaIndex, err := strconv.Atoi(replicaIndexStr)
+	if err != nil || replicaIndex < 0 || GetReplicaName(replicaGroup, replicaIndex) != replicaName {
+		return 0, false
+	}
+	return replicaIndex, true
+}
+
+// sortReplicaNames sorts the names of the replicas of the replicated service by replica index
+func sortReplicaNames(replicaGroup service.ServiceName, replicaNames []service.ServiceName) {
+	sort.Slice(replicaNames, func(i, j int) bool {
+		firstIndex, _ := GetReplicaIndex(replicaGroup, replicaNames[i])
+		secondIndex, _ := GetReplicaIndex(replicaGroup, replicaNames[j])
+		return firstIndex < secondIndex
+	})
+}
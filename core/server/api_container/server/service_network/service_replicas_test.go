@@ -0,0 +1,25 @@
+package service_network
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReplicaIndex(t *testing.T) {
+	replicaIndex, isReplica := GetReplicaIndex("api", GetReplicaName("api", 12))
+	require.True(t, isReplica)
+	require.Equal(t, 12, replicaIndex)
+
+	for _, serviceName := range []service.ServiceName{"api", "api-", "api-x", "api-01", "api--1", "web-0", "api-server-0"} {
+		_, isReplica = GetReplicaIndex("api", serviceName)
+		require.False(t, isReplica, "'%s' shouldn't be a replica of 'api'", serviceName)
+	}
+}
+
+func TestSortReplicaNames(t *testing.T) {
+	replicaNames := []service.ServiceName{"api-10", "api-2", "api-0", "api-1"}
+	sortReplicaNames("api", replicaNames)
+	require.Equal(t, []service.ServiceName{"api-0", "api-1", "api-2", "api-10"}, replicaNames)
+}
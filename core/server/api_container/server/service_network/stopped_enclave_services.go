@@ -0,0 +1,129 @@
+package service_network
+
+import (
+	"context"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// StartServicesStoppedWithEnclave starts the services that were running when the enclave got stopped, that is the
+// services registered as started whose container isn't running anymore. They get started in the given order, so
+// that a service only starts once the services added before it are up. A service which fails to start is left stopped
+func (network *DefaultServiceNetwork) StartServicesStoppedWithEnclave(ctx context.Context, serviceNamesInStartOrder []service.ServiceName) error {
+	serviceNamesToStart, err := network.markServicesStoppedWithEnclave(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services which were stopped with the enclave")
+	}
+	if len(serviceNamesToStart) == 0 {
+		return nil
+	}
+
+	logrus.Infof("Starting the %d service(s) which were running when the enclave got stopped...", len(serviceNamesToStart))
+	for _, serviceNamesBatch := range getStartBatches(serviceNamesToStart, serviceNamesInStartOrder) {
+		serviceIdentifiers := []string{}
+		for _, serviceName := range serviceNamesBatch {
+			serviceIdentifiers = append(serviceIdentifiers, string(serviceName))
+		}
+		_, erroredUuids, err := network.StartServices(ctx, serviceIdentifiers)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred starting services '%v'", serviceIdentifiers)
+		}
+		for serviceUuid, startErr := range erroredUuids {
+			logrus.Errorf("Service with UUID '%v' was running when the enclave got stopped but it couldn't be started again; it's left stopped:\n%v", serviceUuid, startErr)
+		}
+	}
+	logrus.Info("...services started")
+	return nil
+}
+
+// markServicesStoppedWithEnclave registers the services whose container got stopped with the enclave as stopped, so
+// that starting them starts their existing container again, and returns their names
+func (network *DefaultServiceNetwork) markServicesStoppedWithEnclave(ctx context.Context) (map[service.ServiceName]bool, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	registeredServices, err := network.serviceRegistrationRepository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting registered services from the repository")
+	}
+	startedServiceNames := map[service.ServiceName]bool{}
+	for serviceName, serviceRegistration := range registeredServices {
+		if serviceRegistration.GetStatus() == service.ServiceStatus_Started {
+			startedServiceNames[serviceName] = true
+		}
+	}
+	// An empty filter would match every service
+	if len(startedServiceNames) == 0 {
+		return map[service.ServiceName]bool{}, nil
+	}
+
+	startedServiceFilters := &service.ServiceFilters{
+		Names:    startedServiceNames,
+		UUIDs:    nil,
+		Statuses: nil,
+	}
+	startedServices, err := network.kurtosisBackend.GetUserServices(ctx, network.enclaveUuid, startedServiceFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services registered as started from the backend")
+	}
+
+	serviceNamesStoppedWithEnclave := map[service.ServiceName]bool{}
+	for _, startedService := range startedServices {
+		// Services without a container have nothing to start again
+		serviceContainer := startedService.GetContainer()
+		if serviceContainer == nil || serviceContainer.GetStatus() != container.ContainerStatus_Stopped {
+			continue
+		}
+		serviceName := startedService.GetRegistration().GetName()
+		if err := network.serviceRegistrationRepository.UpdateStatus(serviceName, service.ServiceStatus_Stopped); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred registering service '%v' as stopped", serviceName)
+		}
+		serviceNamesStoppedWithEnclave[serviceName] = true
+	}
+	return serviceNamesStoppedWithEnclave, nil
+}
+
+// getStartBatches splits the services to start into batches following the given start order; the replicas of a
+// replicated service are started together in place of the service. The services missing from the start order are
+// started last, sorted by name
+func getStartBatches(serviceNamesToStart map[service.ServiceName]bool, serviceNamesInStartOrder []service.ServiceName) [][]service.ServiceName {
+	batches := [][]service.ServiceName{}
+	batchedServiceNames := map[service.ServiceName]bool{}
+	for _, serviceName := range serviceNamesInStartOrder {
+		batch := []service.ServiceName{}
+		if serviceNamesToStart[serviceName] && !batchedServiceNames[serviceName] {
+			batch = append(batch, serviceName)
+		}
+		replicaNames := []service.ServiceName{}
+		for candidateName := range serviceNamesToStart {
+			if _, isReplica := GetReplicaIndex(serviceName, candidateName); isReplica && !batchedServiceNames[candidateName] {
+				replicaNames = append(replicaNames, candidateName)
+			}
+		}
+		sortReplicaNames(serviceName, replicaNames)
+		batch = append(batch, replicaNames...)
+		if len(batch) == 0 {
+			continue
+		}
+		for _, batchedServiceName := range batch {
+			batchedServiceNames[batchedServiceName] = true
+		}
+		batches = append(batches, batch)
+	}
+
+	remainingServiceNames := []string{}
+	for serviceName := range serviceNamesToStart {
+		if !batchedServiceNames[serviceName] {
+			remainingServiceNames = append(remainingServiceNames, string(serviceName))
+		}
+	}
+	sort.Strings(remainingServiceNames)
+	for _, serviceName := range remainingServiceNames {
+		batches = append(batches, []service.ServiceName{service.ServiceName(serviceName)})
+	}
+	return batches
+}
@@ -0,0 +1,34 @@
+package service_network
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStartBatches(t *testing.T) {
+	serviceNamesToStart := map[service.ServiceName]bool{
+		"db":     true,
+		"api-1":  true,
+		"api-0":  true,
+		"web":    true,
+		"zeta":   true,
+		"alpha":  true,
+		"api-10": true,
+	}
+	serviceNamesInStartOrder := []service.ServiceName{"db", "cache", "api", "db", "web"}
+
+	expectedBatches := [][]service.ServiceName{
+		{"db"},
+		{"api-0", "api-1", "api-10"},
+		{"web"},
+		{"alpha"},
+		{"zeta"},
+	}
+	require.Equal(t, expectedBatches, getStartBatches(serviceNamesToStart, serviceNamesInStartOrder))
+}
+
+func TestGetStartBatches_NothingToStart(t *testing.T) {
+	require.Empty(t, getStartBatches(map[service.ServiceName]bool{}, []service.ServiceName{"db", "api"}))
+}
@@ -0,0 +1,54 @@
+package secret
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	SecretBuiltinName = "Secret"
+
+	NameArgName = "name"
+)
+
+// NewSecretHelper returns a reference to a secret of the enclave store, as a string that can be used as an env var
+// value (on its own or within a larger string) or as a 'files' value. The secret value only gets injected when the
+// service container starts, so it never shows up in the plan, the printed instructions or the service registration
+func NewSecretHelper() *kurtosis_helper.KurtosisHelper {
+	return &kurtosis_helper.KurtosisHelper{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: SecretBuiltinName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              NameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, NameArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: &secretCapabilities{},
+	}
+}
+
+type secretCapabilities struct{}
+
+func (builtin *secretCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	nameValue, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, NameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", NameArgName)
+	}
+	secretName := nameValue.GoString()
+	if err := secret_store.ValidateSecretName(secretName); err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid secret name '%s'", secretName)
+	}
+	// Whether the secret exists is checked at validation time, as secrets can be set after the package got written
+	return starlark.String(secret_store.GetSecretPlaceholder(secretName)), nil
+}
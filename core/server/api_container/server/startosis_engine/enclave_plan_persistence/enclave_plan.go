@@ -2,6 +2,7 @@ package enclave_plan_persistence
 
 import (
 	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/stacktrace"
 	"go.etcd.io/bbolt"
@@ -53,15 +54,32 @@ func Load(enclaveDb *enclave_db.EnclaveDB) (*EnclavePlan, error) {
 		// No enclave plan persisted for this enclave just yet, return an empty plan
 		return NewEnclavePlan(), nil
 	}
-	persistedEnclavePlan := new(EnclavePlan)
-	if err := json.Unmarshal(persistedEnclavePlanSerializedMaybe, persistedEnclavePlan); err != nil {
+	persistedEnclavePlan, err := Deserialize(persistedEnclavePlanSerializedMaybe)
+	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred deserializing enclave plan from enclave database")
 	}
 	return persistedEnclavePlan, nil
 }
 
-func (enclavePlan *EnclavePlan) Persist(enclaveDb *enclave_db.EnclaveDB) error {
+// Deserialize parses an enclave plan serialized with Serialize, for example from an enclave snapshot
+func Deserialize(serializedEnclavePlan []byte) (*EnclavePlan, error) {
+	enclavePlan := new(EnclavePlan)
+	if err := json.Unmarshal(serializedEnclavePlan, enclavePlan); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing enclave plan")
+	}
+	return enclavePlan, nil
+}
+
+func (enclavePlan *EnclavePlan) Serialize() ([]byte, error) {
 	serializedEnclavePlanBytes, err := json.Marshal(enclavePlan)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing enclave plan")
+	}
+	return serializedEnclavePlanBytes, nil
+}
+
+func (enclavePlan *EnclavePlan) Persist(enclaveDb *enclave_db.EnclaveDB) error {
+	serializedEnclavePlanBytes, err := enclavePlan.Serialize()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred serializing enclave plan to enclave database")
 	}
@@ -92,3 +110,20 @@ func (enclavePlan *EnclavePlan) GeneratePlan() []*EnclavePlanInstruction {
 func (enclavePlan *EnclavePlan) Size() int {
 	return len(enclavePlan.EnclavePlanInstructions)
 }
+
+// GetServiceNamesInStartOrder returns the names of the services the plan refers to, in the order the plan first refers
+// to them. As a service can only depend on the services added before it, it's an order the services can be started in
+func (enclavePlan *EnclavePlan) GetServiceNamesInStartOrder() []service.ServiceName {
+	serviceNamesInStartOrder := []service.ServiceName{}
+	seenServiceNames := map[string]bool{}
+	for _, instruction := range enclavePlan.EnclavePlanInstructions {
+		for _, serviceName := range instruction.ServiceNames {
+			if seenServiceNames[serviceName] {
+				continue
+			}
+			seenServiceNames[serviceName] = true
+			serviceNamesInStartOrder = append(serviceNamesInStartOrder, service.ServiceName(serviceName))
+		}
+	}
+	return serviceNamesInStartOrder
+}
@@ -0,0 +1,257 @@
+package enclave_snapshot
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/exec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/request"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/store_service_files"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/tasks"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/upload_files"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/wait"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"sort"
+	"strings"
+)
+
+const (
+	restoreScriptHeader     = "def run(plan):\n"
+	restoreScriptLineFormat = "    plan.%s\n"
+	restoreScriptEmptyBody  = "    pass\n"
+
+	// The persistent directories are seeded by a short-lived service mounting both the files artifacts holding the
+	// snapshot data and the persistent directories, before any of the restored services gets started. It runs the
+	// files artifacts expander image, which is already used for every service mounting files artifacts and ships a shell
+	persistentDirectoriesSeederServiceName      = "kurtosis-snapshot-restore"
+	persistentDirectoriesSeederSourceDirpathFmt = "/kurtosis-snapshot/%d"
+	persistentDirectoriesSeederTargetDirpathFmt = "/kurtosis-persistent-directory/%d"
+	persistentDirectoriesSeederDoneFilepath     = "/tmp/kurtosis-snapshot-restored"
+	persistentDirectoriesSeederCopyCmdFmt       = "cp -a %s/. %s/"
+	persistentDirectoriesSeederCmdSeparator     = " && "
+)
+
+// instructionTypesRestoredFromFilesArtifacts are the instructions whose only effect on the enclave is to produce a
+// files artifact. They are not re-executed on restore, as their files artifacts are uploaded from the snapshot
+var instructionTypesRestoredFromFilesArtifacts = map[string]bool{
+	upload_files.UploadFilesBuiltinName:              true,
+	render_templates.RenderTemplatesBuiltinName:      true,
+	store_service_files.StoreServiceFilesBuiltinName: true,
+}
+
+// instructionTypesCreatingRuntimeValues are the instructions generating exactly one new runtime value each time they
+// are interpreted
+var instructionTypesCreatingRuntimeValues = map[string]bool{
+	exec.ExecBuiltinName:       true,
+	request.RequestBuiltinName: true,
+	wait.WaitBuiltinName:       true,
+	tasks.RunShBuiltinName:     true,
+	tasks.RunPythonBuiltinName: true,
+}
+
+// instructionTypesAddingServices are the instructions returning services, whose runtime values are associated with
+// the service names
+var instructionTypesAddingServices = map[string]bool{
+	add_service.AddServiceBuiltinName:  true,
+	add_service.AddServicesBuiltinName: true,
+}
+
+// EnclaveSnapshotRestore is the script re-creating the content of an enclave snapshot, alongside the runtime value
+// UUIDs that must be reused so that the magic strings contained in the snapshot instructions keep resolving
+type EnclaveSnapshotRestore struct {
+	script string
+
+	serviceAssociatedValueUuids map[service.ServiceName]string
+
+	// UUIDs of the runtime values created by the restored instructions, in the order they get created
+	runtimeValueUuids []string
+}
+
+// NewEnclaveSnapshotRestore builds the restore script of the plan. serviceImages holds the digest-pinned image of each
+// service, which the restored service runs instead of the image referenced in the plan, and seederImage is the image
+// of the service seeding the persistent directories
+func NewEnclaveSnapshotRestore(
+	enclavePlan *enclave_plan_persistence.EnclavePlan,
+	persistentDirectoryFilesArtifacts map[service_directory.DirectoryPersistentKey]string,
+	serviceImages map[service.ServiceName]string,
+	seederImage string,
+	starlarkValueSerde *kurtosis_types.StarlarkValueSerde,
+) (*EnclaveSnapshotRestore, error) {
+	serviceAssociatedValueUuids := map[service.ServiceName]string{}
+	runtimeValueUuids := []string{}
+
+	scriptBody := strings.Builder{}
+	if len(persistentDirectoryFilesArtifacts) > 0 {
+		// the seeder service is not part of the snapshot, it gets a fresh UUID so that it doesn't consume the ones
+		// reserved for the restored instructions
+		seederServiceUuid, err := uuid_generator.GenerateUUIDString()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred generating the runtime value UUID for the persistent directories seeder service")
+		}
+		serviceAssociatedValueUuids[persistentDirectoriesSeederServiceName] = seederServiceUuid
+		scriptBody.WriteString(fmt.Sprintf(restoreScriptLineFormat, getPersistentDirectoriesSeederAddServiceInstruction(persistentDirectoryFilesArtifacts, seederImage)))
+		scriptBody.WriteString(fmt.Sprintf(restoreScriptLineFormat, getPersistentDirectoriesSeederRemoveServiceInstruction()))
+	}
+
+	for _, instruction := range enclavePlan.GeneratePlan() {
+		if instructionTypesRestoredFromFilesArtifacts[instruction.Type] {
+			continue
+		}
+		starlarkCode := instruction.StarlarkCode
+		if instructionTypesAddingServices[instruction.Type] {
+			instructionServiceUuids, err := getServiceAssociatedValueUuids(instruction, starlarkValueSerde)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the runtime values of the services added by instruction '%s'", instruction.StarlarkCode)
+			}
+			for serviceName, serviceUuid := range instructionServiceUuids {
+				serviceAssociatedValueUuids[serviceName] = serviceUuid
+			}
+			starlarkCode, err = pinServiceImages(instruction.Type, instruction.StarlarkCode, serviceImages)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred pinning the images of the services added by instruction '%s'", instruction.StarlarkCode)
+			}
+		}
+		if instructionTypesCreatingRuntimeValues[instruction.Type] {
+			instructionRuntimeValueUuids, err := magic_string_helper.GetRuntimeValueUuids(instruction.ReturnedValue)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the runtime values returned by instruction '%s'", instruction.StarlarkCode)
+			}
+			if len(instructionRuntimeValueUuids) != 1 {
+				return nil, stacktrace.NewError("Expected instruction '%s' to return exactly one runtime value but found %d in '%s'", instruction.StarlarkCode, len(instructionRuntimeValueUuids), instruction.ReturnedValue)
+			}
+			runtimeValueUuids = append(runtimeValueUuids, instructionRuntimeValueUuids[0])
+		}
+		scriptBody.WriteString(fmt.Sprintf(restoreScriptLineFormat, starlarkCode))
+	}
+
+	if scriptBody.Len() == 0 {
+		scriptBody.WriteString(restoreScriptEmptyBody)
+	}
+
+	return &EnclaveSnapshotRestore{
+		script:                      restoreScriptHeader + scriptBody.String(),
+		serviceAssociatedValueUuids: serviceAssociatedValueUuids,
+		runtimeValueUuids:           runtimeValueUuids,
+	}, nil
+}
+
+func (restore *EnclaveSnapshotRestore) GetScript() string {
+	return restore.script
+}
+
+// SeedRuntimeValueStore makes the runtime value store hand out the snapshot UUIDs to the restored instructions. It has
+// to be called right before the restore script gets interpreted, and the store must be reset with
+// SetNextValueUuids(nil) once the interpretation is done
+func (restore *EnclaveSnapshotRestore) SeedRuntimeValueStore(runtimeValueStore *runtime_value_store.RuntimeValueStore) error {
+	for serviceName, serviceUuid := range restore.serviceAssociatedValueUuids {
+		if err := runtimeValueStore.SetValueAssociatedWithService(serviceName, serviceUuid); err != nil {
+			return stacktrace.Propagate(err, "An error occurred associating runtime value '%s' with service '%s'", serviceUuid, serviceName)
+		}
+	}
+	runtimeValueUuids := make([]string, len(restore.runtimeValueUuids))
+	copy(runtimeValueUuids, restore.runtimeValueUuids)
+	runtimeValueStore.SetNextValueUuids(runtimeValueUuids)
+	return nil
+}
+
+// getServiceAssociatedValueUuids reads the service names and runtime value UUIDs from the services returned by an
+// add_service or add_services instruction
+func getServiceAssociatedValueUuids(instruction *enclave_plan_persistence.EnclavePlanInstruction, starlarkValueSerde *kurtosis_types.StarlarkValueSerde) (map[service.ServiceName]string, error) {
+	returnedValue, interpretationErr := starlarkValueSerde.Deserialize(instruction.ReturnedValue)
+	if interpretationErr != nil {
+		return nil, stacktrace.Propagate(interpretationErr, "An error occurred deserializing the value '%s'", instruction.ReturnedValue)
+	}
+
+	returnedServices := []starlark.Value{returnedValue}
+	if returnedServicesDict, ok := returnedValue.(*starlark.Dict); ok {
+		returnedServices = []starlark.Value{}
+		for _, returnedServicesItem := range returnedServicesDict.Items() {
+			returnedServices = append(returnedServices, returnedServicesItem[1])
+		}
+	}
+
+	serviceAssociatedValueUuids := map[service.ServiceName]string{}
+	for _, returnedService := range returnedServices {
+		serviceName, err := getStringAttr(returnedService, kurtosis_types.ServiceNameAttr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the name of service '%s'", returnedService.String())
+		}
+		ipAddress, err := getStringAttr(returnedService, kurtosis_types.IpAddressAttr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the IP address of service '%s'", returnedService.String())
+		}
+		runtimeValueUuids, err := magic_string_helper.GetRuntimeValueUuids(ipAddress)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the runtime value of service '%s'", serviceName)
+		}
+		if len(runtimeValueUuids) != 1 {
+			return nil, stacktrace.NewError("Expected the IP address of service '%s' to reference exactly one runtime value but it was '%s'", serviceName, ipAddress)
+		}
+		serviceAssociatedValueUuids[service.ServiceName(serviceName)] = runtimeValueUuids[0]
+	}
+	return serviceAssociatedValueUuids, nil
+}
+
+func getStringAttr(value starlark.Value, attrName string) (string, error) {
+	valueWithAttrs, ok := value.(starlark.HasAttrs)
+	if !ok {
+		return "", stacktrace.NewError("Value '%s' has no attributes", value.String())
+	}
+	attrValue, err := valueWithAttrs.Attr(attrName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting attribute '%s' of value '%s'", attrName, value.String())
+	}
+	attrStr, ok := attrValue.(starlark.String)
+	if !ok {
+		return "", stacktrace.NewError("Expected attribute '%s' of value '%s' to be a string but it was '%s'", attrName, value.String(), attrValue.Type())
+	}
+	return attrStr.GoString(), nil
+}
+
+func getPersistentDirectoriesSeederAddServiceInstruction(persistentDirectoryFilesArtifacts map[service_directory.DirectoryPersistentKey]string, seederImage string) string {
+	persistentKeys := []string{}
+	for persistentKey := range persistentDirectoryFilesArtifacts {
+		persistentKeys = append(persistentKeys, string(persistentKey))
+	}
+	sort.Strings(persistentKeys)
+
+	files := []string{}
+	copyCmds := []string{}
+	for idx, persistentKey := range persistentKeys {
+		sourceDirpath := fmt.Sprintf(persistentDirectoriesSeederSourceDirpathFmt, idx)
+		targetDirpath := fmt.Sprintf(persistentDirectoriesSeederTargetDirpathFmt, idx)
+		filesArtifactName := persistentDirectoryFilesArtifacts[service_directory.DirectoryPersistentKey(persistentKey)]
+		files = append(
+			files,
+			fmt.Sprintf("%s: %s", quote(sourceDirpath), quote(filesArtifactName)),
+			fmt.Sprintf("%s: Directory(persistent_key=%s)", quote(targetDirpath), quote(persistentKey)),
+		)
+		copyCmds = append(copyCmds, fmt.Sprintf(persistentDirectoriesSeederCopyCmdFmt, sourceDirpath, targetDirpath))
+	}
+	copyCmds = append(copyCmds, "touch "+persistentDirectoriesSeederDoneFilepath, "tail -f /dev/null")
+
+	return fmt.Sprintf(
+		`add_service(name=%s, config=ServiceConfig(image=%s, entrypoint=["/bin/sh", "-c"], cmd=[%s], files={%s}, ready_conditions=ReadyCondition(recipe=ExecRecipe(command=["test", "-f", %s]), field="code", assertion="==", target_value=0)))`,
+		quote(persistentDirectoriesSeederServiceName),
+		quote(seederImage),
+		quote(strings.Join(copyCmds, persistentDirectoriesSeederCmdSeparator)),
+		strings.Join(files, ", "),
+		quote(persistentDirectoriesSeederDoneFilepath),
+	)
+}
+
+func getPersistentDirectoriesSeederRemoveServiceInstruction() string {
+	return fmt.Sprintf("remove_service(name=%s)", quote(persistentDirectoriesSeederServiceName))
+}
+
+func quote(str string) string {
+	return starlark.String(str).String()
+}
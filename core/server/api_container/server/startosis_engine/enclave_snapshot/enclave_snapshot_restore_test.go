@@ -0,0 +1,174 @@
+package enclave_snapshot
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+	"go.starlark.net/starlark"
+	"os"
+	"strings"
+	"testing"
+)
+
+const (
+	testServiceName          = "node"
+	testServiceValueUuid     = "a1b2c3d4e5f60718293a4b5c6d7e8f90"
+	testExecValueUuid        = "0f9e8d7c6b5a49382716a5b4c3d2e1f0"
+	testUploadedArtifact     = "genesis"
+	testPersistentKey        = "node-data"
+	testSnapshotDataArtifact = "snapshot-node-data"
+	testSeederImage          = "kurtosistech/files-artifacts-expander:1.2.3"
+	testPinnedImage          = "nginx@sha256:0a1b2c3d4e5f"
+)
+
+var (
+	testAddServiceCode = fmt.Sprintf(`add_service(name=%q, config=ServiceConfig(image="nginx", files={"/genesis": %q}))`, testServiceName, testUploadedArtifact)
+	testExecCode       = fmt.Sprintf(`exec(service_name=%q, recipe=ExecRecipe(command=["echo", "{{kurtosis:%s:ip_address.runtime_value}}"]))`, testServiceName, testServiceValueUuid)
+	testUploadCode     = fmt.Sprintf(`upload_files(src="./genesis", name=%q)`, testUploadedArtifact)
+)
+
+func TestNewEnclaveSnapshotRestore_SkipsFilesArtifactsInstructionsAndCollectsRuntimeValues(t *testing.T) {
+	serde := newStarlarkValueSerdeForTest()
+	enclavePlan := newEnclavePlanForTest(t, serde)
+
+	restore, err := NewEnclaveSnapshotRestore(enclavePlan, map[service_directory.DirectoryPersistentKey]string{}, map[service.ServiceName]string{}, testSeederImage, serde)
+	require.NoError(t, err)
+
+	expectedScript := "def run(plan):\n" +
+		"    plan." + testAddServiceCode + "\n" +
+		"    plan." + testExecCode + "\n"
+	require.Equal(t, expectedScript, restore.GetScript())
+
+	runtimeValueStore := newRuntimeValueStoreForTest(t, serde)
+	require.NoError(t, restore.SeedRuntimeValueStore(runtimeValueStore))
+
+	serviceValueUuid, err := runtimeValueStore.GetOrCreateValueAssociatedWithService(testServiceName)
+	require.NoError(t, err)
+	require.Equal(t, testServiceValueUuid, serviceValueUuid)
+
+	execValueUuid, err := runtimeValueStore.CreateValue()
+	require.NoError(t, err)
+	require.Equal(t, testExecValueUuid, execValueUuid)
+
+	newValueUuid, err := runtimeValueStore.CreateValue()
+	require.NoError(t, err)
+	require.NotEqual(t, testExecValueUuid, newValueUuid)
+}
+
+func TestNewEnclaveSnapshotRestore_SeedsPersistentDirectoriesFirst(t *testing.T) {
+	serde := newStarlarkValueSerdeForTest()
+	enclavePlan := newEnclavePlanForTest(t, serde)
+
+	persistentDirectoryFilesArtifacts := map[service_directory.DirectoryPersistentKey]string{
+		testPersistentKey: testSnapshotDataArtifact,
+	}
+	restore, err := NewEnclaveSnapshotRestore(enclavePlan, persistentDirectoryFilesArtifacts, map[service.ServiceName]string{}, testSeederImage, serde)
+	require.NoError(t, err)
+
+	scriptLines := strings.Split(strings.TrimSpace(restore.GetScript()), "\n")
+	require.Len(t, scriptLines, 5)
+	require.Contains(t, scriptLines[1], `plan.add_service(name="kurtosis-snapshot-restore", config=ServiceConfig(image="`+testSeederImage+`"`)
+	require.Contains(t, scriptLines[1], `"/kurtosis-snapshot/0": "snapshot-node-data"`)
+	require.Contains(t, scriptLines[1], `"/kurtosis-persistent-directory/0": Directory(persistent_key="node-data")`)
+	require.Equal(t, `    plan.remove_service(name="kurtosis-snapshot-restore")`, scriptLines[2])
+	require.Equal(t, "    plan."+testAddServiceCode, scriptLines[3])
+
+	// the seeder service must not consume the UUIDs reserved for the restored instructions
+	runtimeValueStore := newRuntimeValueStoreForTest(t, serde)
+	require.NoError(t, restore.SeedRuntimeValueStore(runtimeValueStore))
+	seederValueUuid, err := runtimeValueStore.GetOrCreateValueAssociatedWithService(persistentDirectoriesSeederServiceName)
+	require.NoError(t, err)
+	require.NotEqual(t, testExecValueUuid, seederValueUuid)
+	execValueUuid, err := runtimeValueStore.CreateValue()
+	require.NoError(t, err)
+	require.Equal(t, testExecValueUuid, execValueUuid)
+}
+
+func TestNewEnclaveSnapshotRestore_PinsServiceImages(t *testing.T) {
+	serde := newStarlarkValueSerdeForTest()
+	enclavePlan := newEnclavePlanForTest(t, serde)
+
+	serviceImages := map[service.ServiceName]string{
+		testServiceName: testPinnedImage,
+	}
+	restore, err := NewEnclaveSnapshotRestore(enclavePlan, map[service_directory.DirectoryPersistentKey]string{}, serviceImages, testSeederImage, serde)
+	require.NoError(t, err)
+
+	pinnedAddServiceCode := strings.Replace(testAddServiceCode, `image="nginx"`, `image="`+testPinnedImage+`"`, 1)
+	expectedScript := "def run(plan):\n" +
+		"    plan." + pinnedAddServiceCode + "\n" +
+		"    plan." + testExecCode + "\n"
+	require.Equal(t, expectedScript, restore.GetScript())
+}
+
+func TestNewEnclaveSnapshotRestore_EmptyPlan(t *testing.T) {
+	serde := newStarlarkValueSerdeForTest()
+	restore, err := NewEnclaveSnapshotRestore(enclave_plan_persistence.NewEnclavePlan(), map[service_directory.DirectoryPersistentKey]string{}, map[service.ServiceName]string{}, testSeederImage, serde)
+	require.NoError(t, err)
+	require.Equal(t, "def run(plan):\n    pass\n", restore.GetScript())
+}
+
+func newEnclavePlanForTest(t *testing.T, serde *kurtosis_types.StarlarkValueSerde) *enclave_plan_persistence.EnclavePlan {
+	returnedService, interpretationErr := kurtosis_types.CreateService(
+		starlark.String(testServiceName),
+		starlark.String(testServiceName),
+		starlark.String(fmt.Sprintf("{{kurtosis:%s:ip_address.runtime_value}}", testServiceValueUuid)),
+		starlark.String(fmt.Sprintf("{{kurtosis:%s:ipv6_address.runtime_value}}", testServiceValueUuid)),
+		starlark.NewDict(0),
+	)
+	require.Nil(t, interpretationErr)
+
+	execReturnedValue := starlark.NewDict(2)
+	require.NoError(t, execReturnedValue.SetKey(starlark.String("code"), starlark.String(fmt.Sprintf("{{kurtosis:%s:code.runtime_value}}", testExecValueUuid))))
+	require.NoError(t, execReturnedValue.SetKey(starlark.String("output"), starlark.String(fmt.Sprintf("{{kurtosis:%s:output.runtime_value}}", testExecValueUuid))))
+
+	enclavePlan := enclave_plan_persistence.NewEnclavePlan()
+	enclavePlan.AppendInstruction(newEnclavePlanInstructionForTest(t, "upload_files", testUploadCode, serde.Serialize(starlark.String(testUploadedArtifact))))
+	enclavePlan.AppendInstruction(newEnclavePlanInstructionForTest(t, "add_service", testAddServiceCode, serde.Serialize(returnedService)))
+	enclavePlan.AppendInstruction(newEnclavePlanInstructionForTest(t, "exec", testExecCode, serde.Serialize(execReturnedValue)))
+	return enclavePlan
+}
+
+func newEnclavePlanInstructionForTest(t *testing.T, instructionType string, starlarkCode string, returnedValue string) *enclave_plan_persistence.EnclavePlanInstruction {
+	instruction, err := enclave_plan_persistence.NewEnclavePlanInstructionBuilder().
+		SetUuid(instructionType).
+		SetType(instructionType).
+		SetStarlarkCode(starlarkCode).
+		SetReturnedValue(returnedValue).
+		Build()
+	require.NoError(t, err)
+	return instruction
+}
+
+func newStarlarkValueSerdeForTest() *kurtosis_types.StarlarkValueSerde {
+	starlarkThread := &starlark.Thread{
+		Name:       "enclave-snapshot-restore-test",
+		Print:      nil,
+		Load:       nil,
+		OnMaxSteps: nil,
+		Steps:      0,
+	}
+	starlarkEnv := starlark.StringDict{
+		kurtosis_types.ServiceTypeName: starlark.NewBuiltin(kurtosis_types.ServiceTypeName, kurtosis_types.NewServiceType().CreateBuiltin()),
+	}
+	return kurtosis_types.NewStarlarkValueSerde(starlarkThread, starlarkEnv)
+}
+
+func newRuntimeValueStoreForTest(t *testing.T, serde *kurtosis_types.StarlarkValueSerde) *runtime_value_store.RuntimeValueStore {
+	file, err := os.CreateTemp(t.TempDir(), "*.db")
+	require.NoError(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(serde, &enclave_db.EnclaveDB{DB: db})
+	require.NoError(t, err)
+	return runtimeValueStore
+}
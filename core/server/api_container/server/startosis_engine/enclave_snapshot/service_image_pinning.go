@@ -0,0 +1,172 @@
+package enclave_snapshot
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/syntax"
+	"sort"
+	"strings"
+)
+
+const (
+	pinnedInstructionFilename = "snapshot-instruction"
+	noParseMode               = 0
+
+	// positions of the arguments when add_service and add_services are called without keywords
+	addServiceNameArgIdx     = 0
+	addServiceConfigArgIdx   = 1
+	addServicesConfigsArgIdx = 0
+	keywordOnlyArgIdx        = -1
+)
+
+// imageLiteralReplacement is the digest-pinned image replacing the string literal starting at the given byte offset
+type imageLiteralReplacement struct {
+	offset      int
+	literalLen  int
+	pinnedImage string
+}
+
+// pinServiceImages rewrites the image literals of the service configs of an add_service or add_services instruction to
+// the digest-pinned images the services were running when the snapshot was taken. Services without pinned image, or
+// whose image isn't a string literal (e.g. an ImageBuildSpec), keep the image of the instruction
+func pinServiceImages(instructionType string, starlarkCode string, serviceImages map[service.ServiceName]string) (string, error) {
+	if len(serviceImages) == 0 {
+		return starlarkCode, nil
+	}
+	expr, err := syntax.ParseExpr(pinnedInstructionFilename, starlarkCode, noParseMode)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing instruction '%s'", starlarkCode)
+	}
+	call, ok := expr.(*syntax.CallExpr)
+	if !ok {
+		return "", stacktrace.NewError("Expected instruction '%s' to be a function call", starlarkCode)
+	}
+
+	serviceConfigs := map[service.ServiceName]syntax.Expr{}
+	switch instructionType {
+	case add_service.AddServiceBuiltinName:
+		serviceNameExpr := getCallArg(call, add_service.ServiceNameArgName, addServiceNameArgIdx)
+		serviceName, found := getStringLiteral(serviceNameExpr)
+		if !found {
+			return starlarkCode, nil
+		}
+		serviceConfigs[service.ServiceName(serviceName)] = getCallArg(call, add_service.ServiceConfigArgName, addServiceConfigArgIdx)
+	case add_service.AddServicesBuiltinName:
+		configsDict, ok := getCallArg(call, add_service.ConfigsArgName, addServicesConfigsArgIdx).(*syntax.DictExpr)
+		if !ok {
+			return starlarkCode, nil
+		}
+		for _, configsDictItem := range configsDict.List {
+			configsDictEntry, ok := configsDictItem.(*syntax.DictEntry)
+			if !ok {
+				continue
+			}
+			if serviceName, found := getStringLiteral(configsDictEntry.Key); found {
+				serviceConfigs[service.ServiceName(serviceName)] = configsDictEntry.Value
+			}
+		}
+	default:
+		return starlarkCode, nil
+	}
+
+	replacements := []imageLiteralReplacement{}
+	for serviceName, serviceConfigExpr := range serviceConfigs {
+		pinnedImage, found := serviceImages[serviceName]
+		if !found {
+			continue
+		}
+		imageLiteral, found := getServiceConfigImageLiteral(serviceConfigExpr)
+		if !found {
+			continue
+		}
+		offset, err := getByteOffset(starlarkCode, imageLiteral.TokenPos)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred locating the image of service '%s' in instruction '%s'", serviceName, starlarkCode)
+		}
+		replacements = append(replacements, imageLiteralReplacement{
+			offset:      offset,
+			literalLen:  len(imageLiteral.Raw),
+			pinnedImage: pinnedImage,
+		})
+	}
+
+	// replacing from the end of the code keeps the offsets of the remaining literals valid
+	sort.Slice(replacements, func(i, j int) bool {
+		return replacements[i].offset > replacements[j].offset
+	})
+	pinnedStarlarkCode := starlarkCode
+	for _, replacement := range replacements {
+		pinnedStarlarkCode = pinnedStarlarkCode[:replacement.offset] + quote(replacement.pinnedImage) + pinnedStarlarkCode[replacement.offset+replacement.literalLen:]
+	}
+	return pinnedStarlarkCode, nil
+}
+
+// getServiceConfigImageLiteral returns the literal of ServiceConfig(image="...") or ServiceConfig(image=ImageSpec(image="..."))
+func getServiceConfigImageLiteral(serviceConfigExpr syntax.Expr) (*syntax.Literal, bool) {
+	serviceConfigCall, ok := serviceConfigExpr.(*syntax.CallExpr)
+	if !ok || !isCallTo(serviceConfigCall, service_config.ServiceConfigTypeName) {
+		return nil, false
+	}
+	imageExpr := getCallArg(serviceConfigCall, service_config.ImageAttr, keywordOnlyArgIdx)
+	if imageSpecCall, ok := imageExpr.(*syntax.CallExpr); ok && isCallTo(imageSpecCall, service_config.ImageSpecTypeName) {
+		imageExpr = getCallArg(imageSpecCall, service_config.ImageSpecImageAttr, keywordOnlyArgIdx)
+	}
+	imageLiteral, ok := imageExpr.(*syntax.Literal)
+	if !ok || imageLiteral.Token != syntax.STRING {
+		return nil, false
+	}
+	return imageLiteral, true
+}
+
+// getCallArg returns the argument passed with the given keyword, or at the given position for positional arguments
+func getCallArg(call *syntax.CallExpr, argName string, positionalIdx int) syntax.Expr {
+	for idx, arg := range call.Args {
+		if binaryExpr, ok := arg.(*syntax.BinaryExpr); ok && binaryExpr.Op == syntax.EQ {
+			if argIdent, ok := binaryExpr.X.(*syntax.Ident); ok && argIdent.Name == argName {
+				return binaryExpr.Y
+			}
+			continue
+		}
+		if idx == positionalIdx {
+			return arg
+		}
+	}
+	return nil
+}
+
+func isCallTo(call *syntax.CallExpr, functionName string) bool {
+	functionIdent, ok := call.Fn.(*syntax.Ident)
+	return ok && functionIdent.Name == functionName
+}
+
+func getStringLiteral(expr syntax.Expr) (string, bool) {
+	literal, ok := expr.(*syntax.Literal)
+	if !ok || literal.Token != syntax.STRING {
+		return "", false
+	}
+	str, ok := literal.Value.(string)
+	return str, ok
+}
+
+// getByteOffset converts a position in the code, whose line and column are 1-based and whose column counts runes, to
+// a byte offset
+func getByteOffset(code string, position syntax.Position) (int, error) {
+	offset := 0
+	lines := strings.SplitAfter(code, "\n")
+	if int(position.Line) < 1 || int(position.Line) > len(lines) {
+		return 0, stacktrace.NewError("Line '%d' is out of the code bounds", position.Line)
+	}
+	for _, line := range lines[:position.Line-1] {
+		offset += len(line)
+	}
+	runeIdx := 1
+	for byteIdx := range lines[position.Line-1] {
+		if runeIdx == int(position.Col) {
+			return offset + byteIdx, nil
+		}
+		runeIdx++
+	}
+	return 0, stacktrace.NewError("Column '%d' is out of the bounds of line '%d'", position.Col, position.Line)
+}
@@ -0,0 +1,36 @@
+package enclave_snapshot
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPinServiceImages_AddServiceWithImageSpec(t *testing.T) {
+	starlarkCode := `add_service(name="db", config=ServiceConfig(image=ImageSpec(image="postgres:16", username="ü"), cmd=["postgres"]))`
+	serviceImages := map[service.ServiceName]string{
+		"db": "postgres@sha256:abcd",
+	}
+	pinnedStarlarkCode, err := pinServiceImages("add_service", starlarkCode, serviceImages)
+	require.NoError(t, err)
+	require.Equal(t, `add_service(name="db", config=ServiceConfig(image=ImageSpec(image="postgres@sha256:abcd", username="ü"), cmd=["postgres"]))`, pinnedStarlarkCode)
+}
+
+func TestPinServiceImages_AddServices(t *testing.T) {
+	starlarkCode := `add_services(configs={"é": ServiceConfig(image="redis:7"), "db": ServiceConfig(image="postgres:16"), "app": ServiceConfig(image=ImageBuildSpec(image_name="app", build_context_dir="./app"))})`
+	serviceImages := map[service.ServiceName]string{
+		"é":   "redis@sha256:1234",
+		"db":  "postgres@sha256:abcd",
+		"app": "app@sha256:ffff",
+	}
+	pinnedStarlarkCode, err := pinServiceImages("add_services", starlarkCode, serviceImages)
+	require.NoError(t, err)
+	require.Equal(t, `add_services(configs={"é": ServiceConfig(image="redis@sha256:1234"), "db": ServiceConfig(image="postgres@sha256:abcd"), "app": ServiceConfig(image=ImageBuildSpec(image_name="app", build_context_dir="./app"))})`, pinnedStarlarkCode)
+}
+
+func TestPinServiceImages_KeepsServicesWithoutPinnedImage(t *testing.T) {
+	starlarkCode := `add_service(name="db", config=ServiceConfig(image="postgres:16"))`
+	pinnedStarlarkCode, err := pinServiceImages("add_service", starlarkCode, map[service.ServiceName]string{"other": "nginx@sha256:abcd"})
+	require.NoError(t, err)
+	require.Equal(t, starlarkCode, pinnedStarlarkCode)
+}
@@ -21,6 +21,7 @@ const (
 	serviceNameStarlarkStr = starlark.String(serviceName)
 	hostName               = serviceNameStarlarkStr
 	ipAddress              = starlark.String("172.23.34.44")
+	ipv6Address            = starlark.String("fd6b:7572:746f:1722::ac17:222c")
 )
 
 func TestPutGet_Succeed(t *testing.T) {
@@ -42,7 +43,7 @@ func TestPutGet_Succeed(t *testing.T) {
 	ports := starlark.NewDict(1)
 	require.NoError(t, ports.SetKey(starlark.String("http"), port))
 
-	expectedService, interpretationErr := kurtosis_types.CreateService(serviceNameStarlarkStr, hostName, ipAddress, ports)
+	expectedService, interpretationErr := kurtosis_types.CreateService(serviceNameStarlarkStr, hostName, ipAddress, ipv6Address, ports)
 	require.Nil(t, interpretationErr)
 
 	err := repository.PutService(serviceName, expectedService)
@@ -83,11 +84,11 @@ func TestGetServices(t *testing.T) {
 	require.NoError(t, ports.SetKey(starlark.String("http"), port))
 
 	serviceOneName := fmt.Sprintf("%v-%v", serviceName, "1")
-	serviceOne, interpretationErr := kurtosis_types.CreateService(starlark.String(serviceOneName), hostName, ipAddress, ports)
+	serviceOne, interpretationErr := kurtosis_types.CreateService(starlark.String(serviceOneName), hostName, ipAddress, ipv6Address, ports)
 	require.Nil(t, interpretationErr)
 
 	serviceTwoName := fmt.Sprintf("%v-%v", serviceName, "2")
-	serviceTwo, interpretationErr := kurtosis_types.CreateService(starlark.String(serviceTwoName), hostName, ipAddress, ports)
+	serviceTwo, interpretationErr := kurtosis_types.CreateService(starlark.String(serviceTwoName), hostName, ipAddress, ipv6Address, ports)
 	require.Nil(t, interpretationErr)
 
 	err := repository.PutService(service.ServiceName(serviceOneName), serviceOne)
@@ -128,7 +129,7 @@ func TestRemoveService(t *testing.T) {
 	require.NoError(t, ports.SetKey(starlark.String("http"), port))
 
 	serviceOneName := fmt.Sprintf("%v-%v", serviceName, "1")
-	serviceOne, interpretationErr := kurtosis_types.CreateService(starlark.String(serviceOneName), hostName, ipAddress, ports)
+	serviceOne, interpretationErr := kurtosis_types.CreateService(starlark.String(serviceOneName), hostName, ipAddress, ipv6Address, ports)
 	require.Nil(t, interpretationErr)
 
 	err := repository.PutService(service.ServiceName(serviceOneName), serviceOne)
@@ -0,0 +1,197 @@
+package add_dns_record
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_dns"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	AddDnsRecordBuiltinName = "add_dns_record"
+
+	NameArgName     = "name"
+	TargetArgName   = "target"
+	TypeArgName     = "type"
+	PortArgName     = "port"
+	PriorityArgName = "priority"
+	WeightArgName   = "weight"
+)
+
+const (
+	descriptionFormatStr = "Adding DNS record '%v' pointing to '%v'"
+)
+
+func NewAddDnsRecord(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: AddDnsRecordBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              NameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, NameArgName)
+					},
+				},
+				{
+					Name:              TargetArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, TargetArgName)
+					},
+				},
+				{
+					Name:              TypeArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, TypeArgName)
+					},
+				},
+				{
+					Name:              PortArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Int64InRange(value, PortArgName, 1, math.MaxUint16)
+					},
+				},
+				{
+					Name:              PriorityArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Int64InRange(value, PriorityArgName, 0, math.MaxUint16)
+					},
+				},
+				{
+					Name:              WeightArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Int64InRange(value, WeightArgName, 0, math.MaxUint16)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &AddDnsRecordCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				record:      nil, // populated at interpretation time
+				description: "",  // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			NameArgName:   true,
+			TargetArgName: true,
+			TypeArgName:   true,
+		},
+	}
+}
+
+type AddDnsRecordCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	record *enclave_dns.DnsRecord
+
+	description string
+}
+
+func (builtin *AddDnsRecordCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	name, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, NameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", NameArgName)
+	}
+	target, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, TargetArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", TargetArgName)
+	}
+
+	var recordType enclave_dns.DnsRecordType
+	if arguments.IsSet(TypeArgName) {
+		recordTypeStr, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, TypeArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", TypeArgName)
+		}
+		recordType = enclave_dns.DnsRecordType(recordTypeStr.GoString())
+	}
+
+	srvValues := map[string]uint16{}
+	for _, argName := range []string{PortArgName, PriorityArgName, WeightArgName} {
+		if !arguments.IsSet(argName) {
+			continue
+		}
+		value, err := builtin_argument.ExtractArgumentValue[starlark.Int](arguments, argName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", argName)
+		}
+		valueInt, ok := value.Int64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("Couldn't convert the '%s' argument '%v' to an integer", argName, value)
+		}
+		srvValues[argName] = uint16(valueInt)
+	}
+
+	record, err := enclave_dns.NewDnsRecord(name.GoString(), recordType, target.GoString(), srvValues[PortArgName], srvValues[PriorityArgName], srvValues[WeightArgName])
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid DNS record '%v' pointing to '%v'", name.GoString(), target.GoString())
+	}
+	builtin.record = record
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(descriptionFormatStr, record.Name, record.Target))
+	return starlark.None, nil
+}
+
+func (builtin *AddDnsRecordCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, _ *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	// The record got validated at interpretation time, conflicts with other records are only known at execution time
+	return nil
+}
+
+func (builtin *AddDnsRecordCapabilities) Execute(_ context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if err := builtin.serviceNetwork.AddDnsRecord(builtin.record); err != nil {
+		return "", stacktrace.Propagate(err, "Failed adding DNS record '%v' with unexpected error", builtin.record)
+	}
+	instructionResult := fmt.Sprintf("DNS record '%v' added", builtin.record)
+	return instructionResult, nil
+}
+
+func (builtin *AddDnsRecordCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	// The records are persisted along with the enclave, so an equal instruction already added the record
+	if instructionsAreEqual {
+		return enclave_structure.InstructionIsEqual
+	}
+	return enclave_structure.InstructionIsUnknown
+}
+
+func (builtin *AddDnsRecordCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(
+		AddDnsRecordBuiltinName,
+	)
+}
+
+func (builtin *AddDnsRecordCapabilities) UpdatePlan(_ *plan_yaml.PlanYaml) error {
+	// DNS records do not affect the plan
+	return nil
+}
+
+func (builtin *AddDnsRecordCapabilities) Description() string {
+	return builtin.description
+}
@@ -31,7 +31,8 @@ const (
 	ServiceNameArgName   = "name"
 	ServiceConfigArgName = "config"
 
-	addServiceDescriptionFormatStr = "Adding service with name '%v' and image '%v'"
+	addServiceDescriptionFormatStr           = "Adding service with name '%v' and image '%v'"
+	addReplicatedServiceDescriptionFormatStr = "Adding %d replicas of service with name '%v' and image '%v'"
 )
 
 func NewAddService(
@@ -83,6 +84,10 @@ func NewAddService(
 
 				resultUuid:     "",  // populated at interpretation time
 				readyCondition: nil, // populated at interpretation time
+				healthCheck:    nil, // populated at interpretation time
+
+				replicas:           0,   // populated at interpretation time
+				replicaResultUuids: nil, // populated at interpretation time
 
 				interpretationTimeValueStore: interpretationTimeValueStore,
 				description:                  "",  // populated at interpretation time
@@ -106,6 +111,11 @@ type AddServiceCapabilities struct {
 	serviceName    service.ServiceName
 	serviceConfig  *service.ServiceConfig
 	readyCondition *service_config.ReadyCondition
+	healthCheck    *service_config.HealthCheck
+
+	// 0 if the service isn't replicated
+	replicas           int
+	replicaResultUuids map[service.ServiceName]string
 
 	// These params are needed to successfully convert service config if an ImageBuildSpec was provided
 	packageId              string
@@ -140,7 +150,7 @@ func (builtin *AddServiceCapabilities) Interpret(locatorOfModuleInWhichThisBuilt
 		return nil, startosis_errors.NewInterpretationError("Unable to extract image attribute off of service config.")
 	}
 	builtin.imageVal = rawImageVal
-	apiServiceConfig, readyCondition, interpretationErr := validateAndConvertConfigAndReadyCondition(
+	apiServiceConfig, readyCondition, healthCheck, interpretationErr := validateAndConvertConfigAndReadyCondition(
 		builtin.serviceNetwork,
 		serviceConfig,
 		locatorOfModuleInWhichThisBuiltInIsBeingCalled,
@@ -156,12 +166,27 @@ func (builtin *AddServiceCapabilities) Interpret(locatorOfModuleInWhichThisBuilt
 	builtin.serviceName = service.ServiceName(serviceName.GoString())
 	builtin.serviceConfig = apiServiceConfig
 	builtin.readyCondition = readyCondition
+	builtin.healthCheck = healthCheck
 	builtin.resultUuid, err = builtin.runtimeValueStore.GetOrCreateValueAssociatedWithService(builtin.serviceName)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to create runtime value to hold '%v' command return values", AddServiceBuiltinName)
 	}
 
-	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(addServiceDescriptionFormatStr, builtin.serviceName, builtin.serviceConfig.GetContainerImageName()))
+	replicas, isReplicated, interpretationErr := serviceConfig.GetReplicas()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if isReplicated {
+		builtin.replicas = replicas
+		builtin.serviceConfig.SetReplicaGroup(string(builtin.serviceName))
+		builtin.replicaResultUuids, interpretationErr = makeAndPersistReplicasInterpretationReturnValues(builtin.serviceName, replicas, builtin.serviceConfig, builtin.runtimeValueStore, builtin.interpretationTimeValueStore)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(addReplicatedServiceDescriptionFormatStr, replicas, builtin.serviceName, builtin.serviceConfig.GetContainerImageName()))
+	} else {
+		builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(addServiceDescriptionFormatStr, builtin.serviceName, builtin.serviceConfig.GetContainerImageName()))
+	}
 
 	builtin.returnValue, interpretationErr = makeAddServiceInterpretationReturnValue(serviceName, builtin.serviceConfig, builtin.resultUuid)
 	if interpretationErr != nil {
@@ -176,6 +201,9 @@ func (builtin *AddServiceCapabilities) Interpret(locatorOfModuleInWhichThisBuilt
 }
 
 func (builtin *AddServiceCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if builtin.replicas > 0 {
+		return validateServiceReplicas(validatorEnvironment, builtin.serviceName, builtin.replicas, builtin.serviceConfig)
+	}
 	if validationErr := validateSingleService(validatorEnvironment, builtin.serviceName, builtin.serviceConfig); validationErr != nil {
 		return validationErr
 	}
@@ -187,6 +215,9 @@ func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_a
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred replace a magic string in '%s' instruction arguments for service '%s'. Execution cannot proceed", AddServiceBuiltinName, builtin.serviceName)
 	}
+	if builtin.replicas > 0 {
+		return builtin.executeReplicated(ctx, replacedServiceName, replacedServiceConfig)
+	}
 	var startedService *service.Service
 	exist, err := builtin.serviceNetwork.ExistServiceRegistration(builtin.serviceName)
 	if err != nil {
@@ -211,6 +242,10 @@ func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_a
 		return "", stacktrace.Propagate(err, "An error occurred while checking if service '%v' is ready", replacedServiceName)
 	}
 
+	if err := setServiceHealthCheck(builtin.serviceNetwork, builtin.runtimeValueStore, replacedServiceName, builtin.healthCheck); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred setting the health check of service '%v'", replacedServiceName)
+	}
+
 	if err := fillAddServiceReturnValueWithRuntimeValues(startedService, builtin.resultUuid, builtin.runtimeValueStore); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while adding service return values with result key UUID '%s'", builtin.resultUuid)
 	}
@@ -218,28 +253,68 @@ func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_a
 	return instructionResult, nil
 }
 
+func (builtin *AddServiceCapabilities) executeReplicated(ctx context.Context, replicaGroup service.ServiceName, serviceConfig *service.ServiceConfig) (string, error) {
+	startedReplicas, err := startServiceReplicas(ctx, builtin.serviceNetwork, replicaGroup, builtin.replicas, serviceConfig)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Unexpected error occurred starting the replicas of service '%s'", replicaGroup)
+	}
+
+	for replicaIndex := 0; replicaIndex < builtin.replicas; replicaIndex++ {
+		replicaName := service_network.GetReplicaName(replicaGroup, replicaIndex)
+		startedReplica, found := startedReplicas[replicaName]
+		if !found {
+			return "", stacktrace.NewError("Replica '%s' of service '%s' wasn't started, yet no error was returned; this is a bug in Kurtosis", replicaName, replicaGroup)
+		}
+		if err := runServiceReadinessCheck(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, replicaName, builtin.readyCondition); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred while checking if replica '%v' is ready", replicaName)
+		}
+		if err := setServiceHealthCheck(builtin.serviceNetwork, builtin.runtimeValueStore, replicaName, builtin.healthCheck); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred setting the health check of replica '%v'", replicaName)
+		}
+		replicaResultUuid, found := builtin.replicaResultUuids[replicaName]
+		if !found {
+			return "", stacktrace.NewError("No return value was created for replica '%s' at interpretation time; this is a bug in Kurtosis", replicaName)
+		}
+		if err := fillAddServiceReturnValueWithRuntimeValues(startedReplica, replicaResultUuid, builtin.runtimeValueStore); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred while adding replica return values with result key UUID '%s'", replicaResultUuid)
+		}
+	}
+
+	// The replicated service resolves to all its replicas by name, and exposes the IP address of the first one
+	firstReplica := startedReplicas[service_network.GetReplicaName(replicaGroup, 0)]
+	if err := builtin.runtimeValueStore.SetValue(builtin.resultUuid, map[string]starlark.Comparable{
+		ipAddressRuntimeValue:   starlark.String(firstReplica.GetRegistration().GetPrivateIP().String()),
+		ipv6AddressRuntimeValue: starlark.String(getPrivateIpv6AddrStr(firstReplica)),
+		hostnameRuntimeValue:    starlark.String(replicaGroup),
+	}); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred setting value with key '%s' in the runtime value store", builtin.resultUuid)
+	}
+	instructionResult := fmt.Sprintf("Service '%s' added with %d replicas", replicaGroup, builtin.replicas)
+	return instructionResult, nil
+}
+
 func (builtin *AddServiceCapabilities) TryResolveWith(instructionsAreEqual bool, other *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
 	// if other instruction is nil or other instruction is not an add_service instruction, status is unknown
 	if other == nil {
-		enclaveComponents.AddService(builtin.serviceName, enclave_structure.ComponentIsNew)
+		builtin.addServiceAndReplicasToEnclaveComponents(enclaveComponents, enclave_structure.ComponentIsNew)
 		return enclave_structure.InstructionIsUnknown
 	}
 
 	if other.Type != AddServiceBuiltinName {
-		enclaveComponents.AddService(builtin.serviceName, enclave_structure.ComponentIsNew)
+		builtin.addServiceAndReplicasToEnclaveComponents(enclaveComponents, enclave_structure.ComponentIsNew)
 		return enclave_structure.InstructionIsUnknown
 	}
 
 	// if service names don't match, status is unknown, instructions can't be resolved together
 	if !other.HasOnlyServiceName(builtin.serviceName) {
-		enclaveComponents.AddService(builtin.serviceName, enclave_structure.ComponentIsNew)
+		builtin.addServiceAndReplicasToEnclaveComponents(enclaveComponents, enclave_structure.ComponentIsNew)
 		return enclave_structure.InstructionIsUnknown
 	}
 
 	// if service names are equal but the instructions are not equal, it means the service config has been updated.
 	// The instruction should be rerun
 	if !instructionsAreEqual {
-		enclaveComponents.AddService(builtin.serviceName, enclave_structure.ComponentIsUpdated)
+		builtin.addServiceAndReplicasToEnclaveComponents(enclaveComponents, enclave_structure.ComponentIsUpdated)
 		return enclave_structure.InstructionIsUpdate
 	}
 
@@ -250,17 +325,25 @@ func (builtin *AddServiceCapabilities) TryResolveWith(instructionsAreEqual bool,
 		for _, filesArtifactNames := range filesArtifactsExpansion.ServiceDirpathsToArtifactIdentifiers {
 			for _, filesArtifactName := range filesArtifactNames {
 				if enclaveComponents.HasFilesArtifactBeenUpdated(filesArtifactName) {
-					enclaveComponents.AddService(builtin.serviceName, enclave_structure.ComponentIsUpdated)
+					builtin.addServiceAndReplicasToEnclaveComponents(enclaveComponents, enclave_structure.ComponentIsUpdated)
 					return enclave_structure.InstructionIsUpdate
 				}
 			}
 		}
 	}
 
-	enclaveComponents.AddService(builtin.serviceName, enclave_structure.ComponentWasLeftIntact)
+	builtin.addServiceAndReplicasToEnclaveComponents(enclaveComponents, enclave_structure.ComponentWasLeftIntact)
 	return enclave_structure.InstructionIsEqual
 }
 
+// Replicas get re-run along with the service they're replicas of
+func (builtin *AddServiceCapabilities) addServiceAndReplicasToEnclaveComponents(enclaveComponents *enclave_structure.EnclaveComponents, componentStatus enclave_structure.EnclaveComponentStatus) {
+	enclaveComponents.AddService(builtin.serviceName, componentStatus)
+	for replicaIndex := 0; replicaIndex < builtin.replicas; replicaIndex++ {
+		enclaveComponents.AddService(service_network.GetReplicaName(builtin.serviceName, replicaIndex), componentStatus)
+	}
+}
+
 func (builtin *AddServiceCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
 	builder.SetType(
 		AddServiceBuiltinName,
@@ -316,10 +399,10 @@ func validateAndConvertConfigAndReadyCondition(
 	packageContentProvider startosis_packages.PackageContentProvider,
 	packageReplaceOptions map[string]string,
 	imageDownloadMode image_download_mode.ImageDownloadMode,
-) (*service.ServiceConfig, *service_config.ReadyCondition, *startosis_errors.InterpretationError) {
+) (*service.ServiceConfig, *service_config.ReadyCondition, *service_config.HealthCheck, *startosis_errors.InterpretationError) {
 	config, ok := rawConfig.(*service_config.ServiceConfig)
 	if !ok {
-		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument is not a ServiceConfig (was '%s').", ConfigsArgName, reflect.TypeOf(rawConfig))
+		return nil, nil, nil, startosis_errors.NewInterpretationError("The '%s' argument is not a ServiceConfig (was '%s').", ConfigsArgName, reflect.TypeOf(rawConfig))
 	}
 	apiServiceConfig, interpretationErr := config.ToKurtosisType(
 		serviceNetwork,
@@ -329,13 +412,18 @@ func validateAndConvertConfigAndReadyCondition(
 		packageReplaceOptions,
 		imageDownloadMode)
 	if interpretationErr != nil {
-		return nil, nil, interpretationErr
+		return nil, nil, nil, interpretationErr
 	}
 
 	readyCondition, interpretationErr := config.GetReadyCondition()
 	if interpretationErr != nil {
-		return nil, nil, interpretationErr
+		return nil, nil, nil, interpretationErr
+	}
+
+	healthCheck, interpretationErr := config.GetHealthCheck()
+	if interpretationErr != nil {
+		return nil, nil, nil, interpretationErr
 	}
 
-	return apiServiceConfig, readyCondition, nil
+	return apiServiceConfig, readyCondition, healthCheck, nil
 }
@@ -7,7 +7,9 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
@@ -16,20 +18,33 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/tracing"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"go.starlark.net/starlark"
 )
 
 const (
-	ipAddressRuntimeValue = "ip_address"
-	hostnameRuntimeValue  = "hostname"
+	ipAddressRuntimeValue   = "ip_address"
+	ipv6AddressRuntimeValue = "ipv6_address"
+	hostnameRuntimeValue    = "hostname"
 )
 
+// getPrivateIpv6AddrStr returns the private IPv6 address of the service, or an empty string if the enclave doesn't
+// have IPv6 enabled
+func getPrivateIpv6AddrStr(service *service.Service) string {
+	privateIpv6 := service.GetRegistration().GetPrivateIPv6()
+	if privateIpv6 == nil {
+		return ""
+	}
+	return privateIpv6.String()
+}
+
 func fillAddServiceReturnValueWithRuntimeValues(service *service.Service, resultUuid string, runtimeValueStore *runtime_value_store.RuntimeValueStore) error {
 	if err := runtimeValueStore.SetValue(resultUuid, map[string]starlark.Comparable{
-		ipAddressRuntimeValue: starlark.String(service.GetRegistration().GetPrivateIP().String()),
-		hostnameRuntimeValue:  starlark.String(service.GetRegistration().GetHostname()),
+		ipAddressRuntimeValue:   starlark.String(service.GetRegistration().GetPrivateIP().String()),
+		ipv6AddressRuntimeValue: starlark.String(getPrivateIpv6AddrStr(service)),
+		hostnameRuntimeValue:    starlark.String(service.GetRegistration().GetHostname()),
 	}); err != nil {
 		return stacktrace.Propagate(err, "An error occurred setting value with key '%s' in the runtime value store", resultUuid)
 	}
@@ -60,8 +75,9 @@ func makeAddServiceInterpretationReturnValue(serviceName starlark.String, servic
 		}
 	}
 	ipAddress := starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, ipAddressRuntimeValue))
+	ipv6Address := starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, ipv6AddressRuntimeValue))
 	hostname := starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, hostnameRuntimeValue))
-	returnValue, interpretationErr := kurtosis_types.CreateService(serviceName, hostname, ipAddress, portSpecsDict)
+	returnValue, interpretationErr := kurtosis_types.CreateService(serviceName, hostname, ipAddress, ipv6Address, portSpecsDict)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
@@ -103,6 +119,22 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 		return validationErr
 	}
 
+	if validationErr := validatorEnvironment.ValidateContainerSettings(serviceConfig.GetContainerSettings(), serviceName); validationErr != nil {
+		return validationErr
+	}
+
+	if validationErr := validatorEnvironment.ValidateSecretsExist(secret_store.GetSecretNamesReferencedByServiceConfig(serviceConfig), serviceName); validationErr != nil {
+		return validationErr
+	}
+
+	if validationErr := validatorEnvironment.ReserveHostPorts(serviceConfig.GetPortPublishSpecs(), serviceName); validationErr != nil {
+		return validationErr
+	}
+
+	if validationErr := validatorEnvironment.ReserveResourceQuota(serviceName, serviceConfig); validationErr != nil {
+		return validationErr
+	}
+
 	validatorEnvironment.AddServiceName(serviceName)
 
 	if serviceConfig.GetImageBuildSpec() != nil {
@@ -202,7 +234,7 @@ func replaceMagicStrings(
 		serviceConfig.GetImageRegistrySpec(),
 		serviceConfig.GetNixBuildSpec(),
 		serviceConfig.GetPrivatePorts(),
-		serviceConfig.GetPublicPorts(),
+		nil, // the port publish specs are copied over below, as they can be more than static public ports
 		entrypoints,
 		cmdArgs,
 		envVars,
@@ -224,6 +256,17 @@ func replaceMagicStrings(
 		return "", nil, stacktrace.Propagate(err, "An error occurred creating a service config")
 	}
 	renderedServiceConfig.SetFilesToBeMoved(serviceConfig.GetFilesToBeMoved())
+	renderedServiceConfig.SetRestartPolicy(serviceConfig.GetRestartPolicy())
+	renderedServiceConfig.SetInitContainers(serviceConfig.GetInitContainers())
+	renderedServiceConfig.SetSidecars(serviceConfig.GetSidecars())
+	renderedServiceConfig.SetContainerSettings(serviceConfig.GetContainerSettings())
+	renderedServiceConfig.SetSecretEnvVarNames(serviceConfig.GetSecretEnvVarNames())
+	renderedServiceConfig.SetSecretFiles(serviceConfig.GetSecretFiles())
+	renderedServiceConfig.SetReplicaGroup(serviceConfig.GetReplicaGroup())
+	renderedServiceConfig.SetNetworks(serviceConfig.GetNetworks())
+	renderedServiceConfig.SetExternalNetworks(serviceConfig.GetExternalNetworks())
+	renderedServiceConfig.SetPrivateIPv6AddrPlaceholder(serviceConfig.GetPrivateIPv6AddrPlaceholder())
+	renderedServiceConfig.SetPortPublishSpecs(serviceConfig.GetPortPublishSpecs())
 
 	return service.ServiceName(serviceNameStr), renderedServiceConfig, nil
 }
@@ -269,8 +312,9 @@ func runServiceReadinessCheck(
 
 		startTime := time.Now()
 		logrus.Infof("Checking service readiness for '%s' at '%v'", serviceName, startTime) //TODO change to debug
+		readyConditionCtx, span := tracing.StartSpan(ctx, tracing.WaitForReadyConditionSpanName, tracing.ServiceNameKey.String(string(serviceName)))
 		lastResult, tries, err := shared_helpers.ExecuteServiceAssertionWithRecipe(
-			ctx,
+			readyConditionCtx,
 			serviceNetwork,
 			runtimeValueStore,
 			serviceName,
@@ -281,6 +325,7 @@ func runServiceReadinessCheck(
 			interval,
 			timeout,
 		)
+		tracing.EndSpan(span, err)
 		if err != nil {
 			return stacktrace.Propagate(
 				err,
@@ -306,3 +351,58 @@ func runServiceReadinessCheck(
 	}
 	return nil
 }
+
+// setServiceHealthCheck makes the service network run the health check against the service for as long as it runs. A
+// nil health check removes the one the service may have had before being updated
+func setServiceHealthCheck(
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	healthCheck *service_config.HealthCheck,
+) error {
+	if healthCheck == nil {
+		serviceNetwork.SetServiceHealthCheck(serviceName, nil)
+		return nil
+	}
+
+	recipe, interpretationErr := healthCheck.GetRecipe()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the recipe value from health check '%v'", healthCheck)
+	}
+
+	field, interpretationErr := healthCheck.GetField()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the field value from health check '%v'", healthCheck)
+	}
+
+	assertion, interpretationErr := healthCheck.GetAssertion()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the assertion value from health check '%v'", healthCheck)
+	}
+
+	target, interpretationErr := healthCheck.GetTarget()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the target value from health check '%v'", healthCheck)
+	}
+
+	interval, interpretationErr := healthCheck.GetInterval()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the interval value from health check '%v'", healthCheck)
+	}
+
+	timeout, interpretationErr := healthCheck.GetTimeout()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the timeout value from health check '%v'", healthCheck)
+	}
+
+	failureThreshold, interpretationErr := healthCheck.GetFailureThreshold()
+	if interpretationErr != nil {
+		return stacktrace.Propagate(interpretationErr, "An error occurred getting the failure threshold value from health check '%v'", healthCheck)
+	}
+
+	probe := func(ctx context.Context) error {
+		return shared_helpers.ExecuteServiceAssertionOnceWithRecipe(ctx, serviceNetwork, runtimeValueStore, serviceName, recipe, field, assertion, target)
+	}
+	serviceNetwork.SetServiceHealthCheck(serviceName, service_health.NewHealthCheck(probe, interval, timeout, failureThreshold))
+	return nil
+}
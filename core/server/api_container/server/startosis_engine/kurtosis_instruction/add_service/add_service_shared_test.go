@@ -6,7 +6,10 @@ import (
 	"os"
 	"testing"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_settings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_restart_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
@@ -217,6 +220,66 @@ func TestAddServiceShared_ServiceNameWithRuntimeValuesAreReplaced(t *testing.T)
 	require.Equal(t, service.ServiceName("database-1"), replacedServiceName)
 }
 
+func TestAddServiceShared_SettingsAreKeptWhenReplacingRuntimeValues(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	require.NoError(t, err)
+
+	serviceName := service.ServiceName("example-datastore-server-2")
+	serviceConfig, err := service.CreateServiceConfig(
+		testContainerImageName,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		map[string]string{},
+		nil,
+		nil,
+		0,
+		0,
+		"",
+		0,
+		0,
+		map[string]string{},
+		nil,
+		nil,
+		map[string]string{},
+		image_download_mode.ImageDownloadMode_Missing,
+	)
+	require.NoError(t, err)
+	restartPolicy, err := service_restart_policy.NewServiceRestartPolicy(service_restart_policy.ServiceRestartPolicyMode_OnFailure, 3)
+	require.NoError(t, err)
+	serviceConfig.SetRestartPolicy(restartPolicy)
+	initContainer, err := container_spec.NewContainerSpec("migrations", testContainerImageName, nil, []string{"migrate"}, map[string]string{}, map[string]string{})
+	require.NoError(t, err)
+	serviceConfig.SetInitContainers([]*container_spec.ContainerSpec{initContainer})
+	sidecar, err := container_spec.NewContainerSpec("metrics-exporter", testContainerImageName, nil, nil, map[string]string{}, map[string]string{"/data": "/data"})
+	require.NoError(t, err)
+	serviceConfig.SetSidecars([]*container_spec.ContainerSpec{sidecar})
+	containerSettings, err := container_settings.NewContainerSettings(true, nil, nil, nil, nil, 0, false, nil, nil)
+	require.NoError(t, err)
+	serviceConfig.SetContainerSettings(containerSettings)
+	serviceConfig.SetSecretFiles(map[string]string{"/run/secrets/token": "{{kurtosis:secret:token}}"})
+	serviceConfig.SetNetworks([]string{"dmz", "backend"})
+	serviceConfig.SetExternalNetworks([]string{"lab_network"})
+
+	_, replacedServiceConfig, err := replaceMagicStrings(runtimeValueStore, serviceName, serviceConfig)
+	require.Nil(t, err)
+	require.Equal(t, restartPolicy, replacedServiceConfig.GetRestartPolicy())
+	require.Equal(t, []*container_spec.ContainerSpec{initContainer}, replacedServiceConfig.GetInitContainers())
+	require.Equal(t, []*container_spec.ContainerSpec{sidecar}, replacedServiceConfig.GetSidecars())
+	require.Equal(t, containerSettings, replacedServiceConfig.GetContainerSettings())
+	require.Equal(t, serviceConfig.GetSecretFiles(), replacedServiceConfig.GetSecretFiles())
+	require.Equal(t, serviceConfig.GetNetworks(), replacedServiceConfig.GetNetworks())
+	require.Equal(t, serviceConfig.GetExternalNetworks(), replacedServiceConfig.GetExternalNetworks())
+}
+
 func getEnclaveDBForTest(t *testing.T) *enclave_db.EnclaveDB {
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer func() {
@@ -76,6 +76,7 @@ func NewAddServices(
 
 				resultUuids:       map[service.ServiceName]string{}, // populated at interpretation time
 				readyConditions:   nil,                              // populated at interpretation time
+				healthChecks:      nil,                              // populated at interpretation time
 				description:       "",                               // populated at interpretation time
 				imageDownloadMode: imageDownloadMode,
 			}
@@ -101,6 +102,8 @@ type AddServicesCapabilities struct {
 
 	readyConditions map[service.ServiceName]*service_config.ReadyCondition
 
+	healthChecks map[service.ServiceName]*service_config.HealthCheck
+
 	resultUuids map[service.ServiceName]string
 	description string
 
@@ -112,7 +115,7 @@ func (builtin *AddServicesCapabilities) Interpret(locatorOfModuleInWhichThisBuil
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ConfigsArgName)
 	}
-	serviceConfigs, readyConditions, interpretationErr := validateAndConvertConfigsAndReadyConditions(
+	serviceConfigs, readyConditions, healthChecks, interpretationErr := validateAndConvertConfigsAndReadyConditions(
 		builtin.serviceNetwork,
 		ServiceConfigsDict,
 		locatorOfModuleInWhichThisBuiltInIsBeingCalled,
@@ -126,6 +129,7 @@ func (builtin *AddServicesCapabilities) Interpret(locatorOfModuleInWhichThisBuil
 	}
 	builtin.serviceConfigs = serviceConfigs
 	builtin.readyConditions = readyConditions
+	builtin.healthChecks = healthChecks
 
 	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(addServicesDescriptionFormatStr, len(builtin.serviceConfigs), getNamesAsCommaSeparatedList(builtin.serviceConfigs)))
 
@@ -227,6 +231,12 @@ func (builtin *AddServicesCapabilities) Execute(ctx context.Context, _ *builtin_
 		}
 	}()
 
+	for serviceName := range startedAndUpdatedService {
+		if err := setServiceHealthCheck(builtin.serviceNetwork, builtin.runtimeValueStore, serviceName, builtin.healthChecks[serviceName]); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred setting the health check of service '%v'", serviceName)
+		}
+	}
+
 	instructionResult := strings.Builder{}
 	instructionResult.WriteString(fmt.Sprintf("Successfully added the following '%d' services:", len(startedServices)))
 	for serviceName, serviceObj := range startedAndUpdatedService {
@@ -433,45 +443,58 @@ func validateAndConvertConfigsAndReadyConditions(
 ) (
 	map[service.ServiceName]*service.ServiceConfig,
 	map[service.ServiceName]*service_config.ReadyCondition,
+	map[service.ServiceName]*service_config.HealthCheck,
 	*startosis_errors.InterpretationError,
 ) {
 	configsDict, ok := configs.(*starlark.Dict)
 	if !ok {
-		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a dictionary of matching each service name to their respective ServiceConfig object. Got '%s'", ConfigsArgName, reflect.TypeOf(configs))
+		return nil, nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a dictionary of matching each service name to their respective ServiceConfig object. Got '%s'", ConfigsArgName, reflect.TypeOf(configs))
 	}
 	if configsDict.Len() == 0 {
-		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a non empty dictionary", ConfigsArgName)
+		return nil, nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a non empty dictionary", ConfigsArgName)
 	}
 	convertedServiceConfigs := map[service.ServiceName]*service.ServiceConfig{}
 	readyConditionsByServiceName := map[service.ServiceName]*service_config.ReadyCondition{}
+	healthChecksByServiceName := map[service.ServiceName]*service_config.HealthCheck{}
 	for _, serviceName := range configsDict.Keys() {
 		serviceNameStr, isServiceNameAString := serviceName.(starlark.String)
 		if !isServiceNameAString {
-			return nil, nil, startosis_errors.NewInterpretationError("One key of the '%s' dictionary is not a string (was '%s'). Keys of this argument should correspond to service names, which should be strings", ConfigsArgName, reflect.TypeOf(serviceName))
+			return nil, nil, nil, startosis_errors.NewInterpretationError("One key of the '%s' dictionary is not a string (was '%s'). Keys of this argument should correspond to service names, which should be strings", ConfigsArgName, reflect.TypeOf(serviceName))
 		}
 
 		dictValue, found, err := configsDict.Get(serviceName)
 		if err != nil || !found {
-			return nil, nil, startosis_errors.NewInterpretationError("Could not extract the value of the '%s' dictionary for key '%s'. This is Kurtosis bug", ConfigsArgName, serviceName)
+			return nil, nil, nil, startosis_errors.NewInterpretationError("Could not extract the value of the '%s' dictionary for key '%s'. This is Kurtosis bug", ConfigsArgName, serviceName)
 		}
 		serviceConfig, isDictValueAServiceConfig := dictValue.(*service_config.ServiceConfig)
 		if !isDictValueAServiceConfig {
-			return nil, nil, startosis_errors.NewInterpretationError("One value of the '%s' dictionary is not a ServiceConfig (was '%s'). Values of this argument should correspond to the config of the service to be added", ConfigsArgName, reflect.TypeOf(dictValue))
+			return nil, nil, nil, startosis_errors.NewInterpretationError("One value of the '%s' dictionary is not a ServiceConfig (was '%s'). Values of this argument should correspond to the config of the service to be added", ConfigsArgName, reflect.TypeOf(dictValue))
+		}
+		if _, isReplicated, interpretationErr := serviceConfig.GetReplicas(); interpretationErr != nil {
+			return nil, nil, nil, interpretationErr
+		} else if isReplicated {
+			return nil, nil, nil, startosis_errors.NewInterpretationError("The config of service '%s' sets '%s', which '%s' doesn't support; use '%s' to add replicated services", serviceNameStr.GoString(), service_config.ReplicasAttr, AddServicesBuiltinName, AddServiceBuiltinName)
 		}
 		apiServiceConfig, interpretationErr := serviceConfig.ToKurtosisType(serviceNetwork, locatorOfModuleInWhichThisBuiltInIsBeingCalled, packageId, packageContentProvider, packageReplaceOptions, imageDownloadMode)
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
 		}
 		convertedServiceConfigs[service.ServiceName(serviceNameStr.GoString())] = apiServiceConfig
 
 		readyConditions, interpretationErr := serviceConfig.GetReadyCondition()
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
 		}
 
 		readyConditionsByServiceName[service.ServiceName(serviceNameStr.GoString())] = readyConditions
+
+		healthCheck, interpretationErr := serviceConfig.GetHealthCheck()
+		if interpretationErr != nil {
+			return nil, nil, nil, interpretationErr
+		}
+		healthChecksByServiceName[service.ServiceName(serviceNameStr.GoString())] = healthCheck
 	}
-	return convertedServiceConfigs, readyConditionsByServiceName, nil
+	return convertedServiceConfigs, readyConditionsByServiceName, healthChecksByServiceName, nil
 }
 
 func makeAndPersistAddServicesInterpretationReturnValue(serviceConfigs map[service.ServiceName]*service.ServiceConfig, runtimeValueStore *runtime_value_store.RuntimeValueStore, interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore) (map[service.ServiceName]string, *starlark.Dict, *startosis_errors.InterpretationError) {
@@ -0,0 +1,139 @@
+package add_service
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+// Creates the return values of the replicas of a replicated service, so that each replica can be referenced on its
+// own by name. Returns the UUIDs of the runtime values holding their IP address and hostname, keyed by replica name
+func makeAndPersistReplicasInterpretationReturnValues(
+	replicaGroup service.ServiceName,
+	replicas int,
+	serviceConfig *service.ServiceConfig,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore,
+) (map[service.ServiceName]string, *startosis_errors.InterpretationError) {
+	replicaResultUuids := map[service.ServiceName]string{}
+	for replicaIndex := 0; replicaIndex < replicas; replicaIndex++ {
+		replicaName := service_network.GetReplicaName(replicaGroup, replicaIndex)
+		replicaResultUuid, err := runtimeValueStore.GetOrCreateValueAssociatedWithService(replicaName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to create runtime value to hold the return values of replica '%v'", replicaName)
+		}
+		replicaReturnValue, interpretationErr := makeAddServiceInterpretationReturnValue(starlark.String(replicaName), serviceConfig, replicaResultUuid)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		if err := interpretationTimeValueStore.PutService(replicaName, replicaReturnValue); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred while persisting return value for replica '%v'", replicaName)
+		}
+		replicaResultUuids[replicaName] = replicaResultUuid
+	}
+	return replicaResultUuids, nil
+}
+
+func validateServiceReplicas(
+	validatorEnvironment *startosis_validator.ValidatorEnvironment,
+	replicaGroup service.ServiceName,
+	replicas int,
+	serviceConfig *service.ServiceConfig,
+) *startosis_errors.ValidationError {
+	if isValidServiceName := service.IsServiceNameValid(replicaGroup); !isValidServiceName {
+		return startosis_errors.NewValidationError(invalidServiceNameErrorText(replicaGroup))
+	}
+	if validatorEnvironment.DoesServiceNameExist(replicaGroup) == startosis_validator.ComponentCreatedOrUpdatedDuringPackageRun {
+		return startosis_errors.NewValidationError("There was an error validating '%s' as service with the name '%s' already exists inside the package. Adding two different services with the same name isn't allowed; we recommend prefixing/suffixing the two service names or using two different names entirely.", AddServiceBuiltinName, replicaGroup)
+	}
+	for replicaIndex := 0; replicaIndex < replicas; replicaIndex++ {
+		if validationErr := validateSingleService(validatorEnvironment, service_network.GetReplicaName(replicaGroup, replicaIndex), serviceConfig); validationErr != nil {
+			return validationErr
+		}
+	}
+	// The name of the replicated service is reserved too, as it resolves to the replicas
+	validatorEnvironment.AddServiceName(replicaGroup)
+	return nil
+}
+
+// Starts the replicas of the replicated service, updating the ones which already exist and removing the ones left
+// over from a run with more replicas. Returns the started replicas keyed by name
+func startServiceReplicas(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	replicaGroup service.ServiceName,
+	replicas int,
+	serviceConfig *service.ServiceConfig,
+) (map[service.ServiceName]*service.Service, error) {
+	isServiceNameTaken, err := serviceNetwork.ExistServiceRegistration(replicaGroup)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting service registration for service '%s'", replicaGroup)
+	}
+	if isServiceNameTaken {
+		return nil, stacktrace.NewError("Service '%s' already exists without replicas; it needs to be removed before adding replicas under the same name", replicaGroup)
+	}
+
+	existingReplicaNames, err := serviceNetwork.GetServiceReplicaNames(replicaGroup)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the existing replicas of service '%s'", replicaGroup)
+	}
+	isExistingReplica := map[service.ServiceName]bool{}
+	for _, replicaName := range existingReplicaNames {
+		isExistingReplica[replicaName] = true
+	}
+
+	replicaConfigsToAdd := map[service.ServiceName]*service.ServiceConfig{}
+	replicaConfigsToUpdate := map[service.ServiceName]*service.ServiceConfig{}
+	for replicaIndex := 0; replicaIndex < replicas; replicaIndex++ {
+		replicaName := service_network.GetReplicaName(replicaGroup, replicaIndex)
+		// Each replica gets its own copy, as starting a service can modify its config
+		replicaConfig, err := serviceConfig.Copy()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred copying the config of service '%s' for replica '%s'", replicaGroup, replicaName)
+		}
+		if isExistingReplica[replicaName] {
+			replicaConfigsToUpdate[replicaName] = replicaConfig
+			delete(isExistingReplica, replicaName)
+		} else {
+			replicaConfigsToAdd[replicaName] = replicaConfig
+		}
+	}
+
+	// What's left are the replicas past the requested number of replicas
+	for replicaName := range isExistingReplica {
+		if _, err := serviceNetwork.RemoveService(ctx, string(replicaName)); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred removing replica '%s' of service '%s'", replicaName, replicaGroup)
+		}
+	}
+
+	startedReplicas := map[service.ServiceName]*service.Service{}
+	updatedReplicas, failedToBeUpdatedReplicas, err := serviceNetwork.UpdateServices(ctx, replicaConfigsToUpdate, len(replicaConfigsToUpdate))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating the replicas of service '%s'", replicaGroup)
+	}
+	if len(failedToBeUpdatedReplicas) > 0 {
+		return nil, stacktrace.NewError("Some replicas of service '%s' failed to be updated. Errors were:\n%v", replicaGroup, failedToBeUpdatedReplicas)
+	}
+	for replicaName, updatedReplica := range updatedReplicas {
+		startedReplicas[replicaName] = updatedReplica
+	}
+
+	addedReplicas, failedToBeAddedReplicas, err := serviceNetwork.AddServices(ctx, replicaConfigsToAdd, len(replicaConfigsToAdd))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the replicas of service '%s'", replicaGroup)
+	}
+	if len(failedToBeAddedReplicas) > 0 {
+		return nil, stacktrace.NewError("Some replicas of service '%s' failed to start, so none were added. Errors were:\n%v", replicaGroup, failedToBeAddedReplicas)
+	}
+	for replicaName, addedReplica := range addedReplicas {
+		startedReplicas[replicaName] = addedReplica
+	}
+	return startedReplicas, nil
+}
@@ -90,10 +90,26 @@ func (builtin *RemoveServiceCapabilities) Validate(_ *builtin_argument.ArgumentV
 	validatorEnvironment.RemoveServiceFromPrivatePortIDMapping(builtin.serviceName)
 	validatorEnvironment.FreeMemory(builtin.serviceName)
 	validatorEnvironment.FreeCPU(builtin.serviceName)
+	validatorEnvironment.FreeHostPorts(builtin.serviceName)
+	validatorEnvironment.FreeResourceQuota(builtin.serviceName)
 	return nil
 }
 
 func (builtin *RemoveServiceCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	// Removing a replicated service removes all its replicas
+	replicaNames, err := builtin.serviceNetwork.GetServiceReplicaNames(builtin.serviceName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the replicas of service '%s'", builtin.serviceName)
+	}
+	if len(replicaNames) > 0 {
+		for _, replicaName := range replicaNames {
+			if _, err := builtin.serviceNetwork.RemoveService(ctx, string(replicaName)); err != nil {
+				return "", stacktrace.Propagate(err, "Failed removing replica '%s' of service '%s' with unexpected error", replicaName, builtin.serviceName)
+			}
+		}
+		return fmt.Sprintf("Service '%s' removed along with its %d replicas", builtin.serviceName, len(replicaNames)), nil
+	}
+
 	serviceUUID, err := builtin.serviceNetwork.RemoveService(ctx, string(builtin.serviceName))
 	if err != nil {
 		return "", stacktrace.Propagate(err, "Failed removing service with unexpected error")
@@ -32,6 +32,7 @@ const (
 	templatesAndDataArgName = "config"
 	templateFieldKey        = "template"
 	templateDataFieldKey    = "data"
+	templateFormatFieldKey  = "format"
 	jsonParsingThreadName   = "Unused thread name"
 	jsonParsingModuleId     = "Unused module id"
 	descriptionFormatStr    = "Rendering a template to a files artifact with name '%v'"
@@ -118,6 +119,17 @@ func (builtin *RenderTemplatesCapabilities) Validate(_ *builtin_argument.Argumen
 	if validatorEnvironment.DoesArtifactNameExist(builtin.artifactName) == startosis_validator.ComponentCreatedOrUpdatedDuringPackageRun {
 		return startosis_errors.NewValidationError("There was an error validating '%v' as artifact name '%v' already exists", RenderTemplatesBuiltinName, builtin.artifactName)
 	}
+	for relPathInFilesArtifact, templateData := range builtin.templatesAndDataByDestRelFilepath {
+		referencedArtifactNames, err := templateData.GetReferencedFilesArtifactNames()
+		if err != nil {
+			return startosis_errors.WrapWithValidationError(err, "An error occurred getting the files artifacts referenced by the template for file '%s'", relPathInFilesArtifact)
+		}
+		for _, referencedArtifactName := range referencedArtifactNames {
+			if validatorEnvironment.DoesArtifactNameExist(referencedArtifactName) == startosis_validator.ComponentNotFound {
+				return startosis_errors.NewValidationError("There was an error validating '%s' as artifact name '%s' referenced by the template for file '%s' does not exist", RenderTemplatesBuiltinName, referencedArtifactName, relPathInFilesArtifact)
+			}
+		}
+	}
 	validatorEnvironment.AddArtifactName(builtin.artifactName)
 	return nil
 }
@@ -238,15 +250,36 @@ func parseTemplatesAndData(templatesAndData *starlark.Dict) (map[string]*render_
 			return nil, startosis_errors.NewInterpretationError("Template data for file '%v', '%v' isn't valid JSON", relPathInFilesArtifactStr, templateDataJSONStrValue)
 		}
 		// end Massive Hack
-		templateAndData, err := render_templates.CreateTemplateData(templateStr, string(templateDataJson))
+		templateFormat, interpretationErr := parseTemplateFormat(structValue, relPathInFilesArtifactStr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		templateAndData, err := render_templates.CreateTemplateData(templateStr, string(templateDataJson), templateFormat)
 		if err != nil {
-			return nil, startosis_errors.NewInterpretationError("An error occurred creating the template. Make sure the it is a valid template string.")
+			return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating the template for file '%v'. Make sure the it is a valid template string.", relPathInFilesArtifactStr)
 		}
 		templateAndDataByDestRelFilepath[relPathInFilesArtifactStr] = templateAndData
 	}
 	return templateAndDataByDestRelFilepath, nil
 }
 
+func parseTemplateFormat(structValue *starlarkstruct.Struct, relPathInFilesArtifactStr string) (render_templates.TemplateFormat, *startosis_errors.InterpretationError) {
+	templateFormatStarlarkValue, err := structValue.Attr(templateFormatFieldKey)
+	if err != nil {
+		// the format is optional, templates without one are rendered as plain text and not validated
+		return render_templates.DefaultTemplateFormat, nil
+	}
+	templateFormatStr, castErr := kurtosis_types.SafeCastToString(templateFormatStarlarkValue, fmt.Sprintf("%v[\"%v\"][\"%v\"]", templatesAndDataArgName, relPathInFilesArtifactStr, templateFormatFieldKey))
+	if castErr != nil {
+		return "", castErr
+	}
+	templateFormat := render_templates.TemplateFormat(templateFormatStr)
+	if !templateFormat.IsValid() {
+		return "", startosis_errors.NewInterpretationError("Invalid format '%v' for file '%v'. Valid formats are: %v", templateFormatStr, relPathInFilesArtifactStr, render_templates.AllTemplateFormats())
+	}
+	return templateFormat, nil
+}
+
 func encodeStarlarkObjectAsJSON(object starlark.Value, argNameForLogging string) (string, *startosis_errors.InterpretationError) {
 	jsonifiedVersion := ""
 	thread := &starlark.Thread{
@@ -23,7 +23,7 @@ func TestParseTemplatesAndData_SimpleCaseStruct(t *testing.T) {
 	err := input.SetKey(starlark.String("/foo/bar"), starlarkstruct.FromStringDict(starlarkstruct.Default, templateDataStrDict))
 	require.Nil(t, err)
 
-	expectedTemplateAndData, err := render_templates.CreateTemplateData(template, `{"Boolean":true,"LargeFloat":1231231243.43,"Name":"John","UnixTs":1257894000}`)
+	expectedTemplateAndData, err := render_templates.CreateTemplateData(template, `{"Boolean":true,"LargeFloat":1231231243.43,"Name":"John","UnixTs":1257894000}`, render_templates.DefaultTemplateFormat)
 	require.Nil(t, err)
 	expectedOutput := map[string]*render_templates.TemplateData{
 		"/foo/bar": expectedTemplateAndData,
@@ -52,7 +52,7 @@ func TestParseTemplatesAndData_SimpleCaseDict(t *testing.T) {
 	err = input.SetKey(starlark.String("/foo/bar"), starlarkstruct.FromStringDict(starlarkstruct.Default, templateDataStrDict))
 	require.Nil(t, err)
 
-	expectedTemplateAndData, err := render_templates.CreateTemplateData(template, `{"Boolean":true,"LargeFloat":1231231243.43,"Name":"John","UnixTs":1257894000}`)
+	expectedTemplateAndData, err := render_templates.CreateTemplateData(template, `{"Boolean":true,"LargeFloat":1231231243.43,"Name":"John","UnixTs":1257894000}`, render_templates.DefaultTemplateFormat)
 	require.Nil(t, err)
 	expectedOutput := map[string]*render_templates.TemplateData{
 		"/foo/bar": expectedTemplateAndData,
@@ -0,0 +1,155 @@
+package scale_service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	ScaleServiceBuiltinName = "scale_service"
+
+	ServiceNameArgName = "name"
+	ReplicasArgName    = "replicas"
+
+	minReplicas = 1
+)
+
+const (
+	descriptionFormatStr = "Scaling service '%v' to %d replicas"
+)
+
+func NewScaleService(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ScaleServiceBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+				{
+					Name:              ReplicasArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Int64InRange(value, ReplicasArgName, minReplicas, math.MaxInt32)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &ScaleServiceCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				serviceName: "", // populated at interpretation time
+				replicas:    0,  // populated at interpretation time
+				description: "", // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName: true,
+			ReplicasArgName:    true,
+		},
+	}
+}
+
+type ScaleServiceCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	serviceName service.ServiceName
+	replicas    int
+
+	description string
+}
+
+func (builtin *ScaleServiceCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+	replicas, err := builtin_argument.ExtractArgumentValue[starlark.Int](arguments, ReplicasArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ReplicasArgName)
+	}
+	replicasInt, ok := replicas.Int64()
+	if !ok {
+		return nil, startosis_errors.NewInterpretationError("Couldn't convert the '%s' argument '%v' to an integer", ReplicasArgName, replicas)
+	}
+
+	builtin.serviceName = service.ServiceName(serviceName.GoString())
+	builtin.replicas = int(replicasInt)
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(descriptionFormatStr, builtin.serviceName, builtin.replicas))
+	return starlark.None, nil
+}
+
+func (builtin *ScaleServiceCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if validatorEnvironment.DoesServiceNameExist(builtin.serviceName) == startosis_validator.ComponentNotFound {
+		return startosis_errors.NewValidationError("There was an error validating '%v' as service name '%v' doesn't exist", ScaleServiceBuiltinName, builtin.serviceName)
+	}
+	// Later instructions can reference the replicas this instruction adds
+	for replicaIndex := 0; replicaIndex < builtin.replicas; replicaIndex++ {
+		replicaName := service_network.GetReplicaName(builtin.serviceName, replicaIndex)
+		if validationErr := validatorEnvironment.ReserveResourceQuotaForReplica(builtin.serviceName, replicaName); validationErr != nil {
+			return validationErr
+		}
+		validatorEnvironment.AddServiceName(replicaName)
+	}
+	return nil
+}
+
+func (builtin *ScaleServiceCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	addedReplicas, err := builtin.serviceNetwork.ScaleService(ctx, builtin.serviceName, builtin.replicas)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Failed scaling service '%s' to %d replicas with unexpected error", builtin.serviceName, builtin.replicas)
+	}
+	instructionResult := fmt.Sprintf("Service '%s' scaled to %d replicas (%d added)", builtin.serviceName, builtin.replicas, len(addedReplicas))
+	return instructionResult, nil
+}
+
+func (builtin *ScaleServiceCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	// Re-adding the service resets its replicas, so they need to be scaled again
+	if instructionsAreEqual && enclaveComponents.HasServiceBeenUpdated(builtin.serviceName) {
+		return enclave_structure.InstructionIsUpdate
+	} else if instructionsAreEqual {
+		return enclave_structure.InstructionIsEqual
+	}
+	return enclave_structure.InstructionIsUnknown
+}
+
+func (builtin *ScaleServiceCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(
+		ScaleServiceBuiltinName,
+	).AddServiceName(
+		builtin.serviceName,
+	)
+}
+
+func (builtin *ScaleServiceCapabilities) UpdatePlan(_ *plan_yaml.PlanYaml) error {
+	// scale service does not affect the plan
+	return nil
+}
+
+func (builtin *ScaleServiceCapabilities) Description() string {
+	return builtin.description
+}
@@ -64,6 +64,26 @@ func GetOrReplaceRuntimeValueFromString(originalString string, runtimeValueStore
 	}
 }
 
+// GetRuntimeValueUuids returns the UUIDs of the runtime values referenced by the magic strings contained in the
+// string, in order of first appearance and without duplicates
+func GetRuntimeValueUuids(originalString string) ([]string, error) {
+	runtimeValueMatchIndex := compiledRuntimeValueReplacementRegex.SubexpIndex(runtimeValueSubgroupName)
+	if runtimeValueMatchIndex == subExpNotFound {
+		return nil, stacktrace.NewError("There was an error in finding the sub group '%v' in regexp '%v'. This is a Kurtosis Bug", runtimeValueSubgroupName, compiledRuntimeValueReplacementRegex.String())
+	}
+	runtimeValueUuids := []string{}
+	alreadySeenRuntimeValueUuids := map[string]bool{}
+	for _, match := range compiledRuntimeValueReplacementRegex.FindAllStringSubmatch(originalString, unlimitedMatches) {
+		runtimeValueUuid := match[runtimeValueMatchIndex]
+		if alreadySeenRuntimeValueUuids[runtimeValueUuid] {
+			continue
+		}
+		alreadySeenRuntimeValueUuids[runtimeValueUuid] = true
+		runtimeValueUuids = append(runtimeValueUuids, runtimeValueUuid)
+	}
+	return runtimeValueUuids, nil
+}
+
 func getRuntimeValueFromRegexMatch(match []string, runtimeValueStore *runtime_value_store.RuntimeValueStore) (starlark.Comparable, error) {
 	runtimeValueMatchIndex := compiledRuntimeValueReplacementRegex.SubexpIndex(runtimeValueSubgroupName)
 	if runtimeValueMatchIndex == subExpNotFound {
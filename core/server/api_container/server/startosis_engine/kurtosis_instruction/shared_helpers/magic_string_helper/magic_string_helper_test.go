@@ -90,6 +90,24 @@ func TestReplaceRuntimeValueFromString(t *testing.T) {
 	require.Equal(t, resolvedInterpolatedString, testExpectedInterpolatedString.GoString())
 }
 
+func TestGetRuntimeValueUuids(t *testing.T) {
+	firstUuid := "a1b2c3d4e5f60718293a4b5c6d7e8f90"
+	secondUuid := "0f9e8d7c6b5a49382716a5b4c3d2e1f0"
+	originalString := fmt.Sprintf(
+		"%v %v %v",
+		fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, firstUuid, "code"),
+		fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, secondUuid, testRuntimeValueField),
+		fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, firstUuid, "output"),
+	)
+	runtimeValueUuids, err := GetRuntimeValueUuids(originalString)
+	require.NoError(t, err)
+	require.Equal(t, []string{firstUuid, secondUuid}, runtimeValueUuids)
+
+	runtimeValueUuids, err = GetRuntimeValueUuids("no runtime value here")
+	require.NoError(t, err)
+	require.Empty(t, runtimeValueUuids)
+}
+
 func getEnclaveDBForTest(t *testing.T) *enclave_db.EnclaveDB {
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer func() {
@@ -74,6 +74,28 @@ func ExecuteServiceAssertionWithRecipe(
 
 }
 
+// ExecuteServiceAssertionOnceWithRecipe runs the recipe a single time and asserts on its result, unlike
+// ExecuteServiceAssertionWithRecipe which retries until the assertion passes or the timeout is reached
+func ExecuteServiceAssertionOnceWithRecipe(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	recipe recipe.Recipe,
+	valueField string,
+	assertion string,
+	target starlark.Comparable,
+) error {
+	result, err := execRequestAndGetValue(ctx, serviceNetwork, runtimeValueStore, serviceName, recipe, valueField)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred executing the recipe on service '%v'", serviceName)
+	}
+	if err := assertResult(result[valueField], assertion, target); err != nil {
+		return stacktrace.Propagate(err, "The assertion failed on the result of the recipe executed on service '%v'", serviceName)
+	}
+	return nil
+}
+
 func assertResult(currentResult starlark.Comparable, assertion string, target starlark.Comparable) error {
 	err := verify.Verify(currentResult, assertion, target)
 	if err != nil {
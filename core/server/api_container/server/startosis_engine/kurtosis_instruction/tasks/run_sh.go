@@ -364,7 +364,7 @@ func repacaeMagicStringsInEnvVars(runtimeValueStore *runtime_value_store.Runtime
 		serviceConfig.GetImageRegistrySpec(),
 		serviceConfig.GetNixBuildSpec(),
 		serviceConfig.GetPrivatePorts(),
-		serviceConfig.GetPublicPorts(),
+		nil, // the port publish specs are copied over below, as they can be more than static public ports
 		serviceConfig.GetEntrypointArgs(),
 		serviceConfig.GetCmdArgs(),
 		envVars,
@@ -384,6 +384,7 @@ func repacaeMagicStringsInEnvVars(runtimeValueStore *runtime_value_store.Runtime
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating a service config with env var magric strings replaced.")
 	}
+	renderedServiceConfig.SetPortPublishSpecs(serviceConfig.GetPortPublishSpecs())
 
 	return renderedServiceConfig, nil
 }
@@ -2,6 +2,8 @@ package kurtosis_plan_instruction
 
 import (
 	"context"
+	"time"
+
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
@@ -11,6 +13,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/prometheus_metrics"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/tracing"
 	"go.starlark.net/starlark"
 )
 
@@ -59,6 +63,7 @@ func (builtin *kurtosisPlanInstructionInternal) GetPositionInOriginalScript() *k
 // ValidateAndUpdateEnvironment is here to ease transition to the new framework and to implement the KurtosisInstruction interface.
 // Remove it when it's not needed anymore
 func (builtin *kurtosisPlanInstructionInternal) ValidateAndUpdateEnvironment(environment *startosis_validator.ValidatorEnvironment) error {
+	defer prometheus_metrics.ObserveSince(prometheus_metrics.StarlarkInstructionValidationDuration.WithLabelValues(builtin.GetName()), time.Now())
 	validationErr := builtin.Validate(environment)
 	if validationErr != nil {
 		return validationErr
@@ -71,7 +76,9 @@ func (builtin *kurtosisPlanInstructionInternal) Validate(validatorEnvironment *s
 }
 
 func (builtin *kurtosisPlanInstructionInternal) Execute(ctx context.Context) (*string, error) {
+	executionStart := time.Now()
 	result, err := builtin.capabilities.Execute(ctx, builtin.GetArguments())
+	prometheus_metrics.ObserveSince(prometheus_metrics.StarlarkInstructionExecutionDuration.WithLabelValues(builtin.GetName(), prometheus_metrics.GetResult(err)), executionStart)
 	if err != nil {
 		return nil, err
 	}
@@ -102,10 +109,19 @@ func (builtin *kurtosisPlanInstructionInternal) UpdatePlan(plan *plan_yaml.PlanY
 	return builtin.capabilities.UpdatePlan(plan)
 }
 
-func (builtin *kurtosisPlanInstructionInternal) interpret() (starlark.Value, *startosis_errors.InterpretationError) {
+func (builtin *kurtosisPlanInstructionInternal) interpret(ctx context.Context) (starlark.Value, *startosis_errors.InterpretationError) {
+	defer prometheus_metrics.ObserveSince(prometheus_metrics.StarlarkInstructionInterpretationDuration.WithLabelValues(builtin.GetName()), time.Now())
+	_, span := tracing.StartSpan(
+		ctx,
+		tracing.InterpretInstructionSpanName(builtin.GetName()),
+		tracing.InstructionKey.String(builtin.GetName()),
+		tracing.InstructionPositionKey.String(builtin.GetPosition().String()),
+	)
 	result, interpretationErr := builtin.capabilities.Interpret(builtin.GetPosition().GetFilename(), builtin.GetArguments())
 	if interpretationErr != nil {
+		tracing.EndSpan(span, interpretationErr)
 		return nil, interpretationErr
 	}
+	tracing.EndSpan(span, nil)
 	return result, nil
 }
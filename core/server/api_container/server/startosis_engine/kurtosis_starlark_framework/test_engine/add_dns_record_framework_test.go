@@ -0,0 +1,68 @@
+package test_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_dns"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_dns_record"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+const (
+	testDnsRecordName     = "_http._tcp.api.internal.company.com"
+	testDnsRecordType     = "SRV"
+	testDnsRecordPort     = 8080
+	testDnsRecordPriority = 10
+)
+
+type addDnsRecordTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestAddDnsRecord() {
+	expectedRecord, err := enclave_dns.NewDnsRecord(testDnsRecordName, testDnsRecordType, string(testServiceName), testDnsRecordPort, testDnsRecordPriority, 0)
+	suite.Require().NoError(err)
+	suite.serviceNetwork.EXPECT().AddDnsRecord(expectedRecord).Times(1).Return(nil)
+
+	suite.run(&addDnsRecordTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *addDnsRecordTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return add_dns_record.NewAddDnsRecord(t.serviceNetwork)
+}
+
+func (t *addDnsRecordTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf(
+		"%s(%s=%q, %s=%q, %s=%q, %s=%d, %s=%d)",
+		add_dns_record.AddDnsRecordBuiltinName,
+		add_dns_record.NameArgName,
+		testDnsRecordName,
+		add_dns_record.TargetArgName,
+		testServiceName,
+		add_dns_record.TypeArgName,
+		testDnsRecordType,
+		add_dns_record.PortArgName,
+		testDnsRecordPort,
+		add_dns_record.PriorityArgName,
+		testDnsRecordPriority,
+	)
+}
+
+func (t *addDnsRecordTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *addDnsRecordTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("DNS record '%s SRV %d 0 %s:%d' added", testDnsRecordName, testDnsRecordPriority, testServiceName, testDnsRecordPort)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
@@ -60,6 +61,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddService() {
 				image_download_mode.ImageDownloadMode_Missing,
 			)
 			require.NoError(suite.T(), err)
+			expectedServiceConfig.SetPrivateIPv6AddrPlaceholder(service_config.DefaultPrivateIPv6AddrPlaceholder)
 
 			actualServiceConfig := serviceConfig
 			suite.Assert().Equal(expectedServiceConfig, actualServiceConfig)
@@ -70,6 +72,8 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddService() {
 		nil,
 	)
 
+	suite.serviceNetwork.EXPECT().SetServiceHealthCheck(testServiceName, (*service_health.HealthCheck)(nil)).Times(1)
+
 	suite.run(&addServiceTestCase{
 		T:                            suite.T(),
 		serviceNetwork:               suite.serviceNetwork,
@@ -103,7 +107,7 @@ func (t *addServiceTestCase) Assert(interpretationResult starlark.Value, executi
 	serviceObj, ok := interpretationResult.(*kurtosis_types.Service)
 	require.True(t, ok, "interpretation result should be a dictionary")
 	require.NotNil(t, serviceObj)
-	expectedServiceObj := fmt.Sprintf(`Service\(name="%v", hostname="{{kurtosis:[0-9a-f]{32}:hostname.runtime_value}}", ip_address="{{kurtosis:[0-9a-f]{32}:ip_address.runtime_value}}", ports={}\)`, testServiceName)
+	expectedServiceObj := fmt.Sprintf(`Service\(name="%v", hostname="{{kurtosis:[0-9a-f]{32}:hostname.runtime_value}}", ip_address="{{kurtosis:[0-9a-f]{32}:ip_address.runtime_value}}", ports={}, ipv6_address="{{kurtosis:[0-9a-f]{32}:ipv6_address.runtime_value}}"\)`, testServiceName)
 	require.Regexp(t, expectedServiceObj, serviceObj.String())
 
 	expectedExecutionResult := fmt.Sprintf("Service '%s' added with service UUID '%s'", testServiceName, testServiceUuid)
@@ -0,0 +1,126 @@
+package test_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/mock_package_content_provider"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+const (
+	testReplicas = 2
+)
+
+var (
+	testReplicaName0        = service_network.GetReplicaName(testServiceName, 0)
+	testReplicaName1        = service_network.GetReplicaName(testServiceName, 1)
+	testLeftoverReplicaName = service_network.GetReplicaName(testServiceName, 2)
+)
+
+type addServiceReplicasTestCase struct {
+	*testing.T
+	serviceNetwork               *service_network.MockServiceNetwork
+	runtimeValueStore            *runtime_value_store.RuntimeValueStore
+	packageContentProvider       *mock_package_content_provider.MockPackageContentProvider
+	interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestAddServiceWithReplicas() {
+	isReplicaConfig := func(serviceConfig *service.ServiceConfig) bool {
+		return serviceConfig.GetContainerImageName() == testContainerImageName && serviceConfig.GetReplicaGroup() == string(testServiceName)
+	}
+
+	suite.serviceNetwork.EXPECT().ExistServiceRegistration(testServiceName).Times(1).Return(false, nil)
+	// A previous run left replicas 0 and 2 around: 0 gets updated, 1 added and 2 removed
+	suite.serviceNetwork.EXPECT().GetServiceReplicaNames(testServiceName).Times(1).Return(
+		[]service.ServiceName{testReplicaName0, testLeftoverReplicaName},
+		nil,
+	)
+	suite.serviceNetwork.EXPECT().RemoveService(mock.Anything, string(testLeftoverReplicaName)).Times(1).Return(testServiceUuid, nil)
+	suite.serviceNetwork.EXPECT().UpdateServices(
+		mock.Anything,
+		mock.MatchedBy(func(configs map[service.ServiceName]*service.ServiceConfig) bool {
+			return len(configs) == 1 && isReplicaConfig(configs[testReplicaName0])
+		}),
+		mock.Anything,
+	).Times(1).Return(
+		map[service.ServiceName]*service.Service{
+			testReplicaName0: service.NewService(service.NewServiceRegistration(testReplicaName0, testServiceUuid, testEnclaveUuid, nil, string(testReplicaName0)), nil, nil, nil, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil)),
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
+	suite.serviceNetwork.EXPECT().AddServices(
+		mock.Anything,
+		mock.MatchedBy(func(configs map[service.ServiceName]*service.ServiceConfig) bool {
+			return len(configs) == 1 && isReplicaConfig(configs[testReplicaName1])
+		}),
+		mock.Anything,
+	).Times(1).Return(
+		map[service.ServiceName]*service.Service{
+			testReplicaName1: service.NewService(service.NewServiceRegistration(testReplicaName1, testServiceUuid2, testEnclaveUuid, nil, string(testReplicaName1)), nil, nil, nil, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil)),
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
+	suite.serviceNetwork.EXPECT().SetServiceHealthCheck(testReplicaName0, (*service_health.HealthCheck)(nil)).Times(1)
+	suite.serviceNetwork.EXPECT().SetServiceHealthCheck(testReplicaName1, (*service_health.HealthCheck)(nil)).Times(1)
+
+	suite.run(&addServiceReplicasTestCase{
+		T:                            suite.T(),
+		serviceNetwork:               suite.serviceNetwork,
+		runtimeValueStore:            suite.runtimeValueStore,
+		packageContentProvider:       suite.packageContentProvider,
+		interpretationTimeValueStore: suite.interpretationTimeValueStore,
+	})
+
+	replicaObj, err := suite.interpretationTimeValueStore.GetService(testReplicaName1)
+	suite.Require().NoError(err)
+	replicaName, interpretationErr := replicaObj.GetName()
+	suite.Require().Nil(interpretationErr)
+	suite.Require().Equal(testReplicaName1, replicaName)
+}
+
+func (t *addServiceReplicasTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return add_service.NewAddService(
+		t.serviceNetwork,
+		t.runtimeValueStore,
+		testModulePackageId,
+		t.packageContentProvider,
+		testNoPackageReplaceOptions,
+		t.interpretationTimeValueStore,
+		image_download_mode.ImageDownloadMode_Missing)
+}
+
+func (t *addServiceReplicasTestCase) GetStarlarkCode() string {
+	serviceConfig := fmt.Sprintf("ServiceConfig(image=%q, %s=%d)", testContainerImageName, service_config.ReplicasAttr, testReplicas)
+	return fmt.Sprintf(`%s(%s=%q, %s=%s)`, add_service.AddServiceBuiltinName, add_service.ServiceNameArgName, testServiceName, add_service.ServiceConfigArgName, serviceConfig)
+}
+
+func (t *addServiceReplicasTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *addServiceReplicasTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	serviceObj, ok := interpretationResult.(*kurtosis_types.Service)
+	require.True(t, ok, "interpretation result should be a service")
+	expectedServiceObj := fmt.Sprintf(`Service\(name="%v", hostname="{{kurtosis:[0-9a-f]{32}:hostname.runtime_value}}", ip_address="{{kurtosis:[0-9a-f]{32}:ip_address.runtime_value}}", ports={}, ipv6_address="{{kurtosis:[0-9a-f]{32}:ipv6_address.runtime_value}}"\)`, testServiceName)
+	require.Regexp(t, expectedServiceObj, serviceObj.String())
+
+	expectedExecutionResult := fmt.Sprintf("Service '%s' added with %d replicas", testServiceName, testReplicas)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
@@ -76,6 +77,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddServices() {
 				image_download_mode.ImageDownloadMode_Missing,
 			)
 			require.NoError(suite.T(), err)
+			expectedServiceConfig1.SetPrivateIPv6AddrPlaceholder(service_config.DefaultPrivateIPv6AddrPlaceholder)
 
 			actualServiceConfig1 := configs[testServiceName]
 			suite.Assert().Equal(expectedServiceConfig1, actualServiceConfig1)
@@ -104,6 +106,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddServices() {
 				image_download_mode.ImageDownloadMode_Missing,
 			)
 			require.NoError(suite.T(), err)
+			expectedServiceConfig2.SetPrivateIPv6AddrPlaceholder(service_config.DefaultPrivateIPv6AddrPlaceholder)
 
 			actualServiceConfig2 := configs[testServiceName2]
 			suite.Assert().Equal(expectedServiceConfig2, actualServiceConfig2)
@@ -205,6 +208,9 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddServices() {
 		TLS:              nil,
 	}, nil)
 
+	suite.serviceNetwork.EXPECT().SetServiceHealthCheck(testServiceName, (*service_health.HealthCheck)(nil)).Times(1)
+	suite.serviceNetwork.EXPECT().SetServiceHealthCheck(testServiceName2, (*service_health.HealthCheck)(nil)).Times(1)
+
 	suite.run(&addServicesTestCase{
 		T:                            suite.T(),
 		serviceNetwork:               suite.serviceNetwork,
@@ -0,0 +1,51 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type containerSpecTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestContainerSpec() {
+	suite.run(&containerSpecTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *containerSpecTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=[%q], %s={%q: %q}, %s={%q: %q})",
+		service_config.ContainerSpecTypeName,
+		service_config.ContainerNameAttr,
+		testContainerSpecName,
+		service_config.ImageAttr,
+		testContainerSpecImageName,
+		service_config.CmdAttr,
+		testContainerSpecCmdArg,
+		service_config.EnvVarsAttr,
+		testContainerSpecEnvVarKey,
+		testContainerSpecEnvVarValue,
+		service_config.FilesAttr,
+		testContainerSpecDirpath,
+		testContainerSpecServiceDirpath,
+	)
+}
+
+func (t *containerSpecTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	receivedContainerSpec, ok := typeValue.(*service_config.ContainerSpec)
+	require.True(t, ok)
+
+	containerSpec, interpretationErr := receivedContainerSpec.ToKurtosisType()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, testContainerSpecName, containerSpec.GetName())
+	require.Equal(t, testContainerSpecImageName, containerSpec.GetImageName())
+	require.Nil(t, containerSpec.GetEntrypointArgs())
+	require.Equal(t, []string{testContainerSpecCmdArg}, containerSpec.GetCmdArgs())
+	require.Equal(t, map[string]string{testContainerSpecEnvVarKey: testContainerSpecEnvVarValue}, containerSpec.GetEnvVars())
+	require.Equal(t, map[string]string{testContainerSpecDirpath: testContainerSpecServiceDirpath}, containerSpec.GetDirpathsToServiceDirpaths())
+}
@@ -0,0 +1,93 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type healthCheckTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestHealthCheck() {
+	suite.run(&healthCheckTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *healthCheckTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%s(%s=%q, %s=%q), %s=%q, %s=%q, %s=%s, %s=%q, %s=%q, %s=%d)",
+		service_config.HealthCheckTypeName,
+		service_config.RecipeAttr,
+		recipe.GetHttpRecipeTypeName,
+		recipe.PortIdAttr,
+		testReadyConditionsRecipePortId,
+		recipe.EndpointAttr,
+		testReadyConditionsRecipeEndpoint,
+		service_config.FieldAttr,
+		testReadyConditionsField,
+		service_config.AssertionAttr,
+		testReadyConditionsAssertion,
+		service_config.TargetAttr,
+		testReadyConditionsTarget,
+		service_config.IntervalAttr,
+		testHealthCheckInterval,
+		service_config.TimeoutAttr,
+		testHealthCheckTimeout,
+		service_config.FailureThresholdAttr,
+		testHealthCheckFailureThreshold,
+	)
+}
+
+func (t *healthCheckTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	receivedHealthCheck, ok := typeValue.(*service_config.HealthCheck)
+	require.True(t, ok)
+
+	uncastedRecipe, err := receivedHealthCheck.GetRecipe()
+	if assert.Nil(t, err) {
+		_, ok := uncastedRecipe.(*recipe.GetHttpRequestRecipe)
+		require.True(t, ok)
+	}
+
+	field, err := receivedHealthCheck.GetField()
+	if assert.Nil(t, err) {
+		require.Equal(t, testReadyConditionsField, field)
+	}
+
+	assertion, err := receivedHealthCheck.GetAssertion()
+	if assert.Nil(t, err) {
+		require.Equal(t, testReadyConditionsAssertion, assertion)
+	}
+
+	target, err := receivedHealthCheck.GetTarget()
+	if assert.Nil(t, err) {
+		require.Equal(t, testReadyConditionsTarget, target.String())
+	}
+
+	interval, err := receivedHealthCheck.GetInterval()
+	if assert.Nil(t, err) {
+		expectedInterval, err := time.ParseDuration(testHealthCheckInterval)
+		if assert.Nil(t, err) {
+			require.Equal(t, expectedInterval, interval)
+		}
+	}
+
+	timeout, err := receivedHealthCheck.GetTimeout()
+	if assert.Nil(t, err) {
+		expectedTimeout, err := time.ParseDuration(testHealthCheckTimeout)
+		if assert.Nil(t, err) {
+			require.Equal(t, expectedTimeout, timeout)
+		}
+	}
+
+	failureThreshold, err := receivedHealthCheck.GetFailureThreshold()
+	if assert.Nil(t, err) {
+		require.Equal(t, testHealthCheckFailureThreshold, failureThreshold)
+	}
+}
@@ -0,0 +1,40 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type portPublishingTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestPortPublishing() {
+	suite.run(&portPublishingTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *portPublishingTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q)",
+		service_config.PortPublishingTypeName,
+		service_config.HostPortRangeAttr,
+		testHostPortRangeName,
+	)
+}
+
+func (t *portPublishingTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	receivedPortPublishing, ok := typeValue.(*service_config.PortPublishing)
+	require.True(t, ok)
+
+	portPublishSpec, interpretationErr := receivedPortPublishing.ToKurtosisType()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, port_publish_spec.PortPublishMode_Range, portPublishSpec.GetMode())
+	require.Equal(t, testHostPortRangeName, portPublishSpec.GetRangeName())
+	// Only the engine knows the bounds of the range
+	require.Nil(t, portPublishSpec.GetResolvedRange())
+}
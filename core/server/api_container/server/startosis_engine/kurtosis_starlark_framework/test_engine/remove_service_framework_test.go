@@ -2,6 +2,7 @@ package test_engine
 
 import (
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_service"
@@ -19,6 +20,7 @@ type removeServiceTestCase struct {
 }
 
 func (suite *KurtosisPlanInstructionTestSuite) TestRemoveService() {
+	suite.serviceNetwork.EXPECT().GetServiceReplicaNames(testServiceName).Times(1).Return([]service.ServiceName{}, nil)
 	suite.serviceNetwork.EXPECT().RemoveService(
 		mock.Anything,
 		string(testServiceName),
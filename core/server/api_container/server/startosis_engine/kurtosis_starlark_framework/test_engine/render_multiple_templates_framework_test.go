@@ -33,10 +33,10 @@ type renderMultipleTemplatesTestCase struct {
 func (suite *KurtosisPlanInstructionTestSuite) TestRenderMultipleTemplates() {
 	// We expect double quotes for the serialized JSON, for some reasons... See arg_parser.encodeStarlarkObjectAsJSON
 	data1WithDoubleQuote := fmt.Sprintf("%q", renderTemplate_MultipleTemplates_1_data)
-	templateData1, err := render_templates2.CreateTemplateData(renderTemplate_MultipleTemplates_1_template, data1WithDoubleQuote)
+	templateData1, err := render_templates2.CreateTemplateData(renderTemplate_MultipleTemplates_1_template, data1WithDoubleQuote, render_templates2.DefaultTemplateFormat)
 	suite.Require().Nil(err)
 	data2WithDoubleQuote := fmt.Sprintf("%q", renderTemplate_MultipleTemplates_2_data)
-	templateData2, err := render_templates2.CreateTemplateData(renderTemplate_MultipleTemplates_2_template, data2WithDoubleQuote)
+	templateData2, err := render_templates2.CreateTemplateData(renderTemplate_MultipleTemplates_2_template, data2WithDoubleQuote, render_templates2.DefaultTemplateFormat)
 	suite.Require().Nil(err)
 	templatesAndData := map[string]*render_templates2.TemplateData{
 		renderTemplate_MultipleTemplates_1_filePath: templateData1,
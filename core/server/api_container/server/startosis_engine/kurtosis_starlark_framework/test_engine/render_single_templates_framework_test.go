@@ -28,7 +28,7 @@ type renderSingleTemplateTestCase struct {
 func (suite *KurtosisPlanInstructionTestSuite) TestRenderSingleTemplate() {
 	// We expect double quotes for the serialized JSON, for some reasons... See arg_parser.encodeStarlarkObjectAsJSON
 	dataWithDoubleQuote := fmt.Sprintf("%q", renderTemplate_SingleTemplate_data)
-	templateData, err := render_templates2.CreateTemplateData(renderTemplate_SingleTemplate_template, dataWithDoubleQuote)
+	templateData, err := render_templates2.CreateTemplateData(renderTemplate_SingleTemplate_template, dataWithDoubleQuote, render_templates2.DefaultTemplateFormat)
 	suite.Require().Nil(err)
 	templateAndData := map[string]*render_templates2.TemplateData{
 		renderTemplate_SingleTemplate_filePath: templateData,
@@ -0,0 +1,65 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	render_templates2 "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	renderTemplate_WithFormat_filePath = "/config/app.yaml"
+	renderTemplate_WithFormat_data     = `{"Name":"api","Replicas":2,"Labels":{"team":"infra"}}`
+	renderTemplate_WithFormat_template = "name: {{ .Name | upper | quote }}\nreplicas: {{ add .Replicas 1 }}\nlabels:{{ toYaml .Labels | nindent 2 }}"
+	renderTemplate_WithFormat_format   = "yaml"
+)
+
+type renderTemplateWithFormatTestCase struct {
+	*testing.T
+
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestRenderTemplateWithFormat() {
+	// We expect double quotes for the serialized JSON, for some reasons... See arg_parser.encodeStarlarkObjectAsJSON
+	dataWithDoubleQuote := fmt.Sprintf("%q", renderTemplate_WithFormat_data)
+	templateData, err := render_templates2.CreateTemplateData(renderTemplate_WithFormat_template, dataWithDoubleQuote, render_templates2.TemplateFormat_Yaml)
+	suite.Require().Nil(err)
+	templateAndData := map[string]*render_templates2.TemplateData{
+		renderTemplate_WithFormat_filePath: templateData,
+	}
+
+	suite.serviceNetwork.EXPECT().RenderTemplates(templateAndData, testArtifactName).Times(1).Return(testArtifactUuid, nil)
+
+	suite.run(&renderTemplateWithFormatTestCase{
+		T:                 suite.T(),
+		serviceNetwork:    suite.serviceNetwork,
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *renderTemplateWithFormatTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return render_templates.NewRenderTemplatesInstruction(t.serviceNetwork, t.runtimeValueStore)
+}
+
+func (t *renderTemplateWithFormatTestCase) GetStarlarkCode() string {
+	configValue := fmt.Sprintf(`{%q: struct(data=%q, format=%q, template=%q)}`, renderTemplate_WithFormat_filePath, renderTemplate_WithFormat_data, renderTemplate_WithFormat_format, renderTemplate_WithFormat_template)
+	return fmt.Sprintf(`%s(%s=%s, %s=%q)`, render_templates.RenderTemplatesBuiltinName, render_templates.TemplateAndDataByDestinationRelFilepathArg, configValue, render_templates.ArtifactNameArgName, testArtifactName)
+}
+
+func (t *renderTemplateWithFormatTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *renderTemplateWithFormatTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.String(testArtifactName), interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Templates artifact name '%s' rendered with artifact UUID '%s'", testArtifactName, testArtifactUuid)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
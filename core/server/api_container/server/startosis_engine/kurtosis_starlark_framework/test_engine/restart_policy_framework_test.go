@@ -0,0 +1,53 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_restart_policy"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type restartPolicyTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestRestartPolicy() {
+	suite.run(&restartPolicyTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *restartPolicyTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%d)",
+		service_config.RestartPolicyTypeName,
+		service_config.PolicyAttr,
+		testRestartPolicy,
+		service_config.MaxRetriesAttr,
+		testRestartPolicyMaxRetries,
+	)
+}
+
+func (t *restartPolicyTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	receivedRestartPolicy, ok := typeValue.(*service_config.RestartPolicy)
+	require.True(t, ok)
+
+	serviceRestartPolicy, interpretationErr := receivedRestartPolicy.ToKurtosisType()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, service_restart_policy.ServiceRestartPolicyMode(testRestartPolicy), serviceRestartPolicy.GetMode())
+	maxRetries, hasMaxRetries := serviceRestartPolicy.GetMaxRetries()
+	require.True(t, hasMaxRetries)
+	require.Equal(t, testRestartPolicyMaxRetries, maxRetries)
+}
+
+func TestRestartPolicy_BackoffIsRejected(t *testing.T) {
+	predeclared := starlark.StringDict{
+		service_config.RestartPolicyTypeName: starlark.NewBuiltin(service_config.RestartPolicyTypeName, service_config.NewRestartPolicyType().CreateBuiltin()),
+	}
+	starlarkCode := fmt.Sprintf("%s(%s=%q, %s=%q)", service_config.RestartPolicyTypeName, service_config.PolicyAttr, testRestartPolicy, service_config.BackoffAttr, "10s")
+	_, err := starlark.ExecFile(&starlark.Thread{}, startosis_constants.PackageIdPlaceholderForStandaloneScript, starlarkCode, predeclared) //nolint:exhaustruct
+	require.ErrorContains(t, err, "isn't supported")
+}
@@ -0,0 +1,60 @@
+package test_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/scale_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+const (
+	testScaledReplicas = 3
+)
+
+type scaleServiceTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestScaleService() {
+	suite.serviceNetwork.EXPECT().ScaleService(
+		mock.Anything,
+		testServiceName,
+		testScaledReplicas,
+	).Times(1).Return(
+		map[service.ServiceName]*service.Service{
+			service_network.GetReplicaName(testServiceName, 2): nil,
+		},
+		nil,
+	)
+
+	suite.run(&scaleServiceTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *scaleServiceTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return scale_service.NewScaleService(t.serviceNetwork)
+}
+
+func (t *scaleServiceTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%d)", scale_service.ScaleServiceBuiltinName, scale_service.ServiceNameArgName, testServiceName, scale_service.ReplicasArgName, testScaledReplicas)
+}
+
+func (t *scaleServiceTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *scaleServiceTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Service '%s' scaled to %d replicas (1 added)", testServiceName, testScaledReplicas)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -0,0 +1,40 @@
+package test_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/secret"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+const testSecretName = "db-password"
+
+type secretTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisHelperTestSuite) TestSecret() {
+	suite.run(&secretTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *secretTestCase) GetHelper() *kurtosis_helper.KurtosisHelper {
+	return secret.NewSecretHelper()
+}
+
+func (t *secretTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf(`"postgres://admin:" + %s(%s=%q) + "@db:5432"`, secret.SecretBuiltinName, secret.NameArgName, testSecretName)
+}
+
+func (t *secretTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *secretTestCase) Assert(result starlark.Value) {
+	require.Equal(t, starlark.String("postgres://admin:"+secret_store.GetSecretPlaceholder(testSecretName)+"@db:5432"), result)
+}
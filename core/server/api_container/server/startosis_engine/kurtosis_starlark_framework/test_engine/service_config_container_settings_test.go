@@ -0,0 +1,73 @@
+package test_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_settings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/stretchr/testify/require"
+)
+
+type serviceConfigContainerSettingsTest struct {
+	*testing.T
+	serviceNetwork         *service_network.MockServiceNetwork
+	packageContentProvider *startosis_packages.MockPackageContentProvider
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigWithContainerSettings() {
+	suite.run(&serviceConfigContainerSettingsTest{
+		T:                      suite.T(),
+		serviceNetwork:         suite.serviceNetwork,
+		packageContentProvider: suite.packageContentProvider,
+	})
+}
+
+func (t *serviceConfigContainerSettingsTest) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=True, %s=[%q], %s=[%q], %s={%q: %q}, %s={%q: \"%d:%d\"}, %s=%d, %s=True, %s={%q: %d}, %s={%q: %q})",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, testContainerImageName,
+		service_config.PrivilegedAttr,
+		service_config.CapAddAttr, testContainerSettingsAddedCapability,
+		service_config.CapDropAttr, testContainerSettingsDroppedCapability,
+		service_config.SysctlsAttr, testContainerSettingsSysctlKey, testContainerSettingsSysctlValue,
+		service_config.UlimitsAttr, testContainerSettingsUlimitName, testContainerSettingsUlimitSoft, testContainerSettingsUlimitHard,
+		service_config.ShmSizeAttr, testContainerSettingsShmSizeMegabytes,
+		service_config.ReadOnlyRootFsAttr,
+		service_config.TmpfsAttr, testContainerSettingsTmpfsDirpath, testContainerSettingsTmpfsSizeMegabytes,
+		service_config.ExtraHostsAttr, testContainerSettingsExtraHostname, testContainerSettingsExtraHostIpAddr,
+	)
+}
+
+func (t *serviceConfigContainerSettingsTest) Assert(typeValue builtin_argument.KurtosisValueType) {
+	serviceConfigStarlark, ok := typeValue.(*service_config.ServiceConfig)
+	require.True(t, ok)
+
+	serviceConfig, interpretationErr := serviceConfigStarlark.ToKurtosisType(
+		t.serviceNetwork,
+		testModuleMainFileLocator,
+		testModulePackageId,
+		t.packageContentProvider,
+		testNoPackageReplaceOptions, image_download_mode.ImageDownloadMode_Missing)
+	require.Nil(t, interpretationErr)
+
+	expectedUlimit, err := container_settings.NewUlimit(testContainerSettingsUlimitSoft, testContainerSettingsUlimitHard)
+	require.NoError(t, err)
+	expectedContainerSettings, err := container_settings.NewContainerSettings(
+		true,
+		[]string{testContainerSettingsAddedCapability},
+		[]string{testContainerSettingsDroppedCapability},
+		map[string]string{testContainerSettingsSysctlKey: testContainerSettingsSysctlValue},
+		map[string]*container_settings.Ulimit{testContainerSettingsUlimitName: expectedUlimit},
+		testContainerSettingsShmSizeMegabytes,
+		true,
+		map[string]uint64{testContainerSettingsTmpfsDirpath: testContainerSettingsTmpfsSizeMegabytes},
+		map[string]string{testContainerSettingsExtraHostname: testContainerSettingsExtraHostIpAddr},
+	)
+	require.NoError(t, err)
+	require.Equal(t, expectedContainerSettings, serviceConfig.GetContainerSettings())
+}
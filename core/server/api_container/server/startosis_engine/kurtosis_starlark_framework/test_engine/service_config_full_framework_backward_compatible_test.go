@@ -3,6 +3,7 @@ package test_engine
 import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
@@ -22,7 +23,7 @@ type serviceConfigFullTestCaseBackwardCompatible struct {
 
 func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigFullBackwardCompatible() {
 	suite.serviceNetwork.EXPECT().GetApiContainerInfo().Times(1).Return(
-		service_network.NewApiContainerInfo(net.IPv4(0, 0, 0, 0), 0, "0.0.0"),
+		service_network.NewApiContainerInfo(net.IPv4(0, 0, 0, 0), 0, "0.0.0", nil),
 	)
 
 	suite.run(&serviceConfigFullTestCaseBackwardCompatible{
@@ -77,12 +78,12 @@ func (t *serviceConfigFullTestCaseBackwardCompatible) Assert(typeValue builtin_a
 	}
 	require.Equal(t, expectedPrivatePorts, serviceConfig.GetPrivatePorts())
 
-	portSpec, errPublicPortSpec := port_spec.NewPortSpec(testPublicPortNumber, testPublicPortProtocol, testPrivateApplicationProtocol, port_spec.NewWait(waitDuration), "")
-	require.NoError(t, errPublicPortSpec)
-	expectedPublicPorts := map[string]*port_spec.PortSpec{
-		testPublicPortId: portSpec,
+	expectedPublicPortPublishSpec, errPublicPortPublishSpec := port_publish_spec.NewStaticPortPublishSpec(testPublicPortNumber)
+	require.NoError(t, errPublicPortPublishSpec)
+	expectedPortPublishSpecs := map[string]*port_publish_spec.PortPublishSpec{
+		testPublicPortId: expectedPublicPortPublishSpec,
 	}
-	require.Equal(t, expectedPublicPorts, serviceConfig.GetPublicPorts())
+	require.Equal(t, expectedPortPublishSpecs, serviceConfig.GetPortPublishSpecs())
 
 	expectedFilesArtifactMap := map[string][]string{
 		testFilesArtifactPath1: {testFilesArtifactName1},
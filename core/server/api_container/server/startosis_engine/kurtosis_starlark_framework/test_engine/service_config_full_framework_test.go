@@ -3,6 +3,7 @@ package test_engine
 import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
@@ -25,7 +26,7 @@ type serviceConfigFullTestCase struct {
 
 func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigFull() {
 	suite.serviceNetwork.EXPECT().GetApiContainerInfo().Times(1).Return(
-		service_network.NewApiContainerInfo(net.IPv4(0, 0, 0, 0), 0, "0.0.0"),
+		service_network.NewApiContainerInfo(net.IPv4(0, 0, 0, 0), 0, "0.0.0", nil),
 	)
 
 	suite.run(&serviceConfigFullTestCase{
@@ -39,7 +40,7 @@ func (t *serviceConfigFullTestCase) GetStarlarkCode() string {
 	fileArtifact1 := fmt.Sprintf("%s(%s=[%q])", directory.DirectoryTypeName, directory.ArtifactNamesAttr, testFilesArtifactName1)
 	fileArtifact2 := fmt.Sprintf("%s(%s=[%q])", directory.DirectoryTypeName, directory.ArtifactNamesAttr, testFilesArtifactName2)
 	persistentDirectory := fmt.Sprintf("%s(%s=%q)", directory.DirectoryTypeName, directory.PersistentKeyAttr, testPersistentDirectoryKey)
-	starlarkCode := fmt.Sprintf("%s(%s=%q, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%q, %s=%d, %s=%d, %s=%d, %s=%d, %s=%s, %s=%v, %s=%v, %s=%v)",
+	starlarkCode := fmt.Sprintf("%s(%s=%q, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%q, %s=%d, %s=%d, %s=%d, %s=%d, %s=%s, %s=%v, %s=%v, %s=%v, %s=%q)",
 		service_config.ServiceConfigTypeName,
 		service_config.ImageAttr, testContainerImageName,
 		service_config.PortsAttr, fmt.Sprintf("{%q: PortSpec(number=%d, transport_protocol=%q, application_protocol=%q, wait=%q)}", testPrivatePortId, testPrivatePortNumber, testPrivatePortProtocolStr, testPrivateApplicationProtocol, testWaitConfiguration),
@@ -57,7 +58,8 @@ func (t *serviceConfigFullTestCase) GetStarlarkCode() string {
 		getDefaultReadyConditionsScriptPart(),
 		service_config.LabelsAttr, fmt.Sprintf("{%q: %q, %q: %q}", testServiceConfigLabelsKey1, testServiceConfigLabelsValue1, testServiceConfigLabelsKey2, testServiceConfigLabelsValue2),
 		service_config.NodeSelectorsAttr, fmt.Sprintf("{%q: %q}", testNodeSelectorKey1, testNodeSelectorValue1),
-		service_config.FilesToBeMovedAttr, fmt.Sprintf("{%q: %q}", testFilesToBeMoved, testFilesToBeMoved))
+		service_config.FilesToBeMovedAttr, fmt.Sprintf("{%q: %q}", testFilesToBeMoved, testFilesToBeMoved),
+		service_config.PrivateIpv6AddressPlaceholderAttr, testPrivateIPv6AddressPlaceholder)
 	return starlarkCode
 }
 
@@ -87,12 +89,12 @@ func (t *serviceConfigFullTestCase) Assert(typeValue builtin_argument.KurtosisVa
 	}
 	require.Equal(t, expectedPrivatePorts, serviceConfig.GetPrivatePorts())
 
-	portSpec, errPublicPortSpec := port_spec.NewPortSpec(testPublicPortNumber, testPublicPortProtocol, testPrivateApplicationProtocol, port_spec.NewWait(waitDuration), "")
-	require.NoError(t, errPublicPortSpec)
-	expectedPublicPorts := map[string]*port_spec.PortSpec{
-		testPublicPortId: portSpec,
+	expectedPublicPortPublishSpec, errPublicPortPublishSpec := port_publish_spec.NewStaticPortPublishSpec(testPublicPortNumber)
+	require.NoError(t, errPublicPortPublishSpec)
+	expectedPortPublishSpecs := map[string]*port_publish_spec.PortPublishSpec{
+		testPublicPortId: expectedPublicPortPublishSpec,
 	}
-	require.Equal(t, expectedPublicPorts, serviceConfig.GetPublicPorts())
+	require.Equal(t, expectedPortPublishSpecs, serviceConfig.GetPortPublishSpecs())
 
 	expectedFilesArtifactMap := map[string][]string{
 		testFilesArtifactPath1: {testFilesArtifactName1},
@@ -122,6 +124,7 @@ func (t *serviceConfigFullTestCase) Assert(typeValue builtin_argument.KurtosisVa
 	require.Equal(t, expectedEnvVars, serviceConfig.GetEnvVars())
 
 	require.Equal(t, testPrivateIPAddressPlaceholder, serviceConfig.GetPrivateIPAddrPlaceholder())
+	require.Equal(t, testPrivateIPv6AddressPlaceholder, serviceConfig.GetPrivateIPv6AddrPlaceholder())
 	require.Equal(t, testMemoryAllocation, serviceConfig.GetMemoryAllocationMegabytes())
 	require.Equal(t, testCpuAllocation, serviceConfig.GetCPUAllocationMillicpus())
 	require.Equal(t, testMinMemoryMegabytes, serviceConfig.GetMinMemoryAllocationMegabytes())
@@ -105,6 +105,7 @@ func (t *serviceConfigImageBuildSpecTestCase) Assert(typeValue builtin_argument.
 		image_download_mode.ImageDownloadMode_Missing,
 	)
 	require.NoError(t, err)
+	expectedServiceConfig.SetPrivateIPv6AddrPlaceholder(service_config.DefaultPrivateIPv6AddrPlaceholder)
 	require.Equal(t, expectedServiceConfig, serviceConfig)
 	require.Equal(t, expectedImageBuildSpec, serviceConfig.GetImageBuildSpec())
 }
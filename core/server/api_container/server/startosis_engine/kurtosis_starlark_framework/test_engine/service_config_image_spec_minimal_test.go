@@ -78,6 +78,7 @@ func (t *serviceConfigImageSpecMinimalTest) Assert(typeValue builtin_argument.Ku
 		image_download_mode.ImageDownloadMode_Missing,
 	)
 	require.NoError(t, err)
+	expectedServiceConfig.SetPrivateIPv6AddrPlaceholder(service_config.DefaultPrivateIPv6AddrPlaceholder)
 	require.Equal(t, expectedServiceConfig, serviceConfig)
 	require.Equal(t, expectedImageRegistrySpec, serviceConfig.GetImageRegistrySpec())
 }
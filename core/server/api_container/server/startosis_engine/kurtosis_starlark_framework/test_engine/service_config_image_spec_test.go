@@ -84,6 +84,7 @@ func (t *serviceConfigImageSpecTest) Assert(typeValue builtin_argument.KurtosisV
 		image_download_mode.ImageDownloadMode_Missing,
 	)
 	require.NoError(t, err)
+	expectedServiceConfig.SetPrivateIPv6AddrPlaceholder(service_config.DefaultPrivateIPv6AddrPlaceholder)
 	require.Equal(t, expectedServiceConfig, serviceConfig)
 	require.Equal(t, expectedImageRegistrySpec, serviceConfig.GetImageRegistrySpec())
 }
@@ -71,6 +71,7 @@ func (t *serviceConfigMinimalTestCase) Assert(typeValue builtin_argument.Kurtosi
 		image_download_mode.ImageDownloadMode_Missing,
 	)
 	require.NoError(t, err)
+	expectedServiceConfig.SetPrivateIPv6AddrPlaceholder(service_config.DefaultPrivateIPv6AddrPlaceholder)
 	require.Equal(t, expectedServiceConfig, serviceConfig)
 	require.Nil(t, serviceConfig.GetImageBuildSpec())
 }
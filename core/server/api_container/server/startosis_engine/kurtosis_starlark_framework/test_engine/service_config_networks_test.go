@@ -0,0 +1,53 @@
+package test_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/stretchr/testify/require"
+)
+
+type serviceConfigNetworksTest struct {
+	*testing.T
+	serviceNetwork         *service_network.MockServiceNetwork
+	packageContentProvider *startosis_packages.MockPackageContentProvider
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigWithNetworks() {
+	suite.run(&serviceConfigNetworksTest{
+		T:                      suite.T(),
+		serviceNetwork:         suite.serviceNetwork,
+		packageContentProvider: suite.packageContentProvider,
+	})
+}
+
+func (t *serviceConfigNetworksTest) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=[%q, %q], %s=[%q])",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, testContainerImageName,
+		service_config.NetworksAttr, testFrontendNetworkName, testBackendNetworkName,
+		service_config.ExternalNetworksAttr, testExternalNetworkName,
+	)
+}
+
+func (t *serviceConfigNetworksTest) Assert(typeValue builtin_argument.KurtosisValueType) {
+	serviceConfigStarlark, ok := typeValue.(*service_config.ServiceConfig)
+	require.True(t, ok)
+
+	serviceConfig, interpretationErr := serviceConfigStarlark.ToKurtosisType(
+		t.serviceNetwork,
+		testModuleMainFileLocator,
+		testModulePackageId,
+		t.packageContentProvider,
+		testNoPackageReplaceOptions, image_download_mode.ImageDownloadMode_Missing)
+	require.Nil(t, interpretationErr)
+
+	// The order matters, as services are known by their IP on their first network
+	require.Equal(t, []string{testFrontendNetworkName, testBackendNetworkName}, serviceConfig.GetNetworks())
+	require.Equal(t, []string{testExternalNetworkName}, serviceConfig.GetExternalNetworks())
+}
@@ -0,0 +1,65 @@
+package test_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/port_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/stretchr/testify/require"
+)
+
+type serviceConfigPortPublishingTest struct {
+	*testing.T
+	serviceNetwork         *service_network.MockServiceNetwork
+	packageContentProvider *startosis_packages.MockPackageContentProvider
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigWithPortPublishing() {
+	suite.run(&serviceConfigPortPublishingTest{
+		T:                      suite.T(),
+		serviceNetwork:         suite.serviceNetwork,
+		packageContentProvider: suite.packageContentProvider,
+	})
+}
+
+func (t *serviceConfigPortPublishingTest) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s={%q: %s(%s=%d), %q: %s(%s=%d)}, %s={%q: %s(%s=%d), %q: %s(%s=%q)})",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, testContainerImageName,
+		service_config.PortsAttr,
+		testPrivatePortId, port_spec.PortSpecTypeName, port_spec.PortNumberAttr, testPrivatePortNumber,
+		testMetricsPortId, port_spec.PortSpecTypeName, port_spec.PortNumberAttr, testMetricsPortNumber,
+		service_config.PortPublishingAttr,
+		testPrivatePortId, service_config.PortPublishingTypeName, service_config.HostPortNumberAttr, testHostPortNumber,
+		testMetricsPortId, service_config.PortPublishingTypeName, service_config.HostPortRangeAttr, testHostPortRangeName,
+	)
+}
+
+func (t *serviceConfigPortPublishingTest) Assert(typeValue builtin_argument.KurtosisValueType) {
+	serviceConfigStarlark, ok := typeValue.(*service_config.ServiceConfig)
+	require.True(t, ok)
+
+	serviceConfig, interpretationErr := serviceConfigStarlark.ToKurtosisType(
+		t.serviceNetwork,
+		testModuleMainFileLocator,
+		testModulePackageId,
+		t.packageContentProvider,
+		testNoPackageReplaceOptions, image_download_mode.ImageDownloadMode_Missing)
+	require.Nil(t, interpretationErr)
+
+	staticPortPublishSpec, err := port_publish_spec.NewStaticPortPublishSpec(testHostPortNumber)
+	require.NoError(t, err)
+	rangePortPublishSpec, err := port_publish_spec.NewRangePortPublishSpec(testHostPortRangeName)
+	require.NoError(t, err)
+	expectedPortPublishSpecs := map[string]*port_publish_spec.PortPublishSpec{
+		testPrivatePortId: staticPortPublishSpec,
+		testMetricsPortId: rangePortPublishSpec,
+	}
+	require.Equal(t, expectedPortPublishSpecs, serviceConfig.GetPortPublishSpecs())
+}
@@ -0,0 +1,64 @@
+package test_engine
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecretFilepath = "/run/secrets/db-password"
+
+type serviceConfigSecretsTest struct {
+	*testing.T
+	serviceNetwork         *service_network.MockServiceNetwork
+	packageContentProvider *startosis_packages.MockPackageContentProvider
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigWithSecrets() {
+	suite.serviceNetwork.EXPECT().GetApiContainerInfo().Times(1).Return(
+		service_network.NewApiContainerInfo(net.IPv4(0, 0, 0, 0), 0, "0.0.0", nil),
+	)
+
+	suite.run(&serviceConfigSecretsTest{
+		T:                      suite.T(),
+		serviceNetwork:         suite.serviceNetwork,
+		packageContentProvider: suite.packageContentProvider,
+	})
+}
+
+func (t *serviceConfigSecretsTest) GetStarlarkCode() string {
+	secretPlaceholder := secret_store.GetSecretPlaceholder(testSecretName)
+	return fmt.Sprintf("%s(%s=%q, %s={%q: %q}, %s={%q: %q})",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, testContainerImageName,
+		service_config.FilesAttr, testSecretFilepath, secretPlaceholder,
+		service_config.EnvVarsAttr, "DB_PASSWORD", secretPlaceholder)
+}
+
+func (t *serviceConfigSecretsTest) Assert(typeValue builtin_argument.KurtosisValueType) {
+	serviceConfigStarlark, ok := typeValue.(*service_config.ServiceConfig)
+	require.True(t, ok)
+
+	serviceConfig, interpretationErr := serviceConfigStarlark.ToKurtosisType(
+		t.serviceNetwork,
+		testModuleMainFileLocator,
+		testModulePackageId,
+		t.packageContentProvider,
+		testNoPackageReplaceOptions, image_download_mode.ImageDownloadMode_Missing)
+	require.Nil(t, interpretationErr)
+
+	// Secrets stay placeholders until the service starts
+	secretPlaceholder := secret_store.GetSecretPlaceholder(testSecretName)
+	require.Equal(t, map[string]string{"DB_PASSWORD": secretPlaceholder}, serviceConfig.GetEnvVars())
+	require.Equal(t, map[string]string{testSecretFilepath: secretPlaceholder}, serviceConfig.GetSecretFiles())
+	require.Empty(t, serviceConfig.GetFilesArtifactsExpansion().ServiceDirpathsToArtifactIdentifiers)
+	require.Equal(t, []string{testSecretName}, secret_store.GetSecretNamesReferencedByServiceConfig(serviceConfig))
+}
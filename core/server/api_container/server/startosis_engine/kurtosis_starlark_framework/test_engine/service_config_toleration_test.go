@@ -85,6 +85,7 @@ func (t *serviceConfigTolerationTest) Assert(typeValue builtin_argument.Kurtosis
 		image_download_mode.ImageDownloadMode_Missing,
 	)
 	require.NoError(t, err)
+	expectedServiceConfig.SetPrivateIPv6AddrPlaceholder(service_config.DefaultPrivateIPv6AddrPlaceholder)
 	require.Equal(t, expectedServiceConfig, serviceConfig)
 	require.Equal(t, expectedTolerations, serviceConfig.GetTolerations())
 }
@@ -9,9 +9,10 @@ import (
 )
 
 const (
-	testServiceIpAddress = "192.168.0.43"
-	testServiceHostname  = "test-service-hostname"
-	testServicePorts     = "{}"
+	testServiceIpAddress   = "192.168.0.43"
+	testServiceIpv6Address = "fd6b:7572:746f:1000::c0a8:2b"
+	testServiceHostname    = "test-service-hostname"
+	testServicePorts       = "{}"
 )
 
 type serviceObjectTestCase struct {
@@ -25,12 +26,13 @@ func (suite *KurtosisTypeConstructorTestSuite) TestServiceObject() {
 }
 
 func (t serviceObjectTestCase) GetStarlarkCode() string {
-	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q, %s=%s)",
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q, %s=%s, %s=%q)",
 		kurtosis_types.ServiceTypeName,
 		kurtosis_types.ServiceNameAttr, testServiceName,
 		kurtosis_types.HostnameAttr, testServiceHostname,
 		kurtosis_types.IpAddressAttr, testServiceIpAddress,
-		kurtosis_types.PortsAttr, testServicePorts)
+		kurtosis_types.PortsAttr, testServicePorts,
+		kurtosis_types.Ipv6AddressAttr, testServiceIpv6Address)
 }
 
 func (t serviceObjectTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
@@ -49,6 +51,10 @@ func (t serviceObjectTestCase) Assert(typeValue builtin_argument.KurtosisValueTy
 	require.Nil(t, interpretationErr)
 	require.Equal(t, testServiceIpAddress, resultServiceIpAddress)
 
+	resultServiceIpv6Address, interpretationErr := serviceStarlark.GetIpv6Address()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, testServiceIpv6Address, resultServiceIpv6Address)
+
 	resultPorts, interpretationErr := serviceStarlark.GetPorts()
 	require.Nil(t, interpretationErr)
 	require.Equal(t, 0, resultPorts.Len())
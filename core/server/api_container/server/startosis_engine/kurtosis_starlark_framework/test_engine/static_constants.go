@@ -98,7 +98,8 @@ var (
 	testEnvVarName2  = "VAR_2"
 	testEnvVarValue2 = "VALUE_2"
 
-	testPrivateIPAddressPlaceholder = "<IP_ADDRESS>"
+	testPrivateIPAddressPlaceholder   = "<IP_ADDRESS>"
+	testPrivateIPv6AddressPlaceholder = "<IPV6_ADDRESS>"
 
 	testCpuAllocation    = uint64(2000)
 	testMemoryAllocation = uint64(1024)
@@ -125,6 +126,43 @@ var (
 	testReadyConditions2Interval       = "500ms"
 	testReadyConditions2Timeout        = "2s"
 
+	testHealthCheckInterval         = "30s"
+	testHealthCheckTimeout          = "2s"
+	testHealthCheckFailureThreshold = uint32(5)
+
+	testRestartPolicy           = "on-failure"
+	testRestartPolicyMaxRetries = uint32(3)
+
+	testContainerSpecName           = "metrics-exporter"
+	testContainerSpecImageName      = "prom/node-exporter:v1.7.0"
+	testContainerSpecCmdArg         = "--path.rootfs=/host"
+	testContainerSpecEnvVarKey      = "LOG_LEVEL"
+	testContainerSpecEnvVarValue    = "debug"
+	testContainerSpecDirpath        = "/host/data"
+	testContainerSpecServiceDirpath = "/data"
+
+	testContainerSettingsAddedCapability    = "BPF"
+	testContainerSettingsDroppedCapability  = "MKNOD"
+	testContainerSettingsSysctlKey          = "net.core.somaxconn"
+	testContainerSettingsSysctlValue        = "1024"
+	testContainerSettingsUlimitName         = "nofile"
+	testContainerSettingsUlimitSoft         = int64(1024)
+	testContainerSettingsUlimitHard         = int64(65536)
+	testContainerSettingsShmSizeMegabytes   = uint64(256)
+	testContainerSettingsTmpfsDirpath       = "/tmp"
+	testContainerSettingsTmpfsSizeMegabytes = uint64(64)
+	testContainerSettingsExtraHostname      = "db.local"
+	testContainerSettingsExtraHostIpAddr    = "10.0.0.5"
+
+	testFrontendNetworkName = "frontend"
+	testBackendNetworkName  = "backend"
+	testExternalNetworkName = "lab_network"
+
+	testHostPortNumber    = uint16(30303)
+	testHostPortRangeName = "p2p"
+	testMetricsPortId     = "metrics"
+	testMetricsPortNumber = uint16(9090)
+
 	testGetRequestMethod = "GET"
 
 	testNoPackageReplaceOptions = map[string]string{}
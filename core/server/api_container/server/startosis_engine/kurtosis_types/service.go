@@ -16,6 +16,8 @@ const (
 	IpAddressAttr   = "ip_address"
 	PortsAttr       = "ports"
 	ServiceNameAttr = "name"
+	// Empty if the enclave doesn't have IPv6 enabled
+	Ipv6AddressAttr = "ipv6_address"
 )
 
 func NewServiceType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -56,6 +58,12 @@ func NewServiceType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 						return nil
 					},
 				},
+				{
+					Name:              Ipv6AddressAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         nil,
+				},
 			},
 		},
 
@@ -77,12 +85,13 @@ type Service struct {
 	*kurtosis_type_constructor.KurtosisValueTypeDefault
 }
 
-func CreateService(serviceName starlark.String, hostname starlark.String, ipAddress starlark.String, ports *starlark.Dict) (*Service, *startosis_errors.InterpretationError) {
+func CreateService(serviceName starlark.String, hostname starlark.String, ipAddress starlark.String, ipv6Address starlark.String, ports *starlark.Dict) (*Service, *startosis_errors.InterpretationError) {
 	args := []starlark.Value{
 		serviceName,
 		hostname,
 		ipAddress,
 		ports,
+		ipv6Address,
 	}
 
 	argumentDefinitions := NewServiceType().KurtosisBaseBuiltin.Arguments
@@ -133,6 +142,15 @@ func (serviceObj *Service) GetIpAddress() (string, *startosis_errors.Interpretat
 	return ipAddress.GoString(), nil
 }
 
+func (serviceObj *Service) GetIpv6Address() (string, *startosis_errors.InterpretationError) {
+	ipv6Address, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		serviceObj.KurtosisValueTypeDefault, Ipv6AddressAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	return ipv6Address.GoString(), nil
+}
+
 func (serviceObj *Service) GetPorts() (*starlark.Dict, *startosis_errors.InterpretationError) {
 	ports, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](
 		serviceObj.KurtosisValueTypeDefault, PortsAttr)
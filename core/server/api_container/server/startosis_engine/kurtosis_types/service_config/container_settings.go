@@ -0,0 +1,217 @@
+package service_config
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_settings"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	// An ulimit is either a single integer used as both the soft and hard limit, or a "soft:hard" string
+	ulimitSoftHardSeparator = ":"
+	ulimitSoftHardNumParts  = 2
+	ulimitBase              = 10
+	ulimitBitSize           = 64
+)
+
+// All the attributes which end up in the container settings of the service
+var containerSettingsAttrs = []string{
+	PrivilegedAttr,
+	CapAddAttr,
+	CapDropAttr,
+	SysctlsAttr,
+	UlimitsAttr,
+	ShmSizeAttr,
+	ReadOnlyRootFsAttr,
+	TmpfsAttr,
+	ExtraHostsAttr,
+}
+
+// getContainerSettings returns nil if none of the container settings attributes are set, so that the container
+// engine applies its defaults
+func (config *ServiceConfig) getContainerSettings() (*container_settings.ContainerSettings, *startosis_errors.InterpretationError) {
+	isAnyContainerSettingSet := false
+	for _, attrName := range containerSettingsAttrs {
+		if _, found, _ := kurtosis_type_constructor.ExtractAttrValue[starlark.Value](config.KurtosisValueTypeDefault, attrName); found {
+			isAnyContainerSettingSet = true
+			break
+		}
+	}
+	if !isAnyContainerSettingSet {
+		return nil, nil
+	}
+
+	isPrivileged, interpretationErr := config.extractBoolAttr(PrivilegedAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	isReadOnlyRootFs, interpretationErr := config.extractBoolAttr(ReadOnlyRootFsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	addedCapabilities, interpretationErr := config.extractStringSliceAttr(CapAddAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	droppedCapabilities, interpretationErr := config.extractStringSliceAttr(CapDropAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	sysctls, interpretationErr := config.extractMapStringStringAttr(SysctlsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	extraHosts, interpretationErr := config.extractMapStringStringAttr(ExtraHostsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	var shmSizeMegabytes uint64
+	shmSizeStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](config.KurtosisValueTypeDefault, ShmSizeAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		shmSizeMegabytes, _ = shmSizeStarlark.Uint64()
+	}
+
+	tmpfsDirpathsToSizeMegabytes := map[string]uint64{}
+	tmpfsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, TmpfsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		for _, item := range tmpfsStarlark.Items() {
+			dirpath, sizeMegabytes, interpretationErr := convertStringToUint64DictItem(TmpfsAttr, item)
+			if interpretationErr != nil {
+				return nil, interpretationErr
+			}
+			tmpfsDirpathsToSizeMegabytes[dirpath] = sizeMegabytes
+		}
+	}
+
+	ulimits := map[string]*container_settings.Ulimit{}
+	ulimitsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, UlimitsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		for _, item := range ulimitsStarlark.Items() {
+			name, ulimit, interpretationErr := convertUlimitDictItem(item)
+			if interpretationErr != nil {
+				return nil, interpretationErr
+			}
+			ulimits[name] = ulimit
+		}
+	}
+
+	containerSettings, err := container_settings.NewContainerSettings(
+		isPrivileged,
+		addedCapabilities,
+		droppedCapabilities,
+		sysctls,
+		ulimits,
+		shmSizeMegabytes,
+		isReadOnlyRootFs,
+		tmpfsDirpathsToSizeMegabytes,
+		extraHosts,
+	)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating the container settings of the service")
+	}
+	return containerSettings, nil
+}
+
+func (config *ServiceConfig) extractBoolAttr(attrName string) (bool, *startosis_errors.InterpretationError) {
+	value, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Bool](config.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return false, interpretationErr
+	}
+	return found && bool(value), nil
+}
+
+func (config *ServiceConfig) extractStringSliceAttr(attrName string) ([]string, *startosis_errors.InterpretationError) {
+	value, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found || value.Len() == 0 {
+		return nil, nil
+	}
+	return kurtosis_types.SafeCastToStringSlice(value, attrName)
+}
+
+func (config *ServiceConfig) extractMapStringStringAttr(attrName string) (map[string]string, *startosis_errors.InterpretationError) {
+	value, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found || value.Len() == 0 {
+		return map[string]string{}, nil
+	}
+	return kurtosis_types.SafeCastToMapStringString(value, attrName)
+}
+
+func convertStringToUint64DictItem(attrName string, item starlark.Tuple) (string, uint64, *startosis_errors.InterpretationError) {
+	key, ok := item[0].(starlark.String)
+	if !ok {
+		return "", 0, startosis_errors.NewInterpretationError("Expected the keys of the '%s' attr to be strings but got '%s'", attrName, item[0].Type())
+	}
+	valueInt, ok := item[1].(starlark.Int)
+	if !ok {
+		return "", 0, startosis_errors.NewInterpretationError("Expected the value of '%s' in the '%s' attr to be an integer but got '%s'", key.GoString(), attrName, item[1].Type())
+	}
+	value, ok := valueInt.Uint64()
+	if !ok {
+		return "", 0, startosis_errors.NewInterpretationError("Expected the value of '%s' in the '%s' attr to be a positive integer but got '%v'", key.GoString(), attrName, valueInt)
+	}
+	return key.GoString(), value, nil
+}
+
+func convertUlimitDictItem(item starlark.Tuple) (string, *container_settings.Ulimit, *startosis_errors.InterpretationError) {
+	name, ok := item[0].(starlark.String)
+	if !ok {
+		return "", nil, startosis_errors.NewInterpretationError("Expected the keys of the '%s' attr to be strings but got '%s'", UlimitsAttr, item[0].Type())
+	}
+
+	var soft, hard int64
+	switch value := item[1].(type) {
+	case starlark.Int:
+		limit, ok := value.Int64()
+		if !ok {
+			return "", nil, startosis_errors.NewInterpretationError("Ulimit '%s' is too big: '%v'", name.GoString(), value)
+		}
+		soft, hard = limit, limit
+	case starlark.String:
+		parts := strings.Split(value.GoString(), ulimitSoftHardSeparator)
+		if len(parts) != ulimitSoftHardNumParts {
+			return "", nil, startosis_errors.NewInterpretationError("Expected ulimit '%s' to be an integer or a 'soft%shard' string but got '%s'", name.GoString(), ulimitSoftHardSeparator, value.GoString())
+		}
+		var err error
+		if soft, err = strconv.ParseInt(parts[0], ulimitBase, ulimitBitSize); err != nil {
+			return "", nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing the soft limit of ulimit '%s'", name.GoString())
+		}
+		if hard, err = strconv.ParseInt(parts[1], ulimitBase, ulimitBitSize); err != nil {
+			return "", nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred parsing the hard limit of ulimit '%s'", name.GoString())
+		}
+	default:
+		return "", nil, startosis_errors.NewInterpretationError("Expected ulimit '%s' to be an integer or a 'soft%shard' string but got '%s'", name.GoString(), ulimitSoftHardSeparator, item[1].Type())
+	}
+
+	if soft < container_settings.UnlimitedUlimit || hard < container_settings.UnlimitedUlimit {
+		return "", nil, startosis_errors.NewInterpretationError("Ulimit '%s' must be between %d (unlimited) and %d", name.GoString(), container_settings.UnlimitedUlimit, int64(math.MaxInt64))
+	}
+	ulimit, err := container_settings.NewUlimit(soft, hard)
+	if err != nil {
+		return "", nil, startosis_errors.WrapWithInterpretationError(err, "Ulimit '%s' is invalid", name.GoString())
+	}
+	return name.GoString(), ulimit, nil
+}
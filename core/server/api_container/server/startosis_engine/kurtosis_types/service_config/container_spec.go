@@ -0,0 +1,179 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	ContainerSpecTypeName = "ContainerSpec"
+
+	ContainerNameAttr = "name"
+)
+
+// NewContainerSpecType returns the type describing an init container or a sidecar of a service; it shares the
+// network namespace of the service and can mount some of its files
+func NewContainerSpecType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ContainerSpecTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ContainerNameAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringRegexp(value, ContainerNameAttr, container_spec.WordWrappedContainerNameRegex)
+					},
+				},
+				{
+					Name:              ImageAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ImageAttr)
+					},
+				},
+				{
+					Name:              EntrypointAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              CmdAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              EnvVarsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringMappingToString(value, EnvVarsAttr)
+					},
+				},
+				{
+					Name:              FilesAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringMappingToString(value, FilesAttr)
+					},
+				},
+			},
+			Deprecation: nil,
+		},
+		Instantiate: instantiateContainerSpec,
+	}
+}
+
+func instantiateContainerSpec(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(ContainerSpecTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &ContainerSpec{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// ContainerSpec is a starlark.Value holding an init container or a sidecar of a service
+type ContainerSpec struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (containerSpec *ContainerSpec) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := containerSpec.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &ContainerSpec{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (containerSpec *ContainerSpec) ToKurtosisType() (*container_spec.ContainerSpec, *startosis_errors.InterpretationError) {
+	name, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](containerSpec.KurtosisValueTypeDefault, ContainerNameAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", ContainerNameAttr, ContainerSpecTypeName)
+	}
+
+	image, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](containerSpec.KurtosisValueTypeDefault, ImageAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", ImageAttr, ContainerSpecTypeName)
+	}
+
+	var entrypointArgs []string
+	entrypointStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](containerSpec.KurtosisValueTypeDefault, EntrypointAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && entrypointStarlark.Len() > 0 {
+		entrypointArgs, interpretationErr = kurtosis_types.SafeCastToStringSlice(entrypointStarlark, EntrypointAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	var cmdArgs []string
+	cmdStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](containerSpec.KurtosisValueTypeDefault, CmdAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && cmdStarlark.Len() > 0 {
+		cmdArgs, interpretationErr = kurtosis_types.SafeCastToStringSlice(cmdStarlark, CmdAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	envVars := map[string]string{}
+	envVarsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](containerSpec.KurtosisValueTypeDefault, EnvVarsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && envVarsStarlark.Len() > 0 {
+		envVars, interpretationErr = kurtosis_types.SafeCastToMapStringString(envVarsStarlark, EnvVarsAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	dirpathsToServiceDirpaths := map[string]string{}
+	filesStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](containerSpec.KurtosisValueTypeDefault, FilesAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && filesStarlark.Len() > 0 {
+		dirpathsToServiceDirpaths, interpretationErr = kurtosis_types.SafeCastToMapStringString(filesStarlark, FilesAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	result, err := container_spec.NewContainerSpec(
+		name.GoString(),
+		image.GoString(),
+		entrypointArgs,
+		cmdArgs,
+		envVars,
+		dirpathsToServiceDirpaths,
+	)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating container '%s'", name.GoString())
+	}
+	return result, nil
+}
@@ -0,0 +1,207 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/verify"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"math"
+	"time"
+)
+
+const (
+	HealthCheckTypeName = "HealthCheck"
+
+	FailureThresholdAttr = "failure_threshold"
+
+	defaultHealthCheckInterval         = 10 * time.Second
+	defaultHealthCheckTimeout          = 5 * time.Second
+	defaultHealthCheckFailureThreshold = 3
+	minHealthCheckFailureThreshold     = 1
+)
+
+// NewHealthCheckType returns the type of the health checks which are run against a service for as long as it runs,
+// unlike the ready conditions which are only run once when the service starts
+func NewHealthCheckType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: HealthCheckTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              RecipeAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         validateRecipe,
+				},
+				{
+					Name:              FieldAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, FieldAttr)
+					},
+				},
+				{
+					Name:              AssertionAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         verify.ValidateVerificationToken,
+				},
+				{
+					Name:              TargetAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Comparable],
+					Validator:         nil,
+				},
+				{
+					Name:              IntervalAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, IntervalAttr)
+					},
+				},
+				{
+					Name:              TimeoutAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, TimeoutAttr)
+					},
+				},
+				{
+					Name:              FailureThresholdAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, FailureThresholdAttr, minHealthCheckFailureThreshold, math.MaxUint32)
+					},
+				},
+			},
+		},
+		Instantiate: instantiateHealthCheck,
+	}
+}
+
+func instantiateHealthCheck(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, err := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(HealthCheckTypeName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	return &HealthCheck{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// HealthCheck is a starlark.Value that holds all the information needed for checking the health of a service
+type HealthCheck struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (healthCheck *HealthCheck) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := healthCheck.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &HealthCheck{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (healthCheck *HealthCheck) GetRecipe() (recipe.Recipe, *startosis_errors.InterpretationError) {
+	httpRecipe, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[recipe.HttpRequestRecipe](healthCheck.KurtosisValueTypeDefault, RecipeAttr)
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'",
+			RecipeAttr, HealthCheckTypeName)
+	}
+	if interpretationErr == nil {
+		return httpRecipe, nil
+	}
+	execRecipe, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*recipe.ExecRecipe](healthCheck.KurtosisValueTypeDefault, RecipeAttr)
+	if interpretationErr == nil {
+		return execRecipe, nil
+	}
+	return nil, interpretationErr
+}
+
+func (healthCheck *HealthCheck) GetField() (string, *startosis_errors.InterpretationError) {
+	field, err := healthCheck.getRequiredStringAttr(FieldAttr)
+	if err != nil {
+		return "", err
+	}
+	return field, nil
+}
+
+func (healthCheck *HealthCheck) GetAssertion() (string, *startosis_errors.InterpretationError) {
+	assertion, err := healthCheck.getRequiredStringAttr(AssertionAttr)
+	if err != nil {
+		return "", err
+	}
+	return assertion, nil
+}
+
+func (healthCheck *HealthCheck) GetTarget() (starlark.Comparable, *startosis_errors.InterpretationError) {
+	target, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Comparable](healthCheck.KurtosisValueTypeDefault, TargetAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'",
+			TargetAttr, HealthCheckTypeName)
+	}
+	return target, nil
+}
+
+func (healthCheck *HealthCheck) GetInterval() (time.Duration, *startosis_errors.InterpretationError) {
+	return healthCheck.getDurationAttr(IntervalAttr, defaultHealthCheckInterval)
+}
+
+func (healthCheck *HealthCheck) GetTimeout() (time.Duration, *startosis_errors.InterpretationError) {
+	return healthCheck.getDurationAttr(TimeoutAttr, defaultHealthCheckTimeout)
+}
+
+func (healthCheck *HealthCheck) GetFailureThreshold() (uint32, *startosis_errors.InterpretationError) {
+	failureThreshold, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](healthCheck.KurtosisValueTypeDefault, FailureThresholdAttr)
+	if interpretationErr != nil {
+		return 0, interpretationErr
+	}
+	if !found {
+		return defaultHealthCheckFailureThreshold, nil
+	}
+	failureThresholdUint64, ok := failureThreshold.Uint64()
+	if !ok {
+		return 0, startosis_errors.NewInterpretationError("An error occurred parsing attribute '%s' of type '%s' as an unsigned integer, it was '%v'", FailureThresholdAttr, HealthCheckTypeName, failureThreshold)
+	}
+	// Safe to cast because the validator checks that it fits in an uint32
+	return uint32(failureThresholdUint64), nil
+}
+
+func (healthCheck *HealthCheck) getRequiredStringAttr(attrName string) (string, *startosis_errors.InterpretationError) {
+	value, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](healthCheck.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'",
+			attrName, HealthCheckTypeName)
+	}
+	return value.GoString(), nil
+}
+
+func (healthCheck *HealthCheck) getDurationAttr(attrName string, defaultValue time.Duration) (time.Duration, *startosis_errors.InterpretationError) {
+	durationStr, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](healthCheck.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return defaultValue, interpretationErr
+	}
+	if !found {
+		return defaultValue, nil
+	}
+	duration, parseErr := time.ParseDuration(durationStr.GoString())
+	if parseErr != nil {
+		return defaultValue, startosis_errors.WrapWithInterpretationError(parseErr, "An error occurred when parsing %s '%v'", attrName, durationStr.GoString())
+	}
+	return duration, nil
+}
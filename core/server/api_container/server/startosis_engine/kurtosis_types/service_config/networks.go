@@ -0,0 +1,55 @@
+package service_config
+
+import (
+	"regexp"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	// Named networks become container engine networks named after the enclave, so their names are kept short and simple
+	networkNameRegexStr = "^[a-z0-9]([a-z0-9-]{0,30}[a-z0-9])?$"
+)
+
+var networkNameRegex = regexp.MustCompile(networkNameRegexStr)
+
+// Validates a list of network names; named networks of the enclave have to match the network name regex while
+// external networks can be named anything, as Kurtosis doesn't create them
+func validateNetworkNames(value starlark.Value, attrName string, isExternal bool) *startosis_errors.InterpretationError {
+	networksList, ok := value.(*starlark.List)
+	if !ok {
+		return startosis_errors.NewInterpretationError("Attribute '%s' is expected to be a list of strings. Got '%s'", attrName, value.Type())
+	}
+	networkNames, interpretationErr := kurtosis_types.SafeCastToStringSlice(networksList, attrName)
+	if interpretationErr != nil {
+		return interpretationErr
+	}
+	seenNetworkNames := map[string]bool{}
+	for _, networkName := range networkNames {
+		if networkName == "" {
+			return startosis_errors.NewInterpretationError("Attribute '%s' contains an empty network name", attrName)
+		}
+		if !isExternal && !networkNameRegex.MatchString(networkName) {
+			return startosis_errors.NewInterpretationError("Network name '%s' in attribute '%s' doesn't match regex '%s'", networkName, attrName, networkNameRegexStr)
+		}
+		if seenNetworkNames[networkName] {
+			return startosis_errors.NewInterpretationError("Network '%s' is listed more than once in attribute '%s'", networkName, attrName)
+		}
+		seenNetworkNames[networkName] = true
+	}
+	return nil
+}
+
+func (config *ServiceConfig) getNetworkNames(attrName string) ([]string, *startosis_errors.InterpretationError) {
+	networksStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found || networksStarlark.Len() == 0 {
+		return nil, nil
+	}
+	return kurtosis_types.SafeCastToStringSlice(networksStarlark, attrName)
+}
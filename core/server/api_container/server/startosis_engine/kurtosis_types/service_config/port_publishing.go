@@ -0,0 +1,116 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"math"
+)
+
+const (
+	PortPublishingTypeName = "PortPublishing"
+
+	HostPortNumberAttr = "number"
+	HostPortRangeAttr  = "range"
+
+	minHostPortNumber = 1
+)
+
+// NewPortPublishingType returns the type telling on which host port a private port of a service gets published: a
+// static host port number, any free host port of a named range set on the engine, or any free host port when neither
+// is set
+func NewPortPublishingType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: PortPublishingTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              HostPortNumberAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, HostPortNumberAttr, minHostPortNumber, math.MaxUint16)
+					},
+				},
+				{
+					Name:              HostPortRangeAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, HostPortRangeAttr)
+					},
+				},
+			},
+			Deprecation: nil,
+		},
+		Instantiate: instantiatePortPublishing,
+	}
+}
+
+func instantiatePortPublishing(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(PortPublishingTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	portPublishing := &PortPublishing{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}
+	// Fails early if both a host port number and a range are set
+	if _, interpretationErr := portPublishing.ToKurtosisType(); interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return portPublishing, nil
+}
+
+// PortPublishing is a starlark.Value holding on which host port a private port of a service gets published
+type PortPublishing struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (portPublishing *PortPublishing) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := portPublishing.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &PortPublishing{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (portPublishing *PortPublishing) ToKurtosisType() (*port_publish_spec.PortPublishSpec, *startosis_errors.InterpretationError) {
+	hostPortNumberStarlark, hasHostPortNumber, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](portPublishing.KurtosisValueTypeDefault, HostPortNumberAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	rangeNameStarlark, hasRangeName, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](portPublishing.KurtosisValueTypeDefault, HostPortRangeAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	switch {
+	case hasHostPortNumber && hasRangeName:
+		return nil, startosis_errors.NewInterpretationError("'%v' can either publish a port on host port '%v' or on host port range '%v', but not both", PortPublishingTypeName, hostPortNumberStarlark, rangeNameStarlark.GoString())
+	case hasHostPortNumber:
+		hostPortNumberUint64, ok := hostPortNumberStarlark.Uint64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("An error occurred parsing attribute '%s' of type '%s' as an unsigned integer, it was '%v'", HostPortNumberAttr, PortPublishingTypeName, hostPortNumberStarlark)
+		}
+		// Safe to cast because the validator checks that it fits in an uint16
+		portPublishSpec, err := port_publish_spec.NewStaticPortPublishSpec(uint16(hostPortNumberUint64))
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating the static port publish spec")
+		}
+		return portPublishSpec, nil
+	case hasRangeName:
+		portPublishSpec, err := port_publish_spec.NewRangePortPublishSpec(rangeNameStarlark.GoString())
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating the range port publish spec")
+		}
+		return portPublishSpec, nil
+	default:
+		return port_publish_spec.NewEphemeralPortPublishSpec(), nil
+	}
+}
@@ -0,0 +1,128 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_restart_policy"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"math"
+)
+
+const (
+	RestartPolicyTypeName = "RestartPolicy"
+
+	PolicyAttr     = "policy"
+	MaxRetriesAttr = "max_retries"
+	// Not supported, see the validator
+	BackoffAttr = "backoff"
+
+	minMaxRetries = 1
+)
+
+var restartPolicyValues = []string{
+	string(service_restart_policy.ServiceRestartPolicyMode_Never),
+	string(service_restart_policy.ServiceRestartPolicyMode_OnFailure),
+	string(service_restart_policy.ServiceRestartPolicyMode_Always),
+}
+
+// NewRestartPolicyType returns the type telling the container engine what to do with the container of a service when
+// it exits; services without a restart policy get the default one of the enclave
+func NewRestartPolicyType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: RestartPolicyTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PolicyAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringValues(value, PolicyAttr, restartPolicyValues)
+					},
+				},
+				{
+					Name:              MaxRetriesAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, MaxRetriesAttr, minMaxRetries, math.MaxUint32)
+					},
+				},
+				{
+					// Rejected explicitly so a package setting it fails instead of silently getting the delay of the
+					// container engine
+					Name:              BackoffAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return startosis_errors.NewInterpretationError("Attribute '%s' of type '%s' isn't supported: the delay between two restarts of a service is set by the container engine, Docker or Kubernetes, and can't be configured per service", BackoffAttr, RestartPolicyTypeName)
+					},
+				},
+			},
+			Deprecation: nil,
+		},
+		Instantiate: instantiateRestartPolicy,
+	}
+}
+
+func instantiateRestartPolicy(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(RestartPolicyTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	restartPolicy := &RestartPolicy{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}
+	// Fails early if the max retries are set along with a policy which doesn't retry
+	if _, interpretationErr := restartPolicy.ToKurtosisType(); interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return restartPolicy, nil
+}
+
+// RestartPolicy is a starlark.Value holding the restart policy of a service
+type RestartPolicy struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (restartPolicy *RestartPolicy) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := restartPolicy.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &RestartPolicy{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (restartPolicy *RestartPolicy) ToKurtosisType() (*service_restart_policy.ServiceRestartPolicy, *startosis_errors.InterpretationError) {
+	policy, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](restartPolicy.KurtosisValueTypeDefault, PolicyAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", PolicyAttr, RestartPolicyTypeName)
+	}
+
+	var maxRetries uint32
+	maxRetriesStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](restartPolicy.KurtosisValueTypeDefault, MaxRetriesAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		maxRetriesUint64, ok := maxRetriesStarlark.Uint64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("An error occurred parsing attribute '%s' of type '%s' as an unsigned integer, it was '%v'", MaxRetriesAttr, RestartPolicyTypeName, maxRetriesStarlark)
+		}
+		// Safe to cast because the validator checks that it fits in an uint32
+		maxRetries = uint32(maxRetriesUint64)
+	}
+
+	serviceRestartPolicy, err := service_restart_policy.NewServiceRestartPolicy(service_restart_policy.ServiceRestartPolicyMode(policy.GoString()), maxRetries)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating the restart policy")
+	}
+	return serviceRestartPolicy, nil
+}
@@ -2,19 +2,25 @@ package service_config
 
 import (
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"math"
 	"path"
+	"time"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_restart_policy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_user"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/files_artifacts_expander/args"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_access"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
@@ -43,6 +49,19 @@ const (
 	CpuAllocationAttr               = "cpu_allocation"
 	MemoryAllocationAttr            = "memory_allocation"
 	ReadyConditionsAttr             = "ready_conditions"
+	HealthCheckAttr                 = "health_check"
+	RestartPolicyAttr               = "restart_policy"
+	InitContainersAttr              = "init_containers"
+	SidecarsAttr                    = "sidecars"
+	PrivilegedAttr                  = "privileged"
+	CapAddAttr                      = "cap_add"
+	CapDropAttr                     = "cap_drop"
+	SysctlsAttr                     = "sysctls"
+	UlimitsAttr                     = "ulimits"
+	ShmSizeAttr                     = "shm_size"
+	ReadOnlyRootFsAttr              = "read_only_root_fs"
+	TmpfsAttr                       = "tmpfs"
+	ExtraHostsAttr                  = "extra_hosts"
 	MinCpuMilliCoresAttr            = "min_cpu"
 	MinMemoryMegaBytesAttr          = "min_memory"
 	MaxCpuMilliCoresAttr            = "max_cpu"
@@ -52,14 +71,26 @@ const (
 	TolerationsAttr                 = "tolerations"
 	NodeSelectorsAttr               = "node_selectors"
 	FilesToBeMovedAttr              = "files_to_be_moved"
+	ReplicasAttr                    = "replicas"
+	NetworksAttr                    = "networks"
+	ExternalNetworksAttr            = "external_networks"
 
-	DefaultPrivateIPAddrPlaceholder = "KURTOSIS_IP_ADDR_PLACEHOLDER"
+	PrivateIpv6AddressPlaceholderAttr = "private_ipv6_address_placeholder"
+	PortPublishingAttr                = "port_publishing"
+
+	DefaultPrivateIPAddrPlaceholder   = "KURTOSIS_IP_ADDR_PLACEHOLDER"
+	DefaultPrivateIPv6AddrPlaceholder = "KURTOSIS_IPV6_ADDR_PLACEHOLDER"
 
 	filesArtifactExpansionDirsParentDirpath string = "/files-artifacts"
 	// TODO This should be populated from the build flow that builds the files-artifacts-expander Docker image
 	filesArtifactsExpanderImage string = "kurtosistech/files-artifacts-expander"
+	// The expanders run when their service gets started, which can be long after the interpretation for big runs
+	filesArtifactsExpanderAccessTokenSubject  = "files-artifacts-expander"
+	filesArtifactsExpanderAccessTokenValidity = 24 * time.Hour
 
 	minimumMemoryAllocationMegabytes = 6
+
+	minReplicas = 1
 )
 
 func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -182,6 +213,94 @@ func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[*ReadyCondition],
 					Validator:         nil,
 				},
+				{
+					Name:              HealthCheckAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*HealthCheck],
+					Validator:         nil,
+				},
+				{
+					Name:              RestartPolicyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*RestartPolicy],
+					Validator:         nil,
+				},
+				{
+					Name:              InitContainersAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              SidecarsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              PrivilegedAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator:         nil,
+				},
+				{
+					Name:              CapAddAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringListWithNotEmptyValues(value, CapAddAttr)
+					},
+				},
+				{
+					Name:              CapDropAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringListWithNotEmptyValues(value, CapDropAttr)
+					},
+				},
+				{
+					Name:              SysctlsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringMappingToString(value, SysctlsAttr)
+					},
+				},
+				{
+					Name:              UlimitsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              ShmSizeAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, ShmSizeAttr, 0, math.MaxUint64)
+					},
+				},
+				{
+					Name:              ReadOnlyRootFsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator:         nil,
+				},
+				{
+					Name:              TmpfsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              ExtraHostsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringMappingToString(value, ExtraHostsAttr)
+					},
+				},
 				{
 					Name:              LabelsAttr,
 					IsOptional:        true,
@@ -221,6 +340,44 @@ func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 						return builtin_argument.StringMappingToString(value, FilesToBeMovedAttr)
 					},
 				},
+				{
+					Name:              ReplicasAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Int64InRange(value, ReplicasAttr, minReplicas, math.MaxInt32)
+					},
+				},
+				{
+					Name:              NetworksAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateNetworkNames(value, NetworksAttr, false)
+					},
+				},
+				{
+					Name:              ExternalNetworksAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateNetworkNames(value, ExternalNetworksAttr, true)
+					},
+				},
+				{
+					Name:              PrivateIpv6AddressPlaceholderAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PrivateIpv6AddressPlaceholderAttr)
+					},
+				},
+				{
+					Name:              PortPublishingAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
 			},
 		},
 
@@ -315,23 +472,55 @@ func (config *ServiceConfig) ToKurtosisType(
 		}
 	}
 
+	portPublishSpecs := map[string]*port_publish_spec.PortPublishSpec{}
+	portPublishingStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, PortPublishingAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && portPublishingStarlark.Len() > 0 {
+		for _, portPublishingItem := range portPublishingStarlark.Items() {
+			portKey, portPublishSpec, interpretationError := convertPortPublishingMapEntry(PortPublishingAttr, portPublishingItem[0], portPublishingItem[1], portPublishingStarlark)
+			if interpretationError != nil {
+				return nil, interpretationError
+			}
+			if _, found := privatePorts[portKey]; !found {
+				return nil, startosis_errors.NewInterpretationError("Port '%s' is published on the host via '%s', but it isn't declared in '%s'", portKey, PortPublishingAttr, PortsAttr)
+			}
+			if _, found := publicPorts[portKey]; found {
+				return nil, startosis_errors.NewInterpretationError("Port '%s' is published on the host via both '%s' and '%s'; only one of the two can be used for a given port", portKey, PublicPortsAttr, PortPublishingAttr)
+			}
+			portPublishSpecs[portKey] = portPublishSpec
+		}
+	}
+
 	var filesArtifactExpansions *service_directory.FilesArtifactsExpansion
 	var persistentDirectories *service_directory.PersistentDirectories
+	var secretFiles map[string]string
 	filesStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, FilesAttr)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
+	serviceFilesDirpaths := map[string]bool{}
 	if found {
 		var filesArtifactsMountDirpathsMap map[string][]string
-		filesArtifactsMountDirpathsMap, persistentDirectoriesDirpathsMap, interpretationErr := convertFilesArguments(FilesAttr, filesStarlark)
+		filesArtifactsMountDirpathsMap, persistentDirectoriesDirpathsMap, secretFilesMap, interpretationErr := convertFilesArguments(FilesAttr, filesStarlark)
 		if interpretationErr != nil {
 			return nil, interpretationErr
 		}
+		for dirpath := range filesArtifactsMountDirpathsMap {
+			serviceFilesDirpaths[dirpath] = true
+		}
+		for dirpath := range persistentDirectoriesDirpathsMap {
+			serviceFilesDirpaths[dirpath] = true
+		}
 		filesArtifactExpansions, interpretationErr = ConvertFilesArtifactsMounts(filesArtifactsMountDirpathsMap, serviceNetwork)
 		if interpretationErr != nil {
 			return nil, interpretationErr
 		}
 		persistentDirectories = convertPersistentDirectoryMounts(persistentDirectoriesDirpathsMap)
+		if len(secretFilesMap) > 0 {
+			secretFiles = secretFilesMap
+		}
 	}
 
 	var entryPointArgs []string
@@ -381,6 +570,17 @@ func (config *ServiceConfig) ToKurtosisType(
 		privateIpAddressPlaceholder = DefaultPrivateIPAddrPlaceholder
 	}
 
+	var privateIpv6AddressPlaceholder string
+	privateIpv6AddressPlaceholderStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, PrivateIpv6AddressPlaceholderAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && privateIpv6AddressPlaceholderStarlark.GoString() != "" {
+		privateIpv6AddressPlaceholder = privateIpv6AddressPlaceholderStarlark.GoString()
+	} else {
+		privateIpv6AddressPlaceholder = DefaultPrivateIPv6AddrPlaceholder
+	}
+
 	var maxCpu uint64
 	maxCpuStarlark, foundMaxCpu, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](config.KurtosisValueTypeDefault, MaxCpuMilliCoresAttr)
 	if interpretationErr != nil {
@@ -513,6 +713,49 @@ func (config *ServiceConfig) ToKurtosisType(
 		}
 	}
 
+	var serviceRestartPolicy *service_restart_policy.ServiceRestartPolicy
+	restartPolicy, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*RestartPolicy](config.KurtosisValueTypeDefault, RestartPolicyAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		serviceRestartPolicy, interpretationErr = restartPolicy.ToKurtosisType()
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	// Init containers and sidecars end up in the same pod on Kubernetes, so their names must be unique across both lists
+	containerNames := map[string]bool{}
+	var initContainers []*container_spec.ContainerSpec
+	initContainersStarlarkList, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, InitContainersAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		initContainers, interpretationErr = convertContainerSpecs(InitContainersAttr, initContainersStarlarkList, serviceFilesDirpaths, containerNames)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	var sidecars []*container_spec.ContainerSpec
+	sidecarsStarlarkList, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, SidecarsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		sidecars, interpretationErr = convertContainerSpecs(SidecarsAttr, sidecarsStarlarkList, serviceFilesDirpaths, containerNames)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	containerSettings, interpretationErr := config.getContainerSettings()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
 	filesToBeMoved := map[string]string{}
 	filesToBeMovedStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, FilesToBeMovedAttr)
 	if interpretationErr != nil {
@@ -525,6 +768,15 @@ func (config *ServiceConfig) ToKurtosisType(
 		}
 	}
 
+	networks, interpretationErr := config.getNetworkNames(NetworksAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	externalNetworks, interpretationErr := config.getNetworkNames(ExternalNetworksAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
 	serviceConfig, err := service.CreateServiceConfig(
 		imageName,
 		maybeImageBuildSpec,
@@ -552,6 +804,21 @@ func (config *ServiceConfig) ToKurtosisType(
 		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred creating a service config")
 	}
 	serviceConfig.SetFilesToBeMoved(filesToBeMoved)
+	serviceConfig.SetRestartPolicy(serviceRestartPolicy)
+	serviceConfig.SetInitContainers(initContainers)
+	serviceConfig.SetSidecars(sidecars)
+	serviceConfig.SetContainerSettings(containerSettings)
+	serviceConfig.SetSecretFiles(secretFiles)
+	serviceConfig.SetNetworks(networks)
+	serviceConfig.SetExternalNetworks(externalNetworks)
+	serviceConfig.SetPrivateIPv6AddrPlaceholder(privateIpv6AddressPlaceholder)
+	if len(portPublishSpecs) > 0 {
+		// public_ports were turned into static port publish specs when creating the service config
+		for portId, portPublishSpec := range serviceConfig.GetPortPublishSpecs() {
+			portPublishSpecs[portId] = portPublishSpec
+		}
+		serviceConfig.SetPortPublishSpecs(portPublishSpecs)
+	}
 	return serviceConfig, nil
 }
 
@@ -567,6 +834,34 @@ func (config *ServiceConfig) GetReadyCondition() (*ReadyCondition, *startosis_er
 	return readyConditions, nil
 }
 
+func (config *ServiceConfig) GetHealthCheck() (*HealthCheck, *startosis_errors.InterpretationError) {
+	healthCheck, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*HealthCheck](config.KurtosisValueTypeDefault, HealthCheckAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, nil
+	}
+
+	return healthCheck, nil
+}
+
+// GetReplicas returns the number of replicas to run the service with, or false if the service isn't replicated
+func (config *ServiceConfig) GetReplicas() (int, bool, *startosis_errors.InterpretationError) {
+	replicas, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](config.KurtosisValueTypeDefault, ReplicasAttr)
+	if interpretationErr != nil {
+		return 0, false, interpretationErr
+	}
+	if !found {
+		return 0, false, nil
+	}
+	replicasInt, ok := replicas.Int64()
+	if !ok {
+		return 0, false, startosis_errors.NewInterpretationError("Couldn't convert the '%s' attribute '%v' to an integer", ReplicasAttr, replicas)
+	}
+	return int(replicasInt), true, nil
+}
+
 func ConvertFilesArtifactsMounts(filesArtifactsMountDirpathsMap map[string][]string, serviceNetwork service_network.ServiceNetwork) (*service_directory.FilesArtifactsExpansion, *startosis_errors.InterpretationError) {
 	filesArtifactsExpansions := []args.FilesArtifactExpansion{}
 	serviceDirpathsToArtifactIdentifiers := map[string][]string{}
@@ -592,10 +887,15 @@ func ConvertFilesArtifactsMounts(filesArtifactsMountDirpathsMap map[string][]str
 	//  passing the APIC info DOWN to the backend and have the backend create the expander itself.
 	//  Here writing those info into each service config is dumb
 	apiContainerInfo := serviceNetwork.GetApiContainerInfo()
+	accessToken, err := apiContainerInfo.IssueAccessToken(filesArtifactsExpanderAccessTokenSubject, api_container_access.AccessLevel_Read, filesArtifactsExpanderAccessTokenValidity)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred issuing the access token of the files artifacts expander")
+	}
 	filesArtifactsExpanderArgs, err := args.NewFilesArtifactsExpanderArgs(
 		apiContainerInfo.GetIpAddress().String(),
 		apiContainerInfo.GetGrpcPortNum(),
 		filesArtifactsExpansions,
+		accessToken,
 	)
 	if err != nil {
 		return nil, startosis_errors.NewInterpretationError("An error occurred creating files artifacts expander args")
@@ -606,11 +906,7 @@ func ConvertFilesArtifactsMounts(filesArtifactsMountDirpathsMap map[string][]str
 		return nil, startosis_errors.NewInterpretationError("An error occurred getting files artifacts expander environment variables using args: %+v", filesArtifactsExpanderArgs)
 	}
 
-	expanderImageAndTag := fmt.Sprintf(
-		"%v:%v",
-		filesArtifactsExpanderImage,
-		apiContainerInfo.GetVersion(),
-	)
+	expanderImageAndTag := GetFilesArtifactsExpanderImage(apiContainerInfo.GetVersion())
 
 	return &service_directory.FilesArtifactsExpansion{
 		ExpanderImage:                        expanderImageAndTag,
@@ -620,6 +916,11 @@ func ConvertFilesArtifactsMounts(filesArtifactsMountDirpathsMap map[string][]str
 	}, nil
 }
 
+// GetFilesArtifactsExpanderImage returns the files artifacts expander image matching the version of the API container
+func GetFilesArtifactsExpanderImage(apiContainerVersion string) string {
+	return fmt.Sprintf("%v:%v", filesArtifactsExpanderImage, apiContainerVersion)
+}
+
 func convertPersistentDirectoryMounts(persistentDirectoriesMap map[string]service_directory.PersistentDirectory) *service_directory.PersistentDirectories {
 	return service_directory.NewPersistentDirectories(persistentDirectoriesMap)
 }
@@ -640,14 +941,32 @@ func convertPortMapEntry(attrNameForLogging string, key starlark.Value, value st
 	return keyStr.GoString(), servicePortSpec, nil
 }
 
-func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict) (map[string][]string, map[string]service_directory.PersistentDirectory, *startosis_errors.InterpretationError) {
+func convertPortPublishingMapEntry(attrNameForLogging string, key starlark.Value, value starlark.Value, dictForLogging *starlark.Dict) (string, *port_publish_spec.PortPublishSpec, *startosis_errors.InterpretationError) {
+	keyStr, ok := key.(starlark.String)
+	if !ok {
+		return "", nil, startosis_errors.NewInterpretationError("Unable to convert key of '%s' dictionary '%v' to string", attrNameForLogging, dictForLogging)
+	}
+	valuePortPublishing, ok := value.(*PortPublishing)
+	if !ok {
+		return "", nil, startosis_errors.NewInterpretationError("Unable to convert value of '%s' dictionary '%v' to a '%s' object", attrNameForLogging, dictForLogging, PortPublishingTypeName)
+	}
+	portPublishSpec, interpretationErr := valuePortPublishing.ToKurtosisType()
+	if interpretationErr != nil {
+		return "", nil, interpretationErr
+	}
+	return keyStr.GoString(), portPublishSpec, nil
+}
+
+// Values built with Secret("name") are secret files, whose key is the path of the file to create rather than a directory
+func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict) (map[string][]string, map[string]service_directory.PersistentDirectory, map[string]string, *startosis_errors.InterpretationError) {
 	filesArtifacts := map[string][]string{}
 	persistentDirectories := map[string]service_directory.PersistentDirectory{}
+	secretFiles := map[string]string{}
 	for _, fileItem := range filesDict.Items() {
 		rawDirPath := fileItem[0]
 		dirPath, ok := rawDirPath.(starlark.String)
 		if !ok {
-			return nil, nil, startosis_errors.NewInterpretationError("Unable to convert key of '%s' dictionary '%v' to string", attrNameForLogging, filesDict)
+			return nil, nil, nil, startosis_errors.NewInterpretationError("Unable to convert key of '%s' dictionary '%v' to string", attrNameForLogging, filesDict)
 		}
 
 		var interpretationErr *startosis_errors.InterpretationError
@@ -658,28 +977,32 @@ func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict)
 			// we're also supporting raw strings as well and transform them into files artifact name.
 			fileArtifactNameStr, isSimpleStringArg := rawFileValue.(starlark.String)
 			if !isSimpleStringArg {
-				return nil, nil, startosis_errors.NewInterpretationError("Unable to convert value of '%s' dictionary '%v' to a Directory object", attrNameForLogging, filesDict)
+				return nil, nil, nil, startosis_errors.NewInterpretationError("Unable to convert value of '%s' dictionary '%v' to a Directory object", attrNameForLogging, filesDict)
+			}
+			if secret_store.IsSecretPlaceholder(fileArtifactNameStr.GoString()) {
+				secretFiles[dirPath.GoString()] = fileArtifactNameStr.GoString()
+				continue
 			}
 			directoryObj, interpretationErr = directory.CreateDirectoryFromFilesArtifact(fileArtifactNameStr.GoString())
 			if interpretationErr != nil {
-				return nil, nil, interpretationErr
+				return nil, nil, nil, interpretationErr
 			}
 		}
 		artifactNames, artifactNameSet, interpretationErr := directoryObj.GetArtifactNamesIfSet()
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
 		}
 		persistentKey, persistentKeySet, interpretationErr := directoryObj.GetPersistentKeyIfSet()
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
 		}
 		persistentDirectorySize, interpretationErr := directoryObj.GetSizeOrDefault()
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
 		}
 		if artifactNameSet == persistentKeySet {
 			// this condition is a XOR
-			return nil, nil, startosis_errors.NewInterpretationError("Parameter '%s' and '%s' cannot be set on the same '%s' object: '%s'",
+			return nil, nil, nil, startosis_errors.NewInterpretationError("Parameter '%s' and '%s' cannot be set on the same '%s' object: '%s'",
 				directory.ArtifactNamesAttr, directory.PersistentKeyAttr, directory.DirectoryTypeName, directoryObj.String())
 		}
 		if artifactNameSet {
@@ -692,7 +1015,7 @@ func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict)
 			}
 		}
 	}
-	return filesArtifacts, persistentDirectories, nil
+	return filesArtifacts, persistentDirectories, secretFiles, nil
 }
 
 // If [image] is an ImageBuildSpec type, returns name for the image to build and ImageBuildSpec converted to KurtosisType
@@ -759,3 +1082,35 @@ func convertTolerations(tolerationsList *starlark.List) ([]v1.Toleration, *start
 
 	return outputValue, nil
 }
+
+// convertContainerSpecs converts the init containers or sidecars of a service, checking that their names are unique
+// and that they only mount directories which get mounted on the service container
+func convertContainerSpecs(
+	attrName string,
+	containerSpecsList *starlark.List,
+	serviceFilesDirpaths map[string]bool,
+	containerNames map[string]bool,
+) ([]*container_spec.ContainerSpec, *startosis_errors.InterpretationError) {
+	var outputValue []*container_spec.ContainerSpec
+	for index := 0; index < containerSpecsList.Len(); index++ {
+		containerSpecStarlark, ok := containerSpecsList.Index(index).(*ContainerSpec)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("Expected item at index '%v' of the list passed via '%v' attr to be a '%v' but it wasn't", index, attrName, ContainerSpecTypeName)
+		}
+		containerSpec, interpretationErr := containerSpecStarlark.ToKurtosisType()
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		if _, found := containerNames[containerSpec.GetName()]; found {
+			return nil, startosis_errors.NewInterpretationError("Container name '%v' at index '%v' of the list passed via '%v' attr is already used by another init container or sidecar of the service", containerSpec.GetName(), index, attrName)
+		}
+		containerNames[containerSpec.GetName()] = true
+		for dirpath, serviceDirpath := range containerSpec.GetDirpathsToServiceDirpaths() {
+			if _, found := serviceFilesDirpaths[serviceDirpath]; !found {
+				return nil, startosis_errors.NewInterpretationError("Container '%v' passed via '%v' attr wants to mount service directory '%v' on '%v', but the service doesn't declare it in its '%v' attr", containerSpec.GetName(), attrName, serviceDirpath, dirpath, FilesAttr)
+			}
+		}
+		outputValue = append(outputValue, containerSpec)
+	}
+	return outputValue, nil
+}
@@ -94,12 +94,13 @@ func (suite *StarlarkValueSerdeSuite) TestStarlarkValueSerde_Service() {
 		"test-service",
 		"test-service-hostname",
 		"192.168.0.22",
+		"fd6b:7572:746f:1000::c0a8:16",
 		ports,
 	)
 	require.Nil(suite.T(), interpretationErr)
 
 	serializedStarlarkValue := suite.serde.Serialize(serviceObj)
-	expectedSerializedServiceObj := `Service(name="test-service", hostname="test-service-hostname", ip_address="192.168.0.22", ports={"http": PortSpec(number=443, transport_protocol="TCP", application_protocol="http", wait="10s", url="http://my-test-service:443")})`
+	expectedSerializedServiceObj := `Service(name="test-service", hostname="test-service-hostname", ip_address="192.168.0.22", ports={"http": PortSpec(number=443, transport_protocol="TCP", application_protocol="http", wait="10s", url="http://my-test-service:443")}, ipv6_address="fd6b:7572:746f:1000::c0a8:16")`
 	require.Equal(suite.T(), expectedSerializedServiceObj, serializedStarlarkValue)
 
 	deserializedStarlarkValue, interpretationErr := suite.serde.Deserialize(serializedStarlarkValue)
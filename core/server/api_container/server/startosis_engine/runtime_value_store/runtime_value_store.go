@@ -13,6 +13,10 @@ type RuntimeValueStore struct {
 	starlarkValueSerde                *kurtosis_types.StarlarkValueSerde
 	recipeResultRepository            *recipeResultRepository
 	serviceAssociatedValuesRepository *serviceAssociatedValuesRepository
+
+	// UUIDs handed out by CreateValue before it starts generating new ones. This is set when an enclave snapshot gets
+	// restored, so that the runtime values referenced by the restored instructions keep their original UUIDs
+	nextValueUuids []string
 }
 
 func CreateRuntimeValueStore(starlarkValueSerde *kurtosis_types.StarlarkValueSerde, enclaveDb *enclave_db.EnclaveDB) (*RuntimeValueStore, error) {
@@ -30,18 +34,26 @@ func CreateRuntimeValueStore(starlarkValueSerde *kurtosis_types.StarlarkValueSer
 		starlarkValueSerde:                starlarkValueSerde,
 		recipeResultRepository:            recipeResultRepositoryObj,
 		serviceAssociatedValuesRepository: associatedValuesRepository,
+		nextValueUuids:                    nil,
 	}
 
 	return runtimeValueStore, nil
 }
 
 func (re *RuntimeValueStore) CreateValue() (string, error) {
-	uuid, err := uuid_generator.GenerateUUIDString()
-	if err != nil {
-		return "", stacktrace.Propagate(err, "An error occurred while generating uuid for runtime value")
+	var uuid string
+	if len(re.nextValueUuids) > 0 {
+		uuid = re.nextValueUuids[0]
+		re.nextValueUuids = re.nextValueUuids[1:]
+	} else {
+		generatedUuid, err := uuid_generator.GenerateUUIDString()
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred while generating uuid for runtime value")
+		}
+		uuid = generatedUuid
 	}
 
-	if err = re.recipeResultRepository.SaveKey(uuid); err != nil {
+	if err := re.recipeResultRepository.SaveKey(uuid); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred saving key UUID '%s' on the recipe result repository", uuid)
 	}
 
@@ -75,6 +87,24 @@ func (re *RuntimeValueStore) GetOrCreateValueAssociatedWithService(serviceName s
 	return uuid, nil
 }
 
+// SetNextValueUuids makes the next calls to CreateValue return the given UUIDs, in order, before going back to
+// generating new ones. Passing nil drops the UUIDs that haven't been used yet
+func (re *RuntimeValueStore) SetNextValueUuids(uuids []string) {
+	re.nextValueUuids = uuids
+}
+
+// SetValueAssociatedWithService associates an existing UUID with the service, instead of letting
+// GetOrCreateValueAssociatedWithService generate a new one the first time the service gets added
+func (re *RuntimeValueStore) SetValueAssociatedWithService(serviceName service.ServiceName, uuid string) error {
+	if err := re.recipeResultRepository.SaveKey(uuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving key UUID '%s' on the recipe result repository", uuid)
+	}
+	if err := re.serviceAssociatedValuesRepository.Save(serviceName, uuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving associated values '%s' for service '%s' in the service associated values repository", uuid, serviceName)
+	}
+	return nil
+}
+
 func (re *RuntimeValueStore) SetValue(uuid string, value map[string]starlark.Comparable) error {
 	if err := re.recipeResultRepository.Save(uuid, value); err != nil {
 		return stacktrace.Propagate(err, "An error occurred saving value '%+v' using UUID key '%s' into the recipe result repository", value, uuid)
@@ -0,0 +1,128 @@
+package startosis_engine
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+const (
+	// Sampling a service takes about a second on Docker, so there's no point in sampling much more often than this
+	defaultPeakUsageSamplingInterval = 5 * time.Second
+)
+
+// ServicePeakUsage is the highest usage of a service sampled over a Starlark run
+type ServicePeakUsage struct {
+	cpuUsageMilliCores uint64
+
+	memoryUsageBytes uint64
+}
+
+func (peakUsage ServicePeakUsage) GetCpuUsageMilliCores() uint64 {
+	return peakUsage.cpuUsageMilliCores
+}
+
+func (peakUsage ServicePeakUsage) GetMemoryUsageBytes() uint64 {
+	return peakUsage.memoryUsageBytes
+}
+
+// ServicePeakUsageTracker samples the services periodically while a Starlark run executes, so that users can
+// right-size the CPU and memory of their services based on what they actually use
+// The StartosisRunner starts and stops it around the execution, which goes on after the client stops following the run
+type ServicePeakUsageTracker struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	samplingInterval time.Duration
+
+	// Peaks of the last (or current) run, by service name
+	peakUsages map[service.ServiceName]*ServicePeakUsage
+
+	mutex *sync.RWMutex
+}
+
+func NewServicePeakUsageTracker(serviceNetwork service_network.ServiceNetwork) *ServicePeakUsageTracker {
+	return &ServicePeakUsageTracker{
+		serviceNetwork:   serviceNetwork,
+		samplingInterval: defaultPeakUsageSamplingInterval,
+		peakUsages:       map[service.ServiceName]*ServicePeakUsage{},
+		mutex:            &sync.RWMutex{},
+	}
+}
+
+// GetPeakUsages returns a copy of the peaks, by service name
+func (tracker *ServicePeakUsageTracker) GetPeakUsages() map[service.ServiceName]ServicePeakUsage {
+	tracker.mutex.RLock()
+	defer tracker.mutex.RUnlock()
+	peakUsages := map[service.ServiceName]ServicePeakUsage{}
+	for serviceName, peakUsage := range tracker.peakUsages {
+		peakUsages[serviceName] = *peakUsage
+	}
+	return peakUsages
+}
+
+// startTracking forgets the peaks of the previous run and samples the services in the background until the returned
+// function gets called, which waits for the sampling to stop
+func (tracker *ServicePeakUsageTracker) startTracking() func() {
+	tracker.mutex.Lock()
+	tracker.peakUsages = map[service.ServiceName]*ServicePeakUsage{}
+	tracker.mutex.Unlock()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	trackingStopped := make(chan struct{})
+	go func() {
+		defer close(trackingStopped)
+		tracker.track(ctx)
+	}()
+	return func() {
+		cancelFunc()
+		<-trackingStopped
+	}
+}
+
+func (tracker *ServicePeakUsageTracker) track(ctx context.Context) {
+	ticker := time.NewTicker(tracker.samplingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			tracker.sample(ctx)
+		}
+	}
+}
+
+func (tracker *ServicePeakUsageTracker) sample(ctx context.Context) {
+	allServicesIdentifiers := map[string]bool{}
+	serviceStats, _, err := tracker.serviceNetwork.GetServicesStats(ctx, allServicesIdentifiers)
+	if err != nil {
+		// Sampling is best effort, e.g. Kubernetes clusters don't always run a metrics server
+		logrus.Debugf("An error occurred sampling the resource usage of the services, the sample will be skipped:\n%v", err)
+		return
+	}
+	tracker.recordSample(serviceStats)
+}
+
+func (tracker *ServicePeakUsageTracker) recordSample(serviceStats map[service.ServiceName]*service.ServiceStats) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	for serviceName, stats := range serviceStats {
+		peakUsage, found := tracker.peakUsages[serviceName]
+		if !found {
+			peakUsage = &ServicePeakUsage{
+				cpuUsageMilliCores: 0,
+				memoryUsageBytes:   0,
+			}
+			tracker.peakUsages[serviceName] = peakUsage
+		}
+		if stats.GetCpuUsageMilliCores() > peakUsage.cpuUsageMilliCores {
+			peakUsage.cpuUsageMilliCores = stats.GetCpuUsageMilliCores()
+		}
+		if stats.GetMemoryUsageBytes() > peakUsage.memoryUsageBytes {
+			peakUsage.memoryUsageBytes = stats.GetMemoryUsageBytes()
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package startosis_engine
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestServicePeakUsageTracker_KeepsHighestSamples(t *testing.T) {
+	tracker := NewServicePeakUsageTracker(nil)
+	sampleTime := time.Now()
+
+	tracker.recordSample(map[service.ServiceName]*service.ServiceStats{
+		"node":     service.NewServiceStats(100, 2000, 0, nil, sampleTime),
+		"explorer": service.NewServiceStats(10, 500, 0, nil, sampleTime),
+	})
+	tracker.recordSample(map[service.ServiceName]*service.ServiceStats{
+		"node": service.NewServiceStats(300, 1000, 0, nil, sampleTime),
+	})
+
+	peakUsages := tracker.GetPeakUsages()
+	require.Len(t, peakUsages, 2)
+	require.Equal(t, ServicePeakUsage{cpuUsageMilliCores: 300, memoryUsageBytes: 2000}, peakUsages["node"])
+	require.Equal(t, ServicePeakUsage{cpuUsageMilliCores: 10, memoryUsageBytes: 500}, peakUsages["explorer"])
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/tracing"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"sync"
@@ -97,18 +98,28 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 			starlarkRunResponseLineStream <- progress
 
 			instruction := scheduledInstruction.GetInstruction()
-			canonicalInstruction := binding_constructors.NewStarlarkRunResponseLineFromInstruction(instruction.GetCanonicalInstruction(scheduledInstruction.IsExecuted()))
-			starlarkRunResponseLineStream <- canonicalInstruction
+			canonicalInstruction := instruction.GetCanonicalInstruction(scheduledInstruction.IsExecuted())
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstruction(canonicalInstruction)
 
 			if !dryRun {
+				instructionName := canonicalInstruction.GetInstructionName()
+				instructionCtx, span := tracing.StartSpan(
+					ctxWithParallelism,
+					tracing.ExecuteInstructionSpanName(instructionName),
+					tracing.InstructionKey.String(instructionName),
+					tracing.InstructionNumberKey.Int64(int64(instructionNumber)),
+					tracing.InstructionPositionKey.String(instruction.GetPositionInOriginalScript().String()),
+					tracing.IsInstructionSkippedKey.Bool(scheduledInstruction.IsExecuted()),
+				)
 				var err error
 				var instructionOutput *string
 				if scheduledInstruction.IsExecuted() {
 					// instruction already executed within this enclave. Do not run it
 					instructionOutput = &skippedInstructionOutput
 				} else {
-					instructionOutput, err = instruction.Execute(ctxWithParallelism)
+					instructionOutput, err = instruction.Execute(instructionCtx)
 				}
+				tracing.EndSpan(span, err)
 				if err != nil {
 					sendErrorAndFail(starlarkRunResponseLineStream, err, "An error occurred executing instruction (number %d) at %v:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
 					return
@@ -20,6 +20,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/tracing"
 	"github.com/sirupsen/logrus"
 	"go.starlark.net/resolve"
 	"go.starlark.net/starlark"
@@ -217,7 +218,7 @@ func (interpreter *StartosisInterpreter) InterpretAndOptimizePlan(
 //   - The list of Kurtosis instructions that was generated based on the interpretation of the script. It can be empty
 //     if the interpretation of the script failed
 func (interpreter *StartosisInterpreter) Interpret(
-	_ context.Context,
+	ctx context.Context,
 	packageId string,
 	mainFunctionName string,
 	packageReplaceOptions map[string]string,
@@ -231,6 +232,9 @@ func (interpreter *StartosisInterpreter) Interpret(
 ) (string, *instructions_plan.InstructionsPlan, *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) {
 	interpreter.mutex.Lock()
 	defer interpreter.mutex.Unlock()
+	// The plan optimization interprets the script several times, each of which gets its own span
+	ctx, interpretationAttemptSpan := tracing.StartSpan(ctx, tracing.InterpretationAttemptSpanName, tracing.PackageIdKey.String(packageId))
+	defer interpretationAttemptSpan.End()
 	newInstructionsPlan := instructions_plan.NewInstructionsPlan()
 	logrus.Debugf("Interpreting package '%v' with contents '%v' and params '%v'", packageId, serializedStarlark, serializedJsonParams)
 	moduleLocator := packageId
@@ -265,6 +269,7 @@ func (interpreter *StartosisInterpreter) Interpret(
 	}
 
 	runFunctionExecutionThread := newStarlarkThread(moduleLocator)
+	tracing.SetStarlarkThreadContext(ctx, runFunctionExecutionThread)
 
 	var argsTuple starlark.Tuple
 	var kwArgs []starlark.Tuple
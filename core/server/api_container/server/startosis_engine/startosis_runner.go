@@ -4,14 +4,20 @@ import (
 	"context"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan/resolver"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_warning"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/prometheus_metrics"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/tracing"
+	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
 
@@ -22,6 +28,8 @@ type StartosisRunner struct {
 
 	startosisExecutor *StartosisExecutor
 
+	servicePeakUsageTracker *ServicePeakUsageTracker
+
 	mutex *sync.Mutex
 }
 
@@ -33,11 +41,12 @@ const (
 	startingExecutionMsg      = "Starting execution"
 )
 
-func NewStartosisRunner(interpreter *StartosisInterpreter, validator *StartosisValidator, executor *StartosisExecutor) *StartosisRunner {
+func NewStartosisRunner(interpreter *StartosisInterpreter, validator *StartosisValidator, executor *StartosisExecutor, servicePeakUsageTracker *ServicePeakUsageTracker) *StartosisRunner {
 	return &StartosisRunner{
-		startosisInterpreter: interpreter,
-		startosisValidator:   validator,
-		startosisExecutor:    executor,
+		startosisInterpreter:    interpreter,
+		startosisValidator:      validator,
+		startosisExecutor:       executor,
+		servicePeakUsageTracker: servicePeakUsageTracker,
 
 		// we only expect one starlark package to run at a time against an enclave
 		// this lock ensures that only warning set is accessed by one starlark run method
@@ -63,21 +72,39 @@ func (runner *StartosisRunner) Run(
 	starlark_warning.Clear()
 	defer runner.mutex.Unlock()
 
-	// TODO(gb): add metric tracking maybe?
+	// The run goes on once the context is done, e.g. when the client stops following it, in which case its lines get
+	// dropped rather than blocking it
 	starlarkRunResponseLines := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	runResponseLines := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	go forwardResponseLinesWhileContextIsActive(ctx, runResponseLines, starlarkRunResponseLines)
 	go func() {
+		// The root span of the trace of the run, which the interpretation, validation and execution spans are children of
+		ctx, starlarkRunSpan := tracing.StartSpan(
+			ctx,
+			tracing.StarlarkRunSpanName,
+			tracing.PackageIdKey.String(packageId),
+			tracing.MainFunctionNameKey.String(mainFunctionName),
+			tracing.DryRunKey.Bool(dryRun),
+			tracing.ParallelismKey.Int(parallelism),
+		)
+		var starlarkRunErr error
+
 		defer func() {
 			warnings := starlark_warning.GetContentFromWarningSet()
 
 			if len(warnings) > 0 {
 				for _, warning := range warnings {
 					// TODO: create a new binding_constructor for warning message
-					starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromWarning(warning)
+					runResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromWarning(warning)
 				}
 			}
 
-			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromInfoMsg("⭐ us on GitHub - https://github.com/kurtosis-tech/kurtosis")
-			close(starlarkRunResponseLines)
+			runResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromInfoMsg("⭐ us on GitHub - https://github.com/kurtosis-tech/kurtosis")
+			close(runResponseLines)
+		}()
+		// Deferred last so that the span ends before the stream gets closed, for the trace to be complete once the run is
+		defer func() {
+			tracing.EndSpan(starlarkRunSpan, starlarkRunErr)
 		}()
 
 		var experimentalFeaturesStr []string
@@ -95,16 +122,18 @@ func (runner *StartosisRunner) Run(
 		// Interpretation starts > send progress info (this line will be invisible as interpretation is super quick)
 		progressInfo := binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
 			startingInterpretationMsg, defaultCurrentStepNumber, defaultTotalStepsNumber)
-		starlarkRunResponseLines <- progressInfo
+		runResponseLines <- progressInfo
 
 		// TODO: once we have feature flags, add a switch here to call InterpretAndOptimizePlan if the feature flag is
 		//  turned on
+		interpretationStart := time.Now()
+		interpretationCtx, interpretationSpan := tracing.StartSpan(ctx, tracing.InterpretationSpanName)
 		var serializedScriptOutput string
 		var instructionsPlan *instructions_plan.InstructionsPlan
 		var interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError
 		if doesFeatureFlagsContain(experimentalFeatures, kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag_NO_INSTRUCTIONS_CACHING) {
 			serializedScriptOutput, instructionsPlan, interpretationError = runner.startosisInterpreter.Interpret(
-				ctx,
+				interpretationCtx,
 				packageId,
 				mainFunctionName,
 				packageReplaceOptions,
@@ -118,7 +147,7 @@ func (runner *StartosisRunner) Run(
 			)
 		} else {
 			serializedScriptOutput, instructionsPlan, interpretationError = runner.startosisInterpreter.InterpretAndOptimizePlan(
-				ctx,
+				interpretationCtx,
 				packageId,
 				packageReplaceOptions,
 				mainFunctionName,
@@ -130,10 +159,13 @@ func (runner *StartosisRunner) Run(
 				imageDownloadMode,
 			)
 		}
+		prometheus_metrics.ObserveSince(prometheus_metrics.StarlarkInterpretationDuration, interpretationStart)
 
 		if interpretationError != nil {
-			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationError)
-			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			starlarkRunErr = stacktrace.NewError("An error occurred interpreting the Starlark script:\n%v", interpretationError.GetErrorMessage())
+			tracing.EndSpan(interpretationSpan, starlarkRunErr)
+			runResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationError)
+			runResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
 			return
 		}
 		totalNumberOfInstructions := uint32(instructionsPlan.Size())
@@ -142,42 +174,85 @@ func (runner *StartosisRunner) Run(
 
 		instructionsSequence, interpretationErr := instructionsPlan.GeneratePlan()
 		if interpretationErr != nil {
-			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationErr.ToAPIType())
-			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			starlarkRunErr = stacktrace.NewError("An error occurred generating the plan of Kurtosis instructions:\n%v", interpretationErr.Error())
+			tracing.EndSpan(interpretationSpan, starlarkRunErr)
+			runResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationErr.ToAPIType())
+			runResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
 			return
 		}
+		interpretationSpan.SetAttributes(tracing.NumInstructionsKey.Int(len(instructionsSequence)))
+		tracing.EndSpan(interpretationSpan, nil)
 
 		// Validation starts > send progress info
 		progressInfo = binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
 			startingValidationMsg, defaultCurrentStepNumber, totalNumberOfInstructions)
-		starlarkRunResponseLines <- progressInfo
+		runResponseLines <- progressInfo
 
-		validationErrorsChan := runner.startosisValidator.Validate(ctx, instructionsSequence, imageDownloadMode)
-		if isRunFinished, isRunSuccessful := forwardKurtosisResponseLineChannelUntilSourceIsClosed(validationErrorsChan, starlarkRunResponseLines); isRunFinished {
+		validationStart := time.Now()
+		validationCtx, validationSpan := tracing.StartSpan(ctx, tracing.ValidationSpanName)
+		validationErrorsChan := runner.startosisValidator.Validate(validationCtx, instructionsSequence, imageDownloadMode)
+		isRunFinished, isRunSuccessful := forwardKurtosisResponseLineChannelUntilSourceIsClosed(validationErrorsChan, runResponseLines)
+		prometheus_metrics.ObserveSince(prometheus_metrics.StarlarkValidationDuration, validationStart)
+		if isRunFinished {
 			if !isRunSuccessful {
 				logrus.Warnf("An error occurred validating the sequence of Kurtosis instructions. See logs above for more details")
+				starlarkRunErr = stacktrace.NewError("An error occurred validating the sequence of Kurtosis instructions")
 			}
+			tracing.EndSpan(validationSpan, starlarkRunErr)
 			return
 		}
+		tracing.EndSpan(validationSpan, nil)
 		logrus.Debugf("Successfully validated Starlark script")
 
 		// Execution starts > send progress info. This will soon be overridden byt the first instruction execution
 		progressInfo = binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
 			startingExecutionMsg, defaultCurrentStepNumber, totalNumberOfInstructions)
-		starlarkRunResponseLines <- progressInfo
+		runResponseLines <- progressInfo
 
-		executionResponseLinesChan := runner.startosisExecutor.Execute(ctx, dryRun, parallelism, instructionsPlan.GetIndexOfFirstInstruction(), instructionsSequence, serializedScriptOutput)
-		if isRunFinished, isRunSuccessful := forwardKurtosisResponseLineChannelUntilSourceIsClosed(executionResponseLinesChan, starlarkRunResponseLines); !isRunFinished {
+		executionCtx, executionSpan := tracing.StartSpan(ctx, tracing.ExecutionSpanName)
+		isRunFinished, isRunSuccessful = runner.execute(executionCtx, dryRun, parallelism, instructionsPlan.GetIndexOfFirstInstruction(), instructionsSequence, serializedScriptOutput, runResponseLines)
+		if !isRunFinished {
 			logrus.Warnf("Execution finished but no 'RunFinishedEvent' was received through the stream. This is unexpected as every execution should be terminal.")
 		} else if !isRunSuccessful {
 			logrus.Warnf("An error occurred executing the sequence of Kurtosis instructions. See logs above for more details")
+			starlarkRunErr = stacktrace.NewError("An error occurred executing the sequence of Kurtosis instructions")
 		} else {
 			logrus.Debugf("Successfully executed Kurtosis plan composed of %d Kurtosis instructions", totalNumberOfInstructions)
 		}
+		tracing.EndSpan(executionSpan, starlarkRunErr)
 	}()
 	return starlarkRunResponseLines
 }
 
+// execute runs the instructions and forwards their lines, tracking the peak usage of the services over the whole
+// execution, whether the client follows the run until the end or not
+func (runner *StartosisRunner) execute(
+	ctx context.Context,
+	dryRun bool,
+	parallelism int,
+	indexOfFirstInstructionInEnclavePlan int,
+	instructionsSequence []*instructions_plan.ScheduledInstruction,
+	serializedScriptOutput string,
+	runResponseLines chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine,
+) (bool, bool) {
+	if !dryRun {
+		stopTrackingServicePeakUsage := runner.servicePeakUsageTracker.startTracking()
+		defer stopTrackingServicePeakUsage()
+	}
+	executionResponseLinesChan := runner.startosisExecutor.Execute(ctx, dryRun, parallelism, indexOfFirstInstructionInEnclavePlan, instructionsSequence, serializedScriptOutput)
+	return forwardKurtosisResponseLineChannelUntilSourceIsClosed(executionResponseLinesChan, runResponseLines)
+}
+
+// GetServicePeakUsages returns the peak usage of the services over the execution of the last (or current) run
+func (runner *StartosisRunner) GetServicePeakUsages() map[service.ServiceName]ServicePeakUsage {
+	return runner.servicePeakUsageTracker.GetPeakUsages()
+}
+
+// GetCurrentEnclavePlan returns the plan of all the instructions executed so far in this enclave
+func (runner *StartosisRunner) GetCurrentEnclavePlan() *enclave_plan_persistence.EnclavePlan {
+	return runner.startosisExecutor.GetCurrentEnclavePLan()
+}
+
 func forwardKurtosisResponseLineChannelUntilSourceIsClosed(sourceChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, destChan chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) (bool, bool) {
 	isSuccessful := false
 	isStarlarkRunFinished := false
@@ -193,6 +268,19 @@ func forwardKurtosisResponseLineChannelUntilSourceIsClosed(sourceChan <-chan *ku
 	return isStarlarkRunFinished, isSuccessful
 }
 
+// forwardResponseLinesWhileContextIsActive forwards the lines until the source gets closed, dropping the ones nobody
+// reads once the context is done, and closes the destination afterward
+func forwardResponseLinesWhileContextIsActive(ctx context.Context, sourceChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, destChan chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) {
+	defer close(destChan)
+	for responseLine := range sourceChan {
+		select {
+		case destChan <- responseLine:
+		case <-ctx.Done():
+			logrus.Debugf("Dropping Starlark run response line as the run isn't followed anymore:\n%v", responseLine)
+		}
+	}
+}
+
 func doesFeatureFlagsContain(featureFlags []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag, requestedFeatureFlag kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag) bool {
 	for _, featureFlag := range featureFlags {
 		if featureFlag == requestedFeatureFlag {
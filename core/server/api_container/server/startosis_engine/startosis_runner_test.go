@@ -0,0 +1,120 @@
+package startosis_engine
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/mock_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+	"time"
+)
+
+const (
+	testSamplingInterval = time.Millisecond
+
+	testWaitFor  = 5 * time.Second
+	testWaitTick = 10 * time.Millisecond
+)
+
+func TestExecute_TracksServicePeakUsageAfterStreamIsClosed(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	serviceNetwork.EXPECT().GetServicesStats(mock.Anything, mock.Anything).Return(
+		map[service.ServiceName]*service.ServiceStats{
+			"node": service.NewServiceStats(300, 2000, 0, nil, time.Now()),
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
+	tracker := NewServicePeakUsageTracker(serviceNetwork)
+	tracker.samplingInterval = testSamplingInterval
+
+	enclaveDb := getEnclaveDBForTest(t)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(shared_helpers.NewDummyStarlarkValueSerDeForTest(), enclaveDb)
+	require.NoError(t, err)
+	runner := NewStartosisRunner(nil, nil, NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb), tracker)
+
+	instructionCanFinish := make(chan struct{})
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	require.NoError(t, instructionsPlan.AddInstruction(createBlockingMockInstruction(t, "instruction1", instructionCanFinish), starlark.None))
+	scheduledInstructions, interpretationErr := instructionsPlan.GeneratePlan()
+	require.Nil(t, interpretationErr)
+
+	// the client closes the stream before the run ends, and nobody reads its lines anymore
+	streamCtx, closeStream := context.WithCancel(context.Background())
+	closeStream()
+	runResponseLines := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	go forwardResponseLinesWhileContextIsActive(streamCtx, runResponseLines, make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine))
+
+	executionFinished := make(chan bool)
+	go func() {
+		defer close(runResponseLines)
+		isRunFinished, isRunSuccessful := runner.execute(streamCtx, executeForReal, noParallelism, 0, scheduledInstructions, noScriptOutputObject, runResponseLines)
+		executionFinished <- isRunFinished && isRunSuccessful
+	}()
+
+	// the services are sampled while the execution goes on
+	require.Eventually(t, func() bool { return len(runner.GetServicePeakUsages()) == 1 }, testWaitFor, testWaitTick)
+
+	close(instructionCanFinish)
+	select {
+	case isRunSuccessful := <-executionFinished:
+		require.True(t, isRunSuccessful)
+	case <-time.After(testWaitFor):
+		require.FailNow(t, "The execution was blocked by the closed stream")
+	}
+	require.Equal(t, ServicePeakUsage{cpuUsageMilliCores: 300, memoryUsageBytes: 2000}, runner.GetServicePeakUsages()["node"])
+
+	// the tracking stopped with the execution
+	numSamples := len(serviceNetwork.Calls)
+	time.Sleep(50 * testSamplingInterval)
+	require.Len(t, serviceNetwork.Calls, numSamples)
+}
+
+func TestForwardResponseLinesWhileContextIsActive_DropsLinesOnceContextIsDone(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	sourceChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	destChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	go forwardResponseLinesWhileContextIsActive(ctx, sourceChan, destChan)
+
+	responseLine := binding_constructors.NewStarlarkRunResponseLineFromInfoMsg("first line")
+	sourceChan <- responseLine
+	require.Equal(t, responseLine, <-destChan)
+
+	cancelCtx()
+	// nobody reads the destination anymore, which doesn't block the source
+	sourceChan <- binding_constructors.NewStarlarkRunResponseLineFromInfoMsg("dropped line")
+	close(sourceChan)
+
+	_, isOpen := <-destChan
+	require.False(t, isOpen)
+}
+
+func createBlockingMockInstruction(t *testing.T, instructionName string, canFinish <-chan struct{}) *mock_instruction.MockKurtosisInstruction {
+	instruction := mock_instruction.NewMockKurtosisInstruction(t)
+
+	stringifiedInstruction := instructionName + "()"
+	canonicalInstruction := binding_constructors.NewStarlarkInstruction(
+		dummyPosition.ToAPIType(), instructionName, stringifiedInstruction, noInstructionArgsForTesting, isSkipped, instructionName)
+	instruction.EXPECT().GetCanonicalInstruction(mock.Anything).Maybe().Return(canonicalInstruction)
+	instruction.EXPECT().GetPositionInOriginalScript().Maybe().Return(dummyPosition)
+	instruction.EXPECT().String().Maybe().Return(stringifiedInstruction)
+	instruction.EXPECT().GetPersistableAttributes().Maybe().Return(
+		enclave_plan_persistence.NewEnclavePlanInstructionBuilder().SetUuid(uuid.New().String()).SetType(instructionName).SetStarlarkCode(stringifiedInstruction).SetReturnedValue("None"),
+	)
+	instruction.EXPECT().Execute(mock.Anything).RunAndReturn(func(_ context.Context) (*string, error) {
+		<-canFinish
+		return nil, nil
+	})
+
+	return instruction
+}
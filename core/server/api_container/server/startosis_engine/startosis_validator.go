@@ -10,12 +10,16 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/tracing"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
@@ -42,18 +46,55 @@ type StartosisValidator struct {
 
 	serviceNetwork    service_network.ServiceNetwork
 	fileArtifactStore *enclave_data_directory.FilesArtifactStore
+	secretStore       *secret_store.SecretStore
 
-	backend *backend_interface.KurtosisBackend
+	backend     *backend_interface.KurtosisBackend
+	backendType args.KurtosisBackendType
+
+	hostPortRanges map[string]*port_publish_spec.HostPortRange
+
+	// Nil if no quota applies to the enclave
+	resourceQuota *args.EnclaveResourceQuota
+
+	// What the totals of the quotas leave to the enclave, which the engine updates while the enclave runs
+	resourceQuotaBudget *startosis_validator.ResourceQuotaBudget
 }
 
-func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore) *StartosisValidator {
+func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, kurtosisBackendType args.KurtosisBackendType, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore, secretStore *secret_store.SecretStore, hostPortRanges map[string]*port_publish_spec.HostPortRange, resourceQuota *args.EnclaveResourceQuota, resourceQuotaBudget *startosis_validator.ResourceQuotaBudget) *StartosisValidator {
 	imagesValidator := startosis_validator.NewImagesValidator(kurtosisBackend)
 	return &StartosisValidator{
 		imagesValidator,
 		serviceNetwork,
 		fileArtifactStore,
+		secretStore,
 		kurtosisBackend,
+		kurtosisBackendType,
+		hostPortRanges,
+		resourceQuota,
+		resourceQuotaBudget,
+	}
+}
+
+// getResourceQuota returns the quota of the enclave along with what the totals of its quotas leave to it, which is read
+// once so that the whole run gets checked against the same one. The enclaves created before a quota with total limits
+// applied to them are only checked against the latter
+func (validator *StartosisValidator) getResourceQuota() *args.EnclaveResourceQuota {
+	budget := validator.resourceQuotaBudget.Get()
+	if validator.resourceQuota == nil {
+		if budget == nil {
+			return nil
+		}
+		return &args.EnclaveResourceQuota{
+			MaxServices:                0,
+			MaxCpuMillicores:           0,
+			MaxMemoryMegabytes:         0,
+			MaxFilesArtifactsMegabytes: 0,
+			Budget:                     budget,
+		}
 	}
+	resourceQuota := *validator.resourceQuota
+	resourceQuota.Budget = budget
+	return &resourceQuota
 }
 
 func (validator *StartosisValidator) Validate(ctx context.Context, instructionsSequence []*instructions_plan.ScheduledInstruction, imageDownloadMode image_download_mode.ImageDownloadMode) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
@@ -81,6 +122,18 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 			return
 		}
 
+		secretNames, err := validator.secretStore.GetSecretNames()
+		if err != nil {
+			wrappedValidationError := startosis_errors.WrapWithValidationError(err, "Couldn't create validator environment as we ran into errors fetching the secret names")
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(wrappedValidationError.ToAPIType())
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			return
+		}
+		secretNamesSet := map[string]bool{}
+		for _, secretName := range secretNames {
+			secretNamesSet[secretName] = true
+		}
+
 		availableMemoryInMegaBytes, availableCpuInMilliCores, isResourceInformationComplete, err := (*validator.backend).GetAvailableCPUAndMemory(ctx)
 		if err != nil {
 			wrappedValidationError := startosis_errors.WrapWithValidationError(err, "Couldn't create validator environment as we ran into errors fetching information about available cpu & memory")
@@ -89,6 +142,37 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 			return
 		}
 
+		// Host ports are shared by all the enclaves, so the services of every enclave count
+		hostPortReservations, err := (*validator.backend).GetHostPortReservations(ctx)
+		if err != nil {
+			wrappedValidationError := startosis_errors.WrapWithValidationError(err, "Couldn't create validator environment as we ran into errors fetching the host ports reserved by the services")
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(wrappedValidationError.ToAPIType())
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			return
+		}
+
+		resourceQuota := validator.getResourceQuota()
+
+		// What the services and files artifacts already take only matters when the enclave has a quota
+		serviceConfigs := map[service.ServiceName]*service.ServiceConfig{}
+		filesArtifactsStorageBytes := uint64(0)
+		if resourceQuota != nil {
+			serviceConfigs, err = validator.serviceNetwork.GetServiceConfigs()
+			if err != nil {
+				wrappedValidationError := startosis_errors.WrapWithValidationError(err, "Couldn't create validator environment as we ran into errors fetching the configs of the services to check the resource quota of the enclave")
+				starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(wrappedValidationError.ToAPIType())
+				starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+				return
+			}
+			filesArtifactsStorageBytes, err = validator.fileArtifactStore.GetTotalSizeBytes()
+			if err != nil {
+				wrappedValidationError := startosis_errors.WrapWithValidationError(err, "Couldn't create validator environment as we ran into errors fetching the size of the files artifacts to check the resource quota of the enclave")
+				starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(wrappedValidationError.ToAPIType())
+				starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+				return
+			}
+		}
+
 		environment := startosis_validator.NewValidatorEnvironment(
 			serviceNames,
 			validator.fileArtifactStore.ListFiles(),
@@ -96,10 +180,18 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 			availableCpuInMilliCores,
 			availableMemoryInMegaBytes,
 			isResourceInformationComplete,
-			imageDownloadMode)
+			imageDownloadMode,
+			validator.backendType,
+			secretNamesSet,
+			string(validator.serviceNetwork.GetEnclaveUuid()),
+			validator.hostPortRanges,
+			hostPortReservations,
+			resourceQuota,
+			serviceConfigs,
+			filesArtifactsStorageBytes)
 
 		isValidationFailure = isValidationFailure ||
-			validator.validateAndUpdateEnvironment(instructionsSequence, environment, starlarkRunResponseLineStream)
+			validator.validateAndUpdateEnvironment(ctx, instructionsSequence, environment, starlarkRunResponseLineStream)
 		logrus.Debug("Finished validating environment. Validating container images...")
 
 		isValidationFailure = isValidationFailure ||
@@ -115,7 +207,7 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 	return starlarkRunResponseLineStream
 }
 
-func (validator *StartosisValidator) validateAndUpdateEnvironment(instructionsSequence []*instructions_plan.ScheduledInstruction, environment *startosis_validator.ValidatorEnvironment, starlarkRunResponseLineStream chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
+func (validator *StartosisValidator) validateAndUpdateEnvironment(ctx context.Context, instructionsSequence []*instructions_plan.ScheduledInstruction, environment *startosis_validator.ValidatorEnvironment, starlarkRunResponseLineStream chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
 	isValidationFailure := false
 	for _, scheduledInstruction := range instructionsSequence {
 		if scheduledInstruction.IsExecuted() {
@@ -123,7 +215,15 @@ func (validator *StartosisValidator) validateAndUpdateEnvironment(instructionsSe
 			continue
 		}
 		instruction := scheduledInstruction.GetInstruction()
+		instructionName := instruction.GetCanonicalInstruction(false).GetInstructionName()
+		_, span := tracing.StartSpan(
+			ctx,
+			tracing.ValidateInstructionSpanName(instructionName),
+			tracing.InstructionKey.String(instructionName),
+			tracing.InstructionPositionKey.String(instruction.GetPositionInOriginalScript().String()),
+		)
 		err := instruction.ValidateAndUpdateEnvironment(environment)
+		tracing.EndSpan(span, err)
 		if err != nil {
 			wrappedValidationError := startosis_errors.WrapWithValidationError(err,
 				"Error while validating instruction %v. The instruction can be found at %v",
@@ -133,10 +233,18 @@ func (validator *StartosisValidator) validateAndUpdateEnvironment(instructionsSe
 			isValidationFailure = true
 		}
 	}
+	// The files artifacts can be created by many kinds of instructions, so they're checked once all are validated
+	if validationErr := environment.ValidateFilesArtifactsResourceQuota(); validationErr != nil {
+		wrappedValidationError := startosis_errors.WrapWithValidationError(validationErr, "Error while validating the resource quota of the enclave")
+		starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(wrappedValidationError.ToAPIType())
+		isValidationFailure = true
+	}
 	return isValidationFailure
 }
 
 func (validator *StartosisValidator) validateImagesAccountingForProgress(ctx context.Context, environment *startosis_validator.ValidatorEnvironment, starlarkRunResponseLineStream chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
+	ctx, span := tracing.StartSpan(ctx, tracing.ImagesValidationSpanName)
+	defer span.End()
 	isValidationFailure := false
 
 	errors := make(chan error)
@@ -0,0 +1,131 @@
+package startosis_validator
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+)
+
+const (
+	bytesInMegabyte = 1024 * 1024
+
+	unlimitedQuota = 0
+)
+
+// serviceQuotaReservation is what a service of the enclave counts against the resource quota of the enclave
+type serviceQuotaReservation struct {
+	cpuMillicores uint64
+
+	memoryMegabytes uint64
+
+	// Empty if the service isn't a replica of a replicated service
+	replicaGroup string
+}
+
+func newServiceQuotaReservation(serviceConfig *service.ServiceConfig) *serviceQuotaReservation {
+	if serviceConfig == nil {
+		return &serviceQuotaReservation{cpuMillicores: 0, memoryMegabytes: 0, replicaGroup: ""}
+	}
+	return &serviceQuotaReservation{
+		cpuMillicores:   serviceConfig.GetMinCPUAllocationMillicpus(),
+		memoryMegabytes: serviceConfig.GetMinMemoryAllocationMegabytes(),
+		replicaGroup:    serviceConfig.GetReplicaGroup(),
+	}
+}
+
+// ReserveResourceQuota checks that adding or updating the service keeps the enclave within the maximum number of
+// services and the CPU and memory reservations of its resource quota, and within what the totals of the quota leave to
+// the enclave, then accounts for the service
+func (environment *ValidatorEnvironment) ReserveResourceQuota(serviceName service.ServiceName, serviceConfig *service.ServiceConfig) *startosis_errors.ValidationError {
+	return environment.reserveResourceQuota(serviceName, newServiceQuotaReservation(serviceConfig))
+}
+
+// ReserveResourceQuotaForReplica is ReserveResourceQuota for a replica added by scaling a replicated service, which has
+// the same config as the other replicas
+func (environment *ValidatorEnvironment) ReserveResourceQuotaForReplica(replicaGroup service.ServiceName, replicaName service.ServiceName) *startosis_errors.ValidationError {
+	reservation := newServiceQuotaReservation(nil)
+	for _, otherReservation := range environment.serviceQuotaReservations {
+		if otherReservation.replicaGroup == string(replicaGroup) {
+			reservation = &serviceQuotaReservation{
+				cpuMillicores:   otherReservation.cpuMillicores,
+				memoryMegabytes: otherReservation.memoryMegabytes,
+				replicaGroup:    otherReservation.replicaGroup,
+			}
+			break
+		}
+	}
+	return environment.reserveResourceQuota(replicaName, reservation)
+}
+
+// FreeResourceQuota stops counting a removed service, and all its replicas if it's a replicated service
+func (environment *ValidatorEnvironment) FreeResourceQuota(serviceName service.ServiceName) {
+	for reservingServiceName, reservation := range environment.serviceQuotaReservations {
+		if reservingServiceName == serviceName || reservation.replicaGroup == string(serviceName) {
+			delete(environment.serviceQuotaReservations, reservingServiceName)
+		}
+	}
+}
+
+// ValidateFilesArtifactsResourceQuota rejects the runs which create files artifacts when the files artifacts stored in
+// the enclave already take all the storage of its resource quota. The size of the new files artifacts is only known
+// once they're created, so the last one stored can take the enclave over the limit
+func (environment *ValidatorEnvironment) ValidateFilesArtifactsResourceQuota() *startosis_errors.ValidationError {
+	if environment.resourceQuota == nil || environment.resourceQuota.MaxFilesArtifactsMegabytes == unlimitedQuota {
+		return nil
+	}
+	maxFilesArtifactsBytes := environment.resourceQuota.MaxFilesArtifactsMegabytes * bytesInMegabyte
+	if environment.filesArtifactsStorageBytes < maxFilesArtifactsBytes {
+		return nil
+	}
+	for artifactName, existence := range environment.artifactNames {
+		if existence == ComponentCreatedOrUpdatedDuringPackageRun {
+			return startosis_errors.NewValidationError("files artifact '%v' can't be created because the files artifacts of the enclave already take '%v' bytes out of the '%v' megabytes allowed by its resource quota", artifactName, environment.filesArtifactsStorageBytes, environment.resourceQuota.MaxFilesArtifactsMegabytes)
+		}
+	}
+	return nil
+}
+
+func (environment *ValidatorEnvironment) reserveResourceQuota(serviceName service.ServiceName, reservation *serviceQuotaReservation) *startosis_errors.ValidationError {
+	if environment.resourceQuota == nil {
+		return nil
+	}
+	quota := environment.resourceQuota
+
+	numServices := uint32(len(environment.serviceQuotaReservations))
+	totalCpuMillicores := reservation.cpuMillicores
+	totalMemoryMegabytes := reservation.memoryMegabytes
+	_, isExistingService := environment.serviceQuotaReservations[serviceName]
+	if !isExistingService {
+		numServices++
+	}
+	for reservingServiceName, otherReservation := range environment.serviceQuotaReservations {
+		// An updated service releases what it reserved before
+		if reservingServiceName == serviceName {
+			continue
+		}
+		totalCpuMillicores += otherReservation.cpuMillicores
+		totalMemoryMegabytes += otherReservation.memoryMegabytes
+	}
+
+	if quota.MaxServices != unlimitedQuota && numServices > quota.MaxServices {
+		return startosis_errors.NewValidationError("service '%v' can't be added because the enclave would have '%v' services while its resource quota allows '%v'", serviceName, numServices, quota.MaxServices)
+	}
+	if quota.MaxCpuMillicores != unlimitedQuota && totalCpuMillicores > quota.MaxCpuMillicores {
+		return startosis_errors.NewValidationError("service '%v' can't reserve '%v' millicores of cpu because the services of the enclave would reserve '%v' millicores while its resource quota allows '%v'", serviceName, reservation.cpuMillicores, totalCpuMillicores, quota.MaxCpuMillicores)
+	}
+	if quota.MaxMemoryMegabytes != unlimitedQuota && totalMemoryMegabytes > quota.MaxMemoryMegabytes {
+		return startosis_errors.NewValidationError("service '%v' can't reserve '%v' megabytes of memory because the services of the enclave would reserve '%v' megabytes while its resource quota allows '%v'", serviceName, reservation.memoryMegabytes, totalMemoryMegabytes, quota.MaxMemoryMegabytes)
+	}
+	if budget := quota.Budget; budget != nil {
+		if budget.MaxServices != nil && numServices > *budget.MaxServices {
+			return startosis_errors.NewValidationError("service '%v' can't be added because the enclave would have '%v' services while the other enclaves of its resource quotas leave it '%v'", serviceName, numServices, *budget.MaxServices)
+		}
+		if budget.MaxCpuMillicores != nil && totalCpuMillicores > *budget.MaxCpuMillicores {
+			return startosis_errors.NewValidationError("service '%v' can't reserve '%v' millicores of cpu because the services of the enclave would reserve '%v' millicores while the other enclaves of its resource quotas leave it '%v'", serviceName, reservation.cpuMillicores, totalCpuMillicores, *budget.MaxCpuMillicores)
+		}
+		if budget.MaxMemoryMegabytes != nil && totalMemoryMegabytes > *budget.MaxMemoryMegabytes {
+			return startosis_errors.NewValidationError("service '%v' can't reserve '%v' megabytes of memory because the services of the enclave would reserve '%v' megabytes while the other enclaves of its resource quotas leave it '%v'", serviceName, reservation.memoryMegabytes, totalMemoryMegabytes, *budget.MaxMemoryMegabytes)
+		}
+	}
+	environment.serviceQuotaReservations[serviceName] = reservation
+	return nil
+}
@@ -3,6 +3,7 @@ package startosis_validator
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
@@ -11,6 +12,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/prometheus_metrics"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/tracing"
 	"github.com/sirupsen/logrus"
 )
 
@@ -83,12 +86,17 @@ func fetchImageFromBackend(ctx context.Context, wg *sync.WaitGroup, imageCurrent
 	}()
 
 	logrus.Debugf("Starting the download of image: '%s'", imageName)
-	imagePulledFromRemote, imageArch, err := (*backend).FetchImage(ctx, imageName, registrySpec, imageDownloadMode)
+	pullStart := time.Now()
+	pullCtx, span := tracing.StartSpan(ctx, tracing.PullImageSpanName, tracing.ImageKey.String(imageName))
+	imagePulledFromRemote, imageArch, err := (*backend).FetchImage(pullCtx, imageName, registrySpec, imageDownloadMode)
+	span.SetAttributes(tracing.IsImagePulledKey.Bool(imagePulledFromRemote))
+	tracing.EndSpan(span, err)
 	if err != nil {
 		logrus.Warnf("Container image '%s' download failed. Error was: '%s'", imageName, err.Error())
 		pullErrors <- startosis_errors.WrapWithValidationError(err, "Failed fetching the required image '%v'.", imageName)
 		return
 	}
+	prometheus_metrics.ObserveSince(prometheus_metrics.ImagePullDuration.WithLabelValues(prometheus_metrics.GetImageSource(imagePulledFromRemote)), pullStart)
 	logrus.Debugf("Container image '%s' successfully downloaded", imageName)
 }
 
@@ -115,12 +123,16 @@ func (validator *ImagesValidator) buildImageUsingBackend(
 	}()
 
 	logrus.Debugf("Starting the build of image: '%s'", imageName)
-	imageArch, err := (*backend).BuildImage(ctx, imageName, imageBuildSpec)
+	buildStart := time.Now()
+	buildCtx, span := tracing.StartSpan(ctx, tracing.BuildImageSpanName, tracing.ImageKey.String(imageName))
+	imageArch, err := (*backend).BuildImage(buildCtx, imageName, imageBuildSpec)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		logrus.Warnf("Container image '%s' build failed. Error was: '%s'", imageName, err.Error())
 		buildErrors <- startosis_errors.WrapWithValidationError(err, "Failed to build the required image '%v'.", imageName)
 		return
 	}
+	prometheus_metrics.ObserveSince(prometheus_metrics.ImageBuildDuration.WithLabelValues(prometheus_metrics.DockerImageBuilder), buildStart)
 	logrus.Debugf("Container image '%s' successfully built", imageName)
 }
 
@@ -148,11 +160,15 @@ func (validator *ImagesValidator) nixBuildUsingBackend(
 	}()
 
 	logrus.Debugf("Starting the build of image: '%s'", imageRef)
-	imageName, err := (*backend).NixBuild(ctx, nixBuildSpec)
+	buildStart := time.Now()
+	buildCtx, span := tracing.StartSpan(ctx, tracing.NixBuildSpanName, tracing.ImageKey.String(imageRef))
+	imageName, err := (*backend).NixBuild(buildCtx, nixBuildSpec)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		logrus.Warnf("Container image '%s' build failed. Error was: '%s'", imageRef, err.Error())
 		buildErrors <- startosis_errors.WrapWithValidationError(err, "Failed to build the required image '%v'.", imageRef)
 		return
 	}
+	prometheus_metrics.ObserveSince(prometheus_metrics.ImageBuildDuration.WithLabelValues(prometheus_metrics.NixImageBuilder), buildStart)
 	logrus.Debugf("Container image '%s' successfully built from Nix definition %s", imageName, imageRef)
 }
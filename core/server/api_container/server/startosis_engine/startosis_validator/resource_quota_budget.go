@@ -0,0 +1,35 @@
+package startosis_validator
+
+import (
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+)
+
+// ResourceQuotaBudget holds what the totals of the resource quotas of the enclave leave to it. The engine sets it when
+// it creates the enclave, then keeps it up to date as the other enclaves of the quotas add and remove services
+type ResourceQuotaBudget struct {
+	mutex *sync.RWMutex
+
+	// Nil if none of the quotas of the enclave limits a total
+	budget *args.EnclaveResourceQuotaBudget
+}
+
+func NewResourceQuotaBudget(budget *args.EnclaveResourceQuotaBudget) *ResourceQuotaBudget {
+	return &ResourceQuotaBudget{
+		mutex:  &sync.RWMutex{},
+		budget: budget,
+	}
+}
+
+func (quotaBudget *ResourceQuotaBudget) Get() *args.EnclaveResourceQuotaBudget {
+	quotaBudget.mutex.RLock()
+	defer quotaBudget.mutex.RUnlock()
+	return quotaBudget.budget
+}
+
+func (quotaBudget *ResourceQuotaBudget) Set(budget *args.EnclaveResourceQuotaBudget) {
+	quotaBudget.mutex.Lock()
+	defer quotaBudget.mutex.Unlock()
+	quotaBudget.budget = budget
+}
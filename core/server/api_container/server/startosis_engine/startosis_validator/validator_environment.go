@@ -2,14 +2,18 @@ package startosis_validator
 
 import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_settings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/sirupsen/logrus"
+	"sort"
 )
 
 // ValidatorEnvironment fields are not exported so that only validators can access its fields
@@ -27,9 +31,18 @@ type ValidatorEnvironment struct {
 	minCPUByServiceName           map[service.ServiceName]compute_resources.CpuMilliCores
 	minMemoryByServiceName        map[service.ServiceName]compute_resources.MemoryInMegaBytes
 	imageDownloadMode             image_download_mode.ImageDownloadMode
+	kurtosisBackendType           args.KurtosisBackendType
+	secretNames                   map[string]bool
+	enclaveUuid                   string
+	hostPortRanges                map[string]*port_publish_spec.HostPortRange
+	hostPortReservations          map[uint16]*port_publish_spec.HostPortReservation
+	hostPortsReservedByServices   map[uint16]service.ServiceName
+	resourceQuota                 *args.EnclaveResourceQuota
+	serviceQuotaReservations      map[service.ServiceName]*serviceQuotaReservation
+	filesArtifactsStorageBytes    uint64
 }
 
-func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, isResourceInformationComplete bool, imageDownloadMode image_download_mode.ImageDownloadMode) *ValidatorEnvironment {
+func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, isResourceInformationComplete bool, imageDownloadMode image_download_mode.ImageDownloadMode, kurtosisBackendType args.KurtosisBackendType, secretNames map[string]bool, enclaveUuid string, hostPortRanges map[string]*port_publish_spec.HostPortRange, hostPortReservations []*port_publish_spec.HostPortReservation, resourceQuota *args.EnclaveResourceQuota, serviceConfigs map[service.ServiceName]*service.ServiceConfig, filesArtifactsStorageBytes uint64) *ValidatorEnvironment {
 	serviceNamesWithComponentExistence := map[service.ServiceName]ComponentExistence{}
 	for serviceName := range serviceNames {
 		serviceNamesWithComponentExistence[serviceName] = ComponentExistedBeforePackageRun
@@ -38,6 +51,15 @@ func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifact
 	for artifactName := range artifactNames {
 		artifactNamesWithComponentExistence[artifactName] = ComponentExistedBeforePackageRun
 	}
+	// The services registered without a config yet still count, without reserving anything
+	serviceQuotaReservations := map[service.ServiceName]*serviceQuotaReservation{}
+	for serviceName := range serviceNames {
+		serviceQuotaReservations[serviceName] = newServiceQuotaReservation(serviceConfigs[serviceName])
+	}
+	hostPortReservationsByHostPort := map[uint16]*port_publish_spec.HostPortReservation{}
+	for _, hostPortReservation := range hostPortReservations {
+		hostPortReservationsByHostPort[hostPortReservation.GetHostPortNumber()] = hostPortReservation
+	}
 	return &ValidatorEnvironment{
 		imagesToPull:                  map[string]*image_registry_spec.ImageRegistrySpec{},
 		imagesToBuild:                 map[string]*image_build_spec.ImageBuildSpec{},
@@ -53,6 +75,16 @@ func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifact
 		minMemoryByServiceName: map[service.ServiceName]compute_resources.MemoryInMegaBytes{},
 		minCPUByServiceName:    map[service.ServiceName]compute_resources.CpuMilliCores{},
 		imageDownloadMode:      imageDownloadMode,
+		kurtosisBackendType:    kurtosisBackendType,
+		secretNames:            secretNames,
+		enclaveUuid:            enclaveUuid,
+		hostPortRanges:         hostPortRanges,
+		hostPortReservations:   hostPortReservationsByHostPort,
+		// the host ports picked for the services of this run
+		hostPortsReservedByServices: map[uint16]service.ServiceName{},
+		resourceQuota:               resourceQuota,
+		serviceQuotaReservations:    serviceQuotaReservations,
+		filesArtifactsStorageBytes:  filesArtifactsStorageBytes,
 	}
 }
 
@@ -177,6 +209,113 @@ func (environment *ValidatorEnvironment) HasEnoughMemory(memoryToConsume uint64,
 	return startosis_errors.NewValidationError("service '%v' requires '%v' megabytes of memory but based on our calculation we will only have '%v' megabytes available at the time we start the service", serviceNameForLogging, memoryToConsume, environment.availableMemoryInMegaBytes)
 }
 
+// ValidateContainerSettings rejects the container settings the backend running the enclave can't honour
+func (environment *ValidatorEnvironment) ValidateContainerSettings(containerSettings *container_settings.ContainerSettings, serviceNameForLogging service.ServiceName) *startosis_errors.ValidationError {
+	if containerSettings == nil {
+		return nil
+	}
+	if environment.kurtosisBackendType == args.KurtosisBackendType_Kubernetes && len(containerSettings.GetUlimits()) > 0 {
+		return startosis_errors.NewValidationError("service '%v' sets ulimits but Kubernetes doesn't support setting them on a container; they can only be set when running on Docker", serviceNameForLogging)
+	}
+	return nil
+}
+
+// ValidateSecretsExist checks that the secrets used by a service were set in the enclave; they're only read when the
+// service starts, so this is the last chance to catch a typo before the run gets halfway through
+func (environment *ValidatorEnvironment) ValidateSecretsExist(secretNames []string, serviceNameForLogging service.ServiceName) *startosis_errors.ValidationError {
+	for _, secretName := range secretNames {
+		if !environment.secretNames[secretName] {
+			return startosis_errors.NewValidationError("service '%v' uses secret '%v' which doesn't exist in the enclave; set it with 'kurtosis secret set'", serviceNameForLogging, secretName)
+		}
+	}
+	return nil
+}
+
+// ReserveHostPorts checks that the ports a service publishes on static or range host ports can get a host port, which
+// isn't the case when another service, in this run or any enclave, already holds it. Kubernetes ignores the port
+// publish specs, so nothing is reserved there
+func (environment *ValidatorEnvironment) ReserveHostPorts(portPublishSpecs map[string]*port_publish_spec.PortPublishSpec, serviceName service.ServiceName) *startosis_errors.ValidationError {
+	if environment.kurtosisBackendType == args.KurtosisBackendType_Kubernetes {
+		return nil
+	}
+	// Sorted so the host ports picked in ranges don't depend on the map order
+	portIds := []string{}
+	for portId := range portPublishSpecs {
+		portIds = append(portIds, portId)
+	}
+	sort.Strings(portIds)
+
+	for _, portId := range portIds {
+		portPublishSpec := portPublishSpecs[portId]
+		switch portPublishSpec.GetMode() {
+		case port_publish_spec.PortPublishMode_Ephemeral:
+			continue
+		case port_publish_spec.PortPublishMode_Static:
+			hostPortNumber := portPublishSpec.GetHostPortNumber()
+			if validationErr := environment.getHostPortTakenError(hostPortNumber, portId, serviceName); validationErr != nil {
+				return validationErr
+			}
+			environment.hostPortsReservedByServices[hostPortNumber] = serviceName
+		case port_publish_spec.PortPublishMode_Range:
+			rangeName := portPublishSpec.GetRangeName()
+			hostPortRange, found := environment.hostPortRanges[rangeName]
+			if !found {
+				return startosis_errors.NewValidationError("service '%v' publishes port '%v' on host port range '%v' but no such range is set on the engine; ranges are set with the '--host-port-ranges' flag of 'kurtosis engine start'", serviceName, portId, rangeName)
+			}
+			hostPortNumber, found := environment.getFreeHostPortInRange(hostPortRange, portId, serviceName)
+			if !found {
+				return startosis_errors.NewValidationError("service '%v' publishes port '%v' on host port range '%v' (%v) but all its host ports are already reserved", serviceName, portId, rangeName, hostPortRange.String())
+			}
+			environment.hostPortsReservedByServices[hostPortNumber] = serviceName
+		}
+	}
+	return nil
+}
+
+// FreeHostPorts releases the host ports held by a service that gets removed, in this run or before it
+func (environment *ValidatorEnvironment) FreeHostPorts(serviceName service.ServiceName) {
+	for hostPortNumber, reservingServiceName := range environment.hostPortsReservedByServices {
+		if reservingServiceName == serviceName {
+			delete(environment.hostPortsReservedByServices, hostPortNumber)
+		}
+	}
+	for hostPortNumber, hostPortReservation := range environment.hostPortReservations {
+		if hostPortReservation.IsHeldBy(environment.enclaveUuid, string(serviceName)) {
+			delete(environment.hostPortReservations, hostPortNumber)
+		}
+	}
+}
+
+func (environment *ValidatorEnvironment) getHostPortTakenError(hostPortNumber uint16, portId string, serviceName service.ServiceName) *startosis_errors.ValidationError {
+	if reservingServiceName, found := environment.hostPortsReservedByServices[hostPortNumber]; found && reservingServiceName != serviceName {
+		return startosis_errors.NewValidationError("service '%v' publishes port '%v' on host port '%v' but service '%v' of this run already publishes a port there", serviceName, portId, hostPortNumber, reservingServiceName)
+	}
+	if hostPortReservation, found := environment.hostPortReservations[hostPortNumber]; found && !hostPortReservation.IsHeldBy(environment.enclaveUuid, string(serviceName)) {
+		return startosis_errors.NewValidationError("service '%v' publishes port '%v' on host port '%v' but it's reserved by port '%v' of service '%v' in enclave '%v'", serviceName, portId, hostPortNumber, hostPortReservation.GetPrivatePortId(), hostPortReservation.GetServiceName(), hostPortReservation.GetEnclaveUuid())
+	}
+	return nil
+}
+
+// The host port the service already holds comes first, so updating a service doesn't move its port
+func (environment *ValidatorEnvironment) getFreeHostPortInRange(hostPortRange *port_publish_spec.HostPortRange, portId string, serviceName service.ServiceName) (uint16, bool) {
+	for hostPortNumber, hostPortReservation := range environment.hostPortReservations {
+		if hostPortReservation.IsHeldBy(environment.enclaveUuid, string(serviceName)) && hostPortReservation.GetPrivatePortId() == portId &&
+			hostPortRange.Contains(hostPortNumber) && environment.getHostPortTakenError(hostPortNumber, portId, serviceName) == nil {
+			return hostPortNumber, true
+		}
+	}
+	for hostPortNumber := uint32(hostPortRange.GetStart()); hostPortNumber <= uint32(hostPortRange.GetEnd()); hostPortNumber++ {
+		if _, found := environment.hostPortsReservedByServices[uint16(hostPortNumber)]; found {
+			continue
+		}
+		if _, found := environment.hostPortReservations[uint16(hostPortNumber)]; found {
+			continue
+		}
+		return uint16(hostPortNumber), true
+	}
+	return 0, false
+}
+
 func (environment *ValidatorEnvironment) AddPersistentKey(persistentKey service_directory.DirectoryPersistentKey) {
 	environment.persistentKeys[persistentKey] = ComponentCreatedOrUpdatedDuringPackageRun
 }
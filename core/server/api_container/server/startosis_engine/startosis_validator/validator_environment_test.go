@@ -3,8 +3,11 @@ package startosis_validator
 import (
 	"testing"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_settings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,11 +21,26 @@ const (
 	isResourceInformationComplete = true
 	tooMuchMemory                 = 120000
 	tooMuchCpu                    = 5000
+
+	testEnclaveUuid      = "enclave-uuid"
+	otherTestEnclaveUuid = "other-enclave-uuid"
+	testFooService       = service.ServiceName("foo")
+	testHostPortRange    = "p2p"
+)
+
+var (
+	noSecretNames          = map[string]bool{}
+	noHostPortRanges       = map[string]*port_publish_spec.HostPortRange{}
+	noHostPortReservations = []*port_publish_spec.HostPortReservation{}
+
+	noResourceQuota              *args.EnclaveResourceQuota = nil
+	noServiceConfigs                                        = map[service.ServiceName]*service.ServiceConfig{}
+	noFilesArtifactsStorageBytes                            = uint64(0)
 )
 
 func TestMultiplePortIdsForValidation(t *testing.T) {
 	emptyInitialMapping := map[service.ServiceName][]string{}
-	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing)
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, noSecretNames, testEnclaveUuid, noHostPortRanges, noHostPortReservations, noResourceQuota, noServiceConfigs, noFilesArtifactsStorageBytes)
 	portIds := []string{
 		fooPortId,
 		fizzPortId,
@@ -37,3 +55,160 @@ func TestMultiplePortIdsForValidation(t *testing.T) {
 	require.Error(t, validatorEnvironment.HasEnoughCPU(tooMuchCpu, testBarService))
 	require.Error(t, validatorEnvironment.HasEnoughMemory(tooMuchMemory, testBarService))
 }
+
+func TestUlimitsAreOnlyValidOnDocker(t *testing.T) {
+	nofileUlimit, err := container_settings.NewUlimit(1024, 65536)
+	require.NoError(t, err)
+	containerSettings, err := container_settings.NewContainerSettings(false, nil, nil, nil, map[string]*container_settings.Ulimit{"nofile": nofileUlimit}, 0, false, nil, nil)
+	require.NoError(t, err)
+
+	dockerValidatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, noSecretNames, testEnclaveUuid, noHostPortRanges, noHostPortReservations, noResourceQuota, noServiceConfigs, noFilesArtifactsStorageBytes)
+	require.Nil(t, dockerValidatorEnvironment.ValidateContainerSettings(containerSettings, testBarService))
+
+	kubernetesValidatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Kubernetes, noSecretNames, testEnclaveUuid, noHostPortRanges, noHostPortReservations, noResourceQuota, noServiceConfigs, noFilesArtifactsStorageBytes)
+	require.NotNil(t, kubernetesValidatorEnvironment.ValidateContainerSettings(containerSettings, testBarService))
+}
+
+func TestValidateSecretsExist(t *testing.T) {
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, map[string]bool{"db-password": true}, testEnclaveUuid, noHostPortRanges, noHostPortReservations, noResourceQuota, noServiceConfigs, noFilesArtifactsStorageBytes)
+	require.Nil(t, validatorEnvironment.ValidateSecretsExist([]string{"db-password"}, testBarService))
+	require.NotNil(t, validatorEnvironment.ValidateSecretsExist([]string{"db-password", "api-token"}, testBarService))
+}
+
+func TestReserveHostPorts_StaticHostPortConflicts(t *testing.T) {
+	hostPortReservations := []*port_publish_spec.HostPortReservation{
+		port_publish_spec.NewHostPortReservation(8080, otherTestEnclaveUuid, string(testBarService), fooPortId),
+		port_publish_spec.NewHostPortReservation(9090, testEnclaveUuid, string(testBarService), fooPortId),
+	}
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, noSecretNames, testEnclaveUuid, noHostPortRanges, hostPortReservations, noResourceQuota, noServiceConfigs, noFilesArtifactsStorageBytes)
+
+	// Reserved by a service of another enclave
+	require.NotNil(t, validatorEnvironment.ReserveHostPorts(getStaticPortPublishSpecs(t, 8080), testBarService))
+
+	// Reserved by the service itself, which gets it back
+	require.Nil(t, validatorEnvironment.ReserveHostPorts(getStaticPortPublishSpecs(t, 9090), testBarService))
+
+	// Reserved by another service of this run
+	require.NotNil(t, validatorEnvironment.ReserveHostPorts(getStaticPortPublishSpecs(t, 9090), testFooService))
+
+	// Freed once the service is removed
+	validatorEnvironment.FreeHostPorts(testBarService)
+	require.Nil(t, validatorEnvironment.ReserveHostPorts(getStaticPortPublishSpecs(t, 9090), testFooService))
+}
+
+func TestReserveHostPorts_RangeHostPorts(t *testing.T) {
+	hostPortRange, err := port_publish_spec.NewHostPortRange(30000, 30001)
+	require.NoError(t, err)
+	hostPortRanges := map[string]*port_publish_spec.HostPortRange{
+		testHostPortRange: hostPortRange,
+	}
+	hostPortReservations := []*port_publish_spec.HostPortReservation{
+		port_publish_spec.NewHostPortReservation(30000, otherTestEnclaveUuid, string(testBarService), fooPortId),
+	}
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, noSecretNames, testEnclaveUuid, hostPortRanges, hostPortReservations, noResourceQuota, noServiceConfigs, noFilesArtifactsStorageBytes)
+
+	rangePortPublishSpec, err := port_publish_spec.NewRangePortPublishSpec(testHostPortRange)
+	require.NoError(t, err)
+	rangePortPublishSpecs := map[string]*port_publish_spec.PortPublishSpec{
+		fooPortId: rangePortPublishSpec,
+	}
+
+	// Only 30001 is left in the range
+	require.Nil(t, validatorEnvironment.ReserveHostPorts(rangePortPublishSpecs, testBarService))
+	require.NotNil(t, validatorEnvironment.ReserveHostPorts(rangePortPublishSpecs, testFooService))
+
+	unknownRangePortPublishSpec, err := port_publish_spec.NewRangePortPublishSpec("unknown")
+	require.NoError(t, err)
+	require.NotNil(t, validatorEnvironment.ReserveHostPorts(map[string]*port_publish_spec.PortPublishSpec{fooPortId: unknownRangePortPublishSpec}, testFooService))
+}
+
+func getStaticPortPublishSpecs(t *testing.T, hostPortNumber uint16) map[string]*port_publish_spec.PortPublishSpec {
+	staticPortPublishSpec, err := port_publish_spec.NewStaticPortPublishSpec(hostPortNumber)
+	require.NoError(t, err)
+	return map[string]*port_publish_spec.PortPublishSpec{
+		fooPortId: staticPortPublishSpec,
+	}
+}
+
+func TestResourceQuota_ServicesCpuAndMemory(t *testing.T) {
+	resourceQuota := &args.EnclaveResourceQuota{
+		MaxServices:                2,
+		MaxCpuMillicores:           1000,
+		MaxMemoryMegabytes:         512,
+		MaxFilesArtifactsMegabytes: 0,
+		Budget:                     nil,
+	}
+	existingServiceNames := map[service.ServiceName]bool{testFooService: true}
+	existingServiceConfigs := map[service.ServiceName]*service.ServiceConfig{
+		testFooService: getTestServiceConfig(t, 600, 256),
+	}
+	validatorEnvironment := NewValidatorEnvironment(existingServiceNames, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, noSecretNames, testEnclaveUuid, noHostPortRanges, noHostPortReservations, resourceQuota, existingServiceConfigs, noFilesArtifactsStorageBytes)
+
+	// The existing service already reserves 600 millicores
+	require.NotNil(t, validatorEnvironment.ReserveResourceQuota(testBarService, getTestServiceConfig(t, 500, 0)))
+	require.NotNil(t, validatorEnvironment.ReserveResourceQuota(testBarService, getTestServiceConfig(t, 0, 300)))
+	require.Nil(t, validatorEnvironment.ReserveResourceQuota(testBarService, getTestServiceConfig(t, 400, 256)))
+
+	// Updating a service replaces what it reserved
+	require.Nil(t, validatorEnvironment.ReserveResourceQuota(testFooService, getTestServiceConfig(t, 100, 0)))
+	require.Nil(t, validatorEnvironment.ReserveResourceQuota(testBarService, getTestServiceConfig(t, 900, 512)))
+
+	require.NotNil(t, validatorEnvironment.ReserveResourceQuota("third", getTestServiceConfig(t, 0, 0)))
+	validatorEnvironment.FreeResourceQuota(testFooService)
+	require.Nil(t, validatorEnvironment.ReserveResourceQuota("third", getTestServiceConfig(t, 0, 0)))
+}
+
+func TestResourceQuota_BudgetLeftByOtherEnclaves(t *testing.T) {
+	maxServices := uint32(2)
+	noCpuMillicoresLeft := uint64(0)
+	resourceQuota := &args.EnclaveResourceQuota{
+		MaxServices:                0,
+		MaxCpuMillicores:           0,
+		MaxMemoryMegabytes:         0,
+		MaxFilesArtifactsMegabytes: 0,
+		Budget: &args.EnclaveResourceQuotaBudget{
+			MaxServices:        &maxServices,
+			MaxCpuMillicores:   &noCpuMillicoresLeft,
+			MaxMemoryMegabytes: nil,
+		},
+	}
+	existingServiceNames := map[service.ServiceName]bool{testFooService: true}
+	existingServiceConfigs := map[service.ServiceName]*service.ServiceConfig{
+		testFooService: getTestServiceConfig(t, 0, 256),
+	}
+	validatorEnvironment := NewValidatorEnvironment(existingServiceNames, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, noSecretNames, testEnclaveUuid, noHostPortRanges, noHostPortReservations, resourceQuota, existingServiceConfigs, noFilesArtifactsStorageBytes)
+
+	// The other enclaves reserve all the cpu of the quota, while its memory isn't limited
+	require.NotNil(t, validatorEnvironment.ReserveResourceQuota(testBarService, getTestServiceConfig(t, 100, 0)))
+	require.Nil(t, validatorEnvironment.ReserveResourceQuota(testBarService, getTestServiceConfig(t, 0, 4096)))
+
+	require.NotNil(t, validatorEnvironment.ReserveResourceQuota("third", getTestServiceConfig(t, 0, 0)))
+}
+
+func TestResourceQuota_FilesArtifacts(t *testing.T) {
+	resourceQuota := &args.EnclaveResourceQuota{
+		MaxServices:                0,
+		MaxCpuMillicores:           0,
+		MaxMemoryMegabytes:         0,
+		MaxFilesArtifactsMegabytes: 1,
+		Budget:                     nil,
+	}
+	existingArtifactNames := map[string]bool{"existing-artifact": true}
+	fullStorageBytes := uint64(1024 * 1024)
+	validatorEnvironment := NewValidatorEnvironment(nil, existingArtifactNames, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, noSecretNames, testEnclaveUuid, noHostPortRanges, noHostPortReservations, resourceQuota, noServiceConfigs, fullStorageBytes)
+
+	// Runs which don't create files artifacts are fine
+	require.Nil(t, validatorEnvironment.ValidateFilesArtifactsResourceQuota())
+	validatorEnvironment.AddArtifactName("new-artifact")
+	require.NotNil(t, validatorEnvironment.ValidateFilesArtifactsResourceQuota())
+
+	notFullValidatorEnvironment := NewValidatorEnvironment(nil, existingArtifactNames, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, args.KurtosisBackendType_Docker, noSecretNames, testEnclaveUuid, noHostPortRanges, noHostPortReservations, resourceQuota, noServiceConfigs, fullStorageBytes-1)
+	notFullValidatorEnvironment.AddArtifactName("new-artifact")
+	require.Nil(t, notFullValidatorEnvironment.ValidateFilesArtifactsResourceQuota())
+}
+
+func getTestServiceConfig(t *testing.T, minCpuMilliCores uint64, minMemoryMegaBytes uint64) *service.ServiceConfig {
+	serviceConfig, err := service.CreateServiceConfig("test-image", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, 0, "", minCpuMilliCores, minMemoryMegaBytes, nil, nil, nil, nil, image_download_mode.ImageDownloadMode_Missing)
+	require.NoError(t, err)
+	return serviceConfig
+}
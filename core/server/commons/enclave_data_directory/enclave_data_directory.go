@@ -30,6 +30,10 @@ const (
 
 	// Name of directory INSIDE THE ENCLAVE DATA DIR containing the enclave database (currently the bolt dB is implemented)
 	enclaveDatabase = "enclave-database"
+
+	// Name of the file INSIDE THE ENCLAVE DATA DIR holding the key which encrypts the secrets of the enclave; it's kept
+	// out of the enclave database so that the database alone doesn't reveal the secrets
+	secretStoreKeyFilename = "secret-store.key"
 )
 
 // A directory containing all the data associated with a certain enclave (i.e. a Docker subnetwork where services are spun up)
@@ -93,3 +97,7 @@ func (dir EnclaveDataDirectory) GetEnclaveDataDirectoryPaths() (string, string,
 
 	return repositoriesStoreDirpath, tempRepositoriesStoreDirpath, githubAuthStoreDirpath, enclaveDatabaseDirpath, nil
 }
+
+func (dir EnclaveDataDirectory) GetSecretStoreKeyFilepath() string {
+	return path.Join(dir.absMountDirpath, secretStoreKeyFilename)
+}
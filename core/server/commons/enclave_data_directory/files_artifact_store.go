@@ -13,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"io"
+	"os"
 	"strings"
 	"sync"
 )
@@ -25,12 +26,17 @@ const (
 	maxFileArtifactNameRetriesDefault = 5
 )
 
+// FilesArtifactStoredHandler gets called every time a new files artifact gets stored; it must not use the store
+type FilesArtifactStoredHandler func(artifactName string, filesArtifactUuid FilesArtifactUUID)
+
 type FilesArtifactStore struct {
 	fileCache                       *FileCache
 	mutex                           *sync.RWMutex
 	fileArtifactDb                  *file_artifacts_db.FileArtifactPersisted
 	maxRetriesToGetFileArtifactName int
 	generateNatureThemeName         func() string
+	// Behind a pointer, as the methods of the store have value receivers
+	filesArtifactStoredHandlers *[]FilesArtifactStoredHandler
 }
 
 func newFilesArtifactStoreFromDb(absoluteDirpath string, dirpathRelativeToDataDirRoot string, db *file_artifacts_db.FileArtifactPersisted) *FilesArtifactStore {
@@ -40,6 +46,7 @@ func newFilesArtifactStoreFromDb(absoluteDirpath string, dirpathRelativeToDataDi
 		maxRetriesToGetFileArtifactName: maxFileArtifactNameRetriesDefault,
 		generateNatureThemeName:         name_generator.GenerateNatureThemeNameForFileArtifacts,
 		fileArtifactDb:                  db,
+		filesArtifactStoredHandlers:     &[]FilesArtifactStoredHandler{},
 	}
 }
 
@@ -57,6 +64,7 @@ func NewFilesArtifactStoreForTesting(
 		fileArtifactDb:                  fileArtifactDb,
 		maxRetriesToGetFileArtifactName: maxRetry,
 		generateNatureThemeName:         nameGeneratorMock,
+		filesArtifactStoredHandlers:     &[]FilesArtifactStoredHandler{},
 	}
 }
 
@@ -81,9 +89,19 @@ func (store FilesArtifactStore) StoreFile(reader io.Reader, contentMd5 []byte, a
 	if err := store.fileArtifactDb.Persist(); err != nil {
 		return "", stacktrace.Propagate(err, "Failed persisting data on file artifacts db")
 	}
+	for _, handler := range *store.filesArtifactStoredHandlers {
+		handler(artifactName, filesArtifactUuid)
+	}
 	return filesArtifactUuid, nil
 }
 
+// AddFilesArtifactStoredHandler registers a handler which gets called every time a new files artifact gets stored
+func (store FilesArtifactStore) AddFilesArtifactStoredHandler(handler FilesArtifactStoredHandler) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	*store.filesArtifactStoredHandlers = append(*store.filesArtifactStoredHandlers, handler)
+}
+
 func (store FilesArtifactStore) UpdateFile(filesArtifactUuid FilesArtifactUUID, reader io.Reader, contentMd5 []byte) error {
 	store.mutex.Lock()
 	defer store.mutex.Unlock()
@@ -175,6 +193,26 @@ func (store FilesArtifactStore) ListFiles() map[string]bool {
 	return store.fileArtifactDb.ListFiles()
 }
 
+// GetTotalSizeBytes returns the disk space taken by all the files artifacts of the store
+func (store FilesArtifactStore) GetTotalSizeBytes() (uint64, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	totalSizeBytes := uint64(0)
+	for artifactName, filesArtifactUuid := range store.fileArtifactDb.GetArtifactUuidMap() {
+		_, file, _, _, err := store.getFileUnlocked(FilesArtifactUUID(filesArtifactUuid))
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred getting the file of files artifact '%v'", artifactName)
+		}
+		fileInfo, err := os.Stat(file.GetAbsoluteFilepath())
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred getting the size of the file of files artifact '%v'", artifactName)
+		}
+		totalSizeBytes += uint64(fileInfo.Size())
+	}
+	return totalSizeBytes, nil
+}
+
 func (store FilesArtifactStore) GetFileNamesAndUuids() []FileNameAndUuid {
 	store.mutex.RLock()
 	defer store.mutex.RUnlock()
@@ -183,6 +183,43 @@ func TestFilesArtifactStore_GetFileNamesAndUuids(t *testing.T) {
 	require.Contains(t, fileNameAndUuids, FileNameAndUuid{uuid: anotherUUID, name: testArtifact2})
 }
 
+func TestFilesArtifactStore_GetTotalSizeBytes(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+
+	totalSizeBytes, err := fileStore.GetTotalSizeBytes()
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), totalSizeBytes)
+
+	_, err = fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), []byte("blah"), "first-artifact")
+	require.Nil(t, err)
+	_, err = fileStore.StoreFile(strings.NewReader("Kurtosis"), []byte("blah"), "second-artifact")
+	require.Nil(t, err)
+
+	totalSizeBytes, err = fileStore.GetTotalSizeBytes()
+	require.Nil(t, err)
+	require.Equal(t, uint64(len("Long Live Kurtosis!")+len("Kurtosis")), totalSizeBytes)
+}
+
+func TestFilesArtifactStore_FilesArtifactStoredHandlers(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+
+	storedArtifactNames := []string{}
+	fileStore.AddFilesArtifactStoredHandler(func(artifactName string, _ FilesArtifactUUID) {
+		storedArtifactNames = append(storedArtifactNames, artifactName)
+	})
+
+	filesArtifactUuid, err := fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), []byte("blah"), "test-artifact")
+	require.Nil(t, err)
+	// Neither updating a files artifact nor failing to store one counts as storing a new one
+	require.Nil(t, fileStore.UpdateFile(filesArtifactUuid, strings.NewReader("Kurtosis"), []byte("blah")))
+	_, err = fileStore.StoreFile(strings.NewReader("Kurtosis"), []byte("blah"), "test-artifact")
+	require.NotNil(t, err)
+
+	require.Equal(t, []string{"test-artifact"}, storedArtifactNames)
+}
+
 func getTestFileStore(t *testing.T) (*FilesArtifactStore, func()) {
 	absDirpath, err := os.MkdirTemp("", "")
 	require.Nil(t, err)
@@ -0,0 +1,152 @@
+package prometheus_metrics
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	namespace = "kurtosis"
+
+	MetricsPath = "/metrics"
+
+	instructionLabel = "instruction"
+	resultLabel      = "result"
+	sourceLabel      = "source"
+	builderLabel     = "builder"
+
+	SuccessResult = "success"
+	FailureResult = "failure"
+
+	// Whether an image got pulled from its registry, or was already on the host
+	RemoteImageSource = "remote"
+	LocalImageSource  = "local"
+
+	DockerImageBuilder = "docker"
+	NixImageBuilder    = "nix"
+
+	metricsServerReadHeaderTimeout = 10 * time.Second
+	metricsServerShutdownTimeout   = 5 * time.Second
+)
+
+var (
+	// From a tenth of a second to about 40 minutes, for the operations which can take from no time to a big image pull
+	longOperationBuckets = prometheus.ExponentialBuckets(0.1, 2.5, 12)
+
+	StarlarkInterpretationDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "starlark_interpretation_duration_seconds",
+		Help:      "How long the interpretation of the Starlark runs took",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	StarlarkValidationDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "starlark_validation_duration_seconds",
+		Help:      "How long the validation of the Starlark runs took, including the image pulls and builds",
+		Buckets:   longOperationBuckets,
+	})
+
+	StarlarkInstructionInterpretationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "starlark_instruction_interpretation_duration_seconds",
+		Help:      "How long the interpretation of each Starlark instruction took, by instruction type",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{instructionLabel})
+
+	StarlarkInstructionValidationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "starlark_instruction_validation_duration_seconds",
+		Help:      "How long the validation of each Starlark instruction took, by instruction type",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{instructionLabel})
+
+	StarlarkInstructionExecutionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "starlark_instruction_execution_duration_seconds",
+		Help:      "How long the execution of each Starlark instruction took, by instruction type and result",
+		Buckets:   longOperationBuckets,
+	}, []string{instructionLabel, resultLabel})
+
+	ImagePullDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "image_pull_duration_seconds",
+		Help:      "How long fetching the container images of the services took, by whether they got pulled from their registry",
+		Buckets:   longOperationBuckets,
+	}, []string{sourceLabel})
+
+	ImageBuildDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "image_build_duration_seconds",
+		Help:      "How long building the container images of the services took, by builder",
+		Buckets:   longOperationBuckets,
+	}, []string{builderLabel})
+)
+
+func init() {
+	prometheus.MustRegister(
+		StarlarkInterpretationDuration,
+		StarlarkValidationDuration,
+		StarlarkInstructionInterpretationDuration,
+		StarlarkInstructionValidationDuration,
+		StarlarkInstructionExecutionDuration,
+		ImagePullDuration,
+		ImageBuildDuration,
+	)
+}
+
+// GetResult is the value of the result label for an operation which returned the given error
+func GetResult(err error) string {
+	if err != nil {
+		return FailureResult
+	}
+	return SuccessResult
+}
+
+// GetImageSource is the value of the source label for an image which was or wasn't pulled from its registry
+func GetImageSource(isPulledFromRemote bool) string {
+	if isPulledFromRemote {
+		return RemoteImageSource
+	}
+	return LocalImageSource
+}
+
+// RunServer serves the metrics registered with the default Prometheus registerer over HTTP until the context gets
+// cancelled, along with the Go runtime and process metrics
+func RunServer(ctx context.Context, portNum uint16) error {
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, promhttp.Handler())
+	// nolint:exhaustruct
+	server := &http.Server{
+		Addr:              net.JoinHostPort("", strconv.Itoa(int(portNum))),
+		Handler:           mux,
+		ReadHeaderTimeout: metricsServerReadHeaderTimeout,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), metricsServerShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logrus.Warnf("An error occurred shutting down the metrics server:\n%v", err)
+		}
+	}()
+	logrus.Infof("Serving the Prometheus metrics on port %v", portNum)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return stacktrace.Propagate(err, "An error occurred serving the Prometheus metrics on port %v", portNum)
+	}
+	return nil
+}
+
+// ObserveSince records the time elapsed since the start on the histogram
+func ObserveSince(histogram prometheus.Observer, start time.Time) {
+	histogram.Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,105 @@
+package tracing
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Bounds the memory a pathological run with millions of instructions can take; the spans past it are only exported
+	maxStarlarkRunSpans = 50000
+)
+
+// StarlarkRunSpanRecorder keeps the spans of the latest Starlark run of the enclave, for the CLI to write a trace file
+// without needing a collector
+type StarlarkRunSpanRecorder struct {
+	mutex *sync.Mutex
+
+	starlarkRunTraceId trace.TraceID
+
+	spans []sdktrace.ReadOnlySpan
+}
+
+func newStarlarkRunSpanRecorder() *StarlarkRunSpanRecorder {
+	return &StarlarkRunSpanRecorder{
+		mutex:              &sync.Mutex{},
+		starlarkRunTraceId: trace.TraceID{},
+		spans:              []sdktrace.ReadOnlySpan{},
+	}
+}
+
+// GetLatestStarlarkRunSpans returns the spans of the latest Starlark run which ended, ordered by start time
+func (recorder *StarlarkRunSpanRecorder) GetLatestStarlarkRunSpans() []*kurtosis_core_rpc_api_bindings.StarlarkRunSpan {
+	recorder.mutex.Lock()
+	spans := make([]sdktrace.ReadOnlySpan, len(recorder.spans))
+	copy(spans, recorder.spans)
+	recorder.mutex.Unlock()
+
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].StartTime().Before(spans[j].StartTime())
+	})
+	apiSpans := make([]*kurtosis_core_rpc_api_bindings.StarlarkRunSpan, len(spans))
+	for idx, span := range spans {
+		apiSpans[idx] = convertSpanToApiType(span)
+	}
+	return apiSpans
+}
+
+func (recorder *StarlarkRunSpanRecorder) OnStart(_ context.Context, span sdktrace.ReadWriteSpan) {
+	if span.Parent().IsValid() || span.Name() != StarlarkRunSpanName {
+		return
+	}
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.starlarkRunTraceId = span.SpanContext().TraceID()
+	recorder.spans = []sdktrace.ReadOnlySpan{}
+}
+
+func (recorder *StarlarkRunSpanRecorder) OnEnd(span sdktrace.ReadOnlySpan) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	if span.SpanContext().TraceID() != recorder.starlarkRunTraceId || len(recorder.spans) >= maxStarlarkRunSpans {
+		return
+	}
+	recorder.spans = append(recorder.spans, span)
+}
+
+func (recorder *StarlarkRunSpanRecorder) Shutdown(_ context.Context) error {
+	return nil
+}
+
+func (recorder *StarlarkRunSpanRecorder) ForceFlush(_ context.Context) error {
+	return nil
+}
+
+func convertSpanToApiType(span sdktrace.ReadOnlySpan) *kurtosis_core_rpc_api_bindings.StarlarkRunSpan {
+	parentSpanId := ""
+	if span.Parent().IsValid() {
+		parentSpanId = span.Parent().SpanID().String()
+	}
+	attributes := map[string]string{}
+	for _, attribute := range span.Attributes() {
+		attributes[string(attribute.Key)] = attribute.Value.Emit()
+	}
+	errorMsg := ""
+	if span.Status().Code == codes.Error {
+		errorMsg = span.Status().Description
+	}
+	return binding_constructors.NewStarlarkRunSpan(
+		span.SpanContext().SpanID().String(),
+		parentSpanId,
+		span.Name(),
+		timestamppb.New(span.StartTime()),
+		timestamppb.New(span.EndTime()),
+		attributes,
+		errorMsg,
+	)
+}
@@ -0,0 +1,41 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestStarlarkRunSpanRecorder_KeepsOnlyTheLatestRun(t *testing.T) {
+	recorder := newStarlarkRunSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	ctx := context.Background()
+
+	firstRunCtx, firstRunSpan := StartSpan(ctx, StarlarkRunSpanName)
+	_, firstInstructionSpan := StartSpan(firstRunCtx, ExecuteInstructionSpanName("add_service"))
+	EndSpan(firstInstructionSpan, nil)
+	EndSpan(firstRunSpan, nil)
+	require.Len(t, recorder.GetLatestStarlarkRunSpans(), 2)
+
+	secondRunCtx, secondRunSpan := StartSpan(ctx, StarlarkRunSpanName)
+	// Spans outside of the Starlark run, e.g. of another API call, aren't part of its trace
+	_, unrelatedSpan := StartSpan(ctx, "unrelated")
+	EndSpan(unrelatedSpan, nil)
+	_, secondInstructionSpan := StartSpan(secondRunCtx, ExecuteInstructionSpanName("exec"), InstructionKey.String("exec"))
+	EndSpan(secondInstructionSpan, errors.New("exec failed"))
+	EndSpan(secondRunSpan, nil)
+
+	spans := recorder.GetLatestStarlarkRunSpans()
+	require.Len(t, spans, 2)
+	require.Equal(t, StarlarkRunSpanName, spans[0].GetName())
+	require.Empty(t, spans[0].GetParentSpanId())
+	require.Empty(t, spans[0].GetError())
+	require.Equal(t, "execute exec", spans[1].GetName())
+	require.Equal(t, spans[0].GetSpanId(), spans[1].GetParentSpanId())
+	require.Equal(t, map[string]string{string(InstructionKey): "exec"}, spans[1].GetAttributes())
+	require.Equal(t, "exec failed", spans[1].GetError())
+}
@@ -0,0 +1,174 @@
+package tracing
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	tracerName = "github.com/kurtosis-tech/kurtosis/core/server"
+
+	apiContainerServiceName = "kurtosis-api-container"
+
+	tracerProviderShutdownTimeout = 5 * time.Second
+
+	httpScheme  = "http"
+	httpsScheme = "https"
+
+	// Where the context of the Starlark run is kept on the Starlark threads, for the instructions to create their
+	// interpretation spans in it
+	starlarkThreadContextLocalKey = "kurtosis_tracing_context"
+)
+
+const (
+	serviceNameResourceKey = attribute.Key("service.name")
+	EnclaveUuidKey         = attribute.Key("kurtosis.enclave.uuid")
+
+	PackageIdKey            = attribute.Key("kurtosis.starlark.package_id")
+	MainFunctionNameKey     = attribute.Key("kurtosis.starlark.main_function_name")
+	DryRunKey               = attribute.Key("kurtosis.starlark.dry_run")
+	ParallelismKey          = attribute.Key("kurtosis.starlark.parallelism")
+	NumInstructionsKey      = attribute.Key("kurtosis.starlark.num_instructions")
+	InstructionKey          = attribute.Key("kurtosis.starlark.instruction")
+	InstructionNumberKey    = attribute.Key("kurtosis.starlark.instruction_number")
+	InstructionPositionKey  = attribute.Key("kurtosis.starlark.instruction_position")
+	IsInstructionSkippedKey = attribute.Key("kurtosis.starlark.instruction_is_skipped")
+	ServiceNameKey          = attribute.Key("kurtosis.service.name")
+	ServiceUuidKey          = attribute.Key("kurtosis.service.uuid")
+	ImageKey                = attribute.Key("kurtosis.image")
+	IsImagePulledKey        = attribute.Key("kurtosis.image.is_pulled_from_remote")
+)
+
+// The names of the spans; the instruction spans are named after the phase and the instruction, e.g. 'execute add_service'
+const (
+	StarlarkRunSpanName            = "starlark_run"
+	InterpretationSpanName         = "interpretation"
+	InterpretationAttemptSpanName  = "interpret"
+	ValidationSpanName             = "validation"
+	ImagesValidationSpanName       = "validate images"
+	ExecutionSpanName              = "execution"
+	PullImageSpanName              = "pull_image"
+	BuildImageSpanName             = "build_image"
+	NixBuildSpanName               = "nix_build"
+	StartServiceSpanName           = "start_service"
+	CreateContainerSpanName        = "create_container"
+	WaitForPortsSpanName           = "wait_for_ports"
+	WaitForReadyConditionSpanName  = "wait_for_ready_condition"
+	interpretInstructionSpanPrefix = "interpret "
+	validateInstructionSpanPrefix  = "validate "
+	executeInstructionSpanPrefix   = "execute "
+)
+
+// The global tracer provider is only set up once the API container starts, which the tracer picks up
+var tracer = otel.Tracer(tracerName)
+
+// SetUp makes the spans get recorded for the trace of the latest Starlark run and, if an endpoint is given, get
+// exported over OTLP. The returned function flushes and stops the exports.
+// A broken endpoint doesn't stop the API container from starting, the traces just don't get exported.
+func SetUp(ctx context.Context, otlpTracesEndpoint string, enclaveUuid string) (*StarlarkRunSpanRecorder, func()) {
+	spanRecorder := newStarlarkRunSpanRecorder()
+	tracerProviderOpts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(
+			serviceNameResourceKey.String(apiContainerServiceName),
+			EnclaveUuidKey.String(enclaveUuid),
+		)),
+		sdktrace.WithSpanProcessor(spanRecorder),
+	}
+	if otlpTracesEndpoint != "" {
+		exporter, err := newOtlpTracesExporter(ctx, otlpTracesEndpoint)
+		if err != nil {
+			logrus.Warnf("An error occurred creating the OTLP exporter of the traces to '%v'; the traces won't be exported. Error:\n%v", otlpTracesEndpoint, err)
+		} else {
+			tracerProviderOpts = append(tracerProviderOpts, sdktrace.WithBatcher(exporter))
+			logrus.Infof("Exporting the traces of the Starlark runs to '%v'", otlpTracesEndpoint)
+		}
+	}
+	tracerProvider := sdktrace.NewTracerProvider(tracerProviderOpts...)
+	otel.SetTracerProvider(tracerProvider)
+
+	shutdownFunc := func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), tracerProviderShutdownTimeout)
+		defer cancel()
+		if err := tracerProvider.Shutdown(shutdownCtx); err != nil {
+			logrus.Warnf("An error occurred shutting down the tracer provider; the last spans might not have been exported:\n%v", err)
+		}
+	}
+	return spanRecorder, shutdownFunc
+}
+
+// StartSpan starts a child span of the span in the context, if any
+func StartSpan(ctx context.Context, spanName string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, spanName, trace.WithAttributes(attributes...))
+}
+
+// EndSpan ends the span, marking it as failed if the operation it covers returned an error
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func InterpretInstructionSpanName(instructionName string) string {
+	return interpretInstructionSpanPrefix + instructionName
+}
+
+func ValidateInstructionSpanName(instructionName string) string {
+	return validateInstructionSpanPrefix + instructionName
+}
+
+func ExecuteInstructionSpanName(instructionName string) string {
+	return executeInstructionSpanPrefix + instructionName
+}
+
+// SetStarlarkThreadContext keeps the context on the Starlark thread, as the Starlark builtins don't get one
+func SetStarlarkThreadContext(ctx context.Context, thread *starlark.Thread) {
+	thread.SetLocal(starlarkThreadContextLocalKey, ctx)
+}
+
+// GetStarlarkThreadContext returns the context kept on the Starlark thread, or an empty one if there's none
+func GetStarlarkThreadContext(thread *starlark.Thread) context.Context {
+	if ctx, ok := thread.Local(starlarkThreadContextLocalKey).(context.Context); ok {
+		return ctx
+	}
+	return context.Background()
+}
+
+// The endpoint is the URL of an OTLP gRPC receiver, e.g. 'http://otel-collector:4317'; https endpoints are reached
+// over TLS
+func newOtlpTracesExporter(ctx context.Context, otlpTracesEndpoint string) (*otlptrace.Exporter, error) {
+	endpointUrl, err := url.Parse(otlpTracesEndpoint)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the OTLP endpoint URL '%v'", otlpTracesEndpoint)
+	}
+	if endpointUrl.Host == "" {
+		return nil, stacktrace.NewError("The OTLP endpoint URL '%v' has no host", otlpTracesEndpoint)
+	}
+	clientOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpointUrl.Host)}
+	switch endpointUrl.Scheme {
+	case httpScheme:
+		clientOpts = append(clientOpts, otlptracegrpc.WithInsecure())
+	case httpsScheme:
+	default:
+		return nil, stacktrace.NewError("The OTLP endpoint URL '%v' has scheme '%v' but only '%v' and '%v' are supported", otlpTracesEndpoint, endpointUrl.Scheme, httpScheme, httpsScheme)
+	}
+	exporter, err := otlptracegrpc.New(ctx, clientOpts...)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the OTLP gRPC exporter")
+	}
+	return exporter, nil
+}
@@ -203,7 +203,8 @@ func (c *WebServer) GetEnclaves(ctx context.Context, req *connect.Request[emptyp
 	if !auth {
 		return nil, stacktrace.Propagate(err, "User not authorized")
 	}
-	enclaves, err := (*c.engineServiceClient).GetEnclaves(ctx, req)
+	getEnclavesReq := connect.NewRequest(&kurtosis_engine_rpc_api_bindings.GetEnclavesArgs{LabelSelector: nil})
+	enclaves, err := (*c.engineServiceClient).GetEnclaves(ctx, getEnclavesReq)
 	if err != nil {
 		return nil, err
 	}
@@ -689,6 +690,7 @@ func (c *WebServer) GetStarlarkRun(
 			MainFunctionName:       result.Msg.MainFunctionName,
 			ExperimentalFeatures:   result.Msg.ExperimentalFeatures,
 			RestartPolicy:          result.Msg.RestartPolicy,
+			RunningPackageId:       result.Msg.RunningPackageId,
 		},
 	}
 	return resp, nil
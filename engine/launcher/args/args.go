@@ -61,11 +61,38 @@ type EngineServerArgs struct {
 
 	// List of allowed origins to validate CORS requests on the REST API. If undefined, defaults to '*' (any origin).
 	AllowedCORSOrigins *[]string `json:"allowed_cors_origins,omitempty"`
+
+	// Named host port ranges, in the 'name=start-end,...' format, that the services of every enclave can publish their
+	// ports on. Empty means the services can only publish their ports on static or ephemeral host ports
+	HostPortRanges string `json:"hostPortRanges"`
+
+	// How the engine APIs authenticate and authorize their callers. If nil, the engine APIs are open to anyone
+	AuthConfig *EngineAuthConfig `json:"authConfig,omitempty"`
+
+	// Quotas on the enclaves created by some users or matching some label selectors. Empty means no quota applies
+	ResourceQuotas []*ResourceQuotaConfig `json:"resourceQuotas,omitempty"`
+
+	// URLs the engine events get posted to. Empty means the events are only available through the engine APIs
+	Webhooks []*WebhookConfig `json:"webhooks,omitempty"`
+
+	// The OTLP gRPC endpoint the API containers export the traces of the Starlark runs to, e.g.
+	// 'http://otel-collector:4317'. Empty means the traces are only kept by the API containers
+	OtlpTracesEndpoint string `json:"otlpTracesEndpoint"`
+
+	// The sink the engine and the API containers send the metrics to when the user accepted sending metrics. If nil,
+	// the metrics get sent to Segment
+	MetricsSink *metrics_client.SinkConfig `json:"metricsSink,omitempty"`
+
+	// Enclaves the enclave pool keeps warm with packages already run in them, handed out to the enclaves created from
+	// their template. Empty means the pool only keeps empty enclaves
+	EnclavePoolTemplates []*EnclavePoolTemplateConfig `json:"enclavePoolTemplates,omitempty"`
 }
 
 var skipValidation = map[string]bool{
-	"cloud_instance_id": true,
-	"cloud_user_id":     true,
+	"cloud_instance_id":  true,
+	"cloud_user_id":      true,
+	"hostPortRanges":     true,
+	"otlpTracesEndpoint": true,
 }
 
 func (args *EngineServerArgs) UnmarshalJSON(data []byte) error {
@@ -116,6 +143,13 @@ func NewEngineServerArgs(
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
 	allowedCORSOrigins *[]string,
+	hostPortRanges string,
+	authConfig *EngineAuthConfig,
+	resourceQuotas []*ResourceQuotaConfig,
+	webhooks []*WebhookConfig,
+	otlpTracesEndpoint string,
+	metricsSink *metrics_client.SinkConfig,
+	enclavePoolTemplates []*EnclavePoolTemplateConfig,
 ) (*EngineServerArgs, error) {
 	if enclaveEnvVars == "" {
 		enclaveEnvVars = emptyJsonField
@@ -135,6 +169,13 @@ func NewEngineServerArgs(
 		CloudUserID:                 cloudUserID,
 		CloudInstanceID:             cloudInstanceID,
 		AllowedCORSOrigins:          allowedCORSOrigins,
+		HostPortRanges:              hostPortRanges,
+		AuthConfig:                  authConfig,
+		ResourceQuotas:              resourceQuotas,
+		Webhooks:                    webhooks,
+		OtlpTracesEndpoint:          otlpTracesEndpoint,
+		MetricsSink:                 metricsSink,
+		EnclavePoolTemplates:        enclavePoolTemplates,
 	}
 	if err := result.validate(); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred validating engine server args")
@@ -24,3 +24,105 @@ func TestArgsUnmarshalDocker(t *testing.T) {
 	err := json.Unmarshal(paramsJsonBytes, &args)
 	require.NoError(t, err)
 }
+
+func TestArgsAuthConfigRoundTrip(t *testing.T) {
+	authConfig := &EngineAuthConfig{
+		ApiTokens: []*ApiTokenAuthConfig{
+			{Name: "ci", TokenSha256: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", Role: "enclave-owner"},
+		},
+		MutualTls: nil,
+		Oidc: &OidcAuthConfig{
+			Issuer:        "https://issuer.example.com",
+			Audience:      "kurtosis-engine",
+			JwksJson:      `{"keys":[]}`,
+			UsernameClaim: "",
+			RolesClaim:    "",
+			DefaultRole:   "read-only",
+		},
+	}
+	var args EngineServerArgs
+	require.NoError(t, json.Unmarshal([]byte(dockerArgsJson), &args))
+	args.AuthConfig = authConfig
+
+	envVars, err := GetEnvFromArgs(&args)
+	require.NoError(t, err)
+	deserializedArgs, err := GetArgsFromEnvVars(envVars)
+	require.NoError(t, err)
+	require.Equal(t, authConfig, deserializedArgs.AuthConfig)
+
+	// The engines started without an auth config keep their APIs open
+	require.NoError(t, json.Unmarshal([]byte(dockerArgsJson), &args))
+	require.Nil(t, args.AuthConfig)
+}
+
+func TestArgsResourceQuotasRoundTrip(t *testing.T) {
+	resourceQuotas := []*ResourceQuotaConfig{
+		{
+			Name:                                 "ci",
+			User:                                 "ci",
+			LabelSelector:                        "",
+			MaxEnclaves:                          3,
+			MaxServicesPerEnclave:                10,
+			MaxCpuMillicoresPerEnclave:           4000,
+			MaxMemoryMegabytesPerEnclave:         8192,
+			MaxFilesArtifactsMegabytesPerEnclave: 0,
+		},
+	}
+	var args EngineServerArgs
+	require.NoError(t, json.Unmarshal([]byte(dockerArgsJson), &args))
+	args.ResourceQuotas = resourceQuotas
+
+	envVars, err := GetEnvFromArgs(&args)
+	require.NoError(t, err)
+	deserializedArgs, err := GetArgsFromEnvVars(envVars)
+	require.NoError(t, err)
+	require.Equal(t, resourceQuotas, deserializedArgs.ResourceQuotas)
+}
+
+func TestArgsWebhooksRoundTrip(t *testing.T) {
+	webhooks := []*WebhookConfig{
+		{
+			Name:                   "nightly-devnets",
+			Url:                    "https://hooks.example.com/services/nightly",
+			Secret:                 "secret",
+			EventTypes:             []string{"STARLARK_RUN_FINISHED"},
+			EnclaveIdentifiers:     nil,
+			EnclaveLabelSelector:   "purpose=nightly",
+			OnlyFailedStarlarkRuns: true,
+			MaxRetries:             3,
+		},
+	}
+	var args EngineServerArgs
+	require.NoError(t, json.Unmarshal([]byte(dockerArgsJson), &args))
+	args.Webhooks = webhooks
+
+	envVars, err := GetEnvFromArgs(&args)
+	require.NoError(t, err)
+	deserializedArgs, err := GetArgsFromEnvVars(envVars)
+	require.NoError(t, err)
+	require.Equal(t, webhooks, deserializedArgs.Webhooks)
+}
+
+func TestArgsEnclavePoolTemplatesRoundTrip(t *testing.T) {
+	enclavePoolTemplates := []*EnclavePoolTemplateConfig{
+		{
+			Name: "postgres",
+			Size: 2,
+			Packages: []*EnclavePoolTemplatePackageConfig{
+				{
+					PackageId: "github.com/kurtosis-tech/postgres-package",
+					Args:      `{"max_connections": 200}`,
+				},
+			},
+		},
+	}
+	var args EngineServerArgs
+	require.NoError(t, json.Unmarshal([]byte(dockerArgsJson), &args))
+	args.EnclavePoolTemplates = enclavePoolTemplates
+
+	envVars, err := GetEnvFromArgs(&args)
+	require.NoError(t, err)
+	deserializedArgs, err := GetArgsFromEnvVars(envVars)
+	require.NoError(t, err)
+	require.Equal(t, enclavePoolTemplates, deserializedArgs.EnclavePoolTemplates)
+}
@@ -0,0 +1,32 @@
+package args
+
+// EnclavePoolTemplateConfig configures enclaves the engine keeps warm in its enclave pool, with packages already run
+// in them, which get handed out to the enclaves created from the template
+type EnclavePoolTemplateConfig struct {
+	// What the enclaves get created from, e.g. 'postgres-and-redis'
+	Name string `json:"name"`
+
+	// How many warm enclaves of the template the pool keeps ready
+	Size uint8 `json:"size"`
+
+	// Run in order in every enclave of the template, before it gets added to the pool
+	Packages []*EnclavePoolTemplatePackageConfig `json:"packages"`
+
+	// How many instructions of the packages run in parallel. Zero means the default of 'kurtosis run'
+	Parallelism int32 `json:"parallelism,omitempty"`
+
+	// When the images of the packages get pulled, 'always' or 'missing'. Empty means 'missing'
+	ImageDownloadMode string `json:"imageDownloadMode,omitempty"`
+
+	// How many times in a row warming an enclave of the template can fail before the pool stops filling the template,
+	// e.g. because one of its packages is broken. Zero means the default
+	MaxConsecutiveFailures uint32 `json:"maxConsecutiveFailures,omitempty"`
+}
+
+type EnclavePoolTemplatePackageConfig struct {
+	// Locator of the package, e.g. 'github.com/kurtosis-tech/postgres-package'
+	PackageId string `json:"packageId"`
+
+	// The args of the package, serialized as JSON. Empty means the package runs with its default args
+	Args string `json:"args,omitempty"`
+}
@@ -0,0 +1,59 @@
+package args
+
+// EngineAuthConfig configures how the engine authenticates the callers of its REST, websocket and gRPC APIs and
+// which role each of them gets. A nil config (the default) leaves the engine APIs open to anyone who can reach them.
+// File contents (certificates, keys, JWKS) are passed inline because the engine server can't read files on the host.
+type EngineAuthConfig struct {
+	// Static API tokens, sent by the clients as an 'Authorization: Bearer <token>' header
+	ApiTokens []*ApiTokenAuthConfig `json:"apiTokens,omitempty"`
+
+	// Serves the engine APIs over TLS and authenticates the clients presenting a certificate signed by the client CA
+	MutualTls *MutualTlsAuthConfig `json:"mutualTls,omitempty"`
+
+	// Validates the JWTs sent by the clients as an 'Authorization: Bearer <jwt>' header against a local JWKS
+	Oidc *OidcAuthConfig `json:"oidc,omitempty"`
+}
+
+type ApiTokenAuthConfig struct {
+	// Name of the principal the token authenticates, used as the enclave owner
+	Name string `json:"name"`
+
+	// Hex-encoded SHA256 of the token, so the token itself is never stored in the engine args
+	TokenSha256 string `json:"tokenSha256"`
+
+	Role string `json:"role"`
+}
+
+type MutualTlsAuthConfig struct {
+	ServerCertPem string `json:"serverCertPem"`
+
+	ServerKeyPem string `json:"serverKeyPem"`
+
+	ClientCaCertPem string `json:"clientCaCertPem"`
+
+	// Role of the clients by their certificate common name; the clients not listed here get the default role
+	ClientRoles map[string]string `json:"clientRoles,omitempty"`
+
+	// If empty, the clients not listed in the client roles are rejected
+	DefaultRole string `json:"defaultRole,omitempty"`
+}
+
+type OidcAuthConfig struct {
+	// Expected 'iss' claim
+	Issuer string `json:"issuer"`
+
+	// Expected 'aud' claim
+	Audience string `json:"audience"`
+
+	// The JSON Web Key Set the JWT signatures are verified against
+	JwksJson string `json:"jwksJson"`
+
+	// Claim holding the name of the principal; defaults to 'sub'
+	UsernameClaim string `json:"usernameClaim,omitempty"`
+
+	// Claim holding the role, or the list of roles, of the principal; defaults to 'roles'
+	RolesClaim string `json:"rolesClaim,omitempty"`
+
+	// If empty, the tokens without a known role are rejected
+	DefaultRole string `json:"defaultRole,omitempty"`
+}
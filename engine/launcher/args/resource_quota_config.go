@@ -0,0 +1,41 @@
+package args
+
+// ResourceQuotaConfig caps what the enclaves it applies to can take from the host. A quota applies either to the
+// enclaves created by a user, which needs the engine auth to be set so that the callers are known, or to the
+// enclaves whose labels match a label selector. A zero limit means unlimited.
+//
+// The limits without the PerEnclave suffix apply to all the enclaves of the quota together; the engine checks them when
+// an enclave is created, and hands each running enclave what the others leave to it so that its API container checks
+// the runs against it. The PerEnclave limits apply to each enclave on its own.
+type ResourceQuotaConfig struct {
+	// Shown in the engine status, and in the errors of the enclaves going over the quota
+	Name string `json:"name"`
+
+	// Name of the principal, as authenticated by the engine auth, whose enclaves the quota applies to
+	User string `json:"user,omitempty"`
+
+	// Selector, e.g. 'team=infra,branch!=main', of the enclaves the quota applies to
+	LabelSelector string `json:"labelSelector,omitempty"`
+
+	MaxEnclaves uint32 `json:"maxEnclaves,omitempty"`
+
+	// Number of services of all the running enclaves of the quota
+	MaxServices uint32 `json:"maxServices,omitempty"`
+
+	// Sum of the min_cpu reservations of the services of all the running enclaves of the quota
+	MaxCpuMillicores uint64 `json:"maxCpuMillicores,omitempty"`
+
+	// Sum of the min_memory reservations of the services of all the running enclaves of the quota
+	MaxMemoryMegabytes uint64 `json:"maxMemoryMegabytes,omitempty"`
+
+	MaxServicesPerEnclave uint32 `json:"maxServicesPerEnclave,omitempty"`
+
+	// Sum of the min_cpu reservations of the services of each enclave
+	MaxCpuMillicoresPerEnclave uint64 `json:"maxCpuMillicoresPerEnclave,omitempty"`
+
+	// Sum of the min_memory reservations of the services of each enclave
+	MaxMemoryMegabytesPerEnclave uint64 `json:"maxMemoryMegabytesPerEnclave,omitempty"`
+
+	// Disk space taken by the files artifacts stored in each enclave
+	MaxFilesArtifactsMegabytesPerEnclave uint64 `json:"maxFilesArtifactsMegabytesPerEnclave,omitempty"`
+}
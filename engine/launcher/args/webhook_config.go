@@ -0,0 +1,29 @@
+package args
+
+// WebhookConfig configures a URL which the engine posts the engine events matching its filters to, as JSON. The
+// filters left empty match every event.
+type WebhookConfig struct {
+	// Shown in the engine logs when the events can't be delivered
+	Name string `json:"name"`
+
+	Url string `json:"url"`
+
+	// If set, the body of the requests gets signed with it, in the 'X-Kurtosis-Signature-256' header
+	Secret string `json:"secret,omitempty"`
+
+	// Types of the events to post, e.g. 'STARLARK_RUN_FINISHED'
+	EventTypes []string `json:"eventTypes,omitempty"`
+
+	// UUIDs, shortened UUIDs or names of the enclaves whose events get posted
+	EnclaveIdentifiers []string `json:"enclaveIdentifiers,omitempty"`
+
+	// Selector, e.g. 'team=infra,branch!=main', of the enclaves whose events get posted
+	EnclaveLabelSelector string `json:"enclaveLabelSelector,omitempty"`
+
+	// Only posts the Starlark runs which failed, and none of the other Starlark run events
+	OnlyFailedStarlarkRuns bool `json:"onlyFailedStarlarkRuns,omitempty"`
+
+	// How many more times an event gets posted when the URL can't be reached or answers with a server error. Zero
+	// means the default number of retries
+	MaxRetries uint32 `json:"maxRetries,omitempty"`
+}
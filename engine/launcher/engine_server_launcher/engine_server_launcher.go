@@ -45,7 +45,14 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 	cloudInstanceID metrics_client.CloudInstanceID,
 	allowedCORSOrigins *[]string,
 	shouldStartInDebugMode bool,
-	githubAuthToken string) (
+	githubAuthToken string,
+	hostPortRanges string,
+	authConfig *args.EngineAuthConfig,
+	resourceQuotas []*args.ResourceQuotaConfig,
+	webhooks []*args.WebhookConfig,
+	otlpTracesEndpoint string,
+	metricsSinkConfig *metrics_client.SinkConfig,
+	enclavePoolTemplates []*args.EnclavePoolTemplateConfig) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
 	resultErr error,
@@ -66,7 +73,14 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 		cloudInstanceID,
 		allowedCORSOrigins,
 		shouldStartInDebugMode,
-		githubAuthToken)
+		githubAuthToken,
+		hostPortRanges,
+		authConfig,
+		resourceQuotas,
+		webhooks,
+		otlpTracesEndpoint,
+		metricsSinkConfig,
+		enclavePoolTemplates)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred launching the engine server container with default version tag '%v'", kurtosis_version.KurtosisVersion)
 	}
@@ -89,7 +103,14 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 	cloudInstanceID metrics_client.CloudInstanceID,
 	allowedCORSOrigins *[]string,
 	shouldStartInDebugMode bool,
-	githubAuthToken string) (
+	githubAuthToken string,
+	hostPortRanges string,
+	authConfig *args.EngineAuthConfig,
+	resourceQuotas []*args.ResourceQuotaConfig,
+	webhooks []*args.WebhookConfig,
+	otlpTracesEndpoint string,
+	metricsSinkConfig *metrics_client.SinkConfig,
+	enclavePoolTemplates []*args.EnclavePoolTemplateConfig) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
 	resultErr error,
@@ -111,6 +132,13 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 		cloudUserID,
 		cloudInstanceID,
 		allowedCORSOrigins,
+		hostPortRanges,
+		authConfig,
+		resourceQuotas,
+		webhooks,
+		otlpTracesEndpoint,
+		metricsSinkConfig,
+		enclavePoolTemplates,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the engine server args")
@@ -142,7 +142,7 @@ func TestCreateLogFiles(t *testing.T) {
 	enclaveStatus := enclave.EnclaveStatus_Running
 	enclaveCreationTime := mockTime.Now() // time doesn't matter
 	enclaveMap := map[enclave.EnclaveUUID]*enclave.Enclave{
-		enclaveUuid: enclave.NewEnclave(enclaveUuid, testEnclaveUuid, enclaveStatus, &enclaveCreationTime, false),
+		enclaveUuid: enclave.NewEnclave(enclaveUuid, testEnclaveUuid, enclaveStatus, &enclaveCreationTime, false, nil),
 	}
 
 	mockKurtosisBackend.
@@ -0,0 +1,64 @@
+package enclave_manager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_access"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// The engine issues itself a new token on every call to an API container, after authorizing its own caller
+	engineAccessTokenSubject  = "engine"
+	engineAccessTokenValidity = time.Minute
+)
+
+// getApiContainerAccessTokenSigner returns nil if the API containers don't check access tokens
+func (creator *EnclaveCreator) getApiContainerAccessTokenSigner(enclaveUuid string) *api_container_access.TokenSigner {
+	if creator.apiContainerAccessSecret == nil {
+		return nil
+	}
+	return api_container_access.NewTokenSignerFromSecret(creator.apiContainerAccessSecret, enclaveUuid)
+}
+
+// getApiContainerDialOptions returns the options the engine connects to the API container of the enclave with
+func (creator *EnclaveCreator) getApiContainerDialOptions(enclaveUuid string) []grpc.DialOption {
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if accessTokenSigner := creator.getApiContainerAccessTokenSigner(enclaveUuid); accessTokenSigner != nil {
+		accessTokenCredentials := api_container_access.NewTokenCredentials(accessTokenSigner, engineAccessTokenSubject, api_container_access.AccessLevel_Engine, engineAccessTokenValidity)
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(accessTokenCredentials))
+	}
+	return dialOptions
+}
+
+// GetApiContainerDialOptions returns the options the engine connects to the API container of the enclave with, which
+// authenticate the engine when the API containers check access tokens
+func (manager *EnclaveManager) GetApiContainerDialOptions(enclaveUuid string) []grpc.DialOption {
+	return manager.enclaveCreator.getApiContainerDialOptions(enclaveUuid)
+}
+
+// getApiContainerClient connects the engine to the API container of the enclave; the returned function closes the
+// connection and must be called once the client is no longer needed
+func (creator *EnclaveCreator) getApiContainerClient(enclaveInfo *types.EnclaveInfo) (kurtosis_core_rpc_api_bindings.ApiContainerServiceClient, func(), error) {
+	apiContainerInfo := enclaveInfo.ApiContainerInfo
+	if apiContainerInfo == nil {
+		return nil, nil, stacktrace.NewError("No API container info is available for enclave '%v'", enclaveInfo.EnclaveUuid)
+	}
+	grpcServerAddress := fmt.Sprintf("%v:%v", apiContainerInfo.GetIpReachableFromEngine(), apiContainerInfo.GrpcPortInsideEnclave)
+	grpcConnection, err := grpc.Dial(grpcServerAddress, creator.getApiContainerDialOptions(enclaveInfo.EnclaveUuid)...)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating a gRPC client connection to the API container of enclave '%v' on address '%v'", enclaveInfo.Name, grpcServerAddress)
+	}
+	closeConnection := func() {
+		if err := grpcConnection.Close(); err != nil {
+			logrus.Warnf("An error occurred closing the gRPC connection to the API container of enclave '%v':\n%v", enclaveInfo.EnclaveUuid, err)
+		}
+	}
+	return kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(grpcConnection), closeConnection, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
+	apicArgs "github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -22,16 +23,37 @@ const (
 type EnclaveCreator struct {
 	kurtosisBackend                           backend_interface.KurtosisBackend
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
+
+	// The named host port ranges set on the engine, which every enclave gets as-is
+	hostPortRanges string
+
+	// The OTLP endpoint the API containers export the traces of the Starlark runs to; empty to not export them
+	otlpTracesEndpoint string
+
+	// The sink the API containers send the metrics to; nil to send them to Segment
+	metricsSinkConfig *metrics_client.SinkConfig
+
+	// The secret the access token key of each API container is derived from; nil when the engine doesn't require
+	// authentication, in which case the API containers serve anyone who can reach them
+	apiContainerAccessSecret []byte
 }
 
 func newEnclaveCreator(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier,
+	hostPortRanges string,
+	otlpTracesEndpoint string,
+	metricsSinkConfig *metrics_client.SinkConfig,
+	apiContainerAccessSecret []byte,
 ) *EnclaveCreator {
 
 	return &EnclaveCreator{
 		kurtosisBackend: kurtosisBackend,
 		apiContainerKurtosisBackendConfigSupplier: apiContainerKurtosisBackendConfigSupplier,
+		hostPortRanges:           hostPortRanges,
+		otlpTracesEndpoint:       otlpTracesEndpoint,
+		metricsSinkConfig:        metricsSinkConfig,
+		apiContainerAccessSecret: apiContainerAccessSecret,
 	}
 }
 
@@ -51,6 +73,10 @@ func (creator *EnclaveCreator) CreateEnclave(
 	cloudInstanceID metrics_client.CloudInstanceID,
 	kurtosisBackendType args.KurtosisBackendType,
 	shouldAPICRunInDebugMode bool,
+	isIpv6Enabled bool,
+	labels map[string]string,
+	// Nil if no resource quota applies to the enclave
+	resourceQuota *apicArgs.EnclaveResourceQuota,
 ) (*types.EnclaveInfo, error) {
 
 	uuid, err := uuid_generator.GenerateUUIDString()
@@ -62,7 +88,7 @@ func (creator *EnclaveCreator) CreateEnclave(
 	teardownCtx := context.Background() // Separate context for tearing stuff down in case the input context is cancelled
 	// Create Enclave with kurtosisBackend
 
-	newEnclave, err := creator.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName)
+	newEnclave, err := creator.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName, labels, isIpv6Enabled)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with name `%v` and uuid '%v'", enclaveName, enclaveUuid)
 	}
@@ -112,7 +138,8 @@ func (creator *EnclaveCreator) CreateEnclave(
 		isCI,
 		cloudUserID,
 		cloudInstanceID,
-		shouldAPICRunInDebugMode)
+		shouldAPICRunInDebugMode,
+		resourceQuota)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred launching the API container")
 	}
@@ -175,6 +202,7 @@ func (creator *EnclaveCreator) CreateEnclave(
 		},
 		ApiContainerHostMachineInfo: apiContainerHostMachineInfo,
 		CreationTime:                *creationTimestamp,
+		Labels:                      newEnclave.GetLabels(),
 		Mode:                        mode,
 	}
 
@@ -199,6 +227,7 @@ func (creator *EnclaveCreator) launchApiContainer(
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
 	shouldStartInDebugMode bool,
+	resourceQuota *apicArgs.EnclaveResourceQuota,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -206,6 +235,10 @@ func (creator *EnclaveCreator) launchApiContainer(
 	apiContainerLauncher := api_container_launcher.NewApiContainerLauncher(
 		creator.kurtosisBackend,
 	)
+	accessTokenKey := ""
+	if accessTokenSigner := creator.getApiContainerAccessTokenSigner(string(enclaveUuid)); accessTokenSigner != nil {
+		accessTokenKey = accessTokenSigner.GetHexKey()
+	}
 	if apiContainerImageVersionTag != "" {
 		apiContainer, err := apiContainerLauncher.LaunchWithCustomVersion(
 			ctx,
@@ -221,7 +254,12 @@ func (creator *EnclaveCreator) launchApiContainer(
 			isCI,
 			cloudUserID,
 			cloudInstanceID,
-			shouldStartInDebugMode)
+			shouldStartInDebugMode,
+			creator.hostPortRanges,
+			resourceQuota,
+			creator.otlpTracesEndpoint,
+			creator.metricsSinkConfig,
+			accessTokenKey)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with custom version '%v', but an error occurred", enclaveUuid, apiContainerImageVersionTag)
 		}
@@ -241,6 +279,11 @@ func (creator *EnclaveCreator) launchApiContainer(
 		cloudUserID,
 		cloudInstanceID,
 		shouldStartInDebugMode,
+		creator.hostPortRanges,
+		resourceQuota,
+		creator.otlpTracesEndpoint,
+		creator.metricsSinkConfig,
+		accessTokenKey,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with the default version, but an error occurred", enclaveUuid)
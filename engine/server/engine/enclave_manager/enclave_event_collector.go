@@ -0,0 +1,170 @@
+package enclave_manager
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_events"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveEventCollectorInterval = 3 * time.Second
+
+	getEnclaveEventsTimeout = 10 * time.Second
+
+	// Asks an API container for none of its events, only for the sequence number of its latest one
+	skipAllEnclaveEventsSequenceNumber = uint64(math.MaxUint64)
+)
+
+// enclaveEventCursor tells which events of an enclave were already published
+type enclaveEventCursor struct {
+	// The events of an API container start over when it restarts, with a new event log UUID
+	eventLogUuid string
+
+	sequenceNumber uint64
+}
+
+// Completeness enforced via unit test
+var engineEventTypesByEnclaveEventType = map[kurtosis_core_rpc_api_bindings.EnclaveEvent_Type]engine_events.EngineEventType{
+	kurtosis_core_rpc_api_bindings.EnclaveEvent_STARLARK_RUN_STARTED:   engine_events.EngineEventType_STARLARK_RUN_STARTED,
+	kurtosis_core_rpc_api_bindings.EnclaveEvent_STARLARK_RUN_FINISHED:  engine_events.EngineEventType_STARLARK_RUN_FINISHED,
+	kurtosis_core_rpc_api_bindings.EnclaveEvent_SERVICE_STARTED:        engine_events.EngineEventType_SERVICE_STARTED,
+	kurtosis_core_rpc_api_bindings.EnclaveEvent_SERVICE_STOPPED:        engine_events.EngineEventType_SERVICE_STOPPED,
+	kurtosis_core_rpc_api_bindings.EnclaveEvent_SERVICE_CRASHED:        engine_events.EngineEventType_SERVICE_CRASHED,
+	kurtosis_core_rpc_api_bindings.EnclaveEvent_FILES_ARTIFACT_CREATED: engine_events.EngineEventType_FILES_ARTIFACT_CREATED,
+}
+
+// RunEnclaveEventCollector publishes on the engine event bus what happens inside the enclaves, which their API
+// containers keep track of, until the context gets cancelled. Only the events which happen after the engine starts
+// get published.
+func (manager *EnclaveManager) RunEnclaveEventCollector(ctx context.Context) {
+	ticker := time.NewTicker(enclaveEventCollectorInterval)
+	defer ticker.Stop()
+
+	var cursors map[enclave.EnclaveUUID]*enclaveEventCursor
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			isFirstCollection := cursors == nil
+			if isFirstCollection {
+				cursors = map[enclave.EnclaveUUID]*enclaveEventCursor{}
+			}
+			if err := manager.collectEnclaveEvents(ctx, cursors, isFirstCollection); err != nil {
+				logrus.Errorf("An error occurred collecting the events of the enclaves; the collector will try again in %v. Error:\n%v", enclaveEventCollectorInterval, err)
+			}
+		}
+	}
+}
+
+func (manager *EnclaveManager) collectEnclaveEvents(ctx context.Context, cursors map[enclave.EnclaveUUID]*enclaveEventCursor, isFirstCollection bool) error {
+	enclaves, err := manager.GetEnclaves(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+
+	for enclaveUuid := range cursors {
+		if _, found := enclaves[string(enclaveUuid)]; !found {
+			delete(cursors, enclaveUuid)
+		}
+	}
+
+	for enclaveUuidStr, enclaveInfo := range enclaves {
+		if enclaveInfo.ApiContainerStatus != types.ContainerStatus_RUNNING || enclaveInfo.ApiContainerInfo == nil {
+			continue
+		}
+		enclaveUuid := enclave.EnclaveUUID(enclaveUuidStr)
+		cursor, found := cursors[enclaveUuid]
+		if !found {
+			// The history of the enclaves which existed before the engine started was already published, if ever
+			cursor = &enclaveEventCursor{
+				eventLogUuid:   "",
+				sequenceNumber: 0,
+			}
+			if isFirstCollection {
+				cursor.sequenceNumber = skipAllEnclaveEventsSequenceNumber
+			}
+		}
+		newCursor, err := manager.collectEventsOfEnclave(ctx, enclaveInfo, cursor)
+		if err != nil {
+			// The events of the other enclaves are still collected
+			logrus.Debugf("An error occurred collecting the events of enclave '%v'; they'll be collected next time. Error:\n%v", enclaveInfo.Name, err)
+			if !found && isFirstCollection {
+				// Its events are skipped when it's reachable again
+				cursors[enclaveUuid] = cursor
+			}
+			continue
+		}
+		cursors[enclaveUuid] = newCursor
+	}
+	return nil
+}
+
+func (manager *EnclaveManager) collectEventsOfEnclave(ctx context.Context, enclaveInfo *types.EnclaveInfo, cursor *enclaveEventCursor) (*enclaveEventCursor, error) {
+	apiContainerClient, closeApiContainerClient, err := manager.enclaveCreator.getApiContainerClient(enclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v'", enclaveInfo.Name)
+	}
+	defer closeApiContainerClient()
+
+	getEnclaveEventsCtx, cancel := context.WithTimeout(ctx, getEnclaveEventsTimeout)
+	defer cancel()
+	response, err := apiContainerClient.GetEnclaveEvents(getEnclaveEventsCtx, &kurtosis_core_rpc_api_bindings.GetEnclaveEventsArgs{
+		AfterSequenceNumber: cursor.sequenceNumber,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the events of the API container of enclave '%v'", enclaveInfo.Name)
+	}
+
+	isCursorOfAnotherEventLog := cursor.eventLogUuid != "" && cursor.eventLogUuid != response.GetEventLogUuid()
+	if isCursorOfAnotherEventLog {
+		// The API container restarted, e.g. with the enclave, so its events start over
+		response, err = apiContainerClient.GetEnclaveEvents(getEnclaveEventsCtx, &kurtosis_core_rpc_api_bindings.GetEnclaveEventsArgs{
+			AfterSequenceNumber: 0,
+		})
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the events of the restarted API container of enclave '%v'", enclaveInfo.Name)
+		}
+	}
+
+	for _, enclaveEvent := range response.GetEvents() {
+		engineEvent, err := newEngineEventFromEnclaveEvent(enclaveInfo, enclaveEvent)
+		if err != nil {
+			logrus.Warnf("Event '%d' of enclave '%v' couldn't be published:\n%v", enclaveEvent.GetSequenceNumber(), enclaveInfo.Name, err)
+			continue
+		}
+		manager.engineEventBus.Publish(engineEvent)
+	}
+	return &enclaveEventCursor{
+		eventLogUuid:   response.GetEventLogUuid(),
+		sequenceNumber: response.GetLatestSequenceNumber(),
+	}, nil
+}
+
+func newEngineEventFromEnclaveEvent(enclaveInfo *types.EnclaveInfo, enclaveEvent *kurtosis_core_rpc_api_bindings.EnclaveEvent) (*engine_events.EngineEvent, error) {
+	eventType, found := engineEventTypesByEnclaveEventType[enclaveEvent.GetType()]
+	if !found {
+		return nil, stacktrace.NewError("Unrecognized enclave event type '%v'", enclaveEvent.GetType())
+	}
+	return &engine_events.EngineEvent{
+		Id:                      "",
+		Type:                    eventType,
+		Timestamp:               enclaveEvent.GetTimestamp().AsTime(),
+		EnclaveUuid:             enclaveInfo.EnclaveUuid,
+		EnclaveName:             enclaveInfo.Name,
+		EnclaveLabels:           enclaveInfo.Labels,
+		ServiceName:             enclaveEvent.ServiceName,
+		FilesArtifactName:       enclaveEvent.FilesArtifactName,
+		StarlarkPackageId:       enclaveEvent.StarlarkPackageId,
+		IsStarlarkRunSuccessful: enclaveEvent.IsStarlarkRunSuccessful,
+		Message:                 enclaveEvent.GetMessage(),
+		Text:                    "",
+	}, nil
+}
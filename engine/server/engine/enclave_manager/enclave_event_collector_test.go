@@ -0,0 +1,58 @@
+package enclave_manager
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_events"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEngineEventTypesByEnclaveEventTypeCompleteness(t *testing.T) {
+	for enclaveEventTypeValue := range kurtosis_core_rpc_api_bindings.EnclaveEvent_Type_name {
+		enclaveEventType := kurtosis_core_rpc_api_bindings.EnclaveEvent_Type(enclaveEventTypeValue)
+		_, found := engineEventTypesByEnclaveEventType[enclaveEventType]
+		require.True(t, found, "No engine event type provided for enclave event type '%v'", enclaveEventType.String())
+	}
+}
+
+func TestNewEngineEventFromEnclaveEvent(t *testing.T) {
+	enclaveInfo := &types.EnclaveInfo{
+		ApiContainerHostMachineInfo: nil,
+		ApiContainerInfo:            nil,
+		ApiContainerStatus:          types.ContainerStatus_RUNNING,
+		EnclaveStatus:               types.EnclaveStatus_RUNNING,
+		CreationTime:                types.Timestamp{},
+		EnclaveUuid:                 "4b7a3e6c1f2d4e8a9b0c1d2e3f4a5b6c",
+		ExpiryTime:                  nil,
+		Labels:                      map[string]string{"purpose": "nightly"},
+		Mode:                        types.EnclaveMode_TEST,
+		Name:                        "nightly-devnet",
+		ShortenedUuid:               "4b7a3e6c1f2d",
+	}
+	packageId := "github.com/ethpandaops/ethereum-package"
+	isSuccessful := false
+	timestamp := timestamppb.Now()
+	enclaveEvent := &kurtosis_core_rpc_api_bindings.EnclaveEvent{
+		SequenceNumber:          3,
+		Type:                    kurtosis_core_rpc_api_bindings.EnclaveEvent_STARLARK_RUN_FINISHED,
+		Timestamp:               timestamp,
+		ServiceName:             nil,
+		FilesArtifactName:       nil,
+		StarlarkPackageId:       &packageId,
+		IsStarlarkRunSuccessful: &isSuccessful,
+		Message:                 "An error occurred",
+	}
+
+	engineEvent, err := newEngineEventFromEnclaveEvent(enclaveInfo, enclaveEvent)
+	require.NoError(t, err)
+	require.Equal(t, engine_events.EngineEventType_STARLARK_RUN_FINISHED, engineEvent.Type)
+	require.Equal(t, timestamp.AsTime(), engineEvent.Timestamp)
+	require.Equal(t, "nightly-devnet", engineEvent.EnclaveName)
+	require.Equal(t, enclaveInfo.Labels, engineEvent.EnclaveLabels)
+	require.Equal(t, packageId, *engineEvent.StarlarkPackageId)
+	require.False(t, *engineEvent.IsStarlarkRunSuccessful)
+	require.Equal(t, "An error occurred", engineEvent.Message)
+}
@@ -0,0 +1,73 @@
+package enclave_manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_events"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// GetEngineEventBus returns the bus the events of the enclaves get published on
+func (manager *EnclaveManager) GetEngineEventBus() *engine_events.EngineEventBus {
+	return manager.engineEventBus
+}
+
+// NewEngineEventFilter creates a filter of the engine events; the enclave label selector is parsed the same way as the
+// one of the enclaves, e.g. 'team=infra,branch!=main'
+func NewEngineEventFilter(
+	eventTypes []engine_events.EngineEventType,
+	enclaveIdentifiers []string,
+	enclaveLabelSelectorStr string,
+	onlyFailedStarlarkRuns bool,
+) (*engine_events.EngineEventFilter, error) {
+	var enclaveLabelsMatcher engine_events.EnclaveLabelsMatcher
+	if enclaveLabelSelectorStr != matchAllEnclavesLabelSelector {
+		labelSelector, err := ParseEnclaveLabelSelector(enclaveLabelSelectorStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing enclave label selector '%v'", enclaveLabelSelectorStr)
+		}
+		enclaveLabelsMatcher = labelSelector
+	}
+	return engine_events.NewEngineEventFilter(eventTypes, enclaveIdentifiers, enclaveLabelsMatcher, onlyFailedStarlarkRuns), nil
+}
+
+func (manager *EnclaveManager) publishEnclaveEvent(eventType engine_events.EngineEventType, enclaveUuid string, enclaveName string, enclaveLabels map[string]string) {
+	manager.engineEventBus.Publish(&engine_events.EngineEvent{
+		Id:                      "",
+		Type:                    eventType,
+		Timestamp:               time.Now(),
+		EnclaveUuid:             enclaveUuid,
+		EnclaveName:             enclaveName,
+		EnclaveLabels:           enclaveLabels,
+		ServiceName:             nil,
+		FilesArtifactName:       nil,
+		StarlarkPackageId:       nil,
+		IsStarlarkRunSuccessful: nil,
+		Message:                 "",
+		Text:                    "",
+	})
+}
+
+// getEnclaveForEvent returns the enclave, so that the event tells its name and labels; nil if it can't be found
+func (manager *EnclaveManager) getEnclaveForEvent(ctx context.Context, enclaveUuid enclave.EnclaveUUID) *enclave.Enclave {
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		logrus.Warnf("An error occurred getting enclave '%v' to publish its event; it's published without its name and labels. Error:\n%v", enclaveUuid, err)
+		return nil
+	}
+	return enclaves[enclaveUuid]
+}
+
+// publishEnclaveEventForEnclave publishes the event of an enclave which was gotten with getEnclaveForEvent
+func (manager *EnclaveManager) publishEnclaveEventForEnclave(eventType engine_events.EngineEventType, enclaveUuid enclave.EnclaveUUID, enclaveObj *enclave.Enclave) {
+	enclaveName := ""
+	var enclaveLabels map[string]string
+	if enclaveObj != nil {
+		enclaveName = enclaveObj.GetName()
+		enclaveLabels = enclaveObj.GetLabels()
+	}
+	manager.publishEnclaveEvent(eventType, string(enclaveUuid), enclaveName, enclaveLabels)
+}
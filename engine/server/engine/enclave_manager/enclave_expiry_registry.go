@@ -0,0 +1,205 @@
+package enclave_manager
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveExpiriesFilename = "enclave-expiries.json"
+
+	enclaveExpiriesDirPerms  = 0755
+	enclaveExpiriesFilePerms = 0644
+)
+
+// enclaveExpiry is when an enclave created with a TTL or an idle timeout expires, and what happens to it then
+type enclaveExpiry struct {
+	// Nil if the enclave was created without a TTL
+	TtlExpiryTime *time.Time `json:"ttlExpiryTime,omitempty"`
+
+	// Zero if the enclave was created without an idle timeout
+	IdleTimeout time.Duration `json:"idleTimeout,omitempty"`
+
+	// The last time the API container of the enclave served a request, as seen by the engine
+	LastActivityTime time.Time `json:"lastActivityTime"`
+
+	// Set when the enclave gets extended, so that it isn't considered idle before this time
+	NotIdleUntil *time.Time `json:"notIdleUntil,omitempty"`
+
+	Action types.EnclaveExpiryAction `json:"action"`
+}
+
+// getExpiryTime returns the earliest of the TTL and the idle expiry times
+func (expiry *enclaveExpiry) getExpiryTime() time.Time {
+	var expiryTime *time.Time
+	if expiry.TtlExpiryTime != nil {
+		ttlExpiryTime := *expiry.TtlExpiryTime
+		expiryTime = &ttlExpiryTime
+	}
+	if expiry.IdleTimeout > 0 {
+		idleExpiryTime := expiry.LastActivityTime.Add(expiry.IdleTimeout)
+		if expiry.NotIdleUntil != nil && expiry.NotIdleUntil.After(idleExpiryTime) {
+			idleExpiryTime = *expiry.NotIdleUntil
+		}
+		if expiryTime == nil || idleExpiryTime.Before(*expiryTime) {
+			expiryTime = &idleExpiryTime
+		}
+	}
+	if expiryTime == nil {
+		// Expiries are only registered for enclaves with a TTL or an idle timeout
+		return time.Time{}
+	}
+	return *expiryTime
+}
+
+// enclaveExpiryRegistry keeps the expiries of the enclaves in a file of the engine data directory, so that the
+// enclaves still expire after the engine gets restarted
+type enclaveExpiryRegistry struct {
+	filepath string
+
+	expiries map[enclave.EnclaveUUID]*enclaveExpiry
+
+	mutex *sync.Mutex
+}
+
+func newEnclaveExpiryRegistry(engineDataDirpath string) (*enclaveExpiryRegistry, error) {
+	if err := os.MkdirAll(engineDataDirpath, enclaveExpiriesDirPerms); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the engine data directory '%v'", engineDataDirpath)
+	}
+	registry := &enclaveExpiryRegistry{
+		filepath: path.Join(engineDataDirpath, enclaveExpiriesFilename),
+		expiries: map[enclave.EnclaveUUID]*enclaveExpiry{},
+		mutex:    &sync.Mutex{},
+	}
+
+	expiriesBytes, err := os.ReadFile(registry.filepath)
+	if os.IsNotExist(err) {
+		return registry, nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave expiries file '%v'", registry.filepath)
+	}
+	if err := json.Unmarshal(expiriesBytes, &registry.expiries); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave expiries file '%v'", registry.filepath)
+	}
+	return registry, nil
+}
+
+func (registry *enclaveExpiryRegistry) add(enclaveUuid enclave.EnclaveUUID, expiry *enclaveExpiry) error {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.expiries[enclaveUuid] = expiry
+	if err := registry.persist(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the expiry of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (registry *enclaveExpiryRegistry) remove(enclaveUuid enclave.EnclaveUUID) error {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if _, found := registry.expiries[enclaveUuid]; !found {
+		return nil
+	}
+	delete(registry.expiries, enclaveUuid)
+	if err := registry.persist(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the removal of the expiry of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+// getAll returns a copy of the expiries, so that they can be checked without holding the registry
+func (registry *enclaveExpiryRegistry) getAll() map[enclave.EnclaveUUID]enclaveExpiry {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	result := map[enclave.EnclaveUUID]enclaveExpiry{}
+	for enclaveUuid, expiry := range registry.expiries {
+		result[enclaveUuid] = *expiry
+	}
+	return result
+}
+
+// getExpiryTime returns nil if the enclave never expires
+func (registry *enclaveExpiryRegistry) getExpiryTime(enclaveUuid enclave.EnclaveUUID) *time.Time {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	expiry, found := registry.expiries[enclaveUuid]
+	if !found {
+		return nil
+	}
+	expiryTime := expiry.getExpiryTime()
+	return &expiryTime
+}
+
+// recordActivity moves the last activity time of the enclave forward; older activity times are ignored
+func (registry *enclaveExpiryRegistry) recordActivity(enclaveUuid enclave.EnclaveUUID, activityTime time.Time) error {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	expiry, found := registry.expiries[enclaveUuid]
+	if !found || !activityTime.After(expiry.LastActivityTime) {
+		return nil
+	}
+	expiry.LastActivityTime = activityTime
+	if err := registry.persist(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the last activity time of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+// extend pushes the expiry of the enclave by the given extension, counting from now if it's already past, and returns
+// the new expiry time
+func (registry *enclaveExpiryRegistry) extend(enclaveUuid enclave.EnclaveUUID, extension time.Duration, now time.Time) (time.Time, error) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	expiry, found := registry.expiries[enclaveUuid]
+	if !found {
+		return time.Time{}, stacktrace.NewError("Enclave '%v' was created without a TTL nor an idle timeout, so it never expires", enclaveUuid)
+	}
+
+	extendedExpiryTime := expiry.getExpiryTime()
+	if extendedExpiryTime.Before(now) {
+		extendedExpiryTime = now
+	}
+	extendedExpiryTime = extendedExpiryTime.Add(extension)
+
+	if expiry.TtlExpiryTime != nil && expiry.TtlExpiryTime.Before(extendedExpiryTime) {
+		expiry.TtlExpiryTime = &extendedExpiryTime
+	}
+	if expiry.IdleTimeout > 0 && (expiry.NotIdleUntil == nil || expiry.NotIdleUntil.Before(extendedExpiryTime)) {
+		expiry.NotIdleUntil = &extendedExpiryTime
+	}
+	if err := registry.persist(); err != nil {
+		return time.Time{}, stacktrace.Propagate(err, "An error occurred persisting the extended expiry of enclave '%v'", enclaveUuid)
+	}
+	return expiry.getExpiryTime(), nil
+}
+
+// this should be called from a thread safe context
+func (registry *enclaveExpiryRegistry) persist() error {
+	expiriesBytes, err := json.Marshal(registry.expiries)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the enclave expiries")
+	}
+	// Written to a temporary file first, so that a crash in the middle of the write doesn't lose all the expiries
+	tmpFilepath := registry.filepath + ".tmp"
+	if err := os.WriteFile(tmpFilepath, expiriesBytes, enclaveExpiriesFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the enclave expiries to '%v'", tmpFilepath)
+	}
+	if err := os.Rename(tmpFilepath, registry.filepath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving the enclave expiries file '%v' to '%v'", tmpFilepath, registry.filepath)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package enclave_manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid = enclave.EnclaveUUID("e7a4ef9a4d8d4f25a4c4fbea1e5a1e27")
+)
+
+var testNow = time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func TestGetExpiryTime_TtlOnly(t *testing.T) {
+	ttlExpiryTime := testNow.Add(time.Hour)
+	expiry := &enclaveExpiry{
+		TtlExpiryTime:    &ttlExpiryTime,
+		IdleTimeout:      0,
+		LastActivityTime: testNow,
+		NotIdleUntil:     nil,
+		Action:           types.EnclaveExpiryAction_DESTROY,
+	}
+	require.Equal(t, ttlExpiryTime, expiry.getExpiryTime())
+}
+
+func TestGetExpiryTime_EarliestOfTtlAndIdleTimeout(t *testing.T) {
+	ttlExpiryTime := testNow.Add(time.Hour)
+	expiry := &enclaveExpiry{
+		TtlExpiryTime:    &ttlExpiryTime,
+		IdleTimeout:      10 * time.Minute,
+		LastActivityTime: testNow,
+		NotIdleUntil:     nil,
+		Action:           types.EnclaveExpiryAction_STOP,
+	}
+	require.Equal(t, testNow.Add(10*time.Minute), expiry.getExpiryTime())
+
+	expiry.LastActivityTime = testNow.Add(55 * time.Minute)
+	require.Equal(t, ttlExpiryTime, expiry.getExpiryTime())
+}
+
+func TestGetExpiryTime_NotIdleUntil(t *testing.T) {
+	notIdleUntil := testNow.Add(time.Hour)
+	expiry := &enclaveExpiry{
+		TtlExpiryTime:    nil,
+		IdleTimeout:      10 * time.Minute,
+		LastActivityTime: testNow,
+		NotIdleUntil:     &notIdleUntil,
+		Action:           types.EnclaveExpiryAction_DESTROY,
+	}
+	require.Equal(t, notIdleUntil, expiry.getExpiryTime())
+}
+
+func TestExtend(t *testing.T) {
+	registry, err := newEnclaveExpiryRegistry(t.TempDir())
+	require.NoError(t, err)
+
+	ttlExpiryTime := testNow.Add(time.Hour)
+	require.NoError(t, registry.add(testEnclaveUuid, &enclaveExpiry{
+		TtlExpiryTime:    &ttlExpiryTime,
+		IdleTimeout:      10 * time.Minute,
+		LastActivityTime: testNow,
+		NotIdleUntil:     nil,
+		Action:           types.EnclaveExpiryAction_DESTROY,
+	}))
+
+	// The idle expiry is the earliest, so the extension counts from it
+	extendedExpiryTime, err := registry.extend(testEnclaveUuid, 2*time.Hour, testNow)
+	require.NoError(t, err)
+	require.Equal(t, testNow.Add(10*time.Minute+2*time.Hour), extendedExpiryTime)
+	require.Equal(t, extendedExpiryTime, *registry.getExpiryTime(testEnclaveUuid))
+}
+
+func TestExtend_ExpiredEnclaveCountsFromNow(t *testing.T) {
+	registry, err := newEnclaveExpiryRegistry(t.TempDir())
+	require.NoError(t, err)
+
+	ttlExpiryTime := testNow.Add(-time.Minute)
+	require.NoError(t, registry.add(testEnclaveUuid, &enclaveExpiry{
+		TtlExpiryTime:    &ttlExpiryTime,
+		IdleTimeout:      0,
+		LastActivityTime: testNow,
+		NotIdleUntil:     nil,
+		Action:           types.EnclaveExpiryAction_DESTROY,
+	}))
+
+	extendedExpiryTime, err := registry.extend(testEnclaveUuid, time.Hour, testNow)
+	require.NoError(t, err)
+	require.Equal(t, testNow.Add(time.Hour), extendedExpiryTime)
+}
+
+func TestExtend_EnclaveWithoutExpiry(t *testing.T) {
+	registry, err := newEnclaveExpiryRegistry(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = registry.extend(testEnclaveUuid, time.Hour, testNow)
+	require.Error(t, err)
+}
+
+func TestRecordActivity_IgnoresOlderActivity(t *testing.T) {
+	registry, err := newEnclaveExpiryRegistry(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, registry.add(testEnclaveUuid, &enclaveExpiry{
+		TtlExpiryTime:    nil,
+		IdleTimeout:      10 * time.Minute,
+		LastActivityTime: testNow,
+		NotIdleUntil:     nil,
+		Action:           types.EnclaveExpiryAction_DESTROY,
+	}))
+
+	require.NoError(t, registry.recordActivity(testEnclaveUuid, testNow.Add(-time.Minute)))
+	require.Equal(t, testNow.Add(10*time.Minute), *registry.getExpiryTime(testEnclaveUuid))
+
+	require.NoError(t, registry.recordActivity(testEnclaveUuid, testNow.Add(time.Minute)))
+	require.Equal(t, testNow.Add(11*time.Minute), *registry.getExpiryTime(testEnclaveUuid))
+}
+
+func TestExpiriesSurviveRegistryRecreation(t *testing.T) {
+	engineDataDirpath := t.TempDir()
+	registry, err := newEnclaveExpiryRegistry(engineDataDirpath)
+	require.NoError(t, err)
+
+	ttlExpiryTime := testNow.Add(time.Hour)
+	require.NoError(t, registry.add(testEnclaveUuid, &enclaveExpiry{
+		TtlExpiryTime:    &ttlExpiryTime,
+		IdleTimeout:      0,
+		LastActivityTime: testNow,
+		NotIdleUntil:     nil,
+		Action:           types.EnclaveExpiryAction_STOP,
+	}))
+
+	recreatedRegistry, err := newEnclaveExpiryRegistry(engineDataDirpath)
+	require.NoError(t, err)
+	expiries := recreatedRegistry.getAll()
+	require.Len(t, expiries, 1)
+	recreatedExpiry := expiries[testEnclaveUuid]
+	require.True(t, ttlExpiryTime.Equal(recreatedExpiry.getExpiryTime()))
+	require.Equal(t, types.EnclaveExpiryAction_STOP, recreatedExpiry.Action)
+
+	require.NoError(t, recreatedRegistry.remove(testEnclaveUuid))
+	recreatedRegistry, err = newEnclaveExpiryRegistry(engineDataDirpath)
+	require.NoError(t, err)
+	require.Empty(t, recreatedRegistry.getAll())
+}
@@ -0,0 +1,125 @@
+package enclave_manager
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveLabelRequirementsSeparator = ","
+
+	equalsOperatorStr       = "="
+	doubleEqualsOperatorStr = "=="
+	notEqualsOperatorStr    = "!="
+	doesNotExistOperatorStr = "!"
+)
+
+type enclaveLabelSelectorOperator int
+
+const (
+	enclaveLabelSelectorOperator_EQUALS enclaveLabelSelectorOperator = iota
+	enclaveLabelSelectorOperator_NOT_EQUALS
+	enclaveLabelSelectorOperator_EXISTS
+	enclaveLabelSelectorOperator_DOES_NOT_EXIST
+)
+
+type enclaveLabelRequirement struct {
+	key string
+
+	operator enclaveLabelSelectorOperator
+
+	// Empty for the EXISTS and DOES_NOT_EXIST operators
+	value string
+}
+
+// EnclaveLabelSelector selects enclaves by their labels, the same way equality-based Kubernetes label selectors do: a
+// comma-separated list of 'key=value', 'key==value', 'key!=value', 'key' and '!key' requirements, all of which must match
+type EnclaveLabelSelector struct {
+	requirements []*enclaveLabelRequirement
+}
+
+func ParseEnclaveLabelSelector(selectorStr string) (*EnclaveLabelSelector, error) {
+	requirements := []*enclaveLabelRequirement{}
+	if strings.TrimSpace(selectorStr) == "" {
+		return &EnclaveLabelSelector{requirements: requirements}, nil
+	}
+
+	for _, requirementStr := range strings.Split(selectorStr, enclaveLabelRequirementsSeparator) {
+		requirement, err := parseEnclaveLabelRequirement(strings.TrimSpace(requirementStr))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing requirement '%v' of enclave label selector '%v'", requirementStr, selectorStr)
+		}
+		requirements = append(requirements, requirement)
+	}
+	return &EnclaveLabelSelector{requirements: requirements}, nil
+}
+
+// Matches returns true if the labels meet every requirement of the selector
+func (selector *EnclaveLabelSelector) Matches(labels map[string]string) bool {
+	for _, requirement := range selector.requirements {
+		value, found := labels[requirement.key]
+		switch requirement.operator {
+		case enclaveLabelSelectorOperator_EQUALS:
+			if !found || value != requirement.value {
+				return false
+			}
+		case enclaveLabelSelectorOperator_NOT_EQUALS:
+			if found && value == requirement.value {
+				return false
+			}
+		case enclaveLabelSelectorOperator_EXISTS:
+			if !found {
+				return false
+			}
+		case enclaveLabelSelectorOperator_DOES_NOT_EXIST:
+			if found {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MatchesAll returns true if the selector has no requirements, and so matches every enclave
+func (selector *EnclaveLabelSelector) MatchesAll() bool {
+	return len(selector.requirements) == 0
+}
+
+func parseEnclaveLabelRequirement(requirementStr string) (*enclaveLabelRequirement, error) {
+	if requirementStr == "" {
+		return nil, stacktrace.NewError("Enclave label selector requirements can't be empty")
+	}
+
+	var (
+		key      string
+		operator enclaveLabelSelectorOperator
+		value    string
+	)
+	// The two-character operators are looked for first, as they contain the single-character ones
+	if keyStr, valueStr, found := strings.Cut(requirementStr, notEqualsOperatorStr); found {
+		key, operator, value = keyStr, enclaveLabelSelectorOperator_NOT_EQUALS, valueStr
+	} else if keyStr, valueStr, found := strings.Cut(requirementStr, doubleEqualsOperatorStr); found {
+		key, operator, value = keyStr, enclaveLabelSelectorOperator_EQUALS, valueStr
+	} else if keyStr, valueStr, found := strings.Cut(requirementStr, equalsOperatorStr); found {
+		key, operator, value = keyStr, enclaveLabelSelectorOperator_EQUALS, valueStr
+	} else if keyStr, found := strings.CutPrefix(requirementStr, doesNotExistOperatorStr); found {
+		key, operator = keyStr, enclaveLabelSelectorOperator_DOES_NOT_EXIST
+	} else {
+		key, operator = requirementStr, enclaveLabelSelectorOperator_EXISTS
+	}
+
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+	if key == "" {
+		return nil, stacktrace.NewError("Enclave label selector requirement '%v' has no label key", requirementStr)
+	}
+	if strings.ContainsAny(key, equalsOperatorStr+doesNotExistOperatorStr) || strings.ContainsAny(value, equalsOperatorStr+doesNotExistOperatorStr) {
+		return nil, stacktrace.NewError("Enclave label selector requirement '%v' has more than one operator", requirementStr)
+	}
+	return &enclaveLabelRequirement{
+		key:      key,
+		operator: operator,
+		value:    value,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package enclave_manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testEnclaveLabels = map[string]string{
+	"team":   "infra",
+	"branch": "main",
+}
+
+func TestEnclaveLabelSelectorMatches(t *testing.T) {
+	selectorsWithMatch := map[string]bool{
+		"":                         true,
+		"team=infra":               true,
+		"team==infra":              true,
+		"team=payments":            false,
+		"team!=payments":           true,
+		"team!=infra":              false,
+		"pipeline!=nightly":        true,
+		"team":                     true,
+		"pipeline":                 false,
+		"!pipeline":                true,
+		"!team":                    false,
+		"team=infra,branch=main":   true,
+		"team=infra, branch!=main": false,
+	}
+	for selectorStr, expectedMatch := range selectorsWithMatch {
+		selector, err := ParseEnclaveLabelSelector(selectorStr)
+		require.NoError(t, err, "Unexpected error parsing selector '%v'", selectorStr)
+		require.Equal(t, expectedMatch, selector.Matches(testEnclaveLabels), "Unexpected match result for selector '%v'", selectorStr)
+	}
+}
+
+func TestEnclaveLabelSelectorMatchesAll(t *testing.T) {
+	selector, err := ParseEnclaveLabelSelector(" ")
+	require.NoError(t, err)
+	require.True(t, selector.MatchesAll())
+
+	selector, err = ParseEnclaveLabelSelector("team=infra")
+	require.NoError(t, err)
+	require.False(t, selector.MatchesAll())
+}
+
+func TestEnclaveLabelSelectorInvalid(t *testing.T) {
+	invalidSelectors := []string{
+		"=infra",
+		"!",
+		"team=infra,",
+		"team=in=fra",
+		"team!=in!fra",
+	}
+	for _, selectorStr := range invalidSelectors {
+		_, err := ParseEnclaveLabelSelector(selectorStr)
+		require.Error(t, err, "Expected selector '%v' to be invalid", selectorStr)
+	}
+}
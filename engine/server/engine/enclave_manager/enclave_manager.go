@@ -19,6 +19,9 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_auth"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_events"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_metrics"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/name_generator"
 	"github.com/kurtosis-tech/stacktrace"
@@ -35,6 +38,11 @@ const (
 	errorDelimiter = ", "
 
 	enclaveNameNotFound = "Name Not Found"
+
+	// Signifies that an enclave never expires
+	noEnclaveExpiryTimeout = time.Duration(0)
+
+	matchAllEnclavesLabelSelector = ""
 )
 
 // TODO Move this to the KurtosisBackend to calculate!!
@@ -74,6 +82,24 @@ type EnclaveManager struct {
 	isCI                        bool
 	cloudUserID                 metrics_client.CloudUserID
 	cloudInstanceID             metrics_client.CloudInstanceID
+
+	// Expiries of the enclaves created with a TTL or an idle timeout, which the enclave reaper enforces
+	expiryRegistry *enclaveExpiryRegistry
+
+	enclaveExpiryEventHandlers      []func(event *EnclaveExpiryEvent)
+	enclaveExpiryEventHandlersMutex *sync.Mutex
+
+	resourceQuotas []*resourceQuota
+
+	// The templates of the enclaves the enclave pool keeps warm, keyed by name; the enclaves created from a template
+	// get its packages run in them even when the pool has no warm enclave to hand out
+	enclavePoolTemplates map[string]*args.EnclavePoolTemplateConfig
+
+	// Tells whose enclaves the resource quotas of the users apply to; nobody owns any enclave until it gets set
+	enclaveOwnerGetter EnclaveOwnerGetter
+
+	// What happens to the enclaves and inside of them gets published on it
+	engineEventBus *engine_events.EngineEventBus
 }
 
 func CreateEnclaveManager(
@@ -89,17 +115,38 @@ func CreateEnclaveManager(
 	isCI bool,
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
+	hostPortRanges string,
+	engineDataDirpath string,
+	resourceQuotaConfigs []*args.ResourceQuotaConfig,
+	otlpTracesEndpoint string,
+	metricsSinkConfig *metrics_client.SinkConfig,
+	enclavePoolTemplateConfigs []*args.EnclavePoolTemplateConfig,
+	// Nil when the engine doesn't require authentication, in which case the API containers don't either
+	apiContainerAccessSecret []byte,
 ) (*EnclaveManager, error) {
-	enclaveCreator := newEnclaveCreator(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier)
+	resourceQuotas, err := newResourceQuotas(resourceQuotaConfigs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the resource quotas")
+	}
 
-	var (
-		err         error
-		enclavePool *EnclavePool
-	)
+	enclavePoolTemplates, err := newEnclavePoolTemplates(enclavePoolTemplateConfigs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the enclave pool templates")
+	}
 
-	// The enclave pool feature is only available for Kubernetes so far
+	enclaveCreator := newEnclaveCreator(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier, hostPortRanges, otlpTracesEndpoint, metricsSinkConfig, apiContainerAccessSecret)
+
+	expiryRegistry, err := newEnclaveExpiryRegistry(engineDataDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave expiry registry in engine data directory '%v'", engineDataDirpath)
+	}
+
+	var enclavePool *EnclavePool
+
+	// The enclave pool feature is only available for Kubernetes so far; elsewhere the enclaves created from a template
+	// are always created from scratch
 	if kurtosisBackendType == args.KurtosisBackendType_Kubernetes {
-		enclavePool, err = CreateEnclavePool(kurtosisBackend, enclaveCreator, poolSize, engineVersion, enclaveEnvVars, metricsUserID, didUserAcceptSendingMetrics, isCI, cloudUserID, cloudInstanceID)
+		enclavePool, err = CreateEnclavePool(kurtosisBackend, enclaveCreator, poolSize, enclavePoolTemplates, engineVersion, enclaveEnvVars, metricsUserID, didUserAcceptSendingMetrics, isCI, cloudUserID, cloudInstanceID)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating enclave pool with pool-size '%v' and engine version '%v'", poolSize, engineVersion)
 		}
@@ -120,6 +167,15 @@ func CreateEnclaveManager(
 		isCI:                                      isCI,
 		cloudUserID:                               cloudUserID,
 		cloudInstanceID:                           cloudInstanceID,
+		expiryRegistry:                            expiryRegistry,
+		enclaveExpiryEventHandlers:                []func(event *EnclaveExpiryEvent){},
+		enclaveExpiryEventHandlersMutex:           &sync.Mutex{},
+		resourceQuotas:                            resourceQuotas,
+		enclavePoolTemplates:                      enclavePoolTemplates,
+		enclaveOwnerGetter: func(enclaveUuid enclave.EnclaveUUID) string {
+			return noEnclaveOwner
+		},
+		engineEventBus: engine_events.NewEngineEventBus(),
 	}
 
 	return enclaveManager, nil
@@ -138,82 +194,56 @@ func (manager *EnclaveManager) CreateEnclave(
 	enclaveName string,
 	isProduction bool,
 	shouldAPICRunInDebugMode bool,
+	isIpv6Enabled bool,
+	labels map[string]string,
+	// Zero if the enclave never expires
+	ttl time.Duration,
+	idleTimeout time.Duration,
+	expiryAction types.EnclaveExpiryAction,
+	// If set, the enclave gets the packages of this enclave pool template run in it, and is a warm one from the pool
+	// when there's one
+	fromTemplate string,
 ) (*types.EnclaveInfo, error) {
-	manager.mutex.Lock()
-	defer manager.mutex.Unlock()
+	var template *args.EnclavePoolTemplateConfig
+	if fromTemplate != noEnclavePoolTemplate {
+		var found bool
+		template, found = manager.enclavePoolTemplates[fromTemplate]
+		if !found {
+			return nil, stacktrace.NewError("Enclave '%v' can't be created from template '%v' as there is no enclave pool template with that name", enclaveName, fromTemplate)
+		}
+	}
 
-	var (
-		enclaveInfo *types.EnclaveInfo
-		err         error
+	enclaveInfo, isFromPool, err := manager.createEnclaveWithMutex(
+		setupCtx,
+		engineVersion,
+		apiContainerImageVersionTag,
+		apiContainerLogLevel,
+		enclaveName,
+		isProduction,
+		shouldAPICRunInDebugMode,
+		isIpv6Enabled,
+		labels,
+		ttl,
+		idleTimeout,
+		expiryAction,
+		fromTemplate,
 	)
-
-	allExistingAndHistoricalIdentifiers, err := manager.getExistingAndHistoricalEnclaveIdentifiersWithoutMutex()
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred getting existing and historical enclave identifiers")
+		return nil, stacktrace.Propagate(err, "An error occurred creating enclave '%v'", enclaveName)
 	}
 
-	allEnclaveNames := []string{}
-	for _, enclaveIdentifier := range allExistingAndHistoricalIdentifiers {
-
-		allEnclaveNames = append(allEnclaveNames, enclaveIdentifier.Name)
-	}
-
-	if enclaveName == autogenerateEnclaveNameKeyword {
-		enclaveName = GetRandomEnclaveNameWithRetries(name_generator.GenerateNatureThemeNameForEnclave, allEnclaveNames, getRandomEnclaveIdRetries)
-	}
-
-	if err := validateEnclaveName(enclaveName); err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred validating enclave name '%v'", enclaveName)
-	}
-
-	// TODO(victor.colombo): Extend enclave pool to have warm production enclaves
-	if !isProduction && manager.enclavePool != nil {
-		enclaveInfo, err = manager.enclavePool.GetEnclave(
-			setupCtx,
-			enclaveName,
-			engineVersion,
-			apiContainerImageVersionTag,
-			apiContainerLogLevel,
-			shouldAPICRunInDebugMode,
-		)
-		if err != nil {
-			logrus.Errorf("An error occurred when trying to get an enclave from the enclave pool. Err:\n%v", err)
-		}
-	}
-
-	if enclaveInfo == nil {
-		enclaveInfo, err = manager.enclaveCreator.CreateEnclave(
-			setupCtx,
-			apiContainerImageVersionTag,
-			apiContainerLogLevel,
-			enclaveName,
-			manager.enclaveEnvVars,
-			isProduction,
-			manager.metricsUserID,
-			manager.didUserAcceptSendingMetrics,
-			manager.isCI,
-			manager.cloudUserID,
-			manager.cloudInstanceID,
-			manager.kurtosisBackendType,
-			shouldAPICRunInDebugMode,
-		)
-		if err != nil {
-			return nil, stacktrace.Propagate(
-				err,
-				"An error occurred creating new enclave with name '%s' using api container image version '%s' and api container log level '%v'",
-				enclaveName,
-				apiContainerImageVersionTag,
-				apiContainerLogLevel,
-			)
+	// The warm enclaves of the pool have the packages of their template run already. The packages are run without
+	// the mutex, as they can take long and the enclave manager would be locked in the meantime
+	if template != nil && !isFromPool {
+		if err := runEnclavePoolTemplatePackages(setupCtx, manager.enclaveCreator, enclaveInfo, template); err != nil {
+			if destroyErr := manager.DestroyEnclave(context.Background(), enclaveInfo.EnclaveUuid); destroyErr != nil {
+				logrus.Errorf("An error occurred destroying enclave '%v' whose template packages failed to run; you'll have to destroy it manually. Error:\n%v", enclaveInfo.Name, destroyErr)
+			}
+			return nil, stacktrace.Propagate(err, "An error occurred running the packages of enclave pool template '%v' in enclave '%v'", fromTemplate, enclaveInfo.Name)
 		}
 	}
 
-	enclaveIdentifier := &types.EnclaveIdentifiers{
-		EnclaveUuid:   enclaveInfo.EnclaveUuid,
-		Name:          enclaveInfo.Name,
-		ShortenedUuid: enclaveInfo.ShortenedUuid,
-	}
-	manager.allExistingAndHistoricalIdentifiers = append(manager.allExistingAndHistoricalIdentifiers, enclaveIdentifier)
+	manager.publishEnclaveEvent(engine_events.EngineEventType_ENCLAVE_CREATED, enclaveInfo.EnclaveUuid, enclaveInfo.Name, enclaveInfo.Labels)
 
 	return enclaveInfo, nil
 }
@@ -224,6 +254,24 @@ func (manager *EnclaveManager) CreateEnclave(
 func (manager *EnclaveManager) GetEnclaves(
 	ctx context.Context,
 ) (map[string]*types.EnclaveInfo, error) {
+	enclaves, err := manager.GetEnclavesMatchingLabelSelector(ctx, matchAllEnclavesLabelSelector)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting all the enclaves")
+	}
+	return enclaves, nil
+}
+
+// GetEnclavesMatchingLabelSelector returns the enclaves whose labels match the given label selector, e.g.
+// 'team=infra,branch!=main'; an empty selector matches every enclave
+func (manager *EnclaveManager) GetEnclavesMatchingLabelSelector(
+	ctx context.Context,
+	labelSelectorStr string,
+) (map[string]*types.EnclaveInfo, error) {
+	labelSelector, err := ParseEnclaveLabelSelector(labelSelectorStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing enclave label selector '%v'", labelSelectorStr)
+	}
+
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 
@@ -235,6 +283,9 @@ func (manager *EnclaveManager) GetEnclaves(
 	// Transform map[enclave.EnclaveUUID]*EnclaveInfo -> map[string]*EnclaveInfo
 	enclaveMapKeyedWithUuidStr := map[string]*types.EnclaveInfo{}
 	for enclaveUuid, enclaveInfo := range enclaves {
+		if !labelSelector.Matches(enclaveInfo.Labels) {
+			continue
+		}
 		enclaveMapKeyedWithUuidStr[string(enclaveUuid)] = enclaveInfo
 	}
 
@@ -251,7 +302,66 @@ func (manager *EnclaveManager) StopEnclave(ctx context.Context, enclaveIdentifie
 		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
 	}
 
-	return manager.stopEnclaveWithoutMutex(ctx, enclaveUuid)
+	if err := manager.stopEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred stopping enclave '%v'", enclaveIdentifier)
+	}
+	manager.publishEnclaveEventForEnclave(engine_events.EngineEventType_ENCLAVE_STOPPED, enclaveUuid, manager.getEnclaveForEvent(ctx, enclaveUuid))
+	return nil
+}
+
+// StartEnclave starts a stopped enclave again: its API container and logs collector, and then the user services which
+// were running when it got stopped. Files artifacts, persistent directories and the enclave plan are kept, so
+// idempotent runs keep working on the started enclave
+func (manager *EnclaveManager) StartEnclave(ctx context.Context, enclaveIdentifier string) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+
+	stoppedEnclaveFilter := &enclave.EnclaveFilters{
+		UUIDs: map[enclave.EnclaveUUID]bool{
+			enclaveUuid: true,
+		},
+		Statuses: map[enclave.EnclaveStatus]bool{
+			enclave.EnclaveStatus_Stopped: true,
+		},
+	}
+	successfullyStartedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.StartEnclaves(ctx, stoppedEnclaveFilter)
+	if err != nil {
+		return stacktrace.Propagate(err, "Attempted to start enclave '%v' but the backend threw an error", enclaveUuid)
+	}
+	if _, found := successfullyStartedEnclaves[enclaveUuid]; found {
+		manager.publishEnclaveEventForEnclave(engine_events.EngineEventType_ENCLAVE_STARTED, enclaveUuid, manager.getEnclaveForEvent(ctx, enclaveUuid))
+		return nil
+	}
+	if startErr, found := erroredEnclaves[enclaveUuid]; found {
+		return stacktrace.Propagate(startErr, "An error occurred starting enclave '%v'", enclaveUuid)
+	}
+	return stacktrace.NewError("Enclave '%v' can't be started as it isn't stopped", enclaveIdentifier)
+}
+
+// ExtendEnclave pushes back the expiry of an enclave created with a TTL or an idle timeout, and returns the new expiry
+func (manager *EnclaveManager) ExtendEnclave(ctx context.Context, enclaveIdentifier string, extension time.Duration) (*time.Time, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if extension <= noEnclaveExpiryTimeout {
+		return nil, stacktrace.NewError("An enclave can only be extended by a positive duration, but got '%v'", extension)
+	}
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+
+	expiryTime, err := manager.expiryRegistry.extend(enclaveUuid, extension, time.Now())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred extending enclave '%v' by '%v'", enclaveIdentifier, extension)
+	}
+	return &expiryTime, nil
 }
 
 // DestroyEnclave
@@ -266,6 +376,9 @@ func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdenti
 		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
 	}
 
+	// Gotten before it's destroyed, so that the event tells its name and labels
+	enclaveToDestroy := manager.getEnclaveForEvent(ctx, enclaveUuid)
+
 	enclaveDestroyFilter := &enclave.EnclaveFilters{
 		UUIDs: map[enclave.EnclaveUUID]bool{
 			enclaveUuid: true,
@@ -280,6 +393,10 @@ func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdenti
 		if err = manager.enclaveLogFileManager.RemoveEnclaveLogs(string(enclaveUuid)); err != nil {
 			return stacktrace.Propagate(err, "An error occurred attempting to remove enclave '%v' logs after it was destroyed.", enclaveIdentifier)
 		}
+		if err = manager.expiryRegistry.remove(enclaveUuid); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing the expiry of enclave '%v' after it was destroyed.", enclaveIdentifier)
+		}
+		manager.publishEnclaveEventForEnclave(engine_events.EngineEventType_ENCLAVE_DESTROYED, enclaveUuid, enclaveToDestroy)
 		return nil
 	}
 	destructionErr, found := erroredEnclaves[enclaveUuid]
@@ -289,7 +406,13 @@ func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdenti
 	return destructionErr
 }
 
-func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) ([]*types.EnclaveNameAndUuid, error) {
+// Clean destroys the stopped enclaves, or all of them if shouldCleanAll is set, whose labels match the label selector
+func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool, labelSelectorStr string) ([]*types.EnclaveNameAndUuid, error) {
+	labelSelector, err := ParseEnclaveLabelSelector(labelSelectorStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing enclave label selector '%v'", labelSelectorStr)
+	}
+
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 	// TODO: Refactor with kurtosis backend
@@ -302,9 +425,16 @@ func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) (
 	}
 
 	enclaveUUIDsToClean := map[enclave.EnclaveUUID]bool{}
-	for enclaveUUID := range enclavesForUuidNameMapping {
+	for enclaveUUID, enclaveInfo := range enclavesForUuidNameMapping {
+		if !labelSelector.Matches(enclaveInfo.Labels) {
+			continue
+		}
 		enclaveUUIDsToClean[enclaveUUID] = true
 	}
+	if len(enclaveUUIDsToClean) == 0 && !labelSelector.MatchesAll() {
+		// The backend treats an empty set of UUIDs as every enclave, so nothing gets cleaned when no enclave matches
+		return resultEnclaveNameAndUuids, nil
+	}
 
 	successfullyRemovedEnclaveUuidStrs, removalErrors, err := manager.cleanEnclaves(ctx, enclaveUUIDsToClean, shouldCleanAll)
 	if err != nil {
@@ -333,10 +463,13 @@ func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) (
 			}
 			// this should always be found; but we don't want to error if it isn't
 			// we just use the default not found that we set above if we can't find the name
+			var enclaveLabels map[string]string
 			enclave, found := enclavesForUuidNameMapping[enclave.EnclaveUUID(successfullyRemovedEnclaveUuidStr)]
 			if found {
 				nameAndUuid.Name = enclave.Name
+				enclaveLabels = enclave.Labels
 			}
+			manager.publishEnclaveEvent(engine_events.EngineEventType_ENCLAVE_DESTROYED, successfullyRemovedEnclaveUuidStr, nameAndUuid.Name, enclaveLabels)
 			resultEnclaveNameAndUuids = append(resultEnclaveNameAndUuids, nameAndUuid)
 			logrus.Infof("Enclave Uuid '%v'", successfullyRemovedEnclaveUuidStr)
 		}
@@ -519,11 +652,186 @@ func (manager *EnclaveManager) cleanEnclaves(
 			logRemovalErr := stacktrace.Propagate(err, "An error occurred removing enclave '%v' logs.", enclaveId)
 			enclaveDestructionErrors = append(enclaveDestructionErrors, logRemovalErr)
 		}
+		if err := manager.expiryRegistry.remove(enclaveId); err != nil {
+			expiryRemovalErr := stacktrace.Propagate(err, "An error occurred removing enclave '%v' expiry.", enclaveId)
+			enclaveDestructionErrors = append(enclaveDestructionErrors, expiryRemovalErr)
+		}
 	}
 
 	return successfullyDestroyedEnclaveIdStrs, enclaveDestructionErrors, nil
 }
 
+// createEnclaveWithMutex creates the enclave, or takes it from the enclave pool, and returns whether it was taken
+// from the pool
+func (manager *EnclaveManager) createEnclaveWithMutex(
+	setupCtx context.Context,
+	engineVersion string,
+	apiContainerImageVersionTag string,
+	apiContainerLogLevel logrus.Level,
+	enclaveName string,
+	isProduction bool,
+	shouldAPICRunInDebugMode bool,
+	isIpv6Enabled bool,
+	labels map[string]string,
+	ttl time.Duration,
+	idleTimeout time.Duration,
+	expiryAction types.EnclaveExpiryAction,
+	fromTemplate string,
+) (*types.EnclaveInfo, bool, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	var (
+		enclaveInfo *types.EnclaveInfo
+		err         error
+	)
+
+	if ttl < noEnclaveExpiryTimeout || idleTimeout < noEnclaveExpiryTimeout {
+		return nil, false, stacktrace.NewError("The TTL and the idle timeout of an enclave can't be negative, but got TTL '%v' and idle timeout '%v'", ttl, idleTimeout)
+	}
+
+	allExistingAndHistoricalIdentifiers, err := manager.getExistingAndHistoricalEnclaveIdentifiersWithoutMutex()
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred getting existing and historical enclave identifiers")
+	}
+
+	allEnclaveNames := []string{}
+	for _, enclaveIdentifier := range allExistingAndHistoricalIdentifiers {
+
+		allEnclaveNames = append(allEnclaveNames, enclaveIdentifier.Name)
+	}
+
+	if enclaveName == autogenerateEnclaveNameKeyword {
+		enclaveName = GetRandomEnclaveNameWithRetries(name_generator.GenerateNatureThemeNameForEnclave, allEnclaveNames, getRandomEnclaveIdRetries)
+	}
+
+	if err := validateEnclaveName(enclaveName); err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred validating enclave name '%v'", enclaveName)
+	}
+
+	if err := enclave.ValidateEnclaveLabels(labels); err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred validating the labels of enclave '%v'", enclaveName)
+	}
+
+	// The resource quotas of the user apply to the enclaves they create, even though they only become their owner
+	// once the enclave is created
+	enclaveOwner := noEnclaveOwner
+	if principal := engine_auth.GetPrincipal(setupCtx); principal != nil {
+		enclaveOwner = principal.GetName()
+	}
+	applicableResourceQuotas := manager.getApplicableResourceQuotas(enclaveOwner, labels)
+	if err := manager.checkMaxEnclavesWithoutMutex(setupCtx, enclaveName, applicableResourceQuotas); err != nil {
+		return nil, false, stacktrace.Propagate(err, "Enclave '%v' would go over a resource quota", enclaveName)
+	}
+	enclaveResourceQuotaBudget, err := manager.getNewEnclaveResourceQuotaBudgetWithoutMutex(setupCtx, enclaveName, applicableResourceQuotas)
+	if err != nil {
+		return nil, false, stacktrace.Propagate(err, "Enclave '%v' would go over a resource quota", enclaveName)
+	}
+	enclaveResourceQuota := getEnclaveResourceQuota(applicableResourceQuotas)
+	if enclaveResourceQuota != nil {
+		enclaveResourceQuota.Budget = enclaveResourceQuotaBudget
+	}
+
+	// TODO(victor.colombo): Extend enclave pool to have warm production enclaves
+	// The enclaves of the pool are IPv4 only, so IPv6 enclaves are always created from scratch, and their API
+	// containers have no resource quota, so the enclaves a quota applies to are created from scratch too
+	if !isProduction && !isIpv6Enabled && enclaveResourceQuota == nil && manager.enclavePool != nil {
+		enclaveInfo, err = manager.enclavePool.GetEnclave(
+			setupCtx,
+			enclaveName,
+			fromTemplate,
+			engineVersion,
+			apiContainerImageVersionTag,
+			apiContainerLogLevel,
+			shouldAPICRunInDebugMode,
+			labels,
+		)
+		if err != nil {
+			logrus.Errorf("An error occurred when trying to get an enclave from the enclave pool. Err:\n%v", err)
+		}
+	}
+
+	isFromPool := enclaveInfo != nil
+
+	// Every enclave of a kind the pool keeps warm counts as a request to the pool, even when it couldn't be taken from
+	// it, so that the hit rate tells how many of them were handed out warm
+	if fromTemplate != noEnclavePoolTemplate || (manager.enclavePool != nil && manager.enclavePool.keepsIdleEnclavesOf(noEnclavePoolTemplate)) {
+		engine_metrics.CountEnclavePoolRequest(fromTemplate, isFromPool)
+	}
+
+	if !isFromPool {
+		enclaveInfo, err = manager.enclaveCreator.CreateEnclave(
+			setupCtx,
+			apiContainerImageVersionTag,
+			apiContainerLogLevel,
+			enclaveName,
+			manager.enclaveEnvVars,
+			isProduction,
+			manager.metricsUserID,
+			manager.didUserAcceptSendingMetrics,
+			manager.isCI,
+			manager.cloudUserID,
+			manager.cloudInstanceID,
+			manager.kurtosisBackendType,
+			shouldAPICRunInDebugMode,
+			isIpv6Enabled,
+			labels,
+			enclaveResourceQuota,
+		)
+		if err != nil {
+			return nil, false, stacktrace.Propagate(
+				err,
+				"An error occurred creating new enclave with name '%s' using api container image version '%s' and api container log level '%v'",
+				enclaveName,
+				apiContainerImageVersionTag,
+				apiContainerLogLevel,
+			)
+		}
+	}
+
+	enclaveIdentifier := &types.EnclaveIdentifiers{
+		EnclaveUuid:   enclaveInfo.EnclaveUuid,
+		Name:          enclaveInfo.Name,
+		ShortenedUuid: enclaveInfo.ShortenedUuid,
+	}
+	manager.allExistingAndHistoricalIdentifiers = append(manager.allExistingAndHistoricalIdentifiers, enclaveIdentifier)
+
+	if ttl > noEnclaveExpiryTimeout || idleTimeout > noEnclaveExpiryTimeout {
+		// The enclave is up already, so it's left running; the error tells the user it won't expire
+		enclaveInfo.ExpiryTime, err = manager.registerEnclaveExpiry(enclave.EnclaveUUID(enclaveInfo.EnclaveUuid), ttl, idleTimeout, expiryAction)
+		if err != nil {
+			return nil, false, stacktrace.Propagate(err, "Enclave '%v' was created but an error occurred registering its expiry, so it won't expire", enclaveInfo.Name)
+		}
+	}
+
+	return enclaveInfo, isFromPool, nil
+}
+
+func (manager *EnclaveManager) registerEnclaveExpiry(
+	enclaveUuid enclave.EnclaveUUID,
+	ttl time.Duration,
+	idleTimeout time.Duration,
+	expiryAction types.EnclaveExpiryAction,
+) (*time.Time, error) {
+	now := time.Now()
+	expiry := &enclaveExpiry{
+		TtlExpiryTime:    nil,
+		IdleTimeout:      idleTimeout,
+		LastActivityTime: now,
+		NotIdleUntil:     nil,
+		Action:           expiryAction,
+	}
+	if ttl > noEnclaveExpiryTimeout {
+		ttlExpiryTime := now.Add(ttl)
+		expiry.TtlExpiryTime = &ttlExpiryTime
+	}
+	if err := manager.expiryRegistry.add(enclaveUuid, expiry); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred registering the expiry of enclave '%v'", enclaveUuid)
+	}
+	expiryTime := expiry.getExpiryTime()
+	return &expiryTime, nil
+}
+
 func (manager *EnclaveManager) getEnclavesWithoutMutex(
 	ctx context.Context,
 ) (map[enclave.EnclaveUUID]*types.EnclaveInfo, error) {
@@ -543,6 +851,7 @@ func (manager *EnclaveManager) getEnclavesWithoutMutex(
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred getting information about enclave '%v'", enclaveId)
 		}
+		enclaveInfo.ExpiryTime = manager.expiryRegistry.getExpiryTime(enclaveId)
 		result[enclaveId] = enclaveInfo
 	}
 	return result, nil
@@ -615,6 +924,7 @@ func getEnclaveInfoForEnclave(ctx context.Context, kurtosisBackend backend_inter
 		ApiContainerInfo:            apiContainerInfo,
 		ApiContainerHostMachineInfo: apiContainerHostMachineInfo,
 		CreationTime:                *creationTimestamp,
+		Labels:                      enclave.GetLabels(),
 		Mode:                        mode,
 	}, nil
 }
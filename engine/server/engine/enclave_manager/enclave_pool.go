@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_metrics"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -24,13 +25,31 @@ const (
 	createTestEnclave = false
 
 	defaultApicDebugModeForEnclavesInThePool = false
+
+	isIpv6EnabledForEnclavesInThePool = false
+
+	// A failed idle enclave creation is retried after a backoff doubling with every failure in a row, and the pool
+	// stops filling a template once that many failures happened in a row, rather than creating and destroying enclaves
+	// forever when a package of the template is broken
+	defaultFillRetryInitialBackoff    = 10 * time.Second
+	fillRetryMaxBackoff               = 5 * time.Minute
+	defaultMaxConsecutiveFillFailures = uint32(5)
 )
 
+// idleEnclaveCreator creates an idle enclave and, if a template is set, runs its packages in it
+type idleEnclaveCreator func(ctx context.Context, template *args.EnclavePoolTemplateConfig) (*types.EnclaveInfo, error)
+
 type EnclavePool struct {
-	kurtosisBackend             backend_interface.KurtosisBackend
-	enclaveCreator              *EnclaveCreator
-	idleEnclavesChan            chan *types.EnclaveInfo
-	fillChan                    chan bool
+	kurtosisBackend backend_interface.KurtosisBackend
+	enclaveCreator  *EnclaveCreator
+
+	// The idle enclaves of every template, keyed by template name; the empty enclaves are under no template
+	idleEnclaveQueues map[string]*idleEnclaveQueue
+
+	createIdleEnclave idleEnclaveCreator
+
+	fillRetryInitialBackoff time.Duration
+
 	engineVersion               string
 	cancelSubRoutineCtxFunc     context.CancelFunc
 	enclaveEnvVars              string
@@ -41,16 +60,36 @@ type EnclavePool struct {
 	cloudInstanceID             metrics_client.CloudInstanceID
 }
 
+// idleEnclaveQueue holds the idle enclaves of a template, and is filled again by its own subroutine
+type idleEnclaveQueue struct {
+	// Nil for the empty enclaves
+	template *args.EnclavePoolTemplateConfig
+
+	size uint8
+
+	idleEnclavesChan chan *types.EnclaveInfo
+
+	// This channel is used as a signal to tell to the sub-routine that one idle enclave
+	// has been allocated from the queue
+	fillChan chan bool
+
+	// Only used by the sub-routine filling the queue
+	consecutiveFillFailures uint32
+	isFillingStopped        bool
+}
+
 // CreateEnclavePool will do the following:
 // 1- Will remove idle enclaves from previous engine runs even if the pool is not activated (this is for removing
 // any resource leak after an engine restar without this feature enabled or after an engine crash)
-// 2- Wil create a new enclave pool object, if pool size > 1, return nil if pool size = 0, or return an error
-// 3- Will start a subroutine in charge of filling the pool
+// 2- Wil create a new enclave pool object, if pool size > 1 or there are templates, return nil if pool size = 0 and
+// there is no template, or return an error
+// 3- Will start a subroutine per template, and one for the empty enclaves, in charge of filling the pool
 func CreateEnclavePool(
 	kurtosisBackend backend_interface.KurtosisBackend,
 
 	enclaveCreator *EnclaveCreator,
 	poolSize uint8,
+	enclavePoolTemplates map[string]*args.EnclavePoolTemplateConfig,
 	engineVersion string,
 	enclaveEnvVars string,
 	metricsUserID string,
@@ -76,27 +115,28 @@ func CreateEnclavePool(
 	go destroyIdleEnclavesFromPreviousRuns(kurtosisBackend, now)
 
 	// validations
-	// poolSize = 0 means that the Enclave Pool won't be activated, it returns nil with no error
-	if poolSize == 0 {
-		logrus.Debugf("The enclave pool won't be activated due the pool size value is equal to zero")
+	// poolSize = 0 with no template means that the Enclave Pool won't be activated, it returns nil with no error
+	if poolSize == 0 && len(enclavePoolTemplates) == 0 {
+		logrus.Debugf("The enclave pool won't be activated due the pool size value is equal to zero and there is no enclave pool template")
 		return nil, nil
 	}
 
-	// this channel is the repository of idle enclave UUIDs
-	idleEnclavesChan := make(chan *types.EnclaveInfo, poolSize)
-
-	// This channel is used as a signal to tell to the sub-routine that one idle enclave
-	// has been allocated from the pool
-	// It has the capacity = poolSize for not blocking the caller (for concurrent requests)
-	fillChan := make(chan bool, poolSize)
+	idleEnclaveQueues := map[string]*idleEnclaveQueue{}
+	if poolSize > 0 {
+		idleEnclaveQueues[noEnclavePoolTemplate] = newIdleEnclaveQueue(nil, poolSize)
+	}
+	for templateName, template := range enclavePoolTemplates {
+		idleEnclaveQueues[templateName] = newIdleEnclaveQueue(template, template.Size)
+	}
 
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(context.Background())
 
 	enclavePool := &EnclavePool{
 		kurtosisBackend:             kurtosisBackend,
 		enclaveCreator:              enclaveCreator,
-		idleEnclavesChan:            idleEnclavesChan,
-		fillChan:                    fillChan,
+		idleEnclaveQueues:           idleEnclaveQueues,
+		createIdleEnclave:           nil,
+		fillRetryInitialBackoff:     defaultFillRetryInitialBackoff,
 		engineVersion:               engineVersion,
 		cancelSubRoutineCtxFunc:     cancelCtxFunc,
 		enclaveEnvVars:              enclaveEnvVars,
@@ -106,28 +146,29 @@ func CreateEnclavePool(
 		cloudUserID:                 cloudUserID,
 		cloudInstanceID:             cloudInstanceID,
 	}
-
-	go enclavePool.run(ctxWithCancel)
-
-	enclavePool.init(poolSize)
+	enclavePool.createIdleEnclave = enclavePool.createNewIdleEnclave
+	enclavePool.start(ctxWithCancel)
 
 	return enclavePool, nil
 }
 
-// GetEnclave returns the first idle enclave from the pool, and the enclave is renamed with the
-// name set by the caller before returning it. It returns nil if there is no enclave on the pool
+// GetEnclave returns the first idle enclave of the template from the pool, and the enclave is renamed with the
+// name set by the caller before returning it. It returns nil if there is no enclave of the template on the pool
 // or if the requested enclave params are different from the enclave in the pool params
 func (pool *EnclavePool) GetEnclave(
 	ctx context.Context,
 	newEnclaveName string,
+	templateName string,
 	engineVersion string,
 	apiContainerVersion string,
 	apiContainerLogLevel logrus.Level,
 	shouldAPICRunInDebugMode bool,
+	labels map[string]string,
 ) (*types.EnclaveInfo, error) {
 
 	logrus.Debugf(
-		"Requesting enclave from pool using params: engine version '%s', api container version '%s' and api container log level '%s'...",
+		"Requesting enclave of template '%s' from pool using params: engine version '%s', api container version '%s' and api container log level '%s'...",
+		templateName,
 		engineVersion,
 		apiContainerVersion,
 		apiContainerLogLevel,
@@ -147,18 +188,25 @@ func (pool *EnclavePool) GetEnclave(
 		return nil, nil
 	}
 
+	queue, found := pool.idleEnclaveQueues[templateName]
+	if !found {
+		logrus.Debugf("The pool keeps no idle enclave of template '%s'", templateName)
+		return nil, nil
+	}
+
 	// If there is no idle enclave in the pool returns nil
 	// for not to block the caller
-	if len(pool.idleEnclavesChan) == 0 {
+	if len(queue.idleEnclavesChan) == 0 {
 		return nil, nil
 	}
-	enclaveInfo, ok := <-pool.idleEnclavesChan
+	enclaveInfo, ok := <-queue.idleEnclavesChan
 	if !ok {
 		return nil, stacktrace.NewError("A new enclave can't be returned from the pool because the internal channel is closed, it shouldn't happen; this is a bug in Kurtosis")
 	}
+	engine_metrics.SetEnclavePoolIdleEnclaves(templateName, len(queue.idleEnclavesChan))
 	// let the subroutine knows that one idle enclave has been taken from the pool,
 	// and it has to fill the pool again
-	pool.fillChan <- fill
+	queue.fillChan <- fill
 
 	enclaveUUID := enclave.EnclaveUUID(enclaveInfo.EnclaveUuid)
 	shouldDestroyEnclaveBecauseSomethingFails := true
@@ -184,14 +232,15 @@ func (pool *EnclavePool) GetEnclave(
 
 	newCreationTime := time.Now()
 
-	if err := pool.kurtosisBackend.UpdateEnclave(ctx, enclaveUUID, newEnclaveName, &newCreationTime); err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred updating enclave with UUID '%v', trying to update name to '%s' and creation time to '%v'", enclaveUUID, newEnclaveName, newCreationTime)
+	if err := pool.kurtosisBackend.UpdateEnclave(ctx, enclaveUUID, newEnclaveName, &newCreationTime, labels); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating enclave with UUID '%v', trying to update name to '%s', creation time to '%v' and labels to '%+v'", enclaveUUID, newEnclaveName, newCreationTime, labels)
 	}
 
 	// update the enclave info before returning it
 	// we assume that container status and apic status both are RUNNING because we check it above in getRunningEnclave
 	enclaveInfo.Name = newEnclaveName
 	enclaveInfo.CreationTime = newCreationTime
+	enclaveInfo.Labels = labels
 
 	logrus.Debugf("Returning enclave Info '%+v' for requested enclave name '%s'", enclaveInfo, newEnclaveName)
 
@@ -199,14 +248,24 @@ func (pool *EnclavePool) GetEnclave(
 	return enclaveInfo, nil
 }
 
-// Close stop the EnclavePool subroutine, in charge of filling the pool,
+// keepsIdleEnclavesOf returns whether the pool keeps idle enclaves of the template
+func (pool *EnclavePool) keepsIdleEnclavesOf(templateName string) bool {
+	_, found := pool.idleEnclaveQueues[templateName]
+	return found
+}
+
+// Close stop the EnclavePool subroutines, in charge of filling the pool,
 // and removes all the idle enclaves already created
 func (pool *EnclavePool) Close() error {
 
-	defer close(pool.idleEnclavesChan)
-	defer close(pool.fillChan)
+	defer func() {
+		for _, queue := range pool.idleEnclaveQueues {
+			close(queue.idleEnclavesChan)
+			close(queue.fillChan)
+		}
+	}()
 
-	// will terminate running processes in the subroutine
+	// will terminate running processes in the subroutines
 	pool.cancelSubRoutineCtxFunc()
 
 	// destroy all the idle enclaves
@@ -222,40 +281,111 @@ func (pool *EnclavePool) Close() error {
 //	Private helper methods
 //
 // ====================================================================================================
-func (pool *EnclavePool) init(poolSize uint8) {
-	logrus.Debugf("Initializing enclave pool with size '%v'...", poolSize)
-	for i := uint8(0); i < poolSize; i++ {
-		pool.fillChan <- fill
+func newIdleEnclaveQueue(template *args.EnclavePoolTemplateConfig, size uint8) *idleEnclaveQueue {
+	return &idleEnclaveQueue{
+		template: template,
+		size:     size,
+		// this channel is the repository of idle enclave UUIDs
+		idleEnclavesChan: make(chan *types.EnclaveInfo, size),
+		// It has the capacity = size for not blocking the caller (for concurrent requests)
+		fillChan:                make(chan bool, size),
+		consecutiveFillFailures: 0,
+		isFillingStopped:        false,
+	}
+}
+
+func (pool *EnclavePool) start(ctx context.Context) {
+	for _, queue := range pool.idleEnclaveQueues {
+		go pool.run(ctx, queue)
+
+		pool.init(queue)
+	}
+}
+
+func (queue *idleEnclaveQueue) getTemplateName() string {
+	if queue.template == nil {
+		return noEnclavePoolTemplate
+	}
+	return queue.template.Name
+}
+
+func (queue *idleEnclaveQueue) getMaxConsecutiveFillFailures() uint32 {
+	if queue.template == nil || queue.template.MaxConsecutiveFailures == 0 {
+		return defaultMaxConsecutiveFillFailures
+	}
+	return queue.template.MaxConsecutiveFailures
+}
+
+func (pool *EnclavePool) init(queue *idleEnclaveQueue) {
+	logrus.Debugf("Initializing enclave pool of template '%v' with size '%v'...", queue.getTemplateName(), queue.size)
+	engine_metrics.SetEnclavePoolIdleEnclaves(queue.getTemplateName(), 0)
+	for i := uint8(0); i < queue.size; i++ {
+		queue.fillChan <- fill
 	}
 }
 
 // run is executed in a subroutine and wait for any of these two signals:
-// 1- for creating and add a new idle enclave in the pool
+// 1- for creating and add a new idle enclave of the queue template in the pool
 // 2- for closing the subroutine
-func (pool *EnclavePool) run(ctx context.Context) {
+func (pool *EnclavePool) run(ctx context.Context, queue *idleEnclaveQueue) {
 	for {
 		// wait until receive the re-fill signal or the ctx has done signal
 		select {
-		case <-pool.fillChan:
-			if err := pool.createAndAddOneIdleEnclaveIfNeeded(ctx); err != nil {
-				if err == context.Canceled {
-					logrus.Debug("The subroutine context has been canceled")
-				} else {
-					logrus.Errorf("An error occurred filling the enclave pool. Error\n%v", err)
-				}
-				break
-			}
+		case <-queue.fillChan:
+			pool.fillOneIdleEnclave(ctx, queue)
 		case <-ctx.Done():
 			logrus.Debug("The subroutine context has done")
-			logrus.Debug("Enclave pool sub-routine stopped")
+			logrus.Debugf("Enclave pool sub-routine of template '%v' stopped", queue.getTemplateName())
+			return
+		}
+	}
+}
+
+// fillOneIdleEnclave adds one idle enclave to the queue, retrying with a backoff until it succeeds, the context is
+// cancelled, or the queue had as many failures in a row as its max, in which case the queue isn't filled anymore
+func (pool *EnclavePool) fillOneIdleEnclave(ctx context.Context, queue *idleEnclaveQueue) {
+	for !queue.isFillingStopped {
+		err := pool.createAndAddOneIdleEnclaveIfNeeded(ctx, queue)
+		if err == nil {
+			queue.consecutiveFillFailures = 0
+			return
+		}
+		if err == context.Canceled || ctx.Err() != nil {
+			logrus.Debug("The subroutine context has been canceled")
+			return
+		}
+
+		queue.consecutiveFillFailures++
+		if queue.consecutiveFillFailures >= queue.getMaxConsecutiveFillFailures() {
+			queue.isFillingStopped = true
+			logrus.Errorf("Filling the enclave pool of template '%v' failed %d times in a row, so the pool stops filling it until the engine restarts; its enclaves get created from scratch instead. Last error:\n%v", queue.getTemplateName(), queue.consecutiveFillFailures, err)
+			return
+		}
+		backoff := getFillRetryBackoff(pool.fillRetryInitialBackoff, queue.consecutiveFillFailures)
+		logrus.Errorf("An error occurred filling the enclave pool of template '%v', retrying in %v. Error\n%v", queue.getTemplateName(), backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
 			return
 		}
 	}
 }
 
-func (pool *EnclavePool) createAndAddOneIdleEnclaveIfNeeded(ctx context.Context) error {
+// getFillRetryBackoff doubles the initial backoff for every failure in a row after the first one
+func getFillRetryBackoff(initialBackoff time.Duration, consecutiveFailures uint32) time.Duration {
+	backoff := initialBackoff
+	for i := uint32(1); i < consecutiveFailures && backoff < fillRetryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > fillRetryMaxBackoff {
+		return fillRetryMaxBackoff
+	}
+	return backoff
+}
 
-	newEnclaveInfo, err := pool.createNewIdleEnclave(ctx)
+func (pool *EnclavePool) createAndAddOneIdleEnclaveIfNeeded(ctx context.Context, queue *idleEnclaveQueue) error {
+
+	newEnclaveInfo, err := pool.createIdleEnclave(ctx, queue.template)
 	if err != nil {
 		if err == context.Canceled {
 			return nil
@@ -263,13 +393,15 @@ func (pool *EnclavePool) createAndAddOneIdleEnclaveIfNeeded(ctx context.Context)
 		return stacktrace.Propagate(err, "An error occurred creating a new idle enclave.")
 	}
 
-	pool.idleEnclavesChan <- newEnclaveInfo
-	logrus.Debugf("Enclave with UUID '%s' was added intho the pool channel", newEnclaveInfo.EnclaveUuid)
+	queue.idleEnclavesChan <- newEnclaveInfo
+	engine_metrics.SetEnclavePoolIdleEnclaves(queue.getTemplateName(), len(queue.idleEnclavesChan))
+	logrus.Debugf("Enclave with UUID '%s' was added intho the pool channel of template '%s'", newEnclaveInfo.EnclaveUuid, queue.getTemplateName())
 
 	return nil
 }
 
-func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context) (*types.EnclaveInfo, error) {
+// createNewIdleEnclave creates an enclave and, if a template is set, runs its packages in it
+func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context, template *args.EnclavePoolTemplateConfig) (*types.EnclaveInfo, error) {
 
 	enclaveName, err := GetRandomIdleEnclaveName()
 	if err != nil {
@@ -295,6 +427,11 @@ func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context) (*types.Encla
 		pool.cloudInstanceID,
 		args.KurtosisBackendType_Kubernetes, // enclave pool only available for k8s
 		defaultApicDebugModeForEnclavesInThePool,
+		isIpv6EnabledForEnclavesInThePool,
+		// The labels get set when the enclave is taken from the pool
+		nil,
+		// The enclaves a resource quota applies to aren't taken from the pool
+		nil,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -306,6 +443,22 @@ func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context) (*types.Encla
 		)
 	}
 
+	if template != nil {
+		if err := runEnclavePoolTemplatePackages(ctx, pool.enclaveCreator, newEnclaveInfo, template); err != nil {
+			// The enclave is destroyed rather than kept half warm
+			idleEnclavesToRemove := map[enclave.EnclaveUUID]bool{
+				enclave.EnclaveUUID(newEnclaveInfo.EnclaveUuid): true,
+			}
+			if destroyErr := destroyEnclavesByUUID(context.Background(), pool.kurtosisBackend, idleEnclavesToRemove); destroyErr != nil {
+				logrus.Errorf("An error occurred destroying idle enclave '%v' whose template packages failed to run; you'll have to destroy it manually. Error:\n%v", newEnclaveInfo.EnclaveUuid, destroyErr)
+			}
+			if ctx.Err() == context.Canceled {
+				return nil, context.Canceled
+			}
+			return nil, stacktrace.Propagate(err, "An error occurred running the packages of enclave pool template '%v' in idle enclave '%s'", template.Name, enclaveName)
+		}
+	}
+
 	logrus.Debugf("New idle enclave created '%+v'", newEnclaveInfo)
 	return newEnclaveInfo, nil
 }
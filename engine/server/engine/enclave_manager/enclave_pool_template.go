@@ -0,0 +1,161 @@
+package enclave_manager
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// The enclaves created from no template are empty
+	noEnclavePoolTemplate = ""
+
+	// The packages of the templates run the way 'kurtosis run' runs them by default
+	enclavePoolTemplatePackageRelativePathToMainFile = ""
+	enclavePoolTemplatePackageMainFunctionName       = ""
+	enclavePoolTemplatePackageDefaultArgs            = "{}"
+	defaultEnclavePoolTemplatePackageParallelism     = int32(4)
+	defaultEnclavePoolTemplatePackageImageDownload   = kurtosis_core_rpc_api_bindings.ImageDownloadMode_missing
+	enclavePoolTemplatePackageDryRun                 = false
+	enclavePoolTemplatePackageNonBlockingMode        = false
+	enclavePoolTemplatePackageCloudInstanceId        = ""
+	enclavePoolTemplatePackageCloudUserId            = ""
+	enclavePoolTemplatePackageGithubAuthToken        = ""
+)
+
+var enclavePoolTemplatePackageExperimentalFeatures = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag(nil)
+
+// newEnclavePoolTemplates validates the enclave pool templates set on the engine, and keys them by name
+func newEnclavePoolTemplates(enclavePoolTemplateConfigs []*args.EnclavePoolTemplateConfig) (map[string]*args.EnclavePoolTemplateConfig, error) {
+	enclavePoolTemplates := map[string]*args.EnclavePoolTemplateConfig{}
+	for _, config := range enclavePoolTemplateConfigs {
+		if config.Name == noEnclavePoolTemplate {
+			return nil, stacktrace.NewError("An enclave pool template has no name; every enclave pool template needs one")
+		}
+		if _, found := enclavePoolTemplates[config.Name]; found {
+			return nil, stacktrace.NewError("Enclave pool template name '%v' is used more than once", config.Name)
+		}
+		if config.Size == 0 {
+			return nil, stacktrace.NewError("Enclave pool template '%v' has a size of zero; it needs to keep at least one enclave warm", config.Name)
+		}
+		if len(config.Packages) == 0 {
+			return nil, stacktrace.NewError("Enclave pool template '%v' has no package; it needs at least one package to run in its enclaves", config.Name)
+		}
+		for _, templatePackage := range config.Packages {
+			if templatePackage.PackageId == "" {
+				return nil, stacktrace.NewError("A package of enclave pool template '%v' has no package ID", config.Name)
+			}
+			if templatePackage.Args != "" && !json.Valid([]byte(templatePackage.Args)) {
+				return nil, stacktrace.NewError("The args '%v' of package '%v' in enclave pool template '%v' aren't valid JSON", templatePackage.Args, templatePackage.PackageId, config.Name)
+			}
+		}
+		if config.Parallelism < 0 {
+			return nil, stacktrace.NewError("Enclave pool template '%v' has a negative parallelism '%v'", config.Name, config.Parallelism)
+		}
+		if config.ImageDownloadMode != "" {
+			if _, found := kurtosis_core_rpc_api_bindings.ImageDownloadMode_value[config.ImageDownloadMode]; !found {
+				return nil, stacktrace.NewError("Enclave pool template '%v' has an invalid image download mode '%v'; valid modes are '%v' and '%v'", config.Name, config.ImageDownloadMode, kurtosis_core_rpc_api_bindings.ImageDownloadMode_always, kurtosis_core_rpc_api_bindings.ImageDownloadMode_missing)
+			}
+		}
+		enclavePoolTemplates[config.Name] = config
+	}
+	return enclavePoolTemplates, nil
+}
+
+// runEnclavePoolTemplatePackages runs the packages of the template in the enclave, one after the other, through its
+// API container, failing on the first package whose run fails
+func runEnclavePoolTemplatePackages(ctx context.Context, enclaveCreator *EnclaveCreator, enclaveInfo *types.EnclaveInfo, template *args.EnclavePoolTemplateConfig) error {
+	apiContainerClient, closeApiContainerClient, err := enclaveCreator.getApiContainerClient(enclaveInfo)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v' to run the packages of enclave pool template '%v'", enclaveInfo.Name, template.Name)
+	}
+	defer closeApiContainerClient()
+
+	for _, templatePackage := range template.Packages {
+		logrus.Debugf("Running package '%v' of enclave pool template '%v' in enclave '%v'...", templatePackage.PackageId, template.Name, enclaveInfo.Name)
+		if err := runEnclavePoolTemplatePackage(ctx, apiContainerClient, template, templatePackage); err != nil {
+			return stacktrace.Propagate(err, "An error occurred running package '%v' of enclave pool template '%v' in enclave '%v'", templatePackage.PackageId, template.Name, enclaveInfo.Name)
+		}
+	}
+	return nil
+}
+
+func runEnclavePoolTemplatePackage(
+	ctx context.Context,
+	apiContainerClient kurtosis_core_rpc_api_bindings.ApiContainerServiceClient,
+	template *args.EnclavePoolTemplateConfig,
+	templatePackage *args.EnclavePoolTemplatePackageConfig,
+) error {
+	serializedArgs := templatePackage.Args
+	if serializedArgs == "" {
+		serializedArgs = enclavePoolTemplatePackageDefaultArgs
+	}
+	runPackageArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(
+		templatePackage.PackageId,
+		enclavePoolTemplatePackageRelativePathToMainFile,
+		enclavePoolTemplatePackageMainFunctionName,
+		serializedArgs,
+		enclavePoolTemplatePackageDryRun,
+		getEnclavePoolTemplateParallelism(template),
+		enclavePoolTemplatePackageExperimentalFeatures,
+		enclavePoolTemplatePackageCloudInstanceId,
+		enclavePoolTemplatePackageCloudUserId,
+		getEnclavePoolTemplateImageDownloadMode(template),
+		enclavePoolTemplatePackageNonBlockingMode,
+		enclavePoolTemplatePackageGithubAuthToken,
+	)
+	responseLines, err := apiContainerClient.RunStarlarkPackage(ctx, runPackageArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the run of the package")
+	}
+	for {
+		responseLine, err := responseLines.Recv()
+		if err == io.EOF {
+			return stacktrace.NewError("The run of the package ended without telling whether it was successful")
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the output of the run of the package")
+		}
+		if runError := responseLine.GetError(); runError != nil {
+			return stacktrace.NewError("The run of the package failed with error:\n%v", getStarlarkErrorMessage(runError))
+		}
+		if runFinishedEvent := responseLine.GetRunFinishedEvent(); runFinishedEvent != nil {
+			if !runFinishedEvent.GetIsRunSuccessful() {
+				return stacktrace.NewError("The run of the package finished without being successful")
+			}
+			return nil
+		}
+	}
+}
+
+func getEnclavePoolTemplateParallelism(template *args.EnclavePoolTemplateConfig) int32 {
+	if template.Parallelism == 0 {
+		return defaultEnclavePoolTemplatePackageParallelism
+	}
+	return template.Parallelism
+}
+
+func getEnclavePoolTemplateImageDownloadMode(template *args.EnclavePoolTemplateConfig) kurtosis_core_rpc_api_bindings.ImageDownloadMode {
+	imageDownloadMode, found := kurtosis_core_rpc_api_bindings.ImageDownloadMode_value[template.ImageDownloadMode]
+	if !found {
+		return defaultEnclavePoolTemplatePackageImageDownload
+	}
+	return kurtosis_core_rpc_api_bindings.ImageDownloadMode(imageDownloadMode)
+}
+
+func getStarlarkErrorMessage(runError *kurtosis_core_rpc_api_bindings.StarlarkError) string {
+	if interpretationError := runError.GetInterpretationError(); interpretationError != nil {
+		return interpretationError.GetErrorMessage()
+	}
+	if validationError := runError.GetValidationError(); validationError != nil {
+		return validationError.GetErrorMessage()
+	}
+	return runError.GetExecutionError().GetErrorMessage()
+}
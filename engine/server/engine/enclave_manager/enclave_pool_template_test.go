@@ -0,0 +1,59 @@
+package enclave_manager
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTemplatePackageId = "github.com/kurtosis-tech/postgres-package"
+)
+
+func TestNewEnclavePoolTemplates(t *testing.T) {
+	postgresTemplate := newTestEnclavePoolTemplateConfig("postgres", 2, testTemplatePackageId, `{"max_connections": 200}`)
+	enclavePoolTemplates, err := newEnclavePoolTemplates([]*args.EnclavePoolTemplateConfig{postgresTemplate})
+	require.NoError(t, err)
+	require.Equal(t, map[string]*args.EnclavePoolTemplateConfig{"postgres": postgresTemplate}, enclavePoolTemplates)
+
+	enclavePoolTemplates, err = newEnclavePoolTemplates(nil)
+	require.NoError(t, err)
+	require.Empty(t, enclavePoolTemplates)
+}
+
+func TestNewEnclavePoolTemplates_InvalidConfigs(t *testing.T) {
+	noPackageTemplate := newTestEnclavePoolTemplateConfig("postgres", 1, testTemplatePackageId, "")
+	noPackageTemplate.Packages = nil
+	negativeParallelismTemplate := newTestEnclavePoolTemplateConfig("postgres", 1, testTemplatePackageId, "")
+	negativeParallelismTemplate.Parallelism = -1
+	invalidImageDownloadModeTemplate := newTestEnclavePoolTemplateConfig("postgres", 1, testTemplatePackageId, "")
+	invalidImageDownloadModeTemplate.ImageDownloadMode = "never"
+	invalidConfigs := map[string][]*args.EnclavePoolTemplateConfig{
+		"no name":                     {newTestEnclavePoolTemplateConfig("", 1, testTemplatePackageId, "")},
+		"duplicated name":             {newTestEnclavePoolTemplateConfig("postgres", 1, testTemplatePackageId, ""), newTestEnclavePoolTemplateConfig("postgres", 2, testTemplatePackageId, "")},
+		"zero size":                   {newTestEnclavePoolTemplateConfig("postgres", 0, testTemplatePackageId, "")},
+		"no package":                  {noPackageTemplate},
+		"no package ID":               {newTestEnclavePoolTemplateConfig("postgres", 1, "", "")},
+		"invalid args":                {newTestEnclavePoolTemplateConfig("postgres", 1, testTemplatePackageId, "max_connections: 200")},
+		"negative parallelism":        {negativeParallelismTemplate},
+		"invalid image download mode": {invalidImageDownloadModeTemplate},
+	}
+	for description, configs := range invalidConfigs {
+		_, err := newEnclavePoolTemplates(configs)
+		require.Error(t, err, "Expected the configs with '%v' to be rejected", description)
+	}
+}
+
+func newTestEnclavePoolTemplateConfig(name string, size uint8, packageId string, packageArgs string) *args.EnclavePoolTemplateConfig {
+	return &args.EnclavePoolTemplateConfig{
+		Name: name,
+		Size: size,
+		Packages: []*args.EnclavePoolTemplatePackageConfig{
+			{
+				PackageId: packageId,
+				Args:      packageArgs,
+			},
+		},
+	}
+}
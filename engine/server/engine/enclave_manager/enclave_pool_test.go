@@ -0,0 +1,138 @@
+package enclave_manager
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPoolTemplateName  = "postgres"
+	testPoolEngineVersion = "1.0.0"
+	testPoolEnclaveName   = "my-enclave"
+
+	testPoolWaitFor  = 5 * time.Second
+	testPoolWaitTick = 10 * time.Millisecond
+)
+
+// testIdleEnclaveCreator creates idle enclaves with consecutive UUIDs, failing while shouldFail is set
+type testIdleEnclaveCreator struct {
+	mutex        sync.Mutex
+	shouldFail   bool
+	numCalls     int
+	lastTemplate *args.EnclavePoolTemplateConfig
+}
+
+func (creator *testIdleEnclaveCreator) create(_ context.Context, template *args.EnclavePoolTemplateConfig) (*types.EnclaveInfo, error) {
+	creator.mutex.Lock()
+	defer creator.mutex.Unlock()
+	creator.numCalls++
+	creator.lastTemplate = template
+	if creator.shouldFail {
+		return nil, errors.New("the template package failed")
+	}
+	return &types.EnclaveInfo{EnclaveUuid: string(rune('a' + creator.numCalls - 1))}, nil //nolint:exhaustruct
+}
+
+func (creator *testIdleEnclaveCreator) getLastTemplate() *args.EnclavePoolTemplateConfig {
+	creator.mutex.Lock()
+	defer creator.mutex.Unlock()
+	return creator.lastTemplate
+}
+
+func (creator *testIdleEnclaveCreator) getNumCalls() int {
+	creator.mutex.Lock()
+	defer creator.mutex.Unlock()
+	return creator.numCalls
+}
+
+func TestEnclavePool_FillsAndClaimsEnclaveOfTemplate(t *testing.T) {
+	template := newTestEnclavePoolTemplateConfig(testPoolTemplateName, 1, testTemplatePackageId, "")
+	creator := &testIdleEnclaveCreator{} //nolint:exhaustruct
+	kurtosisBackend := backend_interface.NewMockKurtosisBackend(t)
+	pool := newTestEnclavePool(t, kurtosisBackend, template, creator)
+
+	require.Eventually(t, func() bool { return len(pool.idleEnclaveQueues[testPoolTemplateName].idleEnclavesChan) == 1 }, testPoolWaitFor, testPoolWaitTick)
+	require.Same(t, template, creator.getLastTemplate())
+
+	// a template the pool doesn't keep gets no enclave
+	enclaveInfo, err := pool.GetEnclave(context.Background(), testPoolEnclaveName, "unknown", testPoolEngineVersion, "", defaultApiContainerLogLevel, false, nil)
+	require.NoError(t, err)
+	require.Nil(t, enclaveInfo)
+
+	labels := map[string]string{"team": "data"}
+	claimedEnclaveUuid := enclave.EnclaveUUID("a")
+	runningEnclave := enclave.NewEnclave(claimedEnclaveUuid, "idle-enclave", enclave.EnclaveStatus_Running, nil, false, nil)
+	kurtosisBackend.EXPECT().
+		GetEnclaves(mock.Anything, mock.Anything).
+		Return(map[enclave.EnclaveUUID]*enclave.Enclave{claimedEnclaveUuid: runningEnclave}, nil)
+	kurtosisBackend.EXPECT().
+		UpdateEnclave(mock.Anything, claimedEnclaveUuid, testPoolEnclaveName, mock.Anything, labels).
+		Return(nil)
+
+	enclaveInfo, err = pool.GetEnclave(context.Background(), testPoolEnclaveName, testPoolTemplateName, testPoolEngineVersion, "", defaultApiContainerLogLevel, false, labels)
+	require.NoError(t, err)
+	require.NotNil(t, enclaveInfo)
+	require.Equal(t, string(claimedEnclaveUuid), enclaveInfo.EnclaveUuid)
+	require.Equal(t, testPoolEnclaveName, enclaveInfo.Name)
+	require.Equal(t, labels, enclaveInfo.Labels)
+
+	// the claimed enclave is replaced with a new one
+	require.Eventually(t, func() bool { return creator.getNumCalls() == 2 }, testPoolWaitFor, testPoolWaitTick)
+	require.Eventually(t, func() bool { return len(pool.idleEnclaveQueues[testPoolTemplateName].idleEnclavesChan) == 1 }, testPoolWaitFor, testPoolWaitTick)
+}
+
+func TestEnclavePool_StopsFillingAfterMaxConsecutiveFailures(t *testing.T) {
+	template := newTestEnclavePoolTemplateConfig(testPoolTemplateName, 2, testTemplatePackageId, "")
+	template.MaxConsecutiveFailures = 3
+	creator := &testIdleEnclaveCreator{shouldFail: true} //nolint:exhaustruct
+	pool := newTestEnclavePool(t, backend_interface.NewMockKurtosisBackend(t), template, creator)
+
+	require.Eventually(t, func() bool { return creator.getNumCalls() == 3 }, testPoolWaitFor, testPoolWaitTick)
+	// the second fill signal of the pool size, and the ones after, don't create enclaves anymore
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, 3, creator.getNumCalls())
+	require.Empty(t, pool.idleEnclaveQueues[testPoolTemplateName].idleEnclavesChan)
+
+	enclaveInfo, err := pool.GetEnclave(context.Background(), testPoolEnclaveName, testPoolTemplateName, testPoolEngineVersion, "", defaultApiContainerLogLevel, false, nil)
+	require.NoError(t, err)
+	require.Nil(t, enclaveInfo)
+}
+
+func TestGetFillRetryBackoff(t *testing.T) {
+	require.Equal(t, 10*time.Second, getFillRetryBackoff(10*time.Second, 1))
+	require.Equal(t, 20*time.Second, getFillRetryBackoff(10*time.Second, 2))
+	require.Equal(t, 80*time.Second, getFillRetryBackoff(10*time.Second, 4))
+	require.Equal(t, fillRetryMaxBackoff, getFillRetryBackoff(10*time.Second, 10))
+}
+
+func newTestEnclavePool(
+	t *testing.T,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	template *args.EnclavePoolTemplateConfig,
+	creator *testIdleEnclaveCreator,
+) *EnclavePool {
+	ctx, cancelCtxFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelCtxFunc)
+	pool := &EnclavePool{ //nolint:exhaustruct
+		kurtosisBackend: kurtosisBackend,
+		idleEnclaveQueues: map[string]*idleEnclaveQueue{
+			template.Name: newIdleEnclaveQueue(template, template.Size),
+		},
+		createIdleEnclave:       creator.create,
+		fillRetryInitialBackoff: time.Millisecond,
+		engineVersion:           testPoolEngineVersion,
+		cancelSubRoutineCtxFunc: cancelCtxFunc,
+	}
+	pool.start(ctx)
+	return pool
+}
@@ -0,0 +1,223 @@
+package enclave_manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	enclaveReaperInterval = 30 * time.Second
+
+	// How long before the expiry of an enclave the expiring-soon event gets emitted
+	enclaveExpiryWarningWindow = 5 * time.Minute
+
+	getEnclaveActivityTimeout = 10 * time.Second
+)
+
+type EnclaveExpiryEventType string
+
+const (
+	// The enclave will be reaped in less than the expiry warning window, unless it gets extended or used
+	EnclaveExpiryEventType_EXPIRING_SOON EnclaveExpiryEventType = "EXPIRING_SOON"
+
+	// The enclave has expired and is about to be stopped or destroyed
+	EnclaveExpiryEventType_REAPING EnclaveExpiryEventType = "REAPING"
+)
+
+type EnclaveExpiryEvent struct {
+	Type EnclaveExpiryEventType
+
+	EnclaveUuid string
+
+	EnclaveName string
+
+	ExpiryTime time.Time
+
+	Action types.EnclaveExpiryAction
+}
+
+type enclaveReapDecision int
+
+const (
+	enclaveReapDecision_NOTHING enclaveReapDecision = iota
+	enclaveReapDecision_WARN
+	enclaveReapDecision_REAP
+)
+
+// AddEnclaveExpiryEventHandler registers a handler which gets called by the enclave reaper before an enclave expires,
+// and right before it's reaped
+func (manager *EnclaveManager) AddEnclaveExpiryEventHandler(handler func(event *EnclaveExpiryEvent)) {
+	manager.enclaveExpiryEventHandlersMutex.Lock()
+	defer manager.enclaveExpiryEventHandlersMutex.Unlock()
+	manager.enclaveExpiryEventHandlers = append(manager.enclaveExpiryEventHandlers, handler)
+}
+
+// RunEnclaveReaper stops or destroys the expired enclaves periodically, until the context gets cancelled
+func (manager *EnclaveManager) RunEnclaveReaper(ctx context.Context) {
+	ticker := time.NewTicker(enclaveReaperInterval)
+	defer ticker.Stop()
+
+	// Enclaves which were last warned about, with the expiry time they were warned about
+	warnedExpiryTimes := map[enclave.EnclaveUUID]time.Time{}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := manager.reapExpiredEnclaves(ctx, warnedExpiryTimes); err != nil {
+				logrus.Errorf("An error occurred reaping the expired enclaves; the reaper will try again in %v. Error:\n%v", enclaveReaperInterval, err)
+			}
+		}
+	}
+}
+
+func (manager *EnclaveManager) reapExpiredEnclaves(ctx context.Context, warnedExpiryTimes map[enclave.EnclaveUUID]time.Time) error {
+	expiries := manager.expiryRegistry.getAll()
+	if len(expiries) == 0 {
+		return nil
+	}
+
+	enclaves, err := manager.GetEnclaves(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+
+	for enclaveUuid, expiry := range expiries {
+		enclaveInfo, found := enclaves[string(enclaveUuid)]
+		if !found {
+			// The enclave got destroyed some other way
+			if err := manager.expiryRegistry.remove(enclaveUuid); err != nil {
+				return stacktrace.Propagate(err, "An error occurred removing the expiry of enclave '%v', which doesn't exist anymore", enclaveUuid)
+			}
+			delete(warnedExpiryTimes, enclaveUuid)
+			continue
+		}
+
+		if expiry.IdleTimeout > 0 && enclaveInfo.ApiContainerStatus == types.ContainerStatus_RUNNING {
+			lastActivityTime, err := getEnclaveLastActivityTime(ctx, manager.enclaveCreator, enclaveInfo)
+			if err != nil {
+				// The last activity time known by the engine is used instead, as an unresponsive enclave may be idle too
+				logrus.Warnf("An error occurred getting the last activity time of enclave '%v'; the last known one is used instead. Error:\n%v", enclaveInfo.Name, err)
+			} else {
+				if err := manager.expiryRegistry.recordActivity(enclaveUuid, lastActivityTime); err != nil {
+					return stacktrace.Propagate(err, "An error occurred recording the last activity time of enclave '%v'", enclaveUuid)
+				}
+				if lastActivityTime.After(expiry.LastActivityTime) {
+					expiry.LastActivityTime = lastActivityTime
+				}
+			}
+		}
+
+		expiryTime := expiry.getExpiryTime()
+		lastWarnedExpiryTime, wasWarned := warnedExpiryTimes[enclaveUuid]
+		switch getEnclaveReapDecision(expiryTime, time.Now(), wasWarned && lastWarnedExpiryTime.Equal(expiryTime)) {
+		case enclaveReapDecision_NOTHING:
+			continue
+		case enclaveReapDecision_WARN:
+			warnedExpiryTimes[enclaveUuid] = expiryTime
+			manager.emitEnclaveExpiryEvent(EnclaveExpiryEventType_EXPIRING_SOON, enclaveInfo, expiryTime, expiry.Action)
+		case enclaveReapDecision_REAP:
+			manager.emitEnclaveExpiryEvent(EnclaveExpiryEventType_REAPING, enclaveInfo, expiryTime, expiry.Action)
+			if err := manager.reapEnclave(ctx, enclaveUuid, expiry.Action); err != nil {
+				// The other expired enclaves are still reaped
+				logrus.Errorf("An error occurred reaping expired enclave '%v':\n%v", enclaveInfo.Name, err)
+				continue
+			}
+			delete(warnedExpiryTimes, enclaveUuid)
+		}
+	}
+	return nil
+}
+
+func (manager *EnclaveManager) reapEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, action types.EnclaveExpiryAction) error {
+	switch action {
+	case types.EnclaveExpiryAction_STOP:
+		if err := manager.StopEnclave(ctx, string(enclaveUuid)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred stopping expired enclave '%v'", enclaveUuid)
+		}
+		// A stopped enclave doesn't expire again, even if it gets started
+		if err := manager.expiryRegistry.remove(enclaveUuid); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing the expiry of stopped enclave '%v'", enclaveUuid)
+		}
+	case types.EnclaveExpiryAction_DESTROY:
+		// Destroying the enclave removes its expiry as well
+		if err := manager.DestroyEnclave(ctx, string(enclaveUuid)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred destroying expired enclave '%v'", enclaveUuid)
+		}
+	default:
+		return stacktrace.NewError("Unrecognized enclave expiry action '%v'; this is a bug in Kurtosis", action)
+	}
+	return nil
+}
+
+func (manager *EnclaveManager) emitEnclaveExpiryEvent(
+	eventType EnclaveExpiryEventType,
+	enclaveInfo *types.EnclaveInfo,
+	expiryTime time.Time,
+	action types.EnclaveExpiryAction,
+) {
+	event := &EnclaveExpiryEvent{
+		Type:        eventType,
+		EnclaveUuid: enclaveInfo.EnclaveUuid,
+		EnclaveName: enclaveInfo.Name,
+		ExpiryTime:  expiryTime,
+		Action:      action,
+	}
+
+	manager.enclaveExpiryEventHandlersMutex.Lock()
+	defer manager.enclaveExpiryEventHandlersMutex.Unlock()
+	for _, handler := range manager.enclaveExpiryEventHandlers {
+		handler(event)
+	}
+}
+
+// getEnclaveReapDecision decides what to do with an enclave expiring at the given time; an enclave about to expire is
+// only warned about once per expiry time
+func getEnclaveReapDecision(expiryTime time.Time, now time.Time, wasWarnedAboutExpiryTime bool) enclaveReapDecision {
+	if !now.Before(expiryTime) {
+		return enclaveReapDecision_REAP
+	}
+	if !wasWarnedAboutExpiryTime && !now.Before(expiryTime.Add(-enclaveExpiryWarningWindow)) {
+		return enclaveReapDecision_WARN
+	}
+	return enclaveReapDecision_NOTHING
+}
+
+func getEnclaveLastActivityTime(ctx context.Context, enclaveCreator *EnclaveCreator, enclaveInfo *types.EnclaveInfo) (time.Time, error) {
+	apiContainerClient, closeApiContainerClient, err := enclaveCreator.getApiContainerClient(enclaveInfo)
+	if err != nil {
+		return time.Time{}, stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v'", enclaveInfo.Name)
+	}
+	defer closeApiContainerClient()
+
+	getEnclaveActivityCtx, cancel := context.WithTimeout(ctx, getEnclaveActivityTimeout)
+	defer cancel()
+	response, err := apiContainerClient.GetEnclaveActivity(getEnclaveActivityCtx, &emptypb.Empty{})
+	if err != nil {
+		return time.Time{}, stacktrace.Propagate(err, "An error occurred getting the activity of the API container of enclave '%v'", enclaveInfo.Name)
+	}
+	return response.GetLastActivityTime().AsTime(), nil
+}
+
+// LogEnclaveExpiryEvent is an expiry event handler which logs the events
+func LogEnclaveExpiryEvent(event *EnclaveExpiryEvent) {
+	switch event.Type {
+	case EnclaveExpiryEventType_EXPIRING_SOON:
+		logrus.Warnf("Enclave '%v' expires at %v and will be %v then, unless it gets extended or used", event.EnclaveName, event.ExpiryTime.Format(time.RFC3339), getEnclaveExpiryActionParticiple(event.Action))
+	case EnclaveExpiryEventType_REAPING:
+		logrus.Infof("Enclave '%v' expired at %v and is being %v", event.EnclaveName, event.ExpiryTime.Format(time.RFC3339), getEnclaveExpiryActionParticiple(event.Action))
+	}
+}
+
+func getEnclaveExpiryActionParticiple(action types.EnclaveExpiryAction) string {
+	if action == types.EnclaveExpiryAction_STOP {
+		return "stopped"
+	}
+	return "destroyed"
+}
@@ -0,0 +1,20 @@
+package enclave_manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEnclaveReapDecision(t *testing.T) {
+	expiryTime := testNow.Add(time.Hour)
+	require.Equal(t, enclaveReapDecision_NOTHING, getEnclaveReapDecision(expiryTime, testNow, false))
+
+	aboutToExpire := expiryTime.Add(-time.Minute)
+	require.Equal(t, enclaveReapDecision_WARN, getEnclaveReapDecision(expiryTime, aboutToExpire, false))
+	require.Equal(t, enclaveReapDecision_NOTHING, getEnclaveReapDecision(expiryTime, aboutToExpire, true))
+
+	require.Equal(t, enclaveReapDecision_REAP, getEnclaveReapDecision(expiryTime, expiryTime, true))
+	require.Equal(t, enclaveReapDecision_REAP, getEnclaveReapDecision(expiryTime, expiryTime.Add(time.Minute), false))
+}
@@ -0,0 +1,359 @@
+package enclave_manager
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	apicArgs "github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	unlimitedResourceQuota = 0
+
+	getEnclaveResourceUsageTimeout = 10 * time.Second
+
+	// The enclaves created while the engine APIs are open to anyone have no owner
+	noEnclaveOwner = ""
+
+	// An enclave being created has no UUID yet, so none of the existing enclaves gets mistaken for it
+	newEnclaveUuid = ""
+)
+
+// EnclaveOwnerGetter returns the name of the principal who created the enclave, or empty if it isn't known
+type EnclaveOwnerGetter func(enclaveUuid enclave.EnclaveUUID) string
+
+// ResourceQuotaUsage is a resource quota set on the engine with the enclaves it applies to
+type ResourceQuotaUsage struct {
+	Config *args.ResourceQuotaConfig
+
+	EnclaveUsages []*EnclaveResourceUsage
+}
+
+type EnclaveResourceUsage struct {
+	EnclaveUuid string
+
+	EnclaveName string
+
+	// Nil if the enclave is stopped or its API container couldn't be reached
+	Usage *kurtosis_core_rpc_api_bindings.GetEnclaveResourceUsageResponse
+}
+
+type resourceQuota struct {
+	config *args.ResourceQuotaConfig
+
+	// Nil if the quota applies to the enclaves of a user
+	labelSelector *EnclaveLabelSelector
+}
+
+func newResourceQuotas(resourceQuotaConfigs []*args.ResourceQuotaConfig) ([]*resourceQuota, error) {
+	resourceQuotas := []*resourceQuota{}
+	quotaNames := map[string]bool{}
+	for _, config := range resourceQuotaConfigs {
+		if config.Name == "" {
+			return nil, stacktrace.NewError("A resource quota has no name; every resource quota needs one")
+		}
+		if quotaNames[config.Name] {
+			return nil, stacktrace.NewError("Resource quota name '%v' is used more than once", config.Name)
+		}
+		quotaNames[config.Name] = true
+
+		if (config.User == "") == (config.LabelSelector == "") {
+			return nil, stacktrace.NewError("Resource quota '%v' must apply to either a user or a label selector, and not both", config.Name)
+		}
+		var labelSelector *EnclaveLabelSelector
+		if config.LabelSelector != "" {
+			var err error
+			labelSelector, err = ParseEnclaveLabelSelector(config.LabelSelector)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred parsing the label selector '%v' of resource quota '%v'", config.LabelSelector, config.Name)
+			}
+		}
+		if config.MaxEnclaves == unlimitedResourceQuota && hasPerEnclaveLimit(config) && !hasTotalLimit(config) {
+			logrus.Warnf("Resource quota '%v' limits what each of its enclaves takes but neither how many enclaves there are nor what they take together, so the latter is unlimited; set its max enclaves or its total limits to bound it", config.Name)
+		}
+		resourceQuotas = append(resourceQuotas, &resourceQuota{
+			config:        config,
+			labelSelector: labelSelector,
+		})
+	}
+	return resourceQuotas, nil
+}
+
+func hasPerEnclaveLimit(config *args.ResourceQuotaConfig) bool {
+	return config.MaxServicesPerEnclave != unlimitedResourceQuota ||
+		config.MaxCpuMillicoresPerEnclave != unlimitedResourceQuota ||
+		config.MaxMemoryMegabytesPerEnclave != unlimitedResourceQuota ||
+		config.MaxFilesArtifactsMegabytesPerEnclave != unlimitedResourceQuota
+}
+
+// hasTotalLimit returns true if the quota limits what all its enclaves take together, other than their number
+func hasTotalLimit(config *args.ResourceQuotaConfig) bool {
+	return config.MaxServices != unlimitedResourceQuota ||
+		config.MaxCpuMillicores != unlimitedResourceQuota ||
+		config.MaxMemoryMegabytes != unlimitedResourceQuota
+}
+
+func (quota *resourceQuota) appliesTo(enclaveOwner string, enclaveLabels map[string]string) bool {
+	if quota.labelSelector != nil {
+		return quota.labelSelector.Matches(enclaveLabels)
+	}
+	return enclaveOwner != noEnclaveOwner && enclaveOwner == quota.config.User
+}
+
+// SetEnclaveOwnerGetter tells the enclave manager who created the enclaves, so that the resource quotas of the users
+// apply to their enclaves
+func (manager *EnclaveManager) SetEnclaveOwnerGetter(enclaveOwnerGetter EnclaveOwnerGetter) {
+	manager.enclaveOwnerGetter = enclaveOwnerGetter
+}
+
+// GetResourceQuotaUsage returns every resource quota set on the engine, with what the enclaves it applies to take
+func (manager *EnclaveManager) GetResourceQuotaUsage(ctx context.Context) ([]*ResourceQuotaUsage, error) {
+	if len(manager.resourceQuotas) == 0 {
+		return []*ResourceQuotaUsage{}, nil
+	}
+
+	enclaves, err := manager.GetEnclaves(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+	enclaveInfos := []*types.EnclaveInfo{}
+	for _, enclaveInfo := range enclaves {
+		enclaveInfos = append(enclaveInfos, enclaveInfo)
+	}
+
+	enclaveUsagesByQuota := manager.getEnclaveUsagesByQuota(ctx, enclaveInfos, manager.resourceQuotas)
+	resourceQuotaUsages := []*ResourceQuotaUsage{}
+	for _, quota := range manager.resourceQuotas {
+		resourceQuotaUsages = append(resourceQuotaUsages, &ResourceQuotaUsage{
+			Config:        quota.config,
+			EnclaveUsages: enclaveUsagesByQuota[quota],
+		})
+	}
+	return resourceQuotaUsages, nil
+}
+
+// getEnclaveUsagesByQuota returns what the enclaves each quota applies to take, sorted by enclave name. Each enclave
+// is only asked once, even if several quotas apply to it
+func (manager *EnclaveManager) getEnclaveUsagesByQuota(ctx context.Context, enclaveInfos []*types.EnclaveInfo, quotas []*resourceQuota) map[*resourceQuota][]*EnclaveResourceUsage {
+	sortedEnclaves := append([]*types.EnclaveInfo{}, enclaveInfos...)
+	sort.Slice(sortedEnclaves, func(i, j int) bool {
+		return sortedEnclaves[i].Name < sortedEnclaves[j].Name
+	})
+
+	enclaveUsages := map[string]*EnclaveResourceUsage{}
+	enclaveUsagesByQuota := map[*resourceQuota][]*EnclaveResourceUsage{}
+	for _, quota := range quotas {
+		enclaveUsagesByQuota[quota] = []*EnclaveResourceUsage{}
+		for _, enclaveInfo := range sortedEnclaves {
+			if !quota.appliesTo(manager.enclaveOwnerGetter(enclave.EnclaveUUID(enclaveInfo.EnclaveUuid)), enclaveInfo.Labels) {
+				continue
+			}
+			enclaveUsage, found := enclaveUsages[enclaveInfo.EnclaveUuid]
+			if !found {
+				enclaveUsage = getEnclaveResourceUsage(ctx, manager.enclaveCreator, enclaveInfo)
+				enclaveUsages[enclaveInfo.EnclaveUuid] = enclaveUsage
+			}
+			enclaveUsagesByQuota[quota] = append(enclaveUsagesByQuota[quota], enclaveUsage)
+		}
+	}
+	return enclaveUsagesByQuota
+}
+
+func (manager *EnclaveManager) getApplicableResourceQuotas(enclaveOwner string, enclaveLabels map[string]string) []*resourceQuota {
+	applicableQuotas := []*resourceQuota{}
+	for _, quota := range manager.resourceQuotas {
+		if quota.appliesTo(enclaveOwner, enclaveLabels) {
+			applicableQuotas = append(applicableQuotas, quota)
+		}
+	}
+	return applicableQuotas
+}
+
+// this should be called from a thread safe context
+func (manager *EnclaveManager) checkMaxEnclavesWithoutMutex(ctx context.Context, enclaveName string, applicableQuotas []*resourceQuota) error {
+	isMaxEnclavesSet := false
+	for _, quota := range applicableQuotas {
+		if quota.config.MaxEnclaves != unlimitedResourceQuota {
+			isMaxEnclavesSet = true
+		}
+	}
+	if !isMaxEnclavesSet {
+		return nil
+	}
+
+	enclaves, err := manager.getEnclavesWithoutMutex(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the existing enclaves")
+	}
+	for _, quota := range applicableQuotas {
+		if quota.config.MaxEnclaves == unlimitedResourceQuota {
+			continue
+		}
+		numEnclaves := uint32(0)
+		for enclaveUuid, enclaveInfo := range enclaves {
+			if quota.appliesTo(manager.enclaveOwnerGetter(enclaveUuid), enclaveInfo.Labels) {
+				numEnclaves++
+			}
+		}
+		if numEnclaves >= quota.config.MaxEnclaves {
+			return stacktrace.NewError("Enclave '%v' can't be created because resource quota '%v' allows '%v' enclaves and '%v' already exist; destroy some of them first", enclaveName, quota.config.Name, quota.config.MaxEnclaves, numEnclaves)
+		}
+	}
+	return nil
+}
+
+// getNewEnclaveResourceQuotaBudgetWithoutMutex rejects the enclave if the existing enclaves of one of its quotas
+// already take everything a total limit of the quota allows, and otherwise returns what the totals leave to it
+// this should be called from a thread safe context
+func (manager *EnclaveManager) getNewEnclaveResourceQuotaBudgetWithoutMutex(ctx context.Context, enclaveName string, applicableQuotas []*resourceQuota) (*apicArgs.EnclaveResourceQuotaBudget, error) {
+	quotasWithTotalLimits := []*resourceQuota{}
+	for _, quota := range applicableQuotas {
+		if hasTotalLimit(quota.config) {
+			quotasWithTotalLimits = append(quotasWithTotalLimits, quota)
+		}
+	}
+	if len(quotasWithTotalLimits) == 0 {
+		return nil, nil
+	}
+
+	enclaves, err := manager.getEnclavesWithoutMutex(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the existing enclaves")
+	}
+	enclaveInfos := []*types.EnclaveInfo{}
+	for _, enclaveInfo := range enclaves {
+		enclaveInfos = append(enclaveInfos, enclaveInfo)
+	}
+	enclaveUsagesByQuota := manager.getEnclaveUsagesByQuota(ctx, enclaveInfos, quotasWithTotalLimits)
+	for _, quota := range quotasWithTotalLimits {
+		quotaBudget := getEnclaveResourceQuotaBudget(newEnclaveUuid, []*resourceQuota{quota}, enclaveUsagesByQuota)
+		if isResourceQuotaBudgetExhausted(quotaBudget) {
+			return nil, stacktrace.NewError("Enclave '%v' can't be created because the running enclaves of resource quota '%v' already take everything one of its total limits allows; stop or destroy some of them first", enclaveName, quota.config.Name)
+		}
+	}
+	return getEnclaveResourceQuotaBudget(newEnclaveUuid, quotasWithTotalLimits, enclaveUsagesByQuota), nil
+}
+
+// getEnclaveResourceQuotaBudget returns what the total limits of the quotas leave to the enclave once what the other
+// enclaves of each quota reserve is taken out, or nil if none of the quotas has a total limit. The enclaves whose usage
+// is unknown, e.g. because they're stopped, reserve nothing
+func getEnclaveResourceQuotaBudget(enclaveUuid string, applicableQuotas []*resourceQuota, enclaveUsagesByQuota map[*resourceQuota][]*EnclaveResourceUsage) *apicArgs.EnclaveResourceQuotaBudget {
+	var budget *apicArgs.EnclaveResourceQuotaBudget
+	for _, quota := range applicableQuotas {
+		if !hasTotalLimit(quota.config) {
+			continue
+		}
+		if budget == nil {
+			budget = &apicArgs.EnclaveResourceQuotaBudget{
+				MaxServices:        nil,
+				MaxCpuMillicores:   nil,
+				MaxMemoryMegabytes: nil,
+			}
+		}
+		otherServiceCount := uint32(0)
+		otherCpuMillicores := uint64(0)
+		otherMemoryMegabytes := uint64(0)
+		for _, enclaveUsage := range enclaveUsagesByQuota[quota] {
+			if enclaveUsage.EnclaveUuid == enclaveUuid || enclaveUsage.Usage == nil {
+				continue
+			}
+			otherServiceCount += enclaveUsage.Usage.GetServiceCount()
+			otherCpuMillicores += enclaveUsage.Usage.GetReservedCpuMillicores()
+			otherMemoryMegabytes += enclaveUsage.Usage.GetReservedMemoryMegabytes()
+		}
+		budget.MaxServices = getMostRestrictiveBudget(budget.MaxServices, quota.config.MaxServices, otherServiceCount)
+		budget.MaxCpuMillicores = getMostRestrictiveBudget(budget.MaxCpuMillicores, quota.config.MaxCpuMillicores, otherCpuMillicores)
+		budget.MaxMemoryMegabytes = getMostRestrictiveBudget(budget.MaxMemoryMegabytes, quota.config.MaxMemoryMegabytes, otherMemoryMegabytes)
+	}
+	return budget
+}
+
+// getMostRestrictiveBudget returns the smallest of the budget and of what the total limit leaves once the other
+// enclaves took their part; a nil budget is unlimited
+func getMostRestrictiveBudget[T uint32 | uint64](budget *T, totalLimit T, takenByOtherEnclaves T) *T {
+	if totalLimit == unlimitedResourceQuota {
+		return budget
+	}
+	left := T(0)
+	if takenByOtherEnclaves < totalLimit {
+		left = totalLimit - takenByOtherEnclaves
+	}
+	if budget != nil && *budget < left {
+		return budget
+	}
+	return &left
+}
+
+func isResourceQuotaBudgetExhausted(budget *apicArgs.EnclaveResourceQuotaBudget) bool {
+	if budget == nil {
+		return false
+	}
+	return (budget.MaxServices != nil && *budget.MaxServices == 0) ||
+		(budget.MaxCpuMillicores != nil && *budget.MaxCpuMillicores == 0) ||
+		(budget.MaxMemoryMegabytes != nil && *budget.MaxMemoryMegabytes == 0)
+}
+
+// getEnclaveResourceQuota merges the quotas applying to an enclave into the quota enforced by its API container,
+// which keeps the most restrictive of their limits. Nil if no quota applies
+func getEnclaveResourceQuota(applicableQuotas []*resourceQuota) *apicArgs.EnclaveResourceQuota {
+	if len(applicableQuotas) == 0 {
+		return nil
+	}
+	enclaveResourceQuota := &apicArgs.EnclaveResourceQuota{
+		MaxServices:                unlimitedResourceQuota,
+		MaxCpuMillicores:           unlimitedResourceQuota,
+		MaxMemoryMegabytes:         unlimitedResourceQuota,
+		MaxFilesArtifactsMegabytes: unlimitedResourceQuota,
+		Budget:                     nil,
+	}
+	for _, quota := range applicableQuotas {
+		enclaveResourceQuota.MaxServices = getMostRestrictiveLimit(enclaveResourceQuota.MaxServices, quota.config.MaxServicesPerEnclave)
+		enclaveResourceQuota.MaxCpuMillicores = getMostRestrictiveLimit(enclaveResourceQuota.MaxCpuMillicores, quota.config.MaxCpuMillicoresPerEnclave)
+		enclaveResourceQuota.MaxMemoryMegabytes = getMostRestrictiveLimit(enclaveResourceQuota.MaxMemoryMegabytes, quota.config.MaxMemoryMegabytesPerEnclave)
+		enclaveResourceQuota.MaxFilesArtifactsMegabytes = getMostRestrictiveLimit(enclaveResourceQuota.MaxFilesArtifactsMegabytes, quota.config.MaxFilesArtifactsMegabytesPerEnclave)
+	}
+	return enclaveResourceQuota
+}
+
+func getMostRestrictiveLimit[T uint32 | uint64](limit T, otherLimit T) T {
+	if limit == unlimitedResourceQuota || (otherLimit != unlimitedResourceQuota && otherLimit < limit) {
+		return otherLimit
+	}
+	return limit
+}
+
+func getEnclaveResourceUsage(ctx context.Context, enclaveCreator *EnclaveCreator, enclaveInfo *types.EnclaveInfo) *EnclaveResourceUsage {
+	enclaveUsage := &EnclaveResourceUsage{
+		EnclaveUuid: enclaveInfo.EnclaveUuid,
+		EnclaveName: enclaveInfo.Name,
+		Usage:       nil,
+	}
+	if enclaveInfo.ApiContainerStatus != types.ContainerStatus_RUNNING || enclaveInfo.ApiContainerInfo == nil {
+		return enclaveUsage
+	}
+
+	apiContainerClient, closeApiContainerClient, err := enclaveCreator.getApiContainerClient(enclaveInfo)
+	if err != nil {
+		logrus.Warnf("An error occurred connecting to the API container of enclave '%v'; its resource usage is left unknown. Error:\n%v", enclaveInfo.Name, err)
+		return enclaveUsage
+	}
+	defer closeApiContainerClient()
+
+	getEnclaveResourceUsageCtx, cancel := context.WithTimeout(ctx, getEnclaveResourceUsageTimeout)
+	defer cancel()
+	usage, err := apiContainerClient.GetEnclaveResourceUsage(getEnclaveResourceUsageCtx, &emptypb.Empty{})
+	if err != nil {
+		logrus.Warnf("An error occurred getting the resource usage of enclave '%v' from its API container; it's left unknown. Error:\n%v", enclaveInfo.Name, err)
+		return enclaveUsage
+	}
+	enclaveUsage.Usage = usage
+	return enclaveUsage
+}
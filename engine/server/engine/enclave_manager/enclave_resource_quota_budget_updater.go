@@ -0,0 +1,95 @@
+package enclave_manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	apicArgs "github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	resourceQuotaBudgetUpdaterInterval = 3 * time.Second
+
+	setEnclaveResourceQuotaBudgetTimeout = 10 * time.Second
+)
+
+// RunResourceQuotaBudgetUpdater hands every running enclave what the total limits of its resource quotas leave to it,
+// as the other enclaves of the quotas add and remove services, until the context gets cancelled. It returns right
+// away if no quota has a total limit.
+func (manager *EnclaveManager) RunResourceQuotaBudgetUpdater(ctx context.Context) {
+	quotasWithTotalLimits := []*resourceQuota{}
+	for _, quota := range manager.resourceQuotas {
+		if hasTotalLimit(quota.config) {
+			quotasWithTotalLimits = append(quotasWithTotalLimits, quota)
+		}
+	}
+	if len(quotasWithTotalLimits) == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(resourceQuotaBudgetUpdaterInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := manager.updateResourceQuotaBudgets(ctx, quotasWithTotalLimits); err != nil {
+				logrus.Errorf("An error occurred updating what the resource quotas leave to the enclaves; the updater will try again in %v. Error:\n%v", resourceQuotaBudgetUpdaterInterval, err)
+			}
+		}
+	}
+}
+
+func (manager *EnclaveManager) updateResourceQuotaBudgets(ctx context.Context, quotasWithTotalLimits []*resourceQuota) error {
+	enclaves, err := manager.GetEnclaves(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+	enclaveInfos := []*types.EnclaveInfo{}
+	for _, enclaveInfo := range enclaves {
+		enclaveInfos = append(enclaveInfos, enclaveInfo)
+	}
+
+	enclaveUsagesByQuota := manager.getEnclaveUsagesByQuota(ctx, enclaveInfos, quotasWithTotalLimits)
+	for _, enclaveInfo := range enclaveInfos {
+		if enclaveInfo.ApiContainerStatus != types.ContainerStatus_RUNNING || enclaveInfo.ApiContainerInfo == nil {
+			continue
+		}
+		enclaveOwner := manager.enclaveOwnerGetter(enclave.EnclaveUUID(enclaveInfo.EnclaveUuid))
+		applicableQuotas := manager.getApplicableResourceQuotas(enclaveOwner, enclaveInfo.Labels)
+		budget := getEnclaveResourceQuotaBudget(enclaveInfo.EnclaveUuid, applicableQuotas, enclaveUsagesByQuota)
+		if budget == nil {
+			continue
+		}
+		if err := manager.setEnclaveResourceQuotaBudget(ctx, enclaveInfo, budget); err != nil {
+			// The budgets of the other enclaves are still updated
+			logrus.Debugf("An error occurred updating what the resource quotas leave to enclave '%v'; it'll be updated next time. Error:\n%v", enclaveInfo.Name, err)
+		}
+	}
+	return nil
+}
+
+func (manager *EnclaveManager) setEnclaveResourceQuotaBudget(ctx context.Context, enclaveInfo *types.EnclaveInfo, budget *apicArgs.EnclaveResourceQuotaBudget) error {
+	apiContainerClient, closeApiContainerClient, err := manager.enclaveCreator.getApiContainerClient(enclaveInfo)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v'", enclaveInfo.Name)
+	}
+	defer closeApiContainerClient()
+
+	setBudgetCtx, cancel := context.WithTimeout(ctx, setEnclaveResourceQuotaBudgetTimeout)
+	defer cancel()
+	if _, err := apiContainerClient.SetEnclaveResourceQuotaBudget(setBudgetCtx, &kurtosis_core_rpc_api_bindings.EnclaveResourceQuotaBudget{
+		MaxServices:        budget.MaxServices,
+		MaxCpuMillicores:   budget.MaxCpuMillicores,
+		MaxMemoryMegabytes: budget.MaxMemoryMegabytes,
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting what the resource quotas leave to enclave '%v'", enclaveInfo.Name)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package enclave_manager
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	apicArgs "github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResourceQuotas_InvalidConfigs(t *testing.T) {
+	invalidConfigs := map[string][]*args.ResourceQuotaConfig{
+		"no name":                    {newTestResourceQuotaConfig("", "alice", "")},
+		"duplicated name":            {newTestResourceQuotaConfig("quota", "alice", ""), newTestResourceQuotaConfig("quota", "bob", "")},
+		"no user nor selector":       {newTestResourceQuotaConfig("quota", "", "")},
+		"both user and selector":     {newTestResourceQuotaConfig("quota", "alice", "team=infra")},
+		"invalid label selector":     {newTestResourceQuotaConfig("quota", "", "team=infra=payments")},
+		"label selector with no key": {newTestResourceQuotaConfig("quota", "", "!=infra")},
+	}
+	for description, configs := range invalidConfigs {
+		_, err := newResourceQuotas(configs)
+		require.Error(t, err, "Expected the configs with '%v' to be rejected", description)
+	}
+
+	resourceQuotas, err := newResourceQuotas(nil)
+	require.NoError(t, err)
+	require.Empty(t, resourceQuotas)
+}
+
+func TestResourceQuotaAppliesTo(t *testing.T) {
+	resourceQuotas, err := newResourceQuotas([]*args.ResourceQuotaConfig{
+		newTestResourceQuotaConfig("alice", "alice", ""),
+		newTestResourceQuotaConfig("infra", "", "team=infra"),
+	})
+	require.NoError(t, err)
+	userQuota := resourceQuotas[0]
+	labelSelectorQuota := resourceQuotas[1]
+
+	require.True(t, userQuota.appliesTo("alice", nil))
+	require.False(t, userQuota.appliesTo("bob", testEnclaveLabels))
+	require.False(t, userQuota.appliesTo(noEnclaveOwner, testEnclaveLabels))
+
+	require.True(t, labelSelectorQuota.appliesTo(noEnclaveOwner, testEnclaveLabels))
+	require.False(t, labelSelectorQuota.appliesTo("alice", map[string]string{"team": "payments"}))
+}
+
+func TestGetEnclaveResourceQuota(t *testing.T) {
+	require.Nil(t, getEnclaveResourceQuota(nil))
+
+	userQuotaConfig := newTestResourceQuotaConfig("alice", "alice", "")
+	userQuotaConfig.MaxServicesPerEnclave = 10
+	userQuotaConfig.MaxCpuMillicoresPerEnclave = 2000
+	labelSelectorQuotaConfig := newTestResourceQuotaConfig("infra", "", "team=infra")
+	labelSelectorQuotaConfig.MaxServicesPerEnclave = 20
+	labelSelectorQuotaConfig.MaxMemoryMegabytesPerEnclave = 4096
+	resourceQuotas, err := newResourceQuotas([]*args.ResourceQuotaConfig{userQuotaConfig, labelSelectorQuotaConfig})
+	require.NoError(t, err)
+
+	// The most restrictive limit wins, and a limit set by only one of the quotas still applies
+	expectedEnclaveResourceQuota := &apicArgs.EnclaveResourceQuota{
+		MaxServices:                10,
+		MaxCpuMillicores:           2000,
+		MaxMemoryMegabytes:         4096,
+		MaxFilesArtifactsMegabytes: unlimitedResourceQuota,
+		Budget:                     nil,
+	}
+	require.Equal(t, expectedEnclaveResourceQuota, getEnclaveResourceQuota(resourceQuotas))
+}
+
+func TestGetEnclaveResourceQuotaBudget(t *testing.T) {
+	userQuotaConfig := newTestResourceQuotaConfig("alice", "alice", "")
+	userQuotaConfig.MaxServices = 10
+	userQuotaConfig.MaxCpuMillicores = 4000
+	labelSelectorQuotaConfig := newTestResourceQuotaConfig("infra", "", "team=infra")
+	labelSelectorQuotaConfig.MaxCpuMillicores = 3000
+	perEnclaveQuotaConfig := newTestResourceQuotaConfig("per-enclave", "", "team=infra")
+	perEnclaveQuotaConfig.MaxCpuMillicoresPerEnclave = 1000
+	resourceQuotas, err := newResourceQuotas([]*args.ResourceQuotaConfig{userQuotaConfig, labelSelectorQuotaConfig, perEnclaveQuotaConfig})
+	require.NoError(t, err)
+	userQuota, labelSelectorQuota, perEnclaveQuota := resourceQuotas[0], resourceQuotas[1], resourceQuotas[2]
+
+	thisEnclaveUsage := newTestEnclaveResourceUsage("this", 3, 500, 256)
+	otherEnclaveUsage := newTestEnclaveResourceUsage("other", 4, 1000, 512)
+	stoppedEnclaveUsage := &EnclaveResourceUsage{EnclaveUuid: "stopped", EnclaveName: "stopped", Usage: nil}
+	enclaveUsagesByQuota := map[*resourceQuota][]*EnclaveResourceUsage{
+		userQuota:          {thisEnclaveUsage, otherEnclaveUsage, stoppedEnclaveUsage},
+		labelSelectorQuota: {thisEnclaveUsage, otherEnclaveUsage, newTestEnclaveResourceUsage("infra", 1, 2500, 0)},
+	}
+
+	// What the enclave reserves itself isn't taken out, and the most restrictive of what the quotas leave wins
+	budget := getEnclaveResourceQuotaBudget("this", resourceQuotas, enclaveUsagesByQuota)
+	require.Equal(t, uint32(6), *budget.MaxServices)
+	require.Equal(t, uint64(0), *budget.MaxCpuMillicores)
+	require.Nil(t, budget.MaxMemoryMegabytes)
+	require.True(t, isResourceQuotaBudgetExhausted(budget))
+
+	budget = getEnclaveResourceQuotaBudget("this", []*resourceQuota{userQuota}, enclaveUsagesByQuota)
+	require.Equal(t, uint64(3000), *budget.MaxCpuMillicores)
+	require.False(t, isResourceQuotaBudgetExhausted(budget))
+
+	// A new enclave gets what all the existing enclaves leave
+	budget = getEnclaveResourceQuotaBudget(newEnclaveUuid, []*resourceQuota{userQuota}, enclaveUsagesByQuota)
+	require.Equal(t, uint32(3), *budget.MaxServices)
+	require.Equal(t, uint64(2500), *budget.MaxCpuMillicores)
+
+	require.Nil(t, getEnclaveResourceQuotaBudget("this", []*resourceQuota{perEnclaveQuota}, enclaveUsagesByQuota))
+}
+
+func newTestEnclaveResourceUsage(enclaveUuid string, serviceCount uint32, cpuMillicores uint64, memoryMegabytes uint64) *EnclaveResourceUsage {
+	return &EnclaveResourceUsage{
+		EnclaveUuid: enclaveUuid,
+		EnclaveName: enclaveUuid,
+		Usage: &kurtosis_core_rpc_api_bindings.GetEnclaveResourceUsageResponse{
+			ServiceCount:               serviceCount,
+			ReservedCpuMillicores:      cpuMillicores,
+			ReservedMemoryMegabytes:    memoryMegabytes,
+			FilesArtifactsStorageBytes: 0,
+		},
+	}
+}
+
+func newTestResourceQuotaConfig(name string, user string, labelSelector string) *args.ResourceQuotaConfig {
+	return &args.ResourceQuotaConfig{
+		Name:                                 name,
+		User:                                 user,
+		LabelSelector:                        labelSelector,
+		MaxEnclaves:                          unlimitedResourceQuota,
+		MaxServices:                          unlimitedResourceQuota,
+		MaxCpuMillicores:                     unlimitedResourceQuota,
+		MaxMemoryMegabytes:                   unlimitedResourceQuota,
+		MaxServicesPerEnclave:                unlimitedResourceQuota,
+		MaxCpuMillicoresPerEnclave:           unlimitedResourceQuota,
+		MaxMemoryMegabytesPerEnclave:         unlimitedResourceQuota,
+		MaxFilesArtifactsMegabytesPerEnclave: unlimitedResourceQuota,
+	}
+}
@@ -0,0 +1,42 @@
+package engine_auth
+
+import (
+	"crypto/rand"
+	"os"
+	"path"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	apiContainerAccessSecretFilename = "api-container-access-secret"
+
+	apiContainerAccessSecretNumBytes  = 32
+	apiContainerAccessSecretFilePerms = 0600
+)
+
+// LoadApiContainerAccessSecret returns the secret the access token keys of the API containers are derived from,
+// creating it in the engine data directory the first time so that the API containers launched before an engine restart
+// keep accepting the tokens the engine issues
+func LoadApiContainerAccessSecret(engineDataDirpath string) ([]byte, error) {
+	if err := os.MkdirAll(engineDataDirpath, enclaveOwnersDirPerms); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the engine data directory '%v'", engineDataDirpath)
+	}
+	secretFilepath := path.Join(engineDataDirpath, apiContainerAccessSecretFilename)
+	secret, err := os.ReadFile(secretFilepath)
+	if err == nil && len(secret) == apiContainerAccessSecretNumBytes {
+		return secret, nil
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the API container access secret file '%v'", secretFilepath)
+	}
+
+	secret = make([]byte, apiContainerAccessSecretNumBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the API container access secret")
+	}
+	if err := os.WriteFile(secretFilepath, secret, apiContainerAccessSecretFilePerms); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred writing the API container access secret file '%v'", secretFilepath)
+	}
+	return secret, nil
+}
@@ -0,0 +1,42 @@
+package engine_auth
+
+import (
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_access"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// The tokens are handed out with the enclave info, which the SDKs keep for as long as they talk to the enclave
+	apiContainerAccessTokenValidity = 24 * time.Hour
+)
+
+// GetApiContainerAccessLevel returns what the principal can do through the API container of the enclave: change the
+// enclave if they could manage it through the engine, only inspect it otherwise
+func (authorizer *Authorizer) GetApiContainerAccessLevel(principal *Principal, enclaveUuid enclave.EnclaveUUID) api_container_access.AccessLevel {
+	role := principal.GetRole()
+	if role == Role_Admin {
+		return api_container_access.AccessLevel_Manage
+	}
+	if getRoleRank(role) >= getRoleRank(Role_EnclaveOwner) && authorizer.ownerRegistry.getOwner(enclaveUuid) == principal.GetName() {
+		return api_container_access.AccessLevel_Manage
+	}
+	return api_container_access.AccessLevel_Read
+}
+
+// setApiContainerAccessToken gives the principal a token for the API container of the enclave
+func (engineAuth *EngineAuth) setApiContainerAccessToken(principal *Principal, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) {
+	enclaveUuid := enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid())
+	accessLevel := engineAuth.authorizer.GetApiContainerAccessLevel(principal, enclaveUuid)
+	signer := api_container_access.NewTokenSignerFromSecret(engineAuth.apiContainerAccessSecret, string(enclaveUuid))
+	token, err := signer.IssueToken(principal.GetName(), accessLevel, apiContainerAccessTokenValidity)
+	if err != nil {
+		// The API container rejects the calls without a token, which is what the principal gets
+		logrus.Errorf("An error occurred issuing the token of '%v' for the API container of enclave '%v':\n%v", principal.GetName(), enclaveUuid, err)
+		return
+	}
+	enclaveInfo.ApiContainerAccessToken = token
+}
@@ -0,0 +1,51 @@
+package engine_auth
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_access"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngineAuth_IssuesApiContainerAccessTokensByAccessLevel(t *testing.T) {
+	engineDataDirpath := t.TempDir()
+	authorizer, err := NewAuthorizer(engineDataDirpath, resolveTestEnclaveUuid)
+	require.NoError(t, err)
+	apiContainerAccessSecret, err := LoadApiContainerAccessSecret(engineDataDirpath)
+	require.NoError(t, err)
+	engineAuth := &EngineAuth{ //nolint:exhaustruct
+		authorizer:               authorizer,
+		apiContainerAccessSecret: apiContainerAccessSecret,
+	}
+
+	owner := NewPrincipal("alice", Role_EnclaveOwner)
+	authorizer.RecordEnclaveOwner(owner, testEnclaveUuid)
+	expectedAccessLevels := map[*Principal]api_container_access.AccessLevel{
+		owner:                                  api_container_access.AccessLevel_Manage,
+		NewPrincipal("bob", Role_EnclaveOwner): api_container_access.AccessLevel_Read,
+		NewPrincipal("viewer", Role_ReadOnly):  api_container_access.AccessLevel_Read,
+		NewPrincipal("root", Role_Admin):       api_container_access.AccessLevel_Manage,
+	}
+
+	// The API container of the enclave gets launched with the key derived from the same secret
+	apiContainerSigner, err := api_container_access.NewTokenSignerFromKey(
+		api_container_access.NewTokenSignerFromSecret(apiContainerAccessSecret, string(testEnclaveUuid)).GetHexKey(),
+		string(testEnclaveUuid),
+	)
+	require.NoError(t, err)
+	for principal, expectedAccessLevel := range expectedAccessLevels {
+		enclaveInfo := &kurtosis_engine_rpc_api_bindings.EnclaveInfo{EnclaveUuid: string(testEnclaveUuid)} //nolint:exhaustruct
+		engineAuth.setApiContainerAccessToken(principal, enclaveInfo)
+
+		accessToken, err := apiContainerSigner.VerifyToken(enclaveInfo.GetApiContainerAccessToken())
+		require.NoError(t, err)
+		require.Equal(t, principal.GetName(), accessToken.GetSubject())
+		require.Equal(t, expectedAccessLevel, accessToken.GetAccessLevel(), "Unexpected access level for '%v'", principal.GetName())
+	}
+
+	// The secret survives an engine restart, so do the keys of the API containers
+	reloadedApiContainerAccessSecret, err := LoadApiContainerAccessSecret(engineDataDirpath)
+	require.NoError(t, err)
+	require.Equal(t, apiContainerAccessSecret, reloadedApiContainerAccessSecret)
+}
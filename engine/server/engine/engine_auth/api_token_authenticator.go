@@ -0,0 +1,60 @@
+package engine_auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+type apiToken struct {
+	tokenSha256 []byte
+
+	principal *Principal
+}
+
+// apiTokenAuthenticator authenticates the bearer tokens against the SHA256 of the static API tokens of the config
+type apiTokenAuthenticator struct {
+	tokens []*apiToken
+}
+
+func newApiTokenAuthenticator(apiTokenConfigs []*args.ApiTokenAuthConfig) (*apiTokenAuthenticator, error) {
+	var tokens []*apiToken
+	for _, apiTokenConfig := range apiTokenConfigs {
+		if apiTokenConfig.Name == "" {
+			return nil, stacktrace.NewError("An API token has no name; every API token needs one to identify who uses it")
+		}
+		tokenSha256, err := hex.DecodeString(strings.TrimSpace(apiTokenConfig.TokenSha256))
+		if err != nil || len(tokenSha256) != sha256.Size {
+			return nil, stacktrace.NewError("The SHA256 of API token '%v' isn't a valid hex-encoded SHA256", apiTokenConfig.Name)
+		}
+		role, err := ParseRole(apiTokenConfig.Role)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the role of API token '%v'", apiTokenConfig.Name)
+		}
+		tokens = append(tokens, &apiToken{
+			tokenSha256: tokenSha256,
+			principal:   NewPrincipal(apiTokenConfig.Name, role),
+		})
+	}
+	return &apiTokenAuthenticator{tokens: tokens}, nil
+}
+
+func (authenticator *apiTokenAuthenticator) Authenticate(request *http.Request) (*Principal, error) {
+	bearerToken := getBearerToken(request)
+	if bearerToken == "" {
+		return nil, nil
+	}
+	bearerTokenSha256 := sha256.Sum256([]byte(bearerToken))
+	for _, token := range authenticator.tokens {
+		if subtle.ConstantTimeCompare(bearerTokenSha256[:], token.tokenSha256) == 1 {
+			return token.principal, nil
+		}
+	}
+	// The bearer token may be an OIDC JWT, which is for the next authenticator to decide
+	return nil, nil
+}
@@ -0,0 +1,83 @@
+package engine_auth
+
+import (
+	"crypto/tls"
+	"net/http"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	authorizationHeaderKey = "Authorization"
+	bearerTokenPrefix      = "Bearer "
+)
+
+// Authenticator identifies the caller of a request from the credentials it carries
+type Authenticator interface {
+	// Authenticate returns nil and no error if the request doesn't carry the credentials this authenticator handles,
+	// and an error if it carries them but they aren't valid
+	Authenticate(request *http.Request) (*Principal, error)
+}
+
+// chainAuthenticator tries the authenticators in order, returning the principal of the first that recognizes the
+// credentials of the request
+type chainAuthenticator struct {
+	authenticators []Authenticator
+}
+
+func (chain *chainAuthenticator) Authenticate(request *http.Request) (*Principal, error) {
+	for _, authenticator := range chain.authenticators {
+		principal, err := authenticator.Authenticate(request)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "The credentials of the request are not valid")
+		}
+		if principal != nil {
+			return principal, nil
+		}
+	}
+	return nil, nil
+}
+
+// NewAuthenticatorFromConfig builds the authenticator for the engine auth config, along with the TLS config the engine
+// servers have to serve with, which is nil unless mutual TLS is configured
+func NewAuthenticatorFromConfig(authConfig *args.EngineAuthConfig) (Authenticator, *tls.Config, error) {
+	var authenticators []Authenticator
+	var tlsConfig *tls.Config
+	if authConfig.MutualTls != nil {
+		mutualTlsAuthenticator, mutualTlsConfig, err := newMutualTlsAuthenticator(authConfig.MutualTls)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating the mutual TLS authenticator")
+		}
+		authenticators = append(authenticators, mutualTlsAuthenticator)
+		tlsConfig = mutualTlsConfig
+	}
+	if len(authConfig.ApiTokens) > 0 {
+		apiTokenAuthenticator, err := newApiTokenAuthenticator(authConfig.ApiTokens)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating the API token authenticator")
+		}
+		authenticators = append(authenticators, apiTokenAuthenticator)
+	}
+	if authConfig.Oidc != nil {
+		oidcAuthenticator, err := newOidcAuthenticator(authConfig.Oidc)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating the OIDC authenticator")
+		}
+		authenticators = append(authenticators, oidcAuthenticator)
+	}
+	if len(authenticators) == 0 {
+		return nil, nil, stacktrace.NewError("The engine auth config is set but doesn't configure any of the API tokens, mutual TLS or OIDC authentication")
+	}
+	return &chainAuthenticator{authenticators: authenticators}, tlsConfig, nil
+}
+
+// getBearerToken returns the token of the 'Authorization: Bearer <token>' header, or empty if there's none
+func getBearerToken(request *http.Request) string {
+	authorizationHeader := request.Header.Get(authorizationHeaderKey)
+	if !strings.HasPrefix(authorizationHeader, bearerTokenPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(authorizationHeader, bearerTokenPrefix))
+}
@@ -0,0 +1,203 @@
+package engine_auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testApiToken = "s3cr3t-t0k3n"
+
+	testOidcIssuer   = "https://issuer.example.com"
+	testOidcAudience = "kurtosis-engine"
+	testOidcKeyId    = "test-key"
+
+	testRsaKeyBits = 2048
+)
+
+func TestApiTokenAuthenticator(t *testing.T) {
+	authenticator, _, err := NewAuthenticatorFromConfig(&args.EngineAuthConfig{
+		ApiTokens: []*args.ApiTokenAuthConfig{newTestApiTokenConfig("ci", testApiToken, string(Role_EnclaveOwner))},
+		MutualTls: nil,
+		Oidc:      nil,
+	})
+	require.NoError(t, err)
+
+	principal, err := authenticator.Authenticate(newTestRequest(testApiToken))
+	require.NoError(t, err)
+	require.Equal(t, "ci", principal.GetName())
+	require.Equal(t, Role_EnclaveOwner, principal.GetRole())
+
+	principal, err = authenticator.Authenticate(newTestRequest("wrong-token"))
+	require.NoError(t, err)
+	require.Nil(t, principal)
+
+	principal, err = authenticator.Authenticate(newTestRequest(""))
+	require.NoError(t, err)
+	require.Nil(t, principal)
+}
+
+func TestNewAuthenticatorFromConfig_InvalidConfigs(t *testing.T) {
+	_, _, err := NewAuthenticatorFromConfig(&args.EngineAuthConfig{ApiTokens: nil, MutualTls: nil, Oidc: nil})
+	require.Error(t, err)
+
+	_, _, err = NewAuthenticatorFromConfig(&args.EngineAuthConfig{
+		ApiTokens: []*args.ApiTokenAuthConfig{newTestApiTokenConfig("ci", testApiToken, "superuser")},
+		MutualTls: nil,
+		Oidc:      nil,
+	})
+	require.Error(t, err)
+
+	_, _, err = NewAuthenticatorFromConfig(&args.EngineAuthConfig{
+		ApiTokens: []*args.ApiTokenAuthConfig{{Name: "ci", TokenSha256: "not-a-sha", Role: string(Role_Admin)}},
+		MutualTls: nil,
+		Oidc:      nil,
+	})
+	require.Error(t, err)
+}
+
+func TestOidcAuthenticator(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, testRsaKeyBits)
+	require.NoError(t, err)
+	authenticator, _, err := NewAuthenticatorFromConfig(&args.EngineAuthConfig{
+		ApiTokens: []*args.ApiTokenAuthConfig{newTestApiTokenConfig("ci", testApiToken, string(Role_Admin))},
+		MutualTls: nil,
+		Oidc:      newTestOidcConfig(t, &privateKey.PublicKey, string(Role_ReadOnly)),
+	})
+	require.NoError(t, err)
+
+	token := signTestOidcToken(t, privateKey, jwt.MapClaims{
+		"iss":   testOidcIssuer,
+		"aud":   []string{"another-app", testOidcAudience},
+		"sub":   "alice",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"some-other-app-role", string(Role_EnclaveOwner)},
+	})
+	principal, err := authenticator.Authenticate(newTestRequest(token))
+	require.NoError(t, err)
+	require.Equal(t, "alice", principal.GetName())
+	require.Equal(t, Role_EnclaveOwner, principal.GetRole())
+
+	// The API tokens are still checked before the OIDC tokens
+	principal, err = authenticator.Authenticate(newTestRequest(testApiToken))
+	require.NoError(t, err)
+	require.Equal(t, Role_Admin, principal.GetRole())
+}
+
+func TestOidcAuthenticator_DefaultRole(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, testRsaKeyBits)
+	require.NoError(t, err)
+	authenticator, err := newOidcAuthenticator(newTestOidcConfig(t, &privateKey.PublicKey, string(Role_ReadOnly)))
+	require.NoError(t, err)
+
+	token := signTestOidcToken(t, privateKey, jwt.MapClaims{
+		"iss": testOidcIssuer,
+		"aud": testOidcAudience,
+		"sub": "bob",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	principal, err := authenticator.Authenticate(newTestRequest(token))
+	require.NoError(t, err)
+	require.Equal(t, Role_ReadOnly, principal.GetRole())
+}
+
+func TestOidcAuthenticator_InvalidTokens(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, testRsaKeyBits)
+	require.NoError(t, err)
+	otherPrivateKey, err := rsa.GenerateKey(rand.Reader, testRsaKeyBits)
+	require.NoError(t, err)
+	authenticator, err := newOidcAuthenticator(newTestOidcConfig(t, &privateKey.PublicKey, ""))
+	require.NoError(t, err)
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":   testOidcIssuer,
+			"aud":   testOidcAudience,
+			"sub":   "alice",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+			"roles": string(Role_Admin),
+		}
+	}
+	expiredClaims := validClaims()
+	expiredClaims["exp"] = time.Now().Add(-time.Minute).Unix()
+	wrongIssuerClaims := validClaims()
+	wrongIssuerClaims["iss"] = "https://attacker.example.com"
+	wrongAudienceClaims := validClaims()
+	wrongAudienceClaims["aud"] = "another-app"
+	noExpiryClaims := validClaims()
+	delete(noExpiryClaims, "exp")
+	noRoleClaims := validClaims()
+	delete(noRoleClaims, "roles")
+
+	invalidTokens := map[string]string{
+		"expired":        signTestOidcToken(t, privateKey, expiredClaims),
+		"wrong issuer":   signTestOidcToken(t, privateKey, wrongIssuerClaims),
+		"wrong audience": signTestOidcToken(t, privateKey, wrongAudienceClaims),
+		"no expiry":      signTestOidcToken(t, privateKey, noExpiryClaims),
+		"no role":        signTestOidcToken(t, privateKey, noRoleClaims),
+		"wrong key":      signTestOidcToken(t, otherPrivateKey, validClaims()),
+		"not a jwt":      "not-a-jwt",
+	}
+	for description, token := range invalidTokens {
+		principal, err := authenticator.Authenticate(newTestRequest(token))
+		require.Error(t, err, "Expected the token with '%v' to be rejected", description)
+		require.Nil(t, principal)
+	}
+}
+
+func newTestApiTokenConfig(name string, token string, role string) *args.ApiTokenAuthConfig {
+	tokenSha256 := sha256.Sum256([]byte(token))
+	return &args.ApiTokenAuthConfig{
+		Name:        name,
+		TokenSha256: hex.EncodeToString(tokenSha256[:]),
+		Role:        role,
+	}
+}
+
+func newTestOidcConfig(t *testing.T, publicKey *rsa.PublicKey, defaultRole string) *args.OidcAuthConfig {
+	jwksJson := fmt.Sprintf(
+		`{"keys":[{"kty":"RSA","kid":"%v","use":"sig","n":"%v","e":"%v"}]}`,
+		testOidcKeyId,
+		base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+	)
+	_, err := parseJsonWebKeySet(jwksJson)
+	require.NoError(t, err)
+	return &args.OidcAuthConfig{
+		Issuer:        testOidcIssuer,
+		Audience:      testOidcAudience,
+		JwksJson:      jwksJson,
+		UsernameClaim: "",
+		RolesClaim:    "",
+		DefaultRole:   defaultRole,
+	}
+}
+
+func signTestOidcToken(t *testing.T, privateKey *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testOidcKeyId
+	signedToken, err := token.SignedString(privateKey)
+	require.NoError(t, err)
+	return signedToken
+}
+
+func newTestRequest(bearerToken string) *http.Request {
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	if bearerToken != "" {
+		request.Header.Set(authorizationHeaderKey, bearerTokenPrefix+bearerToken)
+	}
+	return request
+}
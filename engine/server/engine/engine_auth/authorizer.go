@@ -0,0 +1,117 @@
+package engine_auth
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+type Permission string
+
+const (
+	// List and inspect the enclaves and stream their logs
+	Permission_Read Permission = "read"
+
+	Permission_CreateEnclave Permission = "create-enclave"
+
+	// Change or destroy one enclave; the enclave owners can only manage the enclaves they created
+	Permission_ManageEnclave Permission = "manage-enclave"
+
+	// Anything acting on the engine or on many enclaves at once, like cleaning
+	Permission_Admin Permission = "admin"
+)
+
+// EnclaveUuidResolver resolves an enclave name, UUID or shortened UUID to the enclave UUID
+type EnclaveUuidResolver func(ctx context.Context, enclaveIdentifier string) (enclave.EnclaveUUID, error)
+
+// Authorizer decides what the principals can do based on their role and on who created the enclaves
+type Authorizer struct {
+	ownerRegistry *enclaveOwnerRegistry
+
+	resolveEnclaveUuid EnclaveUuidResolver
+}
+
+func NewAuthorizer(engineDataDirpath string, resolveEnclaveUuid EnclaveUuidResolver) (*Authorizer, error) {
+	ownerRegistry, err := newEnclaveOwnerRegistry(engineDataDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave owner registry")
+	}
+	return &Authorizer{
+		ownerRegistry:      ownerRegistry,
+		resolveEnclaveUuid: resolveEnclaveUuid,
+	}, nil
+}
+
+// Authorize returns an error if the principal isn't allowed the permission. The enclave identifier is only used for
+// the manage-enclave permission, in which case the UUID of the enclave is returned when it could be resolved
+func (authorizer *Authorizer) Authorize(
+	ctx context.Context,
+	principal *Principal,
+	permission Permission,
+	enclaveIdentifier string,
+) (enclave.EnclaveUUID, error) {
+	role := principal.GetRole()
+	switch permission {
+	case Permission_Read:
+		if getRoleRank(role) < getRoleRank(Role_ReadOnly) {
+			return "", stacktrace.NewError("'%v' with role '%v' isn't allowed to read the engine state", principal.GetName(), role)
+		}
+		return "", nil
+	case Permission_CreateEnclave:
+		if getRoleRank(role) < getRoleRank(Role_EnclaveOwner) {
+			return "", stacktrace.NewError("'%v' with role '%v' isn't allowed to create enclaves", principal.GetName(), role)
+		}
+		return "", nil
+	case Permission_ManageEnclave:
+		return authorizer.authorizeManageEnclave(ctx, principal, enclaveIdentifier)
+	case Permission_Admin:
+		if role != Role_Admin {
+			return "", stacktrace.NewError("'%v' with role '%v' isn't allowed to do this; only admins are", principal.GetName(), role)
+		}
+		return "", nil
+	default:
+		return "", stacktrace.NewError("Unrecognized permission '%v'", permission)
+	}
+}
+
+// RecordEnclaveOwner makes the principal the owner of the enclave it just created
+func (authorizer *Authorizer) RecordEnclaveOwner(principal *Principal, enclaveUuid enclave.EnclaveUUID) {
+	if err := authorizer.ownerRegistry.setOwner(enclaveUuid, principal.GetName()); err != nil {
+		logrus.Errorf("An error occurred recording '%v' as the owner of enclave '%v'; only admins will be able to manage it:\n%v", principal.GetName(), enclaveUuid, err)
+	}
+}
+
+// ForgetEnclave drops the owner of an enclave that got destroyed
+func (authorizer *Authorizer) ForgetEnclave(enclaveUuid enclave.EnclaveUUID) {
+	if err := authorizer.ownerRegistry.remove(enclaveUuid); err != nil {
+		logrus.Warnf("An error occurred removing the owner of destroyed enclave '%v':\n%v", enclaveUuid, err)
+	}
+}
+
+func (authorizer *Authorizer) authorizeManageEnclave(
+	ctx context.Context,
+	principal *Principal,
+	enclaveIdentifier string,
+) (enclave.EnclaveUUID, error) {
+	role := principal.GetRole()
+	if getRoleRank(role) < getRoleRank(Role_EnclaveOwner) {
+		return "", stacktrace.NewError("'%v' with role '%v' isn't allowed to manage enclaves", principal.GetName(), role)
+	}
+	enclaveUuid, err := authorizer.resolveEnclaveUuid(ctx, enclaveIdentifier)
+	if err != nil {
+		if role == Role_Admin {
+			// Admins get the same error from the handler as if auth was disabled
+			return "", nil
+		}
+		return "", stacktrace.Propagate(err, "An error occurred resolving enclave '%v' to check its owner", enclaveIdentifier)
+	}
+	if role == Role_Admin {
+		return enclaveUuid, nil
+	}
+	if owner := authorizer.ownerRegistry.getOwner(enclaveUuid); owner != principal.GetName() {
+		return "", stacktrace.NewError("'%v' isn't allowed to manage enclave '%v' because they didn't create it", principal.GetName(), enclaveIdentifier)
+	}
+	return enclaveUuid, nil
+}
@@ -0,0 +1,94 @@
+package engine_auth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveName = "my-enclave"
+	testEnclaveUuid = enclave.EnclaveUUID("0123456789abcdef0123456789abcdef")
+)
+
+func TestAuthorizer_ManageEnclave(t *testing.T) {
+	engineDataDirpath := t.TempDir()
+	authorizer, err := NewAuthorizer(engineDataDirpath, resolveTestEnclaveUuid)
+	require.NoError(t, err)
+
+	owner := NewPrincipal("alice", Role_EnclaveOwner)
+	otherOwner := NewPrincipal("bob", Role_EnclaveOwner)
+	admin := NewPrincipal("root", Role_Admin)
+	reader := NewPrincipal("viewer", Role_ReadOnly)
+	authorizer.RecordEnclaveOwner(owner, testEnclaveUuid)
+
+	enclaveUuid, err := authorizer.Authorize(context.Background(), owner, Permission_ManageEnclave, testEnclaveName)
+	require.NoError(t, err)
+	require.Equal(t, testEnclaveUuid, enclaveUuid)
+
+	_, err = authorizer.Authorize(context.Background(), otherOwner, Permission_ManageEnclave, testEnclaveName)
+	require.Error(t, err)
+
+	_, err = authorizer.Authorize(context.Background(), reader, Permission_ManageEnclave, testEnclaveName)
+	require.Error(t, err)
+
+	enclaveUuid, err = authorizer.Authorize(context.Background(), admin, Permission_ManageEnclave, testEnclaveName)
+	require.NoError(t, err)
+	require.Equal(t, testEnclaveUuid, enclaveUuid)
+
+	// Unknown enclaves are left for the handler to reject for the admins only
+	_, err = authorizer.Authorize(context.Background(), owner, Permission_ManageEnclave, "unknown-enclave")
+	require.Error(t, err)
+	_, err = authorizer.Authorize(context.Background(), admin, Permission_ManageEnclave, "unknown-enclave")
+	require.NoError(t, err)
+
+	// The owners survive an engine restart
+	restartedAuthorizer, err := NewAuthorizer(engineDataDirpath, resolveTestEnclaveUuid)
+	require.NoError(t, err)
+	_, err = restartedAuthorizer.Authorize(context.Background(), owner, Permission_ManageEnclave, testEnclaveName)
+	require.NoError(t, err)
+
+	restartedAuthorizer.ForgetEnclave(testEnclaveUuid)
+	_, err = restartedAuthorizer.Authorize(context.Background(), owner, Permission_ManageEnclave, testEnclaveName)
+	require.Error(t, err)
+}
+
+func TestAuthorizer_RolePermissions(t *testing.T) {
+	authorizer, err := NewAuthorizer(t.TempDir(), resolveTestEnclaveUuid)
+	require.NoError(t, err)
+
+	expectedPermissions := map[Role]map[Permission]bool{
+		Role_ReadOnly:     {Permission_Read: true, Permission_CreateEnclave: false, Permission_Admin: false},
+		Role_EnclaveOwner: {Permission_Read: true, Permission_CreateEnclave: true, Permission_Admin: false},
+		Role_Admin:        {Permission_Read: true, Permission_CreateEnclave: true, Permission_Admin: true},
+	}
+	for role, permissions := range expectedPermissions {
+		principal := NewPrincipal("someone", role)
+		for permission, isExpectedToBeAllowed := range permissions {
+			_, err := authorizer.Authorize(context.Background(), principal, permission, "")
+			require.Equal(t, isExpectedToBeAllowed, err == nil, "Unexpected result for role '%v' and permission '%v'", role, permission)
+		}
+	}
+}
+
+func TestGetRestPermission(t *testing.T) {
+	enclavesRoutePath := "/api/enclaves"
+	require.Equal(t, Permission_Read, getRestPermission(http.MethodGet, "/api/enclaves/:enclave_identifier/services", enclavesRoutePath, testEnclaveName))
+	require.Equal(t, Permission_Read, getRestPermission(http.MethodGet, "/api/starlark/executions/:starlark_execution_uuid/logs", enclavesRoutePath, ""))
+	require.Equal(t, Permission_CreateEnclave, getRestPermission(http.MethodPost, enclavesRoutePath, enclavesRoutePath, ""))
+	require.Equal(t, Permission_Admin, getRestPermission(http.MethodDelete, enclavesRoutePath, enclavesRoutePath, ""))
+	require.Equal(t, Permission_ManageEnclave, getRestPermission(http.MethodDelete, "/api/enclaves/:enclave_identifier", enclavesRoutePath, testEnclaveName))
+	require.Equal(t, Permission_ManageEnclave, getRestPermission(http.MethodPost, "/api/enclaves/:enclave_identifier/starlark/scripts", enclavesRoutePath, testEnclaveName))
+	require.Equal(t, Permission_Admin, getRestPermission(http.MethodPost, "/api/unknown", enclavesRoutePath, ""))
+}
+
+func resolveTestEnclaveUuid(_ context.Context, enclaveIdentifier string) (enclave.EnclaveUUID, error) {
+	if enclaveIdentifier == testEnclaveName || enclaveIdentifier == string(testEnclaveUuid) {
+		return testEnclaveUuid, nil
+	}
+	return "", stacktrace.NewError("No enclave found for identifier '%v'", enclaveIdentifier)
+}
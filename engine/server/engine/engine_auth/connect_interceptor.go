@@ -0,0 +1,108 @@
+package engine_auth
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+)
+
+// The procedures not listed here need the admin permission
+var connectProcedurePermissions = map[string]Permission{
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEngineInfoProcedure:                              Permission_Read,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetResourceQuotaUsageProcedure:                      Permission_Read,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEnclavesProcedure:                                Permission_Read,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetExistingAndHistoricalEnclaveIdentifiersProcedure: Permission_Read,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetServiceLogsProcedure:                             Permission_Read,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceStreamEngineEventsProcedure:                         Permission_Read,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCreateEnclaveProcedure:                              Permission_CreateEnclave,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceStopEnclaveProcedure:                                Permission_ManageEnclave,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceStartEnclaveProcedure:                               Permission_ManageEnclave,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceExtendEnclaveProcedure:                              Permission_ManageEnclave,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceDestroyEnclaveProcedure:                             Permission_ManageEnclave,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCleanProcedure:                                      Permission_Admin,
+}
+
+// enclaveIdentifierArgs is implemented by the args of all the procedures acting on a single enclave
+type enclaveIdentifierArgs interface {
+	GetEnclaveIdentifier() string
+}
+
+type connectInterceptor struct {
+	engineAuth *EngineAuth
+}
+
+// NewConnectInterceptor authorizes the calls to the engine Connect service; the handler must be wrapped with
+// WrapHandler so that the calls are authenticated first
+func (engineAuth *EngineAuth) NewConnectInterceptor() connect.Interceptor {
+	return &connectInterceptor{engineAuth: engineAuth}
+}
+
+func (interceptor *connectInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := request.Spec().Procedure
+		enclaveIdentifier := ""
+		if args, ok := request.Any().(enclaveIdentifierArgs); ok {
+			enclaveIdentifier = args.GetEnclaveIdentifier()
+		}
+		principal, enclaveUuid, err := interceptor.authorize(ctx, procedure, enclaveIdentifier)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := next(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		switch procedure {
+		case kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCreateEnclaveProcedure:
+			if createEnclaveResponse, ok := response.Any().(*kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse); ok {
+				interceptor.engineAuth.authorizer.RecordEnclaveOwner(principal, enclave.EnclaveUUID(createEnclaveResponse.GetEnclaveInfo().GetEnclaveUuid()))
+				interceptor.engineAuth.setApiContainerAccessToken(principal, createEnclaveResponse.GetEnclaveInfo())
+			}
+		case kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEnclavesProcedure:
+			if getEnclavesResponse, ok := response.Any().(*kurtosis_engine_rpc_api_bindings.GetEnclavesResponse); ok {
+				for _, enclaveInfo := range getEnclavesResponse.GetEnclaveInfo() {
+					interceptor.engineAuth.setApiContainerAccessToken(principal, enclaveInfo)
+				}
+			}
+		case kurtosis_engine_rpc_api_bindingsconnect.EngineServiceDestroyEnclaveProcedure:
+			if enclaveUuid != "" {
+				interceptor.engineAuth.authorizer.ForgetEnclave(enclaveUuid)
+			}
+		}
+		return response, nil
+	}
+}
+
+func (interceptor *connectInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor *connectInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		// None of the streaming procedures act on an enclave, so the request message isn't needed to authorize them
+		if _, _, err := interceptor.authorize(ctx, conn.Spec().Procedure, ""); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (interceptor *connectInterceptor) authorize(ctx context.Context, procedure string, enclaveIdentifier string) (*Principal, enclave.EnclaveUUID, error) {
+	principal, err := getAuthenticatedPrincipal(ctx)
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	permission, found := connectProcedurePermissions[procedure]
+	if !found {
+		permission = Permission_Admin
+	}
+	enclaveUuid, err := interceptor.engineAuth.authorizer.Authorize(ctx, principal, permission, enclaveIdentifier)
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return principal, enclaveUuid, nil
+}
@@ -0,0 +1,91 @@
+package engine_auth
+
+import (
+	"net/http"
+
+	engineApi "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/server/engine_rest_api"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	enclaveIdentifierRestPathParam = "enclave_identifier"
+	enclavesRestPath               = "/enclaves"
+
+	// Where the echo middleware leaves the principal and the authorized enclave for the strict middleware
+	principalEchoContextKey             = "engine_auth.principal"
+	authorizedEnclaveUuidEchoContextKey = "engine_auth.authorized_enclave_uuid"
+
+	postEnclavesOperationId                    = "PostEnclaves"
+	deleteEnclavesEnclaveIdentifierOperationId = "DeleteEnclavesEnclaveIdentifier"
+)
+
+// NewEchoMiddleware authenticates and authorizes the requests to the REST and websocket APIs served under the API path
+func (engineAuth *EngineAuth) NewEchoMiddleware(apiPath string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			result := engineAuth.authenticate(c.Request())
+			if result.err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, result.err.Error())
+			}
+			principal := result.principal
+
+			enclaveIdentifier := c.Param(enclaveIdentifierRestPathParam)
+			permission := getRestPermission(c.Request().Method, c.Path(), apiPath+enclavesRestPath, enclaveIdentifier)
+			enclaveUuid, err := engineAuth.authorizer.Authorize(c.Request().Context(), principal, permission, enclaveIdentifier)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusForbidden, err.Error())
+			}
+
+			c.SetRequest(c.Request().WithContext(withPrincipal(c.Request().Context(), principal)))
+			c.Set(principalEchoContextKey, principal)
+			c.Set(authorizedEnclaveUuidEchoContextKey, enclaveUuid)
+			return next(c)
+		}
+	}
+}
+
+// NewEngineRestStrictMiddleware keeps track of the owners of the enclaves created and destroyed through the engine
+// REST API; it relies on the echo middleware having authorized the request
+func (engineAuth *EngineAuth) NewEngineRestStrictMiddleware() engineApi.StrictMiddlewareFunc {
+	return func(handler engineApi.StrictHandlerFunc, operationId string) engineApi.StrictHandlerFunc {
+		return func(c echo.Context, request interface{}) (interface{}, error) {
+			response, err := handler(c, request)
+			if err != nil {
+				return response, err
+			}
+			switch operationId {
+			case postEnclavesOperationId:
+				principal, ok := c.Get(principalEchoContextKey).(*Principal)
+				createdEnclave, isCreated := response.(engineApi.PostEnclaves200JSONResponse)
+				if ok && isCreated {
+					engineAuth.authorizer.RecordEnclaveOwner(principal, enclave.EnclaveUUID(createdEnclave.EnclaveUuid))
+				}
+			case deleteEnclavesEnclaveIdentifierOperationId:
+				enclaveUuid, ok := c.Get(authorizedEnclaveUuidEchoContextKey).(enclave.EnclaveUUID)
+				if _, isDestroyed := response.(engineApi.DeleteEnclavesEnclaveIdentifier200Response); ok && isDestroyed && enclaveUuid != "" {
+					engineAuth.authorizer.ForgetEnclave(enclaveUuid)
+				}
+			}
+			return response, nil
+		}
+	}
+}
+
+// getRestPermission maps the routes to permissions: reads need the read permission, creating an enclave needs the
+// create permission, changing one enclave needs to manage it, and anything else is for the admins
+func getRestPermission(method string, routePath string, enclavesRoutePath string, enclaveIdentifier string) Permission {
+	if method == http.MethodGet || method == http.MethodHead {
+		return Permission_Read
+	}
+	if routePath == enclavesRoutePath {
+		if method == http.MethodPost {
+			return Permission_CreateEnclave
+		}
+		return Permission_Admin
+	}
+	if enclaveIdentifier != "" {
+		return Permission_ManageEnclave
+	}
+	return Permission_Admin
+}
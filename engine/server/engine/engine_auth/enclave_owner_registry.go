@@ -0,0 +1,101 @@
+package engine_auth
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveOwnersFilename = "enclave-owners.json"
+
+	enclaveOwnersDirPerms  = 0755
+	enclaveOwnersFilePerms = 0644
+)
+
+// enclaveOwnerRegistry keeps the name of the principal that created each enclave in a file of the engine data
+// directory, so that the owners can still manage their enclaves after the engine gets restarted
+type enclaveOwnerRegistry struct {
+	filepath string
+
+	owners map[enclave.EnclaveUUID]string
+
+	mutex *sync.Mutex
+}
+
+func newEnclaveOwnerRegistry(engineDataDirpath string) (*enclaveOwnerRegistry, error) {
+	if err := os.MkdirAll(engineDataDirpath, enclaveOwnersDirPerms); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the engine data directory '%v'", engineDataDirpath)
+	}
+	registry := &enclaveOwnerRegistry{
+		filepath: path.Join(engineDataDirpath, enclaveOwnersFilename),
+		owners:   map[enclave.EnclaveUUID]string{},
+		mutex:    &sync.Mutex{},
+	}
+
+	ownersBytes, err := os.ReadFile(registry.filepath)
+	if os.IsNotExist(err) {
+		return registry, nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave owners file '%v'", registry.filepath)
+	}
+	if err := json.Unmarshal(ownersBytes, &registry.owners); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the enclave owners file '%v'", registry.filepath)
+	}
+	return registry, nil
+}
+
+func (registry *enclaveOwnerRegistry) setOwner(enclaveUuid enclave.EnclaveUUID, ownerName string) error {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	registry.owners[enclaveUuid] = ownerName
+	if err := registry.persist(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the owner of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (registry *enclaveOwnerRegistry) remove(enclaveUuid enclave.EnclaveUUID) error {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if _, found := registry.owners[enclaveUuid]; !found {
+		return nil
+	}
+	delete(registry.owners, enclaveUuid)
+	if err := registry.persist(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred persisting the removal of the owner of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+// getOwner returns empty if the enclave has no known owner, e.g. because it was created before auth got enabled
+func (registry *enclaveOwnerRegistry) getOwner(enclaveUuid enclave.EnclaveUUID) string {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	return registry.owners[enclaveUuid]
+}
+
+// this should be called from a thread safe context
+func (registry *enclaveOwnerRegistry) persist() error {
+	ownersBytes, err := json.Marshal(registry.owners)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the enclave owners")
+	}
+	// Written to a temporary file first, so that a crash in the middle of the write doesn't lose all the owners
+	tmpFilepath := registry.filepath + ".tmp"
+	if err := os.WriteFile(tmpFilepath, ownersBytes, enclaveOwnersFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the enclave owners to '%v'", tmpFilepath)
+	}
+	if err := os.Rename(tmpFilepath, registry.filepath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving the enclave owners file '%v' to '%v'", tmpFilepath, registry.filepath)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package engine_auth
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+type authenticationResultContextKey struct{}
+
+// authenticationResult is what the authentication of a request gave, stored in its context so that the handlers
+// further down can reject it with the error type of their own protocol
+type authenticationResult struct {
+	// Nil if the request isn't authenticated
+	principal *Principal
+
+	err error
+}
+
+// EngineAuth authenticates and authorizes the callers of the engine REST, websocket and Connect APIs
+type EngineAuth struct {
+	authenticator Authenticator
+
+	authorizer *Authorizer
+
+	// Nil unless the engine APIs have to be served over TLS
+	tlsConfig *tls.Config
+
+	// What the keys the API containers check the access tokens with are derived from
+	apiContainerAccessSecret []byte
+}
+
+func NewEngineAuth(authConfig *args.EngineAuthConfig, engineDataDirpath string, resolveEnclaveUuid EnclaveUuidResolver, apiContainerAccessSecret []byte) (*EngineAuth, error) {
+	authenticator, tlsConfig, err := NewAuthenticatorFromConfig(authConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the authenticator")
+	}
+	authorizer, err := NewAuthorizer(engineDataDirpath, resolveEnclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the authorizer")
+	}
+	return &EngineAuth{
+		authenticator:            authenticator,
+		authorizer:               authorizer,
+		tlsConfig:                tlsConfig,
+		apiContainerAccessSecret: apiContainerAccessSecret,
+	}, nil
+}
+
+func (engineAuth *EngineAuth) GetTlsConfig() *tls.Config {
+	return engineAuth.tlsConfig
+}
+
+// GetEnclaveOwner returns the name of the principal who created the enclave, or empty if it isn't known
+func (engineAuth *EngineAuth) GetEnclaveOwner(enclaveUuid enclave.EnclaveUUID) string {
+	return engineAuth.authorizer.ownerRegistry.getOwner(enclaveUuid)
+}
+
+// WrapHandler authenticates the requests before handing them to the handler, which authorizes them with the
+// principal found in the request context
+func (engineAuth *EngineAuth) WrapHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		result := engineAuth.authenticate(request)
+		ctxWithResult := context.WithValue(request.Context(), authenticationResultContextKey{}, result)
+		if result.principal != nil {
+			ctxWithResult = withPrincipal(ctxWithResult, result.principal)
+		}
+		handler.ServeHTTP(writer, request.WithContext(ctxWithResult))
+	})
+}
+
+func (engineAuth *EngineAuth) authenticate(request *http.Request) *authenticationResult {
+	principal, err := engineAuth.authenticator.Authenticate(request)
+	if err != nil {
+		logrus.Debugf("Rejected the credentials of a request to '%v':\n%v", request.URL.Path, err)
+		return &authenticationResult{principal: nil, err: err}
+	}
+	if principal == nil {
+		return &authenticationResult{
+			principal: nil,
+			err:       stacktrace.NewError("The request carries no credentials; an API token, a client certificate or an OIDC token is required"),
+		}
+	}
+	return &authenticationResult{principal: principal, err: nil}
+}
+
+// getAuthenticatedPrincipal returns the principal the request of the context got authenticated as by the wrapping
+// handler, or the reason it didn't
+func getAuthenticatedPrincipal(ctx context.Context) (*Principal, error) {
+	result, ok := ctx.Value(authenticationResultContextKey{}).(*authenticationResult)
+	if !ok {
+		return nil, stacktrace.NewError("The request didn't go through authentication; this is a bug in Kurtosis")
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	return result.principal, nil
+}
@@ -0,0 +1,72 @@
+package engine_auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// mutualTlsAuthenticator authenticates the clients by the common name of the certificate they presented, which the TLS
+// handshake already verified against the client CA
+type mutualTlsAuthenticator struct {
+	clientRoles map[string]Role
+
+	// Nil if the clients not listed in the client roles are rejected
+	defaultRole *Role
+}
+
+func newMutualTlsAuthenticator(mutualTlsConfig *args.MutualTlsAuthConfig) (*mutualTlsAuthenticator, *tls.Config, error) {
+	serverCert, err := tls.X509KeyPair([]byte(mutualTlsConfig.ServerCertPem), []byte(mutualTlsConfig.ServerKeyPem))
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred loading the server certificate and key")
+	}
+	clientCaCertPool := x509.NewCertPool()
+	if !clientCaCertPool.AppendCertsFromPEM([]byte(mutualTlsConfig.ClientCaCertPem)) {
+		return nil, nil, stacktrace.NewError("No valid PEM certificate was found in the client CA certificate")
+	}
+
+	clientRoles := map[string]Role{}
+	for clientCommonName, roleStr := range mutualTlsConfig.ClientRoles {
+		role, err := ParseRole(roleStr)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred parsing the role of client '%v'", clientCommonName)
+		}
+		clientRoles[clientCommonName] = role
+	}
+	defaultRole, err := parseOptionalRole(mutualTlsConfig.DefaultRole)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred parsing the default role of the mutual TLS clients")
+	}
+
+	// The clients without a certificate are still let through the handshake, so that they can use the other
+	// authentication methods over TLS
+	// nolint:exhaustruct
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    clientCaCertPool,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		MinVersion:   tls.VersionTLS12,
+	}
+	authenticator := &mutualTlsAuthenticator{
+		clientRoles: clientRoles,
+		defaultRole: defaultRole,
+	}
+	return authenticator, tlsConfig, nil
+}
+
+func (authenticator *mutualTlsAuthenticator) Authenticate(request *http.Request) (*Principal, error) {
+	if request.TLS == nil || len(request.TLS.VerifiedChains) == 0 || len(request.TLS.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+	clientCommonName := request.TLS.VerifiedChains[0][0].Subject.CommonName
+	if role, found := authenticator.clientRoles[clientCommonName]; found {
+		return NewPrincipal(clientCommonName, role), nil
+	}
+	if authenticator.defaultRole == nil {
+		return nil, stacktrace.NewError("Client certificate '%v' has no role and there's no default role for the mutual TLS clients", clientCommonName)
+	}
+	return NewPrincipal(clientCommonName, *authenticator.defaultRole), nil
+}
@@ -0,0 +1,269 @@
+package engine_auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	defaultOidcUsernameClaim = "sub"
+	defaultOidcRolesClaim    = "roles"
+
+	jwkKeyTypeRsa = "RSA"
+	jwkKeyTypeEc  = "EC"
+
+	jwkSignatureUse = "sig"
+)
+
+var (
+	supportedJwtSigningMethods = []string{
+		jwt.SigningMethodRS256.Alg(),
+		jwt.SigningMethodRS384.Alg(),
+		jwt.SigningMethodRS512.Alg(),
+		jwt.SigningMethodES256.Alg(),
+		jwt.SigningMethodES384.Alg(),
+		jwt.SigningMethodES512.Alg(),
+	}
+
+	jwkEllipticCurves = map[string]elliptic.Curve{
+		"P-256": elliptic.P256(),
+		"P-384": elliptic.P384(),
+		"P-521": elliptic.P521(),
+	}
+)
+
+// jsonWebKey is the subset of RFC 7517 needed to verify RSA and EC signatures
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyId   string `json:"kid"`
+	Use     string `json:"use"`
+
+	// RSA
+	Modulus  string `json:"n"`
+	Exponent string `json:"e"`
+
+	// EC
+	Curve string `json:"crv"`
+	X     string `json:"x"`
+	Y     string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []*jsonWebKey `json:"keys"`
+}
+
+// oidcAuthenticator validates the bearer JWTs issued by an OIDC provider against a local copy of its JWKS, so the
+// engine never has to reach the provider
+type oidcAuthenticator struct {
+	issuer string
+
+	audience string
+
+	// By key ID
+	publicKeys map[string]crypto.PublicKey
+
+	usernameClaim string
+
+	rolesClaim string
+
+	// Nil if the tokens without a known role are rejected
+	defaultRole *Role
+
+	parser *jwt.Parser
+}
+
+func newOidcAuthenticator(oidcConfig *args.OidcAuthConfig) (*oidcAuthenticator, error) {
+	if oidcConfig.Issuer == "" || oidcConfig.Audience == "" {
+		return nil, stacktrace.NewError("Validating OIDC tokens requires both the issuer and the audience to be set")
+	}
+	publicKeys, err := parseJsonWebKeySet(oidcConfig.JwksJson)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the OIDC JWKS")
+	}
+	defaultRole, err := parseOptionalRole(oidcConfig.DefaultRole)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the default role of the OIDC tokens")
+	}
+	usernameClaim := oidcConfig.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = defaultOidcUsernameClaim
+	}
+	rolesClaim := oidcConfig.RolesClaim
+	if rolesClaim == "" {
+		rolesClaim = defaultOidcRolesClaim
+	}
+	// nolint:exhaustruct
+	parser := &jwt.Parser{
+		ValidMethods: supportedJwtSigningMethods,
+	}
+	return &oidcAuthenticator{
+		issuer:        oidcConfig.Issuer,
+		audience:      oidcConfig.Audience,
+		publicKeys:    publicKeys,
+		usernameClaim: usernameClaim,
+		rolesClaim:    rolesClaim,
+		defaultRole:   defaultRole,
+		parser:        parser,
+	}, nil
+}
+
+func (authenticator *oidcAuthenticator) Authenticate(request *http.Request) (*Principal, error) {
+	bearerToken := getBearerToken(request)
+	if bearerToken == "" {
+		return nil, nil
+	}
+	claims := jwt.MapClaims{}
+	// The expiry, not-before and issued-at claims are validated by the parser
+	if _, err := authenticator.parser.ParseWithClaims(bearerToken, claims, authenticator.getVerificationKey); err != nil {
+		return nil, stacktrace.Propagate(err, "The bearer token is neither a known API token nor a valid OIDC token")
+	}
+	if !claims.VerifyIssuer(authenticator.issuer, true) {
+		return nil, stacktrace.NewError("The OIDC token wasn't issued by '%v'", authenticator.issuer)
+	}
+	if !claims.VerifyAudience(authenticator.audience, true) {
+		return nil, stacktrace.NewError("The OIDC token isn't meant for audience '%v'", authenticator.audience)
+	}
+	if _, found := claims["exp"]; !found {
+		return nil, stacktrace.NewError("The OIDC token has no expiry")
+	}
+
+	username, ok := claims[authenticator.usernameClaim].(string)
+	if !ok || username == "" {
+		return nil, stacktrace.NewError("The OIDC token has no '%v' claim to use as the username", authenticator.usernameClaim)
+	}
+	role, err := authenticator.getRole(claims)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the role of OIDC user '%v'", username)
+	}
+	return NewPrincipal(username, role), nil
+}
+
+func (authenticator *oidcAuthenticator) getVerificationKey(token *jwt.Token) (interface{}, error) {
+	keyId, _ := token.Header["kid"].(string)
+	if keyId == "" && len(authenticator.publicKeys) == 1 {
+		// Tokens of a provider with a single key don't have to say which one they're signed with
+		for _, publicKey := range authenticator.publicKeys {
+			return publicKey, nil
+		}
+	}
+	publicKey, found := authenticator.publicKeys[keyId]
+	if !found {
+		return nil, stacktrace.NewError("The OIDC token is signed with key '%v', which isn't in the JWKS", keyId)
+	}
+	return publicKey, nil
+}
+
+// getRole returns the highest known role of the roles claim, which can be a single role or a list of roles, falling
+// back to the default role
+func (authenticator *oidcAuthenticator) getRole(claims jwt.MapClaims) (Role, error) {
+	var roleStrs []string
+	switch rolesClaimValue := claims[authenticator.rolesClaim].(type) {
+	case string:
+		roleStrs = append(roleStrs, rolesClaimValue)
+	case []interface{}:
+		for _, roleValue := range rolesClaimValue {
+			if roleStr, ok := roleValue.(string); ok {
+				roleStrs = append(roleStrs, roleStr)
+			}
+		}
+	}
+
+	var highestRole *Role
+	for _, roleStr := range roleStrs {
+		role, err := ParseRole(roleStr)
+		if err != nil {
+			// The provider can hand out roles for other applications too
+			continue
+		}
+		if highestRole == nil || getRoleRank(role) > getRoleRank(*highestRole) {
+			highestRole = &role
+		}
+	}
+	if highestRole != nil {
+		return *highestRole, nil
+	}
+	if authenticator.defaultRole == nil {
+		return "", stacktrace.NewError("The '%v' claim of the OIDC token holds no known role and there's no default role for the OIDC users", authenticator.rolesClaim)
+	}
+	return *authenticator.defaultRole, nil
+}
+
+func parseJsonWebKeySet(jwksJson string) (map[string]crypto.PublicKey, error) {
+	keySet := &jsonWebKeySet{Keys: nil}
+	if err := json.Unmarshal([]byte(jwksJson), keySet); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the JWKS")
+	}
+	publicKeys := map[string]crypto.PublicKey{}
+	for _, key := range keySet.Keys {
+		if key.Use != "" && key.Use != jwkSignatureUse {
+			continue
+		}
+		publicKey, err := parseJsonWebKey(key)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing JWK '%v'", key.KeyId)
+		}
+		publicKeys[key.KeyId] = publicKey
+	}
+	if len(publicKeys) == 0 {
+		return nil, stacktrace.NewError("The JWKS has no signature verification key")
+	}
+	return publicKeys, nil
+}
+
+func parseJsonWebKey(key *jsonWebKey) (crypto.PublicKey, error) {
+	switch key.KeyType {
+	case jwkKeyTypeRsa:
+		modulus, err := decodeBase64UrlInt(key.Modulus)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred decoding the RSA modulus")
+		}
+		exponent, err := decodeBase64UrlInt(key.Exponent)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred decoding the RSA exponent")
+		}
+		if !exponent.IsInt64() {
+			return nil, stacktrace.NewError("The RSA exponent is too large")
+		}
+		return &rsa.PublicKey{N: modulus, E: int(exponent.Int64())}, nil
+	case jwkKeyTypeEc:
+		curve, found := jwkEllipticCurves[key.Curve]
+		if !found {
+			return nil, stacktrace.NewError("Unsupported elliptic curve '%v'", key.Curve)
+		}
+		x, err := decodeBase64UrlInt(key.X)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred decoding the EC x coordinate")
+		}
+		y, err := decodeBase64UrlInt(key.Y)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred decoding the EC y coordinate")
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, stacktrace.NewError("The EC point isn't on curve '%v'", key.Curve)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, stacktrace.NewError("Unsupported key type '%v'; only '%v' and '%v' keys are supported", key.KeyType, jwkKeyTypeRsa, jwkKeyTypeEc)
+	}
+}
+
+func decodeBase64UrlInt(encodedInt string) (*big.Int, error) {
+	intBytes, err := base64.RawURLEncoding.DecodeString(encodedInt)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred base64url-decoding '%v'", encodedInt)
+	}
+	if len(intBytes) == 0 {
+		return nil, stacktrace.NewError("Expected a base64url-encoded integer but got an empty value")
+	}
+	return new(big.Int).SetBytes(intBytes), nil
+}
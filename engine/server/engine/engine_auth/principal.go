@@ -0,0 +1,43 @@
+package engine_auth
+
+import (
+	"context"
+)
+
+type principalContextKey struct{}
+
+// Principal is an authenticated caller of the engine APIs
+type Principal struct {
+	// The token name, the client certificate common name or the OIDC username, used to track the enclave owners
+	name string
+
+	role Role
+}
+
+func NewPrincipal(name string, role Role) *Principal {
+	return &Principal{
+		name: name,
+		role: role,
+	}
+}
+
+func (principal *Principal) GetName() string {
+	return principal.name
+}
+
+func (principal *Principal) GetRole() Role {
+	return principal.role
+}
+
+func withPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// GetPrincipal returns the principal the request of the context got authenticated as, or nil if it didn't
+func GetPrincipal(ctx context.Context) *Principal {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	if !ok {
+		return nil
+	}
+	return principal
+}
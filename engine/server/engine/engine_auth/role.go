@@ -0,0 +1,58 @@
+package engine_auth
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+type Role string
+
+const (
+	// Can list and inspect the enclaves and stream their logs
+	Role_ReadOnly Role = "read-only"
+
+	// Can do what read-only can, create enclaves and manage the enclaves they created
+	Role_EnclaveOwner Role = "enclave-owner"
+
+	// Can do anything, including managing the enclaves of others and cleaning the engine
+	Role_Admin Role = "admin"
+)
+
+var allRoles = map[Role]bool{
+	Role_ReadOnly:     true,
+	Role_EnclaveOwner: true,
+	Role_Admin:        true,
+}
+
+func ParseRole(roleStr string) (Role, error) {
+	role := Role(roleStr)
+	if _, found := allRoles[role]; !found {
+		return "", stacktrace.NewError("Unrecognized role '%v'; valid roles are '%v', '%v' and '%v'", roleStr, Role_ReadOnly, Role_EnclaveOwner, Role_Admin)
+	}
+	return role, nil
+}
+
+// parseOptionalRole is for the default roles of the config, which are empty when the unknown principals are rejected
+func parseOptionalRole(roleStr string) (*Role, error) {
+	if roleStr == "" {
+		return nil, nil
+	}
+	role, err := ParseRole(roleStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing role '%v'", roleStr)
+	}
+	return &role, nil
+}
+
+// getRoleRank orders the roles by how much they allow, each role allowing everything the lower ones do
+func getRoleRank(role Role) int {
+	switch role {
+	case Role_ReadOnly:
+		return 1
+	case Role_EnclaveOwner:
+		return 2
+	case Role_Admin:
+		return 3
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,122 @@
+package engine_events
+
+import (
+	"fmt"
+	"time"
+)
+
+type EngineEventType string
+
+const (
+	EngineEventType_ENCLAVE_CREATED        EngineEventType = "ENCLAVE_CREATED"
+	EngineEventType_ENCLAVE_STARTED        EngineEventType = "ENCLAVE_STARTED"
+	EngineEventType_ENCLAVE_STOPPED        EngineEventType = "ENCLAVE_STOPPED"
+	EngineEventType_ENCLAVE_DESTROYED      EngineEventType = "ENCLAVE_DESTROYED"
+	EngineEventType_STARLARK_RUN_STARTED   EngineEventType = "STARLARK_RUN_STARTED"
+	EngineEventType_STARLARK_RUN_FINISHED  EngineEventType = "STARLARK_RUN_FINISHED"
+	EngineEventType_SERVICE_STARTED        EngineEventType = "SERVICE_STARTED"
+	EngineEventType_SERVICE_STOPPED        EngineEventType = "SERVICE_STOPPED"
+	EngineEventType_SERVICE_CRASHED        EngineEventType = "SERVICE_CRASHED"
+	EngineEventType_FILES_ARTIFACT_CREATED EngineEventType = "FILES_ARTIFACT_CREATED"
+)
+
+// Completeness enforced via unit test
+var AllEngineEventTypes = []EngineEventType{
+	EngineEventType_ENCLAVE_CREATED,
+	EngineEventType_ENCLAVE_STARTED,
+	EngineEventType_ENCLAVE_STOPPED,
+	EngineEventType_ENCLAVE_DESTROYED,
+	EngineEventType_STARLARK_RUN_STARTED,
+	EngineEventType_STARLARK_RUN_FINISHED,
+	EngineEventType_SERVICE_STARTED,
+	EngineEventType_SERVICE_STOPPED,
+	EngineEventType_SERVICE_CRASHED,
+	EngineEventType_FILES_ARTIFACT_CREATED,
+}
+
+// EngineEvent is something which happened to an enclave or inside of it. It's what gets streamed by the engine APIs
+// and posted to the webhooks, as JSON with the same fields as the EngineEvent of the REST API.
+type EngineEvent struct {
+	// Unique to the event, so that webhooks can tell a redelivery apart
+	Id string `json:"event_uuid"`
+
+	Type EngineEventType `json:"type"`
+
+	Timestamp time.Time `json:"timestamp"`
+
+	EnclaveUuid string `json:"enclave_uuid"`
+
+	EnclaveName string `json:"enclave_name"`
+
+	EnclaveLabels map[string]string `json:"enclave_labels,omitempty"`
+
+	// Set on the service events only
+	ServiceName *string `json:"service_name,omitempty"`
+
+	// Set on the files artifact events only
+	FilesArtifactName *string `json:"files_artifact_name,omitempty"`
+
+	// Set on the Starlark run events only; empty for the scripts which aren't part of a package
+	StarlarkPackageId *string `json:"starlark_package_id,omitempty"`
+
+	// Set on the Starlark run finished events only
+	IsStarlarkRunSuccessful *bool `json:"is_starlark_run_successful,omitempty"`
+
+	// Details of the event, e.g. the error of a failed Starlark run or why a service crashed
+	Message string `json:"message,omitempty"`
+
+	// Human-readable summary of the event, which is also what chat incoming webhooks, e.g. Slack's, post
+	Text string `json:"text"`
+}
+
+// getEngineEventText summarizes the event in one line, followed by its message if it has one
+func getEngineEventText(event *EngineEvent) string {
+	var summary string
+	switch event.Type {
+	case EngineEventType_ENCLAVE_CREATED:
+		summary = fmt.Sprintf("Enclave '%v' was created", event.EnclaveName)
+	case EngineEventType_ENCLAVE_STARTED:
+		summary = fmt.Sprintf("Enclave '%v' was started", event.EnclaveName)
+	case EngineEventType_ENCLAVE_STOPPED:
+		summary = fmt.Sprintf("Enclave '%v' was stopped", event.EnclaveName)
+	case EngineEventType_ENCLAVE_DESTROYED:
+		summary = fmt.Sprintf("Enclave '%v' was destroyed", event.EnclaveName)
+	case EngineEventType_STARLARK_RUN_STARTED:
+		summary = fmt.Sprintf("A Starlark run%v started in enclave '%v'", getStarlarkPackageDescription(event), event.EnclaveName)
+	case EngineEventType_STARLARK_RUN_FINISHED:
+		outcome := "succeeded"
+		if event.IsStarlarkRunSuccessful != nil && !*event.IsStarlarkRunSuccessful {
+			outcome = "failed"
+		}
+		summary = fmt.Sprintf("A Starlark run%v %v in enclave '%v'", getStarlarkPackageDescription(event), outcome, event.EnclaveName)
+	case EngineEventType_SERVICE_STARTED:
+		summary = fmt.Sprintf("Service '%v' started in enclave '%v'", getOptionalString(event.ServiceName), event.EnclaveName)
+	case EngineEventType_SERVICE_STOPPED:
+		summary = fmt.Sprintf("Service '%v' stopped in enclave '%v'", getOptionalString(event.ServiceName), event.EnclaveName)
+	case EngineEventType_SERVICE_CRASHED:
+		summary = fmt.Sprintf("Service '%v' crashed in enclave '%v'", getOptionalString(event.ServiceName), event.EnclaveName)
+	case EngineEventType_FILES_ARTIFACT_CREATED:
+		summary = fmt.Sprintf("Files artifact '%v' was created in enclave '%v'", getOptionalString(event.FilesArtifactName), event.EnclaveName)
+	default:
+		summary = fmt.Sprintf("Event '%v' happened in enclave '%v'", event.Type, event.EnclaveName)
+	}
+	if event.Message == "" {
+		return summary
+	}
+	return fmt.Sprintf("%v:\n%v", summary, event.Message)
+}
+
+func getStarlarkPackageDescription(event *EngineEvent) string {
+	packageId := getOptionalString(event.StarlarkPackageId)
+	if packageId == "" {
+		return ""
+	}
+	return fmt.Sprintf(" of package '%v'", packageId)
+}
+
+func getOptionalString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,90 @@
+package engine_events
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	subscriberChanBufferSize = 100
+)
+
+type engineEventSubscriber struct {
+	filter *EngineEventFilter
+
+	eventsChan chan *EngineEvent
+}
+
+// EngineEventBus hands the events which happen in the engine to everyone subscribed to them: the streams of the
+// engine APIs and the webhooks
+type EngineEventBus struct {
+	subscribers map[uint32]*engineEventSubscriber
+
+	nextSubscriberId uint32
+
+	mutex *sync.Mutex
+}
+
+func NewEngineEventBus() *EngineEventBus {
+	return &EngineEventBus{
+		subscribers:      map[uint32]*engineEventSubscriber{},
+		nextSubscriberId: 0,
+		mutex:            &sync.Mutex{},
+	}
+}
+
+// Publish fills in the ID, the timestamp if it's missing, and the text of the event, and hands it to the subscribers
+// whose filter it matches. It never blocks: the event is dropped for the subscribers too far behind.
+func (bus *EngineEventBus) Publish(event *EngineEvent) {
+	eventId, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		logrus.Warnf("An error occurred generating the ID of engine event '%v', it was dropped:\n%v", event.Type, err)
+		return
+	}
+	event.Id = eventId
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	event.Text = getEngineEventText(event)
+
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+	for subscriberId, subscriber := range bus.subscribers {
+		if !subscriber.filter.Matches(event) {
+			continue
+		}
+		select {
+		case subscriber.eventsChan <- event:
+		default:
+			logrus.Warnf("Subscriber '%d' to the engine events is too far behind, engine event '%v' of enclave '%v' was dropped", subscriberId, event.Type, event.EnclaveName)
+		}
+	}
+}
+
+// Subscribe returns a channel receiving the events matching the filter, and the function to call to unsubscribe,
+// which closes the channel
+func (bus *EngineEventBus) Subscribe(filter *EngineEventFilter) (<-chan *EngineEvent, func()) {
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+	subscriberId := bus.nextSubscriberId
+	bus.nextSubscriberId++
+	eventsChan := make(chan *EngineEvent, subscriberChanBufferSize)
+	bus.subscribers[subscriberId] = &engineEventSubscriber{
+		filter:     filter,
+		eventsChan: eventsChan,
+	}
+
+	unsubscribe := func() {
+		bus.mutex.Lock()
+		defer bus.mutex.Unlock()
+		if _, found := bus.subscribers[subscriberId]; !found {
+			return
+		}
+		delete(bus.subscribers, subscriberId)
+		close(eventsChan)
+	}
+	return eventsChan, unsubscribe
+}
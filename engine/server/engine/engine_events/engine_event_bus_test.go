@@ -0,0 +1,49 @@
+package engine_events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testTimestamp = time.Date(2024, 5, 1, 2, 0, 0, 0, time.UTC)
+
+func TestEngineEventBus_PublishesToMatchingSubscribers(t *testing.T) {
+	bus := NewEngineEventBus()
+	crashesChan, unsubscribeCrashes := bus.Subscribe(NewEngineEventFilter([]EngineEventType{EngineEventType_SERVICE_CRASHED}, nil, nil, false))
+	defer unsubscribeCrashes()
+	allEventsChan, unsubscribeAll := bus.Subscribe(NewEngineEventFilter(nil, nil, nil, false))
+	defer unsubscribeAll()
+
+	serviceName := "el-1-geth-lighthouse"
+	crash := newTestEngineEvent(EngineEventType_SERVICE_CRASHED)
+	crash.ServiceName = &serviceName
+	crash.Message = "Exit code 1"
+	bus.Publish(newTestEngineEvent(EngineEventType_ENCLAVE_CREATED))
+	bus.Publish(crash)
+
+	require.Equal(t, EngineEventType_ENCLAVE_CREATED, (<-allEventsChan).Type)
+	publishedCrash := <-allEventsChan
+	require.Equal(t, crash, publishedCrash)
+	require.Equal(t, crash, <-crashesChan)
+	require.NotEmpty(t, publishedCrash.Id)
+	require.Equal(t, testTimestamp, publishedCrash.Timestamp)
+	require.Equal(t, "Service 'el-1-geth-lighthouse' crashed in enclave 'nightly-devnet':\nExit code 1", publishedCrash.Text)
+	require.Empty(t, crashesChan)
+}
+
+func TestEngineEventBus_DropsEventsForSlowSubscribers(t *testing.T) {
+	bus := NewEngineEventBus()
+	eventsChan, unsubscribe := bus.Subscribe(NewEngineEventFilter(nil, nil, nil, false))
+
+	for i := 0; i < subscriberChanBufferSize+1; i++ {
+		bus.Publish(newTestEngineEvent(EngineEventType_ENCLAVE_CREATED))
+	}
+	require.Len(t, eventsChan, subscriberChanBufferSize)
+
+	unsubscribe()
+	// Unsubscribing twice is harmless
+	unsubscribe()
+	bus.Publish(newTestEngineEvent(EngineEventType_ENCLAVE_CREATED))
+}
@@ -0,0 +1,81 @@
+package engine_events
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+)
+
+// EnclaveLabelsMatcher tells whether the labels of an enclave match, e.g. an enclave label selector
+type EnclaveLabelsMatcher interface {
+	Matches(labels map[string]string) bool
+}
+
+// EngineEventFilter selects the events a subscriber or a webhook gets. The filters left empty or nil match every event.
+type EngineEventFilter struct {
+	eventTypes map[EngineEventType]bool
+
+	// UUIDs, shortened UUIDs or names of the enclaves
+	enclaveIdentifiers map[string]bool
+
+	enclaveLabelsMatcher EnclaveLabelsMatcher
+
+	// Drops the Starlark run started events and the successful Starlark run finished events
+	onlyFailedStarlarkRuns bool
+}
+
+func NewEngineEventFilter(
+	eventTypes []EngineEventType,
+	enclaveIdentifiers []string,
+	enclaveLabelsMatcher EnclaveLabelsMatcher,
+	onlyFailedStarlarkRuns bool,
+) *EngineEventFilter {
+	eventTypesSet := map[EngineEventType]bool{}
+	for _, eventType := range eventTypes {
+		eventTypesSet[eventType] = true
+	}
+	enclaveIdentifiersSet := map[string]bool{}
+	for _, enclaveIdentifier := range enclaveIdentifiers {
+		enclaveIdentifiersSet[enclaveIdentifier] = true
+	}
+	return &EngineEventFilter{
+		eventTypes:             eventTypesSet,
+		enclaveIdentifiers:     enclaveIdentifiersSet,
+		enclaveLabelsMatcher:   enclaveLabelsMatcher,
+		onlyFailedStarlarkRuns: onlyFailedStarlarkRuns,
+	}
+}
+
+func (filter *EngineEventFilter) Matches(event *EngineEvent) bool {
+	if len(filter.eventTypes) > 0 && !filter.eventTypes[event.Type] {
+		return false
+	}
+	if len(filter.enclaveIdentifiers) > 0 &&
+		!filter.enclaveIdentifiers[event.EnclaveUuid] &&
+		!filter.enclaveIdentifiers[uuid_generator.ShortenedUUIDString(event.EnclaveUuid)] &&
+		!filter.enclaveIdentifiers[event.EnclaveName] {
+		return false
+	}
+	if filter.enclaveLabelsMatcher != nil && !filter.enclaveLabelsMatcher.Matches(event.EnclaveLabels) {
+		return false
+	}
+	if filter.onlyFailedStarlarkRuns {
+		switch event.Type {
+		case EngineEventType_STARLARK_RUN_STARTED:
+			return false
+		case EngineEventType_STARLARK_RUN_FINISHED:
+			if event.IsStarlarkRunSuccessful == nil || *event.IsStarlarkRunSuccessful {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ParseEngineEventType returns the event type with the given name, e.g. 'STARLARK_RUN_FINISHED'
+func ParseEngineEventType(eventTypeStr string) (EngineEventType, bool) {
+	for _, eventType := range AllEngineEventTypes {
+		if string(eventType) == eventTypeStr {
+			return eventType, true
+		}
+	}
+	return "", false
+}
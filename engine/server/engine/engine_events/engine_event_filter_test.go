@@ -0,0 +1,97 @@
+package engine_events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEnclaveUuid = "4b7a3e6c1f2d4e8a9b0c1d2e3f4a5b6c"
+	testEnclaveName = "nightly-devnet"
+)
+
+type testLabelsMatcher struct {
+	key   string
+	value string
+}
+
+func (matcher *testLabelsMatcher) Matches(labels map[string]string) bool {
+	return labels[matcher.key] == matcher.value
+}
+
+func TestAllEngineEventTypesHaveText(t *testing.T) {
+	for _, eventType := range AllEngineEventTypes {
+		event := newTestEngineEvent(eventType)
+		require.NotContains(t, getEngineEventText(event), "Event '", "Event type '%v' has no summary", eventType)
+	}
+}
+
+func TestEngineEventFilter_MatchesEverythingWhenEmpty(t *testing.T) {
+	filter := NewEngineEventFilter(nil, nil, nil, false)
+	for _, eventType := range AllEngineEventTypes {
+		require.True(t, filter.Matches(newTestEngineEvent(eventType)))
+	}
+}
+
+func TestEngineEventFilter_EventTypes(t *testing.T) {
+	filter := NewEngineEventFilter([]EngineEventType{EngineEventType_SERVICE_CRASHED}, nil, nil, false)
+	require.True(t, filter.Matches(newTestEngineEvent(EngineEventType_SERVICE_CRASHED)))
+	require.False(t, filter.Matches(newTestEngineEvent(EngineEventType_SERVICE_STARTED)))
+}
+
+func TestEngineEventFilter_EnclaveIdentifiers(t *testing.T) {
+	event := newTestEngineEvent(EngineEventType_ENCLAVE_CREATED)
+	require.True(t, NewEngineEventFilter(nil, []string{testEnclaveUuid}, nil, false).Matches(event))
+	require.True(t, NewEngineEventFilter(nil, []string{testEnclaveUuid[:12]}, nil, false).Matches(event))
+	require.True(t, NewEngineEventFilter(nil, []string{testEnclaveName}, nil, false).Matches(event))
+	require.False(t, NewEngineEventFilter(nil, []string{"other-enclave"}, nil, false).Matches(event))
+}
+
+func TestEngineEventFilter_EnclaveLabels(t *testing.T) {
+	event := newTestEngineEvent(EngineEventType_ENCLAVE_CREATED)
+	require.True(t, NewEngineEventFilter(nil, nil, &testLabelsMatcher{key: "purpose", value: "nightly"}, false).Matches(event))
+	require.False(t, NewEngineEventFilter(nil, nil, &testLabelsMatcher{key: "purpose", value: "dev"}, false).Matches(event))
+}
+
+func TestEngineEventFilter_OnlyFailedStarlarkRuns(t *testing.T) {
+	filter := NewEngineEventFilter(nil, nil, nil, true)
+	isSuccessful := true
+	successfulRun := newTestEngineEvent(EngineEventType_STARLARK_RUN_FINISHED)
+	successfulRun.IsStarlarkRunSuccessful = &isSuccessful
+	isFailed := false
+	failedRun := newTestEngineEvent(EngineEventType_STARLARK_RUN_FINISHED)
+	failedRun.IsStarlarkRunSuccessful = &isFailed
+
+	require.True(t, filter.Matches(failedRun))
+	require.False(t, filter.Matches(successfulRun))
+	require.False(t, filter.Matches(newTestEngineEvent(EngineEventType_STARLARK_RUN_STARTED)))
+	// The other events aren't Starlark runs, so the event types filter them instead
+	require.True(t, filter.Matches(newTestEngineEvent(EngineEventType_ENCLAVE_CREATED)))
+}
+
+func TestParseEngineEventType(t *testing.T) {
+	eventType, found := ParseEngineEventType("SERVICE_CRASHED")
+	require.True(t, found)
+	require.Equal(t, EngineEventType_SERVICE_CRASHED, eventType)
+
+	_, found = ParseEngineEventType("SERVICE_EXPLODED")
+	require.False(t, found)
+}
+
+func newTestEngineEvent(eventType EngineEventType) *EngineEvent {
+	return &EngineEvent{
+		Id:                      "",
+		Type:                    eventType,
+		Timestamp:               testTimestamp,
+		EnclaveUuid:             testEnclaveUuid,
+		EnclaveName:             testEnclaveName,
+		EnclaveLabels:           map[string]string{"purpose": "nightly"},
+		ServiceName:             nil,
+		FilesArtifactName:       nil,
+		StarlarkPackageId:       nil,
+		IsStarlarkRunSuccessful: nil,
+		Message:                 "",
+		Text:                    "",
+	}
+}
@@ -0,0 +1,166 @@
+package engine_events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultWebhookMaxRetries = 5
+
+	webhookRequestTimeout = 10 * time.Second
+
+	// Doubles after every failed delivery
+	initialWebhookRetryBackoff = 1 * time.Second
+
+	eventTypeHeader  = "X-Kurtosis-Event"
+	deliveryIdHeader = "X-Kurtosis-Delivery"
+	timestampHeader  = "X-Kurtosis-Timestamp"
+	signatureHeader  = "X-Kurtosis-Signature-256"
+
+	signaturePrefix = "sha256="
+
+	// The signed payload is the timestamp, this separator and the body, so that a captured request can't be replayed
+	// later with a new timestamp
+	signedPayloadSeparator = "."
+
+	contentTypeHeader = "Content-Type"
+	jsonContentType   = "application/json"
+
+	maxWebhookResponseBodyLengthLogged = 512
+)
+
+// EngineEventWebhook posts the engine events matching its filter to a URL, one at a time and in order, retrying with
+// an exponential backoff when the URL can't be reached or answers with a server error
+type EngineEventWebhook struct {
+	name string
+
+	url string
+
+	// Empty if the requests aren't signed
+	secret string
+
+	maxRetries uint32
+
+	filter *EngineEventFilter
+
+	httpClient *http.Client
+
+	initialRetryBackoff time.Duration
+}
+
+// NewEngineEventWebhook creates a webhook; zero max retries means the default number of retries
+func NewEngineEventWebhook(name string, url string, secret string, maxRetries uint32, filter *EngineEventFilter) *EngineEventWebhook {
+	if maxRetries == 0 {
+		maxRetries = defaultWebhookMaxRetries
+	}
+	return &EngineEventWebhook{
+		name:       name,
+		url:        url,
+		secret:     secret,
+		maxRetries: maxRetries,
+		filter:     filter,
+		httpClient: &http.Client{
+			Timeout: webhookRequestTimeout,
+		},
+		initialRetryBackoff: initialWebhookRetryBackoff,
+	}
+}
+
+// Run posts the events published on the bus until the context gets cancelled
+func (webhook *EngineEventWebhook) Run(ctx context.Context, bus *EngineEventBus) {
+	eventsChan, unsubscribe := bus.Subscribe(webhook.filter)
+	defer unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, isChanOpen := <-eventsChan:
+			if !isChanOpen {
+				return
+			}
+			if err := webhook.deliver(ctx, event); err != nil {
+				logrus.Errorf("Engine event '%v' of enclave '%v' couldn't be posted to webhook '%v', it was dropped:\n%v", event.Type, event.EnclaveName, webhook.name, err)
+			}
+		}
+	}
+}
+
+func (webhook *EngineEventWebhook) deliver(ctx context.Context, event *EngineEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing engine event '%v' to JSON", event.Id)
+	}
+
+	backoff := webhook.initialRetryBackoff
+	var lastErr error
+	for attempt := uint32(0); attempt <= webhook.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return stacktrace.Propagate(ctx.Err(), "The engine stopped before engine event '%v' could be posted", event.Id)
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+		shouldRetry, err := webhook.post(ctx, event, body)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !shouldRetry {
+			return stacktrace.Propagate(err, "Webhook '%v' rejected engine event '%v'", webhook.name, event.Id)
+		}
+		logrus.Debugf("Attempt %d to post engine event '%v' to webhook '%v' failed:\n%v", attempt+1, event.Id, webhook.name, err)
+	}
+	return stacktrace.Propagate(lastErr, "Engine event '%v' couldn't be posted to webhook '%v' after %d retries", event.Id, webhook.name, webhook.maxRetries)
+}
+
+// post sends the event once, and returns whether it's worth retrying when it fails
+func (webhook *EngineEventWebhook) post(ctx context.Context, event *EngineEvent, body []byte) (bool, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.url, bytes.NewReader(body))
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred creating the request to URL '%v'", webhook.url)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request.Header.Set(contentTypeHeader, jsonContentType)
+	request.Header.Set(eventTypeHeader, string(event.Type))
+	request.Header.Set(deliveryIdHeader, event.Id)
+	request.Header.Set(timestampHeader, timestamp)
+	if webhook.secret != "" {
+		request.Header.Set(signatureHeader, getWebhookSignature(webhook.secret, timestamp, body))
+	}
+
+	response, err := webhook.httpClient.Do(request)
+	if err != nil {
+		return true, stacktrace.Propagate(err, "An error occurred posting to URL '%v'", webhook.url)
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+	responseBody, _ := io.ReadAll(io.LimitReader(response.Body, maxWebhookResponseBodyLengthLogged))
+	shouldRetry := response.StatusCode >= http.StatusInternalServerError || response.StatusCode == http.StatusTooManyRequests
+	return shouldRetry, stacktrace.NewError("URL '%v' answered with status '%v' and body '%v'", webhook.url, response.Status, string(responseBody))
+}
+
+// getWebhookSignature is what the receivers compute with the secret to check that a request comes from the engine:
+// the hex-encoded HMAC-SHA256 of the timestamp header, a dot and the body
+func getWebhookSignature(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + signedPayloadSeparator))
+	mac.Write(body)
+	return fmt.Sprintf("%v%v", signaturePrefix, hex.EncodeToString(mac.Sum(nil)))
+}
@@ -0,0 +1,84 @@
+package engine_events
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testWebhookSecret = "my-secret"
+
+	testInitialRetryBackoff = time.Millisecond
+)
+
+func TestGetWebhookSignature(t *testing.T) {
+	// Computed with: printf '1714528800.{"type":"ENCLAVE_CREATED"}' | openssl dgst -sha256 -hmac my-secret
+	signature := getWebhookSignature(testWebhookSecret, "1714528800", []byte(`{"type":"ENCLAVE_CREATED"}`))
+	require.Equal(t, "sha256=aee34e5fe0c4aad36f052029d713fd693cd733e419c93342cc4b666904cc0d9b", signature)
+}
+
+func TestEngineEventWebhook_SignsAndRetries(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		require.Equal(t, "ENCLAVE_CREATED", request.Header.Get(eventTypeHeader))
+		require.NotEmpty(t, request.Header.Get(deliveryIdHeader))
+		expectedSignature := getWebhookSignature(testWebhookSecret, request.Header.Get(timestampHeader), body)
+		require.Equal(t, expectedSignature, request.Header.Get(signatureHeader))
+
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writer.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	webhook := newTestEngineEventWebhook(server.URL, testWebhookSecret, 5)
+	event := newTestEngineEvent(EngineEventType_ENCLAVE_CREATED)
+	event.Id = "test-delivery"
+	require.NoError(t, webhook.deliver(context.Background(), event))
+	require.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestEngineEventWebhook_GivesUpAfterMaxRetries(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		writer.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	webhook := newTestEngineEventWebhook(server.URL, "", 2)
+	require.Error(t, webhook.deliver(context.Background(), newTestEngineEvent(EngineEventType_ENCLAVE_CREATED)))
+	require.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestEngineEventWebhook_DoesNotRetryClientErrors(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		// No secret, no signature
+		require.Empty(t, request.Header.Get(signatureHeader))
+		writer.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	webhook := newTestEngineEventWebhook(server.URL, "", 5)
+	require.Error(t, webhook.deliver(context.Background(), newTestEngineEvent(EngineEventType_ENCLAVE_CREATED)))
+	require.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
+
+func newTestEngineEventWebhook(url string, secret string, maxRetries uint32) *EngineEventWebhook {
+	webhook := NewEngineEventWebhook("test", url, secret, maxRetries, NewEngineEventFilter(nil, nil, nil, false))
+	webhook.initialRetryBackoff = testInitialRetryBackoff
+	return webhook
+}
@@ -0,0 +1,178 @@
+package engine_metrics
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	namespace = "kurtosis"
+
+	// The engine serves its metrics on its REST API port, outside of the API path
+	MetricsPath = "/metrics"
+
+	// Where the engine lists the metrics endpoints of the API containers, in the Prometheus HTTP service discovery format
+	ApiContainerScrapeTargetsPath = "/metrics/targets"
+
+	statusLabel = "status"
+
+	// Empty for the requests of empty enclaves
+	enclavePoolTemplateLabel = "template"
+
+	enclavePoolRequestResultLabel = "result"
+	enclavePoolRequestHit         = "hit"
+	enclavePoolRequestMiss        = "miss"
+
+	EnclaveUuidScrapeTargetLabel = "enclave_uuid"
+	EnclaveNameScrapeTargetLabel = "enclave_name"
+
+	getEnclavesForPrometheusTimeout = 10 * time.Second
+)
+
+var (
+	enclavesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "enclaves"),
+		"Number of enclaves, by status",
+		[]string{statusLabel},
+		nil,
+	)
+
+	ServiceLogLinesStreamed = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "service_log_lines_streamed_total",
+		Help:      "Number of service log lines the engine streamed to its clients",
+	})
+
+	ActiveServiceLogStreams = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "service_log_streams_active",
+		Help:      "Number of service log streams the engine is currently serving",
+	})
+
+	EnclavePoolRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "enclave_pool_requests_total",
+		Help:      "Number of enclaves requested from the enclave pool, by template and by whether a warm enclave was handed out (hit) or the enclave had to be created (miss)",
+	}, []string{enclavePoolTemplateLabel, enclavePoolRequestResultLabel})
+
+	EnclavePoolIdleEnclaves = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "enclave_pool_idle_enclaves",
+		Help:      "Number of warm enclaves ready to be handed out by the enclave pool, by template",
+	}, []string{enclavePoolTemplateLabel})
+)
+
+func init() {
+	prometheus.MustRegister(ServiceLogLinesStreamed, ActiveServiceLogStreams, EnclavePoolRequests, EnclavePoolIdleEnclaves)
+}
+
+// ApiContainerScrapeTargetGroup is a target group of the Prometheus HTTP service discovery
+// See https://prometheus.io/docs/prometheus/latest/http_sd/
+type ApiContainerScrapeTargetGroup struct {
+	Targets []string          `json:"targets"`
+	Labels  map[string]string `json:"labels"`
+}
+
+// enclavesGetter is implemented by the enclave manager
+type enclavesGetter interface {
+	GetEnclaves(ctx context.Context) (map[string]*types.EnclaveInfo, error)
+}
+
+// enclavesCollector counts the enclaves when Prometheus scrapes the engine, so the numbers are never stale
+type enclavesCollector struct {
+	enclaveManager enclavesGetter
+}
+
+// NewEnclavesCollector creates the Prometheus collector of the number of enclaves by status
+func NewEnclavesCollector(enclaveManager enclavesGetter) prometheus.Collector {
+	return &enclavesCollector{enclaveManager: enclaveManager}
+}
+
+func (collector *enclavesCollector) Describe(descs chan<- *prometheus.Desc) {
+	descs <- enclavesDesc
+}
+
+func (collector *enclavesCollector) Collect(metrics chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), getEnclavesForPrometheusTimeout)
+	defer cancel()
+	enclaves, err := collector.enclaveManager.GetEnclaves(ctx)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the enclaves; they're left out of the Prometheus metrics. Error:\n%v", err)
+		return
+	}
+
+	// Every status gets reported, even with no enclave, so that they don't disappear from the graphs
+	numEnclavesByStatus := map[types.EnclaveStatus]int{
+		types.EnclaveStatus_RUNNING: 0,
+		types.EnclaveStatus_STOPPED: 0,
+		types.EnclaveStatus_EMPTY:   0,
+	}
+	for _, enclaveInfo := range enclaves {
+		numEnclavesByStatus[enclaveInfo.EnclaveStatus]++
+	}
+	for status, numEnclaves := range numEnclavesByStatus {
+		metrics <- prometheus.MustNewConstMetric(enclavesDesc, prometheus.GaugeValue, float64(numEnclaves), string(status))
+	}
+}
+
+// CountServiceLogLines adds the log lines about to be streamed to a client to the total
+func CountServiceLogLines(serviceLogsByServiceUuid map[user_service.ServiceUUID][]logline.LogLine) {
+	numLogLines := 0
+	for _, serviceLogs := range serviceLogsByServiceUuid {
+		numLogLines += len(serviceLogs)
+	}
+	ServiceLogLinesStreamed.Add(float64(numLogLines))
+}
+
+// CountEnclavePoolRequest counts an enclave requested from the enclave pool, as a hit if a warm enclave was handed out
+func CountEnclavePoolRequest(templateName string, isHit bool) {
+	result := enclavePoolRequestMiss
+	if isHit {
+		result = enclavePoolRequestHit
+	}
+	EnclavePoolRequests.WithLabelValues(templateName, result).Inc()
+}
+
+// SetEnclavePoolIdleEnclaves sets how many warm enclaves of the template are ready in the enclave pool
+func SetEnclavePoolIdleEnclaves(templateName string, numIdleEnclaves int) {
+	EnclavePoolIdleEnclaves.WithLabelValues(templateName).Set(float64(numIdleEnclaves))
+}
+
+// GetApiContainerScrapeTargets returns the metrics endpoint of the API container of every running enclave, labelled
+// with the enclave it belongs to
+func GetApiContainerScrapeTargets(ctx context.Context, enclaveManager enclavesGetter, metricsPortNum uint16) ([]*ApiContainerScrapeTargetGroup, error) {
+	enclaves, err := enclaveManager.GetEnclaves(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+
+	targetGroups := []*ApiContainerScrapeTargetGroup{}
+	for _, enclaveInfo := range enclaves {
+		if enclaveInfo.ApiContainerStatus != types.ContainerStatus_RUNNING || enclaveInfo.ApiContainerInfo == nil {
+			continue
+		}
+		apiContainerIp := enclaveInfo.ApiContainerInfo.GetIpReachableFromEngine()
+		targetGroups = append(targetGroups, &ApiContainerScrapeTargetGroup{
+			Targets: []string{net.JoinHostPort(apiContainerIp, fmt.Sprint(metricsPortNum))},
+			Labels: map[string]string{
+				EnclaveUuidScrapeTargetLabel: enclaveInfo.EnclaveUuid,
+				EnclaveNameScrapeTargetLabel: enclaveInfo.Name,
+			},
+		})
+	}
+	// Sorted so that Prometheus doesn't see a change when there's none
+	sort.Slice(targetGroups, func(i, j int) bool {
+		return targetGroups[i].Labels[EnclaveUuidScrapeTargetLabel] < targetGroups[j].Labels[EnclaveUuidScrapeTargetLabel]
+	})
+	return targetGroups, nil
+}
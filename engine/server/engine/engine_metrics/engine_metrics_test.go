@@ -0,0 +1,95 @@
+package engine_metrics
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMetricsPortNum = 9090
+)
+
+type testEnclavesGetter struct {
+	enclaves map[string]*types.EnclaveInfo
+}
+
+func (getter *testEnclavesGetter) GetEnclaves(ctx context.Context) (map[string]*types.EnclaveInfo, error) {
+	return getter.enclaves, nil
+}
+
+func newTestEnclavesGetter() *testEnclavesGetter {
+	return &testEnclavesGetter{
+		enclaves: map[string]*types.EnclaveInfo{
+			"running-uuid": newTestEnclaveInfo("running-uuid", "running", types.EnclaveStatus_RUNNING, types.ContainerStatus_RUNNING, "172.17.0.3", "10.1.0.2"),
+			"k8s-uuid":     newTestEnclaveInfo("k8s-uuid", "k8s", types.EnclaveStatus_RUNNING, types.ContainerStatus_RUNNING, "", "10.2.0.2"),
+			"stopped-uuid": newTestEnclaveInfo("stopped-uuid", "stopped", types.EnclaveStatus_STOPPED, types.ContainerStatus_STOPPED, "", ""),
+		},
+	}
+}
+
+func TestEnclavesCollector(t *testing.T) {
+	collector := NewEnclavesCollector(newTestEnclavesGetter())
+	expectedMetrics := `
+# HELP kurtosis_enclaves Number of enclaves, by status
+# TYPE kurtosis_enclaves gauge
+kurtosis_enclaves{status="EMPTY"} 0
+kurtosis_enclaves{status="RUNNING"} 2
+kurtosis_enclaves{status="STOPPED"} 1
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expectedMetrics)))
+}
+
+func TestGetApiContainerScrapeTargets(t *testing.T) {
+	targetGroups, err := GetApiContainerScrapeTargets(context.Background(), newTestEnclavesGetter(), testMetricsPortNum)
+	require.NoError(t, err)
+	require.Equal(t, []*ApiContainerScrapeTargetGroup{
+		{
+			Targets: []string{"10.2.0.2:9090"},
+			Labels:  map[string]string{EnclaveUuidScrapeTargetLabel: "k8s-uuid", EnclaveNameScrapeTargetLabel: "k8s"},
+		},
+		{
+			Targets: []string{"172.17.0.3:9090"},
+			Labels:  map[string]string{EnclaveUuidScrapeTargetLabel: "running-uuid", EnclaveNameScrapeTargetLabel: "running"},
+		},
+	}, targetGroups)
+}
+
+func TestCountEnclavePoolRequest(t *testing.T) {
+	CountEnclavePoolRequest("postgres", true)
+	CountEnclavePoolRequest("postgres", true)
+	CountEnclavePoolRequest("postgres", false)
+	require.Equal(t, float64(2), testutil.ToFloat64(EnclavePoolRequests.WithLabelValues("postgres", enclavePoolRequestHit)))
+	require.Equal(t, float64(1), testutil.ToFloat64(EnclavePoolRequests.WithLabelValues("postgres", enclavePoolRequestMiss)))
+}
+
+func newTestEnclaveInfo(
+	enclaveUuid string,
+	name string,
+	enclaveStatus types.EnclaveStatus,
+	apiContainerStatus types.ContainerStatus,
+	bridgeIpAddress string,
+	ipInsideEnclave string,
+) *types.EnclaveInfo {
+	var apiContainerInfo *types.EnclaveAPIContainerInfo
+	if apiContainerStatus == types.ContainerStatus_RUNNING {
+		apiContainerInfo = &types.EnclaveAPIContainerInfo{
+			BridgeIpAddress:       bridgeIpAddress,
+			ContainerId:           "",
+			GrpcPortInsideEnclave: 0,
+			IpInsideEnclave:       ipInsideEnclave,
+		}
+	}
+	// nolint:exhaustruct
+	return &types.EnclaveInfo{
+		ApiContainerInfo:   apiContainerInfo,
+		ApiContainerStatus: apiContainerStatus,
+		EnclaveStatus:      enclaveStatus,
+		EnclaveUuid:        enclaveUuid,
+		Name:               name,
+	}
+}
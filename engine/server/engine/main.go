@@ -7,9 +7,11 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
@@ -17,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
 	enclaveApi "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/server/core_rest_api"
@@ -27,8 +30,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_publish_spec"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
 	em_api "github.com/kurtosis-tech/kurtosis/enclave-manager/server"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
@@ -41,6 +46,9 @@ import (
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/stream_logs_strategy"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_filesystem"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_auth"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_events"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_metrics"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/server"
 	restApi "github.com/kurtosis-tech/kurtosis/engine/server/engine/server"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/streaming"
@@ -52,6 +60,8 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
 )
@@ -84,11 +94,14 @@ const (
 	pathToEnclaveSpecs   = "/specs/enclave"
 	pathToEngineSpecs    = "/specs/engine"
 	pathToWebsocketSpecs = "/specs/websocket"
+
+	httpScheme  = "http"
+	httpsScheme = "https"
 )
 
 var (
 	defaultCORSOrigins []string = []string{"*"}
-	defaultCORSHeaders []string = []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept}
+	defaultCORSHeaders []string = []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization}
 )
 
 // Nil indicates that the KurtosisBackend should not operate in API container mode, which is appropriate here
@@ -145,6 +158,11 @@ func runMain() error {
 	}
 	logrus.SetLevel(logLevel)
 
+	// The ranges are handed to the enclaves as-is, so they're only parsed here to catch mistakes before any enclave gets them
+	if _, err := port_publish_spec.ParseHostPortRanges(serverArgs.HostPortRanges); err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the host port ranges '%v'", serverArgs.HostPortRanges)
+	}
+
 	backendConfig := serverArgs.KurtosisLocalBackendConfig
 	if backendConfig == nil {
 		return stacktrace.NewError("Backend configuration parameters are null - there must be backend configuration parameters.")
@@ -177,6 +195,16 @@ func runMain() error {
 	logFileManager := log_file_manager.NewLogFileManager(kurtosisBackend, osFs, realTime)
 	logFileManager.StartLogFileManagement(ctx)
 
+	// The API containers check the access tokens the engine issues when the engine requires authentication, so that
+	// they can't be used to get around it
+	var apiContainerAccessSecret []byte
+	if serverArgs.AuthConfig != nil {
+		apiContainerAccessSecret, err = engine_auth.LoadApiContainerAccessSecret(consts.EngineDataDirPath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred loading the secret the access tokens of the API containers are signed with")
+		}
+	}
+
 	enclaveManager, err := getEnclaveManager(
 		kurtosisBackend,
 		serverArgs.KurtosisBackendType,
@@ -189,10 +217,39 @@ func runMain() error {
 		serverArgs.IsCI,
 		serverArgs.CloudUserID,
 		serverArgs.CloudInstanceID,
-		serverArgs.KurtosisLocalBackendConfig)
+		serverArgs.KurtosisLocalBackendConfig,
+		serverArgs.HostPortRanges,
+		serverArgs.ResourceQuotas,
+		serverArgs.OtlpTracesEndpoint,
+		serverArgs.MetricsSink,
+		serverArgs.EnclavePoolTemplates,
+		apiContainerAccessSecret)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to create an enclave manager for backend type '%v' and config '%+v'", serverArgs.KurtosisBackendType, backendConfig)
 	}
+	enclaveManager.AddEnclaveExpiryEventHandler(enclave_manager.LogEnclaveExpiryEvent)
+	go enclaveManager.RunEnclaveReaper(ctx)
+
+	engineEventWebhooks, err := getEngineEventWebhooks(serverArgs.Webhooks)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting up the webhooks the engine events get posted to")
+	}
+	go enclaveManager.RunEnclaveEventCollector(ctx)
+	go enclaveManager.RunResourceQuotaBudgetUpdater(ctx)
+	for _, webhook := range engineEventWebhooks {
+		go webhook.Run(ctx, enclaveManager.GetEngineEventBus())
+	}
+
+	// Nil when the engine APIs are open to anyone who can reach them
+	var engineAuth *engine_auth.EngineAuth
+	if serverArgs.AuthConfig != nil {
+		engineAuth, err = engine_auth.NewEngineAuth(serverArgs.AuthConfig, consts.EngineDataDirPath, enclaveManager.GetEnclaveUuidForEnclaveIdentifier, apiContainerAccessSecret)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred setting up the authentication of the engine APIs")
+		}
+		enclaveManager.SetEnclaveOwnerGetter(engineAuth.GetEnclaveOwner)
+		logrus.Info("Authentication and authorization are enabled on the engine APIs")
+	}
 
 	go func() {
 		fileServer := http.FileServer(http.Dir(pathToStaticFolder))
@@ -235,6 +292,12 @@ func runMain() error {
 		}
 	}()
 
+	// The metrics sink file path is a path on the CLI's host, so the engine writes its events to its own data directory
+	var metricsSinkConfig *metrics_client.SinkConfig
+	if serverArgs.MetricsSink != nil {
+		metricsSinkConfig = serverArgs.MetricsSink.WithFileInDirectory(consts.EngineDataDirPath)
+	}
+
 	logger := logrus.StandardLogger()
 	metricsClient, closeClientFunc, err := metrics_client.CreateMetricsClient(
 		metrics_client.NewMetricsClientCreatorOption(
@@ -249,6 +312,7 @@ func runMain() error {
 			serverArgs.IsCI,
 			serverArgs.CloudUserID,
 			serverArgs.CloudInstanceID,
+			metricsSinkConfig,
 		),
 	)
 	if err != nil {
@@ -268,6 +332,7 @@ func runMain() error {
 			logsDatabaseClient,
 			logFileManager,
 			metricsClient,
+			engineAuth,
 		)
 		if err != nil {
 			logrus.Fatal("The REST API server is down, exiting!", err)
@@ -284,14 +349,23 @@ func runMain() error {
 		logsDatabaseClient,
 		logFileManager,
 		metricsClient)
-	apiPath, handler := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceHandler(engineConnectServer)
+	var handlerOptions []connect.HandlerOption
+	if engineAuth != nil {
+		handlerOptions = append(handlerOptions, connect.WithInterceptors(engineAuth.NewConnectInterceptor()))
+	}
+	apiPath, handler := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceHandler(engineConnectServer, handlerOptions...)
 	defer func() {
 		if err := engineConnectServer.Close(); err != nil {
 			logrus.Errorf("We tried to close the engine connect server service but something fails. Err:\n%v", err)
 		}
 	}()
 
-	engineHttpServer := connect_server.NewConnectServer(serverArgs.GrpcListenPortNum, grpcServerStopGracePeriod, handler, apiPath)
+	var tlsConfig *tls.Config
+	if engineAuth != nil {
+		handler = engineAuth.WrapHandler(handler)
+		tlsConfig = engineAuth.GetTlsConfig()
+	}
+	engineHttpServer := connect_server.NewConnectServerWithTls(serverArgs.GrpcListenPortNum, grpcServerStopGracePeriod, handler, apiPath, tlsConfig)
 	if err := engineHttpServer.RunServerUntilInterruptedWithCors(cors.AllowAll()); err != nil {
 		return stacktrace.Propagate(err, "An error occurred running the server.")
 	}
@@ -312,6 +386,12 @@ func getEnclaveManager(
 	cloudUserId metrics_client.CloudUserID,
 	cloudInstanceId metrics_client.CloudInstanceID,
 	kurtosisLocalBackendConfig interface{},
+	hostPortRanges string,
+	resourceQuotas []*args.ResourceQuotaConfig,
+	otlpTracesEndpoint string,
+	metricsSinkConfig *metrics_client.SinkConfig,
+	enclavePoolTemplates []*args.EnclavePoolTemplateConfig,
+	apiContainerAccessSecret []byte,
 ) (*enclave_manager.EnclaveManager, error) {
 	var apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
 	switch kurtosisBackendType {
@@ -340,6 +420,13 @@ func getEnclaveManager(
 		isCI,
 		cloudUserId,
 		cloudInstanceId,
+		hostPortRanges,
+		consts.EngineDataDirPath,
+		resourceQuotas,
+		otlpTracesEndpoint,
+		metricsSinkConfig,
+		enclavePoolTemplates,
+		apiContainerAccessSecret,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave manager for backend type '%+v' using pool-size '%v' and engine version '%v'", kurtosisBackendType, poolSize, engineVersion)
@@ -348,6 +435,39 @@ func getEnclaveManager(
 	return enclaveManager, nil
 }
 
+func getEngineEventWebhooks(webhookConfigs []*args.WebhookConfig) ([]*engine_events.EngineEventWebhook, error) {
+	webhooks := []*engine_events.EngineEventWebhook{}
+	webhookNames := map[string]bool{}
+	for _, webhookConfig := range webhookConfigs {
+		if webhookConfig.Name == "" {
+			return nil, stacktrace.NewError("Every webhook needs a name, but the one posting to '%v' has none", webhookConfig.Url)
+		}
+		if webhookNames[webhookConfig.Name] {
+			return nil, stacktrace.NewError("Webhook name '%v' is used more than once", webhookConfig.Name)
+		}
+		webhookNames[webhookConfig.Name] = true
+		webhookUrl, err := url.Parse(webhookConfig.Url)
+		if err != nil || (webhookUrl.Scheme != httpScheme && webhookUrl.Scheme != httpsScheme) {
+			return nil, stacktrace.NewError("The URL '%v' of webhook '%v' isn't a valid HTTP or HTTPS URL", webhookConfig.Url, webhookConfig.Name)
+		}
+		eventTypes := []engine_events.EngineEventType{}
+		for _, eventTypeStr := range webhookConfig.EventTypes {
+			eventType, found := engine_events.ParseEngineEventType(eventTypeStr)
+			if !found {
+				return nil, stacktrace.NewError("Webhook '%v' has unrecognized event type '%v'; the event types are: %v", webhookConfig.Name, eventTypeStr, engine_events.AllEngineEventTypes)
+			}
+			eventTypes = append(eventTypes, eventType)
+		}
+		filter, err := enclave_manager.NewEngineEventFilter(eventTypes, webhookConfig.EnclaveIdentifiers, webhookConfig.EnclaveLabelSelector, webhookConfig.OnlyFailedStarlarkRuns)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the filter of the events of webhook '%v'", webhookConfig.Name)
+		}
+		webhooks = append(webhooks, engine_events.NewEngineEventWebhook(webhookConfig.Name, webhookConfig.Url, webhookConfig.Secret, webhookConfig.MaxRetries, filter))
+		logrus.Infof("The engine events are posted to webhook '%v'", webhookConfig.Name)
+	}
+	return webhooks, nil
+}
+
 func getKurtosisBackend(ctx context.Context, kurtosisBackendType args.KurtosisBackendType, backendConfig interface{}, remoteBackendConfigMaybe *configs.KurtosisRemoteBackendConfig) (backend_interface.KurtosisBackend, error) {
 	var kurtosisBackend backend_interface.KurtosisBackend
 	var err error
@@ -414,6 +534,7 @@ func restApiServer(
 	logsDatabaseClient centralized_logs.LogsDatabaseClient,
 	logFileManager *log_file_manager.LogFileManager,
 	metricsClient metrics_client.MetricsClient,
+	engineAuth *engine_auth.EngineAuth,
 ) error {
 
 	asyncStarlarkLogs := streaming.NewStreamerPool[*kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](streamerPoolSize, streamerExpirationTime)
@@ -436,6 +557,13 @@ func restApiServer(
 		AllowHeaders: defaultCORSHeaders,
 	}))
 
+	// Registered after the CORS middleware, so that the CORS preflight requests don't need credentials
+	var engineStrictMiddlewares []engineApi.StrictMiddlewareFunc
+	if engineAuth != nil {
+		echoApiRouter.Use(engineAuth.NewEchoMiddleware(pathToApiGroup))
+		engineStrictMiddlewares = append(engineStrictMiddlewares, engineAuth.NewEngineRestStrictMiddleware())
+	}
+
 	// ============================== Engine Management API ======================================
 	engineRuntime := restApi.EngineRuntime{
 		ImageVersionTag: serverArgs.ImageVersionTag,
@@ -443,7 +571,7 @@ func restApiServer(
 		LogFileManager:  logFileManager,
 		MetricsClient:   metricsClient,
 	}
-	engineApi.RegisterHandlers(echoApiRouter, engineApi.NewStrictHandler(engineRuntime, nil))
+	engineApi.RegisterHandlers(echoApiRouter, engineApi.NewStrictHandler(engineRuntime, engineStrictMiddlewares))
 
 	// ============================== Logging API ======================================
 	// nolint:exhaustruct
@@ -472,6 +600,23 @@ func restApiServer(
 	}
 	enclaveApi.RegisterHandlers(echoApiRouter, enclaveApi.NewStrictHandler(enclaveRuntime, nil))
 
+	// ============================== Prometheus Metrics ======================================
+	prometheus.MustRegister(engine_metrics.NewEnclavesCollector(enclave_manager))
+	// Served outside of the API path so that Prometheus finds them where it expects, but behind the same auth
+	var metricsMiddlewares []echo.MiddlewareFunc
+	if engineAuth != nil {
+		metricsMiddlewares = append(metricsMiddlewares, engineAuth.NewEchoMiddleware(pathToApiGroup))
+	}
+	echoRouter.GET(engine_metrics.MetricsPath, echo.WrapHandler(promhttp.Handler()), metricsMiddlewares...)
+	echoApiRouter.GET(engine_metrics.ApiContainerScrapeTargetsPath, func(c echo.Context) error {
+		targetGroups, err := engine_metrics.GetApiContainerScrapeTargets(c.Request().Context(), enclave_manager, api_container.MetricsPortNum)
+		if err != nil {
+			logrus.Errorf("An error occurred getting the Prometheus scrape targets of the API containers:\n%v", err)
+			return echo.NewHTTPError(http.StatusInternalServerError, "An error occurred getting the Prometheus scrape targets of the API containers")
+		}
+		return c.JSON(http.StatusOK, targetGroups)
+	})
+
 	// ============================== Serve OpenAPI specs ======================================
 	// TODO (edgar) Move Spec service to Web Server
 	// =========================================================================================
@@ -500,5 +645,13 @@ func restApiServer(
 	}
 
 	// ============================== Start Server ======================================
-	return echoRouter.Start(net.JoinHostPort(engine.RESTAPIHostIP, fmt.Sprint(engine.RESTAPIPortAddr)))
+	restApiAddress := net.JoinHostPort(engine.RESTAPIHostIP, fmt.Sprint(engine.RESTAPIPortAddr))
+	if engineAuth != nil && engineAuth.GetTlsConfig() != nil {
+		// nolint:exhaustruct
+		return echoRouter.StartServer(&http.Server{
+			Addr:      restApiAddress,
+			TLSConfig: engineAuth.GetTlsConfig(),
+		})
+	}
+	return echoRouter.Start(restApiAddress)
 }
@@ -74,6 +74,10 @@ func ToHttpEnclaveMode(mode types.EnclaveMode) api_type.EnclaveMode {
 }
 
 func ToHttpEnclaveInfo(info types.EnclaveInfo) api_type.EnclaveInfo {
+	var labels *map[string]string
+	if len(info.Labels) > 0 {
+		labels = &info.Labels
+	}
 	return api_type.EnclaveInfo{
 		EnclaveUuid:                 info.EnclaveUuid,
 		ShortenedUuid:               info.ShortenedUuid,
@@ -84,6 +88,8 @@ func ToHttpEnclaveInfo(info types.EnclaveInfo) api_type.EnclaveInfo {
 		ApiContainerHostMachineInfo: utils.MapPointer(info.ApiContainerHostMachineInfo, ToHttpApiContainerHostMachineInfo),
 		CreationTime:                info.CreationTime,
 		Mode:                        ToHttpEnclaveMode(info.Mode),
+		ExpiryTime:                  info.ExpiryTime,
+		Labels:                      labels,
 	}
 }
 
@@ -144,6 +150,36 @@ func ToHttpServiceStatus(status rpc_api.ServiceStatus) api_type.ServiceStatus {
 	}
 }
 
+func ToHttpServiceHealthStatus(status rpc_api.ServiceHealth_Status) api_type.ServiceHealthStatus {
+	switch status {
+	case rpc_api.ServiceHealth_UNKNOWN:
+		return api_type.ServiceHealthStatusUNKNOWN
+	case rpc_api.ServiceHealth_HEALTHY:
+		return api_type.ServiceHealthStatusHEALTHY
+	case rpc_api.ServiceHealth_UNHEALTHY:
+		return api_type.ServiceHealthStatusUNHEALTHY
+	case rpc_api.ServiceHealth_CRASHED:
+		return api_type.ServiceHealthStatusCRASHED
+	default:
+		warnUnmatchedValue(status)
+		panic(fmt.Sprintf("Missing conversion of Service Health Status Enum value: %s", status))
+	}
+}
+
+func ToHttpServiceHealth(health *rpc_api.ServiceHealth) *api_type.ServiceHealth {
+	if health == nil {
+		return nil
+	}
+	return &api_type.ServiceHealth{
+		Status:         ToHttpServiceHealthStatus(health.Status),
+		ExitCode:       health.ExitCode,
+		RestartCount:   int64(health.RestartCount),
+		LastExitReason: health.LastExitReason,
+		Message:        health.Message,
+		Since:          health.Since.AsTime(),
+	}
+}
+
 func ToHttpContainer(container *rpc_api.Container) api_type.Container {
 	status := ToHttpContainerStatus(container.Status)
 	return api_type.Container{
@@ -171,15 +207,51 @@ func ToHttpServiceInfo(service *rpc_api.ServiceInfo) api_type.ServiceInfo {
 	publicPorts := utils.MapMapValues(service.MaybePublicPorts, ToHttpPorts)
 	privatePorts := utils.MapMapValues(service.PrivatePorts, ToHttpPorts)
 	return api_type.ServiceInfo{
-		Container:     container,
-		PublicIpAddr:  &service.MaybePublicIpAddr,
-		PublicPorts:   &publicPorts,
-		Name:          service.Name,
-		PrivateIpAddr: service.PrivateIpAddr,
-		PrivatePorts:  privatePorts,
-		ServiceStatus: serviceStatus,
-		ServiceUuid:   service.ServiceUuid,
-		ShortenedUuid: service.ShortenedUuid,
+		Container:       container,
+		Health:          ToHttpServiceHealth(service.Health),
+		PublicIpAddr:    &service.MaybePublicIpAddr,
+		PublicPorts:     &publicPorts,
+		Name:            service.Name,
+		PrivateIpAddr:   service.PrivateIpAddr,
+		PrivateIpv6Addr: &service.PrivateIpv6Addr,
+		PrivatePorts:    privatePorts,
+		ServiceStatus:   serviceStatus,
+		ServiceUuid:     service.ServiceUuid,
+		ShortenedUuid:   service.ShortenedUuid,
+	}
+}
+
+func ToHttpServiceStatsReport(response *rpc_api.GetServiceStatsResponse) api_type.ServiceStatsReport {
+	return api_type.ServiceStatsReport{
+		ServiceStats:     utils.MapMapValues(response.ServiceStats, ToHttpServiceStats),
+		ServiceErrors:    response.ServiceErrors,
+		LastRunPeakUsage: utils.MapMapValues(response.LastRunPeakUsage, ToHttpServicePeakUsage),
+	}
+}
+
+func ToHttpServiceStats(stats *rpc_api.ServiceStats) api_type.ServiceStats {
+	var ioStats *api_type.ServiceIoStats
+	if stats.IoStats != nil {
+		ioStats = &api_type.ServiceIoStats{
+			NetworkReceivedBytes: int64(stats.IoStats.NetworkReceivedBytes),
+			NetworkSentBytes:     int64(stats.IoStats.NetworkSentBytes),
+			DiskReadBytes:        int64(stats.IoStats.DiskReadBytes),
+			DiskWrittenBytes:     int64(stats.IoStats.DiskWrittenBytes),
+		}
+	}
+	return api_type.ServiceStats{
+		CpuUsageMillicores: int64(stats.CpuUsageMillicores),
+		MemoryUsageBytes:   int64(stats.MemoryUsageBytes),
+		MemoryLimitBytes:   int64(stats.MemoryLimitBytes),
+		IoStats:            ioStats,
+		SampleTime:         stats.SampleTime.AsTime(),
+	}
+}
+
+func ToHttpServicePeakUsage(peakUsage *rpc_api.ServicePeakUsage) api_type.ServicePeakUsage {
+	return api_type.ServicePeakUsage{
+		PeakCpuUsageMillicores: int64(peakUsage.PeakCpuUsageMillicores),
+		PeakMemoryUsageBytes:   int64(peakUsage.PeakMemoryUsageBytes),
 	}
 }
 
@@ -0,0 +1,62 @@
+package to_http
+
+import (
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_events"
+
+	api_type "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/api_types"
+)
+
+func ToHttpEngineEventType(eventType engine_events.EngineEventType) api_type.EngineEventType {
+	switch eventType {
+	case engine_events.EngineEventType_ENCLAVE_CREATED:
+		return api_type.ENCLAVECREATED
+	case engine_events.EngineEventType_ENCLAVE_STARTED:
+		return api_type.ENCLAVESTARTED
+	case engine_events.EngineEventType_ENCLAVE_STOPPED:
+		return api_type.ENCLAVESTOPPED
+	case engine_events.EngineEventType_ENCLAVE_DESTROYED:
+		return api_type.ENCLAVEDESTROYED
+	case engine_events.EngineEventType_STARLARK_RUN_STARTED:
+		return api_type.STARLARKRUNSTARTED
+	case engine_events.EngineEventType_STARLARK_RUN_FINISHED:
+		return api_type.STARLARKRUNFINISHED
+	case engine_events.EngineEventType_SERVICE_STARTED:
+		return api_type.SERVICESTARTED
+	case engine_events.EngineEventType_SERVICE_STOPPED:
+		return api_type.SERVICESTOPPED
+	case engine_events.EngineEventType_SERVICE_CRASHED:
+		return api_type.SERVICECRASHED
+	case engine_events.EngineEventType_FILES_ARTIFACT_CREATED:
+		return api_type.FILESARTIFACTCREATED
+	default:
+		warnUnmatchedValue(eventType)
+		panic(fmt.Sprintf("Undefined mapping of value: %s", eventType))
+	}
+}
+
+func ToHttpEngineEvent(event *engine_events.EngineEvent) api_type.EngineEvent {
+	var enclaveLabels *map[string]string
+	if len(event.EnclaveLabels) > 0 {
+		enclaveLabels = &event.EnclaveLabels
+	}
+	var message *string
+	if event.Message != "" {
+		message = &event.Message
+	}
+	return api_type.EngineEvent{
+		EnclaveLabels:           enclaveLabels,
+		EnclaveName:             event.EnclaveName,
+		EnclaveUuid:             event.EnclaveUuid,
+		EventUuid:               event.Id,
+		FilesArtifactName:       event.FilesArtifactName,
+		IsStarlarkRunSuccessful: event.IsStarlarkRunSuccessful,
+		Message:                 message,
+		ServiceName:             event.ServiceName,
+		StarlarkPackageId:       event.StarlarkPackageId,
+		Text:                    event.Text,
+		Timestamp:               event.Timestamp,
+		Type:                    ToHttpEngineEventType(event.Type),
+	}
+}
@@ -18,7 +18,6 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	rpc_api "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
@@ -312,6 +311,27 @@ func (manager *enclaveRuntime) GetEnclavesEnclaveIdentifierServices(ctx context.
 	return api.GetEnclavesEnclaveIdentifierServices200JSONResponse(mappedServices), nil
 }
 
+// (GET /enclaves/{enclave_identifier}/services/stats)
+func (manager *enclaveRuntime) GetEnclavesEnclaveIdentifierServicesStats(ctx context.Context, request api.GetEnclavesEnclaveIdentifierServicesStatsRequestObject) (api.GetEnclavesEnclaveIdentifierServicesStatsResponseObject, error) {
+	enclaveIdentifier := request.EnclaveIdentifier
+	apiContainerClient, responseErr := manager.getApiClientOrResponseError(enclaveIdentifier)
+	if responseErr != nil {
+		return api.GetEnclavesEnclaveIdentifierServicesStatsdefaultJSONResponse{Body: *responseErr, StatusCode: int(responseErr.Code)}, nil
+	}
+	logrus.Infof("Getting stats of services in enclave %s", enclaveIdentifier)
+
+	serviceIds := utils.DerefWith(request.Params.Services, []string{})
+	getServiceStatsArgs := rpc_api.GetServiceStatsArgs{
+		ServiceIdentifiers: utils.NewMapFromList(serviceIds, func(x string) bool { return true }),
+	}
+	serviceStats, err := (*apiContainerClient).GetServiceStats(ctx, &getServiceStatsArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Can't get the stats of services using gRPC call with enclave %s", enclaveIdentifier)
+	}
+
+	return api.GetEnclavesEnclaveIdentifierServicesStats200JSONResponse(to_http.ToHttpServiceStatsReport(serviceStats)), nil
+}
+
 // (GET /enclaves/{enclave_identifier}/services/history)
 func (manager *enclaveRuntime) GetEnclavesEnclaveIdentifierServicesHistory(ctx context.Context, request api.GetEnclavesEnclaveIdentifierServicesHistoryRequestObject) (api.GetEnclavesEnclaveIdentifierServicesHistoryResponseObject, error) {
 	enclaveIdentifier := request.EnclaveIdentifier
@@ -716,7 +736,7 @@ func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx
 
 // GetGrpcClientConn returns a client conn dialed in to the local port
 // It is the caller's responsibility to call resultClientConn.close()
-func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool) (resultClientConn *grpc.ClientConn, resultErr error) {
+func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool, dialOptions []grpc.DialOption) (resultClientConn *grpc.ClientConn, resultErr error) {
 	enclaveAPIContainerInfo := enclaveInfo.ApiContainerInfo
 	if enclaveAPIContainerInfo == nil {
 		logrus.Infof("No API container info is available for enclave %s", enclaveInfo.EnclaveUuid)
@@ -736,7 +756,7 @@ func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool)
 	}
 
 	grpcServerAddress := fmt.Sprintf("%v:%v", apiContainerIP, apiContainerGrpcPort)
-	grpcConnection, err := grpc.Dial(grpcServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	grpcConnection, err := grpc.Dial(grpcServerAddress, dialOptions...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to create a GRPC client connection on address '%v', but a non-nil error was returned", grpcServerAddress)
 	}
@@ -798,7 +818,7 @@ func (runtime *enclaveRuntime) refreshEnclaveConnections() error {
 	for uuid, info := range enclaves {
 		_, found := runtime.remoteApiContainerClient[uuid]
 		if !found && info != nil {
-			conn, err := getGrpcClientConn(*info, runtime.connectOnHostMachine)
+			conn, err := getGrpcClientConn(*info, runtime.connectOnHostMachine, runtime.enclaveManager.GetApiContainerDialOptions(uuid))
 			if err != nil {
 				return stacktrace.Propagate(err, "Failed to establish gRPC connection with enclave manager service on enclave %s", uuid)
 			}
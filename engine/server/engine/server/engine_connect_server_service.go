@@ -13,6 +13,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/log_file_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_events"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_metrics"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -26,6 +28,28 @@ const (
 	subnetworkDisableBecauseItIsDeprecated = false
 )
 
+// Completeness enforced via unit test
+var engineEventTypesByGrpcEngineEventType = map[kurtosis_engine_rpc_api_bindings.EngineEventType]engine_events.EngineEventType{
+	kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_CREATED:        engine_events.EngineEventType_ENCLAVE_CREATED,
+	kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_STARTED:        engine_events.EngineEventType_ENCLAVE_STARTED,
+	kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_STOPPED:        engine_events.EngineEventType_ENCLAVE_STOPPED,
+	kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_DESTROYED:      engine_events.EngineEventType_ENCLAVE_DESTROYED,
+	kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_STARLARK_RUN_STARTED:   engine_events.EngineEventType_STARLARK_RUN_STARTED,
+	kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_STARLARK_RUN_FINISHED:  engine_events.EngineEventType_STARLARK_RUN_FINISHED,
+	kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_STARTED:        engine_events.EngineEventType_SERVICE_STARTED,
+	kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_STOPPED:        engine_events.EngineEventType_SERVICE_STOPPED,
+	kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_CRASHED:        engine_events.EngineEventType_SERVICE_CRASHED,
+	kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_FILES_ARTIFACT_CREATED: engine_events.EngineEventType_FILES_ARTIFACT_CREATED,
+}
+
+var grpcEngineEventTypesByEngineEventType = func() map[engine_events.EngineEventType]kurtosis_engine_rpc_api_bindings.EngineEventType {
+	result := map[engine_events.EngineEventType]kurtosis_engine_rpc_api_bindings.EngineEventType{}
+	for grpcEventType, eventType := range engineEventTypesByGrpcEngineEventType {
+		result[eventType] = grpcEventType
+	}
+	return result
+}()
+
 type EngineConnectServerService struct {
 	// The version tag of the engine server image, so it can report its own version
 	imageVersionTag string
@@ -127,6 +151,10 @@ func toGrpcEnclaveMode(mode types.EnclaveMode) kurtosis_engine_rpc_api_bindings.
 func toGrpcEnclaveInfo(info types.EnclaveInfo) kurtosis_engine_rpc_api_bindings.EnclaveInfo {
 	containerInfo := utils.MapPointer(info.ApiContainerInfo, toGrpcEnclaveAPIContainerInfo)
 	apiHostMachine := utils.MapPointer(info.ApiContainerHostMachineInfo, toGrpcApiContainerHostMachineInfo)
+	var expiryTime *timestamppb.Timestamp
+	if info.ExpiryTime != nil {
+		expiryTime = toGrpcTimestamp(*info.ExpiryTime)
+	}
 	return kurtosis_engine_rpc_api_bindings.EnclaveInfo{
 		EnclaveUuid:                 info.EnclaveUuid,
 		ShortenedUuid:               info.ShortenedUuid,
@@ -137,6 +165,19 @@ func toGrpcEnclaveInfo(info types.EnclaveInfo) kurtosis_engine_rpc_api_bindings.
 		ApiContainerHostMachineInfo: apiHostMachine,
 		CreationTime:                toGrpcTimestamp(info.CreationTime),
 		Mode:                        toGrpcEnclaveMode(info.Mode),
+		ExpiryTime:                  expiryTime,
+		Labels:                      info.Labels,
+	}
+}
+
+func toEnclaveExpiryAction(action kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction) types.EnclaveExpiryAction {
+	switch action {
+	case kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_DESTROY:
+		return types.EnclaveExpiryAction_DESTROY
+	case kurtosis_engine_rpc_api_bindings.EnclaveExpiryAction_EnclaveExpiryAction_STOP:
+		return types.EnclaveExpiryAction_STOP
+	default:
+		panic(fmt.Sprintf("Undefined mapping of value: %s", action))
 	}
 }
 
@@ -162,6 +203,21 @@ func (service *EngineConnectServerService) GetEngineInfo(context.Context, *conne
 	return connect.NewResponse(result), nil
 }
 
+func (service *EngineConnectServerService) GetResourceQuotaUsage(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetResourceQuotaUsageResponse], error) {
+	resourceQuotaUsages, err := service.enclaveManager.GetResourceQuotaUsage(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the usage of the resource quotas")
+	}
+
+	response := &kurtosis_engine_rpc_api_bindings.GetResourceQuotaUsageResponse{
+		ResourceQuotaUsages: []*kurtosis_engine_rpc_api_bindings.ResourceQuotaUsage{},
+	}
+	for _, resourceQuotaUsage := range resourceQuotaUsages {
+		response.ResourceQuotaUsages = append(response.ResourceQuotaUsages, newRPCBindingsResourceQuotaUsage(resourceQuotaUsage))
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CreateEnclaveResponse], error) {
 	args := connectArgs.Msg
 
@@ -188,6 +244,12 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 		args.GetEnclaveName(),
 		isProduction,
 		args.GetShouldApicRunInDebugMode(),
+		args.GetIsIpv6Enabled(),
+		args.GetLabels(),
+		time.Duration(args.GetTtlSeconds())*time.Second,
+		time.Duration(args.GetIdleTimeoutSeconds())*time.Second,
+		toEnclaveExpiryAction(args.GetExpiryAction()),
+		args.GetFromTemplate(),
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating new enclave with name '%v'", args.GetEnclaveName())
@@ -201,10 +263,11 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 	return connect.NewResponse(response), nil
 }
 
-func (service *EngineConnectServerService) GetEnclaves(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
-	infoForEnclaves, err := service.enclaveManager.GetEnclaves(ctx)
+func (service *EngineConnectServerService) GetEnclaves(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.GetEnclavesArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
+	labelSelector := connectArgs.Msg.GetLabelSelector()
+	infoForEnclaves, err := service.enclaveManager.GetEnclavesMatchingLabelSelector(ctx, labelSelector)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred getting info for enclaves")
+		return nil, stacktrace.Propagate(err, "An error occurred getting info for enclaves matching label selector '%v'", labelSelector)
 	}
 	response := &kurtosis_engine_rpc_api_bindings.GetEnclavesResponse{
 		EnclaveInfo: utils.MapMapValues(
@@ -244,6 +307,33 @@ func (service *EngineConnectServerService) StopEnclave(ctx context.Context, conn
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (service *EngineConnectServerService) StartEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.EnclaveIdentifier
+
+	if err := service.enclaveManager.StartEnclave(ctx, enclaveIdentifier); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting enclave '%v'", enclaveIdentifier)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (service *EngineConnectServerService) ExtendEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.EnclaveIdentifier
+	extension := time.Duration(args.GetExtensionSeconds()) * time.Second
+
+	expiryTime, err := service.enclaveManager.ExtendEnclave(ctx, enclaveIdentifier, extension)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred extending enclave '%v'", enclaveIdentifier)
+	}
+
+	response := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse{
+		ExpiryTime: toGrpcTimestamp(*expiryTime),
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (service *EngineConnectServerService) DestroyEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
 	args := connectArgs.Msg
 	enclaveIdentifier := args.EnclaveIdentifier
@@ -260,11 +350,12 @@ func (service *EngineConnectServerService) DestroyEnclave(ctx context.Context, c
 
 func (service *EngineConnectServerService) Clean(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error) {
 	args := connectArgs.Msg
-	removedEnclaveUuidsAndNames, err := service.enclaveManager.Clean(ctx, args.GetShouldCleanAll())
+	removedEnclaveUuidsAndNames, err := service.enclaveManager.Clean(ctx, args.GetShouldCleanAll(), args.GetLabelSelector())
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while cleaning enclaves")
 	}
-	if args.GetShouldCleanAll() {
+	// Logs of enclaves that didn't match the label selector must survive the clean
+	if args.GetShouldCleanAll() && args.GetLabelSelector() == "" {
 		if err = service.logFileManager.RemoveAllLogs(); err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred removing all logs.")
 		}
@@ -348,6 +439,8 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 		}
 	}()
 
+	engine_metrics.ActiveServiceLogStreams.Inc()
+	defer engine_metrics.ActiveServiceLogStreams.Dec()
 	for {
 		select {
 		//stream case
@@ -358,6 +451,7 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 				return nil
 			}
 
+			engine_metrics.CountServiceLogLines(serviceLogsByServiceUuid)
 			getServiceLogsResponse := newLogsResponse(requestedServiceUuids, serviceLogsByServiceUuid, notFoundServiceUuids)
 			if err := stream.Send(getServiceLogsResponse); err != nil {
 				return stacktrace.Propagate(err, "An error occurred sending the stream logs for service logs response '%+v'", getServiceLogsResponse)
@@ -378,6 +472,39 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 	}
 }
 
+func (service *EngineConnectServerService) StreamEngineEvents(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.StreamEngineEventsArgs], stream *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EngineEvent]) error {
+	args := connectArgs.Msg
+	eventTypes := []engine_events.EngineEventType{}
+	for _, grpcEventType := range args.GetEventTypes() {
+		eventType, found := engineEventTypesByGrpcEngineEventType[grpcEventType]
+		if !found {
+			return stacktrace.NewError("Unrecognized engine event type '%v'", grpcEventType)
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+	filter, err := enclave_manager.NewEngineEventFilter(eventTypes, args.GetEnclaveIdentifiers(), args.GetEnclaveLabelSelector(), args.GetOnlyFailedStarlarkRuns())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the filter of the engine events")
+	}
+
+	eventsChan, unsubscribe := service.enclaveManager.GetEngineEventBus().Subscribe(filter)
+	defer unsubscribe()
+	for {
+		select {
+		case event, isChanOpen := <-eventsChan:
+			if !isChanOpen {
+				return nil
+			}
+			if err := stream.Send(toGrpcEngineEvent(event)); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending engine event '%v'", event.Id)
+			}
+		case <-ctx.Done():
+			logrus.Debug("The engine events stream has done")
+			return nil
+		}
+	}
+}
+
 func (service *EngineConnectServerService) Close() error {
 	if err := service.enclaveManager.Close(); err != nil {
 		return stacktrace.Propagate(err, "An error occurred closing the enclave manager")
@@ -456,6 +583,52 @@ func newLogsResponse(
 	return getServiceLogsResponse
 }
 
+func toGrpcEngineEvent(event *engine_events.EngineEvent) *kurtosis_engine_rpc_api_bindings.EngineEvent {
+	return &kurtosis_engine_rpc_api_bindings.EngineEvent{
+		EventUuid:               event.Id,
+		Type:                    grpcEngineEventTypesByEngineEventType[event.Type],
+		Timestamp:               timestamppb.New(event.Timestamp),
+		EnclaveUuid:             event.EnclaveUuid,
+		EnclaveName:             event.EnclaveName,
+		EnclaveLabels:           event.EnclaveLabels,
+		ServiceName:             event.ServiceName,
+		FilesArtifactName:       event.FilesArtifactName,
+		StarlarkPackageId:       event.StarlarkPackageId,
+		IsStarlarkRunSuccessful: event.IsStarlarkRunSuccessful,
+		Message:                 event.Message,
+	}
+}
+
+func newRPCBindingsResourceQuotaUsage(resourceQuotaUsage *enclave_manager.ResourceQuotaUsage) *kurtosis_engine_rpc_api_bindings.ResourceQuotaUsage {
+	config := resourceQuotaUsage.Config
+	enclaveUsages := []*kurtosis_engine_rpc_api_bindings.EnclaveResourceUsage{}
+	for _, enclaveUsage := range resourceQuotaUsage.EnclaveUsages {
+		enclaveUsages = append(enclaveUsages, &kurtosis_engine_rpc_api_bindings.EnclaveResourceUsage{
+			EnclaveUuid:                enclaveUsage.EnclaveUuid,
+			EnclaveName:                enclaveUsage.EnclaveName,
+			IsUsageKnown:               enclaveUsage.Usage != nil,
+			ServiceCount:               enclaveUsage.Usage.GetServiceCount(),
+			ReservedCpuMillicores:      enclaveUsage.Usage.GetReservedCpuMillicores(),
+			ReservedMemoryMegabytes:    enclaveUsage.Usage.GetReservedMemoryMegabytes(),
+			FilesArtifactsStorageBytes: enclaveUsage.Usage.GetFilesArtifactsStorageBytes(),
+		})
+	}
+	return &kurtosis_engine_rpc_api_bindings.ResourceQuotaUsage{
+		Name:                                 config.Name,
+		User:                                 config.User,
+		LabelSelector:                        config.LabelSelector,
+		MaxEnclaves:                          config.MaxEnclaves,
+		MaxServicesPerEnclave:                config.MaxServicesPerEnclave,
+		MaxCpuMillicoresPerEnclave:           config.MaxCpuMillicoresPerEnclave,
+		MaxMemoryMegabytesPerEnclave:         config.MaxMemoryMegabytesPerEnclave,
+		MaxFilesArtifactsMegabytesPerEnclave: config.MaxFilesArtifactsMegabytesPerEnclave,
+		EnclaveUsages:                        enclaveUsages,
+		MaxServices:                          config.MaxServices,
+		MaxCpuMillicores:                     config.MaxCpuMillicores,
+		MaxMemoryMegabytes:                   config.MaxMemoryMegabytes,
+	}
+}
+
 func newRPCBindingsLogLineFromLogLines(logLines []logline.LogLine) *kurtosis_engine_rpc_api_bindings.LogLine {
 	logLinesStr := make([]string, len(logLines))
 	var logTimestamp *timestamppb.Timestamp
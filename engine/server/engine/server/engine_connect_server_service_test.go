@@ -0,0 +1,21 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngineEventTypesByGrpcEngineEventTypeCompleteness(t *testing.T) {
+	for grpcEventTypeValue := range kurtosis_engine_rpc_api_bindings.EngineEventType_name {
+		grpcEventType := kurtosis_engine_rpc_api_bindings.EngineEventType(grpcEventTypeValue)
+		_, found := engineEventTypesByGrpcEngineEventType[grpcEventType]
+		require.True(t, found, "No engine event type provided for gRPC engine event type '%v'", grpcEventType.String())
+	}
+	for _, eventType := range engine_events.AllEngineEventTypes {
+		_, found := grpcEngineEventTypesByEngineEventType[eventType]
+		require.True(t, found, "No gRPC engine event type provided for engine event type '%v'", eventType)
+	}
+}
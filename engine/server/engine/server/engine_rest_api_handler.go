@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/log_file_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
@@ -32,7 +33,8 @@ type EngineRuntime struct {
 // (DELETE /enclaves)
 func (engine EngineRuntime) DeleteEnclaves(ctx context.Context, request api.DeleteEnclavesRequestObject) (api.DeleteEnclavesResponseObject, error) {
 	removeAll := utils.DerefWith(request.Params.RemoveAll, false)
-	removedEnclaveUuidsAndNames, err := engine.EnclaveManager.Clean(ctx, removeAll)
+	labelSelector := utils.DerefWith(request.Params.LabelSelector, "")
+	removedEnclaveUuidsAndNames, err := engine.EnclaveManager.Clean(ctx, removeAll, labelSelector)
 	if err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred while cleaning enclaves")
 		return api.DeleteEnclavesdefaultJSONResponse{
@@ -40,7 +42,8 @@ func (engine EngineRuntime) DeleteEnclaves(ctx context.Context, request api.Dele
 			StatusCode: int(response.Code),
 		}, nil
 	}
-	if removeAll {
+	// Logs of enclaves that didn't match the label selector must survive the clean
+	if removeAll && labelSelector == "" {
 		if err = engine.LogFileManager.RemoveAllLogs(); err != nil {
 			response := internalErrorResponseInfof(err, "An error occurred removing all logs")
 			return api.DeleteEnclavesdefaultJSONResponse{
@@ -56,9 +59,10 @@ func (engine EngineRuntime) DeleteEnclaves(ctx context.Context, request api.Dele
 // Get Enclaves
 // (GET /enclaves)
 func (engine EngineRuntime) GetEnclaves(ctx context.Context, request api.GetEnclavesRequestObject) (api.GetEnclavesResponseObject, error) {
-	infoForEnclaves, err := engine.EnclaveManager.GetEnclaves(ctx)
+	labelSelector := utils.DerefWith(request.Params.LabelSelector, "")
+	infoForEnclaves, err := engine.EnclaveManager.GetEnclavesMatchingLabelSelector(ctx, labelSelector)
 	if err != nil {
-		response := internalErrorResponseInfof(err, "An error occurred getting info for enclaves")
+		response := internalErrorResponseInfof(err, "An error occurred getting info for enclaves matching label selector '%v'", labelSelector)
 		return api.GetEnclavesdefaultJSONResponse{
 			Body:       response,
 			StatusCode: int(response.Code),
@@ -75,6 +79,11 @@ func (engine EngineRuntime) PostEnclaves(ctx context.Context, request api.PostEn
 	enclaveName := request.Body.EnclaveName
 	apicVersionTag := request.Body.ApiContainerVersionTag
 	shouldApicRunInDebugMode := utils.DerefWith(request.Body.ShouldApicRunInDebugMode, api_type.False)
+	isIpv6Enabled := utils.DerefWith(request.Body.IsIpv6Enabled, false)
+	ttl := time.Duration(utils.DerefWith(request.Body.TtlSeconds, 0)) * time.Second
+	idleTimeout := time.Duration(utils.DerefWith(request.Body.IdleTimeoutSeconds, 0)) * time.Second
+	labels := utils.DerefWith(request.Body.Labels, map[string]string{})
+	fromTemplate := utils.DerefWith(request.Body.FromTemplate, "")
 
 	if err := engine.MetricsClient.TrackCreateEnclave(enclaveName, subnetworkDisableBecauseItIsDeprecated); err != nil {
 		logrus.Warn("An error occurred while logging the create enclave event")
@@ -108,6 +117,13 @@ func (engine EngineRuntime) PostEnclaves(ctx context.Context, request api.PostEn
 		enclaveName,
 		isProduction,
 		bool(shouldApicRunInDebugMode),
+		isIpv6Enabled,
+		labels,
+		ttl,
+		idleTimeout,
+		// Enclaves created through the REST API are always destroyed once they expire
+		types.EnclaveExpiryAction_DESTROY,
+		fromTemplate,
 	)
 	if err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred creating new enclave with name '%v'", request.Body.EnclaveName)
@@ -229,6 +245,17 @@ func (engine EngineRuntime) PostEnclavesEnclaveIdentifierStatus(ctx context.Cont
 
 		return api.PostEnclavesEnclaveIdentifierStatus200Response{}, nil
 
+	case api_type.START:
+		if err := engine.EnclaveManager.StartEnclave(ctx, enclaveIdentifier); err != nil {
+			response := internalErrorResponseInfof(err, "An error occurred starting enclave '%v'", enclaveIdentifier)
+			return api.PostEnclavesEnclaveIdentifierStatusdefaultJSONResponse{
+				Body:       response,
+				StatusCode: int(response.Code),
+			}, nil
+		}
+
+		return api.PostEnclavesEnclaveIdentifierStatus200Response{}, nil
+
 	default:
 		logrus.Infof("Unsupported target state: '%s'", string(*targetState))
 		response := api_type.ResponseInfo{
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/log_file_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_events"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/streaming"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
@@ -125,6 +127,50 @@ func (engine WebSocketRuntime) GetEnclavesEnclaveIdentifierServicesServiceIdenti
 	return nil
 }
 
+// (GET /engine/events)
+func (engine WebSocketRuntime) GetEngineEvents(ctx echo.Context, params api_type.GetEngineEventsParams) error {
+	eventTypes := []engine_events.EngineEventType{}
+	for _, httpEventType := range utils.DerefWith(params.EventTypes, []api_type.EngineEventType{}) {
+		eventType, found := engine_events.ParseEngineEventType(string(httpEventType))
+		if !found {
+			writeResponseInfo(ctx, api_type.ResponseInfo{
+				Code:    http.StatusBadRequest,
+				Message: fmt.Sprintf("Unrecognized engine event type '%v'", httpEventType),
+				Type:    api_type.ERROR,
+			})
+			return nil
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+	filter, err := enclave_manager.NewEngineEventFilter(
+		eventTypes,
+		utils.DerefWith(params.EnclaveIdentifiers, []string{}),
+		utils.DerefWith(params.LabelSelector, ""),
+		utils.DerefWith(params.OnlyFailedStarlarkRuns, false),
+	)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"parameters": params,
+			"stacktrace": fmt.Sprintf("%+v", err),
+		}).Error("Failed to create the filter of the engine events")
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Code:    http.StatusBadRequest,
+			Message: fmt.Sprintf("Invalid engine events filter: %v", err),
+			Type:    api_type.ERROR,
+		})
+		return nil
+	}
+
+	if ctx.IsWebSocket() {
+		logrus.Infof("Starting engine events stream using Websocket")
+		streamEngineEventsWithWebsocket(ctx, engine.CorsConfig, engine.EnclaveManager.GetEngineEventBus(), filter)
+	} else {
+		logrus.Infof("Starting engine events stream using plain HTTP")
+		streamEngineEventsWithHTTP(ctx, engine.EnclaveManager.GetEngineEventBus(), filter)
+	}
+	return nil
+}
+
 // (GET /starlark/executions/{starlark_execution_uuid}/logs)
 func (engine WebSocketRuntime) GetStarlarkExecutionsStarlarkExecutionUuidLogs(ctx echo.Context, starlarkExecutionUuid api_type.StarlarkExecutionUuid) error {
 	asyncLogUuid := streaming.StreamerUUID(starlarkExecutionUuid)
@@ -303,3 +349,64 @@ func streamServiceLogsWithHTTP(ctx echo.Context, streamer streaming.ServiceLogSt
 		}
 	}
 }
+
+func streamEngineEventsWithWebsocket(ctx echo.Context, cors cors.Cors, bus *engine_events.EngineEventBus, filter *engine_events.EngineEventFilter) {
+	wsPump, err := streaming.NewWebsocketPump[api_type.EngineEvent](ctx, cors)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to start websocket connection")
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Type:    api_type.ERROR,
+			Message: "Failed to start websocket connection",
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+	defer wsPump.Close()
+
+	streamCtx, cancel := context.WithCancel(ctx.Request().Context())
+	defer cancel()
+	wsPump.OnClose(cancel)
+
+	eventsChan, unsubscribe := bus.Subscribe(filter)
+	defer unsubscribe()
+	for {
+		select {
+		case event, isChanOpen := <-eventsChan:
+			if !isChanOpen {
+				return
+			}
+			httpEvent := to_http.ToHttpEngineEvent(event)
+			if err := wsPump.PumpMessage(&httpEvent); err != nil {
+				logrus.WithError(err).Warn("Failed to stream the engine events")
+				return
+			}
+		case <-streamCtx.Done():
+			return
+		}
+	}
+}
+
+func streamEngineEventsWithHTTP(ctx echo.Context, bus *engine_events.EngineEventBus, filter *engine_events.EngineEventFilter) {
+	ctx.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	enc := json.NewEncoder(ctx.Response())
+	ctx.Response().WriteHeader(http.StatusOK)
+	ctx.Response().Flush()
+
+	eventsChan, unsubscribe := bus.Subscribe(filter)
+	defer unsubscribe()
+	for {
+		select {
+		case event, isChanOpen := <-eventsChan:
+			if !isChanOpen {
+				return
+			}
+			if err := enc.Encode(to_http.ToHttpEngineEvent(event)); err != nil {
+				logrus.WithError(err).Warn("Failed to stream the engine events")
+				return
+			}
+			ctx.Response().Flush()
+		case <-ctx.Request().Context().Done():
+			return
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_metrics"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_logline"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
@@ -107,6 +108,8 @@ func (streamer ServiceLogStreamer) Close() {
 }
 
 func (streamer ServiceLogStreamer) Consume(consumer func(*api_type.ServiceLogs) error) error {
+	engine_metrics.ActiveServiceLogStreams.Inc()
+	defer engine_metrics.ActiveServiceLogStreams.Dec()
 	for {
 		select {
 		//stream case
@@ -117,6 +120,7 @@ func (streamer ServiceLogStreamer) Consume(consumer func(*api_type.ServiceLogs)
 				return nil
 			}
 
+			engine_metrics.CountServiceLogLines(serviceLogsByServiceUuid)
 			serviceLogsResponse := to_http.ToHttpServiceLogs(streamer.requestedServiceUuids, serviceLogsByServiceUuid, streamer.notFoundServiceUuids)
 			err := consumer(serviceLogsResponse)
 			if err != nil {
@@ -0,0 +1,10 @@
+package types
+
+// GetIpReachableFromEngine returns the IP the engine reaches the API container on: its bridge IP on Docker, and its IP
+// inside the enclave on Kubernetes, where there's no bridge network
+func (info *EnclaveAPIContainerInfo) GetIpReachableFromEngine() string {
+	if info.BridgeIpAddress != "" {
+		return info.BridgeIpAddress
+	}
+	return info.IpInsideEnclave
+}
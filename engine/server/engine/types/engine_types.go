@@ -30,6 +30,14 @@ const (
 	EnclaveMode_TEST       EnclaveMode = "TEST"
 )
 
+// Defines values for EnclaveExpiryAction.
+type EnclaveExpiryAction string
+
+const (
+	EnclaveExpiryAction_DESTROY EnclaveExpiryAction = "DESTROY"
+	EnclaveExpiryAction_STOP    EnclaveExpiryAction = "STOP"
+)
+
 // CreateEnclaveArgs defines model for CreateEnclaveArgs.
 type CreateEnclaveArgs struct {
 	ApiContainerLogLevel   string
@@ -67,6 +75,8 @@ type EnclaveInfo struct {
 	EnclaveStatus               EnclaveStatus
 	CreationTime                Timestamp
 	EnclaveUuid                 string
+	ExpiryTime                  *Timestamp
+	Labels                      map[string]string
 	Mode                        EnclaveMode
 	Name                        string
 	ShortenedUuid               string
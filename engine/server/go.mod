@@ -56,6 +56,7 @@ require (
 require (
 	connectrpc.com/connect v1.11.1
 	github.com/getkin/kin-openapi v0.120.0
+	github.com/golang-jwt/jwt v3.2.2+incompatible
 	github.com/gorilla/websocket v1.5.1
 	github.com/hashicorp/golang-lru/v2 v2.0.7
 	github.com/hpcloud/tail v1.0.0
@@ -63,6 +64,7 @@ require (
 	github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang v0.0.0-20230803130419-099ee7a4e3dc
 	github.com/kurtosis-tech/kurtosis/metrics-library/golang v0.0.0-20231206095907-9bdf0d02cb90
 	github.com/labstack/echo/v4 v4.11.3
+	github.com/prometheus/client_golang v1.14.0
 	github.com/rs/cors v1.9.0
 	github.com/spf13/afero v1.10.0
 	golang.org/x/exp v0.0.0-20230905200255-921286631fa9
@@ -84,7 +86,6 @@ require (
 	github.com/go-openapi/swag v0.22.4 // indirect
 	github.com/go-task/slim-sprig v0.0.0-20230315185526-52ccab3ef572 // indirect
 	github.com/gogo/googleapis v1.4.1 // indirect
-	github.com/golang-jwt/jwt v3.2.2+incompatible // indirect
 	github.com/golang/snappy v0.0.4 // indirect
 	github.com/google/gnostic v0.5.7-v3refs // indirect
 	github.com/google/go-cmp v0.6.0 // indirect
@@ -20,6 +20,8 @@ const (
 	isSubnetworkingEnabledKey      = "is_subnetworking_enabled"
 	userEmailAddressKey            = "user_email"
 	analyticsStatusKey             = "analytics_status"
+	serviceNameKey                 = "service_name"
+	instructionNameKey             = "instruction_name"
 
 	// Categories
 	installCategory = "install"
@@ -30,14 +32,16 @@ const (
 	kurtosisCategory = "kurtosis"
 
 	// Actions
-	consentAction         = "consent"
-	shareEmailAction      = "share-email"
-	createAction          = "create"
-	stopAction            = "stop"
-	destroyAction         = "destroy"
-	runAction             = "run"
-	runFinishedAction     = "run-finished"
-	analyticsToggleAction = "analytics-toggle"
+	consentAction           = "consent"
+	shareEmailAction        = "share-email"
+	createAction            = "create"
+	stopAction              = "stop"
+	destroyAction           = "destroy"
+	runAction               = "run"
+	runFinishedAction       = "run-finished"
+	analyticsToggleAction   = "analytics-toggle"
+	serviceAddedAction      = "service-added"
+	instructionFailedAction = "instruction-failed"
 )
 
 // WARNING WARNING WARNING WARNING WARNING WARNING WARNING WARNING WARNING WARNING WARNING WARNING WARNING WARNING
@@ -131,6 +135,28 @@ func NewKurtosisAnalyticsToggleEvent(analayticsStatus bool) *Event {
 	return event
 }
 
+func NewServiceAddedEvent(enclaveId string, serviceName string) *Event {
+	hashedEnclaveId := hashString(strings.TrimSpace(enclaveId))
+	hashedServiceName := hashString(strings.TrimSpace(serviceName))
+	properties := map[string]string{
+		enclaveIDPropertyKey: hashedEnclaveId,
+		serviceNameKey:       hashedServiceName,
+	}
+	event := newEvent(enclaveCategory, serviceAddedAction, properties)
+	return event
+}
+
+func NewInstructionFailedEvent(enclaveId string, packageId string, instructionName string) *Event {
+	hashedEnclaveId := hashString(strings.TrimSpace(enclaveId))
+	properties := map[string]string{
+		enclaveIDPropertyKey: hashedEnclaveId,
+		packageIdKey:         packageId,
+		instructionNameKey:   instructionName,
+	}
+	event := newEvent(enclaveCategory, instructionFailedAction, properties)
+	return event
+}
+
 // ================================================================================================
 //
 //	Private Helper Functions
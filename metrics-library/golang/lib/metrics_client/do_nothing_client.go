@@ -61,6 +61,18 @@ func (client *doNothingClient) TrackKurtosisAnalyticsToggle(analyticsStatus bool
 	return nil
 }
 
+func (client *doNothingClient) TrackServiceAdded(enclaveId string, serviceName string) error {
+	logrus.Debugf("Do-nothing metrics client TrackServiceAdded called with arguments enclaveId '%v', serviceName '%v'; skipping sending event", enclaveId, serviceName)
+	client.callback.Success()
+	return nil
+}
+
+func (client *doNothingClient) TrackInstructionFailed(enclaveId string, packageId string, instructionName string) error {
+	logrus.Debugf("Do-nothing metrics client TrackInstructionFailed called with arguments enclaveId '%v', packageId '%v', instructionName '%v'; skipping sending event", enclaveId, packageId, instructionName)
+	client.callback.Success()
+	return nil
+}
+
 func (client *doNothingClient) close() (err error) {
 	logrus.Debugf("Do-nothing metrics client close method called")
 	return nil
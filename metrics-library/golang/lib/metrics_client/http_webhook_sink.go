@@ -0,0 +1,75 @@
+package metrics_client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	httpSinkRequestTimeout = 10 * time.Second
+
+	contentTypeHeader   = "Content-Type"
+	jsonContentType     = "application/json"
+	maxErrorBodyToPrint = 1024
+)
+
+// httpWebhookSink POSTs each event as a JSON object to the user's webhook
+type httpWebhookSink struct {
+	httpClient *http.Client
+	url        string
+	headers    map[string]string
+}
+
+func newHttpWebhookSink(url string, headers map[string]string) *httpWebhookSink {
+	return &httpWebhookSink{
+		// nolint: exhaustruct
+		httpClient: &http.Client{Timeout: httpSinkRequestTimeout},
+		url:        url,
+		headers:    headers,
+	}
+}
+
+func (sink *httpWebhookSink) Send(event *SinkEvent) error {
+	serializedEvent, err := json.Marshal(event)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing event '%v'", event.Name)
+	}
+	if err = postJson(sink.httpClient, sink.url, sink.headers, serializedEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending event '%v' to metrics webhook '%v'", event.Name, sink.url)
+	}
+	return nil
+}
+
+func (sink *httpWebhookSink) Close() error {
+	sink.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// postJson is shared by the sinks sending the events over HTTP; any non-2xx response is treated as an error
+func postJson(httpClient *http.Client, url string, headers map[string]string, body []byte) error {
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred building the request to '%v'", url)
+	}
+	request.Header.Set(contentTypeHeader, jsonContentType)
+	for headerName, headerValue := range headers {
+		request.Header.Set(headerName, headerValue)
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the request to '%v'", url)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		responseBody, _ := io.ReadAll(io.LimitReader(response.Body, maxErrorBodyToPrint))
+		return stacktrace.NewError("The request to '%v' failed with status '%v' and body '%v'", url, response.Status, string(responseBody))
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package metrics_client
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	jsonlFileSinkPermission = 0o644
+	jsonlLineSeparator      = '\n'
+)
+
+// jsonlFileSink appends each event to a file as a JSON line, so the file can be tailed or shipped by any log collector
+type jsonlFileSink struct {
+	mutex *sync.Mutex
+	file  *os.File
+}
+
+func newJsonlFileSink(filePath string) (*jsonlFileSink, error) {
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, jsonlFileSinkPermission)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening metrics sink file '%v'", filePath)
+	}
+	return &jsonlFileSink{
+		mutex: &sync.Mutex{},
+		file:  file,
+	}, nil
+}
+
+func (sink *jsonlFileSink) Send(event *SinkEvent) error {
+	serializedEvent, err := json.Marshal(event)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing event '%v'", event.Name)
+	}
+	serializedEvent = append(serializedEvent, jsonlLineSeparator)
+
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	// A single write per event, so that the lines of the processes appending to the same file don't get mixed up
+	if _, err = sink.file.Write(serializedEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing event '%v' to metrics sink file '%v'", event.Name, sink.file.Name())
+	}
+	return nil
+}
+
+func (sink *jsonlFileSink) Close() error {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	if err := sink.file.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing metrics sink file '%v'", sink.file.Name())
+	}
+	return nil
+}
@@ -9,5 +9,7 @@ type MetricsClient interface {
 	TrackKurtosisRun(packageId string, isRemote bool, isDryRun bool, isScript bool) error
 	TrackKurtosisRunFinishedEvent(packageId string, numberOfServices int, isSuccess bool) error
 	TrackKurtosisAnalyticsToggle(analyticsStatus bool) error
+	TrackServiceAdded(enclaveId string, serviceName string) error
+	TrackInstructionFailed(enclaveId string, packageId string, instructionName string) error
 	close() (err error)
 }
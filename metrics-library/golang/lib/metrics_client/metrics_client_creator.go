@@ -16,8 +16,16 @@ func CreateMetricsClient(options *CreateMetricsClientOption) (MetricsClient, fun
 
 	metricsClientType := DoNothing
 
+	// The sink is only ever used if the user accepted sending metrics, so that opting out stops the events from being
+	// sent anywhere, not just to Segment
 	if options.didUserAcceptSendingMetrics {
 		metricsClientType = defaultMetricsType
+		if options.sinkConfig != nil {
+			if err := options.sinkConfig.Validate(); err != nil {
+				return nil, nil, stacktrace.Propagate(err, "An error occurred validating the metrics sink config")
+			}
+			metricsClientType = options.sinkConfig.Type
+		}
 	}
 
 	switch metricsClientType {
@@ -28,6 +36,13 @@ func CreateMetricsClient(options *CreateMetricsClientOption) (MetricsClient, fun
 			return nil, nil, stacktrace.Propagate(err, "An error occurred creating Segment metrics client")
 		}
 		return metricsClient, metricsClient.close, nil
+	case JsonlFile, HttpWebhook, OtlpLogs:
+		sink, err := newSink(options.sinkConfig)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating the '%v' metrics sink", metricsClientType)
+		}
+		metricsClient := newSinkClient(sink, options.source, options.sourceVersion, options.userId, options.backendType, options.callbackObject, options.isCI, options.cloudUserId, options.cloudInstanceId)
+		return metricsClient, metricsClient.close, nil
 	case DoNothing:
 		metricsClient := newDoNothingClient(options.callbackObject)
 		return metricsClient, metricsClient.close, nil
@@ -35,3 +50,24 @@ func CreateMetricsClient(options *CreateMetricsClientOption) (MetricsClient, fun
 		return nil, nil, stacktrace.NewError("Unrecognized metrics client type '%v'", metricsClientType)
 	}
 }
+
+func newSink(sinkConfig *SinkConfig) (Sink, error) {
+	switch sinkConfig.Type {
+	case JsonlFile:
+		sink, err := newJsonlFileSink(sinkConfig.FilePath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the JSONL file metrics sink")
+		}
+		return sink, nil
+	case HttpWebhook:
+		return newHttpWebhookSink(sinkConfig.Url, sinkConfig.Headers), nil
+	case OtlpLogs:
+		sink, err := newOtlpLogsSink(sinkConfig.Url, sinkConfig.Headers)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the OTLP logs metrics sink")
+		}
+		return sink, nil
+	default:
+		return nil, stacktrace.NewError("Metrics client type '%v' isn't a sink", sinkConfig.Type)
+	}
+}
@@ -2,6 +2,10 @@ package metrics_client
 
 const (
 	Segment MetricsClientType = "segment"
+	// The sinks below send the events to the user's own systems rather than to Kurtosis
+	JsonlFile   MetricsClientType = "jsonl-file"
+	HttpWebhook MetricsClientType = "http-webhook"
+	OtlpLogs    MetricsClientType = "otlp-logs"
 	//It's used when users reject sending metrics
 	DoNothing MetricsClientType = "do-nothing"
 )
@@ -0,0 +1,90 @@
+package metrics_client
+
+import (
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	sinkUrlHttpScheme  = "http"
+	sinkUrlHttpsScheme = "https"
+)
+
+// Sink is where the sink metrics clients send the events to, letting users redirect the events to their own systems
+// rather than to Kurtosis' Segment account
+type Sink interface {
+	Send(event *SinkEvent) error
+	Close() error
+}
+
+// SinkEvent is the representation of an event that gets sent to the sinks
+type SinkEvent struct {
+	Timestamp time.Time `json:"timestamp"`
+
+	// The event name, which is the category and the action joined by a dash (e.g. enclave-create)
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Action   string `json:"action"`
+
+	Source        string `json:"source"`
+	SourceVersion string `json:"source_version"`
+	UserId        string `json:"user_id"`
+
+	Properties map[string]string `json:"properties"`
+}
+
+// SinkConfig selects the metrics client that the events get sent with when the user accepted sending metrics
+// It's serialized to JSON as it's passed along from the CLI to the engine and the API containers
+type SinkConfig struct {
+	Type MetricsClientType `json:"type"`
+
+	// Only used by the JSONL file sink
+	FilePath string `json:"filePath,omitempty"`
+
+	// Only used by the HTTP webhook and the OTLP logs sinks
+	Url     string            `json:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+func (config *SinkConfig) Validate() error {
+	switch config.Type {
+	case Segment:
+		return nil
+	case JsonlFile:
+		if config.FilePath == "" {
+			return stacktrace.NewError("A file path is required by the '%v' metrics sink", config.Type)
+		}
+		return nil
+	case HttpWebhook, OtlpLogs:
+		if config.Url == "" {
+			return stacktrace.NewError("A URL is required by the '%v' metrics sink", config.Type)
+		}
+		parsedUrl, err := url.Parse(config.Url)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred parsing URL '%v' of the '%v' metrics sink", config.Url, config.Type)
+		}
+		if parsedUrl.Scheme != sinkUrlHttpScheme && parsedUrl.Scheme != sinkUrlHttpsScheme {
+			return stacktrace.NewError("The URL '%v' of the '%v' metrics sink must have the '%v' or '%v' scheme", config.Url, config.Type, sinkUrlHttpScheme, sinkUrlHttpsScheme)
+		}
+		if parsedUrl.Host == "" {
+			return stacktrace.NewError("The URL '%v' of the '%v' metrics sink doesn't have a host", config.Url, config.Type)
+		}
+		return nil
+	default:
+		return stacktrace.NewError("Unrecognized metrics sink type '%v'; valid types are '%v', '%v', '%v' and '%v'", config.Type, Segment, JsonlFile, HttpWebhook, OtlpLogs)
+	}
+}
+
+// WithFileInDirectory returns a copy of the config whose JSONL file, if any, is moved to the given directory, keeping its name
+// The file path of the config is a path on the host running the CLI, which doesn't exist in the engine and API containers
+// so they write their events to a file in their own data directory instead
+func (config *SinkConfig) WithFileInDirectory(dirpath string) *SinkConfig {
+	result := *config
+	if result.Type == JsonlFile && result.FilePath != "" {
+		result.FilePath = path.Join(dirpath, path.Base(result.FilePath))
+	}
+	return &result
+}
@@ -0,0 +1,175 @@
+package metrics_client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/source"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSourceVersion   = "0.0.0"
+	testUserId          = "test-user"
+	testBackendType     = "docker"
+	testEnclaveId       = "test-enclave"
+	testHeaderName      = "Authorization"
+	testHeaderValue     = "Bearer test-token"
+	testPackageId       = "github.com/test/test"
+	testInstructionName = "add_service"
+)
+
+type countingCallback struct {
+	successes int
+	failures  int
+}
+
+func (callback *countingCallback) Success() {
+	callback.successes++
+}
+
+func (callback *countingCallback) Failure(err error) {
+	callback.failures++
+}
+
+func TestJsonlFileSink(t *testing.T) {
+	filePath := path.Join(t.TempDir(), "metrics.jsonl")
+	callback := &countingCallback{}
+	metricsClient, closeFunc := createTestMetricsClient(t, true, &SinkConfig{Type: JsonlFile, FilePath: filePath, Url: "", Headers: nil}, callback)
+
+	require.NoError(t, metricsClient.TrackCreateEnclave(testEnclaveId, false))
+	require.NoError(t, metricsClient.TrackInstructionFailed(testEnclaveId, testPackageId, testInstructionName))
+	require.NoError(t, closeFunc())
+	require.Equal(t, 2, callback.successes)
+
+	fileContent, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(fileContent)), "\n")
+	require.Len(t, lines, 2)
+
+	var sinkEvent SinkEvent
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &sinkEvent))
+	require.Equal(t, "enclave-instruction-failed", sinkEvent.Name)
+	require.Equal(t, source.KurtosisCLISource.GetKey(), sinkEvent.Source)
+	require.Equal(t, testUserId, sinkEvent.UserId)
+	require.Equal(t, testInstructionName, sinkEvent.Properties["instruction_name"])
+	require.Equal(t, testBackendType, sinkEvent.Properties[backendKey])
+	// The enclave ID is hashed like with Segment
+	require.NotEqual(t, testEnclaveId, sinkEvent.Properties["enclave_id"])
+}
+
+func TestHttpWebhookSink(t *testing.T) {
+	var receivedEvents []*SinkEvent
+	var receivedHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		receivedHeader = request.Header.Get(testHeaderName)
+		var sinkEvent SinkEvent
+		require.NoError(t, json.NewDecoder(request.Body).Decode(&sinkEvent))
+		receivedEvents = append(receivedEvents, &sinkEvent)
+	}))
+	defer server.Close()
+
+	callback := &countingCallback{}
+	metricsClient, closeFunc := createTestMetricsClient(t, true, &SinkConfig{Type: HttpWebhook, FilePath: "", Url: server.URL, Headers: map[string]string{testHeaderName: testHeaderValue}}, callback)
+	defer closeFunc()
+
+	require.NoError(t, metricsClient.TrackServiceAdded(testEnclaveId, "postgres"))
+	require.Equal(t, 1, callback.successes)
+	require.Len(t, receivedEvents, 1)
+	require.Equal(t, "enclave-service-added", receivedEvents[0].Name)
+	require.Equal(t, testHeaderValue, receivedHeader)
+}
+
+func TestHttpWebhookSink_FailingWebhookCallsFailureCallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	callback := &countingCallback{}
+	metricsClient, closeFunc := createTestMetricsClient(t, true, &SinkConfig{Type: HttpWebhook, FilePath: "", Url: server.URL, Headers: nil}, callback)
+	defer closeFunc()
+
+	require.Error(t, metricsClient.TrackStopEnclave(testEnclaveId))
+	require.Equal(t, 0, callback.successes)
+	require.Equal(t, 1, callback.failures)
+}
+
+func TestOtlpLogsSink(t *testing.T) {
+	var receivedPath string
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		receivedPath = request.URL.Path
+		var err error
+		receivedBody, err = io.ReadAll(request.Body)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	metricsClient, closeFunc := createTestMetricsClient(t, true, &SinkConfig{Type: OtlpLogs, FilePath: "", Url: server.URL, Headers: nil}, &countingCallback{})
+	defer closeFunc()
+
+	require.NoError(t, metricsClient.TrackKurtosisRun(testPackageId, true, false, false))
+	require.Equal(t, otlpLogsUrlPath, receivedPath)
+
+	var request otlpLogsRequest
+	require.NoError(t, json.Unmarshal(receivedBody, &request))
+	require.Len(t, request.ResourceLogs, 1)
+	require.Equal(t, newOtlpKeyValue(otlpServiceNameAttributeKey, source.KurtosisCLISource.GetKey()), request.ResourceLogs[0].Resource.Attributes[0])
+	logRecords := request.ResourceLogs[0].ScopeLogs[0].LogRecords
+	require.Len(t, logRecords, 1)
+	require.Equal(t, "kurtosis-run", logRecords[0].Body.StringValue)
+	require.Contains(t, logRecords[0].Attributes, newOtlpKeyValue("package_id", testPackageId))
+}
+
+func TestSinkIsNotUsedWhenUserOptedOut(t *testing.T) {
+	filePath := path.Join(t.TempDir(), "metrics.jsonl")
+	metricsClient, closeFunc := createTestMetricsClient(t, false, &SinkConfig{Type: JsonlFile, FilePath: filePath, Url: "", Headers: nil}, &countingCallback{})
+
+	require.NoError(t, metricsClient.TrackCreateEnclave(testEnclaveId, false))
+	require.NoError(t, closeFunc())
+	require.IsType(t, &doNothingClient{}, metricsClient)
+	require.NoFileExists(t, filePath)
+}
+
+func TestSinkConfigValidate(t *testing.T) {
+	require.NoError(t, (&SinkConfig{Type: OtlpLogs, FilePath: "", Url: "https://collector:4318", Headers: nil}).Validate())
+	require.Error(t, (&SinkConfig{Type: JsonlFile, FilePath: "", Url: "", Headers: nil}).Validate())
+	require.Error(t, (&SinkConfig{Type: HttpWebhook, FilePath: "", Url: "ftp://webhook", Headers: nil}).Validate())
+	require.Error(t, (&SinkConfig{Type: "kafka", FilePath: "", Url: "", Headers: nil}).Validate())
+}
+
+func TestSinkConfigWithFileInDirectory(t *testing.T) {
+	sinkConfig := &SinkConfig{Type: JsonlFile, FilePath: "/home/user/metrics.jsonl", Url: "", Headers: nil}
+	require.Equal(t, "/kurtosis-data/metrics.jsonl", sinkConfig.WithFileInDirectory("/kurtosis-data").FilePath)
+	// The original config is left as-is
+	require.Equal(t, "/home/user/metrics.jsonl", sinkConfig.FilePath)
+
+	webhookSinkConfig := &SinkConfig{Type: HttpWebhook, FilePath: "", Url: "http://webhook", Headers: nil}
+	require.Equal(t, webhookSinkConfig, webhookSinkConfig.WithFileInDirectory("/kurtosis-data"))
+}
+
+func createTestMetricsClient(t *testing.T, didUserAcceptSendingMetrics bool, sinkConfig *SinkConfig, callback Callback) (MetricsClient, func() error) {
+	metricsClient, closeFunc, err := CreateMetricsClient(NewMetricsClientCreatorOption(
+		source.KurtosisCLISource,
+		testSourceVersion,
+		testUserId,
+		testBackendType,
+		didUserAcceptSendingMetrics,
+		true,
+		callback,
+		nil,
+		false,
+		"",
+		"",
+		sinkConfig,
+	))
+	require.NoError(t, err)
+	return metricsClient, closeFunc
+}
@@ -0,0 +1,164 @@
+package metrics_client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// The OTLP/HTTP path of the logs, which gets appended when the URL is only the collector's address
+	// See https://opentelemetry.io/docs/specs/otlp/#otlphttp-request
+	otlpLogsUrlPath = "/v1/logs"
+	urlRootPath     = "/"
+
+	otlpServiceNameAttributeKey    = "service.name"
+	otlpServiceVersionAttributeKey = "service.version"
+	otlpUserIdAttributeKey         = "kurtosis.metrics.user_id"
+	otlpEventNameAttributeKey      = "event.name"
+	otlpEventCategoryAttributeKey  = "kurtosis.metrics.category"
+	otlpEventActionAttributeKey    = "kurtosis.metrics.action"
+
+	otlpScopeName = "kurtosis-metrics-library"
+
+	otlpInfoSeverityNumber = 9
+	otlpInfoSeverityText   = "INFO"
+)
+
+// otlpLogsSink exports each event as an OTLP log record over HTTP with the JSON encoding, so that it can be received
+// by any OpenTelemetry collector
+// The OTLP messages are built by hand rather than with the OpenTelemetry SDK as the JSON encoding is simple enough,
+// and it keeps this library light for its users
+type otlpLogsSink struct {
+	httpClient *http.Client
+	url        string
+	headers    map[string]string
+}
+
+type otlpLogsRequest struct {
+	ResourceLogs []*otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  *otlpResource    `json:"resource"`
+	ScopeLogs []*otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []*otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      *otlpScope       `json:"scope"`
+	LogRecords []*otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	// The OTLP JSON encoding represents the 64 bits integers as strings
+	TimeUnixNano   string          `json:"timeUnixNano"`
+	SeverityNumber int             `json:"severityNumber"`
+	SeverityText   string          `json:"severityText"`
+	Body           *otlpAnyValue   `json:"body"`
+	Attributes     []*otlpKeyValue `json:"attributes"`
+}
+
+type otlpKeyValue struct {
+	Key   string        `json:"key"`
+	Value *otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+func newOtlpLogsSink(collectorUrl string, headers map[string]string) (*otlpLogsSink, error) {
+	parsedUrl, err := url.Parse(collectorUrl)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing OTLP collector URL '%v'", collectorUrl)
+	}
+	if parsedUrl.Path == "" || parsedUrl.Path == urlRootPath {
+		parsedUrl.Path = otlpLogsUrlPath
+	}
+	return &otlpLogsSink{
+		// nolint: exhaustruct
+		httpClient: &http.Client{Timeout: httpSinkRequestTimeout},
+		url:        parsedUrl.String(),
+		headers:    headers,
+	}, nil
+}
+
+func (sink *otlpLogsSink) Send(event *SinkEvent) error {
+	serializedRequest, err := json.Marshal(newOtlpLogsRequest(event))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the OTLP logs request of event '%v'", event.Name)
+	}
+	if err = postJson(sink.httpClient, sink.url, sink.headers, serializedRequest); err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting event '%v' to OTLP collector '%v'", event.Name, sink.url)
+	}
+	return nil
+}
+
+func (sink *otlpLogsSink) Close() error {
+	sink.httpClient.CloseIdleConnections()
+	return nil
+}
+
+func newOtlpLogsRequest(event *SinkEvent) *otlpLogsRequest {
+	attributes := []*otlpKeyValue{
+		newOtlpKeyValue(otlpEventNameAttributeKey, event.Name),
+		newOtlpKeyValue(otlpEventCategoryAttributeKey, event.Category),
+		newOtlpKeyValue(otlpEventActionAttributeKey, event.Action),
+		newOtlpKeyValue(otlpUserIdAttributeKey, event.UserId),
+	}
+	// Sorted so that the requests are deterministic
+	propertyKeys := make([]string, 0, len(event.Properties))
+	for propertyKey := range event.Properties {
+		propertyKeys = append(propertyKeys, propertyKey)
+	}
+	sort.Strings(propertyKeys)
+	for _, propertyKey := range propertyKeys {
+		attributes = append(attributes, newOtlpKeyValue(propertyKey, event.Properties[propertyKey]))
+	}
+
+	return &otlpLogsRequest{
+		ResourceLogs: []*otlpResourceLogs{
+			{
+				Resource: &otlpResource{
+					Attributes: []*otlpKeyValue{
+						newOtlpKeyValue(otlpServiceNameAttributeKey, event.Source),
+						newOtlpKeyValue(otlpServiceVersionAttributeKey, event.SourceVersion),
+					},
+				},
+				ScopeLogs: []*otlpScopeLogs{
+					{
+						Scope: &otlpScope{Name: otlpScopeName},
+						LogRecords: []*otlpLogRecord{
+							{
+								TimeUnixNano:   strconv.FormatInt(event.Timestamp.UnixNano(), 10),
+								SeverityNumber: otlpInfoSeverityNumber,
+								SeverityText:   otlpInfoSeverityText,
+								Body:           &otlpAnyValue{StringValue: event.Name},
+								Attributes:     attributes,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newOtlpKeyValue(key string, value string) *otlpKeyValue {
+	return &otlpKeyValue{
+		Key:   key,
+		Value: &otlpAnyValue{StringValue: value},
+	}
+}
@@ -161,6 +161,22 @@ func (segment *segmentClient) TrackKurtosisAnalyticsToggle(analyticsStatus bool)
 	return nil
 }
 
+func (segment *segmentClient) TrackServiceAdded(enclaveId string, serviceName string) error {
+	newEvent := event.NewServiceAddedEvent(enclaveId, serviceName)
+	if err := segment.track(newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking service added event")
+	}
+	return nil
+}
+
+func (segment *segmentClient) TrackInstructionFailed(enclaveId string, packageId string, instructionName string) error {
+	newEvent := event.NewInstructionFailedEvent(enclaveId, packageId, instructionName)
+	if err := segment.track(newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking instruction failed event")
+	}
+	return nil
+}
+
 func (segment *segmentClient) close() (err error) {
 	if err := segment.client.Close(); err != nil {
 		return stacktrace.Propagate(err, "An error occurred closing the Segment client")
@@ -0,0 +1,164 @@
+package metrics_client
+
+import (
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/event"
+	metrics_source "github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/source"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// sinkClient sends the events synchronously to a sink of the user's choice, with the same properties as the Segment client
+// As there's no queue, the callback gets notified right after each event gets sent
+type sinkClient struct {
+	sink            Sink
+	source          metrics_source.Source
+	sourceVersion   string
+	userID          string
+	isCI            string
+	backendType     string
+	cloudUserId     CloudUserID
+	cloudInstanceId CloudInstanceID
+	callback        Callback
+}
+
+func newSinkClient(sink Sink, source metrics_source.Source, sourceVersion string, userId string, backendType string, callback Callback, isCI bool, cloudUserId CloudUserID, cloudInstanceId CloudInstanceID) *sinkClient {
+	return &sinkClient{
+		sink:            sink,
+		source:          source,
+		sourceVersion:   sourceVersion,
+		userID:          userId,
+		isCI:            strconv.FormatBool(isCI),
+		backendType:     backendType,
+		cloudUserId:     cloudUserId,
+		cloudInstanceId: cloudInstanceId,
+		callback:        callback,
+	}
+}
+
+func (client *sinkClient) TrackShouldSendMetricsUserElection(didUserAcceptSendingMetrics bool) error {
+	newEvent := event.NewShouldSendMetricsUserElectionEvent(didUserAcceptSendingMetrics)
+	if err := client.track(newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking should-send-metrics user election")
+	}
+	return nil
+}
+
+func (client *sinkClient) TrackUserSharedEmailAddress(userSharedEmailAddress string) error {
+	newEvent := event.NewUserSharesEmailAddress(userSharedEmailAddress)
+	if err := client.track(newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking user-shares-email-address event")
+	}
+	return nil
+}
+
+func (client *sinkClient) TrackCreateEnclave(enclaveId string, isSubnetworkingEnabled bool) error {
+	newEvent := event.NewCreateEnclaveEvent(enclaveId, isSubnetworkingEnabled)
+	if err := client.track(newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking create enclave event")
+	}
+	return nil
+}
+
+func (client *sinkClient) TrackStopEnclave(enclaveId string) error {
+	newEvent := event.NewStopEnclaveEvent(enclaveId)
+	if err := client.track(newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking stop enclave event")
+	}
+	return nil
+}
+
+func (client *sinkClient) TrackDestroyEnclave(enclaveId string) error {
+	newEvent := event.NewDestroyEnclaveEvent(enclaveId)
+	if err := client.track(newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking destroy enclave event")
+	}
+	return nil
+}
+
+func (client *sinkClient) TrackKurtosisRun(packageId string, isRemote bool, isDryRun bool, isScript bool) error {
+	newEvent := event.NewKurtosisRunEvent(packageId, isRemote, isDryRun, isScript)
+	if err := client.track(newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking run kurtosis event")
+	}
+	return nil
+}
+
+func (client *sinkClient) TrackKurtosisRunFinishedEvent(packageId string, numberOfServices int, isSuccess bool) error {
+	newEvent := event.NewKurtosisRunFinishedEvent(packageId, numberOfServices, isSuccess)
+	if err := client.track(newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking kurtosis run finished event")
+	}
+	return nil
+}
+
+func (client *sinkClient) TrackKurtosisAnalyticsToggle(analyticsStatus bool) error {
+	newEvent := event.NewKurtosisAnalyticsToggleEvent(analyticsStatus)
+	if err := client.track(newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking kurtosis analytics toggle event")
+	}
+	return nil
+}
+
+func (client *sinkClient) TrackServiceAdded(enclaveId string, serviceName string) error {
+	newEvent := event.NewServiceAddedEvent(enclaveId, serviceName)
+	if err := client.track(newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking service added event")
+	}
+	return nil
+}
+
+func (client *sinkClient) TrackInstructionFailed(enclaveId string, packageId string, instructionName string) error {
+	newEvent := event.NewInstructionFailedEvent(enclaveId, packageId, instructionName)
+	if err := client.track(newEvent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking instruction failed event")
+	}
+	return nil
+}
+
+func (client *sinkClient) close() (err error) {
+	if err := client.sink.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the metrics sink")
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private helper methods
+//
+// ====================================================================================================
+func (client *sinkClient) track(event *event.Event) error {
+	properties := map[string]string{}
+	for propertyKey, propertyValue := range event.GetProperties() {
+		properties[propertyKey] = propertyValue
+	}
+	properties[isCIKey] = client.isCI
+	properties[osKey] = runtime.GOOS
+	properties[archKey] = runtime.GOARCH
+	properties[backendKey] = client.backendType
+	properties[cloudUserIdKey] = string(client.cloudUserId)
+	properties[cloudInstanceIdKey] = string(client.cloudInstanceId)
+	properties[isCloudKey] = strconv.FormatBool(client.cloudInstanceId != "" || client.cloudUserId != "")
+
+	sinkEvent := &SinkEvent{
+		Timestamp:     time.Now(),
+		Name:          event.GetName(),
+		Category:      event.GetCategory(),
+		Action:        event.GetAction(),
+		Source:        client.source.GetKey(),
+		SourceVersion: client.sourceVersion,
+		UserId:        client.userID,
+		Properties:    properties,
+	}
+	if err := client.sink.Send(sinkEvent); err != nil {
+		logrus.Debugf("Metrics sink client failed to send event '%v'", sinkEvent.Name)
+		client.callback.Failure(err)
+		return stacktrace.Propagate(err, "An error occurred sending event '%v' to the metrics sink", sinkEvent.Name)
+	}
+	client.callback.Success()
+	return nil
+}